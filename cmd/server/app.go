@@ -14,7 +14,9 @@ import (
 	"embed"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"time"
 
@@ -28,83 +30,160 @@ import (
 	ent_impl "github.com/anzhiyu-c/anheyu-app/internal/infra/persistence/ent"
 	"github.com/anzhiyu-c/anheyu-app/internal/infra/router"
 	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/autotls"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/conditional"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	server_listener "github.com/anzhiyu-c/anheyu-app/internal/pkg/listener"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/perfprofile"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
 	"github.com/anzhiyu-c/anheyu-app/internal/service/cache"
 	"github.com/anzhiyu-c/anheyu-app/pkg/config"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	a11yaudit_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/a11yaudit"
+	accesslog_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/accesslog"
+	adminevent_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/adminevent"
 	album_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album"
 	album_category_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album_category"
 	article_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article"
 	article_history_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article_history"
+	assetcdn_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/assetcdn"
 	auth_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/auth"
+	author_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/author"
+	avatar_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/avatar"
+	cache_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/cache"
 	captcha_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/captcha"
 	comment_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/comment"
 	config_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/config"
+	dashboard_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/dashboard"
+	diagnostics_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/diagnostics"
 	direct_link_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/direct_link"
 	doc_series_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/doc_series"
+	favicon_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/favicon"
 	file_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/file"
+	gitsync_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/gitsync"
+	instancebackup_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/instancebackup"
 	link_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/link"
+	linkarchive_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/linkarchive"
+	localizer_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/localizer"
+	loginguard_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/loginguard"
+	mail_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/mail"
+	media_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/media"
 	music_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/music"
 	notification_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/notification"
+	oauth_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/oauth"
 	page_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/page"
+	pagedata_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/pagedata"
+	permalink_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/permalink"
+	plugin_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/plugin"
 	post_category_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/post_category"
 	post_tag_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/post_tag"
+	privacy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/privacy"
 	proxy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/proxy"
 	public_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/public"
+	reaction_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/reaction"
+	redirect_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/redirect"
 	search_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/search"
+	selfupdate_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/selfupdate"
+	seoaudit_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/seoaudit"
 	setting_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/setting"
 	sitemap_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/sitemap"
+	snippet_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/snippet"
+	sqlitebackup_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/sqlitebackup"
 	ssrtheme_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/ssrtheme"
 	statistics_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/statistics"
 	storage_policy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/storage_policy"
 	subscriber_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/subscriber"
+	sysconfig_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/sysconfig"
+	task_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/task"
+	taxonomy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/taxonomy"
 	theme_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/theme"
+	themelayout_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/themelayout"
 	thumbnail_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/thumbnail"
+	tls_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/tls"
+	trash_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/trash"
 	user_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/user"
 	version_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/version"
+	webhook_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/webhook"
 	wechat_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/wechat"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/plugin"
+	a11yaudit_service "github.com/anzhiyu-c/anheyu-app/pkg/service/a11yaudit"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/accesslog"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/adminevent"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/album"
 	album_category_service "github.com/anzhiyu-c/anheyu-app/pkg/service/album_category"
 	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
 	article_history_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article_history"
+	assetcdn_service "github.com/anzhiyu-c/anheyu-app/pkg/service/assetcdn"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	author_service "github.com/anzhiyu-c/anheyu-app/pkg/service/author"
+	avatar_service "github.com/anzhiyu-c/anheyu-app/pkg/service/avatar"
 	captcha_service "github.com/anzhiyu-c/anheyu-app/pkg/service/captcha"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/cdn"
 	cleanup_service "github.com/anzhiyu-c/anheyu-app/pkg/service/cleanup"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/cluster"
 	comment_service "github.com/anzhiyu-c/anheyu-app/pkg/service/comment"
 	config_service "github.com/anzhiyu-c/anheyu-app/pkg/service/config"
+	dashboard_service "github.com/anzhiyu-c/anheyu-app/pkg/service/dashboard"
+	demo_service "github.com/anzhiyu-c/anheyu-app/pkg/service/demo"
+	diagnostics_service "github.com/anzhiyu-c/anheyu-app/pkg/service/diagnostics"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/direct_link"
 	doc_series_service "github.com/anzhiyu-c/anheyu-app/pkg/service/doc_series"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/errorreport"
+	favicon_service "github.com/anzhiyu-c/anheyu-app/pkg/service/favicon"
 	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/file_info"
 	geetest_service "github.com/anzhiyu-c/anheyu-app/pkg/service/geetest"
+	gitsync_service "github.com/anzhiyu-c/anheyu-app/pkg/service/gitsync"
 	imagecaptcha_service "github.com/anzhiyu-c/anheyu-app/pkg/service/imagecaptcha"
+	instancebackup_service "github.com/anzhiyu-c/anheyu-app/pkg/service/instancebackup"
 	link_service "github.com/anzhiyu-c/anheyu-app/pkg/service/link"
+	linkarchive_service "github.com/anzhiyu-c/anheyu-app/pkg/service/linkarchive"
+	localizer_service "github.com/anzhiyu-c/anheyu-app/pkg/service/localizer"
+	loginguard_service "github.com/anzhiyu-c/anheyu-app/pkg/service/loginguard"
+	mail_service "github.com/anzhiyu-c/anheyu-app/pkg/service/mail"
+	media_service "github.com/anzhiyu-c/anheyu-app/pkg/service/media"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/music"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
+	oauth_service "github.com/anzhiyu-c/anheyu-app/pkg/service/oauth"
 	page_service "github.com/anzhiyu-c/anheyu-app/pkg/service/page"
+	pagedata_service "github.com/anzhiyu-c/anheyu-app/pkg/service/pagedata"
 	parser_service "github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
+	permalink_service "github.com/anzhiyu-c/anheyu-app/pkg/service/permalink"
 	post_category_service "github.com/anzhiyu-c/anheyu-app/pkg/service/post_category"
 	post_tag_service "github.com/anzhiyu-c/anheyu-app/pkg/service/post_tag"
+	privacy_service "github.com/anzhiyu-c/anheyu-app/pkg/service/privacy"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/process"
+	reaction_service "github.com/anzhiyu-c/anheyu-app/pkg/service/reaction"
+	redirect_service "github.com/anzhiyu-c/anheyu-app/pkg/service/redirect"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/search"
+	selfupdate_service "github.com/anzhiyu-c/anheyu-app/pkg/service/selfupdate"
+	seoaudit_service "github.com/anzhiyu-c/anheyu-app/pkg/service/seoaudit"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/sitemap"
+	siteverify_service "github.com/anzhiyu-c/anheyu-app/pkg/service/siteverify"
+	snippet_service "github.com/anzhiyu-c/anheyu-app/pkg/service/snippet"
+	sqlitebackup_service "github.com/anzhiyu-c/anheyu-app/pkg/service/sqlitebackup"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/statistics"
 	subscriber_service "github.com/anzhiyu-c/anheyu-app/pkg/service/subscriber"
+	summary_service "github.com/anzhiyu-c/anheyu-app/pkg/service/summary"
+	taxonomy_service "github.com/anzhiyu-c/anheyu-app/pkg/service/taxonomy"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
+	themelayout_service "github.com/anzhiyu-c/anheyu-app/pkg/service/themelayout"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/thumbnail"
+	trash_service "github.com/anzhiyu-c/anheyu-app/pkg/service/trash"
 	turnstile_service "github.com/anzhiyu-c/anheyu-app/pkg/service/turnstile"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/user"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume/strategy"
+	webhook_service "github.com/anzhiyu-c/anheyu-app/pkg/service/webhook"
 	wechat_service "github.com/anzhiyu-c/anheyu-app/pkg/service/wechat"
 	"github.com/anzhiyu-c/anheyu-app/pkg/ssr"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	_ "github.com/anzhiyu-c/anheyu-app/ent/runtime"
 )
@@ -137,6 +216,14 @@ type App struct {
 	themeHandler         *theme_handler.Handler
 	ssrManager           *ssr.Manager
 	ssrThemeHandler      *ssrtheme_handler.Handler
+	cacheWarmupSvc       *cache.WarmupService
+	tlsManager           *autotls.Manager
+	configBackupSvc      config_service.BackupService
+	pluginManager        *plugin.Manager
+	instanceBackupSvc    *instancebackup_service.Service
+	sqliteBackupSvc      *sqlitebackup_service.Service
+	selfUpdateSvc        *selfupdate_service.Service
+	clusterSyncer        *cluster.Syncer
 }
 
 func (a *App) PrintBanner() {
@@ -166,8 +253,9 @@ func (a *App) PrintBanner() {
 
 // NewApp 是应用的构造函数，它执行所有的初始化和依赖注入工作
 func NewApp(content embed.FS) (*App, func(), error) {
-	// 在初始化早期获取版本信息
+	// 在初始化早期获取版本信息，并开始保留最近的日志供诊断包使用
 	appVersion := version.GetVersion()
+	diagnostics_service.CaptureLogs()
 
 	// --- Phase 1: 加载外部配置 ---
 	cfg, err := config.NewConfig()
@@ -261,6 +349,15 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	if err := settingSvc.LoadAllSettings(context.Background()); err != nil {
 		return nil, tempCleanup, fmt.Errorf("从数据库加载站点配置失败: %w", err)
 	}
+	// 外部请求的代理、自定义 CA 和下载镜像从站点配置读取，修改后立即生效
+	httpclient.SetConfigProvider(httpclient.NewSettingConfigProvider(settingSvc))
+	httpclient.SetMirrorProvider(httpclient.NewSettingMirrorProvider(settingSvc))
+	httpclient.SetIdentityProvider(httpclient.NewSettingIdentityProvider(settingSvc))
+	// 低配模式影响 worker 数量、缓存容量和后台任务，需在创建相关服务之前设置
+	perfprofile.SetSettingSource(settingSvc)
+	if perfprofile.Current().LowResource {
+		log.Println("已启用低配模式：后台任务单线程执行，暂停重量级定时任务")
+	}
 	strategyManager := strategy.NewManager()
 	strategyManager.Register(constant.PolicyTypeLocal, strategy.NewLocalStrategy())
 	strategyManager.Register(constant.PolicyTypeOneDrive, strategy.NewOneDriveStrategy())
@@ -271,8 +368,21 @@ func NewApp(content embed.FS) (*App, func(), error) {
 
 	// 使用智能缓存工厂，自动选择 Redis 或内存缓存
 	cacheSvc := utility.NewCacheServiceWithFallback(redisClient)
+	applyCacheConfig(cfg, cacheSvc)
+	cfg.OnReload(func(c *config.Config) { applyCacheConfig(c, cacheSvc) })
+
+	// 多实例部署时同步其他实例保存的配置和切换的主题
+	clusterSyncer := cluster.NewSyncer(cluster.Options{
+		Mode:         cfg.GetString(config.KeyClusterSyncMode),
+		PollInterval: time.Duration(cfg.GetInt(config.KeyClusterPollInterval)) * time.Second,
+		Redis:        redisClient,
+		DB:           entClient,
+		Bus:          eventBus,
+		Settings:     settingSvc,
+	})
+	clusterSyncer.Start()
 
-	tokenSvc := auth.NewTokenService(userRepo, settingSvc, cacheSvc)
+	tokenSvc := auth.NewTokenService(userRepo, settingSvc, cacheSvc, auth.NewSessionStore(entClient, auth.LegacySessionStorePath))
 	geoSvc, err := utility.NewGeoIPService(settingSvc)
 	if err != nil {
 		log.Printf("警告: GeoIP 服务初始化失败: %v。IP属地将显示为'未知'", err)
@@ -289,8 +399,8 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	storageProviders[constant.PolicyTypeS3] = storage.NewAWSS3Provider()
 	storageProviders[constant.PolicyTypeQiniu] = storage.NewQiniuKodoProvider()
 	metadataSvc := file_info.NewMetadataService(metadataRepo)
-	postTagSvc := post_tag_service.NewService(postTagRepo)
-	postCategorySvc := post_category_service.NewService(postCategoryRepo, articleRepo)
+	postTagSvc := post_tag_service.NewService(postTagRepo, eventBus)
+	postCategorySvc := post_category_service.NewService(postCategoryRepo, articleRepo, eventBus)
 	docSeriesSvc := doc_series_service.NewService(docSeriesRepo)
 	cleanupSvc := cleanup_service.NewCleanupService(cleanupRepo)
 	userSvc := user.NewUserService(userRepo, userGroupRepo)
@@ -330,13 +440,21 @@ func NewApp(content embed.FS) (*App, func(), error) {
 		log.Printf("[DEBUG] 默认通知类型初始化完成")
 	}
 
-	// 初始化邮件服务（需要 notificationSvc 和 parserSvc 用于表情包解析）
-	emailSvc := utility.NewEmailService(settingSvc, notificationSvc, parserSvc)
+	// 初始化邮件服务（需要 notificationSvc 和 parserSvc 用于表情包解析），邮件统一经由 mailSvc 发送
+	mailSvc := mail_service.NewService(settingSvc, mail_service.DefaultQueuePath)
+	emailSvc := utility.NewEmailService(settingSvc, notificationSvc, parserSvc, mailSvc)
 
 	// 初始化文章历史版本服务（需要在taskBroker之前创建，用于定时清理任务）
 	articleHistorySvc := article_history_service.NewService(articleHistoryRepo, articleRepo, userRepo)
 
-	taskBroker := task.NewBroker(uploadSvc, thumbnailSvc, cleanupSvc, articleRepo, commentRepo, emailSvc, cacheSvc, linkCategoryRepo, linkTagRepo, linkRepo, settingSvc, statService, articleHistorySvc)
+	// 第三方资源本地化服务（需要在taskBroker之前创建，用于定时刷新任务）
+	localizerSvc := localizer_service.NewService(settingSvc, localizer_service.DefaultCacheDir)
+
+	instanceBackupSvc := instancebackup_service.NewService(cfg, sqlDB, settingSvc, storagePolicySvc, storageProviders, appVersion, instancebackup_service.DefaultBackupDir)
+	sqliteBackupSvc := sqlitebackup_service.NewService(cfg, sqlDB, sqlitebackup_service.DefaultBackupDir)
+	selfUpdateSvc := selfupdate_service.NewService(cfg, selfupdate_service.DefaultStateDir)
+	linkArchiveSvc := linkarchive_service.NewService(entClient, settingSvc, linkarchive_service.LegacyStorePath)
+	taskBroker := task.NewBroker(uploadSvc, thumbnailSvc, cleanupSvc, articleRepo, commentRepo, emailSvc, cacheSvc, linkCategoryRepo, linkTagRepo, linkRepo, settingSvc, statService, articleHistorySvc, localizerSvc, instanceBackupSvc, linkArchiveSvc)
 	pageSvc := page_service.NewService(pageRepo)
 
 	// 初始化搜索服务
@@ -399,6 +517,18 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	articleSvc := article_service.NewService(articleRepo, postTagRepo, postCategoryRepo, commentRepo, docSeriesRepo, pageRepo, txManager, cacheSvc, geoSvc, taskBroker, settingSvc, parserSvc, fileSvc, directLinkSvc, searchSvc, primaryColorSvc, cdnSvc, subscriberSvc, userRepo)
 	// 注入文章历史版本仓储
 	articleSvc.SetHistoryRepo(articleHistoryRepo)
+	// 表态服务：文章表态按公共ID计数，abbrlink 会被解析为公共ID
+	reactionSvc := reaction_service.NewService(entClient, settingSvc, cacheSvc, reaction_service.LegacyStorePath)
+	reactionSvc.SetResolver(reaction_service.TargetArticle, func(ctx context.Context, id string) (string, error) {
+		a, err := articleRepo.GetBySlugOrID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return a.ID, nil
+	})
+	articleSvc.SetReactionService(reactionSvc)
+	articleSvc.SetLinkArchiveService(linkArchiveSvc)
+	articleSvc.SetSummaryService(summary_service.NewService(settingSvc))
 	// articleHistorySvc 已在 taskBroker 之前创建
 	log.Printf("[DEBUG] 正在初始化 PushooService...")
 	pushooSvc := utility.NewPushooService(settingSvc)
@@ -408,11 +538,20 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	linkSvc := link_service.NewService(linkRepo, linkCategoryRepo, linkTagRepo, txManager, taskBroker, settingSvc, pushooSvc, emailSvc, eventBus)
 	log.Printf("[DEBUG] LinkService 初始化完成，PushooService、EmailService 和 EventBus 已注入")
 
-	authSvc := auth.NewAuthService(userRepo, settingSvc, tokenSvc, emailSvc, txManager, articleSvc)
+	authSvc := auth.NewAuthService(userRepo, settingSvc, tokenSvc, emailSvc, cacheSvc, txManager, articleSvc)
 	log.Printf("[DEBUG] 正在初始化 CommentService，将注入 PushooService 和 NotificationService...")
 	commentSvc := comment_service.NewService(commentRepo, userRepo, txManager, geoSvc, settingSvc, cacheSvc, taskBroker, fileSvc, parserSvc, pushooSvc, notificationSvc)
+	commentSvc.SetEventBus(eventBus)
 	log.Printf("[DEBUG] CommentService 初始化完成，PushooService 和 NotificationService 已注入")
 	themeSvc := theme.NewThemeService(entClient, userRepo)
+	themeSvc.SetEventBus(eventBus)
+	theme.SetStorageLimitsProvider(theme.NewSettingStorageLimitsProvider(settingSvc))
+	theme.SetMarketSourcesProvider(theme.NewSettingMarketSourcesProvider(settingSvc))
+	theme.SetCanaryConfigProvider(theme.NewSettingCanaryConfigProvider(settingSvc))
+	theme.SetOperationTimeoutsProvider(theme.NewSettingOperationTimeoutsProvider(settingSvc))
+	theme.SetTemplateFuncMapProvider(router.TemplateFuncMap)
+	ssr.SetBuildConfigProvider(ssr.NewSettingBuildConfigProvider(settingSvc))
+	ssr.SetInstallTimeoutProvider(ssr.NewSettingInstallTimeoutProvider(settingSvc))
 	_ = listener.NewFilePostProcessingListener(eventBus, taskBroker, extractionSvc)
 
 	// 初始化缓存清理服务（SSR 模式下启用）
@@ -420,6 +559,16 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	cacheRevalidateListener := listener.NewCacheRevalidateListener(revalidateSvc)
 	cacheRevalidateListener.RegisterHandlers(eventBus)
 
+	// 公开接口 ETag 的列表版本从数据库状态计算，本实例的文章、分类、标签或站点配置变化时立即重新读取
+	conditional.SetSource(conditional.ScopeArticles, cluster.ArticleVersion(entClient))
+	conditional.Watch(eventBus, conditional.ScopeArticles,
+		event.ArticleCreated, event.ArticleUpdated, event.ArticleDeleted, event.ArticlePublished,
+		event.CategoryUpdated, event.TagUpdated, event.SiteConfigUpdated,
+		event.Topic(setting.TopicSettingUpdated))
+
+	// 初始化缓存清除与预热服务
+	cacheWarmupSvc := cache.NewWarmupService(settingSvc, cdnSvc, sitemapSvc, revalidateSvc)
+
 	// 初始化音乐服务
 	log.Printf("[DEBUG] 正在初始化 MusicService...")
 	musicSvc := music.NewMusicService(settingSvc)
@@ -507,11 +656,13 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	}()
 
 	// --- Phase 6: 初始化表现层 (Handlers) ---
-	mw := middleware.NewMiddleware(tokenSvc)
-	authHandler := auth_handler.NewAuthHandler(authSvc, tokenSvc, settingSvc, captchaSvc)
+	mw := middleware.NewMiddleware(tokenSvc, settingSvc)
+	loginGuardSvc := loginguard_service.NewService(entClient, settingSvc, cacheSvc, geoSvc, emailSvc, loginguard_service.LegacyDeviceStorePath)
+	oauthSvc := oauth_service.NewService(entClient, settingSvc, cacheSvc, userRepo, authSvc, oauth_service.LegacyLinkStorePath)
+	authHandler := auth_handler.NewAuthHandler(authSvc, tokenSvc, settingSvc, captchaSvc, loginGuardSvc, imageCaptchaSvc, oauthSvc)
 	albumHandler := album_handler.NewAlbumHandler(albumSvc)
 	albumCategoryHandler := album_category_handler.NewHandler(albumCategorySvc)
-	userHandler := user_handler.NewUserHandler(userSvc, settingSvc, fileSvc, directLinkSvc)
+	userHandler := user_handler.NewUserHandler(userSvc, settingSvc, fileSvc, directLinkSvc, tokenSvc)
 	publicHandler := public_handler.NewPublicHandler(albumSvc, albumCategorySvc)
 	settingHandler := setting_handler.NewSettingHandler(settingSvc, emailSvc, cdnSvc, configBackupSvc)
 	storagePolicyHandler := storage_policy_handler.NewStoragePolicyHandler(storagePolicySvc)
@@ -532,12 +683,86 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	sitemapHandler := sitemap_handler.NewHandler(sitemapSvc)
 	proxyHandler := proxy_handler.NewHandler()
 	musicHandler := music_handler.NewMusicHandler(musicSvc)
-	versionHandler := version_handler.NewHandler()
+	versionHandler := version_handler.NewHandler(settingSvc, reactionSvc)
 	notificationHandler := notification_handler.NewHandler(notificationSvc)
 	configBackupHandler := config_handler.NewConfigBackupHandler(configBackupSvc)
 	configImportExportHandler := config_handler.NewConfigImportExportHandler(configImportExportSvc)
 	subscriberHandler := subscriber_handler.NewHandler(subscriberSvc, captchaSvc)
 	captchaHandler := captcha_handler.NewHandler(captchaSvc)
+	cacheHandler := cache_handler.NewHandler(revalidateSvc, cacheWarmupSvc)
+	accessLogHandler := accesslog_handler.NewHandler(accesslog.DefaultStore())
+	dashboardSvc := dashboard_service.NewService(articleRepo, commentRepo, statService, themeSvc, ssrManager, cacheSvc)
+	dashboardHandler := dashboard_handler.NewHandler(dashboardSvc)
+	taskHandler := task_handler.NewHandler(taskBroker)
+	tlsManager := newTLSManager(cfg)
+	tlsHandler := tls_handler.NewHandler(tlsManager)
+	authorHandler := author_handler.NewHandler(author_service.NewService(settingSvc), articleSvc)
+	reactionHandler := reaction_handler.NewHandler(reactionSvc)
+	snippetHandler := snippet_handler.NewHandler(snippet_service.NewService(settingSvc))
+	localizerHandler := localizer_handler.NewHandler(localizerSvc)
+	// SEO 检查服务在 Gin 引擎创建后注入引擎，通过真实的渲染流程渲染页面
+	seoAuditSvc := seoaudit_service.NewService(settingSvc)
+	seoAuditHandler := seoaudit_handler.NewHandler(seoAuditSvc)
+	instanceBackupHandler := instancebackup_handler.NewHandler(instanceBackupSvc)
+	privacyHandler := privacy_handler.NewHandler(privacy_service.NewService(entClient))
+	loginGuardHandler := loginguard_handler.NewHandler(loginGuardSvc)
+	oauthHandler := oauth_handler.NewHandler(oauthSvc)
+	avatarHandler := avatar_handler.NewHandler(avatar_service.NewService(settingSvc, avatar_service.DefaultCacheDir))
+	linkArchiveHandler := linkarchive_handler.NewHandler(linkArchiveSvc)
+	mediaSvc := media_service.NewService(fileRepo, directLinkRepo, articleRepo, pageRepo, albumRepo, metadataSvc, storagePolicySvc, fileSvc, thumbnailSvc, taskBroker, settingSvc)
+	mediaSvc.RegisterHandlers(eventBus)
+	mediaHandler := media_handler.NewHandler(mediaSvc)
+	sysConfigHandler := sysconfig_handler.NewHandler(cfg)
+	// 插件需要在前端路由配置之前初始化，注册的路由和模板函数才能生效
+	pluginMgr := plugin.NewManager(eventBus, plugin.DefaultDir, plugin.Frontend{
+		RegisterRoutes:       func(r plugin.RouteRegistrar) { router.RegisterFrontendExtension(r) },
+		RegisterTemplateFunc: router.RegisterTemplateFunc,
+	})
+	pluginMgr.Start()
+	pluginHandler := plugin_handler.NewHandler(pluginMgr)
+	webhookHandler := webhook_handler.NewHandler(webhook_service.NewService(settingSvc, articleSvc, articleRepo, postTagRepo, postCategoryRepo, parserSvc))
+	gitSyncSvc := gitsync_service.NewService(settingSvc, articleSvc, articleRepo, pageSvc, postTagRepo, postCategoryRepo, parserSvc, gitsync_service.DefaultDir)
+	articleSvc.SetSaveListener(gitSyncSvc)
+	pageSvc.SetSaveListener(gitSyncSvc)
+	taskBroker.SetGitSync(gitSyncSvc)
+	trashSvc := trash_service.NewService(settingSvc, articleSvc, pageSvc, themeSvc, mediaSvc)
+	taskBroker.SetTrash(trashSvc)
+	taskBroker.SetReadOnly(func() bool { return cfg.GetBool(config.KeyReadOnly) })
+	demoSvc := demo_service.NewService(cfg, instanceBackupSvc, demo_service.DefaultDir)
+	if demoSvc.Enabled() {
+		if err := demoSvc.Prepare(context.Background()); err != nil {
+			log.Printf("[演示模式] %v，定时重置不可用", err)
+		} else {
+			taskBroker.SetDemoReset(demoSvc)
+		}
+	}
+	gitSyncHandler := gitsync_handler.NewHandler(gitSyncSvc)
+	redirectSvc := redirect_service.NewService(entClient, redirect_service.LegacyStorePath)
+	articleSvc.SetRedirectRecorder(redirectSvc)
+	redirectHandler := redirect_handler.NewHandler(redirectSvc)
+	permalinkHandler := permalink_handler.NewHandler(permalink_service.NewService(settingSvc, articleSvc, articleRepo))
+	articleSvc.SetEventBus(eventBus)
+	taxonomySvc := taxonomy_service.NewService(postTagRepo, postCategoryRepo)
+	taxonomySvc.RegisterHandlers(eventBus)
+	taxonomyHandler := taxonomy_handler.NewHandler(taxonomySvc)
+	a11yAuditSvc := a11yaudit_service.NewService(settingSvc, themeSvc)
+	a11yAuditHandler := a11yaudit_handler.NewHandler(a11yAuditSvc)
+	assetCDNSvc := assetcdn_service.NewService(settingSvc, storagePolicySvc, storageProviders, router.NewThemeAssetSource(content), assetcdn_service.DefaultDir)
+	assetCDNSvc.RegisterHandlers(eventBus)
+	themeHandler.SetAssetURLRewriter(assetCDNSvc)
+	assetCDNHandler := assetcdn_handler.NewHandler(assetCDNSvc)
+	pageDataSvc := pagedata_service.NewService(entClient, pagedata_service.LegacyStorePath, pageSvc, articleSvc, taxonomySvc)
+	pageDataHandler := pagedata_handler.NewHandler(pageDataSvc)
+	themeLayoutSvc := themelayout_service.NewService(themelayout_service.DefaultStorePath, themeSvc, settingSvc, articleSvc, taxonomySvc)
+	themeHandler.SetLayoutResolver(themeLayoutSvc)
+	themeLayoutHandler := themelayout_handler.NewHandler(themeLayoutSvc)
+	faviconHandler := favicon_handler.NewHandler(favicon_service.NewService(settingSvc, fileSvc, directLinkSvc), settingSvc)
+	sqliteBackupHandler := sqlitebackup_handler.NewHandler(sqliteBackupSvc)
+	selfUpdateHandler := selfupdate_handler.NewHandler(selfUpdateSvc)
+	adminEventHandler := adminevent_handler.NewHandler(adminevent.Default())
+	mailHandler := mail_handler.NewHandler(mailSvc, settingSvc)
+	trashHandler := trash_handler.NewHandler(trashSvc)
+	diagnosticsHandler := diagnostics_handler.NewHandler(diagnostics_service.NewService(cfg, settingSvc, themeSvc, ssrManager, accesslog.DefaultStore()))
 
 	// --- Phase 7: 初始化路由 ---
 	appRouter := router.NewRouter(
@@ -573,6 +798,41 @@ func NewApp(content embed.FS) (*App, func(), error) {
 		configImportExportHandler,
 		subscriberHandler,
 		captchaHandler,
+		cacheHandler,
+		accessLogHandler,
+		dashboardHandler,
+		taskHandler,
+		tlsHandler,
+		authorHandler,
+		reactionHandler,
+		snippetHandler,
+		localizerHandler,
+		seoAuditHandler,
+		instanceBackupHandler,
+		privacyHandler,
+		loginGuardHandler,
+		oauthHandler,
+		avatarHandler,
+		linkArchiveHandler,
+		mediaHandler,
+		sysConfigHandler,
+		pluginHandler,
+		webhookHandler,
+		gitSyncHandler,
+		redirectHandler,
+		permalinkHandler,
+		taxonomyHandler,
+		a11yAuditHandler,
+		assetCDNHandler,
+		pageDataHandler,
+		themeLayoutHandler,
+		faviconHandler,
+		sqliteBackupHandler,
+		selfUpdateHandler,
+		adminEventHandler,
+		diagnosticsHandler,
+		mailHandler,
+		trashHandler,
 	)
 
 	// --- Phase 8: 配置 Gin 引擎 ---
@@ -585,13 +845,35 @@ func NewApp(content embed.FS) (*App, func(), error) {
 		log.Println("运行模式: Release (Gin 启动日志已禁用)")
 	}
 
-	engine := gin.Default()
-	err = engine.SetTrustedProxies([]string{"127.0.0.1", "::1", "10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"})
+	// 初始化错误上报服务（DSN 未配置时不会上报）
+	errorreport.Init(settingSvc, appVersion)
+
+	engine := gin.New()
+	engine.Use(gin.Logger(), middleware.Recovery())
+	// 可信代理同时作用于 Gin 的 ClientIP 和 util.GetRealClientIP，配置无效时回退到默认值而不是信任所有代理头
+	trustedProxies := util.ParseTrustedProxies(settingSvc.Get(constant.KeyTrustedProxies.String()))
+	if err := util.SetTrustedProxies(trustedProxies); err != nil {
+		log.Printf("警告: 可信代理配置无效，已回退到默认值: %v", err)
+		trustedProxies = util.DefaultTrustedProxies
+		_ = util.SetTrustedProxies(trustedProxies)
+	}
+	err = engine.SetTrustedProxies(trustedProxies)
 	if err != nil {
 		return nil, nil, fmt.Errorf("设置信任代理失败: %w", err)
 	}
 	engine.ForwardedByClientIP = true
 	engine.Use(middleware.Cors())
+	engine.Use(middleware.AccessLog(settingSvc))
+	engine.Use(middleware.Redirect(redirectSvc))
+	engine.Use(middleware.SiteVerification(settingSvc))
+	engine.Use(middleware.ReadOnly(cfg))
+	if cfg.GetBool(config.KeyReadOnly) {
+		log.Println("⚠️ 只读模式已开启，修改数据的接口将返回 503")
+	}
+	if demoSvc.Enabled() {
+		engine.Use(middleware.DemoReadOnly())
+		log.Println("⚠️ 演示模式已开启，修改数据的接口将被拒绝")
+	}
 
 	// 设置 SSR 主题检查器（基于数据库状态判断是否应该代理）
 	// 这样即使 SSR 进程还在运行，切换到普通主题后也不会代理
@@ -601,16 +883,36 @@ func NewApp(content embed.FS) (*App, func(), error) {
 		return themeSvc.GetCurrentSSRThemeName(ctx, 1)
 	})
 
+	// SSR 主题输出的页面同样注入自定义代码片段，并将第三方资源改写为本地地址
+	middleware.SetHTMLInjector(func(path string) (string, string) {
+		head, bodyEnd := snippet_service.Render(settingSvc, path)
+		head = siteverify_service.MetaHTML(settingSvc) + favicon_service.HeadHTML(settingSvc) + head
+		if demoSvc.Enabled() {
+			bodyEnd += demo_service.BannerHTML
+		}
+		return head, bodyEnd
+	})
+	middleware.SetHTMLRewriter(localizerSvc, assetCDNSvc)
+	router.SetResourceLocalizer(localizerSvc)
+	router.SetTaxonomyProvider(taxonomySvc)
+	router.SetPageDataResolver(pageDataSvc)
+	router.SetImagePlaceholderProvider(mediaSvc)
+	router.SetAssetCDN(assetCDNSvc)
+
 	// 注册 SSR 代理中间件（在路由之前）
 	// 当有 SSR 主题运行且数据库标记为当前主题时，前台请求会被代理到 SSR 主题
 	engine.Use(middleware.SSRProxyMiddleware(ssrManager))
 	log.Println("✅ SSR 代理中间件已注册（基于数据库状态判断）")
 
-	router.SetupFrontend(engine, settingSvc, articleSvc, cacheSvc, content, cfg, pageRepo)
+	router.SetupFrontend(engine, settingSvc, articleSvc, cacheSvc, eventBus, commentSvc, content, cfg, pageRepo)
 	appRouter.Setup(engine)
+	seoAuditSvc.SetHandler(engine)
+	a11yAuditSvc.SetHandler(engine)
+	themeSvc.SetCanaryHandler(engine)
 
 	// --- 微信分享路由 ---
-	setupWechatShareRoutes(engine, settingSvc)
+	jssdkService := setupWechatShareRoutes(engine, settingSvc, articleRepo)
+	setupWechatQRCodeRoutes(engine, settingSvc, articleRepo, jssdkService)
 
 	// 将所有初始化好的组件装配到 App 实例中
 	app := &App{
@@ -640,6 +942,14 @@ func NewApp(content embed.FS) (*App, func(), error) {
 		themeHandler:         themeHandler,
 		ssrManager:           ssrManager,
 		ssrThemeHandler:      ssrThemeHandler,
+		cacheWarmupSvc:       cacheWarmupSvc,
+		tlsManager:           tlsManager,
+		configBackupSvc:      configBackupSvc,
+		pluginManager:        pluginMgr,
+		instanceBackupSvc:    instanceBackupSvc,
+		sqliteBackupSvc:      sqliteBackupSvc,
+		selfUpdateSvc:        selfUpdateSvc,
+		clusterSyncer:        clusterSyncer,
 	}
 
 	// 创建cleanup函数
@@ -661,9 +971,27 @@ func NewApp(content embed.FS) (*App, func(), error) {
 		}
 	}
 
+	// 自更新重启前与正常退出一样停止后台任务和 SSR 进程，避免新进程启动时端口被占用
+	selfUpdateSvc.SetBeforeRestart(func() {
+		app.Stop()
+		cleanup()
+	})
+
 	return app, cleanup, nil
 }
 
+// FrontendRouteRegistrar 插件注册前台路由的组件，见 RegisterFrontendRoutes
+type FrontendRouteRegistrar = router.RouteRegistrar
+
+// FrontendRouteRegistrarFunc 将普通函数适配为 FrontendRouteRegistrar
+type FrontendRouteRegistrarFunc = router.RouteRegistrarFunc
+
+// RegisterFrontendRoutes 注册插件的前台路由，须在 NewApp 之前调用。
+// 插件路由在内置前端路由之后、SPA 回退之前注册，路径不能与内置路由冲突
+func RegisterFrontendRoutes(r FrontendRouteRegistrar) {
+	router.RegisterFrontendExtension(r)
+}
+
 func (a *App) Config() *config.Config {
 	return a.cfg
 }
@@ -761,6 +1089,11 @@ func (a *App) ThemeService() theme.ThemeService {
 	return a.themeSvc
 }
 
+// PluginManager 返回插件管理器
+func (a *App) PluginManager() *plugin.Manager {
+	return a.pluginManager
+}
+
 // SSRManager 返回 SSR 主题管理器（用于 PRO 版继承 SSR 功能）
 func (a *App) SSRManager() *ssr.Manager {
 	return a.ssrManager
@@ -776,7 +1109,28 @@ func (a *App) ThemeHandler() *theme_handler.Handler {
 	return a.themeHandler
 }
 
+// CacheWarmupService 返回缓存清除与预热服务（供命令行子命令使用）
+func (a *App) CacheWarmupService() *cache.WarmupService {
+	return a.cacheWarmupSvc
+}
+
+// ConfigBackupService 返回配置备份服务（供命令行子命令使用）
+func (a *App) ConfigBackupService() config_service.BackupService {
+	return a.configBackupSvc
+}
+
+// InstanceBackupService 返回整站备份服务（供命令行子命令使用）
+func (a *App) InstanceBackupService() *instancebackup_service.Service {
+	return a.instanceBackupSvc
+}
+
+// SQLiteBackupService 返回 SQLite 在线备份服务（供命令行子命令使用）
+func (a *App) SQLiteBackupService() *sqlitebackup_service.Service {
+	return a.sqliteBackupSvc
+}
+
 func (a *App) Run() error {
+	watchReloadSignal(a.cfg)
 	a.taskBroker.RegisterCronJobs()
 	a.taskBroker.CheckAndRunMissedAggregation()
 	a.taskBroker.Start()
@@ -784,9 +1138,65 @@ func (a *App) Run() error {
 	if port == "" {
 		port = "8091"
 	}
-	fmt.Printf("应用程序启动成功，正在监听端口: %s\n", port)
+	listeners, err := server_listener.Listen(server_listener.Config{
+		Port:       port,
+		SocketPath: a.cfg.GetString(config.KeyServerSocket),
+		SocketMode: server_listener.ParseSocketMode(a.cfg.GetString(config.KeyServerSocketMode)),
+	})
+	if err != nil {
+		return fmt.Errorf("创建监听失败: %w", err)
+	}
+	fmt.Printf("应用程序启动成功，正在监听%s\n", listeners.Description)
+	a.selfUpdateSvc.WatchHealth(a.healthCheck)
+
+	if a.tlsManager.Enabled() {
+		// HTTP 监听负责 ACME HTTP-01 验证和跳转 HTTPS，HTTPS 监听提供服务；
+		// systemd 传入了第二个套接字时将其用于 HTTPS
+		var httpsListener net.Listener
+		if len(listeners.Extra) > 0 {
+			httpsListener = listeners.Extra[0]
+		}
+		errCh := make(chan error, 2)
+		go func() { errCh <- a.tlsManager.Serve(httpsListener, a.engine) }()
+		go func() { errCh <- http.Serve(listeners.Primary, a.tlsManager.HTTPHandler(a.engine)) }()
+		return <-errCh
+	}
+
+	return a.engine.RunListener(listeners.Primary)
+}
+
+// healthCheck 自更新后的健康检查：数据库可用，且接口能正常响应
+func (a *App) healthCheck(ctx context.Context) error {
+	if err := a.sqlDB.PingContext(ctx); err != nil {
+		return fmt.Errorf("数据库不可用: %w", err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/api/version", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+	a.engine.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		return fmt.Errorf("版本接口返回 HTTP %d", rec.Code)
+	}
+	return nil
+}
 
-	return a.engine.Run(":" + port)
+// newTLSManager 根据配置文件创建内置 HTTPS 证书管理器
+func newTLSManager(cfg *config.Config) *autotls.Manager {
+	redirect := true
+	if cfg.GetString(config.KeyTLSRedirect) != "" {
+		redirect = cfg.GetBool(config.KeyTLSRedirect)
+	}
+	manager := autotls.NewManager(autotls.Config{
+		Enable:    cfg.GetBool(config.KeyTLSEnable),
+		Domains:   autotls.ParseDomains(cfg.GetString(config.KeyTLSDomains)),
+		Email:     cfg.GetString(config.KeyTLSEmail),
+		CacheDir:  cfg.GetString(config.KeyTLSCacheDir),
+		HTTPSPort: cfg.GetString(config.KeyTLSHTTPSPort),
+		Redirect:  redirect,
+	})
+	if cfg.GetBool(config.KeyTLSEnable) && !manager.Enabled() {
+		log.Println("警告: 已开启内置 HTTPS 但未配置 TLS.Domains，将仅使用 HTTP 提供服务")
+	}
+	return manager
 }
 
 func (a *App) Stop() {
@@ -794,6 +1204,12 @@ func (a *App) Stop() {
 		a.taskBroker.Stop()
 		log.Println("任务调度器已停止。")
 	}
+	if a.pluginManager != nil {
+		a.pluginManager.Stop()
+	}
+	if a.clusterSyncer != nil {
+		a.clusterSyncer.Stop()
+	}
 }
 
 // getOrCreateIDSeed 从数据库获取或创建 IDSeed
@@ -853,8 +1269,8 @@ func getOrCreateIDSeed(ctx context.Context, settingRepo repository.SettingReposi
 	return newSeed, nil
 }
 
-// setupWechatShareRoutes 设置微信分享相关路由
-func setupWechatShareRoutes(engine *gin.Engine, settingSvc setting.SettingService) {
+// setupWechatShareRoutes 设置微信分享相关路由，返回的 JSSDKService 未启用时为 nil
+func setupWechatShareRoutes(engine *gin.Engine, settingSvc setting.SettingService, articleRepo repository.ArticleRepository) *wechat_service.JSSDKService {
 	// 获取微信分享配置
 	wechatEnable := settingSvc.Get(constant.KeyWechatShareEnable.String())
 	wechatAppID := settingSvc.Get(constant.KeyWechatShareAppID.String())
@@ -863,21 +1279,60 @@ func setupWechatShareRoutes(engine *gin.Engine, settingSvc setting.SettingServic
 	// 如果未启用或配置不完整，跳过初始化
 	if wechatEnable != "true" || wechatAppID == "" || wechatAppSecret == "" {
 		log.Println("⚠️ 微信分享功能未启用或配置不完整，跳过初始化")
-		return
+		return nil
 	}
 
 	log.Println("🔧 初始化微信JS-SDK分享服务...")
 
 	// 创建微信分享服务
 	jssdkService := wechat_service.NewJSSDKService(wechatAppID, wechatAppSecret)
-	wechatShareHandler := wechat_handler.NewHandler(jssdkService)
+	shareService := wechat_service.NewShareService(jssdkService, articleRepo, settingSvc)
+	wechatShareHandler := wechat_handler.NewHandler(jssdkService, shareService)
 
 	// 注册路由
 	wechatGroup := engine.Group("/api/wechat/jssdk")
 	{
-		wechatGroup.GET("/config", wechatShareHandler.GetJSSDKConfig)    // 获取JS-SDK配置
-		wechatGroup.GET("/status", wechatShareHandler.CheckShareEnabled) // 检查分享功能状态
+		wechatGroup.GET("/config", wechatShareHandler.GetJSSDKConfig)               // 获取JS-SDK配置
+		wechatGroup.GET("/status", wechatShareHandler.CheckShareEnabled)            // 检查分享功能状态
+		wechatGroup.GET("/article-share", wechatShareHandler.GetArticleShareConfig) // 获取文章分享配置（签名 + 分享内容）
 	}
 
 	log.Println("✅ 微信JS-SDK分享服务已启动")
+	return jssdkService
+}
+
+// setupWechatQRCodeRoutes 设置文章微信二维码路由
+// 公众号二维码复用微信分享的 JSSDKService，避免同一 AppID 的 access_token 被两处交替刷新而失效
+func setupWechatQRCodeRoutes(engine *gin.Engine, settingSvc setting.SettingService, articleRepo repository.ArticleRepository, shareService *wechat_service.JSSDKService) {
+	if settingSvc.Get(constant.KeyWechatQRCodeEnable.String()) != "true" {
+		log.Println("⚠️ 文章微信二维码功能未启用，跳过初始化")
+		return
+	}
+
+	codeType := settingSvc.Get(constant.KeyWechatQRCodeType.String())
+	tokenSource := shareService
+	if codeType != wechat_service.QRCodeTypeOfficial {
+		appID := settingSvc.Get(constant.KeyWechatMiniProgramAppID.String())
+		appSecret := settingSvc.Get(constant.KeyWechatMiniProgramAppSecret.String())
+		if appID != "" && appSecret != "" {
+			tokenSource = wechat_service.NewJSSDKService(appID, appSecret)
+		} else {
+			tokenSource = nil
+		}
+	}
+	if tokenSource == nil {
+		log.Println("⚠️ 文章微信二维码配置不完整（公众号二维码需启用微信分享，小程序码需配置小程序凭证），跳过初始化")
+		return
+	}
+
+	qrcodeService := wechat_service.NewQRCodeService(
+		codeType,
+		settingSvc.Get(constant.KeyWechatMiniProgramArticlePage.String()),
+		tokenSource,
+	)
+	qrcodeHandler := wechat_handler.NewQRCodeHandler(qrcodeService, articleRepo)
+
+	engine.GET("/api/public/wechat/qrcode", qrcodeHandler.GetArticleQRCode) // 获取文章微信二维码
+
+	log.Println("✅ 文章微信二维码服务已启动")
 }
@@ -0,0 +1,130 @@
+/*
+ * @Description: 备份命令行子命令
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 用法：
+ *   anheyu backup run --description "升级前备份"
+ *   anheyu backup list
+ *   anheyu backup instance --description "迁移前备份"
+ *   anheyu backup instances
+ *   anheyu backup validate --file instance-20261015-033000.tar.gz
+ *   anheyu backup restore --file /path/to/instance-20261015-033000.tar.gz.enc --passphrase xxx --yes
+ *   anheyu backup sqlite
+ *   anheyu backup sqlites
+ *
+ * run/list 只备份配置文件；instance 备份数据库、data 目录和本机上传文件；
+ * sqlite 通过 SQLite 在线备份接口生成数据库快照，服务运行中也可以执行。
+ * restore 会覆盖当前数据，请先停止服务，恢复完成后再启动。
+ */
+package server
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+)
+
+// RunBackupCommand 执行 backup 子命令
+func RunBackupCommand(app *App, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("用法: backup <run|list|instance|instances|validate|restore|sqlite|sqlites> [选项]")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	switch args[0] {
+	case "run":
+		fs := flag.NewFlagSet("backup run", flag.ContinueOnError)
+		description := fs.String("description", "命令行手动备份", "备份描述")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		info, err := app.ConfigBackupService().CreateBackup(ctx, *description, false)
+		if err != nil {
+			return err
+		}
+		return printCLIResult(info)
+
+	case "list":
+		backups, err := app.ConfigBackupService().ListBackups(ctx)
+		if err != nil {
+			return err
+		}
+		return printCLIResult(backups)
+
+	case "instance":
+		fs := flag.NewFlagSet("backup instance", flag.ContinueOnError)
+		description := fs.String("description", "命令行手动备份", "备份描述")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		info, err := app.InstanceBackupService().Create(context.Background(), *description)
+		if err != nil {
+			return err
+		}
+		return printCLIResult(info)
+
+	case "instances":
+		backups, err := app.InstanceBackupService().List()
+		if err != nil {
+			return err
+		}
+		return printCLIResult(backups)
+
+	case "validate", "restore":
+		fs := flag.NewFlagSet("backup "+args[0], flag.ContinueOnError)
+		file := fs.String("file", "", "备份文件名（data/backups 下）或完整路径")
+		passphrase := fs.String("passphrase", "", "加密备份的口令，为空时使用当前配置的口令")
+		yes := fs.Bool("yes", false, "确认覆盖当前数据（仅 restore）")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		if *file == "" {
+			return fmt.Errorf("请通过 --file 指定备份文件")
+		}
+
+		svc := app.InstanceBackupService()
+		path := *file
+		if p, err := svc.Path(path); err == nil {
+			path = p
+		}
+
+		if args[0] == "validate" {
+			manifest, err := svc.Validate(context.Background(), path, *passphrase)
+			if err != nil {
+				return err
+			}
+			return printCLIResult(manifest)
+		}
+
+		if !*yes {
+			return fmt.Errorf("恢复会覆盖当前数据库和 data 目录，请先停止服务，确认后添加 --yes 参数")
+		}
+		manifest, err := svc.Restore(context.Background(), path, *passphrase)
+		if err != nil {
+			return err
+		}
+		fmt.Println("恢复完成，请重新启动服务")
+		return printCLIResult(manifest)
+
+	case "sqlite":
+		info, err := app.SQLiteBackupService().Create(ctx)
+		if err != nil {
+			return err
+		}
+		return printCLIResult(info)
+
+	case "sqlites":
+		backups, err := app.SQLiteBackupService().List()
+		if err != nil {
+			return err
+		}
+		return printCLIResult(backups)
+
+	default:
+		return fmt.Errorf("未知的 backup 子命令: %s（可用: run, list, instance, instances, validate, restore, sqlite, sqlites）", args[0])
+	}
+}
@@ -0,0 +1,103 @@
+/*
+ * @Description: 缓存管理命令行子命令
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 用法：
+ *   anheyu cache purge --path /posts/hello --path /about --tag article-list
+ *   anheyu cache purge --all
+ *   anheyu cache warm --sitemap --url /archives --concurrency 4
+ */
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/service/cache"
+)
+
+// stringSliceFlag 支持重复传入的字符串参数
+type stringSliceFlag []string
+
+func (s *stringSliceFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringSliceFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*s = append(*s, v)
+		}
+	}
+	return nil
+}
+
+// RunCacheCommand 执行 cache 子命令
+func RunCacheCommand(app *App, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("用法: cache <purge|warm> [选项]")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	switch args[0] {
+	case "purge":
+		fs := flag.NewFlagSet("cache purge", flag.ContinueOnError)
+		var paths, tags stringSliceFlag
+		fs.Var(&paths, "path", "要清除的站内路径或完整 URL，可重复指定")
+		fs.Var(&tags, "tag", "要清除的缓存标签，可重复指定")
+		all := fs.Bool("all", false, "按站点根目录清除全部 CDN 缓存，SSR 模式下同时清除前端的全部缓存")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+
+		result, err := app.CacheWarmupService().Purge(ctx, &cache.PurgeRequest{
+			Paths: paths,
+			Tags:  tags,
+			All:   *all,
+		})
+		if err != nil {
+			return err
+		}
+		return printCLIResult(result)
+
+	case "warm":
+		fs := flag.NewFlagSet("cache warm", flag.ContinueOnError)
+		var urls stringSliceFlag
+		fs.Var(&urls, "url", "要预热的站内路径或完整 URL，可重复指定")
+		useSitemap := fs.Bool("sitemap", false, "预热站点地图中的全部 URL")
+		concurrency := fs.Int("concurrency", 0, "并发请求数（默认 4，最大 16）")
+		limit := fs.Int("limit", 0, "最多预热的 URL 数量（默认 500）")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+
+		result, err := app.CacheWarmupService().Warm(ctx, &cache.WarmRequest{
+			URLs:        urls,
+			UseSitemap:  *useSitemap,
+			Concurrency: *concurrency,
+			Limit:       *limit,
+		})
+		if err != nil {
+			return err
+		}
+		return printCLIResult(result)
+
+	default:
+		return fmt.Errorf("未知的 cache 子命令: %s（可用: purge, warm）", args[0])
+	}
+}
+
+// printCLIResult 以 JSON 格式输出命令执行结果
+func printCLIResult(v interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
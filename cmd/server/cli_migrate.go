@@ -0,0 +1,96 @@
+/*
+ * @Description: 数据库迁移命令行子命令
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 用法：
+ *   anheyu migrate plan      预览升级后启动时将执行的表结构变更，不修改数据库
+ *   anheyu migrate history   查看已应用的迁移记录
+ *   anheyu migrate apply     导出快照后执行迁移，效果与正常启动时相同
+ *
+ * 与其他子命令不同，migrate 只连接数据库而不初始化整个应用，否则启动过程会先执行迁移。
+ */
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/persistence/database"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+)
+
+// migratePlan migrate plan 的输出
+type migratePlan struct {
+	AppVersion    string   `json:"app_version"`
+	SchemaVersion string   `json:"schema_version"`
+	Statements    []string `json:"statements"`
+	Downgrade     string   `json:"downgrade,omitempty"`
+}
+
+// RunMigrateCommand 执行 migrate 子命令
+func RunMigrateCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("用法: migrate <plan|history|apply>")
+	}
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+	sqlDB, err := database.NewSQLDB(cfg)
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+	client, err := database.OpenEntClient(sqlDB, cfg)
+	if err != nil {
+		return err
+	}
+	manager := database.NewMigrationManager(sqlDB, client, cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	switch args[0] {
+	case "plan":
+		statements, err := manager.Plan(ctx)
+		if err != nil {
+			return err
+		}
+		plan := migratePlan{
+			AppVersion:    version.GetVersion(),
+			SchemaVersion: database.SchemaVersion(),
+			Statements:    statements,
+		}
+		if err := manager.CheckDowngrade(ctx); err != nil {
+			plan.Downgrade = err.Error()
+		}
+		return printCLIResult(plan)
+
+	case "history":
+		history, err := manager.History(ctx)
+		if err != nil {
+			return err
+		}
+		return printCLIResult(history)
+
+	case "apply":
+		if err := manager.Apply(ctx); err != nil {
+			return err
+		}
+		history, err := manager.History(ctx)
+		if err != nil {
+			return err
+		}
+		if len(history) > 0 {
+			return printCLIResult(history[0])
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("未知的 migrate 子命令: %s", args[0])
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * @Description: 主题管理命令行子命令
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 用法：
+ *   anheyu theme list
+ *   anheyu theme switch <主题名>       # 主题名为 official 时切换回官方主题
+ *   anheyu theme install --name theme-foo --url https://example.com/theme-foo.zip --checksum <sha256>
+ *
+ * 后台因主题异常无法打开时，可以用 theme switch official 恢复。
+ */
+package server
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
+)
+
+// cliAdminUserID 命令行操作使用的管理员用户 ID，与 SSR 主题检查保持一致
+const cliAdminUserID = 1
+
+// cliThemeItem 主题列表的输出格式
+type cliThemeItem struct {
+	Name        string `json:"name"`
+	Version     string `json:"version,omitempty"`
+	Installed   string `json:"installed_version,omitempty"`
+	ThemeType   string `json:"theme_type,omitempty"`
+	IsCurrent   bool   `json:"is_current"`
+	Description string `json:"description,omitempty"`
+}
+
+// RunThemeCommand 执行 theme 子命令
+func RunThemeCommand(app *App, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("用法: theme <list|switch|install> [选项]")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	switch args[0] {
+	case "list":
+		themes, err := app.ThemeService().GetInstalledThemes(ctx, cliAdminUserID)
+		if err != nil {
+			return err
+		}
+		items := make([]cliThemeItem, 0, len(themes))
+		for _, t := range themes {
+			items = append(items, cliThemeItem{
+				Name:        t.Name,
+				Version:     t.Version,
+				Installed:   t.InstalledVersion,
+				ThemeType:   t.ThemeType,
+				IsCurrent:   t.IsCurrent,
+				Description: t.Description,
+			})
+		}
+		return printCLIResult(items)
+
+	case "switch":
+		if len(args) < 2 || args[1] == "" {
+			return fmt.Errorf("用法: theme switch <主题名|official>")
+		}
+		themeName := args[1]
+		if themeName == "official" {
+			if err := app.ThemeService().SwitchToOfficial(ctx, cliAdminUserID, app.SSRManager()); err != nil {
+				return err
+			}
+		} else if err := app.ThemeService().SwitchToTheme(ctx, cliAdminUserID, themeName, app.SSRManager()); err != nil {
+			return err
+		}
+		fmt.Printf("已切换到主题: %s\n", themeName)
+		return nil
+
+	case "install":
+		fs := flag.NewFlagSet("theme install", flag.ContinueOnError)
+		name := fs.String("name", "", "主题名称")
+		downloadURL := fs.String("url", "", "主题包下载地址")
+		version := fs.String("version", "", "主题版本")
+		checksum := fs.String("checksum", "", "主题包 SHA-256，提供时校验下载内容")
+		marketID := fs.Int("market-id", 0, "主题商城 ID（可选）")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		if *name == "" || *downloadURL == "" {
+			return fmt.Errorf("用法: theme install --name <主题名> --url <下载地址> [--version v] [--checksum sha256]")
+		}
+
+		err := app.ThemeService().InstallTheme(ctx, cliAdminUserID, &theme.ThemeInstallRequest{
+			MarketID:    *marketID,
+			ThemeName:   *name,
+			DownloadURL: *downloadURL,
+			Version:     *version,
+			Checksum:    *checksum,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("主题安装成功: %s\n", *name)
+		return nil
+
+	default:
+		return fmt.Errorf("未知的 theme 子命令: %s（可用: list, switch, install）", args[0])
+	}
+}
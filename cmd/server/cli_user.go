@@ -0,0 +1,71 @@
+/*
+ * @Description: 用户管理命令行子命令
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 用法：
+ *   anheyu user reset-password --username admin --password 'new-password'
+ *   anheyu user reset-password --username admin        # 不指定密码时生成随机密码并输出
+ */
+package server
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
+)
+
+// RunUserCommand 执行 user 子命令
+func RunUserCommand(app *App, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("用法: user <reset-password> [选项]")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	switch args[0] {
+	case "reset-password":
+		fs := flag.NewFlagSet("user reset-password", flag.ContinueOnError)
+		username := fs.String("username", "", "用户名")
+		password := fs.String("password", "", "新密码，留空时生成随机密码")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		if *username == "" {
+			return fmt.Errorf("用法: user reset-password --username <用户名> [--password <新密码>]")
+		}
+
+		user, err := app.UserService().GetUserInfoByUsername(ctx, *username)
+		if err != nil {
+			return fmt.Errorf("查询用户失败: %w", err)
+		}
+		if user == nil {
+			return fmt.Errorf("用户不存在: %s", *username)
+		}
+
+		newPassword := *password
+		generated := newPassword == ""
+		if generated {
+			if newPassword, err = utils.GenerateRandomString(16); err != nil {
+				return fmt.Errorf("生成随机密码失败: %w", err)
+			}
+		}
+
+		if err := app.UserService().AdminResetPassword(ctx, user.ID, newPassword); err != nil {
+			return err
+		}
+		if generated {
+			fmt.Printf("用户 %s 的密码已重置为: %s\n", *username, newPassword)
+		} else {
+			fmt.Printf("用户 %s 的密码已重置\n", *username)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("未知的 user 子命令: %s（可用: reset-password）", args[0])
+	}
+}
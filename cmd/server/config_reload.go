@@ -0,0 +1,50 @@
+/*
+ * @Description: 配置文件热重载，收到 SIGHUP 信号或调用后台接口时重新读取 data/conf.ini
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 日志级别、内存缓存清理间隔和只读模式立即生效；监听地址、数据库、Redis、TLS 和 Debug 模式等
+ * 在启动时已经使用的配置即使发生变化也保持当前值，需要重启后生效。
+ */
+package server
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// defaultCacheCleanupInterval 未配置 Cache.CleanupInterval 时内存缓存的清理间隔
+const defaultCacheCleanupInterval = time.Minute
+
+// applyCacheConfig 将配置文件中的缓存策略应用到缓存服务，Redis 缓存由 Redis 自行处理过期，无需设置
+func applyCacheConfig(cfg *config.Config, cacheSvc utility.CacheService) {
+	setter, ok := cacheSvc.(interface{ SetCleanupInterval(time.Duration) })
+	if !ok {
+		return
+	}
+	interval := defaultCacheCleanupInterval
+	if seconds := cfg.GetInt(config.KeyCacheCleanupInterval); seconds > 0 {
+		interval = time.Duration(seconds) * time.Second
+	}
+	setter.SetCleanupInterval(interval)
+}
+
+// watchReloadSignal 收到 SIGHUP 时重新加载配置文件
+func watchReloadSignal(cfg *config.Config) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	go func() {
+		for range ch {
+			log.Println("收到 SIGHUP 信号，重新加载配置文件...")
+			if _, err := cfg.Reload(); err != nil {
+				log.Printf("重新加载配置文件失败，继续使用当前配置: %v", err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,1122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/authsession"
+	"github.com/anzhiyu-c/anheyu-app/ent/user"
+)
+
+// AuthSessionCreate is the builder for creating a AuthSession entity.
+type AuthSessionCreate struct {
+	config
+	mutation *AuthSessionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AuthSessionCreate) SetUserID(v uint) *AuthSessionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDevice sets the "device" field.
+func (_c *AuthSessionCreate) SetDevice(v string) *AuthSessionCreate {
+	_c.mutation.SetDevice(v)
+	return _c
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_c *AuthSessionCreate) SetNillableDevice(v *string) *AuthSessionCreate {
+	if v != nil {
+		_c.SetDevice(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *AuthSessionCreate) SetIP(v string) *AuthSessionCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *AuthSessionCreate) SetNillableIP(v *string) *AuthSessionCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetRememberMe sets the "remember_me" field.
+func (_c *AuthSessionCreate) SetRememberMe(v bool) *AuthSessionCreate {
+	_c.mutation.SetRememberMe(v)
+	return _c
+}
+
+// SetNillableRememberMe sets the "remember_me" field if the given value is not nil.
+func (_c *AuthSessionCreate) SetNillableRememberMe(v *bool) *AuthSessionCreate {
+	if v != nil {
+		_c.SetRememberMe(*v)
+	}
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *AuthSessionCreate) SetTokenHash(v string) *AuthSessionCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetPrevTokenHash sets the "prev_token_hash" field.
+func (_c *AuthSessionCreate) SetPrevTokenHash(v string) *AuthSessionCreate {
+	_c.mutation.SetPrevTokenHash(v)
+	return _c
+}
+
+// SetNillablePrevTokenHash sets the "prev_token_hash" field if the given value is not nil.
+func (_c *AuthSessionCreate) SetNillablePrevTokenHash(v *string) *AuthSessionCreate {
+	if v != nil {
+		_c.SetPrevTokenHash(*v)
+	}
+	return _c
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (_c *AuthSessionCreate) SetRotatedAt(v time.Time) *AuthSessionCreate {
+	_c.mutation.SetRotatedAt(v)
+	return _c
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_c *AuthSessionCreate) SetNillableRotatedAt(v *time.Time) *AuthSessionCreate {
+	if v != nil {
+		_c.SetRotatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuthSessionCreate) SetCreatedAt(v time.Time) *AuthSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuthSessionCreate) SetNillableCreatedAt(v *time.Time) *AuthSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *AuthSessionCreate) SetLastUsedAt(v time.Time) *AuthSessionCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *AuthSessionCreate) SetNillableLastUsedAt(v *time.Time) *AuthSessionCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AuthSessionCreate) SetExpiresAt(v time.Time) *AuthSessionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AuthSessionCreate) SetID(v string) *AuthSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *AuthSessionCreate) SetUser(v *User) *AuthSessionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the AuthSessionMutation object of the builder.
+func (_c *AuthSessionCreate) Mutation() *AuthSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the AuthSession in the database.
+func (_c *AuthSessionCreate) Save(ctx context.Context) (*AuthSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuthSessionCreate) SaveX(ctx context.Context) *AuthSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuthSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuthSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuthSessionCreate) defaults() {
+	if _, ok := _c.mutation.RememberMe(); !ok {
+		v := authsession.DefaultRememberMe
+		_c.mutation.SetRememberMe(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := authsession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.LastUsedAt(); !ok {
+		v := authsession.DefaultLastUsedAt()
+		_c.mutation.SetLastUsedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuthSessionCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AuthSession.user_id"`)}
+	}
+	if v, ok := _c.mutation.Device(); ok {
+		if err := authsession.DeviceValidator(v); err != nil {
+			return &ValidationError{Name: "device", err: fmt.Errorf(`ent: validator failed for field "AuthSession.device": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.IP(); ok {
+		if err := authsession.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AuthSession.ip": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RememberMe(); !ok {
+		return &ValidationError{Name: "remember_me", err: errors.New(`ent: missing required field "AuthSession.remember_me"`)}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "AuthSession.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := authsession.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "AuthSession.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PrevTokenHash(); ok {
+		if err := authsession.PrevTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "prev_token_hash", err: fmt.Errorf(`ent: validator failed for field "AuthSession.prev_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuthSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.LastUsedAt(); !ok {
+		return &ValidationError{Name: "last_used_at", err: errors.New(`ent: missing required field "AuthSession.last_used_at"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "AuthSession.expires_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := authsession.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AuthSession.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AuthSession.user"`)}
+	}
+	return nil
+}
+
+func (_c *AuthSessionCreate) sqlSave(ctx context.Context) (*AuthSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AuthSession.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuthSessionCreate) createSpec() (*AuthSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuthSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(authsession.Table, sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Device(); ok {
+		_spec.SetField(authsession.FieldDevice, field.TypeString, value)
+		_node.Device = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(authsession.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.RememberMe(); ok {
+		_spec.SetField(authsession.FieldRememberMe, field.TypeBool, value)
+		_node.RememberMe = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(authsession.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.PrevTokenHash(); ok {
+		_spec.SetField(authsession.FieldPrevTokenHash, field.TypeString, value)
+		_node.PrevTokenHash = value
+	}
+	if value, ok := _c.mutation.RotatedAt(); ok {
+		_spec.SetField(authsession.FieldRotatedAt, field.TypeTime, value)
+		_node.RotatedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(authsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(authsession.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(authsession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authsession.UserTable,
+			Columns: []string{authsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuthSession.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuthSessionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuthSessionCreate) OnConflict(opts ...sql.ConflictOption) *AuthSessionUpsertOne {
+	_c.conflict = opts
+	return &AuthSessionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuthSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuthSessionCreate) OnConflictColumns(columns ...string) *AuthSessionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuthSessionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AuthSessionUpsertOne is the builder for "upsert"-ing
+	//  one AuthSession node.
+	AuthSessionUpsertOne struct {
+		create *AuthSessionCreate
+	}
+
+	// AuthSessionUpsert is the "OnConflict" setter.
+	AuthSessionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *AuthSessionUpsert) SetUserID(v uint) *AuthSessionUpsert {
+	u.Set(authsession.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AuthSessionUpsert) UpdateUserID() *AuthSessionUpsert {
+	u.SetExcluded(authsession.FieldUserID)
+	return u
+}
+
+// SetDevice sets the "device" field.
+func (u *AuthSessionUpsert) SetDevice(v string) *AuthSessionUpsert {
+	u.Set(authsession.FieldDevice, v)
+	return u
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *AuthSessionUpsert) UpdateDevice() *AuthSessionUpsert {
+	u.SetExcluded(authsession.FieldDevice)
+	return u
+}
+
+// ClearDevice clears the value of the "device" field.
+func (u *AuthSessionUpsert) ClearDevice() *AuthSessionUpsert {
+	u.SetNull(authsession.FieldDevice)
+	return u
+}
+
+// SetIP sets the "ip" field.
+func (u *AuthSessionUpsert) SetIP(v string) *AuthSessionUpsert {
+	u.Set(authsession.FieldIP, v)
+	return u
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *AuthSessionUpsert) UpdateIP() *AuthSessionUpsert {
+	u.SetExcluded(authsession.FieldIP)
+	return u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *AuthSessionUpsert) ClearIP() *AuthSessionUpsert {
+	u.SetNull(authsession.FieldIP)
+	return u
+}
+
+// SetRememberMe sets the "remember_me" field.
+func (u *AuthSessionUpsert) SetRememberMe(v bool) *AuthSessionUpsert {
+	u.Set(authsession.FieldRememberMe, v)
+	return u
+}
+
+// UpdateRememberMe sets the "remember_me" field to the value that was provided on create.
+func (u *AuthSessionUpsert) UpdateRememberMe() *AuthSessionUpsert {
+	u.SetExcluded(authsession.FieldRememberMe)
+	return u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *AuthSessionUpsert) SetTokenHash(v string) *AuthSessionUpsert {
+	u.Set(authsession.FieldTokenHash, v)
+	return u
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *AuthSessionUpsert) UpdateTokenHash() *AuthSessionUpsert {
+	u.SetExcluded(authsession.FieldTokenHash)
+	return u
+}
+
+// SetPrevTokenHash sets the "prev_token_hash" field.
+func (u *AuthSessionUpsert) SetPrevTokenHash(v string) *AuthSessionUpsert {
+	u.Set(authsession.FieldPrevTokenHash, v)
+	return u
+}
+
+// UpdatePrevTokenHash sets the "prev_token_hash" field to the value that was provided on create.
+func (u *AuthSessionUpsert) UpdatePrevTokenHash() *AuthSessionUpsert {
+	u.SetExcluded(authsession.FieldPrevTokenHash)
+	return u
+}
+
+// ClearPrevTokenHash clears the value of the "prev_token_hash" field.
+func (u *AuthSessionUpsert) ClearPrevTokenHash() *AuthSessionUpsert {
+	u.SetNull(authsession.FieldPrevTokenHash)
+	return u
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (u *AuthSessionUpsert) SetRotatedAt(v time.Time) *AuthSessionUpsert {
+	u.Set(authsession.FieldRotatedAt, v)
+	return u
+}
+
+// UpdateRotatedAt sets the "rotated_at" field to the value that was provided on create.
+func (u *AuthSessionUpsert) UpdateRotatedAt() *AuthSessionUpsert {
+	u.SetExcluded(authsession.FieldRotatedAt)
+	return u
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (u *AuthSessionUpsert) ClearRotatedAt() *AuthSessionUpsert {
+	u.SetNull(authsession.FieldRotatedAt)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *AuthSessionUpsert) SetLastUsedAt(v time.Time) *AuthSessionUpsert {
+	u.Set(authsession.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *AuthSessionUpsert) UpdateLastUsedAt() *AuthSessionUpsert {
+	u.SetExcluded(authsession.FieldLastUsedAt)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AuthSessionUpsert) SetExpiresAt(v time.Time) *AuthSessionUpsert {
+	u.Set(authsession.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AuthSessionUpsert) UpdateExpiresAt() *AuthSessionUpsert {
+	u.SetExcluded(authsession.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuthSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(authsession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuthSessionUpsertOne) UpdateNewValues() *AuthSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(authsession.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(authsession.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuthSession.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuthSessionUpsertOne) Ignore() *AuthSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuthSessionUpsertOne) DoNothing() *AuthSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuthSessionCreate.OnConflict
+// documentation for more info.
+func (u *AuthSessionUpsertOne) Update(set func(*AuthSessionUpsert)) *AuthSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuthSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AuthSessionUpsertOne) SetUserID(v uint) *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AuthSessionUpsertOne) UpdateUserID() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDevice sets the "device" field.
+func (u *AuthSessionUpsertOne) SetDevice(v string) *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetDevice(v)
+	})
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *AuthSessionUpsertOne) UpdateDevice() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateDevice()
+	})
+}
+
+// ClearDevice clears the value of the "device" field.
+func (u *AuthSessionUpsertOne) ClearDevice() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.ClearDevice()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *AuthSessionUpsertOne) SetIP(v string) *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *AuthSessionUpsertOne) UpdateIP() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *AuthSessionUpsertOne) ClearIP() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetRememberMe sets the "remember_me" field.
+func (u *AuthSessionUpsertOne) SetRememberMe(v bool) *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetRememberMe(v)
+	})
+}
+
+// UpdateRememberMe sets the "remember_me" field to the value that was provided on create.
+func (u *AuthSessionUpsertOne) UpdateRememberMe() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateRememberMe()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *AuthSessionUpsertOne) SetTokenHash(v string) *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *AuthSessionUpsertOne) UpdateTokenHash() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetPrevTokenHash sets the "prev_token_hash" field.
+func (u *AuthSessionUpsertOne) SetPrevTokenHash(v string) *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetPrevTokenHash(v)
+	})
+}
+
+// UpdatePrevTokenHash sets the "prev_token_hash" field to the value that was provided on create.
+func (u *AuthSessionUpsertOne) UpdatePrevTokenHash() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdatePrevTokenHash()
+	})
+}
+
+// ClearPrevTokenHash clears the value of the "prev_token_hash" field.
+func (u *AuthSessionUpsertOne) ClearPrevTokenHash() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.ClearPrevTokenHash()
+	})
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (u *AuthSessionUpsertOne) SetRotatedAt(v time.Time) *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetRotatedAt(v)
+	})
+}
+
+// UpdateRotatedAt sets the "rotated_at" field to the value that was provided on create.
+func (u *AuthSessionUpsertOne) UpdateRotatedAt() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateRotatedAt()
+	})
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (u *AuthSessionUpsertOne) ClearRotatedAt() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.ClearRotatedAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *AuthSessionUpsertOne) SetLastUsedAt(v time.Time) *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *AuthSessionUpsertOne) UpdateLastUsedAt() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AuthSessionUpsertOne) SetExpiresAt(v time.Time) *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AuthSessionUpsertOne) UpdateExpiresAt() *AuthSessionUpsertOne {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AuthSessionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuthSessionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuthSessionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuthSessionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AuthSessionUpsertOne.ID is not supported by MySQL driver. Use AuthSessionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuthSessionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuthSessionCreateBulk is the builder for creating many AuthSession entities in bulk.
+type AuthSessionCreateBulk struct {
+	config
+	err      error
+	builders []*AuthSessionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuthSession entities in the database.
+func (_c *AuthSessionCreateBulk) Save(ctx context.Context) ([]*AuthSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuthSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuthSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuthSessionCreateBulk) SaveX(ctx context.Context) []*AuthSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuthSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuthSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuthSession.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuthSessionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuthSessionCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuthSessionUpsertBulk {
+	_c.conflict = opts
+	return &AuthSessionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuthSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuthSessionCreateBulk) OnConflictColumns(columns ...string) *AuthSessionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuthSessionUpsertBulk{
+		create: _c,
+	}
+}
+
+// AuthSessionUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuthSession nodes.
+type AuthSessionUpsertBulk struct {
+	create *AuthSessionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuthSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(authsession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuthSessionUpsertBulk) UpdateNewValues() *AuthSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(authsession.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(authsession.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuthSession.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuthSessionUpsertBulk) Ignore() *AuthSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuthSessionUpsertBulk) DoNothing() *AuthSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuthSessionCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuthSessionUpsertBulk) Update(set func(*AuthSessionUpsert)) *AuthSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuthSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AuthSessionUpsertBulk) SetUserID(v uint) *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AuthSessionUpsertBulk) UpdateUserID() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDevice sets the "device" field.
+func (u *AuthSessionUpsertBulk) SetDevice(v string) *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetDevice(v)
+	})
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *AuthSessionUpsertBulk) UpdateDevice() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateDevice()
+	})
+}
+
+// ClearDevice clears the value of the "device" field.
+func (u *AuthSessionUpsertBulk) ClearDevice() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.ClearDevice()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *AuthSessionUpsertBulk) SetIP(v string) *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *AuthSessionUpsertBulk) UpdateIP() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *AuthSessionUpsertBulk) ClearIP() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetRememberMe sets the "remember_me" field.
+func (u *AuthSessionUpsertBulk) SetRememberMe(v bool) *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetRememberMe(v)
+	})
+}
+
+// UpdateRememberMe sets the "remember_me" field to the value that was provided on create.
+func (u *AuthSessionUpsertBulk) UpdateRememberMe() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateRememberMe()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *AuthSessionUpsertBulk) SetTokenHash(v string) *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *AuthSessionUpsertBulk) UpdateTokenHash() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetPrevTokenHash sets the "prev_token_hash" field.
+func (u *AuthSessionUpsertBulk) SetPrevTokenHash(v string) *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetPrevTokenHash(v)
+	})
+}
+
+// UpdatePrevTokenHash sets the "prev_token_hash" field to the value that was provided on create.
+func (u *AuthSessionUpsertBulk) UpdatePrevTokenHash() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdatePrevTokenHash()
+	})
+}
+
+// ClearPrevTokenHash clears the value of the "prev_token_hash" field.
+func (u *AuthSessionUpsertBulk) ClearPrevTokenHash() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.ClearPrevTokenHash()
+	})
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (u *AuthSessionUpsertBulk) SetRotatedAt(v time.Time) *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetRotatedAt(v)
+	})
+}
+
+// UpdateRotatedAt sets the "rotated_at" field to the value that was provided on create.
+func (u *AuthSessionUpsertBulk) UpdateRotatedAt() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateRotatedAt()
+	})
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (u *AuthSessionUpsertBulk) ClearRotatedAt() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.ClearRotatedAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *AuthSessionUpsertBulk) SetLastUsedAt(v time.Time) *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *AuthSessionUpsertBulk) UpdateLastUsedAt() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AuthSessionUpsertBulk) SetExpiresAt(v time.Time) *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AuthSessionUpsertBulk) UpdateExpiresAt() *AuthSessionUpsertBulk {
+	return u.Update(func(s *AuthSessionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AuthSessionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuthSessionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuthSessionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuthSessionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,707 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/authsession"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/user"
+)
+
+// AuthSessionUpdate is the builder for updating AuthSession entities.
+type AuthSessionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuthSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuthSessionUpdate builder.
+func (_u *AuthSessionUpdate) Where(ps ...predicate.AuthSession) *AuthSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AuthSessionUpdate) SetUserID(v uint) *AuthSessionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AuthSessionUpdate) SetNillableUserID(v *uint) *AuthSessionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDevice sets the "device" field.
+func (_u *AuthSessionUpdate) SetDevice(v string) *AuthSessionUpdate {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *AuthSessionUpdate) SetNillableDevice(v *string) *AuthSessionUpdate {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// ClearDevice clears the value of the "device" field.
+func (_u *AuthSessionUpdate) ClearDevice() *AuthSessionUpdate {
+	_u.mutation.ClearDevice()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AuthSessionUpdate) SetIP(v string) *AuthSessionUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AuthSessionUpdate) SetNillableIP(v *string) *AuthSessionUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *AuthSessionUpdate) ClearIP() *AuthSessionUpdate {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetRememberMe sets the "remember_me" field.
+func (_u *AuthSessionUpdate) SetRememberMe(v bool) *AuthSessionUpdate {
+	_u.mutation.SetRememberMe(v)
+	return _u
+}
+
+// SetNillableRememberMe sets the "remember_me" field if the given value is not nil.
+func (_u *AuthSessionUpdate) SetNillableRememberMe(v *bool) *AuthSessionUpdate {
+	if v != nil {
+		_u.SetRememberMe(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *AuthSessionUpdate) SetTokenHash(v string) *AuthSessionUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *AuthSessionUpdate) SetNillableTokenHash(v *string) *AuthSessionUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetPrevTokenHash sets the "prev_token_hash" field.
+func (_u *AuthSessionUpdate) SetPrevTokenHash(v string) *AuthSessionUpdate {
+	_u.mutation.SetPrevTokenHash(v)
+	return _u
+}
+
+// SetNillablePrevTokenHash sets the "prev_token_hash" field if the given value is not nil.
+func (_u *AuthSessionUpdate) SetNillablePrevTokenHash(v *string) *AuthSessionUpdate {
+	if v != nil {
+		_u.SetPrevTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearPrevTokenHash clears the value of the "prev_token_hash" field.
+func (_u *AuthSessionUpdate) ClearPrevTokenHash() *AuthSessionUpdate {
+	_u.mutation.ClearPrevTokenHash()
+	return _u
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (_u *AuthSessionUpdate) SetRotatedAt(v time.Time) *AuthSessionUpdate {
+	_u.mutation.SetRotatedAt(v)
+	return _u
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_u *AuthSessionUpdate) SetNillableRotatedAt(v *time.Time) *AuthSessionUpdate {
+	if v != nil {
+		_u.SetRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (_u *AuthSessionUpdate) ClearRotatedAt() *AuthSessionUpdate {
+	_u.mutation.ClearRotatedAt()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *AuthSessionUpdate) SetLastUsedAt(v time.Time) *AuthSessionUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *AuthSessionUpdate) SetNillableLastUsedAt(v *time.Time) *AuthSessionUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AuthSessionUpdate) SetExpiresAt(v time.Time) *AuthSessionUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AuthSessionUpdate) SetNillableExpiresAt(v *time.Time) *AuthSessionUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AuthSessionUpdate) SetUser(v *User) *AuthSessionUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the AuthSessionMutation object of the builder.
+func (_u *AuthSessionUpdate) Mutation() *AuthSessionMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AuthSessionUpdate) ClearUser() *AuthSessionUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuthSessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuthSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuthSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuthSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuthSessionUpdate) check() error {
+	if v, ok := _u.mutation.Device(); ok {
+		if err := authsession.DeviceValidator(v); err != nil {
+			return &ValidationError{Name: "device", err: fmt.Errorf(`ent: validator failed for field "AuthSession.device": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := authsession.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AuthSession.ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := authsession.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "AuthSession.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PrevTokenHash(); ok {
+		if err := authsession.PrevTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "prev_token_hash", err: fmt.Errorf(`ent: validator failed for field "AuthSession.prev_token_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AuthSession.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthSessionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthSessionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuthSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(authsession.Table, authsession.Columns, sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(authsession.FieldDevice, field.TypeString, value)
+	}
+	if _u.mutation.DeviceCleared() {
+		_spec.ClearField(authsession.FieldDevice, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(authsession.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(authsession.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.RememberMe(); ok {
+		_spec.SetField(authsession.FieldRememberMe, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(authsession.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PrevTokenHash(); ok {
+		_spec.SetField(authsession.FieldPrevTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.PrevTokenHashCleared() {
+		_spec.ClearField(authsession.FieldPrevTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.RotatedAt(); ok {
+		_spec.SetField(authsession.FieldRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RotatedAtCleared() {
+		_spec.ClearField(authsession.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(authsession.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(authsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authsession.UserTable,
+			Columns: []string{authsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authsession.UserTable,
+			Columns: []string{authsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuthSessionUpdateOne is the builder for updating a single AuthSession entity.
+type AuthSessionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuthSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AuthSessionUpdateOne) SetUserID(v uint) *AuthSessionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AuthSessionUpdateOne) SetNillableUserID(v *uint) *AuthSessionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDevice sets the "device" field.
+func (_u *AuthSessionUpdateOne) SetDevice(v string) *AuthSessionUpdateOne {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *AuthSessionUpdateOne) SetNillableDevice(v *string) *AuthSessionUpdateOne {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// ClearDevice clears the value of the "device" field.
+func (_u *AuthSessionUpdateOne) ClearDevice() *AuthSessionUpdateOne {
+	_u.mutation.ClearDevice()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AuthSessionUpdateOne) SetIP(v string) *AuthSessionUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AuthSessionUpdateOne) SetNillableIP(v *string) *AuthSessionUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *AuthSessionUpdateOne) ClearIP() *AuthSessionUpdateOne {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetRememberMe sets the "remember_me" field.
+func (_u *AuthSessionUpdateOne) SetRememberMe(v bool) *AuthSessionUpdateOne {
+	_u.mutation.SetRememberMe(v)
+	return _u
+}
+
+// SetNillableRememberMe sets the "remember_me" field if the given value is not nil.
+func (_u *AuthSessionUpdateOne) SetNillableRememberMe(v *bool) *AuthSessionUpdateOne {
+	if v != nil {
+		_u.SetRememberMe(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *AuthSessionUpdateOne) SetTokenHash(v string) *AuthSessionUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *AuthSessionUpdateOne) SetNillableTokenHash(v *string) *AuthSessionUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetPrevTokenHash sets the "prev_token_hash" field.
+func (_u *AuthSessionUpdateOne) SetPrevTokenHash(v string) *AuthSessionUpdateOne {
+	_u.mutation.SetPrevTokenHash(v)
+	return _u
+}
+
+// SetNillablePrevTokenHash sets the "prev_token_hash" field if the given value is not nil.
+func (_u *AuthSessionUpdateOne) SetNillablePrevTokenHash(v *string) *AuthSessionUpdateOne {
+	if v != nil {
+		_u.SetPrevTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearPrevTokenHash clears the value of the "prev_token_hash" field.
+func (_u *AuthSessionUpdateOne) ClearPrevTokenHash() *AuthSessionUpdateOne {
+	_u.mutation.ClearPrevTokenHash()
+	return _u
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (_u *AuthSessionUpdateOne) SetRotatedAt(v time.Time) *AuthSessionUpdateOne {
+	_u.mutation.SetRotatedAt(v)
+	return _u
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_u *AuthSessionUpdateOne) SetNillableRotatedAt(v *time.Time) *AuthSessionUpdateOne {
+	if v != nil {
+		_u.SetRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (_u *AuthSessionUpdateOne) ClearRotatedAt() *AuthSessionUpdateOne {
+	_u.mutation.ClearRotatedAt()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *AuthSessionUpdateOne) SetLastUsedAt(v time.Time) *AuthSessionUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *AuthSessionUpdateOne) SetNillableLastUsedAt(v *time.Time) *AuthSessionUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AuthSessionUpdateOne) SetExpiresAt(v time.Time) *AuthSessionUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AuthSessionUpdateOne) SetNillableExpiresAt(v *time.Time) *AuthSessionUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AuthSessionUpdateOne) SetUser(v *User) *AuthSessionUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the AuthSessionMutation object of the builder.
+func (_u *AuthSessionUpdateOne) Mutation() *AuthSessionMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AuthSessionUpdateOne) ClearUser() *AuthSessionUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the AuthSessionUpdate builder.
+func (_u *AuthSessionUpdateOne) Where(ps ...predicate.AuthSession) *AuthSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuthSessionUpdateOne) Select(field string, fields ...string) *AuthSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuthSession entity.
+func (_u *AuthSessionUpdateOne) Save(ctx context.Context) (*AuthSession, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuthSessionUpdateOne) SaveX(ctx context.Context) *AuthSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuthSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuthSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuthSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.Device(); ok {
+		if err := authsession.DeviceValidator(v); err != nil {
+			return &ValidationError{Name: "device", err: fmt.Errorf(`ent: validator failed for field "AuthSession.device": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := authsession.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AuthSession.ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := authsession.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "AuthSession.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PrevTokenHash(); ok {
+		if err := authsession.PrevTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "prev_token_hash", err: fmt.Errorf(`ent: validator failed for field "AuthSession.prev_token_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AuthSession.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthSessionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthSessionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuthSessionUpdateOne) sqlSave(ctx context.Context) (_node *AuthSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(authsession.Table, authsession.Columns, sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuthSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, authsession.FieldID)
+		for _, f := range fields {
+			if !authsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != authsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(authsession.FieldDevice, field.TypeString, value)
+	}
+	if _u.mutation.DeviceCleared() {
+		_spec.ClearField(authsession.FieldDevice, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(authsession.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(authsession.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.RememberMe(); ok {
+		_spec.SetField(authsession.FieldRememberMe, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(authsession.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PrevTokenHash(); ok {
+		_spec.SetField(authsession.FieldPrevTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.PrevTokenHashCleared() {
+		_spec.ClearField(authsession.FieldPrevTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.RotatedAt(); ok {
+		_spec.SetField(authsession.FieldRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RotatedAtCleared() {
+		_spec.ClearField(authsession.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(authsession.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(authsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authsession.UserTable,
+			Columns: []string{authsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authsession.UserTable,
+			Columns: []string{authsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AuthSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/albumcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/article"
 	"github.com/anzhiyu-c/anheyu-app/ent/articlehistory"
+	"github.com/anzhiyu-c/anheyu-app/ent/authsession"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/docseries"
@@ -26,13 +27,18 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
 	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/link"
+	"github.com/anzhiyu-c/anheyu-app/ent/linkarchive"
 	"github.com/anzhiyu-c/anheyu-app/ent/linkcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/linktag"
+	"github.com/anzhiyu-c/anheyu-app/ent/logindevice"
 	"github.com/anzhiyu-c/anheyu-app/ent/metadata"
 	"github.com/anzhiyu-c/anheyu-app/ent/notificationtype"
+	"github.com/anzhiyu-c/anheyu-app/ent/oauthlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
+	"github.com/anzhiyu-c/anheyu-app/ent/reactioncount"
+	"github.com/anzhiyu-c/anheyu-app/ent/redirectrule"
 	"github.com/anzhiyu-c/anheyu-app/ent/setting"
 	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
 	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
@@ -59,6 +65,8 @@ type Client struct {
 	Article *ArticleClient
 	// ArticleHistory is the client for interacting with the ArticleHistory builders.
 	ArticleHistory *ArticleHistoryClient
+	// AuthSession is the client for interacting with the AuthSession builders.
+	AuthSession *AuthSessionClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
 	// DirectLink is the client for interacting with the DirectLink builders.
@@ -73,20 +81,30 @@ type Client struct {
 	FileEntity *FileEntityClient
 	// Link is the client for interacting with the Link builders.
 	Link *LinkClient
+	// LinkArchive is the client for interacting with the LinkArchive builders.
+	LinkArchive *LinkArchiveClient
 	// LinkCategory is the client for interacting with the LinkCategory builders.
 	LinkCategory *LinkCategoryClient
 	// LinkTag is the client for interacting with the LinkTag builders.
 	LinkTag *LinkTagClient
+	// LoginDevice is the client for interacting with the LoginDevice builders.
+	LoginDevice *LoginDeviceClient
 	// Metadata is the client for interacting with the Metadata builders.
 	Metadata *MetadataClient
 	// NotificationType is the client for interacting with the NotificationType builders.
 	NotificationType *NotificationTypeClient
+	// OAuthLink is the client for interacting with the OAuthLink builders.
+	OAuthLink *OAuthLinkClient
 	// Page is the client for interacting with the Page builders.
 	Page *PageClient
 	// PostCategory is the client for interacting with the PostCategory builders.
 	PostCategory *PostCategoryClient
 	// PostTag is the client for interacting with the PostTag builders.
 	PostTag *PostTagClient
+	// ReactionCount is the client for interacting with the ReactionCount builders.
+	ReactionCount *ReactionCountClient
+	// RedirectRule is the client for interacting with the RedirectRule builders.
+	RedirectRule *RedirectRuleClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
 	// StoragePolicy is the client for interacting with the StoragePolicy builders.
@@ -124,6 +142,7 @@ func (c *Client) init() {
 	c.AlbumCategory = NewAlbumCategoryClient(c.config)
 	c.Article = NewArticleClient(c.config)
 	c.ArticleHistory = NewArticleHistoryClient(c.config)
+	c.AuthSession = NewAuthSessionClient(c.config)
 	c.Comment = NewCommentClient(c.config)
 	c.DirectLink = NewDirectLinkClient(c.config)
 	c.DocSeries = NewDocSeriesClient(c.config)
@@ -131,13 +150,18 @@ func (c *Client) init() {
 	c.File = NewFileClient(c.config)
 	c.FileEntity = NewFileEntityClient(c.config)
 	c.Link = NewLinkClient(c.config)
+	c.LinkArchive = NewLinkArchiveClient(c.config)
 	c.LinkCategory = NewLinkCategoryClient(c.config)
 	c.LinkTag = NewLinkTagClient(c.config)
+	c.LoginDevice = NewLoginDeviceClient(c.config)
 	c.Metadata = NewMetadataClient(c.config)
 	c.NotificationType = NewNotificationTypeClient(c.config)
+	c.OAuthLink = NewOAuthLinkClient(c.config)
 	c.Page = NewPageClient(c.config)
 	c.PostCategory = NewPostCategoryClient(c.config)
 	c.PostTag = NewPostTagClient(c.config)
+	c.ReactionCount = NewReactionCountClient(c.config)
+	c.RedirectRule = NewRedirectRuleClient(c.config)
 	c.Setting = NewSettingClient(c.config)
 	c.StoragePolicy = NewStoragePolicyClient(c.config)
 	c.Subscriber = NewSubscriberClient(c.config)
@@ -245,6 +269,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		AlbumCategory:          NewAlbumCategoryClient(cfg),
 		Article:                NewArticleClient(cfg),
 		ArticleHistory:         NewArticleHistoryClient(cfg),
+		AuthSession:            NewAuthSessionClient(cfg),
 		Comment:                NewCommentClient(cfg),
 		DirectLink:             NewDirectLinkClient(cfg),
 		DocSeries:              NewDocSeriesClient(cfg),
@@ -252,13 +277,18 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		File:                   NewFileClient(cfg),
 		FileEntity:             NewFileEntityClient(cfg),
 		Link:                   NewLinkClient(cfg),
+		LinkArchive:            NewLinkArchiveClient(cfg),
 		LinkCategory:           NewLinkCategoryClient(cfg),
 		LinkTag:                NewLinkTagClient(cfg),
+		LoginDevice:            NewLoginDeviceClient(cfg),
 		Metadata:               NewMetadataClient(cfg),
 		NotificationType:       NewNotificationTypeClient(cfg),
+		OAuthLink:              NewOAuthLinkClient(cfg),
 		Page:                   NewPageClient(cfg),
 		PostCategory:           NewPostCategoryClient(cfg),
 		PostTag:                NewPostTagClient(cfg),
+		ReactionCount:          NewReactionCountClient(cfg),
+		RedirectRule:           NewRedirectRuleClient(cfg),
 		Setting:                NewSettingClient(cfg),
 		StoragePolicy:          NewStoragePolicyClient(cfg),
 		Subscriber:             NewSubscriberClient(cfg),
@@ -293,6 +323,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		AlbumCategory:          NewAlbumCategoryClient(cfg),
 		Article:                NewArticleClient(cfg),
 		ArticleHistory:         NewArticleHistoryClient(cfg),
+		AuthSession:            NewAuthSessionClient(cfg),
 		Comment:                NewCommentClient(cfg),
 		DirectLink:             NewDirectLinkClient(cfg),
 		DocSeries:              NewDocSeriesClient(cfg),
@@ -300,13 +331,18 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		File:                   NewFileClient(cfg),
 		FileEntity:             NewFileEntityClient(cfg),
 		Link:                   NewLinkClient(cfg),
+		LinkArchive:            NewLinkArchiveClient(cfg),
 		LinkCategory:           NewLinkCategoryClient(cfg),
 		LinkTag:                NewLinkTagClient(cfg),
+		LoginDevice:            NewLoginDeviceClient(cfg),
 		Metadata:               NewMetadataClient(cfg),
 		NotificationType:       NewNotificationTypeClient(cfg),
+		OAuthLink:              NewOAuthLinkClient(cfg),
 		Page:                   NewPageClient(cfg),
 		PostCategory:           NewPostCategoryClient(cfg),
 		PostTag:                NewPostTagClient(cfg),
+		ReactionCount:          NewReactionCountClient(cfg),
+		RedirectRule:           NewRedirectRuleClient(cfg),
 		Setting:                NewSettingClient(cfg),
 		StoragePolicy:          NewStoragePolicyClient(cfg),
 		Subscriber:             NewSubscriberClient(cfg),
@@ -347,11 +383,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Album, c.AlbumCategory, c.Article, c.ArticleHistory, c.Comment, c.DirectLink,
-		c.DocSeries, c.Entity, c.File, c.FileEntity, c.Link, c.LinkCategory, c.LinkTag,
-		c.Metadata, c.NotificationType, c.Page, c.PostCategory, c.PostTag, c.Setting,
-		c.StoragePolicy, c.Subscriber, c.Tag, c.URLStat, c.User, c.UserGroup,
-		c.UserInstalledTheme, c.UserNotificationConfig, c.VisitorLog, c.VisitorStat,
+		c.Album, c.AlbumCategory, c.Article, c.ArticleHistory, c.AuthSession, c.Comment,
+		c.DirectLink, c.DocSeries, c.Entity, c.File, c.FileEntity, c.Link,
+		c.LinkArchive, c.LinkCategory, c.LinkTag, c.LoginDevice, c.Metadata,
+		c.NotificationType, c.OAuthLink, c.Page, c.PostCategory, c.PostTag,
+		c.ReactionCount, c.RedirectRule, c.Setting, c.StoragePolicy, c.Subscriber,
+		c.Tag, c.URLStat, c.User, c.UserGroup, c.UserInstalledTheme,
+		c.UserNotificationConfig, c.VisitorLog, c.VisitorStat,
 	} {
 		n.Use(hooks...)
 	}
@@ -361,11 +399,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Album, c.AlbumCategory, c.Article, c.ArticleHistory, c.Comment, c.DirectLink,
-		c.DocSeries, c.Entity, c.File, c.FileEntity, c.Link, c.LinkCategory, c.LinkTag,
-		c.Metadata, c.NotificationType, c.Page, c.PostCategory, c.PostTag, c.Setting,
-		c.StoragePolicy, c.Subscriber, c.Tag, c.URLStat, c.User, c.UserGroup,
-		c.UserInstalledTheme, c.UserNotificationConfig, c.VisitorLog, c.VisitorStat,
+		c.Album, c.AlbumCategory, c.Article, c.ArticleHistory, c.AuthSession, c.Comment,
+		c.DirectLink, c.DocSeries, c.Entity, c.File, c.FileEntity, c.Link,
+		c.LinkArchive, c.LinkCategory, c.LinkTag, c.LoginDevice, c.Metadata,
+		c.NotificationType, c.OAuthLink, c.Page, c.PostCategory, c.PostTag,
+		c.ReactionCount, c.RedirectRule, c.Setting, c.StoragePolicy, c.Subscriber,
+		c.Tag, c.URLStat, c.User, c.UserGroup, c.UserInstalledTheme,
+		c.UserNotificationConfig, c.VisitorLog, c.VisitorStat,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -382,6 +422,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Article.mutate(ctx, m)
 	case *ArticleHistoryMutation:
 		return c.ArticleHistory.mutate(ctx, m)
+	case *AuthSessionMutation:
+		return c.AuthSession.mutate(ctx, m)
 	case *CommentMutation:
 		return c.Comment.mutate(ctx, m)
 	case *DirectLinkMutation:
@@ -396,20 +438,30 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.FileEntity.mutate(ctx, m)
 	case *LinkMutation:
 		return c.Link.mutate(ctx, m)
+	case *LinkArchiveMutation:
+		return c.LinkArchive.mutate(ctx, m)
 	case *LinkCategoryMutation:
 		return c.LinkCategory.mutate(ctx, m)
 	case *LinkTagMutation:
 		return c.LinkTag.mutate(ctx, m)
+	case *LoginDeviceMutation:
+		return c.LoginDevice.mutate(ctx, m)
 	case *MetadataMutation:
 		return c.Metadata.mutate(ctx, m)
 	case *NotificationTypeMutation:
 		return c.NotificationType.mutate(ctx, m)
+	case *OAuthLinkMutation:
+		return c.OAuthLink.mutate(ctx, m)
 	case *PageMutation:
 		return c.Page.mutate(ctx, m)
 	case *PostCategoryMutation:
 		return c.PostCategory.mutate(ctx, m)
 	case *PostTagMutation:
 		return c.PostTag.mutate(ctx, m)
+	case *ReactionCountMutation:
+		return c.ReactionCount.mutate(ctx, m)
+	case *RedirectRuleMutation:
+		return c.RedirectRule.mutate(ctx, m)
 	case *SettingMutation:
 		return c.Setting.mutate(ctx, m)
 	case *StoragePolicyMutation:
@@ -1099,6 +1151,155 @@ func (c *ArticleHistoryClient) mutate(ctx context.Context, m *ArticleHistoryMuta
 	}
 }
 
+// AuthSessionClient is a client for the AuthSession schema.
+type AuthSessionClient struct {
+	config
+}
+
+// NewAuthSessionClient returns a client for the AuthSession from the given config.
+func NewAuthSessionClient(c config) *AuthSessionClient {
+	return &AuthSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `authsession.Hooks(f(g(h())))`.
+func (c *AuthSessionClient) Use(hooks ...Hook) {
+	c.hooks.AuthSession = append(c.hooks.AuthSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `authsession.Intercept(f(g(h())))`.
+func (c *AuthSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuthSession = append(c.inters.AuthSession, interceptors...)
+}
+
+// Create returns a builder for creating a AuthSession entity.
+func (c *AuthSessionClient) Create() *AuthSessionCreate {
+	mutation := newAuthSessionMutation(c.config, OpCreate)
+	return &AuthSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuthSession entities.
+func (c *AuthSessionClient) CreateBulk(builders ...*AuthSessionCreate) *AuthSessionCreateBulk {
+	return &AuthSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuthSessionClient) MapCreateBulk(slice any, setFunc func(*AuthSessionCreate, int)) *AuthSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuthSessionCreateBulk{err: fmt.Errorf("calling to AuthSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuthSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuthSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuthSession.
+func (c *AuthSessionClient) Update() *AuthSessionUpdate {
+	mutation := newAuthSessionMutation(c.config, OpUpdate)
+	return &AuthSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuthSessionClient) UpdateOne(_m *AuthSession) *AuthSessionUpdateOne {
+	mutation := newAuthSessionMutation(c.config, OpUpdateOne, withAuthSession(_m))
+	return &AuthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuthSessionClient) UpdateOneID(id string) *AuthSessionUpdateOne {
+	mutation := newAuthSessionMutation(c.config, OpUpdateOne, withAuthSessionID(id))
+	return &AuthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuthSession.
+func (c *AuthSessionClient) Delete() *AuthSessionDelete {
+	mutation := newAuthSessionMutation(c.config, OpDelete)
+	return &AuthSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuthSessionClient) DeleteOne(_m *AuthSession) *AuthSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuthSessionClient) DeleteOneID(id string) *AuthSessionDeleteOne {
+	builder := c.Delete().Where(authsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuthSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for AuthSession.
+func (c *AuthSessionClient) Query() *AuthSessionQuery {
+	return &AuthSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuthSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuthSession entity by its id.
+func (c *AuthSessionClient) Get(ctx context.Context, id string) (*AuthSession, error) {
+	return c.Query().Where(authsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuthSessionClient) GetX(ctx context.Context, id string) *AuthSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a AuthSession.
+func (c *AuthSessionClient) QueryUser(_m *AuthSession) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(authsession.Table, authsession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, authsession.UserTable, authsession.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AuthSessionClient) Hooks() []Hook {
+	return c.hooks.AuthSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuthSessionClient) Interceptors() []Interceptor {
+	return c.inters.AuthSession
+}
+
+func (c *AuthSessionClient) mutate(ctx context.Context, m *AuthSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuthSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuthSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuthSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuthSession mutation op: %q", m.Op())
+	}
+}
+
 // CommentClient is a client for the Comment schema.
 type CommentClient struct {
 	config
@@ -2306,6 +2507,139 @@ func (c *LinkClient) mutate(ctx context.Context, m *LinkMutation) (Value, error)
 	}
 }
 
+// LinkArchiveClient is a client for the LinkArchive schema.
+type LinkArchiveClient struct {
+	config
+}
+
+// NewLinkArchiveClient returns a client for the LinkArchive from the given config.
+func NewLinkArchiveClient(c config) *LinkArchiveClient {
+	return &LinkArchiveClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `linkarchive.Hooks(f(g(h())))`.
+func (c *LinkArchiveClient) Use(hooks ...Hook) {
+	c.hooks.LinkArchive = append(c.hooks.LinkArchive, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `linkarchive.Intercept(f(g(h())))`.
+func (c *LinkArchiveClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LinkArchive = append(c.inters.LinkArchive, interceptors...)
+}
+
+// Create returns a builder for creating a LinkArchive entity.
+func (c *LinkArchiveClient) Create() *LinkArchiveCreate {
+	mutation := newLinkArchiveMutation(c.config, OpCreate)
+	return &LinkArchiveCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LinkArchive entities.
+func (c *LinkArchiveClient) CreateBulk(builders ...*LinkArchiveCreate) *LinkArchiveCreateBulk {
+	return &LinkArchiveCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkArchiveClient) MapCreateBulk(slice any, setFunc func(*LinkArchiveCreate, int)) *LinkArchiveCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkArchiveCreateBulk{err: fmt.Errorf("calling to LinkArchiveClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkArchiveCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkArchiveCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LinkArchive.
+func (c *LinkArchiveClient) Update() *LinkArchiveUpdate {
+	mutation := newLinkArchiveMutation(c.config, OpUpdate)
+	return &LinkArchiveUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkArchiveClient) UpdateOne(_m *LinkArchive) *LinkArchiveUpdateOne {
+	mutation := newLinkArchiveMutation(c.config, OpUpdateOne, withLinkArchive(_m))
+	return &LinkArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkArchiveClient) UpdateOneID(id uint) *LinkArchiveUpdateOne {
+	mutation := newLinkArchiveMutation(c.config, OpUpdateOne, withLinkArchiveID(id))
+	return &LinkArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LinkArchive.
+func (c *LinkArchiveClient) Delete() *LinkArchiveDelete {
+	mutation := newLinkArchiveMutation(c.config, OpDelete)
+	return &LinkArchiveDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkArchiveClient) DeleteOne(_m *LinkArchive) *LinkArchiveDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkArchiveClient) DeleteOneID(id uint) *LinkArchiveDeleteOne {
+	builder := c.Delete().Where(linkarchive.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkArchiveDeleteOne{builder}
+}
+
+// Query returns a query builder for LinkArchive.
+func (c *LinkArchiveClient) Query() *LinkArchiveQuery {
+	return &LinkArchiveQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLinkArchive},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LinkArchive entity by its id.
+func (c *LinkArchiveClient) Get(ctx context.Context, id uint) (*LinkArchive, error) {
+	return c.Query().Where(linkarchive.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkArchiveClient) GetX(ctx context.Context, id uint) *LinkArchive {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LinkArchiveClient) Hooks() []Hook {
+	return c.hooks.LinkArchive
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkArchiveClient) Interceptors() []Interceptor {
+	return c.inters.LinkArchive
+}
+
+func (c *LinkArchiveClient) mutate(ctx context.Context, m *LinkArchiveMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkArchiveCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkArchiveUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkArchiveDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LinkArchive mutation op: %q", m.Op())
+	}
+}
+
 // LinkCategoryClient is a client for the LinkCategory schema.
 type LinkCategoryClient struct {
 	config
@@ -2604,6 +2938,155 @@ func (c *LinkTagClient) mutate(ctx context.Context, m *LinkTagMutation) (Value,
 	}
 }
 
+// LoginDeviceClient is a client for the LoginDevice schema.
+type LoginDeviceClient struct {
+	config
+}
+
+// NewLoginDeviceClient returns a client for the LoginDevice from the given config.
+func NewLoginDeviceClient(c config) *LoginDeviceClient {
+	return &LoginDeviceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `logindevice.Hooks(f(g(h())))`.
+func (c *LoginDeviceClient) Use(hooks ...Hook) {
+	c.hooks.LoginDevice = append(c.hooks.LoginDevice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `logindevice.Intercept(f(g(h())))`.
+func (c *LoginDeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginDevice = append(c.inters.LoginDevice, interceptors...)
+}
+
+// Create returns a builder for creating a LoginDevice entity.
+func (c *LoginDeviceClient) Create() *LoginDeviceCreate {
+	mutation := newLoginDeviceMutation(c.config, OpCreate)
+	return &LoginDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoginDevice entities.
+func (c *LoginDeviceClient) CreateBulk(builders ...*LoginDeviceCreate) *LoginDeviceCreateBulk {
+	return &LoginDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoginDeviceClient) MapCreateBulk(slice any, setFunc func(*LoginDeviceCreate, int)) *LoginDeviceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoginDeviceCreateBulk{err: fmt.Errorf("calling to LoginDeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoginDeviceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoginDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoginDevice.
+func (c *LoginDeviceClient) Update() *LoginDeviceUpdate {
+	mutation := newLoginDeviceMutation(c.config, OpUpdate)
+	return &LoginDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoginDeviceClient) UpdateOne(_m *LoginDevice) *LoginDeviceUpdateOne {
+	mutation := newLoginDeviceMutation(c.config, OpUpdateOne, withLoginDevice(_m))
+	return &LoginDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoginDeviceClient) UpdateOneID(id uint) *LoginDeviceUpdateOne {
+	mutation := newLoginDeviceMutation(c.config, OpUpdateOne, withLoginDeviceID(id))
+	return &LoginDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoginDevice.
+func (c *LoginDeviceClient) Delete() *LoginDeviceDelete {
+	mutation := newLoginDeviceMutation(c.config, OpDelete)
+	return &LoginDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoginDeviceClient) DeleteOne(_m *LoginDevice) *LoginDeviceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoginDeviceClient) DeleteOneID(id uint) *LoginDeviceDeleteOne {
+	builder := c.Delete().Where(logindevice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoginDeviceDeleteOne{builder}
+}
+
+// Query returns a query builder for LoginDevice.
+func (c *LoginDeviceClient) Query() *LoginDeviceQuery {
+	return &LoginDeviceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoginDevice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoginDevice entity by its id.
+func (c *LoginDeviceClient) Get(ctx context.Context, id uint) (*LoginDevice, error) {
+	return c.Query().Where(logindevice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoginDeviceClient) GetX(ctx context.Context, id uint) *LoginDevice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LoginDevice.
+func (c *LoginDeviceClient) QueryUser(_m *LoginDevice) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(logindevice.Table, logindevice.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, logindevice.UserTable, logindevice.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LoginDeviceClient) Hooks() []Hook {
+	return c.hooks.LoginDevice
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoginDeviceClient) Interceptors() []Interceptor {
+	return c.inters.LoginDevice
+}
+
+func (c *LoginDeviceClient) mutate(ctx context.Context, m *LoginDeviceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoginDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoginDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoginDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoginDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoginDevice mutation op: %q", m.Op())
+	}
+}
+
 // MetadataClient is a client for the Metadata schema.
 type MetadataClient struct {
 	config
@@ -2903,19 +3386,168 @@ func (c *NotificationTypeClient) mutate(ctx context.Context, m *NotificationType
 	}
 }
 
-// PageClient is a client for the Page schema.
-type PageClient struct {
+// OAuthLinkClient is a client for the OAuthLink schema.
+type OAuthLinkClient struct {
 	config
 }
 
-// NewPageClient returns a client for the Page from the given config.
-func NewPageClient(c config) *PageClient {
-	return &PageClient{config: c}
+// NewOAuthLinkClient returns a client for the OAuthLink from the given config.
+func NewOAuthLinkClient(c config) *OAuthLinkClient {
+	return &OAuthLinkClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `page.Hooks(f(g(h())))`.
-func (c *PageClient) Use(hooks ...Hook) {
+// A call to `Use(f, g, h)` equals to `oauthlink.Hooks(f(g(h())))`.
+func (c *OAuthLinkClient) Use(hooks ...Hook) {
+	c.hooks.OAuthLink = append(c.hooks.OAuthLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthlink.Intercept(f(g(h())))`.
+func (c *OAuthLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OAuthLink = append(c.inters.OAuthLink, interceptors...)
+}
+
+// Create returns a builder for creating a OAuthLink entity.
+func (c *OAuthLinkClient) Create() *OAuthLinkCreate {
+	mutation := newOAuthLinkMutation(c.config, OpCreate)
+	return &OAuthLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OAuthLink entities.
+func (c *OAuthLinkClient) CreateBulk(builders ...*OAuthLinkCreate) *OAuthLinkCreateBulk {
+	return &OAuthLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OAuthLinkClient) MapCreateBulk(slice any, setFunc func(*OAuthLinkCreate, int)) *OAuthLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OAuthLinkCreateBulk{err: fmt.Errorf("calling to OAuthLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OAuthLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OAuthLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OAuthLink.
+func (c *OAuthLinkClient) Update() *OAuthLinkUpdate {
+	mutation := newOAuthLinkMutation(c.config, OpUpdate)
+	return &OAuthLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OAuthLinkClient) UpdateOne(_m *OAuthLink) *OAuthLinkUpdateOne {
+	mutation := newOAuthLinkMutation(c.config, OpUpdateOne, withOAuthLink(_m))
+	return &OAuthLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OAuthLinkClient) UpdateOneID(id uint) *OAuthLinkUpdateOne {
+	mutation := newOAuthLinkMutation(c.config, OpUpdateOne, withOAuthLinkID(id))
+	return &OAuthLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OAuthLink.
+func (c *OAuthLinkClient) Delete() *OAuthLinkDelete {
+	mutation := newOAuthLinkMutation(c.config, OpDelete)
+	return &OAuthLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OAuthLinkClient) DeleteOne(_m *OAuthLink) *OAuthLinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OAuthLinkClient) DeleteOneID(id uint) *OAuthLinkDeleteOne {
+	builder := c.Delete().Where(oauthlink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OAuthLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for OAuthLink.
+func (c *OAuthLinkClient) Query() *OAuthLinkQuery {
+	return &OAuthLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOAuthLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OAuthLink entity by its id.
+func (c *OAuthLinkClient) Get(ctx context.Context, id uint) (*OAuthLink, error) {
+	return c.Query().Where(oauthlink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OAuthLinkClient) GetX(ctx context.Context, id uint) *OAuthLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a OAuthLink.
+func (c *OAuthLinkClient) QueryUser(_m *OAuthLink) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthlink.Table, oauthlink.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthlink.UserTable, oauthlink.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OAuthLinkClient) Hooks() []Hook {
+	return c.hooks.OAuthLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *OAuthLinkClient) Interceptors() []Interceptor {
+	return c.inters.OAuthLink
+}
+
+func (c *OAuthLinkClient) mutate(ctx context.Context, m *OAuthLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OAuthLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OAuthLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OAuthLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OAuthLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OAuthLink mutation op: %q", m.Op())
+	}
+}
+
+// PageClient is a client for the Page schema.
+type PageClient struct {
+	config
+}
+
+// NewPageClient returns a client for the Page from the given config.
+func NewPageClient(c config) *PageClient {
+	return &PageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `page.Hooks(f(g(h())))`.
+func (c *PageClient) Use(hooks ...Hook) {
 	c.hooks.Page = append(c.hooks.Page, hooks...)
 }
 
@@ -3337,6 +3969,272 @@ func (c *PostTagClient) mutate(ctx context.Context, m *PostTagMutation) (Value,
 	}
 }
 
+// ReactionCountClient is a client for the ReactionCount schema.
+type ReactionCountClient struct {
+	config
+}
+
+// NewReactionCountClient returns a client for the ReactionCount from the given config.
+func NewReactionCountClient(c config) *ReactionCountClient {
+	return &ReactionCountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reactioncount.Hooks(f(g(h())))`.
+func (c *ReactionCountClient) Use(hooks ...Hook) {
+	c.hooks.ReactionCount = append(c.hooks.ReactionCount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reactioncount.Intercept(f(g(h())))`.
+func (c *ReactionCountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReactionCount = append(c.inters.ReactionCount, interceptors...)
+}
+
+// Create returns a builder for creating a ReactionCount entity.
+func (c *ReactionCountClient) Create() *ReactionCountCreate {
+	mutation := newReactionCountMutation(c.config, OpCreate)
+	return &ReactionCountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReactionCount entities.
+func (c *ReactionCountClient) CreateBulk(builders ...*ReactionCountCreate) *ReactionCountCreateBulk {
+	return &ReactionCountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReactionCountClient) MapCreateBulk(slice any, setFunc func(*ReactionCountCreate, int)) *ReactionCountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReactionCountCreateBulk{err: fmt.Errorf("calling to ReactionCountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReactionCountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReactionCountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReactionCount.
+func (c *ReactionCountClient) Update() *ReactionCountUpdate {
+	mutation := newReactionCountMutation(c.config, OpUpdate)
+	return &ReactionCountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReactionCountClient) UpdateOne(_m *ReactionCount) *ReactionCountUpdateOne {
+	mutation := newReactionCountMutation(c.config, OpUpdateOne, withReactionCount(_m))
+	return &ReactionCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReactionCountClient) UpdateOneID(id uint) *ReactionCountUpdateOne {
+	mutation := newReactionCountMutation(c.config, OpUpdateOne, withReactionCountID(id))
+	return &ReactionCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReactionCount.
+func (c *ReactionCountClient) Delete() *ReactionCountDelete {
+	mutation := newReactionCountMutation(c.config, OpDelete)
+	return &ReactionCountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReactionCountClient) DeleteOne(_m *ReactionCount) *ReactionCountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReactionCountClient) DeleteOneID(id uint) *ReactionCountDeleteOne {
+	builder := c.Delete().Where(reactioncount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReactionCountDeleteOne{builder}
+}
+
+// Query returns a query builder for ReactionCount.
+func (c *ReactionCountClient) Query() *ReactionCountQuery {
+	return &ReactionCountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReactionCount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReactionCount entity by its id.
+func (c *ReactionCountClient) Get(ctx context.Context, id uint) (*ReactionCount, error) {
+	return c.Query().Where(reactioncount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReactionCountClient) GetX(ctx context.Context, id uint) *ReactionCount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReactionCountClient) Hooks() []Hook {
+	return c.hooks.ReactionCount
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReactionCountClient) Interceptors() []Interceptor {
+	return c.inters.ReactionCount
+}
+
+func (c *ReactionCountClient) mutate(ctx context.Context, m *ReactionCountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReactionCountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReactionCountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReactionCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReactionCountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReactionCount mutation op: %q", m.Op())
+	}
+}
+
+// RedirectRuleClient is a client for the RedirectRule schema.
+type RedirectRuleClient struct {
+	config
+}
+
+// NewRedirectRuleClient returns a client for the RedirectRule from the given config.
+func NewRedirectRuleClient(c config) *RedirectRuleClient {
+	return &RedirectRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `redirectrule.Hooks(f(g(h())))`.
+func (c *RedirectRuleClient) Use(hooks ...Hook) {
+	c.hooks.RedirectRule = append(c.hooks.RedirectRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `redirectrule.Intercept(f(g(h())))`.
+func (c *RedirectRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RedirectRule = append(c.inters.RedirectRule, interceptors...)
+}
+
+// Create returns a builder for creating a RedirectRule entity.
+func (c *RedirectRuleClient) Create() *RedirectRuleCreate {
+	mutation := newRedirectRuleMutation(c.config, OpCreate)
+	return &RedirectRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RedirectRule entities.
+func (c *RedirectRuleClient) CreateBulk(builders ...*RedirectRuleCreate) *RedirectRuleCreateBulk {
+	return &RedirectRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RedirectRuleClient) MapCreateBulk(slice any, setFunc func(*RedirectRuleCreate, int)) *RedirectRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RedirectRuleCreateBulk{err: fmt.Errorf("calling to RedirectRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RedirectRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RedirectRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RedirectRule.
+func (c *RedirectRuleClient) Update() *RedirectRuleUpdate {
+	mutation := newRedirectRuleMutation(c.config, OpUpdate)
+	return &RedirectRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RedirectRuleClient) UpdateOne(_m *RedirectRule) *RedirectRuleUpdateOne {
+	mutation := newRedirectRuleMutation(c.config, OpUpdateOne, withRedirectRule(_m))
+	return &RedirectRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RedirectRuleClient) UpdateOneID(id uint) *RedirectRuleUpdateOne {
+	mutation := newRedirectRuleMutation(c.config, OpUpdateOne, withRedirectRuleID(id))
+	return &RedirectRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RedirectRule.
+func (c *RedirectRuleClient) Delete() *RedirectRuleDelete {
+	mutation := newRedirectRuleMutation(c.config, OpDelete)
+	return &RedirectRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RedirectRuleClient) DeleteOne(_m *RedirectRule) *RedirectRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RedirectRuleClient) DeleteOneID(id uint) *RedirectRuleDeleteOne {
+	builder := c.Delete().Where(redirectrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RedirectRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for RedirectRule.
+func (c *RedirectRuleClient) Query() *RedirectRuleQuery {
+	return &RedirectRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRedirectRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RedirectRule entity by its id.
+func (c *RedirectRuleClient) Get(ctx context.Context, id uint) (*RedirectRule, error) {
+	return c.Query().Where(redirectrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RedirectRuleClient) GetX(ctx context.Context, id uint) *RedirectRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RedirectRuleClient) Hooks() []Hook {
+	return c.hooks.RedirectRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *RedirectRuleClient) Interceptors() []Interceptor {
+	return c.inters.RedirectRule
+}
+
+func (c *RedirectRuleClient) mutate(ctx context.Context, m *RedirectRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RedirectRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RedirectRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RedirectRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RedirectRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RedirectRule mutation op: %q", m.Op())
+	}
+}
+
 // SettingClient is a client for the Setting schema.
 type SettingClient struct {
 	config
@@ -4193,6 +5091,54 @@ func (c *UserClient) QueryNotificationConfigs(_m *User) *UserNotificationConfigQ
 	return query
 }
 
+// QueryLoginDevices queries the login_devices edge of a User.
+func (c *UserClient) QueryLoginDevices(_m *User) *LoginDeviceQuery {
+	query := (&LoginDeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(logindevice.Table, logindevice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LoginDevicesTable, user.LoginDevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOauthLinks queries the oauth_links edge of a User.
+func (c *UserClient) QueryOauthLinks(_m *User) *OAuthLinkQuery {
+	query := (&OAuthLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(oauthlink.Table, oauthlink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OauthLinksTable, user.OauthLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthSessions queries the auth_sessions edge of a User.
+func (c *UserClient) QueryAuthSessions(_m *User) *AuthSessionQuery {
+	query := (&AuthSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(authsession.Table, authsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuthSessionsTable, user.AuthSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	hooks := c.hooks.User
@@ -4953,17 +5899,19 @@ func (c *VisitorStatClient) mutate(ctx context.Context, m *VisitorStatMutation)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Album, AlbumCategory, Article, ArticleHistory, Comment, DirectLink, DocSeries,
-		Entity, File, FileEntity, Link, LinkCategory, LinkTag, Metadata,
-		NotificationType, Page, PostCategory, PostTag, Setting, StoragePolicy,
-		Subscriber, Tag, URLStat, User, UserGroup, UserInstalledTheme,
-		UserNotificationConfig, VisitorLog, VisitorStat []ent.Hook
+		Album, AlbumCategory, Article, ArticleHistory, AuthSession, Comment, DirectLink,
+		DocSeries, Entity, File, FileEntity, Link, LinkArchive, LinkCategory, LinkTag,
+		LoginDevice, Metadata, NotificationType, OAuthLink, Page, PostCategory,
+		PostTag, ReactionCount, RedirectRule, Setting, StoragePolicy, Subscriber, Tag,
+		URLStat, User, UserGroup, UserInstalledTheme, UserNotificationConfig,
+		VisitorLog, VisitorStat []ent.Hook
 	}
 	inters struct {
-		Album, AlbumCategory, Article, ArticleHistory, Comment, DirectLink, DocSeries,
-		Entity, File, FileEntity, Link, LinkCategory, LinkTag, Metadata,
-		NotificationType, Page, PostCategory, PostTag, Setting, StoragePolicy,
-		Subscriber, Tag, URLStat, User, UserGroup, UserInstalledTheme,
-		UserNotificationConfig, VisitorLog, VisitorStat []ent.Interceptor
+		Album, AlbumCategory, Article, ArticleHistory, AuthSession, Comment, DirectLink,
+		DocSeries, Entity, File, FileEntity, Link, LinkArchive, LinkCategory, LinkTag,
+		LoginDevice, Metadata, NotificationType, OAuthLink, Page, PostCategory,
+		PostTag, ReactionCount, RedirectRule, Setting, StoragePolicy, Subscriber, Tag,
+		URLStat, User, UserGroup, UserInstalledTheme, UserNotificationConfig,
+		VisitorLog, VisitorStat []ent.Interceptor
 	}
 )
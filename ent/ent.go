@@ -16,6 +16,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/albumcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/article"
 	"github.com/anzhiyu-c/anheyu-app/ent/articlehistory"
+	"github.com/anzhiyu-c/anheyu-app/ent/authsession"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/docseries"
@@ -23,13 +24,18 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
 	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/link"
+	"github.com/anzhiyu-c/anheyu-app/ent/linkarchive"
 	"github.com/anzhiyu-c/anheyu-app/ent/linkcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/linktag"
+	"github.com/anzhiyu-c/anheyu-app/ent/logindevice"
 	"github.com/anzhiyu-c/anheyu-app/ent/metadata"
 	"github.com/anzhiyu-c/anheyu-app/ent/notificationtype"
+	"github.com/anzhiyu-c/anheyu-app/ent/oauthlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
+	"github.com/anzhiyu-c/anheyu-app/ent/reactioncount"
+	"github.com/anzhiyu-c/anheyu-app/ent/redirectrule"
 	"github.com/anzhiyu-c/anheyu-app/ent/setting"
 	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
 	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
@@ -105,6 +111,7 @@ func checkColumn(t, c string) error {
 			albumcategory.Table:          albumcategory.ValidColumn,
 			article.Table:                article.ValidColumn,
 			articlehistory.Table:         articlehistory.ValidColumn,
+			authsession.Table:            authsession.ValidColumn,
 			comment.Table:                comment.ValidColumn,
 			directlink.Table:             directlink.ValidColumn,
 			docseries.Table:              docseries.ValidColumn,
@@ -112,13 +119,18 @@ func checkColumn(t, c string) error {
 			file.Table:                   file.ValidColumn,
 			fileentity.Table:             fileentity.ValidColumn,
 			link.Table:                   link.ValidColumn,
+			linkarchive.Table:            linkarchive.ValidColumn,
 			linkcategory.Table:           linkcategory.ValidColumn,
 			linktag.Table:                linktag.ValidColumn,
+			logindevice.Table:            logindevice.ValidColumn,
 			metadata.Table:               metadata.ValidColumn,
 			notificationtype.Table:       notificationtype.ValidColumn,
+			oauthlink.Table:              oauthlink.ValidColumn,
 			page.Table:                   page.ValidColumn,
 			postcategory.Table:           postcategory.ValidColumn,
 			posttag.Table:                posttag.ValidColumn,
+			reactioncount.Table:          reactioncount.ValidColumn,
+			redirectrule.Table:           redirectrule.ValidColumn,
 			setting.Table:                setting.ValidColumn,
 			storagepolicy.Table:          storagepolicy.ValidColumn,
 			subscriber.Table:             subscriber.ValidColumn,
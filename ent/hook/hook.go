@@ -57,6 +57,18 @@ func (f ArticleHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleHistoryMutation", m)
 }
 
+// The AuthSessionFunc type is an adapter to allow the use of ordinary
+// function as AuthSession mutator.
+type AuthSessionFunc func(context.Context, *ent.AuthSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuthSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuthSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuthSessionMutation", m)
+}
+
 // The CommentFunc type is an adapter to allow the use of ordinary
 // function as Comment mutator.
 type CommentFunc func(context.Context, *ent.CommentMutation) (ent.Value, error)
@@ -141,6 +153,18 @@ func (f LinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LinkMutation", m)
 }
 
+// The LinkArchiveFunc type is an adapter to allow the use of ordinary
+// function as LinkArchive mutator.
+type LinkArchiveFunc func(context.Context, *ent.LinkArchiveMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LinkArchiveFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LinkArchiveMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LinkArchiveMutation", m)
+}
+
 // The LinkCategoryFunc type is an adapter to allow the use of ordinary
 // function as LinkCategory mutator.
 type LinkCategoryFunc func(context.Context, *ent.LinkCategoryMutation) (ent.Value, error)
@@ -165,6 +189,18 @@ func (f LinkTagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LinkTagMutation", m)
 }
 
+// The LoginDeviceFunc type is an adapter to allow the use of ordinary
+// function as LoginDevice mutator.
+type LoginDeviceFunc func(context.Context, *ent.LoginDeviceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoginDeviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoginDeviceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoginDeviceMutation", m)
+}
+
 // The MetadataFunc type is an adapter to allow the use of ordinary
 // function as Metadata mutator.
 type MetadataFunc func(context.Context, *ent.MetadataMutation) (ent.Value, error)
@@ -189,6 +225,18 @@ func (f NotificationTypeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationTypeMutation", m)
 }
 
+// The OAuthLinkFunc type is an adapter to allow the use of ordinary
+// function as OAuthLink mutator.
+type OAuthLinkFunc func(context.Context, *ent.OAuthLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OAuthLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OAuthLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OAuthLinkMutation", m)
+}
+
 // The PageFunc type is an adapter to allow the use of ordinary
 // function as Page mutator.
 type PageFunc func(context.Context, *ent.PageMutation) (ent.Value, error)
@@ -225,6 +273,30 @@ func (f PostTagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PostTagMutation", m)
 }
 
+// The ReactionCountFunc type is an adapter to allow the use of ordinary
+// function as ReactionCount mutator.
+type ReactionCountFunc func(context.Context, *ent.ReactionCountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReactionCountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReactionCountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReactionCountMutation", m)
+}
+
+// The RedirectRuleFunc type is an adapter to allow the use of ordinary
+// function as RedirectRule mutator.
+type RedirectRuleFunc func(context.Context, *ent.RedirectRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RedirectRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RedirectRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RedirectRuleMutation", m)
+}
+
 // The SettingFunc type is an adapter to allow the use of ordinary
 // function as Setting mutator.
 type SettingFunc func(context.Context, *ent.SettingMutation) (ent.Value, error)
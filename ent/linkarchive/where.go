@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package linkarchive
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLTE(FieldID, id))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldURL, v))
+}
+
+// ArchivedURL applies equality check predicate on the "archived_url" field. It's identical to ArchivedURLEQ.
+func ArchivedURL(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldArchivedURL, v))
+}
+
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldAttempts, v))
+}
+
+// LastAttempt applies equality check predicate on the "last_attempt" field. It's identical to LastAttemptEQ.
+func LastAttempt(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldLastAttempt, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldContainsFold(FieldURL, v))
+}
+
+// ArchivedURLEQ applies the EQ predicate on the "archived_url" field.
+func ArchivedURLEQ(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldArchivedURL, v))
+}
+
+// ArchivedURLNEQ applies the NEQ predicate on the "archived_url" field.
+func ArchivedURLNEQ(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNEQ(FieldArchivedURL, v))
+}
+
+// ArchivedURLIn applies the In predicate on the "archived_url" field.
+func ArchivedURLIn(vs ...string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIn(FieldArchivedURL, vs...))
+}
+
+// ArchivedURLNotIn applies the NotIn predicate on the "archived_url" field.
+func ArchivedURLNotIn(vs ...string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotIn(FieldArchivedURL, vs...))
+}
+
+// ArchivedURLGT applies the GT predicate on the "archived_url" field.
+func ArchivedURLGT(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGT(FieldArchivedURL, v))
+}
+
+// ArchivedURLGTE applies the GTE predicate on the "archived_url" field.
+func ArchivedURLGTE(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGTE(FieldArchivedURL, v))
+}
+
+// ArchivedURLLT applies the LT predicate on the "archived_url" field.
+func ArchivedURLLT(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLT(FieldArchivedURL, v))
+}
+
+// ArchivedURLLTE applies the LTE predicate on the "archived_url" field.
+func ArchivedURLLTE(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLTE(FieldArchivedURL, v))
+}
+
+// ArchivedURLContains applies the Contains predicate on the "archived_url" field.
+func ArchivedURLContains(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldContains(FieldArchivedURL, v))
+}
+
+// ArchivedURLHasPrefix applies the HasPrefix predicate on the "archived_url" field.
+func ArchivedURLHasPrefix(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldHasPrefix(FieldArchivedURL, v))
+}
+
+// ArchivedURLHasSuffix applies the HasSuffix predicate on the "archived_url" field.
+func ArchivedURLHasSuffix(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldHasSuffix(FieldArchivedURL, v))
+}
+
+// ArchivedURLIsNil applies the IsNil predicate on the "archived_url" field.
+func ArchivedURLIsNil() predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIsNull(FieldArchivedURL))
+}
+
+// ArchivedURLNotNil applies the NotNil predicate on the "archived_url" field.
+func ArchivedURLNotNil() predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotNull(FieldArchivedURL))
+}
+
+// ArchivedURLEqualFold applies the EqualFold predicate on the "archived_url" field.
+func ArchivedURLEqualFold(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEqualFold(FieldArchivedURL, v))
+}
+
+// ArchivedURLContainsFold applies the ContainsFold predicate on the "archived_url" field.
+func ArchivedURLContainsFold(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldContainsFold(FieldArchivedURL, v))
+}
+
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtIsNil applies the IsNil predicate on the "archived_at" field.
+func ArchivedAtIsNil() predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIsNull(FieldArchivedAt))
+}
+
+// ArchivedAtNotNil applies the NotNil predicate on the "archived_at" field.
+func ArchivedAtNotNil() predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotNull(FieldArchivedAt))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLTE(FieldAttempts, v))
+}
+
+// LastAttemptEQ applies the EQ predicate on the "last_attempt" field.
+func LastAttemptEQ(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldLastAttempt, v))
+}
+
+// LastAttemptNEQ applies the NEQ predicate on the "last_attempt" field.
+func LastAttemptNEQ(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNEQ(FieldLastAttempt, v))
+}
+
+// LastAttemptIn applies the In predicate on the "last_attempt" field.
+func LastAttemptIn(vs ...time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIn(FieldLastAttempt, vs...))
+}
+
+// LastAttemptNotIn applies the NotIn predicate on the "last_attempt" field.
+func LastAttemptNotIn(vs ...time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotIn(FieldLastAttempt, vs...))
+}
+
+// LastAttemptGT applies the GT predicate on the "last_attempt" field.
+func LastAttemptGT(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGT(FieldLastAttempt, v))
+}
+
+// LastAttemptGTE applies the GTE predicate on the "last_attempt" field.
+func LastAttemptGTE(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGTE(FieldLastAttempt, v))
+}
+
+// LastAttemptLT applies the LT predicate on the "last_attempt" field.
+func LastAttemptLT(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLT(FieldLastAttempt, v))
+}
+
+// LastAttemptLTE applies the LTE predicate on the "last_attempt" field.
+func LastAttemptLTE(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLTE(FieldLastAttempt, v))
+}
+
+// LastAttemptIsNil applies the IsNil predicate on the "last_attempt" field.
+func LastAttemptIsNil() predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIsNull(FieldLastAttempt))
+}
+
+// LastAttemptNotNil applies the NotNil predicate on the "last_attempt" field.
+func LastAttemptNotNil() predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotNull(FieldLastAttempt))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LinkArchive) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LinkArchive) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LinkArchive) predicate.LinkArchive {
+	return predicate.LinkArchive(sql.NotPredicates(p))
+}
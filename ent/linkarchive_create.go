@@ -0,0 +1,929 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/linkarchive"
+)
+
+// LinkArchiveCreate is the builder for creating a LinkArchive entity.
+type LinkArchiveCreate struct {
+	config
+	mutation *LinkArchiveMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetURL sets the "url" field.
+func (_c *LinkArchiveCreate) SetURL(v string) *LinkArchiveCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetArchivedURL sets the "archived_url" field.
+func (_c *LinkArchiveCreate) SetArchivedURL(v string) *LinkArchiveCreate {
+	_c.mutation.SetArchivedURL(v)
+	return _c
+}
+
+// SetNillableArchivedURL sets the "archived_url" field if the given value is not nil.
+func (_c *LinkArchiveCreate) SetNillableArchivedURL(v *string) *LinkArchiveCreate {
+	if v != nil {
+		_c.SetArchivedURL(*v)
+	}
+	return _c
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_c *LinkArchiveCreate) SetArchivedAt(v time.Time) *LinkArchiveCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *LinkArchiveCreate) SetNillableArchivedAt(v *time.Time) *LinkArchiveCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *LinkArchiveCreate) SetAttempts(v int) *LinkArchiveCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *LinkArchiveCreate) SetNillableAttempts(v *int) *LinkArchiveCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastAttempt sets the "last_attempt" field.
+func (_c *LinkArchiveCreate) SetLastAttempt(v time.Time) *LinkArchiveCreate {
+	_c.mutation.SetLastAttempt(v)
+	return _c
+}
+
+// SetNillableLastAttempt sets the "last_attempt" field if the given value is not nil.
+func (_c *LinkArchiveCreate) SetNillableLastAttempt(v *time.Time) *LinkArchiveCreate {
+	if v != nil {
+		_c.SetLastAttempt(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *LinkArchiveCreate) SetError(v string) *LinkArchiveCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *LinkArchiveCreate) SetNillableError(v *string) *LinkArchiveCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LinkArchiveCreate) SetCreatedAt(v time.Time) *LinkArchiveCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LinkArchiveCreate) SetNillableCreatedAt(v *time.Time) *LinkArchiveCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LinkArchiveCreate) SetID(v uint) *LinkArchiveCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LinkArchiveMutation object of the builder.
+func (_c *LinkArchiveCreate) Mutation() *LinkArchiveMutation {
+	return _c.mutation
+}
+
+// Save creates the LinkArchive in the database.
+func (_c *LinkArchiveCreate) Save(ctx context.Context) (*LinkArchive, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LinkArchiveCreate) SaveX(ctx context.Context) *LinkArchive {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkArchiveCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkArchiveCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LinkArchiveCreate) defaults() {
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := linkarchive.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := linkarchive.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LinkArchiveCreate) check() error {
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "LinkArchive.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := linkarchive.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LinkArchive.url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "LinkArchive.attempts"`)}
+	}
+	if v, ok := _c.mutation.Error(); ok {
+		if err := linkarchive.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "LinkArchive.error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LinkArchive.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LinkArchiveCreate) sqlSave(ctx context.Context) (*LinkArchive, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LinkArchiveCreate) createSpec() (*LinkArchive, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LinkArchive{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(linkarchive.Table, sqlgraph.NewFieldSpec(linkarchive.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(linkarchive.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.ArchivedURL(); ok {
+		_spec.SetField(linkarchive.FieldArchivedURL, field.TypeString, value)
+		_node.ArchivedURL = value
+	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(linkarchive.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(linkarchive.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LastAttempt(); ok {
+		_spec.SetField(linkarchive.FieldLastAttempt, field.TypeTime, value)
+		_node.LastAttempt = &value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(linkarchive.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(linkarchive.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LinkArchive.Create().
+//		SetURL(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LinkArchiveUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LinkArchiveCreate) OnConflict(opts ...sql.ConflictOption) *LinkArchiveUpsertOne {
+	_c.conflict = opts
+	return &LinkArchiveUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LinkArchive.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LinkArchiveCreate) OnConflictColumns(columns ...string) *LinkArchiveUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LinkArchiveUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LinkArchiveUpsertOne is the builder for "upsert"-ing
+	//  one LinkArchive node.
+	LinkArchiveUpsertOne struct {
+		create *LinkArchiveCreate
+	}
+
+	// LinkArchiveUpsert is the "OnConflict" setter.
+	LinkArchiveUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetURL sets the "url" field.
+func (u *LinkArchiveUpsert) SetURL(v string) *LinkArchiveUpsert {
+	u.Set(linkarchive.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *LinkArchiveUpsert) UpdateURL() *LinkArchiveUpsert {
+	u.SetExcluded(linkarchive.FieldURL)
+	return u
+}
+
+// SetArchivedURL sets the "archived_url" field.
+func (u *LinkArchiveUpsert) SetArchivedURL(v string) *LinkArchiveUpsert {
+	u.Set(linkarchive.FieldArchivedURL, v)
+	return u
+}
+
+// UpdateArchivedURL sets the "archived_url" field to the value that was provided on create.
+func (u *LinkArchiveUpsert) UpdateArchivedURL() *LinkArchiveUpsert {
+	u.SetExcluded(linkarchive.FieldArchivedURL)
+	return u
+}
+
+// ClearArchivedURL clears the value of the "archived_url" field.
+func (u *LinkArchiveUpsert) ClearArchivedURL() *LinkArchiveUpsert {
+	u.SetNull(linkarchive.FieldArchivedURL)
+	return u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *LinkArchiveUpsert) SetArchivedAt(v time.Time) *LinkArchiveUpsert {
+	u.Set(linkarchive.FieldArchivedAt, v)
+	return u
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *LinkArchiveUpsert) UpdateArchivedAt() *LinkArchiveUpsert {
+	u.SetExcluded(linkarchive.FieldArchivedAt)
+	return u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *LinkArchiveUpsert) ClearArchivedAt() *LinkArchiveUpsert {
+	u.SetNull(linkarchive.FieldArchivedAt)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *LinkArchiveUpsert) SetAttempts(v int) *LinkArchiveUpsert {
+	u.Set(linkarchive.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *LinkArchiveUpsert) UpdateAttempts() *LinkArchiveUpsert {
+	u.SetExcluded(linkarchive.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *LinkArchiveUpsert) AddAttempts(v int) *LinkArchiveUpsert {
+	u.Add(linkarchive.FieldAttempts, v)
+	return u
+}
+
+// SetLastAttempt sets the "last_attempt" field.
+func (u *LinkArchiveUpsert) SetLastAttempt(v time.Time) *LinkArchiveUpsert {
+	u.Set(linkarchive.FieldLastAttempt, v)
+	return u
+}
+
+// UpdateLastAttempt sets the "last_attempt" field to the value that was provided on create.
+func (u *LinkArchiveUpsert) UpdateLastAttempt() *LinkArchiveUpsert {
+	u.SetExcluded(linkarchive.FieldLastAttempt)
+	return u
+}
+
+// ClearLastAttempt clears the value of the "last_attempt" field.
+func (u *LinkArchiveUpsert) ClearLastAttempt() *LinkArchiveUpsert {
+	u.SetNull(linkarchive.FieldLastAttempt)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *LinkArchiveUpsert) SetError(v string) *LinkArchiveUpsert {
+	u.Set(linkarchive.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *LinkArchiveUpsert) UpdateError() *LinkArchiveUpsert {
+	u.SetExcluded(linkarchive.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *LinkArchiveUpsert) ClearError() *LinkArchiveUpsert {
+	u.SetNull(linkarchive.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LinkArchive.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(linkarchive.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LinkArchiveUpsertOne) UpdateNewValues() *LinkArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(linkarchive.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(linkarchive.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LinkArchive.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LinkArchiveUpsertOne) Ignore() *LinkArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LinkArchiveUpsertOne) DoNothing() *LinkArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LinkArchiveCreate.OnConflict
+// documentation for more info.
+func (u *LinkArchiveUpsertOne) Update(set func(*LinkArchiveUpsert)) *LinkArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LinkArchiveUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *LinkArchiveUpsertOne) SetURL(v string) *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *LinkArchiveUpsertOne) UpdateURL() *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetArchivedURL sets the "archived_url" field.
+func (u *LinkArchiveUpsertOne) SetArchivedURL(v string) *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetArchivedURL(v)
+	})
+}
+
+// UpdateArchivedURL sets the "archived_url" field to the value that was provided on create.
+func (u *LinkArchiveUpsertOne) UpdateArchivedURL() *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateArchivedURL()
+	})
+}
+
+// ClearArchivedURL clears the value of the "archived_url" field.
+func (u *LinkArchiveUpsertOne) ClearArchivedURL() *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.ClearArchivedURL()
+	})
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *LinkArchiveUpsertOne) SetArchivedAt(v time.Time) *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *LinkArchiveUpsertOne) UpdateArchivedAt() *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *LinkArchiveUpsertOne) ClearArchivedAt() *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *LinkArchiveUpsertOne) SetAttempts(v int) *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *LinkArchiveUpsertOne) AddAttempts(v int) *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *LinkArchiveUpsertOne) UpdateAttempts() *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastAttempt sets the "last_attempt" field.
+func (u *LinkArchiveUpsertOne) SetLastAttempt(v time.Time) *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetLastAttempt(v)
+	})
+}
+
+// UpdateLastAttempt sets the "last_attempt" field to the value that was provided on create.
+func (u *LinkArchiveUpsertOne) UpdateLastAttempt() *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateLastAttempt()
+	})
+}
+
+// ClearLastAttempt clears the value of the "last_attempt" field.
+func (u *LinkArchiveUpsertOne) ClearLastAttempt() *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.ClearLastAttempt()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *LinkArchiveUpsertOne) SetError(v string) *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *LinkArchiveUpsertOne) UpdateError() *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *LinkArchiveUpsertOne) ClearError() *LinkArchiveUpsertOne {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *LinkArchiveUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LinkArchiveCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LinkArchiveUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LinkArchiveUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LinkArchiveUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LinkArchiveCreateBulk is the builder for creating many LinkArchive entities in bulk.
+type LinkArchiveCreateBulk struct {
+	config
+	err      error
+	builders []*LinkArchiveCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LinkArchive entities in the database.
+func (_c *LinkArchiveCreateBulk) Save(ctx context.Context) ([]*LinkArchive, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LinkArchive, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LinkArchiveMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LinkArchiveCreateBulk) SaveX(ctx context.Context) []*LinkArchive {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkArchiveCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkArchiveCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LinkArchive.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LinkArchiveUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LinkArchiveCreateBulk) OnConflict(opts ...sql.ConflictOption) *LinkArchiveUpsertBulk {
+	_c.conflict = opts
+	return &LinkArchiveUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LinkArchive.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LinkArchiveCreateBulk) OnConflictColumns(columns ...string) *LinkArchiveUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LinkArchiveUpsertBulk{
+		create: _c,
+	}
+}
+
+// LinkArchiveUpsertBulk is the builder for "upsert"-ing
+// a bulk of LinkArchive nodes.
+type LinkArchiveUpsertBulk struct {
+	create *LinkArchiveCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LinkArchive.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(linkarchive.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LinkArchiveUpsertBulk) UpdateNewValues() *LinkArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(linkarchive.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(linkarchive.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LinkArchive.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LinkArchiveUpsertBulk) Ignore() *LinkArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LinkArchiveUpsertBulk) DoNothing() *LinkArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LinkArchiveCreateBulk.OnConflict
+// documentation for more info.
+func (u *LinkArchiveUpsertBulk) Update(set func(*LinkArchiveUpsert)) *LinkArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LinkArchiveUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *LinkArchiveUpsertBulk) SetURL(v string) *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *LinkArchiveUpsertBulk) UpdateURL() *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetArchivedURL sets the "archived_url" field.
+func (u *LinkArchiveUpsertBulk) SetArchivedURL(v string) *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetArchivedURL(v)
+	})
+}
+
+// UpdateArchivedURL sets the "archived_url" field to the value that was provided on create.
+func (u *LinkArchiveUpsertBulk) UpdateArchivedURL() *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateArchivedURL()
+	})
+}
+
+// ClearArchivedURL clears the value of the "archived_url" field.
+func (u *LinkArchiveUpsertBulk) ClearArchivedURL() *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.ClearArchivedURL()
+	})
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *LinkArchiveUpsertBulk) SetArchivedAt(v time.Time) *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *LinkArchiveUpsertBulk) UpdateArchivedAt() *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *LinkArchiveUpsertBulk) ClearArchivedAt() *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *LinkArchiveUpsertBulk) SetAttempts(v int) *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *LinkArchiveUpsertBulk) AddAttempts(v int) *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *LinkArchiveUpsertBulk) UpdateAttempts() *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastAttempt sets the "last_attempt" field.
+func (u *LinkArchiveUpsertBulk) SetLastAttempt(v time.Time) *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetLastAttempt(v)
+	})
+}
+
+// UpdateLastAttempt sets the "last_attempt" field to the value that was provided on create.
+func (u *LinkArchiveUpsertBulk) UpdateLastAttempt() *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateLastAttempt()
+	})
+}
+
+// ClearLastAttempt clears the value of the "last_attempt" field.
+func (u *LinkArchiveUpsertBulk) ClearLastAttempt() *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.ClearLastAttempt()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *LinkArchiveUpsertBulk) SetError(v string) *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *LinkArchiveUpsertBulk) UpdateError() *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *LinkArchiveUpsertBulk) ClearError() *LinkArchiveUpsertBulk {
+	return u.Update(func(s *LinkArchiveUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *LinkArchiveUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LinkArchiveCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LinkArchiveCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LinkArchiveUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,524 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/linkarchive"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// LinkArchiveUpdate is the builder for updating LinkArchive entities.
+type LinkArchiveUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LinkArchiveMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LinkArchiveUpdate builder.
+func (_u *LinkArchiveUpdate) Where(ps ...predicate.LinkArchive) *LinkArchiveUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *LinkArchiveUpdate) SetURL(v string) *LinkArchiveUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *LinkArchiveUpdate) SetNillableURL(v *string) *LinkArchiveUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetArchivedURL sets the "archived_url" field.
+func (_u *LinkArchiveUpdate) SetArchivedURL(v string) *LinkArchiveUpdate {
+	_u.mutation.SetArchivedURL(v)
+	return _u
+}
+
+// SetNillableArchivedURL sets the "archived_url" field if the given value is not nil.
+func (_u *LinkArchiveUpdate) SetNillableArchivedURL(v *string) *LinkArchiveUpdate {
+	if v != nil {
+		_u.SetArchivedURL(*v)
+	}
+	return _u
+}
+
+// ClearArchivedURL clears the value of the "archived_url" field.
+func (_u *LinkArchiveUpdate) ClearArchivedURL() *LinkArchiveUpdate {
+	_u.mutation.ClearArchivedURL()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *LinkArchiveUpdate) SetArchivedAt(v time.Time) *LinkArchiveUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *LinkArchiveUpdate) SetNillableArchivedAt(v *time.Time) *LinkArchiveUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *LinkArchiveUpdate) ClearArchivedAt() *LinkArchiveUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *LinkArchiveUpdate) SetAttempts(v int) *LinkArchiveUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *LinkArchiveUpdate) SetNillableAttempts(v *int) *LinkArchiveUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *LinkArchiveUpdate) AddAttempts(v int) *LinkArchiveUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastAttempt sets the "last_attempt" field.
+func (_u *LinkArchiveUpdate) SetLastAttempt(v time.Time) *LinkArchiveUpdate {
+	_u.mutation.SetLastAttempt(v)
+	return _u
+}
+
+// SetNillableLastAttempt sets the "last_attempt" field if the given value is not nil.
+func (_u *LinkArchiveUpdate) SetNillableLastAttempt(v *time.Time) *LinkArchiveUpdate {
+	if v != nil {
+		_u.SetLastAttempt(*v)
+	}
+	return _u
+}
+
+// ClearLastAttempt clears the value of the "last_attempt" field.
+func (_u *LinkArchiveUpdate) ClearLastAttempt() *LinkArchiveUpdate {
+	_u.mutation.ClearLastAttempt()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *LinkArchiveUpdate) SetError(v string) *LinkArchiveUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *LinkArchiveUpdate) SetNillableError(v *string) *LinkArchiveUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *LinkArchiveUpdate) ClearError() *LinkArchiveUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// Mutation returns the LinkArchiveMutation object of the builder.
+func (_u *LinkArchiveUpdate) Mutation() *LinkArchiveMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LinkArchiveUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkArchiveUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LinkArchiveUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkArchiveUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkArchiveUpdate) check() error {
+	if v, ok := _u.mutation.URL(); ok {
+		if err := linkarchive.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LinkArchive.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := linkarchive.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "LinkArchive.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LinkArchiveUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LinkArchiveUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LinkArchiveUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkarchive.Table, linkarchive.Columns, sqlgraph.NewFieldSpec(linkarchive.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(linkarchive.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ArchivedURL(); ok {
+		_spec.SetField(linkarchive.FieldArchivedURL, field.TypeString, value)
+	}
+	if _u.mutation.ArchivedURLCleared() {
+		_spec.ClearField(linkarchive.FieldArchivedURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(linkarchive.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(linkarchive.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(linkarchive.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(linkarchive.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastAttempt(); ok {
+		_spec.SetField(linkarchive.FieldLastAttempt, field.TypeTime, value)
+	}
+	if _u.mutation.LastAttemptCleared() {
+		_spec.ClearField(linkarchive.FieldLastAttempt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(linkarchive.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(linkarchive.FieldError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkarchive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LinkArchiveUpdateOne is the builder for updating a single LinkArchive entity.
+type LinkArchiveUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LinkArchiveMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetURL sets the "url" field.
+func (_u *LinkArchiveUpdateOne) SetURL(v string) *LinkArchiveUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *LinkArchiveUpdateOne) SetNillableURL(v *string) *LinkArchiveUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetArchivedURL sets the "archived_url" field.
+func (_u *LinkArchiveUpdateOne) SetArchivedURL(v string) *LinkArchiveUpdateOne {
+	_u.mutation.SetArchivedURL(v)
+	return _u
+}
+
+// SetNillableArchivedURL sets the "archived_url" field if the given value is not nil.
+func (_u *LinkArchiveUpdateOne) SetNillableArchivedURL(v *string) *LinkArchiveUpdateOne {
+	if v != nil {
+		_u.SetArchivedURL(*v)
+	}
+	return _u
+}
+
+// ClearArchivedURL clears the value of the "archived_url" field.
+func (_u *LinkArchiveUpdateOne) ClearArchivedURL() *LinkArchiveUpdateOne {
+	_u.mutation.ClearArchivedURL()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *LinkArchiveUpdateOne) SetArchivedAt(v time.Time) *LinkArchiveUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *LinkArchiveUpdateOne) SetNillableArchivedAt(v *time.Time) *LinkArchiveUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *LinkArchiveUpdateOne) ClearArchivedAt() *LinkArchiveUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *LinkArchiveUpdateOne) SetAttempts(v int) *LinkArchiveUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *LinkArchiveUpdateOne) SetNillableAttempts(v *int) *LinkArchiveUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *LinkArchiveUpdateOne) AddAttempts(v int) *LinkArchiveUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastAttempt sets the "last_attempt" field.
+func (_u *LinkArchiveUpdateOne) SetLastAttempt(v time.Time) *LinkArchiveUpdateOne {
+	_u.mutation.SetLastAttempt(v)
+	return _u
+}
+
+// SetNillableLastAttempt sets the "last_attempt" field if the given value is not nil.
+func (_u *LinkArchiveUpdateOne) SetNillableLastAttempt(v *time.Time) *LinkArchiveUpdateOne {
+	if v != nil {
+		_u.SetLastAttempt(*v)
+	}
+	return _u
+}
+
+// ClearLastAttempt clears the value of the "last_attempt" field.
+func (_u *LinkArchiveUpdateOne) ClearLastAttempt() *LinkArchiveUpdateOne {
+	_u.mutation.ClearLastAttempt()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *LinkArchiveUpdateOne) SetError(v string) *LinkArchiveUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *LinkArchiveUpdateOne) SetNillableError(v *string) *LinkArchiveUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *LinkArchiveUpdateOne) ClearError() *LinkArchiveUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// Mutation returns the LinkArchiveMutation object of the builder.
+func (_u *LinkArchiveUpdateOne) Mutation() *LinkArchiveMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LinkArchiveUpdate builder.
+func (_u *LinkArchiveUpdateOne) Where(ps ...predicate.LinkArchive) *LinkArchiveUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LinkArchiveUpdateOne) Select(field string, fields ...string) *LinkArchiveUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LinkArchive entity.
+func (_u *LinkArchiveUpdateOne) Save(ctx context.Context) (*LinkArchive, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkArchiveUpdateOne) SaveX(ctx context.Context) *LinkArchive {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LinkArchiveUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkArchiveUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkArchiveUpdateOne) check() error {
+	if v, ok := _u.mutation.URL(); ok {
+		if err := linkarchive.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LinkArchive.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := linkarchive.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "LinkArchive.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LinkArchiveUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LinkArchiveUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LinkArchiveUpdateOne) sqlSave(ctx context.Context) (_node *LinkArchive, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkarchive.Table, linkarchive.Columns, sqlgraph.NewFieldSpec(linkarchive.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LinkArchive.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, linkarchive.FieldID)
+		for _, f := range fields {
+			if !linkarchive.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != linkarchive.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(linkarchive.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ArchivedURL(); ok {
+		_spec.SetField(linkarchive.FieldArchivedURL, field.TypeString, value)
+	}
+	if _u.mutation.ArchivedURLCleared() {
+		_spec.ClearField(linkarchive.FieldArchivedURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(linkarchive.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(linkarchive.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(linkarchive.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(linkarchive.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastAttempt(); ok {
+		_spec.SetField(linkarchive.FieldLastAttempt, field.TypeTime, value)
+	}
+	if _u.mutation.LastAttemptCleared() {
+		_spec.ClearField(linkarchive.FieldLastAttempt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(linkarchive.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(linkarchive.FieldError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LinkArchive{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkarchive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,808 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/logindevice"
+	"github.com/anzhiyu-c/anheyu-app/ent/user"
+)
+
+// LoginDeviceCreate is the builder for creating a LoginDevice entity.
+type LoginDeviceCreate struct {
+	config
+	mutation *LoginDeviceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LoginDeviceCreate) SetCreatedAt(v time.Time) *LoginDeviceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LoginDeviceCreate) SetNillableCreatedAt(v *time.Time) *LoginDeviceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LoginDeviceCreate) SetUserID(v uint) *LoginDeviceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_c *LoginDeviceCreate) SetFingerprint(v string) *LoginDeviceCreate {
+	_c.mutation.SetFingerprint(v)
+	return _c
+}
+
+// SetDevice sets the "device" field.
+func (_c *LoginDeviceCreate) SetDevice(v string) *LoginDeviceCreate {
+	_c.mutation.SetDevice(v)
+	return _c
+}
+
+// SetLocation sets the "location" field.
+func (_c *LoginDeviceCreate) SetLocation(v string) *LoginDeviceCreate {
+	_c.mutation.SetLocation(v)
+	return _c
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_c *LoginDeviceCreate) SetNillableLocation(v *string) *LoginDeviceCreate {
+	if v != nil {
+		_c.SetLocation(*v)
+	}
+	return _c
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (_c *LoginDeviceCreate) SetLastSeen(v time.Time) *LoginDeviceCreate {
+	_c.mutation.SetLastSeen(v)
+	return _c
+}
+
+// SetNillableLastSeen sets the "last_seen" field if the given value is not nil.
+func (_c *LoginDeviceCreate) SetNillableLastSeen(v *time.Time) *LoginDeviceCreate {
+	if v != nil {
+		_c.SetLastSeen(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LoginDeviceCreate) SetID(v uint) *LoginDeviceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LoginDeviceCreate) SetUser(v *User) *LoginDeviceCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the LoginDeviceMutation object of the builder.
+func (_c *LoginDeviceCreate) Mutation() *LoginDeviceMutation {
+	return _c.mutation
+}
+
+// Save creates the LoginDevice in the database.
+func (_c *LoginDeviceCreate) Save(ctx context.Context) (*LoginDevice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LoginDeviceCreate) SaveX(ctx context.Context) *LoginDevice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoginDeviceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoginDeviceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LoginDeviceCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := logindevice.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.LastSeen(); !ok {
+		v := logindevice.DefaultLastSeen()
+		_c.mutation.SetLastSeen(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LoginDeviceCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginDevice.created_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LoginDevice.user_id"`)}
+	}
+	if _, ok := _c.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`ent: missing required field "LoginDevice.fingerprint"`)}
+	}
+	if v, ok := _c.mutation.Fingerprint(); ok {
+		if err := logindevice.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "LoginDevice.fingerprint": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Device(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`ent: missing required field "LoginDevice.device"`)}
+	}
+	if v, ok := _c.mutation.Device(); ok {
+		if err := logindevice.DeviceValidator(v); err != nil {
+			return &ValidationError{Name: "device", err: fmt.Errorf(`ent: validator failed for field "LoginDevice.device": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Location(); ok {
+		if err := logindevice.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "LoginDevice.location": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastSeen(); !ok {
+		return &ValidationError{Name: "last_seen", err: errors.New(`ent: missing required field "LoginDevice.last_seen"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LoginDevice.user"`)}
+	}
+	return nil
+}
+
+func (_c *LoginDeviceCreate) sqlSave(ctx context.Context) (*LoginDevice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LoginDeviceCreate) createSpec() (*LoginDevice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginDevice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(logindevice.Table, sqlgraph.NewFieldSpec(logindevice.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(logindevice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Fingerprint(); ok {
+		_spec.SetField(logindevice.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := _c.mutation.Device(); ok {
+		_spec.SetField(logindevice.FieldDevice, field.TypeString, value)
+		_node.Device = value
+	}
+	if value, ok := _c.mutation.Location(); ok {
+		_spec.SetField(logindevice.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := _c.mutation.LastSeen(); ok {
+		_spec.SetField(logindevice.FieldLastSeen, field.TypeTime, value)
+		_node.LastSeen = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   logindevice.UserTable,
+			Columns: []string{logindevice.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginDevice.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginDeviceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LoginDeviceCreate) OnConflict(opts ...sql.ConflictOption) *LoginDeviceUpsertOne {
+	_c.conflict = opts
+	return &LoginDeviceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginDevice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LoginDeviceCreate) OnConflictColumns(columns ...string) *LoginDeviceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LoginDeviceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LoginDeviceUpsertOne is the builder for "upsert"-ing
+	//  one LoginDevice node.
+	LoginDeviceUpsertOne struct {
+		create *LoginDeviceCreate
+	}
+
+	// LoginDeviceUpsert is the "OnConflict" setter.
+	LoginDeviceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *LoginDeviceUpsert) SetUserID(v uint) *LoginDeviceUpsert {
+	u.Set(logindevice.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LoginDeviceUpsert) UpdateUserID() *LoginDeviceUpsert {
+	u.SetExcluded(logindevice.FieldUserID)
+	return u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *LoginDeviceUpsert) SetFingerprint(v string) *LoginDeviceUpsert {
+	u.Set(logindevice.FieldFingerprint, v)
+	return u
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *LoginDeviceUpsert) UpdateFingerprint() *LoginDeviceUpsert {
+	u.SetExcluded(logindevice.FieldFingerprint)
+	return u
+}
+
+// SetDevice sets the "device" field.
+func (u *LoginDeviceUpsert) SetDevice(v string) *LoginDeviceUpsert {
+	u.Set(logindevice.FieldDevice, v)
+	return u
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *LoginDeviceUpsert) UpdateDevice() *LoginDeviceUpsert {
+	u.SetExcluded(logindevice.FieldDevice)
+	return u
+}
+
+// SetLocation sets the "location" field.
+func (u *LoginDeviceUpsert) SetLocation(v string) *LoginDeviceUpsert {
+	u.Set(logindevice.FieldLocation, v)
+	return u
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *LoginDeviceUpsert) UpdateLocation() *LoginDeviceUpsert {
+	u.SetExcluded(logindevice.FieldLocation)
+	return u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (u *LoginDeviceUpsert) ClearLocation() *LoginDeviceUpsert {
+	u.SetNull(logindevice.FieldLocation)
+	return u
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *LoginDeviceUpsert) SetLastSeen(v time.Time) *LoginDeviceUpsert {
+	u.Set(logindevice.FieldLastSeen, v)
+	return u
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *LoginDeviceUpsert) UpdateLastSeen() *LoginDeviceUpsert {
+	u.SetExcluded(logindevice.FieldLastSeen)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LoginDevice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(logindevice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoginDeviceUpsertOne) UpdateNewValues() *LoginDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(logindevice.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(logindevice.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginDevice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LoginDeviceUpsertOne) Ignore() *LoginDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginDeviceUpsertOne) DoNothing() *LoginDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginDeviceCreate.OnConflict
+// documentation for more info.
+func (u *LoginDeviceUpsertOne) Update(set func(*LoginDeviceUpsert)) *LoginDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginDeviceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LoginDeviceUpsertOne) SetUserID(v uint) *LoginDeviceUpsertOne {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LoginDeviceUpsertOne) UpdateUserID() *LoginDeviceUpsertOne {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *LoginDeviceUpsertOne) SetFingerprint(v string) *LoginDeviceUpsertOne {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *LoginDeviceUpsertOne) UpdateFingerprint() *LoginDeviceUpsertOne {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// SetDevice sets the "device" field.
+func (u *LoginDeviceUpsertOne) SetDevice(v string) *LoginDeviceUpsertOne {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.SetDevice(v)
+	})
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *LoginDeviceUpsertOne) UpdateDevice() *LoginDeviceUpsertOne {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.UpdateDevice()
+	})
+}
+
+// SetLocation sets the "location" field.
+func (u *LoginDeviceUpsertOne) SetLocation(v string) *LoginDeviceUpsertOne {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.SetLocation(v)
+	})
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *LoginDeviceUpsertOne) UpdateLocation() *LoginDeviceUpsertOne {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.UpdateLocation()
+	})
+}
+
+// ClearLocation clears the value of the "location" field.
+func (u *LoginDeviceUpsertOne) ClearLocation() *LoginDeviceUpsertOne {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.ClearLocation()
+	})
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *LoginDeviceUpsertOne) SetLastSeen(v time.Time) *LoginDeviceUpsertOne {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.SetLastSeen(v)
+	})
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *LoginDeviceUpsertOne) UpdateLastSeen() *LoginDeviceUpsertOne {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.UpdateLastSeen()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginDeviceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginDeviceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginDeviceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LoginDeviceUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LoginDeviceUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LoginDeviceCreateBulk is the builder for creating many LoginDevice entities in bulk.
+type LoginDeviceCreateBulk struct {
+	config
+	err      error
+	builders []*LoginDeviceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LoginDevice entities in the database.
+func (_c *LoginDeviceCreateBulk) Save(ctx context.Context) ([]*LoginDevice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LoginDevice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginDeviceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LoginDeviceCreateBulk) SaveX(ctx context.Context) []*LoginDevice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoginDeviceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoginDeviceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginDevice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginDeviceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LoginDeviceCreateBulk) OnConflict(opts ...sql.ConflictOption) *LoginDeviceUpsertBulk {
+	_c.conflict = opts
+	return &LoginDeviceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginDevice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LoginDeviceCreateBulk) OnConflictColumns(columns ...string) *LoginDeviceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LoginDeviceUpsertBulk{
+		create: _c,
+	}
+}
+
+// LoginDeviceUpsertBulk is the builder for "upsert"-ing
+// a bulk of LoginDevice nodes.
+type LoginDeviceUpsertBulk struct {
+	create *LoginDeviceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LoginDevice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(logindevice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoginDeviceUpsertBulk) UpdateNewValues() *LoginDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(logindevice.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(logindevice.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginDevice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LoginDeviceUpsertBulk) Ignore() *LoginDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginDeviceUpsertBulk) DoNothing() *LoginDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginDeviceCreateBulk.OnConflict
+// documentation for more info.
+func (u *LoginDeviceUpsertBulk) Update(set func(*LoginDeviceUpsert)) *LoginDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginDeviceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LoginDeviceUpsertBulk) SetUserID(v uint) *LoginDeviceUpsertBulk {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LoginDeviceUpsertBulk) UpdateUserID() *LoginDeviceUpsertBulk {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *LoginDeviceUpsertBulk) SetFingerprint(v string) *LoginDeviceUpsertBulk {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *LoginDeviceUpsertBulk) UpdateFingerprint() *LoginDeviceUpsertBulk {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// SetDevice sets the "device" field.
+func (u *LoginDeviceUpsertBulk) SetDevice(v string) *LoginDeviceUpsertBulk {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.SetDevice(v)
+	})
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *LoginDeviceUpsertBulk) UpdateDevice() *LoginDeviceUpsertBulk {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.UpdateDevice()
+	})
+}
+
+// SetLocation sets the "location" field.
+func (u *LoginDeviceUpsertBulk) SetLocation(v string) *LoginDeviceUpsertBulk {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.SetLocation(v)
+	})
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *LoginDeviceUpsertBulk) UpdateLocation() *LoginDeviceUpsertBulk {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.UpdateLocation()
+	})
+}
+
+// ClearLocation clears the value of the "location" field.
+func (u *LoginDeviceUpsertBulk) ClearLocation() *LoginDeviceUpsertBulk {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.ClearLocation()
+	})
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *LoginDeviceUpsertBulk) SetLastSeen(v time.Time) *LoginDeviceUpsertBulk {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.SetLastSeen(v)
+	})
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *LoginDeviceUpsertBulk) UpdateLastSeen() *LoginDeviceUpsertBulk {
+	return u.Update(func(s *LoginDeviceUpsert) {
+		s.UpdateLastSeen()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginDeviceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LoginDeviceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginDeviceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginDeviceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -178,6 +178,47 @@ var (
 			},
 		},
 	}
+	// AuthSessionsColumns holds the columns for the "auth_sessions" table.
+	AuthSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 32, Comment: "会话ID，刷新令牌的前半部分"},
+		{Name: "device", Type: field.TypeString, Nullable: true, Size: 100, Comment: "浏览器和操作系统"},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64, Comment: "最近一次使用的IP"},
+		{Name: "remember_me", Type: field.TypeBool, Comment: "是否勾选记住我", Default: false},
+		{Name: "token_hash", Type: field.TypeString, Size: 64, Comment: "当前刷新令牌密钥的哈希"},
+		{Name: "prev_token_hash", Type: field.TypeString, Nullable: true, Size: 64, Comment: "上一个刷新令牌密钥的哈希，用于识别令牌重放"},
+		{Name: "rotated_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次轮换刷新令牌的时间"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "登录时间"},
+		{Name: "last_used_at", Type: field.TypeTime, Comment: "最近一次刷新时间"},
+		{Name: "expires_at", Type: field.TypeTime, Comment: "过期时间，每次刷新后顺延"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "用户ID"},
+	}
+	// AuthSessionsTable holds the schema information for the "auth_sessions" table.
+	AuthSessionsTable = &schema.Table{
+		Name:       "auth_sessions",
+		Comment:    "登录会话表",
+		Columns:    AuthSessionsColumns,
+		PrimaryKey: []*schema.Column{AuthSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "auth_sessions_users_auth_sessions",
+				Columns:    []*schema.Column{AuthSessionsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "authsession_user_id_last_used_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuthSessionsColumns[10], AuthSessionsColumns[8]},
+			},
+			{
+				Name:    "authsession_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuthSessionsColumns[9]},
+			},
+		},
+	}
 	// CommentsColumns holds the columns for the "comments" table.
 	CommentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -436,6 +477,31 @@ var (
 			},
 		},
 	}
+	// LinkArchivesColumns holds the columns for the "link_archives" table.
+	LinkArchivesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "url", Type: field.TypeString, Unique: true, Size: 500, Comment: "原链接"},
+		{Name: "archived_url", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "存档地址，未存档时为空"},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true, Comment: "存档时间"},
+		{Name: "attempts", Type: field.TypeInt, Comment: "已提交次数", Default: 0},
+		{Name: "last_attempt", Type: field.TypeTime, Nullable: true, Comment: "最近一次提交时间"},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 500, Comment: "最近一次提交失败的原因"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+	}
+	// LinkArchivesTable holds the schema information for the "link_archives" table.
+	LinkArchivesTable = &schema.Table{
+		Name:       "link_archives",
+		Comment:    "外链存档表",
+		Columns:    LinkArchivesColumns,
+		PrimaryKey: []*schema.Column{LinkArchivesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "linkarchive_attempts",
+				Unique:  false,
+				Columns: []*schema.Column{LinkArchivesColumns[4]},
+			},
+		},
+	}
 	// LinkCategoriesColumns holds the columns for the "link_categories" table.
 	LinkCategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -463,6 +529,43 @@ var (
 		Columns:    LinkTagsColumns,
 		PrimaryKey: []*schema.Column{LinkTagsColumns[0]},
 	}
+	// LoginDevicesColumns holds the columns for the "login_devices" table.
+	LoginDevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "fingerprint", Type: field.TypeString, Size: 32, Comment: "设备指纹，设备描述和地区的哈希"},
+		{Name: "device", Type: field.TypeString, Size: 100, Comment: "浏览器和操作系统"},
+		{Name: "location", Type: field.TypeString, Nullable: true, Size: 100, Comment: "登录地区"},
+		{Name: "last_seen", Type: field.TypeTime, Comment: "最后一次登录时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "用户ID"},
+	}
+	// LoginDevicesTable holds the schema information for the "login_devices" table.
+	LoginDevicesTable = &schema.Table{
+		Name:       "login_devices",
+		Comment:    "已知登录设备表",
+		Columns:    LoginDevicesColumns,
+		PrimaryKey: []*schema.Column{LoginDevicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "login_devices_users_login_devices",
+				Columns:    []*schema.Column{LoginDevicesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "logindevice_user_id_fingerprint",
+				Unique:  true,
+				Columns: []*schema.Column{LoginDevicesColumns[6], LoginDevicesColumns[2]},
+			},
+			{
+				Name:    "logindevice_user_id_last_seen",
+				Unique:  false,
+				Columns: []*schema.Column{LoginDevicesColumns[6], LoginDevicesColumns[5]},
+			},
+		},
+	}
 	// MetadataColumns holds the columns for the "metadata" table.
 	MetadataColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -527,6 +630,48 @@ var (
 			},
 		},
 	}
+	// OauthLinksColumns holds the columns for the "oauth_links" table.
+	OauthLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "provider", Type: field.TypeString, Size: 64, Comment: "提供方ID，对应 oauth.providers 配置中的 id"},
+		{Name: "subject", Type: field.TypeString, Size: 255, Comment: "第三方账号的唯一标识"},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255, Comment: "第三方账号的邮箱"},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 255, Comment: "第三方账号的名称"},
+		{Name: "linked_at", Type: field.TypeTime, Comment: "绑定时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "本站用户ID"},
+	}
+	// OauthLinksTable holds the schema information for the "oauth_links" table.
+	OauthLinksTable = &schema.Table{
+		Name:       "oauth_links",
+		Comment:    "第三方账号绑定表",
+		Columns:    OauthLinksColumns,
+		PrimaryKey: []*schema.Column{OauthLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "oauth_links_users_oauth_links",
+				Columns:    []*schema.Column{OauthLinksColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthlink_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{OauthLinksColumns[1], OauthLinksColumns[2]},
+			},
+			{
+				Name:    "oauthlink_provider_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{OauthLinksColumns[1], OauthLinksColumns[6]},
+			},
+			{
+				Name:    "oauthlink_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{OauthLinksColumns[6]},
+			},
+		},
+	}
 	// PagesColumns holds the columns for the "pages" table.
 	PagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -539,6 +684,7 @@ var (
 		{Name: "is_published", Type: field.TypeBool, Comment: "是否发布", Default: true},
 		{Name: "show_comment", Type: field.TypeBool, Comment: "是否显示评论", Default: false},
 		{Name: "sort", Type: field.TypeInt, Comment: "排序", Default: 0},
+		{Name: "data_bindings", Type: field.TypeJSON, Nullable: true, Comment: "服务端数据绑定"},
 		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
 	}
@@ -584,6 +730,50 @@ var (
 		Columns:    PostTagsColumns,
 		PrimaryKey: []*schema.Column{PostTagsColumns[0]},
 	}
+	// ReactionCountsColumns holds the columns for the "reaction_counts" table.
+	ReactionCountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "target_type", Type: field.TypeString, Size: 32, Comment: "目标类型：article 或 essay"},
+		{Name: "target_id", Type: field.TypeString, Size: 64, Comment: "目标ID，文章为公共ID"},
+		{Name: "reaction", Type: field.TypeString, Size: 64, Comment: "表态"},
+		{Name: "count", Type: field.TypeInt64, Comment: "表态数量", Default: 0},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+	}
+	// ReactionCountsTable holds the schema information for the "reaction_counts" table.
+	ReactionCountsTable = &schema.Table{
+		Name:       "reaction_counts",
+		Comment:    "表态计数表",
+		Columns:    ReactionCountsColumns,
+		PrimaryKey: []*schema.Column{ReactionCountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reactioncount_target_type_target_id_reaction",
+				Unique:  true,
+				Columns: []*schema.Column{ReactionCountsColumns[1], ReactionCountsColumns[2], ReactionCountsColumns[3]},
+			},
+			{
+				Name:    "reactioncount_count",
+				Unique:  false,
+				Columns: []*schema.Column{ReactionCountsColumns[4]},
+			},
+		},
+	}
+	// RedirectRulesColumns holds the columns for the "redirect_rules" table.
+	RedirectRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "from_path", Type: field.TypeString, Unique: true, Size: 512, Comment: "来源地址，站内路径"},
+		{Name: "to_path", Type: field.TypeString, Size: 512, Comment: "目标地址，站内路径"},
+		{Name: "code", Type: field.TypeInt, Comment: "状态码：301、302、307 或 308", Default: 301},
+		{Name: "source", Type: field.TypeString, Size: 20, Comment: "来源：manual 管理员添加，permalink 文章永久链接变化时自动添加", Default: "manual"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+	}
+	// RedirectRulesTable holds the schema information for the "redirect_rules" table.
+	RedirectRulesTable = &schema.Table{
+		Name:       "redirect_rules",
+		Comment:    "重定向规则表",
+		Columns:    RedirectRulesColumns,
+		PrimaryKey: []*schema.Column{RedirectRulesColumns[0]},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1030,6 +1220,7 @@ var (
 		AlbumCategoriesTable,
 		ArticlesTable,
 		ArticleHistoriesTable,
+		AuthSessionsTable,
 		CommentsTable,
 		DirectLinksTable,
 		DocSeriesTable,
@@ -1037,13 +1228,18 @@ var (
 		FilesTable,
 		FileEntitiesTable,
 		LinksTable,
+		LinkArchivesTable,
 		LinkCategoriesTable,
 		LinkTagsTable,
+		LoginDevicesTable,
 		MetadataTable,
 		NotificationTypesTable,
+		OauthLinksTable,
 		PagesTable,
 		PostCategoriesTable,
 		PostTagsTable,
+		ReactionCountsTable,
+		RedirectRulesTable,
 		SettingsTable,
 		StoragePoliciesTable,
 		SubscribersTable,
@@ -1065,6 +1261,7 @@ func init() {
 	AlbumsTable.ForeignKeys[0].RefTable = AlbumCategoriesTable
 	ArticlesTable.ForeignKeys[0].RefTable = DocSeriesTable
 	ArticleHistoriesTable.ForeignKeys[0].RefTable = ArticlesTable
+	AuthSessionsTable.ForeignKeys[0].RefTable = UsersTable
 	CommentsTable.ForeignKeys[0].RefTable = ArticlesTable
 	CommentsTable.ForeignKeys[1].RefTable = CommentsTable
 	CommentsTable.ForeignKeys[2].RefTable = UsersTable
@@ -1075,7 +1272,9 @@ func init() {
 	FileEntitiesTable.ForeignKeys[0].RefTable = EntitiesTable
 	FileEntitiesTable.ForeignKeys[1].RefTable = FilesTable
 	LinksTable.ForeignKeys[0].RefTable = LinkCategoriesTable
+	LoginDevicesTable.ForeignKeys[0].RefTable = UsersTable
 	MetadataTable.ForeignKeys[0].RefTable = FilesTable
+	OauthLinksTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = UserGroupsTable
 	UserInstalledThemesTable.ForeignKeys[0].RefTable = UsersTable
 	UserNotificationConfigsTable.ForeignKeys[0].RefTable = NotificationTypesTable
@@ -15,6 +15,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/albumcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/article"
 	"github.com/anzhiyu-c/anheyu-app/ent/articlehistory"
+	"github.com/anzhiyu-c/anheyu-app/ent/authsession"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/docseries"
@@ -22,14 +23,19 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
 	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/link"
+	"github.com/anzhiyu-c/anheyu-app/ent/linkarchive"
 	"github.com/anzhiyu-c/anheyu-app/ent/linkcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/linktag"
+	"github.com/anzhiyu-c/anheyu-app/ent/logindevice"
 	"github.com/anzhiyu-c/anheyu-app/ent/metadata"
 	"github.com/anzhiyu-c/anheyu-app/ent/notificationtype"
+	"github.com/anzhiyu-c/anheyu-app/ent/oauthlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
 	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/reactioncount"
+	"github.com/anzhiyu-c/anheyu-app/ent/redirectrule"
 	"github.com/anzhiyu-c/anheyu-app/ent/setting"
 	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
 	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
@@ -57,6 +63,7 @@ const (
 	TypeAlbumCategory          = "AlbumCategory"
 	TypeArticle                = "Article"
 	TypeArticleHistory         = "ArticleHistory"
+	TypeAuthSession            = "AuthSession"
 	TypeComment                = "Comment"
 	TypeDirectLink             = "DirectLink"
 	TypeDocSeries              = "DocSeries"
@@ -64,13 +71,18 @@ const (
 	TypeFile                   = "File"
 	TypeFileEntity             = "FileEntity"
 	TypeLink                   = "Link"
+	TypeLinkArchive            = "LinkArchive"
 	TypeLinkCategory           = "LinkCategory"
 	TypeLinkTag                = "LinkTag"
+	TypeLoginDevice            = "LoginDevice"
 	TypeMetadata               = "Metadata"
 	TypeNotificationType       = "NotificationType"
+	TypeOAuthLink              = "OAuthLink"
 	TypePage                   = "Page"
 	TypePostCategory           = "PostCategory"
 	TypePostTag                = "PostTag"
+	TypeReactionCount          = "ReactionCount"
+	TypeRedirectRule           = "RedirectRule"
 	TypeSetting                = "Setting"
 	TypeStoragePolicy          = "StoragePolicy"
 	TypeSubscriber             = "Subscriber"
@@ -7977,59 +7989,40 @@ func (m *ArticleHistoryMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ArticleHistory edge %s", name)
 }
 
-// CommentMutation represents an operation that mutates the Comment nodes in the graph.
-type CommentMutation struct {
+// AuthSessionMutation represents an operation that mutates the AuthSession nodes in the graph.
+type AuthSessionMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uint
-	deleted_at       *time.Time
-	created_at       *time.Time
-	updated_at       *time.Time
-	target_path      *string
-	target_title     *string
-	reply_to_id      *uint
-	addreply_to_id   *int
-	nickname         *string
-	email            *string
-	email_md5        *string
-	website          *string
-	content          *string
-	content_html     *string
-	status           *int
-	addstatus        *int
-	is_admin_comment *bool
-	is_anonymous     *bool
-	user_agent       *string
-	ip_address       *string
-	ip_location      *string
-	like_count       *int
-	addlike_count    *int
-	pinned_at        *time.Time
-	clearedFields    map[string]struct{}
-	user             *uint
-	cleareduser      bool
-	children         *uint
-	clearedchildren  bool
-	parent           map[uint]struct{}
-	removedparent    map[uint]struct{}
-	clearedparent    bool
-	done             bool
-	oldValue         func(context.Context) (*Comment, error)
-	predicates       []predicate.Comment
+	op              Op
+	typ             string
+	id              *string
+	device          *string
+	ip              *string
+	remember_me     *bool
+	token_hash      *string
+	prev_token_hash *string
+	rotated_at      *time.Time
+	created_at      *time.Time
+	last_used_at    *time.Time
+	expires_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *uint
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*AuthSession, error)
+	predicates      []predicate.AuthSession
 }
 
-var _ ent.Mutation = (*CommentMutation)(nil)
+var _ ent.Mutation = (*AuthSessionMutation)(nil)
 
-// commentOption allows management of the mutation configuration using functional options.
-type commentOption func(*CommentMutation)
+// authsessionOption allows management of the mutation configuration using functional options.
+type authsessionOption func(*AuthSessionMutation)
 
-// newCommentMutation creates new mutation for the Comment entity.
-func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
-	m := &CommentMutation{
+// newAuthSessionMutation creates new mutation for the AuthSession entity.
+func newAuthSessionMutation(c config, op Op, opts ...authsessionOption) *AuthSessionMutation {
+	m := &AuthSessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeComment,
+		typ:           TypeAuthSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8038,20 +8031,20 @@ func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation
 	return m
 }
 
-// withCommentID sets the ID field of the mutation.
-func withCommentID(id uint) commentOption {
-	return func(m *CommentMutation) {
+// withAuthSessionID sets the ID field of the mutation.
+func withAuthSessionID(id string) authsessionOption {
+	return func(m *AuthSessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Comment
+			value *AuthSession
 		)
-		m.oldValue = func(ctx context.Context) (*Comment, error) {
+		m.oldValue = func(ctx context.Context) (*AuthSession, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Comment.Get(ctx, id)
+					value, err = m.Client().AuthSession.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8060,10 +8053,10 @@ func withCommentID(id uint) commentOption {
 	}
 }
 
-// withComment sets the old Comment of the mutation.
-func withComment(node *Comment) commentOption {
-	return func(m *CommentMutation) {
-		m.oldValue = func(context.Context) (*Comment, error) {
+// withAuthSession sets the old AuthSession of the mutation.
+func withAuthSession(node *AuthSession) authsessionOption {
+	return func(m *AuthSessionMutation) {
+		m.oldValue = func(context.Context) (*AuthSession, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8072,7 +8065,7 @@ func withComment(node *Comment) commentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CommentMutation) Client() *Client {
+func (m AuthSessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8080,7 +8073,7 @@ func (m CommentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CommentMutation) Tx() (*Tx, error) {
+func (m AuthSessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8090,14 +8083,14 @@ func (m CommentMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Comment entities.
-func (m *CommentMutation) SetID(id uint) {
+// operation is only accepted on creation of AuthSession entities.
+func (m *AuthSessionMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CommentMutation) ID() (id uint, exists bool) {
+func (m *AuthSessionMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8108,3145 +8101,6742 @@ func (m *CommentMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CommentMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *AuthSessionMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uint{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthSession.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *CommentMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetUserID sets the "user_id" field.
+func (m *AuthSessionMutation) SetUserID(u uint) {
+	m.user = &u
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *CommentMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AuthSessionMutation) UserID() (r uint, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *AuthSessionMutation) OldUserID(ctx context.Context) (v uint, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.UserID, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *CommentMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[comment.FieldDeletedAt] = struct{}{}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AuthSessionMutation) ResetUserID() {
+	m.user = nil
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *CommentMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[comment.FieldDeletedAt]
+// SetDevice sets the "device" field.
+func (m *AuthSessionMutation) SetDevice(s string) {
+	m.device = &s
+}
+
+// Device returns the value of the "device" field in the mutation.
+func (m *AuthSessionMutation) Device() (r string, exists bool) {
+	v := m.device
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDevice returns the old "device" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthSessionMutation) OldDevice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDevice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
+	}
+	return oldValue.Device, nil
+}
+
+// ClearDevice clears the value of the "device" field.
+func (m *AuthSessionMutation) ClearDevice() {
+	m.device = nil
+	m.clearedFields[authsession.FieldDevice] = struct{}{}
+}
+
+// DeviceCleared returns if the "device" field was cleared in this mutation.
+func (m *AuthSessionMutation) DeviceCleared() bool {
+	_, ok := m.clearedFields[authsession.FieldDevice]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *CommentMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, comment.FieldDeletedAt)
+// ResetDevice resets all changes to the "device" field.
+func (m *AuthSessionMutation) ResetDevice() {
+	m.device = nil
+	delete(m.clearedFields, authsession.FieldDevice)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CommentMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetIP sets the "ip" field.
+func (m *AuthSessionMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// IP returns the value of the "ip" field in the mutation.
+func (m *AuthSessionMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthSessionMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.IP, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CommentMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearIP clears the value of the "ip" field.
+func (m *AuthSessionMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[authsession.FieldIP] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CommentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *AuthSessionMutation) IPCleared() bool {
+	_, ok := m.clearedFields[authsession.FieldIP]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CommentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetIP resets all changes to the "ip" field.
+func (m *AuthSessionMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, authsession.FieldIP)
+}
+
+// SetRememberMe sets the "remember_me" field.
+func (m *AuthSessionMutation) SetRememberMe(b bool) {
+	m.remember_me = &b
+}
+
+// RememberMe returns the value of the "remember_me" field in the mutation.
+func (m *AuthSessionMutation) RememberMe() (r bool, exists bool) {
+	v := m.remember_me
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldRememberMe returns the old "remember_me" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthSessionMutation) OldRememberMe(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRememberMe is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRememberMe requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRememberMe: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.RememberMe, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CommentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetRememberMe resets all changes to the "remember_me" field.
+func (m *AuthSessionMutation) ResetRememberMe() {
+	m.remember_me = nil
 }
 
-// SetTargetPath sets the "target_path" field.
-func (m *CommentMutation) SetTargetPath(s string) {
-	m.target_path = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *AuthSessionMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// TargetPath returns the value of the "target_path" field in the mutation.
-func (m *CommentMutation) TargetPath() (r string, exists bool) {
-	v := m.target_path
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *AuthSessionMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetPath returns the old "target_path" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldTargetPath(ctx context.Context) (v string, err error) {
+func (m *AuthSessionMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetPath requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.TargetPath, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetTargetPath resets all changes to the "target_path" field.
-func (m *CommentMutation) ResetTargetPath() {
-	m.target_path = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *AuthSessionMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetTargetTitle sets the "target_title" field.
-func (m *CommentMutation) SetTargetTitle(s string) {
-	m.target_title = &s
+// SetPrevTokenHash sets the "prev_token_hash" field.
+func (m *AuthSessionMutation) SetPrevTokenHash(s string) {
+	m.prev_token_hash = &s
 }
 
-// TargetTitle returns the value of the "target_title" field in the mutation.
-func (m *CommentMutation) TargetTitle() (r string, exists bool) {
-	v := m.target_title
+// PrevTokenHash returns the value of the "prev_token_hash" field in the mutation.
+func (m *AuthSessionMutation) PrevTokenHash() (r string, exists bool) {
+	v := m.prev_token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetTitle returns the old "target_title" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldPrevTokenHash returns the old "prev_token_hash" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldTargetTitle(ctx context.Context) (v *string, err error) {
+func (m *AuthSessionMutation) OldPrevTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrevTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetTitle requires an ID field in the mutation")
+		return v, errors.New("OldPrevTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrevTokenHash: %w", err)
 	}
-	return oldValue.TargetTitle, nil
+	return oldValue.PrevTokenHash, nil
 }
 
-// ClearTargetTitle clears the value of the "target_title" field.
-func (m *CommentMutation) ClearTargetTitle() {
-	m.target_title = nil
-	m.clearedFields[comment.FieldTargetTitle] = struct{}{}
+// ClearPrevTokenHash clears the value of the "prev_token_hash" field.
+func (m *AuthSessionMutation) ClearPrevTokenHash() {
+	m.prev_token_hash = nil
+	m.clearedFields[authsession.FieldPrevTokenHash] = struct{}{}
 }
 
-// TargetTitleCleared returns if the "target_title" field was cleared in this mutation.
-func (m *CommentMutation) TargetTitleCleared() bool {
-	_, ok := m.clearedFields[comment.FieldTargetTitle]
+// PrevTokenHashCleared returns if the "prev_token_hash" field was cleared in this mutation.
+func (m *AuthSessionMutation) PrevTokenHashCleared() bool {
+	_, ok := m.clearedFields[authsession.FieldPrevTokenHash]
 	return ok
 }
 
-// ResetTargetTitle resets all changes to the "target_title" field.
-func (m *CommentMutation) ResetTargetTitle() {
-	m.target_title = nil
-	delete(m.clearedFields, comment.FieldTargetTitle)
+// ResetPrevTokenHash resets all changes to the "prev_token_hash" field.
+func (m *AuthSessionMutation) ResetPrevTokenHash() {
+	m.prev_token_hash = nil
+	delete(m.clearedFields, authsession.FieldPrevTokenHash)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *CommentMutation) SetUserID(u uint) {
-	m.user = &u
+// SetRotatedAt sets the "rotated_at" field.
+func (m *AuthSessionMutation) SetRotatedAt(t time.Time) {
+	m.rotated_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *CommentMutation) UserID() (r uint, exists bool) {
-	v := m.user
+// RotatedAt returns the value of the "rotated_at" field in the mutation.
+func (m *AuthSessionMutation) RotatedAt() (r time.Time, exists bool) {
+	v := m.rotated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldRotatedAt returns the old "rotated_at" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldUserID(ctx context.Context) (v *uint, err error) {
+func (m *AuthSessionMutation) OldRotatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRotatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldRotatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRotatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.RotatedAt, nil
 }
 
-// ClearUserID clears the value of the "user_id" field.
-func (m *CommentMutation) ClearUserID() {
-	m.user = nil
-	m.clearedFields[comment.FieldUserID] = struct{}{}
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (m *AuthSessionMutation) ClearRotatedAt() {
+	m.rotated_at = nil
+	m.clearedFields[authsession.FieldRotatedAt] = struct{}{}
 }
 
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *CommentMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[comment.FieldUserID]
+// RotatedAtCleared returns if the "rotated_at" field was cleared in this mutation.
+func (m *AuthSessionMutation) RotatedAtCleared() bool {
+	_, ok := m.clearedFields[authsession.FieldRotatedAt]
 	return ok
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *CommentMutation) ResetUserID() {
-	m.user = nil
-	delete(m.clearedFields, comment.FieldUserID)
+// ResetRotatedAt resets all changes to the "rotated_at" field.
+func (m *AuthSessionMutation) ResetRotatedAt() {
+	m.rotated_at = nil
+	delete(m.clearedFields, authsession.FieldRotatedAt)
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *CommentMutation) SetParentID(u uint) {
-	m.children = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *AuthSessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *CommentMutation) ParentID() (r uint, exists bool) {
-	v := m.children
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuthSessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldParentID(ctx context.Context) (v *uint, err error) {
+func (m *AuthSessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ParentID, nil
-}
-
-// ClearParentID clears the value of the "parent_id" field.
-func (m *CommentMutation) ClearParentID() {
-	m.children = nil
-	m.clearedFields[comment.FieldParentID] = struct{}{}
-}
-
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *CommentMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[comment.FieldParentID]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *CommentMutation) ResetParentID() {
-	m.children = nil
-	delete(m.clearedFields, comment.FieldParentID)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuthSessionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetReplyToID sets the "reply_to_id" field.
-func (m *CommentMutation) SetReplyToID(u uint) {
-	m.reply_to_id = &u
-	m.addreply_to_id = nil
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *AuthSessionMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// ReplyToID returns the value of the "reply_to_id" field in the mutation.
-func (m *CommentMutation) ReplyToID() (r uint, exists bool) {
-	v := m.reply_to_id
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *AuthSessionMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReplyToID returns the old "reply_to_id" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldReplyToID(ctx context.Context) (v *uint, err error) {
+func (m *AuthSessionMutation) OldLastUsedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReplyToID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReplyToID requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReplyToID: %w", err)
-	}
-	return oldValue.ReplyToID, nil
-}
-
-// AddReplyToID adds u to the "reply_to_id" field.
-func (m *CommentMutation) AddReplyToID(u int) {
-	if m.addreply_to_id != nil {
-		*m.addreply_to_id += u
-	} else {
-		m.addreply_to_id = &u
-	}
-}
-
-// AddedReplyToID returns the value that was added to the "reply_to_id" field in this mutation.
-func (m *CommentMutation) AddedReplyToID() (r int, exists bool) {
-	v := m.addreply_to_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return *v, true
-}
-
-// ClearReplyToID clears the value of the "reply_to_id" field.
-func (m *CommentMutation) ClearReplyToID() {
-	m.reply_to_id = nil
-	m.addreply_to_id = nil
-	m.clearedFields[comment.FieldReplyToID] = struct{}{}
-}
-
-// ReplyToIDCleared returns if the "reply_to_id" field was cleared in this mutation.
-func (m *CommentMutation) ReplyToIDCleared() bool {
-	_, ok := m.clearedFields[comment.FieldReplyToID]
-	return ok
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetReplyToID resets all changes to the "reply_to_id" field.
-func (m *CommentMutation) ResetReplyToID() {
-	m.reply_to_id = nil
-	m.addreply_to_id = nil
-	delete(m.clearedFields, comment.FieldReplyToID)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *AuthSessionMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
 }
 
-// SetNickname sets the "nickname" field.
-func (m *CommentMutation) SetNickname(s string) {
-	m.nickname = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *AuthSessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Nickname returns the value of the "nickname" field in the mutation.
-func (m *CommentMutation) Nickname() (r string, exists bool) {
-	v := m.nickname
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *AuthSessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNickname returns the old "nickname" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldNickname(ctx context.Context) (v string, err error) {
+func (m *AuthSessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickname requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Nickname, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetNickname resets all changes to the "nickname" field.
-func (m *CommentMutation) ResetNickname() {
-	m.nickname = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *AuthSessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *CommentMutation) SetEmail(s string) {
-	m.email = &s
+// ClearUser clears the "user" edge to the User entity.
+func (m *AuthSessionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[authsession.FieldUserID] = struct{}{}
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *CommentMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AuthSessionMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// OldEmail returns the old "email" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldEmail(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AuthSessionMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Email, nil
-}
-
-// ClearEmail clears the value of the "email" field.
-func (m *CommentMutation) ClearEmail() {
-	m.email = nil
-	m.clearedFields[comment.FieldEmail] = struct{}{}
-}
-
-// EmailCleared returns if the "email" field was cleared in this mutation.
-func (m *CommentMutation) EmailCleared() bool {
-	_, ok := m.clearedFields[comment.FieldEmail]
-	return ok
+	return
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *CommentMutation) ResetEmail() {
-	m.email = nil
-	delete(m.clearedFields, comment.FieldEmail)
+// ResetUser resets all changes to the "user" edge.
+func (m *AuthSessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SetEmailMd5 sets the "email_md5" field.
-func (m *CommentMutation) SetEmailMd5(s string) {
-	m.email_md5 = &s
+// Where appends a list predicates to the AuthSessionMutation builder.
+func (m *AuthSessionMutation) Where(ps ...predicate.AuthSession) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// EmailMd5 returns the value of the "email_md5" field in the mutation.
-func (m *CommentMutation) EmailMd5() (r string, exists bool) {
-	v := m.email_md5
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AuthSessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuthSessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthSession, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldEmailMd5 returns the old "email_md5" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldEmailMd5(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmailMd5 is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmailMd5 requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmailMd5: %w", err)
-	}
-	return oldValue.EmailMd5, nil
+// Op returns the operation name.
+func (m *AuthSessionMutation) Op() Op {
+	return m.op
 }
 
-// ResetEmailMd5 resets all changes to the "email_md5" field.
-func (m *CommentMutation) ResetEmailMd5() {
-	m.email_md5 = nil
+// SetOp allows setting the mutation operation.
+func (m *AuthSessionMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetWebsite sets the "website" field.
-func (m *CommentMutation) SetWebsite(s string) {
-	m.website = &s
+// Type returns the node type of this mutation (AuthSession).
+func (m *AuthSessionMutation) Type() string {
+	return m.typ
 }
 
-// Website returns the value of the "website" field in the mutation.
-func (m *CommentMutation) Website() (r string, exists bool) {
-	v := m.website
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuthSessionMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.user != nil {
+		fields = append(fields, authsession.FieldUserID)
 	}
-	return *v, true
-}
-
-// OldWebsite returns the old "website" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldWebsite(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWebsite is only allowed on UpdateOne operations")
+	if m.device != nil {
+		fields = append(fields, authsession.FieldDevice)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWebsite requires an ID field in the mutation")
+	if m.ip != nil {
+		fields = append(fields, authsession.FieldIP)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWebsite: %w", err)
+	if m.remember_me != nil {
+		fields = append(fields, authsession.FieldRememberMe)
 	}
-	return oldValue.Website, nil
-}
-
-// ClearWebsite clears the value of the "website" field.
-func (m *CommentMutation) ClearWebsite() {
-	m.website = nil
-	m.clearedFields[comment.FieldWebsite] = struct{}{}
-}
-
-// WebsiteCleared returns if the "website" field was cleared in this mutation.
-func (m *CommentMutation) WebsiteCleared() bool {
-	_, ok := m.clearedFields[comment.FieldWebsite]
-	return ok
-}
-
-// ResetWebsite resets all changes to the "website" field.
-func (m *CommentMutation) ResetWebsite() {
-	m.website = nil
-	delete(m.clearedFields, comment.FieldWebsite)
+	if m.token_hash != nil {
+		fields = append(fields, authsession.FieldTokenHash)
+	}
+	if m.prev_token_hash != nil {
+		fields = append(fields, authsession.FieldPrevTokenHash)
+	}
+	if m.rotated_at != nil {
+		fields = append(fields, authsession.FieldRotatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, authsession.FieldCreatedAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, authsession.FieldLastUsedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, authsession.FieldExpiresAt)
+	}
+	return fields
 }
 
-// SetContent sets the "content" field.
-func (m *CommentMutation) SetContent(s string) {
-	m.content = &s
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuthSessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case authsession.FieldUserID:
+		return m.UserID()
+	case authsession.FieldDevice:
+		return m.Device()
+	case authsession.FieldIP:
+		return m.IP()
+	case authsession.FieldRememberMe:
+		return m.RememberMe()
+	case authsession.FieldTokenHash:
+		return m.TokenHash()
+	case authsession.FieldPrevTokenHash:
+		return m.PrevTokenHash()
+	case authsession.FieldRotatedAt:
+		return m.RotatedAt()
+	case authsession.FieldCreatedAt:
+		return m.CreatedAt()
+	case authsession.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case authsession.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *CommentMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuthSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case authsession.FieldUserID:
+		return m.OldUserID(ctx)
+	case authsession.FieldDevice:
+		return m.OldDevice(ctx)
+	case authsession.FieldIP:
+		return m.OldIP(ctx)
+	case authsession.FieldRememberMe:
+		return m.OldRememberMe(ctx)
+	case authsession.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case authsession.FieldPrevTokenHash:
+		return m.OldPrevTokenHash(ctx)
+	case authsession.FieldRotatedAt:
+		return m.OldRotatedAt(ctx)
+	case authsession.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case authsession.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case authsession.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown AuthSession field %s", name)
 }
 
-// OldContent returns the old "content" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthSessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case authsession.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case authsession.FieldDevice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDevice(v)
+		return nil
+	case authsession.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case authsession.FieldRememberMe:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRememberMe(v)
+		return nil
+	case authsession.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case authsession.FieldPrevTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrevTokenHash(v)
+		return nil
+	case authsession.FieldRotatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotatedAt(v)
+		return nil
+	case authsession.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case authsession.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case authsession.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
 	}
-	return oldValue.Content, nil
+	return fmt.Errorf("unknown AuthSession field %s", name)
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *CommentMutation) ResetContent() {
-	m.content = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuthSessionMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
-// SetContentHTML sets the "content_html" field.
-func (m *CommentMutation) SetContentHTML(s string) {
-	m.content_html = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuthSessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
 }
 
-// ContentHTML returns the value of the "content_html" field in the mutation.
-func (m *CommentMutation) ContentHTML() (r string, exists bool) {
-	v := m.content_html
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthSessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuthSession numeric field %s", name)
 }
 
-// OldContentHTML returns the old "content_html" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldContentHTML(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentHTML is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuthSessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(authsession.FieldDevice) {
+		fields = append(fields, authsession.FieldDevice)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentHTML requires an ID field in the mutation")
+	if m.FieldCleared(authsession.FieldIP) {
+		fields = append(fields, authsession.FieldIP)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentHTML: %w", err)
+	if m.FieldCleared(authsession.FieldPrevTokenHash) {
+		fields = append(fields, authsession.FieldPrevTokenHash)
 	}
-	return oldValue.ContentHTML, nil
-}
-
-// ResetContentHTML resets all changes to the "content_html" field.
-func (m *CommentMutation) ResetContentHTML() {
-	m.content_html = nil
+	if m.FieldCleared(authsession.FieldRotatedAt) {
+		fields = append(fields, authsession.FieldRotatedAt)
+	}
+	return fields
 }
 
-// SetStatus sets the "status" field.
-func (m *CommentMutation) SetStatus(i int) {
-	m.status = &i
-	m.addstatus = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuthSessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *CommentMutation) Status() (r int, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuthSessionMutation) ClearField(name string) error {
+	switch name {
+	case authsession.FieldDevice:
+		m.ClearDevice()
+		return nil
+	case authsession.FieldIP:
+		m.ClearIP()
+		return nil
+	case authsession.FieldPrevTokenHash:
+		m.ClearPrevTokenHash()
+		return nil
+	case authsession.FieldRotatedAt:
+		m.ClearRotatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuthSession nullable field %s", name)
 }
 
-// OldStatus returns the old "status" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldStatus(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuthSessionMutation) ResetField(name string) error {
+	switch name {
+	case authsession.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case authsession.FieldDevice:
+		m.ResetDevice()
+		return nil
+	case authsession.FieldIP:
+		m.ResetIP()
+		return nil
+	case authsession.FieldRememberMe:
+		m.ResetRememberMe()
+		return nil
+	case authsession.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case authsession.FieldPrevTokenHash:
+		m.ResetPrevTokenHash()
+		return nil
+	case authsession.FieldRotatedAt:
+		m.ResetRotatedAt()
+		return nil
+	case authsession.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case authsession.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case authsession.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
 	}
-	return oldValue.Status, nil
+	return fmt.Errorf("unknown AuthSession field %s", name)
 }
 
-// AddStatus adds i to the "status" field.
-func (m *CommentMutation) AddStatus(i int) {
-	if m.addstatus != nil {
-		*m.addstatus += i
-	} else {
-		m.addstatus = &i
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuthSessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, authsession.EdgeUser)
 	}
+	return edges
 }
 
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *CommentMutation) AddedStatus() (r int, exists bool) {
-	v := m.addstatus
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuthSessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case authsession.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return *v, true
+	return nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *CommentMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuthSessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetIsAdminComment sets the "is_admin_comment" field.
-func (m *CommentMutation) SetIsAdminComment(b bool) {
-	m.is_admin_comment = &b
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuthSessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// IsAdminComment returns the value of the "is_admin_comment" field in the mutation.
-func (m *CommentMutation) IsAdminComment() (r bool, exists bool) {
-	v := m.is_admin_comment
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuthSessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, authsession.EdgeUser)
 	}
-	return *v, true
+	return edges
 }
 
-// OldIsAdminComment returns the old "is_admin_comment" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldIsAdminComment(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsAdminComment is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsAdminComment requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsAdminComment: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuthSessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case authsession.EdgeUser:
+		return m.cleareduser
 	}
-	return oldValue.IsAdminComment, nil
+	return false
 }
 
-// ResetIsAdminComment resets all changes to the "is_admin_comment" field.
-func (m *CommentMutation) ResetIsAdminComment() {
-	m.is_admin_comment = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuthSessionMutation) ClearEdge(name string) error {
+	switch name {
+	case authsession.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthSession unique edge %s", name)
 }
 
-// SetIsAnonymous sets the "is_anonymous" field.
-func (m *CommentMutation) SetIsAnonymous(b bool) {
-	m.is_anonymous = &b
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuthSessionMutation) ResetEdge(name string) error {
+	switch name {
+	case authsession.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthSession edge %s", name)
 }
 
-// IsAnonymous returns the value of the "is_anonymous" field in the mutation.
-func (m *CommentMutation) IsAnonymous() (r bool, exists bool) {
-	v := m.is_anonymous
-	if v == nil {
-		return
+// CommentMutation represents an operation that mutates the Comment nodes in the graph.
+type CommentMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uint
+	deleted_at       *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	target_path      *string
+	target_title     *string
+	reply_to_id      *uint
+	addreply_to_id   *int
+	nickname         *string
+	email            *string
+	email_md5        *string
+	website          *string
+	content          *string
+	content_html     *string
+	status           *int
+	addstatus        *int
+	is_admin_comment *bool
+	is_anonymous     *bool
+	user_agent       *string
+	ip_address       *string
+	ip_location      *string
+	like_count       *int
+	addlike_count    *int
+	pinned_at        *time.Time
+	clearedFields    map[string]struct{}
+	user             *uint
+	cleareduser      bool
+	children         *uint
+	clearedchildren  bool
+	parent           map[uint]struct{}
+	removedparent    map[uint]struct{}
+	clearedparent    bool
+	done             bool
+	oldValue         func(context.Context) (*Comment, error)
+	predicates       []predicate.Comment
+}
+
+var _ ent.Mutation = (*CommentMutation)(nil)
+
+// commentOption allows management of the mutation configuration using functional options.
+type commentOption func(*CommentMutation)
+
+// newCommentMutation creates new mutation for the Comment entity.
+func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
+	m := &CommentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeComment,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldIsAnonymous returns the old "is_anonymous" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldIsAnonymous(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsAnonymous is only allowed on UpdateOne operations")
+// withCommentID sets the ID field of the mutation.
+func withCommentID(id uint) commentOption {
+	return func(m *CommentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Comment
+		)
+		m.oldValue = func(ctx context.Context) (*Comment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Comment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsAnonymous requires an ID field in the mutation")
+}
+
+// withComment sets the old Comment of the mutation.
+func withComment(node *Comment) commentOption {
+	return func(m *CommentMutation) {
+		m.oldValue = func(context.Context) (*Comment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsAnonymous: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.IsAnonymous, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetIsAnonymous resets all changes to the "is_anonymous" field.
-func (m *CommentMutation) ResetIsAnonymous() {
-	m.is_anonymous = nil
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Comment entities.
+func (m *CommentMutation) SetID(id uint) {
+	m.id = &id
 }
 
-// SetUserAgent sets the "user_agent" field.
-func (m *CommentMutation) SetUserAgent(s string) {
-	m.user_agent = &s
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommentMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// UserAgent returns the value of the "user_agent" field in the mutation.
-func (m *CommentMutation) UserAgent() (r string, exists bool) {
-	v := m.user_agent
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommentMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CommentMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CommentMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserAgent returns the old "user_agent" field's value of the Comment entity.
+// OldDeletedAt returns the old "deleted_at" field's value of the Comment entity.
 // If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldUserAgent(ctx context.Context) (v *string, err error) {
+func (m *CommentMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.UserAgent, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ClearUserAgent clears the value of the "user_agent" field.
-func (m *CommentMutation) ClearUserAgent() {
-	m.user_agent = nil
-	m.clearedFields[comment.FieldUserAgent] = struct{}{}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CommentMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[comment.FieldDeletedAt] = struct{}{}
 }
 
-// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
-func (m *CommentMutation) UserAgentCleared() bool {
-	_, ok := m.clearedFields[comment.FieldUserAgent]
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CommentMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldDeletedAt]
 	return ok
 }
 
-// ResetUserAgent resets all changes to the "user_agent" field.
-func (m *CommentMutation) ResetUserAgent() {
-	m.user_agent = nil
-	delete(m.clearedFields, comment.FieldUserAgent)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CommentMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, comment.FieldDeletedAt)
 }
 
-// SetIPAddress sets the "ip_address" field.
-func (m *CommentMutation) SetIPAddress(s string) {
-	m.ip_address = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// IPAddress returns the value of the "ip_address" field in the mutation.
-func (m *CommentMutation) IPAddress() (r string, exists bool) {
-	v := m.ip_address
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIPAddress returns the old "ip_address" field's value of the Comment entity.
+// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
 // If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.IPAddress, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetIPAddress resets all changes to the "ip_address" field.
-func (m *CommentMutation) ResetIPAddress() {
-	m.ip_address = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommentMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetIPLocation sets the "ip_location" field.
-func (m *CommentMutation) SetIPLocation(s string) {
-	m.ip_location = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CommentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// IPLocation returns the value of the "ip_location" field in the mutation.
-func (m *CommentMutation) IPLocation() (r string, exists bool) {
-	v := m.ip_location
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CommentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIPLocation returns the old "ip_location" field's value of the Comment entity.
+// OldUpdatedAt returns the old "updated_at" field's value of the Comment entity.
 // If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldIPLocation(ctx context.Context) (v *string, err error) {
+func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIPLocation is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIPLocation requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIPLocation: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.IPLocation, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearIPLocation clears the value of the "ip_location" field.
-func (m *CommentMutation) ClearIPLocation() {
-	m.ip_location = nil
-	m.clearedFields[comment.FieldIPLocation] = struct{}{}
-}
-
-// IPLocationCleared returns if the "ip_location" field was cleared in this mutation.
-func (m *CommentMutation) IPLocationCleared() bool {
-	_, ok := m.clearedFields[comment.FieldIPLocation]
-	return ok
-}
-
-// ResetIPLocation resets all changes to the "ip_location" field.
-func (m *CommentMutation) ResetIPLocation() {
-	m.ip_location = nil
-	delete(m.clearedFields, comment.FieldIPLocation)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CommentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetLikeCount sets the "like_count" field.
-func (m *CommentMutation) SetLikeCount(i int) {
-	m.like_count = &i
-	m.addlike_count = nil
+// SetTargetPath sets the "target_path" field.
+func (m *CommentMutation) SetTargetPath(s string) {
+	m.target_path = &s
 }
 
-// LikeCount returns the value of the "like_count" field in the mutation.
-func (m *CommentMutation) LikeCount() (r int, exists bool) {
-	v := m.like_count
+// TargetPath returns the value of the "target_path" field in the mutation.
+func (m *CommentMutation) TargetPath() (r string, exists bool) {
+	v := m.target_path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLikeCount returns the old "like_count" field's value of the Comment entity.
+// OldTargetPath returns the old "target_path" field's value of the Comment entity.
 // If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldLikeCount(ctx context.Context) (v int, err error) {
+func (m *CommentMutation) OldTargetPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLikeCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLikeCount requires an ID field in the mutation")
+		return v, errors.New("OldTargetPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLikeCount: %w", err)
-	}
-	return oldValue.LikeCount, nil
-}
-
-// AddLikeCount adds i to the "like_count" field.
-func (m *CommentMutation) AddLikeCount(i int) {
-	if m.addlike_count != nil {
-		*m.addlike_count += i
-	} else {
-		m.addlike_count = &i
-	}
-}
-
-// AddedLikeCount returns the value that was added to the "like_count" field in this mutation.
-func (m *CommentMutation) AddedLikeCount() (r int, exists bool) {
-	v := m.addlike_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTargetPath: %w", err)
 	}
-	return *v, true
+	return oldValue.TargetPath, nil
 }
 
-// ResetLikeCount resets all changes to the "like_count" field.
-func (m *CommentMutation) ResetLikeCount() {
-	m.like_count = nil
-	m.addlike_count = nil
+// ResetTargetPath resets all changes to the "target_path" field.
+func (m *CommentMutation) ResetTargetPath() {
+	m.target_path = nil
 }
 
-// SetPinnedAt sets the "pinned_at" field.
-func (m *CommentMutation) SetPinnedAt(t time.Time) {
-	m.pinned_at = &t
+// SetTargetTitle sets the "target_title" field.
+func (m *CommentMutation) SetTargetTitle(s string) {
+	m.target_title = &s
 }
 
-// PinnedAt returns the value of the "pinned_at" field in the mutation.
-func (m *CommentMutation) PinnedAt() (r time.Time, exists bool) {
-	v := m.pinned_at
+// TargetTitle returns the value of the "target_title" field in the mutation.
+func (m *CommentMutation) TargetTitle() (r string, exists bool) {
+	v := m.target_title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPinnedAt returns the old "pinned_at" field's value of the Comment entity.
+// OldTargetTitle returns the old "target_title" field's value of the Comment entity.
 // If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldPinnedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *CommentMutation) OldTargetTitle(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPinnedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPinnedAt requires an ID field in the mutation")
+		return v, errors.New("OldTargetTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPinnedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetTitle: %w", err)
 	}
-	return oldValue.PinnedAt, nil
+	return oldValue.TargetTitle, nil
 }
 
-// ClearPinnedAt clears the value of the "pinned_at" field.
-func (m *CommentMutation) ClearPinnedAt() {
-	m.pinned_at = nil
-	m.clearedFields[comment.FieldPinnedAt] = struct{}{}
+// ClearTargetTitle clears the value of the "target_title" field.
+func (m *CommentMutation) ClearTargetTitle() {
+	m.target_title = nil
+	m.clearedFields[comment.FieldTargetTitle] = struct{}{}
 }
 
-// PinnedAtCleared returns if the "pinned_at" field was cleared in this mutation.
-func (m *CommentMutation) PinnedAtCleared() bool {
-	_, ok := m.clearedFields[comment.FieldPinnedAt]
+// TargetTitleCleared returns if the "target_title" field was cleared in this mutation.
+func (m *CommentMutation) TargetTitleCleared() bool {
+	_, ok := m.clearedFields[comment.FieldTargetTitle]
 	return ok
 }
 
-// ResetPinnedAt resets all changes to the "pinned_at" field.
-func (m *CommentMutation) ResetPinnedAt() {
-	m.pinned_at = nil
-	delete(m.clearedFields, comment.FieldPinnedAt)
+// ResetTargetTitle resets all changes to the "target_title" field.
+func (m *CommentMutation) ResetTargetTitle() {
+	m.target_title = nil
+	delete(m.clearedFields, comment.FieldTargetTitle)
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *CommentMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[comment.FieldUserID] = struct{}{}
+// SetUserID sets the "user_id" field.
+func (m *CommentMutation) SetUserID(u uint) {
+	m.user = &u
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *CommentMutation) UserCleared() bool {
-	return m.UserIDCleared() || m.cleareduser
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CommentMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *CommentMutation) UserIDs() (ids []uint) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldUserID returns the old "user_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldUserID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *CommentMutation) ResetUser() {
+// ClearUserID clears the value of the "user_id" field.
+func (m *CommentMutation) ClearUserID() {
 	m.user = nil
-	m.cleareduser = false
+	m.clearedFields[comment.FieldUserID] = struct{}{}
 }
 
-// SetChildrenID sets the "children" edge to the Comment entity by id.
-func (m *CommentMutation) SetChildrenID(id uint) {
-	m.children = &id
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *CommentMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldUserID]
+	return ok
 }
 
-// ClearChildren clears the "children" edge to the Comment entity.
-func (m *CommentMutation) ClearChildren() {
-	m.clearedchildren = true
-	m.clearedFields[comment.FieldParentID] = struct{}{}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CommentMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, comment.FieldUserID)
 }
 
-// ChildrenCleared reports if the "children" edge to the Comment entity was cleared.
-func (m *CommentMutation) ChildrenCleared() bool {
-	return m.ParentIDCleared() || m.clearedchildren
+// SetParentID sets the "parent_id" field.
+func (m *CommentMutation) SetParentID(u uint) {
+	m.children = &u
 }
 
-// ChildrenID returns the "children" edge ID in the mutation.
-func (m *CommentMutation) ChildrenID() (id uint, exists bool) {
-	if m.children != nil {
-		return *m.children, true
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CommentMutation) ParentID() (r uint, exists bool) {
+	v := m.children
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChildrenID instead. It exists only for internal usage by the builders.
-func (m *CommentMutation) ChildrenIDs() (ids []uint) {
-	if id := m.children; id != nil {
-		ids = append(ids, *id)
+// OldParentID returns the old "parent_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldParentID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *CommentMutation) ResetChildren() {
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CommentMutation) ClearParentID() {
 	m.children = nil
-	m.clearedchildren = false
+	m.clearedFields[comment.FieldParentID] = struct{}{}
 }
 
-// AddParentIDs adds the "parent" edge to the Comment entity by ids.
-func (m *CommentMutation) AddParentIDs(ids ...uint) {
-	if m.parent == nil {
-		m.parent = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.parent[ids[i]] = struct{}{}
-	}
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CommentMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldParentID]
+	return ok
 }
 
-// ClearParent clears the "parent" edge to the Comment entity.
-func (m *CommentMutation) ClearParent() {
-	m.clearedparent = true
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CommentMutation) ResetParentID() {
+	m.children = nil
+	delete(m.clearedFields, comment.FieldParentID)
 }
 
-// ParentCleared reports if the "parent" edge to the Comment entity was cleared.
-func (m *CommentMutation) ParentCleared() bool {
-	return m.clearedparent
+// SetReplyToID sets the "reply_to_id" field.
+func (m *CommentMutation) SetReplyToID(u uint) {
+	m.reply_to_id = &u
+	m.addreply_to_id = nil
 }
 
-// RemoveParentIDs removes the "parent" edge to the Comment entity by IDs.
-func (m *CommentMutation) RemoveParentIDs(ids ...uint) {
-	if m.removedparent == nil {
-		m.removedparent = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.parent, ids[i])
-		m.removedparent[ids[i]] = struct{}{}
+// ReplyToID returns the value of the "reply_to_id" field in the mutation.
+func (m *CommentMutation) ReplyToID() (r uint, exists bool) {
+	v := m.reply_to_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedParent returns the removed IDs of the "parent" edge to the Comment entity.
-func (m *CommentMutation) RemovedParentIDs() (ids []uint) {
-	for id := range m.removedparent {
-		ids = append(ids, id)
+// OldReplyToID returns the old "reply_to_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldReplyToID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToID: %w", err)
+	}
+	return oldValue.ReplyToID, nil
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-func (m *CommentMutation) ParentIDs() (ids []uint) {
-	for id := range m.parent {
-		ids = append(ids, id)
+// AddReplyToID adds u to the "reply_to_id" field.
+func (m *CommentMutation) AddReplyToID(u int) {
+	if m.addreply_to_id != nil {
+		*m.addreply_to_id += u
+	} else {
+		m.addreply_to_id = &u
 	}
-	return
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *CommentMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
-	m.removedparent = nil
+// AddedReplyToID returns the value that was added to the "reply_to_id" field in this mutation.
+func (m *CommentMutation) AddedReplyToID() (r int, exists bool) {
+	v := m.addreply_to_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Where appends a list predicates to the CommentMutation builder.
-func (m *CommentMutation) Where(ps ...predicate.Comment) {
-	m.predicates = append(m.predicates, ps...)
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (m *CommentMutation) ClearReplyToID() {
+	m.reply_to_id = nil
+	m.addreply_to_id = nil
+	m.clearedFields[comment.FieldReplyToID] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Comment, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ReplyToIDCleared returns if the "reply_to_id" field was cleared in this mutation.
+func (m *CommentMutation) ReplyToIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldReplyToID]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *CommentMutation) Op() Op {
-	return m.op
+// ResetReplyToID resets all changes to the "reply_to_id" field.
+func (m *CommentMutation) ResetReplyToID() {
+	m.reply_to_id = nil
+	m.addreply_to_id = nil
+	delete(m.clearedFields, comment.FieldReplyToID)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CommentMutation) SetOp(op Op) {
-	m.op = op
+// SetNickname sets the "nickname" field.
+func (m *CommentMutation) SetNickname(s string) {
+	m.nickname = &s
 }
 
-// Type returns the node type of this mutation (Comment).
-func (m *CommentMutation) Type() string {
-	return m.typ
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *CommentMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CommentMutation) Fields() []string {
-	fields := make([]string, 0, 22)
-	if m.deleted_at != nil {
-		fields = append(fields, comment.FieldDeletedAt)
-	}
-	if m.created_at != nil {
-		fields = append(fields, comment.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, comment.FieldUpdatedAt)
-	}
-	if m.target_path != nil {
-		fields = append(fields, comment.FieldTargetPath)
-	}
-	if m.target_title != nil {
-		fields = append(fields, comment.FieldTargetTitle)
+// OldNickname returns the old "nickname" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
 	}
-	if m.user != nil {
-		fields = append(fields, comment.FieldUserID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
 	}
-	if m.children != nil {
-		fields = append(fields, comment.FieldParentID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
 	}
-	if m.reply_to_id != nil {
-		fields = append(fields, comment.FieldReplyToID)
+	return oldValue.Nickname, nil
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *CommentMutation) ResetNickname() {
+	m.nickname = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *CommentMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *CommentMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
 	}
-	if m.nickname != nil {
-		fields = append(fields, comment.FieldNickname)
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
-	if m.email != nil {
-		fields = append(fields, comment.FieldEmail)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
-	if m.email_md5 != nil {
-		fields = append(fields, comment.FieldEmailMd5)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	if m.website != nil {
-		fields = append(fields, comment.FieldWebsite)
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *CommentMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[comment.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *CommentMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[comment.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *CommentMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, comment.FieldEmail)
+}
+
+// SetEmailMd5 sets the "email_md5" field.
+func (m *CommentMutation) SetEmailMd5(s string) {
+	m.email_md5 = &s
+}
+
+// EmailMd5 returns the value of the "email_md5" field in the mutation.
+func (m *CommentMutation) EmailMd5() (r string, exists bool) {
+	v := m.email_md5
+	if v == nil {
+		return
 	}
-	if m.content != nil {
-		fields = append(fields, comment.FieldContent)
+	return *v, true
+}
+
+// OldEmailMd5 returns the old "email_md5" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldEmailMd5(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailMd5 is only allowed on UpdateOne operations")
 	}
-	if m.content_html != nil {
-		fields = append(fields, comment.FieldContentHTML)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailMd5 requires an ID field in the mutation")
 	}
-	if m.status != nil {
-		fields = append(fields, comment.FieldStatus)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailMd5: %w", err)
 	}
-	if m.is_admin_comment != nil {
-		fields = append(fields, comment.FieldIsAdminComment)
+	return oldValue.EmailMd5, nil
+}
+
+// ResetEmailMd5 resets all changes to the "email_md5" field.
+func (m *CommentMutation) ResetEmailMd5() {
+	m.email_md5 = nil
+}
+
+// SetWebsite sets the "website" field.
+func (m *CommentMutation) SetWebsite(s string) {
+	m.website = &s
+}
+
+// Website returns the value of the "website" field in the mutation.
+func (m *CommentMutation) Website() (r string, exists bool) {
+	v := m.website
+	if v == nil {
+		return
 	}
-	if m.is_anonymous != nil {
-		fields = append(fields, comment.FieldIsAnonymous)
+	return *v, true
+}
+
+// OldWebsite returns the old "website" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldWebsite(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebsite is only allowed on UpdateOne operations")
 	}
-	if m.user_agent != nil {
-		fields = append(fields, comment.FieldUserAgent)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebsite requires an ID field in the mutation")
 	}
-	if m.ip_address != nil {
-		fields = append(fields, comment.FieldIPAddress)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebsite: %w", err)
 	}
-	if m.ip_location != nil {
-		fields = append(fields, comment.FieldIPLocation)
+	return oldValue.Website, nil
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (m *CommentMutation) ClearWebsite() {
+	m.website = nil
+	m.clearedFields[comment.FieldWebsite] = struct{}{}
+}
+
+// WebsiteCleared returns if the "website" field was cleared in this mutation.
+func (m *CommentMutation) WebsiteCleared() bool {
+	_, ok := m.clearedFields[comment.FieldWebsite]
+	return ok
+}
+
+// ResetWebsite resets all changes to the "website" field.
+func (m *CommentMutation) ResetWebsite() {
+	m.website = nil
+	delete(m.clearedFields, comment.FieldWebsite)
+}
+
+// SetContent sets the "content" field.
+func (m *CommentMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *CommentMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
 	}
-	if m.like_count != nil {
-		fields = append(fields, comment.FieldLikeCount)
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
-	if m.pinned_at != nil {
-		fields = append(fields, comment.FieldPinnedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CommentMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case comment.FieldDeletedAt:
-		return m.DeletedAt()
-	case comment.FieldCreatedAt:
-		return m.CreatedAt()
-	case comment.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case comment.FieldTargetPath:
-		return m.TargetPath()
-	case comment.FieldTargetTitle:
-		return m.TargetTitle()
-	case comment.FieldUserID:
-		return m.UserID()
-	case comment.FieldParentID:
-		return m.ParentID()
-	case comment.FieldReplyToID:
-		return m.ReplyToID()
-	case comment.FieldNickname:
-		return m.Nickname()
-	case comment.FieldEmail:
-		return m.Email()
-	case comment.FieldEmailMd5:
-		return m.EmailMd5()
-	case comment.FieldWebsite:
-		return m.Website()
-	case comment.FieldContent:
-		return m.Content()
-	case comment.FieldContentHTML:
-		return m.ContentHTML()
-	case comment.FieldStatus:
-		return m.Status()
-	case comment.FieldIsAdminComment:
-		return m.IsAdminComment()
-	case comment.FieldIsAnonymous:
-		return m.IsAnonymous()
-	case comment.FieldUserAgent:
-		return m.UserAgent()
-	case comment.FieldIPAddress:
-		return m.IPAddress()
-	case comment.FieldIPLocation:
-		return m.IPLocation()
-	case comment.FieldLikeCount:
-		return m.LikeCount()
-	case comment.FieldPinnedAt:
-		return m.PinnedAt()
+// ResetContent resets all changes to the "content" field.
+func (m *CommentMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetContentHTML sets the "content_html" field.
+func (m *CommentMutation) SetContentHTML(s string) {
+	m.content_html = &s
+}
+
+// ContentHTML returns the value of the "content_html" field in the mutation.
+func (m *CommentMutation) ContentHTML() (r string, exists bool) {
+	v := m.content_html
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case comment.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case comment.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case comment.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case comment.FieldTargetPath:
-		return m.OldTargetPath(ctx)
-	case comment.FieldTargetTitle:
-		return m.OldTargetTitle(ctx)
-	case comment.FieldUserID:
-		return m.OldUserID(ctx)
-	case comment.FieldParentID:
-		return m.OldParentID(ctx)
-	case comment.FieldReplyToID:
-		return m.OldReplyToID(ctx)
-	case comment.FieldNickname:
-		return m.OldNickname(ctx)
-	case comment.FieldEmail:
-		return m.OldEmail(ctx)
-	case comment.FieldEmailMd5:
-		return m.OldEmailMd5(ctx)
-	case comment.FieldWebsite:
-		return m.OldWebsite(ctx)
-	case comment.FieldContent:
-		return m.OldContent(ctx)
-	case comment.FieldContentHTML:
-		return m.OldContentHTML(ctx)
-	case comment.FieldStatus:
-		return m.OldStatus(ctx)
-	case comment.FieldIsAdminComment:
-		return m.OldIsAdminComment(ctx)
-	case comment.FieldIsAnonymous:
-		return m.OldIsAnonymous(ctx)
-	case comment.FieldUserAgent:
-		return m.OldUserAgent(ctx)
-	case comment.FieldIPAddress:
-		return m.OldIPAddress(ctx)
-	case comment.FieldIPLocation:
-		return m.OldIPLocation(ctx)
-	case comment.FieldLikeCount:
-		return m.OldLikeCount(ctx)
-	case comment.FieldPinnedAt:
-		return m.OldPinnedAt(ctx)
+// OldContentHTML returns the old "content_html" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldContentHTML(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHTML is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Comment field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHTML requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHTML: %w", err)
+	}
+	return oldValue.ContentHTML, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CommentMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case comment.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case comment.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case comment.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case comment.FieldTargetPath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTargetPath(v)
-		return nil
-	case comment.FieldTargetTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTargetTitle(v)
-		return nil
-	case comment.FieldUserID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case comment.FieldParentID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetParentID(v)
-		return nil
-	case comment.FieldReplyToID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReplyToID(v)
-		return nil
-	case comment.FieldNickname:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNickname(v)
-		return nil
-	case comment.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
+// ResetContentHTML resets all changes to the "content_html" field.
+func (m *CommentMutation) ResetContentHTML() {
+	m.content_html = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *CommentMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *CommentMutation) Status() (r int, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *CommentMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *CommentMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *CommentMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetIsAdminComment sets the "is_admin_comment" field.
+func (m *CommentMutation) SetIsAdminComment(b bool) {
+	m.is_admin_comment = &b
+}
+
+// IsAdminComment returns the value of the "is_admin_comment" field in the mutation.
+func (m *CommentMutation) IsAdminComment() (r bool, exists bool) {
+	v := m.is_admin_comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsAdminComment returns the old "is_admin_comment" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldIsAdminComment(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAdminComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAdminComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAdminComment: %w", err)
+	}
+	return oldValue.IsAdminComment, nil
+}
+
+// ResetIsAdminComment resets all changes to the "is_admin_comment" field.
+func (m *CommentMutation) ResetIsAdminComment() {
+	m.is_admin_comment = nil
+}
+
+// SetIsAnonymous sets the "is_anonymous" field.
+func (m *CommentMutation) SetIsAnonymous(b bool) {
+	m.is_anonymous = &b
+}
+
+// IsAnonymous returns the value of the "is_anonymous" field in the mutation.
+func (m *CommentMutation) IsAnonymous() (r bool, exists bool) {
+	v := m.is_anonymous
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsAnonymous returns the old "is_anonymous" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldIsAnonymous(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAnonymous is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAnonymous requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAnonymous: %w", err)
+	}
+	return oldValue.IsAnonymous, nil
+}
+
+// ResetIsAnonymous resets all changes to the "is_anonymous" field.
+func (m *CommentMutation) ResetIsAnonymous() {
+	m.is_anonymous = nil
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *CommentMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *CommentMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldUserAgent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *CommentMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[comment.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *CommentMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[comment.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *CommentMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, comment.FieldUserAgent)
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *CommentMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *CommentMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *CommentMutation) ResetIPAddress() {
+	m.ip_address = nil
+}
+
+// SetIPLocation sets the "ip_location" field.
+func (m *CommentMutation) SetIPLocation(s string) {
+	m.ip_location = &s
+}
+
+// IPLocation returns the value of the "ip_location" field in the mutation.
+func (m *CommentMutation) IPLocation() (r string, exists bool) {
+	v := m.ip_location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPLocation returns the old "ip_location" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldIPLocation(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPLocation: %w", err)
+	}
+	return oldValue.IPLocation, nil
+}
+
+// ClearIPLocation clears the value of the "ip_location" field.
+func (m *CommentMutation) ClearIPLocation() {
+	m.ip_location = nil
+	m.clearedFields[comment.FieldIPLocation] = struct{}{}
+}
+
+// IPLocationCleared returns if the "ip_location" field was cleared in this mutation.
+func (m *CommentMutation) IPLocationCleared() bool {
+	_, ok := m.clearedFields[comment.FieldIPLocation]
+	return ok
+}
+
+// ResetIPLocation resets all changes to the "ip_location" field.
+func (m *CommentMutation) ResetIPLocation() {
+	m.ip_location = nil
+	delete(m.clearedFields, comment.FieldIPLocation)
+}
+
+// SetLikeCount sets the "like_count" field.
+func (m *CommentMutation) SetLikeCount(i int) {
+	m.like_count = &i
+	m.addlike_count = nil
+}
+
+// LikeCount returns the value of the "like_count" field in the mutation.
+func (m *CommentMutation) LikeCount() (r int, exists bool) {
+	v := m.like_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLikeCount returns the old "like_count" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldLikeCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLikeCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLikeCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLikeCount: %w", err)
+	}
+	return oldValue.LikeCount, nil
+}
+
+// AddLikeCount adds i to the "like_count" field.
+func (m *CommentMutation) AddLikeCount(i int) {
+	if m.addlike_count != nil {
+		*m.addlike_count += i
+	} else {
+		m.addlike_count = &i
+	}
+}
+
+// AddedLikeCount returns the value that was added to the "like_count" field in this mutation.
+func (m *CommentMutation) AddedLikeCount() (r int, exists bool) {
+	v := m.addlike_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLikeCount resets all changes to the "like_count" field.
+func (m *CommentMutation) ResetLikeCount() {
+	m.like_count = nil
+	m.addlike_count = nil
+}
+
+// SetPinnedAt sets the "pinned_at" field.
+func (m *CommentMutation) SetPinnedAt(t time.Time) {
+	m.pinned_at = &t
+}
+
+// PinnedAt returns the value of the "pinned_at" field in the mutation.
+func (m *CommentMutation) PinnedAt() (r time.Time, exists bool) {
+	v := m.pinned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinnedAt returns the old "pinned_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldPinnedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinnedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinnedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinnedAt: %w", err)
+	}
+	return oldValue.PinnedAt, nil
+}
+
+// ClearPinnedAt clears the value of the "pinned_at" field.
+func (m *CommentMutation) ClearPinnedAt() {
+	m.pinned_at = nil
+	m.clearedFields[comment.FieldPinnedAt] = struct{}{}
+}
+
+// PinnedAtCleared returns if the "pinned_at" field was cleared in this mutation.
+func (m *CommentMutation) PinnedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldPinnedAt]
+	return ok
+}
+
+// ResetPinnedAt resets all changes to the "pinned_at" field.
+func (m *CommentMutation) ResetPinnedAt() {
+	m.pinned_at = nil
+	delete(m.clearedFields, comment.FieldPinnedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *CommentMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[comment.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *CommentMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *CommentMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetChildrenID sets the "children" edge to the Comment entity by id.
+func (m *CommentMutation) SetChildrenID(id uint) {
+	m.children = &id
+}
+
+// ClearChildren clears the "children" edge to the Comment entity.
+func (m *CommentMutation) ClearChildren() {
+	m.clearedchildren = true
+	m.clearedFields[comment.FieldParentID] = struct{}{}
+}
+
+// ChildrenCleared reports if the "children" edge to the Comment entity was cleared.
+func (m *CommentMutation) ChildrenCleared() bool {
+	return m.ParentIDCleared() || m.clearedchildren
+}
+
+// ChildrenID returns the "children" edge ID in the mutation.
+func (m *CommentMutation) ChildrenID() (id uint, exists bool) {
+	if m.children != nil {
+		return *m.children, true
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChildrenID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) ChildrenIDs() (ids []uint) {
+	if id := m.children; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *CommentMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+}
+
+// AddParentIDs adds the "parent" edge to the Comment entity by ids.
+func (m *CommentMutation) AddParentIDs(ids ...uint) {
+	if m.parent == nil {
+		m.parent = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.parent[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (m *CommentMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Comment entity was cleared.
+func (m *CommentMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// RemoveParentIDs removes the "parent" edge to the Comment entity by IDs.
+func (m *CommentMutation) RemoveParentIDs(ids ...uint) {
+	if m.removedparent == nil {
+		m.removedparent = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.parent, ids[i])
+		m.removedparent[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParent returns the removed IDs of the "parent" edge to the Comment entity.
+func (m *CommentMutation) RemovedParentIDs() (ids []uint) {
+	for id := range m.removedparent {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+func (m *CommentMutation) ParentIDs() (ids []uint) {
+	for id := range m.parent {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *CommentMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+	m.removedparent = nil
+}
+
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.deleted_at != nil {
+		fields = append(fields, comment.FieldDeletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, comment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, comment.FieldUpdatedAt)
+	}
+	if m.target_path != nil {
+		fields = append(fields, comment.FieldTargetPath)
+	}
+	if m.target_title != nil {
+		fields = append(fields, comment.FieldTargetTitle)
+	}
+	if m.user != nil {
+		fields = append(fields, comment.FieldUserID)
+	}
+	if m.children != nil {
+		fields = append(fields, comment.FieldParentID)
+	}
+	if m.reply_to_id != nil {
+		fields = append(fields, comment.FieldReplyToID)
+	}
+	if m.nickname != nil {
+		fields = append(fields, comment.FieldNickname)
+	}
+	if m.email != nil {
+		fields = append(fields, comment.FieldEmail)
+	}
+	if m.email_md5 != nil {
+		fields = append(fields, comment.FieldEmailMd5)
+	}
+	if m.website != nil {
+		fields = append(fields, comment.FieldWebsite)
+	}
+	if m.content != nil {
+		fields = append(fields, comment.FieldContent)
+	}
+	if m.content_html != nil {
+		fields = append(fields, comment.FieldContentHTML)
+	}
+	if m.status != nil {
+		fields = append(fields, comment.FieldStatus)
+	}
+	if m.is_admin_comment != nil {
+		fields = append(fields, comment.FieldIsAdminComment)
+	}
+	if m.is_anonymous != nil {
+		fields = append(fields, comment.FieldIsAnonymous)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, comment.FieldUserAgent)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, comment.FieldIPAddress)
+	}
+	if m.ip_location != nil {
+		fields = append(fields, comment.FieldIPLocation)
+	}
+	if m.like_count != nil {
+		fields = append(fields, comment.FieldLikeCount)
+	}
+	if m.pinned_at != nil {
+		fields = append(fields, comment.FieldPinnedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case comment.FieldDeletedAt:
+		return m.DeletedAt()
+	case comment.FieldCreatedAt:
+		return m.CreatedAt()
+	case comment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case comment.FieldTargetPath:
+		return m.TargetPath()
+	case comment.FieldTargetTitle:
+		return m.TargetTitle()
+	case comment.FieldUserID:
+		return m.UserID()
+	case comment.FieldParentID:
+		return m.ParentID()
+	case comment.FieldReplyToID:
+		return m.ReplyToID()
+	case comment.FieldNickname:
+		return m.Nickname()
+	case comment.FieldEmail:
+		return m.Email()
+	case comment.FieldEmailMd5:
+		return m.EmailMd5()
+	case comment.FieldWebsite:
+		return m.Website()
+	case comment.FieldContent:
+		return m.Content()
+	case comment.FieldContentHTML:
+		return m.ContentHTML()
+	case comment.FieldStatus:
+		return m.Status()
+	case comment.FieldIsAdminComment:
+		return m.IsAdminComment()
+	case comment.FieldIsAnonymous:
+		return m.IsAnonymous()
+	case comment.FieldUserAgent:
+		return m.UserAgent()
+	case comment.FieldIPAddress:
+		return m.IPAddress()
+	case comment.FieldIPLocation:
+		return m.IPLocation()
+	case comment.FieldLikeCount:
+		return m.LikeCount()
+	case comment.FieldPinnedAt:
+		return m.PinnedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case comment.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case comment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case comment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case comment.FieldTargetPath:
+		return m.OldTargetPath(ctx)
+	case comment.FieldTargetTitle:
+		return m.OldTargetTitle(ctx)
+	case comment.FieldUserID:
+		return m.OldUserID(ctx)
+	case comment.FieldParentID:
+		return m.OldParentID(ctx)
+	case comment.FieldReplyToID:
+		return m.OldReplyToID(ctx)
+	case comment.FieldNickname:
+		return m.OldNickname(ctx)
+	case comment.FieldEmail:
+		return m.OldEmail(ctx)
+	case comment.FieldEmailMd5:
+		return m.OldEmailMd5(ctx)
+	case comment.FieldWebsite:
+		return m.OldWebsite(ctx)
+	case comment.FieldContent:
+		return m.OldContent(ctx)
+	case comment.FieldContentHTML:
+		return m.OldContentHTML(ctx)
+	case comment.FieldStatus:
+		return m.OldStatus(ctx)
+	case comment.FieldIsAdminComment:
+		return m.OldIsAdminComment(ctx)
+	case comment.FieldIsAnonymous:
+		return m.OldIsAnonymous(ctx)
+	case comment.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case comment.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case comment.FieldIPLocation:
+		return m.OldIPLocation(ctx)
+	case comment.FieldLikeCount:
+		return m.OldLikeCount(ctx)
+	case comment.FieldPinnedAt:
+		return m.OldPinnedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Comment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case comment.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case comment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case comment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case comment.FieldTargetPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetPath(v)
+		return nil
+	case comment.FieldTargetTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetTitle(v)
+		return nil
+	case comment.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case comment.FieldParentID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case comment.FieldReplyToID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToID(v)
+		return nil
+	case comment.FieldNickname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickname(v)
+		return nil
+	case comment.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case comment.FieldEmailMd5:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailMd5(v)
+		return nil
+	case comment.FieldWebsite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebsite(v)
+		return nil
+	case comment.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case comment.FieldContentHTML:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHTML(v)
+		return nil
+	case comment.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case comment.FieldIsAdminComment:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAdminComment(v)
+		return nil
+	case comment.FieldIsAnonymous:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAnonymous(v)
+		return nil
+	case comment.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case comment.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case comment.FieldIPLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPLocation(v)
+		return nil
+	case comment.FieldLikeCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLikeCount(v)
+		return nil
+	case comment.FieldPinnedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinnedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommentMutation) AddedFields() []string {
+	var fields []string
+	if m.addreply_to_id != nil {
+		fields = append(fields, comment.FieldReplyToID)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, comment.FieldStatus)
+	}
+	if m.addlike_count != nil {
+		fields = append(fields, comment.FieldLikeCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case comment.FieldReplyToID:
+		return m.AddedReplyToID()
+	case comment.FieldStatus:
+		return m.AddedStatus()
+	case comment.FieldLikeCount:
+		return m.AddedLikeCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case comment.FieldReplyToID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReplyToID(v)
+		return nil
+	case comment.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case comment.FieldLikeCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLikeCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Comment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(comment.FieldDeletedAt) {
+		fields = append(fields, comment.FieldDeletedAt)
+	}
+	if m.FieldCleared(comment.FieldTargetTitle) {
+		fields = append(fields, comment.FieldTargetTitle)
+	}
+	if m.FieldCleared(comment.FieldUserID) {
+		fields = append(fields, comment.FieldUserID)
+	}
+	if m.FieldCleared(comment.FieldParentID) {
+		fields = append(fields, comment.FieldParentID)
+	}
+	if m.FieldCleared(comment.FieldReplyToID) {
+		fields = append(fields, comment.FieldReplyToID)
+	}
+	if m.FieldCleared(comment.FieldEmail) {
+		fields = append(fields, comment.FieldEmail)
+	}
+	if m.FieldCleared(comment.FieldWebsite) {
+		fields = append(fields, comment.FieldWebsite)
+	}
+	if m.FieldCleared(comment.FieldUserAgent) {
+		fields = append(fields, comment.FieldUserAgent)
+	}
+	if m.FieldCleared(comment.FieldIPLocation) {
+		fields = append(fields, comment.FieldIPLocation)
+	}
+	if m.FieldCleared(comment.FieldPinnedAt) {
+		fields = append(fields, comment.FieldPinnedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommentMutation) ClearField(name string) error {
+	switch name {
+	case comment.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case comment.FieldTargetTitle:
+		m.ClearTargetTitle()
+		return nil
+	case comment.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case comment.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case comment.FieldReplyToID:
+		m.ClearReplyToID()
+		return nil
+	case comment.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case comment.FieldWebsite:
+		m.ClearWebsite()
+		return nil
+	case comment.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case comment.FieldIPLocation:
+		m.ClearIPLocation()
+		return nil
+	case comment.FieldPinnedAt:
+		m.ClearPinnedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommentMutation) ResetField(name string) error {
+	switch name {
+	case comment.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case comment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case comment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case comment.FieldTargetPath:
+		m.ResetTargetPath()
+		return nil
+	case comment.FieldTargetTitle:
+		m.ResetTargetTitle()
+		return nil
+	case comment.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case comment.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case comment.FieldReplyToID:
+		m.ResetReplyToID()
+		return nil
+	case comment.FieldNickname:
+		m.ResetNickname()
+		return nil
+	case comment.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case comment.FieldEmailMd5:
+		m.ResetEmailMd5()
+		return nil
+	case comment.FieldWebsite:
+		m.ResetWebsite()
+		return nil
+	case comment.FieldContent:
+		m.ResetContent()
+		return nil
+	case comment.FieldContentHTML:
+		m.ResetContentHTML()
+		return nil
+	case comment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case comment.FieldIsAdminComment:
+		m.ResetIsAdminComment()
+		return nil
+	case comment.FieldIsAnonymous:
+		m.ResetIsAnonymous()
+		return nil
+	case comment.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case comment.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case comment.FieldIPLocation:
+		m.ResetIPLocation()
+		return nil
+	case comment.FieldLikeCount:
+		m.ResetLikeCount()
+		return nil
+	case comment.FieldPinnedAt:
+		m.ResetPinnedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, comment.EdgeUser)
+	}
+	if m.children != nil {
+		edges = append(edges, comment.EdgeChildren)
+	}
+	if m.parent != nil {
+		edges = append(edges, comment.EdgeParent)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeChildren:
+		if id := m.children; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeParent:
+		ids := make([]ent.Value, 0, len(m.parent))
+		for id := range m.parent {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedparent != nil {
+		edges = append(edges, comment.EdgeParent)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeParent:
+		ids := make([]ent.Value, 0, len(m.removedparent))
+		for id := range m.removedparent {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, comment.EdgeUser)
+	}
+	if m.clearedchildren {
+		edges = append(edges, comment.EdgeChildren)
+	}
+	if m.clearedparent {
+		edges = append(edges, comment.EdgeParent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case comment.EdgeUser:
+		return m.cleareduser
+	case comment.EdgeChildren:
+		return m.clearedchildren
+	case comment.EdgeParent:
+		return m.clearedparent
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommentMutation) ClearEdge(name string) error {
+	switch name {
+	case comment.EdgeUser:
+		m.ClearUser()
+		return nil
+	case comment.EdgeChildren:
+		m.ClearChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommentMutation) ResetEdge(name string) error {
+	switch name {
+	case comment.EdgeUser:
+		m.ResetUser()
+		return nil
+	case comment.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case comment.EdgeParent:
+		m.ResetParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment edge %s", name)
+}
+
+// DirectLinkMutation represents an operation that mutates the DirectLink nodes in the graph.
+type DirectLinkMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uint
+	deleted_at     *time.Time
+	created_at     *time.Time
+	updated_at     *time.Time
+	file_name      *string
+	speed_limit    *int64
+	addspeed_limit *int64
+	downloads      *int64
+	adddownloads   *int64
+	clearedFields  map[string]struct{}
+	file           *uint
+	clearedfile    bool
+	done           bool
+	oldValue       func(context.Context) (*DirectLink, error)
+	predicates     []predicate.DirectLink
+}
+
+var _ ent.Mutation = (*DirectLinkMutation)(nil)
+
+// directlinkOption allows management of the mutation configuration using functional options.
+type directlinkOption func(*DirectLinkMutation)
+
+// newDirectLinkMutation creates new mutation for the DirectLink entity.
+func newDirectLinkMutation(c config, op Op, opts ...directlinkOption) *DirectLinkMutation {
+	m := &DirectLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDirectLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDirectLinkID sets the ID field of the mutation.
+func withDirectLinkID(id uint) directlinkOption {
+	return func(m *DirectLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DirectLink
+		)
+		m.oldValue = func(ctx context.Context) (*DirectLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DirectLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDirectLink sets the old DirectLink of the mutation.
+func withDirectLink(node *DirectLink) directlinkOption {
+	return func(m *DirectLinkMutation) {
+		m.oldValue = func(context.Context) (*DirectLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DirectLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DirectLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DirectLink entities.
+func (m *DirectLinkMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DirectLinkMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DirectLinkMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DirectLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *DirectLinkMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *DirectLinkMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DirectLinkMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *DirectLinkMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[directlink.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *DirectLinkMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[directlink.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *DirectLinkMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, directlink.FieldDeletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DirectLinkMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DirectLinkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DirectLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DirectLinkMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DirectLinkMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DirectLinkMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DirectLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DirectLinkMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetFileID sets the "file_id" field.
+func (m *DirectLinkMutation) SetFileID(u uint) {
+	m.file = &u
+}
+
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *DirectLinkMutation) FileID() (r uint, exists bool) {
+	v := m.file
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileID returns the old "file_id" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DirectLinkMutation) OldFileID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+	}
+	return oldValue.FileID, nil
+}
+
+// ResetFileID resets all changes to the "file_id" field.
+func (m *DirectLinkMutation) ResetFileID() {
+	m.file = nil
+}
+
+// SetFileName sets the "file_name" field.
+func (m *DirectLinkMutation) SetFileName(s string) {
+	m.file_name = &s
+}
+
+// FileName returns the value of the "file_name" field in the mutation.
+func (m *DirectLinkMutation) FileName() (r string, exists bool) {
+	v := m.file_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileName returns the old "file_name" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DirectLinkMutation) OldFileName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
+	}
+	return oldValue.FileName, nil
+}
+
+// ResetFileName resets all changes to the "file_name" field.
+func (m *DirectLinkMutation) ResetFileName() {
+	m.file_name = nil
+}
+
+// SetSpeedLimit sets the "speed_limit" field.
+func (m *DirectLinkMutation) SetSpeedLimit(i int64) {
+	m.speed_limit = &i
+	m.addspeed_limit = nil
+}
+
+// SpeedLimit returns the value of the "speed_limit" field in the mutation.
+func (m *DirectLinkMutation) SpeedLimit() (r int64, exists bool) {
+	v := m.speed_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpeedLimit returns the old "speed_limit" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DirectLinkMutation) OldSpeedLimit(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpeedLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpeedLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpeedLimit: %w", err)
+	}
+	return oldValue.SpeedLimit, nil
+}
+
+// AddSpeedLimit adds i to the "speed_limit" field.
+func (m *DirectLinkMutation) AddSpeedLimit(i int64) {
+	if m.addspeed_limit != nil {
+		*m.addspeed_limit += i
+	} else {
+		m.addspeed_limit = &i
+	}
+}
+
+// AddedSpeedLimit returns the value that was added to the "speed_limit" field in this mutation.
+func (m *DirectLinkMutation) AddedSpeedLimit() (r int64, exists bool) {
+	v := m.addspeed_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpeedLimit resets all changes to the "speed_limit" field.
+func (m *DirectLinkMutation) ResetSpeedLimit() {
+	m.speed_limit = nil
+	m.addspeed_limit = nil
+}
+
+// SetDownloads sets the "downloads" field.
+func (m *DirectLinkMutation) SetDownloads(i int64) {
+	m.downloads = &i
+	m.adddownloads = nil
+}
+
+// Downloads returns the value of the "downloads" field in the mutation.
+func (m *DirectLinkMutation) Downloads() (r int64, exists bool) {
+	v := m.downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloads returns the old "downloads" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DirectLinkMutation) OldDownloads(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloads: %w", err)
+	}
+	return oldValue.Downloads, nil
+}
+
+// AddDownloads adds i to the "downloads" field.
+func (m *DirectLinkMutation) AddDownloads(i int64) {
+	if m.adddownloads != nil {
+		*m.adddownloads += i
+	} else {
+		m.adddownloads = &i
+	}
+}
+
+// AddedDownloads returns the value that was added to the "downloads" field in this mutation.
+func (m *DirectLinkMutation) AddedDownloads() (r int64, exists bool) {
+	v := m.adddownloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDownloads resets all changes to the "downloads" field.
+func (m *DirectLinkMutation) ResetDownloads() {
+	m.downloads = nil
+	m.adddownloads = nil
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (m *DirectLinkMutation) ClearFile() {
+	m.clearedfile = true
+	m.clearedFields[directlink.FieldFileID] = struct{}{}
+}
+
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *DirectLinkMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *DirectLinkMutation) FileIDs() (ids []uint) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile resets all changes to the "file" edge.
+func (m *DirectLinkMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// Where appends a list predicates to the DirectLinkMutation builder.
+func (m *DirectLinkMutation) Where(ps ...predicate.DirectLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DirectLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DirectLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DirectLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DirectLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DirectLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DirectLink).
+func (m *DirectLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DirectLinkMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.deleted_at != nil {
+		fields = append(fields, directlink.FieldDeletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, directlink.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, directlink.FieldUpdatedAt)
+	}
+	if m.file != nil {
+		fields = append(fields, directlink.FieldFileID)
+	}
+	if m.file_name != nil {
+		fields = append(fields, directlink.FieldFileName)
+	}
+	if m.speed_limit != nil {
+		fields = append(fields, directlink.FieldSpeedLimit)
+	}
+	if m.downloads != nil {
+		fields = append(fields, directlink.FieldDownloads)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DirectLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case directlink.FieldDeletedAt:
+		return m.DeletedAt()
+	case directlink.FieldCreatedAt:
+		return m.CreatedAt()
+	case directlink.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case directlink.FieldFileID:
+		return m.FileID()
+	case directlink.FieldFileName:
+		return m.FileName()
+	case directlink.FieldSpeedLimit:
+		return m.SpeedLimit()
+	case directlink.FieldDownloads:
+		return m.Downloads()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DirectLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case directlink.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case directlink.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case directlink.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case directlink.FieldFileID:
+		return m.OldFileID(ctx)
+	case directlink.FieldFileName:
+		return m.OldFileName(ctx)
+	case directlink.FieldSpeedLimit:
+		return m.OldSpeedLimit(ctx)
+	case directlink.FieldDownloads:
+		return m.OldDownloads(ctx)
+	}
+	return nil, fmt.Errorf("unknown DirectLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DirectLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case directlink.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case directlink.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case directlink.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case directlink.FieldFileID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileID(v)
+		return nil
+	case directlink.FieldFileName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileName(v)
+		return nil
+	case directlink.FieldSpeedLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpeedLimit(v)
+		return nil
+	case directlink.FieldDownloads:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloads(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DirectLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DirectLinkMutation) AddedFields() []string {
+	var fields []string
+	if m.addspeed_limit != nil {
+		fields = append(fields, directlink.FieldSpeedLimit)
+	}
+	if m.adddownloads != nil {
+		fields = append(fields, directlink.FieldDownloads)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DirectLinkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case directlink.FieldSpeedLimit:
+		return m.AddedSpeedLimit()
+	case directlink.FieldDownloads:
+		return m.AddedDownloads()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DirectLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case directlink.FieldSpeedLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpeedLimit(v)
+		return nil
+	case directlink.FieldDownloads:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloads(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DirectLink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DirectLinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(directlink.FieldDeletedAt) {
+		fields = append(fields, directlink.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DirectLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DirectLinkMutation) ClearField(name string) error {
+	switch name {
+	case directlink.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DirectLink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DirectLinkMutation) ResetField(name string) error {
+	switch name {
+	case directlink.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case directlink.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case directlink.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case directlink.FieldFileID:
+		m.ResetFileID()
+		return nil
+	case directlink.FieldFileName:
+		m.ResetFileName()
+		return nil
+	case directlink.FieldSpeedLimit:
+		m.ResetSpeedLimit()
+		return nil
+	case directlink.FieldDownloads:
+		m.ResetDownloads()
+		return nil
+	}
+	return fmt.Errorf("unknown DirectLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DirectLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.file != nil {
+		edges = append(edges, directlink.EdgeFile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DirectLinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case directlink.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DirectLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DirectLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DirectLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfile {
+		edges = append(edges, directlink.EdgeFile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DirectLinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case directlink.EdgeFile:
+		return m.clearedfile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DirectLinkMutation) ClearEdge(name string) error {
+	switch name {
+	case directlink.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown DirectLink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DirectLinkMutation) ResetEdge(name string) error {
+	switch name {
+	case directlink.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown DirectLink edge %s", name)
+}
+
+// DocSeriesMutation represents an operation that mutates the DocSeries nodes in the graph.
+type DocSeriesMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uint
+	created_at      *time.Time
+	updated_at      *time.Time
+	name            *string
+	description     *string
+	cover_url       *string
+	sort            *int
+	addsort         *int
+	doc_count       *int
+	adddoc_count    *int
+	clearedFields   map[string]struct{}
+	articles        map[uint]struct{}
+	removedarticles map[uint]struct{}
+	clearedarticles bool
+	done            bool
+	oldValue        func(context.Context) (*DocSeries, error)
+	predicates      []predicate.DocSeries
+}
+
+var _ ent.Mutation = (*DocSeriesMutation)(nil)
+
+// docseriesOption allows management of the mutation configuration using functional options.
+type docseriesOption func(*DocSeriesMutation)
+
+// newDocSeriesMutation creates new mutation for the DocSeries entity.
+func newDocSeriesMutation(c config, op Op, opts ...docseriesOption) *DocSeriesMutation {
+	m := &DocSeriesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDocSeries,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDocSeriesID sets the ID field of the mutation.
+func withDocSeriesID(id uint) docseriesOption {
+	return func(m *DocSeriesMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DocSeries
+		)
+		m.oldValue = func(ctx context.Context) (*DocSeries, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DocSeries.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDocSeries sets the old DocSeries of the mutation.
+func withDocSeries(node *DocSeries) docseriesOption {
+	return func(m *DocSeriesMutation) {
+		m.oldValue = func(context.Context) (*DocSeries, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DocSeriesMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DocSeriesMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DocSeries entities.
+func (m *DocSeriesMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DocSeriesMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DocSeriesMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DocSeries.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DocSeriesMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DocSeriesMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DocSeries entity.
+// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocSeriesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DocSeriesMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DocSeriesMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DocSeriesMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DocSeries entity.
+// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocSeriesMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DocSeriesMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *DocSeriesMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DocSeriesMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the DocSeries entity.
+// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocSeriesMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DocSeriesMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *DocSeriesMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *DocSeriesMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the DocSeries entity.
+// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocSeriesMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *DocSeriesMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[docseries.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *DocSeriesMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[docseries.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *DocSeriesMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, docseries.FieldDescription)
+}
+
+// SetCoverURL sets the "cover_url" field.
+func (m *DocSeriesMutation) SetCoverURL(s string) {
+	m.cover_url = &s
+}
+
+// CoverURL returns the value of the "cover_url" field in the mutation.
+func (m *DocSeriesMutation) CoverURL() (r string, exists bool) {
+	v := m.cover_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoverURL returns the old "cover_url" field's value of the DocSeries entity.
+// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocSeriesMutation) OldCoverURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoverURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoverURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoverURL: %w", err)
+	}
+	return oldValue.CoverURL, nil
+}
+
+// ClearCoverURL clears the value of the "cover_url" field.
+func (m *DocSeriesMutation) ClearCoverURL() {
+	m.cover_url = nil
+	m.clearedFields[docseries.FieldCoverURL] = struct{}{}
+}
+
+// CoverURLCleared returns if the "cover_url" field was cleared in this mutation.
+func (m *DocSeriesMutation) CoverURLCleared() bool {
+	_, ok := m.clearedFields[docseries.FieldCoverURL]
+	return ok
+}
+
+// ResetCoverURL resets all changes to the "cover_url" field.
+func (m *DocSeriesMutation) ResetCoverURL() {
+	m.cover_url = nil
+	delete(m.clearedFields, docseries.FieldCoverURL)
+}
+
+// SetSort sets the "sort" field.
+func (m *DocSeriesMutation) SetSort(i int) {
+	m.sort = &i
+	m.addsort = nil
+}
+
+// Sort returns the value of the "sort" field in the mutation.
+func (m *DocSeriesMutation) Sort() (r int, exists bool) {
+	v := m.sort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSort returns the old "sort" field's value of the DocSeries entity.
+// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocSeriesMutation) OldSort(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+	}
+	return oldValue.Sort, nil
+}
+
+// AddSort adds i to the "sort" field.
+func (m *DocSeriesMutation) AddSort(i int) {
+	if m.addsort != nil {
+		*m.addsort += i
+	} else {
+		m.addsort = &i
+	}
+}
+
+// AddedSort returns the value that was added to the "sort" field in this mutation.
+func (m *DocSeriesMutation) AddedSort() (r int, exists bool) {
+	v := m.addsort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSort resets all changes to the "sort" field.
+func (m *DocSeriesMutation) ResetSort() {
+	m.sort = nil
+	m.addsort = nil
+}
+
+// SetDocCount sets the "doc_count" field.
+func (m *DocSeriesMutation) SetDocCount(i int) {
+	m.doc_count = &i
+	m.adddoc_count = nil
+}
+
+// DocCount returns the value of the "doc_count" field in the mutation.
+func (m *DocSeriesMutation) DocCount() (r int, exists bool) {
+	v := m.doc_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocCount returns the old "doc_count" field's value of the DocSeries entity.
+// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocSeriesMutation) OldDocCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocCount: %w", err)
+	}
+	return oldValue.DocCount, nil
+}
+
+// AddDocCount adds i to the "doc_count" field.
+func (m *DocSeriesMutation) AddDocCount(i int) {
+	if m.adddoc_count != nil {
+		*m.adddoc_count += i
+	} else {
+		m.adddoc_count = &i
+	}
+}
+
+// AddedDocCount returns the value that was added to the "doc_count" field in this mutation.
+func (m *DocSeriesMutation) AddedDocCount() (r int, exists bool) {
+	v := m.adddoc_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDocCount resets all changes to the "doc_count" field.
+func (m *DocSeriesMutation) ResetDocCount() {
+	m.doc_count = nil
+	m.adddoc_count = nil
+}
+
+// AddArticleIDs adds the "articles" edge to the Article entity by ids.
+func (m *DocSeriesMutation) AddArticleIDs(ids ...uint) {
+	if m.articles == nil {
+		m.articles = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.articles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearArticles clears the "articles" edge to the Article entity.
+func (m *DocSeriesMutation) ClearArticles() {
+	m.clearedarticles = true
+}
+
+// ArticlesCleared reports if the "articles" edge to the Article entity was cleared.
+func (m *DocSeriesMutation) ArticlesCleared() bool {
+	return m.clearedarticles
+}
+
+// RemoveArticleIDs removes the "articles" edge to the Article entity by IDs.
+func (m *DocSeriesMutation) RemoveArticleIDs(ids ...uint) {
+	if m.removedarticles == nil {
+		m.removedarticles = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.articles, ids[i])
+		m.removedarticles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedArticles returns the removed IDs of the "articles" edge to the Article entity.
+func (m *DocSeriesMutation) RemovedArticlesIDs() (ids []uint) {
+	for id := range m.removedarticles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ArticlesIDs returns the "articles" edge IDs in the mutation.
+func (m *DocSeriesMutation) ArticlesIDs() (ids []uint) {
+	for id := range m.articles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetArticles resets all changes to the "articles" edge.
+func (m *DocSeriesMutation) ResetArticles() {
+	m.articles = nil
+	m.clearedarticles = false
+	m.removedarticles = nil
+}
+
+// Where appends a list predicates to the DocSeriesMutation builder.
+func (m *DocSeriesMutation) Where(ps ...predicate.DocSeries) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DocSeriesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DocSeriesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DocSeries, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DocSeriesMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DocSeriesMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DocSeries).
+func (m *DocSeriesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DocSeriesMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, docseries.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, docseries.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, docseries.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, docseries.FieldDescription)
+	}
+	if m.cover_url != nil {
+		fields = append(fields, docseries.FieldCoverURL)
+	}
+	if m.sort != nil {
+		fields = append(fields, docseries.FieldSort)
+	}
+	if m.doc_count != nil {
+		fields = append(fields, docseries.FieldDocCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DocSeriesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case docseries.FieldCreatedAt:
+		return m.CreatedAt()
+	case docseries.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case docseries.FieldName:
+		return m.Name()
+	case docseries.FieldDescription:
+		return m.Description()
+	case docseries.FieldCoverURL:
+		return m.CoverURL()
+	case docseries.FieldSort:
+		return m.Sort()
+	case docseries.FieldDocCount:
+		return m.DocCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DocSeriesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case docseries.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case docseries.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case docseries.FieldName:
+		return m.OldName(ctx)
+	case docseries.FieldDescription:
+		return m.OldDescription(ctx)
+	case docseries.FieldCoverURL:
+		return m.OldCoverURL(ctx)
+	case docseries.FieldSort:
+		return m.OldSort(ctx)
+	case docseries.FieldDocCount:
+		return m.OldDocCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown DocSeries field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocSeriesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case docseries.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
-	case comment.FieldEmailMd5:
-		v, ok := value.(string)
+	case docseries.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmailMd5(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case comment.FieldWebsite:
+	case docseries.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWebsite(v)
+		m.SetName(v)
 		return nil
-	case comment.FieldContent:
+	case docseries.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetDescription(v)
 		return nil
-	case comment.FieldContentHTML:
+	case docseries.FieldCoverURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentHTML(v)
+		m.SetCoverURL(v)
 		return nil
-	case comment.FieldStatus:
+	case docseries.FieldSort:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetSort(v)
 		return nil
-	case comment.FieldIsAdminComment:
-		v, ok := value.(bool)
+	case docseries.FieldDocCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsAdminComment(v)
+		m.SetDocCount(v)
 		return nil
-	case comment.FieldIsAnonymous:
-		v, ok := value.(bool)
+	}
+	return fmt.Errorf("unknown DocSeries field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DocSeriesMutation) AddedFields() []string {
+	var fields []string
+	if m.addsort != nil {
+		fields = append(fields, docseries.FieldSort)
+	}
+	if m.adddoc_count != nil {
+		fields = append(fields, docseries.FieldDocCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DocSeriesMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case docseries.FieldSort:
+		return m.AddedSort()
+	case docseries.FieldDocCount:
+		return m.AddedDocCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocSeriesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case docseries.FieldSort:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsAnonymous(v)
+		m.AddSort(v)
 		return nil
-	case comment.FieldUserAgent:
-		v, ok := value.(string)
+	case docseries.FieldDocCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserAgent(v)
+		m.AddDocCount(v)
 		return nil
-	case comment.FieldIPAddress:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	}
+	return fmt.Errorf("unknown DocSeries numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DocSeriesMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(docseries.FieldDescription) {
+		fields = append(fields, docseries.FieldDescription)
+	}
+	if m.FieldCleared(docseries.FieldCoverURL) {
+		fields = append(fields, docseries.FieldCoverURL)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DocSeriesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DocSeriesMutation) ClearField(name string) error {
+	switch name {
+	case docseries.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case docseries.FieldCoverURL:
+		m.ClearCoverURL()
+		return nil
+	}
+	return fmt.Errorf("unknown DocSeries nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DocSeriesMutation) ResetField(name string) error {
+	switch name {
+	case docseries.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case docseries.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case docseries.FieldName:
+		m.ResetName()
+		return nil
+	case docseries.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case docseries.FieldCoverURL:
+		m.ResetCoverURL()
+		return nil
+	case docseries.FieldSort:
+		m.ResetSort()
+		return nil
+	case docseries.FieldDocCount:
+		m.ResetDocCount()
+		return nil
+	}
+	return fmt.Errorf("unknown DocSeries field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DocSeriesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.articles != nil {
+		edges = append(edges, docseries.EdgeArticles)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DocSeriesMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case docseries.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.articles))
+		for id := range m.articles {
+			ids = append(ids, id)
 		}
-		m.SetIPAddress(v)
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DocSeriesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedarticles != nil {
+		edges = append(edges, docseries.EdgeArticles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DocSeriesMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case docseries.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.removedarticles))
+		for id := range m.removedarticles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DocSeriesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedarticles {
+		edges = append(edges, docseries.EdgeArticles)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DocSeriesMutation) EdgeCleared(name string) bool {
+	switch name {
+	case docseries.EdgeArticles:
+		return m.clearedarticles
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DocSeriesMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DocSeries unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DocSeriesMutation) ResetEdge(name string) error {
+	switch name {
+	case docseries.EdgeArticles:
+		m.ResetArticles()
 		return nil
-	case comment.FieldIPLocation:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	}
+	return fmt.Errorf("unknown DocSeries edge %s", name)
+}
+
+// EntityMutation represents an operation that mutates the Entity nodes in the graph.
+type EntityMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uint
+	created_at           *time.Time
+	updated_at           *time.Time
+	_type                *string
+	source               *string
+	size                 *int64
+	addsize              *int64
+	upload_session_id    *string
+	recycle_options      *string
+	policy_id            *uint
+	addpolicy_id         *int
+	created_by           *uint64
+	addcreated_by        *int64
+	etag                 *string
+	mime_type            *string
+	dimension            *string
+	storage_metadata     *model.JSONMap
+	clearedFields        map[string]struct{}
+	file_versions        map[uint]struct{}
+	removedfile_versions map[uint]struct{}
+	clearedfile_versions bool
+	done                 bool
+	oldValue             func(context.Context) (*Entity, error)
+	predicates           []predicate.Entity
+}
+
+var _ ent.Mutation = (*EntityMutation)(nil)
+
+// entityOption allows management of the mutation configuration using functional options.
+type entityOption func(*EntityMutation)
+
+// newEntityMutation creates new mutation for the Entity entity.
+func newEntityMutation(c config, op Op, opts ...entityOption) *EntityMutation {
+	m := &EntityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEntity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEntityID sets the ID field of the mutation.
+func withEntityID(id uint) entityOption {
+	return func(m *EntityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Entity
+		)
+		m.oldValue = func(ctx context.Context) (*Entity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Entity.Get(ctx, id)
+				}
+			})
+			return value, err
 		}
-		m.SetIPLocation(v)
-		return nil
-	case comment.FieldLikeCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.id = &id
+	}
+}
+
+// withEntity sets the old Entity of the mutation.
+func withEntity(node *Entity) entityOption {
+	return func(m *EntityMutation) {
+		m.oldValue = func(context.Context) (*Entity, error) {
+			return node, nil
 		}
-		m.SetLikeCount(v)
-		return nil
-	case comment.FieldPinnedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EntityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EntityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Entity entities.
+func (m *EntityMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EntityMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EntityMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
 		}
-		m.SetPinnedAt(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Entity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EntityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EntityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EntityMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EntityMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EntityMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EntityMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetType sets the "type" field.
+func (m *EntityMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *EntityMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *EntityMutation) ResetType() {
+	m._type = nil
+}
+
+// SetSource sets the "source" field.
+func (m *EntityMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *EntityMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldSource(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *CommentMutation) AddedFields() []string {
-	var fields []string
-	if m.addreply_to_id != nil {
-		fields = append(fields, comment.FieldReplyToID)
+// ClearSource clears the value of the "source" field.
+func (m *EntityMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[entity.FieldSource] = struct{}{}
+}
+
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *EntityMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[entity.FieldSource]
+	return ok
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *EntityMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, entity.FieldSource)
+}
+
+// SetSize sets the "size" field.
+func (m *EntityMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *EntityMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
 	}
-	if m.addstatus != nil {
-		fields = append(fields, comment.FieldStatus)
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
-	if m.addlike_count != nil {
-		fields = append(fields, comment.FieldLikeCount)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case comment.FieldReplyToID:
-		return m.AddedReplyToID()
-	case comment.FieldStatus:
-		return m.AddedStatus()
-	case comment.FieldLikeCount:
-		return m.AddedLikeCount()
+// AddSize adds i to the "size" field.
+func (m *EntityMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CommentMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case comment.FieldReplyToID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddReplyToID(v)
-		return nil
-	case comment.FieldStatus:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatus(v)
-		return nil
-	case comment.FieldLikeCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLikeCount(v)
-		return nil
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *EntityMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Comment numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *CommentMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(comment.FieldDeletedAt) {
-		fields = append(fields, comment.FieldDeletedAt)
+// ResetSize resets all changes to the "size" field.
+func (m *EntityMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetUploadSessionID sets the "upload_session_id" field.
+func (m *EntityMutation) SetUploadSessionID(s string) {
+	m.upload_session_id = &s
+}
+
+// UploadSessionID returns the value of the "upload_session_id" field in the mutation.
+func (m *EntityMutation) UploadSessionID() (r string, exists bool) {
+	v := m.upload_session_id
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(comment.FieldTargetTitle) {
-		fields = append(fields, comment.FieldTargetTitle)
+	return *v, true
+}
+
+// OldUploadSessionID returns the old "upload_session_id" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldUploadSessionID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadSessionID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(comment.FieldUserID) {
-		fields = append(fields, comment.FieldUserID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadSessionID requires an ID field in the mutation")
 	}
-	if m.FieldCleared(comment.FieldParentID) {
-		fields = append(fields, comment.FieldParentID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadSessionID: %w", err)
 	}
-	if m.FieldCleared(comment.FieldReplyToID) {
-		fields = append(fields, comment.FieldReplyToID)
+	return oldValue.UploadSessionID, nil
+}
+
+// ClearUploadSessionID clears the value of the "upload_session_id" field.
+func (m *EntityMutation) ClearUploadSessionID() {
+	m.upload_session_id = nil
+	m.clearedFields[entity.FieldUploadSessionID] = struct{}{}
+}
+
+// UploadSessionIDCleared returns if the "upload_session_id" field was cleared in this mutation.
+func (m *EntityMutation) UploadSessionIDCleared() bool {
+	_, ok := m.clearedFields[entity.FieldUploadSessionID]
+	return ok
+}
+
+// ResetUploadSessionID resets all changes to the "upload_session_id" field.
+func (m *EntityMutation) ResetUploadSessionID() {
+	m.upload_session_id = nil
+	delete(m.clearedFields, entity.FieldUploadSessionID)
+}
+
+// SetRecycleOptions sets the "recycle_options" field.
+func (m *EntityMutation) SetRecycleOptions(s string) {
+	m.recycle_options = &s
+}
+
+// RecycleOptions returns the value of the "recycle_options" field in the mutation.
+func (m *EntityMutation) RecycleOptions() (r string, exists bool) {
+	v := m.recycle_options
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(comment.FieldEmail) {
-		fields = append(fields, comment.FieldEmail)
+	return *v, true
+}
+
+// OldRecycleOptions returns the old "recycle_options" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldRecycleOptions(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecycleOptions is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(comment.FieldWebsite) {
-		fields = append(fields, comment.FieldWebsite)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecycleOptions requires an ID field in the mutation")
 	}
-	if m.FieldCleared(comment.FieldUserAgent) {
-		fields = append(fields, comment.FieldUserAgent)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecycleOptions: %w", err)
+	}
+	return oldValue.RecycleOptions, nil
+}
+
+// ClearRecycleOptions clears the value of the "recycle_options" field.
+func (m *EntityMutation) ClearRecycleOptions() {
+	m.recycle_options = nil
+	m.clearedFields[entity.FieldRecycleOptions] = struct{}{}
+}
+
+// RecycleOptionsCleared returns if the "recycle_options" field was cleared in this mutation.
+func (m *EntityMutation) RecycleOptionsCleared() bool {
+	_, ok := m.clearedFields[entity.FieldRecycleOptions]
+	return ok
+}
+
+// ResetRecycleOptions resets all changes to the "recycle_options" field.
+func (m *EntityMutation) ResetRecycleOptions() {
+	m.recycle_options = nil
+	delete(m.clearedFields, entity.FieldRecycleOptions)
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (m *EntityMutation) SetPolicyID(u uint) {
+	m.policy_id = &u
+	m.addpolicy_id = nil
+}
+
+// PolicyID returns the value of the "policy_id" field in the mutation.
+func (m *EntityMutation) PolicyID() (r uint, exists bool) {
+	v := m.policy_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyID returns the old "policy_id" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldPolicyID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(comment.FieldIPLocation) {
-		fields = append(fields, comment.FieldIPLocation)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyID requires an ID field in the mutation")
 	}
-	if m.FieldCleared(comment.FieldPinnedAt) {
-		fields = append(fields, comment.FieldPinnedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyID: %w", err)
 	}
-	return fields
+	return oldValue.PolicyID, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *CommentMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddPolicyID adds u to the "policy_id" field.
+func (m *EntityMutation) AddPolicyID(u int) {
+	if m.addpolicy_id != nil {
+		*m.addpolicy_id += u
+	} else {
+		m.addpolicy_id = &u
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *CommentMutation) ClearField(name string) error {
-	switch name {
-	case comment.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case comment.FieldTargetTitle:
-		m.ClearTargetTitle()
-		return nil
-	case comment.FieldUserID:
-		m.ClearUserID()
-		return nil
-	case comment.FieldParentID:
-		m.ClearParentID()
-		return nil
-	case comment.FieldReplyToID:
-		m.ClearReplyToID()
-		return nil
-	case comment.FieldEmail:
-		m.ClearEmail()
-		return nil
-	case comment.FieldWebsite:
-		m.ClearWebsite()
-		return nil
-	case comment.FieldUserAgent:
-		m.ClearUserAgent()
-		return nil
-	case comment.FieldIPLocation:
-		m.ClearIPLocation()
-		return nil
-	case comment.FieldPinnedAt:
-		m.ClearPinnedAt()
-		return nil
+// AddedPolicyID returns the value that was added to the "policy_id" field in this mutation.
+func (m *EntityMutation) AddedPolicyID() (r int, exists bool) {
+	v := m.addpolicy_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Comment nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *CommentMutation) ResetField(name string) error {
-	switch name {
-	case comment.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case comment.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case comment.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case comment.FieldTargetPath:
-		m.ResetTargetPath()
-		return nil
-	case comment.FieldTargetTitle:
-		m.ResetTargetTitle()
-		return nil
-	case comment.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case comment.FieldParentID:
-		m.ResetParentID()
-		return nil
-	case comment.FieldReplyToID:
-		m.ResetReplyToID()
-		return nil
-	case comment.FieldNickname:
-		m.ResetNickname()
-		return nil
-	case comment.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case comment.FieldEmailMd5:
-		m.ResetEmailMd5()
-		return nil
-	case comment.FieldWebsite:
-		m.ResetWebsite()
-		return nil
-	case comment.FieldContent:
-		m.ResetContent()
-		return nil
-	case comment.FieldContentHTML:
-		m.ResetContentHTML()
-		return nil
-	case comment.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case comment.FieldIsAdminComment:
-		m.ResetIsAdminComment()
-		return nil
-	case comment.FieldIsAnonymous:
-		m.ResetIsAnonymous()
-		return nil
-	case comment.FieldUserAgent:
-		m.ResetUserAgent()
-		return nil
-	case comment.FieldIPAddress:
-		m.ResetIPAddress()
-		return nil
-	case comment.FieldIPLocation:
-		m.ResetIPLocation()
-		return nil
-	case comment.FieldLikeCount:
-		m.ResetLikeCount()
-		return nil
-	case comment.FieldPinnedAt:
-		m.ResetPinnedAt()
-		return nil
+// ResetPolicyID resets all changes to the "policy_id" field.
+func (m *EntityMutation) ResetPolicyID() {
+	m.policy_id = nil
+	m.addpolicy_id = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *EntityMutation) SetCreatedBy(u uint64) {
+	m.created_by = &u
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *EntityMutation) CreatedBy() (r uint64, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CommentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.user != nil {
-		edges = append(edges, comment.EdgeUser)
+// OldCreatedBy returns the old "created_by" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldCreatedBy(ctx context.Context) (v *uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
-	if m.children != nil {
-		edges = append(edges, comment.EdgeChildren)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
-	if m.parent != nil {
-		edges = append(edges, comment.EdgeParent)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return edges
+	return oldValue.CreatedBy, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *CommentMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case comment.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case comment.EdgeChildren:
-		if id := m.children; id != nil {
-			return []ent.Value{*id}
-		}
-	case comment.EdgeParent:
-		ids := make([]ent.Value, 0, len(m.parent))
-		for id := range m.parent {
-			ids = append(ids, id)
-		}
-		return ids
+// AddCreatedBy adds u to the "created_by" field.
+func (m *EntityMutation) AddCreatedBy(u int64) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += u
+	} else {
+		m.addcreated_by = &u
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CommentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedparent != nil {
-		edges = append(edges, comment.EdgeParent)
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *EntityMutation) AddedCreatedBy() (r int64, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case comment.EdgeParent:
-		ids := make([]ent.Value, 0, len(m.removedparent))
-		for id := range m.removedparent {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *EntityMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[entity.FieldCreatedBy] = struct{}{}
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CommentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareduser {
-		edges = append(edges, comment.EdgeUser)
-	}
-	if m.clearedchildren {
-		edges = append(edges, comment.EdgeChildren)
-	}
-	if m.clearedparent {
-		edges = append(edges, comment.EdgeParent)
-	}
-	return edges
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *EntityMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[entity.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *EntityMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, entity.FieldCreatedBy)
+}
+
+// SetEtag sets the "etag" field.
+func (m *EntityMutation) SetEtag(s string) {
+	m.etag = &s
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *CommentMutation) EdgeCleared(name string) bool {
-	switch name {
-	case comment.EdgeUser:
-		return m.cleareduser
-	case comment.EdgeChildren:
-		return m.clearedchildren
-	case comment.EdgeParent:
-		return m.clearedparent
+// Etag returns the value of the "etag" field in the mutation.
+func (m *EntityMutation) Etag() (r string, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *CommentMutation) ClearEdge(name string) error {
-	switch name {
-	case comment.EdgeUser:
-		m.ClearUser()
-		return nil
-	case comment.EdgeChildren:
-		m.ClearChildren()
-		return nil
+// OldEtag returns the old "etag" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldEtag(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Comment unique edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
+	}
+	return oldValue.Etag, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *CommentMutation) ResetEdge(name string) error {
-	switch name {
-	case comment.EdgeUser:
-		m.ResetUser()
-		return nil
-	case comment.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case comment.EdgeParent:
-		m.ResetParent()
-		return nil
-	}
-	return fmt.Errorf("unknown Comment edge %s", name)
+// ClearEtag clears the value of the "etag" field.
+func (m *EntityMutation) ClearEtag() {
+	m.etag = nil
+	m.clearedFields[entity.FieldEtag] = struct{}{}
 }
 
-// DirectLinkMutation represents an operation that mutates the DirectLink nodes in the graph.
-type DirectLinkMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uint
-	deleted_at     *time.Time
-	created_at     *time.Time
-	updated_at     *time.Time
-	file_name      *string
-	speed_limit    *int64
-	addspeed_limit *int64
-	downloads      *int64
-	adddownloads   *int64
-	clearedFields  map[string]struct{}
-	file           *uint
-	clearedfile    bool
-	done           bool
-	oldValue       func(context.Context) (*DirectLink, error)
-	predicates     []predicate.DirectLink
+// EtagCleared returns if the "etag" field was cleared in this mutation.
+func (m *EntityMutation) EtagCleared() bool {
+	_, ok := m.clearedFields[entity.FieldEtag]
+	return ok
 }
 
-var _ ent.Mutation = (*DirectLinkMutation)(nil)
+// ResetEtag resets all changes to the "etag" field.
+func (m *EntityMutation) ResetEtag() {
+	m.etag = nil
+	delete(m.clearedFields, entity.FieldEtag)
+}
 
-// directlinkOption allows management of the mutation configuration using functional options.
-type directlinkOption func(*DirectLinkMutation)
+// SetMimeType sets the "mime_type" field.
+func (m *EntityMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
 
-// newDirectLinkMutation creates new mutation for the DirectLink entity.
-func newDirectLinkMutation(c config, op Op, opts ...directlinkOption) *DirectLinkMutation {
-	m := &DirectLinkMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeDirectLink,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *EntityMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withDirectLinkID sets the ID field of the mutation.
-func withDirectLinkID(id uint) directlinkOption {
-	return func(m *DirectLinkMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *DirectLink
-		)
-		m.oldValue = func(ctx context.Context) (*DirectLink, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().DirectLink.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldMimeType returns the old "mime_type" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldMimeType(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
 }
 
-// withDirectLink sets the old DirectLink of the mutation.
-func withDirectLink(node *DirectLink) directlinkOption {
-	return func(m *DirectLinkMutation) {
-		m.oldValue = func(context.Context) (*DirectLink, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ClearMimeType clears the value of the "mime_type" field.
+func (m *EntityMutation) ClearMimeType() {
+	m.mime_type = nil
+	m.clearedFields[entity.FieldMimeType] = struct{}{}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DirectLinkMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
+func (m *EntityMutation) MimeTypeCleared() bool {
+	_, ok := m.clearedFields[entity.FieldMimeType]
+	return ok
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m DirectLinkMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *EntityMutation) ResetMimeType() {
+	m.mime_type = nil
+	delete(m.clearedFields, entity.FieldMimeType)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DirectLink entities.
-func (m *DirectLinkMutation) SetID(id uint) {
-	m.id = &id
+// SetDimension sets the "dimension" field.
+func (m *EntityMutation) SetDimension(s string) {
+	m.dimension = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *DirectLinkMutation) ID() (id uint, exists bool) {
-	if m.id == nil {
+// Dimension returns the value of the "dimension" field in the mutation.
+func (m *EntityMutation) Dimension() (r string, exists bool) {
+	v := m.dimension
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *DirectLinkMutation) IDs(ctx context.Context) ([]uint, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DirectLink.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldDimension returns the old "dimension" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldDimension(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDimension is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDimension requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDimension: %w", err)
 	}
+	return oldValue.Dimension, nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *DirectLinkMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// ClearDimension clears the value of the "dimension" field.
+func (m *EntityMutation) ClearDimension() {
+	m.dimension = nil
+	m.clearedFields[entity.FieldDimension] = struct{}{}
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *DirectLinkMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// DimensionCleared returns if the "dimension" field was cleared in this mutation.
+func (m *EntityMutation) DimensionCleared() bool {
+	_, ok := m.clearedFields[entity.FieldDimension]
+	return ok
+}
+
+// ResetDimension resets all changes to the "dimension" field.
+func (m *EntityMutation) ResetDimension() {
+	m.dimension = nil
+	delete(m.clearedFields, entity.FieldDimension)
+}
+
+// SetStorageMetadata sets the "storage_metadata" field.
+func (m *EntityMutation) SetStorageMetadata(mm model.JSONMap) {
+	m.storage_metadata = &mm
+}
+
+// StorageMetadata returns the value of the "storage_metadata" field in the mutation.
+func (m *EntityMutation) StorageMetadata() (r model.JSONMap, exists bool) {
+	v := m.storage_metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// OldStorageMetadata returns the old "storage_metadata" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *EntityMutation) OldStorageMetadata(ctx context.Context) (v model.JSONMap, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStorageMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldStorageMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStorageMetadata: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.StorageMetadata, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *DirectLinkMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[directlink.FieldDeletedAt] = struct{}{}
+// ClearStorageMetadata clears the value of the "storage_metadata" field.
+func (m *EntityMutation) ClearStorageMetadata() {
+	m.storage_metadata = nil
+	m.clearedFields[entity.FieldStorageMetadata] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *DirectLinkMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[directlink.FieldDeletedAt]
+// StorageMetadataCleared returns if the "storage_metadata" field was cleared in this mutation.
+func (m *EntityMutation) StorageMetadataCleared() bool {
+	_, ok := m.clearedFields[entity.FieldStorageMetadata]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *DirectLinkMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, directlink.FieldDeletedAt)
+// ResetStorageMetadata resets all changes to the "storage_metadata" field.
+func (m *EntityMutation) ResetStorageMetadata() {
+	m.storage_metadata = nil
+	delete(m.clearedFields, entity.FieldStorageMetadata)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DirectLinkMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddFileVersionIDs adds the "file_versions" edge to the FileEntity entity by ids.
+func (m *EntityMutation) AddFileVersionIDs(ids ...uint) {
+	if m.file_versions == nil {
+		m.file_versions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.file_versions[ids[i]] = struct{}{}
+	}
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DirectLinkMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearFileVersions clears the "file_versions" edge to the FileEntity entity.
+func (m *EntityMutation) ClearFileVersions() {
+	m.clearedfile_versions = true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// FileVersionsCleared reports if the "file_versions" edge to the FileEntity entity was cleared.
+func (m *EntityMutation) FileVersionsCleared() bool {
+	return m.clearedfile_versions
+}
+
+// RemoveFileVersionIDs removes the "file_versions" edge to the FileEntity entity by IDs.
+func (m *EntityMutation) RemoveFileVersionIDs(ids ...uint) {
+	if m.removedfile_versions == nil {
+		m.removedfile_versions = make(map[uint]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.file_versions, ids[i])
+		m.removedfile_versions[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+}
+
+// RemovedFileVersions returns the removed IDs of the "file_versions" edge to the FileEntity entity.
+func (m *EntityMutation) RemovedFileVersionsIDs() (ids []uint) {
+	for id := range m.removedfile_versions {
+		ids = append(ids, id)
 	}
-	return oldValue.CreatedAt, nil
+	return
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DirectLinkMutation) ResetCreatedAt() {
-	m.created_at = nil
+// FileVersionsIDs returns the "file_versions" edge IDs in the mutation.
+func (m *EntityMutation) FileVersionsIDs() (ids []uint) {
+	for id := range m.file_versions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DirectLinkMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ResetFileVersions resets all changes to the "file_versions" edge.
+func (m *EntityMutation) ResetFileVersions() {
+	m.file_versions = nil
+	m.clearedfile_versions = false
+	m.removedfile_versions = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DirectLinkMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// Where appends a list predicates to the EntityMutation builder.
+func (m *EntityMutation) Where(ps ...predicate.Entity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EntityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EntityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Entity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+// Op returns the operation name.
+func (m *EntityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EntityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Entity).
+func (m *EntityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EntityMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, entity.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, entity.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m._type != nil {
+		fields = append(fields, entity.FieldType)
+	}
+	if m.source != nil {
+		fields = append(fields, entity.FieldSource)
+	}
+	if m.size != nil {
+		fields = append(fields, entity.FieldSize)
+	}
+	if m.upload_session_id != nil {
+		fields = append(fields, entity.FieldUploadSessionID)
+	}
+	if m.recycle_options != nil {
+		fields = append(fields, entity.FieldRecycleOptions)
+	}
+	if m.policy_id != nil {
+		fields = append(fields, entity.FieldPolicyID)
+	}
+	if m.created_by != nil {
+		fields = append(fields, entity.FieldCreatedBy)
+	}
+	if m.etag != nil {
+		fields = append(fields, entity.FieldEtag)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, entity.FieldMimeType)
+	}
+	if m.dimension != nil {
+		fields = append(fields, entity.FieldDimension)
+	}
+	if m.storage_metadata != nil {
+		fields = append(fields, entity.FieldStorageMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EntityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case entity.FieldCreatedAt:
+		return m.CreatedAt()
+	case entity.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case entity.FieldType:
+		return m.GetType()
+	case entity.FieldSource:
+		return m.Source()
+	case entity.FieldSize:
+		return m.Size()
+	case entity.FieldUploadSessionID:
+		return m.UploadSessionID()
+	case entity.FieldRecycleOptions:
+		return m.RecycleOptions()
+	case entity.FieldPolicyID:
+		return m.PolicyID()
+	case entity.FieldCreatedBy:
+		return m.CreatedBy()
+	case entity.FieldEtag:
+		return m.Etag()
+	case entity.FieldMimeType:
+		return m.MimeType()
+	case entity.FieldDimension:
+		return m.Dimension()
+	case entity.FieldStorageMetadata:
+		return m.StorageMetadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EntityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case entity.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case entity.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case entity.FieldType:
+		return m.OldType(ctx)
+	case entity.FieldSource:
+		return m.OldSource(ctx)
+	case entity.FieldSize:
+		return m.OldSize(ctx)
+	case entity.FieldUploadSessionID:
+		return m.OldUploadSessionID(ctx)
+	case entity.FieldRecycleOptions:
+		return m.OldRecycleOptions(ctx)
+	case entity.FieldPolicyID:
+		return m.OldPolicyID(ctx)
+	case entity.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case entity.FieldEtag:
+		return m.OldEtag(ctx)
+	case entity.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case entity.FieldDimension:
+		return m.OldDimension(ctx)
+	case entity.FieldStorageMetadata:
+		return m.OldStorageMetadata(ctx)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DirectLinkMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetFileID sets the "file_id" field.
-func (m *DirectLinkMutation) SetFileID(u uint) {
-	m.file = &u
+	return nil, fmt.Errorf("unknown Entity field %s", name)
 }
 
-// FileID returns the value of the "file_id" field in the mutation.
-func (m *DirectLinkMutation) FileID() (r uint, exists bool) {
-	v := m.file
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case entity.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case entity.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case entity.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case entity.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case entity.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case entity.FieldUploadSessionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadSessionID(v)
+		return nil
+	case entity.FieldRecycleOptions:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecycleOptions(v)
+		return nil
+	case entity.FieldPolicyID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyID(v)
+		return nil
+	case entity.FieldCreatedBy:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case entity.FieldEtag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case entity.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case entity.FieldDimension:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDimension(v)
+		return nil
+	case entity.FieldStorageMetadata:
+		v, ok := value.(model.JSONMap)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageMetadata(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Entity field %s", name)
 }
 
-// OldFileID returns the old "file_id" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldFileID(ctx context.Context) (v uint, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EntityMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, entity.FieldSize)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileID requires an ID field in the mutation")
+	if m.addpolicy_id != nil {
+		fields = append(fields, entity.FieldPolicyID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+	if m.addcreated_by != nil {
+		fields = append(fields, entity.FieldCreatedBy)
 	}
-	return oldValue.FileID, nil
-}
-
-// ResetFileID resets all changes to the "file_id" field.
-func (m *DirectLinkMutation) ResetFileID() {
-	m.file = nil
+	return fields
 }
 
-// SetFileName sets the "file_name" field.
-func (m *DirectLinkMutation) SetFileName(s string) {
-	m.file_name = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EntityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case entity.FieldSize:
+		return m.AddedSize()
+	case entity.FieldPolicyID:
+		return m.AddedPolicyID()
+	case entity.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	}
+	return nil, false
 }
 
-// FileName returns the value of the "file_name" field in the mutation.
-func (m *DirectLinkMutation) FileName() (r string, exists bool) {
-	v := m.file_name
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case entity.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case entity.FieldPolicyID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPolicyID(v)
+		return nil
+	case entity.FieldCreatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Entity numeric field %s", name)
 }
 
-// OldFileName returns the old "file_name" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldFileName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EntityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(entity.FieldSource) {
+		fields = append(fields, entity.FieldSource)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileName requires an ID field in the mutation")
+	if m.FieldCleared(entity.FieldUploadSessionID) {
+		fields = append(fields, entity.FieldUploadSessionID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
+	if m.FieldCleared(entity.FieldRecycleOptions) {
+		fields = append(fields, entity.FieldRecycleOptions)
 	}
-	return oldValue.FileName, nil
-}
-
-// ResetFileName resets all changes to the "file_name" field.
-func (m *DirectLinkMutation) ResetFileName() {
-	m.file_name = nil
-}
-
-// SetSpeedLimit sets the "speed_limit" field.
-func (m *DirectLinkMutation) SetSpeedLimit(i int64) {
-	m.speed_limit = &i
-	m.addspeed_limit = nil
-}
-
-// SpeedLimit returns the value of the "speed_limit" field in the mutation.
-func (m *DirectLinkMutation) SpeedLimit() (r int64, exists bool) {
-	v := m.speed_limit
-	if v == nil {
-		return
+	if m.FieldCleared(entity.FieldCreatedBy) {
+		fields = append(fields, entity.FieldCreatedBy)
 	}
-	return *v, true
-}
-
-// OldSpeedLimit returns the old "speed_limit" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldSpeedLimit(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpeedLimit is only allowed on UpdateOne operations")
+	if m.FieldCleared(entity.FieldEtag) {
+		fields = append(fields, entity.FieldEtag)
+	}
+	if m.FieldCleared(entity.FieldMimeType) {
+		fields = append(fields, entity.FieldMimeType)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpeedLimit requires an ID field in the mutation")
+	if m.FieldCleared(entity.FieldDimension) {
+		fields = append(fields, entity.FieldDimension)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpeedLimit: %w", err)
+	if m.FieldCleared(entity.FieldStorageMetadata) {
+		fields = append(fields, entity.FieldStorageMetadata)
 	}
-	return oldValue.SpeedLimit, nil
+	return fields
 }
 
-// AddSpeedLimit adds i to the "speed_limit" field.
-func (m *DirectLinkMutation) AddSpeedLimit(i int64) {
-	if m.addspeed_limit != nil {
-		*m.addspeed_limit += i
-	} else {
-		m.addspeed_limit = &i
-	}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EntityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// AddedSpeedLimit returns the value that was added to the "speed_limit" field in this mutation.
-func (m *DirectLinkMutation) AddedSpeedLimit() (r int64, exists bool) {
-	v := m.addspeed_limit
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EntityMutation) ClearField(name string) error {
+	switch name {
+	case entity.FieldSource:
+		m.ClearSource()
+		return nil
+	case entity.FieldUploadSessionID:
+		m.ClearUploadSessionID()
+		return nil
+	case entity.FieldRecycleOptions:
+		m.ClearRecycleOptions()
+		return nil
+	case entity.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case entity.FieldEtag:
+		m.ClearEtag()
+		return nil
+	case entity.FieldMimeType:
+		m.ClearMimeType()
+		return nil
+	case entity.FieldDimension:
+		m.ClearDimension()
+		return nil
+	case entity.FieldStorageMetadata:
+		m.ClearStorageMetadata()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Entity nullable field %s", name)
 }
 
-// ResetSpeedLimit resets all changes to the "speed_limit" field.
-func (m *DirectLinkMutation) ResetSpeedLimit() {
-	m.speed_limit = nil
-	m.addspeed_limit = nil
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EntityMutation) ResetField(name string) error {
+	switch name {
+	case entity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case entity.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case entity.FieldType:
+		m.ResetType()
+		return nil
+	case entity.FieldSource:
+		m.ResetSource()
+		return nil
+	case entity.FieldSize:
+		m.ResetSize()
+		return nil
+	case entity.FieldUploadSessionID:
+		m.ResetUploadSessionID()
+		return nil
+	case entity.FieldRecycleOptions:
+		m.ResetRecycleOptions()
+		return nil
+	case entity.FieldPolicyID:
+		m.ResetPolicyID()
+		return nil
+	case entity.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case entity.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case entity.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case entity.FieldDimension:
+		m.ResetDimension()
+		return nil
+	case entity.FieldStorageMetadata:
+		m.ResetStorageMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Entity field %s", name)
 }
 
-// SetDownloads sets the "downloads" field.
-func (m *DirectLinkMutation) SetDownloads(i int64) {
-	m.downloads = &i
-	m.adddownloads = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EntityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.file_versions != nil {
+		edges = append(edges, entity.EdgeFileVersions)
+	}
+	return edges
 }
 
-// Downloads returns the value of the "downloads" field in the mutation.
-func (m *DirectLinkMutation) Downloads() (r int64, exists bool) {
-	v := m.downloads
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EntityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case entity.EdgeFileVersions:
+		ids := make([]ent.Value, 0, len(m.file_versions))
+		for id := range m.file_versions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldDownloads returns the old "downloads" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldDownloads(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDownloads is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDownloads requires an ID field in the mutation")
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EntityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedfile_versions != nil {
+		edges = append(edges, entity.EdgeFileVersions)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDownloads: %w", err)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EntityMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case entity.EdgeFileVersions:
+		ids := make([]ent.Value, 0, len(m.removedfile_versions))
+		for id := range m.removedfile_versions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.Downloads, nil
+	return nil
 }
 
-// AddDownloads adds i to the "downloads" field.
-func (m *DirectLinkMutation) AddDownloads(i int64) {
-	if m.adddownloads != nil {
-		*m.adddownloads += i
-	} else {
-		m.adddownloads = &i
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EntityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfile_versions {
+		edges = append(edges, entity.EdgeFileVersions)
 	}
+	return edges
 }
 
-// AddedDownloads returns the value that was added to the "downloads" field in this mutation.
-func (m *DirectLinkMutation) AddedDownloads() (r int64, exists bool) {
-	v := m.adddownloads
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EntityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case entity.EdgeFileVersions:
+		return m.clearedfile_versions
 	}
-	return *v, true
+	return false
 }
 
-// ResetDownloads resets all changes to the "downloads" field.
-func (m *DirectLinkMutation) ResetDownloads() {
-	m.downloads = nil
-	m.adddownloads = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EntityMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Entity unique edge %s", name)
 }
 
-// ClearFile clears the "file" edge to the File entity.
-func (m *DirectLinkMutation) ClearFile() {
-	m.clearedfile = true
-	m.clearedFields[directlink.FieldFileID] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EntityMutation) ResetEdge(name string) error {
+	switch name {
+	case entity.EdgeFileVersions:
+		m.ResetFileVersions()
+		return nil
+	}
+	return fmt.Errorf("unknown Entity edge %s", name)
 }
 
-// FileCleared reports if the "file" edge to the File entity was cleared.
-func (m *DirectLinkMutation) FileCleared() bool {
-	return m.clearedfile
+// FileMutation represents an operation that mutates the File nodes in the graph.
+type FileMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uint
+	deleted_at            *time.Time
+	created_at            *time.Time
+	updated_at            *time.Time
+	_type                 *int
+	add_type              *int
+	name                  *string
+	size                  *int64
+	addsize               *int64
+	children_count        *int64
+	addchildren_count     *int64
+	view_config           *string
+	clearedFields         map[string]struct{}
+	owner                 *uint
+	clearedowner          bool
+	parent                *uint
+	clearedparent         bool
+	children              map[uint]struct{}
+	removedchildren       map[uint]struct{}
+	clearedchildren       bool
+	primary_entity        *uint
+	clearedprimary_entity bool
+	versions              map[uint]struct{}
+	removedversions       map[uint]struct{}
+	clearedversions       bool
+	direct_link           *uint
+	cleareddirect_link    bool
+	metadata              map[uint]struct{}
+	removedmetadata       map[uint]struct{}
+	clearedmetadata       bool
+	done                  bool
+	oldValue              func(context.Context) (*File, error)
+	predicates            []predicate.File
 }
 
-// FileIDs returns the "file" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FileID instead. It exists only for internal usage by the builders.
-func (m *DirectLinkMutation) FileIDs() (ids []uint) {
-	if id := m.file; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
+var _ ent.Mutation = (*FileMutation)(nil)
 
-// ResetFile resets all changes to the "file" edge.
-func (m *DirectLinkMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
+// fileOption allows management of the mutation configuration using functional options.
+type fileOption func(*FileMutation)
+
+// newFileMutation creates new mutation for the File entity.
+func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
+	m := &FileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// Where appends a list predicates to the DirectLinkMutation builder.
-func (m *DirectLinkMutation) Where(ps ...predicate.DirectLink) {
-	m.predicates = append(m.predicates, ps...)
+// withFileID sets the ID field of the mutation.
+func withFileID(id uint) fileOption {
+	return func(m *FileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *File
+		)
+		m.oldValue = func(ctx context.Context) (*File, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().File.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// WhereP appends storage-level predicates to the DirectLinkMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DirectLinkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DirectLink, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// withFile sets the old File of the mutation.
+func withFile(node *File) fileOption {
+	return func(m *FileMutation) {
+		m.oldValue = func(context.Context) (*File, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *DirectLinkMutation) Op() Op {
-	return m.op
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetOp allows setting the mutation operation.
-func (m *DirectLinkMutation) SetOp(op Op) {
-	m.op = op
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Type returns the node type of this mutation (DirectLink).
-func (m *DirectLinkMutation) Type() string {
-	return m.typ
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of File entities.
+func (m *FileMutation) SetID(id uint) {
+	m.id = &id
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *DirectLinkMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.deleted_at != nil {
-		fields = append(fields, directlink.FieldDeletedAt)
-	}
-	if m.created_at != nil {
-		fields = append(fields, directlink.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, directlink.FieldUpdatedAt)
-	}
-	if m.file != nil {
-		fields = append(fields, directlink.FieldFileID)
-	}
-	if m.file_name != nil {
-		fields = append(fields, directlink.FieldFileName)
-	}
-	if m.speed_limit != nil {
-		fields = append(fields, directlink.FieldSpeedLimit)
-	}
-	if m.downloads != nil {
-		fields = append(fields, directlink.FieldDownloads)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return fields
+	return *m.id, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *DirectLinkMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case directlink.FieldDeletedAt:
-		return m.DeletedAt()
-	case directlink.FieldCreatedAt:
-		return m.CreatedAt()
-	case directlink.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case directlink.FieldFileID:
-		return m.FileID()
-	case directlink.FieldFileName:
-		return m.FileName()
-	case directlink.FieldSpeedLimit:
-		return m.SpeedLimit()
-	case directlink.FieldDownloads:
-		return m.Downloads()
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *DirectLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case directlink.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case directlink.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case directlink.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case directlink.FieldFileID:
-		return m.OldFileID(ctx)
-	case directlink.FieldFileName:
-		return m.OldFileName(ctx)
-	case directlink.FieldSpeedLimit:
-		return m.OldSpeedLimit(ctx)
-	case directlink.FieldDownloads:
-		return m.OldDownloads(ctx)
+// SetDeletedAt sets the "deleted_at" field.
+func (m *FileMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *FileMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown DirectLink field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DirectLinkMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case directlink.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case directlink.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case directlink.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case directlink.FieldFileID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFileID(v)
-		return nil
-	case directlink.FieldFileName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFileName(v)
-		return nil
-	case directlink.FieldSpeedLimit:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSpeedLimit(v)
-		return nil
-	case directlink.FieldDownloads:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDownloads(v)
-		return nil
+// OldDeletedAt returns the old "deleted_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown DirectLink field %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *DirectLinkMutation) AddedFields() []string {
-	var fields []string
-	if m.addspeed_limit != nil {
-		fields = append(fields, directlink.FieldSpeedLimit)
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *FileMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[file.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *FileMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *FileMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, file.FieldDeletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	if m.adddownloads != nil {
-		fields = append(fields, directlink.FieldDownloads)
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *DirectLinkMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case directlink.FieldSpeedLimit:
-		return m.AddedSpeedLimit()
-	case directlink.FieldDownloads:
-		return m.AddedDownloads()
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DirectLinkMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case directlink.FieldSpeedLimit:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSpeedLimit(v)
-		return nil
-	case directlink.FieldDownloads:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDownloads(v)
-		return nil
+// OldUpdatedAt returns the old "updated_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown DirectLink numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *DirectLinkMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(directlink.FieldDeletedAt) {
-		fields = append(fields, directlink.FieldDeletedAt)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetType sets the "type" field.
+func (m *FileMutation) SetType(i int) {
+	m._type = &i
+	m.add_type = nil
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *FileMutation) GetType() (r int, exists bool) {
+	v := m._type
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *DirectLinkMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// OldType returns the old "type" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldType(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *DirectLinkMutation) ClearField(name string) error {
-	switch name {
-	case directlink.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
+// AddType adds i to the "type" field.
+func (m *FileMutation) AddType(i int) {
+	if m.add_type != nil {
+		*m.add_type += i
+	} else {
+		m.add_type = &i
 	}
-	return fmt.Errorf("unknown DirectLink nullable field %s", name)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *DirectLinkMutation) ResetField(name string) error {
-	switch name {
-	case directlink.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case directlink.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case directlink.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case directlink.FieldFileID:
-		m.ResetFileID()
-		return nil
-	case directlink.FieldFileName:
-		m.ResetFileName()
-		return nil
-	case directlink.FieldSpeedLimit:
-		m.ResetSpeedLimit()
-		return nil
-	case directlink.FieldDownloads:
-		m.ResetDownloads()
-		return nil
+// AddedType returns the value that was added to the "type" field in this mutation.
+func (m *FileMutation) AddedType() (r int, exists bool) {
+	v := m.add_type
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown DirectLink field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DirectLinkMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.file != nil {
-		edges = append(edges, directlink.EdgeFile)
+// ResetType resets all changes to the "type" field.
+func (m *FileMutation) ResetType() {
+	m._type = nil
+	m.add_type = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *FileMutation) SetOwnerID(u uint) {
+	m.owner = &u
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *FileMutation) OwnerID() (r uint, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *DirectLinkMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case directlink.EdgeFile:
-		if id := m.file; id != nil {
-			return []ent.Value{*id}
-		}
+// OldOwnerID returns the old "owner_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldOwnerID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DirectLinkMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *FileMutation) ResetOwnerID() {
+	m.owner = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *DirectLinkMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetParentID sets the "parent_id" field.
+func (m *FileMutation) SetParentID(u uint) {
+	m.parent = &u
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DirectLinkMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedfile {
-		edges = append(edges, directlink.EdgeFile)
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *FileMutation) ParentID() (r uint, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *DirectLinkMutation) EdgeCleared(name string) bool {
-	switch name {
-	case directlink.EdgeFile:
-		return m.clearedfile
+// OldParentID returns the old "parent_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldParentID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
-	return false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *DirectLinkMutation) ClearEdge(name string) error {
-	switch name {
-	case directlink.EdgeFile:
-		m.ClearFile()
-		return nil
-	}
-	return fmt.Errorf("unknown DirectLink unique edge %s", name)
+// ClearParentID clears the value of the "parent_id" field.
+func (m *FileMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[file.FieldParentID] = struct{}{}
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *DirectLinkMutation) ResetEdge(name string) error {
-	switch name {
-	case directlink.EdgeFile:
-		m.ResetFile()
-		return nil
-	}
-	return fmt.Errorf("unknown DirectLink edge %s", name)
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *FileMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[file.FieldParentID]
+	return ok
 }
 
-// DocSeriesMutation represents an operation that mutates the DocSeries nodes in the graph.
-type DocSeriesMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *uint
-	created_at      *time.Time
-	updated_at      *time.Time
-	name            *string
-	description     *string
-	cover_url       *string
-	sort            *int
-	addsort         *int
-	doc_count       *int
-	adddoc_count    *int
-	clearedFields   map[string]struct{}
-	articles        map[uint]struct{}
-	removedarticles map[uint]struct{}
-	clearedarticles bool
-	done            bool
-	oldValue        func(context.Context) (*DocSeries, error)
-	predicates      []predicate.DocSeries
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *FileMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, file.FieldParentID)
 }
 
-var _ ent.Mutation = (*DocSeriesMutation)(nil)
+// SetName sets the "name" field.
+func (m *FileMutation) SetName(s string) {
+	m.name = &s
+}
 
-// docseriesOption allows management of the mutation configuration using functional options.
-type docseriesOption func(*DocSeriesMutation)
+// Name returns the value of the "name" field in the mutation.
+func (m *FileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newDocSeriesMutation creates new mutation for the DocSeries entity.
-func newDocSeriesMutation(c config, op Op, opts ...docseriesOption) *DocSeriesMutation {
-	m := &DocSeriesMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeDocSeries,
-		clearedFields: make(map[string]struct{}),
+// OldName returns the old "name" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// withDocSeriesID sets the ID field of the mutation.
-func withDocSeriesID(id uint) docseriesOption {
-	return func(m *DocSeriesMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *DocSeries
-		)
-		m.oldValue = func(ctx context.Context) (*DocSeries, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().DocSeries.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetName resets all changes to the "name" field.
+func (m *FileMutation) ResetName() {
+	m.name = nil
 }
 
-// withDocSeries sets the old DocSeries of the mutation.
-func withDocSeries(node *DocSeries) docseriesOption {
-	return func(m *DocSeriesMutation) {
-		m.oldValue = func(context.Context) (*DocSeries, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetSize sets the "size" field.
+func (m *FileMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DocSeriesMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Size returns the value of the "size" field in the mutation.
+func (m *FileMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m DocSeriesMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// OldSize returns the old "size" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DocSeries entities.
-func (m *DocSeriesMutation) SetID(id uint) {
-	m.id = &id
+// AddSize adds i to the "size" field.
+func (m *FileMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *DocSeriesMutation) ID() (id uint, exists bool) {
-	if m.id == nil {
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *FileMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *DocSeriesMutation) IDs(ctx context.Context) ([]uint, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DocSeries.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetSize resets all changes to the "size" field.
+func (m *FileMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DocSeriesMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetPrimaryEntityID sets the "primary_entity_id" field.
+func (m *FileMutation) SetPrimaryEntityID(u uint) {
+	m.primary_entity = &u
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DocSeriesMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// PrimaryEntityID returns the value of the "primary_entity_id" field in the mutation.
+func (m *FileMutation) PrimaryEntityID() (r uint, exists bool) {
+	v := m.primary_entity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DocSeries entity.
-// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldPrimaryEntityID returns the old "primary_entity_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocSeriesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FileMutation) OldPrimaryEntityID(ctx context.Context) (v *uint, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrimaryEntityID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPrimaryEntityID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrimaryEntityID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.PrimaryEntityID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DocSeriesMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearPrimaryEntityID clears the value of the "primary_entity_id" field.
+func (m *FileMutation) ClearPrimaryEntityID() {
+	m.primary_entity = nil
+	m.clearedFields[file.FieldPrimaryEntityID] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DocSeriesMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// PrimaryEntityIDCleared returns if the "primary_entity_id" field was cleared in this mutation.
+func (m *FileMutation) PrimaryEntityIDCleared() bool {
+	_, ok := m.clearedFields[file.FieldPrimaryEntityID]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DocSeriesMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetPrimaryEntityID resets all changes to the "primary_entity_id" field.
+func (m *FileMutation) ResetPrimaryEntityID() {
+	m.primary_entity = nil
+	delete(m.clearedFields, file.FieldPrimaryEntityID)
+}
+
+// SetChildrenCount sets the "children_count" field.
+func (m *FileMutation) SetChildrenCount(i int64) {
+	m.children_count = &i
+	m.addchildren_count = nil
+}
+
+// ChildrenCount returns the value of the "children_count" field in the mutation.
+func (m *FileMutation) ChildrenCount() (r int64, exists bool) {
+	v := m.children_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the DocSeries entity.
-// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldChildrenCount returns the old "children_count" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocSeriesMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FileMutation) OldChildrenCount(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldChildrenCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldChildrenCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldChildrenCount: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ChildrenCount, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DocSeriesMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddChildrenCount adds i to the "children_count" field.
+func (m *FileMutation) AddChildrenCount(i int64) {
+	if m.addchildren_count != nil {
+		*m.addchildren_count += i
+	} else {
+		m.addchildren_count = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *DocSeriesMutation) SetName(s string) {
-	m.name = &s
+// AddedChildrenCount returns the value that was added to the "children_count" field in this mutation.
+func (m *FileMutation) AddedChildrenCount() (r int64, exists bool) {
+	v := m.addchildren_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DocSeriesMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetChildrenCount resets all changes to the "children_count" field.
+func (m *FileMutation) ResetChildrenCount() {
+	m.children_count = nil
+	m.addchildren_count = nil
+}
+
+// SetViewConfig sets the "view_config" field.
+func (m *FileMutation) SetViewConfig(s string) {
+	m.view_config = &s
+}
+
+// ViewConfig returns the value of the "view_config" field in the mutation.
+func (m *FileMutation) ViewConfig() (r string, exists bool) {
+	v := m.view_config
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the DocSeries entity.
-// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldViewConfig returns the old "view_config" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocSeriesMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *FileMutation) OldViewConfig(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldViewConfig is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldViewConfig requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldViewConfig: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ViewConfig, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *DocSeriesMutation) ResetName() {
-	m.name = nil
+// ClearViewConfig clears the value of the "view_config" field.
+func (m *FileMutation) ClearViewConfig() {
+	m.view_config = nil
+	m.clearedFields[file.FieldViewConfig] = struct{}{}
 }
 
-// SetDescription sets the "description" field.
-func (m *DocSeriesMutation) SetDescription(s string) {
-	m.description = &s
+// ViewConfigCleared returns if the "view_config" field was cleared in this mutation.
+func (m *FileMutation) ViewConfigCleared() bool {
+	_, ok := m.clearedFields[file.FieldViewConfig]
+	return ok
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *DocSeriesMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// ResetViewConfig resets all changes to the "view_config" field.
+func (m *FileMutation) ResetViewConfig() {
+	m.view_config = nil
+	delete(m.clearedFields, file.FieldViewConfig)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *FileMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[file.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *FileMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) OwnerIDs() (ids []uint) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldDescription returns the old "description" field's value of the DocSeries entity.
-// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocSeriesMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+// ResetOwner resets all changes to the "owner" edge.
+func (m *FileMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// ClearParent clears the "parent" edge to the File entity.
+func (m *FileMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[file.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the File entity was cleared.
+func (m *FileMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) ParentIDs() (ids []uint) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *FileMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the File entity by ids.
+func (m *FileMutation) AddChildIDs(ids ...uint) {
+	if m.children == nil {
+		m.children = make(map[uint]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
 	}
-	return oldValue.Description, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *DocSeriesMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[docseries.FieldDescription] = struct{}{}
+// ClearChildren clears the "children" edge to the File entity.
+func (m *FileMutation) ClearChildren() {
+	m.clearedchildren = true
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *DocSeriesMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[docseries.FieldDescription]
-	return ok
+// ChildrenCleared reports if the "children" edge to the File entity was cleared.
+func (m *FileMutation) ChildrenCleared() bool {
+	return m.clearedchildren
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *DocSeriesMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, docseries.FieldDescription)
+// RemoveChildIDs removes the "children" edge to the File entity by IDs.
+func (m *FileMutation) RemoveChildIDs(ids ...uint) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
 }
 
-// SetCoverURL sets the "cover_url" field.
-func (m *DocSeriesMutation) SetCoverURL(s string) {
-	m.cover_url = &s
+// RemovedChildren returns the removed IDs of the "children" edge to the File entity.
+func (m *FileMutation) RemovedChildrenIDs() (ids []uint) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// CoverURL returns the value of the "cover_url" field in the mutation.
-func (m *DocSeriesMutation) CoverURL() (r string, exists bool) {
-	v := m.cover_url
-	if v == nil {
-		return
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *FileMutation) ChildrenIDs() (ids []uint) {
+	for id := range m.children {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldCoverURL returns the old "cover_url" field's value of the DocSeries entity.
-// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocSeriesMutation) OldCoverURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCoverURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCoverURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCoverURL: %w", err)
-	}
-	return oldValue.CoverURL, nil
+// ResetChildren resets all changes to the "children" edge.
+func (m *FileMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
 }
 
-// ClearCoverURL clears the value of the "cover_url" field.
-func (m *DocSeriesMutation) ClearCoverURL() {
-	m.cover_url = nil
-	m.clearedFields[docseries.FieldCoverURL] = struct{}{}
+// ClearPrimaryEntity clears the "primary_entity" edge to the Entity entity.
+func (m *FileMutation) ClearPrimaryEntity() {
+	m.clearedprimary_entity = true
+	m.clearedFields[file.FieldPrimaryEntityID] = struct{}{}
 }
 
-// CoverURLCleared returns if the "cover_url" field was cleared in this mutation.
-func (m *DocSeriesMutation) CoverURLCleared() bool {
-	_, ok := m.clearedFields[docseries.FieldCoverURL]
-	return ok
+// PrimaryEntityCleared reports if the "primary_entity" edge to the Entity entity was cleared.
+func (m *FileMutation) PrimaryEntityCleared() bool {
+	return m.PrimaryEntityIDCleared() || m.clearedprimary_entity
 }
 
-// ResetCoverURL resets all changes to the "cover_url" field.
-func (m *DocSeriesMutation) ResetCoverURL() {
-	m.cover_url = nil
-	delete(m.clearedFields, docseries.FieldCoverURL)
+// PrimaryEntityIDs returns the "primary_entity" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PrimaryEntityID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) PrimaryEntityIDs() (ids []uint) {
+	if id := m.primary_entity; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// SetSort sets the "sort" field.
-func (m *DocSeriesMutation) SetSort(i int) {
-	m.sort = &i
-	m.addsort = nil
+// ResetPrimaryEntity resets all changes to the "primary_entity" edge.
+func (m *FileMutation) ResetPrimaryEntity() {
+	m.primary_entity = nil
+	m.clearedprimary_entity = false
 }
 
-// Sort returns the value of the "sort" field in the mutation.
-func (m *DocSeriesMutation) Sort() (r int, exists bool) {
-	v := m.sort
-	if v == nil {
-		return
+// AddVersionIDs adds the "versions" edge to the FileEntity entity by ids.
+func (m *FileMutation) AddVersionIDs(ids ...uint) {
+	if m.versions == nil {
+		m.versions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.versions[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldSort returns the old "sort" field's value of the DocSeries entity.
-// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocSeriesMutation) OldSort(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSort is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSort requires an ID field in the mutation")
+// ClearVersions clears the "versions" edge to the FileEntity entity.
+func (m *FileMutation) ClearVersions() {
+	m.clearedversions = true
+}
+
+// VersionsCleared reports if the "versions" edge to the FileEntity entity was cleared.
+func (m *FileMutation) VersionsCleared() bool {
+	return m.clearedversions
+}
+
+// RemoveVersionIDs removes the "versions" edge to the FileEntity entity by IDs.
+func (m *FileMutation) RemoveVersionIDs(ids ...uint) {
+	if m.removedversions == nil {
+		m.removedversions = make(map[uint]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+	for i := range ids {
+		delete(m.versions, ids[i])
+		m.removedversions[ids[i]] = struct{}{}
 	}
-	return oldValue.Sort, nil
 }
 
-// AddSort adds i to the "sort" field.
-func (m *DocSeriesMutation) AddSort(i int) {
-	if m.addsort != nil {
-		*m.addsort += i
-	} else {
-		m.addsort = &i
+// RemovedVersions returns the removed IDs of the "versions" edge to the FileEntity entity.
+func (m *FileMutation) RemovedVersionsIDs() (ids []uint) {
+	for id := range m.removedversions {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedSort returns the value that was added to the "sort" field in this mutation.
-func (m *DocSeriesMutation) AddedSort() (r int, exists bool) {
-	v := m.addsort
-	if v == nil {
-		return
+// VersionsIDs returns the "versions" edge IDs in the mutation.
+func (m *FileMutation) VersionsIDs() (ids []uint) {
+	for id := range m.versions {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetSort resets all changes to the "sort" field.
-func (m *DocSeriesMutation) ResetSort() {
-	m.sort = nil
-	m.addsort = nil
+// ResetVersions resets all changes to the "versions" edge.
+func (m *FileMutation) ResetVersions() {
+	m.versions = nil
+	m.clearedversions = false
+	m.removedversions = nil
 }
 
-// SetDocCount sets the "doc_count" field.
-func (m *DocSeriesMutation) SetDocCount(i int) {
-	m.doc_count = &i
-	m.adddoc_count = nil
+// SetDirectLinkID sets the "direct_link" edge to the DirectLink entity by id.
+func (m *FileMutation) SetDirectLinkID(id uint) {
+	m.direct_link = &id
 }
 
-// DocCount returns the value of the "doc_count" field in the mutation.
-func (m *DocSeriesMutation) DocCount() (r int, exists bool) {
-	v := m.doc_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearDirectLink clears the "direct_link" edge to the DirectLink entity.
+func (m *FileMutation) ClearDirectLink() {
+	m.cleareddirect_link = true
 }
 
-// OldDocCount returns the old "doc_count" field's value of the DocSeries entity.
-// If the DocSeries object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocSeriesMutation) OldDocCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDocCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDocCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDocCount: %w", err)
-	}
-	return oldValue.DocCount, nil
+// DirectLinkCleared reports if the "direct_link" edge to the DirectLink entity was cleared.
+func (m *FileMutation) DirectLinkCleared() bool {
+	return m.cleareddirect_link
 }
 
-// AddDocCount adds i to the "doc_count" field.
-func (m *DocSeriesMutation) AddDocCount(i int) {
-	if m.adddoc_count != nil {
-		*m.adddoc_count += i
-	} else {
-		m.adddoc_count = &i
+// DirectLinkID returns the "direct_link" edge ID in the mutation.
+func (m *FileMutation) DirectLinkID() (id uint, exists bool) {
+	if m.direct_link != nil {
+		return *m.direct_link, true
 	}
+	return
 }
 
-// AddedDocCount returns the value that was added to the "doc_count" field in this mutation.
-func (m *DocSeriesMutation) AddedDocCount() (r int, exists bool) {
-	v := m.adddoc_count
-	if v == nil {
-		return
+// DirectLinkIDs returns the "direct_link" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DirectLinkID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) DirectLinkIDs() (ids []uint) {
+	if id := m.direct_link; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// ResetDocCount resets all changes to the "doc_count" field.
-func (m *DocSeriesMutation) ResetDocCount() {
-	m.doc_count = nil
-	m.adddoc_count = nil
+// ResetDirectLink resets all changes to the "direct_link" edge.
+func (m *FileMutation) ResetDirectLink() {
+	m.direct_link = nil
+	m.cleareddirect_link = false
 }
 
-// AddArticleIDs adds the "articles" edge to the Article entity by ids.
-func (m *DocSeriesMutation) AddArticleIDs(ids ...uint) {
-	if m.articles == nil {
-		m.articles = make(map[uint]struct{})
+// AddMetadatumIDs adds the "metadata" edge to the Metadata entity by ids.
+func (m *FileMutation) AddMetadatumIDs(ids ...uint) {
+	if m.metadata == nil {
+		m.metadata = make(map[uint]struct{})
 	}
 	for i := range ids {
-		m.articles[ids[i]] = struct{}{}
+		m.metadata[ids[i]] = struct{}{}
 	}
 }
 
-// ClearArticles clears the "articles" edge to the Article entity.
-func (m *DocSeriesMutation) ClearArticles() {
-	m.clearedarticles = true
+// ClearMetadata clears the "metadata" edge to the Metadata entity.
+func (m *FileMutation) ClearMetadata() {
+	m.clearedmetadata = true
 }
 
-// ArticlesCleared reports if the "articles" edge to the Article entity was cleared.
-func (m *DocSeriesMutation) ArticlesCleared() bool {
-	return m.clearedarticles
+// MetadataCleared reports if the "metadata" edge to the Metadata entity was cleared.
+func (m *FileMutation) MetadataCleared() bool {
+	return m.clearedmetadata
 }
 
-// RemoveArticleIDs removes the "articles" edge to the Article entity by IDs.
-func (m *DocSeriesMutation) RemoveArticleIDs(ids ...uint) {
-	if m.removedarticles == nil {
-		m.removedarticles = make(map[uint]struct{})
+// RemoveMetadatumIDs removes the "metadata" edge to the Metadata entity by IDs.
+func (m *FileMutation) RemoveMetadatumIDs(ids ...uint) {
+	if m.removedmetadata == nil {
+		m.removedmetadata = make(map[uint]struct{})
 	}
 	for i := range ids {
-		delete(m.articles, ids[i])
-		m.removedarticles[ids[i]] = struct{}{}
+		delete(m.metadata, ids[i])
+		m.removedmetadata[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedArticles returns the removed IDs of the "articles" edge to the Article entity.
-func (m *DocSeriesMutation) RemovedArticlesIDs() (ids []uint) {
-	for id := range m.removedarticles {
+// RemovedMetadata returns the removed IDs of the "metadata" edge to the Metadata entity.
+func (m *FileMutation) RemovedMetadataIDs() (ids []uint) {
+	for id := range m.removedmetadata {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ArticlesIDs returns the "articles" edge IDs in the mutation.
-func (m *DocSeriesMutation) ArticlesIDs() (ids []uint) {
-	for id := range m.articles {
+// MetadataIDs returns the "metadata" edge IDs in the mutation.
+func (m *FileMutation) MetadataIDs() (ids []uint) {
+	for id := range m.metadata {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetArticles resets all changes to the "articles" edge.
-func (m *DocSeriesMutation) ResetArticles() {
-	m.articles = nil
-	m.clearedarticles = false
-	m.removedarticles = nil
+// ResetMetadata resets all changes to the "metadata" edge.
+func (m *FileMutation) ResetMetadata() {
+	m.metadata = nil
+	m.clearedmetadata = false
+	m.removedmetadata = nil
 }
 
-// Where appends a list predicates to the DocSeriesMutation builder.
-func (m *DocSeriesMutation) Where(ps ...predicate.DocSeries) {
+// Where appends a list predicates to the FileMutation builder.
+func (m *FileMutation) Where(ps ...predicate.File) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DocSeriesMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DocSeriesMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DocSeries, len(ps))
+func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.File, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -11254,45 +14844,57 @@ func (m *DocSeriesMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DocSeriesMutation) Op() Op {
+func (m *FileMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DocSeriesMutation) SetOp(op Op) {
+func (m *FileMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DocSeries).
-func (m *DocSeriesMutation) Type() string {
+// Type returns the node type of this mutation (File).
+func (m *FileMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DocSeriesMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *FileMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.deleted_at != nil {
+		fields = append(fields, file.FieldDeletedAt)
+	}
 	if m.created_at != nil {
-		fields = append(fields, docseries.FieldCreatedAt)
+		fields = append(fields, file.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, docseries.FieldUpdatedAt)
+		fields = append(fields, file.FieldUpdatedAt)
+	}
+	if m._type != nil {
+		fields = append(fields, file.FieldType)
+	}
+	if m.owner != nil {
+		fields = append(fields, file.FieldOwnerID)
+	}
+	if m.parent != nil {
+		fields = append(fields, file.FieldParentID)
 	}
 	if m.name != nil {
-		fields = append(fields, docseries.FieldName)
+		fields = append(fields, file.FieldName)
 	}
-	if m.description != nil {
-		fields = append(fields, docseries.FieldDescription)
+	if m.size != nil {
+		fields = append(fields, file.FieldSize)
 	}
-	if m.cover_url != nil {
-		fields = append(fields, docseries.FieldCoverURL)
+	if m.primary_entity != nil {
+		fields = append(fields, file.FieldPrimaryEntityID)
 	}
-	if m.sort != nil {
-		fields = append(fields, docseries.FieldSort)
+	if m.children_count != nil {
+		fields = append(fields, file.FieldChildrenCount)
 	}
-	if m.doc_count != nil {
-		fields = append(fields, docseries.FieldDocCount)
+	if m.view_config != nil {
+		fields = append(fields, file.FieldViewConfig)
 	}
 	return fields
 }
@@ -11300,22 +14902,30 @@ func (m *DocSeriesMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DocSeriesMutation) Field(name string) (ent.Value, bool) {
+func (m *FileMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case docseries.FieldCreatedAt:
+	case file.FieldDeletedAt:
+		return m.DeletedAt()
+	case file.FieldCreatedAt:
 		return m.CreatedAt()
-	case docseries.FieldUpdatedAt:
+	case file.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case docseries.FieldName:
+	case file.FieldType:
+		return m.GetType()
+	case file.FieldOwnerID:
+		return m.OwnerID()
+	case file.FieldParentID:
+		return m.ParentID()
+	case file.FieldName:
 		return m.Name()
-	case docseries.FieldDescription:
-		return m.Description()
-	case docseries.FieldCoverURL:
-		return m.CoverURL()
-	case docseries.FieldSort:
-		return m.Sort()
-	case docseries.FieldDocCount:
-		return m.DocCount()
+	case file.FieldSize:
+		return m.Size()
+	case file.FieldPrimaryEntityID:
+		return m.PrimaryEntityID()
+	case file.FieldChildrenCount:
+		return m.ChildrenCount()
+	case file.FieldViewConfig:
+		return m.ViewConfig()
 	}
 	return nil, false
 }
@@ -11323,93 +14933,132 @@ func (m *DocSeriesMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DocSeriesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case docseries.FieldCreatedAt:
+	case file.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case file.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case docseries.FieldUpdatedAt:
+	case file.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case docseries.FieldName:
+	case file.FieldType:
+		return m.OldType(ctx)
+	case file.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case file.FieldParentID:
+		return m.OldParentID(ctx)
+	case file.FieldName:
 		return m.OldName(ctx)
-	case docseries.FieldDescription:
-		return m.OldDescription(ctx)
-	case docseries.FieldCoverURL:
-		return m.OldCoverURL(ctx)
-	case docseries.FieldSort:
-		return m.OldSort(ctx)
-	case docseries.FieldDocCount:
-		return m.OldDocCount(ctx)
+	case file.FieldSize:
+		return m.OldSize(ctx)
+	case file.FieldPrimaryEntityID:
+		return m.OldPrimaryEntityID(ctx)
+	case file.FieldChildrenCount:
+		return m.OldChildrenCount(ctx)
+	case file.FieldViewConfig:
+		return m.OldViewConfig(ctx)
 	}
-	return nil, fmt.Errorf("unknown DocSeries field %s", name)
+	return nil, fmt.Errorf("unknown File field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocSeriesMutation) SetField(name string, value ent.Value) error {
+func (m *FileMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case docseries.FieldCreatedAt:
+	case file.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case file.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case docseries.FieldUpdatedAt:
+	case file.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case docseries.FieldName:
+	case file.FieldType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case file.FieldOwnerID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case file.FieldParentID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case file.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case docseries.FieldDescription:
-		v, ok := value.(string)
+	case file.FieldSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetSize(v)
 		return nil
-	case docseries.FieldCoverURL:
-		v, ok := value.(string)
+	case file.FieldPrimaryEntityID:
+		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCoverURL(v)
+		m.SetPrimaryEntityID(v)
 		return nil
-	case docseries.FieldSort:
-		v, ok := value.(int)
+	case file.FieldChildrenCount:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSort(v)
+		m.SetChildrenCount(v)
 		return nil
-	case docseries.FieldDocCount:
-		v, ok := value.(int)
+	case file.FieldViewConfig:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDocCount(v)
+		m.SetViewConfig(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DocSeries field %s", name)
+	return fmt.Errorf("unknown File field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DocSeriesMutation) AddedFields() []string {
+func (m *FileMutation) AddedFields() []string {
 	var fields []string
-	if m.addsort != nil {
-		fields = append(fields, docseries.FieldSort)
+	if m.add_type != nil {
+		fields = append(fields, file.FieldType)
 	}
-	if m.adddoc_count != nil {
-		fields = append(fields, docseries.FieldDocCount)
+	if m.addsize != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	if m.addchildren_count != nil {
+		fields = append(fields, file.FieldChildrenCount)
 	}
 	return fields
 }
@@ -11417,12 +15066,14 @@ func (m *DocSeriesMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DocSeriesMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case docseries.FieldSort:
-		return m.AddedSort()
-	case docseries.FieldDocCount:
-		return m.AddedDocCount()
+	case file.FieldType:
+		return m.AddedType()
+	case file.FieldSize:
+		return m.AddedSize()
+	case file.FieldChildrenCount:
+		return m.AddedChildrenCount()
 	}
 	return nil, false
 }
@@ -11430,105 +15081,182 @@ func (m *DocSeriesMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocSeriesMutation) AddField(name string, value ent.Value) error {
+func (m *FileMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case docseries.FieldSort:
+	case file.FieldType:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSort(v)
+		m.AddType(v)
 		return nil
-	case docseries.FieldDocCount:
-		v, ok := value.(int)
+	case file.FieldSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDocCount(v)
+		m.AddSize(v)
+		return nil
+	case file.FieldChildrenCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChildrenCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DocSeries numeric field %s", name)
+	return fmt.Errorf("unknown File numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DocSeriesMutation) ClearedFields() []string {
+func (m *FileMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(docseries.FieldDescription) {
-		fields = append(fields, docseries.FieldDescription)
+	if m.FieldCleared(file.FieldDeletedAt) {
+		fields = append(fields, file.FieldDeletedAt)
 	}
-	if m.FieldCleared(docseries.FieldCoverURL) {
-		fields = append(fields, docseries.FieldCoverURL)
+	if m.FieldCleared(file.FieldParentID) {
+		fields = append(fields, file.FieldParentID)
+	}
+	if m.FieldCleared(file.FieldPrimaryEntityID) {
+		fields = append(fields, file.FieldPrimaryEntityID)
+	}
+	if m.FieldCleared(file.FieldViewConfig) {
+		fields = append(fields, file.FieldViewConfig)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DocSeriesMutation) FieldCleared(name string) bool {
+func (m *FileMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DocSeriesMutation) ClearField(name string) error {
+func (m *FileMutation) ClearField(name string) error {
 	switch name {
-	case docseries.FieldDescription:
-		m.ClearDescription()
+	case file.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case file.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case file.FieldPrimaryEntityID:
+		m.ClearPrimaryEntityID()
 		return nil
-	case docseries.FieldCoverURL:
-		m.ClearCoverURL()
+	case file.FieldViewConfig:
+		m.ClearViewConfig()
 		return nil
 	}
-	return fmt.Errorf("unknown DocSeries nullable field %s", name)
+	return fmt.Errorf("unknown File nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DocSeriesMutation) ResetField(name string) error {
+func (m *FileMutation) ResetField(name string) error {
 	switch name {
-	case docseries.FieldCreatedAt:
+	case file.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case file.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case docseries.FieldUpdatedAt:
+	case file.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case docseries.FieldName:
+	case file.FieldType:
+		m.ResetType()
+		return nil
+	case file.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case file.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case file.FieldName:
 		m.ResetName()
 		return nil
-	case docseries.FieldDescription:
-		m.ResetDescription()
+	case file.FieldSize:
+		m.ResetSize()
 		return nil
-	case docseries.FieldCoverURL:
-		m.ResetCoverURL()
+	case file.FieldPrimaryEntityID:
+		m.ResetPrimaryEntityID()
 		return nil
-	case docseries.FieldSort:
-		m.ResetSort()
+	case file.FieldChildrenCount:
+		m.ResetChildrenCount()
 		return nil
-	case docseries.FieldDocCount:
-		m.ResetDocCount()
+	case file.FieldViewConfig:
+		m.ResetViewConfig()
 		return nil
 	}
-	return fmt.Errorf("unknown DocSeries field %s", name)
+	return fmt.Errorf("unknown File field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DocSeriesMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.articles != nil {
-		edges = append(edges, docseries.EdgeArticles)
+func (m *FileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.owner != nil {
+		edges = append(edges, file.EdgeOwner)
+	}
+	if m.parent != nil {
+		edges = append(edges, file.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, file.EdgeChildren)
+	}
+	if m.primary_entity != nil {
+		edges = append(edges, file.EdgePrimaryEntity)
+	}
+	if m.versions != nil {
+		edges = append(edges, file.EdgeVersions)
+	}
+	if m.direct_link != nil {
+		edges = append(edges, file.EdgeDirectLink)
+	}
+	if m.metadata != nil {
+		edges = append(edges, file.EdgeMetadata)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DocSeriesMutation) AddedIDs(name string) []ent.Value {
+func (m *FileMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case docseries.EdgeArticles:
-		ids := make([]ent.Value, 0, len(m.articles))
-		for id := range m.articles {
+	case file.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgePrimaryEntity:
+		if id := m.primary_entity; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.versions))
+		for id := range m.versions {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeDirectLink:
+		if id := m.direct_link; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeMetadata:
+		ids := make([]ent.Value, 0, len(m.metadata))
+		for id := range m.metadata {
 			ids = append(ids, id)
 		}
 		return ids
@@ -11537,21 +15265,39 @@ func (m *DocSeriesMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DocSeriesMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedarticles != nil {
-		edges = append(edges, docseries.EdgeArticles)
+func (m *FileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedchildren != nil {
+		edges = append(edges, file.EdgeChildren)
+	}
+	if m.removedversions != nil {
+		edges = append(edges, file.EdgeVersions)
+	}
+	if m.removedmetadata != nil {
+		edges = append(edges, file.EdgeMetadata)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DocSeriesMutation) RemovedIDs(name string) []ent.Value {
+func (m *FileMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case docseries.EdgeArticles:
-		ids := make([]ent.Value, 0, len(m.removedarticles))
-		for id := range m.removedarticles {
+	case file.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.removedversions))
+		for id := range m.removedversions {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeMetadata:
+		ids := make([]ent.Value, 0, len(m.removedmetadata))
+		for id := range m.removedmetadata {
 			ids = append(ids, id)
 		}
 		return ids
@@ -11560,85 +15306,137 @@ func (m *DocSeriesMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DocSeriesMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedarticles {
-		edges = append(edges, docseries.EdgeArticles)
+func (m *FileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedowner {
+		edges = append(edges, file.EdgeOwner)
+	}
+	if m.clearedparent {
+		edges = append(edges, file.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, file.EdgeChildren)
+	}
+	if m.clearedprimary_entity {
+		edges = append(edges, file.EdgePrimaryEntity)
+	}
+	if m.clearedversions {
+		edges = append(edges, file.EdgeVersions)
+	}
+	if m.cleareddirect_link {
+		edges = append(edges, file.EdgeDirectLink)
+	}
+	if m.clearedmetadata {
+		edges = append(edges, file.EdgeMetadata)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DocSeriesMutation) EdgeCleared(name string) bool {
+func (m *FileMutation) EdgeCleared(name string) bool {
 	switch name {
-	case docseries.EdgeArticles:
-		return m.clearedarticles
+	case file.EdgeOwner:
+		return m.clearedowner
+	case file.EdgeParent:
+		return m.clearedparent
+	case file.EdgeChildren:
+		return m.clearedchildren
+	case file.EdgePrimaryEntity:
+		return m.clearedprimary_entity
+	case file.EdgeVersions:
+		return m.clearedversions
+	case file.EdgeDirectLink:
+		return m.cleareddirect_link
+	case file.EdgeMetadata:
+		return m.clearedmetadata
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DocSeriesMutation) ClearEdge(name string) error {
+func (m *FileMutation) ClearEdge(name string) error {
 	switch name {
+	case file.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case file.EdgeParent:
+		m.ClearParent()
+		return nil
+	case file.EdgePrimaryEntity:
+		m.ClearPrimaryEntity()
+		return nil
+	case file.EdgeDirectLink:
+		m.ClearDirectLink()
+		return nil
 	}
-	return fmt.Errorf("unknown DocSeries unique edge %s", name)
+	return fmt.Errorf("unknown File unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DocSeriesMutation) ResetEdge(name string) error {
+func (m *FileMutation) ResetEdge(name string) error {
 	switch name {
-	case docseries.EdgeArticles:
-		m.ResetArticles()
+	case file.EdgeOwner:
+		m.ResetOwner()
 		return nil
-	}
-	return fmt.Errorf("unknown DocSeries edge %s", name)
-}
-
-// EntityMutation represents an operation that mutates the Entity nodes in the graph.
-type EntityMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *uint
-	created_at           *time.Time
-	updated_at           *time.Time
-	_type                *string
-	source               *string
-	size                 *int64
-	addsize              *int64
-	upload_session_id    *string
-	recycle_options      *string
-	policy_id            *uint
-	addpolicy_id         *int
-	created_by           *uint64
-	addcreated_by        *int64
-	etag                 *string
-	mime_type            *string
-	dimension            *string
-	storage_metadata     *model.JSONMap
-	clearedFields        map[string]struct{}
-	file_versions        map[uint]struct{}
-	removedfile_versions map[uint]struct{}
-	clearedfile_versions bool
-	done                 bool
-	oldValue             func(context.Context) (*Entity, error)
-	predicates           []predicate.Entity
+	case file.EdgeParent:
+		m.ResetParent()
+		return nil
+	case file.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case file.EdgePrimaryEntity:
+		m.ResetPrimaryEntity()
+		return nil
+	case file.EdgeVersions:
+		m.ResetVersions()
+		return nil
+	case file.EdgeDirectLink:
+		m.ResetDirectLink()
+		return nil
+	case file.EdgeMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown File edge %s", name)
 }
 
-var _ ent.Mutation = (*EntityMutation)(nil)
+// FileEntityMutation represents an operation that mutates the FileEntity nodes in the graph.
+type FileEntityMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uint
+	deleted_at             *time.Time
+	created_at             *time.Time
+	updated_at             *time.Time
+	version                *string
+	is_current             *bool
+	uploaded_by_user_id    *uint64
+	adduploaded_by_user_id *int64
+	clearedFields          map[string]struct{}
+	file                   *uint
+	clearedfile            bool
+	entity                 *uint
+	clearedentity          bool
+	done                   bool
+	oldValue               func(context.Context) (*FileEntity, error)
+	predicates             []predicate.FileEntity
+}
 
-// entityOption allows management of the mutation configuration using functional options.
-type entityOption func(*EntityMutation)
+var _ ent.Mutation = (*FileEntityMutation)(nil)
 
-// newEntityMutation creates new mutation for the Entity entity.
-func newEntityMutation(c config, op Op, opts ...entityOption) *EntityMutation {
-	m := &EntityMutation{
+// fileentityOption allows management of the mutation configuration using functional options.
+type fileentityOption func(*FileEntityMutation)
+
+// newFileEntityMutation creates new mutation for the FileEntity entity.
+func newFileEntityMutation(c config, op Op, opts ...fileentityOption) *FileEntityMutation {
+	m := &FileEntityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEntity,
+		typ:           TypeFileEntity,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -11647,20 +15445,20 @@ func newEntityMutation(c config, op Op, opts ...entityOption) *EntityMutation {
 	return m
 }
 
-// withEntityID sets the ID field of the mutation.
-func withEntityID(id uint) entityOption {
-	return func(m *EntityMutation) {
+// withFileEntityID sets the ID field of the mutation.
+func withFileEntityID(id uint) fileentityOption {
+	return func(m *FileEntityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Entity
+			value *FileEntity
 		)
-		m.oldValue = func(ctx context.Context) (*Entity, error) {
+		m.oldValue = func(ctx context.Context) (*FileEntity, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Entity.Get(ctx, id)
+					value, err = m.Client().FileEntity.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -11669,10 +15467,10 @@ func withEntityID(id uint) entityOption {
 	}
 }
 
-// withEntity sets the old Entity of the mutation.
-func withEntity(node *Entity) entityOption {
-	return func(m *EntityMutation) {
-		m.oldValue = func(context.Context) (*Entity, error) {
+// withFileEntity sets the old FileEntity of the mutation.
+func withFileEntity(node *FileEntity) fileentityOption {
+	return func(m *FileEntityMutation) {
+		m.oldValue = func(context.Context) (*FileEntity, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -11681,2196 +15479,2538 @@ func withEntity(node *Entity) entityOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EntityMutation) Client() *Client {
+func (m FileEntityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m EntityMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileEntityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FileEntity entities.
+func (m *FileEntityMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileEntityMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileEntityMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FileEntity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *FileEntityMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *FileEntityMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the FileEntity entity.
+// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileEntityMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *FileEntityMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[fileentity.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *FileEntityMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[fileentity.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *FileEntityMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, fileentity.FieldDeletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FileEntityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FileEntityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FileEntity entity.
+// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileEntityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FileEntityMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FileEntityMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FileEntityMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the FileEntity entity.
+// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileEntityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FileEntityMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetFileID sets the "file_id" field.
+func (m *FileEntityMutation) SetFileID(u uint) {
+	m.file = &u
+}
+
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *FileEntityMutation) FileID() (r uint, exists bool) {
+	v := m.file
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileID returns the old "file_id" field's value of the FileEntity entity.
+// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileEntityMutation) OldFileID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+	}
+	return oldValue.FileID, nil
+}
+
+// ResetFileID resets all changes to the "file_id" field.
+func (m *FileEntityMutation) ResetFileID() {
+	m.file = nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Entity entities.
-func (m *EntityMutation) SetID(id uint) {
-	m.id = &id
+// SetEntityID sets the "entity_id" field.
+func (m *FileEntityMutation) SetEntityID(u uint) {
+	m.entity = &u
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *EntityMutation) ID() (id uint, exists bool) {
-	if m.id == nil {
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *FileEntityMutation) EntityID() (r uint, exists bool) {
+	v := m.entity
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *EntityMutation) IDs(ctx context.Context) ([]uint, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Entity.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldEntityID returns the old "entity_id" field's value of the FileEntity entity.
+// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileEntityMutation) OldEntityID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EntityMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *FileEntityMutation) ResetEntityID() {
+	m.entity = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EntityMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetVersion sets the "version" field.
+func (m *FileEntityMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *FileEntityMutation) Version() (r string, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the FileEntity entity.
+// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FileEntityMutation) OldVersion(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Version, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EntityMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearVersion clears the value of the "version" field.
+func (m *FileEntityMutation) ClearVersion() {
+	m.version = nil
+	m.clearedFields[fileentity.FieldVersion] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EntityMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// VersionCleared returns if the "version" field was cleared in this mutation.
+func (m *FileEntityMutation) VersionCleared() bool {
+	_, ok := m.clearedFields[fileentity.FieldVersion]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EntityMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetVersion resets all changes to the "version" field.
+func (m *FileEntityMutation) ResetVersion() {
+	m.version = nil
+	delete(m.clearedFields, fileentity.FieldVersion)
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (m *FileEntityMutation) SetIsCurrent(b bool) {
+	m.is_current = &b
+}
+
+// IsCurrent returns the value of the "is_current" field in the mutation.
+func (m *FileEntityMutation) IsCurrent() (r bool, exists bool) {
+	v := m.is_current
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldIsCurrent returns the old "is_current" field's value of the FileEntity entity.
+// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FileEntityMutation) OldIsCurrent(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsCurrent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldIsCurrent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsCurrent: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.IsCurrent, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EntityMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetIsCurrent resets all changes to the "is_current" field.
+func (m *FileEntityMutation) ResetIsCurrent() {
+	m.is_current = nil
 }
 
-// SetType sets the "type" field.
-func (m *EntityMutation) SetType(s string) {
-	m._type = &s
+// SetUploadedByUserID sets the "uploaded_by_user_id" field.
+func (m *FileEntityMutation) SetUploadedByUserID(u uint64) {
+	m.uploaded_by_user_id = &u
+	m.adduploaded_by_user_id = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *EntityMutation) GetType() (r string, exists bool) {
-	v := m._type
+// UploadedByUserID returns the value of the "uploaded_by_user_id" field in the mutation.
+func (m *FileEntityMutation) UploadedByUserID() (r uint64, exists bool) {
+	v := m.uploaded_by_user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldUploadedByUserID returns the old "uploaded_by_user_id" field's value of the FileEntity entity.
+// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *FileEntityMutation) OldUploadedByUserID(ctx context.Context) (v *uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUploadedByUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldUploadedByUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUploadedByUserID: %w", err)
+	}
+	return oldValue.UploadedByUserID, nil
+}
+
+// AddUploadedByUserID adds u to the "uploaded_by_user_id" field.
+func (m *FileEntityMutation) AddUploadedByUserID(u int64) {
+	if m.adduploaded_by_user_id != nil {
+		*m.adduploaded_by_user_id += u
+	} else {
+		m.adduploaded_by_user_id = &u
+	}
+}
+
+// AddedUploadedByUserID returns the value that was added to the "uploaded_by_user_id" field in this mutation.
+func (m *FileEntityMutation) AddedUploadedByUserID() (r int64, exists bool) {
+	v := m.adduploaded_by_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUploadedByUserID clears the value of the "uploaded_by_user_id" field.
+func (m *FileEntityMutation) ClearUploadedByUserID() {
+	m.uploaded_by_user_id = nil
+	m.adduploaded_by_user_id = nil
+	m.clearedFields[fileentity.FieldUploadedByUserID] = struct{}{}
+}
+
+// UploadedByUserIDCleared returns if the "uploaded_by_user_id" field was cleared in this mutation.
+func (m *FileEntityMutation) UploadedByUserIDCleared() bool {
+	_, ok := m.clearedFields[fileentity.FieldUploadedByUserID]
+	return ok
+}
+
+// ResetUploadedByUserID resets all changes to the "uploaded_by_user_id" field.
+func (m *FileEntityMutation) ResetUploadedByUserID() {
+	m.uploaded_by_user_id = nil
+	m.adduploaded_by_user_id = nil
+	delete(m.clearedFields, fileentity.FieldUploadedByUserID)
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (m *FileEntityMutation) ClearFile() {
+	m.clearedfile = true
+	m.clearedFields[fileentity.FieldFileID] = struct{}{}
+}
+
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *FileEntityMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *FileEntityMutation) FileIDs() (ids []uint) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile resets all changes to the "file" edge.
+func (m *FileEntityMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// ClearEntity clears the "entity" edge to the Entity entity.
+func (m *FileEntityMutation) ClearEntity() {
+	m.clearedentity = true
+	m.clearedFields[fileentity.FieldEntityID] = struct{}{}
+}
+
+// EntityCleared reports if the "entity" edge to the Entity entity was cleared.
+func (m *FileEntityMutation) EntityCleared() bool {
+	return m.clearedentity
+}
+
+// EntityIDs returns the "entity" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EntityID instead. It exists only for internal usage by the builders.
+func (m *FileEntityMutation) EntityIDs() (ids []uint) {
+	if id := m.entity; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Type, nil
+	return
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *EntityMutation) ResetType() {
-	m._type = nil
+// ResetEntity resets all changes to the "entity" edge.
+func (m *FileEntityMutation) ResetEntity() {
+	m.entity = nil
+	m.clearedentity = false
 }
 
-// SetSource sets the "source" field.
-func (m *EntityMutation) SetSource(s string) {
-	m.source = &s
+// Where appends a list predicates to the FileEntityMutation builder.
+func (m *FileEntityMutation) Where(ps ...predicate.FileEntity) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *EntityMutation) Source() (r string, exists bool) {
-	v := m.source
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the FileEntityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileEntityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileEntity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldSource returns the old "source" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldSource(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
-	}
-	return oldValue.Source, nil
+// Op returns the operation name.
+func (m *FileEntityMutation) Op() Op {
+	return m.op
 }
 
-// ClearSource clears the value of the "source" field.
-func (m *EntityMutation) ClearSource() {
-	m.source = nil
-	m.clearedFields[entity.FieldSource] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *FileEntityMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SourceCleared returns if the "source" field was cleared in this mutation.
-func (m *EntityMutation) SourceCleared() bool {
-	_, ok := m.clearedFields[entity.FieldSource]
-	return ok
+// Type returns the node type of this mutation (FileEntity).
+func (m *FileEntityMutation) Type() string {
+	return m.typ
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *EntityMutation) ResetSource() {
-	m.source = nil
-	delete(m.clearedFields, entity.FieldSource)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileEntityMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.deleted_at != nil {
+		fields = append(fields, fileentity.FieldDeletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, fileentity.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, fileentity.FieldUpdatedAt)
+	}
+	if m.file != nil {
+		fields = append(fields, fileentity.FieldFileID)
+	}
+	if m.entity != nil {
+		fields = append(fields, fileentity.FieldEntityID)
+	}
+	if m.version != nil {
+		fields = append(fields, fileentity.FieldVersion)
+	}
+	if m.is_current != nil {
+		fields = append(fields, fileentity.FieldIsCurrent)
+	}
+	if m.uploaded_by_user_id != nil {
+		fields = append(fields, fileentity.FieldUploadedByUserID)
+	}
+	return fields
 }
 
-// SetSize sets the "size" field.
-func (m *EntityMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileEntityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fileentity.FieldDeletedAt:
+		return m.DeletedAt()
+	case fileentity.FieldCreatedAt:
+		return m.CreatedAt()
+	case fileentity.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case fileentity.FieldFileID:
+		return m.FileID()
+	case fileentity.FieldEntityID:
+		return m.EntityID()
+	case fileentity.FieldVersion:
+		return m.Version()
+	case fileentity.FieldIsCurrent:
+		return m.IsCurrent()
+	case fileentity.FieldUploadedByUserID:
+		return m.UploadedByUserID()
+	}
+	return nil, false
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *EntityMutation) Size() (r int64, exists bool) {
-	v := m.size
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileEntityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fileentity.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case fileentity.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case fileentity.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case fileentity.FieldFileID:
+		return m.OldFileID(ctx)
+	case fileentity.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case fileentity.FieldVersion:
+		return m.OldVersion(ctx)
+	case fileentity.FieldIsCurrent:
+		return m.OldIsCurrent(ctx)
+	case fileentity.FieldUploadedByUserID:
+		return m.OldUploadedByUserID(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown FileEntity field %s", name)
 }
 
-// OldSize returns the old "size" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldSize(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileEntityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fileentity.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case fileentity.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case fileentity.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case fileentity.FieldFileID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileID(v)
+		return nil
+	case fileentity.FieldEntityID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case fileentity.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case fileentity.FieldIsCurrent:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsCurrent(v)
+		return nil
+	case fileentity.FieldUploadedByUserID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadedByUserID(v)
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+	return fmt.Errorf("unknown FileEntity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileEntityMutation) AddedFields() []string {
+	var fields []string
+	if m.adduploaded_by_user_id != nil {
+		fields = append(fields, fileentity.FieldUploadedByUserID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileEntityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case fileentity.FieldUploadedByUserID:
+		return m.AddedUploadedByUserID()
 	}
-	return oldValue.Size, nil
+	return nil, false
 }
 
-// AddSize adds i to the "size" field.
-func (m *EntityMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileEntityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case fileentity.FieldUploadedByUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUploadedByUserID(v)
+		return nil
 	}
+	return fmt.Errorf("unknown FileEntity numeric field %s", name)
 }
 
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *EntityMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
-		return
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileEntityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fileentity.FieldDeletedAt) {
+		fields = append(fields, fileentity.FieldDeletedAt)
 	}
-	return *v, true
+	if m.FieldCleared(fileentity.FieldVersion) {
+		fields = append(fields, fileentity.FieldVersion)
+	}
+	if m.FieldCleared(fileentity.FieldUploadedByUserID) {
+		fields = append(fields, fileentity.FieldUploadedByUserID)
+	}
+	return fields
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *EntityMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileEntityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetUploadSessionID sets the "upload_session_id" field.
-func (m *EntityMutation) SetUploadSessionID(s string) {
-	m.upload_session_id = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileEntityMutation) ClearField(name string) error {
+	switch name {
+	case fileentity.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case fileentity.FieldVersion:
+		m.ClearVersion()
+		return nil
+	case fileentity.FieldUploadedByUserID:
+		m.ClearUploadedByUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown FileEntity nullable field %s", name)
 }
 
-// UploadSessionID returns the value of the "upload_session_id" field in the mutation.
-func (m *EntityMutation) UploadSessionID() (r string, exists bool) {
-	v := m.upload_session_id
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileEntityMutation) ResetField(name string) error {
+	switch name {
+	case fileentity.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case fileentity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case fileentity.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case fileentity.FieldFileID:
+		m.ResetFileID()
+		return nil
+	case fileentity.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case fileentity.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case fileentity.FieldIsCurrent:
+		m.ResetIsCurrent()
+		return nil
+	case fileentity.FieldUploadedByUserID:
+		m.ResetUploadedByUserID()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown FileEntity field %s", name)
 }
 
-// OldUploadSessionID returns the old "upload_session_id" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldUploadSessionID(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploadSessionID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploadSessionID requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileEntityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.file != nil {
+		edges = append(edges, fileentity.EdgeFile)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploadSessionID: %w", err)
+	if m.entity != nil {
+		edges = append(edges, fileentity.EdgeEntity)
 	}
-	return oldValue.UploadSessionID, nil
+	return edges
 }
 
-// ClearUploadSessionID clears the value of the "upload_session_id" field.
-func (m *EntityMutation) ClearUploadSessionID() {
-	m.upload_session_id = nil
-	m.clearedFields[entity.FieldUploadSessionID] = struct{}{}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileEntityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case fileentity.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	case fileentity.EdgeEntity:
+		if id := m.entity; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// UploadSessionIDCleared returns if the "upload_session_id" field was cleared in this mutation.
-func (m *EntityMutation) UploadSessionIDCleared() bool {
-	_, ok := m.clearedFields[entity.FieldUploadSessionID]
-	return ok
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileEntityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// ResetUploadSessionID resets all changes to the "upload_session_id" field.
-func (m *EntityMutation) ResetUploadSessionID() {
-	m.upload_session_id = nil
-	delete(m.clearedFields, entity.FieldUploadSessionID)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileEntityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetRecycleOptions sets the "recycle_options" field.
-func (m *EntityMutation) SetRecycleOptions(s string) {
-	m.recycle_options = &s
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileEntityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedfile {
+		edges = append(edges, fileentity.EdgeFile)
+	}
+	if m.clearedentity {
+		edges = append(edges, fileentity.EdgeEntity)
+	}
+	return edges
 }
 
-// RecycleOptions returns the value of the "recycle_options" field in the mutation.
-func (m *EntityMutation) RecycleOptions() (r string, exists bool) {
-	v := m.recycle_options
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileEntityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case fileentity.EdgeFile:
+		return m.clearedfile
+	case fileentity.EdgeEntity:
+		return m.clearedentity
 	}
-	return *v, true
+	return false
 }
 
-// OldRecycleOptions returns the old "recycle_options" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldRecycleOptions(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRecycleOptions is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRecycleOptions requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRecycleOptions: %w", err)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileEntityMutation) ClearEdge(name string) error {
+	switch name {
+	case fileentity.EdgeFile:
+		m.ClearFile()
+		return nil
+	case fileentity.EdgeEntity:
+		m.ClearEntity()
+		return nil
 	}
-	return oldValue.RecycleOptions, nil
+	return fmt.Errorf("unknown FileEntity unique edge %s", name)
 }
 
-// ClearRecycleOptions clears the value of the "recycle_options" field.
-func (m *EntityMutation) ClearRecycleOptions() {
-	m.recycle_options = nil
-	m.clearedFields[entity.FieldRecycleOptions] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileEntityMutation) ResetEdge(name string) error {
+	switch name {
+	case fileentity.EdgeFile:
+		m.ResetFile()
+		return nil
+	case fileentity.EdgeEntity:
+		m.ResetEntity()
+		return nil
+	}
+	return fmt.Errorf("unknown FileEntity edge %s", name)
 }
 
-// RecycleOptionsCleared returns if the "recycle_options" field was cleared in this mutation.
-func (m *EntityMutation) RecycleOptionsCleared() bool {
-	_, ok := m.clearedFields[entity.FieldRecycleOptions]
-	return ok
+// LinkMutation represents an operation that mutates the Link nodes in the graph.
+type LinkMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	url               *string
+	logo              *string
+	description       *string
+	status            *link.Status
+	siteshot          *string
+	email             *string
+	_type             *link.Type
+	original_url      *string
+	update_reason     *string
+	sort_order        *int
+	addsort_order     *int
+	skip_health_check *bool
+	clearedFields     map[string]struct{}
+	category          *int
+	clearedcategory   bool
+	tags              map[int]struct{}
+	removedtags       map[int]struct{}
+	clearedtags       bool
+	done              bool
+	oldValue          func(context.Context) (*Link, error)
+	predicates        []predicate.Link
 }
 
-// ResetRecycleOptions resets all changes to the "recycle_options" field.
-func (m *EntityMutation) ResetRecycleOptions() {
-	m.recycle_options = nil
-	delete(m.clearedFields, entity.FieldRecycleOptions)
+var _ ent.Mutation = (*LinkMutation)(nil)
+
+// linkOption allows management of the mutation configuration using functional options.
+type linkOption func(*LinkMutation)
+
+// newLinkMutation creates new mutation for the Link entity.
+func newLinkMutation(c config, op Op, opts ...linkOption) *LinkMutation {
+	m := &LinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// SetPolicyID sets the "policy_id" field.
-func (m *EntityMutation) SetPolicyID(u uint) {
-	m.policy_id = &u
-	m.addpolicy_id = nil
+// withLinkID sets the ID field of the mutation.
+func withLinkID(id int) linkOption {
+	return func(m *LinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Link
+		)
+		m.oldValue = func(ctx context.Context) (*Link, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Link.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// PolicyID returns the value of the "policy_id" field in the mutation.
-func (m *EntityMutation) PolicyID() (r uint, exists bool) {
-	v := m.policy_id
-	if v == nil {
-		return
+// withLink sets the old Link of the mutation.
+func withLink(node *Link) linkOption {
+	return func(m *LinkMutation) {
+		m.oldValue = func(context.Context) (*Link, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// OldPolicyID returns the old "policy_id" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldPolicyID(ctx context.Context) (v uint, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPolicyID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPolicyID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPolicyID: %w", err)
-	}
-	return oldValue.PolicyID, nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddPolicyID adds u to the "policy_id" field.
-func (m *EntityMutation) AddPolicyID(u int) {
-	if m.addpolicy_id != nil {
-		*m.addpolicy_id += u
-	} else {
-		m.addpolicy_id = &u
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddedPolicyID returns the value that was added to the "policy_id" field in this mutation.
-func (m *EntityMutation) AddedPolicyID() (r int, exists bool) {
-	v := m.addpolicy_id
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LinkMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetPolicyID resets all changes to the "policy_id" field.
-func (m *EntityMutation) ResetPolicyID() {
-	m.policy_id = nil
-	m.addpolicy_id = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LinkMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Link.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *EntityMutation) SetCreatedBy(u uint64) {
-	m.created_by = &u
-	m.addcreated_by = nil
+// SetName sets the "name" field.
+func (m *LinkMutation) SetName(s string) {
+	m.name = &s
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *EntityMutation) CreatedBy() (r uint64, exists bool) {
-	v := m.created_by
+// Name returns the value of the "name" field in the mutation.
+func (m *LinkMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldCreatedBy(ctx context.Context) (v *uint64, err error) {
+func (m *LinkMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
-	}
-	return oldValue.CreatedBy, nil
-}
-
-// AddCreatedBy adds u to the "created_by" field.
-func (m *EntityMutation) AddCreatedBy(u int64) {
-	if m.addcreated_by != nil {
-		*m.addcreated_by += u
-	} else {
-		m.addcreated_by = &u
-	}
-}
-
-// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
-func (m *EntityMutation) AddedCreatedBy() (r int64, exists bool) {
-	v := m.addcreated_by
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
-}
-
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *EntityMutation) ClearCreatedBy() {
-	m.created_by = nil
-	m.addcreated_by = nil
-	m.clearedFields[entity.FieldCreatedBy] = struct{}{}
-}
-
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *EntityMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[entity.FieldCreatedBy]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *EntityMutation) ResetCreatedBy() {
-	m.created_by = nil
-	m.addcreated_by = nil
-	delete(m.clearedFields, entity.FieldCreatedBy)
+// ResetName resets all changes to the "name" field.
+func (m *LinkMutation) ResetName() {
+	m.name = nil
 }
 
-// SetEtag sets the "etag" field.
-func (m *EntityMutation) SetEtag(s string) {
-	m.etag = &s
+// SetURL sets the "url" field.
+func (m *LinkMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// Etag returns the value of the "etag" field in the mutation.
-func (m *EntityMutation) Etag() (r string, exists bool) {
-	v := m.etag
+// URL returns the value of the "url" field in the mutation.
+func (m *LinkMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEtag returns the old "etag" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldEtag(ctx context.Context) (v *string, err error) {
+func (m *LinkMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEtag requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.Etag, nil
-}
-
-// ClearEtag clears the value of the "etag" field.
-func (m *EntityMutation) ClearEtag() {
-	m.etag = nil
-	m.clearedFields[entity.FieldEtag] = struct{}{}
-}
-
-// EtagCleared returns if the "etag" field was cleared in this mutation.
-func (m *EntityMutation) EtagCleared() bool {
-	_, ok := m.clearedFields[entity.FieldEtag]
-	return ok
+	return oldValue.URL, nil
 }
 
-// ResetEtag resets all changes to the "etag" field.
-func (m *EntityMutation) ResetEtag() {
-	m.etag = nil
-	delete(m.clearedFields, entity.FieldEtag)
+// ResetURL resets all changes to the "url" field.
+func (m *LinkMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetMimeType sets the "mime_type" field.
-func (m *EntityMutation) SetMimeType(s string) {
-	m.mime_type = &s
+// SetLogo sets the "logo" field.
+func (m *LinkMutation) SetLogo(s string) {
+	m.logo = &s
 }
 
-// MimeType returns the value of the "mime_type" field in the mutation.
-func (m *EntityMutation) MimeType() (r string, exists bool) {
-	v := m.mime_type
+// Logo returns the value of the "logo" field in the mutation.
+func (m *LinkMutation) Logo() (r string, exists bool) {
+	v := m.logo
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMimeType returns the old "mime_type" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldLogo returns the old "logo" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldMimeType(ctx context.Context) (v *string, err error) {
+func (m *LinkMutation) OldLogo(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+		return v, errors.New("OldLogo is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMimeType requires an ID field in the mutation")
+		return v, errors.New("OldLogo requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+		return v, fmt.Errorf("querying old value for OldLogo: %w", err)
 	}
-	return oldValue.MimeType, nil
+	return oldValue.Logo, nil
 }
 
-// ClearMimeType clears the value of the "mime_type" field.
-func (m *EntityMutation) ClearMimeType() {
-	m.mime_type = nil
-	m.clearedFields[entity.FieldMimeType] = struct{}{}
+// ClearLogo clears the value of the "logo" field.
+func (m *LinkMutation) ClearLogo() {
+	m.logo = nil
+	m.clearedFields[link.FieldLogo] = struct{}{}
 }
 
-// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
-func (m *EntityMutation) MimeTypeCleared() bool {
-	_, ok := m.clearedFields[entity.FieldMimeType]
+// LogoCleared returns if the "logo" field was cleared in this mutation.
+func (m *LinkMutation) LogoCleared() bool {
+	_, ok := m.clearedFields[link.FieldLogo]
 	return ok
 }
 
-// ResetMimeType resets all changes to the "mime_type" field.
-func (m *EntityMutation) ResetMimeType() {
-	m.mime_type = nil
-	delete(m.clearedFields, entity.FieldMimeType)
+// ResetLogo resets all changes to the "logo" field.
+func (m *LinkMutation) ResetLogo() {
+	m.logo = nil
+	delete(m.clearedFields, link.FieldLogo)
 }
 
-// SetDimension sets the "dimension" field.
-func (m *EntityMutation) SetDimension(s string) {
-	m.dimension = &s
+// SetDescription sets the "description" field.
+func (m *LinkMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Dimension returns the value of the "dimension" field in the mutation.
-func (m *EntityMutation) Dimension() (r string, exists bool) {
-	v := m.dimension
+// Description returns the value of the "description" field in the mutation.
+func (m *LinkMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDimension returns the old "dimension" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldDimension(ctx context.Context) (v *string, err error) {
+func (m *LinkMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDimension is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDimension requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDimension: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Dimension, nil
+	return oldValue.Description, nil
 }
 
-// ClearDimension clears the value of the "dimension" field.
-func (m *EntityMutation) ClearDimension() {
-	m.dimension = nil
-	m.clearedFields[entity.FieldDimension] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *LinkMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[link.FieldDescription] = struct{}{}
 }
 
-// DimensionCleared returns if the "dimension" field was cleared in this mutation.
-func (m *EntityMutation) DimensionCleared() bool {
-	_, ok := m.clearedFields[entity.FieldDimension]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *LinkMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[link.FieldDescription]
 	return ok
 }
 
-// ResetDimension resets all changes to the "dimension" field.
-func (m *EntityMutation) ResetDimension() {
-	m.dimension = nil
-	delete(m.clearedFields, entity.FieldDimension)
+// ResetDescription resets all changes to the "description" field.
+func (m *LinkMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, link.FieldDescription)
 }
 
-// SetStorageMetadata sets the "storage_metadata" field.
-func (m *EntityMutation) SetStorageMetadata(mm model.JSONMap) {
-	m.storage_metadata = &mm
+// SetStatus sets the "status" field.
+func (m *LinkMutation) SetStatus(l link.Status) {
+	m.status = &l
 }
 
-// StorageMetadata returns the value of the "storage_metadata" field in the mutation.
-func (m *EntityMutation) StorageMetadata() (r model.JSONMap, exists bool) {
-	v := m.storage_metadata
+// Status returns the value of the "status" field in the mutation.
+func (m *LinkMutation) Status() (r link.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStorageMetadata returns the old "storage_metadata" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldStorageMetadata(ctx context.Context) (v model.JSONMap, err error) {
+func (m *LinkMutation) OldStatus(ctx context.Context) (v link.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStorageMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStorageMetadata requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStorageMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.StorageMetadata, nil
-}
-
-// ClearStorageMetadata clears the value of the "storage_metadata" field.
-func (m *EntityMutation) ClearStorageMetadata() {
-	m.storage_metadata = nil
-	m.clearedFields[entity.FieldStorageMetadata] = struct{}{}
+	return oldValue.Status, nil
 }
 
-// StorageMetadataCleared returns if the "storage_metadata" field was cleared in this mutation.
-func (m *EntityMutation) StorageMetadataCleared() bool {
-	_, ok := m.clearedFields[entity.FieldStorageMetadata]
-	return ok
+// ResetStatus resets all changes to the "status" field.
+func (m *LinkMutation) ResetStatus() {
+	m.status = nil
 }
 
-// ResetStorageMetadata resets all changes to the "storage_metadata" field.
-func (m *EntityMutation) ResetStorageMetadata() {
-	m.storage_metadata = nil
-	delete(m.clearedFields, entity.FieldStorageMetadata)
+// SetSiteshot sets the "siteshot" field.
+func (m *LinkMutation) SetSiteshot(s string) {
+	m.siteshot = &s
 }
 
-// AddFileVersionIDs adds the "file_versions" edge to the FileEntity entity by ids.
-func (m *EntityMutation) AddFileVersionIDs(ids ...uint) {
-	if m.file_versions == nil {
-		m.file_versions = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.file_versions[ids[i]] = struct{}{}
+// Siteshot returns the value of the "siteshot" field in the mutation.
+func (m *LinkMutation) Siteshot() (r string, exists bool) {
+	v := m.siteshot
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearFileVersions clears the "file_versions" edge to the FileEntity entity.
-func (m *EntityMutation) ClearFileVersions() {
-	m.clearedfile_versions = true
-}
-
-// FileVersionsCleared reports if the "file_versions" edge to the FileEntity entity was cleared.
-func (m *EntityMutation) FileVersionsCleared() bool {
-	return m.clearedfile_versions
-}
-
-// RemoveFileVersionIDs removes the "file_versions" edge to the FileEntity entity by IDs.
-func (m *EntityMutation) RemoveFileVersionIDs(ids ...uint) {
-	if m.removedfile_versions == nil {
-		m.removedfile_versions = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.file_versions, ids[i])
-		m.removedfile_versions[ids[i]] = struct{}{}
+// OldSiteshot returns the old "siteshot" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldSiteshot(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSiteshot is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedFileVersions returns the removed IDs of the "file_versions" edge to the FileEntity entity.
-func (m *EntityMutation) RemovedFileVersionsIDs() (ids []uint) {
-	for id := range m.removedfile_versions {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSiteshot requires an ID field in the mutation")
 	}
-	return
-}
-
-// FileVersionsIDs returns the "file_versions" edge IDs in the mutation.
-func (m *EntityMutation) FileVersionsIDs() (ids []uint) {
-	for id := range m.file_versions {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSiteshot: %w", err)
 	}
-	return
-}
-
-// ResetFileVersions resets all changes to the "file_versions" edge.
-func (m *EntityMutation) ResetFileVersions() {
-	m.file_versions = nil
-	m.clearedfile_versions = false
-	m.removedfile_versions = nil
+	return oldValue.Siteshot, nil
 }
 
-// Where appends a list predicates to the EntityMutation builder.
-func (m *EntityMutation) Where(ps ...predicate.Entity) {
-	m.predicates = append(m.predicates, ps...)
+// ClearSiteshot clears the value of the "siteshot" field.
+func (m *LinkMutation) ClearSiteshot() {
+	m.siteshot = nil
+	m.clearedFields[link.FieldSiteshot] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the EntityMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EntityMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Entity, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// SiteshotCleared returns if the "siteshot" field was cleared in this mutation.
+func (m *LinkMutation) SiteshotCleared() bool {
+	_, ok := m.clearedFields[link.FieldSiteshot]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *EntityMutation) Op() Op {
-	return m.op
+// ResetSiteshot resets all changes to the "siteshot" field.
+func (m *LinkMutation) ResetSiteshot() {
+	m.siteshot = nil
+	delete(m.clearedFields, link.FieldSiteshot)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EntityMutation) SetOp(op Op) {
-	m.op = op
+// SetEmail sets the "email" field.
+func (m *LinkMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Type returns the node type of this mutation (Entity).
-func (m *EntityMutation) Type() string {
-	return m.typ
+// Email returns the value of the "email" field in the mutation.
+func (m *LinkMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EntityMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.created_at != nil {
-		fields = append(fields, entity.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, entity.FieldUpdatedAt)
-	}
-	if m._type != nil {
-		fields = append(fields, entity.FieldType)
-	}
-	if m.source != nil {
-		fields = append(fields, entity.FieldSource)
-	}
-	if m.size != nil {
-		fields = append(fields, entity.FieldSize)
-	}
-	if m.upload_session_id != nil {
-		fields = append(fields, entity.FieldUploadSessionID)
-	}
-	if m.recycle_options != nil {
-		fields = append(fields, entity.FieldRecycleOptions)
-	}
-	if m.policy_id != nil {
-		fields = append(fields, entity.FieldPolicyID)
-	}
-	if m.created_by != nil {
-		fields = append(fields, entity.FieldCreatedBy)
-	}
-	if m.etag != nil {
-		fields = append(fields, entity.FieldEtag)
-	}
-	if m.mime_type != nil {
-		fields = append(fields, entity.FieldMimeType)
+// OldEmail returns the old "email" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
-	if m.dimension != nil {
-		fields = append(fields, entity.FieldDimension)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
-	if m.storage_metadata != nil {
-		fields = append(fields, entity.FieldStorageMetadata)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return fields
+	return oldValue.Email, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *EntityMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case entity.FieldCreatedAt:
-		return m.CreatedAt()
-	case entity.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case entity.FieldType:
-		return m.GetType()
-	case entity.FieldSource:
-		return m.Source()
-	case entity.FieldSize:
-		return m.Size()
-	case entity.FieldUploadSessionID:
-		return m.UploadSessionID()
-	case entity.FieldRecycleOptions:
-		return m.RecycleOptions()
-	case entity.FieldPolicyID:
-		return m.PolicyID()
-	case entity.FieldCreatedBy:
-		return m.CreatedBy()
-	case entity.FieldEtag:
-		return m.Etag()
-	case entity.FieldMimeType:
-		return m.MimeType()
-	case entity.FieldDimension:
-		return m.Dimension()
-	case entity.FieldStorageMetadata:
-		return m.StorageMetadata()
+// ClearEmail clears the value of the "email" field.
+func (m *LinkMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[link.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *LinkMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[link.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *LinkMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, link.FieldEmail)
+}
+
+// SetType sets the "type" field.
+func (m *LinkMutation) SetType(l link.Type) {
+	m._type = &l
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *LinkMutation) GetType() (r link.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *EntityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case entity.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case entity.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case entity.FieldType:
-		return m.OldType(ctx)
-	case entity.FieldSource:
-		return m.OldSource(ctx)
-	case entity.FieldSize:
-		return m.OldSize(ctx)
-	case entity.FieldUploadSessionID:
-		return m.OldUploadSessionID(ctx)
-	case entity.FieldRecycleOptions:
-		return m.OldRecycleOptions(ctx)
-	case entity.FieldPolicyID:
-		return m.OldPolicyID(ctx)
-	case entity.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case entity.FieldEtag:
-		return m.OldEtag(ctx)
-	case entity.FieldMimeType:
-		return m.OldMimeType(ctx)
-	case entity.FieldDimension:
-		return m.OldDimension(ctx)
-	case entity.FieldStorageMetadata:
-		return m.OldStorageMetadata(ctx)
+// OldType returns the old "type" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldType(ctx context.Context) (v link.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Entity field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EntityMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case entity.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case entity.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case entity.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case entity.FieldSource:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSource(v)
-		return nil
-	case entity.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSize(v)
-		return nil
-	case entity.FieldUploadSessionID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUploadSessionID(v)
-		return nil
-	case entity.FieldRecycleOptions:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRecycleOptions(v)
-		return nil
-	case entity.FieldPolicyID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPolicyID(v)
-		return nil
-	case entity.FieldCreatedBy:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
-		return nil
-	case entity.FieldEtag:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEtag(v)
-		return nil
-	case entity.FieldMimeType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMimeType(v)
-		return nil
-	case entity.FieldDimension:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDimension(v)
-		return nil
-	case entity.FieldStorageMetadata:
-		v, ok := value.(model.JSONMap)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStorageMetadata(v)
-		return nil
+// ClearType clears the value of the "type" field.
+func (m *LinkMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[link.FieldType] = struct{}{}
+}
+
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *LinkMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[link.FieldType]
+	return ok
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *LinkMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, link.FieldType)
+}
+
+// SetOriginalURL sets the "original_url" field.
+func (m *LinkMutation) SetOriginalURL(s string) {
+	m.original_url = &s
+}
+
+// OriginalURL returns the value of the "original_url" field in the mutation.
+func (m *LinkMutation) OriginalURL() (r string, exists bool) {
+	v := m.original_url
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Entity field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *EntityMutation) AddedFields() []string {
-	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, entity.FieldSize)
+// OldOriginalURL returns the old "original_url" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldOriginalURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalURL is only allowed on UpdateOne operations")
 	}
-	if m.addpolicy_id != nil {
-		fields = append(fields, entity.FieldPolicyID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalURL requires an ID field in the mutation")
 	}
-	if m.addcreated_by != nil {
-		fields = append(fields, entity.FieldCreatedBy)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalURL: %w", err)
 	}
-	return fields
+	return oldValue.OriginalURL, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EntityMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case entity.FieldSize:
-		return m.AddedSize()
-	case entity.FieldPolicyID:
-		return m.AddedPolicyID()
-	case entity.FieldCreatedBy:
-		return m.AddedCreatedBy()
-	}
-	return nil, false
+// ClearOriginalURL clears the value of the "original_url" field.
+func (m *LinkMutation) ClearOriginalURL() {
+	m.original_url = nil
+	m.clearedFields[link.FieldOriginalURL] = struct{}{}
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EntityMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case entity.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSize(v)
-		return nil
-	case entity.FieldPolicyID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPolicyID(v)
-		return nil
-	case entity.FieldCreatedBy:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCreatedBy(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Entity numeric field %s", name)
+// OriginalURLCleared returns if the "original_url" field was cleared in this mutation.
+func (m *LinkMutation) OriginalURLCleared() bool {
+	_, ok := m.clearedFields[link.FieldOriginalURL]
+	return ok
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *EntityMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(entity.FieldSource) {
-		fields = append(fields, entity.FieldSource)
+// ResetOriginalURL resets all changes to the "original_url" field.
+func (m *LinkMutation) ResetOriginalURL() {
+	m.original_url = nil
+	delete(m.clearedFields, link.FieldOriginalURL)
+}
+
+// SetUpdateReason sets the "update_reason" field.
+func (m *LinkMutation) SetUpdateReason(s string) {
+	m.update_reason = &s
+}
+
+// UpdateReason returns the value of the "update_reason" field in the mutation.
+func (m *LinkMutation) UpdateReason() (r string, exists bool) {
+	v := m.update_reason
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(entity.FieldUploadSessionID) {
-		fields = append(fields, entity.FieldUploadSessionID)
+	return *v, true
+}
+
+// OldUpdateReason returns the old "update_reason" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldUpdateReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateReason is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(entity.FieldRecycleOptions) {
-		fields = append(fields, entity.FieldRecycleOptions)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateReason requires an ID field in the mutation")
 	}
-	if m.FieldCleared(entity.FieldCreatedBy) {
-		fields = append(fields, entity.FieldCreatedBy)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateReason: %w", err)
 	}
-	if m.FieldCleared(entity.FieldEtag) {
-		fields = append(fields, entity.FieldEtag)
+	return oldValue.UpdateReason, nil
+}
+
+// ClearUpdateReason clears the value of the "update_reason" field.
+func (m *LinkMutation) ClearUpdateReason() {
+	m.update_reason = nil
+	m.clearedFields[link.FieldUpdateReason] = struct{}{}
+}
+
+// UpdateReasonCleared returns if the "update_reason" field was cleared in this mutation.
+func (m *LinkMutation) UpdateReasonCleared() bool {
+	_, ok := m.clearedFields[link.FieldUpdateReason]
+	return ok
+}
+
+// ResetUpdateReason resets all changes to the "update_reason" field.
+func (m *LinkMutation) ResetUpdateReason() {
+	m.update_reason = nil
+	delete(m.clearedFields, link.FieldUpdateReason)
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (m *LinkMutation) SetSortOrder(i int) {
+	m.sort_order = &i
+	m.addsort_order = nil
+}
+
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *LinkMutation) SortOrder() (r int, exists bool) {
+	v := m.sort_order
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(entity.FieldMimeType) {
-		fields = append(fields, entity.FieldMimeType)
+	return *v, true
+}
+
+// OldSortOrder returns the old "sort_order" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(entity.FieldDimension) {
-		fields = append(fields, entity.FieldDimension)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
 	}
-	if m.FieldCleared(entity.FieldStorageMetadata) {
-		fields = append(fields, entity.FieldStorageMetadata)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
 	}
-	return fields
+	return oldValue.SortOrder, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *EntityMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddSortOrder adds i to the "sort_order" field.
+func (m *LinkMutation) AddSortOrder(i int) {
+	if m.addsort_order != nil {
+		*m.addsort_order += i
+	} else {
+		m.addsort_order = &i
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *EntityMutation) ClearField(name string) error {
-	switch name {
-	case entity.FieldSource:
-		m.ClearSource()
-		return nil
-	case entity.FieldUploadSessionID:
-		m.ClearUploadSessionID()
-		return nil
-	case entity.FieldRecycleOptions:
-		m.ClearRecycleOptions()
-		return nil
-	case entity.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case entity.FieldEtag:
-		m.ClearEtag()
-		return nil
-	case entity.FieldMimeType:
-		m.ClearMimeType()
-		return nil
-	case entity.FieldDimension:
-		m.ClearDimension()
-		return nil
-	case entity.FieldStorageMetadata:
-		m.ClearStorageMetadata()
-		return nil
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *LinkMutation) AddedSortOrder() (r int, exists bool) {
+	v := m.addsort_order
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Entity nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *EntityMutation) ResetField(name string) error {
-	switch name {
-	case entity.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case entity.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case entity.FieldType:
-		m.ResetType()
-		return nil
-	case entity.FieldSource:
-		m.ResetSource()
-		return nil
-	case entity.FieldSize:
-		m.ResetSize()
-		return nil
-	case entity.FieldUploadSessionID:
-		m.ResetUploadSessionID()
-		return nil
-	case entity.FieldRecycleOptions:
-		m.ResetRecycleOptions()
-		return nil
-	case entity.FieldPolicyID:
-		m.ResetPolicyID()
-		return nil
-	case entity.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case entity.FieldEtag:
-		m.ResetEtag()
-		return nil
-	case entity.FieldMimeType:
-		m.ResetMimeType()
-		return nil
-	case entity.FieldDimension:
-		m.ResetDimension()
-		return nil
-	case entity.FieldStorageMetadata:
-		m.ResetStorageMetadata()
-		return nil
-	}
-	return fmt.Errorf("unknown Entity field %s", name)
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *LinkMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
+}
+
+// SetSkipHealthCheck sets the "skip_health_check" field.
+func (m *LinkMutation) SetSkipHealthCheck(b bool) {
+	m.skip_health_check = &b
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EntityMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.file_versions != nil {
-		edges = append(edges, entity.EdgeFileVersions)
+// SkipHealthCheck returns the value of the "skip_health_check" field in the mutation.
+func (m *LinkMutation) SkipHealthCheck() (r bool, exists bool) {
+	v := m.skip_health_check
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EntityMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case entity.EdgeFileVersions:
-		ids := make([]ent.Value, 0, len(m.file_versions))
-		for id := range m.file_versions {
-			ids = append(ids, id)
-		}
-		return ids
+// OldSkipHealthCheck returns the old "skip_health_check" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldSkipHealthCheck(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkipHealthCheck is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkipHealthCheck requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkipHealthCheck: %w", err)
+	}
+	return oldValue.SkipHealthCheck, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EntityMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedfile_versions != nil {
-		edges = append(edges, entity.EdgeFileVersions)
-	}
-	return edges
+// ResetSkipHealthCheck resets all changes to the "skip_health_check" field.
+func (m *LinkMutation) ResetSkipHealthCheck() {
+	m.skip_health_check = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EntityMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case entity.EdgeFileVersions:
-		ids := make([]ent.Value, 0, len(m.removedfile_versions))
-		for id := range m.removedfile_versions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// SetCategoryID sets the "category" edge to the LinkCategory entity by id.
+func (m *LinkMutation) SetCategoryID(id int) {
+	m.category = &id
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EntityMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedfile_versions {
-		edges = append(edges, entity.EdgeFileVersions)
-	}
-	return edges
+// ClearCategory clears the "category" edge to the LinkCategory entity.
+func (m *LinkMutation) ClearCategory() {
+	m.clearedcategory = true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EntityMutation) EdgeCleared(name string) bool {
-	switch name {
-	case entity.EdgeFileVersions:
-		return m.clearedfile_versions
-	}
-	return false
+// CategoryCleared reports if the "category" edge to the LinkCategory entity was cleared.
+func (m *LinkMutation) CategoryCleared() bool {
+	return m.clearedcategory
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EntityMutation) ClearEdge(name string) error {
-	switch name {
+// CategoryID returns the "category" edge ID in the mutation.
+func (m *LinkMutation) CategoryID() (id int, exists bool) {
+	if m.category != nil {
+		return *m.category, true
 	}
-	return fmt.Errorf("unknown Entity unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EntityMutation) ResetEdge(name string) error {
-	switch name {
-	case entity.EdgeFileVersions:
-		m.ResetFileVersions()
-		return nil
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *LinkMutation) CategoryIDs() (ids []int) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
 	}
-	return fmt.Errorf("unknown Entity edge %s", name)
+	return
 }
 
-// FileMutation represents an operation that mutates the File nodes in the graph.
-type FileMutation struct {
-	config
-	op                    Op
-	typ                   string
-	id                    *uint
-	deleted_at            *time.Time
-	created_at            *time.Time
-	updated_at            *time.Time
-	_type                 *int
-	add_type              *int
-	name                  *string
-	size                  *int64
-	addsize               *int64
-	children_count        *int64
-	addchildren_count     *int64
-	view_config           *string
-	clearedFields         map[string]struct{}
-	owner                 *uint
-	clearedowner          bool
-	parent                *uint
-	clearedparent         bool
-	children              map[uint]struct{}
-	removedchildren       map[uint]struct{}
-	clearedchildren       bool
-	primary_entity        *uint
-	clearedprimary_entity bool
-	versions              map[uint]struct{}
-	removedversions       map[uint]struct{}
-	clearedversions       bool
-	direct_link           *uint
-	cleareddirect_link    bool
-	metadata              map[uint]struct{}
-	removedmetadata       map[uint]struct{}
-	clearedmetadata       bool
-	done                  bool
-	oldValue              func(context.Context) (*File, error)
-	predicates            []predicate.File
+// ResetCategory resets all changes to the "category" edge.
+func (m *LinkMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
 }
 
-var _ ent.Mutation = (*FileMutation)(nil)
-
-// fileOption allows management of the mutation configuration using functional options.
-type fileOption func(*FileMutation)
-
-// newFileMutation creates new mutation for the File entity.
-func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
-	m := &FileMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeFile,
-		clearedFields: make(map[string]struct{}),
+// AddTagIDs adds the "tags" edge to the LinkTag entity by ids.
+func (m *LinkMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withFileID sets the ID field of the mutation.
-func withFileID(id uint) fileOption {
-	return func(m *FileMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *File
-		)
-		m.oldValue = func(ctx context.Context) (*File, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().File.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ClearTags clears the "tags" edge to the LinkTag entity.
+func (m *LinkMutation) ClearTags() {
+	m.clearedtags = true
 }
 
-// withFile sets the old File of the mutation.
-func withFile(node *File) fileOption {
-	return func(m *FileMutation) {
-		m.oldValue = func(context.Context) (*File, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// TagsCleared reports if the "tags" edge to the LinkTag entity was cleared.
+func (m *LinkMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the LinkTag entity by IDs.
+func (m *LinkMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// RemovedTags returns the removed IDs of the "tags" edge to the LinkTag entity.
+func (m *LinkMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m FileMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *LinkMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of File entities.
-func (m *FileMutation) SetID(id uint) {
-	m.id = &id
+// ResetTags resets all changes to the "tags" edge.
+func (m *LinkMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// Where appends a list predicates to the LinkMutation builder.
+func (m *LinkMutation) Where(ps ...predicate.Link) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *FileMutation) ID() (id uint, exists bool) {
-	if m.id == nil {
-		return
+// WhereP appends storage-level predicates to the LinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Link, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *m.id, true
+	m.Where(p...)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *FileMutation) IDs(ctx context.Context) ([]uint, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// Op returns the operation name.
+func (m *LinkMutation) Op() Op {
+	return m.op
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *FileMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetOp allows setting the mutation operation.
+func (m *LinkMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *FileMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Link).
+func (m *LinkMutation) Type() string {
+	return m.typ
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LinkMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.name != nil {
+		fields = append(fields, link.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	if m.url != nil {
+		fields = append(fields, link.FieldURL)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	if m.logo != nil {
+		fields = append(fields, link.FieldLogo)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *FileMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[file.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *FileMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[file.FieldDeletedAt]
-	return ok
+	if m.description != nil {
+		fields = append(fields, link.FieldDescription)
+	}
+	if m.status != nil {
+		fields = append(fields, link.FieldStatus)
+	}
+	if m.siteshot != nil {
+		fields = append(fields, link.FieldSiteshot)
+	}
+	if m.email != nil {
+		fields = append(fields, link.FieldEmail)
+	}
+	if m._type != nil {
+		fields = append(fields, link.FieldType)
+	}
+	if m.original_url != nil {
+		fields = append(fields, link.FieldOriginalURL)
+	}
+	if m.update_reason != nil {
+		fields = append(fields, link.FieldUpdateReason)
+	}
+	if m.sort_order != nil {
+		fields = append(fields, link.FieldSortOrder)
+	}
+	if m.skip_health_check != nil {
+		fields = append(fields, link.FieldSkipHealthCheck)
+	}
+	return fields
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *FileMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, file.FieldDeletedAt)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case link.FieldName:
+		return m.Name()
+	case link.FieldURL:
+		return m.URL()
+	case link.FieldLogo:
+		return m.Logo()
+	case link.FieldDescription:
+		return m.Description()
+	case link.FieldStatus:
+		return m.Status()
+	case link.FieldSiteshot:
+		return m.Siteshot()
+	case link.FieldEmail:
+		return m.Email()
+	case link.FieldType:
+		return m.GetType()
+	case link.FieldOriginalURL:
+		return m.OriginalURL()
+	case link.FieldUpdateReason:
+		return m.UpdateReason()
+	case link.FieldSortOrder:
+		return m.SortOrder()
+	case link.FieldSkipHealthCheck:
+		return m.SkipHealthCheck()
+	}
+	return nil, false
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FileMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case link.FieldName:
+		return m.OldName(ctx)
+	case link.FieldURL:
+		return m.OldURL(ctx)
+	case link.FieldLogo:
+		return m.OldLogo(ctx)
+	case link.FieldDescription:
+		return m.OldDescription(ctx)
+	case link.FieldStatus:
+		return m.OldStatus(ctx)
+	case link.FieldSiteshot:
+		return m.OldSiteshot(ctx)
+	case link.FieldEmail:
+		return m.OldEmail(ctx)
+	case link.FieldType:
+		return m.OldType(ctx)
+	case link.FieldOriginalURL:
+		return m.OldOriginalURL(ctx)
+	case link.FieldUpdateReason:
+		return m.OldUpdateReason(ctx)
+	case link.FieldSortOrder:
+		return m.OldSortOrder(ctx)
+	case link.FieldSkipHealthCheck:
+		return m.OldSkipHealthCheck(ctx)
+	}
+	return nil, fmt.Errorf("unknown Link field %s", name)
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case link.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case link.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case link.FieldLogo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogo(v)
+		return nil
+	case link.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case link.FieldStatus:
+		v, ok := value.(link.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case link.FieldSiteshot:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSiteshot(v)
+		return nil
+	case link.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case link.FieldType:
+		v, ok := value.(link.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case link.FieldOriginalURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalURL(v)
+		return nil
+	case link.FieldUpdateReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateReason(v)
+		return nil
+	case link.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortOrder(v)
+		return nil
+	case link.FieldSkipHealthCheck:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkipHealthCheck(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Link field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LinkMutation) AddedFields() []string {
+	var fields []string
+	if m.addsort_order != nil {
+		fields = append(fields, link.FieldSortOrder)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FileMutation) ResetCreatedAt() {
-	m.created_at = nil
+	return fields
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *FileMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LinkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case link.FieldSortOrder:
+		return m.AddedSortOrder()
+	}
+	return nil, false
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case link.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortOrder(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Link numeric field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(link.FieldLogo) {
+		fields = append(fields, link.FieldLogo)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m.FieldCleared(link.FieldDescription) {
+		fields = append(fields, link.FieldDescription)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.FieldCleared(link.FieldSiteshot) {
+		fields = append(fields, link.FieldSiteshot)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FileMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetType sets the "type" field.
-func (m *FileMutation) SetType(i int) {
-	m._type = &i
-	m.add_type = nil
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *FileMutation) GetType() (r int, exists bool) {
-	v := m._type
-	if v == nil {
-		return
+	if m.FieldCleared(link.FieldEmail) {
+		fields = append(fields, link.FieldEmail)
 	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldType(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	if m.FieldCleared(link.FieldType) {
+		fields = append(fields, link.FieldType)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+	if m.FieldCleared(link.FieldOriginalURL) {
+		fields = append(fields, link.FieldOriginalURL)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	if m.FieldCleared(link.FieldUpdateReason) {
+		fields = append(fields, link.FieldUpdateReason)
 	}
-	return oldValue.Type, nil
+	return fields
 }
 
-// AddType adds i to the "type" field.
-func (m *FileMutation) AddType(i int) {
-	if m.add_type != nil {
-		*m.add_type += i
-	} else {
-		m.add_type = &i
-	}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// AddedType returns the value that was added to the "type" field in this mutation.
-func (m *FileMutation) AddedType() (r int, exists bool) {
-	v := m.add_type
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LinkMutation) ClearField(name string) error {
+	switch name {
+	case link.FieldLogo:
+		m.ClearLogo()
+		return nil
+	case link.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case link.FieldSiteshot:
+		m.ClearSiteshot()
+		return nil
+	case link.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case link.FieldType:
+		m.ClearType()
+		return nil
+	case link.FieldOriginalURL:
+		m.ClearOriginalURL()
+		return nil
+	case link.FieldUpdateReason:
+		m.ClearUpdateReason()
+		return nil
 	}
-	return *v, true
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *FileMutation) ResetType() {
-	m._type = nil
-	m.add_type = nil
-}
-
-// SetOwnerID sets the "owner_id" field.
-func (m *FileMutation) SetOwnerID(u uint) {
-	m.owner = &u
+	return fmt.Errorf("unknown Link nullable field %s", name)
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *FileMutation) OwnerID() (r uint, exists bool) {
-	v := m.owner
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LinkMutation) ResetField(name string) error {
+	switch name {
+	case link.FieldName:
+		m.ResetName()
+		return nil
+	case link.FieldURL:
+		m.ResetURL()
+		return nil
+	case link.FieldLogo:
+		m.ResetLogo()
+		return nil
+	case link.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case link.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case link.FieldSiteshot:
+		m.ResetSiteshot()
+		return nil
+	case link.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case link.FieldType:
+		m.ResetType()
+		return nil
+	case link.FieldOriginalURL:
+		m.ResetOriginalURL()
+		return nil
+	case link.FieldUpdateReason:
+		m.ResetUpdateReason()
+		return nil
+	case link.FieldSortOrder:
+		m.ResetSortOrder()
+		return nil
+	case link.FieldSkipHealthCheck:
+		m.ResetSkipHealthCheck()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Link field %s", name)
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldOwnerID(ctx context.Context) (v uint, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.category != nil {
+		edges = append(edges, link.EdgeCategory)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	if m.tags != nil {
+		edges = append(edges, link.EdgeTags)
 	}
-	return oldValue.OwnerID, nil
+	return edges
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *FileMutation) ResetOwnerID() {
-	m.owner = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case link.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	case link.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *FileMutation) SetParentID(u uint) {
-	m.parent = &u
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedtags != nil {
+		edges = append(edges, link.EdgeTags)
+	}
+	return edges
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *FileMutation) ParentID() (r uint, exists bool) {
-	v := m.parent
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LinkMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case link.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldParentID returns the old "parent_id" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldParentID(ctx context.Context) (v *uint, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcategory {
+		edges = append(edges, link.EdgeCategory)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	if m.clearedtags {
+		edges = append(edges, link.EdgeTags)
 	}
-	return oldValue.ParentID, nil
+	return edges
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (m *FileMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[file.FieldParentID] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case link.EdgeCategory:
+		return m.clearedcategory
+	case link.EdgeTags:
+		return m.clearedtags
+	}
+	return false
 }
 
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *FileMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[file.FieldParentID]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LinkMutation) ClearEdge(name string) error {
+	switch name {
+	case link.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown Link unique edge %s", name)
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *FileMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, file.FieldParentID)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LinkMutation) ResetEdge(name string) error {
+	switch name {
+	case link.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	case link.EdgeTags:
+		m.ResetTags()
+		return nil
+	}
+	return fmt.Errorf("unknown Link edge %s", name)
 }
 
-// SetName sets the "name" field.
-func (m *FileMutation) SetName(s string) {
-	m.name = &s
+// LinkArchiveMutation represents an operation that mutates the LinkArchive nodes in the graph.
+type LinkArchiveMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	url           *string
+	archived_url  *string
+	archived_at   *time.Time
+	attempts      *int
+	addattempts   *int
+	last_attempt  *time.Time
+	error         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LinkArchive, error)
+	predicates    []predicate.LinkArchive
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FileMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+var _ ent.Mutation = (*LinkArchiveMutation)(nil)
 
-// OldName returns the old "name" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+// linkarchiveOption allows management of the mutation configuration using functional options.
+type linkarchiveOption func(*LinkArchiveMutation)
+
+// newLinkArchiveMutation creates new mutation for the LinkArchive entity.
+func newLinkArchiveMutation(c config, op Op, opts ...linkarchiveOption) *LinkArchiveMutation {
+	m := &LinkArchiveMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLinkArchive,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.Name, nil
+	return m
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FileMutation) ResetName() {
-	m.name = nil
+// withLinkArchiveID sets the ID field of the mutation.
+func withLinkArchiveID(id uint) linkarchiveOption {
+	return func(m *LinkArchiveMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LinkArchive
+		)
+		m.oldValue = func(ctx context.Context) (*LinkArchive, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LinkArchive.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetSize sets the "size" field.
-func (m *FileMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// withLinkArchive sets the old LinkArchive of the mutation.
+func withLinkArchive(node *LinkArchive) linkarchiveOption {
+	return func(m *LinkArchiveMutation) {
+		m.oldValue = func(context.Context) (*LinkArchive, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *FileMutation) Size() (r int64, exists bool) {
-	v := m.size
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LinkArchiveMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldSize returns the old "size" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldSize(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LinkArchiveMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Size, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddSize adds i to the "size" field.
-func (m *FileMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
-	}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LinkArchive entities.
+func (m *LinkArchiveMutation) SetID(id uint) {
+	m.id = &id
 }
 
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *FileMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LinkArchiveMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *FileMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LinkArchiveMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LinkArchive.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetPrimaryEntityID sets the "primary_entity_id" field.
-func (m *FileMutation) SetPrimaryEntityID(u uint) {
-	m.primary_entity = &u
+// SetURL sets the "url" field.
+func (m *LinkArchiveMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// PrimaryEntityID returns the value of the "primary_entity_id" field in the mutation.
-func (m *FileMutation) PrimaryEntityID() (r uint, exists bool) {
-	v := m.primary_entity
+// URL returns the value of the "url" field in the mutation.
+func (m *LinkArchiveMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrimaryEntityID returns the old "primary_entity_id" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the LinkArchive entity.
+// If the LinkArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldPrimaryEntityID(ctx context.Context) (v *uint, err error) {
+func (m *LinkArchiveMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrimaryEntityID is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrimaryEntityID requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrimaryEntityID: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.PrimaryEntityID, nil
-}
-
-// ClearPrimaryEntityID clears the value of the "primary_entity_id" field.
-func (m *FileMutation) ClearPrimaryEntityID() {
-	m.primary_entity = nil
-	m.clearedFields[file.FieldPrimaryEntityID] = struct{}{}
-}
-
-// PrimaryEntityIDCleared returns if the "primary_entity_id" field was cleared in this mutation.
-func (m *FileMutation) PrimaryEntityIDCleared() bool {
-	_, ok := m.clearedFields[file.FieldPrimaryEntityID]
-	return ok
+	return oldValue.URL, nil
 }
 
-// ResetPrimaryEntityID resets all changes to the "primary_entity_id" field.
-func (m *FileMutation) ResetPrimaryEntityID() {
-	m.primary_entity = nil
-	delete(m.clearedFields, file.FieldPrimaryEntityID)
+// ResetURL resets all changes to the "url" field.
+func (m *LinkArchiveMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetChildrenCount sets the "children_count" field.
-func (m *FileMutation) SetChildrenCount(i int64) {
-	m.children_count = &i
-	m.addchildren_count = nil
+// SetArchivedURL sets the "archived_url" field.
+func (m *LinkArchiveMutation) SetArchivedURL(s string) {
+	m.archived_url = &s
 }
 
-// ChildrenCount returns the value of the "children_count" field in the mutation.
-func (m *FileMutation) ChildrenCount() (r int64, exists bool) {
-	v := m.children_count
+// ArchivedURL returns the value of the "archived_url" field in the mutation.
+func (m *LinkArchiveMutation) ArchivedURL() (r string, exists bool) {
+	v := m.archived_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChildrenCount returns the old "children_count" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldArchivedURL returns the old "archived_url" field's value of the LinkArchive entity.
+// If the LinkArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldChildrenCount(ctx context.Context) (v int64, err error) {
+func (m *LinkArchiveMutation) OldArchivedURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChildrenCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldArchivedURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChildrenCount requires an ID field in the mutation")
+		return v, errors.New("OldArchivedURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChildrenCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldArchivedURL: %w", err)
 	}
-	return oldValue.ChildrenCount, nil
+	return oldValue.ArchivedURL, nil
 }
 
-// AddChildrenCount adds i to the "children_count" field.
-func (m *FileMutation) AddChildrenCount(i int64) {
-	if m.addchildren_count != nil {
-		*m.addchildren_count += i
-	} else {
-		m.addchildren_count = &i
-	}
+// ClearArchivedURL clears the value of the "archived_url" field.
+func (m *LinkArchiveMutation) ClearArchivedURL() {
+	m.archived_url = nil
+	m.clearedFields[linkarchive.FieldArchivedURL] = struct{}{}
 }
 
-// AddedChildrenCount returns the value that was added to the "children_count" field in this mutation.
-func (m *FileMutation) AddedChildrenCount() (r int64, exists bool) {
-	v := m.addchildren_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ArchivedURLCleared returns if the "archived_url" field was cleared in this mutation.
+func (m *LinkArchiveMutation) ArchivedURLCleared() bool {
+	_, ok := m.clearedFields[linkarchive.FieldArchivedURL]
+	return ok
 }
 
-// ResetChildrenCount resets all changes to the "children_count" field.
-func (m *FileMutation) ResetChildrenCount() {
-	m.children_count = nil
-	m.addchildren_count = nil
+// ResetArchivedURL resets all changes to the "archived_url" field.
+func (m *LinkArchiveMutation) ResetArchivedURL() {
+	m.archived_url = nil
+	delete(m.clearedFields, linkarchive.FieldArchivedURL)
 }
 
-// SetViewConfig sets the "view_config" field.
-func (m *FileMutation) SetViewConfig(s string) {
-	m.view_config = &s
+// SetArchivedAt sets the "archived_at" field.
+func (m *LinkArchiveMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
 }
 
-// ViewConfig returns the value of the "view_config" field in the mutation.
-func (m *FileMutation) ViewConfig() (r string, exists bool) {
-	v := m.view_config
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *LinkArchiveMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViewConfig returns the old "view_config" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldArchivedAt returns the old "archived_at" field's value of the LinkArchive entity.
+// If the LinkArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldViewConfig(ctx context.Context) (v *string, err error) {
+func (m *LinkArchiveMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViewConfig is only allowed on UpdateOne operations")
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViewConfig requires an ID field in the mutation")
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViewConfig: %w", err)
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
 	}
-	return oldValue.ViewConfig, nil
+	return oldValue.ArchivedAt, nil
 }
 
-// ClearViewConfig clears the value of the "view_config" field.
-func (m *FileMutation) ClearViewConfig() {
-	m.view_config = nil
-	m.clearedFields[file.FieldViewConfig] = struct{}{}
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *LinkArchiveMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[linkarchive.FieldArchivedAt] = struct{}{}
 }
 
-// ViewConfigCleared returns if the "view_config" field was cleared in this mutation.
-func (m *FileMutation) ViewConfigCleared() bool {
-	_, ok := m.clearedFields[file.FieldViewConfig]
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *LinkArchiveMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[linkarchive.FieldArchivedAt]
 	return ok
 }
 
-// ResetViewConfig resets all changes to the "view_config" field.
-func (m *FileMutation) ResetViewConfig() {
-	m.view_config = nil
-	delete(m.clearedFields, file.FieldViewConfig)
-}
-
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *FileMutation) ClearOwner() {
-	m.clearedowner = true
-	m.clearedFields[file.FieldOwnerID] = struct{}{}
-}
-
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *FileMutation) OwnerCleared() bool {
-	return m.clearedowner
-}
-
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) OwnerIDs() (ids []uint) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetOwner resets all changes to the "owner" edge.
-func (m *FileMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
-}
-
-// ClearParent clears the "parent" edge to the File entity.
-func (m *FileMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[file.FieldParentID] = struct{}{}
-}
-
-// ParentCleared reports if the "parent" edge to the File entity was cleared.
-func (m *FileMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
-}
-
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) ParentIDs() (ids []uint) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *LinkArchiveMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, linkarchive.FieldArchivedAt)
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *FileMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// SetAttempts sets the "attempts" field.
+func (m *LinkArchiveMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// AddChildIDs adds the "children" edge to the File entity by ids.
-func (m *FileMutation) AddChildIDs(ids ...uint) {
-	if m.children == nil {
-		m.children = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *LinkArchiveMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearChildren clears the "children" edge to the File entity.
-func (m *FileMutation) ClearChildren() {
-	m.clearedchildren = true
-}
-
-// ChildrenCleared reports if the "children" edge to the File entity was cleared.
-func (m *FileMutation) ChildrenCleared() bool {
-	return m.clearedchildren
-}
-
-// RemoveChildIDs removes the "children" edge to the File entity by IDs.
-func (m *FileMutation) RemoveChildIDs(ids ...uint) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+// OldAttempts returns the old "attempts" field's value of the LinkArchive entity.
+// If the LinkArchive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkArchiveMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedChildren returns the removed IDs of the "children" edge to the File entity.
-func (m *FileMutation) RemovedChildrenIDs() (ids []uint) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
-	return
-}
-
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *FileMutation) ChildrenIDs() (ids []uint) {
-	for id := range m.children {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return
+	return oldValue.Attempts, nil
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *FileMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
-}
-
-// ClearPrimaryEntity clears the "primary_entity" edge to the Entity entity.
-func (m *FileMutation) ClearPrimaryEntity() {
-	m.clearedprimary_entity = true
-	m.clearedFields[file.FieldPrimaryEntityID] = struct{}{}
-}
-
-// PrimaryEntityCleared reports if the "primary_entity" edge to the Entity entity was cleared.
-func (m *FileMutation) PrimaryEntityCleared() bool {
-	return m.PrimaryEntityIDCleared() || m.clearedprimary_entity
-}
-
-// PrimaryEntityIDs returns the "primary_entity" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PrimaryEntityID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) PrimaryEntityIDs() (ids []uint) {
-	if id := m.primary_entity; id != nil {
-		ids = append(ids, *id)
+// AddAttempts adds i to the "attempts" field.
+func (m *LinkArchiveMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
 	}
-	return
-}
-
-// ResetPrimaryEntity resets all changes to the "primary_entity" edge.
-func (m *FileMutation) ResetPrimaryEntity() {
-	m.primary_entity = nil
-	m.clearedprimary_entity = false
 }
 
-// AddVersionIDs adds the "versions" edge to the FileEntity entity by ids.
-func (m *FileMutation) AddVersionIDs(ids ...uint) {
-	if m.versions == nil {
-		m.versions = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.versions[ids[i]] = struct{}{}
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *LinkArchiveMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearVersions clears the "versions" edge to the FileEntity entity.
-func (m *FileMutation) ClearVersions() {
-	m.clearedversions = true
-}
-
-// VersionsCleared reports if the "versions" edge to the FileEntity entity was cleared.
-func (m *FileMutation) VersionsCleared() bool {
-	return m.clearedversions
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *LinkArchiveMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// RemoveVersionIDs removes the "versions" edge to the FileEntity entity by IDs.
-func (m *FileMutation) RemoveVersionIDs(ids ...uint) {
-	if m.removedversions == nil {
-		m.removedversions = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.versions, ids[i])
-		m.removedversions[ids[i]] = struct{}{}
-	}
+// SetLastAttempt sets the "last_attempt" field.
+func (m *LinkArchiveMutation) SetLastAttempt(t time.Time) {
+	m.last_attempt = &t
 }
-
-// RemovedVersions returns the removed IDs of the "versions" edge to the FileEntity entity.
-func (m *FileMutation) RemovedVersionsIDs() (ids []uint) {
-	for id := range m.removedversions {
-		ids = append(ids, id)
+
+// LastAttempt returns the value of the "last_attempt" field in the mutation.
+func (m *LinkArchiveMutation) LastAttempt() (r time.Time, exists bool) {
+	v := m.last_attempt
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// VersionsIDs returns the "versions" edge IDs in the mutation.
-func (m *FileMutation) VersionsIDs() (ids []uint) {
-	for id := range m.versions {
-		ids = append(ids, id)
+// OldLastAttempt returns the old "last_attempt" field's value of the LinkArchive entity.
+// If the LinkArchive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkArchiveMutation) OldLastAttempt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastAttempt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastAttempt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastAttempt: %w", err)
+	}
+	return oldValue.LastAttempt, nil
 }
 
-// ResetVersions resets all changes to the "versions" edge.
-func (m *FileMutation) ResetVersions() {
-	m.versions = nil
-	m.clearedversions = false
-	m.removedversions = nil
+// ClearLastAttempt clears the value of the "last_attempt" field.
+func (m *LinkArchiveMutation) ClearLastAttempt() {
+	m.last_attempt = nil
+	m.clearedFields[linkarchive.FieldLastAttempt] = struct{}{}
 }
 
-// SetDirectLinkID sets the "direct_link" edge to the DirectLink entity by id.
-func (m *FileMutation) SetDirectLinkID(id uint) {
-	m.direct_link = &id
+// LastAttemptCleared returns if the "last_attempt" field was cleared in this mutation.
+func (m *LinkArchiveMutation) LastAttemptCleared() bool {
+	_, ok := m.clearedFields[linkarchive.FieldLastAttempt]
+	return ok
 }
 
-// ClearDirectLink clears the "direct_link" edge to the DirectLink entity.
-func (m *FileMutation) ClearDirectLink() {
-	m.cleareddirect_link = true
+// ResetLastAttempt resets all changes to the "last_attempt" field.
+func (m *LinkArchiveMutation) ResetLastAttempt() {
+	m.last_attempt = nil
+	delete(m.clearedFields, linkarchive.FieldLastAttempt)
 }
 
-// DirectLinkCleared reports if the "direct_link" edge to the DirectLink entity was cleared.
-func (m *FileMutation) DirectLinkCleared() bool {
-	return m.cleareddirect_link
+// SetError sets the "error" field.
+func (m *LinkArchiveMutation) SetError(s string) {
+	m.error = &s
 }
 
-// DirectLinkID returns the "direct_link" edge ID in the mutation.
-func (m *FileMutation) DirectLinkID() (id uint, exists bool) {
-	if m.direct_link != nil {
-		return *m.direct_link, true
+// Error returns the value of the "error" field in the mutation.
+func (m *LinkArchiveMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// DirectLinkIDs returns the "direct_link" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DirectLinkID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) DirectLinkIDs() (ids []uint) {
-	if id := m.direct_link; id != nil {
-		ids = append(ids, *id)
+// OldError returns the old "error" field's value of the LinkArchive entity.
+// If the LinkArchive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkArchiveMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
 }
 
-// ResetDirectLink resets all changes to the "direct_link" edge.
-func (m *FileMutation) ResetDirectLink() {
-	m.direct_link = nil
-	m.cleareddirect_link = false
+// ClearError clears the value of the "error" field.
+func (m *LinkArchiveMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[linkarchive.FieldError] = struct{}{}
 }
 
-// AddMetadatumIDs adds the "metadata" edge to the Metadata entity by ids.
-func (m *FileMutation) AddMetadatumIDs(ids ...uint) {
-	if m.metadata == nil {
-		m.metadata = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.metadata[ids[i]] = struct{}{}
-	}
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *LinkArchiveMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[linkarchive.FieldError]
+	return ok
 }
 
-// ClearMetadata clears the "metadata" edge to the Metadata entity.
-func (m *FileMutation) ClearMetadata() {
-	m.clearedmetadata = true
+// ResetError resets all changes to the "error" field.
+func (m *LinkArchiveMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, linkarchive.FieldError)
 }
 
-// MetadataCleared reports if the "metadata" edge to the Metadata entity was cleared.
-func (m *FileMutation) MetadataCleared() bool {
-	return m.clearedmetadata
+// SetCreatedAt sets the "created_at" field.
+func (m *LinkArchiveMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveMetadatumIDs removes the "metadata" edge to the Metadata entity by IDs.
-func (m *FileMutation) RemoveMetadatumIDs(ids ...uint) {
-	if m.removedmetadata == nil {
-		m.removedmetadata = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.metadata, ids[i])
-		m.removedmetadata[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LinkArchiveMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedMetadata returns the removed IDs of the "metadata" edge to the Metadata entity.
-func (m *FileMutation) RemovedMetadataIDs() (ids []uint) {
-	for id := range m.removedmetadata {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the LinkArchive entity.
+// If the LinkArchive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkArchiveMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// MetadataIDs returns the "metadata" edge IDs in the mutation.
-func (m *FileMutation) MetadataIDs() (ids []uint) {
-	for id := range m.metadata {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetMetadata resets all changes to the "metadata" edge.
-func (m *FileMutation) ResetMetadata() {
-	m.metadata = nil
-	m.clearedmetadata = false
-	m.removedmetadata = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LinkArchiveMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the FileMutation builder.
-func (m *FileMutation) Where(ps ...predicate.File) {
+// Where appends a list predicates to the LinkArchiveMutation builder.
+func (m *LinkArchiveMutation) Where(ps ...predicate.LinkArchive) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the LinkArchiveMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.File, len(ps))
+func (m *LinkArchiveMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LinkArchive, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -13878,57 +18018,45 @@ func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileMutation) Op() Op {
+func (m *LinkArchiveMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileMutation) SetOp(op Op) {
+func (m *LinkArchiveMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (File).
-func (m *FileMutation) Type() string {
+// Type returns the node type of this mutation (LinkArchive).
+func (m *LinkArchiveMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.deleted_at != nil {
-		fields = append(fields, file.FieldDeletedAt)
-	}
-	if m.created_at != nil {
-		fields = append(fields, file.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, file.FieldUpdatedAt)
-	}
-	if m._type != nil {
-		fields = append(fields, file.FieldType)
-	}
-	if m.owner != nil {
-		fields = append(fields, file.FieldOwnerID)
+func (m *LinkArchiveMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.url != nil {
+		fields = append(fields, linkarchive.FieldURL)
 	}
-	if m.parent != nil {
-		fields = append(fields, file.FieldParentID)
+	if m.archived_url != nil {
+		fields = append(fields, linkarchive.FieldArchivedURL)
 	}
-	if m.name != nil {
-		fields = append(fields, file.FieldName)
+	if m.archived_at != nil {
+		fields = append(fields, linkarchive.FieldArchivedAt)
 	}
-	if m.size != nil {
-		fields = append(fields, file.FieldSize)
+	if m.attempts != nil {
+		fields = append(fields, linkarchive.FieldAttempts)
 	}
-	if m.primary_entity != nil {
-		fields = append(fields, file.FieldPrimaryEntityID)
+	if m.last_attempt != nil {
+		fields = append(fields, linkarchive.FieldLastAttempt)
 	}
-	if m.children_count != nil {
-		fields = append(fields, file.FieldChildrenCount)
+	if m.error != nil {
+		fields = append(fields, linkarchive.FieldError)
 	}
-	if m.view_config != nil {
-		fields = append(fields, file.FieldViewConfig)
+	if m.created_at != nil {
+		fields = append(fields, linkarchive.FieldCreatedAt)
 	}
 	return fields
 }
@@ -13936,30 +18064,22 @@ func (m *FileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileMutation) Field(name string) (ent.Value, bool) {
+func (m *LinkArchiveMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldDeletedAt:
-		return m.DeletedAt()
-	case file.FieldCreatedAt:
+	case linkarchive.FieldURL:
+		return m.URL()
+	case linkarchive.FieldArchivedURL:
+		return m.ArchivedURL()
+	case linkarchive.FieldArchivedAt:
+		return m.ArchivedAt()
+	case linkarchive.FieldAttempts:
+		return m.Attempts()
+	case linkarchive.FieldLastAttempt:
+		return m.LastAttempt()
+	case linkarchive.FieldError:
+		return m.Error()
+	case linkarchive.FieldCreatedAt:
 		return m.CreatedAt()
-	case file.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case file.FieldType:
-		return m.GetType()
-	case file.FieldOwnerID:
-		return m.OwnerID()
-	case file.FieldParentID:
-		return m.ParentID()
-	case file.FieldName:
-		return m.Name()
-	case file.FieldSize:
-		return m.Size()
-	case file.FieldPrimaryEntityID:
-		return m.PrimaryEntityID()
-	case file.FieldChildrenCount:
-		return m.ChildrenCount()
-	case file.FieldViewConfig:
-		return m.ViewConfig()
 	}
 	return nil, false
 }
@@ -13967,132 +18087,90 @@ func (m *FileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *LinkArchiveMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case file.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case file.FieldCreatedAt:
+	case linkarchive.FieldURL:
+		return m.OldURL(ctx)
+	case linkarchive.FieldArchivedURL:
+		return m.OldArchivedURL(ctx)
+	case linkarchive.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	case linkarchive.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case linkarchive.FieldLastAttempt:
+		return m.OldLastAttempt(ctx)
+	case linkarchive.FieldError:
+		return m.OldError(ctx)
+	case linkarchive.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case file.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case file.FieldType:
-		return m.OldType(ctx)
-	case file.FieldOwnerID:
-		return m.OldOwnerID(ctx)
-	case file.FieldParentID:
-		return m.OldParentID(ctx)
-	case file.FieldName:
-		return m.OldName(ctx)
-	case file.FieldSize:
-		return m.OldSize(ctx)
-	case file.FieldPrimaryEntityID:
-		return m.OldPrimaryEntityID(ctx)
-	case file.FieldChildrenCount:
-		return m.OldChildrenCount(ctx)
-	case file.FieldViewConfig:
-		return m.OldViewConfig(ctx)
 	}
-	return nil, fmt.Errorf("unknown File field %s", name)
+	return nil, fmt.Errorf("unknown LinkArchive field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) SetField(name string, value ent.Value) error {
+func (m *LinkArchiveMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case linkarchive.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetURL(v)
 		return nil
-	case file.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case linkarchive.FieldArchivedURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetArchivedURL(v)
 		return nil
-	case file.FieldUpdatedAt:
+	case linkarchive.FieldArchivedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetArchivedAt(v)
 		return nil
-	case file.FieldType:
+	case linkarchive.FieldAttempts:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
-		return nil
-	case file.FieldOwnerID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerID(v)
-		return nil
-	case file.FieldParentID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetParentID(v)
-		return nil
-	case file.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case file.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSize(v)
-		return nil
-	case file.FieldPrimaryEntityID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrimaryEntityID(v)
+		m.SetAttempts(v)
 		return nil
-	case file.FieldChildrenCount:
-		v, ok := value.(int64)
+	case linkarchive.FieldLastAttempt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChildrenCount(v)
+		m.SetLastAttempt(v)
 		return nil
-	case file.FieldViewConfig:
+	case linkarchive.FieldError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetViewConfig(v)
+		m.SetError(v)
+		return nil
+	case linkarchive.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown LinkArchive field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FileMutation) AddedFields() []string {
+func (m *LinkArchiveMutation) AddedFields() []string {
 	var fields []string
-	if m.add_type != nil {
-		fields = append(fields, file.FieldType)
-	}
-	if m.addsize != nil {
-		fields = append(fields, file.FieldSize)
-	}
-	if m.addchildren_count != nil {
-		fields = append(fields, file.FieldChildrenCount)
+	if m.addattempts != nil {
+		fields = append(fields, linkarchive.FieldAttempts)
 	}
 	return fields
 }
@@ -14100,14 +18178,10 @@ func (m *FileMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *LinkArchiveMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldType:
-		return m.AddedType()
-	case file.FieldSize:
-		return m.AddedSize()
-	case file.FieldChildrenCount:
-		return m.AddedChildrenCount()
+	case linkarchive.FieldAttempts:
+		return m.AddedAttempts()
 	}
 	return nil, false
 }
@@ -14115,866 +18189,941 @@ func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) AddField(name string, value ent.Value) error {
+func (m *LinkArchiveMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldType:
+	case linkarchive.FieldAttempts:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddType(v)
-		return nil
-	case file.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSize(v)
-		return nil
-	case file.FieldChildrenCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddChildrenCount(v)
+		m.AddAttempts(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File numeric field %s", name)
+	return fmt.Errorf("unknown LinkArchive numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileMutation) ClearedFields() []string {
+func (m *LinkArchiveMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(file.FieldDeletedAt) {
-		fields = append(fields, file.FieldDeletedAt)
+	if m.FieldCleared(linkarchive.FieldArchivedURL) {
+		fields = append(fields, linkarchive.FieldArchivedURL)
 	}
-	if m.FieldCleared(file.FieldParentID) {
-		fields = append(fields, file.FieldParentID)
+	if m.FieldCleared(linkarchive.FieldArchivedAt) {
+		fields = append(fields, linkarchive.FieldArchivedAt)
 	}
-	if m.FieldCleared(file.FieldPrimaryEntityID) {
-		fields = append(fields, file.FieldPrimaryEntityID)
+	if m.FieldCleared(linkarchive.FieldLastAttempt) {
+		fields = append(fields, linkarchive.FieldLastAttempt)
 	}
-	if m.FieldCleared(file.FieldViewConfig) {
-		fields = append(fields, file.FieldViewConfig)
+	if m.FieldCleared(linkarchive.FieldError) {
+		fields = append(fields, linkarchive.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FileMutation) FieldCleared(name string) bool {
+func (m *LinkArchiveMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileMutation) ClearField(name string) error {
+func (m *LinkArchiveMutation) ClearField(name string) error {
 	switch name {
-	case file.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case linkarchive.FieldArchivedURL:
+		m.ClearArchivedURL()
 		return nil
-	case file.FieldParentID:
-		m.ClearParentID()
+	case linkarchive.FieldArchivedAt:
+		m.ClearArchivedAt()
 		return nil
-	case file.FieldPrimaryEntityID:
-		m.ClearPrimaryEntityID()
+	case linkarchive.FieldLastAttempt:
+		m.ClearLastAttempt()
 		return nil
-	case file.FieldViewConfig:
-		m.ClearViewConfig()
+	case linkarchive.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown File nullable field %s", name)
+	return fmt.Errorf("unknown LinkArchive nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FileMutation) ResetField(name string) error {
+func (m *LinkArchiveMutation) ResetField(name string) error {
 	switch name {
-	case file.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case file.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case file.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case file.FieldType:
-		m.ResetType()
-		return nil
-	case file.FieldOwnerID:
-		m.ResetOwnerID()
+	case linkarchive.FieldURL:
+		m.ResetURL()
 		return nil
-	case file.FieldParentID:
-		m.ResetParentID()
+	case linkarchive.FieldArchivedURL:
+		m.ResetArchivedURL()
 		return nil
-	case file.FieldName:
-		m.ResetName()
+	case linkarchive.FieldArchivedAt:
+		m.ResetArchivedAt()
 		return nil
-	case file.FieldSize:
-		m.ResetSize()
+	case linkarchive.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case file.FieldPrimaryEntityID:
-		m.ResetPrimaryEntityID()
+	case linkarchive.FieldLastAttempt:
+		m.ResetLastAttempt()
 		return nil
-	case file.FieldChildrenCount:
-		m.ResetChildrenCount()
+	case linkarchive.FieldError:
+		m.ResetError()
 		return nil
-	case file.FieldViewConfig:
-		m.ResetViewConfig()
+	case linkarchive.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown LinkArchive field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.owner != nil {
-		edges = append(edges, file.EdgeOwner)
-	}
-	if m.parent != nil {
-		edges = append(edges, file.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, file.EdgeChildren)
-	}
-	if m.primary_entity != nil {
-		edges = append(edges, file.EdgePrimaryEntity)
-	}
-	if m.versions != nil {
-		edges = append(edges, file.EdgeVersions)
-	}
-	if m.direct_link != nil {
-		edges = append(edges, file.EdgeDirectLink)
-	}
-	if m.metadata != nil {
-		edges = append(edges, file.EdgeMetadata)
-	}
+func (m *LinkArchiveMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case file.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
-	case file.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case file.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case file.EdgePrimaryEntity:
-		if id := m.primary_entity; id != nil {
-			return []ent.Value{*id}
-		}
-	case file.EdgeVersions:
-		ids := make([]ent.Value, 0, len(m.versions))
-		for id := range m.versions {
-			ids = append(ids, id)
-		}
-		return ids
-	case file.EdgeDirectLink:
-		if id := m.direct_link; id != nil {
-			return []ent.Value{*id}
+func (m *LinkArchiveMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LinkArchiveMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LinkArchiveMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LinkArchiveMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LinkArchiveMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LinkArchiveMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LinkArchive unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LinkArchiveMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LinkArchive edge %s", name)
+}
+
+// LinkCategoryMutation represents an operation that mutates the LinkCategory nodes in the graph.
+type LinkCategoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	description   *string
+	style         *linkcategory.Style
+	clearedFields map[string]struct{}
+	links         map[int]struct{}
+	removedlinks  map[int]struct{}
+	clearedlinks  bool
+	done          bool
+	oldValue      func(context.Context) (*LinkCategory, error)
+	predicates    []predicate.LinkCategory
+}
+
+var _ ent.Mutation = (*LinkCategoryMutation)(nil)
+
+// linkcategoryOption allows management of the mutation configuration using functional options.
+type linkcategoryOption func(*LinkCategoryMutation)
+
+// newLinkCategoryMutation creates new mutation for the LinkCategory entity.
+func newLinkCategoryMutation(c config, op Op, opts ...linkcategoryOption) *LinkCategoryMutation {
+	m := &LinkCategoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLinkCategory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLinkCategoryID sets the ID field of the mutation.
+func withLinkCategoryID(id int) linkcategoryOption {
+	return func(m *LinkCategoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LinkCategory
+		)
+		m.oldValue = func(ctx context.Context) (*LinkCategory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LinkCategory.Get(ctx, id)
+				}
+			})
+			return value, err
 		}
-	case file.EdgeMetadata:
-		ids := make([]ent.Value, 0, len(m.metadata))
-		for id := range m.metadata {
-			ids = append(ids, id)
+		m.id = &id
+	}
+}
+
+// withLinkCategory sets the old LinkCategory of the mutation.
+func withLinkCategory(node *LinkCategory) linkcategoryOption {
+	return func(m *LinkCategoryMutation) {
+		m.oldValue = func(context.Context) (*LinkCategory, error) {
+			return node, nil
 		}
-		return ids
+		m.id = &node.ID
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.removedchildren != nil {
-		edges = append(edges, file.EdgeChildren)
-	}
-	if m.removedversions != nil {
-		edges = append(edges, file.EdgeVersions)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LinkCategoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LinkCategoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	if m.removedmetadata != nil {
-		edges = append(edges, file.EdgeMetadata)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LinkCategoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return edges
+	return *m.id, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *FileMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case file.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	case file.EdgeVersions:
-		ids := make([]ent.Value, 0, len(m.removedversions))
-		for id := range m.removedversions {
-			ids = append(ids, id)
-		}
-		return ids
-	case file.EdgeMetadata:
-		ids := make([]ent.Value, 0, len(m.removedmetadata))
-		for id := range m.removedmetadata {
-			ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LinkCategoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
 		}
-		return ids
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LinkCategory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.clearedowner {
-		edges = append(edges, file.EdgeOwner)
+// SetName sets the "name" field.
+func (m *LinkCategoryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LinkCategoryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	if m.clearedparent {
-		edges = append(edges, file.EdgeParent)
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the LinkCategory entity.
+// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkCategoryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	if m.clearedchildren {
-		edges = append(edges, file.EdgeChildren)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	if m.clearedprimary_entity {
-		edges = append(edges, file.EdgePrimaryEntity)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	if m.clearedversions {
-		edges = append(edges, file.EdgeVersions)
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LinkCategoryMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *LinkCategoryMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *LinkCategoryMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
-	if m.cleareddirect_link {
-		edges = append(edges, file.EdgeDirectLink)
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the LinkCategory entity.
+// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkCategoryMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	if m.clearedmetadata {
-		edges = append(edges, file.EdgeMetadata)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *FileMutation) EdgeCleared(name string) bool {
-	switch name {
-	case file.EdgeOwner:
-		return m.clearedowner
-	case file.EdgeParent:
-		return m.clearedparent
-	case file.EdgeChildren:
-		return m.clearedchildren
-	case file.EdgePrimaryEntity:
-		return m.clearedprimary_entity
-	case file.EdgeVersions:
-		return m.clearedversions
-	case file.EdgeDirectLink:
-		return m.cleareddirect_link
-	case file.EdgeMetadata:
-		return m.clearedmetadata
+// ClearDescription clears the value of the "description" field.
+func (m *LinkCategoryMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[linkcategory.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *LinkCategoryMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[linkcategory.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *LinkCategoryMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, linkcategory.FieldDescription)
+}
+
+// SetStyle sets the "style" field.
+func (m *LinkCategoryMutation) SetStyle(l linkcategory.Style) {
+	m.style = &l
+}
+
+// Style returns the value of the "style" field in the mutation.
+func (m *LinkCategoryMutation) Style() (r linkcategory.Style, exists bool) {
+	v := m.style
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *FileMutation) ClearEdge(name string) error {
-	switch name {
-	case file.EdgeOwner:
-		m.ClearOwner()
-		return nil
-	case file.EdgeParent:
-		m.ClearParent()
-		return nil
-	case file.EdgePrimaryEntity:
-		m.ClearPrimaryEntity()
-		return nil
-	case file.EdgeDirectLink:
-		m.ClearDirectLink()
-		return nil
+// OldStyle returns the old "style" field's value of the LinkCategory entity.
+// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkCategoryMutation) OldStyle(ctx context.Context) (v linkcategory.Style, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStyle is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown File unique edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStyle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStyle: %w", err)
+	}
+	return oldValue.Style, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *FileMutation) ResetEdge(name string) error {
-	switch name {
-	case file.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case file.EdgeParent:
-		m.ResetParent()
-		return nil
-	case file.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case file.EdgePrimaryEntity:
-		m.ResetPrimaryEntity()
-		return nil
-	case file.EdgeVersions:
-		m.ResetVersions()
-		return nil
-	case file.EdgeDirectLink:
-		m.ResetDirectLink()
-		return nil
-	case file.EdgeMetadata:
-		m.ResetMetadata()
-		return nil
+// ResetStyle resets all changes to the "style" field.
+func (m *LinkCategoryMutation) ResetStyle() {
+	m.style = nil
+}
+
+// AddLinkIDs adds the "links" edge to the Link entity by ids.
+func (m *LinkCategoryMutation) AddLinkIDs(ids ...int) {
+	if m.links == nil {
+		m.links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.links[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown File edge %s", name)
 }
 
-// FileEntityMutation represents an operation that mutates the FileEntity nodes in the graph.
-type FileEntityMutation struct {
-	config
-	op                     Op
-	typ                    string
-	id                     *uint
-	deleted_at             *time.Time
-	created_at             *time.Time
-	updated_at             *time.Time
-	version                *string
-	is_current             *bool
-	uploaded_by_user_id    *uint64
-	adduploaded_by_user_id *int64
-	clearedFields          map[string]struct{}
-	file                   *uint
-	clearedfile            bool
-	entity                 *uint
-	clearedentity          bool
-	done                   bool
-	oldValue               func(context.Context) (*FileEntity, error)
-	predicates             []predicate.FileEntity
+// ClearLinks clears the "links" edge to the Link entity.
+func (m *LinkCategoryMutation) ClearLinks() {
+	m.clearedlinks = true
 }
 
-var _ ent.Mutation = (*FileEntityMutation)(nil)
-
-// fileentityOption allows management of the mutation configuration using functional options.
-type fileentityOption func(*FileEntityMutation)
+// LinksCleared reports if the "links" edge to the Link entity was cleared.
+func (m *LinkCategoryMutation) LinksCleared() bool {
+	return m.clearedlinks
+}
 
-// newFileEntityMutation creates new mutation for the FileEntity entity.
-func newFileEntityMutation(c config, op Op, opts ...fileentityOption) *FileEntityMutation {
-	m := &FileEntityMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeFileEntity,
-		clearedFields: make(map[string]struct{}),
+// RemoveLinkIDs removes the "links" edge to the Link entity by IDs.
+func (m *LinkCategoryMutation) RemoveLinkIDs(ids ...int) {
+	if m.removedlinks == nil {
+		m.removedlinks = make(map[int]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.links, ids[i])
+		m.removedlinks[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withFileEntityID sets the ID field of the mutation.
-func withFileEntityID(id uint) fileentityOption {
-	return func(m *FileEntityMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *FileEntity
-		)
-		m.oldValue = func(ctx context.Context) (*FileEntity, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().FileEntity.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedLinks returns the removed IDs of the "links" edge to the Link entity.
+func (m *LinkCategoryMutation) RemovedLinksIDs() (ids []int) {
+	for id := range m.removedlinks {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withFileEntity sets the old FileEntity of the mutation.
-func withFileEntity(node *FileEntity) fileentityOption {
-	return func(m *FileEntityMutation) {
-		m.oldValue = func(context.Context) (*FileEntity, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// LinksIDs returns the "links" edge IDs in the mutation.
+func (m *LinkCategoryMutation) LinksIDs() (ids []int) {
+	for id := range m.links {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileEntityMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetLinks resets all changes to the "links" edge.
+func (m *LinkCategoryMutation) ResetLinks() {
+	m.links = nil
+	m.clearedlinks = false
+	m.removedlinks = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m FileEntityMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// Where appends a list predicates to the LinkCategoryMutation builder.
+func (m *LinkCategoryMutation) Where(ps ...predicate.LinkCategory) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of FileEntity entities.
-func (m *FileEntityMutation) SetID(id uint) {
-	m.id = &id
+// WhereP appends storage-level predicates to the LinkCategoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LinkCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LinkCategory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *FileEntityMutation) ID() (id uint, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// Op returns the operation name.
+func (m *LinkCategoryMutation) Op() Op {
+	return m.op
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *FileEntityMutation) IDs(ctx context.Context) ([]uint, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FileEntity.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// SetOp allows setting the mutation operation.
+func (m *LinkCategoryMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *FileEntityMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// Type returns the node type of this mutation (LinkCategory).
+func (m *LinkCategoryMutation) Type() string {
+	return m.typ
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *FileEntityMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LinkCategoryMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, linkcategory.FieldName)
 	}
-	return *v, true
+	if m.description != nil {
+		fields = append(fields, linkcategory.FieldDescription)
+	}
+	if m.style != nil {
+		fields = append(fields, linkcategory.FieldStyle)
+	}
+	return fields
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the FileEntity entity.
-// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileEntityMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LinkCategoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case linkcategory.FieldName:
+		return m.Name()
+	case linkcategory.FieldDescription:
+		return m.Description()
+	case linkcategory.FieldStyle:
+		return m.Style()
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LinkCategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case linkcategory.FieldName:
+		return m.OldName(ctx)
+	case linkcategory.FieldDescription:
+		return m.OldDescription(ctx)
+	case linkcategory.FieldStyle:
+		return m.OldStyle(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	return nil, fmt.Errorf("unknown LinkCategory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkCategoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case linkcategory.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case linkcategory.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case linkcategory.FieldStyle:
+		v, ok := value.(linkcategory.Style)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStyle(v)
+		return nil
 	}
-	return oldValue.DeletedAt, nil
+	return fmt.Errorf("unknown LinkCategory field %s", name)
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *FileEntityMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[fileentity.FieldDeletedAt] = struct{}{}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LinkCategoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LinkCategoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *FileEntityMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[fileentity.FieldDeletedAt]
-	return ok
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkCategoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LinkCategory numeric field %s", name)
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *FileEntityMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, fileentity.FieldDeletedAt)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LinkCategoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(linkcategory.FieldDescription) {
+		fields = append(fields, linkcategory.FieldDescription)
+	}
+	return fields
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FileEntityMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LinkCategoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FileEntityMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LinkCategoryMutation) ClearField(name string) error {
+	switch name {
+	case linkcategory.FieldDescription:
+		m.ClearDescription()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown LinkCategory nullable field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the FileEntity entity.
-// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileEntityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LinkCategoryMutation) ResetField(name string) error {
+	switch name {
+	case linkcategory.FieldName:
+		m.ResetName()
+		return nil
+	case linkcategory.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case linkcategory.FieldStyle:
+		m.ResetStyle()
+		return nil
 	}
-	return oldValue.CreatedAt, nil
+	return fmt.Errorf("unknown LinkCategory field %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FileEntityMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LinkCategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.links != nil {
+		edges = append(edges, linkcategory.EdgeLinks)
+	}
+	return edges
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *FileEntityMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LinkCategoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case linkcategory.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.links))
+		for id := range m.links {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FileEntityMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LinkCategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedlinks != nil {
+		edges = append(edges, linkcategory.EdgeLinks)
 	}
-	return *v, true
+	return edges
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the FileEntity entity.
-// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileEntityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LinkCategoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case linkcategory.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.removedlinks))
+		for id := range m.removedlinks {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.UpdatedAt, nil
+	return nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FileEntityMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LinkCategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlinks {
+		edges = append(edges, linkcategory.EdgeLinks)
+	}
+	return edges
 }
 
-// SetFileID sets the "file_id" field.
-func (m *FileEntityMutation) SetFileID(u uint) {
-	m.file = &u
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LinkCategoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case linkcategory.EdgeLinks:
+		return m.clearedlinks
+	}
+	return false
 }
 
-// FileID returns the value of the "file_id" field in the mutation.
-func (m *FileEntityMutation) FileID() (r uint, exists bool) {
-	v := m.file
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LinkCategoryMutation) ClearEdge(name string) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown LinkCategory unique edge %s", name)
 }
 
-// OldFileID returns the old "file_id" field's value of the FileEntity entity.
-// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileEntityMutation) OldFileID(ctx context.Context) (v uint, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LinkCategoryMutation) ResetEdge(name string) error {
+	switch name {
+	case linkcategory.EdgeLinks:
+		m.ResetLinks()
+		return nil
 	}
-	return oldValue.FileID, nil
+	return fmt.Errorf("unknown LinkCategory edge %s", name)
 }
 
-// ResetFileID resets all changes to the "file_id" field.
-func (m *FileEntityMutation) ResetFileID() {
-	m.file = nil
+// LinkTagMutation represents an operation that mutates the LinkTag nodes in the graph.
+type LinkTagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	color         *string
+	clearedFields map[string]struct{}
+	links         map[int]struct{}
+	removedlinks  map[int]struct{}
+	clearedlinks  bool
+	done          bool
+	oldValue      func(context.Context) (*LinkTag, error)
+	predicates    []predicate.LinkTag
 }
 
-// SetEntityID sets the "entity_id" field.
-func (m *FileEntityMutation) SetEntityID(u uint) {
-	m.entity = &u
-}
+var _ ent.Mutation = (*LinkTagMutation)(nil)
 
-// EntityID returns the value of the "entity_id" field in the mutation.
-func (m *FileEntityMutation) EntityID() (r uint, exists bool) {
-	v := m.entity
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// linktagOption allows management of the mutation configuration using functional options.
+type linktagOption func(*LinkTagMutation)
 
-// OldEntityID returns the old "entity_id" field's value of the FileEntity entity.
-// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileEntityMutation) OldEntityID(ctx context.Context) (v uint, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
+// newLinkTagMutation creates new mutation for the LinkTag entity.
+func newLinkTagMutation(c config, op Op, opts ...linktagOption) *LinkTagMutation {
+	m := &LinkTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLinkTag,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	return m
+}
+
+// withLinkTagID sets the ID field of the mutation.
+func withLinkTagID(id int) linktagOption {
+	return func(m *LinkTagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LinkTag
+		)
+		m.oldValue = func(ctx context.Context) (*LinkTag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LinkTag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.EntityID, nil
 }
 
-// ResetEntityID resets all changes to the "entity_id" field.
-func (m *FileEntityMutation) ResetEntityID() {
-	m.entity = nil
+// withLinkTag sets the old LinkTag of the mutation.
+func withLinkTag(node *LinkTag) linktagOption {
+	return func(m *LinkTagMutation) {
+		m.oldValue = func(context.Context) (*LinkTag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetVersion sets the "version" field.
-func (m *FileEntityMutation) SetVersion(s string) {
-	m.version = &s
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LinkTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *FileEntityMutation) Version() (r string, exists bool) {
-	v := m.version
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LinkTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldVersion returns the old "version" field's value of the FileEntity entity.
-// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileEntityMutation) OldVersion(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LinkTagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.Version, nil
-}
-
-// ClearVersion clears the value of the "version" field.
-func (m *FileEntityMutation) ClearVersion() {
-	m.version = nil
-	m.clearedFields[fileentity.FieldVersion] = struct{}{}
-}
-
-// VersionCleared returns if the "version" field was cleared in this mutation.
-func (m *FileEntityMutation) VersionCleared() bool {
-	_, ok := m.clearedFields[fileentity.FieldVersion]
-	return ok
+	return *m.id, true
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *FileEntityMutation) ResetVersion() {
-	m.version = nil
-	delete(m.clearedFields, fileentity.FieldVersion)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LinkTagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LinkTag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetIsCurrent sets the "is_current" field.
-func (m *FileEntityMutation) SetIsCurrent(b bool) {
-	m.is_current = &b
+// SetName sets the "name" field.
+func (m *LinkTagMutation) SetName(s string) {
+	m.name = &s
 }
 
-// IsCurrent returns the value of the "is_current" field in the mutation.
-func (m *FileEntityMutation) IsCurrent() (r bool, exists bool) {
-	v := m.is_current
+// Name returns the value of the "name" field in the mutation.
+func (m *LinkTagMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsCurrent returns the old "is_current" field's value of the FileEntity entity.
-// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the LinkTag entity.
+// If the LinkTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileEntityMutation) OldIsCurrent(ctx context.Context) (v bool, err error) {
+func (m *LinkTagMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsCurrent is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsCurrent requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsCurrent: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.IsCurrent, nil
+	return oldValue.Name, nil
 }
 
-// ResetIsCurrent resets all changes to the "is_current" field.
-func (m *FileEntityMutation) ResetIsCurrent() {
-	m.is_current = nil
+// ResetName resets all changes to the "name" field.
+func (m *LinkTagMutation) ResetName() {
+	m.name = nil
 }
 
-// SetUploadedByUserID sets the "uploaded_by_user_id" field.
-func (m *FileEntityMutation) SetUploadedByUserID(u uint64) {
-	m.uploaded_by_user_id = &u
-	m.adduploaded_by_user_id = nil
+// SetColor sets the "color" field.
+func (m *LinkTagMutation) SetColor(s string) {
+	m.color = &s
 }
 
-// UploadedByUserID returns the value of the "uploaded_by_user_id" field in the mutation.
-func (m *FileEntityMutation) UploadedByUserID() (r uint64, exists bool) {
-	v := m.uploaded_by_user_id
+// Color returns the value of the "color" field in the mutation.
+func (m *LinkTagMutation) Color() (r string, exists bool) {
+	v := m.color
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUploadedByUserID returns the old "uploaded_by_user_id" field's value of the FileEntity entity.
-// If the FileEntity object wasn't provided to the builder, the object is fetched from the database.
+// OldColor returns the old "color" field's value of the LinkTag entity.
+// If the LinkTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileEntityMutation) OldUploadedByUserID(ctx context.Context) (v *uint64, err error) {
+func (m *LinkTagMutation) OldColor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploadedByUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploadedByUserID requires an ID field in the mutation")
+		return v, errors.New("OldColor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploadedByUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
 	}
-	return oldValue.UploadedByUserID, nil
+	return oldValue.Color, nil
 }
 
-// AddUploadedByUserID adds u to the "uploaded_by_user_id" field.
-func (m *FileEntityMutation) AddUploadedByUserID(u int64) {
-	if m.adduploaded_by_user_id != nil {
-		*m.adduploaded_by_user_id += u
-	} else {
-		m.adduploaded_by_user_id = &u
-	}
+// ResetColor resets all changes to the "color" field.
+func (m *LinkTagMutation) ResetColor() {
+	m.color = nil
 }
 
-// AddedUploadedByUserID returns the value that was added to the "uploaded_by_user_id" field in this mutation.
-func (m *FileEntityMutation) AddedUploadedByUserID() (r int64, exists bool) {
-	v := m.adduploaded_by_user_id
-	if v == nil {
-		return
+// AddLinkIDs adds the "links" edge to the Link entity by ids.
+func (m *LinkTagMutation) AddLinkIDs(ids ...int) {
+	if m.links == nil {
+		m.links = make(map[int]struct{})
 	}
-	return *v, true
-}
-
-// ClearUploadedByUserID clears the value of the "uploaded_by_user_id" field.
-func (m *FileEntityMutation) ClearUploadedByUserID() {
-	m.uploaded_by_user_id = nil
-	m.adduploaded_by_user_id = nil
-	m.clearedFields[fileentity.FieldUploadedByUserID] = struct{}{}
-}
-
-// UploadedByUserIDCleared returns if the "uploaded_by_user_id" field was cleared in this mutation.
-func (m *FileEntityMutation) UploadedByUserIDCleared() bool {
-	_, ok := m.clearedFields[fileentity.FieldUploadedByUserID]
-	return ok
-}
-
-// ResetUploadedByUserID resets all changes to the "uploaded_by_user_id" field.
-func (m *FileEntityMutation) ResetUploadedByUserID() {
-	m.uploaded_by_user_id = nil
-	m.adduploaded_by_user_id = nil
-	delete(m.clearedFields, fileentity.FieldUploadedByUserID)
-}
-
-// ClearFile clears the "file" edge to the File entity.
-func (m *FileEntityMutation) ClearFile() {
-	m.clearedfile = true
-	m.clearedFields[fileentity.FieldFileID] = struct{}{}
-}
-
-// FileCleared reports if the "file" edge to the File entity was cleared.
-func (m *FileEntityMutation) FileCleared() bool {
-	return m.clearedfile
-}
-
-// FileIDs returns the "file" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FileID instead. It exists only for internal usage by the builders.
-func (m *FileEntityMutation) FileIDs() (ids []uint) {
-	if id := m.file; id != nil {
-		ids = append(ids, *id)
+	for i := range ids {
+		m.links[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// ResetFile resets all changes to the "file" edge.
-func (m *FileEntityMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
+// ClearLinks clears the "links" edge to the Link entity.
+func (m *LinkTagMutation) ClearLinks() {
+	m.clearedlinks = true
 }
 
-// ClearEntity clears the "entity" edge to the Entity entity.
-func (m *FileEntityMutation) ClearEntity() {
-	m.clearedentity = true
-	m.clearedFields[fileentity.FieldEntityID] = struct{}{}
+// LinksCleared reports if the "links" edge to the Link entity was cleared.
+func (m *LinkTagMutation) LinksCleared() bool {
+	return m.clearedlinks
 }
 
-// EntityCleared reports if the "entity" edge to the Entity entity was cleared.
-func (m *FileEntityMutation) EntityCleared() bool {
-	return m.clearedentity
+// RemoveLinkIDs removes the "links" edge to the Link entity by IDs.
+func (m *LinkTagMutation) RemoveLinkIDs(ids ...int) {
+	if m.removedlinks == nil {
+		m.removedlinks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.links, ids[i])
+		m.removedlinks[ids[i]] = struct{}{}
+	}
 }
 
-// EntityIDs returns the "entity" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EntityID instead. It exists only for internal usage by the builders.
-func (m *FileEntityMutation) EntityIDs() (ids []uint) {
-	if id := m.entity; id != nil {
-		ids = append(ids, *id)
+// RemovedLinks returns the removed IDs of the "links" edge to the Link entity.
+func (m *LinkTagMutation) RemovedLinksIDs() (ids []int) {
+	for id := range m.removedlinks {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetEntity resets all changes to the "entity" edge.
-func (m *FileEntityMutation) ResetEntity() {
-	m.entity = nil
-	m.clearedentity = false
+// LinksIDs returns the "links" edge IDs in the mutation.
+func (m *LinkTagMutation) LinksIDs() (ids []int) {
+	for id := range m.links {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the FileEntityMutation builder.
-func (m *FileEntityMutation) Where(ps ...predicate.FileEntity) {
+// ResetLinks resets all changes to the "links" edge.
+func (m *LinkTagMutation) ResetLinks() {
+	m.links = nil
+	m.clearedlinks = false
+	m.removedlinks = nil
+}
+
+// Where appends a list predicates to the LinkTagMutation builder.
+func (m *LinkTagMutation) Where(ps ...predicate.LinkTag) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileEntityMutation builder. Using this method,
+// WhereP appends storage-level predicates to the LinkTagMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileEntityMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FileEntity, len(ps))
+func (m *LinkTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LinkTag, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -14982,48 +19131,30 @@ func (m *FileEntityMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileEntityMutation) Op() Op {
+func (m *LinkTagMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileEntityMutation) SetOp(op Op) {
+func (m *LinkTagMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FileEntity).
-func (m *FileEntityMutation) Type() string {
+// Type returns the node type of this mutation (LinkTag).
+func (m *LinkTagMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileEntityMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.deleted_at != nil {
-		fields = append(fields, fileentity.FieldDeletedAt)
-	}
-	if m.created_at != nil {
-		fields = append(fields, fileentity.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, fileentity.FieldUpdatedAt)
-	}
-	if m.file != nil {
-		fields = append(fields, fileentity.FieldFileID)
-	}
-	if m.entity != nil {
-		fields = append(fields, fileentity.FieldEntityID)
-	}
-	if m.version != nil {
-		fields = append(fields, fileentity.FieldVersion)
-	}
-	if m.is_current != nil {
-		fields = append(fields, fileentity.FieldIsCurrent)
+func (m *LinkTagMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, linktag.FieldName)
 	}
-	if m.uploaded_by_user_id != nil {
-		fields = append(fields, fileentity.FieldUploadedByUserID)
+	if m.color != nil {
+		fields = append(fields, linktag.FieldColor)
 	}
 	return fields
 }
@@ -15031,24 +19162,12 @@ func (m *FileEntityMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileEntityMutation) Field(name string) (ent.Value, bool) {
+func (m *LinkTagMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case fileentity.FieldDeletedAt:
-		return m.DeletedAt()
-	case fileentity.FieldCreatedAt:
-		return m.CreatedAt()
-	case fileentity.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case fileentity.FieldFileID:
-		return m.FileID()
-	case fileentity.FieldEntityID:
-		return m.EntityID()
-	case fileentity.FieldVersion:
-		return m.Version()
-	case fileentity.FieldIsCurrent:
-		return m.IsCurrent()
-	case fileentity.FieldUploadedByUserID:
-		return m.UploadedByUserID()
+	case linktag.FieldName:
+		return m.Name()
+	case linktag.FieldColor:
+		return m.Color()
 	}
 	return nil, false
 }
@@ -15056,335 +19175,208 @@ func (m *FileEntityMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileEntityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *LinkTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case fileentity.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case fileentity.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case fileentity.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case fileentity.FieldFileID:
-		return m.OldFileID(ctx)
-	case fileentity.FieldEntityID:
-		return m.OldEntityID(ctx)
-	case fileentity.FieldVersion:
-		return m.OldVersion(ctx)
-	case fileentity.FieldIsCurrent:
-		return m.OldIsCurrent(ctx)
-	case fileentity.FieldUploadedByUserID:
-		return m.OldUploadedByUserID(ctx)
+	case linktag.FieldName:
+		return m.OldName(ctx)
+	case linktag.FieldColor:
+		return m.OldColor(ctx)
 	}
-	return nil, fmt.Errorf("unknown FileEntity field %s", name)
+	return nil, fmt.Errorf("unknown LinkTag field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileEntityMutation) SetField(name string, value ent.Value) error {
+func (m *LinkTagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case fileentity.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case fileentity.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case fileentity.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case fileentity.FieldFileID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFileID(v)
-		return nil
-	case fileentity.FieldEntityID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEntityID(v)
-		return nil
-	case fileentity.FieldVersion:
+	case linktag.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
-		return nil
-	case fileentity.FieldIsCurrent:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsCurrent(v)
+		m.SetName(v)
 		return nil
-	case fileentity.FieldUploadedByUserID:
-		v, ok := value.(uint64)
+	case linktag.FieldColor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUploadedByUserID(v)
+		m.SetColor(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FileEntity field %s", name)
+	return fmt.Errorf("unknown LinkTag field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FileEntityMutation) AddedFields() []string {
-	var fields []string
-	if m.adduploaded_by_user_id != nil {
-		fields = append(fields, fileentity.FieldUploadedByUserID)
-	}
-	return fields
+func (m *LinkTagMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileEntityMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case fileentity.FieldUploadedByUserID:
-		return m.AddedUploadedByUserID()
-	}
+func (m *LinkTagMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileEntityMutation) AddField(name string, value ent.Value) error {
+func (m *LinkTagMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case fileentity.FieldUploadedByUserID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUploadedByUserID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown FileEntity numeric field %s", name)
+	return fmt.Errorf("unknown LinkTag numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileEntityMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(fileentity.FieldDeletedAt) {
-		fields = append(fields, fileentity.FieldDeletedAt)
-	}
-	if m.FieldCleared(fileentity.FieldVersion) {
-		fields = append(fields, fileentity.FieldVersion)
-	}
-	if m.FieldCleared(fileentity.FieldUploadedByUserID) {
-		fields = append(fields, fileentity.FieldUploadedByUserID)
-	}
-	return fields
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *FileEntityMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *FileEntityMutation) ClearField(name string) error {
-	switch name {
-	case fileentity.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case fileentity.FieldVersion:
-		m.ClearVersion()
-		return nil
-	case fileentity.FieldUploadedByUserID:
-		m.ClearUploadedByUserID()
-		return nil
-	}
-	return fmt.Errorf("unknown FileEntity nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *FileEntityMutation) ResetField(name string) error {
-	switch name {
-	case fileentity.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case fileentity.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case fileentity.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case fileentity.FieldFileID:
-		m.ResetFileID()
-		return nil
-	case fileentity.FieldEntityID:
-		m.ResetEntityID()
-		return nil
-	case fileentity.FieldVersion:
-		m.ResetVersion()
-		return nil
-	case fileentity.FieldIsCurrent:
-		m.ResetIsCurrent()
+func (m *LinkTagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LinkTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LinkTagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LinkTag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LinkTagMutation) ResetField(name string) error {
+	switch name {
+	case linktag.FieldName:
+		m.ResetName()
 		return nil
-	case fileentity.FieldUploadedByUserID:
-		m.ResetUploadedByUserID()
+	case linktag.FieldColor:
+		m.ResetColor()
 		return nil
 	}
-	return fmt.Errorf("unknown FileEntity field %s", name)
+	return fmt.Errorf("unknown LinkTag field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileEntityMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.file != nil {
-		edges = append(edges, fileentity.EdgeFile)
-	}
-	if m.entity != nil {
-		edges = append(edges, fileentity.EdgeEntity)
+func (m *LinkTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.links != nil {
+		edges = append(edges, linktag.EdgeLinks)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileEntityMutation) AddedIDs(name string) []ent.Value {
+func (m *LinkTagMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case fileentity.EdgeFile:
-		if id := m.file; id != nil {
-			return []ent.Value{*id}
-		}
-	case fileentity.EdgeEntity:
-		if id := m.entity; id != nil {
-			return []ent.Value{*id}
+	case linktag.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.links))
+		for id := range m.links {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileEntityMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *LinkTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedlinks != nil {
+		edges = append(edges, linktag.EdgeLinks)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FileEntityMutation) RemovedIDs(name string) []ent.Value {
+func (m *LinkTagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case linktag.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.removedlinks))
+		for id := range m.removedlinks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileEntityMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedfile {
-		edges = append(edges, fileentity.EdgeFile)
-	}
-	if m.clearedentity {
-		edges = append(edges, fileentity.EdgeEntity)
+func (m *LinkTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlinks {
+		edges = append(edges, linktag.EdgeLinks)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FileEntityMutation) EdgeCleared(name string) bool {
+func (m *LinkTagMutation) EdgeCleared(name string) bool {
 	switch name {
-	case fileentity.EdgeFile:
-		return m.clearedfile
-	case fileentity.EdgeEntity:
-		return m.clearedentity
+	case linktag.EdgeLinks:
+		return m.clearedlinks
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FileEntityMutation) ClearEdge(name string) error {
+func (m *LinkTagMutation) ClearEdge(name string) error {
 	switch name {
-	case fileentity.EdgeFile:
-		m.ClearFile()
-		return nil
-	case fileentity.EdgeEntity:
-		m.ClearEntity()
-		return nil
 	}
-	return fmt.Errorf("unknown FileEntity unique edge %s", name)
+	return fmt.Errorf("unknown LinkTag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FileEntityMutation) ResetEdge(name string) error {
+func (m *LinkTagMutation) ResetEdge(name string) error {
 	switch name {
-	case fileentity.EdgeFile:
-		m.ResetFile()
-		return nil
-	case fileentity.EdgeEntity:
-		m.ResetEntity()
+	case linktag.EdgeLinks:
+		m.ResetLinks()
 		return nil
 	}
-	return fmt.Errorf("unknown FileEntity edge %s", name)
+	return fmt.Errorf("unknown LinkTag edge %s", name)
 }
 
-// LinkMutation represents an operation that mutates the Link nodes in the graph.
-type LinkMutation struct {
+// LoginDeviceMutation represents an operation that mutates the LoginDevice nodes in the graph.
+type LoginDeviceMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	name              *string
-	url               *string
-	logo              *string
-	description       *string
-	status            *link.Status
-	siteshot          *string
-	email             *string
-	_type             *link.Type
-	original_url      *string
-	update_reason     *string
-	sort_order        *int
-	addsort_order     *int
-	skip_health_check *bool
-	clearedFields     map[string]struct{}
-	category          *int
-	clearedcategory   bool
-	tags              map[int]struct{}
-	removedtags       map[int]struct{}
-	clearedtags       bool
-	done              bool
-	oldValue          func(context.Context) (*Link, error)
-	predicates        []predicate.Link
+	op            Op
+	typ           string
+	id            *uint
+	created_at    *time.Time
+	fingerprint   *string
+	device        *string
+	location      *string
+	last_seen     *time.Time
+	clearedFields map[string]struct{}
+	user          *uint
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*LoginDevice, error)
+	predicates    []predicate.LoginDevice
 }
 
-var _ ent.Mutation = (*LinkMutation)(nil)
+var _ ent.Mutation = (*LoginDeviceMutation)(nil)
 
-// linkOption allows management of the mutation configuration using functional options.
-type linkOption func(*LinkMutation)
+// logindeviceOption allows management of the mutation configuration using functional options.
+type logindeviceOption func(*LoginDeviceMutation)
 
-// newLinkMutation creates new mutation for the Link entity.
-func newLinkMutation(c config, op Op, opts ...linkOption) *LinkMutation {
-	m := &LinkMutation{
+// newLoginDeviceMutation creates new mutation for the LoginDevice entity.
+func newLoginDeviceMutation(c config, op Op, opts ...logindeviceOption) *LoginDeviceMutation {
+	m := &LoginDeviceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLink,
+		typ:           TypeLoginDevice,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -15393,730 +19385,1050 @@ func newLinkMutation(c config, op Op, opts ...linkOption) *LinkMutation {
 	return m
 }
 
-// withLinkID sets the ID field of the mutation.
-func withLinkID(id int) linkOption {
-	return func(m *LinkMutation) {
+// withLoginDeviceID sets the ID field of the mutation.
+func withLoginDeviceID(id uint) logindeviceOption {
+	return func(m *LoginDeviceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Link
+			value *LoginDevice
 		)
-		m.oldValue = func(ctx context.Context) (*Link, error) {
+		m.oldValue = func(ctx context.Context) (*LoginDevice, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Link.Get(ctx, id)
+					value, err = m.Client().LoginDevice.Get(ctx, id)
 				}
 			})
 			return value, err
 		}
 		m.id = &id
 	}
-}
-
-// withLink sets the old Link of the mutation.
-func withLink(node *Link) linkOption {
-	return func(m *LinkMutation) {
-		m.oldValue = func(context.Context) (*Link, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+}
+
+// withLoginDevice sets the old LoginDevice of the mutation.
+func withLoginDevice(node *LoginDevice) logindeviceOption {
+	return func(m *LoginDeviceMutation) {
+		m.oldValue = func(context.Context) (*LoginDevice, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoginDeviceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoginDeviceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LoginDevice entities.
+func (m *LoginDeviceMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoginDeviceMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoginDeviceMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoginDevice.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LoginDeviceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LoginDeviceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LoginDevice entity.
+// If the LoginDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginDeviceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LoginDeviceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *LoginDeviceMutation) SetUserID(u uint) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *LoginDeviceMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the LoginDevice entity.
+// If the LoginDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginDeviceMutation) OldUserID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
+	return oldValue.UserID, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LinkMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetUserID resets all changes to the "user_id" field.
+func (m *LoginDeviceMutation) ResetUserID() {
+	m.user = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m LinkMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetFingerprint sets the "fingerprint" field.
+func (m *LoginDeviceMutation) SetFingerprint(s string) {
+	m.fingerprint = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *LinkMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// Fingerprint returns the value of the "fingerprint" field in the mutation.
+func (m *LoginDeviceMutation) Fingerprint() (r string, exists bool) {
+	v := m.fingerprint
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *LinkMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Link.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldFingerprint returns the old "fingerprint" field's value of the LoginDevice entity.
+// If the LoginDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginDeviceMutation) OldFingerprint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFingerprint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFingerprint requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFingerprint: %w", err)
+	}
+	return oldValue.Fingerprint, nil
 }
 
-// SetName sets the "name" field.
-func (m *LinkMutation) SetName(s string) {
-	m.name = &s
+// ResetFingerprint resets all changes to the "fingerprint" field.
+func (m *LoginDeviceMutation) ResetFingerprint() {
+	m.fingerprint = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *LinkMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetDevice sets the "device" field.
+func (m *LoginDeviceMutation) SetDevice(s string) {
+	m.device = &s
+}
+
+// Device returns the value of the "device" field in the mutation.
+func (m *LoginDeviceMutation) Device() (r string, exists bool) {
+	v := m.device
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldDevice returns the old "device" field's value of the LoginDevice entity.
+// If the LoginDevice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *LoginDeviceMutation) OldDevice(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldDevice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Device, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *LinkMutation) ResetName() {
-	m.name = nil
+// ResetDevice resets all changes to the "device" field.
+func (m *LoginDeviceMutation) ResetDevice() {
+	m.device = nil
 }
 
-// SetURL sets the "url" field.
-func (m *LinkMutation) SetURL(s string) {
-	m.url = &s
+// SetLocation sets the "location" field.
+func (m *LoginDeviceMutation) SetLocation(s string) {
+	m.location = &s
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *LinkMutation) URL() (r string, exists bool) {
-	v := m.url
+// Location returns the value of the "location" field in the mutation.
+func (m *LoginDeviceMutation) Location() (r string, exists bool) {
+	v := m.location
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldLocation returns the old "location" field's value of the LoginDevice entity.
+// If the LoginDevice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *LoginDeviceMutation) OldLocation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldLocation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.Location, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *LinkMutation) ResetURL() {
-	m.url = nil
+// ClearLocation clears the value of the "location" field.
+func (m *LoginDeviceMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[logindevice.FieldLocation] = struct{}{}
 }
 
-// SetLogo sets the "logo" field.
-func (m *LinkMutation) SetLogo(s string) {
-	m.logo = &s
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *LoginDeviceMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[logindevice.FieldLocation]
+	return ok
 }
 
-// Logo returns the value of the "logo" field in the mutation.
-func (m *LinkMutation) Logo() (r string, exists bool) {
-	v := m.logo
+// ResetLocation resets all changes to the "location" field.
+func (m *LoginDeviceMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, logindevice.FieldLocation)
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (m *LoginDeviceMutation) SetLastSeen(t time.Time) {
+	m.last_seen = &t
+}
+
+// LastSeen returns the value of the "last_seen" field in the mutation.
+func (m *LoginDeviceMutation) LastSeen() (r time.Time, exists bool) {
+	v := m.last_seen
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLogo returns the old "logo" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldLastSeen returns the old "last_seen" field's value of the LoginDevice entity.
+// If the LoginDevice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldLogo(ctx context.Context) (v string, err error) {
+func (m *LoginDeviceMutation) OldLastSeen(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLogo is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastSeen is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLogo requires an ID field in the mutation")
+		return v, errors.New("OldLastSeen requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLogo: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastSeen: %w", err)
 	}
-	return oldValue.Logo, nil
+	return oldValue.LastSeen, nil
 }
 
-// ClearLogo clears the value of the "logo" field.
-func (m *LinkMutation) ClearLogo() {
-	m.logo = nil
-	m.clearedFields[link.FieldLogo] = struct{}{}
+// ResetLastSeen resets all changes to the "last_seen" field.
+func (m *LoginDeviceMutation) ResetLastSeen() {
+	m.last_seen = nil
 }
 
-// LogoCleared returns if the "logo" field was cleared in this mutation.
-func (m *LinkMutation) LogoCleared() bool {
-	_, ok := m.clearedFields[link.FieldLogo]
-	return ok
+// ClearUser clears the "user" edge to the User entity.
+func (m *LoginDeviceMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[logindevice.FieldUserID] = struct{}{}
 }
 
-// ResetLogo resets all changes to the "logo" field.
-func (m *LinkMutation) ResetLogo() {
-	m.logo = nil
-	delete(m.clearedFields, link.FieldLogo)
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *LoginDeviceMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *LoginDeviceMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *LoginDeviceMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the LoginDeviceMutation builder.
+func (m *LoginDeviceMutation) Where(ps ...predicate.LoginDevice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoginDeviceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoginDeviceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoginDevice, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoginDeviceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoginDeviceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LoginDevice).
+func (m *LoginDeviceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoginDeviceMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, logindevice.FieldCreatedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, logindevice.FieldUserID)
+	}
+	if m.fingerprint != nil {
+		fields = append(fields, logindevice.FieldFingerprint)
+	}
+	if m.device != nil {
+		fields = append(fields, logindevice.FieldDevice)
+	}
+	if m.location != nil {
+		fields = append(fields, logindevice.FieldLocation)
+	}
+	if m.last_seen != nil {
+		fields = append(fields, logindevice.FieldLastSeen)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoginDeviceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case logindevice.FieldCreatedAt:
+		return m.CreatedAt()
+	case logindevice.FieldUserID:
+		return m.UserID()
+	case logindevice.FieldFingerprint:
+		return m.Fingerprint()
+	case logindevice.FieldDevice:
+		return m.Device()
+	case logindevice.FieldLocation:
+		return m.Location()
+	case logindevice.FieldLastSeen:
+		return m.LastSeen()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoginDeviceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case logindevice.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case logindevice.FieldUserID:
+		return m.OldUserID(ctx)
+	case logindevice.FieldFingerprint:
+		return m.OldFingerprint(ctx)
+	case logindevice.FieldDevice:
+		return m.OldDevice(ctx)
+	case logindevice.FieldLocation:
+		return m.OldLocation(ctx)
+	case logindevice.FieldLastSeen:
+		return m.OldLastSeen(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoginDevice field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginDeviceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case logindevice.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case logindevice.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case logindevice.FieldFingerprint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFingerprint(v)
+		return nil
+	case logindevice.FieldDevice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDevice(v)
+		return nil
+	case logindevice.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case logindevice.FieldLastSeen:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeen(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginDevice field %s", name)
 }
 
-// SetDescription sets the "description" field.
-func (m *LinkMutation) SetDescription(s string) {
-	m.description = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoginDeviceMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *LinkMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoginDeviceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldDescription returns the old "description" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginDeviceMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.Description, nil
+	return fmt.Errorf("unknown LoginDevice numeric field %s", name)
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *LinkMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[link.FieldDescription] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoginDeviceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(logindevice.FieldLocation) {
+		fields = append(fields, logindevice.FieldLocation)
+	}
+	return fields
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *LinkMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[link.FieldDescription]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoginDeviceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *LinkMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, link.FieldDescription)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoginDeviceMutation) ClearField(name string) error {
+	switch name {
+	case logindevice.FieldLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginDevice nullable field %s", name)
 }
 
-// SetStatus sets the "status" field.
-func (m *LinkMutation) SetStatus(l link.Status) {
-	m.status = &l
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoginDeviceMutation) ResetField(name string) error {
+	switch name {
+	case logindevice.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case logindevice.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case logindevice.FieldFingerprint:
+		m.ResetFingerprint()
+		return nil
+	case logindevice.FieldDevice:
+		m.ResetDevice()
+		return nil
+	case logindevice.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case logindevice.FieldLastSeen:
+		m.ResetLastSeen()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginDevice field %s", name)
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *LinkMutation) Status() (r link.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoginDeviceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, logindevice.EdgeUser)
 	}
-	return *v, true
+	return edges
 }
 
-// OldStatus returns the old "status" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldStatus(ctx context.Context) (v link.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoginDeviceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case logindevice.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.Status, nil
+	return nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *LinkMutation) ResetStatus() {
-	m.status = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoginDeviceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetSiteshot sets the "siteshot" field.
-func (m *LinkMutation) SetSiteshot(s string) {
-	m.siteshot = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoginDeviceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Siteshot returns the value of the "siteshot" field in the mutation.
-func (m *LinkMutation) Siteshot() (r string, exists bool) {
-	v := m.siteshot
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoginDeviceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, logindevice.EdgeUser)
 	}
-	return *v, true
+	return edges
 }
 
-// OldSiteshot returns the old "siteshot" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldSiteshot(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSiteshot is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSiteshot requires an ID field in the mutation")
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoginDeviceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case logindevice.EdgeUser:
+		return m.cleareduser
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSiteshot: %w", err)
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoginDeviceMutation) ClearEdge(name string) error {
+	switch name {
+	case logindevice.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return oldValue.Siteshot, nil
+	return fmt.Errorf("unknown LoginDevice unique edge %s", name)
 }
 
-// ClearSiteshot clears the value of the "siteshot" field.
-func (m *LinkMutation) ClearSiteshot() {
-	m.siteshot = nil
-	m.clearedFields[link.FieldSiteshot] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoginDeviceMutation) ResetEdge(name string) error {
+	switch name {
+	case logindevice.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginDevice edge %s", name)
 }
 
-// SiteshotCleared returns if the "siteshot" field was cleared in this mutation.
-func (m *LinkMutation) SiteshotCleared() bool {
-	_, ok := m.clearedFields[link.FieldSiteshot]
-	return ok
+// MetadataMutation represents an operation that mutates the Metadata nodes in the graph.
+type MetadataMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	deleted_at    *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	name          *string
+	value         *string
+	clearedFields map[string]struct{}
+	file          *uint
+	clearedfile   bool
+	done          bool
+	oldValue      func(context.Context) (*Metadata, error)
+	predicates    []predicate.Metadata
 }
 
-// ResetSiteshot resets all changes to the "siteshot" field.
-func (m *LinkMutation) ResetSiteshot() {
-	m.siteshot = nil
-	delete(m.clearedFields, link.FieldSiteshot)
+var _ ent.Mutation = (*MetadataMutation)(nil)
+
+// metadataOption allows management of the mutation configuration using functional options.
+type metadataOption func(*MetadataMutation)
+
+// newMetadataMutation creates new mutation for the Metadata entity.
+func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutation {
+	m := &MetadataMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMetadata,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// SetEmail sets the "email" field.
-func (m *LinkMutation) SetEmail(s string) {
-	m.email = &s
+// withMetadataID sets the ID field of the mutation.
+func withMetadataID(id uint) metadataOption {
+	return func(m *MetadataMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Metadata
+		)
+		m.oldValue = func(ctx context.Context) (*Metadata, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Metadata.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *LinkMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
+// withMetadata sets the old Metadata of the mutation.
+func withMetadata(node *Metadata) metadataOption {
+	return func(m *MetadataMutation) {
+		m.oldValue = func(context.Context) (*Metadata, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MetadataMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MetadataMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Email, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearEmail clears the value of the "email" field.
-func (m *LinkMutation) ClearEmail() {
-	m.email = nil
-	m.clearedFields[link.FieldEmail] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Metadata entities.
+func (m *MetadataMutation) SetID(id uint) {
+	m.id = &id
 }
 
-// EmailCleared returns if the "email" field was cleared in this mutation.
-func (m *LinkMutation) EmailCleared() bool {
-	_, ok := m.clearedFields[link.FieldEmail]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MetadataMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *LinkMutation) ResetEmail() {
-	m.email = nil
-	delete(m.clearedFields, link.FieldEmail)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MetadataMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Metadata.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetType sets the "type" field.
-func (m *LinkMutation) SetType(l link.Type) {
-	m._type = &l
+// SetDeletedAt sets the "deleted_at" field.
+func (m *MetadataMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *LinkMutation) GetType() (r link.Type, exists bool) {
-	v := m._type
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *MetadataMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldType(ctx context.Context) (v link.Type, err error) {
+func (m *MetadataMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ClearType clears the value of the "type" field.
-func (m *LinkMutation) ClearType() {
-	m._type = nil
-	m.clearedFields[link.FieldType] = struct{}{}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *MetadataMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[metadata.FieldDeletedAt] = struct{}{}
 }
 
-// TypeCleared returns if the "type" field was cleared in this mutation.
-func (m *LinkMutation) TypeCleared() bool {
-	_, ok := m.clearedFields[link.FieldType]
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *MetadataMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[metadata.FieldDeletedAt]
 	return ok
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *LinkMutation) ResetType() {
-	m._type = nil
-	delete(m.clearedFields, link.FieldType)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *MetadataMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, metadata.FieldDeletedAt)
 }
 
-// SetOriginalURL sets the "original_url" field.
-func (m *LinkMutation) SetOriginalURL(s string) {
-	m.original_url = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *MetadataMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// OriginalURL returns the value of the "original_url" field in the mutation.
-func (m *LinkMutation) OriginalURL() (r string, exists bool) {
-	v := m.original_url
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MetadataMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOriginalURL returns the old "original_url" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldOriginalURL(ctx context.Context) (v string, err error) {
+func (m *MetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOriginalURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOriginalURL requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOriginalURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.OriginalURL, nil
-}
-
-// ClearOriginalURL clears the value of the "original_url" field.
-func (m *LinkMutation) ClearOriginalURL() {
-	m.original_url = nil
-	m.clearedFields[link.FieldOriginalURL] = struct{}{}
-}
-
-// OriginalURLCleared returns if the "original_url" field was cleared in this mutation.
-func (m *LinkMutation) OriginalURLCleared() bool {
-	_, ok := m.clearedFields[link.FieldOriginalURL]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetOriginalURL resets all changes to the "original_url" field.
-func (m *LinkMutation) ResetOriginalURL() {
-	m.original_url = nil
-	delete(m.clearedFields, link.FieldOriginalURL)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MetadataMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetUpdateReason sets the "update_reason" field.
-func (m *LinkMutation) SetUpdateReason(s string) {
-	m.update_reason = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MetadataMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// UpdateReason returns the value of the "update_reason" field in the mutation.
-func (m *LinkMutation) UpdateReason() (r string, exists bool) {
-	v := m.update_reason
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MetadataMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateReason returns the old "update_reason" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldUpdateReason(ctx context.Context) (v string, err error) {
+func (m *MetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateReason requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.UpdateReason, nil
-}
-
-// ClearUpdateReason clears the value of the "update_reason" field.
-func (m *LinkMutation) ClearUpdateReason() {
-	m.update_reason = nil
-	m.clearedFields[link.FieldUpdateReason] = struct{}{}
-}
-
-// UpdateReasonCleared returns if the "update_reason" field was cleared in this mutation.
-func (m *LinkMutation) UpdateReasonCleared() bool {
-	_, ok := m.clearedFields[link.FieldUpdateReason]
-	return ok
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetUpdateReason resets all changes to the "update_reason" field.
-func (m *LinkMutation) ResetUpdateReason() {
-	m.update_reason = nil
-	delete(m.clearedFields, link.FieldUpdateReason)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MetadataMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetSortOrder sets the "sort_order" field.
-func (m *LinkMutation) SetSortOrder(i int) {
-	m.sort_order = &i
-	m.addsort_order = nil
+// SetName sets the "name" field.
+func (m *MetadataMutation) SetName(s string) {
+	m.name = &s
 }
 
-// SortOrder returns the value of the "sort_order" field in the mutation.
-func (m *LinkMutation) SortOrder() (r int, exists bool) {
-	v := m.sort_order
+// Name returns the value of the "name" field in the mutation.
+func (m *MetadataMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSortOrder returns the old "sort_order" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+func (m *MetadataMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSortOrder requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
-	}
-	return oldValue.SortOrder, nil
-}
-
-// AddSortOrder adds i to the "sort_order" field.
-func (m *LinkMutation) AddSortOrder(i int) {
-	if m.addsort_order != nil {
-		*m.addsort_order += i
-	} else {
-		m.addsort_order = &i
-	}
-}
-
-// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
-func (m *LinkMutation) AddedSortOrder() (r int, exists bool) {
-	v := m.addsort_order
-	if v == nil {
-		return
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	return *v, true
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// ResetSortOrder resets all changes to the "sort_order" field.
-func (m *LinkMutation) ResetSortOrder() {
-	m.sort_order = nil
-	m.addsort_order = nil
+// ResetName resets all changes to the "name" field.
+func (m *MetadataMutation) ResetName() {
+	m.name = nil
 }
 
-// SetSkipHealthCheck sets the "skip_health_check" field.
-func (m *LinkMutation) SetSkipHealthCheck(b bool) {
-	m.skip_health_check = &b
+// SetValue sets the "value" field.
+func (m *MetadataMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// SkipHealthCheck returns the value of the "skip_health_check" field in the mutation.
-func (m *LinkMutation) SkipHealthCheck() (r bool, exists bool) {
-	v := m.skip_health_check
+// Value returns the value of the "value" field in the mutation.
+func (m *MetadataMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSkipHealthCheck returns the old "skip_health_check" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldSkipHealthCheck(ctx context.Context) (v bool, err error) {
+func (m *MetadataMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkipHealthCheck is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkipHealthCheck requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkipHealthCheck: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.SkipHealthCheck, nil
+	return oldValue.Value, nil
 }
 
-// ResetSkipHealthCheck resets all changes to the "skip_health_check" field.
-func (m *LinkMutation) ResetSkipHealthCheck() {
-	m.skip_health_check = nil
+// ClearValue clears the value of the "value" field.
+func (m *MetadataMutation) ClearValue() {
+	m.value = nil
+	m.clearedFields[metadata.FieldValue] = struct{}{}
 }
 
-// SetCategoryID sets the "category" edge to the LinkCategory entity by id.
-func (m *LinkMutation) SetCategoryID(id int) {
-	m.category = &id
+// ValueCleared returns if the "value" field was cleared in this mutation.
+func (m *MetadataMutation) ValueCleared() bool {
+	_, ok := m.clearedFields[metadata.FieldValue]
+	return ok
 }
 
-// ClearCategory clears the "category" edge to the LinkCategory entity.
-func (m *LinkMutation) ClearCategory() {
-	m.clearedcategory = true
+// ResetValue resets all changes to the "value" field.
+func (m *MetadataMutation) ResetValue() {
+	m.value = nil
+	delete(m.clearedFields, metadata.FieldValue)
 }
 
-// CategoryCleared reports if the "category" edge to the LinkCategory entity was cleared.
-func (m *LinkMutation) CategoryCleared() bool {
-	return m.clearedcategory
+// SetFileID sets the "file_id" field.
+func (m *MetadataMutation) SetFileID(u uint) {
+	m.file = &u
 }
 
-// CategoryID returns the "category" edge ID in the mutation.
-func (m *LinkMutation) CategoryID() (id int, exists bool) {
-	if m.category != nil {
-		return *m.category, true
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *MetadataMutation) FileID() (r uint, exists bool) {
+	v := m.file
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CategoryIDs returns the "category" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CategoryID instead. It exists only for internal usage by the builders.
-func (m *LinkMutation) CategoryIDs() (ids []int) {
-	if id := m.category; id != nil {
-		ids = append(ids, *id)
+// OldFileID returns the old "file_id" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataMutation) OldFileID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetCategory resets all changes to the "category" edge.
-func (m *LinkMutation) ResetCategory() {
-	m.category = nil
-	m.clearedcategory = false
-}
-
-// AddTagIDs adds the "tags" edge to the LinkTag entity by ids.
-func (m *LinkMutation) AddTagIDs(ids ...int) {
-	if m.tags == nil {
-		m.tags = make(map[int]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileID requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
 	}
+	return oldValue.FileID, nil
 }
 
-// ClearTags clears the "tags" edge to the LinkTag entity.
-func (m *LinkMutation) ClearTags() {
-	m.clearedtags = true
-}
-
-// TagsCleared reports if the "tags" edge to the LinkTag entity was cleared.
-func (m *LinkMutation) TagsCleared() bool {
-	return m.clearedtags
+// ResetFileID resets all changes to the "file_id" field.
+func (m *MetadataMutation) ResetFileID() {
+	m.file = nil
 }
 
-// RemoveTagIDs removes the "tags" edge to the LinkTag entity by IDs.
-func (m *LinkMutation) RemoveTagIDs(ids ...int) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.tags, ids[i])
-		m.removedtags[ids[i]] = struct{}{}
-	}
+// ClearFile clears the "file" edge to the File entity.
+func (m *MetadataMutation) ClearFile() {
+	m.clearedfile = true
+	m.clearedFields[metadata.FieldFileID] = struct{}{}
 }
 
-// RemovedTags returns the removed IDs of the "tags" edge to the LinkTag entity.
-func (m *LinkMutation) RemovedTagsIDs() (ids []int) {
-	for id := range m.removedtags {
-		ids = append(ids, id)
-	}
-	return
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *MetadataMutation) FileCleared() bool {
+	return m.clearedfile
 }
 
-// TagsIDs returns the "tags" edge IDs in the mutation.
-func (m *LinkMutation) TagsIDs() (ids []int) {
-	for id := range m.tags {
-		ids = append(ids, id)
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *MetadataMutation) FileIDs() (ids []uint) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTags resets all changes to the "tags" edge.
-func (m *LinkMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
+// ResetFile resets all changes to the "file" edge.
+func (m *MetadataMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
 }
 
-// Where appends a list predicates to the LinkMutation builder.
-func (m *LinkMutation) Where(ps ...predicate.Link) {
+// Where appends a list predicates to the MetadataMutation builder.
+func (m *MetadataMutation) Where(ps ...predicate.Metadata) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LinkMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MetadataMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LinkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Link, len(ps))
+func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Metadata, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -16124,60 +20436,42 @@ func (m *LinkMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LinkMutation) Op() Op {
+func (m *MetadataMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LinkMutation) SetOp(op Op) {
+func (m *MetadataMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Link).
-func (m *LinkMutation) Type() string {
+// Type returns the node type of this mutation (Metadata).
+func (m *MetadataMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LinkMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.name != nil {
-		fields = append(fields, link.FieldName)
-	}
-	if m.url != nil {
-		fields = append(fields, link.FieldURL)
-	}
-	if m.logo != nil {
-		fields = append(fields, link.FieldLogo)
-	}
-	if m.description != nil {
-		fields = append(fields, link.FieldDescription)
-	}
-	if m.status != nil {
-		fields = append(fields, link.FieldStatus)
-	}
-	if m.siteshot != nil {
-		fields = append(fields, link.FieldSiteshot)
-	}
-	if m.email != nil {
-		fields = append(fields, link.FieldEmail)
+func (m *MetadataMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.deleted_at != nil {
+		fields = append(fields, metadata.FieldDeletedAt)
 	}
-	if m._type != nil {
-		fields = append(fields, link.FieldType)
+	if m.created_at != nil {
+		fields = append(fields, metadata.FieldCreatedAt)
 	}
-	if m.original_url != nil {
-		fields = append(fields, link.FieldOriginalURL)
+	if m.updated_at != nil {
+		fields = append(fields, metadata.FieldUpdatedAt)
 	}
-	if m.update_reason != nil {
-		fields = append(fields, link.FieldUpdateReason)
+	if m.name != nil {
+		fields = append(fields, metadata.FieldName)
 	}
-	if m.sort_order != nil {
-		fields = append(fields, link.FieldSortOrder)
+	if m.value != nil {
+		fields = append(fields, metadata.FieldValue)
 	}
-	if m.skip_health_check != nil {
-		fields = append(fields, link.FieldSkipHealthCheck)
+	if m.file != nil {
+		fields = append(fields, metadata.FieldFileID)
 	}
 	return fields
 }
@@ -16185,32 +20479,20 @@ func (m *LinkMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LinkMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case link.FieldName:
-		return m.Name()
-	case link.FieldURL:
-		return m.URL()
-	case link.FieldLogo:
-		return m.Logo()
-	case link.FieldDescription:
-		return m.Description()
-	case link.FieldStatus:
-		return m.Status()
-	case link.FieldSiteshot:
-		return m.Siteshot()
-	case link.FieldEmail:
-		return m.Email()
-	case link.FieldType:
-		return m.GetType()
-	case link.FieldOriginalURL:
-		return m.OriginalURL()
-	case link.FieldUpdateReason:
-		return m.UpdateReason()
-	case link.FieldSortOrder:
-		return m.SortOrder()
-	case link.FieldSkipHealthCheck:
-		return m.SkipHealthCheck()
+func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case metadata.FieldDeletedAt:
+		return m.DeletedAt()
+	case metadata.FieldCreatedAt:
+		return m.CreatedAt()
+	case metadata.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case metadata.FieldName:
+		return m.Name()
+	case metadata.FieldValue:
+		return m.Value()
+	case metadata.FieldFileID:
+		return m.FileID()
 	}
 	return nil, false
 }
@@ -16218,146 +20500,87 @@ func (m *LinkMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case link.FieldName:
+	case metadata.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case metadata.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case metadata.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case metadata.FieldName:
 		return m.OldName(ctx)
-	case link.FieldURL:
-		return m.OldURL(ctx)
-	case link.FieldLogo:
-		return m.OldLogo(ctx)
-	case link.FieldDescription:
-		return m.OldDescription(ctx)
-	case link.FieldStatus:
-		return m.OldStatus(ctx)
-	case link.FieldSiteshot:
-		return m.OldSiteshot(ctx)
-	case link.FieldEmail:
-		return m.OldEmail(ctx)
-	case link.FieldType:
-		return m.OldType(ctx)
-	case link.FieldOriginalURL:
-		return m.OldOriginalURL(ctx)
-	case link.FieldUpdateReason:
-		return m.OldUpdateReason(ctx)
-	case link.FieldSortOrder:
-		return m.OldSortOrder(ctx)
-	case link.FieldSkipHealthCheck:
-		return m.OldSkipHealthCheck(ctx)
+	case metadata.FieldValue:
+		return m.OldValue(ctx)
+	case metadata.FieldFileID:
+		return m.OldFileID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Link field %s", name)
+	return nil, fmt.Errorf("unknown Metadata field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkMutation) SetField(name string, value ent.Value) error {
+func (m *MetadataMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case link.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case link.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case link.FieldLogo:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLogo(v)
-		return nil
-	case link.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case link.FieldStatus:
-		v, ok := value.(link.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case link.FieldSiteshot:
-		v, ok := value.(string)
+	case metadata.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSiteshot(v)
+		m.SetDeletedAt(v)
 		return nil
-	case link.FieldEmail:
-		v, ok := value.(string)
+	case metadata.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetCreatedAt(v)
 		return nil
-	case link.FieldType:
-		v, ok := value.(link.Type)
+	case metadata.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case link.FieldOriginalURL:
+	case metadata.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOriginalURL(v)
+		m.SetName(v)
 		return nil
-	case link.FieldUpdateReason:
+	case metadata.FieldValue:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdateReason(v)
-		return nil
-	case link.FieldSortOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSortOrder(v)
+		m.SetValue(v)
 		return nil
-	case link.FieldSkipHealthCheck:
-		v, ok := value.(bool)
+	case metadata.FieldFileID:
+		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSkipHealthCheck(v)
+		m.SetFileID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Link field %s", name)
+	return fmt.Errorf("unknown Metadata field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LinkMutation) AddedFields() []string {
+func (m *MetadataMutation) AddedFields() []string {
 	var fields []string
-	if m.addsort_order != nil {
-		fields = append(fields, link.FieldSortOrder)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LinkMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MetadataMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case link.FieldSortOrder:
-		return m.AddedSortOrder()
 	}
 	return nil, false
 }
@@ -16365,258 +20588,182 @@ func (m *LinkMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkMutation) AddField(name string, value ent.Value) error {
+func (m *MetadataMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case link.FieldSortOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSortOrder(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Link numeric field %s", name)
+	return fmt.Errorf("unknown Metadata numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LinkMutation) ClearedFields() []string {
+func (m *MetadataMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(link.FieldLogo) {
-		fields = append(fields, link.FieldLogo)
-	}
-	if m.FieldCleared(link.FieldDescription) {
-		fields = append(fields, link.FieldDescription)
-	}
-	if m.FieldCleared(link.FieldSiteshot) {
-		fields = append(fields, link.FieldSiteshot)
-	}
-	if m.FieldCleared(link.FieldEmail) {
-		fields = append(fields, link.FieldEmail)
-	}
-	if m.FieldCleared(link.FieldType) {
-		fields = append(fields, link.FieldType)
-	}
-	if m.FieldCleared(link.FieldOriginalURL) {
-		fields = append(fields, link.FieldOriginalURL)
+	if m.FieldCleared(metadata.FieldDeletedAt) {
+		fields = append(fields, metadata.FieldDeletedAt)
 	}
-	if m.FieldCleared(link.FieldUpdateReason) {
-		fields = append(fields, link.FieldUpdateReason)
+	if m.FieldCleared(metadata.FieldValue) {
+		fields = append(fields, metadata.FieldValue)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LinkMutation) FieldCleared(name string) bool {
+func (m *MetadataMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LinkMutation) ClearField(name string) error {
+func (m *MetadataMutation) ClearField(name string) error {
 	switch name {
-	case link.FieldLogo:
-		m.ClearLogo()
-		return nil
-	case link.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case link.FieldSiteshot:
-		m.ClearSiteshot()
-		return nil
-	case link.FieldEmail:
-		m.ClearEmail()
-		return nil
-	case link.FieldType:
-		m.ClearType()
-		return nil
-	case link.FieldOriginalURL:
-		m.ClearOriginalURL()
+	case metadata.FieldDeletedAt:
+		m.ClearDeletedAt()
 		return nil
-	case link.FieldUpdateReason:
-		m.ClearUpdateReason()
+	case metadata.FieldValue:
+		m.ClearValue()
 		return nil
 	}
-	return fmt.Errorf("unknown Link nullable field %s", name)
+	return fmt.Errorf("unknown Metadata nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LinkMutation) ResetField(name string) error {
+func (m *MetadataMutation) ResetField(name string) error {
 	switch name {
-	case link.FieldName:
-		m.ResetName()
-		return nil
-	case link.FieldURL:
-		m.ResetURL()
-		return nil
-	case link.FieldLogo:
-		m.ResetLogo()
-		return nil
-	case link.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case link.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case link.FieldSiteshot:
-		m.ResetSiteshot()
-		return nil
-	case link.FieldEmail:
-		m.ResetEmail()
+	case metadata.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case link.FieldType:
-		m.ResetType()
+	case metadata.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case link.FieldOriginalURL:
-		m.ResetOriginalURL()
+	case metadata.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case link.FieldUpdateReason:
-		m.ResetUpdateReason()
+	case metadata.FieldName:
+		m.ResetName()
 		return nil
-	case link.FieldSortOrder:
-		m.ResetSortOrder()
+	case metadata.FieldValue:
+		m.ResetValue()
 		return nil
-	case link.FieldSkipHealthCheck:
-		m.ResetSkipHealthCheck()
+	case metadata.FieldFileID:
+		m.ResetFileID()
 		return nil
 	}
-	return fmt.Errorf("unknown Link field %s", name)
+	return fmt.Errorf("unknown Metadata field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LinkMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.category != nil {
-		edges = append(edges, link.EdgeCategory)
-	}
-	if m.tags != nil {
-		edges = append(edges, link.EdgeTags)
+func (m *MetadataMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.file != nil {
+		edges = append(edges, metadata.EdgeFile)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LinkMutation) AddedIDs(name string) []ent.Value {
+func (m *MetadataMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case link.EdgeCategory:
-		if id := m.category; id != nil {
+	case metadata.EdgeFile:
+		if id := m.file; id != nil {
 			return []ent.Value{*id}
 		}
-	case link.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.tags))
-		for id := range m.tags {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LinkMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedtags != nil {
-		edges = append(edges, link.EdgeTags)
-	}
+func (m *MetadataMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LinkMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case link.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.removedtags))
-		for id := range m.removedtags {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *MetadataMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LinkMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedcategory {
-		edges = append(edges, link.EdgeCategory)
-	}
-	if m.clearedtags {
-		edges = append(edges, link.EdgeTags)
+func (m *MetadataMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfile {
+		edges = append(edges, metadata.EdgeFile)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LinkMutation) EdgeCleared(name string) bool {
+func (m *MetadataMutation) EdgeCleared(name string) bool {
 	switch name {
-	case link.EdgeCategory:
-		return m.clearedcategory
-	case link.EdgeTags:
-		return m.clearedtags
+	case metadata.EdgeFile:
+		return m.clearedfile
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LinkMutation) ClearEdge(name string) error {
+func (m *MetadataMutation) ClearEdge(name string) error {
 	switch name {
-	case link.EdgeCategory:
-		m.ClearCategory()
+	case metadata.EdgeFile:
+		m.ClearFile()
 		return nil
 	}
-	return fmt.Errorf("unknown Link unique edge %s", name)
+	return fmt.Errorf("unknown Metadata unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LinkMutation) ResetEdge(name string) error {
+func (m *MetadataMutation) ResetEdge(name string) error {
 	switch name {
-	case link.EdgeCategory:
-		m.ResetCategory()
-		return nil
-	case link.EdgeTags:
-		m.ResetTags()
+	case metadata.EdgeFile:
+		m.ResetFile()
 		return nil
 	}
-	return fmt.Errorf("unknown Link edge %s", name)
+	return fmt.Errorf("unknown Metadata edge %s", name)
 }
 
-// LinkCategoryMutation represents an operation that mutates the LinkCategory nodes in the graph.
-type LinkCategoryMutation struct {
+// NotificationTypeMutation represents an operation that mutates the NotificationType nodes in the graph.
+type NotificationTypeMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	description   *string
-	style         *linkcategory.Style
-	clearedFields map[string]struct{}
-	links         map[int]struct{}
-	removedlinks  map[int]struct{}
-	clearedlinks  bool
-	done          bool
-	oldValue      func(context.Context) (*LinkCategory, error)
-	predicates    []predicate.LinkCategory
+	op                       Op
+	typ                      string
+	id                       *uint
+	created_at               *time.Time
+	updated_at               *time.Time
+	code                     *string
+	name                     *string
+	description              *string
+	category                 *string
+	is_active                *bool
+	default_enabled          *bool
+	supported_channels       *[]string
+	appendsupported_channels []string
+	clearedFields            map[string]struct{}
+	user_configs             map[uint]struct{}
+	removeduser_configs      map[uint]struct{}
+	cleareduser_configs      bool
+	done                     bool
+	oldValue                 func(context.Context) (*NotificationType, error)
+	predicates               []predicate.NotificationType
 }
 
-var _ ent.Mutation = (*LinkCategoryMutation)(nil)
+var _ ent.Mutation = (*NotificationTypeMutation)(nil)
 
-// linkcategoryOption allows management of the mutation configuration using functional options.
-type linkcategoryOption func(*LinkCategoryMutation)
+// notificationtypeOption allows management of the mutation configuration using functional options.
+type notificationtypeOption func(*NotificationTypeMutation)
 
-// newLinkCategoryMutation creates new mutation for the LinkCategory entity.
-func newLinkCategoryMutation(c config, op Op, opts ...linkcategoryOption) *LinkCategoryMutation {
-	m := &LinkCategoryMutation{
+// newNotificationTypeMutation creates new mutation for the NotificationType entity.
+func newNotificationTypeMutation(c config, op Op, opts ...notificationtypeOption) *NotificationTypeMutation {
+	m := &NotificationTypeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLinkCategory,
+		typ:           TypeNotificationType,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -16625,20 +20772,20 @@ func newLinkCategoryMutation(c config, op Op, opts ...linkcategoryOption) *LinkC
 	return m
 }
 
-// withLinkCategoryID sets the ID field of the mutation.
-func withLinkCategoryID(id int) linkcategoryOption {
-	return func(m *LinkCategoryMutation) {
+// withNotificationTypeID sets the ID field of the mutation.
+func withNotificationTypeID(id uint) notificationtypeOption {
+	return func(m *NotificationTypeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *LinkCategory
+			value *NotificationType
 		)
-		m.oldValue = func(ctx context.Context) (*LinkCategory, error) {
+		m.oldValue = func(ctx context.Context) (*NotificationType, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().LinkCategory.Get(ctx, id)
+					value, err = m.Client().NotificationType.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -16647,70 +20794,184 @@ func withLinkCategoryID(id int) linkcategoryOption {
 	}
 }
 
-// withLinkCategory sets the old LinkCategory of the mutation.
-func withLinkCategory(node *LinkCategory) linkcategoryOption {
-	return func(m *LinkCategoryMutation) {
-		m.oldValue = func(context.Context) (*LinkCategory, error) {
+// withNotificationType sets the old NotificationType of the mutation.
+func withNotificationType(node *NotificationType) notificationtypeOption {
+	return func(m *NotificationTypeMutation) {
+		m.oldValue = func(context.Context) (*NotificationType, error) {
 			return node, nil
 		}
 		m.id = &node.ID
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LinkCategoryMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationTypeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationTypeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NotificationType entities.
+func (m *NotificationTypeMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationTypeMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationTypeMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationType.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationTypeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationTypeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NotificationType entity.
+// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTypeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationTypeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationTypeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationTypeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the NotificationType entity.
+// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTypeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationTypeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m LinkCategoryMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetCode sets the "code" field.
+func (m *NotificationTypeMutation) SetCode(s string) {
+	m.code = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *LinkCategoryMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// Code returns the value of the "code" field in the mutation.
+func (m *NotificationTypeMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *LinkCategoryMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LinkCategory.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldCode returns the old "code" field's value of the NotificationType entity.
+// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTypeMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
 	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *NotificationTypeMutation) ResetCode() {
+	m.code = nil
 }
 
 // SetName sets the "name" field.
-func (m *LinkCategoryMutation) SetName(s string) {
+func (m *NotificationTypeMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *LinkCategoryMutation) Name() (r string, exists bool) {
+func (m *NotificationTypeMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -16718,10 +20979,10 @@ func (m *LinkCategoryMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the LinkCategory entity.
-// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the NotificationType entity.
+// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkCategoryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *NotificationTypeMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -16736,17 +20997,17 @@ func (m *LinkCategoryMutation) OldName(ctx context.Context) (v string, err error
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *LinkCategoryMutation) ResetName() {
+func (m *NotificationTypeMutation) ResetName() {
 	m.name = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *LinkCategoryMutation) SetDescription(s string) {
+func (m *NotificationTypeMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *LinkCategoryMutation) Description() (r string, exists bool) {
+func (m *NotificationTypeMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -16754,10 +21015,10 @@ func (m *LinkCategoryMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the LinkCategory entity.
-// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the NotificationType entity.
+// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkCategoryMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *NotificationTypeMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -16772,122 +21033,259 @@ func (m *LinkCategoryMutation) OldDescription(ctx context.Context) (v string, er
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *LinkCategoryMutation) ClearDescription() {
+func (m *NotificationTypeMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[linkcategory.FieldDescription] = struct{}{}
+	m.clearedFields[notificationtype.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *LinkCategoryMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[linkcategory.FieldDescription]
+func (m *NotificationTypeMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[notificationtype.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *LinkCategoryMutation) ResetDescription() {
+func (m *NotificationTypeMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, linkcategory.FieldDescription)
+	delete(m.clearedFields, notificationtype.FieldDescription)
 }
 
-// SetStyle sets the "style" field.
-func (m *LinkCategoryMutation) SetStyle(l linkcategory.Style) {
-	m.style = &l
+// SetCategory sets the "category" field.
+func (m *NotificationTypeMutation) SetCategory(s string) {
+	m.category = &s
 }
 
-// Style returns the value of the "style" field in the mutation.
-func (m *LinkCategoryMutation) Style() (r linkcategory.Style, exists bool) {
-	v := m.style
+// Category returns the value of the "category" field in the mutation.
+func (m *NotificationTypeMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the NotificationType entity.
+// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTypeMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *NotificationTypeMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *NotificationTypeMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *NotificationTypeMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the NotificationType entity.
+// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTypeMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *NotificationTypeMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetDefaultEnabled sets the "default_enabled" field.
+func (m *NotificationTypeMutation) SetDefaultEnabled(b bool) {
+	m.default_enabled = &b
+}
+
+// DefaultEnabled returns the value of the "default_enabled" field in the mutation.
+func (m *NotificationTypeMutation) DefaultEnabled() (r bool, exists bool) {
+	v := m.default_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultEnabled returns the old "default_enabled" field's value of the NotificationType entity.
+// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTypeMutation) OldDefaultEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultEnabled: %w", err)
+	}
+	return oldValue.DefaultEnabled, nil
+}
+
+// ResetDefaultEnabled resets all changes to the "default_enabled" field.
+func (m *NotificationTypeMutation) ResetDefaultEnabled() {
+	m.default_enabled = nil
+}
+
+// SetSupportedChannels sets the "supported_channels" field.
+func (m *NotificationTypeMutation) SetSupportedChannels(s []string) {
+	m.supported_channels = &s
+	m.appendsupported_channels = nil
+}
+
+// SupportedChannels returns the value of the "supported_channels" field in the mutation.
+func (m *NotificationTypeMutation) SupportedChannels() (r []string, exists bool) {
+	v := m.supported_channels
 	if v == nil {
 		return
 	}
-	return *v, true
+	return *v, true
+}
+
+// OldSupportedChannels returns the old "supported_channels" field's value of the NotificationType entity.
+// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTypeMutation) OldSupportedChannels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSupportedChannels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSupportedChannels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSupportedChannels: %w", err)
+	}
+	return oldValue.SupportedChannels, nil
+}
+
+// AppendSupportedChannels adds s to the "supported_channels" field.
+func (m *NotificationTypeMutation) AppendSupportedChannels(s []string) {
+	m.appendsupported_channels = append(m.appendsupported_channels, s...)
+}
+
+// AppendedSupportedChannels returns the list of values that were appended to the "supported_channels" field in this mutation.
+func (m *NotificationTypeMutation) AppendedSupportedChannels() ([]string, bool) {
+	if len(m.appendsupported_channels) == 0 {
+		return nil, false
+	}
+	return m.appendsupported_channels, true
 }
 
-// OldStyle returns the old "style" field's value of the LinkCategory entity.
-// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkCategoryMutation) OldStyle(ctx context.Context) (v linkcategory.Style, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStyle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStyle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStyle: %w", err)
-	}
-	return oldValue.Style, nil
+// ClearSupportedChannels clears the value of the "supported_channels" field.
+func (m *NotificationTypeMutation) ClearSupportedChannels() {
+	m.supported_channels = nil
+	m.appendsupported_channels = nil
+	m.clearedFields[notificationtype.FieldSupportedChannels] = struct{}{}
 }
 
-// ResetStyle resets all changes to the "style" field.
-func (m *LinkCategoryMutation) ResetStyle() {
-	m.style = nil
+// SupportedChannelsCleared returns if the "supported_channels" field was cleared in this mutation.
+func (m *NotificationTypeMutation) SupportedChannelsCleared() bool {
+	_, ok := m.clearedFields[notificationtype.FieldSupportedChannels]
+	return ok
 }
 
-// AddLinkIDs adds the "links" edge to the Link entity by ids.
-func (m *LinkCategoryMutation) AddLinkIDs(ids ...int) {
-	if m.links == nil {
-		m.links = make(map[int]struct{})
+// ResetSupportedChannels resets all changes to the "supported_channels" field.
+func (m *NotificationTypeMutation) ResetSupportedChannels() {
+	m.supported_channels = nil
+	m.appendsupported_channels = nil
+	delete(m.clearedFields, notificationtype.FieldSupportedChannels)
+}
+
+// AddUserConfigIDs adds the "user_configs" edge to the UserNotificationConfig entity by ids.
+func (m *NotificationTypeMutation) AddUserConfigIDs(ids ...uint) {
+	if m.user_configs == nil {
+		m.user_configs = make(map[uint]struct{})
 	}
 	for i := range ids {
-		m.links[ids[i]] = struct{}{}
+		m.user_configs[ids[i]] = struct{}{}
 	}
 }
 
-// ClearLinks clears the "links" edge to the Link entity.
-func (m *LinkCategoryMutation) ClearLinks() {
-	m.clearedlinks = true
+// ClearUserConfigs clears the "user_configs" edge to the UserNotificationConfig entity.
+func (m *NotificationTypeMutation) ClearUserConfigs() {
+	m.cleareduser_configs = true
 }
 
-// LinksCleared reports if the "links" edge to the Link entity was cleared.
-func (m *LinkCategoryMutation) LinksCleared() bool {
-	return m.clearedlinks
+// UserConfigsCleared reports if the "user_configs" edge to the UserNotificationConfig entity was cleared.
+func (m *NotificationTypeMutation) UserConfigsCleared() bool {
+	return m.cleareduser_configs
 }
 
-// RemoveLinkIDs removes the "links" edge to the Link entity by IDs.
-func (m *LinkCategoryMutation) RemoveLinkIDs(ids ...int) {
-	if m.removedlinks == nil {
-		m.removedlinks = make(map[int]struct{})
+// RemoveUserConfigIDs removes the "user_configs" edge to the UserNotificationConfig entity by IDs.
+func (m *NotificationTypeMutation) RemoveUserConfigIDs(ids ...uint) {
+	if m.removeduser_configs == nil {
+		m.removeduser_configs = make(map[uint]struct{})
 	}
 	for i := range ids {
-		delete(m.links, ids[i])
-		m.removedlinks[ids[i]] = struct{}{}
+		delete(m.user_configs, ids[i])
+		m.removeduser_configs[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedLinks returns the removed IDs of the "links" edge to the Link entity.
-func (m *LinkCategoryMutation) RemovedLinksIDs() (ids []int) {
-	for id := range m.removedlinks {
+// RemovedUserConfigs returns the removed IDs of the "user_configs" edge to the UserNotificationConfig entity.
+func (m *NotificationTypeMutation) RemovedUserConfigsIDs() (ids []uint) {
+	for id := range m.removeduser_configs {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// LinksIDs returns the "links" edge IDs in the mutation.
-func (m *LinkCategoryMutation) LinksIDs() (ids []int) {
-	for id := range m.links {
+// UserConfigsIDs returns the "user_configs" edge IDs in the mutation.
+func (m *NotificationTypeMutation) UserConfigsIDs() (ids []uint) {
+	for id := range m.user_configs {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetLinks resets all changes to the "links" edge.
-func (m *LinkCategoryMutation) ResetLinks() {
-	m.links = nil
-	m.clearedlinks = false
-	m.removedlinks = nil
+// ResetUserConfigs resets all changes to the "user_configs" edge.
+func (m *NotificationTypeMutation) ResetUserConfigs() {
+	m.user_configs = nil
+	m.cleareduser_configs = false
+	m.removeduser_configs = nil
 }
 
-// Where appends a list predicates to the LinkCategoryMutation builder.
-func (m *LinkCategoryMutation) Where(ps ...predicate.LinkCategory) {
+// Where appends a list predicates to the NotificationTypeMutation builder.
+func (m *NotificationTypeMutation) Where(ps ...predicate.NotificationType) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LinkCategoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NotificationTypeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LinkCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LinkCategory, len(ps))
+func (m *NotificationTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationType, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -16895,33 +21293,51 @@ func (m *LinkCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LinkCategoryMutation) Op() Op {
+func (m *NotificationTypeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LinkCategoryMutation) SetOp(op Op) {
+func (m *NotificationTypeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (LinkCategory).
-func (m *LinkCategoryMutation) Type() string {
+// Type returns the node type of this mutation (NotificationType).
+func (m *NotificationTypeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LinkCategoryMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *NotificationTypeMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, notificationtype.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notificationtype.FieldUpdatedAt)
+	}
+	if m.code != nil {
+		fields = append(fields, notificationtype.FieldCode)
+	}
 	if m.name != nil {
-		fields = append(fields, linkcategory.FieldName)
+		fields = append(fields, notificationtype.FieldName)
 	}
 	if m.description != nil {
-		fields = append(fields, linkcategory.FieldDescription)
+		fields = append(fields, notificationtype.FieldDescription)
 	}
-	if m.style != nil {
-		fields = append(fields, linkcategory.FieldStyle)
+	if m.category != nil {
+		fields = append(fields, notificationtype.FieldCategory)
+	}
+	if m.is_active != nil {
+		fields = append(fields, notificationtype.FieldIsActive)
+	}
+	if m.default_enabled != nil {
+		fields = append(fields, notificationtype.FieldDefaultEnabled)
+	}
+	if m.supported_channels != nil {
+		fields = append(fields, notificationtype.FieldSupportedChannels)
 	}
 	return fields
 }
@@ -16929,14 +21345,26 @@ func (m *LinkCategoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LinkCategoryMutation) Field(name string) (ent.Value, bool) {
+func (m *NotificationTypeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case linkcategory.FieldName:
+	case notificationtype.FieldCreatedAt:
+		return m.CreatedAt()
+	case notificationtype.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case notificationtype.FieldCode:
+		return m.Code()
+	case notificationtype.FieldName:
 		return m.Name()
-	case linkcategory.FieldDescription:
+	case notificationtype.FieldDescription:
 		return m.Description()
-	case linkcategory.FieldStyle:
-		return m.Style()
+	case notificationtype.FieldCategory:
+		return m.Category()
+	case notificationtype.FieldIsActive:
+		return m.IsActive()
+	case notificationtype.FieldDefaultEnabled:
+		return m.DefaultEnabled()
+	case notificationtype.FieldSupportedChannels:
+		return m.SupportedChannels()
 	}
 	return nil, false
 }
@@ -16944,131 +21372,209 @@ func (m *LinkCategoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LinkCategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NotificationTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case linkcategory.FieldName:
+	case notificationtype.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notificationtype.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case notificationtype.FieldCode:
+		return m.OldCode(ctx)
+	case notificationtype.FieldName:
 		return m.OldName(ctx)
-	case linkcategory.FieldDescription:
+	case notificationtype.FieldDescription:
 		return m.OldDescription(ctx)
-	case linkcategory.FieldStyle:
-		return m.OldStyle(ctx)
+	case notificationtype.FieldCategory:
+		return m.OldCategory(ctx)
+	case notificationtype.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case notificationtype.FieldDefaultEnabled:
+		return m.OldDefaultEnabled(ctx)
+	case notificationtype.FieldSupportedChannels:
+		return m.OldSupportedChannels(ctx)
 	}
-	return nil, fmt.Errorf("unknown LinkCategory field %s", name)
+	return nil, fmt.Errorf("unknown NotificationType field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkCategoryMutation) SetField(name string, value ent.Value) error {
+func (m *NotificationTypeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case linkcategory.FieldName:
+	case notificationtype.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notificationtype.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case notificationtype.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case notificationtype.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case linkcategory.FieldDescription:
+	case notificationtype.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case linkcategory.FieldStyle:
-		v, ok := value.(linkcategory.Style)
+	case notificationtype.FieldCategory:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStyle(v)
+		m.SetCategory(v)
+		return nil
+	case notificationtype.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case notificationtype.FieldDefaultEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultEnabled(v)
+		return nil
+	case notificationtype.FieldSupportedChannels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSupportedChannels(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LinkCategory field %s", name)
+	return fmt.Errorf("unknown NotificationType field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LinkCategoryMutation) AddedFields() []string {
+func (m *NotificationTypeMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LinkCategoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NotificationTypeMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkCategoryMutation) AddField(name string, value ent.Value) error {
+func (m *NotificationTypeMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown LinkCategory numeric field %s", name)
+	return fmt.Errorf("unknown NotificationType numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LinkCategoryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(linkcategory.FieldDescription) {
-		fields = append(fields, linkcategory.FieldDescription)
+func (m *NotificationTypeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notificationtype.FieldDescription) {
+		fields = append(fields, notificationtype.FieldDescription)
+	}
+	if m.FieldCleared(notificationtype.FieldSupportedChannels) {
+		fields = append(fields, notificationtype.FieldSupportedChannels)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LinkCategoryMutation) FieldCleared(name string) bool {
+func (m *NotificationTypeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LinkCategoryMutation) ClearField(name string) error {
+func (m *NotificationTypeMutation) ClearField(name string) error {
 	switch name {
-	case linkcategory.FieldDescription:
+	case notificationtype.FieldDescription:
 		m.ClearDescription()
 		return nil
+	case notificationtype.FieldSupportedChannels:
+		m.ClearSupportedChannels()
+		return nil
 	}
-	return fmt.Errorf("unknown LinkCategory nullable field %s", name)
+	return fmt.Errorf("unknown NotificationType nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LinkCategoryMutation) ResetField(name string) error {
+func (m *NotificationTypeMutation) ResetField(name string) error {
 	switch name {
-	case linkcategory.FieldName:
+	case notificationtype.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notificationtype.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case notificationtype.FieldCode:
+		m.ResetCode()
+		return nil
+	case notificationtype.FieldName:
 		m.ResetName()
 		return nil
-	case linkcategory.FieldDescription:
+	case notificationtype.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case linkcategory.FieldStyle:
-		m.ResetStyle()
+	case notificationtype.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case notificationtype.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case notificationtype.FieldDefaultEnabled:
+		m.ResetDefaultEnabled()
+		return nil
+	case notificationtype.FieldSupportedChannels:
+		m.ResetSupportedChannels()
 		return nil
 	}
-	return fmt.Errorf("unknown LinkCategory field %s", name)
+	return fmt.Errorf("unknown NotificationType field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LinkCategoryMutation) AddedEdges() []string {
+func (m *NotificationTypeMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.links != nil {
-		edges = append(edges, linkcategory.EdgeLinks)
+	if m.user_configs != nil {
+		edges = append(edges, notificationtype.EdgeUserConfigs)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LinkCategoryMutation) AddedIDs(name string) []ent.Value {
+func (m *NotificationTypeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case linkcategory.EdgeLinks:
-		ids := make([]ent.Value, 0, len(m.links))
-		for id := range m.links {
+	case notificationtype.EdgeUserConfigs:
+		ids := make([]ent.Value, 0, len(m.user_configs))
+		for id := range m.user_configs {
 			ids = append(ids, id)
 		}
 		return ids
@@ -17077,21 +21583,21 @@ func (m *LinkCategoryMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LinkCategoryMutation) RemovedEdges() []string {
+func (m *NotificationTypeMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedlinks != nil {
-		edges = append(edges, linkcategory.EdgeLinks)
+	if m.removeduser_configs != nil {
+		edges = append(edges, notificationtype.EdgeUserConfigs)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LinkCategoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *NotificationTypeMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case linkcategory.EdgeLinks:
-		ids := make([]ent.Value, 0, len(m.removedlinks))
-		for id := range m.removedlinks {
+	case notificationtype.EdgeUserConfigs:
+		ids := make([]ent.Value, 0, len(m.removeduser_configs))
+		for id := range m.removeduser_configs {
 			ids = append(ids, id)
 		}
 		return ids
@@ -17100,71 +21606,73 @@ func (m *LinkCategoryMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LinkCategoryMutation) ClearedEdges() []string {
+func (m *NotificationTypeMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedlinks {
-		edges = append(edges, linkcategory.EdgeLinks)
+	if m.cleareduser_configs {
+		edges = append(edges, notificationtype.EdgeUserConfigs)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LinkCategoryMutation) EdgeCleared(name string) bool {
+func (m *NotificationTypeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case linkcategory.EdgeLinks:
-		return m.clearedlinks
+	case notificationtype.EdgeUserConfigs:
+		return m.cleareduser_configs
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LinkCategoryMutation) ClearEdge(name string) error {
+func (m *NotificationTypeMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown LinkCategory unique edge %s", name)
+	return fmt.Errorf("unknown NotificationType unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LinkCategoryMutation) ResetEdge(name string) error {
+func (m *NotificationTypeMutation) ResetEdge(name string) error {
 	switch name {
-	case linkcategory.EdgeLinks:
-		m.ResetLinks()
+	case notificationtype.EdgeUserConfigs:
+		m.ResetUserConfigs()
 		return nil
 	}
-	return fmt.Errorf("unknown LinkCategory edge %s", name)
+	return fmt.Errorf("unknown NotificationType edge %s", name)
 }
 
-// LinkTagMutation represents an operation that mutates the LinkTag nodes in the graph.
-type LinkTagMutation struct {
+// OAuthLinkMutation represents an operation that mutates the OAuthLink nodes in the graph.
+type OAuthLinkMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *int
+	id            *uint
+	provider      *string
+	subject       *string
+	email         *string
 	name          *string
-	color         *string
+	linked_at     *time.Time
 	clearedFields map[string]struct{}
-	links         map[int]struct{}
-	removedlinks  map[int]struct{}
-	clearedlinks  bool
+	user          *uint
+	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*LinkTag, error)
-	predicates    []predicate.LinkTag
+	oldValue      func(context.Context) (*OAuthLink, error)
+	predicates    []predicate.OAuthLink
 }
 
-var _ ent.Mutation = (*LinkTagMutation)(nil)
+var _ ent.Mutation = (*OAuthLinkMutation)(nil)
 
-// linktagOption allows management of the mutation configuration using functional options.
-type linktagOption func(*LinkTagMutation)
+// oauthlinkOption allows management of the mutation configuration using functional options.
+type oauthlinkOption func(*OAuthLinkMutation)
 
-// newLinkTagMutation creates new mutation for the LinkTag entity.
-func newLinkTagMutation(c config, op Op, opts ...linktagOption) *LinkTagMutation {
-	m := &LinkTagMutation{
+// newOAuthLinkMutation creates new mutation for the OAuthLink entity.
+func newOAuthLinkMutation(c config, op Op, opts ...oauthlinkOption) *OAuthLinkMutation {
+	m := &OAuthLinkMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLinkTag,
+		typ:           TypeOAuthLink,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -17173,20 +21681,20 @@ func newLinkTagMutation(c config, op Op, opts ...linktagOption) *LinkTagMutation
 	return m
 }
 
-// withLinkTagID sets the ID field of the mutation.
-func withLinkTagID(id int) linktagOption {
-	return func(m *LinkTagMutation) {
+// withOAuthLinkID sets the ID field of the mutation.
+func withOAuthLinkID(id uint) oauthlinkOption {
+	return func(m *OAuthLinkMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *LinkTag
+			value *OAuthLink
 		)
-		m.oldValue = func(ctx context.Context) (*LinkTag, error) {
+		m.oldValue = func(ctx context.Context) (*OAuthLink, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().LinkTag.Get(ctx, id)
+					value, err = m.Client().OAuthLink.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -17195,10 +21703,10 @@ func withLinkTagID(id int) linktagOption {
 	}
 }
 
-// withLinkTag sets the old LinkTag of the mutation.
-func withLinkTag(node *LinkTag) linktagOption {
-	return func(m *LinkTagMutation) {
-		m.oldValue = func(context.Context) (*LinkTag, error) {
+// withOAuthLink sets the old OAuthLink of the mutation.
+func withOAuthLink(node *OAuthLink) oauthlinkOption {
+	return func(m *OAuthLinkMutation) {
+		m.oldValue = func(context.Context) (*OAuthLink, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -17207,7 +21715,7 @@ func withLinkTag(node *LinkTag) linktagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LinkTagMutation) Client() *Client {
+func (m OAuthLinkMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -17215,7 +21723,7 @@ func (m LinkTagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LinkTagMutation) Tx() (*Tx, error) {
+func (m OAuthLinkMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -17224,9 +21732,15 @@ func (m LinkTagMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OAuthLink entities.
+func (m *OAuthLinkMutation) SetID(id uint) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LinkTagMutation) ID() (id int, exists bool) {
+func (m *OAuthLinkMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -17237,28 +21751,185 @@ func (m *LinkTagMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LinkTagMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *OAuthLinkMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uint{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LinkTag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().OAuthLink.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetProvider sets the "provider" field.
+func (m *OAuthLinkMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *OAuthLinkMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the OAuthLink entity.
+// If the OAuthLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuthLinkMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *OAuthLinkMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *OAuthLinkMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *OAuthLinkMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the OAuthLink entity.
+// If the OAuthLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuthLinkMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *OAuthLinkMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *OAuthLinkMutation) SetUserID(u uint) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *OAuthLinkMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the OAuthLink entity.
+// If the OAuthLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuthLinkMutation) OldUserID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *OAuthLinkMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *OAuthLinkMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *OAuthLinkMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the OAuthLink entity.
+// If the OAuthLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuthLinkMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *OAuthLinkMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[oauthlink.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *OAuthLinkMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[oauthlink.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *OAuthLinkMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, oauthlink.FieldEmail)
+}
+
 // SetName sets the "name" field.
-func (m *LinkTagMutation) SetName(s string) {
+func (m *OAuthLinkMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *LinkTagMutation) Name() (r string, exists bool) {
+func (m *OAuthLinkMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -17266,10 +21937,10 @@ func (m *LinkTagMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the LinkTag entity.
-// If the LinkTag object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the OAuthLink entity.
+// If the OAuthLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkTagMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *OAuthLinkMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -17283,110 +21954,96 @@ func (m *LinkTagMutation) OldName(ctx context.Context) (v string, err error) {
 	return oldValue.Name, nil
 }
 
+// ClearName clears the value of the "name" field.
+func (m *OAuthLinkMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[oauthlink.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *OAuthLinkMutation) NameCleared() bool {
+	_, ok := m.clearedFields[oauthlink.FieldName]
+	return ok
+}
+
 // ResetName resets all changes to the "name" field.
-func (m *LinkTagMutation) ResetName() {
+func (m *OAuthLinkMutation) ResetName() {
 	m.name = nil
+	delete(m.clearedFields, oauthlink.FieldName)
 }
 
-// SetColor sets the "color" field.
-func (m *LinkTagMutation) SetColor(s string) {
-	m.color = &s
+// SetLinkedAt sets the "linked_at" field.
+func (m *OAuthLinkMutation) SetLinkedAt(t time.Time) {
+	m.linked_at = &t
 }
 
-// Color returns the value of the "color" field in the mutation.
-func (m *LinkTagMutation) Color() (r string, exists bool) {
-	v := m.color
+// LinkedAt returns the value of the "linked_at" field in the mutation.
+func (m *OAuthLinkMutation) LinkedAt() (r time.Time, exists bool) {
+	v := m.linked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldColor returns the old "color" field's value of the LinkTag entity.
-// If the LinkTag object wasn't provided to the builder, the object is fetched from the database.
+// OldLinkedAt returns the old "linked_at" field's value of the OAuthLink entity.
+// If the OAuthLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkTagMutation) OldColor(ctx context.Context) (v string, err error) {
+func (m *OAuthLinkMutation) OldLinkedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+		return v, errors.New("OldLinkedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColor requires an ID field in the mutation")
+		return v, errors.New("OldLinkedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColor: %w", err)
-	}
-	return oldValue.Color, nil
-}
-
-// ResetColor resets all changes to the "color" field.
-func (m *LinkTagMutation) ResetColor() {
-	m.color = nil
-}
-
-// AddLinkIDs adds the "links" edge to the Link entity by ids.
-func (m *LinkTagMutation) AddLinkIDs(ids ...int) {
-	if m.links == nil {
-		m.links = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.links[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldLinkedAt: %w", err)
 	}
+	return oldValue.LinkedAt, nil
 }
 
-// ClearLinks clears the "links" edge to the Link entity.
-func (m *LinkTagMutation) ClearLinks() {
-	m.clearedlinks = true
-}
-
-// LinksCleared reports if the "links" edge to the Link entity was cleared.
-func (m *LinkTagMutation) LinksCleared() bool {
-	return m.clearedlinks
+// ResetLinkedAt resets all changes to the "linked_at" field.
+func (m *OAuthLinkMutation) ResetLinkedAt() {
+	m.linked_at = nil
 }
 
-// RemoveLinkIDs removes the "links" edge to the Link entity by IDs.
-func (m *LinkTagMutation) RemoveLinkIDs(ids ...int) {
-	if m.removedlinks == nil {
-		m.removedlinks = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.links, ids[i])
-		m.removedlinks[ids[i]] = struct{}{}
-	}
+// ClearUser clears the "user" edge to the User entity.
+func (m *OAuthLinkMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[oauthlink.FieldUserID] = struct{}{}
 }
 
-// RemovedLinks returns the removed IDs of the "links" edge to the Link entity.
-func (m *LinkTagMutation) RemovedLinksIDs() (ids []int) {
-	for id := range m.removedlinks {
-		ids = append(ids, id)
-	}
-	return
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *OAuthLinkMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// LinksIDs returns the "links" edge IDs in the mutation.
-func (m *LinkTagMutation) LinksIDs() (ids []int) {
-	for id := range m.links {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *OAuthLinkMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetLinks resets all changes to the "links" edge.
-func (m *LinkTagMutation) ResetLinks() {
-	m.links = nil
-	m.clearedlinks = false
-	m.removedlinks = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *OAuthLinkMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the LinkTagMutation builder.
-func (m *LinkTagMutation) Where(ps ...predicate.LinkTag) {
+// Where appends a list predicates to the OAuthLinkMutation builder.
+func (m *OAuthLinkMutation) Where(ps ...predicate.OAuthLink) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LinkTagMutation builder. Using this method,
+// WhereP appends storage-level predicates to the OAuthLinkMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LinkTagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LinkTag, len(ps))
+func (m *OAuthLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OAuthLink, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -17394,30 +22051,42 @@ func (m *LinkTagMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LinkTagMutation) Op() Op {
+func (m *OAuthLinkMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LinkTagMutation) SetOp(op Op) {
+func (m *OAuthLinkMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (LinkTag).
-func (m *LinkTagMutation) Type() string {
+// Type returns the node type of this mutation (OAuthLink).
+func (m *OAuthLinkMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LinkTagMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+func (m *OAuthLinkMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.provider != nil {
+		fields = append(fields, oauthlink.FieldProvider)
+	}
+	if m.subject != nil {
+		fields = append(fields, oauthlink.FieldSubject)
+	}
+	if m.user != nil {
+		fields = append(fields, oauthlink.FieldUserID)
+	}
+	if m.email != nil {
+		fields = append(fields, oauthlink.FieldEmail)
+	}
 	if m.name != nil {
-		fields = append(fields, linktag.FieldName)
+		fields = append(fields, oauthlink.FieldName)
 	}
-	if m.color != nil {
-		fields = append(fields, linktag.FieldColor)
+	if m.linked_at != nil {
+		fields = append(fields, oauthlink.FieldLinkedAt)
 	}
 	return fields
 }
@@ -17425,12 +22094,20 @@ func (m *LinkTagMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LinkTagMutation) Field(name string) (ent.Value, bool) {
+func (m *OAuthLinkMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case linktag.FieldName:
+	case oauthlink.FieldProvider:
+		return m.Provider()
+	case oauthlink.FieldSubject:
+		return m.Subject()
+	case oauthlink.FieldUserID:
+		return m.UserID()
+	case oauthlink.FieldEmail:
+		return m.Email()
+	case oauthlink.FieldName:
 		return m.Name()
-	case linktag.FieldColor:
-		return m.Color()
+	case oauthlink.FieldLinkedAt:
+		return m.LinkedAt()
 	}
 	return nil, false
 }
@@ -17438,208 +22115,271 @@ func (m *LinkTagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LinkTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *OAuthLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case linktag.FieldName:
+	case oauthlink.FieldProvider:
+		return m.OldProvider(ctx)
+	case oauthlink.FieldSubject:
+		return m.OldSubject(ctx)
+	case oauthlink.FieldUserID:
+		return m.OldUserID(ctx)
+	case oauthlink.FieldEmail:
+		return m.OldEmail(ctx)
+	case oauthlink.FieldName:
 		return m.OldName(ctx)
-	case linktag.FieldColor:
-		return m.OldColor(ctx)
+	case oauthlink.FieldLinkedAt:
+		return m.OldLinkedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown LinkTag field %s", name)
+	return nil, fmt.Errorf("unknown OAuthLink field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkTagMutation) SetField(name string, value ent.Value) error {
+func (m *OAuthLinkMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case linktag.FieldName:
+	case oauthlink.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetProvider(v)
 		return nil
-	case linktag.FieldColor:
+	case oauthlink.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetColor(v)
+		m.SetSubject(v)
+		return nil
+	case oauthlink.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case oauthlink.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case oauthlink.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case oauthlink.FieldLinkedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LinkTag field %s", name)
+	return fmt.Errorf("unknown OAuthLink field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LinkTagMutation) AddedFields() []string {
-	return nil
+func (m *OAuthLinkMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LinkTagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *OAuthLinkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkTagMutation) AddField(name string, value ent.Value) error {
+func (m *OAuthLinkMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown LinkTag numeric field %s", name)
+	return fmt.Errorf("unknown OAuthLink numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LinkTagMutation) ClearedFields() []string {
-	return nil
+func (m *OAuthLinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(oauthlink.FieldEmail) {
+		fields = append(fields, oauthlink.FieldEmail)
+	}
+	if m.FieldCleared(oauthlink.FieldName) {
+		fields = append(fields, oauthlink.FieldName)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LinkTagMutation) FieldCleared(name string) bool {
+func (m *OAuthLinkMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LinkTagMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown LinkTag nullable field %s", name)
+func (m *OAuthLinkMutation) ClearField(name string) error {
+	switch name {
+	case oauthlink.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case oauthlink.FieldName:
+		m.ClearName()
+		return nil
+	}
+	return fmt.Errorf("unknown OAuthLink nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LinkTagMutation) ResetField(name string) error {
+func (m *OAuthLinkMutation) ResetField(name string) error {
 	switch name {
-	case linktag.FieldName:
+	case oauthlink.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case oauthlink.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case oauthlink.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case oauthlink.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case oauthlink.FieldName:
 		m.ResetName()
 		return nil
-	case linktag.FieldColor:
-		m.ResetColor()
+	case oauthlink.FieldLinkedAt:
+		m.ResetLinkedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown LinkTag field %s", name)
+	return fmt.Errorf("unknown OAuthLink field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LinkTagMutation) AddedEdges() []string {
+func (m *OAuthLinkMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.links != nil {
-		edges = append(edges, linktag.EdgeLinks)
+	if m.user != nil {
+		edges = append(edges, oauthlink.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LinkTagMutation) AddedIDs(name string) []ent.Value {
+func (m *OAuthLinkMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case linktag.EdgeLinks:
-		ids := make([]ent.Value, 0, len(m.links))
-		for id := range m.links {
-			ids = append(ids, id)
+	case oauthlink.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LinkTagMutation) RemovedEdges() []string {
+func (m *OAuthLinkMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedlinks != nil {
-		edges = append(edges, linktag.EdgeLinks)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LinkTagMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case linktag.EdgeLinks:
-		ids := make([]ent.Value, 0, len(m.removedlinks))
-		for id := range m.removedlinks {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *OAuthLinkMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LinkTagMutation) ClearedEdges() []string {
+func (m *OAuthLinkMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedlinks {
-		edges = append(edges, linktag.EdgeLinks)
+	if m.cleareduser {
+		edges = append(edges, oauthlink.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LinkTagMutation) EdgeCleared(name string) bool {
+func (m *OAuthLinkMutation) EdgeCleared(name string) bool {
 	switch name {
-	case linktag.EdgeLinks:
-		return m.clearedlinks
+	case oauthlink.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LinkTagMutation) ClearEdge(name string) error {
+func (m *OAuthLinkMutation) ClearEdge(name string) error {
 	switch name {
+	case oauthlink.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown LinkTag unique edge %s", name)
+	return fmt.Errorf("unknown OAuthLink unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LinkTagMutation) ResetEdge(name string) error {
+func (m *OAuthLinkMutation) ResetEdge(name string) error {
 	switch name {
-	case linktag.EdgeLinks:
-		m.ResetLinks()
+	case oauthlink.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown LinkTag edge %s", name)
+	return fmt.Errorf("unknown OAuthLink edge %s", name)
 }
 
-// MetadataMutation represents an operation that mutates the Metadata nodes in the graph.
-type MetadataMutation struct {
+// PageMutation represents an operation that mutates the Page nodes in the graph.
+type PageMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uint
-	deleted_at    *time.Time
-	created_at    *time.Time
-	updated_at    *time.Time
-	name          *string
-	value         *string
-	clearedFields map[string]struct{}
-	file          *uint
-	clearedfile   bool
-	done          bool
-	oldValue      func(context.Context) (*Metadata, error)
-	predicates    []predicate.Metadata
+	op                  Op
+	typ                 string
+	id                  *uint
+	deleted_at          *time.Time
+	title               *string
+	_path               *string
+	content             *string
+	markdown_content    *string
+	description         *string
+	is_published        *bool
+	show_comment        *bool
+	sort                *int
+	addsort             *int
+	data_bindings       *[]model.PageDataBinding
+	appenddata_bindings []model.PageDataBinding
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*Page, error)
+	predicates          []predicate.Page
 }
 
-var _ ent.Mutation = (*MetadataMutation)(nil)
+var _ ent.Mutation = (*PageMutation)(nil)
 
-// metadataOption allows management of the mutation configuration using functional options.
-type metadataOption func(*MetadataMutation)
+// pageOption allows management of the mutation configuration using functional options.
+type pageOption func(*PageMutation)
 
-// newMetadataMutation creates new mutation for the Metadata entity.
-func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutation {
-	m := &MetadataMutation{
+// newPageMutation creates new mutation for the Page entity.
+func newPageMutation(c config, op Op, opts ...pageOption) *PageMutation {
+	m := &PageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMetadata,
+		typ:           TypePage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -17648,20 +22388,20 @@ func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutat
 	return m
 }
 
-// withMetadataID sets the ID field of the mutation.
-func withMetadataID(id uint) metadataOption {
-	return func(m *MetadataMutation) {
+// withPageID sets the ID field of the mutation.
+func withPageID(id uint) pageOption {
+	return func(m *PageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Metadata
+			value *Page
 		)
-		m.oldValue = func(ctx context.Context) (*Metadata, error) {
+		m.oldValue = func(ctx context.Context) (*Page, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Metadata.Get(ctx, id)
+					value, err = m.Client().Page.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -17670,10 +22410,10 @@ func withMetadataID(id uint) metadataOption {
 	}
 }
 
-// withMetadata sets the old Metadata of the mutation.
-func withMetadata(node *Metadata) metadataOption {
-	return func(m *MetadataMutation) {
-		m.oldValue = func(context.Context) (*Metadata, error) {
+// withPage sets the old Page of the mutation.
+func withPage(node *Page) pageOption {
+	return func(m *PageMutation) {
+		m.oldValue = func(context.Context) (*Page, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -17682,7 +22422,7 @@ func withMetadata(node *Metadata) metadataOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MetadataMutation) Client() *Client {
+func (m PageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -17690,7 +22430,7 @@ func (m MetadataMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MetadataMutation) Tx() (*Tx, error) {
+func (m PageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -17700,14 +22440,14 @@ func (m MetadataMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Metadata entities.
-func (m *MetadataMutation) SetID(id uint) {
+// operation is only accepted on creation of Page entities.
+func (m *PageMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MetadataMutation) ID() (id uint, exists bool) {
+func (m *PageMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -17718,7 +22458,7 @@ func (m *MetadataMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MetadataMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *PageMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -17727,19 +22467,19 @@ func (m *MetadataMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Metadata.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Page.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *MetadataMutation) SetDeletedAt(t time.Time) {
+func (m *PageMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *MetadataMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *PageMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -17747,10 +22487,10 @@ func (m *MetadataMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *PageMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -17765,252 +22505,490 @@ func (m *MetadataMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *MetadataMutation) ClearDeletedAt() {
+func (m *PageMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[metadata.FieldDeletedAt] = struct{}{}
+	m.clearedFields[page.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *MetadataMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[metadata.FieldDeletedAt]
+func (m *PageMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[page.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *MetadataMutation) ResetDeletedAt() {
+func (m *PageMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, metadata.FieldDeletedAt)
+	delete(m.clearedFields, page.FieldDeletedAt)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *MetadataMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetTitle sets the "title" field.
+func (m *PageMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MetadataMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Title returns the value of the "title" field in the mutation.
+func (m *PageMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PageMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *PageMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetPath sets the "path" field.
+func (m *PageMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *PageMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *PageMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetContent sets the "content" field.
+func (m *PageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *PageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *PageMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetMarkdownContent sets the "markdown_content" field.
+func (m *PageMutation) SetMarkdownContent(s string) {
+	m.markdown_content = &s
+}
+
+// MarkdownContent returns the value of the "markdown_content" field in the mutation.
+func (m *PageMutation) MarkdownContent() (r string, exists bool) {
+	v := m.markdown_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMarkdownContent returns the old "markdown_content" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageMutation) OldMarkdownContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMarkdownContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMarkdownContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMarkdownContent: %w", err)
+	}
+	return oldValue.MarkdownContent, nil
+}
+
+// ResetMarkdownContent resets all changes to the "markdown_content" field.
+func (m *PageMutation) ResetMarkdownContent() {
+	m.markdown_content = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *PageMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PageMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *PageMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[page.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PageMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[page.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PageMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, page.FieldDescription)
+}
+
+// SetIsPublished sets the "is_published" field.
+func (m *PageMutation) SetIsPublished(b bool) {
+	m.is_published = &b
+}
+
+// IsPublished returns the value of the "is_published" field in the mutation.
+func (m *PageMutation) IsPublished() (r bool, exists bool) {
+	v := m.is_published
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPublished returns the old "is_published" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageMutation) OldIsPublished(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsPublished is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldIsPublished requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsPublished: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.IsPublished, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MetadataMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetIsPublished resets all changes to the "is_published" field.
+func (m *PageMutation) ResetIsPublished() {
+	m.is_published = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *MetadataMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetShowComment sets the "show_comment" field.
+func (m *PageMutation) SetShowComment(b bool) {
+	m.show_comment = &b
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MetadataMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ShowComment returns the value of the "show_comment" field in the mutation.
+func (m *PageMutation) ShowComment() (r bool, exists bool) {
+	v := m.show_comment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldShowComment returns the old "show_comment" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PageMutation) OldShowComment(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldShowComment is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldShowComment requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldShowComment: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ShowComment, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MetadataMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetShowComment resets all changes to the "show_comment" field.
+func (m *PageMutation) ResetShowComment() {
+	m.show_comment = nil
 }
 
-// SetName sets the "name" field.
-func (m *MetadataMutation) SetName(s string) {
-	m.name = &s
+// SetSort sets the "sort" field.
+func (m *PageMutation) SetSort(i int) {
+	m.sort = &i
+	m.addsort = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *MetadataMutation) Name() (r string, exists bool) {
-	v := m.name
+// Sort returns the value of the "sort" field in the mutation.
+func (m *PageMutation) Sort() (r int, exists bool) {
+	v := m.sort
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldSort returns the old "sort" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *PageMutation) OldSort(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldSort requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Sort, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *MetadataMutation) ResetName() {
-	m.name = nil
+// AddSort adds i to the "sort" field.
+func (m *PageMutation) AddSort(i int) {
+	if m.addsort != nil {
+		*m.addsort += i
+	} else {
+		m.addsort = &i
+	}
 }
 
-// SetValue sets the "value" field.
-func (m *MetadataMutation) SetValue(s string) {
-	m.value = &s
+// AddedSort returns the value that was added to the "sort" field in this mutation.
+func (m *PageMutation) AddedSort() (r int, exists bool) {
+	v := m.addsort
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *MetadataMutation) Value() (r string, exists bool) {
-	v := m.value
+// ResetSort resets all changes to the "sort" field.
+func (m *PageMutation) ResetSort() {
+	m.sort = nil
+	m.addsort = nil
+}
+
+// SetDataBindings sets the "data_bindings" field.
+func (m *PageMutation) SetDataBindings(mdb []model.PageDataBinding) {
+	m.data_bindings = &mdb
+	m.appenddata_bindings = nil
+}
+
+// DataBindings returns the value of the "data_bindings" field in the mutation.
+func (m *PageMutation) DataBindings() (r []model.PageDataBinding, exists bool) {
+	v := m.data_bindings
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldDataBindings returns the old "data_bindings" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *PageMutation) OldDataBindings(ctx context.Context) (v []model.PageDataBinding, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldDataBindings is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldDataBindings requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldDataBindings: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.DataBindings, nil
 }
 
-// ClearValue clears the value of the "value" field.
-func (m *MetadataMutation) ClearValue() {
-	m.value = nil
-	m.clearedFields[metadata.FieldValue] = struct{}{}
+// AppendDataBindings adds mdb to the "data_bindings" field.
+func (m *PageMutation) AppendDataBindings(mdb []model.PageDataBinding) {
+	m.appenddata_bindings = append(m.appenddata_bindings, mdb...)
 }
 
-// ValueCleared returns if the "value" field was cleared in this mutation.
-func (m *MetadataMutation) ValueCleared() bool {
-	_, ok := m.clearedFields[metadata.FieldValue]
+// AppendedDataBindings returns the list of values that were appended to the "data_bindings" field in this mutation.
+func (m *PageMutation) AppendedDataBindings() ([]model.PageDataBinding, bool) {
+	if len(m.appenddata_bindings) == 0 {
+		return nil, false
+	}
+	return m.appenddata_bindings, true
+}
+
+// ClearDataBindings clears the value of the "data_bindings" field.
+func (m *PageMutation) ClearDataBindings() {
+	m.data_bindings = nil
+	m.appenddata_bindings = nil
+	m.clearedFields[page.FieldDataBindings] = struct{}{}
+}
+
+// DataBindingsCleared returns if the "data_bindings" field was cleared in this mutation.
+func (m *PageMutation) DataBindingsCleared() bool {
+	_, ok := m.clearedFields[page.FieldDataBindings]
 	return ok
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *MetadataMutation) ResetValue() {
-	m.value = nil
-	delete(m.clearedFields, metadata.FieldValue)
+// ResetDataBindings resets all changes to the "data_bindings" field.
+func (m *PageMutation) ResetDataBindings() {
+	m.data_bindings = nil
+	m.appenddata_bindings = nil
+	delete(m.clearedFields, page.FieldDataBindings)
 }
 
-// SetFileID sets the "file_id" field.
-func (m *MetadataMutation) SetFileID(u uint) {
-	m.file = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *PageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// FileID returns the value of the "file_id" field in the mutation.
-func (m *MetadataMutation) FileID() (r uint, exists bool) {
-	v := m.file
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileID returns the old "file_id" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldFileID(ctx context.Context) (v uint, err error) {
+func (m *PageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.FileID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetFileID resets all changes to the "file_id" field.
-func (m *MetadataMutation) ResetFileID() {
-	m.file = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PageMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearFile clears the "file" edge to the File entity.
-func (m *MetadataMutation) ClearFile() {
-	m.clearedfile = true
-	m.clearedFields[metadata.FieldFileID] = struct{}{}
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// FileCleared reports if the "file" edge to the File entity was cleared.
-func (m *MetadataMutation) FileCleared() bool {
-	return m.clearedfile
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FileIDs returns the "file" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FileID instead. It exists only for internal usage by the builders.
-func (m *MetadataMutation) FileIDs() (ids []uint) {
-	if id := m.file; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the Page entity.
+// If the Page object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetFile resets all changes to the "file" edge.
-func (m *MetadataMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the MetadataMutation builder.
-func (m *MetadataMutation) Where(ps ...predicate.Metadata) {
+// Where appends a list predicates to the PageMutation builder.
+func (m *PageMutation) Where(ps ...predicate.Page) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MetadataMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Metadata, len(ps))
+func (m *PageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Page, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -18018,42 +22996,60 @@ func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MetadataMutation) Op() Op {
+func (m *PageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MetadataMutation) SetOp(op Op) {
+func (m *PageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Metadata).
-func (m *MetadataMutation) Type() string {
+// Type returns the node type of this mutation (Page).
+func (m *PageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MetadataMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *PageMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.deleted_at != nil {
-		fields = append(fields, metadata.FieldDeletedAt)
+		fields = append(fields, page.FieldDeletedAt)
 	}
-	if m.created_at != nil {
-		fields = append(fields, metadata.FieldCreatedAt)
+	if m.title != nil {
+		fields = append(fields, page.FieldTitle)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, metadata.FieldUpdatedAt)
+	if m._path != nil {
+		fields = append(fields, page.FieldPath)
+	}
+	if m.content != nil {
+		fields = append(fields, page.FieldContent)
+	}
+	if m.markdown_content != nil {
+		fields = append(fields, page.FieldMarkdownContent)
+	}
+	if m.description != nil {
+		fields = append(fields, page.FieldDescription)
+	}
+	if m.is_published != nil {
+		fields = append(fields, page.FieldIsPublished)
+	}
+	if m.show_comment != nil {
+		fields = append(fields, page.FieldShowComment)
+	}
+	if m.sort != nil {
+		fields = append(fields, page.FieldSort)
 	}
-	if m.name != nil {
-		fields = append(fields, metadata.FieldName)
+	if m.data_bindings != nil {
+		fields = append(fields, page.FieldDataBindings)
 	}
-	if m.value != nil {
-		fields = append(fields, metadata.FieldValue)
+	if m.created_at != nil {
+		fields = append(fields, page.FieldCreatedAt)
 	}
-	if m.file != nil {
-		fields = append(fields, metadata.FieldFileID)
+	if m.updated_at != nil {
+		fields = append(fields, page.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -18061,20 +23057,32 @@ func (m *MetadataMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
+func (m *PageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case metadata.FieldDeletedAt:
+	case page.FieldDeletedAt:
 		return m.DeletedAt()
-	case metadata.FieldCreatedAt:
+	case page.FieldTitle:
+		return m.Title()
+	case page.FieldPath:
+		return m.Path()
+	case page.FieldContent:
+		return m.Content()
+	case page.FieldMarkdownContent:
+		return m.MarkdownContent()
+	case page.FieldDescription:
+		return m.Description()
+	case page.FieldIsPublished:
+		return m.IsPublished()
+	case page.FieldShowComment:
+		return m.ShowComment()
+	case page.FieldSort:
+		return m.Sort()
+	case page.FieldDataBindings:
+		return m.DataBindings()
+	case page.FieldCreatedAt:
 		return m.CreatedAt()
-	case metadata.FieldUpdatedAt:
+	case page.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case metadata.FieldName:
-		return m.Name()
-	case metadata.FieldValue:
-		return m.Value()
-	case metadata.FieldFileID:
-		return m.FileID()
 	}
 	return nil, false
 }
@@ -18082,87 +23090,146 @@ func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case metadata.FieldDeletedAt:
+	case page.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case metadata.FieldCreatedAt:
+	case page.FieldTitle:
+		return m.OldTitle(ctx)
+	case page.FieldPath:
+		return m.OldPath(ctx)
+	case page.FieldContent:
+		return m.OldContent(ctx)
+	case page.FieldMarkdownContent:
+		return m.OldMarkdownContent(ctx)
+	case page.FieldDescription:
+		return m.OldDescription(ctx)
+	case page.FieldIsPublished:
+		return m.OldIsPublished(ctx)
+	case page.FieldShowComment:
+		return m.OldShowComment(ctx)
+	case page.FieldSort:
+		return m.OldSort(ctx)
+	case page.FieldDataBindings:
+		return m.OldDataBindings(ctx)
+	case page.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case metadata.FieldUpdatedAt:
+	case page.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case metadata.FieldName:
-		return m.OldName(ctx)
-	case metadata.FieldValue:
-		return m.OldValue(ctx)
-	case metadata.FieldFileID:
-		return m.OldFileID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Metadata field %s", name)
+	return nil, fmt.Errorf("unknown Page field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MetadataMutation) SetField(name string, value ent.Value) error {
+func (m *PageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case metadata.FieldDeletedAt:
+	case page.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case metadata.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case page.FieldTitle:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetTitle(v)
 		return nil
-	case metadata.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case page.FieldPath:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetPath(v)
 		return nil
-	case metadata.FieldName:
+	case page.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetContent(v)
 		return nil
-	case metadata.FieldValue:
+	case page.FieldMarkdownContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetMarkdownContent(v)
 		return nil
-	case metadata.FieldFileID:
-		v, ok := value.(uint)
+	case page.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileID(v)
+		m.SetDescription(v)
+		return nil
+	case page.FieldIsPublished:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublished(v)
+		return nil
+	case page.FieldShowComment:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShowComment(v)
+		return nil
+	case page.FieldSort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSort(v)
+		return nil
+	case page.FieldDataBindings:
+		v, ok := value.([]model.PageDataBinding)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataBindings(v)
+		return nil
+	case page.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case page.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata field %s", name)
+	return fmt.Errorf("unknown Page field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MetadataMutation) AddedFields() []string {
+func (m *PageMutation) AddedFields() []string {
 	var fields []string
+	if m.addsort != nil {
+		fields = append(fields, page.FieldSort)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MetadataMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PageMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case page.FieldSort:
+		return m.AddedSort()
 	}
 	return nil, false
 }
@@ -18170,182 +23237,187 @@ func (m *MetadataMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MetadataMutation) AddField(name string, value ent.Value) error {
+func (m *PageMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case page.FieldSort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSort(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Metadata numeric field %s", name)
+	return fmt.Errorf("unknown Page numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MetadataMutation) ClearedFields() []string {
+func (m *PageMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(metadata.FieldDeletedAt) {
-		fields = append(fields, metadata.FieldDeletedAt)
+	if m.FieldCleared(page.FieldDeletedAt) {
+		fields = append(fields, page.FieldDeletedAt)
 	}
-	if m.FieldCleared(metadata.FieldValue) {
-		fields = append(fields, metadata.FieldValue)
+	if m.FieldCleared(page.FieldDescription) {
+		fields = append(fields, page.FieldDescription)
+	}
+	if m.FieldCleared(page.FieldDataBindings) {
+		fields = append(fields, page.FieldDataBindings)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MetadataMutation) FieldCleared(name string) bool {
+func (m *PageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MetadataMutation) ClearField(name string) error {
+func (m *PageMutation) ClearField(name string) error {
 	switch name {
-	case metadata.FieldDeletedAt:
+	case page.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case metadata.FieldValue:
-		m.ClearValue()
+	case page.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case page.FieldDataBindings:
+		m.ClearDataBindings()
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata nullable field %s", name)
+	return fmt.Errorf("unknown Page nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MetadataMutation) ResetField(name string) error {
+func (m *PageMutation) ResetField(name string) error {
 	switch name {
-	case metadata.FieldDeletedAt:
+	case page.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case metadata.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case page.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case metadata.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case page.FieldPath:
+		m.ResetPath()
 		return nil
-	case metadata.FieldName:
-		m.ResetName()
+	case page.FieldContent:
+		m.ResetContent()
 		return nil
-	case metadata.FieldValue:
-		m.ResetValue()
+	case page.FieldMarkdownContent:
+		m.ResetMarkdownContent()
 		return nil
-	case metadata.FieldFileID:
-		m.ResetFileID()
+	case page.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case page.FieldIsPublished:
+		m.ResetIsPublished()
+		return nil
+	case page.FieldShowComment:
+		m.ResetShowComment()
+		return nil
+	case page.FieldSort:
+		m.ResetSort()
+		return nil
+	case page.FieldDataBindings:
+		m.ResetDataBindings()
+		return nil
+	case page.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case page.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata field %s", name)
+	return fmt.Errorf("unknown Page field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MetadataMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.file != nil {
-		edges = append(edges, metadata.EdgeFile)
-	}
+func (m *PageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MetadataMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case metadata.EdgeFile:
-		if id := m.file; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *PageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MetadataMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *PageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MetadataMutation) RemovedIDs(name string) []ent.Value {
+func (m *PageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MetadataMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedfile {
-		edges = append(edges, metadata.EdgeFile)
-	}
+func (m *PageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MetadataMutation) EdgeCleared(name string) bool {
-	switch name {
-	case metadata.EdgeFile:
-		return m.clearedfile
-	}
+func (m *PageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MetadataMutation) ClearEdge(name string) error {
-	switch name {
-	case metadata.EdgeFile:
-		m.ClearFile()
-		return nil
-	}
-	return fmt.Errorf("unknown Metadata unique edge %s", name)
+func (m *PageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Page unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MetadataMutation) ResetEdge(name string) error {
-	switch name {
-	case metadata.EdgeFile:
-		m.ResetFile()
-		return nil
-	}
-	return fmt.Errorf("unknown Metadata edge %s", name)
+func (m *PageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Page edge %s", name)
 }
 
-// NotificationTypeMutation represents an operation that mutates the NotificationType nodes in the graph.
-type NotificationTypeMutation struct {
+// PostCategoryMutation represents an operation that mutates the PostCategory nodes in the graph.
+type PostCategoryMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *uint
-	created_at               *time.Time
-	updated_at               *time.Time
-	code                     *string
-	name                     *string
-	description              *string
-	category                 *string
-	is_active                *bool
-	default_enabled          *bool
-	supported_channels       *[]string
-	appendsupported_channels []string
-	clearedFields            map[string]struct{}
-	user_configs             map[uint]struct{}
-	removeduser_configs      map[uint]struct{}
-	cleareduser_configs      bool
-	done                     bool
-	oldValue                 func(context.Context) (*NotificationType, error)
-	predicates               []predicate.NotificationType
+	op              Op
+	typ             string
+	id              *uint
+	deleted_at      *time.Time
+	created_at      *time.Time
+	updated_at      *time.Time
+	name            *string
+	description     *string
+	count           *int
+	addcount        *int
+	is_series       *bool
+	sort_order      *int
+	addsort_order   *int
+	clearedFields   map[string]struct{}
+	articles        map[uint]struct{}
+	removedarticles map[uint]struct{}
+	clearedarticles bool
+	done            bool
+	oldValue        func(context.Context) (*PostCategory, error)
+	predicates      []predicate.PostCategory
 }
 
-var _ ent.Mutation = (*NotificationTypeMutation)(nil)
+var _ ent.Mutation = (*PostCategoryMutation)(nil)
 
-// notificationtypeOption allows management of the mutation configuration using functional options.
-type notificationtypeOption func(*NotificationTypeMutation)
+// postcategoryOption allows management of the mutation configuration using functional options.
+type postcategoryOption func(*PostCategoryMutation)
 
-// newNotificationTypeMutation creates new mutation for the NotificationType entity.
-func newNotificationTypeMutation(c config, op Op, opts ...notificationtypeOption) *NotificationTypeMutation {
-	m := &NotificationTypeMutation{
+// newPostCategoryMutation creates new mutation for the PostCategory entity.
+func newPostCategoryMutation(c config, op Op, opts ...postcategoryOption) *PostCategoryMutation {
+	m := &PostCategoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNotificationType,
+		typ:           TypePostCategory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -18354,20 +23426,20 @@ func newNotificationTypeMutation(c config, op Op, opts ...notificationtypeOption
 	return m
 }
 
-// withNotificationTypeID sets the ID field of the mutation.
-func withNotificationTypeID(id uint) notificationtypeOption {
-	return func(m *NotificationTypeMutation) {
+// withPostCategoryID sets the ID field of the mutation.
+func withPostCategoryID(id uint) postcategoryOption {
+	return func(m *PostCategoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NotificationType
+			value *PostCategory
 		)
-		m.oldValue = func(ctx context.Context) (*NotificationType, error) {
+		m.oldValue = func(ctx context.Context) (*PostCategory, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NotificationType.Get(ctx, id)
+					value, err = m.Client().PostCategory.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -18376,10 +23448,10 @@ func withNotificationTypeID(id uint) notificationtypeOption {
 	}
 }
 
-// withNotificationType sets the old NotificationType of the mutation.
-func withNotificationType(node *NotificationType) notificationtypeOption {
-	return func(m *NotificationTypeMutation) {
-		m.oldValue = func(context.Context) (*NotificationType, error) {
+// withPostCategory sets the old PostCategory of the mutation.
+func withPostCategory(node *PostCategory) postcategoryOption {
+	return func(m *PostCategoryMutation) {
+		m.oldValue = func(context.Context) (*PostCategory, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -18388,7 +23460,7 @@ func withNotificationType(node *NotificationType) notificationtypeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NotificationTypeMutation) Client() *Client {
+func (m PostCategoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -18396,7 +23468,7 @@ func (m NotificationTypeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NotificationTypeMutation) Tx() (*Tx, error) {
+func (m PostCategoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -18406,14 +23478,14 @@ func (m NotificationTypeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of NotificationType entities.
-func (m *NotificationTypeMutation) SetID(id uint) {
+// operation is only accepted on creation of PostCategory entities.
+func (m *PostCategoryMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NotificationTypeMutation) ID() (id uint, exists bool) {
+func (m *PostCategoryMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -18424,7 +23496,7 @@ func (m *NotificationTypeMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NotificationTypeMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *PostCategoryMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -18433,127 +23505,140 @@ func (m *NotificationTypeMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NotificationType.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PostCategory.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NotificationTypeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostCategoryMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NotificationTypeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostCategoryMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the NotificationType entity.
-// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationTypeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PostCategoryMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NotificationTypeMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostCategoryMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[postcategory.FieldDeletedAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *NotificationTypeMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostCategoryMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[postcategory.FieldDeletedAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NotificationTypeMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostCategoryMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, postcategory.FieldDeletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostCategoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostCategoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the NotificationType entity.
-// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationTypeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PostCategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NotificationTypeMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostCategoryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCode sets the "code" field.
-func (m *NotificationTypeMutation) SetCode(s string) {
-	m.code = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PostCategoryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *NotificationTypeMutation) Code() (r string, exists bool) {
-	v := m.code
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PostCategoryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the NotificationType entity.
-// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationTypeMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *PostCategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Code, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *NotificationTypeMutation) ResetCode() {
-	m.code = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PostCategoryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
 // SetName sets the "name" field.
-func (m *NotificationTypeMutation) SetName(s string) {
+func (m *PostCategoryMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *NotificationTypeMutation) Name() (r string, exists bool) {
+func (m *PostCategoryMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -18561,10 +23646,10 @@ func (m *NotificationTypeMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the NotificationType entity.
-// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationTypeMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *PostCategoryMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -18579,17 +23664,17 @@ func (m *NotificationTypeMutation) OldName(ctx context.Context) (v string, err e
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *NotificationTypeMutation) ResetName() {
+func (m *PostCategoryMutation) ResetName() {
 	m.name = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *NotificationTypeMutation) SetDescription(s string) {
+func (m *PostCategoryMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *NotificationTypeMutation) Description() (r string, exists bool) {
+func (m *PostCategoryMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -18597,10 +23682,10 @@ func (m *NotificationTypeMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the NotificationType entity.
-// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationTypeMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *PostCategoryMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -18615,259 +23700,234 @@ func (m *NotificationTypeMutation) OldDescription(ctx context.Context) (v string
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *NotificationTypeMutation) ClearDescription() {
+func (m *PostCategoryMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[notificationtype.FieldDescription] = struct{}{}
+	m.clearedFields[postcategory.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *NotificationTypeMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[notificationtype.FieldDescription]
+func (m *PostCategoryMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[postcategory.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *NotificationTypeMutation) ResetDescription() {
+func (m *PostCategoryMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, notificationtype.FieldDescription)
+	delete(m.clearedFields, postcategory.FieldDescription)
 }
 
-// SetCategory sets the "category" field.
-func (m *NotificationTypeMutation) SetCategory(s string) {
-	m.category = &s
+// SetCount sets the "count" field.
+func (m *PostCategoryMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *NotificationTypeMutation) Category() (r string, exists bool) {
-	v := m.category
+// Count returns the value of the "count" field in the mutation.
+func (m *PostCategoryMutation) Count() (r int, exists bool) {
+	v := m.count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the NotificationType entity.
-// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// OldCount returns the old "count" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationTypeMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *PostCategoryMutation) OldCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
 	}
-	return oldValue.Category, nil
-}
-
-// ResetCategory resets all changes to the "category" field.
-func (m *NotificationTypeMutation) ResetCategory() {
-	m.category = nil
+	return oldValue.Count, nil
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *NotificationTypeMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// AddCount adds i to the "count" field.
+func (m *PostCategoryMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *NotificationTypeMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *PostCategoryMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the NotificationType entity.
-// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationTypeMutation) OldIsActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
-	}
-	return oldValue.IsActive, nil
-}
-
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *NotificationTypeMutation) ResetIsActive() {
-	m.is_active = nil
+// ResetCount resets all changes to the "count" field.
+func (m *PostCategoryMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
 }
 
-// SetDefaultEnabled sets the "default_enabled" field.
-func (m *NotificationTypeMutation) SetDefaultEnabled(b bool) {
-	m.default_enabled = &b
+// SetIsSeries sets the "is_series" field.
+func (m *PostCategoryMutation) SetIsSeries(b bool) {
+	m.is_series = &b
 }
 
-// DefaultEnabled returns the value of the "default_enabled" field in the mutation.
-func (m *NotificationTypeMutation) DefaultEnabled() (r bool, exists bool) {
-	v := m.default_enabled
+// IsSeries returns the value of the "is_series" field in the mutation.
+func (m *PostCategoryMutation) IsSeries() (r bool, exists bool) {
+	v := m.is_series
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDefaultEnabled returns the old "default_enabled" field's value of the NotificationType entity.
-// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// OldIsSeries returns the old "is_series" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationTypeMutation) OldDefaultEnabled(ctx context.Context) (v bool, err error) {
+func (m *PostCategoryMutation) OldIsSeries(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefaultEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsSeries is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefaultEnabled requires an ID field in the mutation")
+		return v, errors.New("OldIsSeries requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefaultEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsSeries: %w", err)
 	}
-	return oldValue.DefaultEnabled, nil
+	return oldValue.IsSeries, nil
 }
 
-// ResetDefaultEnabled resets all changes to the "default_enabled" field.
-func (m *NotificationTypeMutation) ResetDefaultEnabled() {
-	m.default_enabled = nil
+// ResetIsSeries resets all changes to the "is_series" field.
+func (m *PostCategoryMutation) ResetIsSeries() {
+	m.is_series = nil
 }
 
-// SetSupportedChannels sets the "supported_channels" field.
-func (m *NotificationTypeMutation) SetSupportedChannels(s []string) {
-	m.supported_channels = &s
-	m.appendsupported_channels = nil
+// SetSortOrder sets the "sort_order" field.
+func (m *PostCategoryMutation) SetSortOrder(i int) {
+	m.sort_order = &i
+	m.addsort_order = nil
 }
 
-// SupportedChannels returns the value of the "supported_channels" field in the mutation.
-func (m *NotificationTypeMutation) SupportedChannels() (r []string, exists bool) {
-	v := m.supported_channels
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *PostCategoryMutation) SortOrder() (r int, exists bool) {
+	v := m.sort_order
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSupportedChannels returns the old "supported_channels" field's value of the NotificationType entity.
-// If the NotificationType object wasn't provided to the builder, the object is fetched from the database.
+// OldSortOrder returns the old "sort_order" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationTypeMutation) OldSupportedChannels(ctx context.Context) (v []string, err error) {
+func (m *PostCategoryMutation) OldSortOrder(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSupportedChannels is only allowed on UpdateOne operations")
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSupportedChannels requires an ID field in the mutation")
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSupportedChannels: %w", err)
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
 	}
-	return oldValue.SupportedChannels, nil
-}
-
-// AppendSupportedChannels adds s to the "supported_channels" field.
-func (m *NotificationTypeMutation) AppendSupportedChannels(s []string) {
-	m.appendsupported_channels = append(m.appendsupported_channels, s...)
+	return oldValue.SortOrder, nil
 }
 
-// AppendedSupportedChannels returns the list of values that were appended to the "supported_channels" field in this mutation.
-func (m *NotificationTypeMutation) AppendedSupportedChannels() ([]string, bool) {
-	if len(m.appendsupported_channels) == 0 {
-		return nil, false
+// AddSortOrder adds i to the "sort_order" field.
+func (m *PostCategoryMutation) AddSortOrder(i int) {
+	if m.addsort_order != nil {
+		*m.addsort_order += i
+	} else {
+		m.addsort_order = &i
 	}
-	return m.appendsupported_channels, true
 }
 
-// ClearSupportedChannels clears the value of the "supported_channels" field.
-func (m *NotificationTypeMutation) ClearSupportedChannels() {
-	m.supported_channels = nil
-	m.appendsupported_channels = nil
-	m.clearedFields[notificationtype.FieldSupportedChannels] = struct{}{}
-}
-
-// SupportedChannelsCleared returns if the "supported_channels" field was cleared in this mutation.
-func (m *NotificationTypeMutation) SupportedChannelsCleared() bool {
-	_, ok := m.clearedFields[notificationtype.FieldSupportedChannels]
-	return ok
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *PostCategoryMutation) AddedSortOrder() (r int, exists bool) {
+	v := m.addsort_order
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetSupportedChannels resets all changes to the "supported_channels" field.
-func (m *NotificationTypeMutation) ResetSupportedChannels() {
-	m.supported_channels = nil
-	m.appendsupported_channels = nil
-	delete(m.clearedFields, notificationtype.FieldSupportedChannels)
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *PostCategoryMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
 }
 
-// AddUserConfigIDs adds the "user_configs" edge to the UserNotificationConfig entity by ids.
-func (m *NotificationTypeMutation) AddUserConfigIDs(ids ...uint) {
-	if m.user_configs == nil {
-		m.user_configs = make(map[uint]struct{})
+// AddArticleIDs adds the "articles" edge to the Article entity by ids.
+func (m *PostCategoryMutation) AddArticleIDs(ids ...uint) {
+	if m.articles == nil {
+		m.articles = make(map[uint]struct{})
 	}
 	for i := range ids {
-		m.user_configs[ids[i]] = struct{}{}
+		m.articles[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUserConfigs clears the "user_configs" edge to the UserNotificationConfig entity.
-func (m *NotificationTypeMutation) ClearUserConfigs() {
-	m.cleareduser_configs = true
+// ClearArticles clears the "articles" edge to the Article entity.
+func (m *PostCategoryMutation) ClearArticles() {
+	m.clearedarticles = true
 }
 
-// UserConfigsCleared reports if the "user_configs" edge to the UserNotificationConfig entity was cleared.
-func (m *NotificationTypeMutation) UserConfigsCleared() bool {
-	return m.cleareduser_configs
+// ArticlesCleared reports if the "articles" edge to the Article entity was cleared.
+func (m *PostCategoryMutation) ArticlesCleared() bool {
+	return m.clearedarticles
 }
 
-// RemoveUserConfigIDs removes the "user_configs" edge to the UserNotificationConfig entity by IDs.
-func (m *NotificationTypeMutation) RemoveUserConfigIDs(ids ...uint) {
-	if m.removeduser_configs == nil {
-		m.removeduser_configs = make(map[uint]struct{})
+// RemoveArticleIDs removes the "articles" edge to the Article entity by IDs.
+func (m *PostCategoryMutation) RemoveArticleIDs(ids ...uint) {
+	if m.removedarticles == nil {
+		m.removedarticles = make(map[uint]struct{})
 	}
 	for i := range ids {
-		delete(m.user_configs, ids[i])
-		m.removeduser_configs[ids[i]] = struct{}{}
+		delete(m.articles, ids[i])
+		m.removedarticles[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUserConfigs returns the removed IDs of the "user_configs" edge to the UserNotificationConfig entity.
-func (m *NotificationTypeMutation) RemovedUserConfigsIDs() (ids []uint) {
-	for id := range m.removeduser_configs {
+// RemovedArticles returns the removed IDs of the "articles" edge to the Article entity.
+func (m *PostCategoryMutation) RemovedArticlesIDs() (ids []uint) {
+	for id := range m.removedarticles {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UserConfigsIDs returns the "user_configs" edge IDs in the mutation.
-func (m *NotificationTypeMutation) UserConfigsIDs() (ids []uint) {
-	for id := range m.user_configs {
+// ArticlesIDs returns the "articles" edge IDs in the mutation.
+func (m *PostCategoryMutation) ArticlesIDs() (ids []uint) {
+	for id := range m.articles {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUserConfigs resets all changes to the "user_configs" edge.
-func (m *NotificationTypeMutation) ResetUserConfigs() {
-	m.user_configs = nil
-	m.cleareduser_configs = false
-	m.removeduser_configs = nil
+// ResetArticles resets all changes to the "articles" edge.
+func (m *PostCategoryMutation) ResetArticles() {
+	m.articles = nil
+	m.clearedarticles = false
+	m.removedarticles = nil
 }
 
-// Where appends a list predicates to the NotificationTypeMutation builder.
-func (m *NotificationTypeMutation) Where(ps ...predicate.NotificationType) {
+// Where appends a list predicates to the PostCategoryMutation builder.
+func (m *PostCategoryMutation) Where(ps ...predicate.PostCategory) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NotificationTypeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PostCategoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NotificationTypeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NotificationType, len(ps))
+func (m *PostCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostCategory, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -18875,51 +23935,48 @@ func (m *NotificationTypeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NotificationTypeMutation) Op() Op {
+func (m *PostCategoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NotificationTypeMutation) SetOp(op Op) {
+func (m *PostCategoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (NotificationType).
-func (m *NotificationTypeMutation) Type() string {
+// Type returns the node type of this mutation (PostCategory).
+func (m *PostCategoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *NotificationTypeMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+// AddedFields().
+func (m *PostCategoryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.deleted_at != nil {
+		fields = append(fields, postcategory.FieldDeletedAt)
+	}
 	if m.created_at != nil {
-		fields = append(fields, notificationtype.FieldCreatedAt)
+		fields = append(fields, postcategory.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, notificationtype.FieldUpdatedAt)
-	}
-	if m.code != nil {
-		fields = append(fields, notificationtype.FieldCode)
+		fields = append(fields, postcategory.FieldUpdatedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, notificationtype.FieldName)
+		fields = append(fields, postcategory.FieldName)
 	}
 	if m.description != nil {
-		fields = append(fields, notificationtype.FieldDescription)
-	}
-	if m.category != nil {
-		fields = append(fields, notificationtype.FieldCategory)
+		fields = append(fields, postcategory.FieldDescription)
 	}
-	if m.is_active != nil {
-		fields = append(fields, notificationtype.FieldIsActive)
+	if m.count != nil {
+		fields = append(fields, postcategory.FieldCount)
 	}
-	if m.default_enabled != nil {
-		fields = append(fields, notificationtype.FieldDefaultEnabled)
+	if m.is_series != nil {
+		fields = append(fields, postcategory.FieldIsSeries)
 	}
-	if m.supported_channels != nil {
-		fields = append(fields, notificationtype.FieldSupportedChannels)
+	if m.sort_order != nil {
+		fields = append(fields, postcategory.FieldSortOrder)
 	}
 	return fields
 }
@@ -18927,26 +23984,24 @@ func (m *NotificationTypeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NotificationTypeMutation) Field(name string) (ent.Value, bool) {
+func (m *PostCategoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case notificationtype.FieldCreatedAt:
+	case postcategory.FieldDeletedAt:
+		return m.DeletedAt()
+	case postcategory.FieldCreatedAt:
 		return m.CreatedAt()
-	case notificationtype.FieldUpdatedAt:
+	case postcategory.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case notificationtype.FieldCode:
-		return m.Code()
-	case notificationtype.FieldName:
+	case postcategory.FieldName:
 		return m.Name()
-	case notificationtype.FieldDescription:
+	case postcategory.FieldDescription:
 		return m.Description()
-	case notificationtype.FieldCategory:
-		return m.Category()
-	case notificationtype.FieldIsActive:
-		return m.IsActive()
-	case notificationtype.FieldDefaultEnabled:
-		return m.DefaultEnabled()
-	case notificationtype.FieldSupportedChannels:
-		return m.SupportedChannels()
+	case postcategory.FieldCount:
+		return m.Count()
+	case postcategory.FieldIsSeries:
+		return m.IsSeries()
+	case postcategory.FieldSortOrder:
+		return m.SortOrder()
 	}
 	return nil, false
 }
@@ -18954,209 +24009,224 @@ func (m *NotificationTypeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NotificationTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PostCategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case notificationtype.FieldCreatedAt:
+	case postcategory.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case postcategory.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case notificationtype.FieldUpdatedAt:
+	case postcategory.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case notificationtype.FieldCode:
-		return m.OldCode(ctx)
-	case notificationtype.FieldName:
+	case postcategory.FieldName:
 		return m.OldName(ctx)
-	case notificationtype.FieldDescription:
+	case postcategory.FieldDescription:
 		return m.OldDescription(ctx)
-	case notificationtype.FieldCategory:
-		return m.OldCategory(ctx)
-	case notificationtype.FieldIsActive:
-		return m.OldIsActive(ctx)
-	case notificationtype.FieldDefaultEnabled:
-		return m.OldDefaultEnabled(ctx)
-	case notificationtype.FieldSupportedChannels:
-		return m.OldSupportedChannels(ctx)
+	case postcategory.FieldCount:
+		return m.OldCount(ctx)
+	case postcategory.FieldIsSeries:
+		return m.OldIsSeries(ctx)
+	case postcategory.FieldSortOrder:
+		return m.OldSortOrder(ctx)
 	}
-	return nil, fmt.Errorf("unknown NotificationType field %s", name)
+	return nil, fmt.Errorf("unknown PostCategory field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotificationTypeMutation) SetField(name string, value ent.Value) error {
+func (m *PostCategoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case notificationtype.FieldCreatedAt:
+	case postcategory.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetDeletedAt(v)
 		return nil
-	case notificationtype.FieldUpdatedAt:
+	case postcategory.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case notificationtype.FieldCode:
-		v, ok := value.(string)
+	case postcategory.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCode(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case notificationtype.FieldName:
+	case postcategory.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case notificationtype.FieldDescription:
+	case postcategory.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case notificationtype.FieldCategory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
-		return nil
-	case notificationtype.FieldIsActive:
-		v, ok := value.(bool)
+	case postcategory.FieldCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsActive(v)
+		m.SetCount(v)
 		return nil
-	case notificationtype.FieldDefaultEnabled:
+	case postcategory.FieldIsSeries:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDefaultEnabled(v)
+		m.SetIsSeries(v)
 		return nil
-	case notificationtype.FieldSupportedChannels:
-		v, ok := value.([]string)
+	case postcategory.FieldSortOrder:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSupportedChannels(v)
+		m.SetSortOrder(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationType field %s", name)
+	return fmt.Errorf("unknown PostCategory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NotificationTypeMutation) AddedFields() []string {
-	return nil
+func (m *PostCategoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addcount != nil {
+		fields = append(fields, postcategory.FieldCount)
+	}
+	if m.addsort_order != nil {
+		fields = append(fields, postcategory.FieldSortOrder)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NotificationTypeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PostCategoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case postcategory.FieldCount:
+		return m.AddedCount()
+	case postcategory.FieldSortOrder:
+		return m.AddedSortOrder()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotificationTypeMutation) AddField(name string, value ent.Value) error {
+func (m *PostCategoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case postcategory.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
+	case postcategory.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortOrder(v)
+		return nil
 	}
-	return fmt.Errorf("unknown NotificationType numeric field %s", name)
+	return fmt.Errorf("unknown PostCategory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NotificationTypeMutation) ClearedFields() []string {
+func (m *PostCategoryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(notificationtype.FieldDescription) {
-		fields = append(fields, notificationtype.FieldDescription)
+	if m.FieldCleared(postcategory.FieldDeletedAt) {
+		fields = append(fields, postcategory.FieldDeletedAt)
 	}
-	if m.FieldCleared(notificationtype.FieldSupportedChannels) {
-		fields = append(fields, notificationtype.FieldSupportedChannels)
+	if m.FieldCleared(postcategory.FieldDescription) {
+		fields = append(fields, postcategory.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NotificationTypeMutation) FieldCleared(name string) bool {
+func (m *PostCategoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NotificationTypeMutation) ClearField(name string) error {
+func (m *PostCategoryMutation) ClearField(name string) error {
 	switch name {
-	case notificationtype.FieldDescription:
-		m.ClearDescription()
+	case postcategory.FieldDeletedAt:
+		m.ClearDeletedAt()
 		return nil
-	case notificationtype.FieldSupportedChannels:
-		m.ClearSupportedChannels()
+	case postcategory.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationType nullable field %s", name)
+	return fmt.Errorf("unknown PostCategory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NotificationTypeMutation) ResetField(name string) error {
+func (m *PostCategoryMutation) ResetField(name string) error {
 	switch name {
-	case notificationtype.FieldCreatedAt:
+	case postcategory.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case postcategory.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case notificationtype.FieldUpdatedAt:
+	case postcategory.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case notificationtype.FieldCode:
-		m.ResetCode()
-		return nil
-	case notificationtype.FieldName:
+	case postcategory.FieldName:
 		m.ResetName()
 		return nil
-	case notificationtype.FieldDescription:
+	case postcategory.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case notificationtype.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case notificationtype.FieldIsActive:
-		m.ResetIsActive()
+	case postcategory.FieldCount:
+		m.ResetCount()
 		return nil
-	case notificationtype.FieldDefaultEnabled:
-		m.ResetDefaultEnabled()
+	case postcategory.FieldIsSeries:
+		m.ResetIsSeries()
 		return nil
-	case notificationtype.FieldSupportedChannels:
-		m.ResetSupportedChannels()
+	case postcategory.FieldSortOrder:
+		m.ResetSortOrder()
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationType field %s", name)
+	return fmt.Errorf("unknown PostCategory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NotificationTypeMutation) AddedEdges() []string {
+func (m *PostCategoryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.user_configs != nil {
-		edges = append(edges, notificationtype.EdgeUserConfigs)
+	if m.articles != nil {
+		edges = append(edges, postcategory.EdgeArticles)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NotificationTypeMutation) AddedIDs(name string) []ent.Value {
+func (m *PostCategoryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case notificationtype.EdgeUserConfigs:
-		ids := make([]ent.Value, 0, len(m.user_configs))
-		for id := range m.user_configs {
+	case postcategory.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.articles))
+		for id := range m.articles {
 			ids = append(ids, id)
 		}
 		return ids
@@ -19165,21 +24235,21 @@ func (m *NotificationTypeMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NotificationTypeMutation) RemovedEdges() []string {
+func (m *PostCategoryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removeduser_configs != nil {
-		edges = append(edges, notificationtype.EdgeUserConfigs)
+	if m.removedarticles != nil {
+		edges = append(edges, postcategory.EdgeArticles)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NotificationTypeMutation) RemovedIDs(name string) []ent.Value {
+func (m *PostCategoryMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case notificationtype.EdgeUserConfigs:
-		ids := make([]ent.Value, 0, len(m.removeduser_configs))
-		for id := range m.removeduser_configs {
+	case postcategory.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.removedarticles))
+		for id := range m.removedarticles {
 			ids = append(ids, id)
 		}
 		return ids
@@ -19188,78 +24258,75 @@ func (m *NotificationTypeMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NotificationTypeMutation) ClearedEdges() []string {
+func (m *PostCategoryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareduser_configs {
-		edges = append(edges, notificationtype.EdgeUserConfigs)
+	if m.clearedarticles {
+		edges = append(edges, postcategory.EdgeArticles)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NotificationTypeMutation) EdgeCleared(name string) bool {
+func (m *PostCategoryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case notificationtype.EdgeUserConfigs:
-		return m.cleareduser_configs
+	case postcategory.EdgeArticles:
+		return m.clearedarticles
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NotificationTypeMutation) ClearEdge(name string) error {
+func (m *PostCategoryMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown NotificationType unique edge %s", name)
+	return fmt.Errorf("unknown PostCategory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NotificationTypeMutation) ResetEdge(name string) error {
+func (m *PostCategoryMutation) ResetEdge(name string) error {
 	switch name {
-	case notificationtype.EdgeUserConfigs:
-		m.ResetUserConfigs()
+	case postcategory.EdgeArticles:
+		m.ResetArticles()
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationType edge %s", name)
+	return fmt.Errorf("unknown PostCategory edge %s", name)
 }
 
-// PageMutation represents an operation that mutates the Page nodes in the graph.
-type PageMutation struct {
+// PostTagMutation represents an operation that mutates the PostTag nodes in the graph.
+type PostTagMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uint
-	deleted_at       *time.Time
-	title            *string
-	_path            *string
-	content          *string
-	markdown_content *string
-	description      *string
-	is_published     *bool
-	show_comment     *bool
-	sort             *int
-	addsort          *int
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*Page, error)
-	predicates       []predicate.Page
+	op              Op
+	typ             string
+	id              *uint
+	deleted_at      *time.Time
+	created_at      *time.Time
+	updated_at      *time.Time
+	name            *string
+	count           *int
+	addcount        *int
+	clearedFields   map[string]struct{}
+	articles        map[uint]struct{}
+	removedarticles map[uint]struct{}
+	clearedarticles bool
+	done            bool
+	oldValue        func(context.Context) (*PostTag, error)
+	predicates      []predicate.PostTag
 }
 
-var _ ent.Mutation = (*PageMutation)(nil)
+var _ ent.Mutation = (*PostTagMutation)(nil)
 
-// pageOption allows management of the mutation configuration using functional options.
-type pageOption func(*PageMutation)
+// posttagOption allows management of the mutation configuration using functional options.
+type posttagOption func(*PostTagMutation)
 
-// newPageMutation creates new mutation for the Page entity.
-func newPageMutation(c config, op Op, opts ...pageOption) *PageMutation {
-	m := &PageMutation{
+// newPostTagMutation creates new mutation for the PostTag entity.
+func newPostTagMutation(c config, op Op, opts ...posttagOption) *PostTagMutation {
+	m := &PostTagMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePage,
+		typ:           TypePostTag,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -19268,20 +24335,20 @@ func newPageMutation(c config, op Op, opts ...pageOption) *PageMutation {
 	return m
 }
 
-// withPageID sets the ID field of the mutation.
-func withPageID(id uint) pageOption {
-	return func(m *PageMutation) {
+// withPostTagID sets the ID field of the mutation.
+func withPostTagID(id uint) posttagOption {
+	return func(m *PostTagMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Page
+			value *PostTag
 		)
-		m.oldValue = func(ctx context.Context) (*Page, error) {
+		m.oldValue = func(ctx context.Context) (*PostTag, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Page.Get(ctx, id)
+					value, err = m.Client().PostTag.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -19290,10 +24357,10 @@ func withPageID(id uint) pageOption {
 	}
 }
 
-// withPage sets the old Page of the mutation.
-func withPage(node *Page) pageOption {
-	return func(m *PageMutation) {
-		m.oldValue = func(context.Context) (*Page, error) {
+// withPostTag sets the old PostTag of the mutation.
+func withPostTag(node *PostTag) posttagOption {
+	return func(m *PostTagMutation) {
+		m.oldValue = func(context.Context) (*PostTag, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -19302,7 +24369,7 @@ func withPage(node *Page) pageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PageMutation) Client() *Client {
+func (m PostTagMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -19310,7 +24377,7 @@ func (m PageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PageMutation) Tx() (*Tx, error) {
+func (m PostTagMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -19320,14 +24387,14 @@ func (m PageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Page entities.
-func (m *PageMutation) SetID(id uint) {
+// operation is only accepted on creation of PostTag entities.
+func (m *PostTagMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PageMutation) ID() (id uint, exists bool) {
+func (m *PostTagMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -19338,7 +24405,7 @@ func (m *PageMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PageMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *PostTagMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -19346,464 +24413,289 @@ func (m *PageMutation) IDs(ctx context.Context) ([]uint, error) {
 			return []uint{id}, nil
 		}
 		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Page.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (m *PageMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
-}
-
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PageMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeletedAt returns the old "deleted_at" field's value of the Page entity.
-// If the Page object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PageMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[page.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PageMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[page.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PageMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, page.FieldDeletedAt)
-}
-
-// SetTitle sets the "title" field.
-func (m *PageMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *PageMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the Page entity.
-// If the Page object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *PageMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetPath sets the "path" field.
-func (m *PageMutation) SetPath(s string) {
-	m._path = &s
-}
-
-// Path returns the value of the "path" field in the mutation.
-func (m *PageMutation) Path() (r string, exists bool) {
-	v := m._path
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPath returns the old "path" field's value of the Page entity.
-// If the Page object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageMutation) OldPath(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
-	}
-	return oldValue.Path, nil
-}
-
-// ResetPath resets all changes to the "path" field.
-func (m *PageMutation) ResetPath() {
-	m._path = nil
-}
-
-// SetContent sets the "content" field.
-func (m *PageMutation) SetContent(s string) {
-	m.content = &s
-}
-
-// Content returns the value of the "content" field in the mutation.
-func (m *PageMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldContent returns the old "content" field's value of the Page entity.
-// If the Page object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PostTag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.Content, nil
-}
-
-// ResetContent resets all changes to the "content" field.
-func (m *PageMutation) ResetContent() {
-	m.content = nil
 }
 
-// SetMarkdownContent sets the "markdown_content" field.
-func (m *PageMutation) SetMarkdownContent(s string) {
-	m.markdown_content = &s
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostTagMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// MarkdownContent returns the value of the "markdown_content" field in the mutation.
-func (m *PageMutation) MarkdownContent() (r string, exists bool) {
-	v := m.markdown_content
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostTagMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMarkdownContent returns the old "markdown_content" field's value of the Page entity.
-// If the Page object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageMutation) OldMarkdownContent(ctx context.Context) (v string, err error) {
+func (m *PostTagMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMarkdownContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMarkdownContent requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMarkdownContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.MarkdownContent, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ResetMarkdownContent resets all changes to the "markdown_content" field.
-func (m *PageMutation) ResetMarkdownContent() {
-	m.markdown_content = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostTagMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[posttag.FieldDeletedAt] = struct{}{}
 }
 
-// SetDescription sets the "description" field.
-func (m *PageMutation) SetDescription(s string) {
-	m.description = &s
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostTagMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[posttag.FieldDeletedAt]
+	return ok
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *PageMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostTagMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, posttag.FieldDeletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostTagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostTagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Page entity.
-// If the Page object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *PostTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *PageMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[page.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *PageMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[page.FieldDescription]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *PageMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, page.FieldDescription)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostTagMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetIsPublished sets the "is_published" field.
-func (m *PageMutation) SetIsPublished(b bool) {
-	m.is_published = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PostTagMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// IsPublished returns the value of the "is_published" field in the mutation.
-func (m *PageMutation) IsPublished() (r bool, exists bool) {
-	v := m.is_published
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PostTagMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsPublished returns the old "is_published" field's value of the Page entity.
-// If the Page object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageMutation) OldIsPublished(ctx context.Context) (v bool, err error) {
+func (m *PostTagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublished is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublished requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublished: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.IsPublished, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetIsPublished resets all changes to the "is_published" field.
-func (m *PageMutation) ResetIsPublished() {
-	m.is_published = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PostTagMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetShowComment sets the "show_comment" field.
-func (m *PageMutation) SetShowComment(b bool) {
-	m.show_comment = &b
+// SetName sets the "name" field.
+func (m *PostTagMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ShowComment returns the value of the "show_comment" field in the mutation.
-func (m *PageMutation) ShowComment() (r bool, exists bool) {
-	v := m.show_comment
+// Name returns the value of the "name" field in the mutation.
+func (m *PostTagMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldShowComment returns the old "show_comment" field's value of the Page entity.
-// If the Page object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageMutation) OldShowComment(ctx context.Context) (v bool, err error) {
+func (m *PostTagMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShowComment is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShowComment requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShowComment: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.ShowComment, nil
+	return oldValue.Name, nil
 }
 
-// ResetShowComment resets all changes to the "show_comment" field.
-func (m *PageMutation) ResetShowComment() {
-	m.show_comment = nil
+// ResetName resets all changes to the "name" field.
+func (m *PostTagMutation) ResetName() {
+	m.name = nil
 }
 
-// SetSort sets the "sort" field.
-func (m *PageMutation) SetSort(i int) {
-	m.sort = &i
-	m.addsort = nil
+// SetCount sets the "count" field.
+func (m *PostTagMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
 }
 
-// Sort returns the value of the "sort" field in the mutation.
-func (m *PageMutation) Sort() (r int, exists bool) {
-	v := m.sort
+// Count returns the value of the "count" field in the mutation.
+func (m *PostTagMutation) Count() (r int, exists bool) {
+	v := m.count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSort returns the old "sort" field's value of the Page entity.
-// If the Page object wasn't provided to the builder, the object is fetched from the database.
+// OldCount returns the old "count" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageMutation) OldSort(ctx context.Context) (v int, err error) {
+func (m *PostTagMutation) OldCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSort requires an ID field in the mutation")
+		return v, errors.New("OldCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
 	}
-	return oldValue.Sort, nil
+	return oldValue.Count, nil
 }
 
-// AddSort adds i to the "sort" field.
-func (m *PageMutation) AddSort(i int) {
-	if m.addsort != nil {
-		*m.addsort += i
+// AddCount adds i to the "count" field.
+func (m *PostTagMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
 	} else {
-		m.addsort = &i
+		m.addcount = &i
 	}
 }
 
-// AddedSort returns the value that was added to the "sort" field in this mutation.
-func (m *PageMutation) AddedSort() (r int, exists bool) {
-	v := m.addsort
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *PostTagMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSort resets all changes to the "sort" field.
-func (m *PageMutation) ResetSort() {
-	m.sort = nil
-	m.addsort = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *PageMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetCount resets all changes to the "count" field.
+func (m *PostTagMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PageMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// AddArticleIDs adds the "articles" edge to the Article entity by ids.
+func (m *PostTagMutation) AddArticleIDs(ids ...uint) {
+	if m.articles == nil {
+		m.articles = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.articles[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Page entity.
-// If the Page object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
+// ClearArticles clears the "articles" edge to the Article entity.
+func (m *PostTagMutation) ClearArticles() {
+	m.clearedarticles = true
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PageMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ArticlesCleared reports if the "articles" edge to the Article entity was cleared.
+func (m *PostTagMutation) ArticlesCleared() bool {
+	return m.clearedarticles
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PageMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RemoveArticleIDs removes the "articles" edge to the Article entity by IDs.
+func (m *PostTagMutation) RemoveArticleIDs(ids ...uint) {
+	if m.removedarticles == nil {
+		m.removedarticles = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.articles, ids[i])
+		m.removedarticles[ids[i]] = struct{}{}
+	}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PageMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// RemovedArticles returns the removed IDs of the "articles" edge to the Article entity.
+func (m *PostTagMutation) RemovedArticlesIDs() (ids []uint) {
+	for id := range m.removedarticles {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Page entity.
-// If the Page object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// ArticlesIDs returns the "articles" edge IDs in the mutation.
+func (m *PostTagMutation) ArticlesIDs() (ids []uint) {
+	for id := range m.articles {
+		ids = append(ids, id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PageMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetArticles resets all changes to the "articles" edge.
+func (m *PostTagMutation) ResetArticles() {
+	m.articles = nil
+	m.clearedarticles = false
+	m.removedarticles = nil
 }
 
-// Where appends a list predicates to the PageMutation builder.
-func (m *PageMutation) Where(ps ...predicate.Page) {
+// Where appends a list predicates to the PostTagMutation builder.
+func (m *PostTagMutation) Where(ps ...predicate.PostTag) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PostTagMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Page, len(ps))
+func (m *PostTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostTag, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -19811,57 +24703,39 @@ func (m *PageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PageMutation) Op() Op {
+func (m *PostTagMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PageMutation) SetOp(op Op) {
+func (m *PostTagMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Page).
-func (m *PageMutation) Type() string {
+// Type returns the node type of this mutation (PostTag).
+func (m *PostTagMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PageMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *PostTagMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.deleted_at != nil {
-		fields = append(fields, page.FieldDeletedAt)
-	}
-	if m.title != nil {
-		fields = append(fields, page.FieldTitle)
-	}
-	if m._path != nil {
-		fields = append(fields, page.FieldPath)
-	}
-	if m.content != nil {
-		fields = append(fields, page.FieldContent)
-	}
-	if m.markdown_content != nil {
-		fields = append(fields, page.FieldMarkdownContent)
-	}
-	if m.description != nil {
-		fields = append(fields, page.FieldDescription)
-	}
-	if m.is_published != nil {
-		fields = append(fields, page.FieldIsPublished)
-	}
-	if m.show_comment != nil {
-		fields = append(fields, page.FieldShowComment)
-	}
-	if m.sort != nil {
-		fields = append(fields, page.FieldSort)
+		fields = append(fields, posttag.FieldDeletedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, page.FieldCreatedAt)
+		fields = append(fields, posttag.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, page.FieldUpdatedAt)
+		fields = append(fields, posttag.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, posttag.FieldName)
+	}
+	if m.count != nil {
+		fields = append(fields, posttag.FieldCount)
 	}
 	return fields
 }
@@ -19869,30 +24743,18 @@ func (m *PageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PageMutation) Field(name string) (ent.Value, bool) {
+func (m *PostTagMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case page.FieldDeletedAt:
+	case posttag.FieldDeletedAt:
 		return m.DeletedAt()
-	case page.FieldTitle:
-		return m.Title()
-	case page.FieldPath:
-		return m.Path()
-	case page.FieldContent:
-		return m.Content()
-	case page.FieldMarkdownContent:
-		return m.MarkdownContent()
-	case page.FieldDescription:
-		return m.Description()
-	case page.FieldIsPublished:
-		return m.IsPublished()
-	case page.FieldShowComment:
-		return m.ShowComment()
-	case page.FieldSort:
-		return m.Sort()
-	case page.FieldCreatedAt:
+	case posttag.FieldCreatedAt:
 		return m.CreatedAt()
-	case page.FieldUpdatedAt:
+	case posttag.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case posttag.FieldName:
+		return m.Name()
+	case posttag.FieldCount:
+		return m.Count()
 	}
 	return nil, false
 }
@@ -19900,126 +24762,72 @@ func (m *PageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PostTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case page.FieldDeletedAt:
+	case posttag.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case page.FieldTitle:
-		return m.OldTitle(ctx)
-	case page.FieldPath:
-		return m.OldPath(ctx)
-	case page.FieldContent:
-		return m.OldContent(ctx)
-	case page.FieldMarkdownContent:
-		return m.OldMarkdownContent(ctx)
-	case page.FieldDescription:
-		return m.OldDescription(ctx)
-	case page.FieldIsPublished:
-		return m.OldIsPublished(ctx)
-	case page.FieldShowComment:
-		return m.OldShowComment(ctx)
-	case page.FieldSort:
-		return m.OldSort(ctx)
-	case page.FieldCreatedAt:
+	case posttag.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case page.FieldUpdatedAt:
+	case posttag.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case posttag.FieldName:
+		return m.OldName(ctx)
+	case posttag.FieldCount:
+		return m.OldCount(ctx)
 	}
-	return nil, fmt.Errorf("unknown Page field %s", name)
+	return nil, fmt.Errorf("unknown PostTag field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PageMutation) SetField(name string, value ent.Value) error {
+func (m *PostTagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case page.FieldDeletedAt:
+	case posttag.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case page.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case page.FieldPath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPath(v)
-		return nil
-	case page.FieldContent:
-		v, ok := value.(string)
+	case posttag.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetCreatedAt(v)
 		return nil
-	case page.FieldMarkdownContent:
-		v, ok := value.(string)
+	case posttag.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMarkdownContent(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case page.FieldDescription:
+	case posttag.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
-		return nil
-	case page.FieldIsPublished:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsPublished(v)
-		return nil
-	case page.FieldShowComment:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetShowComment(v)
+		m.SetName(v)
 		return nil
-	case page.FieldSort:
+	case posttag.FieldCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSort(v)
-		return nil
-	case page.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case page.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
+		m.SetCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Page field %s", name)
+	return fmt.Errorf("unknown PostTag field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PageMutation) AddedFields() []string {
+func (m *PostTagMutation) AddedFields() []string {
 	var fields []string
-	if m.addsort != nil {
-		fields = append(fields, page.FieldSort)
+	if m.addcount != nil {
+		fields = append(fields, posttag.FieldCount)
 	}
 	return fields
 }
@@ -20027,10 +24835,10 @@ func (m *PageMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PostTagMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case page.FieldSort:
-		return m.AddedSort()
+	case posttag.FieldCount:
+		return m.AddedCount()
 	}
 	return nil, false
 }
@@ -20038,178 +24846,183 @@ func (m *PageMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PageMutation) AddField(name string, value ent.Value) error {
+func (m *PostTagMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case page.FieldSort:
+	case posttag.FieldCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSort(v)
+		m.AddCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Page numeric field %s", name)
+	return fmt.Errorf("unknown PostTag numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PageMutation) ClearedFields() []string {
+func (m *PostTagMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(page.FieldDeletedAt) {
-		fields = append(fields, page.FieldDeletedAt)
-	}
-	if m.FieldCleared(page.FieldDescription) {
-		fields = append(fields, page.FieldDescription)
+	if m.FieldCleared(posttag.FieldDeletedAt) {
+		fields = append(fields, posttag.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PageMutation) FieldCleared(name string) bool {
+func (m *PostTagMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PageMutation) ClearField(name string) error {
+func (m *PostTagMutation) ClearField(name string) error {
 	switch name {
-	case page.FieldDeletedAt:
+	case posttag.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case page.FieldDescription:
-		m.ClearDescription()
-		return nil
 	}
-	return fmt.Errorf("unknown Page nullable field %s", name)
+	return fmt.Errorf("unknown PostTag nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PageMutation) ResetField(name string) error {
+func (m *PostTagMutation) ResetField(name string) error {
 	switch name {
-	case page.FieldDeletedAt:
+	case posttag.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case page.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case page.FieldPath:
-		m.ResetPath()
-		return nil
-	case page.FieldContent:
-		m.ResetContent()
-		return nil
-	case page.FieldMarkdownContent:
-		m.ResetMarkdownContent()
-		return nil
-	case page.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case page.FieldIsPublished:
-		m.ResetIsPublished()
-		return nil
-	case page.FieldShowComment:
-		m.ResetShowComment()
-		return nil
-	case page.FieldSort:
-		m.ResetSort()
-		return nil
-	case page.FieldCreatedAt:
+	case posttag.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case page.FieldUpdatedAt:
+	case posttag.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case posttag.FieldName:
+		m.ResetName()
+		return nil
+	case posttag.FieldCount:
+		m.ResetCount()
+		return nil
 	}
-	return fmt.Errorf("unknown Page field %s", name)
+	return fmt.Errorf("unknown PostTag field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PostTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.articles != nil {
+		edges = append(edges, posttag.EdgeArticles)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PageMutation) AddedIDs(name string) []ent.Value {
+func (m *PostTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case posttag.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.articles))
+		for id := range m.articles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PostTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedarticles != nil {
+		edges = append(edges, posttag.EdgeArticles)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PageMutation) RemovedIDs(name string) []ent.Value {
+func (m *PostTagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case posttag.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.removedarticles))
+		for id := range m.removedarticles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PostTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedarticles {
+		edges = append(edges, posttag.EdgeArticles)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PageMutation) EdgeCleared(name string) bool {
+func (m *PostTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case posttag.EdgeArticles:
+		return m.clearedarticles
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Page unique edge %s", name)
+func (m *PostTagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PostTag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Page edge %s", name)
+func (m *PostTagMutation) ResetEdge(name string) error {
+	switch name {
+	case posttag.EdgeArticles:
+		m.ResetArticles()
+		return nil
+	}
+	return fmt.Errorf("unknown PostTag edge %s", name)
 }
 
-// PostCategoryMutation represents an operation that mutates the PostCategory nodes in the graph.
-type PostCategoryMutation struct {
+// ReactionCountMutation represents an operation that mutates the ReactionCount nodes in the graph.
+type ReactionCountMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uint
-	deleted_at      *time.Time
-	created_at      *time.Time
-	updated_at      *time.Time
-	name            *string
-	description     *string
-	count           *int
-	addcount        *int
-	is_series       *bool
-	sort_order      *int
-	addsort_order   *int
-	clearedFields   map[string]struct{}
-	articles        map[uint]struct{}
-	removedarticles map[uint]struct{}
-	clearedarticles bool
-	done            bool
-	oldValue        func(context.Context) (*PostCategory, error)
-	predicates      []predicate.PostCategory
+	op            Op
+	typ           string
+	id            *uint
+	target_type   *string
+	target_id     *string
+	reaction      *string
+	count         *int64
+	addcount      *int64
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ReactionCount, error)
+	predicates    []predicate.ReactionCount
 }
 
-var _ ent.Mutation = (*PostCategoryMutation)(nil)
+var _ ent.Mutation = (*ReactionCountMutation)(nil)
 
-// postcategoryOption allows management of the mutation configuration using functional options.
-type postcategoryOption func(*PostCategoryMutation)
+// reactioncountOption allows management of the mutation configuration using functional options.
+type reactioncountOption func(*ReactionCountMutation)
 
-// newPostCategoryMutation creates new mutation for the PostCategory entity.
-func newPostCategoryMutation(c config, op Op, opts ...postcategoryOption) *PostCategoryMutation {
-	m := &PostCategoryMutation{
+// newReactionCountMutation creates new mutation for the ReactionCount entity.
+func newReactionCountMutation(c config, op Op, opts ...reactioncountOption) *ReactionCountMutation {
+	m := &ReactionCountMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePostCategory,
+		typ:           TypeReactionCount,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -20218,20 +25031,20 @@ func newPostCategoryMutation(c config, op Op, opts ...postcategoryOption) *PostC
 	return m
 }
 
-// withPostCategoryID sets the ID field of the mutation.
-func withPostCategoryID(id uint) postcategoryOption {
-	return func(m *PostCategoryMutation) {
+// withReactionCountID sets the ID field of the mutation.
+func withReactionCountID(id uint) reactioncountOption {
+	return func(m *ReactionCountMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PostCategory
+			value *ReactionCount
 		)
-		m.oldValue = func(ctx context.Context) (*PostCategory, error) {
+		m.oldValue = func(ctx context.Context) (*ReactionCount, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PostCategory.Get(ctx, id)
+					value, err = m.Client().ReactionCount.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -20240,10 +25053,10 @@ func withPostCategoryID(id uint) postcategoryOption {
 	}
 }
 
-// withPostCategory sets the old PostCategory of the mutation.
-func withPostCategory(node *PostCategory) postcategoryOption {
-	return func(m *PostCategoryMutation) {
-		m.oldValue = func(context.Context) (*PostCategory, error) {
+// withReactionCount sets the old ReactionCount of the mutation.
+func withReactionCount(node *ReactionCount) reactioncountOption {
+	return func(m *ReactionCountMutation) {
+		m.oldValue = func(context.Context) (*ReactionCount, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -20252,271 +25065,173 @@ func withPostCategory(node *PostCategory) postcategoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostCategoryMutation) Client() *Client {
+func (m ReactionCountMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PostCategoryMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PostCategory entities.
-func (m *PostCategoryMutation) SetID(id uint) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PostCategoryMutation) ID() (id uint, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PostCategoryMutation) IDs(ctx context.Context) ([]uint, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PostCategory.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (m *PostCategoryMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
-}
-
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PostCategoryMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeletedAt returns the old "deleted_at" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PostCategoryMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[postcategory.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PostCategoryMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[postcategory.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PostCategoryMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, postcategory.FieldDeletedAt)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReactionCountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PostCategoryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReactionCount entities.
+func (m *ReactionCountMutation) SetID(id uint) {
+	m.id = &id
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostCategoryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReactionCountMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReactionCountMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReactionCount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostCategoryMutation) ResetCreatedAt() {
-	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PostCategoryMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetTargetType sets the "target_type" field.
+func (m *ReactionCountMutation) SetTargetType(s string) {
+	m.target_type = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PostCategoryMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *ReactionCountMutation) TargetType() (r string, exists bool) {
+	v := m.target_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetType returns the old "target_type" field's value of the ReactionCount entity.
+// If the ReactionCount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReactionCountMutation) OldTargetType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.TargetType, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PostCategoryMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *ReactionCountMutation) ResetTargetType() {
+	m.target_type = nil
 }
 
-// SetName sets the "name" field.
-func (m *PostCategoryMutation) SetName(s string) {
-	m.name = &s
+// SetTargetID sets the "target_id" field.
+func (m *ReactionCountMutation) SetTargetID(s string) {
+	m.target_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PostCategoryMutation) Name() (r string, exists bool) {
-	v := m.name
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *ReactionCountMutation) TargetID() (r string, exists bool) {
+	v := m.target_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetID returns the old "target_id" field's value of the ReactionCount entity.
+// If the ReactionCount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ReactionCountMutation) OldTargetID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TargetID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PostCategoryMutation) ResetName() {
-	m.name = nil
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *ReactionCountMutation) ResetTargetID() {
+	m.target_id = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *PostCategoryMutation) SetDescription(s string) {
-	m.description = &s
+// SetReaction sets the "reaction" field.
+func (m *ReactionCountMutation) SetReaction(s string) {
+	m.reaction = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *PostCategoryMutation) Description() (r string, exists bool) {
-	v := m.description
+// Reaction returns the value of the "reaction" field in the mutation.
+func (m *ReactionCountMutation) Reaction() (r string, exists bool) {
+	v := m.reaction
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldReaction returns the old "reaction" field's value of the ReactionCount entity.
+// If the ReactionCount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ReactionCountMutation) OldReaction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldReaction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldReaction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldReaction: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *PostCategoryMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[postcategory.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *PostCategoryMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[postcategory.FieldDescription]
-	return ok
+	return oldValue.Reaction, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *PostCategoryMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, postcategory.FieldDescription)
+// ResetReaction resets all changes to the "reaction" field.
+func (m *ReactionCountMutation) ResetReaction() {
+	m.reaction = nil
 }
 
 // SetCount sets the "count" field.
-func (m *PostCategoryMutation) SetCount(i int) {
+func (m *ReactionCountMutation) SetCount(i int64) {
 	m.count = &i
 	m.addcount = nil
 }
 
 // Count returns the value of the "count" field in the mutation.
-func (m *PostCategoryMutation) Count() (r int, exists bool) {
+func (m *ReactionCountMutation) Count() (r int64, exists bool) {
 	v := m.count
 	if v == nil {
 		return
@@ -20524,10 +25239,10 @@ func (m *PostCategoryMutation) Count() (r int, exists bool) {
 	return *v, true
 }
 
-// OldCount returns the old "count" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldCount returns the old "count" field's value of the ReactionCount entity.
+// If the ReactionCount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldCount(ctx context.Context) (v int, err error) {
+func (m *ReactionCountMutation) OldCount(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCount is only allowed on UpdateOne operations")
 	}
@@ -20542,7 +25257,7 @@ func (m *PostCategoryMutation) OldCount(ctx context.Context) (v int, err error)
 }
 
 // AddCount adds i to the "count" field.
-func (m *PostCategoryMutation) AddCount(i int) {
+func (m *ReactionCountMutation) AddCount(i int64) {
 	if m.addcount != nil {
 		*m.addcount += i
 	} else {
@@ -20551,7 +25266,7 @@ func (m *PostCategoryMutation) AddCount(i int) {
 }
 
 // AddedCount returns the value that was added to the "count" field in this mutation.
-func (m *PostCategoryMutation) AddedCount() (r int, exists bool) {
+func (m *ReactionCountMutation) AddedCount() (r int64, exists bool) {
 	v := m.addcount
 	if v == nil {
 		return
@@ -20560,166 +25275,56 @@ func (m *PostCategoryMutation) AddedCount() (r int, exists bool) {
 }
 
 // ResetCount resets all changes to the "count" field.
-func (m *PostCategoryMutation) ResetCount() {
+func (m *ReactionCountMutation) ResetCount() {
 	m.count = nil
 	m.addcount = nil
 }
 
-// SetIsSeries sets the "is_series" field.
-func (m *PostCategoryMutation) SetIsSeries(b bool) {
-	m.is_series = &b
-}
-
-// IsSeries returns the value of the "is_series" field in the mutation.
-func (m *PostCategoryMutation) IsSeries() (r bool, exists bool) {
-	v := m.is_series
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIsSeries returns the old "is_series" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldIsSeries(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSeries is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSeries requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSeries: %w", err)
-	}
-	return oldValue.IsSeries, nil
-}
-
-// ResetIsSeries resets all changes to the "is_series" field.
-func (m *PostCategoryMutation) ResetIsSeries() {
-	m.is_series = nil
-}
-
-// SetSortOrder sets the "sort_order" field.
-func (m *PostCategoryMutation) SetSortOrder(i int) {
-	m.sort_order = &i
-	m.addsort_order = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReactionCountMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// SortOrder returns the value of the "sort_order" field in the mutation.
-func (m *PostCategoryMutation) SortOrder() (r int, exists bool) {
-	v := m.sort_order
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReactionCountMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSortOrder returns the old "sort_order" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ReactionCount entity.
+// If the ReactionCount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+func (m *ReactionCountMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSortOrder requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
-	}
-	return oldValue.SortOrder, nil
-}
-
-// AddSortOrder adds i to the "sort_order" field.
-func (m *PostCategoryMutation) AddSortOrder(i int) {
-	if m.addsort_order != nil {
-		*m.addsort_order += i
-	} else {
-		m.addsort_order = &i
-	}
-}
-
-// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
-func (m *PostCategoryMutation) AddedSortOrder() (r int, exists bool) {
-	v := m.addsort_order
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetSortOrder resets all changes to the "sort_order" field.
-func (m *PostCategoryMutation) ResetSortOrder() {
-	m.sort_order = nil
-	m.addsort_order = nil
-}
-
-// AddArticleIDs adds the "articles" edge to the Article entity by ids.
-func (m *PostCategoryMutation) AddArticleIDs(ids ...uint) {
-	if m.articles == nil {
-		m.articles = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.articles[ids[i]] = struct{}{}
-	}
-}
-
-// ClearArticles clears the "articles" edge to the Article entity.
-func (m *PostCategoryMutation) ClearArticles() {
-	m.clearedarticles = true
-}
-
-// ArticlesCleared reports if the "articles" edge to the Article entity was cleared.
-func (m *PostCategoryMutation) ArticlesCleared() bool {
-	return m.clearedarticles
-}
-
-// RemoveArticleIDs removes the "articles" edge to the Article entity by IDs.
-func (m *PostCategoryMutation) RemoveArticleIDs(ids ...uint) {
-	if m.removedarticles == nil {
-		m.removedarticles = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.articles, ids[i])
-		m.removedarticles[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedArticles returns the removed IDs of the "articles" edge to the Article entity.
-func (m *PostCategoryMutation) RemovedArticlesIDs() (ids []uint) {
-	for id := range m.removedarticles {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ArticlesIDs returns the "articles" edge IDs in the mutation.
-func (m *PostCategoryMutation) ArticlesIDs() (ids []uint) {
-	for id := range m.articles {
-		ids = append(ids, id)
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetArticles resets all changes to the "articles" edge.
-func (m *PostCategoryMutation) ResetArticles() {
-	m.articles = nil
-	m.clearedarticles = false
-	m.removedarticles = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReactionCountMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the PostCategoryMutation builder.
-func (m *PostCategoryMutation) Where(ps ...predicate.PostCategory) {
+// Where appends a list predicates to the ReactionCountMutation builder.
+func (m *ReactionCountMutation) Where(ps ...predicate.ReactionCount) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PostCategoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReactionCountMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PostCategory, len(ps))
+func (m *ReactionCountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReactionCount, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -20727,48 +25332,39 @@ func (m *PostCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostCategoryMutation) Op() Op {
+func (m *ReactionCountMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostCategoryMutation) SetOp(op Op) {
+func (m *ReactionCountMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PostCategory).
-func (m *PostCategoryMutation) Type() string {
+// Type returns the node type of this mutation (ReactionCount).
+func (m *ReactionCountMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostCategoryMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.deleted_at != nil {
-		fields = append(fields, postcategory.FieldDeletedAt)
-	}
-	if m.created_at != nil {
-		fields = append(fields, postcategory.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, postcategory.FieldUpdatedAt)
+func (m *ReactionCountMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.target_type != nil {
+		fields = append(fields, reactioncount.FieldTargetType)
 	}
-	if m.name != nil {
-		fields = append(fields, postcategory.FieldName)
+	if m.target_id != nil {
+		fields = append(fields, reactioncount.FieldTargetID)
 	}
-	if m.description != nil {
-		fields = append(fields, postcategory.FieldDescription)
+	if m.reaction != nil {
+		fields = append(fields, reactioncount.FieldReaction)
 	}
 	if m.count != nil {
-		fields = append(fields, postcategory.FieldCount)
-	}
-	if m.is_series != nil {
-		fields = append(fields, postcategory.FieldIsSeries)
+		fields = append(fields, reactioncount.FieldCount)
 	}
-	if m.sort_order != nil {
-		fields = append(fields, postcategory.FieldSortOrder)
+	if m.updated_at != nil {
+		fields = append(fields, reactioncount.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -20776,24 +25372,18 @@ func (m *PostCategoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostCategoryMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case postcategory.FieldDeletedAt:
-		return m.DeletedAt()
-	case postcategory.FieldCreatedAt:
-		return m.CreatedAt()
-	case postcategory.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case postcategory.FieldName:
-		return m.Name()
-	case postcategory.FieldDescription:
-		return m.Description()
-	case postcategory.FieldCount:
+func (m *ReactionCountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reactioncount.FieldTargetType:
+		return m.TargetType()
+	case reactioncount.FieldTargetID:
+		return m.TargetID()
+	case reactioncount.FieldReaction:
+		return m.Reaction()
+	case reactioncount.FieldCount:
 		return m.Count()
-	case postcategory.FieldIsSeries:
-		return m.IsSeries()
-	case postcategory.FieldSortOrder:
-		return m.SortOrder()
+	case reactioncount.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -20801,102 +25391,72 @@ func (m *PostCategoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostCategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case postcategory.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case postcategory.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case postcategory.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case postcategory.FieldName:
-		return m.OldName(ctx)
-	case postcategory.FieldDescription:
-		return m.OldDescription(ctx)
-	case postcategory.FieldCount:
+func (m *ReactionCountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reactioncount.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case reactioncount.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case reactioncount.FieldReaction:
+		return m.OldReaction(ctx)
+	case reactioncount.FieldCount:
 		return m.OldCount(ctx)
-	case postcategory.FieldIsSeries:
-		return m.OldIsSeries(ctx)
-	case postcategory.FieldSortOrder:
-		return m.OldSortOrder(ctx)
+	case reactioncount.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PostCategory field %s", name)
+	return nil, fmt.Errorf("unknown ReactionCount field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostCategoryMutation) SetField(name string, value ent.Value) error {
+func (m *ReactionCountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case postcategory.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case postcategory.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case postcategory.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case reactioncount.FieldTargetType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetTargetType(v)
 		return nil
-	case postcategory.FieldName:
+	case reactioncount.FieldTargetID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTargetID(v)
 		return nil
-	case postcategory.FieldDescription:
+	case reactioncount.FieldReaction:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetReaction(v)
 		return nil
-	case postcategory.FieldCount:
-		v, ok := value.(int)
+	case reactioncount.FieldCount:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCount(v)
 		return nil
-	case postcategory.FieldIsSeries:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsSeries(v)
-		return nil
-	case postcategory.FieldSortOrder:
-		v, ok := value.(int)
+	case reactioncount.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSortOrder(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PostCategory field %s", name)
+	return fmt.Errorf("unknown ReactionCount field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostCategoryMutation) AddedFields() []string {
+func (m *ReactionCountMutation) AddedFields() []string {
 	var fields []string
 	if m.addcount != nil {
-		fields = append(fields, postcategory.FieldCount)
-	}
-	if m.addsort_order != nil {
-		fields = append(fields, postcategory.FieldSortOrder)
+		fields = append(fields, reactioncount.FieldCount)
 	}
 	return fields
 }
@@ -20904,12 +25464,10 @@ func (m *PostCategoryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostCategoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReactionCountMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case postcategory.FieldCount:
+	case reactioncount.FieldCount:
 		return m.AddedCount()
-	case postcategory.FieldSortOrder:
-		return m.AddedSortOrder()
 	}
 	return nil, false
 }
@@ -20917,208 +25475,138 @@ func (m *PostCategoryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostCategoryMutation) AddField(name string, value ent.Value) error {
+func (m *ReactionCountMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case postcategory.FieldCount:
-		v, ok := value.(int)
+	case reactioncount.FieldCount:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCount(v)
 		return nil
-	case postcategory.FieldSortOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSortOrder(v)
-		return nil
 	}
-	return fmt.Errorf("unknown PostCategory numeric field %s", name)
+	return fmt.Errorf("unknown ReactionCount numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostCategoryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(postcategory.FieldDeletedAt) {
-		fields = append(fields, postcategory.FieldDeletedAt)
-	}
-	if m.FieldCleared(postcategory.FieldDescription) {
-		fields = append(fields, postcategory.FieldDescription)
-	}
-	return fields
+func (m *ReactionCountMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostCategoryMutation) FieldCleared(name string) bool {
+func (m *ReactionCountMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostCategoryMutation) ClearField(name string) error {
-	switch name {
-	case postcategory.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case postcategory.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown PostCategory nullable field %s", name)
+func (m *ReactionCountMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReactionCount nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostCategoryMutation) ResetField(name string) error {
+func (m *ReactionCountMutation) ResetField(name string) error {
 	switch name {
-	case postcategory.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case postcategory.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case postcategory.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case reactioncount.FieldTargetType:
+		m.ResetTargetType()
 		return nil
-	case postcategory.FieldName:
-		m.ResetName()
+	case reactioncount.FieldTargetID:
+		m.ResetTargetID()
 		return nil
-	case postcategory.FieldDescription:
-		m.ResetDescription()
+	case reactioncount.FieldReaction:
+		m.ResetReaction()
 		return nil
-	case postcategory.FieldCount:
+	case reactioncount.FieldCount:
 		m.ResetCount()
 		return nil
-	case postcategory.FieldIsSeries:
-		m.ResetIsSeries()
-		return nil
-	case postcategory.FieldSortOrder:
-		m.ResetSortOrder()
+	case reactioncount.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PostCategory field %s", name)
+	return fmt.Errorf("unknown ReactionCount field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostCategoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.articles != nil {
-		edges = append(edges, postcategory.EdgeArticles)
-	}
+func (m *ReactionCountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostCategoryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case postcategory.EdgeArticles:
-		ids := make([]ent.Value, 0, len(m.articles))
-		for id := range m.articles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ReactionCountMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostCategoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedarticles != nil {
-		edges = append(edges, postcategory.EdgeArticles)
-	}
+func (m *ReactionCountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostCategoryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case postcategory.EdgeArticles:
-		ids := make([]ent.Value, 0, len(m.removedarticles))
-		for id := range m.removedarticles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ReactionCountMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostCategoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedarticles {
-		edges = append(edges, postcategory.EdgeArticles)
-	}
+func (m *ReactionCountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostCategoryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case postcategory.EdgeArticles:
-		return m.clearedarticles
-	}
+func (m *ReactionCountMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostCategoryMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown PostCategory unique edge %s", name)
+func (m *ReactionCountMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ReactionCount unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostCategoryMutation) ResetEdge(name string) error {
-	switch name {
-	case postcategory.EdgeArticles:
-		m.ResetArticles()
-		return nil
-	}
-	return fmt.Errorf("unknown PostCategory edge %s", name)
+func (m *ReactionCountMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ReactionCount edge %s", name)
 }
-
-// PostTagMutation represents an operation that mutates the PostTag nodes in the graph.
-type PostTagMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *uint
-	deleted_at      *time.Time
-	created_at      *time.Time
-	updated_at      *time.Time
-	name            *string
-	count           *int
-	addcount        *int
-	clearedFields   map[string]struct{}
-	articles        map[uint]struct{}
-	removedarticles map[uint]struct{}
-	clearedarticles bool
-	done            bool
-	oldValue        func(context.Context) (*PostTag, error)
-	predicates      []predicate.PostTag
+
+// RedirectRuleMutation represents an operation that mutates the RedirectRule nodes in the graph.
+type RedirectRuleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	from_path     *string
+	to_path       *string
+	code          *int
+	addcode       *int
+	source        *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RedirectRule, error)
+	predicates    []predicate.RedirectRule
 }
 
-var _ ent.Mutation = (*PostTagMutation)(nil)
+var _ ent.Mutation = (*RedirectRuleMutation)(nil)
 
-// posttagOption allows management of the mutation configuration using functional options.
-type posttagOption func(*PostTagMutation)
+// redirectruleOption allows management of the mutation configuration using functional options.
+type redirectruleOption func(*RedirectRuleMutation)
 
-// newPostTagMutation creates new mutation for the PostTag entity.
-func newPostTagMutation(c config, op Op, opts ...posttagOption) *PostTagMutation {
-	m := &PostTagMutation{
+// newRedirectRuleMutation creates new mutation for the RedirectRule entity.
+func newRedirectRuleMutation(c config, op Op, opts ...redirectruleOption) *RedirectRuleMutation {
+	m := &RedirectRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePostTag,
+		typ:           TypeRedirectRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -21127,20 +25615,20 @@ func newPostTagMutation(c config, op Op, opts ...posttagOption) *PostTagMutation
 	return m
 }
 
-// withPostTagID sets the ID field of the mutation.
-func withPostTagID(id uint) posttagOption {
-	return func(m *PostTagMutation) {
+// withRedirectRuleID sets the ID field of the mutation.
+func withRedirectRuleID(id uint) redirectruleOption {
+	return func(m *RedirectRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PostTag
+			value *RedirectRule
 		)
-		m.oldValue = func(ctx context.Context) (*PostTag, error) {
+		m.oldValue = func(ctx context.Context) (*RedirectRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PostTag.Get(ctx, id)
+					value, err = m.Client().RedirectRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -21149,10 +25637,10 @@ func withPostTagID(id uint) posttagOption {
 	}
 }
 
-// withPostTag sets the old PostTag of the mutation.
-func withPostTag(node *PostTag) posttagOption {
-	return func(m *PostTagMutation) {
-		m.oldValue = func(context.Context) (*PostTag, error) {
+// withRedirectRule sets the old RedirectRule of the mutation.
+func withRedirectRule(node *RedirectRule) redirectruleOption {
+	return func(m *RedirectRuleMutation) {
+		m.oldValue = func(context.Context) (*RedirectRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -21161,7 +25649,7 @@ func withPostTag(node *PostTag) posttagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostTagMutation) Client() *Client {
+func (m RedirectRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -21169,7 +25657,7 @@ func (m PostTagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostTagMutation) Tx() (*Tx, error) {
+func (m RedirectRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -21179,14 +25667,14 @@ func (m PostTagMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PostTag entities.
-func (m *PostTagMutation) SetID(id uint) {
+// operation is only accepted on creation of RedirectRule entities.
+func (m *RedirectRuleMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PostTagMutation) ID() (id uint, exists bool) {
+func (m *RedirectRuleMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -21197,7 +25685,7 @@ func (m *PostTagMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PostTagMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *RedirectRuleMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -21206,288 +25694,221 @@ func (m *PostTagMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PostTag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RedirectRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *PostTagMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetFromPath sets the "from_path" field.
+func (m *RedirectRuleMutation) SetFromPath(s string) {
+	m.from_path = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PostTagMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// FromPath returns the value of the "from_path" field in the mutation.
+func (m *RedirectRuleMutation) FromPath() (r string, exists bool) {
+	v := m.from_path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// OldFromPath returns the old "from_path" field's value of the RedirectRule entity.
+// If the RedirectRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *RedirectRuleMutation) OldFromPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFromPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldFromPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFromPath: %w", err)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PostTagMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[posttag.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PostTagMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[posttag.FieldDeletedAt]
-	return ok
+	return oldValue.FromPath, nil
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PostTagMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, posttag.FieldDeletedAt)
+// ResetFromPath resets all changes to the "from_path" field.
+func (m *RedirectRuleMutation) ResetFromPath() {
+	m.from_path = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PostTagMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetToPath sets the "to_path" field.
+func (m *RedirectRuleMutation) SetToPath(s string) {
+	m.to_path = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostTagMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ToPath returns the value of the "to_path" field in the mutation.
+func (m *RedirectRuleMutation) ToPath() (r string, exists bool) {
+	v := m.to_path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// OldToPath returns the old "to_path" field's value of the RedirectRule entity.
+// If the RedirectRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RedirectRuleMutation) OldToPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldToPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldToPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldToPath: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ToPath, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostTagMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetToPath resets all changes to the "to_path" field.
+func (m *RedirectRuleMutation) ResetToPath() {
+	m.to_path = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PostTagMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetCode sets the "code" field.
+func (m *RedirectRuleMutation) SetCode(i int) {
+	m.code = &i
+	m.addcode = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PostTagMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Code returns the value of the "code" field in the mutation.
+func (m *RedirectRuleMutation) Code() (r int, exists bool) {
+	v := m.code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// OldCode returns the old "code" field's value of the RedirectRule entity.
+// If the RedirectRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RedirectRuleMutation) OldCode(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PostTagMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.Code, nil
 }
 
-// SetName sets the "name" field.
-func (m *PostTagMutation) SetName(s string) {
-	m.name = &s
+// AddCode adds i to the "code" field.
+func (m *RedirectRuleMutation) AddCode(i int) {
+	if m.addcode != nil {
+		*m.addcode += i
+	} else {
+		m.addcode = &i
+	}
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PostTagMutation) Name() (r string, exists bool) {
-	v := m.name
+// AddedCode returns the value that was added to the "code" field in this mutation.
+func (m *RedirectRuleMutation) AddedCode() (r int, exists bool) {
+	v := m.addcode
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *PostTagMutation) ResetName() {
-	m.name = nil
+// ResetCode resets all changes to the "code" field.
+func (m *RedirectRuleMutation) ResetCode() {
+	m.code = nil
+	m.addcode = nil
 }
 
-// SetCount sets the "count" field.
-func (m *PostTagMutation) SetCount(i int) {
-	m.count = &i
-	m.addcount = nil
+// SetSource sets the "source" field.
+func (m *RedirectRuleMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// Count returns the value of the "count" field in the mutation.
-func (m *PostTagMutation) Count() (r int, exists bool) {
-	v := m.count
+// Source returns the value of the "source" field in the mutation.
+func (m *RedirectRuleMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCount returns the old "count" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the RedirectRule entity.
+// If the RedirectRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldCount(ctx context.Context) (v int, err error) {
+func (m *RedirectRuleMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCount requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.Count, nil
+	return oldValue.Source, nil
 }
 
-// AddCount adds i to the "count" field.
-func (m *PostTagMutation) AddCount(i int) {
-	if m.addcount != nil {
-		*m.addcount += i
-	} else {
-		m.addcount = &i
-	}
+// ResetSource resets all changes to the "source" field.
+func (m *RedirectRuleMutation) ResetSource() {
+	m.source = nil
 }
 
-// AddedCount returns the value that was added to the "count" field in this mutation.
-func (m *PostTagMutation) AddedCount() (r int, exists bool) {
-	v := m.addcount
+// SetCreatedAt sets the "created_at" field.
+func (m *RedirectRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RedirectRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCount resets all changes to the "count" field.
-func (m *PostTagMutation) ResetCount() {
-	m.count = nil
-	m.addcount = nil
-}
-
-// AddArticleIDs adds the "articles" edge to the Article entity by ids.
-func (m *PostTagMutation) AddArticleIDs(ids ...uint) {
-	if m.articles == nil {
-		m.articles = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.articles[ids[i]] = struct{}{}
-	}
-}
-
-// ClearArticles clears the "articles" edge to the Article entity.
-func (m *PostTagMutation) ClearArticles() {
-	m.clearedarticles = true
-}
-
-// ArticlesCleared reports if the "articles" edge to the Article entity was cleared.
-func (m *PostTagMutation) ArticlesCleared() bool {
-	return m.clearedarticles
-}
-
-// RemoveArticleIDs removes the "articles" edge to the Article entity by IDs.
-func (m *PostTagMutation) RemoveArticleIDs(ids ...uint) {
-	if m.removedarticles == nil {
-		m.removedarticles = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.articles, ids[i])
-		m.removedarticles[ids[i]] = struct{}{}
+// OldCreatedAt returns the old "created_at" field's value of the RedirectRule entity.
+// If the RedirectRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RedirectRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedArticles returns the removed IDs of the "articles" edge to the Article entity.
-func (m *PostTagMutation) RemovedArticlesIDs() (ids []uint) {
-	for id := range m.removedarticles {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// ArticlesIDs returns the "articles" edge IDs in the mutation.
-func (m *PostTagMutation) ArticlesIDs() (ids []uint) {
-	for id := range m.articles {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return
+	return oldValue.CreatedAt, nil
 }
 
-// ResetArticles resets all changes to the "articles" edge.
-func (m *PostTagMutation) ResetArticles() {
-	m.articles = nil
-	m.clearedarticles = false
-	m.removedarticles = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RedirectRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the PostTagMutation builder.
-func (m *PostTagMutation) Where(ps ...predicate.PostTag) {
+// Where appends a list predicates to the RedirectRuleMutation builder.
+func (m *RedirectRuleMutation) Where(ps ...predicate.RedirectRule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PostTagMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RedirectRuleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostTagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PostTag, len(ps))
+func (m *RedirectRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RedirectRule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -21495,39 +25916,39 @@ func (m *PostTagMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostTagMutation) Op() Op {
+func (m *RedirectRuleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostTagMutation) SetOp(op Op) {
+func (m *RedirectRuleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PostTag).
-func (m *PostTagMutation) Type() string {
+// Type returns the node type of this mutation (RedirectRule).
+func (m *RedirectRuleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostTagMutation) Fields() []string {
+func (m *RedirectRuleMutation) Fields() []string {
 	fields := make([]string, 0, 5)
-	if m.deleted_at != nil {
-		fields = append(fields, posttag.FieldDeletedAt)
+	if m.from_path != nil {
+		fields = append(fields, redirectrule.FieldFromPath)
 	}
-	if m.created_at != nil {
-		fields = append(fields, posttag.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, posttag.FieldUpdatedAt)
+	if m.to_path != nil {
+		fields = append(fields, redirectrule.FieldToPath)
 	}
-	if m.name != nil {
-		fields = append(fields, posttag.FieldName)
+	if m.code != nil {
+		fields = append(fields, redirectrule.FieldCode)
 	}
-	if m.count != nil {
-		fields = append(fields, posttag.FieldCount)
+	if m.source != nil {
+		fields = append(fields, redirectrule.FieldSource)
+	}
+	if m.created_at != nil {
+		fields = append(fields, redirectrule.FieldCreatedAt)
 	}
 	return fields
 }
@@ -21535,18 +25956,18 @@ func (m *PostTagMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostTagMutation) Field(name string) (ent.Value, bool) {
+func (m *RedirectRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case posttag.FieldDeletedAt:
-		return m.DeletedAt()
-	case posttag.FieldCreatedAt:
+	case redirectrule.FieldFromPath:
+		return m.FromPath()
+	case redirectrule.FieldToPath:
+		return m.ToPath()
+	case redirectrule.FieldCode:
+		return m.Code()
+	case redirectrule.FieldSource:
+		return m.Source()
+	case redirectrule.FieldCreatedAt:
 		return m.CreatedAt()
-	case posttag.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case posttag.FieldName:
-		return m.Name()
-	case posttag.FieldCount:
-		return m.Count()
 	}
 	return nil, false
 }
@@ -21554,72 +25975,72 @@ func (m *PostTagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RedirectRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case posttag.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case posttag.FieldCreatedAt:
+	case redirectrule.FieldFromPath:
+		return m.OldFromPath(ctx)
+	case redirectrule.FieldToPath:
+		return m.OldToPath(ctx)
+	case redirectrule.FieldCode:
+		return m.OldCode(ctx)
+	case redirectrule.FieldSource:
+		return m.OldSource(ctx)
+	case redirectrule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case posttag.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case posttag.FieldName:
-		return m.OldName(ctx)
-	case posttag.FieldCount:
-		return m.OldCount(ctx)
 	}
-	return nil, fmt.Errorf("unknown PostTag field %s", name)
+	return nil, fmt.Errorf("unknown RedirectRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostTagMutation) SetField(name string, value ent.Value) error {
+func (m *RedirectRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case posttag.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case redirectrule.FieldFromPath:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetFromPath(v)
 		return nil
-	case posttag.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case redirectrule.FieldToPath:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetToPath(v)
 		return nil
-	case posttag.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case redirectrule.FieldCode:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCode(v)
 		return nil
-	case posttag.FieldName:
+	case redirectrule.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetSource(v)
 		return nil
-	case posttag.FieldCount:
-		v, ok := value.(int)
+	case redirectrule.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCount(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PostTag field %s", name)
+	return fmt.Errorf("unknown RedirectRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostTagMutation) AddedFields() []string {
+func (m *RedirectRuleMutation) AddedFields() []string {
 	var fields []string
-	if m.addcount != nil {
-		fields = append(fields, posttag.FieldCount)
+	if m.addcode != nil {
+		fields = append(fields, redirectrule.FieldCode)
 	}
 	return fields
 }
@@ -21627,10 +26048,10 @@ func (m *PostTagMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostTagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RedirectRuleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case posttag.FieldCount:
-		return m.AddedCount()
+	case redirectrule.FieldCode:
+		return m.AddedCode()
 	}
 	return nil, false
 }
@@ -21638,152 +26059,107 @@ func (m *PostTagMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostTagMutation) AddField(name string, value ent.Value) error {
+func (m *RedirectRuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case posttag.FieldCount:
+	case redirectrule.FieldCode:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCount(v)
+		m.AddCode(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PostTag numeric field %s", name)
+	return fmt.Errorf("unknown RedirectRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostTagMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(posttag.FieldDeletedAt) {
-		fields = append(fields, posttag.FieldDeletedAt)
-	}
-	return fields
+func (m *RedirectRuleMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostTagMutation) FieldCleared(name string) bool {
+func (m *RedirectRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostTagMutation) ClearField(name string) error {
-	switch name {
-	case posttag.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown PostTag nullable field %s", name)
+func (m *RedirectRuleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RedirectRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostTagMutation) ResetField(name string) error {
+func (m *RedirectRuleMutation) ResetField(name string) error {
 	switch name {
-	case posttag.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case redirectrule.FieldFromPath:
+		m.ResetFromPath()
 		return nil
-	case posttag.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case redirectrule.FieldToPath:
+		m.ResetToPath()
 		return nil
-	case posttag.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case redirectrule.FieldCode:
+		m.ResetCode()
 		return nil
-	case posttag.FieldName:
-		m.ResetName()
+	case redirectrule.FieldSource:
+		m.ResetSource()
 		return nil
-	case posttag.FieldCount:
-		m.ResetCount()
+	case redirectrule.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PostTag field %s", name)
+	return fmt.Errorf("unknown RedirectRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostTagMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.articles != nil {
-		edges = append(edges, posttag.EdgeArticles)
-	}
+func (m *RedirectRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostTagMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case posttag.EdgeArticles:
-		ids := make([]ent.Value, 0, len(m.articles))
-		for id := range m.articles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RedirectRuleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostTagMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedarticles != nil {
-		edges = append(edges, posttag.EdgeArticles)
-	}
+func (m *RedirectRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostTagMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case posttag.EdgeArticles:
-		ids := make([]ent.Value, 0, len(m.removedarticles))
-		for id := range m.removedarticles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RedirectRuleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostTagMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedarticles {
-		edges = append(edges, posttag.EdgeArticles)
-	}
+func (m *RedirectRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostTagMutation) EdgeCleared(name string) bool {
-	switch name {
-	case posttag.EdgeArticles:
-		return m.clearedarticles
-	}
+func (m *RedirectRuleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostTagMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown PostTag unique edge %s", name)
+func (m *RedirectRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RedirectRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostTagMutation) ResetEdge(name string) error {
-	switch name {
-	case posttag.EdgeArticles:
-		m.ResetArticles()
-		return nil
-	}
-	return fmt.Errorf("unknown PostTag edge %s", name)
+func (m *RedirectRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RedirectRule edge %s", name)
 }
 
 // SettingMutation represents an operation that mutates the Setting nodes in the graph.
@@ -25920,6 +30296,15 @@ type UserMutation struct {
 	notification_configs        map[uint]struct{}
 	removednotification_configs map[uint]struct{}
 	clearednotification_configs bool
+	login_devices               map[uint]struct{}
+	removedlogin_devices        map[uint]struct{}
+	clearedlogin_devices        bool
+	oauth_links                 map[uint]struct{}
+	removedoauth_links          map[uint]struct{}
+	clearedoauth_links          bool
+	auth_sessions               map[string]struct{}
+	removedauth_sessions        map[string]struct{}
+	clearedauth_sessions        bool
 	done                        bool
 	oldValue                    func(context.Context) (*User, error)
 	predicates                  []predicate.User
@@ -26778,6 +31163,168 @@ func (m *UserMutation) ResetNotificationConfigs() {
 	m.removednotification_configs = nil
 }
 
+// AddLoginDeviceIDs adds the "login_devices" edge to the LoginDevice entity by ids.
+func (m *UserMutation) AddLoginDeviceIDs(ids ...uint) {
+	if m.login_devices == nil {
+		m.login_devices = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.login_devices[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLoginDevices clears the "login_devices" edge to the LoginDevice entity.
+func (m *UserMutation) ClearLoginDevices() {
+	m.clearedlogin_devices = true
+}
+
+// LoginDevicesCleared reports if the "login_devices" edge to the LoginDevice entity was cleared.
+func (m *UserMutation) LoginDevicesCleared() bool {
+	return m.clearedlogin_devices
+}
+
+// RemoveLoginDeviceIDs removes the "login_devices" edge to the LoginDevice entity by IDs.
+func (m *UserMutation) RemoveLoginDeviceIDs(ids ...uint) {
+	if m.removedlogin_devices == nil {
+		m.removedlogin_devices = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.login_devices, ids[i])
+		m.removedlogin_devices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLoginDevices returns the removed IDs of the "login_devices" edge to the LoginDevice entity.
+func (m *UserMutation) RemovedLoginDevicesIDs() (ids []uint) {
+	for id := range m.removedlogin_devices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LoginDevicesIDs returns the "login_devices" edge IDs in the mutation.
+func (m *UserMutation) LoginDevicesIDs() (ids []uint) {
+	for id := range m.login_devices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLoginDevices resets all changes to the "login_devices" edge.
+func (m *UserMutation) ResetLoginDevices() {
+	m.login_devices = nil
+	m.clearedlogin_devices = false
+	m.removedlogin_devices = nil
+}
+
+// AddOauthLinkIDs adds the "oauth_links" edge to the OAuthLink entity by ids.
+func (m *UserMutation) AddOauthLinkIDs(ids ...uint) {
+	if m.oauth_links == nil {
+		m.oauth_links = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.oauth_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOauthLinks clears the "oauth_links" edge to the OAuthLink entity.
+func (m *UserMutation) ClearOauthLinks() {
+	m.clearedoauth_links = true
+}
+
+// OauthLinksCleared reports if the "oauth_links" edge to the OAuthLink entity was cleared.
+func (m *UserMutation) OauthLinksCleared() bool {
+	return m.clearedoauth_links
+}
+
+// RemoveOauthLinkIDs removes the "oauth_links" edge to the OAuthLink entity by IDs.
+func (m *UserMutation) RemoveOauthLinkIDs(ids ...uint) {
+	if m.removedoauth_links == nil {
+		m.removedoauth_links = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.oauth_links, ids[i])
+		m.removedoauth_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOauthLinks returns the removed IDs of the "oauth_links" edge to the OAuthLink entity.
+func (m *UserMutation) RemovedOauthLinksIDs() (ids []uint) {
+	for id := range m.removedoauth_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OauthLinksIDs returns the "oauth_links" edge IDs in the mutation.
+func (m *UserMutation) OauthLinksIDs() (ids []uint) {
+	for id := range m.oauth_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOauthLinks resets all changes to the "oauth_links" edge.
+func (m *UserMutation) ResetOauthLinks() {
+	m.oauth_links = nil
+	m.clearedoauth_links = false
+	m.removedoauth_links = nil
+}
+
+// AddAuthSessionIDs adds the "auth_sessions" edge to the AuthSession entity by ids.
+func (m *UserMutation) AddAuthSessionIDs(ids ...string) {
+	if m.auth_sessions == nil {
+		m.auth_sessions = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.auth_sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAuthSessions clears the "auth_sessions" edge to the AuthSession entity.
+func (m *UserMutation) ClearAuthSessions() {
+	m.clearedauth_sessions = true
+}
+
+// AuthSessionsCleared reports if the "auth_sessions" edge to the AuthSession entity was cleared.
+func (m *UserMutation) AuthSessionsCleared() bool {
+	return m.clearedauth_sessions
+}
+
+// RemoveAuthSessionIDs removes the "auth_sessions" edge to the AuthSession entity by IDs.
+func (m *UserMutation) RemoveAuthSessionIDs(ids ...string) {
+	if m.removedauth_sessions == nil {
+		m.removedauth_sessions = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.auth_sessions, ids[i])
+		m.removedauth_sessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAuthSessions returns the removed IDs of the "auth_sessions" edge to the AuthSession entity.
+func (m *UserMutation) RemovedAuthSessionsIDs() (ids []string) {
+	for id := range m.removedauth_sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AuthSessionsIDs returns the "auth_sessions" edge IDs in the mutation.
+func (m *UserMutation) AuthSessionsIDs() (ids []string) {
+	for id := range m.auth_sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAuthSessions resets all changes to the "auth_sessions" edge.
+func (m *UserMutation) ResetAuthSessions() {
+	m.auth_sessions = nil
+	m.clearedauth_sessions = false
+	m.removedauth_sessions = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -27135,7 +31682,7 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 8)
 	if m.user_group != nil {
 		edges = append(edges, user.EdgeUserGroup)
 	}
@@ -27151,6 +31698,15 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.notification_configs != nil {
 		edges = append(edges, user.EdgeNotificationConfigs)
 	}
+	if m.login_devices != nil {
+		edges = append(edges, user.EdgeLoginDevices)
+	}
+	if m.oauth_links != nil {
+		edges = append(edges, user.EdgeOauthLinks)
+	}
+	if m.auth_sessions != nil {
+		edges = append(edges, user.EdgeAuthSessions)
+	}
 	return edges
 }
 
@@ -27186,13 +31742,31 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeLoginDevices:
+		ids := make([]ent.Value, 0, len(m.login_devices))
+		for id := range m.login_devices {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeOauthLinks:
+		ids := make([]ent.Value, 0, len(m.oauth_links))
+		for id := range m.oauth_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuthSessions:
+		ids := make([]ent.Value, 0, len(m.auth_sessions))
+		for id := range m.auth_sessions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 8)
 	if m.removedfiles != nil {
 		edges = append(edges, user.EdgeFiles)
 	}
@@ -27205,6 +31779,15 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removednotification_configs != nil {
 		edges = append(edges, user.EdgeNotificationConfigs)
 	}
+	if m.removedlogin_devices != nil {
+		edges = append(edges, user.EdgeLoginDevices)
+	}
+	if m.removedoauth_links != nil {
+		edges = append(edges, user.EdgeOauthLinks)
+	}
+	if m.removedauth_sessions != nil {
+		edges = append(edges, user.EdgeAuthSessions)
+	}
 	return edges
 }
 
@@ -27236,13 +31819,31 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeLoginDevices:
+		ids := make([]ent.Value, 0, len(m.removedlogin_devices))
+		for id := range m.removedlogin_devices {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeOauthLinks:
+		ids := make([]ent.Value, 0, len(m.removedoauth_links))
+		for id := range m.removedoauth_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuthSessions:
+		ids := make([]ent.Value, 0, len(m.removedauth_sessions))
+		for id := range m.removedauth_sessions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 8)
 	if m.cleareduser_group {
 		edges = append(edges, user.EdgeUserGroup)
 	}
@@ -27258,6 +31859,15 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearednotification_configs {
 		edges = append(edges, user.EdgeNotificationConfigs)
 	}
+	if m.clearedlogin_devices {
+		edges = append(edges, user.EdgeLoginDevices)
+	}
+	if m.clearedoauth_links {
+		edges = append(edges, user.EdgeOauthLinks)
+	}
+	if m.clearedauth_sessions {
+		edges = append(edges, user.EdgeAuthSessions)
+	}
 	return edges
 }
 
@@ -27275,6 +31885,12 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedinstalled_themes
 	case user.EdgeNotificationConfigs:
 		return m.clearednotification_configs
+	case user.EdgeLoginDevices:
+		return m.clearedlogin_devices
+	case user.EdgeOauthLinks:
+		return m.clearedoauth_links
+	case user.EdgeAuthSessions:
+		return m.clearedauth_sessions
 	}
 	return false
 }
@@ -27309,6 +31925,15 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeNotificationConfigs:
 		m.ResetNotificationConfigs()
 		return nil
+	case user.EdgeLoginDevices:
+		m.ResetLoginDevices()
+		return nil
+	case user.EdgeOauthLinks:
+		m.ResetOauthLinks()
+		return nil
+	case user.EdgeAuthSessions:
+		m.ResetAuthSessions()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/oauthlink"
+	"github.com/anzhiyu-c/anheyu-app/ent/user"
+)
+
+// 第三方账号绑定表
+type OAuthLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 提供方ID，对应 oauth.providers 配置中的 id
+	Provider string `json:"provider,omitempty"`
+	// 第三方账号的唯一标识
+	Subject string `json:"subject,omitempty"`
+	// 本站用户ID
+	UserID uint `json:"user_id,omitempty"`
+	// 第三方账号的邮箱
+	Email string `json:"email,omitempty"`
+	// 第三方账号的名称
+	Name string `json:"name,omitempty"`
+	// 绑定时间
+	LinkedAt time.Time `json:"linked_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OAuthLinkQuery when eager-loading is set.
+	Edges        OAuthLinkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OAuthLinkEdges holds the relations/edges for other nodes in the graph.
+type OAuthLinkEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OAuthLinkEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthlink.FieldID, oauthlink.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case oauthlink.FieldProvider, oauthlink.FieldSubject, oauthlink.FieldEmail, oauthlink.FieldName:
+			values[i] = new(sql.NullString)
+		case oauthlink.FieldLinkedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthLink fields.
+func (_m *OAuthLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthlink.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case oauthlink.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case oauthlink.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case oauthlink.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = uint(value.Int64)
+			}
+		case oauthlink.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case oauthlink.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case oauthlink.FieldLinkedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field linked_at", values[i])
+			} else if value.Valid {
+				_m.LinkedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OAuthLink.
+// This includes values selected through modifiers, order, etc.
+func (_m *OAuthLink) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the OAuthLink entity.
+func (_m *OAuthLink) QueryUser() *UserQuery {
+	return NewOAuthLinkClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this OAuthLink.
+// Note that you need to call OAuthLink.Unwrap() before calling this method if this OAuthLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OAuthLink) Update() *OAuthLinkUpdateOne {
+	return NewOAuthLinkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OAuthLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OAuthLink) Unwrap() *OAuthLink {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthLink is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OAuthLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("linked_at=")
+	builder.WriteString(_m.LinkedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OAuthLinks is a parsable slice of OAuthLink.
+type OAuthLinks []*OAuthLink
@@ -0,0 +1,860 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/oauthlink"
+	"github.com/anzhiyu-c/anheyu-app/ent/user"
+)
+
+// OAuthLinkCreate is the builder for creating a OAuthLink entity.
+type OAuthLinkCreate struct {
+	config
+	mutation *OAuthLinkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProvider sets the "provider" field.
+func (_c *OAuthLinkCreate) SetProvider(v string) *OAuthLinkCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *OAuthLinkCreate) SetSubject(v string) *OAuthLinkCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *OAuthLinkCreate) SetUserID(v uint) *OAuthLinkCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *OAuthLinkCreate) SetEmail(v string) *OAuthLinkCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *OAuthLinkCreate) SetNillableEmail(v *string) *OAuthLinkCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *OAuthLinkCreate) SetName(v string) *OAuthLinkCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *OAuthLinkCreate) SetNillableName(v *string) *OAuthLinkCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetLinkedAt sets the "linked_at" field.
+func (_c *OAuthLinkCreate) SetLinkedAt(v time.Time) *OAuthLinkCreate {
+	_c.mutation.SetLinkedAt(v)
+	return _c
+}
+
+// SetNillableLinkedAt sets the "linked_at" field if the given value is not nil.
+func (_c *OAuthLinkCreate) SetNillableLinkedAt(v *time.Time) *OAuthLinkCreate {
+	if v != nil {
+		_c.SetLinkedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OAuthLinkCreate) SetID(v uint) *OAuthLinkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *OAuthLinkCreate) SetUser(v *User) *OAuthLinkCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the OAuthLinkMutation object of the builder.
+func (_c *OAuthLinkCreate) Mutation() *OAuthLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the OAuthLink in the database.
+func (_c *OAuthLinkCreate) Save(ctx context.Context) (*OAuthLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OAuthLinkCreate) SaveX(ctx context.Context) *OAuthLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OAuthLinkCreate) defaults() {
+	if _, ok := _c.mutation.LinkedAt(); !ok {
+		v := oauthlink.DefaultLinkedAt()
+		_c.mutation.SetLinkedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OAuthLinkCreate) check() error {
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "OAuthLink.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := oauthlink.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "OAuthLink.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "OAuthLink.subject"`)}
+	}
+	if v, ok := _c.mutation.Subject(); ok {
+		if err := oauthlink.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "OAuthLink.subject": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OAuthLink.user_id"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := oauthlink.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "OAuthLink.email": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := oauthlink.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OAuthLink.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LinkedAt(); !ok {
+		return &ValidationError{Name: "linked_at", err: errors.New(`ent: missing required field "OAuthLink.linked_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "OAuthLink.user"`)}
+	}
+	return nil
+}
+
+func (_c *OAuthLinkCreate) sqlSave(ctx context.Context) (*OAuthLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OAuthLinkCreate) createSpec() (*OAuthLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oauthlink.Table, sqlgraph.NewFieldSpec(oauthlink.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(oauthlink.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(oauthlink.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(oauthlink.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(oauthlink.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.LinkedAt(); ok {
+		_spec.SetField(oauthlink.FieldLinkedAt, field.TypeTime, value)
+		_node.LinkedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthlink.UserTable,
+			Columns: []string{oauthlink.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OAuthLink.Create().
+//		SetProvider(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OAuthLinkUpsert) {
+//			SetProvider(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OAuthLinkCreate) OnConflict(opts ...sql.ConflictOption) *OAuthLinkUpsertOne {
+	_c.conflict = opts
+	return &OAuthLinkUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OAuthLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OAuthLinkCreate) OnConflictColumns(columns ...string) *OAuthLinkUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OAuthLinkUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// OAuthLinkUpsertOne is the builder for "upsert"-ing
+	//  one OAuthLink node.
+	OAuthLinkUpsertOne struct {
+		create *OAuthLinkCreate
+	}
+
+	// OAuthLinkUpsert is the "OnConflict" setter.
+	OAuthLinkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProvider sets the "provider" field.
+func (u *OAuthLinkUpsert) SetProvider(v string) *OAuthLinkUpsert {
+	u.Set(oauthlink.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *OAuthLinkUpsert) UpdateProvider() *OAuthLinkUpsert {
+	u.SetExcluded(oauthlink.FieldProvider)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *OAuthLinkUpsert) SetSubject(v string) *OAuthLinkUpsert {
+	u.Set(oauthlink.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *OAuthLinkUpsert) UpdateSubject() *OAuthLinkUpsert {
+	u.SetExcluded(oauthlink.FieldSubject)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *OAuthLinkUpsert) SetUserID(v uint) *OAuthLinkUpsert {
+	u.Set(oauthlink.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *OAuthLinkUpsert) UpdateUserID() *OAuthLinkUpsert {
+	u.SetExcluded(oauthlink.FieldUserID)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *OAuthLinkUpsert) SetEmail(v string) *OAuthLinkUpsert {
+	u.Set(oauthlink.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *OAuthLinkUpsert) UpdateEmail() *OAuthLinkUpsert {
+	u.SetExcluded(oauthlink.FieldEmail)
+	return u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *OAuthLinkUpsert) ClearEmail() *OAuthLinkUpsert {
+	u.SetNull(oauthlink.FieldEmail)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *OAuthLinkUpsert) SetName(v string) *OAuthLinkUpsert {
+	u.Set(oauthlink.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *OAuthLinkUpsert) UpdateName() *OAuthLinkUpsert {
+	u.SetExcluded(oauthlink.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *OAuthLinkUpsert) ClearName() *OAuthLinkUpsert {
+	u.SetNull(oauthlink.FieldName)
+	return u
+}
+
+// SetLinkedAt sets the "linked_at" field.
+func (u *OAuthLinkUpsert) SetLinkedAt(v time.Time) *OAuthLinkUpsert {
+	u.Set(oauthlink.FieldLinkedAt, v)
+	return u
+}
+
+// UpdateLinkedAt sets the "linked_at" field to the value that was provided on create.
+func (u *OAuthLinkUpsert) UpdateLinkedAt() *OAuthLinkUpsert {
+	u.SetExcluded(oauthlink.FieldLinkedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OAuthLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(oauthlink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OAuthLinkUpsertOne) UpdateNewValues() *OAuthLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(oauthlink.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OAuthLink.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OAuthLinkUpsertOne) Ignore() *OAuthLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OAuthLinkUpsertOne) DoNothing() *OAuthLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OAuthLinkCreate.OnConflict
+// documentation for more info.
+func (u *OAuthLinkUpsertOne) Update(set func(*OAuthLinkUpsert)) *OAuthLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OAuthLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *OAuthLinkUpsertOne) SetProvider(v string) *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *OAuthLinkUpsertOne) UpdateProvider() *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *OAuthLinkUpsertOne) SetSubject(v string) *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *OAuthLinkUpsertOne) UpdateSubject() *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *OAuthLinkUpsertOne) SetUserID(v uint) *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *OAuthLinkUpsertOne) UpdateUserID() *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *OAuthLinkUpsertOne) SetEmail(v string) *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *OAuthLinkUpsertOne) UpdateEmail() *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *OAuthLinkUpsertOne) ClearEmail() *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *OAuthLinkUpsertOne) SetName(v string) *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *OAuthLinkUpsertOne) UpdateName() *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *OAuthLinkUpsertOne) ClearName() *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetLinkedAt sets the "linked_at" field.
+func (u *OAuthLinkUpsertOne) SetLinkedAt(v time.Time) *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetLinkedAt(v)
+	})
+}
+
+// UpdateLinkedAt sets the "linked_at" field to the value that was provided on create.
+func (u *OAuthLinkUpsertOne) UpdateLinkedAt() *OAuthLinkUpsertOne {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateLinkedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OAuthLinkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OAuthLinkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OAuthLinkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OAuthLinkUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OAuthLinkUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OAuthLinkCreateBulk is the builder for creating many OAuthLink entities in bulk.
+type OAuthLinkCreateBulk struct {
+	config
+	err      error
+	builders []*OAuthLinkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OAuthLink entities in the database.
+func (_c *OAuthLinkCreateBulk) Save(ctx context.Context) ([]*OAuthLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OAuthLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OAuthLinkCreateBulk) SaveX(ctx context.Context) []*OAuthLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OAuthLink.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OAuthLinkUpsert) {
+//			SetProvider(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OAuthLinkCreateBulk) OnConflict(opts ...sql.ConflictOption) *OAuthLinkUpsertBulk {
+	_c.conflict = opts
+	return &OAuthLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OAuthLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OAuthLinkCreateBulk) OnConflictColumns(columns ...string) *OAuthLinkUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OAuthLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OAuthLinkUpsertBulk is the builder for "upsert"-ing
+// a bulk of OAuthLink nodes.
+type OAuthLinkUpsertBulk struct {
+	create *OAuthLinkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OAuthLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(oauthlink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OAuthLinkUpsertBulk) UpdateNewValues() *OAuthLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(oauthlink.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OAuthLink.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OAuthLinkUpsertBulk) Ignore() *OAuthLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OAuthLinkUpsertBulk) DoNothing() *OAuthLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OAuthLinkCreateBulk.OnConflict
+// documentation for more info.
+func (u *OAuthLinkUpsertBulk) Update(set func(*OAuthLinkUpsert)) *OAuthLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OAuthLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *OAuthLinkUpsertBulk) SetProvider(v string) *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *OAuthLinkUpsertBulk) UpdateProvider() *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *OAuthLinkUpsertBulk) SetSubject(v string) *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *OAuthLinkUpsertBulk) UpdateSubject() *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *OAuthLinkUpsertBulk) SetUserID(v uint) *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *OAuthLinkUpsertBulk) UpdateUserID() *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *OAuthLinkUpsertBulk) SetEmail(v string) *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *OAuthLinkUpsertBulk) UpdateEmail() *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *OAuthLinkUpsertBulk) ClearEmail() *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *OAuthLinkUpsertBulk) SetName(v string) *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *OAuthLinkUpsertBulk) UpdateName() *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *OAuthLinkUpsertBulk) ClearName() *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetLinkedAt sets the "linked_at" field.
+func (u *OAuthLinkUpsertBulk) SetLinkedAt(v time.Time) *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.SetLinkedAt(v)
+	})
+}
+
+// UpdateLinkedAt sets the "linked_at" field to the value that was provided on create.
+func (u *OAuthLinkUpsertBulk) UpdateLinkedAt() *OAuthLinkUpsertBulk {
+	return u.Update(func(s *OAuthLinkUpsert) {
+		s.UpdateLinkedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OAuthLinkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OAuthLinkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OAuthLinkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OAuthLinkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
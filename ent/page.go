@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 )
 
 // 自定义页面表
@@ -35,6 +37,8 @@ type Page struct {
 	ShowComment bool `json:"show_comment,omitempty"`
 	// 排序
 	Sort int `json:"sort,omitempty"`
+	// 服务端数据绑定
+	DataBindings []model.PageDataBinding `json:"data_bindings,omitempty"`
 	// 创建时间
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新时间
@@ -47,6 +51,8 @@ func (*Page) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case page.FieldDataBindings:
+			values[i] = new([]byte)
 		case page.FieldIsPublished, page.FieldShowComment:
 			values[i] = new(sql.NullBool)
 		case page.FieldID, page.FieldSort:
@@ -131,6 +137,14 @@ func (_m *Page) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Sort = int(value.Int64)
 			}
+		case page.FieldDataBindings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data_bindings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DataBindings); err != nil {
+					return fmt.Errorf("unmarshal field data_bindings: %w", err)
+				}
+			}
 		case page.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -208,6 +222,9 @@ func (_m *Page) String() string {
 	builder.WriteString("sort=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Sort))
 	builder.WriteString(", ")
+	builder.WriteString("data_bindings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DataBindings))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -32,6 +32,8 @@ const (
 	FieldShowComment = "show_comment"
 	// FieldSort holds the string denoting the sort field in the database.
 	FieldSort = "sort"
+	// FieldDataBindings holds the string denoting the data_bindings field in the database.
+	FieldDataBindings = "data_bindings"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -52,6 +54,7 @@ var Columns = []string{
 	FieldIsPublished,
 	FieldShowComment,
 	FieldSort,
+	FieldDataBindings,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -554,6 +554,16 @@ func SortLTE(v int) predicate.Page {
 	return predicate.Page(sql.FieldLTE(FieldSort, v))
 }
 
+// DataBindingsIsNil applies the IsNil predicate on the "data_bindings" field.
+func DataBindingsIsNil() predicate.Page {
+	return predicate.Page(sql.FieldIsNull(FieldDataBindings))
+}
+
+// DataBindingsNotNil applies the NotNil predicate on the "data_bindings" field.
+func DataBindingsNotNil() predicate.Page {
+	return predicate.Page(sql.FieldNotNull(FieldDataBindings))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Page {
 	return predicate.Page(sql.FieldEQ(FieldCreatedAt, v))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 )
 
 // PageCreate is the builder for creating a Page entity.
@@ -124,6 +125,12 @@ func (_c *PageCreate) SetNillableSort(v *int) *PageCreate {
 	return _c
 }
 
+// SetDataBindings sets the "data_bindings" field.
+func (_c *PageCreate) SetDataBindings(v []model.PageDataBinding) *PageCreate {
+	_c.mutation.SetDataBindings(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PageCreate) SetCreatedAt(v time.Time) *PageCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -341,6 +348,10 @@ func (_c *PageCreate) createSpec() (*Page, *sqlgraph.CreateSpec) {
 		_spec.SetField(page.FieldSort, field.TypeInt, value)
 		_node.Sort = value
 	}
+	if value, ok := _c.mutation.DataBindings(); ok {
+		_spec.SetField(page.FieldDataBindings, field.TypeJSON, value)
+		_node.DataBindings = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(page.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -527,6 +538,24 @@ func (u *PageUpsert) AddSort(v int) *PageUpsert {
 	return u
 }
 
+// SetDataBindings sets the "data_bindings" field.
+func (u *PageUpsert) SetDataBindings(v []model.PageDataBinding) *PageUpsert {
+	u.Set(page.FieldDataBindings, v)
+	return u
+}
+
+// UpdateDataBindings sets the "data_bindings" field to the value that was provided on create.
+func (u *PageUpsert) UpdateDataBindings() *PageUpsert {
+	u.SetExcluded(page.FieldDataBindings)
+	return u
+}
+
+// ClearDataBindings clears the value of the "data_bindings" field.
+func (u *PageUpsert) ClearDataBindings() *PageUpsert {
+	u.SetNull(page.FieldDataBindings)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *PageUpsert) SetUpdatedAt(v time.Time) *PageUpsert {
 	u.Set(page.FieldUpdatedAt, v)
@@ -737,6 +766,27 @@ func (u *PageUpsertOne) UpdateSort() *PageUpsertOne {
 	})
 }
 
+// SetDataBindings sets the "data_bindings" field.
+func (u *PageUpsertOne) SetDataBindings(v []model.PageDataBinding) *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.SetDataBindings(v)
+	})
+}
+
+// UpdateDataBindings sets the "data_bindings" field to the value that was provided on create.
+func (u *PageUpsertOne) UpdateDataBindings() *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.UpdateDataBindings()
+	})
+}
+
+// ClearDataBindings clears the value of the "data_bindings" field.
+func (u *PageUpsertOne) ClearDataBindings() *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.ClearDataBindings()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *PageUpsertOne) SetUpdatedAt(v time.Time) *PageUpsertOne {
 	return u.Update(func(s *PageUpsert) {
@@ -1115,6 +1165,27 @@ func (u *PageUpsertBulk) UpdateSort() *PageUpsertBulk {
 	})
 }
 
+// SetDataBindings sets the "data_bindings" field.
+func (u *PageUpsertBulk) SetDataBindings(v []model.PageDataBinding) *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.SetDataBindings(v)
+	})
+}
+
+// UpdateDataBindings sets the "data_bindings" field to the value that was provided on create.
+func (u *PageUpsertBulk) UpdateDataBindings() *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.UpdateDataBindings()
+	})
+}
+
+// ClearDataBindings clears the value of the "data_bindings" field.
+func (u *PageUpsertBulk) ClearDataBindings() *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.ClearDataBindings()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *PageUpsertBulk) SetUpdatedAt(v time.Time) *PageUpsertBulk {
 	return u.Update(func(s *PageUpsert) {
@@ -10,9 +10,11 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
 	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 )
 
 // PageUpdate is the builder for updating Page entities.
@@ -174,6 +176,24 @@ func (_u *PageUpdate) AddSort(v int) *PageUpdate {
 	return _u
 }
 
+// SetDataBindings sets the "data_bindings" field.
+func (_u *PageUpdate) SetDataBindings(v []model.PageDataBinding) *PageUpdate {
+	_u.mutation.SetDataBindings(v)
+	return _u
+}
+
+// AppendDataBindings appends value to the "data_bindings" field.
+func (_u *PageUpdate) AppendDataBindings(v []model.PageDataBinding) *PageUpdate {
+	_u.mutation.AppendDataBindings(v)
+	return _u
+}
+
+// ClearDataBindings clears the value of the "data_bindings" field.
+func (_u *PageUpdate) ClearDataBindings() *PageUpdate {
+	_u.mutation.ClearDataBindings()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PageUpdate) SetUpdatedAt(v time.Time) *PageUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -301,6 +321,17 @@ func (_u *PageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedSort(); ok {
 		_spec.AddField(page.FieldSort, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.DataBindings(); ok {
+		_spec.SetField(page.FieldDataBindings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDataBindings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, page.FieldDataBindings, value)
+		})
+	}
+	if _u.mutation.DataBindingsCleared() {
+		_spec.ClearField(page.FieldDataBindings, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(page.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -471,6 +502,24 @@ func (_u *PageUpdateOne) AddSort(v int) *PageUpdateOne {
 	return _u
 }
 
+// SetDataBindings sets the "data_bindings" field.
+func (_u *PageUpdateOne) SetDataBindings(v []model.PageDataBinding) *PageUpdateOne {
+	_u.mutation.SetDataBindings(v)
+	return _u
+}
+
+// AppendDataBindings appends value to the "data_bindings" field.
+func (_u *PageUpdateOne) AppendDataBindings(v []model.PageDataBinding) *PageUpdateOne {
+	_u.mutation.AppendDataBindings(v)
+	return _u
+}
+
+// ClearDataBindings clears the value of the "data_bindings" field.
+func (_u *PageUpdateOne) ClearDataBindings() *PageUpdateOne {
+	_u.mutation.ClearDataBindings()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PageUpdateOne) SetUpdatedAt(v time.Time) *PageUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -628,6 +677,17 @@ func (_u *PageUpdateOne) sqlSave(ctx context.Context) (_node *Page, err error) {
 	if value, ok := _u.mutation.AddedSort(); ok {
 		_spec.AddField(page.FieldSort, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.DataBindings(); ok {
+		_spec.SetField(page.FieldDataBindings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDataBindings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, page.FieldDataBindings, value)
+		})
+	}
+	if _u.mutation.DataBindingsCleared() {
+		_spec.ClearField(page.FieldDataBindings, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(page.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -18,6 +18,9 @@ type Article func(*sql.Selector)
 // ArticleHistory is the predicate function for articlehistory builders.
 type ArticleHistory func(*sql.Selector)
 
+// AuthSession is the predicate function for authsession builders.
+type AuthSession func(*sql.Selector)
+
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
@@ -39,18 +42,27 @@ type FileEntity func(*sql.Selector)
 // Link is the predicate function for link builders.
 type Link func(*sql.Selector)
 
+// LinkArchive is the predicate function for linkarchive builders.
+type LinkArchive func(*sql.Selector)
+
 // LinkCategory is the predicate function for linkcategory builders.
 type LinkCategory func(*sql.Selector)
 
 // LinkTag is the predicate function for linktag builders.
 type LinkTag func(*sql.Selector)
 
+// LoginDevice is the predicate function for logindevice builders.
+type LoginDevice func(*sql.Selector)
+
 // Metadata is the predicate function for metadata builders.
 type Metadata func(*sql.Selector)
 
 // NotificationType is the predicate function for notificationtype builders.
 type NotificationType func(*sql.Selector)
 
+// OAuthLink is the predicate function for oauthlink builders.
+type OAuthLink func(*sql.Selector)
+
 // Page is the predicate function for page builders.
 type Page func(*sql.Selector)
 
@@ -60,6 +72,12 @@ type PostCategory func(*sql.Selector)
 // PostTag is the predicate function for posttag builders.
 type PostTag func(*sql.Selector)
 
+// ReactionCount is the predicate function for reactioncount builders.
+type ReactionCount func(*sql.Selector)
+
+// RedirectRule is the predicate function for redirectrule builders.
+type RedirectRule func(*sql.Selector)
+
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
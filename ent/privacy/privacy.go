@@ -206,6 +206,30 @@ func (f ArticleHistoryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ArticleHistoryMutation", m)
 }
 
+// The AuthSessionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AuthSessionQueryRuleFunc func(context.Context, *ent.AuthSessionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AuthSessionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuthSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AuthSessionQuery", q)
+}
+
+// The AuthSessionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AuthSessionMutationRuleFunc func(context.Context, *ent.AuthSessionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AuthSessionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AuthSessionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AuthSessionMutation", m)
+}
+
 // The CommentQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type CommentQueryRuleFunc func(context.Context, *ent.CommentQuery) error
@@ -374,6 +398,30 @@ func (f LinkMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LinkMutation", m)
 }
 
+// The LinkArchiveQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LinkArchiveQueryRuleFunc func(context.Context, *ent.LinkArchiveQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LinkArchiveQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LinkArchiveQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LinkArchiveQuery", q)
+}
+
+// The LinkArchiveMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LinkArchiveMutationRuleFunc func(context.Context, *ent.LinkArchiveMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LinkArchiveMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LinkArchiveMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LinkArchiveMutation", m)
+}
+
 // The LinkCategoryQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type LinkCategoryQueryRuleFunc func(context.Context, *ent.LinkCategoryQuery) error
@@ -422,6 +470,30 @@ func (f LinkTagMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LinkTagMutation", m)
 }
 
+// The LoginDeviceQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LoginDeviceQueryRuleFunc func(context.Context, *ent.LoginDeviceQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LoginDeviceQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LoginDeviceQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LoginDeviceQuery", q)
+}
+
+// The LoginDeviceMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LoginDeviceMutationRuleFunc func(context.Context, *ent.LoginDeviceMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LoginDeviceMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LoginDeviceMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LoginDeviceMutation", m)
+}
+
 // The MetadataQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type MetadataQueryRuleFunc func(context.Context, *ent.MetadataQuery) error
@@ -470,6 +542,30 @@ func (f NotificationTypeMutationRuleFunc) EvalMutation(ctx context.Context, m en
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.NotificationTypeMutation", m)
 }
 
+// The OAuthLinkQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OAuthLinkQueryRuleFunc func(context.Context, *ent.OAuthLinkQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OAuthLinkQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OAuthLinkQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OAuthLinkQuery", q)
+}
+
+// The OAuthLinkMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OAuthLinkMutationRuleFunc func(context.Context, *ent.OAuthLinkMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OAuthLinkMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OAuthLinkMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OAuthLinkMutation", m)
+}
+
 // The PageQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type PageQueryRuleFunc func(context.Context, *ent.PageQuery) error
@@ -542,6 +638,54 @@ func (f PostTagMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PostTagMutation", m)
 }
 
+// The ReactionCountQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ReactionCountQueryRuleFunc func(context.Context, *ent.ReactionCountQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ReactionCountQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReactionCountQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ReactionCountQuery", q)
+}
+
+// The ReactionCountMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ReactionCountMutationRuleFunc func(context.Context, *ent.ReactionCountMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ReactionCountMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ReactionCountMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ReactionCountMutation", m)
+}
+
+// The RedirectRuleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RedirectRuleQueryRuleFunc func(context.Context, *ent.RedirectRuleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RedirectRuleQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RedirectRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RedirectRuleQuery", q)
+}
+
+// The RedirectRuleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RedirectRuleMutationRuleFunc func(context.Context, *ent.RedirectRuleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RedirectRuleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RedirectRuleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RedirectRuleMutation", m)
+}
+
 // The SettingQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type SettingQueryRuleFunc func(context.Context, *ent.SettingQuery) error
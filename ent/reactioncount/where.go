@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reactioncount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLTE(FieldID, id))
+}
+
+// TargetType applies equality check predicate on the "target_type" field. It's identical to TargetTypeEQ.
+func TargetType(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldTargetType, v))
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldTargetID, v))
+}
+
+// Reaction applies equality check predicate on the "reaction" field. It's identical to ReactionEQ.
+func Reaction(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldReaction, v))
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v int64) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldCount, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TargetTypeEQ applies the EQ predicate on the "target_type" field.
+func TargetTypeEQ(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldTargetType, v))
+}
+
+// TargetTypeNEQ applies the NEQ predicate on the "target_type" field.
+func TargetTypeNEQ(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNEQ(FieldTargetType, v))
+}
+
+// TargetTypeIn applies the In predicate on the "target_type" field.
+func TargetTypeIn(vs ...string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldIn(FieldTargetType, vs...))
+}
+
+// TargetTypeNotIn applies the NotIn predicate on the "target_type" field.
+func TargetTypeNotIn(vs ...string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNotIn(FieldTargetType, vs...))
+}
+
+// TargetTypeGT applies the GT predicate on the "target_type" field.
+func TargetTypeGT(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGT(FieldTargetType, v))
+}
+
+// TargetTypeGTE applies the GTE predicate on the "target_type" field.
+func TargetTypeGTE(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGTE(FieldTargetType, v))
+}
+
+// TargetTypeLT applies the LT predicate on the "target_type" field.
+func TargetTypeLT(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLT(FieldTargetType, v))
+}
+
+// TargetTypeLTE applies the LTE predicate on the "target_type" field.
+func TargetTypeLTE(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLTE(FieldTargetType, v))
+}
+
+// TargetTypeContains applies the Contains predicate on the "target_type" field.
+func TargetTypeContains(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldContains(FieldTargetType, v))
+}
+
+// TargetTypeHasPrefix applies the HasPrefix predicate on the "target_type" field.
+func TargetTypeHasPrefix(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldHasPrefix(FieldTargetType, v))
+}
+
+// TargetTypeHasSuffix applies the HasSuffix predicate on the "target_type" field.
+func TargetTypeHasSuffix(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldHasSuffix(FieldTargetType, v))
+}
+
+// TargetTypeEqualFold applies the EqualFold predicate on the "target_type" field.
+func TargetTypeEqualFold(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEqualFold(FieldTargetType, v))
+}
+
+// TargetTypeContainsFold applies the ContainsFold predicate on the "target_type" field.
+func TargetTypeContainsFold(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldContainsFold(FieldTargetType, v))
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNEQ(FieldTargetID, v))
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldIn(FieldTargetID, vs...))
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNotIn(FieldTargetID, vs...))
+}
+
+// TargetIDGT applies the GT predicate on the "target_id" field.
+func TargetIDGT(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGT(FieldTargetID, v))
+}
+
+// TargetIDGTE applies the GTE predicate on the "target_id" field.
+func TargetIDGTE(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGTE(FieldTargetID, v))
+}
+
+// TargetIDLT applies the LT predicate on the "target_id" field.
+func TargetIDLT(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLT(FieldTargetID, v))
+}
+
+// TargetIDLTE applies the LTE predicate on the "target_id" field.
+func TargetIDLTE(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLTE(FieldTargetID, v))
+}
+
+// TargetIDContains applies the Contains predicate on the "target_id" field.
+func TargetIDContains(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldContains(FieldTargetID, v))
+}
+
+// TargetIDHasPrefix applies the HasPrefix predicate on the "target_id" field.
+func TargetIDHasPrefix(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldHasPrefix(FieldTargetID, v))
+}
+
+// TargetIDHasSuffix applies the HasSuffix predicate on the "target_id" field.
+func TargetIDHasSuffix(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldHasSuffix(FieldTargetID, v))
+}
+
+// TargetIDEqualFold applies the EqualFold predicate on the "target_id" field.
+func TargetIDEqualFold(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEqualFold(FieldTargetID, v))
+}
+
+// TargetIDContainsFold applies the ContainsFold predicate on the "target_id" field.
+func TargetIDContainsFold(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldContainsFold(FieldTargetID, v))
+}
+
+// ReactionEQ applies the EQ predicate on the "reaction" field.
+func ReactionEQ(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldReaction, v))
+}
+
+// ReactionNEQ applies the NEQ predicate on the "reaction" field.
+func ReactionNEQ(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNEQ(FieldReaction, v))
+}
+
+// ReactionIn applies the In predicate on the "reaction" field.
+func ReactionIn(vs ...string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldIn(FieldReaction, vs...))
+}
+
+// ReactionNotIn applies the NotIn predicate on the "reaction" field.
+func ReactionNotIn(vs ...string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNotIn(FieldReaction, vs...))
+}
+
+// ReactionGT applies the GT predicate on the "reaction" field.
+func ReactionGT(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGT(FieldReaction, v))
+}
+
+// ReactionGTE applies the GTE predicate on the "reaction" field.
+func ReactionGTE(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGTE(FieldReaction, v))
+}
+
+// ReactionLT applies the LT predicate on the "reaction" field.
+func ReactionLT(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLT(FieldReaction, v))
+}
+
+// ReactionLTE applies the LTE predicate on the "reaction" field.
+func ReactionLTE(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLTE(FieldReaction, v))
+}
+
+// ReactionContains applies the Contains predicate on the "reaction" field.
+func ReactionContains(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldContains(FieldReaction, v))
+}
+
+// ReactionHasPrefix applies the HasPrefix predicate on the "reaction" field.
+func ReactionHasPrefix(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldHasPrefix(FieldReaction, v))
+}
+
+// ReactionHasSuffix applies the HasSuffix predicate on the "reaction" field.
+func ReactionHasSuffix(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldHasSuffix(FieldReaction, v))
+}
+
+// ReactionEqualFold applies the EqualFold predicate on the "reaction" field.
+func ReactionEqualFold(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEqualFold(FieldReaction, v))
+}
+
+// ReactionContainsFold applies the ContainsFold predicate on the "reaction" field.
+func ReactionContainsFold(v string) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldContainsFold(FieldReaction, v))
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v int64) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldCount, v))
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v int64) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNEQ(FieldCount, v))
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...int64) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldIn(FieldCount, vs...))
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...int64) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNotIn(FieldCount, vs...))
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v int64) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGT(FieldCount, v))
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v int64) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGTE(FieldCount, v))
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v int64) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLT(FieldCount, v))
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v int64) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLTE(FieldCount, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReactionCount) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReactionCount) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReactionCount) predicate.ReactionCount {
+	return predicate.ReactionCount(sql.NotPredicates(p))
+}
@@ -0,0 +1,762 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/reactioncount"
+)
+
+// ReactionCountCreate is the builder for creating a ReactionCount entity.
+type ReactionCountCreate struct {
+	config
+	mutation *ReactionCountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTargetType sets the "target_type" field.
+func (_c *ReactionCountCreate) SetTargetType(v string) *ReactionCountCreate {
+	_c.mutation.SetTargetType(v)
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *ReactionCountCreate) SetTargetID(v string) *ReactionCountCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetReaction sets the "reaction" field.
+func (_c *ReactionCountCreate) SetReaction(v string) *ReactionCountCreate {
+	_c.mutation.SetReaction(v)
+	return _c
+}
+
+// SetCount sets the "count" field.
+func (_c *ReactionCountCreate) SetCount(v int64) *ReactionCountCreate {
+	_c.mutation.SetCount(v)
+	return _c
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_c *ReactionCountCreate) SetNillableCount(v *int64) *ReactionCountCreate {
+	if v != nil {
+		_c.SetCount(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReactionCountCreate) SetUpdatedAt(v time.Time) *ReactionCountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReactionCountCreate) SetNillableUpdatedAt(v *time.Time) *ReactionCountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReactionCountCreate) SetID(v uint) *ReactionCountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ReactionCountMutation object of the builder.
+func (_c *ReactionCountCreate) Mutation() *ReactionCountMutation {
+	return _c.mutation
+}
+
+// Save creates the ReactionCount in the database.
+func (_c *ReactionCountCreate) Save(ctx context.Context) (*ReactionCount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReactionCountCreate) SaveX(ctx context.Context) *ReactionCount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReactionCountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReactionCountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReactionCountCreate) defaults() {
+	if _, ok := _c.mutation.Count(); !ok {
+		v := reactioncount.DefaultCount
+		_c.mutation.SetCount(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := reactioncount.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReactionCountCreate) check() error {
+	if _, ok := _c.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "ReactionCount.target_type"`)}
+	}
+	if v, ok := _c.mutation.TargetType(); ok {
+		if err := reactioncount.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "ReactionCount.target_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "ReactionCount.target_id"`)}
+	}
+	if v, ok := _c.mutation.TargetID(); ok {
+		if err := reactioncount.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`ent: validator failed for field "ReactionCount.target_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reaction(); !ok {
+		return &ValidationError{Name: "reaction", err: errors.New(`ent: missing required field "ReactionCount.reaction"`)}
+	}
+	if v, ok := _c.mutation.Reaction(); ok {
+		if err := reactioncount.ReactionValidator(v); err != nil {
+			return &ValidationError{Name: "reaction", err: fmt.Errorf(`ent: validator failed for field "ReactionCount.reaction": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "ReactionCount.count"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReactionCount.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReactionCountCreate) sqlSave(ctx context.Context) (*ReactionCount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReactionCountCreate) createSpec() (*ReactionCount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReactionCount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reactioncount.Table, sqlgraph.NewFieldSpec(reactioncount.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TargetType(); ok {
+		_spec.SetField(reactioncount.FieldTargetType, field.TypeString, value)
+		_node.TargetType = value
+	}
+	if value, ok := _c.mutation.TargetID(); ok {
+		_spec.SetField(reactioncount.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	if value, ok := _c.mutation.Reaction(); ok {
+		_spec.SetField(reactioncount.FieldReaction, field.TypeString, value)
+		_node.Reaction = value
+	}
+	if value, ok := _c.mutation.Count(); ok {
+		_spec.SetField(reactioncount.FieldCount, field.TypeInt64, value)
+		_node.Count = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(reactioncount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReactionCount.Create().
+//		SetTargetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReactionCountUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReactionCountCreate) OnConflict(opts ...sql.ConflictOption) *ReactionCountUpsertOne {
+	_c.conflict = opts
+	return &ReactionCountUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReactionCount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReactionCountCreate) OnConflictColumns(columns ...string) *ReactionCountUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReactionCountUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReactionCountUpsertOne is the builder for "upsert"-ing
+	//  one ReactionCount node.
+	ReactionCountUpsertOne struct {
+		create *ReactionCountCreate
+	}
+
+	// ReactionCountUpsert is the "OnConflict" setter.
+	ReactionCountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTargetType sets the "target_type" field.
+func (u *ReactionCountUpsert) SetTargetType(v string) *ReactionCountUpsert {
+	u.Set(reactioncount.FieldTargetType, v)
+	return u
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ReactionCountUpsert) UpdateTargetType() *ReactionCountUpsert {
+	u.SetExcluded(reactioncount.FieldTargetType)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *ReactionCountUpsert) SetTargetID(v string) *ReactionCountUpsert {
+	u.Set(reactioncount.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *ReactionCountUpsert) UpdateTargetID() *ReactionCountUpsert {
+	u.SetExcluded(reactioncount.FieldTargetID)
+	return u
+}
+
+// SetReaction sets the "reaction" field.
+func (u *ReactionCountUpsert) SetReaction(v string) *ReactionCountUpsert {
+	u.Set(reactioncount.FieldReaction, v)
+	return u
+}
+
+// UpdateReaction sets the "reaction" field to the value that was provided on create.
+func (u *ReactionCountUpsert) UpdateReaction() *ReactionCountUpsert {
+	u.SetExcluded(reactioncount.FieldReaction)
+	return u
+}
+
+// SetCount sets the "count" field.
+func (u *ReactionCountUpsert) SetCount(v int64) *ReactionCountUpsert {
+	u.Set(reactioncount.FieldCount, v)
+	return u
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *ReactionCountUpsert) UpdateCount() *ReactionCountUpsert {
+	u.SetExcluded(reactioncount.FieldCount)
+	return u
+}
+
+// AddCount adds v to the "count" field.
+func (u *ReactionCountUpsert) AddCount(v int64) *ReactionCountUpsert {
+	u.Add(reactioncount.FieldCount, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReactionCountUpsert) SetUpdatedAt(v time.Time) *ReactionCountUpsert {
+	u.Set(reactioncount.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReactionCountUpsert) UpdateUpdatedAt() *ReactionCountUpsert {
+	u.SetExcluded(reactioncount.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReactionCount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reactioncount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReactionCountUpsertOne) UpdateNewValues() *ReactionCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reactioncount.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReactionCount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReactionCountUpsertOne) Ignore() *ReactionCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReactionCountUpsertOne) DoNothing() *ReactionCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReactionCountCreate.OnConflict
+// documentation for more info.
+func (u *ReactionCountUpsertOne) Update(set func(*ReactionCountUpsert)) *ReactionCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReactionCountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *ReactionCountUpsertOne) SetTargetType(v string) *ReactionCountUpsertOne {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ReactionCountUpsertOne) UpdateTargetType() *ReactionCountUpsertOne {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *ReactionCountUpsertOne) SetTargetID(v string) *ReactionCountUpsertOne {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *ReactionCountUpsertOne) UpdateTargetID() *ReactionCountUpsertOne {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetReaction sets the "reaction" field.
+func (u *ReactionCountUpsertOne) SetReaction(v string) *ReactionCountUpsertOne {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.SetReaction(v)
+	})
+}
+
+// UpdateReaction sets the "reaction" field to the value that was provided on create.
+func (u *ReactionCountUpsertOne) UpdateReaction() *ReactionCountUpsertOne {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.UpdateReaction()
+	})
+}
+
+// SetCount sets the "count" field.
+func (u *ReactionCountUpsertOne) SetCount(v int64) *ReactionCountUpsertOne {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *ReactionCountUpsertOne) AddCount(v int64) *ReactionCountUpsertOne {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *ReactionCountUpsertOne) UpdateCount() *ReactionCountUpsertOne {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReactionCountUpsertOne) SetUpdatedAt(v time.Time) *ReactionCountUpsertOne {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReactionCountUpsertOne) UpdateUpdatedAt() *ReactionCountUpsertOne {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReactionCountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReactionCountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReactionCountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReactionCountUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReactionCountUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReactionCountCreateBulk is the builder for creating many ReactionCount entities in bulk.
+type ReactionCountCreateBulk struct {
+	config
+	err      error
+	builders []*ReactionCountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReactionCount entities in the database.
+func (_c *ReactionCountCreateBulk) Save(ctx context.Context) ([]*ReactionCount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReactionCount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReactionCountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReactionCountCreateBulk) SaveX(ctx context.Context) []*ReactionCount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReactionCountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReactionCountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReactionCount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReactionCountUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReactionCountCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReactionCountUpsertBulk {
+	_c.conflict = opts
+	return &ReactionCountUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReactionCount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReactionCountCreateBulk) OnConflictColumns(columns ...string) *ReactionCountUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReactionCountUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReactionCountUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReactionCount nodes.
+type ReactionCountUpsertBulk struct {
+	create *ReactionCountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReactionCount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reactioncount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReactionCountUpsertBulk) UpdateNewValues() *ReactionCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reactioncount.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReactionCount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReactionCountUpsertBulk) Ignore() *ReactionCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReactionCountUpsertBulk) DoNothing() *ReactionCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReactionCountCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReactionCountUpsertBulk) Update(set func(*ReactionCountUpsert)) *ReactionCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReactionCountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *ReactionCountUpsertBulk) SetTargetType(v string) *ReactionCountUpsertBulk {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ReactionCountUpsertBulk) UpdateTargetType() *ReactionCountUpsertBulk {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *ReactionCountUpsertBulk) SetTargetID(v string) *ReactionCountUpsertBulk {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *ReactionCountUpsertBulk) UpdateTargetID() *ReactionCountUpsertBulk {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetReaction sets the "reaction" field.
+func (u *ReactionCountUpsertBulk) SetReaction(v string) *ReactionCountUpsertBulk {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.SetReaction(v)
+	})
+}
+
+// UpdateReaction sets the "reaction" field to the value that was provided on create.
+func (u *ReactionCountUpsertBulk) UpdateReaction() *ReactionCountUpsertBulk {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.UpdateReaction()
+	})
+}
+
+// SetCount sets the "count" field.
+func (u *ReactionCountUpsertBulk) SetCount(v int64) *ReactionCountUpsertBulk {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *ReactionCountUpsertBulk) AddCount(v int64) *ReactionCountUpsertBulk {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *ReactionCountUpsertBulk) UpdateCount() *ReactionCountUpsertBulk {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReactionCountUpsertBulk) SetUpdatedAt(v time.Time) *ReactionCountUpsertBulk {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReactionCountUpsertBulk) UpdateUpdatedAt() *ReactionCountUpsertBulk {
+	return u.Update(func(s *ReactionCountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReactionCountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReactionCountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReactionCountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReactionCountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
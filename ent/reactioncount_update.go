@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/reactioncount"
+)
+
+// ReactionCountUpdate is the builder for updating ReactionCount entities.
+type ReactionCountUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReactionCountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReactionCountUpdate builder.
+func (_u *ReactionCountUpdate) Where(ps ...predicate.ReactionCount) *ReactionCountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTargetType sets the "target_type" field.
+func (_u *ReactionCountUpdate) SetTargetType(v string) *ReactionCountUpdate {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *ReactionCountUpdate) SetNillableTargetType(v *string) *ReactionCountUpdate {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *ReactionCountUpdate) SetTargetID(v string) *ReactionCountUpdate {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *ReactionCountUpdate) SetNillableTargetID(v *string) *ReactionCountUpdate {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetReaction sets the "reaction" field.
+func (_u *ReactionCountUpdate) SetReaction(v string) *ReactionCountUpdate {
+	_u.mutation.SetReaction(v)
+	return _u
+}
+
+// SetNillableReaction sets the "reaction" field if the given value is not nil.
+func (_u *ReactionCountUpdate) SetNillableReaction(v *string) *ReactionCountUpdate {
+	if v != nil {
+		_u.SetReaction(*v)
+	}
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *ReactionCountUpdate) SetCount(v int64) *ReactionCountUpdate {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *ReactionCountUpdate) SetNillableCount(v *int64) *ReactionCountUpdate {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *ReactionCountUpdate) AddCount(v int64) *ReactionCountUpdate {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReactionCountUpdate) SetUpdatedAt(v time.Time) *ReactionCountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ReactionCountMutation object of the builder.
+func (_u *ReactionCountUpdate) Mutation() *ReactionCountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReactionCountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReactionCountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReactionCountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReactionCountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReactionCountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reactioncount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReactionCountUpdate) check() error {
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := reactioncount.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "ReactionCount.target_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetID(); ok {
+		if err := reactioncount.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`ent: validator failed for field "ReactionCount.target_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reaction(); ok {
+		if err := reactioncount.ReactionValidator(v); err != nil {
+			return &ValidationError{Name: "reaction", err: fmt.Errorf(`ent: validator failed for field "ReactionCount.reaction": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReactionCountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReactionCountUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReactionCountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reactioncount.Table, reactioncount.Columns, sqlgraph.NewFieldSpec(reactioncount.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(reactioncount.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(reactioncount.FieldTargetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reaction(); ok {
+		_spec.SetField(reactioncount.FieldReaction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(reactioncount.FieldCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(reactioncount.FieldCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reactioncount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reactioncount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReactionCountUpdateOne is the builder for updating a single ReactionCount entity.
+type ReactionCountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReactionCountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTargetType sets the "target_type" field.
+func (_u *ReactionCountUpdateOne) SetTargetType(v string) *ReactionCountUpdateOne {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *ReactionCountUpdateOne) SetNillableTargetType(v *string) *ReactionCountUpdateOne {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *ReactionCountUpdateOne) SetTargetID(v string) *ReactionCountUpdateOne {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *ReactionCountUpdateOne) SetNillableTargetID(v *string) *ReactionCountUpdateOne {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetReaction sets the "reaction" field.
+func (_u *ReactionCountUpdateOne) SetReaction(v string) *ReactionCountUpdateOne {
+	_u.mutation.SetReaction(v)
+	return _u
+}
+
+// SetNillableReaction sets the "reaction" field if the given value is not nil.
+func (_u *ReactionCountUpdateOne) SetNillableReaction(v *string) *ReactionCountUpdateOne {
+	if v != nil {
+		_u.SetReaction(*v)
+	}
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *ReactionCountUpdateOne) SetCount(v int64) *ReactionCountUpdateOne {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *ReactionCountUpdateOne) SetNillableCount(v *int64) *ReactionCountUpdateOne {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *ReactionCountUpdateOne) AddCount(v int64) *ReactionCountUpdateOne {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReactionCountUpdateOne) SetUpdatedAt(v time.Time) *ReactionCountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ReactionCountMutation object of the builder.
+func (_u *ReactionCountUpdateOne) Mutation() *ReactionCountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReactionCountUpdate builder.
+func (_u *ReactionCountUpdateOne) Where(ps ...predicate.ReactionCount) *ReactionCountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReactionCountUpdateOne) Select(field string, fields ...string) *ReactionCountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReactionCount entity.
+func (_u *ReactionCountUpdateOne) Save(ctx context.Context) (*ReactionCount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReactionCountUpdateOne) SaveX(ctx context.Context) *ReactionCount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReactionCountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReactionCountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReactionCountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reactioncount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReactionCountUpdateOne) check() error {
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := reactioncount.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "ReactionCount.target_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetID(); ok {
+		if err := reactioncount.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`ent: validator failed for field "ReactionCount.target_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reaction(); ok {
+		if err := reactioncount.ReactionValidator(v); err != nil {
+			return &ValidationError{Name: "reaction", err: fmt.Errorf(`ent: validator failed for field "ReactionCount.reaction": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReactionCountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReactionCountUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReactionCountUpdateOne) sqlSave(ctx context.Context) (_node *ReactionCount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reactioncount.Table, reactioncount.Columns, sqlgraph.NewFieldSpec(reactioncount.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReactionCount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reactioncount.FieldID)
+		for _, f := range fields {
+			if !reactioncount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reactioncount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(reactioncount.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(reactioncount.FieldTargetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reaction(); ok {
+		_spec.SetField(reactioncount.FieldReaction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(reactioncount.FieldCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(reactioncount.FieldCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reactioncount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ReactionCount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reactioncount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
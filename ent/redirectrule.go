@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/redirectrule"
+)
+
+// 重定向规则表
+type RedirectRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 来源地址，站内路径
+	FromPath string `json:"from_path,omitempty"`
+	// 目标地址，站内路径
+	ToPath string `json:"to_path,omitempty"`
+	// 状态码：301、302、307 或 308
+	Code int `json:"code,omitempty"`
+	// 来源：manual 管理员添加，permalink 文章永久链接变化时自动添加
+	Source string `json:"source,omitempty"`
+	// 创建时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RedirectRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case redirectrule.FieldID, redirectrule.FieldCode:
+			values[i] = new(sql.NullInt64)
+		case redirectrule.FieldFromPath, redirectrule.FieldToPath, redirectrule.FieldSource:
+			values[i] = new(sql.NullString)
+		case redirectrule.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RedirectRule fields.
+func (_m *RedirectRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case redirectrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case redirectrule.FieldFromPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_path", values[i])
+			} else if value.Valid {
+				_m.FromPath = value.String
+			}
+		case redirectrule.FieldToPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_path", values[i])
+			} else if value.Valid {
+				_m.ToPath = value.String
+			}
+		case redirectrule.FieldCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = int(value.Int64)
+			}
+		case redirectrule.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case redirectrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RedirectRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *RedirectRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RedirectRule.
+// Note that you need to call RedirectRule.Unwrap() before calling this method if this RedirectRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RedirectRule) Update() *RedirectRuleUpdateOne {
+	return NewRedirectRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RedirectRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RedirectRule) Unwrap() *RedirectRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RedirectRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RedirectRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("RedirectRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("from_path=")
+	builder.WriteString(_m.FromPath)
+	builder.WriteString(", ")
+	builder.WriteString("to_path=")
+	builder.WriteString(_m.ToPath)
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Code))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RedirectRules is a parsable slice of RedirectRule.
+type RedirectRules []*RedirectRule
@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package redirectrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLTE(FieldID, id))
+}
+
+// FromPath applies equality check predicate on the "from_path" field. It's identical to FromPathEQ.
+func FromPath(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldFromPath, v))
+}
+
+// ToPath applies equality check predicate on the "to_path" field. It's identical to ToPathEQ.
+func ToPath(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldToPath, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v int) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldCode, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldSource, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FromPathEQ applies the EQ predicate on the "from_path" field.
+func FromPathEQ(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldFromPath, v))
+}
+
+// FromPathNEQ applies the NEQ predicate on the "from_path" field.
+func FromPathNEQ(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNEQ(FieldFromPath, v))
+}
+
+// FromPathIn applies the In predicate on the "from_path" field.
+func FromPathIn(vs ...string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldIn(FieldFromPath, vs...))
+}
+
+// FromPathNotIn applies the NotIn predicate on the "from_path" field.
+func FromPathNotIn(vs ...string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNotIn(FieldFromPath, vs...))
+}
+
+// FromPathGT applies the GT predicate on the "from_path" field.
+func FromPathGT(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGT(FieldFromPath, v))
+}
+
+// FromPathGTE applies the GTE predicate on the "from_path" field.
+func FromPathGTE(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGTE(FieldFromPath, v))
+}
+
+// FromPathLT applies the LT predicate on the "from_path" field.
+func FromPathLT(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLT(FieldFromPath, v))
+}
+
+// FromPathLTE applies the LTE predicate on the "from_path" field.
+func FromPathLTE(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLTE(FieldFromPath, v))
+}
+
+// FromPathContains applies the Contains predicate on the "from_path" field.
+func FromPathContains(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldContains(FieldFromPath, v))
+}
+
+// FromPathHasPrefix applies the HasPrefix predicate on the "from_path" field.
+func FromPathHasPrefix(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldHasPrefix(FieldFromPath, v))
+}
+
+// FromPathHasSuffix applies the HasSuffix predicate on the "from_path" field.
+func FromPathHasSuffix(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldHasSuffix(FieldFromPath, v))
+}
+
+// FromPathEqualFold applies the EqualFold predicate on the "from_path" field.
+func FromPathEqualFold(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEqualFold(FieldFromPath, v))
+}
+
+// FromPathContainsFold applies the ContainsFold predicate on the "from_path" field.
+func FromPathContainsFold(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldContainsFold(FieldFromPath, v))
+}
+
+// ToPathEQ applies the EQ predicate on the "to_path" field.
+func ToPathEQ(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldToPath, v))
+}
+
+// ToPathNEQ applies the NEQ predicate on the "to_path" field.
+func ToPathNEQ(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNEQ(FieldToPath, v))
+}
+
+// ToPathIn applies the In predicate on the "to_path" field.
+func ToPathIn(vs ...string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldIn(FieldToPath, vs...))
+}
+
+// ToPathNotIn applies the NotIn predicate on the "to_path" field.
+func ToPathNotIn(vs ...string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNotIn(FieldToPath, vs...))
+}
+
+// ToPathGT applies the GT predicate on the "to_path" field.
+func ToPathGT(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGT(FieldToPath, v))
+}
+
+// ToPathGTE applies the GTE predicate on the "to_path" field.
+func ToPathGTE(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGTE(FieldToPath, v))
+}
+
+// ToPathLT applies the LT predicate on the "to_path" field.
+func ToPathLT(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLT(FieldToPath, v))
+}
+
+// ToPathLTE applies the LTE predicate on the "to_path" field.
+func ToPathLTE(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLTE(FieldToPath, v))
+}
+
+// ToPathContains applies the Contains predicate on the "to_path" field.
+func ToPathContains(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldContains(FieldToPath, v))
+}
+
+// ToPathHasPrefix applies the HasPrefix predicate on the "to_path" field.
+func ToPathHasPrefix(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldHasPrefix(FieldToPath, v))
+}
+
+// ToPathHasSuffix applies the HasSuffix predicate on the "to_path" field.
+func ToPathHasSuffix(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldHasSuffix(FieldToPath, v))
+}
+
+// ToPathEqualFold applies the EqualFold predicate on the "to_path" field.
+func ToPathEqualFold(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEqualFold(FieldToPath, v))
+}
+
+// ToPathContainsFold applies the ContainsFold predicate on the "to_path" field.
+func ToPathContainsFold(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldContainsFold(FieldToPath, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v int) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v int) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...int) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...int) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v int) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v int) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v int) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v int) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLTE(FieldCode, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldContainsFold(FieldSource, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RedirectRule) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RedirectRule) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RedirectRule) predicate.RedirectRule {
+	return predicate.RedirectRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,740 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/redirectrule"
+)
+
+// RedirectRuleCreate is the builder for creating a RedirectRule entity.
+type RedirectRuleCreate struct {
+	config
+	mutation *RedirectRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetFromPath sets the "from_path" field.
+func (_c *RedirectRuleCreate) SetFromPath(v string) *RedirectRuleCreate {
+	_c.mutation.SetFromPath(v)
+	return _c
+}
+
+// SetToPath sets the "to_path" field.
+func (_c *RedirectRuleCreate) SetToPath(v string) *RedirectRuleCreate {
+	_c.mutation.SetToPath(v)
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *RedirectRuleCreate) SetCode(v int) *RedirectRuleCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_c *RedirectRuleCreate) SetNillableCode(v *int) *RedirectRuleCreate {
+	if v != nil {
+		_c.SetCode(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *RedirectRuleCreate) SetSource(v string) *RedirectRuleCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *RedirectRuleCreate) SetNillableSource(v *string) *RedirectRuleCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RedirectRuleCreate) SetCreatedAt(v time.Time) *RedirectRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RedirectRuleCreate) SetNillableCreatedAt(v *time.Time) *RedirectRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RedirectRuleCreate) SetID(v uint) *RedirectRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the RedirectRuleMutation object of the builder.
+func (_c *RedirectRuleCreate) Mutation() *RedirectRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the RedirectRule in the database.
+func (_c *RedirectRuleCreate) Save(ctx context.Context) (*RedirectRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RedirectRuleCreate) SaveX(ctx context.Context) *RedirectRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RedirectRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RedirectRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RedirectRuleCreate) defaults() {
+	if _, ok := _c.mutation.Code(); !ok {
+		v := redirectrule.DefaultCode
+		_c.mutation.SetCode(v)
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := redirectrule.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := redirectrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RedirectRuleCreate) check() error {
+	if _, ok := _c.mutation.FromPath(); !ok {
+		return &ValidationError{Name: "from_path", err: errors.New(`ent: missing required field "RedirectRule.from_path"`)}
+	}
+	if v, ok := _c.mutation.FromPath(); ok {
+		if err := redirectrule.FromPathValidator(v); err != nil {
+			return &ValidationError{Name: "from_path", err: fmt.Errorf(`ent: validator failed for field "RedirectRule.from_path": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToPath(); !ok {
+		return &ValidationError{Name: "to_path", err: errors.New(`ent: missing required field "RedirectRule.to_path"`)}
+	}
+	if v, ok := _c.mutation.ToPath(); ok {
+		if err := redirectrule.ToPathValidator(v); err != nil {
+			return &ValidationError{Name: "to_path", err: fmt.Errorf(`ent: validator failed for field "RedirectRule.to_path": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "RedirectRule.code"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "RedirectRule.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := redirectrule.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "RedirectRule.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RedirectRule.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *RedirectRuleCreate) sqlSave(ctx context.Context) (*RedirectRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RedirectRuleCreate) createSpec() (*RedirectRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RedirectRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(redirectrule.Table, sqlgraph.NewFieldSpec(redirectrule.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.FromPath(); ok {
+		_spec.SetField(redirectrule.FieldFromPath, field.TypeString, value)
+		_node.FromPath = value
+	}
+	if value, ok := _c.mutation.ToPath(); ok {
+		_spec.SetField(redirectrule.FieldToPath, field.TypeString, value)
+		_node.ToPath = value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(redirectrule.FieldCode, field.TypeInt, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(redirectrule.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(redirectrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RedirectRule.Create().
+//		SetFromPath(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RedirectRuleUpsert) {
+//			SetFromPath(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RedirectRuleCreate) OnConflict(opts ...sql.ConflictOption) *RedirectRuleUpsertOne {
+	_c.conflict = opts
+	return &RedirectRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RedirectRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RedirectRuleCreate) OnConflictColumns(columns ...string) *RedirectRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RedirectRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RedirectRuleUpsertOne is the builder for "upsert"-ing
+	//  one RedirectRule node.
+	RedirectRuleUpsertOne struct {
+		create *RedirectRuleCreate
+	}
+
+	// RedirectRuleUpsert is the "OnConflict" setter.
+	RedirectRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetFromPath sets the "from_path" field.
+func (u *RedirectRuleUpsert) SetFromPath(v string) *RedirectRuleUpsert {
+	u.Set(redirectrule.FieldFromPath, v)
+	return u
+}
+
+// UpdateFromPath sets the "from_path" field to the value that was provided on create.
+func (u *RedirectRuleUpsert) UpdateFromPath() *RedirectRuleUpsert {
+	u.SetExcluded(redirectrule.FieldFromPath)
+	return u
+}
+
+// SetToPath sets the "to_path" field.
+func (u *RedirectRuleUpsert) SetToPath(v string) *RedirectRuleUpsert {
+	u.Set(redirectrule.FieldToPath, v)
+	return u
+}
+
+// UpdateToPath sets the "to_path" field to the value that was provided on create.
+func (u *RedirectRuleUpsert) UpdateToPath() *RedirectRuleUpsert {
+	u.SetExcluded(redirectrule.FieldToPath)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *RedirectRuleUpsert) SetCode(v int) *RedirectRuleUpsert {
+	u.Set(redirectrule.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *RedirectRuleUpsert) UpdateCode() *RedirectRuleUpsert {
+	u.SetExcluded(redirectrule.FieldCode)
+	return u
+}
+
+// AddCode adds v to the "code" field.
+func (u *RedirectRuleUpsert) AddCode(v int) *RedirectRuleUpsert {
+	u.Add(redirectrule.FieldCode, v)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *RedirectRuleUpsert) SetSource(v string) *RedirectRuleUpsert {
+	u.Set(redirectrule.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *RedirectRuleUpsert) UpdateSource() *RedirectRuleUpsert {
+	u.SetExcluded(redirectrule.FieldSource)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RedirectRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(redirectrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RedirectRuleUpsertOne) UpdateNewValues() *RedirectRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(redirectrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(redirectrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RedirectRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RedirectRuleUpsertOne) Ignore() *RedirectRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RedirectRuleUpsertOne) DoNothing() *RedirectRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RedirectRuleCreate.OnConflict
+// documentation for more info.
+func (u *RedirectRuleUpsertOne) Update(set func(*RedirectRuleUpsert)) *RedirectRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RedirectRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFromPath sets the "from_path" field.
+func (u *RedirectRuleUpsertOne) SetFromPath(v string) *RedirectRuleUpsertOne {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.SetFromPath(v)
+	})
+}
+
+// UpdateFromPath sets the "from_path" field to the value that was provided on create.
+func (u *RedirectRuleUpsertOne) UpdateFromPath() *RedirectRuleUpsertOne {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.UpdateFromPath()
+	})
+}
+
+// SetToPath sets the "to_path" field.
+func (u *RedirectRuleUpsertOne) SetToPath(v string) *RedirectRuleUpsertOne {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.SetToPath(v)
+	})
+}
+
+// UpdateToPath sets the "to_path" field to the value that was provided on create.
+func (u *RedirectRuleUpsertOne) UpdateToPath() *RedirectRuleUpsertOne {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.UpdateToPath()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *RedirectRuleUpsertOne) SetCode(v int) *RedirectRuleUpsertOne {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// AddCode adds v to the "code" field.
+func (u *RedirectRuleUpsertOne) AddCode(v int) *RedirectRuleUpsertOne {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.AddCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *RedirectRuleUpsertOne) UpdateCode() *RedirectRuleUpsertOne {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *RedirectRuleUpsertOne) SetSource(v string) *RedirectRuleUpsertOne {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *RedirectRuleUpsertOne) UpdateSource() *RedirectRuleUpsertOne {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// Exec executes the query.
+func (u *RedirectRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RedirectRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RedirectRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RedirectRuleUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RedirectRuleUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RedirectRuleCreateBulk is the builder for creating many RedirectRule entities in bulk.
+type RedirectRuleCreateBulk struct {
+	config
+	err      error
+	builders []*RedirectRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RedirectRule entities in the database.
+func (_c *RedirectRuleCreateBulk) Save(ctx context.Context) ([]*RedirectRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RedirectRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RedirectRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RedirectRuleCreateBulk) SaveX(ctx context.Context) []*RedirectRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RedirectRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RedirectRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RedirectRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RedirectRuleUpsert) {
+//			SetFromPath(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RedirectRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *RedirectRuleUpsertBulk {
+	_c.conflict = opts
+	return &RedirectRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RedirectRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RedirectRuleCreateBulk) OnConflictColumns(columns ...string) *RedirectRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RedirectRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// RedirectRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of RedirectRule nodes.
+type RedirectRuleUpsertBulk struct {
+	create *RedirectRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RedirectRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(redirectrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RedirectRuleUpsertBulk) UpdateNewValues() *RedirectRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(redirectrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(redirectrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RedirectRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RedirectRuleUpsertBulk) Ignore() *RedirectRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RedirectRuleUpsertBulk) DoNothing() *RedirectRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RedirectRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *RedirectRuleUpsertBulk) Update(set func(*RedirectRuleUpsert)) *RedirectRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RedirectRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFromPath sets the "from_path" field.
+func (u *RedirectRuleUpsertBulk) SetFromPath(v string) *RedirectRuleUpsertBulk {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.SetFromPath(v)
+	})
+}
+
+// UpdateFromPath sets the "from_path" field to the value that was provided on create.
+func (u *RedirectRuleUpsertBulk) UpdateFromPath() *RedirectRuleUpsertBulk {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.UpdateFromPath()
+	})
+}
+
+// SetToPath sets the "to_path" field.
+func (u *RedirectRuleUpsertBulk) SetToPath(v string) *RedirectRuleUpsertBulk {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.SetToPath(v)
+	})
+}
+
+// UpdateToPath sets the "to_path" field to the value that was provided on create.
+func (u *RedirectRuleUpsertBulk) UpdateToPath() *RedirectRuleUpsertBulk {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.UpdateToPath()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *RedirectRuleUpsertBulk) SetCode(v int) *RedirectRuleUpsertBulk {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// AddCode adds v to the "code" field.
+func (u *RedirectRuleUpsertBulk) AddCode(v int) *RedirectRuleUpsertBulk {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.AddCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *RedirectRuleUpsertBulk) UpdateCode() *RedirectRuleUpsertBulk {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *RedirectRuleUpsertBulk) SetSource(v string) *RedirectRuleUpsertBulk {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *RedirectRuleUpsertBulk) UpdateSource() *RedirectRuleUpsertBulk {
+	return u.Update(func(s *RedirectRuleUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// Exec executes the query.
+func (u *RedirectRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RedirectRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RedirectRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RedirectRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/redirectrule"
+)
+
+// RedirectRuleUpdate is the builder for updating RedirectRule entities.
+type RedirectRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RedirectRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RedirectRuleUpdate builder.
+func (_u *RedirectRuleUpdate) Where(ps ...predicate.RedirectRule) *RedirectRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFromPath sets the "from_path" field.
+func (_u *RedirectRuleUpdate) SetFromPath(v string) *RedirectRuleUpdate {
+	_u.mutation.SetFromPath(v)
+	return _u
+}
+
+// SetNillableFromPath sets the "from_path" field if the given value is not nil.
+func (_u *RedirectRuleUpdate) SetNillableFromPath(v *string) *RedirectRuleUpdate {
+	if v != nil {
+		_u.SetFromPath(*v)
+	}
+	return _u
+}
+
+// SetToPath sets the "to_path" field.
+func (_u *RedirectRuleUpdate) SetToPath(v string) *RedirectRuleUpdate {
+	_u.mutation.SetToPath(v)
+	return _u
+}
+
+// SetNillableToPath sets the "to_path" field if the given value is not nil.
+func (_u *RedirectRuleUpdate) SetNillableToPath(v *string) *RedirectRuleUpdate {
+	if v != nil {
+		_u.SetToPath(*v)
+	}
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *RedirectRuleUpdate) SetCode(v int) *RedirectRuleUpdate {
+	_u.mutation.ResetCode()
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *RedirectRuleUpdate) SetNillableCode(v *int) *RedirectRuleUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// AddCode adds value to the "code" field.
+func (_u *RedirectRuleUpdate) AddCode(v int) *RedirectRuleUpdate {
+	_u.mutation.AddCode(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *RedirectRuleUpdate) SetSource(v string) *RedirectRuleUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *RedirectRuleUpdate) SetNillableSource(v *string) *RedirectRuleUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RedirectRuleMutation object of the builder.
+func (_u *RedirectRuleUpdate) Mutation() *RedirectRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RedirectRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RedirectRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RedirectRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RedirectRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RedirectRuleUpdate) check() error {
+	if v, ok := _u.mutation.FromPath(); ok {
+		if err := redirectrule.FromPathValidator(v); err != nil {
+			return &ValidationError{Name: "from_path", err: fmt.Errorf(`ent: validator failed for field "RedirectRule.from_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToPath(); ok {
+		if err := redirectrule.ToPathValidator(v); err != nil {
+			return &ValidationError{Name: "to_path", err: fmt.Errorf(`ent: validator failed for field "RedirectRule.to_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := redirectrule.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "RedirectRule.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RedirectRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RedirectRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RedirectRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(redirectrule.Table, redirectrule.Columns, sqlgraph.NewFieldSpec(redirectrule.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromPath(); ok {
+		_spec.SetField(redirectrule.FieldFromPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToPath(); ok {
+		_spec.SetField(redirectrule.FieldToPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(redirectrule.FieldCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCode(); ok {
+		_spec.AddField(redirectrule.FieldCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(redirectrule.FieldSource, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{redirectrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RedirectRuleUpdateOne is the builder for updating a single RedirectRule entity.
+type RedirectRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RedirectRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetFromPath sets the "from_path" field.
+func (_u *RedirectRuleUpdateOne) SetFromPath(v string) *RedirectRuleUpdateOne {
+	_u.mutation.SetFromPath(v)
+	return _u
+}
+
+// SetNillableFromPath sets the "from_path" field if the given value is not nil.
+func (_u *RedirectRuleUpdateOne) SetNillableFromPath(v *string) *RedirectRuleUpdateOne {
+	if v != nil {
+		_u.SetFromPath(*v)
+	}
+	return _u
+}
+
+// SetToPath sets the "to_path" field.
+func (_u *RedirectRuleUpdateOne) SetToPath(v string) *RedirectRuleUpdateOne {
+	_u.mutation.SetToPath(v)
+	return _u
+}
+
+// SetNillableToPath sets the "to_path" field if the given value is not nil.
+func (_u *RedirectRuleUpdateOne) SetNillableToPath(v *string) *RedirectRuleUpdateOne {
+	if v != nil {
+		_u.SetToPath(*v)
+	}
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *RedirectRuleUpdateOne) SetCode(v int) *RedirectRuleUpdateOne {
+	_u.mutation.ResetCode()
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *RedirectRuleUpdateOne) SetNillableCode(v *int) *RedirectRuleUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// AddCode adds value to the "code" field.
+func (_u *RedirectRuleUpdateOne) AddCode(v int) *RedirectRuleUpdateOne {
+	_u.mutation.AddCode(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *RedirectRuleUpdateOne) SetSource(v string) *RedirectRuleUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *RedirectRuleUpdateOne) SetNillableSource(v *string) *RedirectRuleUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RedirectRuleMutation object of the builder.
+func (_u *RedirectRuleUpdateOne) Mutation() *RedirectRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RedirectRuleUpdate builder.
+func (_u *RedirectRuleUpdateOne) Where(ps ...predicate.RedirectRule) *RedirectRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RedirectRuleUpdateOne) Select(field string, fields ...string) *RedirectRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RedirectRule entity.
+func (_u *RedirectRuleUpdateOne) Save(ctx context.Context) (*RedirectRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RedirectRuleUpdateOne) SaveX(ctx context.Context) *RedirectRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RedirectRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RedirectRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RedirectRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.FromPath(); ok {
+		if err := redirectrule.FromPathValidator(v); err != nil {
+			return &ValidationError{Name: "from_path", err: fmt.Errorf(`ent: validator failed for field "RedirectRule.from_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToPath(); ok {
+		if err := redirectrule.ToPathValidator(v); err != nil {
+			return &ValidationError{Name: "to_path", err: fmt.Errorf(`ent: validator failed for field "RedirectRule.to_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := redirectrule.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "RedirectRule.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RedirectRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RedirectRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RedirectRuleUpdateOne) sqlSave(ctx context.Context) (_node *RedirectRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(redirectrule.Table, redirectrule.Columns, sqlgraph.NewFieldSpec(redirectrule.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RedirectRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, redirectrule.FieldID)
+		for _, f := range fields {
+			if !redirectrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != redirectrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromPath(); ok {
+		_spec.SetField(redirectrule.FieldFromPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToPath(); ok {
+		_spec.SetField(redirectrule.FieldToPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(redirectrule.FieldCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCode(); ok {
+		_spec.AddField(redirectrule.FieldCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(redirectrule.FieldSource, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RedirectRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{redirectrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
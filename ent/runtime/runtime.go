@@ -9,6 +9,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/albumcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/article"
 	"github.com/anzhiyu-c/anheyu-app/ent/articlehistory"
+	"github.com/anzhiyu-c/anheyu-app/ent/authsession"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/docseries"
@@ -16,13 +17,18 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
 	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/link"
+	"github.com/anzhiyu-c/anheyu-app/ent/linkarchive"
 	"github.com/anzhiyu-c/anheyu-app/ent/linkcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/linktag"
+	"github.com/anzhiyu-c/anheyu-app/ent/logindevice"
 	"github.com/anzhiyu-c/anheyu-app/ent/metadata"
 	"github.com/anzhiyu-c/anheyu-app/ent/notificationtype"
+	"github.com/anzhiyu-c/anheyu-app/ent/oauthlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
+	"github.com/anzhiyu-c/anheyu-app/ent/reactioncount"
+	"github.com/anzhiyu-c/anheyu-app/ent/redirectrule"
 	"github.com/anzhiyu-c/anheyu-app/ent/schema"
 	"github.com/anzhiyu-c/anheyu-app/ent/setting"
 	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
@@ -266,6 +272,54 @@ func init() {
 	articlehistoryDescCreatedAt := articlehistoryFields[15].Descriptor()
 	// articlehistory.DefaultCreatedAt holds the default value on creation for the created_at field.
 	articlehistory.DefaultCreatedAt = articlehistoryDescCreatedAt.Default.(func() time.Time)
+	authsessionFields := schema.AuthSession{}.Fields()
+	_ = authsessionFields
+	// authsessionDescDevice is the schema descriptor for device field.
+	authsessionDescDevice := authsessionFields[2].Descriptor()
+	// authsession.DeviceValidator is a validator for the "device" field. It is called by the builders before save.
+	authsession.DeviceValidator = authsessionDescDevice.Validators[0].(func(string) error)
+	// authsessionDescIP is the schema descriptor for ip field.
+	authsessionDescIP := authsessionFields[3].Descriptor()
+	// authsession.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	authsession.IPValidator = authsessionDescIP.Validators[0].(func(string) error)
+	// authsessionDescRememberMe is the schema descriptor for remember_me field.
+	authsessionDescRememberMe := authsessionFields[4].Descriptor()
+	// authsession.DefaultRememberMe holds the default value on creation for the remember_me field.
+	authsession.DefaultRememberMe = authsessionDescRememberMe.Default.(bool)
+	// authsessionDescTokenHash is the schema descriptor for token_hash field.
+	authsessionDescTokenHash := authsessionFields[5].Descriptor()
+	// authsession.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	authsession.TokenHashValidator = authsessionDescTokenHash.Validators[0].(func(string) error)
+	// authsessionDescPrevTokenHash is the schema descriptor for prev_token_hash field.
+	authsessionDescPrevTokenHash := authsessionFields[6].Descriptor()
+	// authsession.PrevTokenHashValidator is a validator for the "prev_token_hash" field. It is called by the builders before save.
+	authsession.PrevTokenHashValidator = authsessionDescPrevTokenHash.Validators[0].(func(string) error)
+	// authsessionDescCreatedAt is the schema descriptor for created_at field.
+	authsessionDescCreatedAt := authsessionFields[8].Descriptor()
+	// authsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	authsession.DefaultCreatedAt = authsessionDescCreatedAt.Default.(func() time.Time)
+	// authsessionDescLastUsedAt is the schema descriptor for last_used_at field.
+	authsessionDescLastUsedAt := authsessionFields[9].Descriptor()
+	// authsession.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	authsession.DefaultLastUsedAt = authsessionDescLastUsedAt.Default.(func() time.Time)
+	// authsessionDescID is the schema descriptor for id field.
+	authsessionDescID := authsessionFields[0].Descriptor()
+	// authsession.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	authsession.IDValidator = func() func(string) error {
+		validators := authsessionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	commentMixin := schema.Comment{}.Mixin()
 	commentMixinHooks0 := commentMixin[0].Hooks()
 	comment.Hooks[0] = commentMixinHooks0[0]
@@ -522,6 +576,38 @@ func init() {
 	linkDescSkipHealthCheck := linkFields[11].Descriptor()
 	// link.DefaultSkipHealthCheck holds the default value on creation for the skip_health_check field.
 	link.DefaultSkipHealthCheck = linkDescSkipHealthCheck.Default.(bool)
+	linkarchiveFields := schema.LinkArchive{}.Fields()
+	_ = linkarchiveFields
+	// linkarchiveDescURL is the schema descriptor for url field.
+	linkarchiveDescURL := linkarchiveFields[1].Descriptor()
+	// linkarchive.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	linkarchive.URLValidator = func() func(string) error {
+		validators := linkarchiveDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// linkarchiveDescAttempts is the schema descriptor for attempts field.
+	linkarchiveDescAttempts := linkarchiveFields[4].Descriptor()
+	// linkarchive.DefaultAttempts holds the default value on creation for the attempts field.
+	linkarchive.DefaultAttempts = linkarchiveDescAttempts.Default.(int)
+	// linkarchiveDescError is the schema descriptor for error field.
+	linkarchiveDescError := linkarchiveFields[6].Descriptor()
+	// linkarchive.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	linkarchive.ErrorValidator = linkarchiveDescError.Validators[0].(func(string) error)
+	// linkarchiveDescCreatedAt is the schema descriptor for created_at field.
+	linkarchiveDescCreatedAt := linkarchiveFields[7].Descriptor()
+	// linkarchive.DefaultCreatedAt holds the default value on creation for the created_at field.
+	linkarchive.DefaultCreatedAt = linkarchiveDescCreatedAt.Default.(func() time.Time)
 	linkcategoryFields := schema.LinkCategory{}.Fields()
 	_ = linkcategoryFields
 	// linkcategoryDescName is the schema descriptor for name field.
@@ -538,6 +624,28 @@ func init() {
 	linktagDescColor := linktagFields[1].Descriptor()
 	// linktag.DefaultColor holds the default value on creation for the color field.
 	linktag.DefaultColor = linktagDescColor.Default.(string)
+	logindeviceFields := schema.LoginDevice{}.Fields()
+	_ = logindeviceFields
+	// logindeviceDescCreatedAt is the schema descriptor for created_at field.
+	logindeviceDescCreatedAt := logindeviceFields[1].Descriptor()
+	// logindevice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	logindevice.DefaultCreatedAt = logindeviceDescCreatedAt.Default.(func() time.Time)
+	// logindeviceDescFingerprint is the schema descriptor for fingerprint field.
+	logindeviceDescFingerprint := logindeviceFields[3].Descriptor()
+	// logindevice.FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	logindevice.FingerprintValidator = logindeviceDescFingerprint.Validators[0].(func(string) error)
+	// logindeviceDescDevice is the schema descriptor for device field.
+	logindeviceDescDevice := logindeviceFields[4].Descriptor()
+	// logindevice.DeviceValidator is a validator for the "device" field. It is called by the builders before save.
+	logindevice.DeviceValidator = logindeviceDescDevice.Validators[0].(func(string) error)
+	// logindeviceDescLocation is the schema descriptor for location field.
+	logindeviceDescLocation := logindeviceFields[5].Descriptor()
+	// logindevice.LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	logindevice.LocationValidator = logindeviceDescLocation.Validators[0].(func(string) error)
+	// logindeviceDescLastSeen is the schema descriptor for last_seen field.
+	logindeviceDescLastSeen := logindeviceFields[6].Descriptor()
+	// logindevice.DefaultLastSeen holds the default value on creation for the last_seen field.
+	logindevice.DefaultLastSeen = logindeviceDescLastSeen.Default.(func() time.Time)
 	metadataMixin := schema.Metadata{}.Mixin()
 	metadataMixinHooks0 := metadataMixin[0].Hooks()
 	metadata.Hooks[0] = metadataMixinHooks0[0]
@@ -607,6 +715,28 @@ func init() {
 	notificationtypeDescDefaultEnabled := notificationtypeFields[8].Descriptor()
 	// notificationtype.DefaultDefaultEnabled holds the default value on creation for the default_enabled field.
 	notificationtype.DefaultDefaultEnabled = notificationtypeDescDefaultEnabled.Default.(bool)
+	oauthlinkFields := schema.OAuthLink{}.Fields()
+	_ = oauthlinkFields
+	// oauthlinkDescProvider is the schema descriptor for provider field.
+	oauthlinkDescProvider := oauthlinkFields[1].Descriptor()
+	// oauthlink.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	oauthlink.ProviderValidator = oauthlinkDescProvider.Validators[0].(func(string) error)
+	// oauthlinkDescSubject is the schema descriptor for subject field.
+	oauthlinkDescSubject := oauthlinkFields[2].Descriptor()
+	// oauthlink.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	oauthlink.SubjectValidator = oauthlinkDescSubject.Validators[0].(func(string) error)
+	// oauthlinkDescEmail is the schema descriptor for email field.
+	oauthlinkDescEmail := oauthlinkFields[4].Descriptor()
+	// oauthlink.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	oauthlink.EmailValidator = oauthlinkDescEmail.Validators[0].(func(string) error)
+	// oauthlinkDescName is the schema descriptor for name field.
+	oauthlinkDescName := oauthlinkFields[5].Descriptor()
+	// oauthlink.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthlink.NameValidator = oauthlinkDescName.Validators[0].(func(string) error)
+	// oauthlinkDescLinkedAt is the schema descriptor for linked_at field.
+	oauthlinkDescLinkedAt := oauthlinkFields[6].Descriptor()
+	// oauthlink.DefaultLinkedAt holds the default value on creation for the linked_at field.
+	oauthlink.DefaultLinkedAt = oauthlinkDescLinkedAt.Default.(func() time.Time)
 	pageMixin := schema.Page{}.Mixin()
 	pageMixinHooks0 := pageMixin[0].Hooks()
 	page.Hooks[0] = pageMixinHooks0[0]
@@ -669,11 +799,11 @@ func init() {
 	// page.DefaultSort holds the default value on creation for the sort field.
 	page.DefaultSort = pageDescSort.Default.(int)
 	// pageDescCreatedAt is the schema descriptor for created_at field.
-	pageDescCreatedAt := pageFields[9].Descriptor()
+	pageDescCreatedAt := pageFields[10].Descriptor()
 	// page.DefaultCreatedAt holds the default value on creation for the created_at field.
 	page.DefaultCreatedAt = pageDescCreatedAt.Default.(func() time.Time)
 	// pageDescUpdatedAt is the schema descriptor for updated_at field.
-	pageDescUpdatedAt := pageFields[10].Descriptor()
+	pageDescUpdatedAt := pageFields[11].Descriptor()
 	// page.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	page.DefaultUpdatedAt = pageDescUpdatedAt.Default.(func() time.Time)
 	// page.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -738,6 +868,82 @@ func init() {
 	posttag.DefaultCount = posttagDescCount.Default.(int)
 	// posttag.CountValidator is a validator for the "count" field. It is called by the builders before save.
 	posttag.CountValidator = posttagDescCount.Validators[0].(func(int) error)
+	reactioncountFields := schema.ReactionCount{}.Fields()
+	_ = reactioncountFields
+	// reactioncountDescTargetType is the schema descriptor for target_type field.
+	reactioncountDescTargetType := reactioncountFields[1].Descriptor()
+	// reactioncount.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	reactioncount.TargetTypeValidator = reactioncountDescTargetType.Validators[0].(func(string) error)
+	// reactioncountDescTargetID is the schema descriptor for target_id field.
+	reactioncountDescTargetID := reactioncountFields[2].Descriptor()
+	// reactioncount.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	reactioncount.TargetIDValidator = reactioncountDescTargetID.Validators[0].(func(string) error)
+	// reactioncountDescReaction is the schema descriptor for reaction field.
+	reactioncountDescReaction := reactioncountFields[3].Descriptor()
+	// reactioncount.ReactionValidator is a validator for the "reaction" field. It is called by the builders before save.
+	reactioncount.ReactionValidator = reactioncountDescReaction.Validators[0].(func(string) error)
+	// reactioncountDescCount is the schema descriptor for count field.
+	reactioncountDescCount := reactioncountFields[4].Descriptor()
+	// reactioncount.DefaultCount holds the default value on creation for the count field.
+	reactioncount.DefaultCount = reactioncountDescCount.Default.(int64)
+	// reactioncountDescUpdatedAt is the schema descriptor for updated_at field.
+	reactioncountDescUpdatedAt := reactioncountFields[5].Descriptor()
+	// reactioncount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reactioncount.DefaultUpdatedAt = reactioncountDescUpdatedAt.Default.(func() time.Time)
+	// reactioncount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reactioncount.UpdateDefaultUpdatedAt = reactioncountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	redirectruleFields := schema.RedirectRule{}.Fields()
+	_ = redirectruleFields
+	// redirectruleDescFromPath is the schema descriptor for from_path field.
+	redirectruleDescFromPath := redirectruleFields[1].Descriptor()
+	// redirectrule.FromPathValidator is a validator for the "from_path" field. It is called by the builders before save.
+	redirectrule.FromPathValidator = func() func(string) error {
+		validators := redirectruleDescFromPath.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(from_path string) error {
+			for _, fn := range fns {
+				if err := fn(from_path); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// redirectruleDescToPath is the schema descriptor for to_path field.
+	redirectruleDescToPath := redirectruleFields[2].Descriptor()
+	// redirectrule.ToPathValidator is a validator for the "to_path" field. It is called by the builders before save.
+	redirectrule.ToPathValidator = func() func(string) error {
+		validators := redirectruleDescToPath.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(to_path string) error {
+			for _, fn := range fns {
+				if err := fn(to_path); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// redirectruleDescCode is the schema descriptor for code field.
+	redirectruleDescCode := redirectruleFields[3].Descriptor()
+	// redirectrule.DefaultCode holds the default value on creation for the code field.
+	redirectrule.DefaultCode = redirectruleDescCode.Default.(int)
+	// redirectruleDescSource is the schema descriptor for source field.
+	redirectruleDescSource := redirectruleFields[4].Descriptor()
+	// redirectrule.DefaultSource holds the default value on creation for the source field.
+	redirectrule.DefaultSource = redirectruleDescSource.Default.(string)
+	// redirectrule.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	redirectrule.SourceValidator = redirectruleDescSource.Validators[0].(func(string) error)
+	// redirectruleDescCreatedAt is the schema descriptor for created_at field.
+	redirectruleDescCreatedAt := redirectruleFields[5].Descriptor()
+	// redirectrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	redirectrule.DefaultCreatedAt = redirectruleDescCreatedAt.Default.(func() time.Time)
 	settingMixin := schema.Setting{}.Mixin()
 	settingMixinHooks0 := settingMixin[0].Hooks()
 	setting.Hooks[0] = settingMixinHooks0[0]
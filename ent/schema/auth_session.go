@@ -0,0 +1,96 @@
+/*
+ * @Description: 服务端登录会话，保存刷新令牌的哈希和设备信息
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuthSession 登录会话表，一行对应一台设备上的一次登录
+type AuthSession struct {
+	ent.Schema
+}
+
+// Annotations of the AuthSession.
+func (AuthSession) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("登录会话表"),
+	}
+}
+
+// Fields of the AuthSession.
+func (AuthSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(32).
+			NotEmpty().
+			Immutable().
+			Comment("会话ID，刷新令牌的前半部分"),
+		field.Uint("user_id").
+			Comment("用户ID"),
+		field.String("device").
+			MaxLen(100).
+			Optional().
+			Comment("浏览器和操作系统"),
+		field.String("ip").
+			MaxLen(64).
+			Optional().
+			Comment("最近一次使用的IP"),
+		field.Bool("remember_me").
+			Default(false).
+			Comment("是否勾选记住我"),
+		field.String("token_hash").
+			MaxLen(64).
+			Sensitive().
+			Comment("当前刷新令牌密钥的哈希"),
+		field.String("prev_token_hash").
+			MaxLen(64).
+			Optional().
+			Sensitive().
+			Comment("上一个刷新令牌密钥的哈希，用于识别令牌重放"),
+		field.Time("rotated_at").
+			Optional().
+			Nillable().
+			Comment("最近一次轮换刷新令牌的时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("登录时间"),
+		field.Time("last_used_at").
+			Default(time.Now).
+			Comment("最近一次刷新时间"),
+		field.Time("expires_at").
+			Comment("过期时间，每次刷新后顺延"),
+	}
+}
+
+// Edges of the AuthSession.
+func (AuthSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 每个会话属于一个用户
+		edge.From("user", User.Type).
+			Ref("auth_sessions").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the AuthSession.
+func (AuthSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "last_used_at"),
+		index.Fields("expires_at"),
+	}
+}
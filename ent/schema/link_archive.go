@@ -0,0 +1,76 @@
+/*
+ * @Description: 外链存档记录，保存文章中外部链接的存档状态
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LinkArchive 外链存档表，一条记录对应一个外部链接
+type LinkArchive struct {
+	ent.Schema
+}
+
+// Annotations of the LinkArchive.
+func (LinkArchive) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("外链存档表"),
+	}
+}
+
+// Fields of the LinkArchive.
+func (LinkArchive) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.String("url").
+			MaxLen(500).
+			NotEmpty().
+			Unique().
+			Comment("原链接"),
+		field.Text("archived_url").
+			Optional().
+			Comment("存档地址，未存档时为空"),
+		field.Time("archived_at").
+			Optional().
+			Nillable().
+			Comment("存档时间"),
+		field.Int("attempts").
+			Default(0).
+			Comment("已提交次数"),
+		field.Time("last_attempt").
+			Optional().
+			Nillable().
+			Comment("最近一次提交时间"),
+		field.String("error").
+			MaxLen(500).
+			Optional().
+			Comment("最近一次提交失败的原因"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Edges of the LinkArchive.
+func (LinkArchive) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the LinkArchive.
+func (LinkArchive) Indexes() []ent.Index {
+	return []ent.Index{
+		// 查找待提交的链接
+		index.Fields("attempts"),
+	}
+}
@@ -0,0 +1,75 @@
+/*
+ * @Description: 用户登录过的设备，用于新设备登录提醒
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginDevice 已知登录设备表
+type LoginDevice struct {
+	ent.Schema
+}
+
+// Annotations of the LoginDevice.
+func (LoginDevice) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("已知登录设备表"),
+	}
+}
+
+// Fields of the LoginDevice.
+func (LoginDevice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Uint("user_id").
+			Comment("用户ID"),
+		field.String("fingerprint").
+			MaxLen(32).
+			Comment("设备指纹，设备描述和地区的哈希"),
+		field.String("device").
+			MaxLen(100).
+			Comment("浏览器和操作系统"),
+		field.String("location").
+			MaxLen(100).
+			Optional().
+			Comment("登录地区"),
+		field.Time("last_seen").
+			Default(time.Now).
+			Comment("最后一次登录时间"),
+	}
+}
+
+// Edges of the LoginDevice.
+func (LoginDevice) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 每个设备属于一个用户
+		edge.From("user", User.Type).
+			Ref("login_devices").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the LoginDevice.
+func (LoginDevice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "fingerprint").Unique(),
+		index.Fields("user_id", "last_seen"),
+	}
+}
@@ -22,6 +22,13 @@ type SoftDeleteMutator interface {
 	SetDeletedAt(time.Time)
 }
 
+type skipSoftDeleteKey struct{}
+
+// SkipSoftDelete 返回跳过软删除的 context，用于彻底删除已在回收站中的记录.
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, skipSoftDeleteKey{}, true)
+}
+
 // SoftDeleteMixin 实现了软删除的 mixin.
 type SoftDeleteMixin struct {
 	mixin.Schema
@@ -47,6 +54,10 @@ func (SoftDeleteMixin) Hooks() []ent.Hook {
 				if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
 					return next.Mutate(ctx, m)
 				}
+				// 彻底删除时保持原有的删除操作
+				if skip, _ := ctx.Value(skipSoftDeleteKey{}).(bool); skip {
+					return next.Mutate(ctx, m)
+				}
 				// 将 mutation 类型断言为定义的接口
 				mx, ok := m.(SoftDeleteMutator)
 				if !ok {
@@ -0,0 +1,79 @@
+/*
+ * @Description: 第三方账号与本站账号的绑定关系
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OAuthLink 第三方账号绑定表
+type OAuthLink struct {
+	ent.Schema
+}
+
+// Annotations of the OAuthLink.
+func (OAuthLink) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("第三方账号绑定表"),
+	}
+}
+
+// Fields of the OAuthLink.
+func (OAuthLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.String("provider").
+			MaxLen(64).
+			Comment("提供方ID，对应 oauth.providers 配置中的 id"),
+		field.String("subject").
+			MaxLen(255).
+			Comment("第三方账号的唯一标识"),
+		field.Uint("user_id").
+			Comment("本站用户ID"),
+		field.String("email").
+			MaxLen(255).
+			Optional().
+			Comment("第三方账号的邮箱"),
+		field.String("name").
+			MaxLen(255).
+			Optional().
+			Comment("第三方账号的名称"),
+		field.Time("linked_at").
+			Default(time.Now).
+			Comment("绑定时间"),
+	}
+}
+
+// Edges of the OAuthLink.
+func (OAuthLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 每个绑定属于一个用户
+		edge.From("user", User.Type).
+			Ref("oauth_links").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the OAuthLink.
+func (OAuthLink) Indexes() []ent.Index {
+	return []ent.Index{
+		// 一个第三方账号只能绑定一个本站账号
+		index.Fields("provider", "subject").Unique(),
+		// 同一提供方下每个本站账号只保留一个绑定
+		index.Fields("provider", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
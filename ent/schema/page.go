@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/ent/schema/mixin"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
@@ -76,6 +77,10 @@ func (Page) Fields() []ent.Field {
 			Default(0).
 			Comment("排序"),
 
+		field.JSON("data_bindings", []model.PageDataBinding{}).
+			Optional().
+			Comment("服务端数据绑定"),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
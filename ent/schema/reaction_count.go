@@ -0,0 +1,60 @@
+/*
+ * @Description: 文章与即刻的表态计数表
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReactionCount 表态计数表，每个目标的每种表态一行
+type ReactionCount struct {
+	ent.Schema
+}
+
+// Annotations of the ReactionCount.
+func (ReactionCount) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("表态计数表"),
+	}
+}
+
+// Fields of the ReactionCount.
+func (ReactionCount) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.String("target_type").
+			MaxLen(32).
+			Comment("目标类型：article 或 essay"),
+		field.String("target_id").
+			MaxLen(64).
+			Comment("目标ID，文章为公共ID"),
+		field.String("reaction").
+			MaxLen(64).
+			Comment("表态"),
+		field.Int64("count").
+			Default(0).
+			Comment("表态数量"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+	}
+}
+
+// Indexes of the ReactionCount.
+func (ReactionCount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_type", "target_id", "reaction").Unique(),
+		index.Fields("count"),
+	}
+}
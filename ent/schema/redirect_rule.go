@@ -0,0 +1,65 @@
+/*
+ * @Description: 重定向规则，保存旧地址到新地址的重定向
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// RedirectRule 重定向规则表，一条规则对应一个来源地址
+type RedirectRule struct {
+	ent.Schema
+}
+
+// Annotations of the RedirectRule.
+func (RedirectRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("重定向规则表"),
+	}
+}
+
+// Fields of the RedirectRule.
+func (RedirectRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.String("from_path").
+			MaxLen(512).
+			NotEmpty().
+			Unique().
+			Comment("来源地址，站内路径"),
+		field.String("to_path").
+			MaxLen(512).
+			NotEmpty().
+			Comment("目标地址，站内路径"),
+		field.Int("code").
+			Default(301).
+			Comment("状态码：301、302、307 或 308"),
+		field.String("source").
+			MaxLen(20).
+			Default("manual").
+			Comment("来源：manual 管理员添加，permalink 文章永久链接变化时自动添加"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Edges of the RedirectRule.
+func (RedirectRule) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the RedirectRule.
+func (RedirectRule) Indexes() []ent.Index {
+	return nil
+}
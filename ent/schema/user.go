@@ -104,5 +104,14 @@ func (User) Edges() []ent.Edge {
 
 		// 定义一个用户有多个通知配置的关系
 		edge.To("notification_configs", UserNotificationConfig.Type),
+
+		// 定义一个用户有多个已知登录设备的关系
+		edge.To("login_devices", LoginDevice.Type),
+
+		// 定义一个用户可以绑定多个第三方账号的关系
+		edge.To("oauth_links", OAuthLink.Type),
+
+		// 定义一个用户有多个登录会话的关系
+		edge.To("auth_sessions", AuthSession.Type),
 	}
 }
@@ -20,6 +20,8 @@ type Tx struct {
 	Article *ArticleClient
 	// ArticleHistory is the client for interacting with the ArticleHistory builders.
 	ArticleHistory *ArticleHistoryClient
+	// AuthSession is the client for interacting with the AuthSession builders.
+	AuthSession *AuthSessionClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
 	// DirectLink is the client for interacting with the DirectLink builders.
@@ -34,20 +36,30 @@ type Tx struct {
 	FileEntity *FileEntityClient
 	// Link is the client for interacting with the Link builders.
 	Link *LinkClient
+	// LinkArchive is the client for interacting with the LinkArchive builders.
+	LinkArchive *LinkArchiveClient
 	// LinkCategory is the client for interacting with the LinkCategory builders.
 	LinkCategory *LinkCategoryClient
 	// LinkTag is the client for interacting with the LinkTag builders.
 	LinkTag *LinkTagClient
+	// LoginDevice is the client for interacting with the LoginDevice builders.
+	LoginDevice *LoginDeviceClient
 	// Metadata is the client for interacting with the Metadata builders.
 	Metadata *MetadataClient
 	// NotificationType is the client for interacting with the NotificationType builders.
 	NotificationType *NotificationTypeClient
+	// OAuthLink is the client for interacting with the OAuthLink builders.
+	OAuthLink *OAuthLinkClient
 	// Page is the client for interacting with the Page builders.
 	Page *PageClient
 	// PostCategory is the client for interacting with the PostCategory builders.
 	PostCategory *PostCategoryClient
 	// PostTag is the client for interacting with the PostTag builders.
 	PostTag *PostTagClient
+	// ReactionCount is the client for interacting with the ReactionCount builders.
+	ReactionCount *ReactionCountClient
+	// RedirectRule is the client for interacting with the RedirectRule builders.
+	RedirectRule *RedirectRuleClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
 	// StoragePolicy is the client for interacting with the StoragePolicy builders.
@@ -205,6 +217,7 @@ func (tx *Tx) init() {
 	tx.AlbumCategory = NewAlbumCategoryClient(tx.config)
 	tx.Article = NewArticleClient(tx.config)
 	tx.ArticleHistory = NewArticleHistoryClient(tx.config)
+	tx.AuthSession = NewAuthSessionClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
 	tx.DirectLink = NewDirectLinkClient(tx.config)
 	tx.DocSeries = NewDocSeriesClient(tx.config)
@@ -212,13 +225,18 @@ func (tx *Tx) init() {
 	tx.File = NewFileClient(tx.config)
 	tx.FileEntity = NewFileEntityClient(tx.config)
 	tx.Link = NewLinkClient(tx.config)
+	tx.LinkArchive = NewLinkArchiveClient(tx.config)
 	tx.LinkCategory = NewLinkCategoryClient(tx.config)
 	tx.LinkTag = NewLinkTagClient(tx.config)
+	tx.LoginDevice = NewLoginDeviceClient(tx.config)
 	tx.Metadata = NewMetadataClient(tx.config)
 	tx.NotificationType = NewNotificationTypeClient(tx.config)
+	tx.OAuthLink = NewOAuthLinkClient(tx.config)
 	tx.Page = NewPageClient(tx.config)
 	tx.PostCategory = NewPostCategoryClient(tx.config)
 	tx.PostTag = NewPostTagClient(tx.config)
+	tx.ReactionCount = NewReactionCountClient(tx.config)
+	tx.RedirectRule = NewRedirectRuleClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
 	tx.StoragePolicy = NewStoragePolicyClient(tx.config)
 	tx.Subscriber = NewSubscriberClient(tx.config)
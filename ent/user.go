@@ -59,9 +59,15 @@ type UserEdges struct {
 	InstalledThemes []*UserInstalledTheme `json:"installed_themes,omitempty"`
 	// NotificationConfigs holds the value of the notification_configs edge.
 	NotificationConfigs []*UserNotificationConfig `json:"notification_configs,omitempty"`
+	// LoginDevices holds the value of the login_devices edge.
+	LoginDevices []*LoginDevice `json:"login_devices,omitempty"`
+	// OauthLinks holds the value of the oauth_links edge.
+	OauthLinks []*OAuthLink `json:"oauth_links,omitempty"`
+	// AuthSessions holds the value of the auth_sessions edge.
+	AuthSessions []*AuthSession `json:"auth_sessions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [8]bool
 }
 
 // UserGroupOrErr returns the UserGroup value or an error if the edge
@@ -111,6 +117,33 @@ func (e UserEdges) NotificationConfigsOrErr() ([]*UserNotificationConfig, error)
 	return nil, &NotLoadedError{edge: "notification_configs"}
 }
 
+// LoginDevicesOrErr returns the LoginDevices value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LoginDevicesOrErr() ([]*LoginDevice, error) {
+	if e.loadedTypes[5] {
+		return e.LoginDevices, nil
+	}
+	return nil, &NotLoadedError{edge: "login_devices"}
+}
+
+// OauthLinksOrErr returns the OauthLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OauthLinksOrErr() ([]*OAuthLink, error) {
+	if e.loadedTypes[6] {
+		return e.OauthLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_links"}
+}
+
+// AuthSessionsOrErr returns the AuthSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AuthSessionsOrErr() ([]*AuthSession, error) {
+	if e.loadedTypes[7] {
+		return e.AuthSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "auth_sessions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -258,6 +291,21 @@ func (_m *User) QueryNotificationConfigs() *UserNotificationConfigQuery {
 	return NewUserClient(_m.config).QueryNotificationConfigs(_m)
 }
 
+// QueryLoginDevices queries the "login_devices" edge of the User entity.
+func (_m *User) QueryLoginDevices() *LoginDeviceQuery {
+	return NewUserClient(_m.config).QueryLoginDevices(_m)
+}
+
+// QueryOauthLinks queries the "oauth_links" edge of the User entity.
+func (_m *User) QueryOauthLinks() *OAuthLinkQuery {
+	return NewUserClient(_m.config).QueryOauthLinks(_m)
+}
+
+// QueryAuthSessions queries the "auth_sessions" edge of the User entity.
+func (_m *User) QueryAuthSessions() *AuthSessionQuery {
+	return NewUserClient(_m.config).QueryAuthSessions(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -47,6 +47,12 @@ const (
 	EdgeInstalledThemes = "installed_themes"
 	// EdgeNotificationConfigs holds the string denoting the notification_configs edge name in mutations.
 	EdgeNotificationConfigs = "notification_configs"
+	// EdgeLoginDevices holds the string denoting the login_devices edge name in mutations.
+	EdgeLoginDevices = "login_devices"
+	// EdgeOauthLinks holds the string denoting the oauth_links edge name in mutations.
+	EdgeOauthLinks = "oauth_links"
+	// EdgeAuthSessions holds the string denoting the auth_sessions edge name in mutations.
+	EdgeAuthSessions = "auth_sessions"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// UserGroupTable is the table that holds the user_group relation/edge.
@@ -84,6 +90,27 @@ const (
 	NotificationConfigsInverseTable = "user_notification_configs"
 	// NotificationConfigsColumn is the table column denoting the notification_configs relation/edge.
 	NotificationConfigsColumn = "user_id"
+	// LoginDevicesTable is the table that holds the login_devices relation/edge.
+	LoginDevicesTable = "login_devices"
+	// LoginDevicesInverseTable is the table name for the LoginDevice entity.
+	// It exists in this package in order to avoid circular dependency with the "logindevice" package.
+	LoginDevicesInverseTable = "login_devices"
+	// LoginDevicesColumn is the table column denoting the login_devices relation/edge.
+	LoginDevicesColumn = "user_id"
+	// OauthLinksTable is the table that holds the oauth_links relation/edge.
+	OauthLinksTable = "oauth_links"
+	// OauthLinksInverseTable is the table name for the OAuthLink entity.
+	// It exists in this package in order to avoid circular dependency with the "oauthlink" package.
+	OauthLinksInverseTable = "oauth_links"
+	// OauthLinksColumn is the table column denoting the oauth_links relation/edge.
+	OauthLinksColumn = "user_id"
+	// AuthSessionsTable is the table that holds the auth_sessions relation/edge.
+	AuthSessionsTable = "auth_sessions"
+	// AuthSessionsInverseTable is the table name for the AuthSession entity.
+	// It exists in this package in order to avoid circular dependency with the "authsession" package.
+	AuthSessionsInverseTable = "auth_sessions"
+	// AuthSessionsColumn is the table column denoting the auth_sessions relation/edge.
+	AuthSessionsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -277,6 +304,48 @@ func ByNotificationConfigs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOpti
 		sqlgraph.OrderByNeighborTerms(s, newNotificationConfigsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByLoginDevicesCount orders the results by login_devices count.
+func ByLoginDevicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLoginDevicesStep(), opts...)
+	}
+}
+
+// ByLoginDevices orders the results by login_devices terms.
+func ByLoginDevices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoginDevicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOauthLinksCount orders the results by oauth_links count.
+func ByOauthLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOauthLinksStep(), opts...)
+	}
+}
+
+// ByOauthLinks orders the results by oauth_links terms.
+func ByOauthLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOauthLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAuthSessionsCount orders the results by auth_sessions count.
+func ByAuthSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuthSessionsStep(), opts...)
+	}
+}
+
+// ByAuthSessions orders the results by auth_sessions terms.
+func ByAuthSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuthSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserGroupStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -312,3 +381,24 @@ func newNotificationConfigsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, NotificationConfigsTable, NotificationConfigsColumn),
 	)
 }
+func newLoginDevicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoginDevicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LoginDevicesTable, LoginDevicesColumn),
+	)
+}
+func newOauthLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OauthLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OauthLinksTable, OauthLinksColumn),
+	)
+}
+func newAuthSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuthSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuthSessionsTable, AuthSessionsColumn),
+	)
+}
@@ -12,8 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/authsession"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
+	"github.com/anzhiyu-c/anheyu-app/ent/logindevice"
+	"github.com/anzhiyu-c/anheyu-app/ent/oauthlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
@@ -33,6 +36,9 @@ type UserQuery struct {
 	withComments            *CommentQuery
 	withInstalledThemes     *UserInstalledThemeQuery
 	withNotificationConfigs *UserNotificationConfigQuery
+	withLoginDevices        *LoginDeviceQuery
+	withOauthLinks          *OAuthLinkQuery
+	withAuthSessions        *AuthSessionQuery
 	withFKs                 bool
 	modifiers               []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -181,6 +187,72 @@ func (_q *UserQuery) QueryNotificationConfigs() *UserNotificationConfigQuery {
 	return query
 }
 
+// QueryLoginDevices chains the current query on the "login_devices" edge.
+func (_q *UserQuery) QueryLoginDevices() *LoginDeviceQuery {
+	query := (&LoginDeviceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(logindevice.Table, logindevice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LoginDevicesTable, user.LoginDevicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOauthLinks chains the current query on the "oauth_links" edge.
+func (_q *UserQuery) QueryOauthLinks() *OAuthLinkQuery {
+	query := (&OAuthLinkClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(oauthlink.Table, oauthlink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OauthLinksTable, user.OauthLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuthSessions chains the current query on the "auth_sessions" edge.
+func (_q *UserQuery) QueryAuthSessions() *AuthSessionQuery {
+	query := (&AuthSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(authsession.Table, authsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuthSessionsTable, user.AuthSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -378,6 +450,9 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withComments:            _q.withComments.Clone(),
 		withInstalledThemes:     _q.withInstalledThemes.Clone(),
 		withNotificationConfigs: _q.withNotificationConfigs.Clone(),
+		withLoginDevices:        _q.withLoginDevices.Clone(),
+		withOauthLinks:          _q.withOauthLinks.Clone(),
+		withAuthSessions:        _q.withAuthSessions.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -440,6 +515,39 @@ func (_q *UserQuery) WithNotificationConfigs(opts ...func(*UserNotificationConfi
 	return _q
 }
 
+// WithLoginDevices tells the query-builder to eager-load the nodes that are connected to
+// the "login_devices" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLoginDevices(opts ...func(*LoginDeviceQuery)) *UserQuery {
+	query := (&LoginDeviceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLoginDevices = query
+	return _q
+}
+
+// WithOauthLinks tells the query-builder to eager-load the nodes that are connected to
+// the "oauth_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithOauthLinks(opts ...func(*OAuthLinkQuery)) *UserQuery {
+	query := (&OAuthLinkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOauthLinks = query
+	return _q
+}
+
+// WithAuthSessions tells the query-builder to eager-load the nodes that are connected to
+// the "auth_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithAuthSessions(opts ...func(*AuthSessionQuery)) *UserQuery {
+	query := (&AuthSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAuthSessions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -519,12 +627,15 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		nodes       = []*User{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [8]bool{
 			_q.withUserGroup != nil,
 			_q.withFiles != nil,
 			_q.withComments != nil,
 			_q.withInstalledThemes != nil,
 			_q.withNotificationConfigs != nil,
+			_q.withLoginDevices != nil,
+			_q.withOauthLinks != nil,
+			_q.withAuthSessions != nil,
 		}
 	)
 	if _q.withUserGroup != nil {
@@ -590,6 +701,27 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withLoginDevices; query != nil {
+		if err := _q.loadLoginDevices(ctx, query, nodes,
+			func(n *User) { n.Edges.LoginDevices = []*LoginDevice{} },
+			func(n *User, e *LoginDevice) { n.Edges.LoginDevices = append(n.Edges.LoginDevices, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOauthLinks; query != nil {
+		if err := _q.loadOauthLinks(ctx, query, nodes,
+			func(n *User) { n.Edges.OauthLinks = []*OAuthLink{} },
+			func(n *User, e *OAuthLink) { n.Edges.OauthLinks = append(n.Edges.OauthLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAuthSessions; query != nil {
+		if err := _q.loadAuthSessions(ctx, query, nodes,
+			func(n *User) { n.Edges.AuthSessions = []*AuthSession{} },
+			func(n *User, e *AuthSession) { n.Edges.AuthSessions = append(n.Edges.AuthSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -749,6 +881,96 @@ func (_q *UserQuery) loadNotificationConfigs(ctx context.Context, query *UserNot
 	}
 	return nil
 }
+func (_q *UserQuery) loadLoginDevices(ctx context.Context, query *LoginDeviceQuery, nodes []*User, init func(*User), assign func(*User, *LoginDevice)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(logindevice.FieldUserID)
+	}
+	query.Where(predicate.LoginDevice(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LoginDevicesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadOauthLinks(ctx context.Context, query *OAuthLinkQuery, nodes []*User, init func(*User), assign func(*User, *OAuthLink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(oauthlink.FieldUserID)
+	}
+	query.Where(predicate.OAuthLink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.OauthLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadAuthSessions(ctx context.Context, query *AuthSessionQuery, nodes []*User, init func(*User), assign func(*User, *AuthSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(authsession.FieldUserID)
+	}
+	query.Where(predicate.AuthSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AuthSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
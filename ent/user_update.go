@@ -11,8 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/authsession"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
+	"github.com/anzhiyu-c/anheyu-app/ent/logindevice"
+	"github.com/anzhiyu-c/anheyu-app/ent/oauthlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
@@ -280,6 +283,51 @@ func (_u *UserUpdate) AddNotificationConfigs(v ...*UserNotificationConfig) *User
 	return _u.AddNotificationConfigIDs(ids...)
 }
 
+// AddLoginDeviceIDs adds the "login_devices" edge to the LoginDevice entity by IDs.
+func (_u *UserUpdate) AddLoginDeviceIDs(ids ...uint) *UserUpdate {
+	_u.mutation.AddLoginDeviceIDs(ids...)
+	return _u
+}
+
+// AddLoginDevices adds the "login_devices" edges to the LoginDevice entity.
+func (_u *UserUpdate) AddLoginDevices(v ...*LoginDevice) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLoginDeviceIDs(ids...)
+}
+
+// AddOauthLinkIDs adds the "oauth_links" edge to the OAuthLink entity by IDs.
+func (_u *UserUpdate) AddOauthLinkIDs(ids ...uint) *UserUpdate {
+	_u.mutation.AddOauthLinkIDs(ids...)
+	return _u
+}
+
+// AddOauthLinks adds the "oauth_links" edges to the OAuthLink entity.
+func (_u *UserUpdate) AddOauthLinks(v ...*OAuthLink) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthLinkIDs(ids...)
+}
+
+// AddAuthSessionIDs adds the "auth_sessions" edge to the AuthSession entity by IDs.
+func (_u *UserUpdate) AddAuthSessionIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddAuthSessionIDs(ids...)
+	return _u
+}
+
+// AddAuthSessions adds the "auth_sessions" edges to the AuthSession entity.
+func (_u *UserUpdate) AddAuthSessions(v ...*AuthSession) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthSessionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -375,6 +423,69 @@ func (_u *UserUpdate) RemoveNotificationConfigs(v ...*UserNotificationConfig) *U
 	return _u.RemoveNotificationConfigIDs(ids...)
 }
 
+// ClearLoginDevices clears all "login_devices" edges to the LoginDevice entity.
+func (_u *UserUpdate) ClearLoginDevices() *UserUpdate {
+	_u.mutation.ClearLoginDevices()
+	return _u
+}
+
+// RemoveLoginDeviceIDs removes the "login_devices" edge to LoginDevice entities by IDs.
+func (_u *UserUpdate) RemoveLoginDeviceIDs(ids ...uint) *UserUpdate {
+	_u.mutation.RemoveLoginDeviceIDs(ids...)
+	return _u
+}
+
+// RemoveLoginDevices removes "login_devices" edges to LoginDevice entities.
+func (_u *UserUpdate) RemoveLoginDevices(v ...*LoginDevice) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLoginDeviceIDs(ids...)
+}
+
+// ClearOauthLinks clears all "oauth_links" edges to the OAuthLink entity.
+func (_u *UserUpdate) ClearOauthLinks() *UserUpdate {
+	_u.mutation.ClearOauthLinks()
+	return _u
+}
+
+// RemoveOauthLinkIDs removes the "oauth_links" edge to OAuthLink entities by IDs.
+func (_u *UserUpdate) RemoveOauthLinkIDs(ids ...uint) *UserUpdate {
+	_u.mutation.RemoveOauthLinkIDs(ids...)
+	return _u
+}
+
+// RemoveOauthLinks removes "oauth_links" edges to OAuthLink entities.
+func (_u *UserUpdate) RemoveOauthLinks(v ...*OAuthLink) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOauthLinkIDs(ids...)
+}
+
+// ClearAuthSessions clears all "auth_sessions" edges to the AuthSession entity.
+func (_u *UserUpdate) ClearAuthSessions() *UserUpdate {
+	_u.mutation.ClearAuthSessions()
+	return _u
+}
+
+// RemoveAuthSessionIDs removes the "auth_sessions" edge to AuthSession entities by IDs.
+func (_u *UserUpdate) RemoveAuthSessionIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveAuthSessionIDs(ids...)
+	return _u
+}
+
+// RemoveAuthSessions removes "auth_sessions" edges to AuthSession entities.
+func (_u *UserUpdate) RemoveAuthSessions(v ...*AuthSession) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuthSessionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	if err := _u.defaults(); err != nil {
@@ -733,6 +844,141 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LoginDevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginDevicesTable,
+			Columns: []string{user.LoginDevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(logindevice.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLoginDevicesIDs(); len(nodes) > 0 && !_u.mutation.LoginDevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginDevicesTable,
+			Columns: []string{user.LoginDevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(logindevice.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LoginDevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginDevicesTable,
+			Columns: []string{user.LoginDevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(logindevice.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OauthLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthLinksTable,
+			Columns: []string{user.OauthLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthlink.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthLinksIDs(); len(nodes) > 0 && !_u.mutation.OauthLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthLinksTable,
+			Columns: []string{user.OauthLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthlink.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthLinksTable,
+			Columns: []string{user.OauthLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthlink.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuthSessionsIDs(); len(nodes) > 0 && !_u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1001,6 +1247,51 @@ func (_u *UserUpdateOne) AddNotificationConfigs(v ...*UserNotificationConfig) *U
 	return _u.AddNotificationConfigIDs(ids...)
 }
 
+// AddLoginDeviceIDs adds the "login_devices" edge to the LoginDevice entity by IDs.
+func (_u *UserUpdateOne) AddLoginDeviceIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.AddLoginDeviceIDs(ids...)
+	return _u
+}
+
+// AddLoginDevices adds the "login_devices" edges to the LoginDevice entity.
+func (_u *UserUpdateOne) AddLoginDevices(v ...*LoginDevice) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLoginDeviceIDs(ids...)
+}
+
+// AddOauthLinkIDs adds the "oauth_links" edge to the OAuthLink entity by IDs.
+func (_u *UserUpdateOne) AddOauthLinkIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.AddOauthLinkIDs(ids...)
+	return _u
+}
+
+// AddOauthLinks adds the "oauth_links" edges to the OAuthLink entity.
+func (_u *UserUpdateOne) AddOauthLinks(v ...*OAuthLink) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthLinkIDs(ids...)
+}
+
+// AddAuthSessionIDs adds the "auth_sessions" edge to the AuthSession entity by IDs.
+func (_u *UserUpdateOne) AddAuthSessionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddAuthSessionIDs(ids...)
+	return _u
+}
+
+// AddAuthSessions adds the "auth_sessions" edges to the AuthSession entity.
+func (_u *UserUpdateOne) AddAuthSessions(v ...*AuthSession) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthSessionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -1096,6 +1387,69 @@ func (_u *UserUpdateOne) RemoveNotificationConfigs(v ...*UserNotificationConfig)
 	return _u.RemoveNotificationConfigIDs(ids...)
 }
 
+// ClearLoginDevices clears all "login_devices" edges to the LoginDevice entity.
+func (_u *UserUpdateOne) ClearLoginDevices() *UserUpdateOne {
+	_u.mutation.ClearLoginDevices()
+	return _u
+}
+
+// RemoveLoginDeviceIDs removes the "login_devices" edge to LoginDevice entities by IDs.
+func (_u *UserUpdateOne) RemoveLoginDeviceIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.RemoveLoginDeviceIDs(ids...)
+	return _u
+}
+
+// RemoveLoginDevices removes "login_devices" edges to LoginDevice entities.
+func (_u *UserUpdateOne) RemoveLoginDevices(v ...*LoginDevice) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLoginDeviceIDs(ids...)
+}
+
+// ClearOauthLinks clears all "oauth_links" edges to the OAuthLink entity.
+func (_u *UserUpdateOne) ClearOauthLinks() *UserUpdateOne {
+	_u.mutation.ClearOauthLinks()
+	return _u
+}
+
+// RemoveOauthLinkIDs removes the "oauth_links" edge to OAuthLink entities by IDs.
+func (_u *UserUpdateOne) RemoveOauthLinkIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.RemoveOauthLinkIDs(ids...)
+	return _u
+}
+
+// RemoveOauthLinks removes "oauth_links" edges to OAuthLink entities.
+func (_u *UserUpdateOne) RemoveOauthLinks(v ...*OAuthLink) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOauthLinkIDs(ids...)
+}
+
+// ClearAuthSessions clears all "auth_sessions" edges to the AuthSession entity.
+func (_u *UserUpdateOne) ClearAuthSessions() *UserUpdateOne {
+	_u.mutation.ClearAuthSessions()
+	return _u
+}
+
+// RemoveAuthSessionIDs removes the "auth_sessions" edge to AuthSession entities by IDs.
+func (_u *UserUpdateOne) RemoveAuthSessionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveAuthSessionIDs(ids...)
+	return _u
+}
+
+// RemoveAuthSessions removes "auth_sessions" edges to AuthSession entities.
+func (_u *UserUpdateOne) RemoveAuthSessions(v ...*AuthSession) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuthSessionIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1484,6 +1838,141 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LoginDevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginDevicesTable,
+			Columns: []string{user.LoginDevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(logindevice.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLoginDevicesIDs(); len(nodes) > 0 && !_u.mutation.LoginDevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginDevicesTable,
+			Columns: []string{user.LoginDevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(logindevice.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LoginDevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginDevicesTable,
+			Columns: []string{user.LoginDevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(logindevice.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OauthLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthLinksTable,
+			Columns: []string{user.OauthLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthlink.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthLinksIDs(); len(nodes) > 0 && !_u.mutation.OauthLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthLinksTable,
+			Columns: []string{user.OauthLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthlink.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthLinksTable,
+			Columns: []string{user.OauthLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthlink.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuthSessionsIDs(); len(nodes) > 0 && !_u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -75,6 +75,9 @@ func (b *Bootstrapper) syncSettings() {
 			if def.Key == constant.KeyLocalFileSigningSecret {
 				value, _ = utils.GenerateRandomString(32)
 			}
+			if def.Key == constant.KeyOutboundInstanceID {
+				value, _ = utils.GenerateRandomString(16)
+			}
 
 			// 检查环境变量覆盖
 			envKey := "AN_SETTING_DEFAULT_" + strings.ToUpper(string(def.Key))
@@ -55,9 +55,7 @@ func (l *CacheRevalidateListener) RegisterHandlers(bus *event.EventBus) {
 }
 
 // ArticlePayload 文章事件载荷
-type ArticlePayload struct {
-	Slug string
-}
+type ArticlePayload = event.ArticlePayload
 
 // onArticleChange 文章变更时清理缓存
 func (l *CacheRevalidateListener) onArticleChange(payload interface{}) {
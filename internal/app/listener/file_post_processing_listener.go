@@ -13,6 +13,7 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/internal/app/task"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/perfprofile"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/file_info"
 )
 
@@ -63,6 +64,11 @@ func (l *FilePostProcessingListener) handleFileCreated(payload interface{}) {
 
 	// 任务2：将计算密集型的缩略图生成任务派发到后台任务队列 (Broker)
 	// Broker 内部会处理任务的分发和执行。
+	// 低配模式下不预先生成，首次请求缩略图时再生成。
+	if !perfprofile.Current().EagerThumbnails {
+		log.Printf("[FilePostProcessingListener] -> 低配模式，FileID %d 的缩略图将在首次访问时生成。", fileID)
+		return
+	}
 	log.Printf("[FilePostProcessingListener] -> 正在为 FileID %d 派发缩略图生成任务...", fileID)
 	l.broker.DispatchThumbnailGeneration(fileID)
 }
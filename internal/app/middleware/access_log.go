@@ -0,0 +1,94 @@
+/*
+ * @Description: 访问日志与慢请求追踪中间件
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package middleware
+
+import (
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/accesslog"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultSlowRequestThreshold 未配置或配置非法时使用的慢请求阈值
+const defaultSlowRequestThreshold = time.Second
+
+// AccessLog 记录结构化访问日志，并为超过阈值的慢请求附带数据库查询次数和外部调用详情
+// 阈值和开关每次请求时从配置读取，后台修改后立即生效
+func AccessLog(settingSvc setting.SettingService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if settingSvc.Get(constant.KeyAccessLogEnable.String()) == "false" {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		ctx, trace := accesslog.WithTrace(c.Request.Context())
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		latency := time.Since(start)
+		threshold := defaultSlowRequestThreshold
+		if ms, err := strconv.Atoi(settingSvc.Get(constant.KeyAccessLogSlowThresholdMs.String())); err == nil && ms > 0 {
+			threshold = time.Duration(ms) * time.Millisecond
+		}
+
+		entry := accesslog.Entry{
+			Time:      start,
+			Method:    c.Request.Method,
+			Path:      c.Request.URL.Path,
+			Status:    c.Writer.Status(),
+			LatencyMs: latency.Milliseconds(),
+			Upstream:  requestUpstream(c),
+			UserID:    accessLogUserID(c),
+			ClientIP:  c.ClientIP(),
+			Slow:      latency >= threshold,
+		}
+		if entry.Slow {
+			entry.DBQueries = trace.DBQueries()
+			entry.ExternalCalls = trace.ExternalCalls()
+			log.Printf("[慢请求] %s %s -> %d, 耗时 %dms, upstream=%s, 数据库查询 %d 次, 外部调用 %d 次",
+				entry.Method, entry.Path, entry.Status, entry.LatencyMs, entry.Upstream, entry.DBQueries, len(entry.ExternalCalls))
+		}
+		accesslog.DefaultStore().Add(entry)
+	}
+}
+
+// requestUpstream 判断请求由哪一类上游处理
+func requestUpstream(c *gin.Context) string {
+	if upstream := c.GetString(accesslog.UpstreamContextKey); upstream != "" {
+		return upstream
+	}
+	if strings.HasPrefix(c.Request.URL.Path, "/api/") {
+		return accesslog.UpstreamAPI
+	}
+	return accesslog.UpstreamStatic
+}
+
+// accessLogUserID 从 JWT 中间件设置的认证信息中解析用户 ID，未登录时返回 0
+func accessLogUserID(c *gin.Context) uint {
+	claimsValue, exists := c.Get(auth.ClaimsKey)
+	if !exists {
+		return 0
+	}
+	claims, ok := claimsValue.(*auth.CustomClaims)
+	if !ok {
+		return 0
+	}
+	userID, entityType, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		return 0
+	}
+	return userID
+}
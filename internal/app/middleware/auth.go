@@ -10,6 +10,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	service_auth "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 
 	"github.com/gin-gonic/gin"
 )
@@ -23,11 +24,12 @@ func min(a, b int) int {
 }
 
 type Middleware struct {
-	tokenSvc service_auth.TokenService
+	tokenSvc   service_auth.TokenService
+	settingSvc setting.SettingService
 }
 
-func NewMiddleware(tokenSvc service_auth.TokenService) *Middleware {
-	return &Middleware{tokenSvc: tokenSvc}
+func NewMiddleware(tokenSvc service_auth.TokenService, settingSvc setting.SettingService) *Middleware {
+	return &Middleware{tokenSvc: tokenSvc, settingSvc: settingSvc}
 }
 
 // JWTAuth 是一个强制性的JWT认证中间件
@@ -0,0 +1,27 @@
+/*
+ * @Description: 演示模式中间件，拒绝修改数据的请求
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+)
+
+// demoAllowedWrites 演示模式下仍允许的写请求：登录、退出登录、只读查询，以及浏览量、访问统计等会随定时重置清除的计数
+var demoAllowedWrites = []*regexp.Regexp{
+	regexp.MustCompile(`^/api/auth/(login|refresh-token|logout|oauth/exchange)$`),
+	regexp.MustCompile(`^/api/settings/get-by-keys$`),
+	regexp.MustCompile(`^/api/public/articles/[^/]+/view$`),
+	regexp.MustCompile(`^/api/public/statistics/visit$`),
+	regexp.MustCompile(`^/api/public/stat/[^/]+$`),
+}
+
+// DemoReadOnly 演示模式下拒绝除白名单外的 POST、PUT、PATCH、DELETE 请求
+func DemoReadOnly() gin.HandlerFunc {
+	return rejectWrites(demoAllowedWrites, http.StatusForbidden, "演示模式下不能修改数据", nil)
+}
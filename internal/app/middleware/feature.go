@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// RequireFeature 功能被站点开关关闭时拒绝请求：读取类请求返回 404，写入类请求返回 403。
+// 每个请求都读取当前配置，后台修改开关后立即生效
+func (m *Middleware) RequireFeature(f setting.Feature) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if setting.FeatureEnabled(m.settingSvc, f) {
+			c.Next()
+			return
+		}
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead:
+			response.Fail(c, http.StatusNotFound, "该功能未开启")
+		default:
+			response.Fail(c, http.StatusForbidden, "该功能已关闭")
+		}
+		c.Abort()
+	}
+}
@@ -0,0 +1,30 @@
+/*
+ * @Description: 只读模式中间件，备份、迁移或连接只读副本时拒绝修改数据的请求
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+)
+
+// readOnlyAllowedWrites 只读模式下仍允许的写请求：登录、退出登录、只读查询，以及重新加载配置文件以关闭只读模式
+var readOnlyAllowedWrites = []*regexp.Regexp{
+	regexp.MustCompile(`^/api/auth/(login|refresh-token|logout|oauth/exchange)$`),
+	regexp.MustCompile(`^/api/settings/get-by-keys$`),
+	regexp.MustCompile(`^/api/admin/config/reload$`),
+}
+
+// ReadOnly 开启 System.ReadOnly 时以 503 拒绝除白名单外的 POST、PUT、PATCH、DELETE 请求，页面渲染和查询不受影响。
+// 每个请求都读取当前配置，修改配置文件后通过 SIGHUP 或后台接口重新加载即可切换，无需重启
+func ReadOnly(cfg *config.Config) gin.HandlerFunc {
+	return rejectWrites(readOnlyAllowedWrites, http.StatusServiceUnavailable, "站点处于只读模式，暂时无法修改数据", func() bool {
+		return cfg.GetBool(config.KeyReadOnly)
+	})
+}
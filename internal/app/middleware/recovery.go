@@ -0,0 +1,76 @@
+/*
+ * @Description: panic 恢复中间件
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package middleware
+
+import (
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"syscall"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/errorreport"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Recovery 捕获请求处理过程中的 panic，记录调用栈并上报到错误上报服务
+// 客户端断开连接导致的 broken pipe 不视为错误，也不上报
+func Recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+
+			if isBrokenPipe(recovered) {
+				log.Printf("[Recovery] 客户端已断开连接: %s %s", c.Request.Method, c.Request.URL.Path)
+				c.Abort()
+				return
+			}
+
+			stack := make([]uintptr, 64)
+			stack = stack[:runtime.Callers(3, stack)]
+			log.Printf("[Recovery] 请求 %s %s 发生 panic: %v\n%s", c.Request.Method, c.Request.URL.Path, recovered, debug.Stack())
+			errorreport.CapturePanic(recovered, c.Request, stack)
+
+			if c.Writer.Written() {
+				c.Abort()
+				return
+			}
+			if strings.HasPrefix(c.Request.URL.Path, "/api/") {
+				response.Fail(c, http.StatusInternalServerError, "服务器内部错误")
+				c.Abort()
+				return
+			}
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}()
+		c.Next()
+	}
+}
+
+// isBrokenPipe 判断 panic 是否由客户端断开连接引起
+func isBrokenPipe(recovered interface{}) bool {
+	err, ok := recovered.(error)
+	if !ok {
+		return false
+	}
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		return false
+	}
+	var syscallErr *os.SyscallError
+	if errors.As(opErr.Err, &syscallErr) {
+		return errors.Is(syscallErr.Err, syscall.EPIPE) || errors.Is(syscallErr.Err, syscall.ECONNRESET)
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+/*
+ * @Description: 重定向中间件，按重定向规则将旧地址跳转到新地址
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// publicArticleAPIPrefix 前台文章详情接口，使用文章永久链接作为参数
+const publicArticleAPIPrefix = "/api/public/articles/"
+
+// RedirectLookup 查找请求路径对应的重定向目标和状态码
+type RedirectLookup interface {
+	Lookup(path string) (string, int, bool)
+}
+
+// Redirect 对 GET 和 HEAD 请求应用重定向规则，保留查询参数。
+// 前台通过文章详情接口按永久链接取文章时，旧链接同样跳转到新链接对应的接口地址。
+func Redirect(lookup RedirectLookup) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+		path := c.Request.URL.Path
+		target, code, ok := "", 0, false
+		if slug, isArticleAPI := strings.CutPrefix(path, publicArticleAPIPrefix); isArticleAPI {
+			if slug != "" && !strings.Contains(slug, "/") {
+				if to, redirectCode, found := lookup.Lookup("/posts/" + slug); found {
+					if newSlug, isPost := strings.CutPrefix(to, "/posts/"); isPost {
+						target, code, ok = publicArticleAPIPrefix+newSlug, redirectCode, true
+					}
+				}
+			}
+		} else if !strings.HasPrefix(path, "/api/") {
+			target, code, ok = lookup.Lookup(path)
+		}
+		if !ok {
+			c.Next()
+			return
+		}
+		if c.Request.URL.RawQuery != "" {
+			target += "?" + c.Request.URL.RawQuery
+		}
+		c.Redirect(code, target)
+		c.Abort()
+	}
+}
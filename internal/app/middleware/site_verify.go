@@ -0,0 +1,33 @@
+/*
+ * @Description: 站点验证文件中间件，提供 well-known 文件和搜索引擎验证文件
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/siteverify"
+)
+
+// SiteVerification 对 GET 和 HEAD 请求返回配置的验证文件，优先于前台页面和 SSR 代理
+func SiteVerification(settingSvc setting.SettingService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+		content, contentType, ok := siteverify.Lookup(settingSvc, c.Request.URL.Path)
+		if !ok {
+			c.Next()
+			return
+		}
+		c.Header("Cache-Control", "public, max-age=3600")
+		c.Data(http.StatusOK, contentType, []byte(content))
+		c.Abort()
+	}
+}
@@ -5,14 +5,21 @@
 package middleware
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"strconv"
 	"strings"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/perfprofile"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/accesslog"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/snippet"
 	"github.com/anzhiyu-c/anheyu-app/pkg/ssr"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -31,6 +38,34 @@ func SetSSRThemeChecker(checker CurrentSSRThemeChecker) {
 	ssrThemeChecker = checker
 }
 
+// HTMLInjector 返回指定路径需要注入到 </head> 前和 </body> 前的 HTML
+type HTMLInjector func(path string) (head, bodyEnd string)
+
+// htmlInjector 全局的 HTML 注入器，用于向 SSR 主题输出的页面注入自定义代码片段
+var htmlInjector HTMLInjector
+
+// SetHTMLInjector 设置 SSR 页面的 HTML 注入器
+func SetHTMLInjector(injector HTMLInjector) {
+	htmlInjector = injector
+}
+
+// HTMLRewriter 改写 SSR 页面的 HTML，如将第三方资源地址替换为本地地址
+type HTMLRewriter interface {
+	Enabled() bool
+	Rewrite(html string) string
+}
+
+// htmlRewriters 全局的 HTML 改写器，按顺序执行
+var htmlRewriters []HTMLRewriter
+
+// SetHTMLRewriter 设置 SSR 页面的 HTML 改写器，多个改写器按顺序执行
+func SetHTMLRewriter(rewriters ...HTMLRewriter) {
+	htmlRewriters = rewriters
+}
+
+// ssrRetryAfter SSR 并发超限时建议客户端重试的秒数
+const ssrRetryAfter = "5"
+
 // SSRProxyMiddleware 创建 SSR 主题反向代理中间件
 // 当有 SSR 主题运行时，将前台请求（非 API、非后台）代理到 SSR 主题
 func SSRProxyMiddleware(ssrManager *ssr.Manager) gin.HandlerFunc {
@@ -88,15 +123,47 @@ func SSRProxyMiddleware(ssrManager *ssr.Manager) gin.HandlerFunc {
 		// 创建反向代理
 		proxy := httputil.NewSingleHostReverseProxy(target)
 
+		// 有需要注入的代码片段或需要改写资源地址时要求 SSR 主题返回未压缩的内容，以便改写 HTML
+		var injectHead, injectBodyEnd string
+		if htmlInjector != nil {
+			injectHead, injectBodyEnd = htmlInjector(path)
+		}
+		var rewriters []HTMLRewriter
+		for _, rewriter := range htmlRewriters {
+			if rewriter.Enabled() {
+				rewriters = append(rewriters, rewriter)
+			}
+		}
+		if injectHead != "" || injectBodyEnd != "" || len(rewriters) > 0 {
+			proxy.ModifyResponse = func(resp *http.Response) error {
+				return rewriteHTMLResponse(resp, func(document string) string {
+					document = snippet.InjectHTML(document, injectHead, injectBodyEnd)
+					for _, rewriter := range rewriters {
+						document = rewriter.Rewrite(document)
+					}
+					return document
+				})
+			}
+		}
+
 		// 自定义 Director 保留原始请求信息
 		originalDirector := proxy.Director
 		proxy.Director = func(req *http.Request) {
+			// 非可信代理转发的请求丢弃客户端自带的转发头，ReverseProxy 会重新追加真实对端地址
+			if !util.IsFromTrustedProxy(c) {
+				req.Header.Del("X-Forwarded-For")
+				req.Header.Del("X-Forwarded-Proto")
+			}
 			originalDirector(req)
 			// 保留原始 Host 头（某些 SSR 框架可能需要）
 			req.Host = req.URL.Host
 			// 添加代理标识头
 			req.Header.Set("X-Forwarded-Host", c.Request.Host)
-			req.Header.Set("X-Real-IP", c.ClientIP())
+			req.Header.Set("X-Real-IP", util.GetRealClientIP(c))
+			req.Header.Set("X-Forwarded-Proto", util.GetRequestScheme(c))
+			if proxy.ModifyResponse != nil {
+				req.Header.Del("Accept-Encoding")
+			}
 		}
 
 		// 错误处理：当 SSR 进程不可用时返回友好错误
@@ -122,12 +189,42 @@ func SSRProxyMiddleware(ssrManager *ssr.Manager) gin.HandlerFunc {
 </html>`, runningTheme.Name)))
 		}
 
+		// 限制同时渲染的页面数，避免突发的慢请求占满唯一的 Node.js 进程
+		release, err := perfprofile.AcquireSSR(c.Request.Context(), util.GetRealClientIP(c), path)
+		if err != nil {
+			log.Printf("[SSR 代理] 拒绝请求 %s (主题: %s): %v", path, runningTheme.Name, err)
+			c.Header("Retry-After", ssrRetryAfter)
+			c.String(http.StatusTooManyRequests, "服务器繁忙，请稍后重试")
+			c.Abort()
+			return
+		}
+		defer release()
+
 		// 代理请求
+		c.Set(accesslog.UpstreamContextKey, accesslog.UpstreamSSR)
 		proxy.ServeHTTP(c.Writer, c.Request)
 		c.Abort()
 	}
 }
 
+// rewriteHTMLResponse 改写 SSR 主题返回的 HTML 页面，非 HTML 或已压缩的响应保持原样
+func rewriteHTMLResponse(resp *http.Response, rewrite func(document string) string) error {
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html") || resp.Header.Get("Content-Encoding") != "" {
+		return nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	body = []byte(rewrite(string(body)))
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	resp.Header.Del("ETag")
+	return nil
+}
+
 // shouldSkipSSRProxy 判断是否应该跳过 SSR 代理
 // 以下路径始终由 Go 后端处理，不代理到 SSR 主题
 func shouldSkipSSRProxy(path string) bool {
@@ -138,6 +235,7 @@ func shouldSkipSSRProxy(path string) bool {
 		"/rss.xml",
 		"/feed.xml",
 		"/atom.xml",
+		"/site.webmanifest",
 	}
 
 	for _, exact := range exactPaths {
@@ -146,6 +244,11 @@ func shouldSkipSSRProxy(path string) bool {
 		}
 	}
 
+	// 文章精简阅读页由后端直接渲染
+	if strings.HasPrefix(path, "/posts/") && strings.HasSuffix(path, "/lite") {
+		return true
+	}
+
 	// 前缀匹配的路径
 	// 注意：/static/ 和 /assets/ 不在此列表中，因为它们应该由当前激活的主题控制
 	// 当使用 SSR 主题时，这些路径会被代理到 SSR 进程
@@ -158,6 +261,7 @@ func shouldSkipSSRProxy(path string) bool {
 		"/admin-assets/", // 后台 Vue 资源（专用路径，不受主题影响）
 		"/f/",            // 文件服务
 		"/needcache/",    // 缓存服务
+		"/plugins/",      // 插件路由
 	}
 
 	for _, prefix := range skipPrefixes {
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+)
+
+// writeGuardRetryAfter 以 503 拒绝时建议客户端重试的间隔（秒）
+const writeGuardRetryAfter = "60"
+
+// rejectWrites 在 active 返回 true 时拒绝除 allowed 白名单外的 POST、PUT、PATCH、DELETE 请求，
+// 以 status 和 message 响应，status 为 503 时附带 Retry-After。active 为 nil 表示始终生效
+func rejectWrites(allowed []*regexp.Regexp, status int, message string, active func() bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+		if active != nil && !active() {
+			c.Next()
+			return
+		}
+		for _, re := range allowed {
+			if re.MatchString(c.Request.URL.Path) {
+				c.Next()
+				return
+			}
+		}
+		if status == http.StatusServiceUnavailable {
+			c.Header("Retry-After", writeGuardRetryAfter)
+		}
+		response.Fail(c, status, message)
+		c.Abort()
+	}
+}
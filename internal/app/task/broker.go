@@ -5,16 +5,22 @@ import (
 	"context"
 	"log/slog"
 	"os"
-	"runtime"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/perfprofile"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	article_history_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article_history"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/cleanup"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/diagnostics"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/instancebackup"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/linkarchive"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/localizer"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/statistics"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/thumbnail"
@@ -41,6 +47,17 @@ type Broker struct {
 	settingSvc        setting.SettingService
 	statService       statistics.VisitorStatService
 	articleHistorySvc article_history_service.Service
+	localizerSvc      *localizer.Service
+	backupSvc         *instancebackup.Service
+	linkArchiveSvc    *linkarchive.Service
+	gitSync           GitSyncer
+	demoReset         DemoResetter
+	trash             TrashPurger
+	readOnlyFn        func() bool
+
+	tasksMu     sync.RWMutex
+	tasks       map[string]*scheduledTask
+	overridesMu sync.Mutex // 串行化调度覆盖配置的读改写
 }
 
 // NewBroker 是 Broker 的构造函数。
@@ -58,9 +75,13 @@ func NewBroker(
 	settingSvc setting.SettingService,
 	statService statistics.VisitorStatService,
 	articleHistorySvc article_history_service.Service,
+	localizerSvc *localizer.Service,
+	backupSvc *instancebackup.Service,
+	linkArchiveSvc *linkarchive.Service,
 ) *Broker {
 
-	slogHandler := slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})
+	// 结构化日志同时保留在内存中，供诊断包使用
+	slogHandler := slog.NewTextHandler(diagnostics.LogWriter(os.Stdout), &slog.HandlerOptions{Level: config.LogLevel})
 	logger := slog.New(slogHandler).With("system", "task_broker")
 
 	c := cron.New(
@@ -91,6 +112,10 @@ func NewBroker(
 		settingSvc:        settingSvc,
 		statService:       statService,
 		articleHistorySvc: articleHistorySvc,
+		localizerSvc:      localizerSvc,
+		backupSvc:         backupSvc,
+		linkArchiveSvc:    linkArchiveSvc,
+		tasks:             make(map[string]*scheduledTask),
 	}
 
 	broker.startWorkerPool()
@@ -99,11 +124,9 @@ func NewBroker(
 }
 
 // startWorkerPool 启动固定数量的 worker goroutine 来处理任务。
+// 数量默认与 CPU 核数相同，低配模式下只启动一个。
 func (b *Broker) startWorkerPool() {
-	workerCount := runtime.NumCPU()
-	if workerCount <= 0 {
-		workerCount = 4
-	}
+	workerCount := perfprofile.Current().TaskWorkers
 	b.logger.Info("Starting task worker pool", "concurrency", workerCount)
 
 	for i := 0; i < workerCount; i++ {
@@ -111,6 +134,10 @@ func (b *Broker) startWorkerPool() {
 		go func() {
 			b.logger.Info("Worker started", "worker_id", workerID)
 			for job := range b.jobQueue {
+				if b.readOnly() {
+					b.logger.Warn("Read-only mode, dropping queued job", "worker_id", workerID, "job_name", job.Name())
+					continue
+				}
 				jobWithWrappers := cron.NewChain(
 					NewPanicRecoveryWrapper(b.logger),
 					NewLoggingWrapper(b.logger),
@@ -139,62 +166,88 @@ func (b *Broker) DispatchOrphanCleanup() {
 	b.logger.Info("Successfully queued orphaned items cleanup job")
 }
 
+// SetGitSync 注入 Git 内容同步服务。
+// 该服务依赖文章服务，而文章服务依赖 Broker，因此不能通过构造函数传入。
+func (b *Broker) SetGitSync(gitSync GitSyncer) {
+	b.gitSync = gitSync
+}
+
+// SetDemoReset 注入演示模式服务，仅在开启演示模式时调用。
+func (b *Broker) SetDemoReset(demo DemoResetter) {
+	b.demoReset = demo
+}
+
+// SetTrash 注入回收站服务，回收站依赖文章服务，同样不能通过构造函数传入。
+func (b *Broker) SetTrash(trash TrashPurger) {
+	b.trash = trash
+}
+
+// SetReadOnly 注入只读模式开关，开启时跳过修改数据的定时任务和队列任务。
+// 每次执行前都会重新读取，重新加载配置后立即生效。
+func (b *Broker) SetReadOnly(readOnly func() bool) {
+	b.readOnlyFn = readOnly
+}
+
+func (b *Broker) readOnly() bool {
+	return b.readOnlyFn != nil && b.readOnlyFn()
+}
+
 // RegisterCronJobs 注册所有周期性任务。
+// 下面的调度为默认值，管理员可在后台修改，修改结果保存在 task.schedules 配置项中。
 func (b *Broker) RegisterCronJobs() {
 	b.logger.Info("Registering all periodic jobs...")
 
-	cleanupJob := NewCleanupAbandonedUploadsJob(b.uploadSvc)
-	_, err := b.cron.AddJob("0 0 3 * * *", cleanupJob) // 每天凌晨3点
-	if err != nil {
-		b.logger.Error("Failed to add 'CleanupAbandonedUploadsJob'", slog.Any("error", err))
-		os.Exit(1)
+	b.registerTask("cleanup_abandoned_uploads", "清理过期未完成的上传会话",
+		"0 0 3 * * *", NewCleanupAbandonedUploadsJob(b.uploadSvc)) // 每天凌晨3点
+
+	b.registerTask("sync_view_counts", "将缓存中的文章浏览量批量同步到数据库",
+		"0 */10 * * * *", NewSyncViewCountsJob(b.articleRepo, b.cacheSvc)) // 每10分钟
+
+	b.registerTask("statistics_aggregation", "聚合前一天的访问统计数据",
+		"0 0 1 * * *", NewStatisticsAggregationJob(b.statService, b.logger)) // 每天凌晨1点
+
+	b.registerTask("link_health_check", "检查友链是否可以正常访问",
+		"0 0 3 * * *", NewLinkHealthCheckJob(b.linkRepo, b.logger)) // 每天凌晨3点
+
+	b.registerTask("scheduled_publish", "发布到达定时发布时间的文章",
+		"0 * * * * *", NewScheduledPublishJob(b.articleRepo, b.cacheSvc, b.logger)) // 每分钟的第0秒
+
+	if b.articleHistorySvc != nil {
+		b.registerTask("article_history_cleanup", "清理超出保留数量的文章历史版本",
+			"0 30 3 * * *", NewArticleHistoryCleanupJob(b.articleHistorySvc)) // 每天凌晨3:30
 	}
-	b.logger.Info("-> Successfully registered 'CleanupAbandonedUploadsJob'", "schedule", "every day at 3:00:00 AM")
 
-	syncViewsJob := NewSyncViewCountsJob(b.articleRepo, b.cacheSvc)
-	_, err = b.cron.AddJob("0 0 2 * * *", syncViewsJob) // 每天凌晨 2 点执行一次
-	if err != nil {
-		b.logger.Error("Failed to add 'SyncViewCountsJob'", slog.Any("error", err))
-		os.Exit(1)
+	b.registerTask("visitor_cache_cleanup", "清理访问统计的 User-Agent 解析缓存和请求去重记录",
+		"0 */30 * * * *", NewVisitorCacheCleanupJob(b.statService)) // 每30分钟
+
+	if b.localizerSvc != nil {
+		b.registerTask("resource_localize_refresh", "重新下载已本地化的第三方字体和静态资源",
+			"0 0 4 * * *", NewResourceLocalizeRefreshJob(b.localizerSvc)) // 每天凌晨4点
 	}
-	b.logger.Info("-> Successfully registered 'SyncViewCountsJob'", "schedule", "every day at 2:00:00 AM")
-
-	// 添加统计聚合任务
-	statsAggregationJob := NewStatisticsAggregationJob(b.statService, b.logger)
-	_, err = b.cron.AddJob("0 0 1 * * *", statsAggregationJob) // 每天凌晨1点执行
-	if err != nil {
-		b.logger.Error("Failed to add 'StatisticsAggregationJob'", slog.Any("error", err))
-		os.Exit(1)
+
+	if b.backupSvc != nil {
+		b.registerTask("instance_backup", "备份数据库、data 目录和本机上传文件",
+			"0 30 3 * * *", NewInstanceBackupJob(b.backupSvc)) // 每天凌晨3点30分
 	}
-	b.logger.Info("-> Successfully registered 'StatisticsAggregationJob'", "schedule", "every day at 1:00:00 AM")
-
-	// 添加友链健康检查任务
-	linkHealthCheckJob := NewLinkHealthCheckJob(b.linkRepo, b.logger)
-	_, err = b.cron.AddJob("0 0 3 * * *", linkHealthCheckJob) // 每天凌晨3点执行
-	if err != nil {
-		b.logger.Error("Failed to add 'LinkHealthCheckJob'", slog.Any("error", err))
-		os.Exit(1)
+
+	if b.linkArchiveSvc != nil {
+		b.registerTask("link_archive", "将文章中的外部链接提交到存档服务",
+			"0 15 * * * *", NewLinkArchiveJob(b.linkArchiveSvc)) // 每小时第15分钟
 	}
-	b.logger.Info("-> Successfully registered 'LinkHealthCheckJob'", "schedule", "every day at 3:00:00 AM")
-
-	// 添加定时发布文章任务 - 每分钟检查一次
-	scheduledPublishJob := NewScheduledPublishJob(b.articleRepo, b.cacheSvc, b.logger)
-	_, err = b.cron.AddJob("0 * * * * *", scheduledPublishJob) // 每分钟的第0秒执行
-	if err != nil {
-		b.logger.Error("Failed to add 'ScheduledPublishJob'", slog.Any("error", err))
-		os.Exit(1)
+
+	if b.gitSync != nil {
+		b.registerTask("git_sync", "文章和页面与 Git 仓库双向同步",
+			"0 */10 * * * *", NewGitSyncJob(b.gitSync)) // 每10分钟
 	}
-	b.logger.Info("-> Successfully registered 'ScheduledPublishJob'", "schedule", "every minute")
 
-	// 添加文章历史版本清理任务 - 每天凌晨3:30执行
-	if b.articleHistorySvc != nil {
-		articleHistoryCleanupJob := NewArticleHistoryCleanupJob(b.articleHistorySvc)
-		_, err = b.cron.AddJob("0 30 3 * * *", articleHistoryCleanupJob) // 每天凌晨3:30执行
-		if err != nil {
-			b.logger.Error("Failed to add 'ArticleHistoryCleanupJob'", slog.Any("error", err))
-			os.Exit(1)
-		}
-		b.logger.Info("-> Successfully registered 'ArticleHistoryCleanupJob'", "schedule", "every day at 3:30:00 AM")
+	if b.trash != nil {
+		b.registerTask("trash_purge", "彻底删除回收站中超过保留天数的文章、页面和主题",
+			"0 45 3 * * *", NewTrashPurgeJob(b.trash)) // 每天凌晨3点45分
+	}
+
+	if b.demoReset != nil {
+		b.registerTask("demo_reset", "演示模式下将数据库恢复到启动时的快照",
+			"0 0 * * * *", NewDemoResetJob(b.demoReset)) // 每小时整点
 	}
 
 	b.logger.Info("All periodic jobs registered.")
@@ -243,6 +296,10 @@ func (b *Broker) DispatchLinkHealthCheck() {
 
 // CheckAndRunMissedAggregation 在应用启动时检查并追补所有错过的聚合任务
 func (b *Broker) CheckAndRunMissedAggregation() {
+	if b.readOnly() {
+		b.logger.Info("Read-only mode, skipping missed statistics aggregation")
+		return
+	}
 	b.logger.Info("Checking for any missed statistics aggregation jobs...")
 
 	// 使用 goroutine 在后台执行整个追补过程，避免阻塞启动
@@ -0,0 +1,41 @@
+/*
+ * @Description: 演示模式数据重置定时任务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package task
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// DemoResetter 演示模式服务需要实现的接口
+type DemoResetter interface {
+	Reset(ctx context.Context) error
+}
+
+// DemoResetJob 将演示站点的数据库恢复到启动时的快照
+type DemoResetJob struct {
+	demo DemoResetter
+}
+
+// NewDemoResetJob 创建演示数据重置任务
+func NewDemoResetJob(demo DemoResetter) *DemoResetJob {
+	return &DemoResetJob{demo: demo}
+}
+
+// Run 执行重置
+func (j *DemoResetJob) Run() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	if err := j.demo.Reset(ctx); err != nil {
+		log.Printf("[演示模式] 定时重置失败: %v", err)
+	}
+}
+
+// Name 任务名称
+func (j *DemoResetJob) Name() string {
+	return "DemoResetJob"
+}
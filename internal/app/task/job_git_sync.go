@@ -0,0 +1,41 @@
+/*
+ * @Description: Git 内容同步定时任务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package task
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// GitSyncer Git 内容同步服务需要实现的接口
+type GitSyncer interface {
+	RunScheduled(ctx context.Context) error
+}
+
+// GitSyncJob 拉取 Git 仓库的变更并推送站内的修改
+type GitSyncJob struct {
+	gitSync GitSyncer
+}
+
+// NewGitSyncJob 创建 Git 内容同步任务
+func NewGitSyncJob(gitSync GitSyncer) *GitSyncJob {
+	return &GitSyncJob{gitSync: gitSync}
+}
+
+// Run 未启用 Git 同步时由服务自行跳过
+func (j *GitSyncJob) Run() {
+	ctx, cancel := context.WithTimeout(context.Background(), 9*time.Minute)
+	defer cancel()
+	if err := j.gitSync.RunScheduled(ctx); err != nil {
+		log.Printf("[Git同步] 定时任务失败: %v", err)
+	}
+}
+
+// Name 任务名称
+func (j *GitSyncJob) Name() string {
+	return "GitSyncJob"
+}
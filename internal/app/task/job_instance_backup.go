@@ -0,0 +1,41 @@
+/*
+ * @Description: 整站定时备份任务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package task
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/instancebackup"
+)
+
+// InstanceBackupJob 定时备份数据库、data 目录和本机上传文件
+type InstanceBackupJob struct {
+	backupSvc *instancebackup.Service
+}
+
+// NewInstanceBackupJob 创建整站备份任务
+func NewInstanceBackupJob(backupSvc *instancebackup.Service) *InstanceBackupJob {
+	return &InstanceBackupJob{backupSvc: backupSvc}
+}
+
+// Run 未启用整站定时备份时跳过
+func (j *InstanceBackupJob) Run() {
+	if !j.backupSvc.Enabled() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Hour)
+	defer cancel()
+	if _, err := j.backupSvc.Create(ctx, "定时自动备份"); err != nil {
+		log.Printf("[整站备份] 定时备份失败: %v", err)
+	}
+}
+
+// Name 任务名称
+func (j *InstanceBackupJob) Name() string {
+	return "InstanceBackupJob"
+}
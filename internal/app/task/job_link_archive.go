@@ -0,0 +1,41 @@
+/*
+ * @Description: 外链存档定时任务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package task
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/linkarchive"
+)
+
+// LinkArchiveJob 扫描有变更的文章并提交外部链接存档
+type LinkArchiveJob struct {
+	archiveSvc *linkarchive.Service
+}
+
+// NewLinkArchiveJob 创建外链存档任务
+func NewLinkArchiveJob(archiveSvc *linkarchive.Service) *LinkArchiveJob {
+	return &LinkArchiveJob{archiveSvc: archiveSvc}
+}
+
+// Run 未启用外链存档时跳过
+func (j *LinkArchiveJob) Run() {
+	if !j.archiveSvc.Enabled() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Minute)
+	defer cancel()
+	if _, err := j.archiveSvc.Run(ctx); err != nil {
+		log.Printf("[外链存档] 定时任务失败: %v", err)
+	}
+}
+
+// Name 任务名称
+func (j *LinkArchiveJob) Name() string {
+	return "LinkArchiveJob"
+}
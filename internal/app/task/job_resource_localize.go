@@ -0,0 +1,38 @@
+/*
+ * @Description: 第三方资源本地化刷新定时任务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/localizer"
+)
+
+// ResourceLocalizeRefreshJob 重新下载已本地化的第三方资源，使本地副本与 CDN 保持一致
+type ResourceLocalizeRefreshJob struct {
+	localizerSvc *localizer.Service
+}
+
+// NewResourceLocalizeRefreshJob 创建资源本地化刷新任务
+func NewResourceLocalizeRefreshJob(localizerSvc *localizer.Service) *ResourceLocalizeRefreshJob {
+	return &ResourceLocalizeRefreshJob{localizerSvc: localizerSvc}
+}
+
+// Run 未启用资源本地化时跳过
+func (j *ResourceLocalizeRefreshJob) Run() {
+	if !j.localizerSvc.Enabled() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+	j.localizerSvc.Refresh(ctx)
+}
+
+// Name 任务名称
+func (j *ResourceLocalizeRefreshJob) Name() string {
+	return "ResourceLocalizeRefreshJob"
+}
@@ -77,3 +77,23 @@ func (j *StatisticsCleanupJob) Run() {
 func (j *StatisticsCleanupJob) Name() string {
 	return "StatisticsCleanupJob"
 }
+
+// VisitorCacheCleanupJob 访问统计内存缓存清理任务
+type VisitorCacheCleanupJob struct {
+	statService statistics.VisitorStatService
+}
+
+// NewVisitorCacheCleanupJob 创建访问统计缓存清理任务实例
+func NewVisitorCacheCleanupJob(statService statistics.VisitorStatService) *VisitorCacheCleanupJob {
+	return &VisitorCacheCleanupJob{statService: statService}
+}
+
+// Run 清理过期的 User-Agent 解析缓存和请求去重记录
+func (j *VisitorCacheCleanupJob) Run() {
+	j.statService.CleanupExpiredCaches()
+}
+
+// Name 返回任务名称
+func (j *VisitorCacheCleanupJob) Name() string {
+	return "VisitorCacheCleanupJob"
+}
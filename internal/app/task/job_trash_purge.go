@@ -0,0 +1,41 @@
+/*
+ * @Description: 回收站自动清理定时任务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package task
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// TrashPurger 回收站服务需要实现的接口
+type TrashPurger interface {
+	PurgeExpired(ctx context.Context) (int, error)
+}
+
+// TrashPurgeJob 彻底删除回收站中超过保留天数的文章、页面和主题
+type TrashPurgeJob struct {
+	trash TrashPurger
+}
+
+// NewTrashPurgeJob 创建回收站自动清理任务
+func NewTrashPurgeJob(trash TrashPurger) *TrashPurgeJob {
+	return &TrashPurgeJob{trash: trash}
+}
+
+// Run 保留天数设置为 0 时由服务自行跳过
+func (j *TrashPurgeJob) Run() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+	if _, err := j.trash.PurgeExpired(ctx); err != nil {
+		log.Printf("[回收站] 自动清理失败: %v", err)
+	}
+}
+
+// Name 任务名称
+func (j *TrashPurgeJob) Name() string {
+	return "TrashPurgeJob"
+}
@@ -0,0 +1,364 @@
+/*
+ * @Description: 定时任务注册表，提供调度覆盖、运行状态、手动触发和并发保护
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 所有周期性任务通过 registerTask 登记到 Broker，默认调度写在代码中，
+ * 管理员修改后的调度以 JSON 形式保存在 task.schedules 配置项里，重启后依然生效。
+ * 同一个任务无论是定时触发还是手动触发，同一时间只会运行一个实例。
+ */
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/perfprofile"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/adminevent"
+	"github.com/robfig/cron/v3"
+)
+
+// ScheduleDisabled 调度覆盖值为该值时停用任务的定时触发，仍可手动执行
+const ScheduleDisabled = "off"
+
+// 任务触发方式
+const (
+	TriggerCron   = "cron"
+	TriggerManual = "manual"
+)
+
+// 任务运行结果
+const (
+	ResultSuccess = "success"
+	ResultFailed  = "failed"
+)
+
+var (
+	// ErrTaskNotFound 任务不存在
+	ErrTaskNotFound = errors.New("任务不存在")
+	// ErrTaskRunning 任务正在运行
+	ErrTaskRunning = errors.New("任务正在运行中，请稍后再试")
+	// ErrInvalidSchedule 调度表达式不合法
+	ErrInvalidSchedule = errors.New("调度表达式不合法")
+	// ErrReadOnly 只读模式下不执行修改数据的任务
+	ErrReadOnly = errors.New("站点处于只读模式，修改数据的任务暂停执行")
+)
+
+// heavyTasks 重量级任务，低配模式下暂停定时触发，仍可手动执行
+var heavyTasks = map[string]bool{
+	"link_health_check":         true,
+	"link_archive":              true,
+	"resource_localize_refresh": true,
+}
+
+// readOnlySafeTasks 只读模式下仍会执行的任务，它们只读取数据库
+var readOnlySafeTasks = map[string]bool{
+	"instance_backup": true,
+}
+
+// scheduleParser 与 Broker 中 cron.WithSeconds() 使用的解析规则一致
+var scheduleParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// TaskStatus 任务的调度配置与最近一次运行状态
+type TaskStatus struct {
+	Key             string     `json:"key"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	Schedule        string     `json:"schedule"`
+	DefaultSchedule string     `json:"default_schedule"`
+	Enabled         bool       `json:"enabled"`
+	Running         bool       `json:"running"`
+	RunCount        int        `json:"run_count"`
+	LastTrigger     string     `json:"last_trigger,omitempty"`
+	LastRunAt       *time.Time `json:"last_run_at,omitempty"`
+	LastDurationMs  int64      `json:"last_duration_ms"`
+	LastResult      string     `json:"last_result,omitempty"`
+	LastError       string     `json:"last_error,omitempty"`
+	NextRunAt       *time.Time `json:"next_run_at,omitempty"`
+	// PausedByLowResource 低配模式下暂停了该任务的定时触发
+	PausedByLowResource bool `json:"paused_by_low_resource,omitempty"`
+}
+
+// scheduledTask 已注册的周期性任务
+type scheduledTask struct {
+	key         string
+	description string
+	defaultSpec string
+	job         Job
+
+	running atomic.Bool
+
+	mu      sync.Mutex
+	spec    string
+	entryID cron.EntryID
+	status  TaskStatus
+}
+
+// registerTask 登记一个周期性任务并按（可能被覆盖的）调度加入 cron
+func (b *Broker) registerTask(key, description, defaultSpec string, job Job) {
+	if _, err := scheduleParser.Parse(defaultSpec); err != nil {
+		b.logger.Error("Invalid default schedule", "task", key, "schedule", defaultSpec, slog.Any("error", err))
+		return
+	}
+
+	t := &scheduledTask{
+		key:         key,
+		description: description,
+		defaultSpec: defaultSpec,
+		job:         job,
+		spec:        defaultSpec,
+	}
+
+	if override, ok := b.loadScheduleOverrides()[key]; ok {
+		if err := validateSchedule(override); err != nil {
+			b.logger.Warn("Ignoring invalid schedule override", "task", key, "schedule", override, slog.Any("error", err))
+		} else {
+			t.spec = override
+		}
+	}
+
+	b.tasksMu.Lock()
+	b.tasks[key] = t
+	b.tasksMu.Unlock()
+
+	if err := b.scheduleTask(t); err != nil {
+		b.logger.Error("Failed to schedule task", "task", key, "schedule", t.spec, slog.Any("error", err))
+		return
+	}
+	b.logger.Info("-> Successfully registered task", "task", key, "job_name", job.Name(), "schedule", t.spec)
+}
+
+// scheduleTask 将任务按当前调度加入 cron，停用的任务只移除不添加
+func (b *Broker) scheduleTask(t *scheduledTask) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.entryID != 0 {
+		b.cron.Remove(t.entryID)
+		t.entryID = 0
+	}
+	if t.spec == ScheduleDisabled {
+		return nil
+	}
+
+	entryID, err := b.cron.AddJob(t.spec, &cronTaskJob{broker: b, task: t})
+	if err != nil {
+		return err
+	}
+	t.entryID = entryID
+	return nil
+}
+
+// cronTaskJob 由 cron 触发的任务包装，保留原任务名称便于日志装饰器输出
+type cronTaskJob struct {
+	broker *Broker
+	task   *scheduledTask
+}
+
+func (j *cronTaskJob) Run() {
+	if heavyTasks[j.task.key] && !perfprofile.Current().HeavyJobs {
+		j.broker.logger.Info("Low resource mode enabled, skipping heavy task", "task", j.task.key)
+		return
+	}
+	j.broker.runTask(j.task, TriggerCron)
+}
+
+func (j *cronTaskJob) Name() string { return j.task.job.Name() }
+
+// runTask 执行任务并记录状态；任务已在运行时跳过本次执行
+func (b *Broker) runTask(t *scheduledTask, trigger string) {
+	if b.readOnly() && !readOnlySafeTasks[t.key] {
+		b.logger.Info("Read-only mode, skipping task", "task", t.key, "trigger", trigger)
+		return
+	}
+	if !t.running.CompareAndSwap(false, true) {
+		b.logger.Warn("Task is still running, skipping this execution", "task", t.key, "trigger", trigger)
+		return
+	}
+	defer t.running.Store(false)
+
+	startedAt := time.Now()
+	t.mu.Lock()
+	t.status.LastTrigger = trigger
+	t.status.LastRunAt = &startedAt
+	t.mu.Unlock()
+	adminevent.Publish(adminevent.TypeTaskStarted, adminevent.TaskData{Key: t.key, Trigger: trigger})
+
+	var runErr string
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				runErr = fmt.Sprintf("panic: %v", r)
+				b.logger.Error("Task panicked", "task", t.key, slog.Any("panic", r))
+			}
+		}()
+		t.job.Run()
+	}()
+
+	t.mu.Lock()
+	t.status.RunCount++
+	t.status.LastDurationMs = time.Since(startedAt).Milliseconds()
+	t.status.LastError = runErr
+	if runErr != "" {
+		t.status.LastResult = ResultFailed
+	} else {
+		t.status.LastResult = ResultSuccess
+	}
+	finished := adminevent.TaskData{
+		Key:        t.key,
+		Trigger:    trigger,
+		Result:     t.status.LastResult,
+		Error:      runErr,
+		DurationMs: t.status.LastDurationMs,
+	}
+	t.mu.Unlock()
+	adminevent.Publish(adminevent.TypeTaskFinished, finished)
+}
+
+// ListTasks 返回所有已注册任务的状态，按 key 排序
+func (b *Broker) ListTasks() []TaskStatus {
+	b.tasksMu.RLock()
+	tasks := make([]*scheduledTask, 0, len(b.tasks))
+	for _, t := range b.tasks {
+		tasks = append(tasks, t)
+	}
+	b.tasksMu.RUnlock()
+
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].key < tasks[j].key })
+
+	result := make([]TaskStatus, 0, len(tasks))
+	for _, t := range tasks {
+		result = append(result, b.taskStatus(t))
+	}
+	return result
+}
+
+// GetTask 返回单个任务的状态
+func (b *Broker) GetTask(key string) (TaskStatus, error) {
+	t, err := b.lookupTask(key)
+	if err != nil {
+		return TaskStatus{}, err
+	}
+	return b.taskStatus(t), nil
+}
+
+// RunTaskNow 在后台立即执行一次任务，任务正在运行时返回 ErrTaskRunning
+func (b *Broker) RunTaskNow(key string) error {
+	t, err := b.lookupTask(key)
+	if err != nil {
+		return err
+	}
+	if t.running.Load() {
+		return ErrTaskRunning
+	}
+	if b.readOnly() && !readOnlySafeTasks[t.key] {
+		return ErrReadOnly
+	}
+	go b.runTask(t, TriggerManual)
+	return nil
+}
+
+// UpdateTaskSchedule 修改任务调度并持久化；spec 为空时恢复默认调度，为 off 时停用定时触发
+func (b *Broker) UpdateTaskSchedule(ctx context.Context, key, spec string) (TaskStatus, error) {
+	t, err := b.lookupTask(key)
+	if err != nil {
+		return TaskStatus{}, err
+	}
+	if spec == "" {
+		spec = t.defaultSpec
+	}
+	if err := validateSchedule(spec); err != nil {
+		return TaskStatus{}, err
+	}
+
+	b.overridesMu.Lock()
+	defer b.overridesMu.Unlock()
+
+	overrides := b.loadScheduleOverrides()
+	if spec == t.defaultSpec {
+		delete(overrides, key)
+	} else {
+		overrides[key] = spec
+	}
+	data, err := json.Marshal(overrides)
+	if err != nil {
+		return TaskStatus{}, err
+	}
+	if err := b.settingSvc.UpdateSettings(ctx, map[string]string{constant.KeyTaskSchedules.String(): string(data)}); err != nil {
+		return TaskStatus{}, fmt.Errorf("保存任务调度失败: %w", err)
+	}
+
+	t.mu.Lock()
+	t.spec = spec
+	t.mu.Unlock()
+	if err := b.scheduleTask(t); err != nil {
+		return TaskStatus{}, err
+	}
+
+	b.logger.Info("Task schedule updated", "task", key, "schedule", spec)
+	return b.taskStatus(t), nil
+}
+
+func (b *Broker) lookupTask(key string) (*scheduledTask, error) {
+	b.tasksMu.RLock()
+	defer b.tasksMu.RUnlock()
+	t, ok := b.tasks[key]
+	if !ok {
+		return nil, ErrTaskNotFound
+	}
+	return t, nil
+}
+
+func (b *Broker) taskStatus(t *scheduledTask) TaskStatus {
+	t.mu.Lock()
+	status := t.status
+	status.Key = t.key
+	status.Name = t.job.Name()
+	status.Description = t.description
+	status.Schedule = t.spec
+	status.DefaultSchedule = t.defaultSpec
+	status.Enabled = t.spec != ScheduleDisabled
+	entryID := t.entryID
+	t.mu.Unlock()
+
+	status.Running = t.running.Load()
+	status.PausedByLowResource = heavyTasks[t.key] && !perfprofile.Current().HeavyJobs
+	if entryID != 0 {
+		if next := b.cron.Entry(entryID).Next; !next.IsZero() {
+			status.NextRunAt = &next
+		}
+	}
+	return status
+}
+
+// loadScheduleOverrides 读取配置中保存的调度覆盖，格式为 {"任务key": "cron 表达式"}
+func (b *Broker) loadScheduleOverrides() map[string]string {
+	overrides := make(map[string]string)
+	raw := b.settingSvc.Get(constant.KeyTaskSchedules.String())
+	if raw == "" {
+		return overrides
+	}
+	if err := json.Unmarshal([]byte(raw), &overrides); err != nil {
+		b.logger.Warn("Failed to parse task schedule overrides", slog.Any("error", err))
+		return make(map[string]string)
+	}
+	return overrides
+}
+
+func validateSchedule(spec string) error {
+	if spec == ScheduleDisabled {
+		return nil
+	}
+	if _, err := scheduleParser.Parse(spec); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSchedule, err)
+	}
+	return nil
+}
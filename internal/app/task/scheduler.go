@@ -11,6 +11,7 @@ import (
 	"log/slog"
 	"os"
 
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/file"
 	article_history_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article_history"
 
@@ -31,7 +32,7 @@ type Scheduler struct {
 // 它现在使用 slog 来创建 logger，并将其传递给新的装饰器。
 func NewScheduler(uploadSvc file.IUploadService, articleHistorySvc article_history_service.Service) *Scheduler {
 	// 1. 创建一个 slog.Logger 实例，并为其添加一个固定的 "system":"cron" 属性。
-	slogHandler := slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})
+	slogHandler := slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: config.LogLevel})
 	logger := slog.New(slogHandler).With("system", "cron")
 
 	// 2. 创建一个新的 cron 调度器实例，并将新的 logger 传递给装饰器。
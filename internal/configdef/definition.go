@@ -146,6 +146,7 @@ var AllSettings = []Definition{
 	{Key: constant.KeyPostDefaultDoubleColumn, Value: "true", Comment: "文章默认双栏", IsPublic: true},
 	{Key: constant.KeyPostDefaultPageSize, Value: "12", Comment: "文章默认分页大小", IsPublic: true},
 	{Key: constant.KeyPostExpirationTime, Value: "365", Comment: "文章过期时间(单位天)", IsPublic: true},
+	{Key: constant.KeyPostLazyLoadSkipFirst, Value: "0", Comment: "服务端渲染文章正文时不转换为懒加载的前几张图片数量，首屏的 LCP 图片直接加载可改善最大内容绘制时间，0 表示全部懒加载", IsPublic: true},
 	{Key: constant.Key404PageDefaultImage, Value: "/static/img/background-effect.gif", Comment: "404页面默认图片", IsPublic: true},
 	{Key: constant.KeyPostRewardEnable, Value: "true", Comment: "文章打赏功能是否启用", IsPublic: true},
 	{Key: constant.KeyPostRewardWeChatQR, Value: "https://npm.elemecdn.com/anzhiyu-blog@1.1.6/img/post/common/qrcode-weichat.png", Comment: "微信打赏二维码图片URL", IsPublic: true},
@@ -169,6 +170,7 @@ var AllSettings = []Definition{
 
 	// 文章目录 Hash 更新配置
 	{Key: constant.KeyPostTocHashUpdateMode, Value: "replace", Comment: "目录滚动是否更新URL Hash: replace(启用), none(禁用)", IsPublic: true},
+	{Key: constant.KeyPostTocMaxDepth, Value: "3", Comment: "保存文章时提取目录的标题层数，从文章中最高一级标题开始计算 (1-6)", IsPublic: true},
 
 	// 文章页面波浪区域配置
 	{Key: constant.KeyPostWavesEnable, Value: "true", Comment: "是否显示文章页面波浪区域 (true/false)，默认显示", IsPublic: true},
@@ -184,7 +186,7 @@ var AllSettings = []Definition{
 	{Key: constant.KeyPostCopyrightShowSubscribeButton, Value: "true", Comment: "是否显示订阅按钮 (true/false)，全局控制所有文章底部是否显示订阅按钮", IsPublic: true},
 
 	// 文章订阅配置
-	{Key: constant.KeyPostSubscribeEnable, Value: "false", Comment: "是否启用文章订阅功能 (true/false)", IsPublic: true},
+	{Key: constant.KeyPostSubscribeEnable, Value: "false", Comment: "是否启用文章订阅功能 (true/false)，关闭后不再接受订阅、不再推送新文章，退订不受影响", IsPublic: true},
 	{Key: constant.KeyPostSubscribeButtonText, Value: "订阅", Comment: "订阅按钮文案", IsPublic: true},
 	{Key: constant.KeyPostSubscribeDialogTitle, Value: "订阅博客更新", Comment: "订阅弹窗标题", IsPublic: true},
 	{Key: constant.KeyPostSubscribeDialogDesc, Value: "输入您的邮箱，获取最新文章推送", Comment: "订阅弹窗描述", IsPublic: true},
@@ -202,7 +204,7 @@ var AllSettings = []Definition{
 	{Key: constant.KeyRecentCommentsBannerTitle, Value: "评论", Comment: "最近评论页面横幅标题", IsPublic: true},
 	{Key: constant.KeyRecentCommentsBannerDescription, Value: "最近评论", Comment: "最近评论页面横幅描述", IsPublic: true},
 	{Key: constant.KeyRecentCommentsBannerTip, Value: "发表你的观点和看法，让更多人看到", Comment: "最近评论页面横幅提示", IsPublic: true},
-	{Key: constant.KeyCommentEnable, Value: "true", Comment: "是否启用评论功能", IsPublic: true},
+	{Key: constant.KeyCommentEnable, Value: "true", Comment: "是否启用评论功能，关闭后公开评论接口返回 404/403，后台管理不受影响", IsPublic: true},
 	{Key: constant.KeyCommentLoginRequired, Value: "false", Comment: "是否开启登录后评论", IsPublic: true},
 	{Key: constant.KeyCommentPageSize, Value: "10", Comment: "评论每页数量", IsPublic: true},
 	{Key: constant.KeyCommentMasterTag, Value: "博主", Comment: "管理员评论专属标签文字", IsPublic: true},
@@ -212,6 +214,8 @@ var AllSettings = []Definition{
 	{Key: constant.KeyCommentAnonymousEmail, Value: "", Comment: "收取匿名评论邮箱，为空时使用前台网站拥有者邮箱", IsPublic: true},
 	{Key: constant.KeyCommentShowUA, Value: "true", Comment: "是否显示评论者操作系统和浏览器信息", IsPublic: true},
 	{Key: constant.KeyCommentShowRegion, Value: "true", Comment: "是否显示评论者IP归属地", IsPublic: true},
+	{Key: constant.KeyCommentLocationGranularity, Value: "city", Comment: "评论属地显示粒度：country-国家, province-省份, city-省市, off-不查询也不显示；只影响新评论的存储，历史评论需执行一次 IP 匿名化任务按此粒度处理", IsPublic: false},
+	{Key: constant.KeyCommentIPAnonymize, Value: "false", Comment: "是否在存储前对评论IP做匿名化 (true/false)，IPv4 末段置零，IPv6 仅保留前48位；属地仍基于完整IP查询；只作用于新评论，历史评论需执行一次 IP 匿名化任务", IsPublic: false},
 	{Key: constant.KeyCommentAllowImageUpload, Value: "true", Comment: "是否允许在评论中上传图片", IsPublic: true},
 	{Key: constant.KeyCommentLimitPerMinute, Value: "5", Comment: "单个IP每分钟允许提交的评论数", IsPublic: false},
 	{Key: constant.KeyCommentLimitLength, Value: "10000", Comment: "单条评论最大字数", IsPublic: true},
@@ -467,6 +471,212 @@ var AllSettings = []Definition{
 	{Key: constant.KeyCDNZoneID, Value: "", Comment: "EdgeOne站点ID", IsPublic: false},
 	{Key: constant.KeyCDNBaseURL, Value: "", Comment: "CDNFLY网站URL", IsPublic: false},
 
+	// --- 访问日志配置 ---
+	{Key: constant.KeyAccessLogEnable, Value: "true", Comment: "是否记录访问日志到内存缓冲区 (true/false)", IsPublic: false},
+	{Key: constant.KeyAccessLogSlowThresholdMs, Value: "1000", Comment: "慢请求阈值（毫秒），超过该值的请求会记录数据库查询次数和外部调用详情", IsPublic: false},
+
+	// --- 错误上报配置 ---
+	{Key: constant.KeyErrorReportDSN, Value: "", Comment: "Sentry 兼容的错误上报 DSN（支持 Sentry、GlitchTip），留空则不上报", IsPublic: false},
+	{Key: constant.KeyErrorReportEnvironment, Value: "production", Comment: "错误上报时附带的环境名称", IsPublic: false},
+
+	// --- 出站 HTTP 配置 ---
+	{Key: constant.KeyOutboundProxy, Value: "", Comment: "主题商城、SSR主题下载、IP属地、微信等外部请求使用的代理地址（如 http://127.0.0.1:7890），留空使用 HTTP(S)_PROXY 环境变量，direct 表示直连", IsPublic: false},
+	{Key: constant.KeyOutboundNoProxy, Value: "", Comment: "不走代理的主机，逗号分隔，支持 .example.com 后缀匹配", IsPublic: false},
+	{Key: constant.KeyOutboundCABundle, Value: "", Comment: "外部请求额外信任的CA证书，可填写PEM内容或证书文件路径，适用于企业内网中间人代理", IsPublic: false},
+	{Key: constant.KeyOutboundInstanceID, Value: "", Comment: "主题商城、IP属地、链接存档等外部请求携带的匿名实例ID（X-Anheyu-Instance 请求头和 User-Agent），首次启动时随机生成，不包含站点信息；留空则不发送", IsPublic: false},
+	{Key: constant.KeyOutboundContactURL, Value: "", Comment: "外部请求 User-Agent 中附带的联系地址（如站点的关于页或邮箱 mailto: 链接），上游服务遇到异常流量时可据此联系站长，留空不附带", IsPublic: false},
+	{Key: constant.KeyOutboundServiceOverrides, Value: "", Comment: "按服务覆盖出站配置的JSON，服务名可选 theme、ssr、geoip、wechat、revalidate、summary、mail，如 {\"wechat\":{\"proxy\":\"direct\"}}", IsPublic: false},
+
+	// --- 下载镜像配置 ---
+	{Key: constant.KeyDownloadMirrors, Value: "", Comment: "主题包下载镜像改写规则的JSON数组，URL匹配 prefix 时改为 mirror 下载，失败后回退原地址，如 [{\"prefix\":\"https://github.com/\",\"mirror\":\"https://ghproxy.com/https://github.com/\"}]", IsPublic: false},
+	{Key: constant.KeyDownloadMirrorRequireChecksum, Value: "true", Comment: "未提供主题包校验和时是否跳过镜像直接从原地址下载 (true/false)，镜像内容只有校验通过才可信", IsPublic: false},
+
+	// --- 定时任务配置 ---
+	{Key: constant.KeyTaskSchedules, Value: "", Comment: "定时任务调度覆盖的JSON对象，键为任务key，值为带秒的cron表达式或 off（停用定时触发），未配置的任务使用默认调度，一般在后台任务管理中修改", IsPublic: false},
+
+	// --- 性能档位配置 ---
+	{Key: constant.KeyPerformanceLowResourceMode, Value: "false", Comment: "低配模式：后台任务单线程执行、减小解析缓存（需重启生效），上传后不预生成缩略图、暂停友链检查和外链存档等定时任务、限制 SSR 并发 (true/false)", IsPublic: false},
+	{Key: constant.KeyPerformanceSSRMaxConcurrency, Value: "0", Comment: "同时代理到 SSR 主题的请求数上限，超出的请求排队等待，0 表示不限制；低配模式下未设置时为 2", IsPublic: false},
+	{Key: constant.KeyPerformanceSSRMaxPerIP, Value: "0", Comment: "单个 IP 同时代理到 SSR 主题的请求数上限，超出时直接返回 429，0 表示不限制。SSR 主题的静态资源同样经过代理，设置时应留出页面并行加载资源的余量", IsPublic: false},
+	{Key: constant.KeyPerformanceSSRMaxPerRoute, Value: "0", Comment: "单个路由（同一路径）同时代理到 SSR 主题的请求数上限，超出时直接返回 429，0 表示不限制", IsPublic: false},
+	{Key: constant.KeyPerformanceSSRQueueTimeout, Value: "10", Comment: "超过 SSR 全局并发上限时请求排队等待的最长秒数，超时返回 429", IsPublic: false},
+
+	// --- SSR 主题源码构建配置 ---
+	{Key: constant.KeySSRBuildEnable, Value: "false", Comment: "安装 SSR 主题时主题包只包含源码（有 package.json 的 build 脚本、没有 server.js）是否在本机执行依赖安装和构建 (true/false)。构建需要本机安装 Node.js 和 npm，且会占用较多 CPU 和内存。注意：构建不在沙箱中进行，主题的依赖安装脚本和 build 脚本以与本程序相同的权限运行，可以读取配置文件、数据库和上传文件，只应对可信来源的主题开启", IsPublic: false},
+	{Key: constant.KeySSRBuildTimeout, Value: "900", Comment: "SSR 主题安装依赖和构建的总时长上限（秒），超时后结束构建进程并放弃安装", IsPublic: false},
+	{Key: constant.KeySSRBuildMaxMemoryMB, Value: "2048", Comment: "SSR 主题构建时 Node.js 的堆内存上限（MB），通过 --max-old-space-size 传给构建进程", IsPublic: false},
+
+	// --- 主题存储配额配置 ---
+	{Key: constant.KeyThemeStorageQuotaMB, Value: "0", Comment: "themes 和 backup 目录合计占用上限（MB），安装、上传主题和备份 static 目录前检查，0 表示不限制", IsPublic: false},
+	{Key: constant.KeyThemeStorageMinFreeMB, Value: "100", Comment: "主题下载、解压和备份完成后磁盘至少保留的可用空间（MB），空间不足时操作会在开始前失败", IsPublic: false},
+
+	// --- 主题切换后页面检查配置 ---
+	{Key: constant.KeyThemeCanaryEnable, Value: "true", Comment: "切换到外部主题或 SSR 主题后是否请求首页和最新一篇文章检查可用性，页面一直返回 5xx 或空白内容时自动回滚到切换前的主题并推送系统通知 (true/false)", IsPublic: false},
+	{Key: constant.KeyThemeCanaryTimeout, Value: "30", Comment: "切换主题后每个页面检查的最长等待时间（秒），SSR 主题启动较慢时可适当调大", IsPublic: false},
+
+	// --- 主题操作超时配置 ---
+	{Key: constant.KeyThemeInstallTimeout, Value: "600", Comment: "安装、上传主题（下载、解压、校验）的最长时间（秒），超时后中止并清理已写出的文件；SSR 主题的构建时长由 ssr.build.timeout 单独限制，0 表示不限制", IsPublic: false},
+	{Key: constant.KeyThemeSwitchTimeout, Value: "120", Comment: "切换主题（备份、复制主题文件、启动 SSR 主题）的最长时间（秒），超时后中止并恢复切换前的 static 目录；切换后的页面检查由 theme.canary.timeout 单独限制，0 表示不限制", IsPublic: false},
+
+	// --- 外部主题文件访问策略 ---
+	{Key: constant.KeyThemeFilesDeny, Value: ".*,*.map", Comment: "外部主题中禁止访问的文件模式，逗号分隔；不含 / 的模式匹配路径中的任意一段（如 .* 匹配所有点文件和点目录），含 / 的模式匹配完整相对路径", IsPublic: false},
+	{Key: constant.KeyThemeFilesAllow, Value: ".well-known/*", Comment: "外部主题中不受禁止列表限制的文件模式，逗号分隔，规则同禁止列表", IsPublic: false},
+	{Key: constant.KeyThemeFilesTrustedHTML, Value: "", Comment: "外部主题中按原样提供的 HTML、SVG、XML 文件模式，逗号分隔；首页、文章模板等页面 HTML 不受影响，其余此类文件（如演示页）默认带沙箱 CSP 返回", IsPublic: false},
+	{Key: constant.KeyThemeFilesHTMLCSP, Value: "sandbox; default-src 'none'; img-src 'self' data:; style-src 'self' 'unsafe-inline'; font-src 'self' data:; media-src 'self'; frame-ancestors 'self'", Comment: "外部主题中非页面 HTML 及 SVG、XML 文件使用的 Content-Security-Policy，默认以沙箱方式打开并禁止脚本", IsPublic: false},
+
+	// --- 主题商城来源配置 ---
+	{Key: constant.KeyThemeMarketSources, Value: "[]", Comment: "主题商城来源的JSON数组，每项包含 id、name、url（主题列表接口）、enabled、token（私有源的 Bearer 令牌）、download_api 和 rating_api（{id} 为主题 ID，默认 url/{id}/download 和 url/{id}/rating）。各来源的列表合并展示并标记来源，同名主题保留排在前面的来源；官方商城未配置时排在第一位，配置 id 为 official 的项可替换地址或禁用", IsPublic: false},
+
+	// --- 文章永久链接配置 ---
+	{Key: constant.KeyPermalinkStrategy, Value: "abbrlink", Comment: "新文章未填写永久链接时的生成策略：abbrlink（使用文章ID）、date（发布日期加标题）、title（标题）、pattern（自定义模式）；生成的永久链接与其他文章或页面冲突时自动追加序号", IsPublic: false},
+	{Key: constant.KeyPermalinkPattern, Value: "{year}{month}{day}-{title}", Comment: "pattern 策略使用的模式，支持 {year}、{month}、{day}、{hour}、{minute}、{title}、{category}，/ 会转换为连字符", IsPublic: false},
+	{Key: constant.KeyPermalinkAutoRedirect, Value: "true", Comment: "文章永久链接变化时是否自动为旧地址添加 301 重定向 (true/false)", IsPublic: false},
+
+	// --- 外部主题增量静态再生成配置 ---
+	{Key: constant.KeyThemeISREnable, Value: "false", Comment: "是否缓存外部主题 Go 模板页面（如 posts/__template__.html、archives.html）的渲染结果到 data/isr，文章、分类、标签或站点配置变化时由事件触发重新生成 (true/false)", IsPublic: false},
+	{Key: constant.KeyThemeISRRevalidate, Value: "0", Comment: "缓存页面的最长有效期（秒）。超过后先返回旧页面并在后台重新生成；0 表示只在内容变化时重新生成", IsPublic: false},
+
+	// --- 主题静态资源 CDN 配置 ---
+	{Key: constant.KeyThemeAssetCDNURL, Value: "", Comment: "主题静态资源的 CDN 地址（如 https://cdn.example.com）。设置后前台页面和公开主题配置中 /static/、/assets/ 开头的资源地址改写为该地址并附带内容哈希参数 ?v=，CDN 需将查询参数计入缓存键；为空表示不使用", IsPublic: false},
+	{Key: constant.KeyThemeAssetCDNPolicyID, Value: "", Comment: "推送主题资源的存储策略公共 ID。为空时 CDN 以本站为源站；设置后切换主题时将资源上传到该存储策略的 theme-assets 目录，CDN 地址应指向该目录", IsPublic: false},
+
+	// --- 站点所有权验证配置 ---
+	{Key: constant.KeySiteVerifyGoogle, Value: "", Comment: "Google Search Console 的 HTML 标记验证码，前台页面输出 <meta name=\"google-site-verification\">；可直接粘贴整个 meta 标签", IsPublic: false},
+	{Key: constant.KeySiteVerifyBing, Value: "", Comment: "Bing Webmaster Tools 的验证码，前台页面输出 <meta name=\"msvalidate.01\">", IsPublic: false},
+	{Key: constant.KeySiteVerifyBaidu, Value: "", Comment: "百度搜索资源平台的验证码，前台页面输出 <meta name=\"baidu-site-verification\">", IsPublic: false},
+	{Key: constant.KeySiteVerifyYandex, Value: "", Comment: "Yandex Webmaster 的验证码，前台页面输出 <meta name=\"yandex-verification\">", IsPublic: false},
+	{Key: constant.KeySiteVerifySecurityTxt, Value: "", Comment: "/.well-known/security.txt 的内容（RFC 9116），为空时不提供该文件", IsPublic: false},
+	{Key: constant.KeySiteVerifyIndexNowKey, Value: "", Comment: "IndexNow 密钥（8~128 位字母、数字或短横线），设置后以 /{密钥}.txt 提供密钥文件", IsPublic: false},
+	{Key: constant.KeySiteVerifyFiles, Value: "{}", Comment: "其他验证文件，JSON 对象，键为路径，值为文件内容，如 {\"/BingSiteAuth.xml\": \"...\"}。路径只能是根目录下的单个文件或 /.well-known/ 下的文件", IsPublic: false},
+
+	// --- 站点图标配置 ---
+	{Key: constant.KeySiteIconSet, Value: "", Comment: "由一张 Logo 生成的全套站点图标（favicon、apple-touch-icon、manifest 图标），在后台上传 Logo 时自动生成，请勿手动修改", IsPublic: false},
+	{Key: constant.KeySiteIconBackground, Value: "#ffffff", Comment: "生成 apple-touch-icon 和可遮罩图标时透明区域填充的背景色，同时作为 /site.webmanifest 的 background_color", IsPublic: false},
+
+	// --- 外链存档配置 ---
+	{Key: constant.KeyLinkArchiveEnable, Value: "false", Comment: "是否每小时扫描有变更的文章，将其中的外部链接提交到存档服务，文章详情接口通过 archived_links 返回存档地址 (true/false)", IsPublic: false},
+	{Key: constant.KeyLinkArchiveProvider, Value: "wayback", Comment: "存档服务：wayback（Internet Archive Wayback Machine）或 custom（自定义）", IsPublic: false},
+	{Key: constant.KeyLinkArchiveEndpoint, Value: "", Comment: "自定义存档服务地址，{url} 会被替换为编码后的链接，服务应通过跳转或 Content-Location 返回存档地址", IsPublic: false},
+	{Key: constant.KeyLinkArchiveExclude, Value: "", Comment: "不需要存档的域名，逗号分隔，子域名同样排除；本站域名会自动排除", IsPublic: false},
+	{Key: constant.KeyLinkArchiveBatchSize, Value: "20", Comment: "每次任务最多提交的链接数，Wayback Machine 有频率限制，不建议设置过大", IsPublic: false},
+	{Key: constant.KeyLinkArchiveScannedAt, Value: "", Comment: "上次扫描文章的时间（RFC3339），只扫描此后更新过的文章，由系统维护；清空后下次任务重新扫描全部文章", IsPublic: false},
+
+	// --- 自动摘要配置 ---
+	{Key: constant.KeySummaryProvider, Value: "none", Comment: "文章摘要生成方式：none（关闭）、openai（OpenAI 兼容接口）、local（本地模型，如 Ollama、LM Studio 提供的 OpenAI 兼容接口）、extractive（从正文抽取句子，不调用模型）", IsPublic: false},
+	{Key: constant.KeySummaryEndpoint, Value: "", Comment: "接口地址，填写到 /v1 为止，如 https://api.openai.com/v1；local 留空时使用 http://127.0.0.1:11434/v1", IsPublic: false},
+	{Key: constant.KeySummaryAPIKey, Value: "", Comment: "OpenAI 兼容接口的 API Key，本地模型一般无需填写", IsPublic: false},
+	{Key: constant.KeySummaryModel, Value: "", Comment: "模型名称，openai 留空时使用 gpt-4o-mini，local 必须填写", IsPublic: false},
+	{Key: constant.KeySummaryOnSave, Value: "false", Comment: "保存（非草稿）文章且未填写摘要时是否在后台自动生成并写入；文章可在扩展配置中设置 disable_auto_summary 单独关闭 (true/false)", IsPublic: false},
+	{Key: constant.KeySummaryMaxLength, Value: "150", Comment: "生成的摘要最大字数", IsPublic: false},
+	{Key: constant.KeySummaryMaxInputChars, Value: "6000", Comment: "发送给模型的正文最大字数，超出部分截断，用于控制调用费用", IsPublic: false},
+	{Key: constant.KeySummaryHourlyLimit, Value: "30", Comment: "每小时最多调用模型的次数，0 表示不限制；抽取式摘要不计入", IsPublic: false},
+	{Key: constant.KeySummaryFallback, Value: "true", Comment: "模型调用失败或超出每小时次数限制时是否改用抽取式摘要 (true/false)", IsPublic: false},
+
+	// --- 邮件发送配置 ---
+	{Key: constant.KeyMailProvider, Value: "smtp", Comment: "邮件发送方式：smtp（使用 SMTP_* 配置）、sendgrid、mailgun、resend；发件人名称、地址和回信地址统一使用 SMTP_SENDER_NAME、SMTP_SENDER_EMAIL、SMTP_REPLY_TO_EMAIL", IsPublic: false},
+	{Key: constant.KeyMailAPIKey, Value: "", Comment: "SendGrid、Mailgun 或 Resend 的 API Key，使用 SMTP 时无需填写", IsPublic: false},
+	{Key: constant.KeyMailMailgunDomain, Value: "", Comment: "Mailgun 的发信域名，如 mg.example.com", IsPublic: false},
+	{Key: constant.KeyMailMailgunRegion, Value: "us", Comment: "Mailgun 账号所在区域：us 或 eu", IsPublic: false},
+	{Key: constant.KeyMailBrandLayout, Value: "true", Comment: "是否为未使用完整 HTML 文档的邮件正文套用带站点名称、Logo 和主题色的统一外框 (true/false)", IsPublic: false},
+	{Key: constant.KeyMailMaxAttempts, Value: "5", Comment: "通知类邮件发送失败后按 1、5、30 分钟、2、6 小时的间隔重试，达到该次数后不再重试", IsPublic: false},
+
+	// --- 评论服务端渲染配置 ---
+	{Key: constant.KeyCommentSSREnable, Value: "true", Comment: "文章页服务端渲染时是否在 initialData.comments 中注入一页评论，便于搜索引擎收录，其余评论由前端分页加载 (true/false)", IsPublic: true},
+	{Key: constant.KeyCommentSSRPageSize, Value: "10", Comment: "服务端渲染注入的根评论条数，最大 50，可通过 ?comment_page=N 访问后续页", IsPublic: true},
+
+	// --- 评论身份标识配置 ---
+	{Key: constant.KeyCommentVerifiedBadgeLabel, Value: "已验证", Comment: "登录用户（含第三方登录）发表评论时显示的徽章文字，留空则不显示", IsPublic: true},
+	{Key: constant.KeyCommentCustomBadges, Value: "[]", Comment: "自定义评论徽章的JSON数组，每项包含 email（邮箱或邮箱MD5）、label、color，例如 [{\"email\":\"friend@example.com\",\"label\":\"好友\",\"color\":\"#f56c6c\"}]", IsPublic: false},
+	{Key: constant.KeyAvatarProxyEnable, Value: "false", Comment: "是否由本站代理 Gravatar 头像并缓存到本地，启用后评论接口返回的 avatar 指向 /api/avatar/{hash} (true/false)", IsPublic: true},
+	{Key: constant.KeyAvatarProxyCacheHours, Value: "168", Comment: "代理头像的本地缓存时长（小时），上游不可用时继续使用过期缓存", IsPublic: false},
+
+	// --- 第三方登录配置 ---
+	{Key: constant.KeyOAuthProviders, Value: "[]", Comment: "第三方登录提供方的JSON数组，每项包含 id、type（github/google/oidc）、name、client_id、client_secret、issuer（oidc 必填）、scopes、enabled；回调地址为 站点地址/api/auth/oauth/{id}/callback", IsPublic: false},
+	{Key: constant.KeyOAuthAllowRegister, Value: "false", Comment: "第三方账号未绑定且邮箱未注册时，是否使用其已验证邮箱自动创建普通用户账号 (true/false)", IsPublic: false},
+
+	// --- 内容来源 Webhook 配置 ---
+	{Key: constant.KeyWebhookSources, Value: "[]", Comment: "内容来源 Webhook 的JSON数组，每项包含 id、type（github/json）、name、secret（HMAC-SHA256 签名密钥）、enabled、owner_id、default_status、create_tags、create_categories、mapping（字段映射）；github 来源还可填写 repository、branch、path_prefix、token。接收地址为 站点地址/api/webhooks/{id}，文章按 abbrlink 匹配，存在则更新否则创建", IsPublic: false},
+
+	// --- Git 内容同步配置 ---
+	{Key: constant.KeyGitSyncEnable, Value: "false", Comment: "是否启用文章和页面与 Git 仓库的双向同步 (true/false)，需要服务器安装 git；定时拉取的频率在后台任务管理中调整", IsPublic: false},
+	{Key: constant.KeyGitSyncRepoURL, Value: "", Comment: "同步的 Git 仓库地址，支持 HTTPS、SSH（使用服务器上的 SSH 密钥）和本地路径，修改后会重新克隆", IsPublic: false},
+	{Key: constant.KeyGitSyncBranch, Value: "main", Comment: "同步的分支，不存在时在首次推送时创建", IsPublic: false},
+	{Key: constant.KeyGitSyncToken, Value: "", Comment: "HTTPS 仓库的访问令牌（GitHub、Gitea 或 GitLab 个人令牌），SSH 和本地仓库留空", IsPublic: false},
+	{Key: constant.KeyGitSyncPostsDir, Value: "posts", Comment: "仓库中文章 Markdown 文件所在的目录，文件按 abbrlink 命名", IsPublic: false},
+	{Key: constant.KeyGitSyncPagesDir, Value: "pages", Comment: "仓库中自定义页面 Markdown 文件所在的目录，留空则不同步页面", IsPublic: false},
+	{Key: constant.KeyGitSyncPushOnSave, Value: "true", Comment: "在后台保存或删除文章、页面后是否自动同步到仓库 (true/false)", IsPublic: false},
+	{Key: constant.KeyGitSyncMapping, Value: "{}", Comment: "frontmatter 字段名映射的JSON对象，键为内置字段名（title、abbrlink、date、status、tags、categories、cover、keywords、description），值为仓库文件中使用的字段名，例如 {\"abbrlink\":\"slug\"}", IsPublic: false},
+	{Key: constant.KeyGitSyncCommitter, Value: "anheyu <anheyu@localhost>", Comment: "同步提交使用的作者，格式为 名称 <邮箱>", IsPublic: false},
+
+	// --- 登录安全配置 ---
+	{Key: constant.KeyLoginLockEnable, Value: "true", Comment: "是否启用登录失败锁定 (true/false)，同时按账号和 IP 统计失败次数", IsPublic: false},
+	{Key: constant.KeyLoginLockAccountMax, Value: "5", Comment: "统计窗口内单个账号允许的登录失败次数，达到后锁定该账号", IsPublic: false},
+	{Key: constant.KeyLoginLockIPMax, Value: "20", Comment: "统计窗口内单个 IP 允许的登录失败次数，达到后锁定该 IP", IsPublic: false},
+	{Key: constant.KeyLoginLockWindow, Value: "15", Comment: "登录失败次数的统计窗口（分钟）", IsPublic: false},
+	{Key: constant.KeyLoginLockDuration, Value: "15", Comment: "账号或 IP 被锁定的时长（分钟）", IsPublic: false},
+	{Key: constant.KeyLoginCaptchaAfter, Value: "3", Comment: "未启用人机验证时，账号或 IP 登录失败达到该次数后要求填写系统图形验证码，0 表示不启用", IsPublic: false},
+	{Key: constant.KeyLoginNotifyNewDevice, Value: "false", Comment: "用户在新设备（浏览器、系统和登录地区的组合）上登录时是否发送邮件提醒 (true/false)", IsPublic: false},
+
+	// --- 整站备份配置 ---
+	{Key: constant.KeyInstanceBackupEnable, Value: "false", Comment: "是否启用整站定时备份 (true/false)，每天凌晨备份数据库、data 目录和本机上传文件", IsPublic: false},
+	{Key: constant.KeyInstanceBackupKeep, Value: "7", Comment: "本地保留的整站备份数量，超出后删除最旧的备份", IsPublic: false},
+	{Key: constant.KeyInstanceBackupIncludeUploads, Value: "true", Comment: "整站备份是否包含 data/storage 下的本机上传文件 (true/false)", IsPublic: false},
+	{Key: constant.KeyInstanceBackupPassphrase, Value: "", Comment: "整站备份加密口令，填写后备份文件使用 AES-256-GCM 加密，恢复时需提供相同口令；为空则不加密", IsPublic: false},
+	{Key: constant.KeyInstanceBackupPolicyID, Value: "", Comment: "整站备份完成后推送到的存储策略ID，为空则只保存在本地 data/backups 目录", IsPublic: false},
+
+	// --- 精简阅读配置 ---
+	{Key: constant.KeyArticleLiteEnable, Value: "false", Comment: "是否提供文章精简阅读页 /posts/{slug}/lite (true/false)，页面不加载主题资源和脚本，样式内联，适合极慢网络和打印", IsPublic: true},
+
+	// --- 资源本地化配置 ---
+	{Key: constant.KeyResourceLocalizeEnable, Value: "false", Comment: "是否将主题页面中引用的 Google Fonts、jsDelivr 等第三方资源下载到本地，改写为 /needcache/resource/ 下的地址 (true/false)，首次访问时在后台下载，下载完成后生效", IsPublic: false},
+	{Key: constant.KeyResourceLocalizeHosts, Value: "fonts.googleapis.com,fonts.gstatic.com,cdn.jsdelivr.net", Comment: "需要本地化的资源域名，逗号分隔，只有这些域名下的资源会被下载到本地", IsPublic: false},
+
+	// --- 自定义代码片段配置 ---
+	{Key: constant.KeySnippets, Value: "[]", Comment: "自定义 CSS/JS 代码片段的JSON数组，每项包含 id、name、type（css/js）、content、enabled、position（head/body_end）、routes（路由范围，如 /posts/*，为空表示全站）、sort，一般在后台代码片段管理中修改", IsPublic: false},
+	{Key: constant.KeySnippetHistory, Value: "{}", Comment: "代码片段历史版本的JSON对象，键为片段ID，每个片段最多保留 20 个历史版本", IsPublic: false},
+
+	// --- 表态配置 ---
+	{Key: constant.KeyReactionAllowed, Value: "like,👍,❤️,😂,😮,😢,🎉", Comment: "文章和即刻允许的表态，逗号分隔，like 表示点赞", IsPublic: true},
+
+	// --- 站点功能开关 ---
+	// 评论和订阅分别使用 comment.enable 和 post.subscribe.enable 作为开关
+	{Key: constant.KeyFeatureSearch, Value: "true", Comment: "是否启用站内搜索 (true/false)，关闭后搜索接口返回 404", IsPublic: true},
+	{Key: constant.KeyFeatureReactions, Value: "true", Comment: "是否启用文章和即刻的表态 (true/false)，关闭后表态接口返回 404/403", IsPublic: true},
+
+	// --- 文章浏览量配置 ---
+	{Key: constant.KeyArticleViewDebounceMinutes, Value: "30", Comment: "同一访客（IP + User-Agent）在该时间内重复浏览同一篇文章只计一次，单位分钟，0 表示不去重", IsPublic: false},
+
+	// --- 回收站配置 ---
+	{Key: constant.KeyTrashRetentionDays, Value: "30", Comment: "删除的文章、页面和卸载的主题在回收站中保留的天数，超过后每天凌晨自动彻底删除，0 表示不自动清理", IsPublic: false},
+
+	// --- 文章自定义字段配置 ---
+	{Key: constant.KeyPostCustomFieldSchema, Value: "[]", Comment: "文章自定义字段声明的JSON数组，每项包含 key、type（string/number/bool/json）、label、description、required、default；为空时文章可以保存任意字段，声明后只接受已声明的字段并校验类型", IsPublic: true},
+
+	// --- 首页布局配置 ---
+	{Key: constant.KeyHomeLayout, Value: "", Comment: "首页布局JSON，包含 featured_ids（精选文章ID或abbrlink）和 sections（区块列表，type 可选 featured、pinned、recent、category、tag），为空时使用默认布局", IsPublic: false},
+
+	// --- 文章作者配置 ---
+	{Key: constant.KeyAuthorProfiles, Value: "[]", Comment: "作者资料的JSON数组，每项包含 slug、name、bio、avatar、website、socials、user_id（关联用户的公共ID），文章在 extra_config.authors 中按 slug 关联共同作者", IsPublic: false},
+
+	// --- 可信代理配置 ---
+	{Key: constant.KeyTrustedProxies, Value: "", Comment: "可信反向代理的IP或CIDR，逗号分隔，如 127.0.0.1,172.17.0.0/16；只有来自这些地址的请求才会读取 X-Forwarded-For、X-Real-IP、X-Forwarded-Proto 及 CDN 真实IP头。留空时仅信任本机和内网地址，通过 Unix 域套接字接入的请求按 127.0.0.1 处理，修改后需重启生效", IsPublic: false},
+
+	// --- 页面缓存策略配置 ---
+	{Key: constant.KeyPageCacheEnable, Value: "false", Comment: "是否为前台HTML页面启用缓存策略 (true/false)，关闭时页面始终不缓存", IsPublic: false},
+	{Key: constant.KeyPageCacheCDNDetect, Value: "true", Comment: "是否识别CDN回源请求并下发 s-maxage (true/false)，关闭时所有请求都按CDN策略处理", IsPublic: false},
+	{Key: constant.KeyPageCacheArticleMaxAge, Value: "180", Comment: "文章详情页浏览器缓存时间（秒）", IsPublic: false},
+	{Key: constant.KeyPageCacheArticleSMaxAge, Value: "60", Comment: "文章详情页CDN缓存时间（秒）", IsPublic: false},
+	{Key: constant.KeyPageCacheArticleSWR, Value: "60", Comment: "文章详情页 stale-while-revalidate 时间（秒）", IsPublic: false},
+	{Key: constant.KeyPageCacheHomeMaxAge, Value: "300", Comment: "首页浏览器缓存时间（秒）", IsPublic: false},
+	{Key: constant.KeyPageCacheHomeSMaxAge, Value: "120", Comment: "首页CDN缓存时间（秒）", IsPublic: false},
+	{Key: constant.KeyPageCacheHomeSWR, Value: "30", Comment: "首页 stale-while-revalidate 时间（秒）", IsPublic: false},
+	{Key: constant.KeyPageCacheStaticPageMaxAge, Value: "1800", Comment: "自定义页面浏览器缓存时间（秒）", IsPublic: false},
+	{Key: constant.KeyPageCacheStaticPageSMaxAge, Value: "600", Comment: "自定义页面CDN缓存时间（秒）", IsPublic: false},
+	{Key: constant.KeyPageCacheStaticPageSWR, Value: "120", Comment: "自定义页面 stale-while-revalidate 时间（秒）", IsPublic: false},
+	{Key: constant.KeyPageCacheDefaultMaxAge, Value: "180", Comment: "其他页面浏览器缓存时间（秒）", IsPublic: false},
+	{Key: constant.KeyPageCacheDefaultSMaxAge, Value: "60", Comment: "其他页面CDN缓存时间（秒）", IsPublic: false},
+	{Key: constant.KeyPageCacheDefaultSWR, Value: "30", Comment: "其他页面 stale-while-revalidate 时间（秒）", IsPublic: false},
+
 	// --- 相册页面配置 ---
 	{Key: constant.KeyAlbumPageBannerBackground, Value: "", Comment: "相册页面横幅背景图/视频URL", IsPublic: true},
 	{Key: constant.KeyAlbumPageBannerTitle, Value: "相册", Comment: "相册页面横幅标题", IsPublic: true},
@@ -489,6 +699,13 @@ var AllSettings = []Definition{
 	{Key: constant.KeyWechatShareAppID, Value: "", Comment: "微信公众号 AppID", IsPublic: true},
 	{Key: constant.KeyWechatShareAppSecret, Value: "", Comment: "微信公众号 AppSecret（用于生成 JS-SDK 签名）", IsPublic: false},
 
+	// --- 微信文章二维码配置 ---
+	{Key: constant.KeyWechatQRCodeEnable, Value: "false", Comment: "是否启用文章微信二维码 (true/false)，启用后可通过 /api/public/wechat/qrcode?slug= 获取", IsPublic: true},
+	{Key: constant.KeyWechatQRCodeType, Value: "official", Comment: "文章二维码类型：miniprogram-小程序码（需配置小程序），official-公众号二维码（使用微信分享的公众号配置）", IsPublic: false},
+	{Key: constant.KeyWechatMiniProgramAppID, Value: "", Comment: "微信小程序 AppID（用于生成小程序码）", IsPublic: false},
+	{Key: constant.KeyWechatMiniProgramAppSecret, Value: "", Comment: "微信小程序 AppSecret", IsPublic: false},
+	{Key: constant.KeyWechatMiniProgramArticlePage, Value: "pages/article/index", Comment: "小程序中打开文章的页面路径，页面通过 scene 参数获取文章ID", IsPublic: false},
+
 	// --- Cloudflare Turnstile 人机验证配置 ---
 	{Key: constant.KeyTurnstileEnable, Value: "false", Comment: "是否启用 Cloudflare Turnstile 人机验证 (true/false)，已废弃，请使用 captcha.provider", IsPublic: true},
 	{Key: constant.KeyTurnstileSiteKey, Value: "", Comment: "Turnstile Site Key（公钥，前端使用，从 Cloudflare 控制台获取）", IsPublic: true},
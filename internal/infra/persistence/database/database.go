@@ -18,7 +18,6 @@ import (
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
-	"github.com/anzhiyu-c/anheyu-app/ent/migrate"
 	"github.com/anzhiyu-c/anheyu-app/pkg/config"
 
 	"entgo.io/ent/dialect"
@@ -78,8 +77,8 @@ func NewSQLDB(cfg *config.Config) (*sql.DB, error) {
 		finalPath := filepath.Join(dataDir, finalDbName)
 		log.Printf("【提示】SQLite 数据库路径: %s\n", finalPath)
 
-		// 使用 file: DSN 格式并启用外键约束
-		dsn = fmt.Sprintf("file:%s?_fk=1&cache=shared", finalPath)
+		// 使用 file: DSN 格式并启用外键约束，每个连接建立时执行调优 PRAGMA
+		dsn = fmt.Sprintf("file:%s?_fk=1&cache=shared&%s", finalPath, sqlitePragmas(cfg))
 	default:
 		return nil, fmt.Errorf("不支持的数据库驱动: %s (支持: mysql/mariadb, postgres, sqlite)", driver)
 	}
@@ -90,9 +89,7 @@ func NewSQLDB(cfg *config.Config) (*sql.DB, error) {
 	}
 
 	// 设置连接池参数
-	db.SetMaxIdleConns(10)
-	db.SetMaxOpenConns(100)
-	db.SetConnMaxLifetime(time.Hour)
+	applyPoolConfig(db, cfg)
 
 	// 验证数据库连接
 	if err := db.Ping(); err != nil {
@@ -101,11 +98,79 @@ func NewSQLDB(cfg *config.Config) (*sql.DB, error) {
 	}
 
 	log.Printf("✅ %s 数据库连接池创建成功！\n", strings.Title(driver))
+	registerPool(db)
 	return db, nil
 }
 
-// NewEntClient 根据配置创建并返回一个 Ent ORM 客户端。
-func NewEntClient(db *sql.DB, cfg *config.Config) (*ent.Client, error) {
+// applyPoolConfig 按配置设置连接池参数，未配置或配置无效时使用默认值
+func applyPoolConfig(db *sql.DB, cfg *config.Config) {
+	maxOpen := cfg.GetInt(config.KeyDBMaxOpenConns)
+	if maxOpen <= 0 {
+		maxOpen = defaultMaxOpenConns
+	}
+	maxIdle := cfg.GetInt(config.KeyDBMaxIdleConns)
+	if maxIdle <= 0 {
+		maxIdle = defaultMaxIdleConns
+	}
+	if maxIdle > maxOpen {
+		maxIdle = maxOpen
+	}
+	lifetime := defaultConnMaxLifetime
+	if seconds := cfg.GetInt(config.KeyDBConnMaxLifetime); seconds > 0 {
+		lifetime = time.Duration(seconds) * time.Second
+	}
+	idleTime := time.Duration(cfg.GetInt(config.KeyDBConnMaxIdleTime)) * time.Second
+
+	db.SetMaxOpenConns(maxOpen)
+	db.SetMaxIdleConns(maxIdle)
+	db.SetConnMaxLifetime(lifetime)
+	if idleTime > 0 {
+		db.SetConnMaxIdleTime(idleTime)
+	}
+	poolMu.Lock()
+	defer poolMu.Unlock()
+	poolSettings = PoolSettings{
+		MaxOpenConns:           maxOpen,
+		MaxIdleConns:           maxIdle,
+		ConnMaxLifetimeSeconds: int(lifetime / time.Second),
+		ConnMaxIdleTimeSeconds: int(idleTime / time.Second),
+	}
+}
+
+// sqlitePragmas 根据配置生成 SQLite 连接的 _pragma 参数，配置值无效时使用默认值
+func sqlitePragmas(cfg *config.Config) string {
+	journalMode := strings.ToLower(cfg.GetString(config.KeyDBSQLiteJournalMode))
+	switch journalMode {
+	case "delete", "truncate", "persist", "memory", "wal", "off":
+	default:
+		if journalMode != "" {
+			log.Printf("警告: 无法识别的 %s %q，将使用 wal", config.KeyDBSQLiteJournalMode, journalMode)
+		}
+		journalMode = "wal"
+	}
+
+	synchronous := strings.ToLower(cfg.GetString(config.KeyDBSQLiteSynchronous))
+	switch synchronous {
+	case "off", "normal", "full", "extra":
+	default:
+		if synchronous != "" {
+			log.Printf("警告: 无法识别的 %s %q，将使用 normal", config.KeyDBSQLiteSynchronous, synchronous)
+		}
+		synchronous = "normal"
+	}
+
+	busyTimeout := cfg.GetInt(config.KeyDBSQLiteBusyTimeout)
+	if busyTimeout <= 0 {
+		busyTimeout = 60000
+	}
+
+	log.Printf("【提示】SQLite 调优: journal_mode=%s, synchronous=%s, busy_timeout=%dms", journalMode, synchronous, busyTimeout)
+	return fmt.Sprintf("_pragma=busy_timeout(%d)&_pragma=journal_mode(%s)&_pragma=synchronous(%s)",
+		busyTimeout, journalMode, synchronous)
+}
+
+// OpenEntClient 根据配置创建 Ent ORM 客户端，不执行表结构迁移。
+func OpenEntClient(db *sql.DB, cfg *config.Config) (*ent.Client, error) {
 	// *FIXED*: 使用 KeyDBType 来获取数据库类型，以匹配 conf.ini 的配置
 	driverName := cfg.GetString(config.KeyDBType)
 	if driverName == "" {
@@ -126,8 +191,8 @@ func NewEntClient(db *sql.DB, cfg *config.Config) (*ent.Client, error) {
 
 	var entOptions []ent.Option
 
-	// 1. 始终添加 Driver 选项
-	entOptions = append(entOptions, ent.Driver(drv))
+	// 1. 始终添加 Driver 选项（包装一层以统计每个请求的查询次数，并在数据库短暂故障时重试）
+	entOptions = append(entOptions, ent.Driver(&tracingDriver{Driver: &retryDriver{Driver: drv}}))
 
 	// 2. 根据配置决定是否添加 Debug 选项
 	if cfg.GetBool(config.KeyDBDebug) {
@@ -136,15 +201,40 @@ func NewEntClient(db *sql.DB, cfg *config.Config) (*ent.Client, error) {
 	}
 
 	// 使用所有收集到的选项创建客户端
-	client := ent.NewClient(entOptions...)
+	return ent.NewClient(entOptions...), nil
+}
+
+// NewEntClient 根据配置创建并返回一个 Ent ORM 客户端，并在启动时自动迁移数据库结构。
+func NewEntClient(db *sql.DB, cfg *config.Config) (*ent.Client, error) {
+	client, err := OpenEntClient(db, cfg)
+	if err != nil {
+		return nil, err
+	}
+	driverName := cfg.GetString(config.KeyDBType)
+	if driverName == "" {
+		driverName = "sqlite"
+	}
+
+	// 只读模式下（例如连接只读副本）不修改表结构，也不执行数据迁移，只提示待执行的变更
+	if cfg.GetBool(config.KeyReadOnly) {
+		manager := NewMigrationManager(db, client, cfg)
+		if err := manager.CheckDowngrade(context.Background()); err != nil && !cfg.GetBool(config.KeyDBAllowDowngrade) {
+			return nil, err
+		}
+		if statements, err := manager.Plan(context.Background()); err != nil {
+			log.Printf("⚠️ 只读模式，跳过数据库迁移，生成迁移预览失败: %v", err)
+		} else if len(statements) > 0 {
+			log.Printf("⚠️ 只读模式，跳过 %d 条待执行的表结构变更，关闭只读模式并重启后执行", len(statements))
+		} else {
+			log.Println("只读模式，跳过数据库迁移")
+		}
+		return client, nil
+	}
 
-	// 在启动时自动迁移数据库结构
+	// 在启动时自动迁移数据库结构，有变更时先导出快照并记录迁移版本
 	log.Println("⚡ 开始数据库表结构迁移...")
-	if err := client.Schema.Create(context.Background(),
-		migrate.WithDropIndex(true),  // 允许删除旧索引（包括唯一约束）
-		migrate.WithDropColumn(true), // 允许删除旧列
-	); err != nil {
-		return nil, fmt.Errorf("数据库迁移失败: %w", err)
+	if err := NewMigrationManager(db, client, cfg).Apply(context.Background()); err != nil {
+		return nil, err
 	}
 	log.Println("✅ 数据库表结构迁移成功")
 
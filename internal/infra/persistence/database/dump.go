@@ -0,0 +1,102 @@
+/*
+ * @Description: 导出数据库副本，供整站备份和迁移前快照使用
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/ncruces/go-sqlite3"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+)
+
+// NormalizeDBType 返回归一化后的数据库类型：sqlite、mysql 或 postgres
+func NormalizeDBType(dbType string) string {
+	switch t := strings.ToLower(dbType); t {
+	case "mariadb":
+		return "mysql"
+	case "sqlite3", "":
+		return "sqlite"
+	default:
+		return t
+	}
+}
+
+// DumpDatabase 将当前数据库导出到 dir，返回导出文件的路径。
+// SQLite 通过 VACUUM INTO 生成一致的数据库副本，PostgreSQL 和 MySQL 调用 pg_dump、mysqldump 导出 SQL
+func DumpDatabase(ctx context.Context, db *sql.DB, cfg *config.Config, dir string) (string, error) {
+	switch dbType := NormalizeDBType(cfg.GetString(config.KeyDBType)); dbType {
+	case "sqlite":
+		out := filepath.Join(dir, "sqlite.db")
+		// VACUUM INTO 在不阻塞写入的情况下生成一致的数据库副本。
+		// 驱动为可取消的上下文注册了中断检查，VACUUM 会因此报 "SQL statements in progress"，这里去掉取消信号
+		if _, err := db.ExecContext(context.WithoutCancel(ctx), "VACUUM INTO ?", out); err != nil {
+			return "", fmt.Errorf("导出 SQLite 数据库失败: %w", err)
+		}
+		return out, nil
+	case "postgres":
+		out := filepath.Join(dir, "postgres.sql")
+		cmd := exec.CommandContext(ctx, "pg_dump", "--clean", "--if-exists", "--no-owner",
+			"-h", cfg.GetString(config.KeyDBHost), "-p", cfg.GetString(config.KeyDBPort),
+			"-U", cfg.GetString(config.KeyDBUser), "-f", out, cfg.GetString(config.KeyDBName))
+		cmd.Env = append(os.Environ(), "PGPASSWORD="+cfg.GetString(config.KeyDBPassword))
+		if err := RunClientTool(cmd); err != nil {
+			return "", err
+		}
+		return out, nil
+	case "mysql":
+		out := filepath.Join(dir, "mysql.sql")
+		cmd := exec.CommandContext(ctx, "mysqldump", "--single-transaction", "--routines", "--add-drop-table",
+			"-h", cfg.GetString(config.KeyDBHost), "-P", cfg.GetString(config.KeyDBPort),
+			"-u", cfg.GetString(config.KeyDBUser), "--result-file="+out, cfg.GetString(config.KeyDBName))
+		cmd.Env = append(os.Environ(), "MYSQL_PWD="+cfg.GetString(config.KeyDBPassword))
+		if err := RunClientTool(cmd); err != nil {
+			return "", err
+		}
+		return out, nil
+	default:
+		return "", fmt.Errorf("不支持导出的数据库类型: %s", dbType)
+	}
+}
+
+// BackupSQLite 通过 SQLite 在线备份接口将数据库完整复制到 dst。
+// 复制在一个读事务中完成，得到的是开始时刻的一致快照；WAL 模式下期间的写入不受阻塞
+func BackupSQLite(ctx context.Context, db *sql.DB, dst string) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("获取数据库连接失败: %w", err)
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		raw, ok := driverConn.(interface{ Raw() *sqlite3.Conn })
+		if !ok {
+			return fmt.Errorf("当前数据库驱动不支持 SQLite 在线备份")
+		}
+		if err := raw.Raw().Backup("main", dst); err != nil {
+			return fmt.Errorf("SQLite 在线备份失败: %w", err)
+		}
+		return nil
+	})
+}
+
+// RunClientTool 执行数据库客户端工具，失败时附带其错误输出
+func RunClientTool(cmd *exec.Cmd) error {
+	if _, err := exec.LookPath(cmd.Args[0]); err != nil {
+		return fmt.Errorf("未找到 %s，请先安装数据库客户端工具", cmd.Args[0])
+	}
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s 执行失败: %w: %s", cmd.Args[0], err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
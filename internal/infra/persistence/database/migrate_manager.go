@@ -0,0 +1,319 @@
+/*
+ * @Description: 表结构迁移管理，迁移前快照、预览变更、记录迁移版本并阻止降级启动
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 启动时先对比 Ent 定义的表结构与数据库，生成待执行的 SQL。有变更时先把数据库导出到
+ * data/backup/migrations，再执行迁移，并在 schema_migrations 表中记录结构版本和程序版本。
+ * 结构版本是 Ent 表定义的摘要，程序版本取自构建信息。数据库最近一次由更高版本的程序迁移过时拒绝启动，
+ * 避免旧程序按旧的表定义删除新版本添加的字段。
+ */
+package database
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/migrate"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+)
+
+const (
+	// migrationTable 记录已应用迁移的表，由迁移管理器自行维护，不属于 Ent 表定义
+	migrationTable = "schema_migrations"
+	// migrationBackupDir 迁移前数据库快照的保存目录
+	migrationBackupDir = "data/backup/migrations"
+	// migrationBackupKeep 保留的迁移前快照数量
+	migrationBackupKeep = 5
+)
+
+// ErrDowngrade 数据库已被更高版本的程序迁移过
+var ErrDowngrade = errors.New("数据库已被更高版本的程序迁移，拒绝降级启动")
+
+// AppliedMigration 一次已应用的迁移记录
+type AppliedMigration struct {
+	SchemaVersion string    `json:"schema_version"`
+	AppVersion    string    `json:"app_version"`
+	Statements    int       `json:"statements"`
+	Backup        string    `json:"backup,omitempty"`
+	AppliedAt     time.Time `json:"applied_at"`
+}
+
+// MigrationManager 表结构迁移管理器
+type MigrationManager struct {
+	db     *sql.DB
+	client *ent.Client
+	cfg    *config.Config
+	dbType string
+}
+
+// NewMigrationManager 创建表结构迁移管理器
+func NewMigrationManager(db *sql.DB, client *ent.Client, cfg *config.Config) *MigrationManager {
+	return &MigrationManager{
+		db:     db,
+		client: client,
+		cfg:    cfg,
+		dbType: NormalizeDBType(cfg.GetString(config.KeyDBType)),
+	}
+}
+
+// migrateOptions 与启动时自动迁移一致的选项
+func migrateOptions() []schema.MigrateOption {
+	return []schema.MigrateOption{
+		migrate.WithDropIndex(true),  // 允许删除旧索引（包括唯一约束）
+		migrate.WithDropColumn(true), // 允许删除旧列
+	}
+}
+
+// SchemaVersion 当前程序中 Ent 表定义的摘要，表、列、索引或外键变化时随之改变
+func SchemaVersion() string {
+	var b strings.Builder
+	tables := append([]*schema.Table(nil), migrate.Tables...)
+	sort.Slice(tables, func(i, j int) bool { return tables[i].Name < tables[j].Name })
+	for _, t := range tables {
+		fmt.Fprintf(&b, "table %s\n", t.Name)
+		for _, c := range t.Columns {
+			fmt.Fprintf(&b, "  column %s %s size=%d null=%t unique=%t default=%v types=%v\n",
+				c.Name, c.Type, c.Size, c.Nullable, c.Unique, c.Default, c.SchemaType)
+		}
+		for _, idx := range t.Indexes {
+			cols := make([]string, len(idx.Columns))
+			for i, c := range idx.Columns {
+				cols[i] = c.Name
+			}
+			fmt.Fprintf(&b, "  index %s unique=%t (%s)\n", idx.Name, idx.Unique, strings.Join(cols, ","))
+		}
+		for _, fk := range t.ForeignKeys {
+			cols := make([]string, len(fk.Columns))
+			for i, c := range fk.Columns {
+				cols[i] = c.Name
+			}
+			fmt.Fprintf(&b, "  fk %s (%s) -> %s on_delete=%s\n", fk.Symbol, strings.Join(cols, ","), fk.RefTable.Name, fk.OnDelete)
+		}
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// Plan 预览迁移：返回将要执行的 SQL 语句，不修改数据库。表结构已是最新时返回空列表
+func (m *MigrationManager) Plan(ctx context.Context) ([]string, error) {
+	var buf bytes.Buffer
+	if err := m.client.Schema.WriteTo(ctx, &buf, migrateOptions()...); err != nil {
+		return nil, fmt.Errorf("生成迁移预览失败: %w", err)
+	}
+	statements := make([]string, 0)
+	for _, line := range strings.Split(buf.String(), "\n") {
+		line = strings.TrimSpace(line)
+		// 事务语句和 SQLite 迁移时切换外键检查的 PRAGMA 不属于结构变更
+		upper := strings.ToUpper(line)
+		if upper == "" || upper == "BEGIN;" || upper == "COMMIT;" || strings.HasPrefix(upper, "PRAGMA ") {
+			continue
+		}
+		statements = append(statements, line)
+	}
+	return statements, nil
+}
+
+// History 返回已应用的迁移记录，最近的在前
+func (m *MigrationManager) History(ctx context.Context) ([]AppliedMigration, error) {
+	if exists, err := m.tableExists(ctx, migrationTable); err != nil || !exists {
+		return nil, err
+	}
+	rows, err := m.db.QueryContext(ctx, fmt.Sprintf(
+		"SELECT schema_version, app_version, statements, backup, applied_at FROM %s ORDER BY applied_at DESC", migrationTable))
+	if err != nil {
+		return nil, fmt.Errorf("查询迁移记录失败: %w", err)
+	}
+	defer rows.Close()
+
+	var history []AppliedMigration
+	for rows.Next() {
+		var rec AppliedMigration
+		var appliedAt int64
+		if err := rows.Scan(&rec.SchemaVersion, &rec.AppVersion, &rec.Statements, &rec.Backup, &appliedAt); err != nil {
+			return nil, fmt.Errorf("读取迁移记录失败: %w", err)
+		}
+		rec.AppliedAt = time.UnixMilli(appliedAt)
+		history = append(history, rec)
+	}
+	return history, rows.Err()
+}
+
+// CheckDowngrade 检查数据库是否已被更高版本的程序迁移过，不修改数据库
+func (m *MigrationManager) CheckDowngrade(ctx context.Context) error {
+	history, err := m.History(ctx)
+	if err != nil {
+		return err
+	}
+	return checkDowngrade(history, version.GetVersion(), SchemaVersion())
+}
+
+// Apply 检查降级、导出快照后执行迁移并记录版本
+func (m *MigrationManager) Apply(ctx context.Context) error {
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+	history, err := m.History(ctx)
+	if err != nil {
+		return err
+	}
+	appVersion, schemaVersion := version.GetVersion(), SchemaVersion()
+	if err := checkDowngrade(history, appVersion, schemaVersion); err != nil {
+		if !m.cfg.GetBool(config.KeyDBAllowDowngrade) {
+			return err
+		}
+		log.Printf("⚠️ %v，已按 %s 配置继续启动", err, config.KeyDBAllowDowngrade)
+	}
+
+	statements, err := m.Plan(ctx)
+	if err != nil {
+		return err
+	}
+	rec := AppliedMigration{SchemaVersion: schemaVersion, AppVersion: appVersion, Statements: len(statements)}
+
+	if len(statements) > 0 {
+		log.Printf("⚡ 检测到 %d 条表结构变更，结构版本 %s", len(statements), schemaVersion)
+		// 数据库中还没有 Ent 管理的表（全新安装）时无需快照
+		existing, err := m.tableExists(ctx, migrate.UsersTable.Name)
+		if err != nil {
+			return err
+		}
+		if existing && !m.cfg.GetBool(config.KeyDBSkipMigrateBackup) {
+			if rec.Backup, err = m.snapshot(ctx, schemaVersion); err != nil {
+				return fmt.Errorf("迁移前备份数据库失败，已取消迁移: %w（确认无需备份时可设置 %s = true）",
+					err, config.KeyDBSkipMigrateBackup)
+			}
+			log.Printf("✅ 迁移前数据库快照已保存到 %s", rec.Backup)
+		}
+		if err := m.client.Schema.Create(ctx, migrateOptions()...); err != nil {
+			if rec.Backup != "" {
+				return fmt.Errorf("数据库迁移失败，可从 %s 恢复: %w", rec.Backup, err)
+			}
+			return fmt.Errorf("数据库迁移失败: %w", err)
+		}
+	}
+
+	// 表结构没有变化时不记录新的程序版本，否则只升级程序、未改表结构的版本也会阻止回退到旧版本
+	if len(history) > 0 && len(statements) == 0 {
+		return nil
+	}
+	return m.record(ctx, rec)
+}
+
+// checkDowngrade 最近一次迁移由更高版本的程序执行时返回 ErrDowngrade。
+// 版本号无法比较（例如开发构建）时，当前结构版本只出现在更早的记录中也视为降级
+func checkDowngrade(history []AppliedMigration, appVersion, schemaVersion string) error {
+	if len(history) == 0 {
+		return nil
+	}
+	latest := history[0]
+	if cmp, ok := version.Compare(latest.AppVersion, appVersion); ok {
+		if cmp > 0 {
+			return fmt.Errorf("%w：当前程序版本 %s，数据库最近一次由 %s 迁移（结构版本 %s）。请升级程序，或从迁移前的快照恢复后再启动",
+				ErrDowngrade, appVersion, latest.AppVersion, latest.SchemaVersion)
+		}
+		return nil
+	}
+	if latest.SchemaVersion == schemaVersion {
+		return nil
+	}
+	for _, rec := range history[1:] {
+		if rec.SchemaVersion == schemaVersion {
+			return fmt.Errorf("%w：当前程序的结构版本 %s 早于数据库最近一次迁移的结构版本 %s（%s）。请升级程序，或从迁移前的快照恢复后再启动",
+				ErrDowngrade, schemaVersion, latest.SchemaVersion, latest.AppliedAt.Format(time.DateTime))
+		}
+	}
+	return nil
+}
+
+// ensureTable 创建迁移记录表
+func (m *MigrationManager) ensureTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		schema_version VARCHAR(64) NOT NULL,
+		app_version VARCHAR(64) NOT NULL,
+		statements INTEGER NOT NULL DEFAULT 0,
+		backup VARCHAR(512) NOT NULL DEFAULT '',
+		applied_at BIGINT NOT NULL
+	)`, migrationTable))
+	if err != nil {
+		return fmt.Errorf("创建迁移记录表失败: %w", err)
+	}
+	return nil
+}
+
+// record 写入一条迁移记录
+func (m *MigrationManager) record(ctx context.Context, rec AppliedMigration) error {
+	query := fmt.Sprintf("INSERT INTO %s (schema_version, app_version, statements, backup, applied_at) VALUES (?, ?, ?, ?, ?)", migrationTable)
+	if m.dbType == "postgres" {
+		query = fmt.Sprintf("INSERT INTO %s (schema_version, app_version, statements, backup, applied_at) VALUES ($1, $2, $3, $4, $5)", migrationTable)
+	}
+	if _, err := m.db.ExecContext(ctx, query, rec.SchemaVersion, rec.AppVersion, rec.Statements, rec.Backup, time.Now().UnixMilli()); err != nil {
+		return fmt.Errorf("记录迁移版本失败: %w", err)
+	}
+	return nil
+}
+
+// tableExists 检查数据库中是否存在指定的表
+func (m *MigrationManager) tableExists(ctx context.Context, name string) (bool, error) {
+	var query string
+	switch m.dbType {
+	case "sqlite":
+		query = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	case "postgres":
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1"
+	case "mysql":
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?"
+	default:
+		return false, fmt.Errorf("不支持的数据库类型: %s", m.dbType)
+	}
+	var count int
+	if err := m.db.QueryRowContext(ctx, query, name).Scan(&count); err != nil {
+		return false, fmt.Errorf("检查数据库表失败: %w", err)
+	}
+	return count > 0, nil
+}
+
+// snapshot 导出迁移前的数据库快照，文件名包含时间和即将应用的结构版本，只保留最近几份
+func (m *MigrationManager) snapshot(ctx context.Context, schemaVersion string) (string, error) {
+	if err := os.MkdirAll(migrationBackupDir, 0755); err != nil {
+		return "", err
+	}
+	workDir, err := os.MkdirTemp(migrationBackupDir, "snapshot-*")
+	if err != nil {
+		return "", fmt.Errorf("创建临时目录失败: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	dumped, err := DumpDatabase(ctx, m.db, m.cfg, workDir)
+	if err != nil {
+		return "", err
+	}
+	target := filepath.Join(migrationBackupDir, fmt.Sprintf("pre-migrate-%s-%s%s",
+		time.Now().Format("20060102-150405"), schemaVersion, filepath.Ext(dumped)))
+	if err := os.Rename(dumped, target); err != nil {
+		return "", fmt.Errorf("保存数据库快照失败: %w", err)
+	}
+
+	if matches, _ := filepath.Glob(filepath.Join(migrationBackupDir, "pre-migrate-*")); len(matches) > migrationBackupKeep {
+		sort.Strings(matches)
+		for _, old := range matches[:len(matches)-migrationBackupKeep] {
+			os.Remove(old)
+		}
+	}
+	return target, nil
+}
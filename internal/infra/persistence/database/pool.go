@@ -0,0 +1,96 @@
+/*
+ * @Description: 数据库连接池配置与运行指标
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package database
+
+import (
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultMaxOpenConns    = 100
+	defaultMaxIdleConns    = 10
+	defaultConnMaxLifetime = time.Hour
+)
+
+var (
+	poolMu       sync.RWMutex
+	pool         *sql.DB
+	poolSettings PoolSettings
+
+	retryAttempts  atomic.Int64
+	retrySucceeded atomic.Int64
+	retryExhausted atomic.Int64
+)
+
+// PoolSettings 生效的连接池参数
+type PoolSettings struct {
+	MaxOpenConns           int `json:"max_open_conns"`
+	MaxIdleConns           int `json:"max_idle_conns"`
+	ConnMaxLifetimeSeconds int `json:"conn_max_lifetime_seconds"`
+	ConnMaxIdleTimeSeconds int `json:"conn_max_idle_time_seconds"`
+}
+
+// PoolStats 连接池运行指标
+type PoolStats struct {
+	Settings PoolSettings `json:"settings"`
+
+	OpenConnections int     `json:"open_connections"`
+	InUse           int     `json:"in_use"`
+	Idle            int     `json:"idle"`
+	Utilization     float64 `json:"utilization"` // 使用中的连接占最大连接数的比例
+
+	WaitCount         int64 `json:"wait_count"`
+	WaitDurationMs    int64 `json:"wait_duration_ms"`
+	MaxIdleClosed     int64 `json:"max_idle_closed"`
+	MaxIdleTimeClosed int64 `json:"max_idle_time_closed"`
+	MaxLifetimeClosed int64 `json:"max_lifetime_closed"`
+
+	// 瞬时错误重试统计
+	RetryAttempts  int64 `json:"retry_attempts"`  // 发起的重试次数
+	RetrySucceeded int64 `json:"retry_succeeded"` // 重试后成功的调用数
+	RetryExhausted int64 `json:"retry_exhausted"` // 重试用尽仍失败的调用数
+}
+
+// registerPool 记录应用使用的连接池，供 PoolMetrics 读取
+func registerPool(db *sql.DB) {
+	poolMu.Lock()
+	defer poolMu.Unlock()
+	pool = db
+}
+
+// PoolMetrics 返回连接池当前的运行指标，连接池尚未创建时只包含重试统计
+func PoolMetrics() PoolStats {
+	poolMu.RLock()
+	db, settings := pool, poolSettings
+	poolMu.RUnlock()
+
+	stats := PoolStats{
+		Settings:       settings,
+		RetryAttempts:  retryAttempts.Load(),
+		RetrySucceeded: retrySucceeded.Load(),
+		RetryExhausted: retryExhausted.Load(),
+	}
+	if db == nil {
+		return stats
+	}
+
+	s := db.Stats()
+	stats.OpenConnections = s.OpenConnections
+	stats.InUse = s.InUse
+	stats.Idle = s.Idle
+	stats.WaitCount = s.WaitCount
+	stats.WaitDurationMs = s.WaitDuration.Milliseconds()
+	stats.MaxIdleClosed = s.MaxIdleClosed
+	stats.MaxIdleTimeClosed = s.MaxIdleTimeClosed
+	stats.MaxLifetimeClosed = s.MaxLifetimeClosed
+	if s.MaxOpenConnections > 0 {
+		stats.Utilization = float64(s.InUse) / float64(s.MaxOpenConnections)
+	}
+	return stats
+}
@@ -0,0 +1,159 @@
+/*
+ * @Description: 数据库瞬时错误重试
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * PostgreSQL、MySQL 主从切换或代理重启时，连接会在短时间内被拒绝或断开，
+ * 这里在驱动层重试事务外的单条语句和开启事务，避免这类故障直接变成接口 500。
+ * 查询语句遇到瞬时错误都会重试；写入语句只在确定未执行时重试（如连接被拒绝、死锁回滚），
+ * 连接中途断开时无法得知写入是否已生效，直接返回错误，避免重复写入。
+ * 事务内的语句不重试，由调用方决定是否重新执行整个事务。
+ */
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+)
+
+const (
+	// retryMaxAttempts 单次调用最多执行的次数（含首次）
+	retryMaxAttempts = 3
+	// retryBaseDelay 首次重试前的等待时间，之后每次翻倍
+	retryBaseDelay = 200 * time.Millisecond
+)
+
+// retryDriver 在事务外遇到瞬时错误时重试
+type retryDriver struct {
+	dialect.Driver
+}
+
+func (d *retryDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return withRetry(ctx, isReadQuery(query), func() error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+func (d *retryDriver) Query(ctx context.Context, query string, args, v any) error {
+	return withRetry(ctx, isReadQuery(query), func() error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+func (d *retryDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	var tx dialect.Tx
+	// 开启事务本身不会修改数据，按只读处理
+	err := withRetry(ctx, true, func() error {
+		var err error
+		tx, err = d.Driver.Tx(ctx)
+		return err
+	})
+	return tx, err
+}
+
+// withRetry 执行 fn，遇到可重试的错误时按指数退避重试
+func withRetry(ctx context.Context, readOnly bool, fn func() error) error {
+	delay := retryBaseDelay
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			if attempt > 1 {
+				retrySucceeded.Add(1)
+			}
+			return nil
+		}
+		if !isRetryable(err, readOnly) || ctx.Err() != nil {
+			return err
+		}
+		if attempt >= retryMaxAttempts {
+			retryExhausted.Add(1)
+			log.Printf("【数据库】瞬时错误重试 %d 次后仍失败: %v", attempt-1, err)
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		retryAttempts.Add(1)
+		delay *= 2
+	}
+}
+
+// isReadQuery 判断语句是否只读，只读语句在连接中断后重试是安全的
+func isReadQuery(query string) bool {
+	q := strings.TrimLeft(query, " \t\r\n(")
+	return hasPrefixFold(q, "SELECT") || hasPrefixFold(q, "SHOW")
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+// isRetryable 判断错误是否值得重试
+func isRetryable(err error, readOnly bool) bool {
+	if notExecuted(err) {
+		return true
+	}
+	return readOnly && connectionLost(err)
+}
+
+// notExecuted 错误表明语句确定没有执行或已被数据库回滚
+func notExecuted(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var myErr *mysql.MySQLError
+	if errors.As(err, &myErr) {
+		switch myErr.Number {
+		case 1040, // 连接数过多
+			1205, // 锁等待超时
+			1213: // 死锁
+			return true
+		}
+		return false
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "40001", // 序列化失败
+			"40P01", // 死锁
+			"53300", // 连接数过多
+			"57P03", // 数据库正在启动或恢复
+			"08001", // 无法建立连接
+			"08004": // 服务端拒绝连接
+			return true
+		}
+	}
+	return false
+}
+
+// connectionLost 错误表明连接在执行过程中中断，无法确定语句是否已生效
+func connectionLost(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, mysql.ErrInvalidConn) {
+		return true
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		// 08 类为连接异常，57P01/57P02 为管理员或崩溃导致的服务端关闭
+		return pqErr.Code.Class() == "08" || pqErr.Code == "57P01" || pqErr.Code == "57P02"
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,48 @@
+package database
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/accesslog"
+)
+
+// tracingDriver 统计每个请求执行的 SQL 次数，供慢请求追踪使用
+type tracingDriver struct {
+	dialect.Driver
+}
+
+func (d *tracingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	accesslog.RecordDBQuery(ctx)
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *tracingDriver) Query(ctx context.Context, query string, args, v any) error {
+	accesslog.RecordDBQuery(ctx)
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *tracingDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tracingTx{Tx: tx, ctx: ctx}, nil
+}
+
+// tracingTx 事务内的 SQL 同样计入发起事务的请求
+type tracingTx struct {
+	dialect.Tx
+	ctx context.Context
+}
+
+func (t *tracingTx) Exec(ctx context.Context, query string, args, v any) error {
+	accesslog.RecordDBQuery(t.ctx)
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+func (t *tracingTx) Query(ctx context.Context, query string, args, v any) error {
+	accesslog.RecordDBQuery(t.ctx)
+	return t.Tx.Query(ctx, query, args, v)
+}
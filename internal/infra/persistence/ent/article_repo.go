@@ -2,6 +2,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"math/rand"
@@ -12,12 +13,14 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
 	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/schema/mixin"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 )
 
 type articleRepo struct {
@@ -118,6 +121,8 @@ func (r *articleRepo) toModel(a *ent.Article) *model.Article {
 		IsDoc:       a.IsDoc,
 		DocSeriesID: a.DocSeriesID,
 		DocSort:     a.DocSort,
+		// 回收站
+		DeletedAt: a.DeletedAt,
 	}
 }
 
@@ -130,9 +135,47 @@ func convertExtraConfig(config map[string]interface{}) *model.ArticleExtraConfig
 	if enableAIPodcast, ok := config["enable_ai_podcast"].(bool); ok {
 		result.EnableAIPodcast = enableAIPodcast
 	}
+	if disableAutoSummary, ok := config["disable_auto_summary"].(bool); ok {
+		result.DisableAutoSummary = disableAutoSummary
+	}
+	if authors, ok := config["authors"].([]interface{}); ok {
+		for _, author := range authors {
+			if slug, ok := author.(string); ok && slug != "" {
+				result.Authors = append(result.Authors, slug)
+			}
+		}
+	}
+	if customFields, ok := config["custom_fields"].(map[string]interface{}); ok && len(customFields) > 0 {
+		result.CustomFields = customFields
+	}
+	if rawToc, ok := config["toc"]; ok {
+		if data, err := json.Marshal(rawToc); err == nil {
+			_ = json.Unmarshal(data, &result.Toc)
+		}
+	}
 	return result
 }
 
+// extraConfigToMap 将 ArticleExtraConfig 转换为写入数据库的 map
+func extraConfigToMap(config *model.ArticleExtraConfig) map[string]interface{} {
+	extraConfigMap := map[string]interface{}{
+		"enable_ai_podcast": config.EnableAIPodcast,
+	}
+	if config.DisableAutoSummary {
+		extraConfigMap["disable_auto_summary"] = true
+	}
+	if len(config.Authors) > 0 {
+		extraConfigMap["authors"] = config.Authors
+	}
+	if len(config.Toc) > 0 {
+		extraConfigMap["toc"] = config.Toc
+	}
+	if len(config.CustomFields) > 0 {
+		extraConfigMap["custom_fields"] = config.CustomFields
+	}
+	return extraConfigMap
+}
+
 // toModelSlice 将 ent.Article 切片转换为 model.Article 切片，减少代码重复。
 func (r *articleRepo) toModelSlice(entities []*ent.Article) []*model.Article {
 	models := make([]*model.Article, len(entities))
@@ -471,6 +514,46 @@ func (r *articleRepo) UpdateViewCounts(ctx context.Context, updates map[uint]int
 	return tx.Commit()
 }
 
+// UpdatePinSort 更新文章的置顶权重
+func (r *articleRepo) UpdatePinSort(ctx context.Context, publicID string, pinSort int) error {
+	dbID, _, err := idgen.DecodePublicID(publicID)
+	if err != nil {
+		return err
+	}
+	return r.db.Article.UpdateOneID(dbID).Where(article.DeletedAtIsNil()).SetPinSort(pinSort).Exec(ctx)
+}
+
+// UpdateSummaries 更新文章摘要，不修改更新时间
+func (r *articleRepo) UpdateSummaries(ctx context.Context, publicID string, summaries []string) error {
+	dbID, _, err := idgen.DecodePublicID(publicID)
+	if err != nil {
+		return err
+	}
+	return r.db.Article.UpdateOneID(dbID).Where(article.DeletedAtIsNil()).SetSummaries(summaries).Exec(ctx)
+}
+
+// UpdateDerivedData 更新文章的派生数据，不修改更新时间
+func (r *articleRepo) UpdateDerivedData(ctx context.Context, publicID string, data *model.ArticleDerivedData) error {
+	dbID, _, err := idgen.DecodePublicID(publicID)
+	if err != nil {
+		return err
+	}
+	update := r.db.Article.UpdateOneID(dbID).Where(article.DeletedAtIsNil())
+	if data.WordCount != nil {
+		update.SetWordCount(*data.WordCount)
+	}
+	if data.ReadingTime != nil {
+		update.SetReadingTime(*data.ReadingTime)
+	}
+	if data.ContentHTML != nil {
+		update.SetContentHTML(*data.ContentHTML)
+	}
+	if data.ExtraConfig != nil {
+		update.SetExtraConfig(extraConfigToMap(data.ExtraConfig))
+	}
+	return update.Exec(ctx)
+}
+
 // IncrementViewCount 原子地为给定文章的浏览次数加一
 func (r *articleRepo) IncrementViewCount(ctx context.Context, publicID string) error {
 	dbID, _, err := idgen.DecodePublicID(publicID)
@@ -545,10 +628,7 @@ func (r *articleRepo) Create(ctx context.Context, params *model.CreateArticlePar
 
 	// 设置扩展配置
 	if params.ExtraConfig != nil {
-		extraConfigMap := map[string]interface{}{
-			"enable_ai_podcast": params.ExtraConfig.EnableAIPodcast,
-		}
-		creator.SetExtraConfig(extraConfigMap)
+		creator.SetExtraConfig(extraConfigToMap(params.ExtraConfig))
 	}
 
 	// 设置文档模式相关字段
@@ -691,10 +771,7 @@ func (r *articleRepo) Update(ctx context.Context, publicID string, req *model.Up
 	}
 	// 更新扩展配置
 	if req.ExtraConfig != nil {
-		extraConfigMap := map[string]interface{}{
-			"enable_ai_podcast": req.ExtraConfig.EnableAIPodcast,
-		}
-		updater.SetExtraConfig(extraConfigMap)
+		updater.SetExtraConfig(extraConfigToMap(req.ExtraConfig))
 	}
 	// 更新文档模式相关字段
 	if req.IsDoc != nil {
@@ -803,11 +880,27 @@ func (r *articleRepo) ListPublic(ctx context.Context, options *model.ListPublicA
 
 	// 只在普通列表（没有指定分类、标签、年份、月份）时应用 show_on_home 过滤
 	// 分类页、标签页、归档页应该显示所有文章
-	isFilteredView := options.CategoryName != "" || options.TagName != "" || options.Year > 0 || options.Month > 0
+	isFilteredView := options.CategoryName != "" || options.TagName != "" || options.Year > 0 || options.Month > 0 || options.Author != ""
 	if !isFilteredView {
 		baseQuery = baseQuery.Where(article.ShowOnHomeEQ(true))
 	}
 
+	if options.PinnedOnly {
+		baseQuery = baseQuery.Where(article.PinSortGT(0))
+	}
+
+	// 作者归档：extra_config.authors 中包含该作者，或由作者关联的系统用户发布
+	if options.Author != "" {
+		baseQuery = baseQuery.Where(func(s *sql.Selector) {
+			byAuthor := sqljson.ValueContains(s.C(article.FieldExtraConfig), options.Author, sqljson.Path("authors"))
+			if options.AuthorUserID != nil {
+				s.Where(sql.Or(byAuthor, sql.EQ(s.C(article.FieldOwnerID), *options.AuthorUserID)))
+				return
+			}
+			s.Where(byAuthor)
+		})
+	}
+
 	if options.CategoryName != "" {
 		baseQuery = baseQuery.Where(article.HasPostCategoriesWith(postcategory.NameEQ(options.CategoryName)))
 	}
@@ -924,8 +1017,8 @@ func (r *articleRepo) List(ctx context.Context, options *model.ListArticlesOptio
 	return r.toModelSlice(entities), total, nil
 }
 
-// ListHome 获取首页推荐文章
-func (r *articleRepo) ListHome(ctx context.Context) ([]*model.Article, error) {
+// ListHome 获取首页推荐文章，最多返回 limit 篇
+func (r *articleRepo) ListHome(ctx context.Context, limit int) ([]*model.Article, error) {
 	entities, err := r.db.Article.Query().
 		Where(
 			article.ShowOnHomeEQ(true),
@@ -940,7 +1033,7 @@ func (r *articleRepo) ListHome(ctx context.Context) ([]*model.Article, error) {
 			),
 		).
 		Order(ent.Asc(article.FieldHomeSort)).
-		Limit(6).
+		Limit(limit).
 		WithPostTags().
 		WithPostCategories().
 		All(ctx)
@@ -1006,6 +1099,61 @@ func (r *articleRepo) Delete(ctx context.Context, publicID string) error {
 	return r.db.Article.DeleteOneID(dbID).Exec(ctx)
 }
 
+// ListDeleted 获取回收站中的文章
+func (r *articleRepo) ListDeleted(ctx context.Context) ([]*model.Article, error) {
+	entities, err := r.db.Article.Query().
+		Where(article.DeletedAtNotNil()).
+		WithPostTags().
+		WithPostCategories().
+		Order(ent.Desc(article.FieldDeletedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询回收站文章失败: %w", err)
+	}
+	return r.toModelSlice(entities), nil
+}
+
+// GetDeletedByID 根据公共ID获取回收站中的文章
+func (r *articleRepo) GetDeletedByID(ctx context.Context, publicID string) (*model.Article, error) {
+	dbID, _, err := idgen.DecodePublicID(publicID)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.db.Article.Query().
+		Where(article.ID(dbID), article.DeletedAtNotNil()).
+		WithPostTags().
+		WithPostCategories().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return r.toModel(entity), nil
+}
+
+// Restore 清除文章的删除时间
+func (r *articleRepo) Restore(ctx context.Context, publicID string) error {
+	dbID, _, err := idgen.DecodePublicID(publicID)
+	if err != nil {
+		return err
+	}
+	return r.db.Article.UpdateOneID(dbID).Where(article.DeletedAtNotNil()).ClearDeletedAt().Exec(ctx)
+}
+
+// Purge 跳过软删除，从数据库中删除回收站中的文章
+func (r *articleRepo) Purge(ctx context.Context, publicID string) error {
+	dbID, _, err := idgen.DecodePublicID(publicID)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.Article.Delete().
+		Where(article.ID(dbID), article.DeletedAtNotNil()).
+		Exec(mixin.SkipSoftDelete(ctx))
+	return err
+}
+
 // FindScheduledArticlesToPublish 查找所有定时发布时间已到的文章
 // 返回状态为 SCHEDULED 且 scheduled_at <= now 的文章列表
 func (r *articleRepo) FindScheduledArticlesToPublish(ctx context.Context, now time.Time) ([]*model.Article, error) {
@@ -1025,6 +1173,44 @@ func (r *articleRepo) FindScheduledArticlesToPublish(ctx context.Context, now ti
 	return r.toModelSlice(entities), nil
 }
 
+// ListForCalendar 查询日历视图中的文章（不含正文）
+// 返回 created_at 在 [start, end) 内的已发布文章和 scheduled_at 在 [start, end) 内的定时文章
+func (r *articleRepo) ListForCalendar(ctx context.Context, start, end time.Time, authorID *uint) ([]*model.Article, error) {
+	query := r.db.Article.Query().
+		Where(
+			article.DeletedAtIsNil(),
+			article.Or(
+				article.And(
+					article.StatusEQ(article.StatusPUBLISHED),
+					article.CreatedAtGTE(start),
+					article.CreatedAtLT(end),
+				),
+				article.And(
+					article.StatusEQ(article.StatusSCHEDULED),
+					article.ScheduledAtNotNil(),
+					article.ScheduledAtGTE(start),
+					article.ScheduledAtLT(end),
+				),
+			),
+		)
+	if authorID != nil {
+		query = query.Where(article.OwnerIDEQ(*authorID))
+	}
+	entities, err := query.
+		Order(ent.Asc(article.FieldCreatedAt)).
+		Select(
+			article.FieldID, article.FieldCreatedAt, article.FieldUpdatedAt,
+			article.FieldTitle, article.FieldCoverURL, article.FieldStatus,
+			article.FieldAbbrlink, article.FieldReviewStatus, article.FieldOwnerID,
+			article.FieldIsTakedown, article.FieldScheduledAt,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询日历文章失败: %w", err)
+	}
+	return r.toModelSlice(entities), nil
+}
+
 // PublishScheduledArticle 发布一篇定时文章
 // 将文章状态从 SCHEDULED 改为 PUBLISHED，并将 created_at 设置为 scheduled_at（保持原定时发布时间）
 func (r *articleRepo) PublishScheduledArticle(ctx context.Context, articleID uint) error {
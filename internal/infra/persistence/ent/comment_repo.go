@@ -276,9 +276,14 @@ func (r *commentRepo) FindWithConditions(ctx context.Context, params repository.
 		return nil, 0, err
 	}
 
-	query = query.
-		WithUser(). // 预加载关联的用户信息
-		Modify(func(s *sql.Selector) {
+	query = query.WithUser() // 预加载关联的用户信息
+	if params.Keyset.Enabled {
+		query = query.
+			Where(keysetAfter(params.Keyset)).
+			Order(ent.Desc(entcomment.FieldCreatedAt), ent.Desc(entcomment.FieldID)).
+			Limit(params.PageSize)
+	} else {
+		query = query.Modify(func(s *sql.Selector) {
 			switch r.dbType {
 			case "mysql":
 				s.OrderExpr(sql.Expr(fmt.Sprintf("`%s` IS NULL ASC", entcomment.FieldPinnedAt)))
@@ -295,8 +300,9 @@ func (r *commentRepo) FindWithConditions(ctx context.Context, params repository.
 				s.OrderExpr(sql.Expr(fmt.Sprintf(`"%s" DESC`, entcomment.FieldCreatedAt)))
 			}
 		}).
-		Limit(params.PageSize).
-		Offset((params.Page - 1) * params.PageSize)
+			Limit(params.PageSize).
+			Offset((params.Page - 1) * params.PageSize)
+	}
 
 	entComments, err := query.All(ctx)
 	if err != nil {
@@ -389,6 +395,40 @@ func (r *commentRepo) UpdatePath(ctx context.Context, oldPath, newPath string) (
 		Save(ctx)
 	return info, err
 }
+
+// ListIPAddresses 按 ID 升序返回 afterID 之后的评论 IP 和属地记录
+func (r *commentRepo) ListIPAddresses(ctx context.Context, afterID uint, limit int) ([]*repository.CommentIPRecord, error) {
+	entComments, err := r.db.Comment.Query().
+		Where(entcomment.IDGT(afterID)).
+		Order(ent.Asc(entcomment.FieldID)).
+		Limit(limit).
+		Select(entcomment.FieldID, entcomment.FieldIPAddress, entcomment.FieldIPLocation).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*repository.CommentIPRecord, len(entComments))
+	for i, c := range entComments {
+		records[i] = &repository.CommentIPRecord{ID: c.ID, IPAddress: c.IPAddress}
+		if c.IPLocation != nil {
+			records[i].IPLocation = *c.IPLocation
+		}
+	}
+	return records, nil
+}
+
+// UpdateIPAddress 更新评论存储的 IP 地址和属地
+func (r *commentRepo) UpdateIPAddress(ctx context.Context, id uint, ip, location string) error {
+	update := r.db.Comment.UpdateOneID(id).SetIPAddress(ip)
+	if location == "" {
+		update.ClearIPLocation()
+	} else {
+		update.SetIPLocation(location)
+	}
+	return update.Exec(ctx)
+}
+
 func (r *commentRepo) FindPublishedChildrenByParentID(ctx context.Context, parentID uint, page, pageSize int) ([]*model.Comment, int64, error) {
 	query := r.db.Comment.Query().
 		Where(
@@ -14,6 +14,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/entity"
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
 	"github.com/anzhiyu-c/anheyu-app/ent/privacy"
 )
@@ -438,6 +439,7 @@ func toDomainFile(f *ent.File) *model.File {
 	if f.ViewConfig != nil {
 		domainFile.ViewConfig = sql.NullString{String: *f.ViewConfig, Valid: true}
 	}
+	domainFile.DeletedAt = f.DeletedAt
 
 	if f.Edges.PrimaryEntity != nil {
 		domainFile.PrimaryEntity = toDomainEntity(f.Edges.PrimaryEntity)
@@ -649,3 +651,49 @@ func (r *entFileRepository) SoftDeleteByOwnerID(ctx context.Context, ownerID uin
 		Save(ctx)
 	return err
 }
+
+func (r *entFileRepository) ListMediaFiles(ctx context.Context, options *repository.MediaListOptions) ([]*model.File, int64, error) {
+	query := r.client.File.Query().Where(file.TypeEQ(int(model.FileTypeFile)))
+	if options.Deleted {
+		query = query.Where(file.DeletedAtNotNil())
+	} else {
+		query = query.Where(file.DeletedAtIsNil())
+	}
+	if options.PolicyID > 0 {
+		query = query.Where(file.HasPrimaryEntityWith(entity.PolicyID(options.PolicyID)))
+	}
+	if options.Keyword != "" {
+		query = query.Where(file.NameContainsFold(options.Keyword))
+	}
+	if options.OwnerID > 0 {
+		query = query.Where(file.OwnerID(options.OwnerID))
+	}
+	if options.MimePrefix != "" {
+		query = query.Where(file.HasPrimaryEntityWith(entity.MimeTypeHasPrefix(options.MimePrefix)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	q := query.Order(ent.Desc(file.FieldCreatedAt), ent.Desc(file.FieldID)).WithPrimaryEntity()
+	if options.Keyset.Enabled {
+		q = q.Where(keysetAfter(options.Keyset))
+		if options.PageSize > 0 {
+			q = q.Limit(options.PageSize)
+		}
+	} else if options.Page > 0 && options.PageSize > 0 {
+		q = q.Offset((options.Page - 1) * options.PageSize).Limit(options.PageSize)
+	}
+	entFiles, err := q.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	domainFiles := make([]*model.File, len(entFiles))
+	for i, f := range entFiles {
+		domainFiles[i] = toDomainFile(f)
+	}
+	return domainFiles, int64(total), nil
+}
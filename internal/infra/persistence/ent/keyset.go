@@ -0,0 +1,21 @@
+package ent
+
+import (
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// keysetAfter 返回键集分页的查询条件：只保留在 (created_at, id) 倒序中排在 k.After 之后的记录。
+// 调用方需要按 created_at、id 倒序排列，k.After 为 nil 时不限制
+func keysetAfter(k repository.KeysetQuery) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if k.After == nil {
+			return
+		}
+		s.Where(sql.Or(
+			sql.LT(s.C("created_at"), *k.After),
+			sql.And(sql.EQ(s.C("created_at"), *k.After), sql.LT(s.C("id"), k.AfterID)),
+		))
+	}
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
+	"github.com/anzhiyu-c/anheyu-app/ent/schema/mixin"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 )
@@ -52,7 +54,9 @@ func (r *EntPageRepository) GetByID(ctx context.Context, id string) (*model.Page
 		return nil, fmt.Errorf("无效的页面ID: %w", err)
 	}
 
-	entPage, err := r.client.Page.Get(ctx, uint(idUint))
+	entPage, err := r.client.Page.Query().
+		Where(page.ID(uint(idUint)), page.DeletedAtIsNil()).
+		Only(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("获取页面失败: %w", err)
 	}
@@ -69,7 +73,7 @@ func (r *EntPageRepository) GetByPath(ctx context.Context, path string) (*model.
 	}
 
 	entPage, err := r.client.Page.Query().
-		Where(page.Path(queryPath)).
+		Where(page.Path(queryPath), page.DeletedAtIsNil()).
 		First(ctx)
 
 	if err != nil {
@@ -85,7 +89,7 @@ func (r *EntPageRepository) GetByPath(ctx context.Context, path string) (*model.
 
 // List 列出页面
 func (r *EntPageRepository) List(ctx context.Context, options *model.ListPagesOptions) ([]*model.Page, int, error) {
-	query := r.client.Page.Query()
+	query := r.client.Page.Query().Where(page.DeletedAtIsNil())
 
 	// 搜索条件
 	if options.Search != "" {
@@ -197,7 +201,60 @@ func (r *EntPageRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// ExistsByPath 检查路径是否存在
+// ListDeleted 获取回收站中的页面
+func (r *EntPageRepository) ListDeleted(ctx context.Context) ([]*model.Page, error) {
+	entPages, err := r.client.Page.Query().
+		Where(page.DeletedAtNotNil()).
+		Order(ent.Desc(page.FieldDeletedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取回收站页面失败: %w", err)
+	}
+	pages := make([]*model.Page, len(entPages))
+	for i, entPage := range entPages {
+		pages[i] = r.entToModel(entPage)
+	}
+	return pages, nil
+}
+
+// Restore 清除页面的删除时间
+func (r *EntPageRepository) Restore(ctx context.Context, id string) error {
+	idUint, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return fmt.Errorf("无效的页面ID: %w", err)
+	}
+	n, err := r.client.Page.Update().
+		Where(page.ID(uint(idUint)), page.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("恢复页面失败: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("回收站中不存在页面 %s: %w", id, constant.ErrNotFound)
+	}
+	return nil
+}
+
+// Purge 跳过软删除，从数据库中删除回收站中的页面
+func (r *EntPageRepository) Purge(ctx context.Context, id string) error {
+	idUint, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return fmt.Errorf("无效的页面ID: %w", err)
+	}
+	n, err := r.client.Page.Delete().
+		Where(page.ID(uint(idUint)), page.DeletedAtNotNil()).
+		Exec(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return fmt.Errorf("彻底删除页面失败: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("回收站中不存在页面 %s: %w", id, constant.ErrNotFound)
+	}
+	return nil
+}
+
+// ExistsByPath 检查路径是否存在，路径在数据库中唯一，回收站中的页面同样占用路径
 func (r *EntPageRepository) ExistsByPath(ctx context.Context, path string, excludeID string) (bool, error) {
 	query := r.client.Page.Query().Where(page.Path(path))
 
@@ -232,5 +289,6 @@ func (r *EntPageRepository) entToModel(entPage *ent.Page) *model.Page {
 		Sort:            entPage.Sort,
 		CreatedAt:       entPage.CreatedAt,
 		UpdatedAt:       entPage.UpdatedAt,
+		DeletedAt:       entPage.DeletedAt,
 	}
 }
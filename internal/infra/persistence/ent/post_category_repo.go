@@ -50,6 +50,18 @@ func (r *postCategoryRepo) DeleteIfUnused(ctx context.Context, ids []uint) error
 	return err
 }
 
+// Restore 清除分类的删除时间
+func (r *postCategoryRepo) Restore(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := r.db.PostCategory.Update().
+		Where(postcategory.IDIn(ids...), postcategory.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(ctx)
+	return err
+}
+
 // UpdateCount 更新指定 ID 集合的计数值
 func (r *postCategoryRepo) UpdateCount(ctx context.Context, incIDs, decIDs []uint) error {
 	if len(incIDs) > 0 {
@@ -154,6 +154,18 @@ func (r *postTagRepo) DeleteIfUnused(ctx context.Context, ids []uint) error {
 	return err
 }
 
+// Restore 清除标签的删除时间
+func (r *postTagRepo) Restore(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := r.db.PostTag.Update().
+		Where(posttag.IDIn(ids...), posttag.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(ctx)
+	return err
+}
+
 func (r *postTagRepo) GetByID(ctx context.Context, publicID string) (*model.PostTag, error) {
 	dbID, _, err := idgen.DecodePublicID(publicID)
 	if err != nil {
@@ -9,9 +9,12 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/authsession"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
+	"github.com/anzhiyu-c/anheyu-app/ent/logindevice"
+	"github.com/anzhiyu-c/anheyu-app/ent/oauthlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
 	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
@@ -301,7 +304,34 @@ func (r *entUserRepository) Delete(ctx context.Context, id uint) error {
 		return fmt.Errorf("物理删除用户通知配置失败: %w", err)
 	}
 
-	// 5. 最后软删除用户
+	// 5. 物理删除该用户的已知登录设备
+	_, err = tx.LoginDevice.Delete().
+		Where(logindevice.UserID(id)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("物理删除用户登录设备失败: %w", err)
+	}
+
+	// 6. 物理删除该用户的第三方账号绑定
+	_, err = tx.OAuthLink.Delete().
+		Where(oauthlink.UserID(id)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("物理删除用户第三方账号绑定失败: %w", err)
+	}
+
+	// 7. 物理删除该用户的登录会话
+	_, err = tx.AuthSession.Delete().
+		Where(authsession.UserID(id)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("物理删除用户登录会话失败: %w", err)
+	}
+
+	// 8. 最后软删除用户
 	_, err = tx.User.Delete().Where(user.ID(id)).Exec(ctx)
 	if err != nil {
 		tx.Rollback()
@@ -0,0 +1,104 @@
+package router
+
+import (
+	"embed"
+	"errors"
+	"io"
+	"io/fs"
+	"log"
+	"path"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/assetcdn"
+)
+
+// assetCDN 主题静态资源 CDN 服务，未设置时不改写资源地址
+var assetCDN *assetcdn.Service
+
+// SetAssetCDN 设置主题静态资源 CDN 服务，应在 SetupFrontend 之前调用
+func SetAssetCDN(svc *assetcdn.Service) {
+	assetCDN = svc
+}
+
+// frontendRewriteEnabled 前台页面渲染完成后是否需要改写 HTML
+func frontendRewriteEnabled() bool {
+	return resourceLocalizer.Enabled() || assetCDN.Enabled()
+}
+
+// rewriteFrontendHTML 将第三方资源改写为本地地址，再将主题资源改写为 CDN 地址
+func rewriteFrontendHTML(document string) string {
+	document = resourceLocalizer.Rewrite(document)
+	return assetCDN.Rewrite(document)
+}
+
+// themeAssetSource 当前前台主题的 /static/ 和 /assets/ 资源，查找顺序与资源路由一致：
+// 外部主题激活时 /static/ 只使用外部主题，/assets/ 缺少的资源回退到内嵌资源
+type themeAssetSource struct {
+	distFS fs.FS
+}
+
+// NewThemeAssetSource 创建供资源 CDN 使用的主题资源来源
+func NewThemeAssetSource(content embed.FS) assetcdn.Source {
+	distFS, err := fs.Sub(content, "assets/dist")
+	if err != nil {
+		log.Printf("警告：读取内嵌前端资源失败: %v", err)
+	}
+	return &themeAssetSource{distFS: distFS}
+}
+
+// Signature 实现 assetcdn.Source
+func (s *themeAssetSource) Signature() string {
+	signature, _ := currentManifestSignature()
+	return signature
+}
+
+// Walk 实现 assetcdn.Source
+func (s *themeAssetSource) Walk(fn func(url string, open func() (io.ReadCloser, error)) error) error {
+	seen := make(map[string]bool)
+	walk := func(fsys fs.FS, root string) error {
+		if fsys == nil {
+			return nil
+		}
+		err := fs.WalkDir(fsys, root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			// 页面模板、主题元数据和预压缩文件不需要分发
+			switch path.Ext(p) {
+			case ".html", ".gz", ".br":
+				return nil
+			}
+			if path.Base(p) == "theme.json" {
+				return nil
+			}
+			url := "/" + p
+			if seen[url] {
+				return nil
+			}
+			seen[url] = true
+			name := p
+			return fn(url, func() (io.ReadCloser, error) { return fsys.Open(name) })
+		})
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	if isStaticModeActive() {
+		theme := themeFS()
+		if err := walk(theme, "static"); err != nil {
+			return err
+		}
+		if err := walk(theme, "assets"); err != nil {
+			return err
+		}
+		return walk(s.distFS, "assets")
+	}
+	if err := walk(s.distFS, "static"); err != nil {
+		return err
+	}
+	return walk(s.distFS, "assets")
+}
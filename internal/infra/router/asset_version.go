@@ -0,0 +1,106 @@
+package router
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"log"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// assetVersionTable 官方内嵌资源的内容哈希表
+// 程序启动时根据 embed 文件系统计算一次，二进制升级后内容变化，资源 URL 随之变化，浏览器不会继续使用旧缓存
+type assetVersionTable struct {
+	version string            // 所有内嵌资源的聚合哈希，作为构建版本号
+	files   map[string]string // 资源 URL（如 /static/img/logo.png）-> 内容哈希
+}
+
+var embeddedAssetVersions = &assetVersionTable{version: "dev", files: map[string]string{}}
+
+// assetVersionHashLength 资源 URL 中使用的哈希长度
+const assetVersionHashLength = 10
+
+// 匹配 HTML 中引用内嵌资源的 src/href 属性
+var assetReferencePattern = regexp.MustCompile(`((?:src|href)=["'])(/(?:static|assets)/[^"'?#]+)(["'])`)
+
+// initEmbeddedAssetVersions 遍历内嵌资源计算内容哈希
+func initEmbeddedAssetVersions(distFS fs.FS) {
+	files := make(map[string]string)
+	var urls []string
+
+	err := fs.WalkDir(distFS, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || p == "index.html" {
+			return nil
+		}
+		// 预压缩文件与原文件共用同一个 URL，不单独计算
+		if ext := path.Ext(p); ext == ".gz" || ext == ".br" {
+			return nil
+		}
+
+		content, err := fs.ReadFile(distFS, p)
+		if err != nil {
+			return fmt.Errorf("读取内嵌资源失败 %s: %w", p, err)
+		}
+		sum := sha256.Sum256(content)
+		url := "/" + p
+		files[url] = hex.EncodeToString(sum[:])[:assetVersionHashLength]
+		urls = append(urls, url)
+		return nil
+	})
+	if err != nil {
+		log.Printf("警告：计算内嵌资源版本失败，资源 URL 将不带版本号: %v", err)
+		return
+	}
+
+	// 按 URL 排序后计算聚合哈希，保证同一份构建产物得到相同的版本号
+	sort.Strings(urls)
+	versionHash := sha256.New()
+	for _, url := range urls {
+		versionHash.Write([]byte(url))
+		versionHash.Write([]byte(files[url]))
+	}
+
+	embeddedAssetVersions = &assetVersionTable{
+		version: hex.EncodeToString(versionHash.Sum(nil))[:assetVersionHashLength],
+		files:   files,
+	}
+	debugLog("内嵌资源版本已计算: version=%s, 资源数=%d", embeddedAssetVersions.version, len(files))
+}
+
+// versionedAssetURL 为内嵌资源 URL 追加内容哈希参数，未知资源原样返回
+// 模板中可通过 {{ asset "/static/img/logo.png" }} 使用
+func versionedAssetURL(url string) string {
+	base := url
+	if i := strings.IndexAny(base, "?#"); i != -1 {
+		base = base[:i]
+	}
+	hash, ok := embeddedAssetVersions.files[base]
+	if !ok {
+		return url
+	}
+	if strings.Contains(url, "?") {
+		return url + "&v=" + hash
+	}
+	return url + "?v=" + hash
+}
+
+// stampAssetURLs 为 HTML 中引用的内嵌资源加上内容哈希参数
+func stampAssetURLs(html string) string {
+	return assetReferencePattern.ReplaceAllStringFunc(html, func(match string) string {
+		parts := assetReferencePattern.FindStringSubmatch(match)
+		return parts[1] + versionedAssetURL(parts[2]) + parts[3]
+	})
+}
+
+// getAppVersion 获取应用版本号（用于缓存失效）
+// 使用内嵌资源的聚合内容哈希，只有在二进制升级且前端资源变化时才会改变
+func getAppVersion() string {
+	return "v" + embeddedAssetVersions.version
+}
@@ -0,0 +1,93 @@
+package router
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+
+	"github.com/gin-gonic/gin"
+)
+
+// pageCachePolicy 单类页面的缓存策略（单位：秒）
+type pageCachePolicy struct {
+	MaxAge               int
+	SMaxAge              int
+	StaleWhileRevalidate int
+}
+
+// pageCachePolicyKeys 各页面类型对应的配置项及默认值
+// 配置项通过 settingSvc 读取，后台修改后下一次请求即生效
+var pageCachePolicyKeys = map[string]struct {
+	maxAge, sMaxAge, swr constant.SettingKey
+	fallback             pageCachePolicy
+}{
+	"article_detail": {constant.KeyPageCacheArticleMaxAge, constant.KeyPageCacheArticleSMaxAge, constant.KeyPageCacheArticleSWR, pageCachePolicy{180, 60, 60}},
+	"home_page":      {constant.KeyPageCacheHomeMaxAge, constant.KeyPageCacheHomeSMaxAge, constant.KeyPageCacheHomeSWR, pageCachePolicy{300, 120, 30}},
+	"static_page":    {constant.KeyPageCacheStaticPageMaxAge, constant.KeyPageCacheStaticPageSMaxAge, constant.KeyPageCacheStaticPageSWR, pageCachePolicy{1800, 600, 120}},
+	"default":        {constant.KeyPageCacheDefaultMaxAge, constant.KeyPageCacheDefaultSMaxAge, constant.KeyPageCacheDefaultSWR, pageCachePolicy{180, 60, 30}},
+}
+
+var articleDetailPathPattern = regexp.MustCompile(`^/posts/([^/]+)$`)
+
+// loadPageCachePolicy 读取指定页面类型的缓存策略，配置缺失或非法时使用默认值
+func loadPageCachePolicy(settingSvc setting.SettingService, pageType string) pageCachePolicy {
+	keys, ok := pageCachePolicyKeys[pageType]
+	if !ok {
+		keys = pageCachePolicyKeys["default"]
+	}
+	return pageCachePolicy{
+		MaxAge:               settingSeconds(settingSvc, keys.maxAge, keys.fallback.MaxAge),
+		SMaxAge:              settingSeconds(settingSvc, keys.sMaxAge, keys.fallback.SMaxAge),
+		StaleWhileRevalidate: settingSeconds(settingSvc, keys.swr, keys.fallback.StaleWhileRevalidate),
+	}
+}
+
+func settingSeconds(settingSvc setting.SettingService, key constant.SettingKey, fallback int) int {
+	value, err := strconv.Atoi(settingSvc.Get(key.String()))
+	if err != nil || value < 0 {
+		return fallback
+	}
+	return value
+}
+
+// isCDNRequest 检测是否通过CDN访问
+func isCDNRequest(c *gin.Context) bool {
+	return c.GetHeader("CF-Ray") != "" || // Cloudflare
+		c.GetHeader("X-Amz-Cf-Id") != "" || // CloudFront
+		c.GetHeader("X-Cache") != "" || // 通用CDN标识
+		c.GetHeader("X-Served-By") != "" // Fastly等
+}
+
+// pageTypeForPath 根据请求路径判断页面类型，内置页面和已发布的自定义页面视为 static_page
+func pageTypeForPath(ctx context.Context, path string) string {
+	if path == "/" || path == "" {
+		return "home_page"
+	}
+	if articleDetailPathPattern.MatchString(path) {
+		return "article_detail"
+	}
+	if _, ok := builtInPageSEO[path]; ok {
+		return "static_page"
+	}
+	if globalPageRepo != nil {
+		if page, err := globalPageRepo.GetByPath(ctx, path); err == nil && page != nil && page.IsPublished {
+			return "static_page"
+		}
+	}
+	return "default"
+}
+
+// applyPageCachePolicy 为前台 HTML 页面设置缓存头
+// 未启用页面缓存策略时保持原有的禁止缓存行为
+func applyPageCachePolicy(c *gin.Context, settingSvc setting.SettingService) {
+	if settingSvc.Get(constant.KeyPageCacheEnable.String()) != "true" {
+		c.Header("Cache-Control", "no-cache, no-store, must-revalidate, private, max-age=0")
+		c.Header("Pragma", "no-cache")
+		c.Header("Expires", "0")
+		return
+	}
+	setSmartCacheHeaders(c, settingSvc, pageTypeForPath(c.Request.Context(), c.Request.URL.Path), "")
+}
@@ -0,0 +1,594 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/rss"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	rss_service "github.com/anzhiyu-c/anheyu-app/pkg/service/rss"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RouteRegistrar 前端路由注册组件，SetupFrontend 按顺序组合各组件完成前端路由配置
+type RouteRegistrar interface {
+	Register(engine *gin.Engine)
+}
+
+// RouteRegistrarFunc 将普通函数适配为 RouteRegistrar
+type RouteRegistrarFunc func(engine *gin.Engine)
+
+// Register 实现 RouteRegistrar
+func (f RouteRegistrarFunc) Register(engine *gin.Engine) {
+	f(engine)
+}
+
+// frontendExtensions 插件注册的前端路由组件
+var frontendExtensions struct {
+	mu         sync.Mutex
+	registrars []RouteRegistrar
+}
+
+// RegisterFrontendExtension 注册插件的前端路由，应在 SetupFrontend 之前调用。
+// 插件路由在内置路由之后、SPA 回退之前注册，因此不会被回退到 index.html；
+// 路径与内置路由冲突时 gin 会在启动时 panic，插件应使用独立的路径前缀
+func RegisterFrontendExtension(r RouteRegistrar) {
+	frontendExtensions.mu.Lock()
+	defer frontendExtensions.mu.Unlock()
+	frontendExtensions.registrars = append(frontendExtensions.registrars, r)
+}
+
+func registeredFrontendExtensions() []RouteRegistrar {
+	frontendExtensions.mu.Lock()
+	defer frontendExtensions.mu.Unlock()
+	return append([]RouteRegistrar(nil), frontendExtensions.registrars...)
+}
+
+// extraTemplateFuncs 插件注册的主题模板函数
+var extraTemplateFuncs struct {
+	mu    sync.Mutex
+	funcs template.FuncMap
+}
+
+// RegisterTemplateFunc 注册主题模板可以使用的函数，应在 SetupFrontend 之前调用；与内置函数同名时忽略
+func RegisterTemplateFunc(name string, fn any) {
+	extraTemplateFuncs.mu.Lock()
+	defer extraTemplateFuncs.mu.Unlock()
+	if extraTemplateFuncs.funcs == nil {
+		extraTemplateFuncs.funcs = template.FuncMap{}
+	}
+	extraTemplateFuncs.funcs[name] = fn
+}
+
+// TemplateFuncMap 返回主题模板可以使用的全部函数（内置函数加上插件注册的函数），
+// 前台渲染和主题冒烟测试共用，保证冒烟测试与实际渲染支持的函数一致
+func TemplateFuncMap() template.FuncMap {
+	funcMap := template.FuncMap{
+		"json": func(v interface{}) template.JS {
+			a, _ := json.Marshal(v)
+			return template.JS(a)
+		},
+		// 内嵌资源带内容哈希的 URL，升级后自动失效浏览器缓存
+		"asset":        versionedAssetURL,
+		"assetVersion": getAppVersion,
+	}
+	extraTemplateFuncs.mu.Lock()
+	defer extraTemplateFuncs.mu.Unlock()
+	for name, fn := range extraTemplateFuncs.funcs {
+		if _, exists := funcMap[name]; exists {
+			log.Printf("警告: 模板函数 %s 与内置函数重名，已忽略", name)
+			continue
+		}
+		funcMap[name] = fn
+	}
+	return funcMap
+}
+
+// AdminAssetsOptions 后台静态资源路由的配置
+type AdminAssetsOptions struct {
+	DistFS fs.FS // 内嵌的前端构建产物（assets/dist）
+}
+
+// AdminAssets 注册后台专用的 /admin-static/ 和 /admin-assets/ 路由。
+// 这是前后台分离的关键：后台的 JS/CSS 始终从内嵌资源读取，不受外部主题影响
+type AdminAssets struct {
+	distFS fs.FS
+}
+
+// NewAdminAssets 创建后台静态资源路由组件
+func NewAdminAssets(opts AdminAssetsOptions) *AdminAssets {
+	return &AdminAssets{distFS: opts.DistFS}
+}
+
+// Register 实现 RouteRegistrar
+func (a *AdminAssets) Register(engine *gin.Engine) {
+	engine.GET("/admin-static/*filepath", a.serveStatic)
+	// 当外部主题存在时，后台 HTML 中的资源路径会被重写为 /admin-assets/
+	engine.GET("/admin-assets/*filepath", a.serveAssets)
+}
+
+func (a *AdminAssets) serveStatic(c *gin.Context) {
+	filePath := strings.TrimPrefix(c.Param("filepath"), "/")
+	debugLog("后台静态资源请求: %s (始终使用内嵌资源)", filePath)
+
+	// 首先尝试提供压缩文件
+	if compressed, compressedPath, modTime, size := tryServeCompressedFile(c, "static/"+filePath, false, a.distFS); compressed {
+		etag := generateFileETag(compressedPath, modTime, size)
+		if handleStaticFileConditionalRequest(c, etag, "static/"+filePath) {
+			return
+		}
+		c.Header("ETag", etag)
+		if isHTMLFile(filePath) {
+			c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
+			c.Header("Pragma", "no-cache")
+			c.Header("Expires", "0")
+		} else {
+			c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
+		}
+		c.Header("Vary", "Accept-Encoding")
+		http.ServeFileFS(c.Writer, c.Request, a.distFS, compressedPath)
+		return
+	}
+
+	// 提供原始文件
+	staticFilePath := "static/" + filePath
+	if file, err := a.distFS.Open(staticFilePath); err == nil {
+		defer file.Close()
+		if stat, err := file.Stat(); err == nil && !stat.IsDir() {
+			etag := generateFileETag(filePath, stat.ModTime(), stat.Size())
+			if handleStaticFileConditionalRequest(c, etag, filePath) {
+				return
+			}
+			c.Header("ETag", etag)
+			if isHTMLFile(filePath) {
+				c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
+				c.Header("Pragma", "no-cache")
+				c.Header("Expires", "0")
+			} else {
+				c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
+			}
+			c.Header("Vary", "Accept-Encoding")
+			c.Header("Content-Type", getContentType(filePath))
+			http.ServeFileFS(c.Writer, c.Request, a.distFS, staticFilePath)
+			return
+		}
+	}
+	c.Status(http.StatusNotFound)
+}
+
+func (a *AdminAssets) serveAssets(c *gin.Context) {
+	filePath := strings.TrimPrefix(c.Param("filepath"), "/")
+	debugLog("后台 admin-assets 资源请求: %s (始终使用内嵌资源)", filePath)
+	serveEmbeddedAssets(c, filePath, a.distFS)
+}
+
+// ThemeAssetsOptions 前台主题资源路由的配置
+type ThemeAssetsOptions struct {
+	DistFS fs.FS // 内嵌的前端构建产物，外部主题缺少的资源回退到这里
+}
+
+// ThemeAssets 注册前台的 /assets/、/static/ 路由和离线资源清单。
+// 外部主题激活时优先使用外部主题（含覆盖目录和父主题）的资源，否则使用内嵌资源
+type ThemeAssets struct {
+	distFS fs.FS
+}
+
+// NewThemeAssets 创建前台主题资源路由组件
+func NewThemeAssets(opts ThemeAssetsOptions) *ThemeAssets {
+	return &ThemeAssets{distFS: opts.DistFS}
+}
+
+// Register 实现 RouteRegistrar
+func (t *ThemeAssets) Register(engine *gin.Engine) {
+	// 离线资源清单与 Service Worker，版本号随主题切换自动变化
+	engine.GET("/asset-manifest.json", handleAssetManifest(t.distFS))
+	engine.GET("/sw.js", handleServiceWorker(t.distFS))
+	debugLog("离线资源路由已配置: /asset-manifest.json 和 /sw.js")
+
+	// 资源优先从外部主题加载，兼容任何类型的外部主题（不限于 Next.js）
+	engine.GET("/assets/*filepath", t.serveAssets)
+	engine.GET("/static/*filepath", t.serveStatic)
+}
+
+func (t *ThemeAssets) serveAssets(c *gin.Context) {
+	filePath := strings.TrimPrefix(c.Param("filepath"), "/")
+
+	// 如果外部主题模式激活，先检查外部主题是否有此资源（被访问策略禁止的外部文件视为不存在）
+	if isStaticModeActive() && !themeFileBlocked("assets/"+filePath) {
+		externalPath := themeFilePath("assets/" + filePath)
+		if fileInfo, err := os.Stat(externalPath); err == nil && !fileInfo.IsDir() {
+			// 外部主题有此资源，从外部加载
+			debugLog("assets 资源请求: %s (使用外部主题资源)", filePath)
+			etag := generateFileETag(filePath, fileInfo.ModTime(), fileInfo.Size())
+			if handleStaticFileConditionalRequest(c, etag, filePath) {
+				return
+			}
+			c.Header("ETag", etag)
+			c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
+			c.Header("Vary", "Accept-Encoding")
+			c.Header("Content-Type", getContentType(filePath))
+			c.File(externalPath)
+			return
+		}
+	}
+
+	// 外部主题没有此资源或不在外部主题模式，从内嵌资源加载
+	debugLog("assets 资源请求: %s (使用内嵌资源)", filePath)
+	serveEmbeddedAssets(c, filePath, t.distFS)
+}
+
+func (t *ThemeAssets) serveStatic(c *gin.Context) {
+	filePath := strings.TrimPrefix(c.Param("filepath"), "/")
+	staticMode := isStaticModeActive()
+
+	// 外部主题中被访问策略禁止的文件按不存在处理
+	if staticMode && themeFileBlocked("static/"+filePath) {
+		c.Status(http.StatusNotFound)
+		return
+	}
+
+	// 首先尝试提供压缩文件
+	if compressed, compressedPath, modTime, size := tryServeCompressedFile(c, "static/"+filePath, staticMode, t.distFS); compressed {
+		// 生成基于压缩文件的ETag
+		etag := generateFileETag(compressedPath, modTime, size)
+
+		// 处理条件请求
+		if handleStaticFileConditionalRequest(c, etag, "static/"+filePath) {
+			return
+		}
+
+		// 设置缓存头 - 根据文件类型设置不同策略
+		c.Header("ETag", etag)
+		if isHTMLFile(filePath) {
+			// HTML文件不缓存
+			c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
+			c.Header("Pragma", "no-cache")
+			c.Header("Expires", "0")
+		} else {
+			// 其他静态文件使用协商缓存（1年，但每次验证）
+			c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
+		}
+		c.Header("Vary", "Accept-Encoding")
+
+		if staticMode {
+			applyThemeHTMLPolicy(c, filePath)
+			debugLog("动态路由：使用外部主题压缩文件 %s", compressedPath)
+			c.File(compressedPath)
+		} else {
+			debugLog("动态路由：使用内嵌压缩文件 %s", compressedPath)
+			http.ServeFileFS(c.Writer, c.Request, t.distFS, compressedPath)
+		}
+		return
+	}
+
+	// 如果没有压缩版本，提供原文件
+	if staticMode {
+		// 使用外部 static 目录，覆盖目录中的同名文件优先
+		fullPath := themeFilePath("static/" + filePath)
+
+		if fileInfo, err := os.Stat(fullPath); err == nil {
+			// 生成基于文件内容的ETag
+			etag := generateFileETag(filePath, fileInfo.ModTime(), fileInfo.Size())
+
+			// 处理条件请求
+			if handleStaticFileConditionalRequest(c, etag, filePath) {
+				return
+			}
+
+			// 设置缓存头 - 根据文件类型设置不同策略
+			c.Header("ETag", etag)
+			if isHTMLFile(filePath) {
+				// HTML文件不缓存
+				c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
+				c.Header("Pragma", "no-cache")
+				c.Header("Expires", "0")
+			} else {
+				// 其他静态文件使用协商缓存（1年，但每次验证）
+				c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
+			}
+			c.Header("Vary", "Accept-Encoding")
+			c.Header("Content-Type", getContentType(filePath))
+			applyThemeHTMLPolicy(c, filePath)
+
+			debugLog("动态路由：使用外部主题原始文件 %s", fullPath)
+			c.File(fullPath)
+		} else {
+			c.Status(http.StatusNotFound)
+		}
+	} else {
+		// 使用内嵌资源
+		staticFilePath := "static/" + filePath
+		if file, err := t.distFS.Open(staticFilePath); err == nil {
+			defer file.Close()
+			if stat, err := file.Stat(); err == nil && !stat.IsDir() {
+				// 生成基于文件内容的ETag
+				etag := generateFileETag(filePath, stat.ModTime(), stat.Size())
+
+				// 处理条件请求
+				if handleStaticFileConditionalRequest(c, etag, filePath) {
+					return
+				}
+
+				// 设置缓存头 - 根据文件类型设置不同策略
+				c.Header("ETag", etag)
+				if isHTMLFile(filePath) {
+					// HTML文件不缓存
+					c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
+					c.Header("Pragma", "no-cache")
+					c.Header("Expires", "0")
+				} else {
+					// 其他静态文件使用协商缓存（1年，但每次验证）
+					c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
+				}
+				c.Header("Vary", "Accept-Encoding")
+				c.Header("Content-Type", getContentType(filePath))
+
+				debugLog("动态路由：使用内嵌原始文件 %s", c.Param("filepath"))
+				http.ServeFileFS(c.Writer, c.Request, t.distFS, staticFilePath)
+			} else {
+				c.Status(http.StatusNotFound)
+			}
+		} else {
+			c.Status(http.StatusNotFound)
+		}
+	}
+}
+
+// SEORendererOptions 页面渲染组件的配置
+type SEORendererOptions struct {
+	SettingSvc    setting.SettingService
+	ArticleSvc    article_service.Service
+	CacheSvc      utility.CacheService
+	EventBus      *event.EventBus // 内容或评论变化时使外部主题页面缓存失效，为 nil 时只按有效期重新生成
+	CommentLister CommentLister   // 文章页随 HTML 注入评论使用的服务，为 nil 时不注入
+	DistFS        fs.FS           // 内嵌的前端构建产物，从中读取官方 index.html 模板
+}
+
+// SEORenderer 负责服务端渲染带 SEO 数据的 HTML 页面，并注册 RSS 和文章精简阅读页等由后端直接输出的路由
+type SEORenderer struct {
+	settingSvc        setting.SettingService
+	articleSvc        article_service.Service
+	cacheSvc          utility.CacheService
+	commentLister     CommentLister
+	funcMap           template.FuncMap
+	embeddedTemplates *template.Template
+	isr               *staticRegenerator
+}
+
+// NewSEORenderer 创建页面渲染组件，内嵌的 index.html 无法读取或解析时返回错误。
+// 应在 initEmbeddedAssetVersions 之后调用，以便为官方模板中引用的资源加上版本参数
+func NewSEORenderer(opts SEORendererOptions) (*SEORenderer, error) {
+	funcMap := TemplateFuncMap()
+
+	embeddedIndex, err := fs.ReadFile(opts.DistFS, "index.html")
+	if err != nil {
+		return nil, fmt.Errorf("读取嵌入式HTML模板失败: %w", err)
+	}
+	embeddedTemplates, err := template.New("index.html").Funcs(funcMap).Parse(stampAssetURLs(string(embeddedIndex)))
+	if err != nil {
+		return nil, fmt.Errorf("解析嵌入式HTML模板失败: %w", err)
+	}
+
+	r := &SEORenderer{
+		settingSvc:        opts.SettingSvc,
+		articleSvc:        opts.ArticleSvc,
+		cacheSvc:          opts.CacheSvc,
+		commentLister:     opts.CommentLister,
+		funcMap:           funcMap,
+		embeddedTemplates: embeddedTemplates,
+	}
+	r.isr = newStaticRegenerator(r, isrDir)
+	if opts.EventBus != nil {
+		r.isr.registerHandlers(opts.EventBus)
+	}
+	return r, nil
+}
+
+// Register 实现 RouteRegistrar
+func (r *SEORenderer) Register(engine *gin.Engine) {
+	// 配置 RSS feed
+	rssSvc := rss_service.NewService(r.articleSvc, r.settingSvc, r.cacheSvc)
+	rssHandler := rss.NewHandler(rssSvc, r.settingSvc)
+	engine.GET("/rss.xml", rssHandler.GetRSSFeed)
+	engine.GET("/feed.xml", rssHandler.GetRSSFeed)
+	engine.GET("/atom.xml", rssHandler.GetRSSFeed)
+	debugLog("RSS feed 路由已配置: /rss.xml, /feed.xml 和 /atom.xml")
+
+	// 文章精简阅读页，由后端直接渲染，不经过主题
+	engine.GET("/posts/:slug/lite", func(c *gin.Context) {
+		serveLiteArticle(c, r.settingSvc, r.articleSvc)
+	})
+}
+
+// serveThemePage 外部主题为该路径提供了独立的 HTML 文件时直接返回，返回是否已处理
+func (r *SEORenderer) serveThemePage(c *gin.Context, path string) bool {
+	if !shouldUseExternalTheme(path) {
+		return false
+	}
+	// 归档、标签、分类的详情页和分页没有同名 HTML 文件时，使用主题提供的列表页模板
+	for _, htmlFilePath := range []string{getPageHTMLPath(path), listPageTemplatePath(path)} {
+		if htmlFilePath == "" {
+			continue
+		}
+		fullPath := themeFilePath(htmlFilePath)
+		if _, err := os.Stat(fullPath); err == nil {
+			debugLog("多页面模式：返回独立HTML文件 %s，路径: %s", htmlFilePath, path)
+			// 开启增量静态再生成时优先返回缓存的渲染结果
+			if r.isr.serve(c, path, fullPath) {
+				return true
+			}
+			// 所有外部主题的 HTML 文件都通过 serveStaticHTMLFile 处理
+			// 该函数会自动判断是 Go 模板还是纯静态 HTML
+			serveStaticHTMLFile(c, fullPath, r.settingSvc, r.articleSvc, r.commentLister, r.funcMap, r.embeddedTemplates)
+			return true
+		}
+	}
+	return false
+}
+
+// renderIndex 渲染 index.html，由前端路由处理该路径
+func (r *SEORenderer) renderIndex(c *gin.Context, path string) {
+	// 核心改进：根据路径决定使用哪个模板
+	// - 后台路径（/admin/*, /login）：始终使用官方内嵌模板，且静态资源路径重写
+	// - 前台路径：根据 static 目录是否存在决定
+	isAdmin := isAdminPath(path)
+	useExternalTheme := shouldUseExternalTheme(path)
+	var templateInstance *template.Template
+	externalTemplateFailed := false
+
+	if useExternalTheme {
+		debugLog("动态路由：前台页面使用外部主题模式，路径: %s", path)
+		// 每次都重新解析外部模板，确保获取最新内容
+		indexPath := themeFilePath("index.html")
+		parsedTemplates, err := template.New("index.html").Funcs(r.funcMap).ParseFiles(indexPath)
+		if err != nil {
+			debugLog("解析外部HTML模板失败: %v，回退到内嵌模板", err)
+			reportTemplateError(indexPath, "parse", err)
+			templateInstance = r.embeddedTemplates
+			externalTemplateFailed = true
+		} else {
+			templateInstance = parsedTemplates
+		}
+	} else {
+		if isAdmin {
+			debugLog("动态路由：后台页面始终使用内嵌模板，路径: %s", path)
+		} else {
+			debugLog("动态路由：前台页面使用内嵌主题模式，路径: %s", path)
+		}
+		templateInstance = r.embeddedTemplates
+	}
+
+	// 渲染HTML页面
+	// 如果是后台页面且存在外部主题，需要重写静态资源路径
+	if isAdmin && isStaticModeActive() {
+		renderHTMLPageWithAdminRewrite(c, r.settingSvc, r.articleSvc, templateInstance)
+	} else if externalTemplateFailed {
+		renderEmbeddedFallback(c, func(c *gin.Context) {
+			renderHTMLPage(c, r.settingSvc, r.articleSvc, r.commentLister, templateInstance)
+		})
+	} else {
+		renderHTMLPage(c, r.settingSvc, r.articleSvc, r.commentLister, templateInstance)
+	}
+}
+
+// renderAdminPage 使用内嵌模板渲染后台页面
+func (r *SEORenderer) renderAdminPage(c *gin.Context) {
+	renderHTMLPageWithAdminRewrite(c, r.settingSvc, r.articleSvc, r.embeddedTemplates)
+}
+
+// SPAFallbackOptions 未匹配路由回退处理的配置
+type SPAFallbackOptions struct {
+	Renderer *SEORenderer
+	DistFS   fs.FS
+	APIOnly  bool // API-only 模式下前台请求由外部 SSR 服务处理，只回退后台页面
+}
+
+// SPAFallback 注册 NoRoute 处理：依次尝试外部主题的独立 HTML 页面、
+// 返回 index.html 交给前端路由、根目录静态文件，都不匹配时返回 404
+type SPAFallback struct {
+	renderer *SEORenderer
+	distFS   fs.FS
+	apiOnly  bool
+}
+
+// NewSPAFallback 创建 SPA 回退组件
+func NewSPAFallback(opts SPAFallbackOptions) *SPAFallback {
+	return &SPAFallback{renderer: opts.Renderer, distFS: opts.DistFS, apiOnly: opts.APIOnly}
+}
+
+// Register 实现 RouteRegistrar
+func (f *SPAFallback) Register(engine *gin.Engine) {
+	engine.NoRoute(f.handle)
+}
+
+func (f *SPAFallback) handle(c *gin.Context) {
+	path := c.Request.URL.Path
+
+	// API路由直接返回404
+	if strings.HasPrefix(path, "/api/") {
+		response.Fail(c, http.StatusNotFound, "API 路由未找到")
+		return
+	}
+
+	// 🆕 API-only 模式：仅处理后台路由，前台请求返回 404
+	// 前台由外部 SSR 服务（如 Next.js）处理，通过 Nginx 反向代理
+	if f.apiOnly {
+		// 后台路由继续处理
+		if isAdminPath(path) {
+			debugLog("API-only 模式：处理后台路由 %s", path)
+			// 判断是否应该返回 index.html 让前端路由处理
+			if shouldReturnIndexHTML(path) {
+				debugLog("SPA路由请求: %s，返回index.html让前端处理", path)
+				// 后台始终使用内嵌模板
+				f.renderer.renderAdminPage(c)
+				return
+			}
+		}
+
+		// 尝试提供后台静态文件（favicon.ico 等）
+		filePath := strings.TrimPrefix(path, "/")
+		if filePath != "" && isAdminPath(path) && tryServeStaticFile(c, filePath, false, f.distFS) {
+			return
+		}
+
+		// 前台请求在 API-only 模式下返回 404
+		// 说明：此请求应该由 Nginx 转发到 Next.js SSR 服务
+		if !isAdminPath(path) {
+			debugLog("API-only 模式：前台请求 %s 应由 SSR 服务处理", path)
+			response.Fail(c, http.StatusNotFound, "此路由由外部 SSR 服务处理")
+			return
+		}
+
+		// 其他未知请求，返回404
+		debugLog("未知请求: %s", path)
+		response.Fail(c, http.StatusNotFound, "页面未找到")
+		return
+	}
+
+	// 🆕 多页面模式支持：优先检查是否存在对应的 HTML 文件
+	// 这样可以为每个页面提供独立的 HTML，优化 SEO
+	// 支持两种主题类型：
+	//   1. Go 模板主题：HTML 中包含 {{ }} 变量，由 serveStaticHTMLFile 解析
+	//   2. 纯静态主题（如 Next.js）：直接返回 HTML，不做模板解析
+	if f.renderer.serveThemePage(c, path) {
+		return
+	}
+
+	// 判断是否应该返回 index.html 让前端路由处理
+	if shouldReturnIndexHTML(path) {
+		debugLog("SPA路由请求: %s，返回index.html让前端处理", path)
+		f.renderer.renderIndex(c, path)
+		return
+	}
+
+	// 尝试提供静态文件（处理根目录下的静态文件，如 favicon.ico, robots.txt 等）
+	filePath := strings.TrimPrefix(path, "/")
+	// 静态文件也需要区分前后台：后台的静态文件始终从 embed 读取
+	useExternalForStatic := !isAdminPath(path) && isStaticModeActive()
+	if filePath != "" && tryServeStaticFile(c, filePath, useExternalForStatic, f.distFS) {
+		return
+	}
+
+	// 如果是静态文件请求但找不到文件，返回404
+	if filePath != "" && isStaticFileRequest(filePath) {
+		debugLog("静态文件请求未找到: %s", filePath)
+		response.Fail(c, http.StatusNotFound, "文件未找到")
+		return
+	}
+
+	// 其他未知请求，返回404
+	debugLog("未知请求: %s", path)
+	response.Fail(c, http.StatusNotFound, "页面未找到")
+}
@@ -0,0 +1,175 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// testDistFS 模拟内嵌的前端构建产物
+func testDistFS() fstest.MapFS {
+	return fstest.MapFS{
+		"index.html":          {Data: []byte("<!DOCTYPE html><html><head></head><body>embedded</body></html>")},
+		"favicon.ico":         {Data: []byte("icon")},
+		"static/admin.css":    {Data: []byte("body{}")},
+		"assets/admin.js":     {Data: []byte("console.log(1)")},
+		"assets/admin.js.map": {Data: []byte("{}")},
+	}
+}
+
+func serve(engine *gin.Engine, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func newEngine(registrars ...RouteRegistrar) *gin.Engine {
+	engine := gin.New()
+	for _, r := range registrars {
+		r.Register(engine)
+	}
+	return engine
+}
+
+func TestAdminAssets(t *testing.T) {
+	engine := newEngine(NewAdminAssets(AdminAssetsOptions{DistFS: testDistFS()}))
+
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{"/admin-static/admin.css", http.StatusOK, "body{}"},
+		{"/admin-assets/admin.js", http.StatusOK, "console.log(1)"},
+		{"/admin-static/missing.css", http.StatusNotFound, ""},
+		{"/admin-assets/missing.js", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		w := serve(engine, tt.path)
+		if w.Code != tt.status {
+			t.Errorf("%s: 状态码 %d，期望 %d", tt.path, w.Code, tt.status)
+		}
+		if tt.body != "" && w.Body.String() != tt.body {
+			t.Errorf("%s: 响应内容 %q，期望 %q", tt.path, w.Body.String(), tt.body)
+		}
+	}
+}
+
+func TestThemeAssetsEmbedded(t *testing.T) {
+	t.Chdir(t.TempDir())
+	engine := newEngine(NewThemeAssets(ThemeAssetsOptions{DistFS: testDistFS()}))
+
+	if w := serve(engine, "/static/admin.css"); w.Code != http.StatusOK || w.Body.String() != "body{}" {
+		t.Errorf("未启用外部主题时应使用内嵌资源，得到 %d %q", w.Code, w.Body.String())
+	}
+	if w := serve(engine, "/assets/admin.js"); w.Code != http.StatusOK {
+		t.Errorf("未启用外部主题时应使用内嵌 assets，得到 %d", w.Code)
+	}
+	if w := serve(engine, "/static/missing.css"); w.Code != http.StatusNotFound {
+		t.Errorf("缺少的资源应返回 404，得到 %d", w.Code)
+	}
+}
+
+func TestThemeAssetsExternal(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	files := map[string]string{
+		"static/index.html":       "<!DOCTYPE html><html></html>",
+		"static/static/theme.css": "external",
+		"static/assets/admin.js":  "external js",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	engine := newEngine(NewThemeAssets(ThemeAssetsOptions{DistFS: testDistFS()}))
+
+	if w := serve(engine, "/static/theme.css"); w.Code != http.StatusOK || w.Body.String() != "external" {
+		t.Errorf("启用外部主题时应使用外部资源，得到 %d %q", w.Code, w.Body.String())
+	}
+	if w := serve(engine, "/assets/admin.js"); w.Body.String() != "external js" {
+		t.Errorf("外部主题的 assets 应优先于内嵌资源，得到 %q", w.Body.String())
+	}
+	if w := serve(engine, "/assets/admin.js.map"); w.Code != http.StatusOK || w.Body.String() != "{}" {
+		t.Errorf("外部主题缺少的 assets 应回退到内嵌资源，得到 %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestSEORenderer(t *testing.T) {
+	if _, err := NewSEORenderer(SEORendererOptions{DistFS: fstest.MapFS{}}); err == nil {
+		t.Error("缺少 index.html 时应返回错误")
+	}
+
+	renderer, err := NewSEORenderer(SEORendererOptions{DistFS: testDistFS()})
+	if err != nil {
+		t.Fatalf("创建渲染组件失败: %v", err)
+	}
+	engine := newEngine(renderer)
+	registered := make(map[string]bool)
+	for _, route := range engine.Routes() {
+		registered[route.Path] = true
+	}
+	for _, path := range []string{"/rss.xml", "/feed.xml", "/atom.xml", "/posts/:slug/lite"} {
+		if !registered[path] {
+			t.Errorf("未注册路由 %s", path)
+		}
+	}
+}
+
+func TestSPAFallback(t *testing.T) {
+	t.Chdir(t.TempDir())
+	renderer, err := NewSEORenderer(SEORendererOptions{DistFS: testDistFS()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	engine := newEngine(NewSPAFallback(SPAFallbackOptions{Renderer: renderer, DistFS: testDistFS()}))
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{"/api/unknown", http.StatusNotFound, "API 路由未找到"},
+		{"/favicon.ico", http.StatusOK, "icon"},
+		{"/missing.png", http.StatusNotFound, "文件未找到"},
+	}
+	for _, tt := range tests {
+		w := serve(engine, tt.path)
+		if w.Code != tt.status || !strings.Contains(w.Body.String(), tt.body) {
+			t.Errorf("%s: 得到 %d %q，期望 %d 且包含 %q", tt.path, w.Code, w.Body.String(), tt.status, tt.body)
+		}
+	}
+
+	apiOnly := newEngine(NewSPAFallback(SPAFallbackOptions{Renderer: renderer, DistFS: testDistFS(), APIOnly: true}))
+	if w := serve(apiOnly, "/posts/hello"); w.Code != http.StatusNotFound || !strings.Contains(w.Body.String(), "外部 SSR 服务") {
+		t.Errorf("API-only 模式下前台页面应返回 404，得到 %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestFrontendExtension(t *testing.T) {
+	saved := registeredFrontendExtensions()
+	t.Cleanup(func() { frontendExtensions.registrars = saved })
+
+	RegisterFrontendExtension(RouteRegistrarFunc(func(engine *gin.Engine) {
+		engine.GET("/plugin/hello", func(c *gin.Context) { c.String(http.StatusOK, "hello") })
+	}))
+	engine := newEngine(registeredFrontendExtensions()...)
+	if w := serve(engine, "/plugin/hello"); w.Code != http.StatusOK || w.Body.String() != "hello" {
+		t.Errorf("插件路由未生效，得到 %d %q", w.Code, w.Body.String())
+	}
+}
@@ -15,20 +15,27 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/parser"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/strutil"
 	"github.com/anzhiyu-c/anheyu-app/pkg/config"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
-	"github.com/anzhiyu-c/anheyu-app/pkg/handler/rss"
-	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
-	rss_service "github.com/anzhiyu-c/anheyu-app/pkg/service/rss"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/demo"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/favicon"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/localizer"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/siteverify"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/snippet"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/render"
@@ -37,12 +44,45 @@ import (
 type CustomHTMLRender struct{ Templates *template.Template }
 
 func (r CustomHTMLRender) Instance(name string, data interface{}) render.Render {
-	return render.HTML{Template: r.Templates, Name: name, Data: data}
+	return localizedHTML{HTML: render.HTML{Template: r.Templates, Name: name, Data: data}}
+}
+
+// localizedHTML 渲染完成后将页面中的第三方资源地址改写为本地地址，主题资源地址改写为 CDN 地址
+type localizedHTML struct{ render.HTML }
+
+func (r localizedHTML) Render(w http.ResponseWriter) error {
+	if !frontendRewriteEnabled() {
+		return r.HTML.Render(w)
+	}
+	r.WriteContentType(w)
+	var buf bytes.Buffer
+	var err error
+	if r.Name == "" {
+		err = r.Template.Execute(&buf, r.Data)
+	} else {
+		err = r.Template.ExecuteTemplate(&buf, r.Name, r.Data)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write([]byte(rewriteFrontendHTML(buf.String())))
+	return err
+}
+
+// resourceLocalizer 第三方资源本地化服务，未设置时不改写
+var resourceLocalizer *localizer.Service
+
+// SetResourceLocalizer 设置第三方资源本地化服务，应在 SetupFrontend 之前调用
+func SetResourceLocalizer(svc *localizer.Service) {
+	resourceLocalizer = svc
 }
 
 // 全局 Debug 标志
 var isDebugMode bool
 
+// 演示模式标志，开启时页面底部注入演示横幅
+var isDemoMode bool
+
 // API-only 模式标志
 // 当 ANHEYU_MODE=api 时，仅提供 API 和后台管理，前台由外部 SSR 服务处理
 var isAPIOnlyMode bool
@@ -312,60 +352,37 @@ func generateContentETag(content interface{}) string {
 }
 
 // ：设置智能缓存策略（针对CDN优化）
-func setSmartCacheHeaders(c *gin.Context, pageType string, etag string, maxAge int) {
-	// 检测是否通过CDN访问
-	isCDN := c.GetHeader("CF-Ray") != "" || // Cloudflare
-		c.GetHeader("X-Amz-Cf-Id") != "" || // CloudFront
-		c.GetHeader("X-Cache") != "" || // 通用CDN标识
-		c.GetHeader("X-Served-By") != "" // Fastly等
+// 各页面类型的缓存时间从后台配置读取，etag 为空时不设置 ETag
+func setSmartCacheHeaders(c *gin.Context, settingSvc setting.SettingService, pageType string, etag string) {
+	policy := loadPageCachePolicy(settingSvc, pageType)
 
+	// 关闭 CDN 识别时，所有请求都按 CDN 策略下发 s-maxage，适用于无法透传 CDN 标识头的场景
+	isCDN := true
+	if settingSvc.Get(constant.KeyPageCacheCDNDetect.String()) != "false" {
+		isCDN = isCDNRequest(c)
+	}
+
+	if isCDN {
+		c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d, s-maxage=%d, must-revalidate, stale-while-revalidate=%d",
+			policy.MaxAge, policy.SMaxAge, policy.StaleWhileRevalidate))
+	} else {
+		c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d, must-revalidate", policy.MaxAge))
+	}
+	if etag != "" {
+		c.Header("ETag", etag)
+	}
+	c.Header("Vary", "Accept-Encoding")
+
+	// 添加缓存标签，便于CDN批量清除
 	switch pageType {
 	case "article_detail":
-		if isCDN {
-			// CDN环境：更短的缓存时间，强制验证
-			c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d, s-maxage=%d, must-revalidate, stale-while-revalidate=60",
-				min(maxAge, 180), min(maxAge/2, 60))) // CDN缓存时间更短
-		} else {
-			// 直连环境：正常缓存策略
-			c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d, must-revalidate", maxAge))
-		}
-		c.Header("ETag", etag)
-		c.Header("Vary", "Accept-Encoding")
 		c.Header("X-Content-Type-Options", "nosniff")
-		// 添加缓存标签，便于CDN批量清除
 		c.Header("Cache-Tag", fmt.Sprintf("article-detail,article-%s", extractArticleIDFromPath(c.Request.URL.Path)))
-
 	case "home_page":
-		if isCDN {
-			// 首页：CDN缓存2分钟，浏览器缓存5分钟
-			c.Header("Cache-Control", "public, max-age=300, s-maxage=120, must-revalidate, stale-while-revalidate=30")
-		} else {
-			c.Header("Cache-Control", "public, max-age=300, must-revalidate") // 5分钟
-		}
-		c.Header("ETag", etag)
-		c.Header("Vary", "Accept-Encoding")
 		c.Header("Cache-Tag", "home-page,article-list")
-
 	case "static_page":
-		if isCDN {
-			// 静态页面：CDN缓存10分钟，浏览器缓存30分钟
-			c.Header("Cache-Control", "public, max-age=1800, s-maxage=600, must-revalidate, stale-while-revalidate=120")
-		} else {
-			c.Header("Cache-Control", "public, max-age=1800, must-revalidate") // 30分钟
-		}
-		c.Header("ETag", etag)
-		c.Header("Vary", "Accept-Encoding")
 		c.Header("Cache-Tag", "static-page")
-
 	default:
-		if isCDN {
-			// 默认：CDN缓存1分钟，浏览器缓存3分钟
-			c.Header("Cache-Control", "public, max-age=180, s-maxage=60, must-revalidate, stale-while-revalidate=30")
-		} else {
-			c.Header("Cache-Control", "public, max-age=180, must-revalidate") // 3分钟
-		}
-		c.Header("ETag", etag)
-		c.Header("Vary", "Accept-Encoding")
 		c.Header("Cache-Tag", "default")
 	}
 
@@ -396,13 +413,6 @@ func extractArticleIDFromPath(path string) string {
 	return "unknown"
 }
 
-// getAppVersion 获取应用版本号（用于缓存失效）
-func getAppVersion() string {
-	// 可以从环境变量、构建时间或版本文件中获取
-	// 这里使用简单的时间戳作为版本标识
-	return fmt.Sprintf("v%d", time.Now().Unix()/3600) // 每小时变化一次
-}
-
 // ：处理条件请求
 func handleConditionalRequest(c *gin.Context, etag string) bool {
 	// 检查 If-None-Match 头
@@ -417,16 +427,9 @@ func handleConditionalRequest(c *gin.Context, etag string) bool {
 }
 
 // getRequestScheme 确定请求的协议 (http 或 https)
+// 仅在请求来自可信代理时采用 X-Forwarded-Proto
 func getRequestScheme(c *gin.Context) string {
-	// 优先检查 X-Forwarded-Proto Header，这在反向代理后很常见
-	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
-		return proto
-	}
-	// 检查请求的 TLS 字段
-	if c.Request.TLS != nil {
-		return "https"
-	}
-	return "http"
+	return util.GetRequestScheme(c)
 }
 
 // getCanonicalURL 获取用于 SEO 的规范 URL
@@ -493,9 +496,8 @@ func tryServeCompressedFile(c *gin.Context, basePath string, staticMode bool, di
 	}
 
 	if staticMode {
-		// 外部主题模式
-		overrideDir := "static"
-		fullPath := filepath.Join(overrideDir, compressedPath)
+		// 外部主题模式：只使用与原文件同一层（覆盖目录、static 或父主题）的压缩版本，避免返回其它层中的旧压缩文件
+		fullPath := themeFilePath(basePath) + strings.TrimPrefix(compressedPath, basePath)
 		if fileInfo, err := os.Stat(fullPath); err == nil {
 			c.Header("Content-Encoding", contentEncoding)
 			c.Header("Content-Type", getContentType(basePath))
@@ -580,6 +582,12 @@ func isHTMLFile(filePath string) bool {
 
 // tryServeStaticFile 尝试从对应的文件系统中提供静态文件（优先压缩版本）
 func tryServeStaticFile(c *gin.Context, filePath string, staticMode bool, distFS fs.FS) bool {
+	// 外部主题中被访问策略禁止的文件按不存在处理
+	if staticMode && themeFileBlocked(filePath) {
+		debugLog("外部主题文件已被访问策略禁止: %s", filePath)
+		return false
+	}
+
 	// 首先尝试提供压缩文件
 	if compressed, compressedPath, modTime, size := tryServeCompressedFile(c, filePath, staticMode, distFS); compressed {
 		// 生成基于压缩文件的ETag
@@ -604,6 +612,7 @@ func tryServeStaticFile(c *gin.Context, filePath string, staticMode bool, distFS
 		c.Header("Vary", "Accept-Encoding")
 
 		if staticMode {
+			applyThemeHTMLPolicy(c, filePath)
 			// log.Printf("提供外部压缩静态文件: %s", compressedPath)
 			c.File(compressedPath)
 		} else {
@@ -615,9 +624,8 @@ func tryServeStaticFile(c *gin.Context, filePath string, staticMode bool, distFS
 
 	// 如果没有压缩版本，提供原文件
 	if staticMode {
-		// 外部主题模式：从 static 目录查找文件
-		overrideDir := "static"
-		fullPath := filepath.Join(overrideDir, filePath)
+		// 外部主题模式：从 static 目录查找文件，覆盖目录中的同名文件优先
+		fullPath := themeFilePath(filePath)
 		if fileInfo, err := os.Stat(fullPath); err == nil {
 			// 生成基于文件内容的ETag
 			etag := generateFileETag(filePath, fileInfo.ModTime(), fileInfo.Size())
@@ -640,6 +648,7 @@ func tryServeStaticFile(c *gin.Context, filePath string, staticMode bool, distFS
 			}
 			c.Header("Vary", "Accept-Encoding")
 			c.Header("Content-Type", getContentType(filePath))
+			applyThemeHTMLPolicy(c, filePath)
 
 			// debugLog("提供外部原始静态文件: %s", fullPath)
 			c.File(fullPath)
@@ -811,8 +820,8 @@ func isStaticModeActive() bool {
 		return false
 	}
 
-	// 检查 index.html 是否存在
-	indexPath := filepath.Join(staticDirName, "index.html")
+	// 检查 index.html 是否存在（子主题可以使用父主题的 index.html）
+	indexPath := themeFilePath("index.html")
 	if _, err := os.Stat(indexPath); os.IsNotExist(err) {
 		return false
 	}
@@ -853,16 +862,20 @@ func isStaticModeActive() bool {
 }
 
 // SetupFrontend 封装了所有与前端静态资源和模板相关的配置（动态模式）
-func SetupFrontend(engine *gin.Engine, settingSvc setting.SettingService, articleSvc article_service.Service, cacheSvc utility.CacheService, embeddedFS embed.FS, cfg *config.Config, pageRepo repository.PageRepository) {
+func SetupFrontend(engine *gin.Engine, settingSvc setting.SettingService, articleSvc article_service.Service, cacheSvc utility.CacheService, eventBus *event.EventBus, commentLister CommentLister, embeddedFS embed.FS, cfg *config.Config, pageRepo repository.PageRepository) {
 	// 保存 pageRepo 到全局变量，用于 SEO 数据获取
 	globalPageRepo = pageRepo
 
 	// 从配置中读取 Debug 模式
 	isDebugMode = cfg.GetBool(config.KeyServerDebug)
+	isDemoMode = cfg.GetBool(config.KeyDemoEnable)
 
 	// 检查 API-only 模式
 	isAPIOnlyMode = IsAPIOnlyMode()
 
+	// 外部主题文件访问策略从配置读取，后台修改后下一次请求即生效
+	themeFileSettings = settingSvc
+
 	// 启动时打印主题模式信息
 	log.Println("========================================")
 	if isAPIOnlyMode {
@@ -886,358 +899,37 @@ func SetupFrontend(engine *gin.Engine, settingSvc setting.SettingService, articl
 
 	debugLog("正在配置动态前端路由系统...")
 
-	// 配置 RSS feed
-	rssSvc := rss_service.NewService(articleSvc, settingSvc, cacheSvc)
-	rssHandler := rss.NewHandler(rssSvc, settingSvc)
-	engine.GET("/rss.xml", rssHandler.GetRSSFeed)
-	engine.GET("/feed.xml", rssHandler.GetRSSFeed)
-	engine.GET("/atom.xml", rssHandler.GetRSSFeed)
-	debugLog("RSS feed 路由已配置: /rss.xml, /feed.xml 和 /atom.xml")
-
-	// 准备一个通用的模板函数映射
-	funcMap := template.FuncMap{
-		"json": func(v interface{}) template.JS {
-			a, _ := json.Marshal(v)
-			return template.JS(a)
-		},
-	}
-
 	// 预加载嵌入式资源，避免每次请求都处理
 	distFS, err := fs.Sub(embeddedFS, "assets/dist")
 	if err != nil {
 		log.Fatalf("致命错误: 无法从嵌入的资源中创建 'assets/dist' 子文件系统: %v", err)
 	}
 
-	embeddedTemplates, err := template.New("index.html").Funcs(funcMap).ParseFS(distFS, "index.html")
+	// 根据内嵌资源内容计算版本号，并为官方模板中引用的资源加上版本参数
+	initEmbeddedAssetVersions(distFS)
+	renderer, err := NewSEORenderer(SEORendererOptions{
+		SettingSvc:    settingSvc,
+		ArticleSvc:    articleSvc,
+		CacheSvc:      cacheSvc,
+		EventBus:      eventBus,
+		CommentLister: commentLister,
+		DistFS:        distFS,
+	})
 	if err != nil {
-		log.Fatalf("解析嵌入式HTML模板失败: %v", err)
+		log.Fatalf("%v", err)
 	}
 
-	// 后台专用静态文件路由 - 始终从 embed 读取，不受外部主题影响
-	// 这是前后台分离的关键：后台的 JS/CSS 使用 /admin-static/ 路径
-	engine.GET("/admin-static/*filepath", func(c *gin.Context) {
-		filePath := strings.TrimPrefix(c.Param("filepath"), "/")
-		debugLog("后台静态资源请求: %s (始终使用内嵌资源)", filePath)
-
-		// 首先尝试提供压缩文件
-		if compressed, compressedPath, modTime, size := tryServeCompressedFile(c, "static/"+filePath, false, distFS); compressed {
-			etag := generateFileETag(compressedPath, modTime, size)
-			if handleStaticFileConditionalRequest(c, etag, "static/"+filePath) {
-				return
-			}
-			c.Header("ETag", etag)
-			if isHTMLFile(filePath) {
-				c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
-				c.Header("Pragma", "no-cache")
-				c.Header("Expires", "0")
-			} else {
-				c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
-			}
-			c.Header("Vary", "Accept-Encoding")
-			http.ServeFileFS(c.Writer, c.Request, distFS, compressedPath)
-			return
-		}
-
-		// 提供原始文件
-		staticFilePath := "static/" + filePath
-		if file, err := distFS.Open(staticFilePath); err == nil {
-			defer file.Close()
-			if stat, err := file.Stat(); err == nil && !stat.IsDir() {
-				etag := generateFileETag(filePath, stat.ModTime(), stat.Size())
-				if handleStaticFileConditionalRequest(c, etag, filePath) {
-					return
-				}
-				c.Header("ETag", etag)
-				if isHTMLFile(filePath) {
-					c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
-					c.Header("Pragma", "no-cache")
-					c.Header("Expires", "0")
-				} else {
-					c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
-				}
-				c.Header("Vary", "Accept-Encoding")
-				c.Header("Content-Type", getContentType(filePath))
-				http.ServeFileFS(c.Writer, c.Request, distFS, staticFilePath)
-				return
-			}
-		}
-		c.Status(http.StatusNotFound)
-	})
-
-	// 后台专用 assets 路由（别名）- 处理 Vue 前端的 JS/CSS 资源
-	// 当外部主题存在时，后台 HTML 中的资源路径会被重写为 /admin-assets/
-	// 这个路由始终从内嵌资源加载，确保后台不受外部主题影响
-	engine.GET("/admin-assets/*filepath", func(c *gin.Context) {
-		filePath := strings.TrimPrefix(c.Param("filepath"), "/")
-		debugLog("后台 admin-assets 资源请求: %s (始终使用内嵌资源)", filePath)
-		serveEmbeddedAssets(c, filePath, distFS)
-	})
-
-	// 动态 assets 路由 - 优先使用外部主题资源，回退到内嵌资源
-	// 这样可以兼容任何类型的外部主题（不限于 Next.js）
-	engine.GET("/assets/*filepath", func(c *gin.Context) {
-		filePath := strings.TrimPrefix(c.Param("filepath"), "/")
-
-		// 如果外部主题模式激活，先检查外部主题是否有此资源
-		if isStaticModeActive() {
-			externalPath := filepath.Join("static", "assets", filePath)
-			if fileInfo, err := os.Stat(externalPath); err == nil && !fileInfo.IsDir() {
-				// 外部主题有此资源，从外部加载
-				debugLog("assets 资源请求: %s (使用外部主题资源)", filePath)
-				etag := generateFileETag(filePath, fileInfo.ModTime(), fileInfo.Size())
-				if handleStaticFileConditionalRequest(c, etag, filePath) {
-					return
-				}
-				c.Header("ETag", etag)
-				c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
-				c.Header("Vary", "Accept-Encoding")
-				c.Header("Content-Type", getContentType(filePath))
-				c.File(externalPath)
-				return
-			}
-		}
-
-		// 外部主题没有此资源或不在外部主题模式，从内嵌资源加载
-		debugLog("assets 资源请求: %s (使用内嵌资源)", filePath)
-		serveEmbeddedAssets(c, filePath, distFS)
-	})
-
-	// 动态静态文件路由 - 前台静态资源，根据外部主题是否存在决定来源
-	engine.GET("/static/*filepath", func(c *gin.Context) {
-		filePath := strings.TrimPrefix(c.Param("filepath"), "/")
-		staticMode := isStaticModeActive()
-
-		// 首先尝试提供压缩文件
-		if compressed, compressedPath, modTime, size := tryServeCompressedFile(c, "static/"+filePath, staticMode, distFS); compressed {
-			// 生成基于压缩文件的ETag
-			etag := generateFileETag(compressedPath, modTime, size)
-
-			// 处理条件请求
-			if handleStaticFileConditionalRequest(c, etag, "static/"+filePath) {
-				return
-			}
-
-			// 设置缓存头 - 根据文件类型设置不同策略
-			c.Header("ETag", etag)
-			if isHTMLFile(filePath) {
-				// HTML文件不缓存
-				c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
-				c.Header("Pragma", "no-cache")
-				c.Header("Expires", "0")
-			} else {
-				// 其他静态文件使用协商缓存（1年，但每次验证）
-				c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
-			}
-			c.Header("Vary", "Accept-Encoding")
-
-			if staticMode {
-				debugLog("动态路由：使用外部主题压缩文件 %s", compressedPath)
-				c.File(compressedPath)
-			} else {
-				debugLog("动态路由：使用内嵌压缩文件 %s", compressedPath)
-				http.ServeFileFS(c.Writer, c.Request, distFS, compressedPath)
-			}
-			return
-		}
-
-		// 如果没有压缩版本，提供原文件
-		if staticMode {
-			// 使用外部 static 目录
-			overrideDir := "static"
-			fullPath := filepath.Join(overrideDir, "static", filePath)
-
-			if fileInfo, err := os.Stat(fullPath); err == nil {
-				// 生成基于文件内容的ETag
-				etag := generateFileETag(filePath, fileInfo.ModTime(), fileInfo.Size())
-
-				// 处理条件请求
-				if handleStaticFileConditionalRequest(c, etag, filePath) {
-					return
-				}
-
-				// 设置缓存头 - 根据文件类型设置不同策略
-				c.Header("ETag", etag)
-				if isHTMLFile(filePath) {
-					// HTML文件不缓存
-					c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
-					c.Header("Pragma", "no-cache")
-					c.Header("Expires", "0")
-				} else {
-					// 其他静态文件使用协商缓存（1年，但每次验证）
-					c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
-				}
-				c.Header("Vary", "Accept-Encoding")
-				c.Header("Content-Type", getContentType(filePath))
-
-				debugLog("动态路由：使用外部主题原始文件 %s", c.Param("filepath"))
-				staticHandler := http.StripPrefix("/static", http.FileServer(http.Dir(filepath.Join(overrideDir, "static"))))
-				staticHandler.ServeHTTP(c.Writer, c.Request)
-			} else {
-				c.Status(http.StatusNotFound)
-			}
-		} else {
-			// 使用内嵌资源
-			staticFilePath := "static/" + filePath
-			if file, err := distFS.Open(staticFilePath); err == nil {
-				defer file.Close()
-				if stat, err := file.Stat(); err == nil && !stat.IsDir() {
-					// 生成基于文件内容的ETag
-					etag := generateFileETag(filePath, stat.ModTime(), stat.Size())
-
-					// 处理条件请求
-					if handleStaticFileConditionalRequest(c, etag, filePath) {
-						return
-					}
-
-					// 设置缓存头 - 根据文件类型设置不同策略
-					c.Header("ETag", etag)
-					if isHTMLFile(filePath) {
-						// HTML文件不缓存
-						c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
-						c.Header("Pragma", "no-cache")
-						c.Header("Expires", "0")
-					} else {
-						// 其他静态文件使用协商缓存（1年，但每次验证）
-						c.Header("Cache-Control", "public, max-age=31536000, must-revalidate")
-					}
-					c.Header("Vary", "Accept-Encoding")
-					c.Header("Content-Type", getContentType(filePath))
-
-					debugLog("动态路由：使用内嵌原始文件 %s", c.Param("filepath"))
-					http.ServeFileFS(c.Writer, c.Request, distFS, staticFilePath)
-				} else {
-					c.Status(http.StatusNotFound)
-				}
-			} else {
-				c.Status(http.StatusNotFound)
-			}
-		}
-	})
-
-	// 动态根目录文件路由
-	engine.NoRoute(func(c *gin.Context) {
-		path := c.Request.URL.Path
-
-		// API路由直接返回404
-		if strings.HasPrefix(path, "/api/") {
-			response.Fail(c, http.StatusNotFound, "API 路由未找到")
-			return
-		}
-
-		// 🆕 API-only 模式：仅处理后台路由，前台请求返回 404
-		// 前台由外部 SSR 服务（如 Next.js）处理，通过 Nginx 反向代理
-		if isAPIOnlyMode {
-			// 后台路由继续处理
-			if isAdminPath(path) {
-				debugLog("API-only 模式：处理后台路由 %s", path)
-				// 判断是否应该返回 index.html 让前端路由处理
-				if shouldReturnIndexHTML(path) {
-					debugLog("SPA路由请求: %s，返回index.html让前端处理", path)
-					// 后台始终使用内嵌模板
-					renderHTMLPageWithAdminRewrite(c, settingSvc, articleSvc, embeddedTemplates)
-					return
-				}
-			}
-
-			// 尝试提供后台静态文件（favicon.ico 等）
-			filePath := strings.TrimPrefix(path, "/")
-			if filePath != "" && isAdminPath(path) && tryServeStaticFile(c, filePath, false, distFS) {
-				return
-			}
-
-			// 前台请求在 API-only 模式下返回 404
-			// 说明：此请求应该由 Nginx 转发到 Next.js SSR 服务
-			if !isAdminPath(path) {
-				debugLog("API-only 模式：前台请求 %s 应由 SSR 服务处理", path)
-				response.Fail(c, http.StatusNotFound, "此路由由外部 SSR 服务处理")
-				return
-			}
-
-			// 其他未知请求，返回404
-			debugLog("未知请求: %s", path)
-			response.Fail(c, http.StatusNotFound, "页面未找到")
-			return
-		}
-
-		// 🆕 多页面模式支持：优先检查是否存在对应的 HTML 文件
-		// 这样可以为每个页面提供独立的 HTML，优化 SEO
-		// 支持两种主题类型：
-		//   1. Go 模板主题：HTML 中包含 {{ }} 变量，由 serveStaticHTMLFile 解析
-		//   2. 纯静态主题（如 Next.js）：直接返回 HTML，不做模板解析
-		if shouldUseExternalTheme(path) && !isAdminPath(path) {
-			htmlFilePath := getPageHTMLPath(path)
-			if htmlFilePath != "" {
-				fullPath := filepath.Join("static", htmlFilePath)
-				if _, err := os.Stat(fullPath); err == nil {
-					debugLog("多页面模式：返回独立HTML文件 %s，路径: %s", htmlFilePath, path)
-					// 所有外部主题的 HTML 文件都通过 serveStaticHTMLFile 处理
-					// 该函数会自动判断是 Go 模板还是纯静态 HTML
-					serveStaticHTMLFile(c, fullPath, settingSvc, articleSvc, funcMap)
-					return
-				}
-			}
-		}
-
-		// 判断是否应该返回 index.html 让前端路由处理
-		if shouldReturnIndexHTML(path) {
-			debugLog("SPA路由请求: %s，返回index.html让前端处理", path)
-
-			// 核心改进：根据路径决定使用哪个模板
-			// - 后台路径（/admin/*, /login）：始终使用官方内嵌模板，且静态资源路径重写
-			// - 前台路径：根据 static 目录是否存在决定
-			isAdmin := isAdminPath(path)
-			useExternalTheme := shouldUseExternalTheme(path)
-			var templateInstance *template.Template
-
-			if useExternalTheme {
-				debugLog("动态路由：前台页面使用外部主题模式，路径: %s", path)
-				// 每次都重新解析外部模板，确保获取最新内容
-				overrideDir := "static"
-				parsedTemplates, err := template.New("index.html").Funcs(funcMap).ParseFiles(filepath.Join(overrideDir, "index.html"))
-				if err != nil {
-					debugLog("解析外部HTML模板失败: %v，回退到内嵌模板", err)
-					templateInstance = embeddedTemplates
-				} else {
-					templateInstance = parsedTemplates
-				}
-			} else {
-				if isAdmin {
-					debugLog("动态路由：后台页面始终使用内嵌模板，路径: %s", path)
-				} else {
-					debugLog("动态路由：前台页面使用内嵌主题模式，路径: %s", path)
-				}
-				templateInstance = embeddedTemplates
-			}
-
-			// 渲染HTML页面
-			// 如果是后台页面且存在外部主题，需要重写静态资源路径
-			if isAdmin && isStaticModeActive() {
-				renderHTMLPageWithAdminRewrite(c, settingSvc, articleSvc, templateInstance)
-			} else {
-				renderHTMLPage(c, settingSvc, articleSvc, templateInstance)
-			}
-			return
-		}
-
-		// 尝试提供静态文件（处理根目录下的静态文件，如 favicon.ico, robots.txt 等）
-		filePath := strings.TrimPrefix(path, "/")
-		// 静态文件也需要区分前后台：后台的静态文件始终从 embed 读取
-		useExternalForStatic := !isAdminPath(path) && isStaticModeActive()
-		if filePath != "" && tryServeStaticFile(c, filePath, useExternalForStatic, distFS) {
-			return
-		}
-
-		// 如果是静态文件请求但找不到文件，返回404
-		if filePath != "" && isStaticFileRequest(filePath) {
-			debugLog("静态文件请求未找到: %s", filePath)
-			response.Fail(c, http.StatusNotFound, "文件未找到")
-			return
-		}
-
-		// 其他未知请求，返回404
-		debugLog("未知请求: %s", path)
-		response.Fail(c, http.StatusNotFound, "页面未找到")
-	})
+	registrars := []RouteRegistrar{
+		renderer,
+		NewAdminAssets(AdminAssetsOptions{DistFS: distFS}),
+		NewThemeAssets(ThemeAssetsOptions{DistFS: distFS}),
+	}
+	registrars = append(registrars, registeredFrontendExtensions()...)
+	// SPA 回退处理所有未匹配的请求，放在最后
+	registrars = append(registrars, NewSPAFallback(SPAFallbackOptions{Renderer: renderer, DistFS: distFS, APIOnly: isAPIOnlyMode}))
+	for _, registrar := range registrars {
+		registrar.Register(engine)
+	}
 
 	debugLog("动态前端路由系统配置完成")
 }
@@ -1268,6 +960,18 @@ func ensureScriptTagsClosed(html string) string {
 	return html
 }
 
+// customInjectionHTML 合并自定义头部/底部 HTML 与当前路径生效的代码片段
+func customInjectionHTML(c *gin.Context, settingSvc setting.SettingService) (string, string) {
+	header := ensureScriptTagsClosed(settingSvc.Get(constant.KeyCustomHeaderHTML.String()))
+	footer := ensureScriptTagsClosed(settingSvc.Get(constant.KeyCustomFooterHTML.String()))
+	head, bodyEnd := snippet.Render(settingSvc, c.Request.URL.Path)
+	head = siteverify.MetaHTML(settingSvc) + favicon.HeadHTML(settingSvc) + head
+	if isDemoMode {
+		bodyEnd += demo.BannerHTML
+	}
+	return header + head, footer + bodyEnd
+}
+
 // MenuItem 定义导航菜单项结构
 type MenuItem struct {
 	Title      string     `json:"title"`
@@ -1396,7 +1100,9 @@ func generateBreadcrumbList(path string, baseURL string, settingSvc setting.Sett
 
 // convertImagesToLazyLoad 将HTML中的图片转换为懒加载格式
 // 在服务端渲染时直接生成懒加载HTML，避免浏览器在解析时就开始加载图片
-func convertImagesToLazyLoad(html string) string {
+// 前 skipFirst 张图片保持原样直接加载（首屏 LCP 图片）；其余图片附带 <noscript> 原图，
+// 不执行 JS 的爬虫和访客仍能看到图片
+func convertImagesToLazyLoad(html string, skipFirst int) string {
 	if html == "" {
 		return html
 	}
@@ -1435,13 +1141,28 @@ func convertImagesToLazyLoad(html string) string {
 			return match
 		}
 
+		// 首屏图片不做懒加载
+		if skipFirst > 0 {
+			skipFirst--
+			return match
+		}
+
+		// 媒体库为本站图片生成了低质量占位图时，用它作为初始 src，加载完成前显示模糊预览
+		placeholder := placeholderImage
+		if lqip := lazyImagePlaceholder(originalSrc); lqip != "" {
+			placeholder = lqip
+		}
+
 		// 构建新的 img 标签
 		// 1. 将原始 src 替换为占位符
-		newMatch := srcRegex.ReplaceAllString(match, fmt.Sprintf(`src="%s"`, placeholderImage))
+		newMatch := srcRegex.ReplaceAllString(match, fmt.Sprintf(`src="%s"`, placeholder))
 
 		// 2. 添加 data-src 属性（在 src 之后插入）
-		newMatch = strings.Replace(newMatch, fmt.Sprintf(`src="%s"`, placeholderImage),
-			fmt.Sprintf(`src="%s" data-src="%s"`, placeholderImage, originalSrc), 1)
+		newMatch = strings.Replace(newMatch, fmt.Sprintf(`src="%s"`, placeholder),
+			fmt.Sprintf(`src="%s" data-src="%s"`, placeholder, originalSrc), 1)
+		if placeholder != placeholderImage {
+			newMatch = strings.Replace(newMatch, "<img", `<img data-lqip="true"`, 1)
+		}
 
 		// 3. 添加懒加载相关的 class
 		classRegex := regexp.MustCompile(`class=["']([^"']+)["']`)
@@ -1460,12 +1181,22 @@ func convertImagesToLazyLoad(html string) string {
 		// 4. 添加 data-lazy-processed 标记
 		newMatch = strings.Replace(newMatch, "<img", `<img data-lazy-processed="true"`, 1)
 
-		return newMatch
+		// 5. 附带原图作为不执行 JS 时的回退
+		return newMatch + "<noscript>" + match + "</noscript>"
 	})
 
 	return result
 }
 
+// lazyLoadSkipFirst 文章正文中不做懒加载的前几张图片数量
+func lazyLoadSkipFirst(settingSvc setting.SettingService) int {
+	n, err := strconv.Atoi(strings.TrimSpace(settingSvc.Get(constant.KeyPostLazyLoadSkipFirst.String())))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
 // SocialLink 定义社交链接结构
 type SocialLink struct {
 	Title string `json:"title"`
@@ -1513,6 +1244,73 @@ func generateSocialMediaLinks(settingSvc setting.SettingService) []string {
 	return allLinks
 }
 
+// getHomePageData 首页请求时按首页布局获取区块数据，其余页面返回 nil
+func getHomePageData(c *gin.Context, articleSvc article_service.Service) *model.HomePageData {
+	if articleSvc == nil || (c.Request.URL.Path != "/" && c.Request.URL.Path != "") {
+		return nil
+	}
+	data, err := articleSvc.GetHomePageData(c.Request.Context())
+	if err != nil {
+		log.Printf("[首页布局] 获取首页数据失败: %v", err)
+		return nil
+	}
+	return data
+}
+
+// generateArticleAuthorMeta 生成文章作者的 SEO 数据：作者名称列表和 Article 结构化数据（JSON-LD）
+// 文章设置了共同作者时使用作者资料，否则使用版权作者或站长名称
+func generateArticleAuthorMeta(article *model.ArticleResponse, pageURL string, settingSvc setting.SettingService) ([]string, template.JS) {
+	var names []string
+	var persons []map[string]interface{}
+	for _, profile := range article.Authors {
+		names = append(names, profile.Name)
+		person := map[string]interface{}{"@type": "Person", "name": profile.Name}
+		if profile.Website != "" {
+			person["url"] = profile.Website
+		}
+		var sameAs []string
+		for _, link := range profile.Socials {
+			if strings.HasPrefix(link, "http://") || strings.HasPrefix(link, "https://") {
+				sameAs = append(sameAs, link)
+			}
+		}
+		if len(sameAs) > 0 {
+			sort.Strings(sameAs)
+			person["sameAs"] = sameAs
+		}
+		persons = append(persons, person)
+	}
+
+	if len(names) == 0 {
+		name := article.CopyrightAuthor
+		if name == "" {
+			name = settingSvc.Get(constant.KeyFrontDeskSiteOwnerName.String())
+		}
+		names = []string{name}
+		persons = []map[string]interface{}{{"@type": "Person", "name": name}}
+	}
+
+	jsonLD := map[string]interface{}{
+		"@context":         "https://schema.org",
+		"@type":            "Article",
+		"headline":         article.Title,
+		"datePublished":    article.CreatedAt.Format(time.RFC3339),
+		"dateModified":     article.UpdatedAt.Format(time.RFC3339),
+		"author":           persons,
+		"mainEntityOfPage": pageURL,
+	}
+	if article.CoverURL != "" {
+		jsonLD["image"] = article.CoverURL
+	}
+
+	data, err := json.Marshal(jsonLD)
+	if err != nil {
+		log.Printf("[SEO] 生成文章结构化数据失败: %v", err)
+		return names, ""
+	}
+	return names, template.JS(data)
+}
+
 // rewriteStaticPathsForAdmin 为后台页面重写静态资源路径
 // 将 /static/ 和 /assets/ 替换为 /admin-static/ 和 /admin-assets/，确保后台资源始终从官方 embed 加载
 func rewriteStaticPathsForAdmin(html string) string {
@@ -1598,11 +1396,9 @@ func renderHTMLPageWithAdminRewrite(c *gin.Context, settingSvc setting.SettingSe
 }
 
 // renderHTMLPage 渲染HTML页面的通用函数（版本）
-func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSvc article_service.Service, templates *template.Template) {
-	// 🚫 强制禁用HTML页面的所有缓存
-	c.Header("Cache-Control", "no-cache, no-store, must-revalidate, private, max-age=0")
-	c.Header("Pragma", "no-cache")
-	c.Header("Expires", "0")
+func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSvc article_service.Service, commentLister CommentLister, templates *template.Template) {
+	// 根据后台配置的页面缓存策略设置缓存头（默认禁用HTML页面缓存）
+	applyPageCachePolicy(c, settingSvc)
 
 	// 获取用于 SEO 的规范 URL（优先使用 SITE_URL 配置）
 	fullURL := getCanonicalURL(c, settingSvc)
@@ -1638,17 +1434,19 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 			}
 
 			// 🖼️ 关键修复：在服务端渲染时将图片转换为懒加载格式，避免浏览器解析HTML时自动加载
-			articleResponse.ContentHTML = convertImagesToLazyLoad(articleResponse.ContentHTML)
+			articleResponse.ContentHTML = convertImagesToLazyLoad(articleResponse.ContentHTML, lazyLoadSkipFirst(settingSvc))
 
 			// 处理自定义HTML，确保script标签正确闭合
-			customHeaderHTML := ensureScriptTagsClosed(settingSvc.Get(constant.KeyCustomHeaderHTML.String()))
-			customFooterHTML := ensureScriptTagsClosed(settingSvc.Get(constant.KeyCustomFooterHTML.String()))
+			customHeaderHTML, customFooterHTML := customInjectionHTML(c, settingSvc)
 
 			// 创建包含时间戳的初始数据
 			initialDataWithTimestamp := map[string]interface{}{
 				"data":          articleResponse,
 				"__timestamp__": time.Now().UnixMilli(), // 添加时间戳用于客户端验证数据新鲜度
 			}
+			if comments := ssrComments(c, settingSvc, commentLister, &articleResponse.ArticleResponse); comments != nil {
+				initialDataWithTimestamp["comments"] = comments
+			}
 
 			// 确定使用的 keywords：优先使用文章的 keywords，否则使用全站的 keywords
 			keywords := settingSvc.Get(constant.KeySiteKeywords.String())
@@ -1667,6 +1465,9 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 			// 生成社交媒体链接
 			socialMediaLinks := generateSocialMediaLinks(settingSvc)
 
+			// 生成作者元数据和文章结构化数据
+			articleAuthors, articleJSONLD := generateArticleAuthorMeta(&articleResponse.ArticleResponse, fullURL, settingSvc)
+
 			// 使用传入的模板实例渲染
 			render := CustomHTMLRender{Templates: templates}
 			c.Render(http.StatusOK, render.Instance("index.html", gin.H{
@@ -1689,8 +1490,11 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 				// --- Article 元标签数据 ---
 				"articlePublishedTime": articleResponse.CreatedAt.Format(time.RFC3339),
 				"articleModifiedTime":  articleResponse.UpdatedAt.Format(time.RFC3339),
-				"articleAuthor":        articleResponse.CopyrightAuthor,
+				"articleAuthor":        strings.Join(articleAuthors, ", "),
+				"articleAuthors":       articleAuthors,
 				"articleTags":          articleTags,
+				"articleJsonLd":        articleJSONLD,
+				"articleViewCount":     articleResponse.ViewCount,
 				// --- 面包屑导航数据 ---
 				"breadcrumbList": breadcrumbList,
 				// --- 社交媒体链接 ---
@@ -1698,6 +1502,8 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 				// --- 自定义HTML（包含CSS/JS） ---
 				"customHeaderHTML": template.HTML(customHeaderHTML),
 				"customFooterHTML": template.HTML(customFooterHTML),
+				// --- 演示模式 ---
+				"demoMode": isDemoMode,
 			}))
 			return
 		}
@@ -1726,8 +1532,7 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 	}
 
 	// 处理自定义HTML，确保script标签正确闭合
-	customHeaderHTML := ensureScriptTagsClosed(settingSvc.Get(constant.KeyCustomHeaderHTML.String()))
-	customFooterHTML := ensureScriptTagsClosed(settingSvc.Get(constant.KeyCustomFooterHTML.String()))
+	customHeaderHTML, customFooterHTML := customInjectionHTML(c, settingSvc)
 
 	// 生成面包屑导航数据
 	baseURL := settingSvc.Get(constant.KeySiteURL.String())
@@ -1736,6 +1541,9 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 	// 生成社交媒体链接
 	socialMediaLinks := generateSocialMediaLinks(settingSvc)
 
+	// 首页注入首页布局数据
+	homeData := getHomePageData(c, articleSvc)
+
 	// 使用传入的模板实例渲染
 	render := CustomHTMLRender{Templates: templates}
 	c.Render(http.StatusOK, render.Instance("index.html", gin.H{
@@ -1746,8 +1554,8 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 		"author":          settingSvc.Get(constant.KeyFrontDeskSiteOwnerName.String()),
 		"themeColor":      "#f7f9fe",
 		"favicon":         settingSvc.Get(constant.KeyIconURL.String()),
-		// --- 用于 Vue 水合的数据 ---
-		"initialData":   nil,
+		// --- 用于 Vue 水合的数据（标签、分类、归档页注入标签云和分类树，自定义页面注入数据绑定） ---
+		"initialData":   ssrInitialData(c),
 		"ogType":        ogType,
 		"ogUrl":         fullURL,
 		"ogTitle":       defaultTitle,
@@ -1760,6 +1568,8 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 		"articleModifiedTime":  nil,
 		"articleAuthor":        nil,
 		"articleTags":          nil,
+		// --- 首页布局数据（仅首页） ---
+		"homeData": homeData,
 		// --- 面包屑导航数据 ---
 		"breadcrumbList": breadcrumbList,
 		// --- 社交媒体链接 ---
@@ -1767,6 +1577,8 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 		// --- 自定义HTML（包含CSS/JS） ---
 		"customHeaderHTML": template.HTML(customHeaderHTML),
 		"customFooterHTML": template.HTML(customFooterHTML),
+		// --- 演示模式 ---
+		"demoMode": isDemoMode,
 	}))
 }
 
@@ -1817,7 +1629,9 @@ func isGoTemplateHTML(content string) bool {
 // 支持两种类型：
 //   - Go 模板：包含 {{.xxx}} 等模板语法，会注入数据后渲染
 //   - 纯静态 HTML：直接返回，适用于 Next.js 等现代前端框架
-func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.SettingService, articleSvc article_service.Service, funcMap template.FuncMap) {
+//
+// 当 Go 模板解析或渲染失败时，回退到官方内嵌模板，并将错误记录到系统通知中心
+func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.SettingService, articleSvc article_service.Service, commentLister CommentLister, funcMap template.FuncMap, fallbackTemplates *template.Template) {
 	// 读取 HTML 文件
 	content, err := os.ReadFile(filePath)
 	if err != nil {
@@ -1837,7 +1651,14 @@ func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.Set
 		tmpl, err := template.New(filepath.Base(filePath)).Funcs(funcMap).Parse(htmlContent)
 		if err != nil {
 			debugLog("解析HTML模板失败: %s, 错误: %v", filePath, err)
-			// 如果解析失败，直接返回原始内容
+			reportTemplateError(filePath, "parse", err)
+			if fallbackTemplates != nil {
+				renderEmbeddedFallback(c, func(c *gin.Context) {
+					renderHTMLPage(c, settingSvc, articleSvc, commentLister, fallbackTemplates)
+				})
+				return
+			}
+			// 没有可用的回退模板时，直接返回原始内容
 			c.Header("Content-Type", "text/html; charset=utf-8")
 			c.String(http.StatusOK, htmlContent)
 			return
@@ -1865,8 +1686,7 @@ func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.Set
 			debugLog("🎯 serveStaticHTMLFile SEO 优化: path=%s, title=%s", c.Request.URL.Path, defaultTitle)
 		}
 
-		customHeaderHTML := ensureScriptTagsClosed(settingSvc.Get(constant.KeyCustomHeaderHTML.String()))
-		customFooterHTML := ensureScriptTagsClosed(settingSvc.Get(constant.KeyCustomFooterHTML.String()))
+		customHeaderHTML, customFooterHTML := customInjectionHTML(c, settingSvc)
 
 		baseURL := settingSvc.Get(constant.KeySiteURL.String())
 		breadcrumbList := generateBreadcrumbList(c.Request.URL.Path, baseURL, settingSvc)
@@ -1880,7 +1700,7 @@ func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.Set
 			"author":               settingSvc.Get(constant.KeyFrontDeskSiteOwnerName.String()),
 			"themeColor":           "#f7f9fe",
 			"favicon":              settingSvc.Get(constant.KeyIconURL.String()),
-			"initialData":          nil,
+			"initialData":          ssrInitialData(c),
 			"ogType":               ogType,
 			"ogUrl":                fullURL,
 			"ogTitle":              defaultTitle,
@@ -1892,14 +1712,18 @@ func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.Set
 			"articleModifiedTime":  nil,
 			"articleAuthor":        nil,
 			"articleTags":          nil,
+			"homeData":             getHomePageData(c, articleSvc),
 			"breadcrumbList":       breadcrumbList,
 			"socialMediaLinks":     socialMediaLinks,
 			"customHeaderHTML":     template.HTML(customHeaderHTML),
 			"customFooterHTML":     template.HTML(customFooterHTML),
+			"demoMode":             isDemoMode,
 		}
 
 		// 🆕 检测是否是文章详情页，获取文章数据
 		isPostDetail, _ := regexp.MatchString(`^/posts/([^/]+)$`, c.Request.URL.Path)
+		// 文章详情页只有找到文章时才允许增量静态再生成缓存，避免缓存任意路径
+		cacheable := !isPostDetail
 		if isPostDetail && articleSvc != nil {
 			slug := strings.TrimPrefix(c.Request.URL.Path, "/posts/")
 			debugLog("serveStaticHTMLFile: 检测到文章详情页，获取文章数据: %s", slug)
@@ -1927,14 +1751,19 @@ func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.Set
 					articleTags[i] = tag.Name
 				}
 
+				cacheable = true
+
 				// 转换图片为懒加载
-				articleResponse.ContentHTML = convertImagesToLazyLoad(articleResponse.ContentHTML)
+				articleResponse.ContentHTML = convertImagesToLazyLoad(articleResponse.ContentHTML, lazyLoadSkipFirst(settingSvc))
 
 				// 创建包含时间戳的初始数据
 				initialDataWithTimestamp := map[string]interface{}{
 					"data":          articleResponse,
 					"__timestamp__": time.Now().UnixMilli(),
 				}
+				if comments := ssrComments(c, settingSvc, commentLister, &articleResponse.ArticleResponse); comments != nil {
+					initialDataWithTimestamp["comments"] = comments
+				}
 
 				// 确定 keywords
 				keywords := articleResponse.Keywords
@@ -1954,7 +1783,11 @@ func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.Set
 				data["ogImage"] = articleResponse.CoverURL
 				data["articlePublishedTime"] = articleResponse.CreatedAt
 				data["articleModifiedTime"] = articleResponse.UpdatedAt
-				data["articleAuthor"] = settingSvc.Get(constant.KeyFrontDeskSiteOwnerName.String())
+				articleAuthors, articleJSONLD := generateArticleAuthorMeta(&articleResponse.ArticleResponse, fullURL, settingSvc)
+				data["articleAuthor"] = strings.Join(articleAuthors, ", ")
+				data["articleAuthors"] = articleAuthors
+				data["articleJsonLd"] = articleJSONLD
+				data["articleViewCount"] = articleResponse.ViewCount
 				data["articleTags"] = articleTags
 
 				// 🆕 添加文章详情页需要的更多数据（用于 Go 模板直接渲染）
@@ -1990,21 +1823,46 @@ func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.Set
 			}
 		}
 
-		// 设置响应头
-		c.Header("Content-Type", "text/html; charset=utf-8")
-		c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
+		// 归档、标签、分类页注入文章列表、分页信息和统计数据，多页面 Go 模板主题可直接在服务端渲染完整列表
+		if listData, found := ssrListPageData(c, settingSvc, articleSvc); listData != nil {
+			for key, value := range listData {
+				data[key] = value
+			}
+			cacheable = found
+			debugLog("serveStaticHTMLFile: 列表页数据已注入: %s", c.Request.URL.Path)
+		}
 
 		// 渲染模板
-		var buf bytes.Buffer
-		if err := tmpl.Execute(&buf, data); err != nil {
+		rendered, err := executeTemplateSafely(tmpl, data)
+		if err != nil {
 			debugLog("渲染HTML模板失败: %s, 错误: %v", filePath, err)
+			reportTemplateError(filePath, "execute", err)
+			if fallbackTemplates != nil {
+				renderEmbeddedFallback(c, func(c *gin.Context) {
+					renderHTMLPage(c, settingSvc, articleSvc, commentLister, fallbackTemplates)
+				})
+				return
+			}
 			c.String(http.StatusInternalServerError, "渲染页面失败")
 			return
 		}
 
-		c.String(http.StatusOK, buf.String())
+		// 设置响应头
+		if frontendRewriteEnabled() {
+			rendered = []byte(rewriteFrontendHTML(string(rendered)))
+		}
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		applyPageCachePolicy(c, settingSvc)
+		c.Set(isrCacheableKey, cacheable)
+		c.Data(http.StatusOK, "text/html; charset=utf-8", rendered)
 	} else {
-		// 非模板文件，直接返回
+		// 非模板文件，只注入站点验证标签、图标和代码片段后返回
+		head, bodyEnd := snippet.Render(settingSvc, c.Request.URL.Path)
+		head = siteverify.MetaHTML(settingSvc) + favicon.HeadHTML(settingSvc) + head
+		htmlContent = snippet.InjectHTML(htmlContent, head, bodyEnd)
+		if frontendRewriteEnabled() {
+			htmlContent = rewriteFrontendHTML(htmlContent)
+		}
 		c.Header("Content-Type", "text/html; charset=utf-8")
 		c.Header("Cache-Control", "public, max-age=3600") // 静态 HTML 可以缓存
 		c.String(http.StatusOK, htmlContent)
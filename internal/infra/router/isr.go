@@ -0,0 +1,313 @@
+package router
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+
+	"github.com/gin-gonic/gin"
+)
+
+// isrDir 外部主题页面渲染结果的缓存目录
+// 缓存不放在 static 中，切换或更新主题时模板文件更新，旧缓存按修改时间自动失效
+const isrDir = "data/isr"
+
+// isrDebounce 内容变化事件的合并等待时间，批量导入文章时只重新生成一次
+const isrDebounce = 2 * time.Second
+
+// isrListingPaths 内容变化时立即重新生成的列表页，主题没有对应模板时跳过
+var isrListingPaths = []string{"/", "/archives", "/categories", "/tags"}
+
+// isrCacheableKey gin.Context 中的标记：Go 模板页面渲染成功，且文章详情页找到了文章
+const isrCacheableKey = "isr_cacheable"
+
+// staticRegenerator 外部主题的增量静态再生成（类似 Next.js ISR）：
+// Go 模板页面首次访问时渲染并写入缓存，之后直接返回缓存文件；
+// 文章、分类、标签或配置变化时清空缓存并立即重新生成列表页和变化的文章页，其余页面在下次访问时生成；
+// 评论变化时只重新生成评论所在的页面
+type staticRegenerator struct {
+	renderer *SEORenderer
+	dir      string
+
+	mu       sync.Mutex
+	version  int // 每次清空缓存递增，避免清空前开始的渲染写回旧页面
+	pending  map[string]bool
+	timer    *time.Timer
+	inflight map[string]bool
+}
+
+func newStaticRegenerator(renderer *SEORenderer, dir string) *staticRegenerator {
+	return &staticRegenerator{
+		renderer: renderer,
+		dir:      dir,
+		pending:  map[string]bool{},
+		inflight: map[string]bool{},
+	}
+}
+
+// registerHandlers 订阅内容变化事件
+func (g *staticRegenerator) registerHandlers(bus *event.EventBus) {
+	for _, topic := range []event.Topic{event.ArticleCreated, event.ArticleUpdated, event.ArticlePublished} {
+		bus.Subscribe(topic, g.onArticleChange)
+	}
+	bus.Subscribe(event.ArticleDeleted, func(interface{}) { g.invalidate("") })
+	bus.Subscribe(event.CategoryUpdated, func(interface{}) { g.invalidate("") })
+	bus.Subscribe(event.TagUpdated, func(interface{}) { g.invalidate("") })
+	bus.Subscribe(event.SiteConfigUpdated, func(interface{}) { g.invalidate("") })
+	bus.Subscribe(event.Topic(setting.TopicSettingUpdated), func(interface{}) { g.invalidate("") })
+	// 切换主题后旧主题渲染的页面全部失效（多实例部署时也由其他实例的切换触发）
+	bus.Subscribe(event.ThemeSwitched, func(interface{}) { g.invalidate("") })
+	// 页面渲染结果包含服务端输出的评论，评论变化时重新生成所在页面
+	for _, topic := range []event.Topic{event.CommentCreated, event.CommentUpdated, event.CommentDeleted} {
+		bus.Subscribe(topic, g.onCommentChange)
+	}
+}
+
+func (g *staticRegenerator) onArticleChange(payload interface{}) {
+	path := ""
+	if p, ok := payload.(*event.ArticlePayload); ok && p.Slug != "" {
+		path = "/posts/" + p.Slug
+	}
+	g.invalidate(path)
+}
+
+func (g *staticRegenerator) onCommentChange(payload interface{}) {
+	p, ok := payload.(*event.CommentPayload)
+	if !ok {
+		return
+	}
+	// 评论的目标路径可能带有查询参数或锚点，缓存只按路径存放
+	path := p.TargetPath
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	if path == "" {
+		return
+	}
+	g.invalidatePage(path)
+}
+
+func (g *staticRegenerator) settingSvc() setting.SettingService {
+	return g.renderer.settingSvc
+}
+
+// enabled 开启了再生成且正在使用外部主题
+func (g *staticRegenerator) enabled() bool {
+	return g.settingSvc().GetBool(constant.KeyThemeISREnable.String()) && isStaticModeActive()
+}
+
+// revalidate 缓存页面的最长有效期，0 表示不过期
+func (g *staticRegenerator) revalidate() time.Duration {
+	seconds, err := strconv.Atoi(g.settingSvc().Get(constant.KeyThemeISRRevalidate.String()))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// cachePath 请求路径对应的缓存文件，路径不安全时返回 false
+func (g *staticRegenerator) cachePath(path string) (string, bool) {
+	rel := strings.Trim(path, "/")
+	if rel == "" {
+		rel = "index"
+	}
+	rel = filepath.FromSlash(rel) + ".html"
+	if !filepath.IsLocal(rel) {
+		return "", false
+	}
+	return filepath.Join(g.dir, rel), true
+}
+
+// serve 返回缓存的页面，缓存不存在时渲染并写入缓存，返回是否已处理
+func (g *staticRegenerator) serve(c *gin.Context, path, templatePath string) bool {
+	if !g.enabled() || (c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead) || c.Request.URL.RawQuery != "" {
+		return false
+	}
+	cachePath, ok := g.cachePath(path)
+	if !ok {
+		return false
+	}
+
+	if info, err := os.Stat(cachePath); err == nil && !templateNewer(templatePath, info.ModTime()) {
+		if content, err := os.ReadFile(cachePath); err == nil {
+			state := "HIT"
+			if ttl := g.revalidate(); ttl > 0 && time.Since(info.ModTime()) > ttl {
+				// 过期页面先返回，后台重新生成
+				state = "STALE"
+				go g.regenerate(path)
+			}
+			c.Header("X-ISR-Cache", state)
+			applyPageCachePolicy(c, g.settingSvc())
+			c.Data(http.StatusOK, "text/html; charset=utf-8", content)
+			return true
+		}
+	}
+
+	g.mu.Lock()
+	version := g.version
+	g.mu.Unlock()
+
+	original := c.Writer
+	buffered := &bufferedResponseWriter{ResponseWriter: original, status: http.StatusOK}
+	c.Writer = buffered
+	g.render(c, templatePath)
+	c.Writer = original
+
+	if buffered.status == http.StatusOK && c.GetBool(isrCacheableKey) {
+		g.store(cachePath, buffered.body.Bytes(), version)
+	}
+	c.Header("X-ISR-Cache", "MISS")
+	c.Writer.WriteHeader(buffered.status)
+	_, _ = c.Writer.Write(buffered.body.Bytes())
+	return true
+}
+
+func (g *staticRegenerator) render(c *gin.Context, templatePath string) {
+	r := g.renderer
+	serveStaticHTMLFile(c, templatePath, r.settingSvc, r.articleSvc, r.commentLister, r.funcMap, r.embeddedTemplates)
+}
+
+// regenerate 在后台重新渲染一个页面，页面不存在或不能缓存时删除旧缓存
+func (g *staticRegenerator) regenerate(path string) {
+	cachePath, ok := g.cachePath(path)
+	if !ok {
+		return
+	}
+	g.mu.Lock()
+	if g.inflight[path] {
+		g.mu.Unlock()
+		return
+	}
+	g.inflight[path] = true
+	version := g.version
+	g.mu.Unlock()
+	defer func() {
+		g.mu.Lock()
+		delete(g.inflight, path)
+		g.mu.Unlock()
+	}()
+
+	templatePath := themeFilePath(getPageHTMLPath(path))
+	if !fileExists(templatePath) {
+		_ = os.Remove(cachePath)
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodGet, (&url.URL{Path: path}).String(), nil)
+	if err != nil {
+		return
+	}
+	req.Header.Set("Accept", "text/html")
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = req
+	g.render(c, templatePath)
+
+	if recorder.Code == http.StatusOK && c.GetBool(isrCacheableKey) {
+		g.store(cachePath, recorder.Body.Bytes(), version)
+	} else {
+		_ = os.Remove(cachePath)
+	}
+}
+
+// store 写入缓存，期间缓存被清空过时放弃
+func (g *staticRegenerator) store(cachePath string, content []byte, version int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.version != version {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0755); err != nil {
+		log.Printf("[ISR] 创建缓存目录失败: %v", err)
+		return
+	}
+	tmp := cachePath + ".tmp"
+	if err := os.WriteFile(tmp, content, 0644); err != nil {
+		log.Printf("[ISR] 写入页面缓存失败: %v", err)
+		return
+	}
+	if err := os.Rename(tmp, cachePath); err != nil {
+		log.Printf("[ISR] 写入页面缓存失败: %v", err)
+	}
+}
+
+// invalidate 清空全部缓存，合并等待后重新生成列表页和 path 指定的页面
+func (g *staticRegenerator) invalidate(path string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.version++
+	if err := os.RemoveAll(g.dir); err != nil {
+		log.Printf("[ISR] 清空页面缓存失败: %v", err)
+	}
+	if !g.enabled() {
+		return
+	}
+	for _, p := range isrListingPaths {
+		g.pending[p] = true
+	}
+	if path != "" {
+		g.pending[path] = true
+	}
+	g.schedule()
+}
+
+// invalidatePage 只删除一个页面的缓存，合并等待后重新生成该页面
+func (g *staticRegenerator) invalidatePage(path string) {
+	cachePath, ok := g.cachePath(path)
+	if !ok {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// 递增版本使正在进行的渲染放弃写回，其他页面的缓存保留
+	g.version++
+	if err := os.Remove(cachePath); err != nil && !os.IsNotExist(err) {
+		log.Printf("[ISR] 删除页面缓存失败: %v", err)
+	}
+	if !g.enabled() {
+		return
+	}
+	g.pending[path] = true
+	g.schedule()
+}
+
+// schedule 重置合并等待计时器，调用方需持有 g.mu
+func (g *staticRegenerator) schedule() {
+	if g.timer == nil {
+		g.timer = time.AfterFunc(isrDebounce, g.flush)
+	} else {
+		g.timer.Reset(isrDebounce)
+	}
+}
+
+func (g *staticRegenerator) flush() {
+	g.mu.Lock()
+	paths := make([]string, 0, len(g.pending))
+	for p := range g.pending {
+		paths = append(paths, p)
+	}
+	g.pending = map[string]bool{}
+	g.mu.Unlock()
+
+	for _, p := range paths {
+		g.regenerate(p)
+	}
+	log.Printf("[ISR] 内容变化后已重新生成 %d 个外部主题页面路径", len(paths))
+}
+
+// templateNewer 模板文件在缓存生成之后被修改过（主题更新或切换）
+func templateNewer(templatePath string, cachedAt time.Time) bool {
+	info, err := os.Stat(templatePath)
+	return err != nil || info.ModTime().After(cachedAt)
+}
@@ -0,0 +1,253 @@
+package router
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/taxonomy"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 列表页类型
+const (
+	listPageArchives   = "archives"
+	listPageTags       = "tags"
+	listPageCategories = "categories"
+)
+
+// defaultListPageSize 未配置 post.default.page_size 时列表页每页的文章数
+const defaultListPageSize = 12
+
+var (
+	listPagePathPattern    = regexp.MustCompile(`^/(archives|tags|categories)(?:/(.+))?$`)
+	listPagePaginationPart = regexp.MustCompile(`^(.*?)/page/(\d+)$`)
+	listPageArchivePart    = regexp.MustCompile(`^(\d{4})(?:/(\d{1,2}))?$`)
+)
+
+// listPageRoute 归档、标签、分类页的路径解析结果
+type listPageRoute struct {
+	Kind  string // archives / tags / categories
+	Name  string // 标签或分类名称，列表首页为空
+	Year  int    // 归档年份，归档首页为 0
+	Month int    // 归档月份，按年归档时为 0
+	Page  int
+}
+
+// ListPageData 归档、标签、分类页注入 Go 模板的列表数据（模板中为 .listPage）
+type ListPageData struct {
+	Type       string                  `json:"type"`
+	Name       string                  `json:"name,omitempty"`
+	Year       int                     `json:"year,omitempty"`
+	Month      int                     `json:"month,omitempty"`
+	Articles   []model.ArticleResponse `json:"articles"`
+	Pagination ListPagination          `json:"pagination"`
+}
+
+// ListPagination 列表页分页信息，第一页的地址不带 /page/1
+type ListPagination struct {
+	Page       int    `json:"page"`
+	PageSize   int    `json:"pageSize"`
+	Total      int64  `json:"total"`
+	TotalPages int    `json:"totalPages"`
+	PrevURL    string `json:"prevUrl,omitempty"`
+	NextURL    string `json:"nextUrl,omitempty"`
+}
+
+// parseListPageRoute 解析归档、标签、分类页及其详情页和分页路径，其他路径返回 nil：
+//
+//	/archives[/page/N]、/archives/2024[/05][/page/N]
+//	/tags、/tags/{name}[/page/N]
+//	/categories、/categories/{name}[/page/N]
+func parseListPageRoute(path string) *listPageRoute {
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	route := &listPageRoute{Page: 1}
+	if matches := listPagePaginationPart.FindStringSubmatch(path); matches != nil {
+		page, err := strconv.Atoi(matches[2])
+		if err != nil || page < 1 {
+			return nil
+		}
+		path, route.Page = matches[1], page
+	}
+	matches := listPagePathPattern.FindStringSubmatch(path)
+	if matches == nil {
+		return nil
+	}
+	route.Kind = matches[1]
+	rest := matches[2]
+	if rest == "" {
+		return route
+	}
+	if route.Kind != listPageArchives {
+		route.Name = rest
+		return route
+	}
+	archive := listPageArchivePart.FindStringSubmatch(rest)
+	if archive == nil {
+		return nil
+	}
+	route.Year, _ = strconv.Atoi(archive[1])
+	if archive[2] != "" {
+		route.Month, _ = strconv.Atoi(archive[2])
+		if route.Month < 1 || route.Month > 12 {
+			return nil
+		}
+	}
+	return route
+}
+
+// isDetail 是否是标签、分类详情页或按年月的归档页
+func (r *listPageRoute) isDetail() bool {
+	return r.Name != "" || r.Year != 0
+}
+
+// templatePath 外部主题中渲染该路径的 HTML 文件：列表首页使用 {kind}.html，详情页使用 {kind}/__template__.html
+func (r *listPageRoute) templatePath() string {
+	if r.isDetail() {
+		return r.Kind + "/__template__.html"
+	}
+	return r.Kind + ".html"
+}
+
+// basePath 不带分页部分的页面地址
+func (r *listPageRoute) basePath() string {
+	switch {
+	case r.Name != "":
+		return "/" + r.Kind + "/" + url.PathEscape(r.Name)
+	case r.Month != 0:
+		return fmt.Sprintf("/%s/%d/%02d", r.Kind, r.Year, r.Month)
+	case r.Year != 0:
+		return fmt.Sprintf("/%s/%d", r.Kind, r.Year)
+	}
+	return "/" + r.Kind
+}
+
+func (r *listPageRoute) pageURL(page int) string {
+	if page <= 1 {
+		return r.basePath()
+	}
+	return fmt.Sprintf("%s/page/%d", r.basePath(), page)
+}
+
+// listPageTemplatePath 归档、标签、分类页在外部主题中对应的模板文件，其他路径返回空字符串
+func listPageTemplatePath(path string) string {
+	route := parseListPageRoute(path)
+	if route == nil {
+		return ""
+	}
+	return route.templatePath()
+}
+
+// ssrListPageData 归档、标签、分类页注入 Go 模板的数据：文章列表和分页信息（listPage）、
+// 标签云和标签数（tagCloud、tagCount）、分类树和分类数（categoryTree、categoryCount）、
+// 按年月的文章数（archiveSummary）。其他页面返回 nil。
+// found 表示页面有内容：详情页没有文章或页码超出范围时为 false，此时不应缓存渲染结果
+func ssrListPageData(c *gin.Context, settingSvc setting.SettingService, articleSvc article_service.Service) (data map[string]interface{}, found bool) {
+	route := parseListPageRoute(c.Request.URL.Path)
+	if route == nil || articleSvc == nil {
+		return nil, false
+	}
+	ctx := c.Request.Context()
+	data = make(map[string]interface{})
+	found = true
+
+	pageSize, err := strconv.Atoi(settingSvc.Get(constant.KeyPostDefaultPageSize.String()))
+	if err != nil || pageSize <= 0 {
+		pageSize = defaultListPageSize
+	}
+	listPage := &ListPageData{
+		Type:       route.Kind,
+		Name:       route.Name,
+		Year:       route.Year,
+		Month:      route.Month,
+		Articles:   []model.ArticleResponse{},
+		Pagination: ListPagination{Page: route.Page, PageSize: pageSize},
+	}
+
+	// 标签、分类首页展示标签云和分类树，不列出文章
+	if route.Kind == listPageArchives || route.isDetail() {
+		options := &model.ListPublicArticlesOptions{
+			Page:     route.Page,
+			PageSize: pageSize,
+			Year:     route.Year,
+			Month:    route.Month,
+		}
+		switch route.Kind {
+		case listPageTags:
+			options.TagName = route.Name
+		case listPageCategories:
+			options.CategoryName = route.Name
+		}
+		result, err := articleSvc.ListPublic(ctx, options)
+		if err != nil {
+			debugLog("serveStaticHTMLFile: 获取列表页文章失败: %s, 错误: %v", c.Request.URL.Path, err)
+			found = false
+		} else {
+			for i := range result.List {
+				result.List[i].ContentHTML = ""
+			}
+			listPage.Articles = result.List
+			listPage.Pagination.Total = result.Total
+			listPage.Pagination.TotalPages = int((result.Total + int64(pageSize) - 1) / int64(pageSize))
+			if route.Page > 1 {
+				listPage.Pagination.PrevURL = route.pageURL(route.Page - 1)
+			}
+			if route.Page < listPage.Pagination.TotalPages {
+				listPage.Pagination.NextURL = route.pageURL(route.Page + 1)
+			}
+			if (route.isDetail() && result.Total == 0) || (route.Page > 1 && route.Page > listPage.Pagination.TotalPages) {
+				found = false
+			}
+		}
+	}
+	data["listPage"] = listPage
+
+	switch route.Kind {
+	case listPageTags:
+		if ssrTaxonomyProvider != nil {
+			if tagCloud, err := ssrTaxonomyProvider.TagCloud(ctx); err == nil {
+				data["tagCloud"] = tagCloud
+				data["tagCount"] = len(tagCloud)
+			} else {
+				debugLog("serveStaticHTMLFile: 获取标签云失败: %v", err)
+			}
+		}
+	case listPageCategories:
+		if ssrTaxonomyProvider != nil {
+			if categoryTree, err := ssrTaxonomyProvider.CategoryTree(ctx); err == nil {
+				data["categoryTree"] = categoryTree
+				data["categoryCount"] = countCategoryNodes(categoryTree)
+			} else {
+				debugLog("serveStaticHTMLFile: 获取分类树失败: %v", err)
+			}
+		}
+	case listPageArchives:
+		if summary, err := articleSvc.ListArchives(ctx); err == nil {
+			data["archiveSummary"] = summary.List
+		} else {
+			debugLog("serveStaticHTMLFile: 获取归档统计失败: %v", err)
+		}
+	}
+	return data, found
+}
+
+// countCategoryNodes 分类树中有对应分类记录的节点数
+func countCategoryNodes(nodes []*taxonomy.CategoryNode) int {
+	count := 0
+	for _, node := range nodes {
+		if node.ID != "" {
+			count++
+		}
+		count += countCategoryNodes(node.Children)
+	}
+	return count
+}
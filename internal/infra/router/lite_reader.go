@@ -0,0 +1,157 @@
+package router
+
+import (
+	"bytes"
+	"html/template"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/parser"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/strutil"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// liteScriptPattern 精简阅读页不执行任何脚本，正文中的 script 标签一并移除
+var liteScriptPattern = regexp.MustCompile(`(?is)<script\b.*?</script\s*>`)
+
+// liteReaderTemplate 精简阅读页模板：不依赖主题资源和 JS，样式全部内联，同时适合打印
+var liteReaderTemplate = template.Must(template.New("lite").Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}} - {{.SiteName}}</title>
+<meta name="description" content="{{.Description}}">
+<link rel="canonical" href="{{.CanonicalURL}}">
+<style>
+:root{color-scheme:light dark}
+body{margin:0 auto;max-width:42rem;padding:1.5rem 1rem 3rem;font:17px/1.75 -apple-system,BlinkMacSystemFont,"Segoe UI","PingFang SC","Microsoft YaHei",sans-serif;color:#222;background:#fff;word-wrap:break-word}
+@media (prefers-color-scheme:dark){body{color:#ddd;background:#161616}a{color:#8ab4f8}pre,code{background:#262626}}
+header{border-bottom:1px solid #8884;margin-bottom:1.5rem}
+h1{font-size:1.7rem;line-height:1.35;margin:.5rem 0}
+.meta{color:#888;font-size:.875rem;margin-bottom:1rem}
+.meta span+span:before{content:" · "}
+img,video{max-width:100%;height:auto}
+pre{overflow:auto;padding:.75rem;background:#f5f5f5;border-radius:4px;font-size:.875rem;line-height:1.5}
+code{font-family:ui-monospace,SFMono-Regular,Menlo,Consolas,monospace;background:#f5f5f5;padding:.1em .3em;border-radius:3px}
+pre code{padding:0;background:none}
+blockquote{margin:1rem 0;padding:0 1rem;border-left:4px solid #8886;color:#666}
+table{border-collapse:collapse;display:block;overflow:auto}
+th,td{border:1px solid #8886;padding:.3rem .6rem}
+footer{margin-top:2.5rem;padding-top:1rem;border-top:1px solid #8884;font-size:.875rem;color:#888}
+@media print{body{max-width:none;padding:0;color:#000;background:#fff}a{color:#000}footer .nav{display:none}pre{white-space:pre-wrap}}
+</style>
+</head>
+<body>
+<header>
+<h1>{{.Title}}</h1>
+<div class="meta">{{if .Authors}}<span>{{.Authors}}</span>{{end}}<span>{{.PublishedAt}}</span>{{if .ReadingTime}}<span>约 {{.ReadingTime}} 分钟</span>{{end}}{{if .Tags}}<span>{{.Tags}}</span>{{end}}</div>
+</header>
+<article>
+{{.Content}}
+</article>
+<footer>
+{{if .Copyright}}<p>{{.Copyright}}</p>{{end}}
+<p class="nav"><a href="{{.ArticlePath}}">查看完整版本</a> · <a href="/">{{.SiteName}}</a></p>
+<p>原文链接：{{.CanonicalURL}}</p>
+</footer>
+</body>
+</html>`))
+
+// liteReaderData 精简阅读页的模板数据
+type liteReaderData struct {
+	Title        string
+	SiteName     string
+	Description  string
+	CanonicalURL string
+	ArticlePath  string
+	Authors      string
+	PublishedAt  string
+	ReadingTime  int
+	Tags         string
+	Copyright    string
+	Content      template.HTML
+}
+
+// serveLiteArticle 渲染文章的精简阅读版本，未启用时返回 404
+func serveLiteArticle(c *gin.Context, settingSvc setting.SettingService, articleSvc article_service.Service) {
+	if !settingSvc.GetBool(constant.KeyArticleLiteEnable.String()) {
+		c.String(http.StatusNotFound, "页面未找到")
+		return
+	}
+
+	slug := c.Param("slug")
+	article, err := articleSvc.GetPublicBySlugOrID(c.Request.Context(), slug)
+	if err != nil || article == nil {
+		c.String(http.StatusNotFound, "文章不存在")
+		return
+	}
+
+	if articleSvc.RecordView(c.Request.Context(), article.ID, util.GetRealClientIP(c), c.Request.UserAgent()) {
+		article.ViewCount++
+	}
+
+	siteName := settingSvc.Get(constant.KeyAppName.String())
+	articlePath := "/posts/" + slug
+	canonicalURL := strings.TrimSuffix(settingSvc.Get(constant.KeySiteURL.String()), "/") + articlePath
+	if !strings.HasPrefix(canonicalURL, "http") {
+		canonicalURL = getRequestScheme(c) + "://" + c.Request.Host + articlePath
+	}
+
+	description := ""
+	if len(article.Summaries) > 0 {
+		description = article.Summaries[0]
+	}
+	if description == "" {
+		description = strutil.Truncate(strings.Join(strings.Fields(parser.StripHTML(article.ContentHTML)), " "), 150)
+	}
+
+	tags := make([]string, 0, len(article.PostTags))
+	for _, tag := range article.PostTags {
+		tags = append(tags, "#"+tag.Name)
+	}
+
+	authors, _ := generateArticleAuthorMeta(&article.ArticleResponse, canonicalURL, settingSvc)
+
+	copyright := ""
+	if article.IsReprint && article.CopyrightAuthor != "" {
+		copyright = "本文转载自 " + article.CopyrightAuthor
+		if article.CopyrightURL != "" {
+			copyright += "：" + article.CopyrightURL
+		}
+	}
+
+	data := liteReaderData{
+		Title:        article.Title,
+		SiteName:     siteName,
+		Description:  description,
+		CanonicalURL: canonicalURL,
+		ArticlePath:  articlePath,
+		Authors:      strings.Join(authors, "、"),
+		PublishedAt:  article.CreatedAt.In(time.Local).Format("2006-01-02"),
+		ReadingTime:  article.ReadingTime,
+		Tags:         strings.Join(tags, " "),
+		Copyright:    copyright,
+		Content:      template.HTML(liteScriptPattern.ReplaceAllString(article.ContentHTML, "")),
+	}
+
+	var buf bytes.Buffer
+	if err := liteReaderTemplate.Execute(&buf, data); err != nil {
+		log.Printf("[精简阅读] 渲染文章 %s 失败: %v", slug, err)
+		c.String(http.StatusInternalServerError, "渲染页面失败")
+		return
+	}
+
+	applyPageCachePolicy(c, settingSvc)
+	// 精简版与完整版内容相同，搜索引擎应以完整版为准
+	c.Header("X-Robots-Tag", "noindex, follow")
+	c.Data(http.StatusOK, "text/html; charset=utf-8", buf.Bytes())
+}
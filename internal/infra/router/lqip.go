@@ -0,0 +1,22 @@
+package router
+
+// ImagePlaceholderProvider 根据图片地址返回低质量占位图（data URI），由媒体库服务实现
+type ImagePlaceholderProvider interface {
+	ImagePlaceholder(src string) string
+}
+
+// imagePlaceholderProvider 懒加载图片使用的占位图来源，未设置时统一使用透明占位图
+var imagePlaceholderProvider ImagePlaceholderProvider
+
+// SetImagePlaceholderProvider 设置懒加载图片的占位图来源，应在 SetupFrontend 之前调用
+func SetImagePlaceholderProvider(provider ImagePlaceholderProvider) {
+	imagePlaceholderProvider = provider
+}
+
+// lazyImagePlaceholder 返回图片已生成的低质量占位图，没有时返回空字符串
+func lazyImagePlaceholder(src string) string {
+	if imagePlaceholderProvider == nil {
+		return ""
+	}
+	return imagePlaceholderProvider.ImagePlaceholder(src)
+}
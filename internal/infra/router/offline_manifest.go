@@ -0,0 +1,292 @@
+package router
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AssetManifestEntry 离线资源清单中的单个条目
+type AssetManifestEntry struct {
+	URL      string `json:"url"`
+	Revision string `json:"revision"`
+	Size     int64  `json:"size"`
+}
+
+// AssetManifest 离线资源清单，供 Service Worker 预缓存使用
+type AssetManifest struct {
+	Version     string               `json:"version"`
+	Source      string               `json:"source"` // embedded 或 static
+	GeneratedAt time.Time            `json:"generated_at"`
+	Assets      []AssetManifestEntry `json:"assets"`
+}
+
+// offlineManifestCache 缓存已生成的清单，主题切换后根据签名自动失效
+type offlineManifestCache struct {
+	mu        sync.Mutex
+	signature string
+	manifest  *AssetManifest
+}
+
+var globalManifestCache = &offlineManifestCache{}
+
+// 预缓存的资源扩展名，HTML 页面不做预缓存，避免返回过期的页面内容
+var precacheExtensions = map[string]bool{
+	".js": true, ".css": true, ".woff": true, ".woff2": true, ".ttf": true,
+	".svg": true, ".png": true, ".jpg": true, ".jpeg": true, ".webp": true,
+	".gif": true, ".ico": true, ".json": true,
+}
+
+// maxPrecacheFileSize 单个文件超过该大小时不放入预缓存清单
+const maxPrecacheFileSize = 2 * 1024 * 1024
+
+// currentManifestSignature 计算当前主题来源的签名
+// 外部主题模式下使用 static/index.html 的修改时间，切换主题时会重新复制文件，签名随之变化；
+// 主题覆盖目录的内容同样计入签名
+func currentManifestSignature() (string, bool) {
+	if isStaticModeActive() {
+		info, err := os.Stat(filepath.Join("static", "index.html"))
+		if err == nil {
+			return fmt.Sprintf("static:%d:%d:%s", info.ModTime().UnixNano(), info.Size(), themeOverrideSignature()), true
+		}
+	}
+	return "embedded", false
+}
+
+// getAssetManifest 获取（必要时重新生成）离线资源清单
+func getAssetManifest(distFS fs.FS) (*AssetManifest, error) {
+	signature, staticMode := currentManifestSignature()
+
+	globalManifestCache.mu.Lock()
+	defer globalManifestCache.mu.Unlock()
+
+	if globalManifestCache.manifest != nil && globalManifestCache.signature == signature {
+		return globalManifestCache.manifest, nil
+	}
+
+	// 查找顺序与资源路由一致：外部主题激活时 /static/ 只使用外部主题，/assets/ 缺少的资源回退到内嵌资源
+	var manifest *AssetManifest
+	var err error
+	if staticMode {
+		theme := themeFS()
+		manifest, err = buildAssetManifest("static", manifestRoot{theme, "static"}, manifestRoot{theme, "assets"}, manifestRoot{distFS, "assets"})
+	} else {
+		manifest, err = buildAssetManifest("embedded", manifestRoot{distFS, "static"}, manifestRoot{distFS, "assets"})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	globalManifestCache.signature = signature
+	globalManifestCache.manifest = manifest
+	debugLog("离线资源清单已重新生成: source=%s, version=%s, 资源数=%d", manifest.Source, manifest.Version, len(manifest.Assets))
+	return manifest, nil
+}
+
+// manifestRoot 资源清单的一个来源目录，目录名即 URL 前缀（static 或 assets）
+type manifestRoot struct {
+	fsys fs.FS
+	root string
+}
+
+// buildAssetManifest 依次遍历各来源目录生成资源清单，同一 URL 以先遍历到的为准
+func buildAssetManifest(source string, roots ...manifestRoot) (*AssetManifest, error) {
+	var assets []AssetManifestEntry
+	seen := make(map[string]bool)
+	for _, r := range roots {
+		if r.fsys == nil {
+			continue
+		}
+		entries, err := walkManifestRoot(r.fsys, r.root, seen)
+		if err != nil {
+			return nil, fmt.Errorf("生成资源清单失败: %w", err)
+		}
+		assets = append(assets, entries...)
+	}
+
+	versionHash := sha256.New()
+	sort.Slice(assets, func(i, j int) bool { return assets[i].URL < assets[j].URL })
+	for _, a := range assets {
+		versionHash.Write([]byte(a.URL))
+		versionHash.Write([]byte(a.Revision))
+	}
+
+	return &AssetManifest{
+		Version:     source + "-" + hex.EncodeToString(versionHash.Sum(nil))[:12],
+		Source:      source,
+		GeneratedAt: time.Now(),
+		Assets:      assets,
+	}, nil
+}
+
+// walkManifestRoot 遍历一个来源目录，跳过 seen 中已有的 URL；目录不存在时返回空列表
+func walkManifestRoot(fsys fs.FS, root string, seen map[string]bool) ([]AssetManifestEntry, error) {
+	var assets []AssetManifestEntry
+	err := fs.WalkDir(fsys, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		ext := strings.ToLower(path.Ext(p))
+		if !precacheExtensions[ext] {
+			return nil
+		}
+		// 跳过主题元数据及预压缩文件
+		if path.Base(p) == "theme.json" {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.Size() > maxPrecacheFileSize {
+			return nil
+		}
+		url := "/" + p
+		if seen[url] {
+			return nil
+		}
+		seen[url] = true
+
+		content, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return fmt.Errorf("读取资源文件失败 %s: %w", p, err)
+		}
+		sum := sha256.Sum256(content)
+		revision := hex.EncodeToString(sum[:])[:16]
+
+		assets = append(assets, AssetManifestEntry{
+			URL:      url,
+			Revision: revision,
+			Size:     int64(len(content)),
+		})
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return assets, nil
+	}
+	return assets, err
+}
+
+// serviceWorkerTemplate 基础 Service Worker 脚本
+// 安装时预缓存清单中的资源，激活时清理旧版本缓存；HTML 导航请求走网络优先，离线时回退到缓存
+const serviceWorkerTemplate = `/* anheyu service worker, version: %[1]s */
+const CACHE_VERSION = %[2]q;
+const CACHE_NAME = "anheyu-assets-" + CACHE_VERSION;
+const PAGE_CACHE = "anheyu-pages-" + CACHE_VERSION;
+const MANIFEST_URL = "/asset-manifest.json";
+
+self.addEventListener("install", (event) => {
+  event.waitUntil(
+    fetch(MANIFEST_URL, { cache: "no-store" })
+      .then((res) => res.json())
+      .then((manifest) => caches.open(CACHE_NAME).then((cache) =>
+        Promise.all((manifest.assets || []).map((asset) =>
+          cache.add(asset.url + "?v=" + asset.revision).catch(() => undefined)
+        ))
+      ))
+      .then(() => self.skipWaiting())
+  );
+});
+
+self.addEventListener("activate", (event) => {
+  event.waitUntil(
+    caches.keys()
+      .then((keys) => Promise.all(keys
+        .filter((key) => key.startsWith("anheyu-") && key !== CACHE_NAME && key !== PAGE_CACHE)
+        .map((key) => caches.delete(key))))
+      .then(() => self.clients.claim())
+  );
+});
+
+self.addEventListener("fetch", (event) => {
+  const req = event.request;
+  if (req.method !== "GET") return;
+  const url = new URL(req.url);
+  if (url.origin !== self.location.origin) return;
+  if (url.pathname.startsWith("/api/") || url.pathname.startsWith("/admin") || url.pathname === "/login") return;
+
+  if (req.mode === "navigate") {
+    event.respondWith(
+      fetch(req)
+        .then((res) => {
+          const copy = res.clone();
+          caches.open(PAGE_CACHE).then((cache) => cache.put(req, copy));
+          return res;
+        })
+        .catch(() => caches.match(req).then((cached) => cached || caches.match("/")))
+    );
+    return;
+  }
+
+  if (url.pathname.startsWith("/static/") || url.pathname.startsWith("/assets/")) {
+    event.respondWith(
+      caches.match(req, { ignoreSearch: true }).then((cached) => cached || fetch(req))
+    );
+  }
+});
+`
+
+// handleAssetManifest 返回离线资源清单
+func handleAssetManifest(distFS fs.FS) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		manifest, err := getAssetManifest(distFS)
+		if err != nil {
+			debugLog("生成离线资源清单失败: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "生成资源清单失败"})
+			return
+		}
+		etag := fmt.Sprintf(`"%s"`, manifest.Version)
+		if handleConditionalRequest(c, etag) {
+			return
+		}
+		c.Header("ETag", etag)
+		c.Header("Cache-Control", "no-cache")
+		c.JSON(http.StatusOK, manifest)
+	}
+}
+
+// handleServiceWorker 返回 Service Worker 脚本
+// 外部主题自带 sw.js 时优先使用主题提供的脚本
+func handleServiceWorker(distFS fs.FS) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if isStaticModeActive() {
+			themeSW := themeFilePath("sw.js")
+			if info, err := os.Stat(themeSW); err == nil && !info.IsDir() {
+				c.Header("Content-Type", "application/javascript; charset=utf-8")
+				c.Header("Cache-Control", "no-cache")
+				c.Header("Service-Worker-Allowed", "/")
+				c.File(themeSW)
+				return
+			}
+		}
+
+		manifest, err := getAssetManifest(distFS)
+		if err != nil {
+			debugLog("生成 Service Worker 失败: %v", err)
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+
+		// Service Worker 脚本本身不能被长时间缓存，否则版本更新无法及时生效
+		c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
+		c.Header("Service-Worker-Allowed", "/")
+		c.Data(http.StatusOK, "application/javascript; charset=utf-8",
+			[]byte(fmt.Sprintf(serviceWorkerTemplate, manifest.GeneratedAt.Format(time.RFC3339), manifest.Version)))
+	}
+}
@@ -0,0 +1,40 @@
+package router
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PageDataResolver 解析自定义页面的数据绑定，由 pagedata 服务实现
+type PageDataResolver interface {
+	ResolvePath(ctx context.Context, path string) (map[string]interface{}, bool)
+}
+
+// ssrPageDataResolver 自定义页面 SSR 注入使用的服务，未设置时不注入
+var ssrPageDataResolver PageDataResolver
+
+// SetPageDataResolver 设置自定义页面 SSR 注入使用的服务，应在 SetupFrontend 之前调用
+func SetPageDataResolver(resolver PageDataResolver) {
+	ssrPageDataResolver = resolver
+}
+
+// ssrInitialData 非文章页面随 HTML 注入的初始数据：标签、分类、归档页注入标签云和分类树，
+// 配置了数据绑定的自定义页面注入 initialData.bindings，其他页面返回 nil
+func ssrInitialData(c *gin.Context) map[string]interface{} {
+	if data := ssrTaxonomyData(c); data != nil {
+		return data
+	}
+	if ssrPageDataResolver == nil {
+		return nil
+	}
+	bindings, ok := ssrPageDataResolver.ResolvePath(c.Request.Context(), c.Request.URL.Path)
+	if !ok {
+		return nil
+	}
+	return map[string]interface{}{
+		"bindings":      bindings,
+		"__timestamp__": time.Now().UnixMilli(),
+	}
+}
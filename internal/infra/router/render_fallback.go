@@ -0,0 +1,124 @@
+package router
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/errorreport"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
+
+	"github.com/gin-gonic/gin"
+)
+
+// templateErrorLinePattern 匹配 Go 模板错误中的 "name:line:col" 或 "name:line" 片段
+var templateErrorLinePattern = regexp.MustCompile(`template: [^:]+:(\d+)(?::\d+)?:`)
+
+// reportTemplateError 将外部主题模板错误记录到系统通知中心
+// stage 为 parse 或 execute，用于区分模板语法错误和渲染期错误
+func reportTemplateError(filePath string, stage string, err error) notification.SystemNotice {
+	line := 0
+	if matches := templateErrorLinePattern.FindStringSubmatch(err.Error()); len(matches) > 1 {
+		line, _ = strconv.Atoi(matches[1])
+	}
+
+	title := "主题模板解析失败"
+	if stage == "execute" {
+		title = "主题模板渲染失败"
+	}
+
+	notice := notification.DefaultNoticeCenter().Push(notification.SystemNotice{
+		Level:    notification.NoticeLevelError,
+		Category: notification.NoticeCategoryTemplateRender,
+		Title:    title,
+		Message:  err.Error(),
+		Source:   filePath,
+		Line:     line,
+	})
+	log.Printf("⚠️ 外部主题模板错误 [%s] %s:%d: %v（已回退到官方内嵌模板）", stage, filePath, line, err)
+	// 同一错误只在首次出现时上报，避免每次请求都重复上报
+	if notice.Count == 1 {
+		errorreport.CaptureError(err, map[string]string{"component": "render", "stage": stage, "template": filePath})
+	}
+	return notice
+}
+
+// renderErrorBannerScript 管理员专属的模板错误横幅
+// 横幅内容通过仅管理员可访问的接口获取，普通访客请求会被鉴权拦截，因此不会看到任何错误信息
+const renderErrorBannerScript = `<script data-anheyu-render-fallback>
+(function(){try{
+var m=document.cookie.match(/(?:^|; )authorized-token=([^;]*)/);if(!m)return;
+var t=JSON.parse(decodeURIComponent(m[1]));if(!t||!t.accessToken)return;
+fetch("/api/notification/notices/render-error",{headers:{Authorization:"Bearer "+t.accessToken}})
+.then(function(r){return r.ok?r.json():null}).then(function(res){
+if(!res||!res.data)return;var n=res.data;var d=document.createElement("div");
+d.setAttribute("role","alert");
+d.style.cssText="position:fixed;left:0;right:0;top:0;z-index:99999;padding:10px 16px;background:#d93025;color:#fff;font:14px/1.5 sans-serif;box-shadow:0 2px 8px rgba(0,0,0,.2)";
+d.textContent="主题模板错误（仅管理员可见，已回退到官方模板）："+n.source+(n.line?":"+n.line:"")+" - "+n.message;
+var c=document.createElement("span");c.textContent=" ×";c.style.cssText="cursor:pointer;float:right;font-weight:bold";
+c.onclick=function(){d.remove()};d.appendChild(c);document.body.appendChild(d);
+}).catch(function(){});
+}catch(e){}})();
+</script>`
+
+// bufferedResponseWriter 捕获渲染输出，便于在写回客户端前注入横幅脚本
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+// renderEmbeddedFallback 外部主题模板出错时，使用官方内嵌模板渲染当前页面，并注入管理员错误横幅
+func renderEmbeddedFallback(c *gin.Context, renderFn func(c *gin.Context)) {
+	original := c.Writer
+	buffered := &bufferedResponseWriter{ResponseWriter: original, status: http.StatusOK}
+	c.Writer = buffered
+	renderFn(c)
+	c.Writer = original
+
+	html := buffered.body.String()
+	if idx := strings.LastIndex(strings.ToLower(html), "</body>"); idx != -1 {
+		html = html[:idx] + renderErrorBannerScript + html[idx:]
+	} else {
+		html += renderErrorBannerScript
+	}
+
+	c.Header("X-Theme-Render-Fallback", "embedded")
+	c.Header("Content-Length", strconv.Itoa(len(html)))
+	c.Writer.WriteHeader(buffered.status)
+	_, _ = c.Writer.WriteString(html)
+}
+
+// executeTemplateSafely 执行模板并捕获模板函数中的 panic
+func executeTemplateSafely(tmpl *template.Template, data interface{}) (out []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("模板执行时发生 panic: %v", r)
+		}
+	}()
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -14,36 +14,72 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/app/middleware"
+	a11yaudit_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/a11yaudit"
+	accesslog_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/accesslog"
+	adminevent_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/adminevent"
 	album_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album"
 	album_category_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album_category"
 	article_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article"
 	article_history_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article_history"
+	assetcdn_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/assetcdn"
 	auth_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/auth"
+	author_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/author"
+	avatar_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/avatar"
+	cache_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/cache"
 	captcha_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/captcha"
 	comment_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/comment"
 	config_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/config"
+	dashboard_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/dashboard"
+	diagnostics_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/diagnostics"
 	direct_link_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/direct_link"
 	doc_series_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/doc_series"
+	favicon_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/favicon"
 	file_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/file"
+	gitsync_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/gitsync"
+	instancebackup_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/instancebackup"
 	link_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/link"
+	linkarchive_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/linkarchive"
+	localizer_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/localizer"
+	loginguard_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/loginguard"
+	mail_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/mail"
+	media_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/media"
 	music_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/music"
 	notification_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/notification"
+	oauth_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/oauth"
 	page_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/page"
+	pagedata_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/pagedata"
+	permalink_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/permalink"
+	plugin_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/plugin"
 	post_category_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/post_category"
 	post_tag_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/post_tag"
+	privacy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/privacy"
 	proxy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/proxy"
 	public_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/public"
+	reaction_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/reaction"
+	redirect_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/redirect"
 	search_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/search"
+	selfupdate_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/selfupdate"
+	seoaudit_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/seoaudit"
 	setting_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/setting"
 	sitemap_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/sitemap"
+	snippet_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/snippet"
+	sqlitebackup_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/sqlitebackup"
 	ssrtheme_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/ssrtheme"
 	statistics_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/statistics"
 	storage_policy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/storage_policy"
 	subscriber_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/subscriber"
+	sysconfig_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/sysconfig"
+	task_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/task"
+	taxonomy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/taxonomy"
 	theme_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/theme"
+	themelayout_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/themelayout"
 	thumbnail_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/thumbnail"
+	tls_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/tls"
+	trash_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/trash"
 	user_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/user"
 	version_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/version"
+	webhook_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/webhook"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 )
 
 // NoCacheMiddleware 全局反缓存中间件，确保所有API响应都不会被CDN缓存
@@ -96,6 +132,41 @@ type Router struct {
 	configImportExportHandler *config_handler.ConfigImportExportHandler
 	subscriberHandler         *subscriber_handler.Handler
 	captchaHandler            *captcha_handler.Handler
+	cacheHandler              *cache_handler.Handler
+	accessLogHandler          *accesslog_handler.Handler
+	dashboardHandler          *dashboard_handler.Handler
+	taskHandler               *task_handler.Handler
+	tlsHandler                *tls_handler.Handler
+	authorHandler             *author_handler.Handler
+	reactionHandler           *reaction_handler.Handler
+	snippetHandler            *snippet_handler.Handler
+	localizerHandler          *localizer_handler.Handler
+	seoAuditHandler           *seoaudit_handler.Handler
+	instanceBackupHandler     *instancebackup_handler.Handler
+	privacyHandler            *privacy_handler.Handler
+	loginGuardHandler         *loginguard_handler.Handler
+	oauthHandler              *oauth_handler.Handler
+	avatarHandler             *avatar_handler.Handler
+	linkArchiveHandler        *linkarchive_handler.Handler
+	mediaHandler              *media_handler.Handler
+	sysConfigHandler          *sysconfig_handler.Handler
+	pluginHandler             *plugin_handler.Handler
+	webhookHandler            *webhook_handler.Handler
+	gitSyncHandler            *gitsync_handler.Handler
+	redirectHandler           *redirect_handler.Handler
+	permalinkHandler          *permalink_handler.Handler
+	taxonomyHandler           *taxonomy_handler.Handler
+	a11yAuditHandler          *a11yaudit_handler.Handler
+	assetCDNHandler           *assetcdn_handler.Handler
+	pageDataHandler           *pagedata_handler.Handler
+	themeLayoutHandler        *themelayout_handler.Handler
+	faviconHandler            *favicon_handler.Handler
+	sqliteBackupHandler       *sqlitebackup_handler.Handler
+	selfUpdateHandler         *selfupdate_handler.Handler
+	adminEventHandler         *adminevent_handler.Handler
+	diagnosticsHandler        *diagnostics_handler.Handler
+	mailHandler               *mail_handler.Handler
+	trashHandler              *trash_handler.Handler
 }
 
 // NewRouter 是 Router 的构造函数，通过依赖注入接收所有处理器。
@@ -132,6 +203,41 @@ func NewRouter(
 	configImportExportHandler *config_handler.ConfigImportExportHandler,
 	subscriberHandler *subscriber_handler.Handler,
 	captchaHandler *captcha_handler.Handler,
+	cacheHandler *cache_handler.Handler,
+	accessLogHandler *accesslog_handler.Handler,
+	dashboardHandler *dashboard_handler.Handler,
+	taskHandler *task_handler.Handler,
+	tlsHandler *tls_handler.Handler,
+	authorHandler *author_handler.Handler,
+	reactionHandler *reaction_handler.Handler,
+	snippetHandler *snippet_handler.Handler,
+	localizerHandler *localizer_handler.Handler,
+	seoAuditHandler *seoaudit_handler.Handler,
+	instanceBackupHandler *instancebackup_handler.Handler,
+	privacyHandler *privacy_handler.Handler,
+	loginGuardHandler *loginguard_handler.Handler,
+	oauthHandler *oauth_handler.Handler,
+	avatarHandler *avatar_handler.Handler,
+	linkArchiveHandler *linkarchive_handler.Handler,
+	mediaHandler *media_handler.Handler,
+	sysConfigHandler *sysconfig_handler.Handler,
+	pluginHandler *plugin_handler.Handler,
+	webhookHandler *webhook_handler.Handler,
+	gitSyncHandler *gitsync_handler.Handler,
+	redirectHandler *redirect_handler.Handler,
+	permalinkHandler *permalink_handler.Handler,
+	taxonomyHandler *taxonomy_handler.Handler,
+	a11yAuditHandler *a11yaudit_handler.Handler,
+	assetCDNHandler *assetcdn_handler.Handler,
+	pageDataHandler *pagedata_handler.Handler,
+	themeLayoutHandler *themelayout_handler.Handler,
+	faviconHandler *favicon_handler.Handler,
+	sqliteBackupHandler *sqlitebackup_handler.Handler,
+	selfUpdateHandler *selfupdate_handler.Handler,
+	adminEventHandler *adminevent_handler.Handler,
+	diagnosticsHandler *diagnostics_handler.Handler,
+	mailHandler *mail_handler.Handler,
+	trashHandler *trash_handler.Handler,
 ) *Router {
 	return &Router{
 		authHandler:               authHandler,
@@ -166,6 +272,41 @@ func NewRouter(
 		configImportExportHandler: configImportExportHandler,
 		subscriberHandler:         subscriberHandler,
 		captchaHandler:            captchaHandler,
+		cacheHandler:              cacheHandler,
+		accessLogHandler:          accessLogHandler,
+		dashboardHandler:          dashboardHandler,
+		taskHandler:               taskHandler,
+		tlsHandler:                tlsHandler,
+		authorHandler:             authorHandler,
+		reactionHandler:           reactionHandler,
+		snippetHandler:            snippetHandler,
+		localizerHandler:          localizerHandler,
+		seoAuditHandler:           seoAuditHandler,
+		instanceBackupHandler:     instanceBackupHandler,
+		privacyHandler:            privacyHandler,
+		loginGuardHandler:         loginGuardHandler,
+		oauthHandler:              oauthHandler,
+		avatarHandler:             avatarHandler,
+		linkArchiveHandler:        linkArchiveHandler,
+		mediaHandler:              mediaHandler,
+		sysConfigHandler:          sysConfigHandler,
+		pluginHandler:             pluginHandler,
+		webhookHandler:            webhookHandler,
+		gitSyncHandler:            gitSyncHandler,
+		redirectHandler:           redirectHandler,
+		permalinkHandler:          permalinkHandler,
+		taxonomyHandler:           taxonomyHandler,
+		a11yAuditHandler:          a11yAuditHandler,
+		assetCDNHandler:           assetCDNHandler,
+		pageDataHandler:           pageDataHandler,
+		themeLayoutHandler:        themeLayoutHandler,
+		faviconHandler:            faviconHandler,
+		sqliteBackupHandler:       sqliteBackupHandler,
+		selfUpdateHandler:         selfUpdateHandler,
+		adminEventHandler:         adminEventHandler,
+		diagnosticsHandler:        diagnosticsHandler,
+		mailHandler:               mailHandler,
+		trashHandler:              trashHandler,
 	}
 }
 
@@ -187,10 +328,12 @@ func (r *Router) Setup(engine *gin.Engine) {
 	downloadGroup := engine.Group("/needcache")
 	{
 		downloadGroup.GET("/download/:public_id", r.fileHandler.HandleUniversalSignedDownload)
+		downloadGroup.GET("/resource/:name", r.localizerHandler.ServeResource)
 	}
 
 	// 代理路由
 	apiGroup.GET("/proxy/download", r.proxyHandler.HandleDownload)
+	apiGroup.GET("/avatar/:hash", middleware.CustomRateLimit(120, 60), r.avatarHandler.GetAvatar)
 
 	// 注册各个模块的路由
 	r.registerAuthRoutes(apiGroup)
@@ -217,13 +360,46 @@ func (r *Router) Setup(engine *gin.Engine) {
 	r.registerVersionRoutes(apiGroup)
 	r.registerNotificationRoutes(apiGroup)
 	r.registerConfigBackupRoutes(apiGroup)
+	r.registerCacheRoutes(apiGroup)
+	r.registerAccessLogRoutes(apiGroup)
+	r.registerDashboardRoutes(apiGroup)
+	r.registerTaskRoutes(apiGroup)
+	r.registerTLSRoutes(apiGroup)
+	r.registerSystemConfigRoutes(apiGroup)
+	r.registerPluginRoutes(apiGroup)
+	r.registerWebhookRoutes(apiGroup)
+	r.registerGitSyncRoutes(apiGroup)
+	r.registerRedirectRoutes(apiGroup)
+	r.registerPermalinkRoutes(apiGroup)
+	r.registerTaxonomyRoutes(apiGroup)
+	r.registerAuthorRoutes(apiGroup)
+	r.registerReactionRoutes(apiGroup)
+	r.registerSnippetRoutes(apiGroup)
+	r.registerResourceLocalizerRoutes(apiGroup)
+	r.registerSEOAuditRoutes(apiGroup)
+	r.registerA11yAuditRoutes(apiGroup)
+	r.registerAssetCDNRoutes(apiGroup)
+	r.registerPageDataRoutes(apiGroup)
+	r.registerThemeLayoutRoutes(apiGroup)
+	r.registerSiteIconRoutes(engine, apiGroup)
+	r.registerSQLiteBackupRoutes(apiGroup)
+	r.registerSelfUpdateRoutes(apiGroup)
+	r.registerAdminEventRoutes(apiGroup)
+	r.registerDiagnosticsRoutes(apiGroup)
+	r.registerMailRoutes(apiGroup)
+	r.registerTrashRoutes(apiGroup)
+	r.registerInstanceBackupRoutes(apiGroup)
+	r.registerPrivacyRoutes(apiGroup)
+	r.registerLoginSecurityRoutes(apiGroup)
+	r.registerLinkArchiveRoutes(apiGroup)
+	r.registerMediaRoutes(apiGroup)
 	r.registerSitemapRoutes(engine)    // 直接注册到engine，不使用/api前缀
 	r.registerSSRThemeRoutes(apiGroup) // 注册 SSR 主题管理路由
 }
 
 func (r *Router) registerCommentRoutes(api *gin.RouterGroup) {
 	// 公开的评论接口
-	commentsPublic := api.Group("/public/comments")
+	commentsPublic := api.Group("/public/comments").Use(r.mw.RequireFeature(setting.FeatureComments))
 	{
 		commentsPublic.GET("", r.commentHandler.ListByPath)
 
@@ -251,6 +427,8 @@ func (r *Router) registerCommentRoutes(api *gin.RouterGroup) {
 		commentsAdmin.PUT("/:id/pin", r.commentHandler.SetPin)
 		commentsAdmin.POST("/export", r.commentHandler.ExportComments)
 		commentsAdmin.POST("/import", r.commentHandler.ImportComments)
+		commentsAdmin.GET("/anonymize-ips", r.commentHandler.GetIPAnonymizationStatus)
+		commentsAdmin.POST("/anonymize-ips", r.commentHandler.StartIPAnonymization)
 	}
 }
 
@@ -312,6 +490,8 @@ func (r *Router) registerArticleRoutes(api *gin.RouterGroup) {
 	{
 		// 文章列表（普通用户只能查看自己的文章）
 		articlesUser.GET("", r.articleHandler.List)
+		// 内容日历（普通用户只统计自己的文章）
+		articlesUser.GET("/calendar", r.articleHandler.GetCalendar)
 		// 创建文章（支持普通用户，需要检查多人共创配置，权限在handler层校验）
 		articlesUser.POST("", r.articleHandler.Create)
 		// 上传文章图片（支持普通用户，用于多人共创场景）
@@ -322,6 +502,8 @@ func (r *Router) registerArticleRoutes(api *gin.RouterGroup) {
 		articlesUser.DELETE("/:id", r.articleHandler.Delete)
 		// 获取文章（普通用户只能获取自己的文章，权限在handler层校验）
 		articlesUser.GET("/:id", r.articleHandler.Get)
+		// 生成文章摘要（普通用户只能为自己的文章生成，权限在handler层校验）
+		articlesUser.POST("/:id/summary", r.articleHandler.GenerateSummary)
 
 		// 文章历史版本相关路由（需要登录）
 		if r.articleHistoryHandler != nil {
@@ -342,6 +524,23 @@ func (r *Router) registerArticleRoutes(api *gin.RouterGroup) {
 		articlesAdmin.POST("/import", r.articleHandler.ImportArticles)
 		// 批量删除文章（仅管理员可用）
 		articlesAdmin.DELETE("/batch", r.articleHandler.BatchDelete)
+		// 批量操作作为后台任务执行，通过任务ID查询进度和逐项结果
+		articlesAdmin.POST("/bulk", r.articleHandler.StartBulk)
+		articlesAdmin.GET("/bulk", r.articleHandler.ListBulkJobs)
+		articlesAdmin.GET("/bulk/:jobId", r.articleHandler.GetBulkJob)
+		// 设置文章置顶
+		articlesAdmin.PUT("/:id/pin", r.articleHandler.SetPin)
+	}
+
+	// 首页布局：公开读取，管理员修改
+	homePublic := api.Group("/public/home")
+	{
+		homePublic.GET("", r.articleHandler.GetHomePage)
+		homePublic.GET("/layout", r.articleHandler.GetHomeLayout)
+	}
+	homeAdmin := api.Group("/admin/home").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		homeAdmin.PUT("/layout", r.articleHandler.UpdateHomeLayout)
 	}
 
 	articlesPublic := api.Group("/public/articles")
@@ -353,6 +552,7 @@ func (r *Router) registerArticleRoutes(api *gin.RouterGroup) {
 		articlesPublic.GET("/statistics", r.articleHandler.GetArticleStatistics)
 		// 注意：把带参数的路由放在最后，避免路由冲突
 		articlesPublic.GET("/:id", r.articleHandler.GetPublic)
+		articlesPublic.POST("/:id/view", r.articleHandler.RecordView)
 	}
 }
 
@@ -377,12 +577,21 @@ func (r *Router) registerAuthRoutes(api *gin.RouterGroup) {
 	auth := api.Group("/auth")
 	{
 		auth.POST("/login", r.authHandler.Login)
+		auth.GET("/login-captcha", middleware.CustomRateLimit(10, 10), r.authHandler.GetLoginCaptcha)
 		auth.POST("/register", r.authHandler.Register)
 		auth.POST("/refresh-token", r.authHandler.RefreshToken)
+		auth.POST("/logout", r.mw.JWTAuth(), r.authHandler.Logout)
 		auth.POST("/activate", r.authHandler.ActivateUser)
-		auth.POST("/forgot-password", r.authHandler.ForgotPasswordRequest)
+		auth.POST("/resend-activation", middleware.CustomRateLimit(5, 3), r.authHandler.ResendActivation)
+		auth.POST("/forgot-password", middleware.CustomRateLimit(5, 3), r.authHandler.ForgotPasswordRequest)
 		auth.POST("/reset-password", r.authHandler.ResetPassword)
 		auth.GET("/check-email", r.authHandler.CheckEmail)
+
+		// 第三方登录
+		auth.GET("/oauth/providers", r.oauthHandler.ListProviders)
+		auth.GET("/oauth/:provider/authorize", r.oauthHandler.Authorize)
+		auth.GET("/oauth/:provider/callback", r.oauthHandler.Callback)
+		auth.POST("/oauth/exchange", r.authHandler.OAuthExchange)
 	}
 }
 
@@ -438,6 +647,16 @@ func (r *Router) registerUserRoutes(api *gin.RouterGroup) {
 		user.POST("/update-password", r.userHandler.UpdateUserPassword)
 		user.PUT("/profile", r.userHandler.UpdateUserProfile)
 		user.POST("/avatar", r.userHandler.UploadAvatar)
+
+		// 第三方账号绑定
+		user.GET("/oauth", r.oauthHandler.ListLinks)
+		user.POST("/oauth/:provider/link", r.oauthHandler.Link)
+		user.DELETE("/oauth/:provider", r.oauthHandler.Unlink)
+
+		// 登录设备管理
+		user.GET("/sessions", r.authHandler.ListSessions)
+		user.DELETE("/sessions", r.authHandler.RevokeOtherSessions)
+		user.DELETE("/sessions/:id", r.authHandler.RevokeSession)
 	}
 
 	// 管理员用户管理路由（需要登录且为管理员）
@@ -479,8 +698,9 @@ func (r *Router) registerPublicRoutes(api *gin.RouterGroup) {
 		public.GET("/captcha/image", middleware.CustomRateLimit(10, 10), r.captchaHandler.GenerateImage)
 
 		// 订阅相关路由
-		public.POST("/subscribe", middleware.CustomRateLimit(3, 3), r.subscriberHandler.Subscribe)
-		public.POST("/subscribe/code", middleware.CustomRateLimit(3, 3), r.subscriberHandler.SendVerificationCode)
+		// 订阅功能关闭后仍允许退订
+		public.POST("/subscribe", r.mw.RequireFeature(setting.FeatureNewsletter), middleware.CustomRateLimit(3, 3), r.subscriberHandler.Subscribe)
+		public.POST("/subscribe/code", r.mw.RequireFeature(setting.FeatureNewsletter), middleware.CustomRateLimit(3, 3), r.subscriberHandler.SendVerificationCode)
 		public.POST("/unsubscribe", r.subscriberHandler.Unsubscribe)
 		public.GET("/unsubscribe/:token", r.subscriberHandler.UnsubscribeByToken)
 	}
@@ -670,7 +890,7 @@ func (r *Router) registerStatisticsRoutes(api *gin.RouterGroup) {
 // registerSearchRoutes 注册搜索相关的路由
 func (r *Router) registerSearchRoutes(api *gin.RouterGroup) {
 	// 搜索接口是公开的，不需要认证
-	searchGroup := api.Group("/search")
+	searchGroup := api.Group("/search").Use(r.mw.RequireFeature(setting.FeatureSearch))
 	{
 		// 搜索文章: GET /api/search?q=关键词&page=1&size=10
 		searchGroup.GET("", r.searchHandler.Search)
@@ -712,6 +932,9 @@ func (r *Router) registerThemeRoutes(api *gin.RouterGroup) {
 
 		// 获取当前主题配置（公开接口，供前端主题使用）: GET /api/public/theme/config
 		themePublic.GET("/config", r.themeHandler.GetPublicThemeConfig)
+
+		// 获取当前主题的语言词条（公开接口，供前端主题使用）: GET /api/public/theme/locale?lang=en
+		themePublic.GET("/locale", r.themeHandler.GetPublicThemeLocale)
 	}
 
 	// 需要登录的主题管理接口
@@ -726,6 +949,15 @@ func (r *Router) registerThemeRoutes(api *gin.RouterGroup) {
 		// 安装主题: POST /api/theme/install
 		themeAuth.POST("/install", r.themeHandler.InstallTheme)
 
+		// 取消主题安装: POST /api/theme/install/cancel
+		themeAuth.POST("/install/cancel", r.themeHandler.CancelInstall)
+
+		// 检查主题更新: GET /api/theme/check-updates
+		themeAuth.GET("/check-updates", r.themeHandler.CheckThemeUpdates)
+
+		// 从主题商城更新主题: POST /api/theme/update
+		themeAuth.POST("/update", r.themeHandler.UpdateTheme)
+
 		// 上传主题: POST /api/theme/upload
 		themeAuth.POST("/upload", r.themeHandler.UploadTheme)
 
@@ -754,6 +986,47 @@ func (r *Router) registerThemeRoutes(api *gin.RouterGroup) {
 
 		// 获取当前主题的完整配置（定义+值）: GET /api/theme/current-config
 		themeAuth.GET("/current-config", r.themeHandler.GetCurrentThemeConfig)
+
+		// 主题兼容性冒烟测试: GET/POST /api/theme/smoke-test
+		themeAuth.GET("/smoke-test", r.themeHandler.GetSmokeTestResult)
+		themeAuth.POST("/smoke-test", r.themeHandler.RunSmokeTest)
+
+		// 主题存储占用与清理: GET /api/theme/storage, POST /api/theme/storage/cleanup
+		themeAuth.GET("/storage", r.themeHandler.GetStorageReport)
+		themeAuth.POST("/storage/cleanup", r.themeHandler.CleanupStorage)
+
+		// 主题记录与文件一致性检查: GET /api/theme/reconcile, POST /api/theme/reconcile/fix
+		themeAuth.GET("/reconcile", r.themeHandler.ReconcileThemes)
+		themeAuth.POST("/reconcile/fix", r.themeHandler.FixOrphanTheme)
+
+		// 已安装主题批量操作: POST /api/theme/bulk/uninstall, /check-updates, /export
+		themeAuth.POST("/bulk/uninstall", r.themeHandler.BulkUninstallThemes)
+		themeAuth.POST("/bulk/check-updates", r.themeHandler.BulkCheckThemeUpdates)
+		themeAuth.POST("/bulk/export", r.themeHandler.BulkExportThemes)
+
+		// 主题商城来源与评分: GET /api/theme/market/sources, POST /api/theme/market/rate
+		themeAuth.GET("/market/sources", r.themeHandler.GetMarketSources)
+		themeAuth.POST("/market/rate", r.themeHandler.RateMarketTheme)
+	}
+
+	// theme.json 修复：直接读写磁盘上的主题文件，仅管理员可用
+	themeAdmin := api.Group("/theme/metadata").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		// 读取原始内容: GET /api/theme/metadata?theme_name=xxx[&backup=theme.json.20261015-120000]
+		themeAdmin.GET("", r.themeHandler.GetThemeJSON)
+		// 校验内容: POST /api/theme/metadata/validate
+		themeAdmin.POST("/validate", r.themeHandler.ValidateThemeJSON)
+		// 备份并写入: PUT /api/theme/metadata
+		themeAdmin.PUT("", r.themeHandler.SaveThemeJSON)
+	}
+
+	// 主题完整性复检：重新校验已安装主题并与安装时的文件清单比对，仅管理员可用
+	themeIntegrity := api.Group("/theme/integrity").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		// 复检: POST /api/theme/integrity/verify
+		themeIntegrity.POST("/verify", r.themeHandler.VerifyThemeIntegrity)
+		// 以当前文件重建基线: POST /api/theme/integrity/rebuild
+		themeIntegrity.POST("/rebuild", r.themeHandler.RebuildThemeManifest)
 	}
 }
 
@@ -799,6 +1072,9 @@ func (r *Router) registerVersionRoutes(api *gin.RouterGroup) {
 		// GET /api/version/string - 获取版本字符串 (简单字符串格式)
 		versionGroup.GET("/string", r.versionHandler.GetVersionString)
 	}
+
+	// GET /api/public/capabilities - 站点能力声明，供主题检测可用功能
+	api.GET("/public/capabilities", r.versionHandler.GetCapabilities)
 }
 
 // registerNotificationRoutes 注册通知相关路由
@@ -818,6 +1094,366 @@ func (r *Router) registerNotificationRoutes(api *gin.RouterGroup) {
 	notificationAdminGroup := api.Group("/notification").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
 	{
 		notificationAdminGroup.GET("/types", r.notificationHandler.ListNotificationTypes)
+
+		// 系统通知中心
+		notificationAdminGroup.GET("/notices", r.notificationHandler.ListSystemNotices)
+		notificationAdminGroup.GET("/notices/render-error", r.notificationHandler.GetLatestRenderError)
+		notificationAdminGroup.PUT("/notices/:id/read", r.notificationHandler.MarkSystemNoticeRead)
+		notificationAdminGroup.DELETE("/notices", r.notificationHandler.ClearSystemNotices)
+	}
+}
+
+// registerCacheRoutes 注册缓存管理相关路由
+func (r *Router) registerCacheRoutes(api *gin.RouterGroup) {
+	cacheAdminGroup := api.Group("/admin/cache").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		cacheAdminGroup.GET("/status", r.cacheHandler.GetStatus)
+		cacheAdminGroup.POST("/revalidate", r.cacheHandler.Revalidate)
+		cacheAdminGroup.POST("/purge", r.cacheHandler.Purge)
+		cacheAdminGroup.POST("/warm", r.cacheHandler.Warm)
+	}
+}
+
+// registerAccessLogRoutes 注册访问日志相关路由
+func (r *Router) registerAccessLogRoutes(api *gin.RouterGroup) {
+	accessLogAdminGroup := api.Group("/admin/access-logs").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		accessLogAdminGroup.GET("", r.accessLogHandler.List)
+		accessLogAdminGroup.DELETE("", r.accessLogHandler.Clear)
+		accessLogAdminGroup.GET("/upstreams", r.accessLogHandler.UpstreamMetrics)
+		accessLogAdminGroup.GET("/ssr", r.accessLogHandler.SSRMetrics)
+		accessLogAdminGroup.GET("/db", r.accessLogHandler.DBMetrics)
+		accessLogAdminGroup.GET("/identity", r.accessLogHandler.OutboundIdentity)
+	}
+}
+
+// registerDashboardRoutes 注册后台仪表盘路由
+func (r *Router) registerDashboardRoutes(api *gin.RouterGroup) {
+	dashboardAdminGroup := api.Group("/admin/dashboard").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		dashboardAdminGroup.GET("/summary", r.dashboardHandler.GetSummary)
+	}
+}
+
+// registerTaskRoutes 注册定时任务管理路由
+func (r *Router) registerTaskRoutes(api *gin.RouterGroup) {
+	taskAdminGroup := api.Group("/admin/tasks").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		taskAdminGroup.GET("", r.taskHandler.ListTasks)
+		taskAdminGroup.POST("/:key/run", r.taskHandler.RunTask)
+		taskAdminGroup.PUT("/:key/schedule", r.taskHandler.UpdateSchedule)
+	}
+}
+
+// registerTLSRoutes 注册内置 HTTPS 证书状态路由
+func (r *Router) registerTLSRoutes(api *gin.RouterGroup) {
+	tlsAdminGroup := api.Group("/admin/tls").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		tlsAdminGroup.GET("/status", r.tlsHandler.GetStatus)
+	}
+}
+
+// registerSystemConfigRoutes 注册配置文件重新加载路由
+func (r *Router) registerSystemConfigRoutes(api *gin.RouterGroup) {
+	configAdminGroup := api.Group("/admin/config").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		configAdminGroup.POST("/reload", r.sysConfigHandler.Reload)
+	}
+}
+
+// registerPluginRoutes 注册插件管理路由
+func (r *Router) registerPluginRoutes(api *gin.RouterGroup) {
+	pluginAdminGroup := api.Group("/admin/plugins").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		pluginAdminGroup.GET("", r.pluginHandler.List)
+		pluginAdminGroup.PUT("/:name/enabled", r.pluginHandler.SetEnabled)
+		pluginAdminGroup.GET("/:name/settings", r.pluginHandler.GetSettings)
+		pluginAdminGroup.PUT("/:name/settings", r.pluginHandler.UpdateSettings)
+		pluginAdminGroup.POST("/:name/restart", r.pluginHandler.Restart)
+	}
+}
+
+// registerWebhookRoutes 注册内容来源 Webhook 路由，接收接口通过 HMAC 签名鉴权
+func (r *Router) registerWebhookRoutes(api *gin.RouterGroup) {
+	api.POST("/webhooks/:id", r.webhookHandler.Receive)
+
+	webhookAdminGroup := api.Group("/admin/webhooks").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		webhookAdminGroup.GET("", r.webhookHandler.ListSources)
+		webhookAdminGroup.POST("/:id/preview", r.webhookHandler.Preview)
+	}
+}
+
+// registerGitSyncRoutes 注册 Git 内容同步管理路由
+func (r *Router) registerGitSyncRoutes(api *gin.RouterGroup) {
+	gitSyncGroup := api.Group("/admin/gitsync").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		gitSyncGroup.GET("", r.gitSyncHandler.GetStatus)
+		gitSyncGroup.POST("/sync", r.gitSyncHandler.Sync)
+		gitSyncGroup.POST("/conflicts/resolve", r.gitSyncHandler.ResolveConflict)
+	}
+}
+
+// registerRedirectRoutes 注册重定向管理路由
+func (r *Router) registerRedirectRoutes(api *gin.RouterGroup) {
+	redirectGroup := api.Group("/admin/redirects").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		redirectGroup.GET("", r.redirectHandler.ListRedirects)
+		redirectGroup.POST("", r.redirectHandler.CreateRedirect)
+		redirectGroup.DELETE("", r.redirectHandler.DeleteRedirect)
+	}
+}
+
+// registerPermalinkRoutes 注册文章永久链接管理路由
+func (r *Router) registerPermalinkRoutes(api *gin.RouterGroup) {
+	permalinkGroup := api.Group("/admin/permalinks").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		permalinkGroup.GET("", r.permalinkHandler.GetStatus)
+		permalinkGroup.POST("/preview", r.permalinkHandler.Preview)
+		permalinkGroup.POST("/apply", r.permalinkHandler.Apply)
+	}
+}
+
+// registerTaxonomyRoutes 注册标签云与分类树路由
+func (r *Router) registerTaxonomyRoutes(api *gin.RouterGroup) {
+	taxonomyPublic := api.Group("/public/taxonomy")
+	{
+		taxonomyPublic.GET("/tag-cloud", r.taxonomyHandler.GetTagCloud)
+		taxonomyPublic.GET("/category-tree", r.taxonomyHandler.GetCategoryTree)
+	}
+}
+
+// registerAuthorRoutes 注册作者资料与作者页路由
+func (r *Router) registerAuthorRoutes(api *gin.RouterGroup) {
+	authorsPublic := api.Group("/public/authors")
+	{
+		authorsPublic.GET("", r.authorHandler.ListAuthors)
+		authorsPublic.GET("/:slug", r.authorHandler.GetAuthor)
+		authorsPublic.GET("/:slug/articles", r.authorHandler.ListAuthorArticles)
+	}
+
+	authorsAdmin := api.Group("/admin/authors").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		authorsAdmin.GET("", r.authorHandler.AdminListAuthors)
+		authorsAdmin.PUT("", r.authorHandler.SaveAuthors)
+	}
+}
+
+// registerReactionRoutes 注册文章与即刻表态路由
+func (r *Router) registerReactionRoutes(api *gin.RouterGroup) {
+	reactionsPublic := api.Group("/public/reactions").Use(r.mw.RequireFeature(setting.FeatureReactions))
+	{
+		reactionsPublic.GET("/:type/:id", r.reactionHandler.GetReactions)
+		reactionsPublic.POST("/:type/:id", middleware.CustomRateLimit(20, 10), r.reactionHandler.React)
+		reactionsPublic.DELETE("/:type/:id", middleware.CustomRateLimit(20, 10), r.reactionHandler.Unreact)
+	}
+
+	reactionsAdmin := api.Group("/admin/reactions").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		reactionsAdmin.GET("", r.reactionHandler.ListReactions)
+		reactionsAdmin.DELETE("/:type/:id", r.reactionHandler.ResetReactions)
+	}
+}
+
+// registerSnippetRoutes 注册自定义代码片段管理路由
+func (r *Router) registerSnippetRoutes(api *gin.RouterGroup) {
+	snippetsAdmin := api.Group("/admin/snippets").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		snippetsAdmin.GET("", r.snippetHandler.ListSnippets)
+		snippetsAdmin.POST("", r.snippetHandler.CreateSnippet)
+		snippetsAdmin.PUT("/:id", r.snippetHandler.UpdateSnippet)
+		snippetsAdmin.PUT("/:id/enabled", r.snippetHandler.ToggleSnippet)
+		snippetsAdmin.DELETE("/:id", r.snippetHandler.DeleteSnippet)
+		snippetsAdmin.GET("/:id/history", r.snippetHandler.GetSnippetHistory)
+		snippetsAdmin.POST("/:id/history/:version/rollback", r.snippetHandler.RollbackSnippet)
+	}
+}
+
+// registerResourceLocalizerRoutes 注册第三方资源本地化管理路由
+func (r *Router) registerResourceLocalizerRoutes(api *gin.RouterGroup) {
+	resourcesAdmin := api.Group("/admin/resources").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		resourcesAdmin.GET("", r.localizerHandler.ListResources)
+		resourcesAdmin.DELETE("", r.localizerHandler.ClearResources)
+	}
+}
+
+// registerSEOAuditRoutes 注册页面 SEO 检查路由
+func (r *Router) registerSEOAuditRoutes(api *gin.RouterGroup) {
+	seoAdmin := api.Group("/admin/seo").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		seoAdmin.POST("/audit", r.seoAuditHandler.AuditPage)
+	}
+}
+
+// registerA11yAuditRoutes 注册页面无障碍检查路由
+func (r *Router) registerA11yAuditRoutes(api *gin.RouterGroup) {
+	a11yAdmin := api.Group("/admin/a11y").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		a11yAdmin.POST("/audit", r.a11yAuditHandler.AuditPage)
+	}
+}
+
+// registerAssetCDNRoutes 注册主题静态资源 CDN 路由
+func (r *Router) registerAssetCDNRoutes(api *gin.RouterGroup) {
+	assetCDNAdmin := api.Group("/admin/theme/asset-cdn").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		assetCDNAdmin.GET("", r.assetCDNHandler.GetStatus)
+		assetCDNAdmin.POST("/sync", r.assetCDNHandler.Sync)
+	}
+}
+
+// registerPageDataRoutes 注册自定义页面数据绑定路由
+func (r *Router) registerPageDataRoutes(api *gin.RouterGroup) {
+	api.GET("/public/page-data", r.pageDataHandler.GetPageData)
+
+	pageDataAdmin := api.Group("/admin/pages/:id/bindings").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		pageDataAdmin.GET("", r.pageDataHandler.GetBindings)
+		pageDataAdmin.PUT("", r.pageDataHandler.SetBindings)
+	}
+}
+
+// registerThemeLayoutRoutes 注册主题菜单和小工具布局路由
+func (r *Router) registerThemeLayoutRoutes(api *gin.RouterGroup) {
+	themeLayoutAdmin := api.Group("/admin/theme/layout").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		themeLayoutAdmin.GET("", r.themeLayoutHandler.GetLayout)
+		themeLayoutAdmin.PUT("", r.themeLayoutHandler.SetLayout)
+	}
+}
+
+// registerSiteIconRoutes 注册站点图标路由，manifest 直接注册到根路径
+func (r *Router) registerSiteIconRoutes(engine *gin.Engine, api *gin.RouterGroup) {
+	engine.GET("/site.webmanifest", r.faviconHandler.GetManifest)
+
+	siteIconAdmin := api.Group("/admin/site-icon").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		siteIconAdmin.GET("", r.faviconHandler.GetIcons)
+		siteIconAdmin.POST("", r.faviconHandler.GenerateIcons)
+	}
+}
+
+// registerSQLiteBackupRoutes 注册 SQLite 在线备份路由
+func (r *Router) registerSQLiteBackupRoutes(api *gin.RouterGroup) {
+	sqliteBackupAdmin := api.Group("/admin/sqlite-backups").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		sqliteBackupAdmin.GET("", r.sqliteBackupHandler.ListBackups)
+		sqliteBackupAdmin.POST("", r.sqliteBackupHandler.CreateBackup)
+		sqliteBackupAdmin.GET("/:filename/download", r.sqliteBackupHandler.DownloadBackup)
+		sqliteBackupAdmin.DELETE("/:filename", r.sqliteBackupHandler.DeleteBackup)
+	}
+}
+
+// registerSelfUpdateRoutes 注册程序自更新路由
+func (r *Router) registerSelfUpdateRoutes(api *gin.RouterGroup) {
+	updateAdmin := api.Group("/admin/update").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		updateAdmin.GET("/check", r.selfUpdateHandler.Check)
+		updateAdmin.GET("/status", r.selfUpdateHandler.Status)
+		updateAdmin.POST("/apply", r.selfUpdateHandler.Apply)
+		updateAdmin.POST("/rollback", r.selfUpdateHandler.Rollback)
+	}
+}
+
+// registerAdminEventRoutes 注册管理后台实时事件路由
+func (r *Router) registerAdminEventRoutes(api *gin.RouterGroup) {
+	eventsGroup := api.Group("/admin/events").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		// GET /api/admin/events - SSE 事件流
+		eventsGroup.GET("", r.adminEventHandler.Stream)
+		// GET /api/admin/events/poll - 长轮询，供不便使用 SSE 的客户端
+		eventsGroup.GET("/poll", r.adminEventHandler.Poll)
+	}
+}
+
+// registerDiagnosticsRoutes 注册诊断包路由
+func (r *Router) registerDiagnosticsRoutes(api *gin.RouterGroup) {
+	diagnosticsGroup := api.Group("/admin/diagnostics").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		// GET /api/admin/diagnostics/bundle - 下载脱敏后的诊断包
+		diagnosticsGroup.GET("/bundle", r.diagnosticsHandler.DownloadBundle)
+	}
+}
+
+// registerMailRoutes 注册邮件发送管理路由
+func (r *Router) registerMailRoutes(api *gin.RouterGroup) {
+	mailGroup := api.Group("/admin/mail").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		// POST /api/admin/mail/test - 立即发送测试邮件，可指定发送方式
+		mailGroup.POST("/test", r.mailHandler.TestSend)
+		// GET /api/admin/mail/queue - 查看发送队列
+		mailGroup.GET("/queue", r.mailHandler.GetQueue)
+		// POST /api/admin/mail/queue/retry - 重试发送失败的邮件
+		mailGroup.POST("/queue/retry", r.mailHandler.RetryFailed)
+		// DELETE /api/admin/mail/queue/:id - 删除队列中的邮件
+		mailGroup.DELETE("/queue/:id", r.mailHandler.RemoveQueued)
+	}
+}
+
+// registerTrashRoutes 注册回收站路由
+func (r *Router) registerTrashRoutes(api *gin.RouterGroup) {
+	trashGroup := api.Group("/admin/trash").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		// GET /api/admin/trash - 获取回收站内容，可按类型筛选
+		trashGroup.GET("", r.trashHandler.List)
+		// POST /api/admin/trash/:type/:id/restore - 恢复文章、页面或主题
+		trashGroup.POST("/:type/:id/restore", r.trashHandler.Restore)
+		// DELETE /api/admin/trash/:type/:id - 彻底删除
+		trashGroup.DELETE("/:type/:id", r.trashHandler.Purge)
+		// DELETE /api/admin/trash - 清空回收站，可按类型清空
+		trashGroup.DELETE("", r.trashHandler.Empty)
+	}
+}
+
+// registerInstanceBackupRoutes 注册整站备份路由，恢复只能通过命令行在停止服务后执行
+func (r *Router) registerInstanceBackupRoutes(api *gin.RouterGroup) {
+	backupAdmin := api.Group("/admin/instance-backups").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		backupAdmin.GET("", r.instanceBackupHandler.ListBackups)
+		backupAdmin.POST("", r.instanceBackupHandler.CreateBackup)
+		backupAdmin.POST("/:filename/validate", r.instanceBackupHandler.ValidateBackup)
+		backupAdmin.GET("/:filename/download", r.instanceBackupHandler.DownloadBackup)
+		backupAdmin.DELETE("/:filename", r.instanceBackupHandler.DeleteBackup)
+	}
+}
+
+// registerPrivacyRoutes 注册个人数据导出与匿名化路由
+func (r *Router) registerPrivacyRoutes(api *gin.RouterGroup) {
+	privacyAdmin := api.Group("/admin/privacy").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		privacyAdmin.GET("/export", r.privacyHandler.ExportData)
+		privacyAdmin.POST("/anonymize", r.privacyHandler.AnonymizeData)
+	}
+}
+
+// registerLoginSecurityRoutes 注册登录安全管理路由
+func (r *Router) registerLoginSecurityRoutes(api *gin.RouterGroup) {
+	loginSecurityAdmin := api.Group("/admin/login-security").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		loginSecurityAdmin.GET("/events", r.loginGuardHandler.ListEvents)
+		loginSecurityAdmin.POST("/unlock", r.loginGuardHandler.Unlock)
+	}
+}
+
+// registerLinkArchiveRoutes 注册外链存档管理路由
+func (r *Router) registerLinkArchiveRoutes(api *gin.RouterGroup) {
+	linkArchiveAdmin := api.Group("/admin/link-archives").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		linkArchiveAdmin.GET("", r.linkArchiveHandler.ListRecords)
+		linkArchiveAdmin.POST("/run", r.linkArchiveHandler.Run)
+	}
+}
+
+// registerMediaRoutes 注册媒体库管理路由
+func (r *Router) registerMediaRoutes(api *gin.RouterGroup) {
+	mediaAdmin := api.Group("/admin/media").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		mediaAdmin.GET("", r.mediaHandler.List)
+		mediaAdmin.GET("/duplicates", r.mediaHandler.Duplicates)
+		mediaAdmin.GET("/:id/usage", r.mediaHandler.Usage)
+		mediaAdmin.POST("/cleanup", r.mediaHandler.Cleanup)
+		mediaAdmin.POST("/regenerate", r.mediaHandler.Regenerate)
 	}
 }
 
@@ -0,0 +1,53 @@
+package router
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CommentLister 按页面路径分页获取已发布评论，由评论服务实现
+type CommentLister interface {
+	ListByPath(ctx context.Context, path string, page, pageSize int) (*dto.ListResponse, error)
+}
+
+// ssrCommentMaxPageSize 注入 HTML 的单页评论数上限，避免评论过多时页面体积膨胀
+const ssrCommentMaxPageSize = 50
+
+// ssrComments 获取文章页需要随 HTML 注入的一页评论
+// 默认注入第一页；爬虫可以通过 ?comment_page=N 访问后续页面，前端继续通过公开评论接口懒加载。
+// 评论按文章的规范路径查询，通过 ID 或 abbrlink 访问同一篇文章时得到相同的评论
+func ssrComments(c *gin.Context, settingSvc setting.SettingService, lister CommentLister, article *model.ArticleResponse) *dto.ListResponse {
+	if lister == nil || !settingSvc.GetBool(constant.KeyCommentSSREnable.String()) {
+		return nil
+	}
+
+	pageSize, err := strconv.Atoi(settingSvc.Get(constant.KeyCommentSSRPageSize.String()))
+	if err != nil || pageSize <= 0 {
+		pageSize = 10
+	}
+	if pageSize > ssrCommentMaxPageSize {
+		pageSize = ssrCommentMaxPageSize
+	}
+	page, err := strconv.Atoi(c.Query("comment_page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	path := "/posts/" + article.ID
+	if article.Abbrlink != "" {
+		path = "/posts/" + article.Abbrlink
+	}
+	comments, err := lister.ListByPath(c.Request.Context(), path, page, pageSize)
+	if err != nil {
+		debugLog("SSR 获取评论失败: %s, 错误: %v", path, err)
+		return nil
+	}
+	return comments
+}
@@ -0,0 +1,63 @@
+package router
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/taxonomy"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TaxonomyProvider 提供标签云和分类树，由 taxonomy 服务实现
+type TaxonomyProvider interface {
+	TagCloud(ctx context.Context) ([]taxonomy.TagCloudItem, error)
+	CategoryTree(ctx context.Context) ([]*taxonomy.CategoryNode, error)
+}
+
+// ssrTaxonomyProvider 标签、分类、归档页 SSR 注入使用的服务，未设置时不注入
+var ssrTaxonomyProvider TaxonomyProvider
+
+// SetTaxonomyProvider 设置标签、分类、归档页 SSR 注入使用的服务，应在 SetupFrontend 之前调用
+func SetTaxonomyProvider(provider TaxonomyProvider) {
+	ssrTaxonomyProvider = provider
+}
+
+// ssrTaxonomyPaths 注入标签云和分类树的页面
+var ssrTaxonomyPaths = map[string]bool{
+	"/tags":       true,
+	"/categories": true,
+	"/archives":   true,
+}
+
+// ssrTaxonomyData 标签、分类、归档页随 HTML 注入的初始数据，其他页面返回 nil。
+// 主题可以直接使用 initialData.taxonomy，不必拉取全部文章后在客户端统计
+func ssrTaxonomyData(c *gin.Context) map[string]interface{} {
+	path := c.Request.URL.Path
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	if ssrTaxonomyProvider == nil || !ssrTaxonomyPaths[path] {
+		return nil
+	}
+
+	ctx := c.Request.Context()
+	tagCloud, err := ssrTaxonomyProvider.TagCloud(ctx)
+	if err != nil {
+		debugLog("SSR 获取标签云失败: %v", err)
+		return nil
+	}
+	categoryTree, err := ssrTaxonomyProvider.CategoryTree(ctx)
+	if err != nil {
+		debugLog("SSR 获取分类树失败: %v", err)
+		return nil
+	}
+	return map[string]interface{}{
+		"taxonomy": map[string]interface{}{
+			"tagCloud":     tagCloud,
+			"categoryTree": categoryTree,
+		},
+		"__timestamp__": time.Now().UnixMilli(),
+	}
+}
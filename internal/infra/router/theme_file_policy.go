@@ -0,0 +1,100 @@
+/*
+ * @Description: 外部主题文件的访问策略
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 禁止列表中的文件（默认为点文件和源码映射）不对外提供，放行列表优先；
+ * 直接提供的主题 HTML、SVG、XML 等可执行脚本的文件加上沙箱 CSP，受信任的文件除外。
+ */
+package router
+
+import (
+	"path"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 外部主题文件访问策略的默认值，与 configdef 中的默认配置一致
+const (
+	defaultThemeFilesDeny  = ".*,*.map"
+	defaultThemeFilesAllow = ".well-known/*"
+	defaultThemeHTMLCSP    = "sandbox; default-src 'none'; img-src 'self' data:; style-src 'self' 'unsafe-inline'; font-src 'self' data:; media-src 'self'; frame-ancestors 'self'"
+)
+
+// themeFileSettings 读取外部主题文件访问策略，在 SetupFrontend 中设置，未设置时使用默认策略
+var themeFileSettings setting.SettingService
+
+// themeFileSetting 读取策略配置，未设置配置服务时返回默认值
+func themeFileSetting(key constant.SettingKey, fallback string) string {
+	if themeFileSettings == nil {
+		return fallback
+	}
+	return themeFileSettings.Get(key.String())
+}
+
+// themeFileBlocked 外部主题文件是否禁止访问：命中禁止列表且未命中放行列表。
+// 主题压缩包中的源码映射、.git、.env 等文件不应原样对外提供
+func themeFileBlocked(filePath string) bool {
+	filePath = strings.TrimPrefix(path.Clean("/"+filePath), "/")
+	if !matchThemeFilePatterns(themeFileSetting(constant.KeyThemeFilesDeny, defaultThemeFilesDeny), filePath) {
+		return false
+	}
+	return !matchThemeFilePatterns(themeFileSetting(constant.KeyThemeFilesAllow, defaultThemeFilesAllow), filePath)
+}
+
+// themeActiveContentExts 浏览器直接打开时可以执行脚本的文件类型
+var themeActiveContentExts = map[string]bool{
+	".html": true, ".htm": true, ".xhtml": true, ".xht": true,
+	".svg": true, ".svgz": true, ".xml": true, ".xsl": true, ".xslt": true,
+}
+
+// isThemeActiveContent 文件直接打开时是否可能执行脚本
+func isThemeActiveContent(filePath string) bool {
+	return themeActiveContentExts[strings.ToLower(path.Ext(filePath))]
+}
+
+// applyThemeHTMLPolicy 为直接提供的外部主题 HTML、SVG、XML 文件（如主题自带的演示页和图标）加上沙箱 CSP，
+// 使其不能以站点身份执行脚本；SVG 作为图片引用时不受影响。首页、文章模板等页面 HTML 经 serveThemePage 提供，不经过这里
+func applyThemeHTMLPolicy(c *gin.Context, filePath string) {
+	if !isThemeActiveContent(filePath) {
+		return
+	}
+	filePath = strings.TrimPrefix(path.Clean("/"+filePath), "/")
+	if matchThemeFilePatterns(themeFileSetting(constant.KeyThemeFilesTrustedHTML, ""), filePath) {
+		return
+	}
+	csp := strings.TrimSpace(themeFileSetting(constant.KeyThemeFilesHTMLCSP, defaultThemeHTMLCSP))
+	if csp == "" {
+		csp = defaultThemeHTMLCSP
+	}
+	c.Header("Content-Security-Policy", csp)
+	c.Header("X-Content-Type-Options", "nosniff")
+}
+
+// matchThemeFilePatterns 判断相对路径是否命中逗号分隔的模式列表。
+// 含 / 的模式匹配完整路径，不含 / 的模式匹配路径中的任意一段
+func matchThemeFilePatterns(patterns string, filePath string) bool {
+	segments := strings.Split(filePath, "/")
+	for _, pattern := range strings.Split(patterns, ",") {
+		pattern = strings.Trim(strings.TrimSpace(pattern), "/")
+		if pattern == "" {
+			continue
+		}
+		if strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, filePath); ok {
+				return true
+			}
+			continue
+		}
+		for _, segment := range segments {
+			if ok, _ := path.Match(pattern, segment); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
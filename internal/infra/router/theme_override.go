@@ -0,0 +1,252 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// themeOverridesDirName 主题覆盖目录
+// overrides/<主题名>/ 下的文件按相对路径覆盖外部主题中的同名文件（静态资源和 HTML 模板），
+// 覆盖目录不在 static 中，切换或更新主题时不会被清除，用户可以只修改个别 CSS 或模板而不必修改主题包
+const themeOverridesDirName = "overrides"
+
+// defaultOverrideThemeName 主题没有提供 theme.json 时使用的覆盖目录名
+const defaultOverrideThemeName = "default"
+
+// themesDirName 已安装主题的目录，子主题缺少的文件从 themes/<父主题>/ 中回退查找
+const themesDirName = "themes"
+
+// maxThemeInheritanceDepth 主题继承链的最大层数，与主题服务中的限制保持一致
+const maxThemeInheritanceDepth = 5
+
+// themeNameCache 缓存从 static/theme.json 读取的主题名和父主题目录，文件修改后自动重新读取
+var themeNameCache struct {
+	mu         sync.Mutex
+	modTime    time.Time
+	name       string
+	parentDirs []string
+}
+
+// themeMeta theme.json 中路由关心的字段
+type themeMeta struct {
+	Name    string `json:"name"`
+	Extends string `json:"extends"`
+}
+
+func readThemeMeta(dir string) (themeMeta, bool) {
+	var meta themeMeta
+	content, err := os.ReadFile(filepath.Join(dir, "theme.json"))
+	if err != nil || json.Unmarshal(content, &meta) != nil {
+		return meta, false
+	}
+	return meta, true
+}
+
+// isValidThemeDirName 主题名会作为目录名使用，只接受单级目录名，防止跳出主题目录
+func isValidThemeDirName(name string) bool {
+	return name != "" && filepath.IsLocal(name) && !strings.ContainsAny(name, `/\`)
+}
+
+// loadThemeInfo 读取当前外部主题的名称和由近到远的父主题目录
+func loadThemeInfo() (string, []string) {
+	themeNameCache.mu.Lock()
+	defer themeNameCache.mu.Unlock()
+
+	info, err := os.Stat(filepath.Join("static", "theme.json"))
+	if err != nil {
+		themeNameCache.name = ""
+		return defaultOverrideThemeName, nil
+	}
+	if themeNameCache.name != "" && info.ModTime().Equal(themeNameCache.modTime) {
+		return themeNameCache.name, themeNameCache.parentDirs
+	}
+
+	name := defaultOverrideThemeName
+	var parentDirs []string
+	if meta, ok := readThemeMeta("static"); ok {
+		if isValidThemeDirName(meta.Name) {
+			name = meta.Name
+		}
+		// 沿 extends 向上解析继承链，遇到循环、层数超限或父主题缺失时停止
+		seen := map[string]bool{meta.Name: true}
+		for parent := strings.TrimSpace(meta.Extends); isValidThemeDirName(parent) && !seen[parent] && len(parentDirs) < maxThemeInheritanceDepth; {
+			dir := filepath.Join(themesDirName, parent)
+			parentMeta, ok := readThemeMeta(dir)
+			if !ok {
+				log.Printf("[主题继承] 父主题 %s 不存在或 theme.json 无效", parent)
+				break
+			}
+			seen[parent] = true
+			parentDirs = append(parentDirs, dir)
+			parent = strings.TrimSpace(parentMeta.Extends)
+		}
+	}
+	themeNameCache.modTime = info.ModTime()
+	themeNameCache.name = name
+	themeNameCache.parentDirs = parentDirs
+	return name, parentDirs
+}
+
+// currentThemeName 返回当前外部主题的名称，用于定位覆盖目录
+func currentThemeName() string {
+	name, _ := loadThemeInfo()
+	return name
+}
+
+// themeParentDirs 返回当前外部主题由近到远的父主题目录，未声明 extends 时为空
+func themeParentDirs() []string {
+	_, parentDirs := loadThemeInfo()
+	return parentDirs
+}
+
+// themeOverrideDir 返回当前主题的覆盖目录
+func themeOverrideDir() string {
+	return filepath.Join(themeOverridesDirName, currentThemeName())
+}
+
+// themeOverridePath 返回主题内相对路径在覆盖目录中的文件路径，不存在覆盖文件时返回空字符串
+func themeOverridePath(rel string) string {
+	rel = filepath.FromSlash(rel)
+	if !filepath.IsLocal(rel) {
+		return ""
+	}
+	p := filepath.Join(themeOverrideDir(), rel)
+	if info, err := os.Stat(p); err == nil && !info.IsDir() {
+		return p
+	}
+	return ""
+}
+
+// themeFilePath 返回外部主题文件的实际路径：依次查找覆盖目录、static 目录和父主题目录，
+// 都不存在时返回 static 目录中的路径
+func themeFilePath(rel string) string {
+	if p := themeOverridePath(rel); p != "" {
+		debugLog("主题覆盖：使用 %s", p)
+		return p
+	}
+	staticPath := filepath.Join("static", filepath.FromSlash(rel))
+	if fileExists(staticPath) {
+		return staticPath
+	}
+	if filepath.IsLocal(filepath.FromSlash(rel)) {
+		for _, dir := range themeParentDirs() {
+			if p := filepath.Join(dir, filepath.FromSlash(rel)); fileExists(p) {
+				debugLog("主题继承：使用 %s", p)
+				return p
+			}
+		}
+	}
+	return staticPath
+}
+
+func fileExists(p string) bool {
+	info, err := os.Stat(p)
+	return err == nil && !info.IsDir()
+}
+
+// themeOverrideSignature 计算覆盖目录和父主题目录内容的签名，文件增删改后离线资源清单随之重新生成
+func themeOverrideSignature() string {
+	parts := []string{dirSignature(themeOverrideDir())}
+	for _, dir := range themeParentDirs() {
+		parts = append(parts, dir+"="+dirSignature(dir))
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return strings.Join(parts, ";")
+}
+
+func dirSignature(dir string) string {
+	var count int
+	var latest int64
+	var total int64
+	_ = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		if info, err := d.Info(); err == nil {
+			count++
+			total += info.Size()
+			latest = max(latest, info.ModTime().UnixNano())
+		}
+		return nil
+	})
+	if count == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d:%d:%d", count, latest, total)
+}
+
+// overlayFS 以覆盖目录中的文件替代基础文件系统中的同名文件，目录结构以基础文件系统为准；
+// parents 为父主题的文件系统，基础文件系统中缺少的文件和目录项依次从父主题中补充
+type overlayFS struct {
+	base    fs.FS
+	overlay fs.FS
+	parents []fs.FS
+}
+
+func (o overlayFS) Open(name string) (fs.File, error) {
+	if f, err := o.overlay.Open(name); err == nil {
+		if info, err := f.Stat(); err == nil && !info.IsDir() {
+			return f, nil
+		}
+		f.Close()
+	}
+	f, err := o.base.Open(name)
+	if err == nil {
+		return f, nil
+	}
+	for _, parent := range o.parents {
+		if pf, perr := parent.Open(name); perr == nil {
+			return pf, nil
+		}
+	}
+	return nil, err
+}
+
+func (o overlayFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	entries, err := fs.ReadDir(o.base, name)
+	if len(o.parents) == 0 {
+		return entries, err
+	}
+	seen := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		seen[e.Name()] = true
+	}
+	found := err == nil
+	for _, parent := range o.parents {
+		parentEntries, perr := fs.ReadDir(parent, name)
+		if perr != nil {
+			continue
+		}
+		found = true
+		for _, e := range parentEntries {
+			if !seen[e.Name()] {
+				seen[e.Name()] = true
+				entries = append(entries, e)
+			}
+		}
+	}
+	if !found {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
+
+// themeFS 返回叠加了覆盖目录和父主题目录的外部主题文件系统
+func themeFS() fs.FS {
+	var parents []fs.FS
+	for _, dir := range themeParentDirs() {
+		parents = append(parents, os.DirFS(dir))
+	}
+	return overlayFS{base: os.DirFS("static"), overlay: os.DirFS(themeOverrideDir()), parents: parents}
+}
@@ -18,8 +18,8 @@ import (
 
 // ClaimsKey 和 CustomClaims 已移至 types.go
 
-// GenerateToken 生成一个新的 JWT Access Token
-func GenerateToken(userID uint, permissions []byte, userGroupID uint, secretKey []byte) (string, error) {
+// GenerateToken 生成一个新的 JWT Access Token，sessionID 为签发该令牌的登录会话
+func GenerateToken(userID uint, permissions []byte, userGroupID uint, sessionID string, secretKey []byte) (string, error) {
 	if len(secretKey) == 0 {
 		return "", fmt.Errorf("JWT Secret 不能为空")
 	}
@@ -40,6 +40,7 @@ func GenerateToken(userID uint, permissions []byte, userGroupID uint, secretKey
 		UserID:      publicUserID,
 		UserGroupID: publicUserGroupID,
 		Permissions: permissions,
+		SessionID:   sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(accessTokenExpires),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -52,33 +53,6 @@ func GenerateToken(userID uint, permissions []byte, userGroupID uint, secretKey
 	return token.SignedString(secretKey)
 }
 
-// GenerateRefreshToken 生成一个新的 JWT Refresh Token
-func GenerateRefreshToken(userID uint, secretKey []byte) (string, error) {
-	if len(secretKey) == 0 {
-		return "", fmt.Errorf("JWT Secret 不能为空")
-	}
-
-	refreshTokenExpires := time.Now().Add(time.Hour * 24 * 30)
-
-	publicUserID, err := idgen.GeneratePublicID(userID, idgen.EntityTypeUser)
-	if err != nil {
-		return "", fmt.Errorf("生成用户公共ID失败: %w", err)
-	}
-
-	claims := CustomClaims{ // 此处 CustomClaims 来自同包下的 types.go
-		UserID: publicUserID,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(refreshTokenExpires),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-			Issuer:    "anheyu-app",
-		},
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(secretKey)
-}
-
 // ParseToken 解析 JWT Token
 func ParseToken(tokenStr string, secretKey []byte) (*CustomClaims, error) {
 	if len(secretKey) == 0 {
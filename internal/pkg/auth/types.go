@@ -18,5 +18,6 @@ type CustomClaims struct {
 	UserID      string `json:"user_id"`       // 用户公共ID
 	UserGroupID string `json:"user_group_id"` // 用户组公共ID
 	Permissions []byte `json:"permissions"`   // 用户的权限信息
+	SessionID   string `json:"sid,omitempty"` // 签发令牌的登录会话，会话吊销后令牌立即失效
 	jwt.RegisteredClaims
 }
@@ -0,0 +1,254 @@
+/*
+ * @Description: 内置 HTTPS，基于 ACME（Let's Encrypt）自动申请和续期证书
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 支持 HTTP-01（由 HTTP 端口响应验证请求）和 TLS-ALPN-01（由 HTTPS 端口完成验证）两种验证方式，
+ * 证书缓存在本地目录，到期前 30 天自动续期。
+ */
+package autotls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// DefaultCacheDir 证书默认缓存目录
+const DefaultCacheDir = "data/certs"
+
+// Config 内置 HTTPS 配置
+type Config struct {
+	Enable    bool
+	Domains   []string
+	Email     string
+	CacheDir  string
+	HTTPSPort string
+	Redirect  bool // HTTP 端口是否将普通请求重定向到 HTTPS
+}
+
+// CertStatus 单个域名的证书状态
+type CertStatus struct {
+	Domain        string     `json:"domain"`
+	Issued        bool       `json:"issued"`
+	Issuer        string     `json:"issuer,omitempty"`
+	NotBefore     *time.Time `json:"not_before,omitempty"`
+	NotAfter      *time.Time `json:"not_after,omitempty"`
+	DaysRemaining int        `json:"days_remaining"`
+	LastError     string     `json:"last_error,omitempty"`
+	LastErrorAt   *time.Time `json:"last_error_at,omitempty"`
+}
+
+// Status 内置 HTTPS 状态
+type Status struct {
+	Enabled   bool         `json:"enabled"`
+	HTTPSPort string       `json:"https_port,omitempty"`
+	Redirect  bool         `json:"redirect"`
+	Email     string       `json:"email,omitempty"`
+	Certs     []CertStatus `json:"certs"`
+}
+
+type certError struct {
+	err string
+	at  time.Time
+}
+
+// Manager 管理 ACME 证书和 HTTPS 监听
+type Manager struct {
+	cfg     Config
+	cache   autocert.DirCache
+	autocrt *autocert.Manager
+
+	mu     sync.Mutex
+	errors map[string]certError
+}
+
+// NewManager 创建证书管理器，未启用或未配置域名时返回的 Manager 只用于展示状态
+func NewManager(cfg Config) *Manager {
+	if cfg.CacheDir == "" {
+		cfg.CacheDir = DefaultCacheDir
+	}
+	if cfg.HTTPSPort == "" {
+		cfg.HTTPSPort = "443"
+	}
+	for i, domain := range cfg.Domains {
+		cfg.Domains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+
+	m := &Manager{
+		cfg:    cfg,
+		cache:  autocert.DirCache(cfg.CacheDir),
+		errors: make(map[string]certError),
+	}
+	if m.Enabled() {
+		m.autocrt = &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			Cache:      m.cache,
+			HostPolicy: autocert.HostWhitelist(cfg.Domains...),
+			Email:      cfg.Email,
+		}
+	}
+	return m
+}
+
+// ParseDomains 解析逗号或空格分隔的域名列表
+func ParseDomains(raw string) []string {
+	var domains []string
+	for _, domain := range strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	return domains
+}
+
+// Enabled 是否启用了内置 HTTPS
+func (m *Manager) Enabled() bool {
+	return m != nil && m.cfg.Enable && len(m.cfg.Domains) > 0
+}
+
+// HTTPSAddr HTTPS 监听地址
+func (m *Manager) HTTPSAddr() string {
+	return ":" + m.cfg.HTTPSPort
+}
+
+// TLSConfig 返回用于 HTTPS 监听的 TLS 配置，已包含 TLS-ALPN-01 验证所需的协议
+func (m *Manager) TLSConfig() *tls.Config {
+	tlsConfig := m.autocrt.TLSConfig()
+	tlsConfig.MinVersion = tls.VersionTLS12
+	tlsConfig.GetCertificate = func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		cert, err := m.autocrt.GetCertificate(hello)
+		m.recordResult(hello.ServerName, err)
+		return cert, err
+	}
+	return tlsConfig
+}
+
+// HTTPHandler 包装 HTTP 端口的处理器：响应 HTTP-01 验证请求，
+// 开启重定向时其余请求跳转到 HTTPS，否则交给 fallback 正常处理
+func (m *Manager) HTTPHandler(fallback http.Handler) http.Handler {
+	if m.cfg.Redirect {
+		fallback = http.HandlerFunc(m.redirectToHTTPS)
+	}
+	return m.autocrt.HTTPHandler(fallback)
+}
+
+func (m *Manager) redirectToHTTPS(w http.ResponseWriter, r *http.Request) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if m.cfg.HTTPSPort != "443" {
+		host = net.JoinHostPort(host, m.cfg.HTTPSPort)
+	}
+	http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+}
+
+// recordResult 记录证书获取结果，供后台展示最近一次申请失败的原因
+func (m *Manager) recordResult(serverName string, err error) {
+	serverName = strings.ToLower(strings.TrimSuffix(serverName, "."))
+	if serverName == "" {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err == nil {
+		delete(m.errors, serverName)
+		return
+	}
+	if _, known := m.errors[serverName]; !known {
+		log.Printf("[HTTPS] 获取域名 %s 的证书失败: %v", serverName, err)
+	}
+	m.errors[serverName] = certError{err: err.Error(), at: time.Now()}
+}
+
+// Status 返回各域名的证书状态
+func (m *Manager) Status(ctx context.Context) *Status {
+	status := &Status{Certs: []CertStatus{}}
+	if m == nil {
+		return status
+	}
+	status.Enabled = m.Enabled()
+	status.Email = m.cfg.Email
+	if !status.Enabled {
+		return status
+	}
+	status.HTTPSPort = m.cfg.HTTPSPort
+	status.Redirect = m.cfg.Redirect
+
+	domains := append([]string(nil), m.cfg.Domains...)
+	sort.Strings(domains)
+	for _, domain := range domains {
+		certStatus := CertStatus{Domain: domain}
+		if leaf, err := m.loadLeaf(ctx, domain); err == nil {
+			certStatus.Issued = true
+			certStatus.Issuer = leaf.Issuer.CommonName
+			certStatus.NotBefore = &leaf.NotBefore
+			certStatus.NotAfter = &leaf.NotAfter
+			certStatus.DaysRemaining = int(time.Until(leaf.NotAfter).Hours() / 24)
+		}
+
+		m.mu.Lock()
+		if e, ok := m.errors[domain]; ok {
+			at := e.at
+			certStatus.LastError, certStatus.LastErrorAt = e.err, &at
+		}
+		m.mu.Unlock()
+
+		status.Certs = append(status.Certs, certStatus)
+	}
+	return status
+}
+
+// loadLeaf 从缓存目录读取域名证书，autocert 默认签发 ECDSA 证书，兼容 RSA 证书的缓存键
+func (m *Manager) loadLeaf(ctx context.Context, domain string) (*x509.Certificate, error) {
+	for _, name := range []string{domain, domain + "+rsa"} {
+		data, err := m.cache.Get(ctx, name)
+		if err != nil {
+			continue
+		}
+		for len(data) > 0 {
+			var block *pem.Block
+			block, data = pem.Decode(data)
+			if block == nil {
+				break
+			}
+			if block.Type == "CERTIFICATE" {
+				return x509.ParseCertificate(block.Bytes)
+			}
+		}
+	}
+	return nil, errors.New("证书尚未签发")
+}
+
+// Serve 启动 HTTPS 服务，阻塞直到出错；ln 为空时监听 HTTPSPort
+func (m *Manager) Serve(ln net.Listener, handler http.Handler) error {
+	if !m.Enabled() {
+		return fmt.Errorf("内置 HTTPS 未启用")
+	}
+	if ln == nil {
+		var err error
+		if ln, err = net.Listen("tcp", m.HTTPSAddr()); err != nil {
+			return err
+		}
+	}
+	server := &http.Server{
+		Handler:           handler,
+		TLSConfig:         m.TLSConfig(),
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	log.Printf("内置 HTTPS 已启用，正在监听: %s，域名: %s", ln.Addr().String(), strings.Join(m.cfg.Domains, ", "))
+	return server.ServeTLS(ln, "", "")
+}
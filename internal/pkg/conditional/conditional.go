@@ -0,0 +1,233 @@
+/*
+ * @Description: 公开 JSON 接口的条件请求（ETag / Last-Modified / 304）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * ETag 由实体的 ID、更新时间等关键字段和列表版本号计算，Last-Modified 取实体更新时间与版本号变化时间中较新的一个。
+ * 列表版本号用于覆盖分类改名这类不会修改文章更新时间的变化，由 SetSource 注册的函数从数据库状态计算，
+ * 多实例部署时各实例得到相同的版本和 ETag。版本在内存中缓存 versionTTL，本实例的相关事件发布时立即失效，
+ * 其他实例的修改最多延迟 versionTTL 生效。
+ * 响应仍按正常流程生成后再比较，浏览量等频繁变化的字段也计入 ETag，按 If-None-Match 验证时不会返回过期数据，
+ * 节省的是传输量，SSR 主题和 CDN 可以放心缓存并重新验证；Last-Modified 只反映内容的更新时间，精度为秒。
+ */
+package conditional
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// Scope 列表版本号的范围
+type Scope string
+
+// ScopeArticles 文章列表、文章详情的上一篇/下一篇/相关文章
+const ScopeArticles Scope = "articles"
+
+// versionTTL 列表版本的缓存时间，也是其他实例的修改在本实例生效的最长延迟
+const versionTTL = time.Second
+
+// Source 计算列表版本，返回版本标识和数据最近一次变化的时间
+type Source func(ctx context.Context) (string, time.Time, error)
+
+type version struct {
+	source    Source
+	token     string
+	at        time.Time
+	fetchedAt time.Time
+	gen       uint64 // 每次失效时递增，读取期间发生失效时丢弃读到的结果
+}
+
+var (
+	versionsMu sync.Mutex
+	versions   = make(map[Scope]*version)
+)
+
+// SetSource 注册列表版本的计算函数
+func SetSource(scope Scope, source Source) {
+	versionsMu.Lock()
+	defer versionsMu.Unlock()
+	versions[scope] = &version{source: source}
+}
+
+// Invalidate 丢弃缓存的版本，下次计算 ETag 时重新读取
+func Invalidate(scope Scope) {
+	versionsMu.Lock()
+	defer versionsMu.Unlock()
+	if v, ok := versions[scope]; ok {
+		v.fetchedAt = time.Time{}
+		v.gen++
+	}
+}
+
+// Version 返回版本标识及数据最近一次变化的时间。
+// 未注册计算函数时返回空版本；计算失败时返回当前时间，等同于不支持条件请求
+func Version(ctx context.Context, scope Scope) (string, time.Time) {
+	versionsMu.Lock()
+	v, ok := versions[scope]
+	if !ok {
+		versionsMu.Unlock()
+		return "", time.Time{}
+	}
+	if !v.fetchedAt.IsZero() && time.Since(v.fetchedAt) < versionTTL {
+		token, at := v.token, v.at
+		versionsMu.Unlock()
+		return token, at
+	}
+	source, gen := v.source, v.gen
+	versionsMu.Unlock()
+
+	fetchedAt := time.Now()
+	token, at, err := source(ctx)
+	if err != nil {
+		log.Printf("[条件请求] 计算 %s 的版本失败: %v", scope, err)
+		return fetchedAt.String(), fetchedAt
+	}
+	versionsMu.Lock()
+	// 读取期间版本被失效或已有更新的结果时不覆盖
+	if v.gen == gen && v.fetchedAt.Before(fetchedAt) {
+		v.token, v.at, v.fetchedAt = token, at, fetchedAt
+	}
+	versionsMu.Unlock()
+	return token, at
+}
+
+// Watch 在事件总线上的任一主题发布时丢弃缓存的版本
+func Watch(bus *event.EventBus, scope Scope, topics ...event.Topic) {
+	for _, topic := range topics {
+		bus.Subscribe(topic, func(interface{}) { Invalidate(scope) })
+	}
+}
+
+// Tag 逐步累加参与计算的字段，最后与请求头比较
+type Tag struct {
+	h            hash.Hash
+	lastModified time.Time
+}
+
+// New 创建 Tag，parts 参与 ETag 计算
+func New(parts ...interface{}) *Tag {
+	t := &Tag{h: sha1.New()}
+	return t.Add(parts...)
+}
+
+// Add 累加字段，时间按纳秒计入
+func (t *Tag) Add(parts ...interface{}) *Tag {
+	for _, p := range parts {
+		switch v := p.(type) {
+		case time.Time:
+			fmt.Fprint(t.h, v.UnixNano())
+		case *time.Time:
+			if v != nil {
+				fmt.Fprint(t.h, v.UnixNano())
+			}
+		default:
+			fmt.Fprint(t.h, v)
+		}
+		t.h.Write([]byte{0})
+	}
+	return t
+}
+
+// AddJSON 按 JSON 序列化结果累加，用于没有更新时间的配置类数据
+func (t *Tag) AddJSON(v interface{}) *Tag {
+	data, err := json.Marshal(v)
+	if err != nil {
+		// 无法序列化时使用当前时间，等同于不支持条件请求
+		return t.Add(time.Now())
+	}
+	t.h.Write(data)
+	t.h.Write([]byte{0})
+	return t
+}
+
+// Scope 计入列表版本，并用版本变化时间更新 Last-Modified
+func (t *Tag) Scope(ctx context.Context, scope Scope) *Tag {
+	token, at := Version(ctx, scope)
+	t.Add(string(scope), token)
+	return t.Touch(at)
+}
+
+// Touch 用实体的更新时间更新 Last-Modified，取较新的时间
+func (t *Tag) Touch(ts time.Time) *Tag {
+	if ts.After(t.lastModified) {
+		t.lastModified = ts
+	}
+	return t
+}
+
+// ETag 返回弱校验的 ETag，JSON 响应不保证逐字节一致
+func (t *Tag) ETag() string {
+	return `W/"` + hex.EncodeToString(t.h.Sum(nil)[:12]) + `"`
+}
+
+// NotModified 设置 ETag、Last-Modified 响应头，请求的 If-None-Match 或 If-Modified-Since 匹配时
+// 返回 304 并返回 true，调用方直接返回即可；非 GET/HEAD 请求不做处理
+func (t *Tag) NotModified(c *gin.Context) bool {
+	if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+		return false
+	}
+	etag := t.ETag()
+	header := c.Writer.Header()
+	header.Set("ETag", etag)
+	if !t.lastModified.IsZero() {
+		header.Set("Last-Modified", t.lastModified.UTC().Format(http.TimeFormat))
+	}
+	if header.Get("Cache-Control") == "" {
+		// 允许缓存，但每次使用前都需要重新验证
+		header.Set("Cache-Control", "no-cache")
+	}
+
+	// 同时提供两者时以 If-None-Match 为准
+	if inm := c.GetHeader("If-None-Match"); inm != "" {
+		if !etagMatch(inm, etag) {
+			return false
+		}
+	} else if ims := c.GetHeader("If-Modified-Since"); ims != "" && !t.lastModified.IsZero() {
+		since, err := http.ParseTime(ims)
+		if err != nil || t.lastModified.Truncate(time.Second).After(since) {
+			return false
+		}
+	} else {
+		return false
+	}
+
+	c.AbortWithStatus(http.StatusNotModified)
+	return true
+}
+
+// ArticleList 计算文章列表的校验信息：请求路径和参数、列表版本号以及每篇文章的关键字段，
+// 置顶、浏览量、评论数的变化不会修改文章更新时间，需要单独计入
+func ArticleList(c *gin.Context, list []model.ArticleResponse, total int64) *Tag {
+	t := New(c.Request.URL.Path, c.Request.URL.RawQuery, total).Scope(c.Request.Context(), ScopeArticles)
+	for i := range list {
+		a := &list[i]
+		t.Add(a.ID, a.UpdatedAt, a.ViewCount, a.CommentCount, a.PinSort, a.HomeSort).Touch(a.UpdatedAt)
+	}
+	return t
+}
+
+// etagMatch 按弱比较规则判断 If-None-Match 是否包含 etag
+func etagMatch(header, etag string) bool {
+	want := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == want {
+			return true
+		}
+	}
+	return false
+}
@@ -35,8 +35,28 @@ const (
 	// 分类/标签事件
 	CategoryUpdated Topic = "category:updated"
 	TagUpdated      Topic = "tag:updated"
+
+	// 主题事件，载荷为切换后的主题名称
+	ThemeSwitched Topic = "theme:switched"
+
+	// 评论事件，载荷为 *CommentPayload。创建时只在评论直接发布时触发，
+	// 更新包括审核状态、内容和置顶的变化
+	CommentCreated Topic = "comment:created"
+	CommentUpdated Topic = "comment:updated"
+	CommentDeleted Topic = "comment:deleted"
 )
 
+// ArticlePayload 文章事件载荷
+type ArticlePayload struct {
+	ID   string // 文章公共 ID
+	Slug string // 详情页使用的 abbrlink，未设置时为 ID
+}
+
+// CommentPayload 评论事件载荷
+type CommentPayload struct {
+	TargetPath string // 评论所在页面的路径
+}
+
 // 事件处理器函数类型
 type Handler func(payload interface{})
 
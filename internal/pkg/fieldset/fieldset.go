@@ -0,0 +1,256 @@
+/*
+ * @Description: 按 ?fields= 和 ?exclude= 裁剪接口返回的字段（稀疏字段集）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 只裁剪顶层字段，可选字段为结构体的 JSON 字段名（白名单），请求了不存在的字段时返回错误，
+ * 便于主题开发时发现拼写错误。同时指定 fields 和 exclude 时先取 fields 再排除 exclude，
+ * 声明为必需的字段（如 id）总是返回。
+ */
+package fieldset
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxFields 单个参数中最多的字段数量
+const maxFields = 64
+
+// Projector 字段裁剪器，为 nil 时不裁剪
+type Projector struct {
+	include map[string]bool // 为 nil 表示返回全部可选字段
+	exclude map[string]bool
+}
+
+// Parse 解析逗号分隔的 fields 和 exclude 参数，两者都为空时返回 nil。
+// allowed 为可选字段，required 为总是返回的字段
+func Parse(fields, exclude string, allowed, required []string) (*Projector, error) {
+	include, err := splitFields(fields, allowed)
+	if err != nil {
+		return nil, err
+	}
+	excluded, err := splitFields(exclude, allowed)
+	if err != nil {
+		return nil, err
+	}
+	if include == nil && excluded == nil {
+		return nil, nil
+	}
+	for _, name := range required {
+		if include != nil {
+			include[name] = true
+		}
+		delete(excluded, name)
+	}
+	return &Projector{include: include, exclude: excluded}, nil
+}
+
+// FromQuery 按结构体 v 的 JSON 字段名作为白名单，解析请求中的 fields 和 exclude 参数
+func FromQuery(c *gin.Context, v interface{}, required ...string) (*Projector, error) {
+	return Parse(c.Query("fields"), c.Query("exclude"), JSONFields(v), required)
+}
+
+func splitFields(raw string, allowed []string) (map[string]bool, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	parts := strings.Split(raw, ",")
+	if len(parts) > maxFields {
+		return nil, fmt.Errorf("最多指定 %d 个字段", maxFields)
+	}
+	result := make(map[string]bool, len(parts))
+	var unknown []string
+	for _, name := range parts {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !contains(allowed, name) {
+			unknown = append(unknown, name)
+			continue
+		}
+		result[name] = true
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("不支持的字段: %s，可选字段: %s", strings.Join(unknown, ", "), strings.Join(allowed, ", "))
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// Keep 字段是否返回
+func (p *Projector) Keep(name string) bool {
+	if p == nil {
+		return true
+	}
+	if p.exclude[name] {
+		return false
+	}
+	return p.include == nil || p.include[name]
+}
+
+// Project 将结构体（或结构体指针）转换为只包含保留字段的 map，未设置裁剪时原样返回。
+// 遵循 omitempty，被省略的空值字段不会出现在结果中
+func (p *Projector) Project(v interface{}) interface{} {
+	if p == nil {
+		return v
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return v
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return v
+	}
+	result := make(map[string]interface{})
+	for _, f := range structFields(rv.Type()) {
+		if !p.Keep(f.name) {
+			continue
+		}
+		fv := rv.FieldByIndex(f.index)
+		if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		result[f.name] = fv.Interface()
+	}
+	return result
+}
+
+// ProjectSlice 裁剪切片中的每个元素，未设置裁剪时原样返回
+func (p *Projector) ProjectSlice(v interface{}) interface{} {
+	if p == nil {
+		return v
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return p.Project(v)
+	}
+	result := make([]interface{}, rv.Len())
+	for i := range result {
+		result[i] = p.Project(rv.Index(i).Interface())
+	}
+	return result
+}
+
+// ProjectList 裁剪分页结果（结构体或结构体指针）中 list 字段的每个元素，total、page 等分页字段原样保留，
+// 未设置裁剪时原样返回
+func (p *Projector) ProjectList(v interface{}) interface{} {
+	if p == nil {
+		return v
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return v
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return v
+	}
+	result := make(map[string]interface{})
+	for _, f := range structFields(rv.Type()) {
+		fv := rv.FieldByIndex(f.index)
+		if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		if f.name == "list" {
+			result[f.name] = p.ProjectSlice(fv.Interface())
+			continue
+		}
+		result[f.name] = fv.Interface()
+	}
+	return result
+}
+
+// JSONFields 返回结构体的顶层 JSON 字段名，用作可选字段白名单
+func JSONFields(v interface{}) []string {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	fields := structFields(t)
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.name
+	}
+	sort.Strings(names)
+	return names
+}
+
+type jsonField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+var fieldCache sync.Map // reflect.Type -> []jsonField
+
+// structFields 解析结构体的 JSON 字段，不展开匿名嵌入的结构体
+func structFields(t reflect.Type) []jsonField {
+	if cached, ok := fieldCache.Load(t); ok {
+		return cached.([]jsonField)
+	}
+	fields := make([]jsonField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, jsonField{
+			name:      name,
+			index:     sf.Index,
+			omitEmpty: strings.Contains(","+opts+",", ",omitempty,"),
+		})
+	}
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+// isEmptyValue 与 encoding/json 判断 omitempty 的规则一致
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+/*
+ * @Description: Markdown YAML frontmatter 的解析与生成
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package frontmatter
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Meta frontmatter 中的键值
+type Meta map[string]any
+
+// Field 生成 frontmatter 时的一个字段，按顺序输出
+type Field struct {
+	Key   string
+	Value any
+}
+
+// timeLayouts 支持的时间格式
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// Split 拆分 YAML frontmatter 和正文，没有 frontmatter 时元数据为空
+func Split(data []byte) (Meta, string, error) {
+	text := strings.ReplaceAll(string(bytes.TrimPrefix(data, []byte("\ufeff"))), "\r\n", "\n")
+	meta := Meta{}
+	if !strings.HasPrefix(text, "---\n") {
+		return meta, text, nil
+	}
+	rest := text[len("---\n"):]
+	end := strings.Index(rest, "\n---")
+	if end < 0 {
+		return nil, "", fmt.Errorf("frontmatter 缺少结束标记 ---")
+	}
+	if err := yaml.Unmarshal([]byte(rest[:end]), &meta); err != nil {
+		return nil, "", fmt.Errorf("解析 frontmatter 失败: %w", err)
+	}
+	body := rest[end+len("\n---"):]
+	if i := strings.IndexByte(body, '\n'); i >= 0 {
+		body = body[i+1:]
+	} else {
+		body = ""
+	}
+	return meta, strings.TrimLeft(body, "\n"), nil
+}
+
+// Join 按字段顺序生成 frontmatter 并拼接正文，值为空的字段不输出
+func Join(fields []Field, body string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	for _, f := range fields {
+		if isEmpty(f.Value) {
+			continue
+		}
+		out, err := yaml.Marshal(map[string]any{f.Key: f.Value})
+		if err != nil {
+			return nil, fmt.Errorf("生成 frontmatter 字段 %s 失败: %w", f.Key, err)
+		}
+		buf.Write(out)
+	}
+	buf.WriteString("---\n\n")
+	buf.WriteString(strings.TrimLeft(body, "\n"))
+	if body != "" && !strings.HasSuffix(body, "\n") {
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+func isEmpty(v any) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case string:
+		return val == ""
+	case []string:
+		return len(val) == 0
+	default:
+		return false
+	}
+}
+
+// String 读取字符串字段，数字和时间转为字符串
+func (m Meta) String(key string) string {
+	switch v := m[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// List 读取列表字段，支持数组或逗号分隔的字符串；字段不存在时返回 nil
+func (m Meta) List(key string) []string {
+	raw, ok := m[key]
+	if !ok || raw == nil {
+		return nil
+	}
+	var values []string
+	switch v := raw.(type) {
+	case []any:
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+	case string:
+		values = strings.Split(v, ",")
+	default:
+		values = []string{fmt.Sprint(v)}
+	}
+	result := []string{}
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// Time 读取时间字段，无法解析时返回 nil
+func (m Meta) Time(key string) *time.Time {
+	switch v := m[key].(type) {
+	case time.Time:
+		// YAML 把不带时区的时间解析为 UTC，按站点本地时间处理
+		if v.Location() == time.UTC {
+			v = time.Date(v.Year(), v.Month(), v.Day(), v.Hour(), v.Minute(), v.Second(), v.Nanosecond(), time.Local)
+		}
+		return &v
+	case string:
+		for _, layout := range timeLayouts {
+			if t, err := time.ParseInLocation(layout, strings.TrimSpace(v), time.Local); err == nil {
+				return &t
+			}
+		}
+	}
+	return nil
+}
+
+// Bool 读取布尔字段，字段不存在或无法识别时返回 nil
+func (m Meta) Bool(key string) *bool {
+	var b bool
+	switch v := m[key].(type) {
+	case bool:
+		b = v
+	case string:
+		parsed, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return nil
+		}
+		b = parsed
+	default:
+		return nil
+	}
+	return &b
+}
+
+// Int 读取整数字段，字段不存在或无法识别时返回 nil
+func (m Meta) Int(key string) *int {
+	var n int
+	switch v := m[key].(type) {
+	case int:
+		n = v
+	case float64:
+		n = int(v)
+	case string:
+		parsed, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil
+		}
+		n = parsed
+	default:
+		return nil
+	}
+	return &n
+}
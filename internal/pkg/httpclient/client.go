@@ -0,0 +1,295 @@
+/*
+ * @Description: 带熔断、重试和按主机统计的共享 HTTP 客户端
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 主题商城、IP 属地、微信等外部接口共用此客户端：
+ *   - 每个请求都有超时；
+ *   - 幂等请求（GET/HEAD）在网络错误或 5xx/429 时按指数退避 + 随机抖动重试；
+ *   - 同一主机连续失败达到阈值后熔断（open），冷却期内直接失败，冷却结束后放行一个探测请求（half-open），
+ *     探测成功则恢复（closed），失败则重新熔断；
+ *   - 按主机记录请求数、失败数、重试数、拒绝数和平均耗时，供后台查看；
+ *   - 代理和自定义 CA 按服务名从出站配置读取，见 outbound.go；
+ *   - 调用自有或合作方接口的客户端可携带统一的 User-Agent 和匿名实例 ID，见 identity.go。
+ */
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/accesslog"
+)
+
+// 熔断器状态
+const (
+	StateClosed   = "closed"
+	StateOpen     = "open"
+	StateHalfOpen = "half_open"
+)
+
+// ErrCircuitOpen 熔断期间请求被直接拒绝
+var ErrCircuitOpen = errors.New("上游服务暂时不可用（已熔断）")
+
+// Options 客户端配置
+type Options struct {
+	Name             string        // 调用方名称，用于日志与统计
+	Service          string        // 出站配置（代理、CA）使用的服务名，默认与 Name 相同
+	Timeout          time.Duration // 单次请求（含重试）的总超时
+	MaxRetries       int           // 最大重试次数，0 表示不重试
+	RetryBaseDelay   time.Duration // 重试基础间隔，实际间隔为 base*2^n 加随机抖动
+	FailureThreshold int           // 连续失败多少次后熔断
+	OpenTimeout      time.Duration // 熔断后的冷却时间
+	Identify         bool          // 是否携带出站身份（统一的 User-Agent 和匿名实例 ID），见 identity.go
+}
+
+func (o *Options) applyDefaults() {
+	if o.Service == "" {
+		o.Service = o.Name
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = 10 * time.Second
+	}
+	if o.MaxRetries < 0 {
+		o.MaxRetries = 0
+	}
+	if o.RetryBaseDelay <= 0 {
+		o.RetryBaseDelay = 200 * time.Millisecond
+	}
+	if o.FailureThreshold <= 0 {
+		o.FailureThreshold = 5
+	}
+	if o.OpenTimeout <= 0 {
+		o.OpenTimeout = 30 * time.Second
+	}
+}
+
+// HostMetrics 单个上游主机的统计信息
+type HostMetrics struct {
+	Host                string    `json:"host"`
+	Client              string    `json:"client"`
+	State               string    `json:"state"`
+	Requests            int64     `json:"requests"`
+	Failures            int64     `json:"failures"`
+	Retries             int64     `json:"retries"`
+	Rejected            int64     `json:"rejected"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	AvgLatencyMs        int64     `json:"avg_latency_ms"`
+	LastError           string    `json:"last_error,omitempty"`
+	LastFailureAt       time.Time `json:"last_failure_at,omitempty"`
+	OpenedAt            time.Time `json:"opened_at,omitempty"`
+}
+
+// hostBreaker 单个主机的熔断器
+type hostBreaker struct {
+	mu            sync.Mutex
+	metrics       HostMetrics
+	totalLatency  time.Duration
+	probeInFlight bool
+}
+
+var (
+	breakersMu sync.Mutex
+	breakers   = make(map[string]*hostBreaker)
+)
+
+func breakerFor(client, host string) *hostBreaker {
+	key := client + "|" + host
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	b, ok := breakers[key]
+	if !ok {
+		b = &hostBreaker{metrics: HostMetrics{Host: host, Client: client, State: StateClosed}}
+		breakers[key] = b
+	}
+	return b
+}
+
+// allow 判断是否放行请求，半开状态只放行一个探测请求
+func (b *hostBreaker) allow(opts *Options) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.metrics.State {
+	case StateOpen:
+		if time.Since(b.metrics.OpenedAt) < opts.OpenTimeout {
+			b.metrics.Rejected++
+			return false
+		}
+		b.metrics.State = StateHalfOpen
+		b.probeInFlight = true
+		return true
+	case StateHalfOpen:
+		if b.probeInFlight {
+			b.metrics.Rejected++
+			return false
+		}
+		b.probeInFlight = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (b *hostBreaker) record(opts *Options, latency time.Duration, retries int, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.metrics.Requests++
+	b.metrics.Retries += int64(retries)
+	b.totalLatency += latency
+	b.metrics.AvgLatencyMs = (b.totalLatency / time.Duration(b.metrics.Requests)).Milliseconds()
+	b.probeInFlight = false
+
+	if err == nil {
+		b.metrics.ConsecutiveFailures = 0
+		b.metrics.State = StateClosed
+		return
+	}
+
+	b.metrics.Failures++
+	b.metrics.ConsecutiveFailures++
+	b.metrics.LastError = err.Error()
+	b.metrics.LastFailureAt = time.Now()
+	if b.metrics.State == StateHalfOpen || b.metrics.ConsecutiveFailures >= opts.FailureThreshold {
+		b.metrics.State = StateOpen
+		b.metrics.OpenedAt = time.Now()
+	}
+}
+
+// Metrics 返回所有上游主机的统计信息
+func Metrics() []HostMetrics {
+	breakersMu.Lock()
+	list := make([]*hostBreaker, 0, len(breakers))
+	for _, b := range breakers {
+		list = append(list, b)
+	}
+	breakersMu.Unlock()
+
+	result := make([]HostMetrics, 0, len(list))
+	for _, b := range list {
+		b.mu.Lock()
+		result = append(result, b.metrics)
+		b.mu.Unlock()
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Client != result[j].Client {
+			return result[i].Client < result[j].Client
+		}
+		return result[i].Host < result[j].Host
+	})
+	return result
+}
+
+// New 创建带熔断与重试的 HTTP 客户端
+func New(opts Options) *http.Client {
+	opts.applyDefaults()
+	var base http.RoundTripper = &outboundTransport{service: opts.Service}
+	if opts.Identify {
+		base = &identityTransport{base: base}
+	}
+	return &http.Client{
+		Timeout: opts.Timeout,
+		Transport: &breakerTransport{
+			opts: opts,
+			base: accesslog.NewTracingTransport(base),
+		},
+	}
+}
+
+// breakerTransport 实现熔断与重试的 RoundTripper
+type breakerTransport struct {
+	opts Options
+	base http.RoundTripper
+}
+
+func (t *breakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	breaker := breakerFor(t.opts.Name, req.URL.Host)
+	if !breaker.allow(&t.opts) {
+		return nil, fmt.Errorf("%s %s: %w", t.opts.Name, req.URL.Host, ErrCircuitOpen)
+	}
+
+	start := time.Now()
+	retries := 0
+	attemptReq := req
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		resp, err = t.base.RoundTrip(attemptReq)
+		if attempt >= t.opts.MaxRetries || !shouldRetry(req, resp, err) {
+			break
+		}
+
+		// 丢弃本次响应，准备重试
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+			resp = nil
+		}
+		retries++
+
+		delay := t.opts.RetryBaseDelay << attempt
+		delay += time.Duration(rand.Int63n(int64(delay)/2 + 1))
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			err = req.Context().Err()
+		case <-timer.C:
+			attemptReq, err = cloneForRetry(req)
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	breaker.record(&t.opts, time.Since(start), retries, upstreamError(resp, err))
+	return resp, err
+}
+
+// shouldRetry 仅对幂等请求的网络错误、5xx 和 429 重试
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+}
+
+// upstreamError 将网络错误和 5xx 响应视为上游失败，4xx 属于调用方问题，不计入熔断
+func upstreamError(resp *http.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 500 {
+		return fmt.Errorf("上游返回状态码 %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// cloneForRetry 复制请求用于重试，请求体通过 GetBody 重新获取
+func cloneForRetry(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
@@ -0,0 +1,103 @@
+package httpclient
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// 出站身份相关的请求头和默认值
+const (
+	// InstanceHeader 携带匿名实例 ID 的请求头，上游可据此区分同一出口 IP 下的不同站点
+	InstanceHeader = "X-Anheyu-Instance"
+	// defaultProduct User-Agent 中的产品名
+	defaultProduct = "Anheyu-App"
+)
+
+// Identity 出站请求携带的身份信息
+type Identity struct {
+	Product    string `json:"product"`
+	Version    string `json:"version"`
+	InstanceID string `json:"instance_id,omitempty"` // 匿名实例 ID，为空时不发送
+	ContactURL string `json:"contact_url,omitempty"` // 站点联系地址，上游遇到异常流量时可据此联系站长
+	UserAgent  string `json:"user_agent"`
+}
+
+// IdentityProvider 返回当前的出站身份
+type IdentityProvider func() Identity
+
+var (
+	identityMu       sync.RWMutex
+	identityProvider IdentityProvider
+)
+
+// SetIdentityProvider 设置出站身份来源，未设置时只带产品名和版本号
+func SetIdentityProvider(provider IdentityProvider) {
+	identityMu.Lock()
+	identityProvider = provider
+	identityMu.Unlock()
+}
+
+// NewSettingIdentityProvider 基于后台配置 outbound.instance_id / outbound.contact_url 的出站身份来源
+func NewSettingIdentityProvider(settings settingGetter) IdentityProvider {
+	return func() Identity {
+		return newIdentity(
+			strings.TrimSpace(settings.Get(constant.KeyOutboundInstanceID.String())),
+			strings.TrimSpace(settings.Get(constant.KeyOutboundContactURL.String())),
+		)
+	}
+}
+
+// CurrentIdentity 返回当前的出站身份，供诊断信息展示
+func CurrentIdentity() Identity {
+	identityMu.RLock()
+	provider := identityProvider
+	identityMu.RUnlock()
+	if provider == nil {
+		return newIdentity("", "")
+	}
+	return provider()
+}
+
+func newIdentity(instanceID, contactURL string) Identity {
+	id := Identity{
+		Product:    defaultProduct,
+		Version:    version.GetVersion(),
+		InstanceID: instanceID,
+		ContactURL: contactURL,
+	}
+	// 形如 Anheyu-App/1.2.3 (+https://blog.example.com/about; instance 3f2a...)
+	var comments []string
+	if contactURL != "" {
+		comments = append(comments, "+"+contactURL)
+	}
+	if instanceID != "" {
+		comments = append(comments, "instance "+instanceID)
+	}
+	id.UserAgent = fmt.Sprintf("%s/%s", id.Product, strings.TrimPrefix(id.Version, "v"))
+	if len(comments) > 0 {
+		id.UserAgent += " (" + strings.Join(comments, "; ") + ")"
+	}
+	return id
+}
+
+// identityTransport 为请求加上出站身份：调用方没有指定 User-Agent 时使用统一的 User-Agent，并附带实例 ID
+type identityTransport struct {
+	base http.RoundTripper
+}
+
+func (t *identityTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	id := CurrentIdentity()
+	req = req.Clone(req.Context())
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", id.UserAgent)
+	}
+	if id.InstanceID != "" {
+		req.Header.Set(InstanceHeader, id.InstanceID)
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,206 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// ErrChecksumMismatch 下载内容的校验和与期望值不一致
+var ErrChecksumMismatch = errors.New("下载内容校验失败")
+
+// MirrorRule 下载镜像改写规则，URL 以 Prefix 开头时替换为 Mirror
+// 如 {"prefix": "https://github.com/", "mirror": "https://ghproxy.com/https://github.com/"}
+type MirrorRule struct {
+	Prefix string `json:"prefix"`
+	Mirror string `json:"mirror"`
+}
+
+// MirrorConfig 下载镜像配置
+type MirrorConfig struct {
+	Rules []MirrorRule
+	// RequireChecksum 为 true 时，未提供校验和的下载不使用镜像，避免信任无法验证的第三方内容
+	RequireChecksum bool
+}
+
+// MirrorProvider 返回当前的下载镜像配置
+type MirrorProvider func() MirrorConfig
+
+var (
+	mirrorProviderMu sync.RWMutex
+	mirrorProvider   MirrorProvider
+)
+
+// SetMirrorProvider 设置下载镜像配置来源，未设置时直接从原地址下载
+func SetMirrorProvider(provider MirrorProvider) {
+	mirrorProviderMu.Lock()
+	mirrorProvider = provider
+	mirrorProviderMu.Unlock()
+}
+
+// NewSettingMirrorProvider 基于后台配置的下载镜像来源
+// download.mirrors 为 JSON 数组，download.mirror_require_checksum 控制无校验和时是否跳过镜像
+func NewSettingMirrorProvider(settings settingGetter) MirrorProvider {
+	return func() MirrorConfig {
+		cfg := MirrorConfig{
+			RequireChecksum: settings.Get(constant.KeyDownloadMirrorRequireChecksum.String()) != "false",
+		}
+		raw := strings.TrimSpace(settings.Get(constant.KeyDownloadMirrors.String()))
+		if raw == "" {
+			return cfg
+		}
+		if err := json.Unmarshal([]byte(raw), &cfg.Rules); err != nil {
+			log.Printf("[下载镜像] 解析镜像规则失败，使用原地址下载: %v", err)
+			cfg.Rules = nil
+		}
+		return cfg
+	}
+}
+
+// DownloadCandidates 返回按优先级排列的下载地址：匹配的镜像地址在前，原地址作为最后的回退
+func DownloadCandidates(rawURL string, hasChecksum bool) []string {
+	mirrorProviderMu.RLock()
+	provider := mirrorProvider
+	mirrorProviderMu.RUnlock()
+	if provider == nil {
+		return []string{rawURL}
+	}
+
+	cfg := provider()
+	if cfg.RequireChecksum && !hasChecksum {
+		return []string{rawURL}
+	}
+
+	candidates := make([]string, 0, len(cfg.Rules)+1)
+	for _, rule := range cfg.Rules {
+		if rule.Prefix == "" || rule.Mirror == "" || !strings.HasPrefix(rawURL, rule.Prefix) {
+			continue
+		}
+		candidates = append(candidates, rule.Mirror+strings.TrimPrefix(rawURL, rule.Prefix))
+	}
+	return append(candidates, rawURL)
+}
+
+// DownloadToFile 依次尝试镜像地址和原地址，将内容写入 file
+// checksum 为期望的 SHA-256（十六进制，可带 "sha256:" 前缀），不为空时校验下载内容，
+// 某个地址下载失败或校验不通过时继续尝试下一个地址
+func DownloadToFile(ctx context.Context, client *http.Client, rawURL, checksum string, file *os.File) error {
+	return DownloadToFileWithSizeCheck(ctx, client, rawURL, checksum, file, nil)
+}
+
+// sizeCheckError 下载前的大小检查未通过，不再尝试其它地址
+type sizeCheckError struct{ err error }
+
+func (e *sizeCheckError) Error() string { return e.err.Error() }
+func (e *sizeCheckError) Unwrap() error { return e.err }
+
+// DownloadToFileWithSizeCheck 与 DownloadToFile 相同，响应提供 Content-Length 时先调用 checkSize，
+// 返回错误时放弃下载（例如磁盘空间不足），也不再尝试其它地址
+func DownloadToFileWithSizeCheck(ctx context.Context, client *http.Client, rawURL, checksum string, file *os.File, checkSize func(contentLength int64) error) error {
+	return DownloadToFileWithProgress(ctx, client, rawURL, checksum, file, checkSize, nil)
+}
+
+// DownloadToFileWithProgress 与 DownloadToFileWithSizeCheck 相同，下载过程中以已写入字节数和总大小调用 onProgress，
+// 服务器未返回 Content-Length 时 total 为 -1；换用其它地址重新下载时从 0 开始计数
+func DownloadToFileWithProgress(ctx context.Context, client *http.Client, rawURL, checksum string, file *os.File, checkSize func(contentLength int64) error, onProgress func(written, total int64)) error {
+	checksum = normalizeChecksum(checksum)
+	var lastErr error
+	for _, candidate := range DownloadCandidates(rawURL, checksum != "") {
+		if err := downloadOnce(ctx, client, candidate, checksum, file, checkSize, onProgress); err != nil {
+			var sizeErr *sizeCheckError
+			if errors.As(err, &sizeErr) {
+				return sizeErr.err
+			}
+			if ctx.Err() != nil {
+				// 下载被取消，不再尝试其它地址
+				return err
+			}
+			log.Printf("[下载] 从 %s 下载失败: %v", candidate, err)
+			lastErr = err
+			continue
+		}
+		if candidate != rawURL {
+			log.Printf("[下载] 已通过镜像 %s 下载并校验", candidate)
+		}
+		return nil
+	}
+	return lastErr
+}
+
+func downloadOnce(ctx context.Context, client *http.Client, url, checksum string, file *os.File, checkSize func(int64) error, onProgress func(int64, int64)) error {
+	if err := file.Truncate(0); err != nil {
+		return fmt.Errorf("重置临时文件失败: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("重置临时文件失败: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("创建请求失败: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("状态码: %d", resp.StatusCode)
+	}
+	if checkSize != nil && resp.ContentLength > 0 {
+		if err := checkSize(resp.ContentLength); err != nil {
+			return &sizeCheckError{err: err}
+		}
+	}
+
+	var body io.Reader = resp.Body
+	if onProgress != nil {
+		body = &progressReader{r: resp.Body, total: resp.ContentLength, onProgress: onProgress}
+	}
+	hasher := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(file, hasher), body); err != nil {
+		return fmt.Errorf("保存下载文件失败: %w", err)
+	}
+	if checksum != "" {
+		if actual := hex.EncodeToString(hasher.Sum(nil)); actual != checksum {
+			return fmt.Errorf("%w: 期望 %s，实际 %s", ErrChecksumMismatch, checksum, actual)
+		}
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("重置临时文件失败: %w", err)
+	}
+	return nil
+}
+
+// progressReader 读取时累计字节数并回调下载进度
+type progressReader struct {
+	r          io.Reader
+	written    int64
+	total      int64
+	onProgress func(written, total int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.written += int64(n)
+		p.onProgress(p.written, p.total)
+	}
+	return n, err
+}
+
+func normalizeChecksum(checksum string) string {
+	checksum = strings.ToLower(strings.TrimSpace(checksum))
+	return strings.TrimPrefix(checksum, "sha256:")
+}
@@ -0,0 +1,213 @@
+package httpclient
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// ProxyDirect 代理配置为该值时不使用任何代理（包括环境变量中的代理）
+const ProxyDirect = "direct"
+
+// OutboundConfig 出站 HTTP 配置
+type OutboundConfig struct {
+	Proxy    string   `json:"proxy"`     // 代理地址，如 http://127.0.0.1:7890；留空使用 HTTP(S)_PROXY 环境变量；direct 表示直连
+	NoProxy  []string `json:"no_proxy"`  // 不走代理的主机（支持 .example.com 后缀匹配）
+	CABundle string   `json:"ca_bundle"` // 额外信任的 CA 证书，PEM 内容或证书文件路径
+}
+
+// ConfigProvider 根据服务名返回出站配置
+type ConfigProvider func(service string) OutboundConfig
+
+var (
+	providerMu     sync.RWMutex
+	configProvider ConfigProvider
+
+	transportsMu sync.Mutex
+	transports   = make(map[string]*cachedTransport)
+)
+
+type cachedTransport struct {
+	signature string
+	transport *http.Transport
+}
+
+// SetConfigProvider 设置出站配置来源，应在应用启动时调用
+// 未设置时所有客户端使用默认传输层（遵循 HTTP(S)_PROXY 环境变量）
+func SetConfigProvider(provider ConfigProvider) {
+	providerMu.Lock()
+	configProvider = provider
+	providerMu.Unlock()
+}
+
+// settingGetter 读取配置项的最小接口，避免依赖具体的配置服务实现
+type settingGetter interface {
+	Get(key string) string
+}
+
+// NewSettingConfigProvider 基于后台配置的出站配置来源
+// 全局配置由 outbound.proxy / outbound.no_proxy / outbound.ca_bundle 提供，
+// outbound.service_overrides 为 JSON，可按服务覆盖，如 {"wechat": {"proxy": "direct"}}
+func NewSettingConfigProvider(settings settingGetter) ConfigProvider {
+	return func(service string) OutboundConfig {
+		cfg := OutboundConfig{
+			Proxy:    strings.TrimSpace(settings.Get(constant.KeyOutboundProxy.String())),
+			NoProxy:  splitList(settings.Get(constant.KeyOutboundNoProxy.String())),
+			CABundle: strings.TrimSpace(settings.Get(constant.KeyOutboundCABundle.String())),
+		}
+
+		raw := strings.TrimSpace(settings.Get(constant.KeyOutboundServiceOverrides.String()))
+		if raw == "" {
+			return cfg
+		}
+		var overrides map[string]OutboundConfig
+		if err := json.Unmarshal([]byte(raw), &overrides); err != nil {
+			log.Printf("[出站代理] 解析服务覆盖配置失败，使用全局配置: %v", err)
+			return cfg
+		}
+		if override, ok := overrides[service]; ok {
+			if override.Proxy != "" {
+				cfg.Proxy = override.Proxy
+			}
+			if len(override.NoProxy) > 0 {
+				cfg.NoProxy = override.NoProxy
+			}
+			if override.CABundle != "" {
+				cfg.CABundle = override.CABundle
+			}
+		}
+		return cfg
+	}
+}
+
+// outboundTransport 每次请求按服务的当前配置选择传输层，配置变化后自动重建
+type outboundTransport struct {
+	service string
+}
+
+func (t *outboundTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return transportFor(t.service).RoundTrip(req)
+}
+
+// transportFor 返回服务对应的传输层，相同配置复用同一个连接池
+func transportFor(service string) http.RoundTripper {
+	providerMu.RLock()
+	provider := configProvider
+	providerMu.RUnlock()
+	if provider == nil {
+		return http.DefaultTransport
+	}
+
+	cfg := provider(service)
+	caSum := sha256.Sum256([]byte(cfg.CABundle))
+	signature := fmt.Sprintf("%s|%s|%s", cfg.Proxy, strings.Join(cfg.NoProxy, ","), hex.EncodeToString(caSum[:8]))
+
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+	if cached, ok := transports[service]; ok && cached.signature == signature {
+		return cached.transport
+	}
+
+	transport := buildTransport(service, cfg)
+	if old, ok := transports[service]; ok {
+		old.transport.CloseIdleConnections()
+	}
+	transports[service] = &cachedTransport{signature: signature, transport: transport}
+	return transport
+}
+
+func buildTransport(service string, cfg OutboundConfig) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	switch {
+	case cfg.Proxy == ProxyDirect:
+		transport.Proxy = nil
+	case cfg.Proxy != "":
+		proxyURL, err := url.Parse(cfg.Proxy)
+		if err != nil || proxyURL.Host == "" {
+			log.Printf("[出站代理] 服务 %s 的代理地址无效 (%s)，改用环境变量配置", service, cfg.Proxy)
+			break
+		}
+		noProxy := cfg.NoProxy
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			if bypassProxy(req.URL.Hostname(), noProxy) {
+				return nil, nil
+			}
+			return proxyURL, nil
+		}
+	}
+
+	if cfg.CABundle != "" {
+		pool, err := loadCertPool(cfg.CABundle)
+		if err != nil {
+			log.Printf("[出站代理] 服务 %s 加载自定义 CA 失败，使用系统证书: %v", service, err)
+		} else {
+			transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+		}
+	}
+
+	return transport
+}
+
+// loadCertPool 在系统证书基础上追加自定义 CA
+func loadCertPool(bundle string) (*x509.CertPool, error) {
+	pemData := []byte(bundle)
+	if !strings.Contains(bundle, "-----BEGIN") {
+		data, err := os.ReadFile(bundle)
+		if err != nil {
+			return nil, fmt.Errorf("读取 CA 文件失败: %w", err)
+		}
+		pemData = data
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pemData) {
+		return nil, fmt.Errorf("未能从 CA 内容中解析出证书")
+	}
+	return pool, nil
+}
+
+// bypassProxy 本机地址和 NoProxy 列表中的主机不走代理
+func bypassProxy(host string, noProxy []string) bool {
+	if host == "localhost" {
+		return true
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return true
+	}
+	for _, rule := range noProxy {
+		rule = strings.ToLower(strings.TrimSpace(rule))
+		if rule == "" {
+			continue
+		}
+		if rule == "*" || strings.EqualFold(host, strings.TrimPrefix(rule, ".")) || strings.HasSuffix(strings.ToLower(host), "."+strings.TrimPrefix(rule, ".")) {
+			return true
+		}
+	}
+	return false
+}
+
+func splitList(raw string) []string {
+	var result []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,169 @@
+/*
+ * @Description: HTTP 监听器，支持 TCP 端口、Unix 域套接字和 systemd 套接字激活
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 优先级：systemd 传入的套接字 > Unix 域套接字 > TCP 端口。
+ * 与 Nginx/Caddy 同机部署时使用 Unix 域套接字可以避免对外暴露本地端口。
+ */
+package listener
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// systemdListenFDsStart systemd 传入的第一个文件描述符编号（SD_LISTEN_FDS_START）
+const systemdListenFDsStart = 3
+
+// Config 监听配置
+type Config struct {
+	Port       string      // TCP 端口
+	SocketPath string      // Unix 域套接字路径，非空时替代 TCP 端口
+	SocketMode os.FileMode // Unix 域套接字文件权限
+}
+
+// ParseSocketMode 解析八进制的套接字权限，如 0660，为空或非法时返回 0660
+func ParseSocketMode(raw string) os.FileMode {
+	mode, err := strconv.ParseUint(strings.TrimSpace(raw), 8, 32)
+	if err != nil || mode == 0 {
+		return 0660
+	}
+	return os.FileMode(mode)
+}
+
+// Listeners 创建好的监听器
+type Listeners struct {
+	Primary     net.Listener   // 主监听器，用于 HTTP 服务
+	Extra       []net.Listener // systemd 传入的其余套接字，启用内置 HTTPS 时第二个用于 HTTPS
+	Description string         // 便于日志输出的地址描述
+}
+
+// Listen 按配置创建监听器
+func Listen(cfg Config) (*Listeners, error) {
+	systemd, err := SystemdListeners()
+	if err != nil {
+		return nil, err
+	}
+	if len(systemd) > 0 {
+		return &Listeners{
+			Primary:     wrapUnix(systemd[0]),
+			Extra:       systemd[1:],
+			Description: "systemd 套接字 " + systemd[0].Addr().String(),
+		}, nil
+	}
+
+	if cfg.SocketPath != "" {
+		ln, err := listenUnix(cfg.SocketPath, cfg.SocketMode)
+		if err != nil {
+			return nil, err
+		}
+		return &Listeners{Primary: ln, Description: "unix:" + cfg.SocketPath}, nil
+	}
+
+	ln, err := net.Listen("tcp", ":"+cfg.Port)
+	if err != nil {
+		return nil, err
+	}
+	return &Listeners{Primary: ln, Description: "端口 " + cfg.Port}, nil
+}
+
+// SystemdListeners 读取 systemd 套接字激活传入的监听器（LISTEN_PID / LISTEN_FDS 协议），
+// 读取后清除相关环境变量，避免子进程（如 SSR 主题）误用
+func SystemdListeners() ([]net.Listener, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, nil
+	}
+	count, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || count <= 0 {
+		return nil, nil
+	}
+	names := strings.Split(os.Getenv("LISTEN_FDNAMES"), ":")
+
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+	os.Unsetenv("LISTEN_FDNAMES")
+
+	listeners := make([]net.Listener, 0, count)
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf("LISTEN_FD_%d", systemdListenFDsStart+i)
+		if i < len(names) && names[i] != "" {
+			name = names[i]
+		}
+		file := os.NewFile(uintptr(systemdListenFDsStart+i), name)
+		ln, err := net.FileListener(file)
+		file.Close()
+		if err != nil {
+			for _, opened := range listeners {
+				opened.Close()
+			}
+			return nil, fmt.Errorf("使用 systemd 传入的套接字 %s 失败: %w", name, err)
+		}
+		listeners = append(listeners, ln)
+	}
+	return listeners, nil
+}
+
+// listenUnix 创建 Unix 域套接字监听器，清理上次异常退出遗留的套接字文件并设置权限
+func listenUnix(path string, mode os.FileMode) (net.Listener, error) {
+	if info, err := os.Stat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s 已存在且不是套接字文件", path)
+		}
+		if conn, err := net.Dial("unix", path); err == nil {
+			conn.Close()
+			return nil, fmt.Errorf("%s 正在被其他进程使用", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("删除遗留的套接字文件失败: %w", err)
+		}
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if mode == 0 {
+		mode = 0660
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		ln.Close()
+		return nil, fmt.Errorf("设置套接字权限失败: %w", err)
+	}
+	return wrapUnix(ln), nil
+}
+
+// unixListener Unix 域套接字连接没有对端 IP，Gin 的 ClientIP 会返回空字符串。
+// 同机反向代理的连接一律视为来自本机回环地址，使可信代理判断和真实 IP 解析照常工作。
+type unixListener struct {
+	net.Listener
+}
+
+type unixConn struct {
+	net.Conn
+}
+
+var loopbackAddr = &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}
+
+func wrapUnix(ln net.Listener) net.Listener {
+	if _, ok := ln.Addr().(*net.UnixAddr); !ok {
+		return ln
+	}
+	return &unixListener{Listener: ln}
+}
+
+func (l *unixListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &unixConn{Conn: conn}, nil
+}
+
+func (c *unixConn) RemoteAddr() net.Addr {
+	return loopbackAddr
+}
@@ -0,0 +1,48 @@
+package perfprofile
+
+import (
+	"context"
+	"sync"
+)
+
+// ConcurrencyLimiter 限制同时执行的请求数，上限每次获取时传入，修改配置后立即生效
+type ConcurrencyLimiter struct {
+	mu     sync.Mutex
+	active int
+	wake   chan struct{}
+}
+
+// NewConcurrencyLimiter 创建并发限制器
+func NewConcurrencyLimiter() *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{wake: make(chan struct{})}
+}
+
+// Acquire 等待空闲名额，limit 不大于 0 时不限制；ctx 结束前仍未获得名额时返回 ctx 的错误。
+// 成功返回后必须调用 Release 归还名额。
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context, limit int) error {
+	for {
+		l.mu.Lock()
+		if limit <= 0 || l.active < limit {
+			l.active++
+			l.mu.Unlock()
+			return nil
+		}
+		wake := l.wake
+		l.mu.Unlock()
+
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Release 归还名额并唤醒等待者
+func (l *ConcurrencyLimiter) Release() {
+	l.mu.Lock()
+	l.active--
+	close(l.wake)
+	l.wake = make(chan struct{})
+	l.mu.Unlock()
+}
@@ -0,0 +1,111 @@
+/*
+ * @Description: 运行性能档位，低配模式下降低并发、缓存占用和后台任务负载
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 面向树莓派、单核 VPS 等低配设备。worker 数量和缓存容量在服务启动时读取，修改后需要重启；
+ * 缩略图预生成、重量级定时任务和 SSR 并发限制每次使用时读取，修改后立即生效。
+ */
+package perfprofile
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// 常规模式下的默认值
+const (
+	DefaultParserCacheSize = 500
+	DefaultSSRQueueTimeout = 10 * time.Second
+	fallbackTaskWorkers    = 4
+)
+
+// 低配模式下的取值
+const (
+	LowResourceTaskWorkers       = 1
+	LowResourceParserCacheSize   = 100
+	LowResourceSSRMaxConcurrency = 2
+)
+
+// Profile 当前生效的性能参数
+type Profile struct {
+	LowResource       bool
+	TaskWorkers       int  // 后台任务队列的 worker 数量
+	ParserCacheSize   int  // Markdown 解析和 HTML 过滤结果的缓存条数
+	EagerThumbnails   bool // 上传后立即生成缩略图；关闭时在首次请求缩略图时生成
+	HeavyJobs         bool // 是否定时执行友链检查、外链存档、资源本地化刷新等重量级任务
+	SSRMaxConcurrency int  // 同时代理到 SSR 主题的请求数上限，0 表示不限制
+	SSRMaxPerIP       int  // 单个 IP 同时代理到 SSR 主题的请求数上限，0 表示不限制
+	SSRMaxPerRoute    int  // 单个路由同时代理到 SSR 主题的请求数上限，0 表示不限制
+	// SSRQueueTimeout 超过 SSR 全局并发上限时排队等待的最长时间
+	SSRQueueTimeout time.Duration
+}
+
+// settingGetter 读取配置项的最小接口
+type settingGetter interface {
+	Get(key string) string
+}
+
+var (
+	mu       sync.RWMutex
+	settings settingGetter
+)
+
+// SetSettingSource 设置配置来源，未设置时使用常规模式
+func SetSettingSource(s settingGetter) {
+	mu.Lock()
+	settings = s
+	mu.Unlock()
+}
+
+// Current 返回当前的性能参数
+func Current() Profile {
+	mu.RLock()
+	s := settings
+	mu.RUnlock()
+
+	p := Profile{
+		TaskWorkers:     runtime.NumCPU(),
+		ParserCacheSize: DefaultParserCacheSize,
+		EagerThumbnails: true,
+		HeavyJobs:       true,
+		SSRQueueTimeout: DefaultSSRQueueTimeout,
+	}
+	if p.TaskWorkers <= 0 {
+		p.TaskWorkers = fallbackTaskWorkers
+	}
+	if s == nil {
+		return p
+	}
+
+	if n, err := strconv.Atoi(strings.TrimSpace(s.Get(constant.KeyPerformanceSSRMaxConcurrency.String()))); err == nil && n > 0 {
+		p.SSRMaxConcurrency = n
+	}
+	if n, err := strconv.Atoi(strings.TrimSpace(s.Get(constant.KeyPerformanceSSRMaxPerIP.String()))); err == nil && n > 0 {
+		p.SSRMaxPerIP = n
+	}
+	if n, err := strconv.Atoi(strings.TrimSpace(s.Get(constant.KeyPerformanceSSRMaxPerRoute.String()))); err == nil && n > 0 {
+		p.SSRMaxPerRoute = n
+	}
+	if n, err := strconv.Atoi(strings.TrimSpace(s.Get(constant.KeyPerformanceSSRQueueTimeout.String()))); err == nil && n > 0 {
+		p.SSRQueueTimeout = time.Duration(n) * time.Second
+	}
+	if s.Get(constant.KeyPerformanceLowResourceMode.String()) != "true" {
+		return p
+	}
+
+	p.LowResource = true
+	p.TaskWorkers = LowResourceTaskWorkers
+	p.ParserCacheSize = LowResourceParserCacheSize
+	p.EagerThumbnails = false
+	p.HeavyJobs = false
+	if p.SSRMaxConcurrency == 0 {
+		p.SSRMaxConcurrency = LowResourceSSRMaxConcurrency
+	}
+	return p
+}
@@ -0,0 +1,147 @@
+package perfprofile
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrSSRPerIPLimit 单个 IP 同时进行的 SSR 请求超过上限
+	ErrSSRPerIPLimit = errors.New("同一 IP 的 SSR 并发请求过多")
+	// ErrSSRPerRouteLimit 单个路由同时进行的 SSR 请求超过上限
+	ErrSSRPerRouteLimit = errors.New("同一页面的 SSR 并发请求过多")
+	// ErrSSRQueueTimeout 排队等待全局名额超时
+	ErrSSRQueueTimeout = errors.New("等待 SSR 并发名额超时")
+)
+
+// SSRLimiterMetrics SSR 并发限制的当前状态和累计统计
+type SSRLimiterMetrics struct {
+	MaxConcurrency       int   `json:"max_concurrency"`        // 全局并发上限，0 表示不限制
+	MaxPerIP             int   `json:"max_per_ip"`             // 单个 IP 的并发上限，0 表示不限制
+	MaxPerRoute          int   `json:"max_per_route"`          // 单个路由的并发上限，0 表示不限制
+	QueueTimeoutSeconds  int   `json:"queue_timeout_seconds"`  // 排队等待的最长时间
+	Active               int   `json:"active"`                 // 正在代理的请求数
+	Waiting              int   `json:"waiting"`                // 正在排队的请求数
+	Admitted             int64 `json:"admitted"`               // 累计放行的请求数
+	RejectedQueueTimeout int64 `json:"rejected_queue_timeout"` // 累计因排队超时拒绝的请求数
+	RejectedPerIP        int64 `json:"rejected_per_ip"`        // 累计因单个 IP 超限拒绝的请求数
+	RejectedPerRoute     int64 `json:"rejected_per_route"`     // 累计因单个路由超限拒绝的请求数
+	AvgWaitMs            int64 `json:"avg_wait_ms"`            // 放行请求的平均排队时间
+	MaxWaitMs            int64 `json:"max_wait_ms"`            // 放行请求的最长排队时间
+}
+
+// SSRLimiter 限制同时代理到 SSR 主题的请求：超过全局上限时排队等待，
+// 单个 IP 或单个路由超过上限时直接拒绝，避免少数慢请求占满唯一的 Node.js 进程
+type SSRLimiter struct {
+	global *ConcurrencyLimiter
+
+	mu        sync.Mutex
+	perIP     map[string]int
+	perRoute  map[string]int
+	waiting   int
+	totalWait time.Duration
+	metrics   SSRLimiterMetrics
+}
+
+// NewSSRLimiter 创建 SSR 并发限制器
+func NewSSRLimiter() *SSRLimiter {
+	return &SSRLimiter{
+		global:   NewConcurrencyLimiter(),
+		perIP:    make(map[string]int),
+		perRoute: make(map[string]int),
+	}
+}
+
+// ssrLimiter SSR 代理中间件使用的限制器
+var ssrLimiter = NewSSRLimiter()
+
+// AcquireSSR 为代理到 SSR 主题的请求获取名额，上限按当前性能档位读取。
+// 成功时返回的 release 必须调用；失败时返回 ErrSSRPerIPLimit、ErrSSRPerRouteLimit 或 ErrSSRQueueTimeout
+func AcquireSSR(ctx context.Context, ip, route string) (release func(), err error) {
+	return ssrLimiter.Acquire(ctx, ip, route, Current())
+}
+
+// SSRMetrics 返回 SSR 并发限制的当前状态
+func SSRMetrics() SSRLimiterMetrics {
+	return ssrLimiter.Metrics(Current())
+}
+
+// Acquire 先占用 IP 和路由名额再排队等待全局名额，排队期间同样计入 IP 和路由的并发数
+func (l *SSRLimiter) Acquire(ctx context.Context, ip, route string, p Profile) (func(), error) {
+	l.mu.Lock()
+	if p.SSRMaxPerIP > 0 && l.perIP[ip] >= p.SSRMaxPerIP {
+		l.metrics.RejectedPerIP++
+		l.mu.Unlock()
+		return nil, ErrSSRPerIPLimit
+	}
+	if p.SSRMaxPerRoute > 0 && l.perRoute[route] >= p.SSRMaxPerRoute {
+		l.metrics.RejectedPerRoute++
+		l.mu.Unlock()
+		return nil, ErrSSRPerRouteLimit
+	}
+	l.perIP[ip]++
+	l.perRoute[route]++
+	l.waiting++
+	l.mu.Unlock()
+
+	start := time.Now()
+	waitCtx, cancel := context.WithTimeout(ctx, p.SSRQueueTimeout)
+	err := l.global.Acquire(waitCtx, p.SSRMaxConcurrency)
+	cancel()
+	wait := time.Since(start)
+
+	l.mu.Lock()
+	l.waiting--
+	if err != nil {
+		l.metrics.RejectedQueueTimeout++
+		l.releaseKeys(ip, route)
+		l.mu.Unlock()
+		return nil, ErrSSRQueueTimeout
+	}
+	l.metrics.Admitted++
+	l.metrics.Active++
+	l.totalWait += wait
+	if ms := wait.Milliseconds(); ms > l.metrics.MaxWaitMs {
+		l.metrics.MaxWaitMs = ms
+	}
+	l.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.global.Release()
+			l.mu.Lock()
+			l.metrics.Active--
+			l.releaseKeys(ip, route)
+			l.mu.Unlock()
+		})
+	}, nil
+}
+
+// releaseKeys 归还 IP 和路由名额，计数归零时删除以免占用内存，调用方需持有锁
+func (l *SSRLimiter) releaseKeys(ip, route string) {
+	if l.perIP[ip]--; l.perIP[ip] <= 0 {
+		delete(l.perIP, ip)
+	}
+	if l.perRoute[route]--; l.perRoute[route] <= 0 {
+		delete(l.perRoute, route)
+	}
+}
+
+// Metrics 返回限制器的状态快照
+func (l *SSRLimiter) Metrics(p Profile) SSRLimiterMetrics {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	m := l.metrics
+	m.Waiting = l.waiting
+	if m.Admitted > 0 {
+		m.AvgWaitMs = (l.totalWait / time.Duration(m.Admitted)).Milliseconds()
+	}
+	m.MaxConcurrency = p.SSRMaxConcurrency
+	m.MaxPerIP = p.SSRMaxPerIP
+	m.MaxPerRoute = p.SSRMaxPerRoute
+	m.QueueTimeoutSeconds = int(p.SSRQueueTimeout / time.Second)
+	return m
+}
@@ -0,0 +1,122 @@
+/*
+ * @Description: 文章永久链接策略，按配置生成文章的 abbrlink
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 文章地址固定为 /posts/{abbrlink}，策略只决定 abbrlink 的生成方式；
+ * 生成结果只包含字母、数字、中文、连字符、下划线和点，模式中的 / 会转换为连字符。
+ */
+package permalink
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// 永久链接策略
+const (
+	StrategyAbbrlink = "abbrlink" // 手动填写，未填写时使用文章 ID
+	StrategyDate     = "date"     // 发布日期加标题，如 2024-05-01-hello-world
+	StrategyTitle    = "title"    // 标题转换的 slug
+	StrategyPattern  = "pattern"  // 自定义模式
+)
+
+// DefaultPattern 自定义模式未配置时使用的模式
+const DefaultPattern = "{year}{month}{day}-{title}"
+
+// maxLength 生成结果的最大字符数，留出冲突后缀的空间
+const maxLength = 120
+
+// Input 生成永久链接所需的文章信息
+type Input struct {
+	Title    string
+	Category string // 第一个分类名称
+	Date     time.Time
+}
+
+// Valid 是否为支持的策略
+func Valid(strategy string) bool {
+	switch strategy {
+	case StrategyAbbrlink, StrategyDate, StrategyTitle, StrategyPattern:
+		return true
+	default:
+		return false
+	}
+}
+
+// Build 按策略生成 abbrlink；abbrlink 策略或生成结果为空时返回空字符串，由文章 ID 兜底
+func Build(strategy, pattern string, in Input) string {
+	switch strategy {
+	case StrategyDate:
+		pattern = "{year}-{month}-{day}-{title}"
+	case StrategyTitle:
+		pattern = "{title}"
+	case StrategyPattern:
+		if strings.TrimSpace(pattern) == "" {
+			pattern = DefaultPattern
+		}
+	default:
+		return ""
+	}
+	date := in.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+	replacer := strings.NewReplacer(
+		"{year}", date.Format("2006"),
+		"{month}", date.Format("01"),
+		"{day}", date.Format("02"),
+		"{hour}", date.Format("15"),
+		"{minute}", date.Format("04"),
+		"{title}", Slugify(in.Title),
+		"{category}", Slugify(in.Category),
+	)
+	return clean(replacer.Replace(pattern))
+}
+
+// Slugify 将文本转换为 slug：英文转小写，保留字母、数字和中文，其余字符转换为连字符
+func Slugify(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(s)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('-')
+		}
+	}
+	return clean(b.String())
+}
+
+// WithSuffix 为冲突的 abbrlink 添加序号后缀
+func WithSuffix(base string, n int) string {
+	if n <= 1 {
+		return base
+	}
+	return base + "-" + strconv.Itoa(n)
+}
+
+// clean 去掉不允许的字符，合并连续的连字符并限制长度
+func clean(s string) string {
+	var b strings.Builder
+	lastDash := true
+	count := 0
+	for _, r := range s {
+		if count >= maxLength {
+			break
+		}
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.':
+			b.WriteRune(r)
+			lastDash = false
+		case !lastDash:
+			b.WriteRune('-')
+			lastDash = true
+		default:
+			continue
+		}
+		count++
+	}
+	return strings.Trim(b.String(), "-.")
+}
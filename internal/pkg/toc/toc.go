@@ -0,0 +1,134 @@
+/*
+ * @Description: 从文章 HTML 中提取目录，补齐并去重标题锚点
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package toc
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// DefaultMaxDepth 默认提取的标题层数
+const DefaultMaxDepth = 3
+
+var (
+	headingRegex = regexp.MustCompile(`(?is)<h([1-6])(\s[^>]*)?>(.*?)</h[1-6]\s*>`)
+	idAttrRegex  = regexp.MustCompile(`(?i)\sid\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+	tagRegex     = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+type heading struct {
+	level  int
+	text   string
+	anchor string
+}
+
+// Extract 提取目录并返回补齐锚点后的 HTML。
+// 所有标题都会保证拥有唯一的 id：已有 id 的保留原值，重复时追加 -1、-2 后缀；没有 id 的根据标题文字生成。
+// 目录只包含从文章中最高一级标题开始的 maxDepth 层，maxDepth <= 0 时使用默认值。
+func Extract(contentHTML string, maxDepth int) (string, []*model.TocItem) {
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+
+	seen := make(map[string]bool)
+	var headings []heading
+	rewritten := headingRegex.ReplaceAllStringFunc(contentHTML, func(match string) string {
+		parts := headingRegex.FindStringSubmatch(match)
+		level, _ := strconv.Atoi(parts[1])
+		attrs, inner := parts[2], parts[3]
+
+		text := strings.Join(strings.Fields(html.UnescapeString(tagRegex.ReplaceAllString(inner, ""))), " ")
+		if text == "" {
+			return match
+		}
+
+		var existing string
+		if m := idAttrRegex.FindStringSubmatch(attrs); m != nil {
+			existing = m[1] + m[2]
+		}
+		base := existing
+		if base == "" {
+			base = Slugify(text)
+		}
+		anchor := uniqueAnchor(base, seen)
+		headings = append(headings, heading{level: level, text: text, anchor: anchor})
+
+		if anchor == existing {
+			return match
+		}
+		newAttrs := idAttrRegex.ReplaceAllString(attrs, "")
+		newAttrs = ` id="` + html.EscapeString(anchor) + `"` + newAttrs
+		return "<h" + parts[1] + newAttrs + ">" + inner + "</h" + parts[1] + ">"
+	})
+
+	return rewritten, buildTree(headings, maxDepth)
+}
+
+// Slugify 将标题文字转换为锚点：保留各语言的字母和数字，其余字符替换为连字符
+func Slugify(text string) string {
+	var b strings.Builder
+	lastDash := true
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			b.WriteRune(r)
+			lastDash = false
+		} else if !lastDash {
+			b.WriteByte('-')
+			lastDash = true
+		}
+	}
+	slug := strings.TrimSuffix(b.String(), "-")
+	if slug == "" {
+		return "heading"
+	}
+	return slug
+}
+
+func uniqueAnchor(base string, seen map[string]bool) string {
+	anchor := base
+	for i := 1; seen[anchor]; i++ {
+		anchor = base + "-" + strconv.Itoa(i)
+	}
+	seen[anchor] = true
+	return anchor
+}
+
+// buildTree 按标题层级构建嵌套目录，跳级的标题挂在最近的上级下
+func buildTree(headings []heading, maxDepth int) []*model.TocItem {
+	if len(headings) == 0 {
+		return nil
+	}
+	minLevel := 6
+	for _, h := range headings {
+		minLevel = min(minLevel, h.level)
+	}
+	maxLevel := minLevel + maxDepth - 1
+
+	var roots []*model.TocItem
+	var stack []*model.TocItem
+	for _, h := range headings {
+		if h.level > maxLevel {
+			continue
+		}
+		item := &model.TocItem{Level: h.level, Text: h.text, Anchor: h.anchor}
+		for len(stack) > 0 && stack[len(stack)-1].Level >= h.level {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			roots = append(roots, item)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, item)
+		}
+		stack = append(stack, item)
+	}
+	return roots
+}
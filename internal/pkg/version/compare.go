@@ -0,0 +1,110 @@
+package version
+
+import (
+	"strconv"
+	"strings"
+)
+
+// semver 解析后的版本号，prerelease 为空表示正式版本
+type semver struct {
+	core       [3]int
+	prerelease []string
+}
+
+// parse 解析 x.y.z[-预发布][+构建元数据] 形式的版本号，允许 v 前缀，缺少的次版本号、修订号按 0 处理
+func parse(v string) (semver, bool) {
+	var out semver
+	v = strings.TrimPrefix(strings.TrimSpace(strings.ToLower(v)), "v")
+	if i := strings.IndexByte(v, '+'); i >= 0 {
+		v = v[:i]
+	}
+	core, pre, hasPre := strings.Cut(v, "-")
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return out, false
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return out, false
+		}
+		out.core[i] = n
+	}
+	if hasPre {
+		if pre == "" {
+			return out, false
+		}
+		out.prerelease = strings.Split(pre, ".")
+		for _, id := range out.prerelease {
+			if id == "" {
+				return out, false
+			}
+		}
+	}
+	return out, true
+}
+
+// Compare 按语义化版本规则比较两个版本号，a 较新时返回正数，较旧时返回负数，相同时返回 0。
+// 预发布版本早于对应的正式版本（1.2.0-rc.1 < 1.2.0），预发布标识逐段比较：数字段按数值、
+// 其余按字典序，数字段早于非数字段，段数少的更早；构建元数据（+ 之后）不参与比较。
+// 任一方无法解析（如开发构建的 dev）时 ok 为 false
+func Compare(a, b string) (cmp int, ok bool) {
+	va, okA := parse(a)
+	vb, okB := parse(b)
+	if !okA || !okB {
+		return 0, false
+	}
+	for i := range va.core {
+		if va.core[i] != vb.core[i] {
+			return sign(va.core[i] - vb.core[i]), true
+		}
+	}
+	switch {
+	case len(va.prerelease) == 0 && len(vb.prerelease) == 0:
+		return 0, true
+	case len(va.prerelease) == 0:
+		return 1, true
+	case len(vb.prerelease) == 0:
+		return -1, true
+	}
+	for i := 0; i < min(len(va.prerelease), len(vb.prerelease)); i++ {
+		if c := comparePrerelease(va.prerelease[i], vb.prerelease[i]); c != 0 {
+			return c, true
+		}
+	}
+	return sign(len(va.prerelease) - len(vb.prerelease)), true
+}
+
+// Newer 判断 latest 是否比 current 新。无法比较（如当前为开发构建）时，只要版本号不同就认为更新
+func Newer(latest, current string) bool {
+	if cmp, ok := Compare(latest, current); ok {
+		return cmp > 0
+	}
+	latest = strings.TrimPrefix(strings.TrimSpace(latest), "v")
+	current = strings.TrimPrefix(strings.TrimSpace(current), "v")
+	return latest != "" && latest != current
+}
+
+func comparePrerelease(a, b string) int {
+	na, errA := strconv.Atoi(a)
+	nb, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return sign(na - nb)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	}
+	return 0
+}
@@ -19,6 +19,10 @@ var (
 const CommunityModulePath = "github.com/anzhiyu-c/anheyu-app"
 const ProModulePath = "github.com/anzhiyu-c/anheyu-pro-backend"
 
+// ThemeAPIVersion 主题可依赖的公开 API 约定版本。
+// 公开接口出现不兼容的变化（删除字段、修改语义）时递增，仅新增字段或接口时不变
+const ThemeAPIVersion = 1
+
 // GetVersion 返回应用版本号
 func GetVersion() string {
 	// 如果通过 ldflags 注入了版本信息，则使用注入的版本
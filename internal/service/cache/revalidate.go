@@ -14,6 +14,8 @@ import (
 	"net/http"
 	"os"
 	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
 )
 
 // RevalidateService Next.js 缓存清理服务
@@ -46,9 +48,10 @@ func NewRevalidateService() *RevalidateService {
 		enabled: enabled,
 		baseURL: frontendURL + "/api/revalidate",
 		token:   token,
-		httpClient: &http.Client{
+		httpClient: httpclient.New(httpclient.Options{
+			Name:    "revalidate",
 			Timeout: 5 * time.Second,
-		},
+		}),
 	}
 }
 
@@ -0,0 +1,343 @@
+/*
+ * @Description: 缓存清除与预热服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 提供按路径/缓存标签清除 CDN 缓存，以及基于站点地图或 URL 列表预热页面缓存的能力，
+ * 供后台管理接口和命令行子命令共同使用。
+ */
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/cdn"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/sitemap"
+)
+
+const (
+	// defaultWarmConcurrency 默认预热并发数
+	defaultWarmConcurrency = 4
+	// maxWarmConcurrency 最大预热并发数，避免预热时压垮源站
+	maxWarmConcurrency = 16
+	// defaultWarmLimit 默认最多预热的 URL 数量
+	defaultWarmLimit = 500
+)
+
+// PurgeRequest 缓存清除请求
+type PurgeRequest struct {
+	Paths []string `json:"paths"` // 站内路径，如 /posts/hello；也可以是完整 URL
+	Tags  []string `json:"tags"`  // 缓存标签，如 article-list、home-page
+	All   bool     `json:"all"`   // 按站点根目录清除全部 CDN 缓存，SSR 模式下同时清除前端的全部缓存
+}
+
+// PurgeResult 缓存清除结果
+type PurgeResult struct {
+	PurgedURLs []string `json:"purged_urls"`
+	PurgedTags []string `json:"purged_tags"`
+	PurgedAll  bool     `json:"purged_all"` // 是否已清除站点的全部 CDN 缓存
+	Revalidate bool     `json:"revalidate"` // 是否同时通知了 SSR 前端
+	Errors     []string `json:"errors,omitempty"`
+}
+
+// WarmRequest 缓存预热请求
+type WarmRequest struct {
+	URLs        []string `json:"urls"`        // 需要预热的路径或完整 URL
+	UseSitemap  bool     `json:"use_sitemap"` // 是否从站点地图中读取 URL
+	Concurrency int      `json:"concurrency"`
+	Limit       int      `json:"limit"`
+}
+
+// WarmURLResult 单个 URL 的预热结果
+type WarmURLResult struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"status_code"`
+	DurationMs int64  `json:"duration_ms"`
+	CacheState string `json:"cache_state,omitempty"` // CDN 返回的缓存状态头（如有）
+	Error      string `json:"error,omitempty"`
+}
+
+// WarmResult 缓存预热结果
+type WarmResult struct {
+	Total      int             `json:"total"`
+	Succeeded  int             `json:"succeeded"`
+	Failed     int             `json:"failed"`
+	DurationMs int64           `json:"duration_ms"`
+	Results    []WarmURLResult `json:"results"`
+}
+
+// WarmupService 缓存清除与预热服务
+type WarmupService struct {
+	settingSvc    setting.SettingService
+	cdnSvc        cdn.CDNService
+	sitemapSvc    sitemap.Service
+	revalidateSvc *RevalidateService
+	httpClient    *http.Client
+}
+
+// NewWarmupService 创建缓存清除与预热服务
+func NewWarmupService(settingSvc setting.SettingService, cdnSvc cdn.CDNService, sitemapSvc sitemap.Service, revalidateSvc *RevalidateService) *WarmupService {
+	return &WarmupService{
+		settingSvc:    settingSvc,
+		cdnSvc:        cdnSvc,
+		sitemapSvc:    sitemapSvc,
+		revalidateSvc: revalidateSvc,
+		httpClient: &http.Client{
+			Timeout: 20 * time.Second,
+		},
+	}
+}
+
+// Purge 按路径和缓存标签清除缓存
+func (s *WarmupService) Purge(ctx context.Context, req *PurgeRequest) (*PurgeResult, error) {
+	if len(req.Paths) == 0 && len(req.Tags) == 0 && !req.All {
+		return nil, fmt.Errorf("请至少指定一个路径、缓存标签或选择全部清除")
+	}
+
+	result := &PurgeResult{}
+
+	if len(req.Paths) > 0 {
+		urls := make([]string, 0, len(req.Paths))
+		for _, p := range req.Paths {
+			fullURL, err := s.resolveURL(p)
+			if err != nil {
+				result.Errors = append(result.Errors, err.Error())
+				continue
+			}
+			urls = append(urls, fullURL)
+		}
+		if len(urls) > 0 {
+			if err := s.cdnSvc.PurgeCache(ctx, urls); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("清除URL缓存失败: %v", err))
+			} else {
+				result.PurgedURLs = urls
+			}
+		}
+	}
+
+	if len(req.Tags) > 0 {
+		if err := s.cdnSvc.PurgeByTags(ctx, req.Tags); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("按标签清除缓存失败: %v", err))
+		} else {
+			result.PurgedTags = req.Tags
+		}
+	}
+
+	ssrEnabled := s.revalidateSvc != nil && s.revalidateSvc.IsEnabled()
+	if req.All {
+		if s.settingSvc.GetBool(constant.KeyCDNEnable.String()) {
+			if err := s.cdnSvc.PurgeAll(ctx); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("清除全部CDN缓存失败: %v", err))
+			} else {
+				result.PurgedAll = true
+			}
+		} else if !ssrEnabled {
+			result.Errors = append(result.Errors, "未启用CDN缓存清除，也未运行SSR前端，没有可清除的缓存")
+		}
+	}
+
+	// SSR 模式下同步清理 Next.js 前端缓存
+	if ssrEnabled {
+		result.Revalidate = true
+		if req.All {
+			if err := s.revalidateSvc.RevalidateAll(); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("清理 SSR 前端缓存失败: %v", err))
+			}
+		} else {
+			for _, p := range req.Paths {
+				if slug, ok := articleSlugFromPath(p); ok {
+					if err := s.revalidateSvc.RevalidateArticle(slug); err != nil {
+						result.Errors = append(result.Errors, fmt.Sprintf("清理文章 %s 的 SSR 缓存失败: %v", slug, err))
+					}
+				}
+			}
+		}
+	}
+
+	log.Printf("[缓存清除] URL: %d 个, 标签: %d 个, 错误: %d 个", len(result.PurgedURLs), len(result.PurgedTags), len(result.Errors))
+	return result, nil
+}
+
+// Warm 预热指定 URL 列表（可选合并站点地图中的全部 URL）
+func (s *WarmupService) Warm(ctx context.Context, req *WarmRequest) (*WarmResult, error) {
+	urls, err := s.collectWarmURLs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("没有需要预热的 URL")
+	}
+
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultWarmConcurrency
+	}
+	if concurrency > maxWarmConcurrency {
+		concurrency = maxWarmConcurrency
+	}
+
+	start := time.Now()
+	results := make([]WarmURLResult, len(urls))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, u := range urls {
+		if ctx.Err() != nil {
+			results[i] = WarmURLResult{URL: u, Error: ctx.Err().Error()}
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, u string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = s.warmOne(ctx, u)
+		}(i, u)
+	}
+	wg.Wait()
+
+	warmResult := &WarmResult{
+		Total:      len(urls),
+		Results:    results,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	for _, r := range results {
+		if r.Error == "" && r.StatusCode >= 200 && r.StatusCode < 400 {
+			warmResult.Succeeded++
+		} else {
+			warmResult.Failed++
+		}
+	}
+
+	log.Printf("[缓存预热] 共 %d 个 URL，成功 %d 个，失败 %d 个，耗时 %dms",
+		warmResult.Total, warmResult.Succeeded, warmResult.Failed, warmResult.DurationMs)
+	return warmResult, nil
+}
+
+// collectWarmURLs 汇总并去重需要预热的 URL
+func (s *WarmupService) collectWarmURLs(ctx context.Context, req *WarmRequest) ([]string, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultWarmLimit
+	}
+
+	seen := make(map[string]bool)
+	var urls []string
+	add := func(raw string) {
+		fullURL, err := s.resolveURL(raw)
+		if err != nil || seen[fullURL] || len(urls) >= limit {
+			return
+		}
+		seen[fullURL] = true
+		urls = append(urls, fullURL)
+	}
+
+	for _, u := range req.URLs {
+		add(u)
+	}
+
+	if req.UseSitemap {
+		urlSet, err := s.sitemapSvc.GenerateSitemap(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("生成站点地图失败: %w", err)
+		}
+		for _, u := range urlSet.URLs {
+			add(u.Location)
+		}
+	}
+
+	return urls, nil
+}
+
+// warmOne 请求单个 URL 以填充 CDN/浏览器侧缓存
+func (s *WarmupService) warmOne(ctx context.Context, target string) WarmURLResult {
+	result := WarmURLResult{URL: target}
+	start := time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	req.Header.Set("User-Agent", "Anheyu-Cache-Warmer/1.0")
+	req.Header.Set("Accept-Encoding", "gzip, br")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		result.Error = err.Error()
+		result.DurationMs = time.Since(start).Milliseconds()
+		return result
+	}
+	defer resp.Body.Close()
+	// 读完响应体，确保 CDN 完整缓存该资源
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	result.StatusCode = resp.StatusCode
+	result.DurationMs = time.Since(start).Milliseconds()
+	for _, h := range []string{"CF-Cache-Status", "X-Cache", "X-Cache-Status", "EO-Cache-Status"} {
+		if v := resp.Header.Get(h); v != "" {
+			result.CacheState = v
+			break
+		}
+	}
+	return result
+}
+
+// resolveURL 将站内路径转换为完整 URL，完整 URL 只允许本站域名
+func (s *WarmupService) resolveURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("URL 不能为空")
+	}
+
+	siteURL := strings.TrimSuffix(s.settingSvc.Get(constant.KeySiteURL.String()), "/")
+	if siteURL == "" {
+		return "", fmt.Errorf("未配置站点地址 (SITE_URL)，无法解析路径 %s", raw)
+	}
+
+	if strings.HasPrefix(raw, "http://") || strings.HasPrefix(raw, "https://") {
+		target, err := url.Parse(raw)
+		if err != nil {
+			return "", fmt.Errorf("无效的 URL %s: %w", raw, err)
+		}
+		site, err := url.Parse(siteURL)
+		if err != nil {
+			return "", fmt.Errorf("无效的站点地址: %w", err)
+		}
+		if !strings.EqualFold(target.Host, site.Host) {
+			return "", fmt.Errorf("URL %s 不属于本站", raw)
+		}
+		return raw, nil
+	}
+
+	if !strings.HasPrefix(raw, "/") {
+		raw = "/" + raw
+	}
+	return siteURL + raw, nil
+}
+
+// articleSlugFromPath 从 /posts/{slug} 路径或 URL 中提取文章 slug
+func articleSlugFromPath(p string) (string, bool) {
+	if u, err := url.Parse(p); err == nil && u.Path != "" {
+		p = u.Path
+	}
+	p = strings.TrimSuffix(p, "/")
+	if !strings.HasPrefix(p, "/posts/") {
+		return "", false
+	}
+	slug := strings.TrimPrefix(p, "/posts/")
+	if slug == "" || strings.Contains(slug, "/") {
+		return "", false
+	}
+	return slug, true
+}
@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 
 	"github.com/anzhiyu-c/anheyu-app/cmd/server"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/selfupdate"
 )
 
 //go:embed all:assets/dist
@@ -45,6 +46,24 @@ var content embed.FS
 // @externalDocs.description  OpenAPI
 // @externalDocs.url          https://swagger.io/resources/open-api/
 func main() {
+	// 迁移子命令只连接数据库，不能先初始化应用，否则启动过程会直接执行迁移
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := server.RunMigrateCommand(os.Args[2:]); err != nil {
+			log.Fatalf("命令执行失败: %v", err)
+		}
+		return
+	}
+
+	// 子命令：直接调用服务层完成管理操作后退出，不启动 HTTP 服务
+	if len(os.Args) > 1 {
+		if command, ok := subcommands[os.Args[1]]; ok {
+			runSubcommand(func(app *server.App) error {
+				return command(app, os.Args[2:])
+			})
+			return
+		}
+	}
+
 	// 解析命令行参数
 	var exportAssetsDir string
 	flag.StringVar(&exportAssetsDir, "export-assets", "", "导出静态资源到指定目录（用于自定义静态资源）")
@@ -60,9 +79,13 @@ func main() {
 		return
 	}
 
+	// 上一次自更新尚未确认成功时记录启动次数，新版本反复启动失败会在这里回滚到旧版本
+	selfupdate.RecoverOnBoot(selfupdate.DefaultStateDir)
+
 	// 调用位于 cmd/server 包中的 NewApp 函数来构建整个应用
 	app, cleanup, err := server.NewApp(content)
 	if err != nil {
+		selfupdate.AbortPendingUpdate(selfupdate.DefaultStateDir, err)
 		log.Fatalf("应用初始化失败: %v", err)
 	}
 
@@ -82,6 +105,28 @@ func main() {
 	}
 }
 
+// subcommands 可用的管理子命令，便于在 Web 后台无法访问时完成恢复操作
+var subcommands = map[string]func(app *server.App, args []string) error{
+	"cache":  server.RunCacheCommand,
+	"theme":  server.RunThemeCommand,
+	"user":   server.RunUserCommand,
+	"backup": server.RunBackupCommand,
+}
+
+// runSubcommand 初始化应用后执行子命令
+func runSubcommand(fn func(app *server.App) error) {
+	app, cleanup, err := server.NewApp(content)
+	if err != nil {
+		log.Fatalf("应用初始化失败: %v", err)
+	}
+	defer cleanup()
+
+	if err := fn(app); err != nil {
+		cleanup()
+		log.Fatalf("命令执行失败: %v", err)
+	}
+}
+
 // exportAssets 将嵌入的静态资源导出到指定目录
 func exportAssets(outputDir string) error {
 	// 创建输出目录
@@ -10,9 +10,12 @@ package config
 import (
 	"fmt"
 	"log"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/go-ini/ini"
 	"github.com/spf13/viper"
@@ -20,11 +23,22 @@ import (
 
 // 定义所有已知的配置键
 var allKeys = []string{
-	KeyServerPort, KeyServerDebug,
+	KeyServerPort, KeyServerDebug, KeyServerSocket, KeyServerSocketMode,
 	KeyDBType, KeyDBHost, KeyDBPort, KeyDBUser, KeyDBPassword, KeyDBName, KeyDBDebug,
+	KeyDBSkipMigrateBackup, KeyDBAllowDowngrade,
+	KeyDBSQLiteJournalMode, KeyDBSQLiteSynchronous, KeyDBSQLiteBusyTimeout,
+	KeyDBMaxOpenConns, KeyDBMaxIdleConns, KeyDBConnMaxLifetime, KeyDBConnMaxIdleTime,
 	KeyRedisAddr, KeyRedisPassword, KeyRedisDB,
+	KeyTLSEnable, KeyTLSDomains, KeyTLSEmail, KeyTLSHTTPSPort, KeyTLSCacheDir, KeyTLSRedirect,
+	KeyLogLevel, KeyCacheCleanupInterval, KeyReadOnly,
+	KeyDemoEnable,
+	KeyUpdateFeedURL, KeyUpdatePublicKey, KeyUpdateAllowUnsigned,
+	KeyClusterSyncMode, KeyClusterPollInterval,
 }
 
+// hotReloadKeys 重新加载配置时可以立即生效的配置键，其余配置键修改后需要重启
+var hotReloadKeys = []string{KeyLogLevel, KeyCacheCleanupInterval, KeyReadOnly}
+
 const (
 	KeyServerPort    = "System.Port"
 	KeyServerDebug   = "System.Debug"
@@ -38,16 +52,86 @@ const (
 	KeyRedisAddr     = "Redis.Addr"
 	KeyRedisPassword = "Redis.Password"
 	KeyRedisDB       = "Redis.DB"
+
+	// 表结构迁移：默认在变更表结构前导出数据库快照，并拒绝用旧版本程序启动新版本迁移过的数据库
+	KeyDBSkipMigrateBackup = "Database.SkipMigrateBackup" // 跳过迁移前的数据库快照（外部数据库未安装客户端工具时使用）
+	KeyDBAllowDowngrade    = "Database.AllowDowngrade"    // 允许旧版本程序启动，可能删除新版本添加的字段
+
+	// 连接池
+	KeyDBMaxOpenConns    = "Database.MaxOpenConns"    // 最大打开连接数，默认 100
+	KeyDBMaxIdleConns    = "Database.MaxIdleConns"    // 最大空闲连接数，默认 10
+	KeyDBConnMaxLifetime = "Database.ConnMaxLifetime" // 连接最长使用时间（秒），默认 3600，数据库或代理会主动断开长连接时调小
+	KeyDBConnMaxIdleTime = "Database.ConnMaxIdleTime" // 连接最长空闲时间（秒），默认 0 表示不限制
+
+	// SQLite 调优，仅在 Database.Type 为 sqlite 时生效
+	KeyDBSQLiteJournalMode = "Database.SQLiteJournalMode" // 日志模式，默认 WAL，读写互不阻塞
+	KeyDBSQLiteSynchronous = "Database.SQLiteSynchronous" // 同步级别，默认 NORMAL，WAL 模式下兼顾性能与安全
+	KeyDBSQLiteBusyTimeout = "Database.SQLiteBusyTimeout" // 数据库被锁定时的等待时间（毫秒），默认 60000
+
+	// Unix 域套接字监听，配置后不再监听 TCP 端口
+	KeyServerSocket     = "System.Socket"
+	KeyServerSocketMode = "System.SocketMode" // 套接字文件权限（八进制），默认 0660
+
+	// 内置 HTTPS（ACME 自动证书），修改后需重启
+	KeyTLSEnable    = "TLS.Enable"
+	KeyTLSDomains   = "TLS.Domains"
+	KeyTLSEmail     = "TLS.Email"
+	KeyTLSHTTPSPort = "TLS.HTTPSPort"
+	KeyTLSCacheDir  = "TLS.CacheDir"
+	KeyTLSRedirect  = "TLS.Redirect"
+
+	// 演示模式：禁止修改数据，页面显示演示横幅，并按计划任务恢复到启动时的数据
+	KeyDemoEnable = "Demo.Enable"
+
+	// 程序自更新
+	KeyUpdateFeedURL       = "Update.FeedURL"       // 发布信息地址，默认为 GitHub 最新发布，自定义地址需返回与 GitHub Releases API 相同的格式
+	KeyUpdatePublicKey     = "Update.PublicKey"     // 校验文件签名使用的 Ed25519 公钥（Base64），默认使用程序内置的项目公钥
+	KeyUpdateAllowUnsigned = "Update.AllowUnsigned" // 允许安装没有签名或无法校验签名的更新包，默认拒绝
+
+	// 多实例部署：一个实例保存的配置和切换的主题同步到其他实例的内存缓存
+	KeyClusterSyncMode     = "Cluster.SyncMode"     // 同步方式：auto（有 Redis 时使用 Redis 发布订阅）、redis、poll（轮询数据库版本）、off，默认 auto
+	KeyClusterPollInterval = "Cluster.PollInterval" // poll 方式下轮询数据库的间隔（秒），默认 5
+
+	// 以下配置支持通过 SIGHUP 或后台接口重新加载，无需重启
+	KeyLogLevel             = "Log.Level"             // 后台任务日志级别：debug、info、warn、error，默认 info
+	KeyCacheCleanupInterval = "Cache.CleanupInterval" // 内存缓存清理过期数据的间隔（秒），默认 60
+	KeyReadOnly             = "System.ReadOnly"       // 只读模式：拒绝修改数据的接口并暂停写数据的后台任务，启动时跳过数据库迁移，适用于备份、迁移或连接只读副本
 )
 
+// configFilePath 配置文件路径
+const configFilePath = "data/conf.ini"
+
+// LogLevel 后台任务等结构化日志共用的日志级别，随 Log.Level 配置变化
+var LogLevel = new(slog.LevelVar)
+
 type Config struct {
-	vp *viper.Viper
+	mu        sync.RWMutex
+	vp        *viper.Viper
+	reloadFns []func(c *Config)
+}
+
+// ReloadResult 重新加载配置的结果，只包含配置键名，不包含配置值
+type ReloadResult struct {
+	Changed         []string `json:"changed"`          // 发生变化的配置键
+	Applied         []string `json:"applied"`          // 已立即生效的配置键
+	RestartRequired []string `json:"restart_required"` // 需要重启才能生效的配置键
 }
 
 // NewConfig 是最终的构造函数，手动加载配置，确保可靠性
 func NewConfig() (*Config, error) {
+	vp, err := loadViper(configFilePath)
+	if err != nil {
+		return nil, err
+	}
+	log.Println("✅ 配置加载器初始化完成。")
+	c := &Config{vp: vp}
+	applyLogLevel(c.GetString(KeyLogLevel))
+	return c, nil
+}
+
+// loadViper 从配置文件和环境变量加载配置，环境变量优先
+func loadViper(filePath string) (*viper.Viper, error) {
 	vp := viper.New()
-	filePath := "data/conf.ini"
 
 	// --- 步骤 1: 使用 go-ini 从文件加载配置 (作为默认值) ---
 	iniCfg, err := ini.Load(filePath)
@@ -88,12 +172,9 @@ func NewConfig() (*Config, error) {
 	}
 
 	// --- 步骤 2: 手动检查并覆盖环境变量 ---
-	envReplacer := strings.NewReplacer(".", "_")
-	envPrefix := "ANHEYU"
-
 	for _, key := range allKeys {
 		// 构建环境变量名，例如 ANHEYU_DATABASE_HOST
-		envVarName := fmt.Sprintf("%s_%s", envPrefix, envReplacer.Replace(strings.ToUpper(key)))
+		envVarName := EnvName(key)
 
 		// 检查环境变量是否存在
 		if value, found := os.LookupEnv(envVarName); found {
@@ -103,22 +184,93 @@ func NewConfig() (*Config, error) {
 		}
 	}
 
-	log.Println("✅ 配置加载器初始化完成。")
-	return &Config{vp: vp}, nil
+	return vp, nil
+}
+
+// EnvName 返回覆盖配置键的环境变量名，例如 Database.Host 对应 ANHEYU_DATABASE_HOST
+func EnvName(key string) string {
+	return "ANHEYU_" + strings.ReplaceAll(strings.ToUpper(key), ".", "_")
+}
+
+// Keys 返回所有已知的配置键
+func Keys() []string {
+	return slices.Clone(allKeys)
 }
 
 func (c *Config) GetString(key string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.vp.GetString(key)
 }
 
 func (c *Config) GetInt(key string) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.vp.GetInt(key)
 }
 
 func (c *Config) GetBool(key string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.vp.GetBool(key)
 }
 
+// OnReload 注册重新加载配置后的回调，用于将可热更新的配置应用到运行中的组件
+func (c *Config) OnReload(fn func(c *Config)) {
+	c.mu.Lock()
+	c.reloadFns = append(c.reloadFns, fn)
+	c.mu.Unlock()
+}
+
+// Reload 重新读取配置文件和环境变量。
+// 可热更新的配置立即生效；其余配置即使发生变化也保持当前值，避免与已建立的数据库连接、监听地址等不一致，
+// 并在结果中标记为需要重启。配置文件格式错误时返回错误，当前配置保持不变。
+func (c *Config) Reload() (*ReloadResult, error) {
+	fresh, err := loadViper(configFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReloadResult{Changed: []string{}, Applied: []string{}, RestartRequired: []string{}}
+	c.mu.Lock()
+	for _, key := range allKeys {
+		if c.vp.GetString(key) == fresh.GetString(key) {
+			continue
+		}
+		result.Changed = append(result.Changed, key)
+		if slices.Contains(hotReloadKeys, key) {
+			c.vp.Set(key, fresh.GetString(key))
+			result.Applied = append(result.Applied, key)
+		} else {
+			result.RestartRequired = append(result.RestartRequired, key)
+		}
+	}
+	fns := slices.Clone(c.reloadFns)
+	c.mu.Unlock()
+
+	applyLogLevel(c.GetString(KeyLogLevel))
+	for _, fn := range fns {
+		fn(c)
+	}
+	log.Printf("配置已重新加载：变化 %d 项，立即生效 %d 项，需要重启 %d 项", len(result.Changed), len(result.Applied), len(result.RestartRequired))
+	if len(result.RestartRequired) > 0 {
+		log.Printf("以下配置需要重启后生效: %s", strings.Join(result.RestartRequired, ", "))
+	}
+	return result, nil
+}
+
+// applyLogLevel 设置结构化日志级别，未配置或无法识别时使用 info
+func applyLogLevel(value string) {
+	level := slog.LevelInfo
+	if value != "" {
+		if err := level.UnmarshalText([]byte(value)); err != nil {
+			log.Printf("警告: 无法识别的日志级别 %q，将使用 info", value)
+			level = slog.LevelInfo
+		}
+	}
+	LogLevel.Set(level)
+}
+
 // createDefaultConfigFile 创建默认的配置文件
 func createDefaultConfigFile(filePath string) error {
 	// 确保目录存在
@@ -131,6 +283,10 @@ func createDefaultConfigFile(filePath string) error {
 	defaultConfig := `[System]
 Port = 8091
 Debug = false
+# 与 Nginx/Caddy 同机部署时可改为监听 Unix 域套接字，配置后不再监听 Port
+# 由 systemd 套接字激活启动时自动使用 systemd 传入的套接字，忽略以上两项
+# Socket = /run/anheyu/anheyu.sock
+# SocketMode = 0660
 
 [Database]
 Type = sqlite
@@ -144,6 +300,34 @@ Debug = false
 Addr = 
 Password =
 DB = 0
+
+# 内置 HTTPS（可选）
+# 开启后通过 Let's Encrypt 自动申请和续期证书，无需额外的反向代理
+# 域名需解析到本机，HTTP-01 验证要求外网 80 端口可以访问到 System.Port，
+# 或者外网 443 端口可以访问到 HTTPSPort（TLS-ALPN-01 验证）
+[TLS]
+Enable = false
+Domains =
+Email =
+HTTPSPort = 443
+CacheDir = data/certs
+Redirect = true
+
+# 多实例部署（可选）
+# 一个实例保存的配置和切换的主题会同步到其他实例的内存缓存
+# SyncMode：auto（配置了 Redis 时通过 Redis 发布订阅同步）、redis、poll（无 Redis 时轮询数据库）、off
+[Cluster]
+SyncMode = auto
+PollInterval = 5
+
+# 以下配置修改后可以发送 SIGHUP 信号或在后台重新加载配置，无需重启
+[Log]
+# 后台任务日志级别：debug、info、warn、error
+Level = info
+
+[Cache]
+# 内存缓存清理过期数据的间隔（秒），仅在未配置 Redis 时使用
+CleanupInterval = 60
 `
 
 	// 写入文件
@@ -162,6 +162,7 @@ const (
 	KeyPostDefaultDoubleColumn   SettingKey = "post.default.double_column"
 	KeyPostDefaultPageSize       SettingKey = "post.default.page_size"
 	KeyPostExpirationTime        SettingKey = "post.expiration_time"
+	KeyPostLazyLoadSkipFirst     SettingKey = "post.lazyload.skip_first" // 文章正文中不做懒加载的前几张图片（首屏 LCP 图片）
 	Key404PageDefaultImage       SettingKey = "post.page404.default_image"
 	KeyPostRewardEnable          SettingKey = "post.reward.enable"
 	KeyPostRewardWeChatQR        SettingKey = "post.reward.wechat_qr"
@@ -185,6 +186,7 @@ const (
 
 	// 文章目录 Hash 更新配置
 	KeyPostTocHashUpdateMode SettingKey = "post.toc.hash_update_mode" // 目录滚动是否更新URL Hash: replace(启用), none(禁用)
+	KeyPostTocMaxDepth       SettingKey = "post.toc.max_depth"        // 服务端提取目录的标题层数
 
 	// 文章页面波浪区域配置
 	KeyPostWavesEnable SettingKey = "post.waves.enable" // 是否显示文章页面波浪区域
@@ -258,6 +260,10 @@ const (
 	KeyCommentMailSubjectAdmin  SettingKey = "comment.mail_subject_admin"
 	KeyCommentMailTemplateAdmin SettingKey = "comment.mail_template_admin"
 
+	// 评论隐私配置
+	KeyCommentLocationGranularity SettingKey = "comment.location_granularity" // 属地显示粒度：country / province / city / off
+	KeyCommentIPAnonymize         SettingKey = "comment.ip_anonymize"         // 存储前是否对评论 IP 做匿名化
+
 	// 侧边栏配置 ---
 	KeySidebarAuthorEnable           SettingKey = "sidebar.author.enable"
 	KeySidebarAuthorDescription      SettingKey = "sidebar.author.description"
@@ -361,6 +367,211 @@ const (
 	KeyCDNZoneID    SettingKey = "cdn.zone_id"
 	KeyCDNBaseURL   SettingKey = "cdn.base_url"
 
+	// --- 访问日志配置 ---
+	KeyAccessLogEnable          SettingKey = "access_log.enable"            // 是否记录访问日志
+	KeyAccessLogSlowThresholdMs SettingKey = "access_log.slow_threshold_ms" // 慢请求阈值（毫秒）
+
+	// --- 错误上报配置 ---
+	KeyErrorReportDSN         SettingKey = "error_report.dsn"         // Sentry 兼容的 DSN，留空则不上报
+	KeyErrorReportEnvironment SettingKey = "error_report.environment" // 上报时附带的环境名称
+
+	// --- 出站 HTTP 配置 ---
+	KeyOutboundProxy            SettingKey = "outbound.proxy"             // 外部请求使用的代理地址，留空使用环境变量，direct 表示直连
+	KeyOutboundNoProxy          SettingKey = "outbound.no_proxy"          // 不走代理的主机，逗号分隔
+	KeyOutboundCABundle         SettingKey = "outbound.ca_bundle"         // 额外信任的 CA 证书（PEM 内容或文件路径）
+	KeyOutboundServiceOverrides SettingKey = "outbound.service_overrides" // 按服务覆盖的出站配置（JSON）
+	KeyOutboundInstanceID       SettingKey = "outbound.instance_id"       // 外部请求携带的匿名实例 ID，留空不发送
+	KeyOutboundContactURL       SettingKey = "outbound.contact_url"       // 外部请求 User-Agent 中的联系地址
+
+	// --- 下载镜像配置 ---
+	KeyDownloadMirrors               SettingKey = "download.mirrors"                 // 主题包下载镜像改写规则（JSON 数组）
+	KeyDownloadMirrorRequireChecksum SettingKey = "download.mirror_require_checksum" // 未提供校验和时是否跳过镜像
+
+	// --- 定时任务配置 ---
+	KeyTaskSchedules SettingKey = "task.schedules" // 定时任务调度覆盖（JSON 对象，任务 key -> cron 表达式）
+
+	// --- 性能档位配置 ---
+	KeyPerformanceLowResourceMode   SettingKey = "performance.low_resource_mode"   // 低配模式，面向树莓派、单核 VPS 等设备
+	KeyPerformanceSSRMaxConcurrency SettingKey = "performance.ssr_max_concurrency" // 同时代理到 SSR 主题的请求数上限，0 表示不限制
+	KeyPerformanceSSRMaxPerIP       SettingKey = "performance.ssr_max_per_ip"      // 单个 IP 同时代理到 SSR 主题的请求数上限，0 表示不限制
+	KeyPerformanceSSRMaxPerRoute    SettingKey = "performance.ssr_max_per_route"   // 单个路由同时代理到 SSR 主题的请求数上限，0 表示不限制
+	KeyPerformanceSSRQueueTimeout   SettingKey = "performance.ssr_queue_timeout"   // 超过 SSR 并发上限时排队等待的最长秒数
+
+	// --- SSR 主题源码构建配置 ---
+	KeySSRBuildEnable      SettingKey = "ssr.build.enable"        // 安装只包含源码的 SSR 主题时是否在本机构建（以本程序的权限运行，不是沙箱）
+	KeySSRBuildTimeout     SettingKey = "ssr.build.timeout"       // 安装依赖和构建的总时长上限（秒）
+	KeySSRBuildMaxMemoryMB SettingKey = "ssr.build.max_memory_mb" // 构建时 Node.js 堆内存上限（MB）
+
+	// --- 主题存储配额配置 ---
+	KeyThemeStorageQuotaMB   SettingKey = "theme.storage.quota_mb"    // themes 和 backup 目录合计占用上限（MB），0 表示不限制
+	KeyThemeStorageMinFreeMB SettingKey = "theme.storage.min_free_mb" // 主题操作完成后磁盘至少保留的可用空间（MB）
+
+	// --- 主题切换后页面检查配置 ---
+	KeyThemeCanaryEnable  SettingKey = "theme.canary.enable"  // 切换主题后是否检查首页和文章页，不可用时自动回滚
+	KeyThemeCanaryTimeout SettingKey = "theme.canary.timeout" // 每个页面检查的最长等待时间（秒）
+
+	// --- 主题操作超时配置 ---
+	KeyThemeInstallTimeout SettingKey = "theme.operation.install_timeout" // 安装、上传主题的最长时间（秒），SSR 主题不含构建
+	KeyThemeSwitchTimeout  SettingKey = "theme.operation.switch_timeout"  // 切换主题的最长时间（秒），不含切换后的页面检查
+
+	// --- 外部主题文件访问策略 ---
+	KeyThemeFilesDeny        SettingKey = "theme.files.deny"         // 禁止访问的主题文件模式，逗号分隔
+	KeyThemeFilesAllow       SettingKey = "theme.files.allow"        // 不受禁止列表限制的主题文件模式，逗号分隔
+	KeyThemeFilesTrustedHTML SettingKey = "theme.files.trusted_html" // 按原样提供、不加沙箱的主题 HTML、SVG、XML 文件模式，逗号分隔
+	KeyThemeFilesHTMLCSP     SettingKey = "theme.files.html_csp"     // 非页面 HTML 及 SVG、XML 文件使用的 Content-Security-Policy
+
+	// --- 主题商城来源配置 ---
+	KeyThemeMarketSources SettingKey = "theme.market_sources" // 主题商城来源（JSON 数组），与官方商城合并展示
+
+	// --- 文章永久链接配置 ---
+	KeyPermalinkStrategy     SettingKey = "permalink.strategy"      // 新文章未填写 abbrlink 时的生成策略：abbrlink、date、title、pattern
+	KeyPermalinkPattern      SettingKey = "permalink.pattern"       // pattern 策略使用的模式
+	KeyPermalinkAutoRedirect SettingKey = "permalink.auto_redirect" // 文章永久链接变化时是否自动为旧地址添加 301 重定向
+
+	// --- 外部主题增量静态再生成配置 ---
+	KeyThemeISREnable     SettingKey = "theme.isr.enable"     // 是否缓存外部主题 Go 模板页面的渲染结果，内容变化时重新生成
+	KeyThemeISRRevalidate SettingKey = "theme.isr.revalidate" // 缓存页面的最长有效期（秒），0 表示只在内容变化时重新生成
+
+	// --- 主题静态资源 CDN 配置 ---
+	KeyThemeAssetCDNURL      SettingKey = "theme.asset_cdn.url"       // 主题静态资源的 CDN 地址，为空表示不使用 CDN
+	KeyThemeAssetCDNPolicyID SettingKey = "theme.asset_cdn.policy_id" // 推送资源的存储策略公共 ID，为空表示 CDN 回源到本站
+
+	// --- 站点所有权验证配置 ---
+	KeySiteVerifyGoogle      SettingKey = "site_verify.google"       // Google Search Console 验证码
+	KeySiteVerifyBing        SettingKey = "site_verify.bing"         // Bing Webmaster 验证码
+	KeySiteVerifyBaidu       SettingKey = "site_verify.baidu"        // 百度搜索资源平台验证码
+	KeySiteVerifyYandex      SettingKey = "site_verify.yandex"       // Yandex Webmaster 验证码
+	KeySiteVerifySecurityTxt SettingKey = "site_verify.security_txt" // /.well-known/security.txt 的内容
+	KeySiteVerifyIndexNowKey SettingKey = "site_verify.indexnow_key" // IndexNow 密钥，以 /{密钥}.txt 提供
+	KeySiteVerifyFiles       SettingKey = "site_verify.files"        // 其他验证文件，JSON 对象，键为路径，值为文件内容
+
+	// --- 站点图标配置 ---
+	KeySiteIconSet        SettingKey = "site_icon.set"              // 由 Logo 生成的全套图标（JSON），由系统维护
+	KeySiteIconBackground SettingKey = "site_icon.background_color" // apple-touch-icon 和可遮罩图标的背景色，也是 manifest 的 background_color
+
+	// --- 外链存档配置 ---
+	KeyLinkArchiveEnable    SettingKey = "link_archive.enable"     // 是否定时将文章中的外部链接提交存档
+	KeyLinkArchiveProvider  SettingKey = "link_archive.provider"   // 存档服务：wayback 或 custom
+	KeyLinkArchiveEndpoint  SettingKey = "link_archive.endpoint"   // 自定义存档服务地址，{url} 替换为待存档链接
+	KeyLinkArchiveExclude   SettingKey = "link_archive.exclude"    // 不需要存档的域名，逗号分隔
+	KeyLinkArchiveBatchSize SettingKey = "link_archive.batch_size" // 每次任务最多提交的链接数
+	KeyLinkArchiveScannedAt SettingKey = "link_archive.scanned_at" // 上次扫描文章的时间，由系统维护
+
+	// --- 自动摘要配置 ---
+	KeySummaryProvider      SettingKey = "summary.provider"        // 摘要生成方式：none / openai / local / extractive
+	KeySummaryEndpoint      SettingKey = "summary.endpoint"        // OpenAI 兼容接口或本地模型的地址
+	KeySummaryAPIKey        SettingKey = "summary.api_key"         // OpenAI 兼容接口的 API Key
+	KeySummaryModel         SettingKey = "summary.model"           // 使用的模型名称
+	KeySummaryOnSave        SettingKey = "summary.on_save"         // 保存未填写摘要的文章时是否自动生成
+	KeySummaryMaxLength     SettingKey = "summary.max_length"      // 摘要最大字数
+	KeySummaryMaxInputChars SettingKey = "summary.max_input_chars" // 发送给模型的正文最大字数
+	KeySummaryHourlyLimit   SettingKey = "summary.hourly_limit"    // 每小时最多调用模型的次数
+	KeySummaryFallback      SettingKey = "summary.fallback"        // 模型调用失败或超出限制时是否改用抽取式摘要
+
+	// --- 邮件发送配置 ---
+	KeyMailProvider      SettingKey = "mail.provider"       // 邮件发送方式：smtp / sendgrid / mailgun / resend
+	KeyMailAPIKey        SettingKey = "mail.api_key"        // SendGrid / Mailgun / Resend 的 API Key
+	KeyMailMailgunDomain SettingKey = "mail.mailgun_domain" // Mailgun 发信域名
+	KeyMailMailgunRegion SettingKey = "mail.mailgun_region" // Mailgun 区域：us / eu
+	KeyMailBrandLayout   SettingKey = "mail.brand_layout"   // 是否为邮件套用带站点标识的统一外框
+	KeyMailMaxAttempts   SettingKey = "mail.max_attempts"   // 队列中邮件的最大发送次数
+
+	// --- 评论服务端渲染配置 ---
+	KeyCommentSSREnable   SettingKey = "comment.ssr.enable"    // 文章页 SSR 时是否随 HTML 注入一页评论
+	KeyCommentSSRPageSize SettingKey = "comment.ssr.page_size" // SSR 注入的评论条数（根评论）
+
+	// --- 评论身份标识配置 ---
+	KeyCommentVerifiedBadgeLabel SettingKey = "comment.badge.verified_label" // 登录用户评论的徽章文字，留空不显示
+	KeyCommentCustomBadges       SettingKey = "comment.badge.custom"         // 按邮箱配置的自定义徽章（JSON 数组）
+	KeyAvatarProxyEnable         SettingKey = "avatar.proxy.enable"          // 是否通过本站代理并缓存评论者头像
+	KeyAvatarProxyCacheHours     SettingKey = "avatar.proxy.cache_hours"     // 头像本地缓存时长（小时）
+
+	// --- 第三方登录配置 ---
+	KeyOAuthProviders     SettingKey = "oauth.providers"      // 第三方登录提供方配置（JSON 数组）
+	KeyOAuthAllowRegister SettingKey = "oauth.allow_register" // 第三方登录时是否为未注册的邮箱创建账号
+
+	// --- 内容来源 Webhook 配置 ---
+	KeyWebhookSources SettingKey = "webhook.sources" // 内容来源 Webhook 配置（JSON 数组）
+
+	// --- Git 内容同步配置 ---
+	KeyGitSyncEnable     SettingKey = "gitsync.enable"       // 是否启用文章和页面与 Git 仓库双向同步
+	KeyGitSyncRepoURL    SettingKey = "gitsync.repo_url"     // 仓库地址
+	KeyGitSyncBranch     SettingKey = "gitsync.branch"       // 同步的分支
+	KeyGitSyncToken      SettingKey = "gitsync.token"        // HTTPS 仓库的访问令牌
+	KeyGitSyncPostsDir   SettingKey = "gitsync.posts_dir"    // 文章所在目录
+	KeyGitSyncPagesDir   SettingKey = "gitsync.pages_dir"    // 页面所在目录，留空不同步页面
+	KeyGitSyncPushOnSave SettingKey = "gitsync.push_on_save" // 保存文章或页面后是否自动同步
+	KeyGitSyncMapping    SettingKey = "gitsync.mapping"      // frontmatter 字段名映射（JSON 对象）
+	KeyGitSyncCommitter  SettingKey = "gitsync.committer"    // 提交作者，格式为 名称 <邮箱>
+
+	// --- 登录安全配置 ---
+	KeyLoginLockEnable      SettingKey = "login.lock.enable"               // 是否启用登录失败锁定
+	KeyLoginLockAccountMax  SettingKey = "login.lock.account_max_failures" // 单个账号允许的连续失败次数
+	KeyLoginLockIPMax       SettingKey = "login.lock.ip_max_failures"      // 单个 IP 允许的失败次数
+	KeyLoginLockWindow      SettingKey = "login.lock.window_minutes"       // 失败次数的统计窗口（分钟）
+	KeyLoginLockDuration    SettingKey = "login.lock.duration_minutes"     // 锁定时长（分钟）
+	KeyLoginCaptchaAfter    SettingKey = "login.captcha_after_failures"    // 失败多少次后要求图形验证码
+	KeyLoginNotifyNewDevice SettingKey = "login.notify_new_device"         // 新设备登录时是否发送邮件提醒
+
+	// --- 整站备份配置 ---
+	KeyInstanceBackupEnable         SettingKey = "backup.instance.enable"          // 是否启用整站定时备份
+	KeyInstanceBackupKeep           SettingKey = "backup.instance.keep"            // 本地保留的整站备份数量
+	KeyInstanceBackupIncludeUploads SettingKey = "backup.instance.include_uploads" // 是否包含本机存储的上传文件
+	KeyInstanceBackupPassphrase     SettingKey = "backup.instance.passphrase"      // 备份加密口令，为空则不加密
+	KeyInstanceBackupPolicyID       SettingKey = "backup.instance.policy_id"       // 备份完成后推送到的存储策略ID
+
+	// --- 精简阅读配置 ---
+	KeyArticleLiteEnable SettingKey = "post.lite.enable" // 是否提供 /posts/{slug}/lite 精简阅读页
+
+	// --- 资源本地化配置 ---
+	KeyResourceLocalizeEnable SettingKey = "resource.localize.enable" // 是否将主题引用的第三方 CDN 资源本地化
+	KeyResourceLocalizeHosts  SettingKey = "resource.localize.hosts"  // 需要本地化的资源域名，逗号分隔
+
+	// --- 自定义代码片段配置 ---
+	KeySnippets       SettingKey = "snippet.items"   // 自定义 CSS/JS 代码片段列表（JSON 数组）
+	KeySnippetHistory SettingKey = "snippet.history" // 代码片段历史版本（JSON 对象，片段 ID -> 版本列表）
+
+	// --- 表态配置 ---
+	KeyReactionAllowed SettingKey = "reaction.allowed" // 允许的表态，逗号分隔
+
+	// --- 站点功能开关 ---
+	KeyFeatureSearch    SettingKey = "feature.search"    // 是否启用站内搜索
+	KeyFeatureReactions SettingKey = "feature.reactions" // 是否启用文章和即刻表态
+
+	// --- 文章浏览量配置 ---
+	KeyArticleViewDebounceMinutes SettingKey = "post.view.debounce_minutes" // 同一访客重复浏览的去重窗口（分钟）
+
+	// --- 回收站配置 ---
+	KeyTrashRetentionDays SettingKey = "trash.retention_days" // 回收站内容保留天数，0 表示不自动清理
+
+	// --- 文章自定义字段配置 ---
+	KeyPostCustomFieldSchema SettingKey = "post.custom_fields.schema" // 文章自定义字段声明（JSON 数组）
+
+	// --- 首页布局配置 ---
+	KeyHomeLayout SettingKey = "home.layout" // 首页布局（精选文章、区块顺序，JSON）
+
+	// --- 文章作者配置 ---
+	KeyAuthorProfiles SettingKey = "author.profiles" // 作者资料列表（JSON 数组）
+
+	// --- 可信代理配置 ---
+	KeyTrustedProxies SettingKey = "server.trusted_proxies" // 可信反向代理 IP / CIDR 列表，逗号分隔
+
+	// --- 页面缓存策略配置 ---
+	KeyPageCacheEnable            SettingKey = "page_cache.enable"               // 是否为前台 HTML 页面启用缓存策略（关闭时页面不缓存）
+	KeyPageCacheCDNDetect         SettingKey = "page_cache.cdn_detect"           // 是否根据请求头识别 CDN 回源并下发 s-maxage
+	KeyPageCacheArticleMaxAge     SettingKey = "page_cache.article.max_age"      // 文章详情页浏览器缓存时间（秒）
+	KeyPageCacheArticleSMaxAge    SettingKey = "page_cache.article.s_maxage"     // 文章详情页 CDN 缓存时间（秒）
+	KeyPageCacheArticleSWR        SettingKey = "page_cache.article.swr"          // 文章详情页 stale-while-revalidate（秒）
+	KeyPageCacheHomeMaxAge        SettingKey = "page_cache.home.max_age"         // 首页浏览器缓存时间（秒）
+	KeyPageCacheHomeSMaxAge       SettingKey = "page_cache.home.s_maxage"        // 首页 CDN 缓存时间（秒）
+	KeyPageCacheHomeSWR           SettingKey = "page_cache.home.swr"             // 首页 stale-while-revalidate（秒）
+	KeyPageCacheStaticPageMaxAge  SettingKey = "page_cache.static_page.max_age"  // 自定义页面浏览器缓存时间（秒）
+	KeyPageCacheStaticPageSMaxAge SettingKey = "page_cache.static_page.s_maxage" // 自定义页面 CDN 缓存时间（秒）
+	KeyPageCacheStaticPageSWR     SettingKey = "page_cache.static_page.swr"      // 自定义页面 stale-while-revalidate（秒）
+	KeyPageCacheDefaultMaxAge     SettingKey = "page_cache.default.max_age"      // 其他页面浏览器缓存时间（秒）
+	KeyPageCacheDefaultSMaxAge    SettingKey = "page_cache.default.s_maxage"     // 其他页面 CDN 缓存时间（秒）
+	KeyPageCacheDefaultSWR        SettingKey = "page_cache.default.swr"          // 其他页面 stale-while-revalidate（秒）
+
 	// --- 相册页面配置 ---
 	KeyAlbumPageBannerBackground     SettingKey = "album.banner.background"
 	KeyAlbumPageBannerTitle          SettingKey = "album.banner.title"
@@ -383,6 +594,13 @@ const (
 	KeyWechatShareAppID     SettingKey = "wechat.share.app_id"     // 微信公众号 AppID
 	KeyWechatShareAppSecret SettingKey = "wechat.share.app_secret" // 微信公众号 AppSecret
 
+	// --- 微信文章二维码配置 ---
+	KeyWechatQRCodeEnable           SettingKey = "wechat.qrcode.enable"            // 是否启用文章微信二维码
+	KeyWechatQRCodeType             SettingKey = "wechat.qrcode.type"              // 二维码类型：miniprogram / official
+	KeyWechatMiniProgramAppID       SettingKey = "wechat.miniprogram.app_id"       // 微信小程序 AppID
+	KeyWechatMiniProgramAppSecret   SettingKey = "wechat.miniprogram.app_secret"   // 微信小程序 AppSecret
+	KeyWechatMiniProgramArticlePage SettingKey = "wechat.miniprogram.article_page" // 小程序中打开文章的页面路径
+
 	// --- Cloudflare Turnstile 人机验证配置 ---
 	KeyTurnstileEnable    SettingKey = "turnstile.enable"     // 是否启用 Turnstile 人机验证（已废弃，使用 captcha.provider）
 	KeyTurnstileSiteKey   SettingKey = "turnstile.site_key"   // Turnstile Site Key（公钥，前端使用）
@@ -14,10 +14,23 @@ import "time"
 // ArticleExtraConfig 文章扩展配置结构体
 // 用于存储各种可选功能配置，支持未来扩展
 type ArticleExtraConfig struct {
-	EnableAIPodcast bool `json:"enable_ai_podcast,omitempty"` // AI播客开关，默认 false
+	EnableAIPodcast    bool       `json:"enable_ai_podcast,omitempty"`    // AI播客开关，默认 false
+	DisableAutoSummary bool       `json:"disable_auto_summary,omitempty"` // 不在保存时自动生成摘要
+	Authors            []string   `json:"authors,omitempty"`              // 共同作者的 slug 列表，按署名顺序排列
+	Toc                []*TocItem `json:"-"`                              // 保存时由服务端从正文提取的目录，不接受客户端传入
+	// 自定义字段（Front-matter 风格的键值对），更新时不传表示保持不变，传 {} 清空
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
 	// 未来可扩展更多配置...
 }
 
+// TocItem 文章目录项
+type TocItem struct {
+	Level    int        `json:"level"`  // 标题级别 1-6
+	Text     string     `json:"text"`   // 标题文字
+	Anchor   string     `json:"anchor"` // 锚点，与正文中标题的 id 一致
+	Children []*TocItem `json:"children,omitempty"`
+}
+
 // --- 核心领域对象 (Domain Object) ---
 
 // Article 是文章的核心领域模型，业务逻辑（Service层）围绕它进行。
@@ -75,6 +88,9 @@ type Article struct {
 	DocSeriesID *uint      // 文档系列ID
 	DocSort     int        // 文档在系列中的排序
 	DocSeries   *DocSeries // 关联的文档系列信息
+
+	// --- 回收站 ---
+	DeletedAt *time.Time // 移入回收站的时间，未删除时为 nil
 }
 
 // --- API 数据传输对象 (Data Transfer Objects) ---
@@ -205,6 +221,16 @@ type ArticleResponse struct {
 	DocSeriesID string             `json:"doc_series_id,omitempty"` // 文档系列ID (公共ID)
 	DocSort     int                `json:"doc_sort,omitempty"`      // 文档在系列中的排序
 	DocSeries   *DocSeriesResponse `json:"doc_series,omitempty"`    // 关联的文档系列信息
+	// 作者资料（按署名顺序，未设置共同作者时为空）
+	Authors []AuthorProfile `json:"authors,omitempty"`
+	// 表态计数（仅文章详情返回）
+	Reactions map[string]int `json:"reactions,omitempty"`
+	// 文章目录（仅返回正文时提供）
+	Toc []*TocItem `json:"toc,omitempty"`
+	// 自定义字段，未填写的已声明字段使用默认值
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	// 外部链接的存档地址，键为原链接（仅文章详情返回）
+	ArchivedLinks map[string]string `json:"archived_links,omitempty"`
 }
 
 // 用于上一篇/下一篇/相关文章的简化信息响应
@@ -226,6 +252,13 @@ type ArticleDetailResponse struct {
 	RelatedArticles []*SimpleArticleResponse `json:"related_articles"`
 }
 
+// ArticleViewResult 记录浏览后的文章浏览量
+type ArticleViewResult struct {
+	ID        string `json:"id"`
+	ViewCount int    `json:"view_count"`
+	Counted   bool   `json:"counted"` // 本次浏览是否被计入（爬虫或去重窗口内的重复浏览不计入）
+}
+
 // ArticleListResponse 定义了文章列表的 API 响应结构
 type ArticleListResponse struct {
 	List     []ArticleResponse `json:"list"`
@@ -251,6 +284,9 @@ type ListPublicArticlesOptions struct {
 	Year         int    `json:"year"`
 	Month        int    `json:"month"`
 	WithContent  bool   // 是否包含 ContentMd 字段（用于知识库同步等场景）
+	Author       string // 按作者 slug 过滤（作者归档页）
+	AuthorUserID *uint  // 作者关联的系统用户，其发布的文章同样计入作者归档
+	PinnedOnly   bool   // 只返回置顶文章（pin_sort > 0）
 }
 
 type SiteStats struct {
@@ -305,6 +341,14 @@ type UpdateArticleComputedParams struct {
 	ContentHTML          string
 }
 
+// ArticleDerivedData 批量重新生成的派生数据，为 nil 的字段保持不变。
+type ArticleDerivedData struct {
+	WordCount   *int
+	ReadingTime *int
+	ContentHTML *string
+	ExtraConfig *ArticleExtraConfig
+}
+
 // CreateArticleParams 封装了创建文章时需要持久化的所有数据。
 type CreateArticleParams struct {
 	Title                string
@@ -364,3 +408,31 @@ type ArchiveItem struct {
 type ArchiveSummaryResponse struct {
 	List []*ArchiveItem `json:"list"`
 }
+
+// CalendarArticle 内容日历中的一篇文章
+type CalendarArticle struct {
+	ID       string    `json:"id"`
+	Title    string    `json:"title"`
+	Status   string    `json:"status"` // PUBLISHED 或 SCHEDULED
+	Time     time.Time `json:"time"`   // 已发布文章为发布时间，定时文章为计划发布时间
+	Abbrlink string    `json:"abbrlink,omitempty"`
+	CoverURL string    `json:"cover_url,omitempty"`
+	OwnerID  uint      `json:"owner_id"`
+}
+
+// CalendarDay 内容日历中的一天
+type CalendarDay struct {
+	Date           string             `json:"date"` // YYYY-MM-DD
+	PublishedCount int                `json:"published_count"`
+	ScheduledCount int                `json:"scheduled_count"`
+	Items          []*CalendarArticle `json:"items"`
+}
+
+// ArticleCalendarResponse 内容日历的响应，只包含有文章的日期，按日期升序排列
+type ArticleCalendarResponse struct {
+	Start          string         `json:"start"` // 起始月份 YYYY-MM
+	End            string         `json:"end"`   // 结束月份 YYYY-MM（含）
+	PublishedTotal int            `json:"published_total"`
+	ScheduledTotal int            `json:"scheduled_total"`
+	Days           []*CalendarDay `json:"days"`
+}
@@ -0,0 +1,17 @@
+/*
+ * @Description: 文章作者领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package model
+
+// AuthorProfile 作者资料，保存在 author.profiles 配置项中
+type AuthorProfile struct {
+	Slug    string            `json:"slug"`              // 作者标识，用于作者页地址和文章关联
+	Name    string            `json:"name"`              // 显示名称
+	Bio     string            `json:"bio,omitempty"`     // 简介
+	Avatar  string            `json:"avatar,omitempty"`  // 头像地址
+	Website string            `json:"website,omitempty"` // 个人主页
+	Socials map[string]string `json:"socials,omitempty"` // 社交账号，如 {"github": "https://github.com/xxx"}
+	UserID  string            `json:"user_id,omitempty"` // 关联的系统用户公共ID，该用户发布的文章同样归入此作者
+}
@@ -90,6 +90,9 @@ type File struct {
 	// Metas 用于在业务逻辑中持有从 metadata 表加载的数据。
 	// 此字段没有任何标签，它只存在于内存中的领域对象上。
 	Metas map[string]string
+
+	// DeletedAt 软删除时间，未删除时为 nil
+	DeletedAt *time.Time
 }
 
 // --- 文件列表及详情相关的模型 ---
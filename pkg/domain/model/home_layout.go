@@ -0,0 +1,42 @@
+package model
+
+// 首页区块类型
+const (
+	HomeSectionFeatured = "featured" // 精选轮播，优先使用 FeaturedIDs，未配置时使用首页推荐文章
+	HomeSectionPinned   = "pinned"   // 置顶文章
+	HomeSectionRecent   = "recent"   // 最新文章
+	HomeSectionCategory = "category" // 指定分类的文章
+	HomeSectionTag      = "tag"      // 指定标签的文章
+)
+
+// HomeSection 首页区块配置
+type HomeSection struct {
+	Type    string `json:"type"`
+	Title   string `json:"title,omitempty"`
+	Enabled bool   `json:"enabled"`
+	Limit   int    `json:"limit,omitempty"`
+	Value   string `json:"value,omitempty"` // category / tag 区块对应的分类或标签名称
+}
+
+// HomeLayout 首页布局配置，Sections 的顺序即首页区块的展示顺序
+type HomeLayout struct {
+	FeaturedIDs []string      `json:"featured_ids"`
+	Sections    []HomeSection `json:"sections"`
+}
+
+// HomeSectionData 首页区块及其文章
+type HomeSectionData struct {
+	HomeSection
+	Articles []ArticleResponse `json:"articles"`
+}
+
+// HomePageData 首页数据，只包含启用的区块
+type HomePageData struct {
+	Sections []HomeSectionData `json:"sections"`
+}
+
+// SetPinRequest 设置文章置顶请求
+type SetPinRequest struct {
+	// PinSort 置顶权重，大于 0 表示置顶，数值越大越靠前，0 表示取消置顶
+	PinSort int `json:"pin_sort"`
+}
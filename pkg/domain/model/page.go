@@ -17,6 +17,8 @@ type Page struct {
 	Sort            int       `json:"sort"`             // 排序
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
+	// DeletedAt 移入回收站的时间，未删除时为 nil
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 // CreatePageOptions 创建页面选项
@@ -50,3 +52,14 @@ type ListPagesOptions struct {
 	Search      string `json:"search,omitempty"`
 	IsPublished *bool  `json:"is_published,omitempty"`
 }
+
+// PageDataBinding 自定义页面的服务端数据绑定，保存在 page 表的 data_bindings 字段
+type PageDataBinding struct {
+	Name       string `json:"name"`                  // initialData.bindings 中的键
+	Type       string `json:"type"`                  // posts、tag_cloud 或 category_tree
+	Category   string `json:"category,omitempty"`    // 按分类名称过滤文章
+	Tag        string `json:"tag,omitempty"`         // 按标签名称过滤文章
+	Author     string `json:"author,omitempty"`      // 按作者 slug 过滤文章
+	PinnedOnly bool   `json:"pinned_only,omitempty"` // 只返回置顶文章
+	Limit      int    `json:"limit,omitempty"`       // 文章数量，默认 10
+}
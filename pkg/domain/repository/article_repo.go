@@ -34,17 +34,29 @@ type ArticleRepository interface {
 		computed *model.UpdateArticleComputedParams,
 	) (*model.Article, error)
 
-	// Delete 方法根据公共ID软删除一篇文章。
+	// Delete 方法根据公共ID软删除一篇文章（移入回收站）。
 	Delete(ctx context.Context, publicID string) error
 
+	// ListDeleted 获取回收站中的文章（包括标签和分类），按删除时间从新到旧排列。
+	ListDeleted(ctx context.Context) ([]*model.Article, error)
+
+	// GetDeletedByID 根据公共ID获取回收站中的文章（包括标签和分类）。
+	GetDeletedByID(ctx context.Context, publicID string) (*model.Article, error)
+
+	// Restore 将回收站中的文章恢复为正常状态。
+	Restore(ctx context.Context, publicID string) error
+
+	// Purge 彻底删除回收站中的文章。
+	Purge(ctx context.Context, publicID string) error
+
 	// List 方法根据提供的选项，分页查询文章列表。
 	List(ctx context.Context, options *model.ListArticlesOptions) ([]*model.Article, int, error)
 
 	// GetRandom 获取一篇随机文章 (用于“随便逛逛”功能)。
 	GetRandom(ctx context.Context) (*model.Article, error)
 
-	// ListHome 获取首页推荐文章列表。
-	ListHome(ctx context.Context) ([]*model.Article, error)
+	// ListHome 获取首页推荐文章列表，最多返回 limit 篇。
+	ListHome(ctx context.Context, limit int) ([]*model.Article, error)
 
 	// ListPublic 根据选项获取公开的文章列表，通常用于前端展示。
 	ListPublic(ctx context.Context, options *model.ListPublicArticlesOptions) ([]*model.Article, int, error)
@@ -55,6 +67,15 @@ type ArticleRepository interface {
 	// IncrementViewCount 增加文章的查看次数。
 	IncrementViewCount(ctx context.Context, publicID string) error
 
+	// UpdatePinSort 更新文章的置顶权重，0 表示取消置顶。
+	UpdatePinSort(ctx context.Context, publicID string, pinSort int) error
+
+	// UpdateSummaries 更新文章摘要（自动生成摘要时使用），不修改更新时间。
+	UpdateSummaries(ctx context.Context, publicID string, summaries []string) error
+
+	// UpdateDerivedData 更新字数、阅读时长、目录等派生数据（批量重新生成时使用），不修改更新时间。
+	UpdateDerivedData(ctx context.Context, publicID string, data *model.ArticleDerivedData) error
+
 	// UpdateViewCounts 批量更新文章的浏览量。
 	UpdateViewCounts(ctx context.Context, updates map[uint]int) error
 
@@ -83,6 +104,10 @@ type ArticleRepository interface {
 	// 返回状态为 SCHEDULED 且 scheduled_at <= now 的文章列表
 	FindScheduledArticlesToPublish(ctx context.Context, now time.Time) ([]*model.Article, error)
 
+	// ListForCalendar 查询日历视图中的文章（不含正文）
+	// 返回 created_at 在 [start, end) 内的已发布文章和 scheduled_at 在 [start, end) 内的定时文章，authorID 不为空时只返回该作者的文章
+	ListForCalendar(ctx context.Context, start, end time.Time, authorID *uint) ([]*model.Article, error)
+
 	// PublishScheduledArticle 发布一篇定时文章
 	// 将文章状态从 SCHEDULED 改为 PUBLISHED，并更新 created_at 为 scheduled_at
 	PublishScheduledArticle(ctx context.Context, articleID uint) error
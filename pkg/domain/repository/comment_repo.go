@@ -47,6 +47,7 @@ type AdminListParams struct {
 	Content    *string
 	TargetPath *string
 	Status     *int
+	Keyset     KeysetQuery // 启用时按 (created_at, id) 倒序分页，不再置顶优先
 }
 
 // UpdateCommentInfoParams 定义了更新评论信息的参数
@@ -59,6 +60,13 @@ type UpdateCommentInfoParams struct {
 	Website     *string // 用户网站
 }
 
+// CommentIPRecord 评论 ID 与其存储的 IP 地址和属地，用于批量处理历史评论的隐私数据
+type CommentIPRecord struct {
+	ID         uint
+	IPAddress  string
+	IPLocation string
+}
+
 // CommentRepository 定义了评论数据的持久化操作接口。
 type CommentRepository interface {
 	// 创建一条新评论
@@ -110,4 +118,10 @@ type CommentRepository interface {
 
 	// 批量统计多个文章的评论数量
 	CountByTargetPaths(ctx context.Context, targetPaths []string) (map[string]int, error)
+
+	// ListIPAddresses 按 ID 升序返回 afterID 之后的评论 IP 和属地记录（包含已软删除的评论）
+	ListIPAddresses(ctx context.Context, afterID uint, limit int) ([]*CommentIPRecord, error)
+
+	// UpdateIPAddress 更新评论存储的 IP 地址和属地
+	UpdateIPAddress(ctx context.Context, id uint, ip, location string) error
 }
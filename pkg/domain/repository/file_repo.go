@@ -118,4 +118,19 @@ type FileRepository interface {
 
 	// SoftDeleteByOwnerID 软删除指定用户的所有文件
 	SoftDeleteByOwnerID(ctx context.Context, ownerID uint) error
+
+	// ListMediaFiles 跨目录分页列出所有用户的普通文件（不含目录，默认不含已删除文件），按创建时间倒序，供媒体库使用。
+	// 返回的文件已加载 PrimaryEntity。
+	ListMediaFiles(ctx context.Context, options *MediaListOptions) ([]*model.File, int64, error)
+}
+
+// MediaListOptions 媒体库文件列表的查询条件
+type MediaListOptions struct {
+	PageQuery
+	Keyword    string // 按文件名模糊搜索，不区分大小写
+	OwnerID    uint   // 按所有者过滤，0 表示不过滤
+	MimePrefix string // 按 MIME 类型前缀过滤，例如 "image/"
+	PolicyID   uint   // 按主体文件所在的存储策略过滤，0 表示不过滤
+	Deleted    bool   // 为 true 时只列出已软删除的文件
+	Keyset     KeysetQuery
 }
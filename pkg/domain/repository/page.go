@@ -26,6 +26,15 @@ type PageRepository interface {
 	// Delete 删除页面
 	Delete(ctx context.Context, id string) error
 
-	// ExistsByPath 检查路径是否存在
+	// ListDeleted 获取回收站中的页面，按删除时间从新到旧排列
+	ListDeleted(ctx context.Context) ([]*model.Page, error)
+
+	// Restore 将回收站中的页面恢复为正常状态
+	Restore(ctx context.Context, id string) error
+
+	// Purge 彻底删除回收站中的页面
+	Purge(ctx context.Context, id string) error
+
+	// ExistsByPath 检查路径是否存在（包括回收站中的页面）
 	ExistsByPath(ctx context.Context, path string, excludeID string) (bool, error)
 }
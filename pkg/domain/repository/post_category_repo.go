@@ -22,6 +22,7 @@ type PostCategoryRepository interface {
 	GetByID(ctx context.Context, id string) (*model.PostCategory, error)
 	UpdateCount(ctx context.Context, incIDs, decIDs []uint) error
 	DeleteIfUnused(ctx context.Context, ids []uint) error
+	Restore(ctx context.Context, ids []uint) error // 恢复因文章移入回收站而被软删除的分类
 	FindAnySeries(ctx context.Context, ids []uint) (bool, error)
 	ExistsByName(ctx context.Context, name string) (bool, error)
 }
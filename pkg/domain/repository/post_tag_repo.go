@@ -22,5 +22,6 @@ type PostTagRepository interface {
 	GetByID(ctx context.Context, id string) (*model.PostTag, error)
 	UpdateCount(ctx context.Context, incIDs, decIDs []uint) error
 	DeleteIfUnused(ctx context.Context, ids []uint) error
+	Restore(ctx context.Context, ids []uint) error // 恢复因文章移入回收站而被软删除的标签
 	ExistsByName(ctx context.Context, name string) (bool, error)
 }
@@ -7,6 +7,8 @@
  */
 package repository
 
+import "time"
+
 // PageQuery 包含了所有列表查询都通用的分页参数。
 // 任何需要分页的查询选项结构体都可以嵌入它。
 type PageQuery struct {
@@ -14,6 +16,14 @@ type PageQuery struct {
 	PageSize int `form:"pageSize" json:"pageSize"`
 }
 
+// KeysetQuery 按 (created_at, id) 倒序的键集分页参数，Enabled 为 true 时忽略页码。
+// After 为 nil 时从第一条开始，否则只返回排在 (After, AfterID) 之后的记录
+type KeysetQuery struct {
+	Enabled bool
+	After   *time.Time
+	AfterID uint
+}
+
 // PageResult 包含了所有分页查询返回的通用结构。
 // T 代表返回的实体类型列表，可以是 []*model.User, []*model.Album 等。
 type PageResult[T any] struct {
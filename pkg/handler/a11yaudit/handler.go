@@ -0,0 +1,63 @@
+/*
+ * @Description: 页面无障碍检查 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package a11yaudit
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/a11yaudit"
+)
+
+// Handler 无障碍检查 handler
+type Handler struct {
+	svc *a11yaudit.Service
+}
+
+// NewHandler 创建无障碍检查 handler
+func NewHandler(svc *a11yaudit.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// AuditRequest 无障碍检查请求
+type AuditRequest struct {
+	Route string `json:"route" binding:"required"`
+}
+
+// AuditPage 检查页面无障碍问题
+// @Summary      检查页面无障碍问题
+// @Description  通过实际的渲染流程渲染指定前台路由，检查图片 alt、标题层级、表单标签，并根据主题配置的颜色给出对比度警告
+// @Tags         无障碍
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  AuditRequest  true  "要检查的路由，如 /posts/hello"
+// @Success      200  {object}  response.Response{data=a11yaudit.Report}  "检查完成"
+// @Failure      400  {object}  response.Response  "路由不合法"
+// @Router       /admin/a11y/audit [post]
+func (h *Handler) AuditPage(c *gin.Context) {
+	var req AuditRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	report, err := h.svc.Audit(c.Request.Context(), req.Route)
+	if err != nil {
+		switch {
+		case errors.Is(err, a11yaudit.ErrInvalidRoute):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, a11yaudit.ErrNotReady):
+			response.Fail(c, http.StatusServiceUnavailable, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+	response.Success(c, report, "无障碍检查完成")
+}
@@ -0,0 +1,115 @@
+/*
+ * @Description: 访问日志查询 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package accesslog
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/persistence/database"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/perfprofile"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/accesslog"
+)
+
+// Handler 访问日志 handler
+type Handler struct {
+	store *accesslog.Store
+}
+
+// NewHandler 创建访问日志 handler
+func NewHandler(store *accesslog.Store) *Handler {
+	return &Handler{store: store}
+}
+
+// List 获取访问日志
+// @Summary      获取访问日志
+// @Description  按时间倒序返回最近的访问日志，慢请求附带数据库查询次数和外部调用详情
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        slow         query  bool    false  "是否只返回慢请求"
+// @Param        upstream     query  string  false  "上游类型: api/ssr/static"
+// @Param        path_prefix  query  string  false  "路径前缀"
+// @Param        min_status   query  int     false  "最小状态码，如 500"
+// @Param        limit        query  int     false  "返回条数，默认 100"
+// @Success      200  {object}  response.Response{data=[]accesslog.Entry}  "获取成功"
+// @Router       /admin/access-logs [get]
+func (h *Handler) List(c *gin.Context) {
+	minStatus, _ := strconv.Atoi(c.Query("min_status"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	entries := h.store.List(accesslog.Filter{
+		SlowOnly:   c.Query("slow") == "true",
+		Upstream:   c.Query("upstream"),
+		PathPrefix: c.Query("path_prefix"),
+		MinStatus:  minStatus,
+		Limit:      limit,
+	})
+	response.Success(c, entries, "获取访问日志成功")
+}
+
+// Clear 清空访问日志
+// @Summary      清空访问日志
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response  "清空成功"
+// @Router       /admin/access-logs [delete]
+func (h *Handler) Clear(c *gin.Context) {
+	h.store.Clear()
+	response.Success(c, nil, "访问日志已清空")
+}
+
+// UpstreamMetrics 获取外部依赖的熔断状态与调用统计
+// @Summary      获取上游接口状态
+// @Description  按主机返回主题商城、IP 属地、微信等外部接口的熔断状态、请求数、失败数和平均耗时
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]httpclient.HostMetrics}  "获取成功"
+// @Router       /admin/access-logs/upstreams [get]
+func (h *Handler) UpstreamMetrics(c *gin.Context) {
+	response.Success(c, httpclient.Metrics(), "获取上游接口状态成功")
+}
+
+// SSRMetrics 获取 SSR 代理的并发限制状态
+// @Summary      获取 SSR 并发限制状态
+// @Description  返回 SSR 代理当前的并发数、排队数、各类上限以及放行和拒绝的累计次数
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=perfprofile.SSRLimiterMetrics}  "获取成功"
+// @Router       /admin/access-logs/ssr [get]
+func (h *Handler) SSRMetrics(c *gin.Context) {
+	response.Success(c, perfprofile.SSRMetrics(), "获取 SSR 并发限制状态成功")
+}
+
+// DBMetrics 获取数据库连接池状态
+// @Summary      获取数据库连接池状态
+// @Description  返回连接池参数、打开/使用中/空闲连接数、使用率、等待统计以及瞬时错误重试次数
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=database.PoolStats}  "获取成功"
+// @Router       /admin/access-logs/db [get]
+func (h *Handler) DBMetrics(c *gin.Context) {
+	response.Success(c, database.PoolMetrics(), "获取数据库连接池状态成功")
+}
+
+// OutboundIdentity 获取外部请求携带的身份信息
+// @Summary      获取出站身份
+// @Description  返回主题商城、IP 属地、链接存档等外部请求使用的 User-Agent、版本号、匿名实例 ID 和联系地址，向上游服务反馈问题时可提供这些信息
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=httpclient.Identity}  "获取成功"
+// @Router       /admin/access-logs/identity [get]
+func (h *Handler) OutboundIdentity(c *gin.Context) {
+	response.Success(c, httpclient.CurrentIdentity(), "获取出站身份成功")
+}
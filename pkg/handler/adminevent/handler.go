@@ -0,0 +1,175 @@
+/*
+ * @Description: 管理后台实时事件 API（SSE 与长轮询）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package adminevent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/adminevent"
+)
+
+const (
+	// heartbeatInterval SSE 心跳间隔，防止反向代理因空闲断开连接
+	heartbeatInterval = 25 * time.Second
+	// defaultPollTimeout 长轮询默认等待时间
+	defaultPollTimeout = 25 * time.Second
+	// maxPollTimeout 长轮询最长等待时间
+	maxPollTimeout = 60 * time.Second
+)
+
+// Handler 管理后台事件 handler
+type Handler struct {
+	hub *adminevent.Hub
+}
+
+// NewHandler 创建管理后台事件 handler
+func NewHandler(hub *adminevent.Hub) *Handler {
+	return &Handler{hub: hub}
+}
+
+// PollResult 长轮询结果
+type PollResult struct {
+	Events []adminevent.Event `json:"events"`
+	LastID uint64             `json:"last_id"` // 下次请求作为 after 传回
+}
+
+// Stream 以 SSE 推送管理后台事件
+// @Summary      订阅管理后台事件（SSE）
+// @Description  以 text/event-stream 持续推送主题切换进度、SSR 进程状态、后台任务运行和新的系统通知。
+// @Description  每条消息的 event 为事件类型，id 为事件 ID；重连时携带 Last-Event-ID 请求头可补齐错过的事件
+// @Tags         管理后台事件
+// @Security     BearerAuth
+// @Produce      text/event-stream
+// @Param        types  query  string  false  "只订阅的事件类型或前缀，逗号分隔，如 ssr,task"
+// @Success      200  {string}  string  "事件流"
+// @Router       /admin/events [get]
+func (h *Handler) Stream(c *gin.Context) {
+	backlog, events, cancel := h.hub.Subscribe(lastEventID(c), parseTypes(c))
+	defer cancel()
+
+	w := c.Writer
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "retry: 3000\n\n")
+	for _, ev := range backlog {
+		writeEvent(w, ev)
+	}
+	w.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			writeEvent(w, ev)
+			w.Flush()
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": ping\n\n")
+			w.Flush()
+		}
+	}
+}
+
+// Poll 长轮询获取管理后台事件
+// @Summary      长轮询管理后台事件
+// @Description  返回 ID 大于 after 的事件；没有新事件时最多等待 timeout 秒。不传 after 时只返回当前最新的事件 ID
+// @Tags         管理后台事件
+// @Security     BearerAuth
+// @Produce      json
+// @Param        after    query  int     false  "上次收到的最后一个事件 ID"
+// @Param        timeout  query  int     false  "最长等待秒数，默认 25，最大 60"
+// @Param        types    query  string  false  "只订阅的事件类型或前缀，逗号分隔"
+// @Success      200  {object}  response.Response{data=PollResult}  "获取成功"
+// @Router       /admin/events/poll [get]
+func (h *Handler) Poll(c *gin.Context) {
+	rawAfter := c.Query("after")
+	if rawAfter == "" {
+		response.Success(c, PollResult{Events: []adminevent.Event{}, LastID: h.hub.LastID()}, "获取事件成功")
+		return
+	}
+	after, err := strconv.ParseUint(rawAfter, 10, 64)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "after 参数无效")
+		return
+	}
+	timeout := defaultPollTimeout
+	if secs, err := strconv.Atoi(c.Query("timeout")); err == nil && secs >= 0 {
+		timeout = time.Duration(secs) * time.Second
+	}
+	if timeout > maxPollTimeout {
+		timeout = maxPollTimeout
+	}
+
+	types := parseTypes(c)
+	backlog, events, cancel := h.hub.Subscribe(after, types)
+	defer cancel()
+	if len(backlog) == 0 && timeout > 0 {
+		ctx, stop := context.WithTimeout(c.Request.Context(), timeout)
+		defer stop()
+		select {
+		case ev, ok := <-events:
+			if ok {
+				backlog = append(backlog, ev)
+			}
+		case <-ctx.Done():
+		}
+	}
+
+	result := PollResult{Events: backlog, LastID: after}
+	if result.Events == nil {
+		result.Events = []adminevent.Event{}
+	}
+	if n := len(result.Events); n > 0 {
+		result.LastID = result.Events[n-1].ID
+	}
+	response.Success(c, result, "获取事件成功")
+}
+
+func writeEvent(w gin.ResponseWriter, ev adminevent.Event) {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.ID, ev.Type, data)
+}
+
+// lastEventID 断线重连时浏览器通过 Last-Event-ID 请求头带回最后收到的事件 ID
+func lastEventID(c *gin.Context) uint64 {
+	raw := c.GetHeader("Last-Event-ID")
+	if raw == "" {
+		raw = c.Query("last_event_id")
+	}
+	id, _ := strconv.ParseUint(raw, 10, 64)
+	return id
+}
+
+func parseTypes(c *gin.Context) []string {
+	var types []string
+	for _, t := range strings.Split(c.Query("types"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, t)
+		}
+	}
+	return types
+}
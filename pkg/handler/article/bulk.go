@@ -0,0 +1,78 @@
+package article
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	articleSvc "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+)
+
+// StartBulk
+// @Summary      提交文章批量操作
+// @Description  批量设置分类或标签（add 追加、remove 移除、replace 替换）、批量发布或转为草稿、批量移入回收站、批量重新生成摘要/字数和阅读时长/目录。
+// @Description  操作在后台执行，立即返回任务信息，可通过任务接口或管理后台事件流（article.bulk）查看进度和逐项结果
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  articleSvc.BulkRequest  true  "批量操作请求"
+// @Success      202 {object} response.Response{data=articleSvc.BulkJob} "任务已提交"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      429 {object} response.Response "排队中的批量任务过多"
+// @Router       /articles/bulk [post]
+func (h *Handler) StartBulk(c *gin.Context) {
+	var req articleSvc.BulkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	job, err := h.svc.StartBulk(c.Request.Context(), &req)
+	if err != nil {
+		if errors.Is(err, articleSvc.ErrInvalidBulkRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, articleSvc.ErrBulkQueueFull) {
+			response.Fail(c, http.StatusTooManyRequests, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "提交批量操作失败: "+err.Error())
+		return
+	}
+	response.SuccessWithStatus(c, http.StatusAccepted, job, "批量操作已提交")
+}
+
+// ListBulkJobs
+// @Summary      获取最近的文章批量任务
+// @Description  返回内存中保留的最近批量任务，从新到旧排列，不包含逐项结果
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=[]articleSvc.BulkJob} "获取成功"
+// @Router       /articles/bulk [get]
+func (h *Handler) ListBulkJobs(c *gin.Context) {
+	response.Success(c, h.svc.ListBulkJobs(), "获取成功")
+}
+
+// GetBulkJob
+// @Summary      获取文章批量任务
+// @Description  返回批量任务的进度和逐项结果，服务重启后任务记录会丢失
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        jobId  path  string  true  "任务ID"
+// @Success      200 {object} response.Response{data=articleSvc.BulkJob} "获取成功"
+// @Failure      404 {object} response.Response "任务不存在或已过期"
+// @Router       /articles/bulk/{jobId} [get]
+func (h *Handler) GetBulkJob(c *gin.Context) {
+	job, err := h.svc.GetBulkJob(c.Param("jobId"))
+	if err != nil {
+		response.Fail(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, job, "获取成功")
+}
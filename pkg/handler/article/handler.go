@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net"
 	"net/http"
 	"path/filepath"
 	"strconv"
@@ -13,12 +14,15 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/conditional"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/fieldset"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	articleSvc "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/summary"
 
 	"github.com/gin-gonic/gin"
 )
@@ -124,10 +128,18 @@ func (h *Handler) UploadImage(c *gin.Context) {
 // @Param        tag query string false "标签名称"
 // @Param        year query int false "年份"
 // @Param        month query int false "月份"
+// @Param        fields query string false "只返回指定的文章字段，逗号分隔，id 总是返回"
+// @Param        exclude query string false "不返回指定的文章字段，逗号分隔"
 // @Success      200 {object} response.Response{data=model.ArticleListResponse} "成功响应"
+// @Failure      400 {object} response.Response "字段参数错误"
 // @Failure      500 {object} response.Response "服务器内部错误"
 // @Router       /public/articles [get]
 func (h *Handler) ListPublic(c *gin.Context) {
+	projector, err := fieldset.FromQuery(c, model.ArticleResponse{}, "id")
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
 	year, _ := strconv.Atoi(c.Query("year"))
@@ -147,8 +159,11 @@ func (h *Handler) ListPublic(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, "获取文章列表失败: "+err.Error())
 		return
 	}
+	if conditional.ArticleList(c, result.List, result.Total).NotModified(c) {
+		return
+	}
 
-	response.Success(c, result, "获取列表成功")
+	response.Success(c, projector.ProjectList(result), "获取列表成功")
 }
 
 // ListArchives
@@ -165,6 +180,9 @@ func (h *Handler) ListArchives(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, "获取归档列表失败: "+err.Error())
 		return
 	}
+	if conditional.New("articles:archives").Scope(c.Request.Context(), conditional.ScopeArticles).AddJSON(archives).NotModified(c) {
+		return
+	}
 	response.Success(c, archives, "获取归档列表成功")
 }
 
@@ -249,6 +267,10 @@ func (h *Handler) Create(c *gin.Context) {
 	article, err := h.svc.Create(c.Request.Context(), &req, clientIP, referer)
 	if err != nil {
 		log.Printf("[Handler.Create] ❌ Service.Create 失败: %v", err)
+		if errors.Is(err, articleSvc.ErrInvalidCustomFields) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		response.Fail(c, http.StatusInternalServerError, "创建文章失败: "+err.Error())
 		return
 	}
@@ -262,16 +284,27 @@ func (h *Handler) Create(c *gin.Context) {
 // @Description  获取配置为在首页卡片中展示的文章列表 (按 home_sort 排序, 最多6篇)
 // @Tags         公开文章
 // @Produce      json
+// @Param        fields query string false "只返回指定的文章字段，逗号分隔，id 总是返回"
+// @Param        exclude query string false "不返回指定的文章字段，逗号分隔"
 // @Success      200 {object} response.Response{data=[]model.ArticleResponse} "成功响应"
+// @Failure      400 {object} response.Response "字段参数错误"
 // @Failure      500 {object} response.Response "服务器内部错误"
 // @Router       /public/articles/home [get]
 func (h *Handler) ListHome(c *gin.Context) {
+	projector, err := fieldset.FromQuery(c, model.ArticleResponse{}, "id")
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
 	articles, err := h.svc.ListHome(c.Request.Context())
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, "获取首页文章列表失败: "+err.Error())
 		return
 	}
-	response.Success(c, articles, "获取列表成功")
+	if conditional.ArticleList(c, articles, int64(len(articles))).NotModified(c) {
+		return
+	}
+	response.Success(c, projector.ProjectSlice(articles), "获取列表成功")
 }
 
 // GetPublic
@@ -300,9 +333,58 @@ func (h *Handler) GetPublic(c *gin.Context) {
 		return
 	}
 
+	// 浏览量只在这里和 POST /view 中记录，服务端渲染页面不再重复记录；计入时同步更新本次返回的浏览量
+	if ip, userAgent, ok := viewerIdentity(c); ok && h.svc.RecordView(c.Request.Context(), articleResponse.ID, ip, userAgent) {
+		articleResponse.ViewCount++
+	}
+
+	// 详情还包含上一篇/下一篇、表态计数等不会修改文章更新时间的数据，按完整内容计算 ETag
+	tag := conditional.New("article").AddJSON(articleResponse).Scope(c.Request.Context(), conditional.ScopeArticles).Touch(articleResponse.UpdatedAt)
+	if tag.NotModified(c) {
+		return
+	}
 	response.Success(c, articleResponse, "获取成功")
 }
 
+// RecordView
+// @Summary      记录文章浏览
+// @Description  记录一次文章浏览并返回最新浏览量。同一访客在去重窗口内重复浏览、爬虫访问不计入
+// @Tags         公开文章
+// @Produce      json
+// @Param        id path string true "文章的公共ID或Abbrlink"
+// @Success      200 {object} response.Response{data=model.ArticleViewResult} "成功响应"
+// @Failure      404 {object} response.Response "文章未找到"
+// @Router       /public/articles/{id}/view [post]
+func (h *Handler) RecordView(c *gin.Context) {
+	ip, userAgent, ok := viewerIdentity(c)
+	if !ok {
+		// 无法区分访客时按爬虫处理，只返回浏览量不计入
+		userAgent = ""
+	}
+	result, err := h.svc.RecordViewBySlugOrID(c.Request.Context(), c.Param("id"), ip, userAgent)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			response.Fail(c, http.StatusNotFound, "文章未找到")
+		} else {
+			response.Fail(c, http.StatusInternalServerError, "记录浏览失败: "+err.Error())
+		}
+		return
+	}
+	response.Success(c, result, "记录成功")
+}
+
+// viewerIdentity 返回用于浏览量去重的访客 IP 和 User-Agent，无法区分访客时 ok 为 false。
+// SSR 主题在服务端请求接口时直连对端是本机的 SSR 进程，访客 IP 取自其转发的 X-Real-IP 或 X-Forwarded-For
+// （SSR 代理已为每个页面请求设置 X-Real-IP）；没有转发访客 IP 的本机请求不计入浏览量，
+// 否则所有访客会被合并为同一个访客，在去重窗口内只计一次
+func viewerIdentity(c *gin.Context) (ip, userAgent string, ok bool) {
+	if remote := net.ParseIP(c.RemoteIP()); remote != nil && remote.IsLoopback() &&
+		c.GetHeader("X-Real-IP") == "" && c.GetHeader("X-Forwarded-For") == "" {
+		return "", "", false
+	}
+	return util.GetRealClientIP(c), c.Request.UserAgent(), true
+}
+
 // Get
 // @Summary      获取单篇文章
 // @Description  根据文章的公共ID获取详细信息
@@ -373,6 +455,10 @@ func (h *Handler) Update(c *gin.Context) {
 	article, err := h.svc.Update(c.Request.Context(), id, &req, clientIP, referer)
 	if err != nil {
 		log.Printf("[Handler.Update] ❌ Service.Update 失败: %v", err)
+		if errors.Is(err, articleSvc.ErrInvalidCustomFields) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		response.Fail(c, http.StatusInternalServerError, "更新文章失败: "+err.Error())
 		return
 	}
@@ -483,6 +569,135 @@ func (h *Handler) List(c *gin.Context) {
 	response.Success(c, result, "获取列表成功")
 }
 
+// GetCalendar
+// @Summary      获取内容日历
+// @Description  按天汇总月份区间内已发布（按发布时间）和定时发布（按计划发布时间）的文章，用于后台编辑日历；普通用户只统计自己的文章
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        start query string false "起始月份 YYYY-MM，默认当月"
+// @Param        end query string false "结束月份 YYYY-MM（含），默认与起始月份相同，最多 12 个月"
+// @Param        author_id query string false "作者ID（仅管理员可用，按作者过滤）"
+// @Success      200 {object} response.Response{data=model.ArticleCalendarResponse} "成功响应"
+// @Failure      400 {object} response.Response "参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /articles/calendar [get]
+func (h *Handler) GetCalendar(c *gin.Context) {
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "未登录")
+		return
+	}
+
+	var isAdmin bool
+	userGroupID, entityType, err := idgen.DecodePublicID(claims.UserGroupID)
+	if err == nil && entityType == idgen.EntityTypeUserGroup && userGroupID == 1 {
+		isAdmin = true
+	}
+
+	var authorID *uint
+	if isAdmin {
+		if authorIDStr := c.Query("author_id"); authorIDStr != "" {
+			if dbID, _, err := idgen.DecodePublicID(authorIDStr); err == nil {
+				authorID = &dbID
+			}
+		}
+	} else {
+		// 普通用户只能查看自己的文章
+		currentUserDBID, _, err := idgen.DecodePublicID(claims.UserID)
+		if err != nil {
+			response.Fail(c, http.StatusBadRequest, "用户ID解析失败")
+			return
+		}
+		authorID = &currentUserDBID
+	}
+
+	start := c.DefaultQuery("start", time.Now().Format("2006-01"))
+	end := c.DefaultQuery("end", start)
+	calendar, err := h.svc.GetCalendar(c.Request.Context(), start, end, authorID)
+	if err != nil {
+		if errors.Is(err, articleSvc.ErrInvalidCalendarRange) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "获取内容日历失败: "+err.Error())
+		return
+	}
+	response.Success(c, calendar, "获取内容日历成功")
+}
+
+// GenerateSummary
+// @Summary      生成文章摘要
+// @Description  按设置中的摘要生成方式（OpenAI 兼容接口、本地模型或抽取式）为文章生成摘要；save 为 true 时直接写入文章，否则只返回结果供编辑器填入。普通用户只能为自己的文章生成
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "文章的公共ID"
+// @Param        body body object{save=bool} false "是否写入文章"
+// @Success      200 {object} response.Response{data=summary.Result} "成功响应"
+// @Failure      400 {object} response.Response "未启用自动摘要或正文为空"
+// @Failure      403 {object} response.Response "权限不足"
+// @Failure      429 {object} response.Response "超出每小时调用次数限制"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /articles/{id}/summary [post]
+func (h *Handler) GenerateSummary(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		response.Fail(c, http.StatusBadRequest, "文章ID不能为空")
+		return
+	}
+	var req struct {
+		Save bool `json:"save"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+			return
+		}
+	}
+
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "未登录")
+		return
+	}
+	userGroupID, entityType, err := idgen.DecodePublicID(claims.UserGroupID)
+	if err != nil || entityType != idgen.EntityTypeUserGroup || userGroupID != 1 {
+		// 普通用户只能为自己的文章生成摘要
+		currentUserDBID, _, err := idgen.DecodePublicID(claims.UserID)
+		if err != nil {
+			response.Fail(c, http.StatusBadRequest, "用户ID解析失败")
+			return
+		}
+		ownerID, err := h.svc.GetArticleOwnerID(c.Request.Context(), id)
+		if err != nil {
+			response.Fail(c, http.StatusNotFound, "文章不存在")
+			return
+		}
+		if ownerID != currentUserDBID {
+			response.Fail(c, http.StatusForbidden, "您只能为自己的文章生成摘要")
+			return
+		}
+	}
+
+	result, err := h.svc.GenerateSummary(c.Request.Context(), id, req.Save)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			response.Fail(c, http.StatusNotFound, "文章不存在")
+		case errors.Is(err, summary.ErrDisabled), errors.Is(err, summary.ErrEmptyContent):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, summary.ErrRateLimited):
+			response.Fail(c, http.StatusTooManyRequests, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, "生成摘要失败: "+err.Error())
+		}
+		return
+	}
+	response.Success(c, result, "生成摘要成功")
+}
+
 // getClaims 从 gin.Context 中安全地提取 JWT Claims
 func getClaims(c *gin.Context) (*auth.CustomClaims, error) {
 	claimsValue, exists := c.Get(auth.ClaimsKey)
@@ -526,17 +741,19 @@ func (h *Handler) GetPrimaryColor(c *gin.Context) {
 	imageURL := req.ImageURL
 	if strings.HasPrefix(imageURL, "/") {
 		// 从请求中获取协议和主机
+		// 代理头只在请求来自可信代理时采信
+		fromTrustedProxy := util.IsFromTrustedProxy(c)
 		scheme := "https"
 		if c.Request.TLS == nil {
 			// 检查是否通过反向代理传递了协议信息
-			if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+			if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" && fromTrustedProxy {
 				scheme = proto
 			} else if c.Request.URL.Scheme != "" {
 				scheme = c.Request.URL.Scheme
 			}
 		}
 		host := c.Request.Host
-		if forwardedHost := c.GetHeader("X-Forwarded-Host"); forwardedHost != "" {
+		if forwardedHost := c.GetHeader("X-Forwarded-Host"); forwardedHost != "" && fromTrustedProxy {
 			host = forwardedHost
 		}
 		imageURL = scheme + "://" + host + imageURL
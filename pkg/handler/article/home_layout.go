@@ -0,0 +1,96 @@
+package article
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+)
+
+// SetPin
+// @Summary      设置文章置顶
+// @Description  设置文章的置顶权重，大于 0 表示置顶，数值越大越靠前，0 表示取消置顶
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path  string               true  "文章ID"
+// @Param        body  body  model.SetPinRequest  true  "置顶设置"
+// @Success      200 {object} response.Response{data=model.ArticleResponse} "设置成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "文章未找到"
+// @Router       /articles/{id}/pin [put]
+func (h *Handler) SetPin(c *gin.Context) {
+	var req model.SetPinRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	article, err := h.svc.SetPinSort(c.Request.Context(), c.Param("id"), req.PinSort)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			response.Fail(c, http.StatusNotFound, "文章未找到")
+			return
+		}
+		response.Fail(c, http.StatusBadRequest, "设置置顶失败: "+err.Error())
+		return
+	}
+	response.Success(c, article, "设置置顶成功")
+}
+
+// GetHomePage
+// @Summary      获取首页数据
+// @Description  按首页布局配置返回各启用区块及其文章，区块顺序即展示顺序
+// @Tags         公开文章
+// @Produce      json
+// @Success      200 {object} response.Response{data=model.HomePageData} "成功响应"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /public/home [get]
+func (h *Handler) GetHomePage(c *gin.Context) {
+	data, err := h.svc.GetHomePageData(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取首页数据失败: "+err.Error())
+		return
+	}
+	response.Success(c, data, "获取首页数据成功")
+}
+
+// GetHomeLayout
+// @Summary      获取首页布局配置
+// @Tags         公开文章
+// @Produce      json
+// @Success      200 {object} response.Response{data=model.HomeLayout} "成功响应"
+// @Router       /public/home/layout [get]
+func (h *Handler) GetHomeLayout(c *gin.Context) {
+	response.Success(c, h.svc.GetHomeLayout(c.Request.Context()), "获取首页布局成功")
+}
+
+// UpdateHomeLayout
+// @Summary      修改首页布局配置
+// @Description  保存精选文章和首页区块顺序，区块类型可选 featured、pinned、recent、category、tag
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  model.HomeLayout  true  "首页布局"
+// @Success      200 {object} response.Response{data=model.HomeLayout} "保存成功"
+// @Failure      400 {object} response.Response "配置无效"
+// @Router       /admin/home/layout [put]
+func (h *Handler) UpdateHomeLayout(c *gin.Context) {
+	var layout model.HomeLayout
+	if err := c.ShouldBindJSON(&layout); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	saved, err := h.svc.UpdateHomeLayout(c.Request.Context(), &layout)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, saved, "保存首页布局成功")
+}
@@ -0,0 +1,61 @@
+/*
+ * @Description: 主题静态资源 CDN API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package assetcdn
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/assetcdn"
+)
+
+// Handler 主题静态资源 CDN handler
+type Handler struct {
+	svc *assetcdn.Service
+}
+
+// NewHandler 创建主题静态资源 CDN handler
+func NewHandler(svc *assetcdn.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// GetStatus 获取资源 CDN 状态
+// @Summary      获取主题资源 CDN 状态
+// @Description  返回 CDN 地址、回源/推送模式、当前主题资源数、已同步资源数和最近一次同步结果
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=assetcdn.Status}  "获取成功"
+// @Router       /admin/theme/asset-cdn [get]
+func (h *Handler) GetStatus(c *gin.Context) {
+	response.Success(c, h.svc.Status(), "获取成功")
+}
+
+// Sync 立即同步主题资源
+// @Summary      同步主题资源到 CDN 存储
+// @Description  将当前主题的 /static/ 和 /assets/ 资源上传到 theme.asset_cdn.policy_id 指定的存储策略，内容未变化的资源跳过
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=assetcdn.SyncResult}  "同步完成"
+// @Failure      409  {object}  response.Response  "同步正在进行中"
+// @Failure      500  {object}  response.Response  "同步失败"
+// @Router       /admin/theme/asset-cdn/sync [post]
+func (h *Handler) Sync(c *gin.Context) {
+	result, err := h.svc.Sync(c.Request.Context(), "手动同步")
+	if err != nil {
+		if errors.Is(err, assetcdn.ErrSyncRunning) {
+			response.Fail(c, http.StatusConflict, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "同步失败: "+err.Error())
+		return
+	}
+	response.Success(c, result, "同步完成")
+}
@@ -1,36 +1,48 @@
 package auth_handler
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/captcha"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/imagecaptcha"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/loginguard"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/oauth"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	"github.com/gin-gonic/gin"
 )
 
 // AuthHandler 封装了所有认证相关的控制器方法
 type AuthHandler struct {
-	authSvc    auth.AuthService
-	tokenSvc   auth.TokenService
-	settingSvc setting.SettingService
-	captchaSvc captcha.CaptchaService
+	authSvc         auth.AuthService
+	tokenSvc        auth.TokenService
+	settingSvc      setting.SettingService
+	captchaSvc      captcha.CaptchaService
+	loginGuard      *loginguard.Service
+	imageCaptchaSvc imagecaptcha.ImageCaptchaService
+	oauthSvc        *oauth.Service
 }
 
 // NewAuthHandler 是 AuthHandler 的构造函数，用于依赖注入
-func NewAuthHandler(authSvc auth.AuthService, tokenSvc auth.TokenService, settingSvc setting.SettingService, captchaSvc captcha.CaptchaService) *AuthHandler {
+func NewAuthHandler(authSvc auth.AuthService, tokenSvc auth.TokenService, settingSvc setting.SettingService, captchaSvc captcha.CaptchaService, loginGuard *loginguard.Service, imageCaptchaSvc imagecaptcha.ImageCaptchaService, oauthSvc *oauth.Service) *AuthHandler {
 	return &AuthHandler{
-		authSvc:    authSvc,
-		tokenSvc:   tokenSvc,
-		settingSvc: settingSvc,
-		captchaSvc: captchaSvc,
+		authSvc:         authSvc,
+		tokenSvc:        tokenSvc,
+		settingSvc:      settingSvc,
+		captchaSvc:      captchaSvc,
+		loginGuard:      loginGuard,
+		imageCaptchaSvc: imageCaptchaSvc,
+		oauthSvc:        oauthSvc,
 	}
 }
 
@@ -50,8 +62,9 @@ type CaptchaParams struct {
 
 // LoginRequest 定义了登录请求的结构
 type LoginRequest struct {
-	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required"`
+	Email      string `json:"email" binding:"required,email"`
+	Password   string `json:"password" binding:"required"`
+	RememberMe bool   `json:"remember_me"` // 记住我：会话有效期 30 天，否则为 1 天
 	CaptchaParams
 }
 
@@ -121,7 +134,8 @@ type LoginUserInfoResponse struct {
 // @Param        body  body      LoginRequest  true  "登录信息"
 // @Success      200   {object}  response.Response{data=object{userInfo=LoginUserInfoResponse,roles=[]string,accessToken=string,refreshToken=string,expires=string}}  "登录成功"
 // @Failure      400   {object}  response.Response  "邮箱或密码格式不正确"
-// @Failure      401   {object}  response.Response  "认证失败"
+// @Failure      401   {object}  response.Response  "认证失败，data.captcha_required 为 true 时下次登录需要填写图形验证码"
+// @Failure      429   {object}  response.Response  "登录失败次数过多，账号或 IP 已被临时锁定"
 // @Failure      500   {object}  response.Response  "内部错误"
 // @Router       /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
@@ -131,6 +145,12 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	clientIP := util.GetRealClientIP(c)
+	if err := h.loginGuard.Check(c.Request.Context(), req.Email, clientIP); err != nil {
+		response.Fail(c, http.StatusTooManyRequests, err.Error())
+		return
+	}
+
 	// 0. 验证人机验证（如果启用）
 	captchaParams := captcha.CaptchaParams{
 		TurnstileToken:       req.TurnstileToken,
@@ -141,21 +161,47 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		ImageCaptchaId:       req.ImageCaptchaId,
 		ImageCaptchaAnswer:   req.ImageCaptchaAnswer,
 	}
-	if err := h.captchaSvc.Verify(c.Request.Context(), captchaParams, c.ClientIP()); err != nil {
+	if err := h.captchaSvc.Verify(c.Request.Context(), captchaParams, clientIP); err != nil {
 		response.Fail(c, http.StatusBadRequest, err.Error())
 		return
 	}
+	// 未启用人机验证时，失败次数较多的账号或 IP 需要额外填写系统图形验证码
+	if !h.captchaSvc.IsEnabled() && h.loginGuard.CaptchaRequired(c.Request.Context(), req.Email, clientIP) {
+		if err := h.imageCaptchaSvc.Verify(c.Request.Context(), req.ImageCaptchaId, req.ImageCaptchaAnswer); err != nil {
+			h.loginFail(c, http.StatusBadRequest, "请填写正确的图形验证码", true)
+			return
+		}
+	}
 
 	// 1. 调用认证服务进行登录逻辑处理
 	user, err := h.authSvc.Login(c.Request.Context(), req.Email, req.Password)
 	if err != nil {
-		response.Fail(c, http.StatusUnauthorized, err.Error())
+		if lockErr := h.loginGuard.RecordFailure(c.Request.Context(), req.Email, clientIP, c.Request.UserAgent(), err.Error()); lockErr != nil {
+			response.Fail(c, http.StatusTooManyRequests, lockErr.Error())
+			return
+		}
+		captchaRequired := !h.captchaSvc.IsEnabled() && h.loginGuard.CaptchaRequired(c.Request.Context(), req.Email, clientIP)
+		h.loginFail(c, http.StatusUnauthorized, err.Error(), captchaRequired)
 		return
 	}
+	h.loginGuard.RecordSuccess(c.Request.Context(), user, clientIP, c.Request.UserAgent(), c.GetHeader("Referer"))
+
+	h.respondLogin(c, user, req.RememberMe)
+}
 
-	// 2. 调用令牌服务生成会话令牌
-	// 注意：这里的 GenerateSessionTokens 内部也需要更新为使用 GeneratePublicID
-	accessToken, refreshToken, expires, err := h.tokenSvc.GenerateSessionTokens(c.Request.Context(), user)
+// sessionOptions 根据请求生成登录会话的客户端信息
+func (h *AuthHandler) sessionOptions(c *gin.Context, rememberMe bool) auth.SessionOptions {
+	return auth.SessionOptions{
+		RememberMe: rememberMe,
+		IP:         util.GetRealClientIP(c),
+		Device:     loginguard.DescribeDevice(c.Request.UserAgent()),
+	}
+}
+
+// respondLogin 为已通过认证的用户签发令牌并返回登录信息
+func (h *AuthHandler) respondLogin(c *gin.Context, user *model.User, rememberMe bool) {
+	// 2. 调用令牌服务创建登录会话并生成令牌
+	accessToken, refreshToken, expires, err := h.tokenSvc.GenerateSessionTokens(c.Request.Context(), user, h.sessionOptions(c, rememberMe))
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, "生成令牌失败: "+err.Error())
 		return
@@ -214,6 +260,67 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}, "登录成功")
 }
 
+// loginFail 返回登录失败响应，并告知前端下次登录是否需要图形验证码
+func (h *AuthHandler) loginFail(c *gin.Context, code int, message string, captchaRequired bool) {
+	c.JSON(code, response.Response{
+		Code:    code,
+		Message: message,
+		Data:    gin.H{"captcha_required": captchaRequired},
+	})
+}
+
+// OAuthExchangeRequest 第三方登录换取令牌的请求
+type OAuthExchangeRequest struct {
+	Code       string `json:"code" binding:"required"`
+	RememberMe bool   `json:"remember_me"`
+}
+
+// OAuthExchange 使用第三方登录回调得到的一次性登录码换取令牌
+// @Summary      第三方登录换取令牌
+// @Description  第三方登录回调会携带 oauth_code 跳转回前端，前端使用该登录码换取与密码登录相同的令牌，登录码两分钟内有效且只能使用一次
+// @Tags         用户认证
+// @Accept       json
+// @Produce      json
+// @Param        body  body      OAuthExchangeRequest  true  "一次性登录码"
+// @Success      200   {object}  response.Response{data=object{userInfo=LoginUserInfoResponse,roles=[]string,accessToken=string,refreshToken=string,expires=string}}  "登录成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      401   {object}  response.Response  "登录码无效或账号不可用"
+// @Router       /auth/oauth/exchange [post]
+func (h *AuthHandler) OAuthExchange(c *gin.Context) {
+	var req OAuthExchangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+	user, err := h.oauthSvc.ExchangeLoginCode(c.Request.Context(), req.Code)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+	h.loginGuard.RecordSuccess(c.Request.Context(), user, util.GetRealClientIP(c), c.Request.UserAgent(), c.GetHeader("Referer"))
+
+	h.respondLogin(c, user, req.RememberMe)
+}
+
+// GetLoginCaptcha 获取登录用的图形验证码
+// @Summary      获取登录图形验证码
+// @Description  未启用人机验证时，登录失败次数较多的账号或 IP 需要填写系统图形验证码
+// @Tags         用户认证
+// @Produce      json
+// @Success      200  {object}  response.Response{data=captcha.ImageCaptchaResponse}  "获取成功"
+// @Router       /auth/login-captcha [get]
+func (h *AuthHandler) GetLoginCaptcha(c *gin.Context) {
+	captchaID, imageBase64, err := h.imageCaptchaSvc.Generate(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "生成验证码失败: "+err.Error())
+		return
+	}
+	response.Success(c, captcha.ImageCaptchaResponse{
+		CaptchaId:   captchaID,
+		ImageBase64: imageBase64,
+	}, "获取验证码成功")
+}
+
 // Register 处理用户注册请求
 // @Summary      用户注册
 // @Description  创建新用户账号
@@ -266,13 +373,13 @@ func (h *AuthHandler) Register(c *gin.Context) {
 
 // RefreshToken 刷新访问 Token
 // @Summary      刷新访问令牌
-// @Description  使用刷新令牌获取新的访问令牌
+// @Description  使用刷新令牌获取新的访问令牌，同时返回新的刷新令牌，旧刷新令牌随即作废；已作废的刷新令牌再次使用会吊销整个会话
 // @Tags         用户认证
 // @Accept       json
 // @Produce      json
 // @Param        Authorization  header  string  false  "Bearer {refresh_token}"
 // @Param        body           body    object{refreshToken=string}  false  "刷新令牌（可选，优先使用Header）"
-// @Success      200  {object}  response.Response{data=object{accessToken=string,expires=string}}  "刷新成功"
+// @Success      200  {object}  response.Response{data=object{accessToken=string,refreshToken=string,expires=string}}  "刷新成功"
 // @Failure      401  {object}  response.Response  "未提供RefreshToken或令牌无效"
 // @Router       /auth/refresh [post]
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
@@ -292,16 +399,16 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 		return
 	}
 
-	// 注意：这里的 RefreshAccessToken 内部也需要更新为使用 DecodePublicID
-	accessToken, expires, err := h.tokenSvc.RefreshAccessToken(c.Request.Context(), refreshToken)
+	accessToken, newRefreshToken, expires, err := h.tokenSvc.RefreshAccessToken(c.Request.Context(), refreshToken, util.GetRealClientIP(c))
 	if err != nil {
 		response.Fail(c, http.StatusUnauthorized, err.Error())
 		return
 	}
 
 	response.Success(c, gin.H{
-		"accessToken": accessToken,
-		"expires":     expires,
+		"accessToken":  accessToken,
+		"refreshToken": newRefreshToken,
+		"expires":      expires,
 	}, "刷新Token成功")
 }
 
@@ -347,7 +454,7 @@ func (h *AuthHandler) ActivateUser(c *gin.Context) {
 	}
 
 	// 生成会话令牌
-	accessToken, refreshToken, expires, err := h.tokenSvc.GenerateSessionTokens(c.Request.Context(), user)
+	accessToken, refreshToken, expires, err := h.tokenSvc.GenerateSessionTokens(c.Request.Context(), user, h.sessionOptions(c, false))
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, "激活成功，但生成登录令牌失败")
 		return
@@ -415,6 +522,7 @@ func (h *AuthHandler) ActivateUser(c *gin.Context) {
 // @Param        body  body  object{email=string}  true  "邮箱地址"
 // @Success      200  {object}  response.Response  "如果该邮箱已注册，将收到重置邮件"
 // @Failure      400  {object}  response.Response  "邮箱格式不正确"
+// @Failure      429  {object}  response.Response  "发送过于频繁"
 // @Router       /auth/forgot-password [post]
 func (h *AuthHandler) ForgotPasswordRequest(c *gin.Context) {
 	var req ForgotPasswordRequest
@@ -439,10 +547,56 @@ func (h *AuthHandler) ForgotPasswordRequest(c *gin.Context) {
 	}
 
 	// 调用 service，无论用户是否存在，都返回成功，防止邮箱枚举攻击
-	h.authSvc.RequestPasswordReset(c.Request.Context(), req.Email)
+	if err := h.authSvc.RequestPasswordReset(c.Request.Context(), req.Email); errors.Is(err, auth.ErrMailTooFrequent) {
+		response.Fail(c, http.StatusTooManyRequests, err.Error())
+		return
+	}
 	response.Success(c, nil, "如果该邮箱已注册，您将会收到一封密码重置邮件。")
 }
 
+// ResendActivation 重新发送激活邮件
+// @Summary      重新发送激活邮件
+// @Description  为尚未激活的账号重新发送激活邮件，同一邮箱每分钟最多一次、每小时最多五次
+// @Tags         用户认证
+// @Accept       json
+// @Produce      json
+// @Param        body  body  ForgotPasswordRequest  true  "邮箱地址"
+// @Success      200  {object}  response.Response  "如果该邮箱已注册且未激活，将收到激活邮件"
+// @Failure      400  {object}  response.Response  "邮箱格式不正确"
+// @Failure      429  {object}  response.Response  "发送过于频繁"
+// @Router       /auth/resend-activation [post]
+func (h *AuthHandler) ResendActivation(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "邮箱格式不正确")
+		return
+	}
+
+	captchaParams := captcha.CaptchaParams{
+		TurnstileToken:       req.TurnstileToken,
+		GeetestLotNumber:     req.GeetestLotNumber,
+		GeetestCaptchaOutput: req.GeetestCaptchaOutput,
+		GeetestPassToken:     req.GeetestPassToken,
+		GeetestGenTime:       req.GeetestGenTime,
+		ImageCaptchaId:       req.ImageCaptchaId,
+		ImageCaptchaAnswer:   req.ImageCaptchaAnswer,
+	}
+	if err := h.captchaSvc.Verify(c.Request.Context(), captchaParams, c.ClientIP()); err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.authSvc.ResendActivation(c.Request.Context(), req.Email); err != nil {
+		if errors.Is(err, auth.ErrMailTooFrequent) {
+			response.Fail(c, http.StatusTooManyRequests, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, nil, "如果该邮箱已注册且尚未激活，您将会收到一封激活邮件。")
+}
+
 // ResetPassword 执行密码重置
 // @Summary      重置密码
 // @Description  通过重置链接设置新密码
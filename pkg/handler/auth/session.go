@@ -0,0 +1,132 @@
+/*
+ * @Description: 登录会话管理：退出登录、设备列表和远程退出
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package auth_handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	jwtauth "github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+)
+
+// currentSession 从 JWT 中解析当前用户的数据库 ID 和会话 ID
+func currentSession(c *gin.Context) (uint, string, bool) {
+	value, exists := c.Get(jwtauth.ClaimsKey)
+	if !exists {
+		return 0, "", false
+	}
+	claims, ok := value.(*jwtauth.CustomClaims)
+	if !ok {
+		return 0, "", false
+	}
+	userID, entityType, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		return 0, "", false
+	}
+	return userID, claims.SessionID, true
+}
+
+// Logout 退出登录
+// @Summary      退出登录
+// @Description  吊销当前会话，当前的访问令牌和刷新令牌立即失效
+// @Tags         用户认证
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response  "退出成功"
+// @Failure      401  {object}  response.Response  "未登录"
+// @Router       /auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+	userID, sessionID, ok := currentSession(c)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "未登录或无法获取当前用户信息")
+		return
+	}
+	if err := h.tokenSvc.RevokeSession(c.Request.Context(), userID, sessionID); err != nil && !errors.Is(err, auth.ErrSessionNotFound) {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, nil, "退出登录成功")
+}
+
+// ListSessions 获取当前用户的登录设备
+// @Summary      获取登录设备列表
+// @Description  返回当前用户所有有效的登录会话，current 为 true 的是发起请求的设备
+// @Tags         用户认证
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]auth.Session}  "获取成功"
+// @Failure      401  {object}  response.Response  "未登录"
+// @Router       /user/sessions [get]
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	userID, sessionID, ok := currentSession(c)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "未登录或无法获取当前用户信息")
+		return
+	}
+	sessions, err := h.tokenSvc.ListSessions(c.Request.Context(), userID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for _, sess := range sessions {
+		sess.Current = sess.ID == sessionID
+	}
+	response.Success(c, sessions, "获取登录设备成功")
+}
+
+// RevokeSession 退出指定设备上的登录
+// @Summary      远程退出登录
+// @Description  吊销指定会话，该设备需要重新登录
+// @Tags         用户认证
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path      string  true  "会话ID"
+// @Success      200  {object}  response.Response  "退出成功"
+// @Failure      404  {object}  response.Response  "会话不存在"
+// @Router       /user/sessions/{id} [delete]
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	userID, _, ok := currentSession(c)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "未登录或无法获取当前用户信息")
+		return
+	}
+	if err := h.tokenSvc.RevokeSession(c.Request.Context(), userID, c.Param("id")); err != nil {
+		if errors.Is(err, auth.ErrSessionNotFound) {
+			response.Fail(c, http.StatusNotFound, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, nil, "已退出该设备的登录")
+}
+
+// RevokeOtherSessions 退出除当前设备外的所有登录
+// @Summary      退出其他设备
+// @Description  吊销当前用户除发起请求的会话以外的全部会话
+// @Tags         用户认证
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=object{revoked=int}}  "退出成功"
+// @Router       /user/sessions [delete]
+func (h *AuthHandler) RevokeOtherSessions(c *gin.Context) {
+	userID, sessionID, ok := currentSession(c)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "未登录或无法获取当前用户信息")
+		return
+	}
+	revoked, err := h.tokenSvc.RevokeUserSessions(c.Request.Context(), userID, sessionID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, gin.H{"revoked": revoked}, "已退出其他设备的登录")
+}
@@ -0,0 +1,173 @@
+/*
+ * @Description: 文章作者资料与作者页 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package author
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/conditional"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/fieldset"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	author_service "github.com/anzhiyu-c/anheyu-app/pkg/service/author"
+)
+
+// Handler 作者 handler
+type Handler struct {
+	authorSvc  *author_service.Service
+	articleSvc article_service.Service
+}
+
+// NewHandler 创建作者 handler
+func NewHandler(authorSvc *author_service.Service, articleSvc article_service.Service) *Handler {
+	return &Handler{authorSvc: authorSvc, articleSvc: articleSvc}
+}
+
+// AuthorDetailResponse 作者页数据
+type AuthorDetailResponse struct {
+	model.AuthorProfile
+	ArticleCount int64 `json:"article_count"`
+}
+
+// ListAuthors 获取作者列表
+// @Summary      获取作者列表
+// @Description  返回所有已配置的作者资料
+// @Tags         公开文章
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]model.AuthorProfile}  "获取成功"
+// @Router       /public/authors [get]
+func (h *Handler) ListAuthors(c *gin.Context) {
+	response.Success(c, h.authorSvc.List(), "获取作者列表成功")
+}
+
+// GetAuthor 获取作者资料
+// @Summary      获取作者资料
+// @Description  根据 slug 获取作者资料及其公开文章数量
+// @Tags         公开文章
+// @Produce      json
+// @Param        slug  path  string  true  "作者标识"
+// @Success      200  {object}  response.Response{data=AuthorDetailResponse}  "获取成功"
+// @Failure      404  {object}  response.Response  "作者不存在"
+// @Router       /public/authors/{slug} [get]
+func (h *Handler) GetAuthor(c *gin.Context) {
+	profile, err := h.authorSvc.Get(c.Param("slug"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+
+	detail := AuthorDetailResponse{AuthorProfile: *profile}
+	result, err := h.articleSvc.ListPublic(c.Request.Context(), authorArticleOptions(profile, 1, 1))
+	if err == nil {
+		detail.ArticleCount = result.Total
+	}
+	response.Success(c, detail, "获取作者资料成功")
+}
+
+// ListAuthorArticles 获取作者的文章归档
+// @Summary      获取作者的文章归档
+// @Description  分页获取作者参与撰写的公开文章，包括作为共同作者的文章和绑定用户发布的文章
+// @Tags         公开文章
+// @Produce      json
+// @Param        slug      path   string  true   "作者标识"
+// @Param        page      query  int     false  "页码"  default(1)
+// @Param        pageSize  query  int     false  "每页数量"  default(10)
+// @Param        fields    query  string  false  "只返回指定的文章字段，逗号分隔，id 总是返回"
+// @Param        exclude   query  string  false  "不返回指定的文章字段，逗号分隔"
+// @Success      200  {object}  response.Response{data=model.ArticleListResponse}  "获取成功"
+// @Failure      400  {object}  response.Response  "字段参数错误"
+// @Failure      404  {object}  response.Response  "作者不存在"
+// @Router       /public/authors/{slug}/articles [get]
+func (h *Handler) ListAuthorArticles(c *gin.Context) {
+	projector, err := fieldset.FromQuery(c, model.ArticleResponse{}, "id")
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	profile, err := h.authorSvc.Get(c.Param("slug"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+	result, err := h.articleSvc.ListPublic(c.Request.Context(), authorArticleOptions(profile, page, pageSize))
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取作者文章失败: "+err.Error())
+		return
+	}
+	if conditional.ArticleList(c, result.List, result.Total).NotModified(c) {
+		return
+	}
+	response.Success(c, projector.ProjectList(result), "获取作者文章成功")
+}
+
+// AdminListAuthors 获取全部作者资料
+// @Summary      获取全部作者资料
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]model.AuthorProfile}  "获取成功"
+// @Router       /admin/authors [get]
+func (h *Handler) AdminListAuthors(c *gin.Context) {
+	response.Success(c, h.authorSvc.List(), "获取作者列表成功")
+}
+
+// SaveAuthors 保存作者资料
+// @Summary      保存作者资料
+// @Description  整体覆盖作者资料列表，slug 在列表中必须唯一
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  []model.AuthorProfile  true  "作者资料列表"
+// @Success      200  {object}  response.Response{data=[]model.AuthorProfile}  "保存成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Router       /admin/authors [put]
+func (h *Handler) SaveAuthors(c *gin.Context) {
+	var profiles []model.AuthorProfile
+	if err := c.ShouldBindJSON(&profiles); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+
+	saved, err := h.authorSvc.Save(c.Request.Context(), profiles)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, saved, "保存作者资料成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	if errors.Is(err, author_service.ErrAuthorNotFound) {
+		response.Fail(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Fail(c, http.StatusInternalServerError, err.Error())
+}
+
+// authorArticleOptions 作者页的文章查询条件，绑定了用户的作者同时包含该用户发布的文章
+func authorArticleOptions(profile *model.AuthorProfile, page, pageSize int) *model.ListPublicArticlesOptions {
+	options := &model.ListPublicArticlesOptions{
+		Page:     page,
+		PageSize: pageSize,
+		Author:   profile.Slug,
+	}
+	if profile.UserID != "" {
+		if userID, entityType, err := idgen.DecodePublicID(profile.UserID); err == nil && entityType == idgen.EntityTypeUser {
+			options.AuthorUserID = &userID
+		}
+	}
+	return options
+}
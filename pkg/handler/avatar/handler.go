@@ -0,0 +1,57 @@
+/*
+ * @Description: 评论者头像代理 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package avatar
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/avatar"
+)
+
+// Handler 头像代理 handler
+type Handler struct {
+	svc *avatar.Service
+}
+
+// NewHandler 创建头像代理 handler
+func NewHandler(svc *avatar.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// GetAvatar 获取评论者头像
+// @Summary      获取评论者头像
+// @Description  按邮箱哈希（MD5 或 SHA256）返回 Gravatar 头像，头像会缓存在本地，需在设置中启用头像代理
+// @Tags         评论管理
+// @Produce      image/png,image/jpeg,image/webp
+// @Param        hash  path   string  true   "邮箱哈希"
+// @Param        s     query  int     false  "头像尺寸，默认 80，向上取整到 40/80/160/320/512"
+// @Success      200  {file}    file               "头像图片"
+// @Failure      400  {object}  response.Response  "哈希格式错误"
+// @Failure      404  {object}  response.Response  "头像代理未启用"
+// @Failure      502  {object}  response.Response  "获取头像失败"
+// @Router       /avatar/{hash} [get]
+func (h *Handler) GetAvatar(c *gin.Context) {
+	size, _ := strconv.Atoi(c.Query("s"))
+	data, err := h.svc.Get(c.Request.Context(), c.Param("hash"), size)
+	if err != nil {
+		switch {
+		case errors.Is(err, avatar.ErrInvalidHash):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, avatar.ErrProxyDisabled):
+			response.Fail(c, http.StatusNotFound, err.Error())
+		default:
+			response.Fail(c, http.StatusBadGateway, err.Error())
+		}
+		return
+	}
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Data(http.StatusOK, http.DetectContentType(data), data)
+}
@@ -19,12 +19,14 @@ import (
 // Handler 缓存管理 handler
 type Handler struct {
 	revalidateSvc *cache.RevalidateService
+	warmupSvc     *cache.WarmupService
 }
 
 // NewHandler 创建缓存管理 handler
-func NewHandler(revalidateSvc *cache.RevalidateService) *Handler {
+func NewHandler(revalidateSvc *cache.RevalidateService, warmupSvc *cache.WarmupService) *Handler {
 	return &Handler{
 		revalidateSvc: revalidateSvc,
+		warmupSvc:     warmupSvc,
 	}
 }
 
@@ -48,13 +50,13 @@ type RevalidateRequest struct {
 // @Security     BearerAuth
 func (h *Handler) Revalidate(c *gin.Context) {
 	if !h.revalidateSvc.IsEnabled() {
-		response.Fail(c, http.StatusBadRequest, "缓存清理功能仅在 SSR 模式下可用")
+		response.FailCode(c, http.StatusConflict, response.CodeSSRNotRunning, "缓存清理功能仅在 SSR 模式下可用")
 		return
 	}
 
 	var req RevalidateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.Fail(c, http.StatusBadRequest, "请求参数错误: "+err.Error())
+		response.FailBind(c, err, &req)
 		return
 	}
 
@@ -64,7 +66,7 @@ func (h *Handler) Revalidate(c *gin.Context) {
 		err = h.revalidateSvc.RevalidateAll()
 	case "article":
 		if req.Slug == "" {
-			response.Fail(c, http.StatusBadRequest, "清理文章缓存需要提供 slug")
+			response.FailFields(c, "清理文章缓存需要提供 slug", response.RequiredField("slug"))
 			return
 		}
 		err = h.revalidateSvc.RevalidateArticle(req.Slug)
@@ -77,12 +79,12 @@ func (h *Handler) Revalidate(c *gin.Context) {
 	case "links":
 		err = h.revalidateSvc.RevalidateFriendLinks()
 	default:
-		response.Fail(c, http.StatusBadRequest, "未知的清理类型")
+		response.FailFields(c, "未知的清理类型", response.FieldError{Field: "type", Rule: "oneof", Message: "未知的清理类型"})
 		return
 	}
 
 	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, "缓存清理失败: "+err.Error())
+		response.FailError(c, err, http.StatusInternalServerError, "缓存清理失败")
 		return
 	}
 
@@ -102,3 +104,57 @@ func (h *Handler) GetStatus(c *gin.Context) {
 		"enabled": h.revalidateSvc.IsEnabled(),
 	}, "success")
 }
+
+// Purge 按路径或缓存标签清除缓存
+// @Summary      清除缓存
+// @Description  按站内路径或缓存标签清除 CDN 缓存，SSR 模式下同时清理前端缓存
+// @Tags         缓存管理
+// @Accept       json
+// @Produce      json
+// @Param        request body cache.PurgeRequest true "清除范围"
+// @Success      200 {object} response.Response{data=cache.PurgeResult}
+// @Failure      400 {object} response.Response
+// @Router       /api/admin/cache/purge [post]
+// @Security     BearerAuth
+func (h *Handler) Purge(c *gin.Context) {
+	var req cache.PurgeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	result, err := h.warmupSvc.Purge(c.Request.Context(), &req)
+	if err != nil {
+		response.FailError(c, err, http.StatusBadRequest, "")
+		return
+	}
+
+	response.Success(c, result, "缓存清除完成")
+}
+
+// Warm 预热页面缓存
+// @Summary      预热缓存
+// @Description  请求指定 URL 列表（可选合并站点地图）以预先填充 CDN 缓存
+// @Tags         缓存管理
+// @Accept       json
+// @Produce      json
+// @Param        request body cache.WarmRequest true "预热范围"
+// @Success      200 {object} response.Response{data=cache.WarmResult}
+// @Failure      400 {object} response.Response
+// @Router       /api/admin/cache/warm [post]
+// @Security     BearerAuth
+func (h *Handler) Warm(c *gin.Context) {
+	var req cache.WarmRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	result, err := h.warmupSvc.Warm(c.Request.Context(), &req)
+	if err != nil {
+		response.FailError(c, err, http.StatusBadRequest, "")
+		return
+	}
+
+	response.Success(c, result, "缓存预热完成")
+}
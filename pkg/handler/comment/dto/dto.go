@@ -56,6 +56,12 @@ type AdminListRequest struct {
 
 	// 按评论状态筛选 (1: 已发布, 2: 待审核)。
 	Status *int `form:"status" binding:"omitempty,oneof=1 2"`
+
+	// 游标分页：Keyset 为 true 时忽略页码并按创建时间倒序（不再置顶优先），
+	// 只返回排在 (After, AfterID) 之后的评论，After 为 nil 时从第一条开始。
+	Keyset  bool       `form:"-"`
+	After   *time.Time `form:"-"`
+	AfterID string     `form:"-"` // 评论公共ID
 }
 
 // DeleteRequest 定义了批量删除评论的API请求体。
@@ -100,9 +106,11 @@ type Response struct {
 	EmailMD5       string      `json:"email_md5"`
 	QQNumber       *string     `json:"qq_number,omitempty"`  // QQ号（如果邮箱是QQ邮箱格式，用于前端显示QQ头像）
 	AvatarURL      *string     `json:"avatar_url,omitempty"` // 用户自定义头像URL（如果有关联用户且用户上传了头像）
+	Avatar         string      `json:"avatar"`               // 最终使用的头像地址，启用头像代理时指向本站
 	Website        *string     `json:"website,omitempty"`
 	ContentHTML    string      `json:"content_html"`
 	IsAdminComment bool        `json:"is_admin_comment"`
+	Badges         []Badge     `json:"badges"` // 评论者身份徽章
 	IsAnonymous    bool        `json:"is_anonymous"`
 	IPLocation     string      `json:"ip_location,omitempty"`
 	UserAgent      *string     `json:"user_agent,omitempty"`
@@ -122,6 +130,13 @@ type Response struct {
 	Status    *int    `json:"status,omitempty"`
 }
 
+// Badge 评论者身份徽章，主题可按 type 统一渲染样式
+type Badge struct {
+	Type  string `json:"type"` // owner、verified 或 custom
+	Label string `json:"label"`
+	Color string `json:"color,omitempty"`
+}
+
 // ListResponse 定义了评论列表的API响应结构。
 type ListResponse struct {
 	List              []*Response `json:"list"`
@@ -385,12 +385,15 @@ func (h *Handler) UnlikeComment(c *gin.Context) {
 
 // AdminList
 // @Summary      管理员查询评论列表
-// @Description  根据多种条件分页查询评论
+// @Description  根据多种条件分页查询评论；使用游标分页时按创建时间倒序排列，置顶评论不再优先
 // @Tags         评论管理
 // @Security     BearerAuth
 // @Accept       json
 // @Produce      json
 // @Param        query query dto.AdminListRequest true "查询参数"
+// @Param        cursor query string false "分页游标，传入上一页返回的 next_cursor"
+// @Param        limit query int false "游标分页每页数量，最大 100"
+// @Param        format query string false "传 ndjson 时逐行流式返回全部评论"
 // @Success      200 {object} response.Response{data=dto.ListResponse} "成功响应"
 // @Failure      400 {object} response.Response "请求参数错误"
 // @Failure      401 {object} response.Response "未授权"
@@ -402,6 +405,27 @@ func (h *Handler) AdminList(c *gin.Context) {
 		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
 		return
 	}
+	cur, err := response.ParseCursor(c, 20, 100)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if cur.Enabled {
+		response.RespondCursor(c, cur, func(after *response.CursorKey, limit int) ([]*dto.Response, int64, error) {
+			req.Keyset, req.PageSize = true, limit
+			if after != nil {
+				req.After, req.AfterID = &after.CreatedAt, after.ID
+			}
+			result, err := h.svc.AdminList(c.Request.Context(), &req)
+			if err != nil {
+				return nil, 0, err
+			}
+			return result.List, result.Total, nil
+		}, func(item *dto.Response) response.CursorKey {
+			return response.CursorKey{CreatedAt: item.CreatedAt, ID: item.ID}
+		}, "获取成功")
+		return
+	}
 
 	commentsResponse, err := h.svc.AdminList(c.Request.Context(), &req)
 	if err != nil {
@@ -572,6 +596,35 @@ func (h *Handler) GetIPLocation(c *gin.Context) {
 	response.Success(c, info, "获取成功")
 }
 
+// StartIPAnonymization
+// @Summary      匿名化历史评论IP
+// @Description  在后台对所有历史评论存储的IP做匿名化处理（IPv4 末段置零，IPv6 仅保留前48位），并按当前属地显示粒度处理已存储的属地，不可撤销。
+// @Description  IP 匿名化和属地粒度设置只作用于之后的新评论，开启后需要执行一次该任务处理历史数据
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response "任务已启动"
+// @Failure      409 {object} response.Response "任务正在运行"
+// @Router       /comments/anonymize-ips [post]
+func (h *Handler) StartIPAnonymization(c *gin.Context) {
+	if err := h.svc.StartIPAnonymization(); err != nil {
+		response.Fail(c, http.StatusConflict, err.Error())
+		return
+	}
+	response.Success(c, h.svc.IPAnonymizationStatus(), "IP 匿名化任务已启动")
+}
+
+// GetIPAnonymizationStatus
+// @Summary      获取历史评论IP匿名化任务状态
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=comment.IPAnonymizeStatus} "成功响应"
+// @Router       /comments/anonymize-ips [get]
+func (h *Handler) GetIPAnonymizationStatus(c *gin.Context) {
+	response.Success(c, h.svc.IPAnonymizationStatus(), "获取成功")
+}
+
 // ExportComments
 // @Summary      管理员导出评论
 // @Description  导出选定的评论或所有评论为 ZIP 文件
@@ -611,12 +664,13 @@ func (h *Handler) ExportComments(c *gin.Context) {
 
 // ImportComments
 // @Summary      管理员导入评论
-// @Description  从 JSON 或 ZIP 文件导入评论
+// @Description  从本站导出的 JSON 或 ZIP 文件导入评论，也支持 Twikoo 导出的 JSON、Waline 导出的 JSON 和 Disqus 导出的 XML
 // @Tags         评论管理
 // @Security     BearerAuth
 // @Accept       multipart/form-data
 // @Produce      json
-// @Param        file formData file true "评论数据文件（JSON或ZIP格式）"
+// @Param        file formData file true "评论数据文件（JSON、ZIP 或 XML 格式）"
+// @Param        source formData string false "数据来源：auto（默认，自动识别）、anheyu、twikoo、waline、disqus"
 // @Param        skip_existing formData bool false "是否跳过已存在的评论"
 // @Param        default_status formData int false "默认状态（1:已发布, 2:待审核）"
 // @Param        keep_create_time formData bool false "是否保留原创建时间"
@@ -679,15 +733,19 @@ func (h *Handler) ImportComments(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	switch ext {
-	case ".json":
-		result, err = h.svc.ImportCommentsFromJSON(ctx, fileData, importReq)
+	case ".json", ".xml":
+		result, err = h.svc.ImportCommentsFromSource(ctx, c.DefaultPostForm("source", comment.ImportSourceAuto), fileData, importReq)
 	case ".zip":
 		result, err = h.svc.ImportCommentsFromZip(ctx, fileData, importReq)
 	default:
-		response.Fail(c, http.StatusBadRequest, "不支持的文件格式，仅支持 .json 和 .zip 文件")
+		response.Fail(c, http.StatusBadRequest, "不支持的文件格式，仅支持 .json、.zip 和 .xml 文件")
 		return
 	}
 
+	if errors.Is(err, comment.ErrUnknownImportSource) {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err != nil {
 		log.Printf("[Handler.ImportComments] 导入失败: %v", err)
 		response.Fail(c, http.StatusInternalServerError, "导入评论失败: "+err.Error())
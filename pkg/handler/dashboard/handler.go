@@ -0,0 +1,61 @@
+/*
+ * @Description: 后台仪表盘 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package dashboard
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/dashboard"
+)
+
+// Handler 仪表盘 handler
+type Handler struct {
+	svc dashboard.Service
+}
+
+// NewHandler 创建仪表盘 handler
+func NewHandler(svc dashboard.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// GetSummary 获取仪表盘汇总数据
+// @Summary      获取仪表盘汇总数据
+// @Description  一次返回文章数、待审评论数、今日访客、当前主题与 SSR 状态、可更新主题和最近系统通知，结果缓存 1 分钟
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        refresh  query  bool  false  "是否跳过缓存"
+// @Success      200  {object}  response.Response{data=dashboard.Summary}  "获取成功"
+// @Router       /admin/dashboard/summary [get]
+func (h *Handler) GetSummary(c *gin.Context) {
+	claims, ok := c.Get(auth.ClaimsKey)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户未登录")
+		return
+	}
+	customClaims, ok := claims.(*auth.CustomClaims)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户认证信息格式错误")
+		return
+	}
+	userID, entityType, err := idgen.DecodePublicID(customClaims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		response.Fail(c, http.StatusUnauthorized, "用户ID解码失败")
+		return
+	}
+
+	summary, err := h.svc.GetSummary(c.Request.Context(), userID, c.Query("refresh") == "true")
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取仪表盘数据失败: "+err.Error())
+		return
+	}
+	response.Success(c, summary, "获取仪表盘数据成功")
+}
@@ -0,0 +1,64 @@
+/*
+ * @Description: 诊断包 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package diagnostics
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/diagnostics"
+)
+
+// Handler 诊断包 handler
+type Handler struct {
+	svc *diagnostics.Service
+}
+
+// NewHandler 创建诊断包 handler
+func NewHandler(svc *diagnostics.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// DownloadBundle 下载诊断包
+// @Summary      下载诊断包
+// @Description  生成包含版本信息、脱敏后的配置、最近日志、主题与 SSR 状态、磁盘占用和最近错误的 zip 文件，反馈问题时附上即可；密码、密钥、令牌等敏感值已替换为 ******
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      application/zip
+// @Success      200  {file}  binary  "诊断包"
+// @Router       /admin/diagnostics/bundle [get]
+func (h *Handler) DownloadBundle(c *gin.Context) {
+	claims, ok := c.Get(auth.ClaimsKey)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户未登录")
+		return
+	}
+	customClaims, ok := claims.(*auth.CustomClaims)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户认证信息格式错误")
+		return
+	}
+	userID, entityType, err := idgen.DecodePublicID(customClaims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		response.Fail(c, http.StatusUnauthorized, "用户ID解码失败")
+		return
+	}
+
+	// 先在内存中生成完整的诊断包，生成失败时仍能返回 JSON 错误
+	var buf bytes.Buffer
+	if err := h.svc.WriteBundle(c.Request.Context(), userID, &buf); err != nil {
+		response.Fail(c, http.StatusInternalServerError, "生成诊断包失败: "+err.Error())
+		return
+	}
+	c.Header("Content-Disposition", "attachment; filename="+diagnostics.BundleFilename())
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "application/zip", buf.Bytes())
+}
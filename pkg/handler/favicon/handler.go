@@ -0,0 +1,121 @@
+/*
+ * @Description: 站点图标生成 API 和 Web App Manifest
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package favicon
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/favicon"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// Handler 站点图标 handler
+type Handler struct {
+	svc        *favicon.Service
+	settingSvc setting.SettingService
+}
+
+// NewHandler 创建站点图标 handler
+func NewHandler(svc *favicon.Service, settingSvc setting.SettingService) *Handler {
+	return &Handler{svc: svc, settingSvc: settingSvc}
+}
+
+// GetIcons 获取当前的站点图标
+// @Summary      获取站点图标
+// @Description  返回由 Logo 生成的全套图标，尚未生成时返回 null
+// @Tags         站点图标
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=favicon.IconSet}  "获取成功"
+// @Router       /admin/site-icon [get]
+func (h *Handler) GetIcons(c *gin.Context) {
+	response.Success(c, favicon.Current(h.settingSvc), "获取站点图标成功")
+}
+
+// GenerateIcons 由上传的 Logo 生成全套站点图标
+// @Summary      生成站点图标
+// @Description  上传一张图片（建议 512x512 以上的正方形 PNG），生成 favicon.ico、各尺寸 PNG 图标、apple-touch-icon 和可遮罩图标，
+// @Description  并更新 ICON_URL、LOGO_URL_192x192、LOGO_URL_512x512 配置
+// @Tags         站点图标
+// @Security     BearerAuth
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file  formData  file  true  "Logo 图片"
+// @Success      200  {object}  response.Response{data=favicon.IconSet}  "生成成功"
+// @Failure      400  {object}  response.Response  "图片无效"
+// @Failure      500  {object}  response.Response  "生成失败"
+// @Router       /admin/site-icon [post]
+func (h *Handler) GenerateIcons(c *gin.Context) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "无效的文件上传请求")
+		return
+	}
+	if fileHeader.Size > favicon.MaxSourceSize {
+		response.Fail(c, http.StatusBadRequest, favicon.ErrSourceTooLarge.Error())
+		return
+	}
+	claims, ok := c.Get(auth.ClaimsKey)
+	customClaims, isClaims := claims.(*auth.CustomClaims)
+	if !ok || !isClaims {
+		response.Fail(c, http.StatusUnauthorized, "无法获取用户信息，请确认是否已登录")
+		return
+	}
+	ownerID, _, err := idgen.DecodePublicID(customClaims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		return
+	}
+	var userGroupID uint
+	if customClaims.UserGroupID != "" {
+		if groupID, _, err := idgen.DecodePublicID(customClaims.UserGroupID); err == nil {
+			userGroupID = groupID
+		}
+	}
+
+	reader, err := fileHeader.Open()
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "无法处理上传的文件")
+		return
+	}
+	defer reader.Close()
+
+	set, err := h.svc.Generate(c.Request.Context(), ownerID, userGroupID, reader)
+	if err != nil {
+		if errors.Is(err, favicon.ErrSourceTooLarge) || errors.Is(err, favicon.ErrSourceTooSmall) || errors.Is(err, favicon.ErrSourceInvalid) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, set, "生成站点图标成功")
+}
+
+// GetManifest 输出 Web App Manifest
+// @Summary      Web App Manifest
+// @Description  根据站点名称、主题色和生成的图标动态输出，尚未生成图标时返回 404
+// @Tags         站点图标
+// @Produce      json
+// @Success      200  {object}  map[string]interface{}  "manifest 内容"
+// @Failure      404  {string}  string  "尚未生成图标"
+// @Router       /site.webmanifest [get]
+func (h *Handler) GetManifest(c *gin.Context) {
+	manifest := favicon.Manifest(h.settingSvc)
+	if manifest == nil {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	c.Header("Content-Type", "application/manifest+json; charset=utf-8")
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.JSON(http.StatusOK, manifest)
+}
@@ -0,0 +1,101 @@
+/*
+ * @Description: Git 内容同步管理 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package gitsync
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/gitsync"
+)
+
+// Handler Git 内容同步 handler
+type Handler struct {
+	svc *gitsync.Service
+}
+
+// NewHandler 创建 Git 内容同步 handler
+func NewHandler(svc *gitsync.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// ResolveConflictRequest 解决冲突请求
+type ResolveConflictRequest struct {
+	Path string `json:"path" binding:"required"`
+	Keep string `json:"keep" binding:"required,oneof=site git"`
+}
+
+// GetStatus 获取 Git 同步状态
+// @Summary      获取 Git 同步状态
+// @Description  返回仓库配置、最近一次同步结果和待解决的冲突
+// @Tags         Git 内容同步
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=gitsync.Status}  "获取成功"
+// @Router       /admin/gitsync [get]
+func (h *Handler) GetStatus(c *gin.Context) {
+	response.Success(c, h.svc.Status(), "获取 Git 同步状态成功")
+}
+
+// Sync 立即执行一次同步
+// @Summary      立即执行 Git 同步
+// @Description  拉取仓库变更并导入站内，再把站内的修改提交推送到仓库；两侧都修改过的内容记为冲突
+// @Tags         Git 内容同步
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=gitsync.Report}  "同步完成"
+// @Failure      400  {object}  response.Response  "未启用 Git 同步"
+// @Failure      409  {object}  response.Response  "正在同步中"
+// @Router       /admin/gitsync/sync [post]
+func (h *Handler) Sync(c *gin.Context) {
+	report, err := h.svc.Sync(c.Request.Context())
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, report, "同步完成")
+}
+
+// ResolveConflict 解决冲突
+// @Summary      解决 Git 同步冲突
+// @Description  keep=site 用站内内容覆盖仓库文件，keep=git 用仓库文件覆盖站内内容
+// @Tags         Git 内容同步
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  ResolveConflictRequest  true  "冲突文件路径和保留方式"
+// @Success      200  {object}  response.Response{data=gitsync.Report}  "冲突已解决"
+// @Failure      404  {object}  response.Response  "冲突不存在"
+// @Router       /admin/gitsync/conflicts/resolve [post]
+func (h *Handler) ResolveConflict(c *gin.Context) {
+	var req ResolveConflictRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	report, err := h.svc.ResolveConflict(c.Request.Context(), req.Path, req.Keep)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, report, "冲突已解决")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gitsync.ErrDisabled), errors.Is(err, gitsync.ErrInvalidKeep), errors.Is(err, gitsync.ErrInvalidRepoURL):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, gitsync.ErrSyncing):
+		response.Fail(c, http.StatusConflict, err.Error())
+	case errors.Is(err, gitsync.ErrConflictNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
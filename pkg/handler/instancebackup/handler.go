@@ -0,0 +1,158 @@
+/*
+ * @Description: 整站备份 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package instancebackup
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/instancebackup"
+)
+
+// Handler 整站备份 handler
+type Handler struct {
+	svc *instancebackup.Service
+}
+
+// NewHandler 创建整站备份 handler
+func NewHandler(svc *instancebackup.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// CreateRequest 创建备份的请求
+type CreateRequest struct {
+	Description string `json:"description"`
+}
+
+// ValidateRequest 校验备份的请求
+type ValidateRequest struct {
+	Passphrase string `json:"passphrase"` // 为空时使用当前配置的加密口令
+}
+
+// ListBackups 获取整站备份列表
+// @Summary      获取整站备份列表
+// @Description  返回本地保存的整站备份，按时间从新到旧排序
+// @Tags         整站备份
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]instancebackup.BackupInfo}  "获取成功"
+// @Router       /admin/instance-backups [get]
+func (h *Handler) ListBackups(c *gin.Context) {
+	backups, err := h.svc.List()
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, backups, "获取备份列表成功")
+}
+
+// CreateBackup 立即创建整站备份
+// @Summary      创建整站备份
+// @Description  备份数据库、data 目录和本机上传文件，按配置加密并推送到存储策略
+// @Tags         整站备份
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateRequest  false  "备份描述"
+// @Success      200  {object}  response.Response{data=instancebackup.BackupInfo}  "创建成功"
+// @Failure      409  {object}  response.Response  "已有备份任务正在进行"
+// @Router       /admin/instance-backups [post]
+func (h *Handler) CreateBackup(c *gin.Context) {
+	var req CreateRequest
+	_ = c.ShouldBindJSON(&req)
+	if req.Description == "" {
+		req.Description = "后台手动备份"
+	}
+	info, err := h.svc.Create(c.Request.Context(), req.Description)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, info, "创建备份成功")
+}
+
+// ValidateBackup 校验整站备份
+// @Summary      校验整站备份
+// @Description  解密并逐个核对备份内文件的摘要，确认数据库类型与当前配置一致，不会修改任何数据
+// @Tags         整站备份
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        filename  path  string           true   "备份文件名"
+// @Param        body      body  ValidateRequest  false  "解密口令"
+// @Success      200  {object}  response.Response{data=instancebackup.Manifest}  "校验通过"
+// @Failure      400  {object}  response.Response  "校验失败"
+// @Failure      404  {object}  response.Response  "备份不存在"
+// @Router       /admin/instance-backups/{filename}/validate [post]
+func (h *Handler) ValidateBackup(c *gin.Context) {
+	var req ValidateRequest
+	_ = c.ShouldBindJSON(&req)
+	p, err := h.svc.Path(c.Param("filename"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	manifest, err := h.svc.Validate(c.Request.Context(), p, req.Passphrase)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, manifest, "备份校验通过")
+}
+
+// DownloadBackup 下载整站备份
+// @Summary      下载整站备份
+// @Tags         整站备份
+// @Security     BearerAuth
+// @Param        filename  path  string  true  "备份文件名"
+// @Success      200  {file}  binary  "备份文件"
+// @Failure      404  {object}  response.Response  "备份不存在"
+// @Router       /admin/instance-backups/{filename}/download [get]
+func (h *Handler) DownloadBackup(c *gin.Context) {
+	p, err := h.svc.Path(c.Param("filename"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	c.FileAttachment(p, c.Param("filename"))
+}
+
+// DeleteBackup 删除整站备份
+// @Summary      删除整站备份
+// @Tags         整站备份
+// @Security     BearerAuth
+// @Produce      json
+// @Param        filename  path  string  true  "备份文件名"
+// @Success      200  {object}  response.Response  "删除成功"
+// @Failure      404  {object}  response.Response  "备份不存在"
+// @Router       /admin/instance-backups/{filename} [delete]
+func (h *Handler) DeleteBackup(c *gin.Context) {
+	if err := h.svc.Delete(c.Param("filename")); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "删除备份成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, instancebackup.ErrBackupNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, instancebackup.ErrBackupRunning):
+		response.Fail(c, http.StatusConflict, err.Error())
+	case errors.Is(err, instancebackup.ErrInvalidBackup),
+		errors.Is(err, instancebackup.ErrPassphraseRequired),
+		errors.Is(err, instancebackup.ErrDecryptFailed),
+		errors.Is(err, instancebackup.ErrChecksumMismatch),
+		errors.Is(err, instancebackup.ErrDatabaseTypeChanged):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
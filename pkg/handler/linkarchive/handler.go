@@ -0,0 +1,65 @@
+/*
+ * @Description: 外链存档管理 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package linkarchive
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/linkarchive"
+)
+
+// Handler 外链存档 handler
+type Handler struct {
+	svc *linkarchive.Service
+}
+
+// NewHandler 创建外链存档 handler
+func NewHandler(svc *linkarchive.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// ListRecords 获取外链存档记录
+// @Summary      获取外链存档记录
+// @Description  返回所有已收集外链的存档状态，包括存档地址、尝试次数和最近一次错误
+// @Tags         外链存档
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]linkarchive.Record}  "获取成功"
+// @Router       /admin/link-archives [get]
+func (h *Handler) ListRecords(c *gin.Context) {
+	records, err := h.svc.Records(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取外链存档记录失败: "+err.Error())
+		return
+	}
+	response.Success(c, records, "获取外链存档记录成功")
+}
+
+// Run 立即执行一次外链存档
+// @Summary      立即执行外链存档
+// @Description  扫描有变更的文章并提交一批待存档的链接，不受启用开关限制
+// @Tags         外链存档
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=linkarchive.RunResult}  "执行完成"
+// @Failure      409  {object}  response.Response  "存档任务正在运行"
+// @Router       /admin/link-archives/run [post]
+func (h *Handler) Run(c *gin.Context) {
+	result, err := h.svc.Run(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, linkarchive.ErrRunning) {
+			response.Fail(c, http.StatusConflict, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, result, "外链存档执行完成")
+}
@@ -0,0 +1,90 @@
+/*
+ * @Description: 第三方资源本地化 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package localizer
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/localizer"
+)
+
+// Handler 资源本地化 handler
+type Handler struct {
+	svc *localizer.Service
+}
+
+// NewHandler 创建资源本地化 handler
+func NewHandler(svc *localizer.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// ResourceStatus 资源本地化状态
+type ResourceStatus struct {
+	Enabled   bool              `json:"enabled"`
+	Resources []localizer.Entry `json:"resources"`
+}
+
+// ServeResource 返回本地化后的资源文件
+// @Summary      获取本地化资源
+// @Description  返回已下载到本地的第三方字体、样式和脚本
+// @Tags         资源本地化
+// @Param        name  path  string  true  "本地文件名"
+// @Success      200  {file}  binary  "资源内容"
+// @Failure      404  {object}  response.Response  "资源不存在"
+// @Router       /needcache/resource/{name} [get]
+func (h *Handler) ServeResource(c *gin.Context) {
+	file, contentType, err := h.svc.Open(c.Param("name"))
+	if err != nil {
+		response.Fail(c, http.StatusNotFound, err.Error())
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		response.Fail(c, http.StatusNotFound, localizer.ErrResourceNotFound.Error())
+		return
+	}
+	c.Header("Content-Type", contentType)
+	c.Header("X-Content-Type-Options", "nosniff")
+	// 文件名由原地址决定，刷新后内容可能变化，因此不使用 immutable
+	c.Header("Cache-Control", "public, max-age=604800")
+	http.ServeContent(c.Writer, c.Request, info.Name(), info.ModTime(), file)
+}
+
+// ListResources 获取本地化资源列表
+// @Summary      获取本地化资源列表
+// @Description  返回是否启用资源本地化及所有已记录资源的下载状态
+// @Tags         资源本地化
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=ResourceStatus}  "获取成功"
+// @Router       /admin/resources [get]
+func (h *Handler) ListResources(c *gin.Context) {
+	response.Success(c, ResourceStatus{
+		Enabled:   h.svc.Enabled(),
+		Resources: h.svc.List(),
+	}, "获取本地化资源成功")
+}
+
+// ClearResources 清空本地化资源
+// @Summary      清空本地化资源
+// @Description  删除所有已下载的资源，之后访问页面时会重新下载
+// @Tags         资源本地化
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response  "清空成功"
+// @Router       /admin/resources [delete]
+func (h *Handler) ClearResources(c *gin.Context) {
+	if err := h.svc.Clear(); err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, nil, "已清空本地化资源")
+}
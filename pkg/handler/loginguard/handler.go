@@ -0,0 +1,73 @@
+/*
+ * @Description: 登录安全管理 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package loginguard
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/loginguard"
+)
+
+// Handler 登录安全 handler
+type Handler struct {
+	svc *loginguard.Service
+}
+
+// NewHandler 创建登录安全 handler
+func NewHandler(svc *loginguard.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// UnlockRequest 解除锁定的请求，邮箱和 IP 至少提供一个
+type UnlockRequest struct {
+	Email string `json:"email"`
+	IP    string `json:"ip"`
+}
+
+// ListEvents 获取最近的认证事件
+// @Summary      获取最近的认证事件
+// @Description  返回最近的登录成功、失败、锁定和新设备登录事件，服务重启后清空
+// @Tags         登录安全
+// @Security     BearerAuth
+// @Produce      json
+// @Param        type   query  string  false  "事件类型：login_success、login_failed、locked、blocked、new_device"
+// @Param        limit  query  int     false  "返回条数，默认 100，最大 500"
+// @Success      200  {object}  response.Response{data=[]loginguard.Event}  "获取成功"
+// @Router       /admin/login-security/events [get]
+func (h *Handler) ListEvents(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	response.Success(c, h.svc.Events(c.Query("type"), limit), "获取认证事件成功")
+}
+
+// Unlock 解除账号或 IP 的登录锁定
+// @Summary      解除登录锁定
+// @Tags         登录安全
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  UnlockRequest  true  "邮箱或 IP"
+// @Success      200  {object}  response.Response  "解除成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Router       /admin/login-security/unlock [post]
+func (h *Handler) Unlock(c *gin.Context) {
+	var req UnlockRequest
+	if err := c.ShouldBindJSON(&req); err != nil || (req.Email == "" && req.IP == "") {
+		response.Fail(c, http.StatusBadRequest, "请提供邮箱或 IP")
+		return
+	}
+	if err := h.svc.Unlock(c.Request.Context(), req.Email, req.IP); err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, nil, "已解除锁定")
+}
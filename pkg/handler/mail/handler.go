@@ -0,0 +1,140 @@
+/*
+ * @Description: 邮件发送 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package mail
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/mail"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// Handler 邮件发送 handler
+type Handler struct {
+	svc        *mail.Service
+	settingSvc setting.SettingService
+}
+
+// NewHandler 创建邮件发送 handler
+func NewHandler(svc *mail.Service, settingSvc setting.SettingService) *Handler {
+	return &Handler{svc: svc, settingSvc: settingSvc}
+}
+
+// TestSendRequest 测试发送请求
+type TestSendRequest struct {
+	ToEmail  string `json:"to_email" binding:"required,email"`
+	Provider string `json:"provider" binding:"omitempty,oneof=smtp sendgrid mailgun resend"` // 留空使用当前配置的发送方式
+}
+
+// RetryRequest 重试请求
+type RetryRequest struct {
+	ID string `json:"id"` // 留空重试全部失败的邮件
+}
+
+// TestSend 发送测试邮件
+// @Summary      发送测试邮件
+// @Description  不经过队列立即发送一封套用统一外框的测试邮件，可指定发送方式以便在切换前验证配置，返回实际使用的发送方式和耗时
+// @Tags         邮件管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  TestSendRequest  true  "测试发送请求"
+// @Success      200  {object}  response.Response{data=mail.SendResult}  "发送成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      502  {object}  response.Response  "发送失败"
+// @Router       /admin/mail/test [post]
+func (h *Handler) TestSend(c *gin.Context) {
+	var req TestSendRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	appName := h.settingSvc.Get(constant.KeyAppName.String())
+	result, err := h.svc.SendWith(c.Request.Context(), req.Provider, &mail.Message{
+		To:      req.ToEmail,
+		Subject: fmt.Sprintf("这是一封来自「%s」的测试邮件", appName),
+		HTML: `<p>你好！</p>
+<p>如果您收到了这封邮件，说明网站的邮件发送配置正确。</p>`,
+	})
+	if err != nil {
+		if errors.Is(err, mail.ErrInvalidRecipient) || errors.Is(err, mail.ErrUnknownProvider) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusBadGateway, "发送测试邮件失败: "+err.Error())
+		return
+	}
+	response.Success(c, result, "测试邮件已发送，请检查收件箱")
+}
+
+// GetQueue 获取发送队列
+// @Summary      获取邮件发送队列
+// @Description  返回当前发送方式、等待发送和发送失败的邮件（不含正文）
+// @Tags         邮件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=mail.QueueStatus}  "获取成功"
+// @Router       /admin/mail/queue [get]
+func (h *Handler) GetQueue(c *gin.Context) {
+	response.Success(c, h.svc.QueueStatus(), "获取邮件发送队列成功")
+}
+
+// RetryFailed 重试发送失败的邮件
+// @Summary      重试发送失败的邮件
+// @Description  将发送失败的邮件重新加入发送，id 为空时重试全部
+// @Tags         邮件管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  RetryRequest  false  "重试请求"
+// @Success      200  {object}  response.Response  "已重新加入发送"
+// @Failure      500  {object}  response.Response  "保存队列失败"
+// @Router       /admin/mail/queue/retry [post]
+func (h *Handler) RetryFailed(c *gin.Context) {
+	var req RetryRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.FailBind(c, err, &req)
+			return
+		}
+	}
+	count, err := h.svc.RetryFailed(req.ID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "保存邮件队列失败: "+err.Error())
+		return
+	}
+	response.Success(c, gin.H{"count": count}, fmt.Sprintf("已重新加入发送 %d 封邮件", count))
+}
+
+// RemoveQueued 删除队列中的邮件
+// @Summary      删除队列中的邮件
+// @Description  从发送队列中删除一封等待发送或发送失败的邮件
+// @Tags         邮件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path  string  true  "队列项 ID"
+// @Success      200  {object}  response.Response  "删除成功"
+// @Failure      404  {object}  response.Response  "邮件不存在"
+// @Router       /admin/mail/queue/{id} [delete]
+func (h *Handler) RemoveQueued(c *gin.Context) {
+	found, err := h.svc.RemoveQueued(c.Param("id"))
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "保存邮件队列失败: "+err.Error())
+		return
+	}
+	if !found {
+		response.Fail(c, http.StatusNotFound, "邮件不存在或已发送")
+		return
+	}
+	response.Success(c, nil, "已从发送队列中删除")
+}
@@ -0,0 +1,176 @@
+/*
+ * @Description: 媒体库管理 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package media
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/media"
+)
+
+// Handler 媒体库 handler
+type Handler struct {
+	svc *media.Service
+}
+
+// NewHandler 创建媒体库 handler
+func NewHandler(svc *media.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// RegenerateRequest 重新生成缩略图的请求
+type RegenerateRequest struct {
+	FileIDs []string `json:"file_ids" binding:"required"`
+}
+
+// List 检索媒体文件
+// @Summary      获取媒体文件列表
+// @Description  跨目录列出所有上传的文件，支持按文件名和 MIME 类型过滤，并返回每个文件的引用位置
+// @Tags         媒体库
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page      query  int     false  "页码"
+// @Param        pageSize  query  int     false  "每页数量"
+// @Param        keyword   query  string  false  "文件名关键字"
+// @Param        mime      query  string  false  "MIME 类型前缀，例如 image/"
+// @Param        cursor    query  string  false  "分页游标，传入上一页返回的 next_cursor"
+// @Param        limit     query  int     false  "游标分页每页数量"
+// @Param        format    query  string  false  "传 ndjson 时逐行流式返回全部文件"
+// @Success      200  {object}  response.Response{data=media.ListResult}  "获取成功"
+// @Router       /admin/media [get]
+func (h *Handler) List(c *gin.Context) {
+	var opts media.ListOptions
+	if err := c.ShouldBindQuery(&opts); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	cur, err := response.ParseCursor(c, media.DefaultPageSize, media.MaxPageSize)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if cur.Enabled {
+		response.RespondCursor(c, cur, func(after *response.CursorKey, limit int) ([]*media.Item, int64, error) {
+			opts.Keyset, opts.PageSize = true, limit
+			if after != nil {
+				opts.After, opts.AfterID = &after.CreatedAt, after.ID
+			}
+			result, err := h.svc.List(c.Request.Context(), opts)
+			if err != nil {
+				return nil, 0, err
+			}
+			return result.List, result.Total, nil
+		}, func(item *media.Item) response.CursorKey {
+			return response.CursorKey{CreatedAt: item.CreatedAt, ID: item.ID}
+		}, "获取媒体文件成功")
+		return
+	}
+
+	result, err := h.svc.List(c.Request.Context(), opts)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, result, "获取媒体文件成功")
+}
+
+// Usage 获取文件的引用位置
+// @Summary      获取文件引用位置
+// @Description  返回引用该文件的文章、页面、相册和站点配置
+// @Tags         媒体库
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path  string  true  "文件公共ID"
+// @Success      200  {object}  response.Response{data=[]media.Reference}  "获取成功"
+// @Failure      400  {object}  response.Response  "无效的文件ID"
+// @Router       /admin/media/{id}/usage [get]
+func (h *Handler) Usage(c *gin.Context) {
+	refs, err := h.svc.Usage(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, refs, "获取文件引用成功")
+}
+
+// Duplicates 查找重复文件
+// @Summary      查找重复文件
+// @Description  按内容哈希查找重复的文件，按可释放空间从大到小排序。首次查找需要读取文件内容，耗时较长
+// @Tags         媒体库
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]media.DuplicateGroup}  "获取成功"
+// @Router       /admin/media/duplicates [get]
+func (h *Handler) Duplicates(c *gin.Context) {
+	groups, err := h.svc.Duplicates(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if groups == nil {
+		groups = []*media.DuplicateGroup{}
+	}
+	response.Success(c, groups, "查找重复文件成功")
+}
+
+// Cleanup 清理未使用的文件
+// @Summary      清理未使用的文件
+// @Description  查找文章图片存储策略下没有被任何内容引用的文件，默认只预览；dry_run 为 false 时移入回收站，可在回收站中恢复
+// @Tags         媒体库
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  media.CleanupRequest  false  "清理选项"
+// @Success      200  {object}  response.Response{data=media.CleanupResult}  "执行成功"
+// @Failure      409  {object}  response.Response  "清理任务正在运行"
+// @Router       /admin/media/cleanup [post]
+func (h *Handler) Cleanup(c *gin.Context) {
+	var req media.CleanupRequest
+	_ = c.ShouldBindJSON(&req)
+	result, err := h.svc.Cleanup(c.Request.Context(), req)
+	if err != nil {
+		if errors.Is(err, media.ErrCleanupRunning) {
+			response.Fail(c, http.StatusConflict, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if result.DryRun {
+		response.Success(c, result, "预览完成，未删除任何文件")
+		return
+	}
+	response.Success(c, result, "清理完成")
+}
+
+// Regenerate 重新生成缩略图
+// @Summary      重新生成缩略图
+// @Description  重置所选文件的缩略图状态，并在后台重新生成
+// @Tags         媒体库
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  RegenerateRequest  true  "文件公共ID列表"
+// @Success      202  {object}  response.Response{data=object{filesToProcess=int}}  "任务已派发"
+// @Failure      400  {object}  response.Response  "请求参数无效"
+// @Router       /admin/media/regenerate [post]
+func (h *Handler) Regenerate(c *gin.Context) {
+	var req RegenerateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	count, err := h.svc.Regenerate(c.Request.Context(), req.FileIDs)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.SuccessWithStatus(c, http.StatusAccepted, gin.H{"filesToProcess": count}, "后台任务已启动")
+}
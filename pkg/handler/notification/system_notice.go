@@ -0,0 +1,93 @@
+/*
+ * @Description: 系统通知中心 API Handler
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package notification
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
+	"github.com/gin-gonic/gin"
+)
+
+// ListSystemNotices 获取系统通知列表
+// @Summary 获取系统通知列表
+// @Description 获取系统运行时告警（如主题模板渲染错误），按时间倒序
+// @Tags 通知管理
+// @Produce json
+// @Security BearerAuth
+// @Param category query string false "通知分类，如 template_render"
+// @Param unread query bool false "是否只返回未读通知"
+// @Success 200 {object} response.Response{data=object{list=[]notification.SystemNotice,unread=int}}
+// @Router /notification/notices [get]
+func (h *Handler) ListSystemNotices(c *gin.Context) {
+	center := notification.DefaultNoticeCenter()
+	unreadOnly := c.Query("unread") == "true"
+	list := center.List(c.Query("category"), unreadOnly)
+
+	response.Success(c, gin.H{
+		"list":   list,
+		"unread": center.UnreadCount(),
+	}, "获取成功")
+}
+
+// GetLatestRenderError 获取最近一次未读的模板渲染错误
+// @Summary 获取最近的模板渲染错误
+// @Description 供前台错误横幅使用，仅管理员可访问
+// @Tags 通知管理
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=notification.SystemNotice}
+// @Router /notification/notices/render-error [get]
+func (h *Handler) GetLatestRenderError(c *gin.Context) {
+	notice, ok := notification.DefaultNoticeCenter().Latest(notification.NoticeCategoryTemplateRender)
+	if !ok {
+		response.Success(c, nil, "暂无渲染错误")
+		return
+	}
+	response.Success(c, notice, "获取成功")
+}
+
+// MarkSystemNoticeRead 标记系统通知为已读
+// @Summary 标记系统通知为已读
+// @Description id 为 all 时标记全部通知为已读
+// @Tags 通知管理
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "通知ID 或 all"
+// @Success 200 {object} response.Response
+// @Router /notification/notices/{id}/read [put]
+func (h *Handler) MarkSystemNoticeRead(c *gin.Context) {
+	idParam := c.Param("id")
+	var id uint64
+	if idParam != "all" {
+		parsed, err := strconv.ParseUint(idParam, 10, 64)
+		if err != nil || parsed == 0 {
+			response.Fail(c, http.StatusBadRequest, "无效的通知ID")
+			return
+		}
+		id = parsed
+	}
+
+	if !notification.DefaultNoticeCenter().MarkRead(id) && id != 0 {
+		response.Fail(c, http.StatusNotFound, "通知不存在")
+		return
+	}
+	response.Success(c, nil, "标记成功")
+}
+
+// ClearSystemNotices 清空系统通知
+// @Summary 清空系统通知
+// @Tags 通知管理
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Router /notification/notices [delete]
+func (h *Handler) ClearSystemNotices(c *gin.Context) {
+	notification.DefaultNoticeCenter().Clear()
+	response.Success(c, nil, "清空成功")
+}
@@ -0,0 +1,204 @@
+/*
+ * @Description: 第三方登录与账号绑定 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package oauth
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/oauth"
+)
+
+// Handler 第三方登录 handler
+type Handler struct {
+	svc *oauth.Service
+}
+
+// NewHandler 创建第三方登录 handler
+func NewHandler(svc *oauth.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// ListProviders 获取已启用的第三方登录方式
+// @Summary      获取第三方登录方式
+// @Tags         第三方登录
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]oauth.ProviderInfo}  "获取成功"
+// @Router       /auth/oauth/providers [get]
+func (h *Handler) ListProviders(c *gin.Context) {
+	response.Success(c, h.svc.Providers(), "获取第三方登录方式成功")
+}
+
+// Authorize 跳转到第三方授权页面
+// @Summary      发起第三方登录
+// @Description  302 跳转到第三方授权页面，授权完成后回到 redirect 指定的站内页面并携带 oauth_code 或 oauth_error 参数
+// @Tags         第三方登录
+// @Param        provider  path   string  true   "提供方 ID"
+// @Param        redirect  query  string  false  "登录完成后返回的站内路径，默认 /"
+// @Success      302  "跳转到第三方授权页面"
+// @Failure      404  {object}  response.Response  "登录方式不存在"
+// @Router       /auth/oauth/{provider}/authorize [get]
+func (h *Handler) Authorize(c *gin.Context) {
+	authURL, err := h.svc.AuthorizeURL(c.Request.Context(), c.Param("provider"), oauth.ModeLogin, 0, c.Query("redirect"), requestBaseURL(c))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// Callback 第三方授权回调
+// @Summary      第三方授权回调
+// @Description  由第三方提供方调用，处理完成后跳转回前端页面：登录成功携带 oauth_code，绑定成功携带 oauth_linked，失败携带 oauth_error
+// @Tags         第三方登录
+// @Param        provider  path   string  true  "提供方 ID"
+// @Param        code      query  string  true  "授权码"
+// @Param        state     query  string  true  "授权状态"
+// @Success      302  "跳转回前端页面"
+// @Router       /auth/oauth/{provider}/callback [get]
+func (h *Handler) Callback(c *gin.Context) {
+	providerID := c.Param("provider")
+	result, err := h.svc.Callback(c.Request.Context(), providerID, c.Query("code"), c.Query("state"), requestBaseURL(c))
+
+	redirect := "/"
+	if result != nil {
+		redirect = result.Redirect
+	}
+	params := url.Values{}
+	switch {
+	case c.Query("error") != "":
+		// 用户在第三方页面取消授权
+		params.Set("oauth_error", "已取消授权")
+	case err != nil:
+		params.Set("oauth_error", err.Error())
+	case result.Mode == oauth.ModeLink:
+		params.Set("oauth_linked", providerID)
+	default:
+		params.Set("oauth_code", result.LoginCode)
+	}
+
+	sep := "?"
+	if strings.Contains(redirect, "?") {
+		sep = "&"
+	}
+	c.Redirect(http.StatusFound, redirect+sep+params.Encode())
+}
+
+// LinkRequest 绑定第三方账号的请求
+type LinkRequest struct {
+	Redirect string `json:"redirect"`
+}
+
+// Link 为当前用户发起第三方账号绑定
+// @Summary      绑定第三方账号
+// @Description  返回第三方授权地址，前端跳转后完成绑定，回调后回到 redirect 并携带 oauth_linked 参数
+// @Tags         第三方登录
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        provider  path  string       true   "提供方 ID"
+// @Param        body      body  LinkRequest  false  "绑定完成后返回的站内路径"
+// @Success      200  {object}  response.Response{data=object{url=string}}  "获取成功"
+// @Router       /user/oauth/{provider}/link [post]
+func (h *Handler) Link(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "未登录")
+		return
+	}
+	var req LinkRequest
+	_ = c.ShouldBindJSON(&req)
+
+	authURL, err := h.svc.AuthorizeURL(c.Request.Context(), c.Param("provider"), oauth.ModeLink, userID, req.Redirect, requestBaseURL(c))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, gin.H{"url": authURL}, "获取授权地址成功")
+}
+
+// ListLinks 获取当前用户绑定的第三方账号
+// @Summary      获取已绑定的第三方账号
+// @Tags         第三方登录
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]oauth.Link}  "获取成功"
+// @Router       /user/oauth [get]
+func (h *Handler) ListLinks(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "未登录")
+		return
+	}
+	links, err := h.svc.Links(c.Request.Context(), userID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, links, "获取绑定信息成功")
+}
+
+// Unlink 解除当前用户的第三方账号绑定
+// @Summary      解除第三方账号绑定
+// @Tags         第三方登录
+// @Security     BearerAuth
+// @Produce      json
+// @Param        provider  path  string  true  "提供方 ID"
+// @Success      200  {object}  response.Response  "解除成功"
+// @Router       /user/oauth/{provider} [delete]
+func (h *Handler) Unlink(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "未登录")
+		return
+	}
+	if err := h.svc.Unlink(c.Request.Context(), userID, c.Param("provider")); err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, nil, "已解除绑定")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	if errors.Is(err, oauth.ErrProviderNotFound) {
+		response.Fail(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Fail(c, http.StatusInternalServerError, err.Error())
+}
+
+// currentUserID 从 JWT 中解析当前用户的数据库 ID
+func currentUserID(c *gin.Context) (uint, bool) {
+	value, exists := c.Get(auth.ClaimsKey)
+	if !exists {
+		return 0, false
+	}
+	claims, ok := value.(*auth.CustomClaims)
+	if !ok {
+		return 0, false
+	}
+	userID, entityType, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		return 0, false
+	}
+	return userID, true
+}
+
+// requestBaseURL 未配置站点地址时，根据当前请求推断回调地址
+func requestBaseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host
+}
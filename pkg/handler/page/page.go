@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/conditional"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/page"
@@ -129,6 +130,9 @@ func (h *Handler) GetByPath(c *gin.Context) {
 		return
 	}
 
+	if conditional.New("page", page.ID, page.UpdatedAt).Touch(page.UpdatedAt).NotModified(c) {
+		return
+	}
 	response.Success(c, page, "获取页面成功")
 }
 
@@ -0,0 +1,106 @@
+/*
+ * @Description: 自定义页面数据绑定 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package pagedata
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/pagedata"
+)
+
+// Handler 页面数据绑定 handler
+type Handler struct {
+	svc *pagedata.Service
+}
+
+// NewHandler 创建页面数据绑定 handler
+func NewHandler(svc *pagedata.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// SetBindingsRequest 保存数据绑定请求
+type SetBindingsRequest struct {
+	Bindings []pagedata.Binding `json:"bindings"`
+}
+
+// GetBindings 获取页面的数据绑定
+// @Summary      获取页面数据绑定
+// @Tags         页面管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path  string  true  "页面ID"
+// @Success      200  {object}  response.Response{data=[]pagedata.Binding}  "获取成功"
+// @Failure      404  {object}  response.Response  "页面不存在"
+// @Router       /admin/pages/{id}/bindings [get]
+func (h *Handler) GetBindings(c *gin.Context) {
+	items, err := h.svc.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, items, "获取数据绑定成功")
+}
+
+// SetBindings 保存页面的数据绑定
+// @Summary      保存页面数据绑定
+// @Description  覆盖页面的全部数据绑定，传入空列表时清除。服务端渲染页面时解析绑定并注入 initialData.bindings
+// @Tags         页面管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path  string              true  "页面ID"
+// @Param        body  body  SetBindingsRequest  true  "数据绑定"
+// @Success      200  {object}  response.Response{data=[]pagedata.Binding}  "保存成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      404  {object}  response.Response  "页面不存在"
+// @Router       /admin/pages/{id}/bindings [put]
+func (h *Handler) SetBindings(c *gin.Context) {
+	var req SetBindingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	items, err := h.svc.Set(c.Request.Context(), c.Param("id"), req.Bindings)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, items, "保存数据绑定成功")
+}
+
+// GetPageData 获取自定义页面的绑定数据
+// @Summary      获取自定义页面的绑定数据
+// @Description  与服务端渲染时注入的 initialData.bindings 相同，供前台在客户端路由切换到自定义页面时使用
+// @Tags         页面管理
+// @Produce      json
+// @Param        path  query  string  true  "页面路径，如 /landing"
+// @Success      200  {object}  response.Response{data=map[string]interface{}}  "获取成功"
+// @Failure      404  {object}  response.Response  "页面不存在或没有数据绑定"
+// @Router       /public/page-data [get]
+func (h *Handler) GetPageData(c *gin.Context) {
+	data, ok := h.svc.ResolvePath(c.Request.Context(), c.Query("path"))
+	if !ok {
+		response.Fail(c, http.StatusNotFound, "页面不存在或没有数据绑定")
+		return
+	}
+	response.Success(c, data, "获取页面数据成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, pagedata.ErrPageNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, pagedata.ErrInvalidName), errors.Is(err, pagedata.ErrDuplicateName), errors.Is(err, pagedata.ErrInvalidType),
+		errors.Is(err, pagedata.ErrInvalidLimit), errors.Is(err, pagedata.ErrTooManyBinding):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * @Description: 文章永久链接管理 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package permalink
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/permalink"
+)
+
+// Handler 永久链接管理 handler
+type Handler struct {
+	svc *permalink.Service
+}
+
+// NewHandler 创建永久链接管理 handler
+func NewHandler(svc *permalink.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// StrategyRequest 永久链接策略
+type StrategyRequest struct {
+	Strategy string `json:"strategy" binding:"required"` // abbrlink、date、title 或 pattern
+	Pattern  string `json:"pattern"`                     // pattern 策略使用的模式
+}
+
+// GetStatus 获取永久链接配置和冲突
+// @Summary      获取永久链接配置
+// @Description  返回当前策略，以及重复或与其他文章 ID 相同的永久链接
+// @Tags         永久链接管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=permalink.Status}  "获取成功"
+// @Router       /admin/permalinks [get]
+func (h *Handler) GetStatus(c *gin.Context) {
+	status, err := h.svc.Status(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, status, "获取永久链接配置成功")
+}
+
+// Preview 预览切换策略后的永久链接
+// @Summary      预览永久链接策略
+// @Description  返回按指定策略重新生成后永久链接会变化的文章，不写入任何数据
+// @Tags         永久链接管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  StrategyRequest  true  "策略和模式"
+// @Success      200  {object}  response.Response{data=[]permalink.Change}  "预览成功"
+// @Router       /admin/permalinks/preview [post]
+func (h *Handler) Preview(c *gin.Context) {
+	var req StrategyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	changes, err := h.svc.Preview(c.Request.Context(), req.Strategy, req.Pattern)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, changes, "预览成功")
+}
+
+// Apply 切换永久链接策略
+// @Summary      切换永久链接策略
+// @Description  保存策略并为已有文章重新生成永久链接，开启自动重定向时旧地址会 301 到新地址
+// @Tags         永久链接管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  StrategyRequest  true  "策略和模式"
+// @Success      200  {object}  response.Response{data=permalink.ApplyResult}  "切换完成"
+// @Router       /admin/permalinks/apply [post]
+func (h *Handler) Apply(c *gin.Context) {
+	var req StrategyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	result, err := h.svc.Apply(c.Request.Context(), req.Strategy, req.Pattern)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, result, "切换永久链接策略完成")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	if errors.Is(err, permalink.ErrInvalidStrategy) {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Fail(c, http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,148 @@
+/*
+ * @Description: 插件管理 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package plugin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/plugin"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+)
+
+// Handler 插件管理 handler
+type Handler struct {
+	manager *plugin.Manager
+}
+
+// NewHandler 创建插件管理 handler
+func NewHandler(manager *plugin.Manager) *Handler {
+	return &Handler{manager: manager}
+}
+
+// SetEnabledRequest 启用或停用插件的请求
+type SetEnabledRequest struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+// SettingsResponse 插件设置
+type SettingsResponse struct {
+	Panels []plugin.SettingsPanel `json:"panels"`
+	Values map[string]string      `json:"values"`
+}
+
+// List 获取插件列表
+// @Summary      获取插件列表
+// @Description  返回内置插件和外部插件的信息、运行状态，以及注册的事件订阅、模板函数、路由和设置面板
+// @Tags         插件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]plugin.Status}  "获取成功"
+// @Router       /admin/plugins [get]
+func (h *Handler) List(c *gin.Context) {
+	response.Success(c, h.manager.List(), "获取插件列表成功")
+}
+
+// SetEnabled 启用或停用插件
+// @Summary      启用或停用插件
+// @Description  保存插件的启用状态，重启后生效
+// @Tags         插件管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        name  path  string             true  "插件名称"
+// @Param        body  body  SetEnabledRequest  true  "启用状态"
+// @Success      200  {object}  response.Response{data=plugin.Status}  "保存成功"
+// @Failure      404  {object}  response.Response  "插件不存在"
+// @Router       /admin/plugins/{name}/enabled [put]
+func (h *Handler) SetEnabled(c *gin.Context) {
+	var req SetEnabledRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	status, err := h.manager.SetEnabled(c.Param("name"), *req.Enabled)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, status, "已保存，重启后生效")
+}
+
+// GetSettings 获取插件设置
+// @Summary      获取插件设置
+// @Description  返回插件注册的设置面板和当前设置值
+// @Tags         插件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        name  path  string  true  "插件名称"
+// @Success      200  {object}  response.Response{data=SettingsResponse}  "获取成功"
+// @Failure      404  {object}  response.Response  "插件不存在"
+// @Router       /admin/plugins/{name}/settings [get]
+func (h *Handler) GetSettings(c *gin.Context) {
+	panels, values, err := h.manager.Settings(c.Param("name"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, SettingsResponse{Panels: panels, Values: values}, "获取插件设置成功")
+}
+
+// UpdateSettings 保存插件设置
+// @Summary      保存插件设置
+// @Description  只接受设置面板中声明的字段，保存后立即通知运行中的插件
+// @Tags         插件管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        name  path  string             true  "插件名称"
+// @Param        body  body  map[string]string  true  "设置值"
+// @Success      200  {object}  response.Response  "保存成功"
+// @Failure      400  {object}  response.Response  "设置项无效"
+// @Failure      404  {object}  response.Response  "插件不存在"
+// @Router       /admin/plugins/{name}/settings [put]
+func (h *Handler) UpdateSettings(c *gin.Context) {
+	var values map[string]string
+	if err := c.ShouldBindJSON(&values); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	if err := h.manager.UpdateSettings(c.Param("name"), values); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "插件设置已保存")
+}
+
+// Restart 重启外部插件
+// @Summary      重启外部插件
+// @Description  重启外部插件的进程，内置插件不支持单独重启
+// @Tags         插件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        name  path  string  true  "插件名称"
+// @Success      200  {object}  response.Response{data=plugin.Status}  "重启成功"
+// @Failure      400  {object}  response.Response  "插件不支持重启"
+// @Failure      404  {object}  response.Response  "插件不存在"
+// @Router       /admin/plugins/{name}/restart [post]
+func (h *Handler) Restart(c *gin.Context) {
+	status, err := h.manager.Restart(c.Param("name"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, status, "插件已重启")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	if errors.Is(err, plugin.ErrPluginNotFound) {
+		response.Fail(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Fail(c, http.StatusBadRequest, err.Error())
+}
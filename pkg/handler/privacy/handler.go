@@ -0,0 +1,104 @@
+/*
+ * @Description: 个人数据导出与匿名化 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package privacy
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/privacy"
+)
+
+// Handler 隐私请求 handler
+type Handler struct {
+	svc *privacy.Service
+}
+
+// NewHandler 创建隐私请求 handler
+func NewHandler(svc *privacy.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// ExportData 导出个人数据
+// @Summary      导出个人数据
+// @Description  按评论者邮箱或用户ID导出评论、订阅和账号信息（访问记录无法关联到个人，不在导出范围内），format=zip 时以 ZIP 文件下载
+// @Tags         隐私请求
+// @Security     BearerAuth
+// @Produce      json
+// @Param        email    query  string  false  "评论者邮箱"
+// @Param        user_id  query  string  false  "用户公共ID"
+// @Param        format   query  string  false  "json（默认）或 zip"
+// @Success      200  {object}  response.Response{data=privacy.Export}  "导出成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      404  {object}  response.Response  "没有相关数据"
+// @Router       /admin/privacy/export [get]
+func (h *Handler) ExportData(c *gin.Context) {
+	var subject privacy.Subject
+	if err := c.ShouldBindQuery(&subject); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+
+	if c.Query("format") == "zip" {
+		data, err := h.svc.ExportZip(c.Request.Context(), subject)
+		if err != nil {
+			h.fail(c, err)
+			return
+		}
+		filename := fmt.Sprintf("personal-data-%s.zip", time.Now().Format("20060102150405"))
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+		c.Data(http.StatusOK, "application/zip", data)
+		return
+	}
+
+	data, err := h.svc.Export(c.Request.Context(), subject)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, data, "导出个人数据成功")
+}
+
+// AnonymizeData 匿名化个人数据
+// @Summary      匿名化个人数据
+// @Description  清除相关评论的邮箱、网站、IP、归属地和 User-Agent，删除订阅记录；评论内容、账号本身和访问记录保留
+// @Tags         隐私请求
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  privacy.Subject  true  "邮箱或用户ID"
+// @Success      200  {object}  response.Response{data=privacy.AnonymizeResult}  "匿名化成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Router       /admin/privacy/anonymize [post]
+func (h *Handler) AnonymizeData(c *gin.Context) {
+	var subject privacy.Subject
+	if err := c.ShouldBindJSON(&subject); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	result, err := h.svc.Anonymize(c.Request.Context(), subject)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, result, "匿名化个人数据成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, privacy.ErrEmptySubject), errors.Is(err, privacy.ErrInvalidUserID):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, privacy.ErrSubjectMissing):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,169 @@
+/*
+ * @Description: 文章与即刻表态 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package reaction
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/reaction"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+)
+
+// Handler 表态 handler
+type Handler struct {
+	svc *reaction.Service
+}
+
+// NewHandler 创建表态 handler
+func NewHandler(svc *reaction.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// ReactRequest 表态请求
+type ReactRequest struct {
+	Reaction string `json:"reaction" binding:"required"`
+}
+
+// ReactionsResponse 目标的表态信息
+type ReactionsResponse struct {
+	Allowed []string        `json:"allowed"`
+	Counts  reaction.Counts `json:"counts"`
+}
+
+// GetReactions 获取表态计数
+// @Summary      获取表态计数
+// @Description  获取文章或即刻的表态计数及允许的表态列表
+// @Tags         表态
+// @Produce      json
+// @Param        type  path  string  true  "目标类型：article 或 essay"
+// @Param        id    path  string  true  "目标ID，文章可使用公共ID或abbrlink"
+// @Success      200  {object}  response.Response{data=ReactionsResponse}  "获取成功"
+// @Failure      400  {object}  response.Response  "目标不合法"
+// @Router       /public/reactions/{type}/{id} [get]
+func (h *Handler) GetReactions(c *gin.Context) {
+	targetType := c.Param("type")
+	targetID, err := h.svc.Resolve(c.Request.Context(), targetType, c.Param("id"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	counts, err := h.svc.Get(c.Request.Context(), targetType, targetID)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, ReactionsResponse{
+		Allowed: h.svc.AllowedReactions(),
+		Counts:  counts,
+	}, "获取表态成功")
+}
+
+// React 表态
+// @Summary      表态
+// @Description  对文章或即刻表态，无需登录，同一访客对同一目标的同一表态只计一次
+// @Tags         表态
+// @Accept       json
+// @Produce      json
+// @Param        type  path  string        true  "目标类型：article 或 essay"
+// @Param        id    path  string        true  "目标ID"
+// @Param        body  body  ReactRequest  true  "表态"
+// @Success      200  {object}  response.Response{data=reaction.Result}  "表态成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      429  {object}  response.Response  "请求过于频繁"
+// @Router       /public/reactions/{type}/{id} [post]
+func (h *Handler) React(c *gin.Context) {
+	var req ReactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	result, err := h.svc.React(c.Request.Context(), c.Param("type"), c.Param("id"), req.Reaction, util.GetRealClientIP(c), c.Request.UserAgent())
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, result, "表态成功")
+}
+
+// Unreact 撤销表态
+// @Summary      撤销表态
+// @Description  撤销当前访客对目标的某个表态
+// @Tags         表态
+// @Produce      json
+// @Param        type      path   string  true  "目标类型：article 或 essay"
+// @Param        id        path   string  true  "目标ID"
+// @Param        reaction  query  string  true  "表态"
+// @Success      200  {object}  response.Response{data=reaction.Result}  "撤销成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Router       /public/reactions/{type}/{id} [delete]
+func (h *Handler) Unreact(c *gin.Context) {
+	result, err := h.svc.Unreact(c.Request.Context(), c.Param("type"), c.Param("id"), c.Query("reaction"), util.GetRealClientIP(c), c.Request.UserAgent())
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, result, "已撤销表态")
+}
+
+// ListReactions 获取表态统计
+// @Summary      获取表态统计
+// @Description  按总数倒序列出各目标的表态计数
+// @Tags         表态
+// @Security     BearerAuth
+// @Produce      json
+// @Param        type  query  string  false  "目标类型：article 或 essay，为空返回全部"
+// @Success      200  {object}  response.Response{data=[]reaction.TargetCounts}  "获取成功"
+// @Router       /admin/reactions [get]
+func (h *Handler) ListReactions(c *gin.Context) {
+	list, err := h.svc.List(c.Request.Context(), c.Query("type"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, list, "获取表态统计成功")
+}
+
+// ResetReactions 重置表态计数
+// @Summary      重置表态计数
+// @Description  清零目标的表态计数，指定 reaction 时只清零该表态
+// @Tags         表态
+// @Security     BearerAuth
+// @Produce      json
+// @Param        type      path   string  true   "目标类型：article 或 essay"
+// @Param        id        path   string  true   "目标ID"
+// @Param        reaction  query  string  false  "表态，为空清零全部"
+// @Success      200  {object}  response.Response{data=reaction.Counts}  "重置成功"
+// @Router       /admin/reactions/{type}/{id} [delete]
+func (h *Handler) ResetReactions(c *gin.Context) {
+	targetType := c.Param("type")
+	targetID, err := h.svc.Resolve(c.Request.Context(), targetType, c.Param("id"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	if err := h.svc.Reset(c.Request.Context(), targetType, targetID, c.Query("reaction")); err != nil {
+		h.fail(c, err)
+		return
+	}
+	counts, err := h.svc.Get(c.Request.Context(), targetType, targetID)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, counts, "重置表态成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	if errors.Is(err, reaction.ErrInvalidTarget) || errors.Is(err, reaction.ErrReactionNotAllowed) {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Fail(c, http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,103 @@
+/*
+ * @Description: 重定向管理 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package redirect
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/redirect"
+)
+
+// Handler 重定向管理 handler
+type Handler struct {
+	svc *redirect.Service
+}
+
+// NewHandler 创建重定向管理 handler
+func NewHandler(svc *redirect.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// CreateRedirectRequest 添加重定向请求
+type CreateRedirectRequest struct {
+	From string `json:"from" binding:"required"`
+	To   string `json:"to" binding:"required"`
+	Code int    `json:"code"` // 默认 301
+}
+
+// ListRedirects 获取重定向规则
+// @Summary      获取重定向规则
+// @Description  返回手动添加和文章永久链接变化时自动添加的重定向规则
+// @Tags         重定向管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]redirect.Rule}  "获取成功"
+// @Router       /admin/redirects [get]
+func (h *Handler) ListRedirects(c *gin.Context) {
+	rules, err := h.svc.List(c.Request.Context())
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, rules, "获取重定向规则成功")
+}
+
+// CreateRedirect 添加重定向规则
+// @Summary      添加重定向规则
+// @Description  来源地址已有规则时覆盖；指向来源地址的规则会改为直接指向新地址
+// @Tags         重定向管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateRedirectRequest  true  "来源地址、目标地址和状态码"
+// @Success      200  {object}  response.Response{data=redirect.Rule}  "添加成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Router       /admin/redirects [post]
+func (h *Handler) CreateRedirect(c *gin.Context) {
+	var req CreateRedirectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	rule, err := h.svc.Add(c.Request.Context(), req.From, req.To, req.Code, redirect.SourceManual)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, rule, "添加重定向规则成功")
+}
+
+// DeleteRedirect 删除重定向规则
+// @Summary      删除重定向规则
+// @Tags         重定向管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        from  query  string  true  "来源地址"
+// @Success      200  {object}  response.Response  "删除成功"
+// @Failure      404  {object}  response.Response  "规则不存在"
+// @Router       /admin/redirects [delete]
+func (h *Handler) DeleteRedirect(c *gin.Context) {
+	if err := h.svc.Delete(c.Request.Context(), c.Query("from")); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "删除重定向规则成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, redirect.ErrNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, redirect.ErrInvalidPath), errors.Is(err, redirect.ErrInvalidCode), errors.Is(err, redirect.ErrSamePath):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/rss"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -84,14 +85,8 @@ func (h *Handler) getSiteURL(c *gin.Context) string {
 	}
 
 	// 如果配置中没有，则从请求中获取
-	scheme := "http"
-	if c.Request.TLS != nil {
-		scheme = "https"
-	}
-	// 优先使用 X-Forwarded-Proto
-	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
-		scheme = proto
-	}
+	// 仅在请求来自可信代理时采用 X-Forwarded-Proto
+	scheme := util.GetRequestScheme(c)
 
 	host := c.Request.Host
 	return fmt.Sprintf("%s://%s", scheme, host)
@@ -0,0 +1,101 @@
+/*
+ * @Description: 程序自更新 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package selfupdate
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/selfupdate"
+)
+
+// Handler 程序自更新 handler
+type Handler struct {
+	svc *selfupdate.Service
+}
+
+// NewHandler 创建程序自更新 handler
+func NewHandler(svc *selfupdate.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// Check 检查新版本
+// @Summary      检查程序更新
+// @Description  从发布信息地址获取最新版本，返回更新说明以及是否有适用于当前平台的发布包
+// @Tags         程序更新
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=selfupdate.CheckResult}  "检查成功"
+// @Failure      502  {object}  response.Response  "获取发布信息失败"
+// @Router       /admin/update/check [get]
+func (h *Handler) Check(c *gin.Context) {
+	result, err := h.svc.Check(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusBadGateway, err.Error())
+		return
+	}
+	response.Success(c, result, "检查更新成功")
+}
+
+// Apply 更新到最新版本
+// @Summary      更新程序
+// @Description  在后台下载并校验最新版本，替换程序后自动重启；新版本未通过健康检查时自动回滚。进度通过状态接口查询
+// @Tags         程序更新
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response  "已开始更新"
+// @Failure      400  {object}  response.Response  "当前部署方式不支持或已是最新版本"
+// @Failure      409  {object}  response.Response  "已有更新正在进行"
+// @Router       /admin/update/apply [post]
+func (h *Handler) Apply(c *gin.Context) {
+	if err := h.svc.Apply(c.Request.Context()); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, h.svc.Status(), "已开始更新，完成后将自动重启")
+}
+
+// Status 获取更新状态
+// @Summary      获取程序更新状态
+// @Tags         程序更新
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=selfupdate.State}  "获取成功"
+// @Router       /admin/update/status [get]
+func (h *Handler) Status(c *gin.Context) {
+	response.Success(c, h.svc.Status(), "获取更新状态成功")
+}
+
+// Rollback 回滚到更新前的版本
+// @Summary      回滚程序更新
+// @Description  恢复最近一次更新前的程序并重启
+// @Tags         程序更新
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response  "已回滚"
+// @Failure      400  {object}  response.Response  "没有可回滚的版本"
+// @Router       /admin/update/rollback [post]
+func (h *Handler) Rollback(c *gin.Context) {
+	if err := h.svc.Rollback(); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "已回滚，程序即将重启")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, selfupdate.ErrUpdateRunning):
+		response.Fail(c, http.StatusConflict, err.Error())
+	case errors.Is(err, selfupdate.ErrUnsupported), errors.Is(err, selfupdate.ErrUpToDate), errors.Is(err, selfupdate.ErrNoRollback):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,63 @@
+/*
+ * @Description: 页面 SEO 检查 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package seoaudit
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/seoaudit"
+)
+
+// Handler SEO 检查 handler
+type Handler struct {
+	svc *seoaudit.Service
+}
+
+// NewHandler 创建 SEO 检查 handler
+func NewHandler(svc *seoaudit.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// AuditRequest SEO 检查请求
+type AuditRequest struct {
+	Route string `json:"route" binding:"required"`
+}
+
+// AuditPage 检查页面 SEO
+// @Summary      检查页面 SEO
+// @Description  通过实际的渲染流程渲染指定前台路由，检查标题、描述、og:image、canonical、h1 数量和图片 alt 覆盖率
+// @Tags         SEO
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  AuditRequest  true  "要检查的路由，如 /posts/hello"
+// @Success      200  {object}  response.Response{data=seoaudit.Report}  "检查完成"
+// @Failure      400  {object}  response.Response  "路由不合法"
+// @Router       /admin/seo/audit [post]
+func (h *Handler) AuditPage(c *gin.Context) {
+	var req AuditRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	report, err := h.svc.Audit(c.Request.Context(), req.Route)
+	if err != nil {
+		switch {
+		case errors.Is(err, seoaudit.ErrInvalidRoute):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, seoaudit.ErrNotReady):
+			response.Fail(c, http.StatusServiceUnavailable, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+	response.Success(c, report, "SEO 检查完成")
+}
@@ -76,13 +76,14 @@ func (h *SettingHandler) TestEmail(c *gin.Context) {
 
 // GetSiteConfig 处理获取公开的站点配置的请求
 // @Summary      获取站点配置
-// @Description  获取公开的站点配置信息（无需认证）
+// @Description  获取公开的站点配置信息（无需认证），features 字段汇总评论、搜索、表态、订阅等功能的开关状态
 // @Tags         站点设置
 // @Produce      json
 // @Success      200  {object}  response.Response  "获取成功"
 // @Router       /public/site-config [get]
 func (h *SettingHandler) GetSiteConfig(c *gin.Context) {
 	siteConfig := h.settingSvc.GetSiteConfig()
+	siteConfig["features"] = setting.Features(h.settingSvc)
 	response.Success(c, siteConfig, "获取站点配置成功")
 }
 
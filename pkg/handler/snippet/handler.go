@@ -0,0 +1,194 @@
+/*
+ * @Description: 自定义 CSS/JS 代码片段管理 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package snippet
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/snippet"
+)
+
+// Handler 代码片段 handler
+type Handler struct {
+	svc *snippet.Service
+}
+
+// NewHandler 创建代码片段 handler
+func NewHandler(svc *snippet.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// ToggleRequest 启停代码片段的请求
+type ToggleRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ListSnippets 获取代码片段列表
+// @Summary      获取代码片段列表
+// @Description  返回全部自定义 CSS/JS 代码片段
+// @Tags         代码片段
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]snippet.Snippet}  "获取成功"
+// @Router       /admin/snippets [get]
+func (h *Handler) ListSnippets(c *gin.Context) {
+	response.Success(c, h.svc.List(), "获取代码片段成功")
+}
+
+// CreateSnippet 创建代码片段
+// @Summary      创建代码片段
+// @Description  创建自定义 CSS/JS 代码片段，routes 为空表示全站生效，以 * 结尾表示前缀匹配
+// @Tags         代码片段
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  snippet.SnippetRequest  true  "代码片段"
+// @Success      200  {object}  response.Response{data=snippet.Snippet}  "创建成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Router       /admin/snippets [post]
+func (h *Handler) CreateSnippet(c *gin.Context) {
+	var req snippet.SnippetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	item, err := h.svc.Create(c.Request.Context(), &req)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, item, "创建代码片段成功")
+}
+
+// UpdateSnippet 修改代码片段
+// @Summary      修改代码片段
+// @Description  修改代码片段，修改前的内容会保存为历史版本
+// @Tags         代码片段
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path  string                  true  "片段ID"
+// @Param        body  body  snippet.SnippetRequest  true  "代码片段"
+// @Success      200  {object}  response.Response{data=snippet.Snippet}  "修改成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      404  {object}  response.Response  "代码片段不存在"
+// @Router       /admin/snippets/{id} [put]
+func (h *Handler) UpdateSnippet(c *gin.Context) {
+	var req snippet.SnippetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	item, err := h.svc.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, item, "修改代码片段成功")
+}
+
+// ToggleSnippet 启用或停用代码片段
+// @Summary      启用或停用代码片段
+// @Tags         代码片段
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path  string         true  "片段ID"
+// @Param        body  body  ToggleRequest  true  "是否启用"
+// @Success      200  {object}  response.Response{data=snippet.Snippet}  "操作成功"
+// @Failure      404  {object}  response.Response  "代码片段不存在"
+// @Router       /admin/snippets/{id}/enabled [put]
+func (h *Handler) ToggleSnippet(c *gin.Context) {
+	var req ToggleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	item, err := h.svc.SetEnabled(c.Request.Context(), c.Param("id"), req.Enabled)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, item, "操作成功")
+}
+
+// DeleteSnippet 删除代码片段
+// @Summary      删除代码片段
+// @Description  删除代码片段及其全部历史版本
+// @Tags         代码片段
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path  string  true  "片段ID"
+// @Success      200  {object}  response.Response  "删除成功"
+// @Failure      404  {object}  response.Response  "代码片段不存在"
+// @Router       /admin/snippets/{id} [delete]
+func (h *Handler) DeleteSnippet(c *gin.Context) {
+	if err := h.svc.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "删除代码片段成功")
+}
+
+// GetSnippetHistory 获取代码片段历史版本
+// @Summary      获取代码片段历史版本
+// @Description  按版本号从新到旧返回代码片段的历史版本
+// @Tags         代码片段
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path  string  true  "片段ID"
+// @Success      200  {object}  response.Response{data=[]snippet.Snippet}  "获取成功"
+// @Failure      404  {object}  response.Response  "代码片段不存在"
+// @Router       /admin/snippets/{id}/history [get]
+func (h *Handler) GetSnippetHistory(c *gin.Context) {
+	versions, err := h.svc.History(c.Param("id"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, versions, "获取历史版本成功")
+}
+
+// RollbackSnippet 回滚代码片段
+// @Summary      回滚代码片段
+// @Description  将代码片段恢复到指定历史版本，回滚会生成一个新版本，启停状态保持不变
+// @Tags         代码片段
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id       path  string  true  "片段ID"
+// @Param        version  path  int     true  "历史版本号"
+// @Success      200  {object}  response.Response{data=snippet.Snippet}  "回滚成功"
+// @Failure      404  {object}  response.Response  "代码片段或版本不存在"
+// @Router       /admin/snippets/{id}/history/{version}/rollback [post]
+func (h *Handler) RollbackSnippet(c *gin.Context) {
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "版本号不合法")
+		return
+	}
+	item, err := h.svc.Rollback(c.Request.Context(), c.Param("id"), version)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, item, "回滚代码片段成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, snippet.ErrSnippetNotFound), errors.Is(err, snippet.ErrVersionNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, snippet.ErrInvalidSnippet):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
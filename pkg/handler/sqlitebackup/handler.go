@@ -0,0 +1,109 @@
+/*
+ * @Description: SQLite 在线备份 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package sqlitebackup
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/sqlitebackup"
+)
+
+// Handler SQLite 在线备份 handler
+type Handler struct {
+	svc *sqlitebackup.Service
+}
+
+// NewHandler 创建 SQLite 在线备份 handler
+func NewHandler(svc *sqlitebackup.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// ListBackups 获取 SQLite 备份列表
+// @Summary      获取 SQLite 备份列表
+// @Description  返回本地保存的 SQLite 在线备份，按时间从新到旧排序
+// @Tags         SQLite 备份
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]sqlitebackup.BackupInfo}  "获取成功"
+// @Router       /admin/sqlite-backups [get]
+func (h *Handler) ListBackups(c *gin.Context) {
+	backups, err := h.svc.List()
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, backups, "获取备份列表成功")
+}
+
+// CreateBackup 立即生成 SQLite 在线备份
+// @Summary      创建 SQLite 在线备份
+// @Description  通过 SQLite 在线备份接口生成数据库的一致快照，无需停止服务
+// @Tags         SQLite 备份
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=sqlitebackup.BackupInfo}  "创建成功"
+// @Failure      400  {object}  response.Response  "当前数据库不是 SQLite"
+// @Failure      409  {object}  response.Response  "已有备份正在进行"
+// @Router       /admin/sqlite-backups [post]
+func (h *Handler) CreateBackup(c *gin.Context) {
+	info, err := h.svc.Create(c.Request.Context())
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, info, "创建备份成功")
+}
+
+// DownloadBackup 下载 SQLite 备份
+// @Summary      下载 SQLite 备份
+// @Tags         SQLite 备份
+// @Security     BearerAuth
+// @Param        filename  path  string  true  "备份文件名"
+// @Success      200  {file}  binary  "备份文件"
+// @Failure      404  {object}  response.Response  "备份不存在"
+// @Router       /admin/sqlite-backups/{filename}/download [get]
+func (h *Handler) DownloadBackup(c *gin.Context) {
+	p, err := h.svc.Path(c.Param("filename"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	c.FileAttachment(p, c.Param("filename"))
+}
+
+// DeleteBackup 删除 SQLite 备份
+// @Summary      删除 SQLite 备份
+// @Tags         SQLite 备份
+// @Security     BearerAuth
+// @Produce      json
+// @Param        filename  path  string  true  "备份文件名"
+// @Success      200  {object}  response.Response  "删除成功"
+// @Failure      404  {object}  response.Response  "备份不存在"
+// @Router       /admin/sqlite-backups/{filename} [delete]
+func (h *Handler) DeleteBackup(c *gin.Context) {
+	if err := h.svc.Delete(c.Param("filename")); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "删除备份成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sqlitebackup.ErrBackupNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, sqlitebackup.ErrBackupRunning):
+		response.Fail(c, http.StatusConflict, err.Error())
+	case errors.Is(err, sqlitebackup.ErrNotSQLite):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
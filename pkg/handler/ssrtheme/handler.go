@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/errorreport"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
 	"github.com/anzhiyu-c/anheyu-app/pkg/ssr"
 	"github.com/gin-gonic/gin"
@@ -36,12 +37,25 @@ func (h *Handler) GetManager() *ssr.Manager {
 	return h.manager
 }
 
+// ssrErrorRules SSR 主题管理和主题服务错误到错误码的映射
+var ssrErrorRules = []response.ErrorRule{
+	{Err: ssr.ErrThemeAlreadyInstalled, Status: http.StatusConflict, Code: response.CodeThemeAlreadyInstalled},
+	{Err: ssr.ErrThemeInstalling, Status: http.StatusConflict, Code: response.CodeConflict},
+	{Err: ssr.ErrBuildDisabled, Status: http.StatusConflict, Code: response.CodeSSRBuildDisabled},
+	{Err: ssr.ErrThemeNotInstalled, Status: http.StatusNotFound, Code: response.CodeThemeNotInstalled},
+	{Err: ssr.ErrThemeAlreadyRunning, Status: http.StatusConflict, Code: response.CodeSSRAlreadyRunning},
+	{Err: ssr.ErrThemeNotRunning, Status: http.StatusConflict, Code: response.CodeSSRNotRunning},
+	{Err: theme.ErrThemeNotInstalled, Status: http.StatusNotFound, Code: response.CodeThemeNotInstalled},
+	{Err: theme.ErrCanaryFailed, Status: http.StatusBadGateway, Code: response.CodeThemeCanaryFailed},
+}
+
 // InstallThemeRequest 安装主题请求
 type InstallThemeRequest struct {
 	ThemeName   string `json:"themeName" binding:"required"`
 	DownloadURL string `json:"downloadUrl" binding:"required"`
 	Version     string `json:"version"`
 	MarketID    int    `json:"marketId"`
+	Checksum    string `json:"checksum"` // 主题包 SHA-256，可选
 }
 
 // StartThemeRequest 启动主题请求
@@ -51,7 +65,8 @@ type StartThemeRequest struct {
 
 // InstallTheme 安装 SSR 主题
 // @Summary 安装 SSR 主题
-// @Description 从指定 URL 下载并安装 SSR 主题
+// @Description 从指定 URL 下载并安装 SSR 主题。主题包只包含源码时，开启 ssr.build.enable 后会在本机安装依赖并构建，
+// @Description 构建进度和日志通过管理后台事件流的 ssr.build 事件推送。构建不在沙箱中进行，脚本以与本程序相同的权限运行
 // @Tags SSR主题管理
 // @Accept json
 // @Produce json
@@ -61,13 +76,14 @@ type StartThemeRequest struct {
 func (h *Handler) InstallTheme(c *gin.Context) {
 	var req InstallThemeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		response.FailBind(c, err, &req)
 		return
 	}
 
 	// 1. 下载并安装 SSR 主题文件
-	if err := h.manager.Install(c.Request.Context(), req.ThemeName, req.DownloadURL); err != nil {
-		response.Fail(c, http.StatusInternalServerError, err.Error())
+	if err := h.manager.Install(c.Request.Context(), req.ThemeName, req.DownloadURL, req.Checksum); err != nil {
+		errorreport.CaptureError(err, map[string]string{"component": "ssr", "action": "install", "theme": req.ThemeName})
+		response.FailError(c, err, http.StatusInternalServerError, "", ssrErrorRules...)
 		return
 	}
 
@@ -77,7 +93,7 @@ func (h *Handler) InstallTheme(c *gin.Context) {
 	if err := h.themeService.InstallSSRTheme(c.Request.Context(), userID, req.ThemeName, req.Version, req.MarketID); err != nil {
 		// 如果数据库写入失败，尝试回滚（卸载已安装的文件）
 		h.manager.Uninstall(req.ThemeName)
-		response.Fail(c, http.StatusInternalServerError, "写入数据库失败: "+err.Error())
+		response.FailError(c, err, http.StatusInternalServerError, "写入数据库失败", ssrErrorRules...)
 		return
 	}
 
@@ -95,7 +111,7 @@ func (h *Handler) InstallTheme(c *gin.Context) {
 func (h *Handler) UninstallTheme(c *gin.Context) {
 	themeName := c.Param("name")
 	if themeName == "" {
-		response.Fail(c, http.StatusBadRequest, "主题名称不能为空")
+		response.FailFields(c, "主题名称不能为空", response.RequiredField("name"))
 		return
 	}
 
@@ -103,7 +119,7 @@ func (h *Handler) UninstallTheme(c *gin.Context) {
 
 	// 1. 先从数据库删除记录
 	if err := h.themeService.UninstallSSRTheme(c.Request.Context(), userID, themeName); err != nil {
-		response.Fail(c, http.StatusInternalServerError, err.Error())
+		response.FailError(c, err, http.StatusInternalServerError, "", ssrErrorRules...)
 		return
 	}
 
@@ -129,7 +145,7 @@ func (h *Handler) UninstallTheme(c *gin.Context) {
 func (h *Handler) StartTheme(c *gin.Context) {
 	themeName := c.Param("name")
 	if themeName == "" {
-		response.Fail(c, http.StatusBadRequest, "主题名称不能为空")
+		response.FailFields(c, "主题名称不能为空", response.RequiredField("name"))
 		return
 	}
 
@@ -144,7 +160,8 @@ func (h *Handler) StartTheme(c *gin.Context) {
 	// 使用 ThemeService 统一处理主题切换
 	// 这会：1. 停止其他 SSR 主题 2. 更新数据库状态 3. 启动目标主题
 	if err := h.themeService.SwitchToSSRTheme(c.Request.Context(), userID, themeName, h.manager); err != nil {
-		response.Fail(c, http.StatusInternalServerError, err.Error())
+		errorreport.CaptureError(err, map[string]string{"component": "ssr", "action": "start", "theme": themeName})
+		response.FailError(c, err, http.StatusInternalServerError, "", ssrErrorRules...)
 		return
 	}
 
@@ -162,12 +179,13 @@ func (h *Handler) StartTheme(c *gin.Context) {
 func (h *Handler) StopTheme(c *gin.Context) {
 	themeName := c.Param("name")
 	if themeName == "" {
-		response.Fail(c, http.StatusBadRequest, "主题名称不能为空")
+		response.FailFields(c, "主题名称不能为空", response.RequiredField("name"))
 		return
 	}
 
 	if err := h.manager.Stop(themeName); err != nil {
-		response.Fail(c, http.StatusInternalServerError, err.Error())
+		errorreport.CaptureError(err, map[string]string{"component": "ssr", "action": "stop", "theme": themeName})
+		response.FailError(c, err, http.StatusInternalServerError, "", ssrErrorRules...)
 		return
 	}
 
@@ -185,7 +203,7 @@ func (h *Handler) StopTheme(c *gin.Context) {
 func (h *Handler) GetThemeStatus(c *gin.Context) {
 	themeName := c.Param("name")
 	if themeName == "" {
-		response.Fail(c, http.StatusBadRequest, "主题名称不能为空")
+		response.FailFields(c, "主题名称不能为空", response.RequiredField("name"))
 		return
 	}
 
@@ -221,7 +239,7 @@ func (h *Handler) ListInstalledThemes(c *gin.Context) {
 
 	themes, err := h.manager.ListInstalled()
 	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, err.Error())
+		response.FailError(c, err, http.StatusInternalServerError, "", ssrErrorRules...)
 		return
 	}
 
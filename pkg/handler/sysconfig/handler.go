@@ -0,0 +1,47 @@
+/*
+ * @Description: 配置文件重新加载 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package sysconfig
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+)
+
+// Handler 配置文件 handler
+type Handler struct {
+	cfg *config.Config
+}
+
+// NewHandler 创建配置文件 handler
+func NewHandler(cfg *config.Config) *Handler {
+	return &Handler{cfg: cfg}
+}
+
+// Reload 重新加载配置文件
+// @Summary      重新加载配置文件
+// @Description  重新读取 data/conf.ini 和环境变量，与发送 SIGHUP 信号效果相同。日志级别、缓存清理间隔立即生效，其余变化的配置需要重启，返回结果只包含配置键名
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=config.ReloadResult}  "重新加载成功"
+// @Failure      400  {object}  response.Response  "配置文件格式错误"
+// @Router       /admin/config/reload [post]
+func (h *Handler) Reload(c *gin.Context) {
+	result, err := h.cfg.Reload()
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(result.RestartRequired) > 0 {
+		response.Success(c, result, "配置已重新加载，部分配置需要重启后生效")
+		return
+	}
+	response.Success(c, result, "配置已重新加载")
+}
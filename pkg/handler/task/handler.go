@@ -0,0 +1,108 @@
+/*
+ * @Description: 定时任务管理 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package task
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/app/task"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+)
+
+// Handler 定时任务管理 handler
+type Handler struct {
+	broker *task.Broker
+}
+
+// NewHandler 创建定时任务管理 handler
+func NewHandler(broker *task.Broker) *Handler {
+	return &Handler{broker: broker}
+}
+
+// UpdateScheduleRequest 修改任务调度请求
+type UpdateScheduleRequest struct {
+	// Schedule 带秒的 cron 表达式，为空恢复默认调度，为 off 停用定时触发
+	Schedule string `json:"schedule"`
+}
+
+// ListTasks 获取定时任务列表
+// @Summary      获取定时任务列表
+// @Description  返回所有已注册的定时任务及其调度、下次执行时间和最近一次运行状态
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]task.TaskStatus}  "获取成功"
+// @Router       /admin/tasks [get]
+func (h *Handler) ListTasks(c *gin.Context) {
+	response.Success(c, h.broker.ListTasks(), "获取任务列表成功")
+}
+
+// RunTask 立即执行定时任务
+// @Summary      立即执行定时任务
+// @Description  在后台立即执行一次任务，任务正在运行时返回 409
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        key  path  string  true  "任务 key"
+// @Success      200  {object}  response.Response{data=task.TaskStatus}  "已触发"
+// @Failure      404  {object}  response.Response  "任务不存在"
+// @Failure      409  {object}  response.Response  "任务正在运行"
+// @Router       /admin/tasks/{key}/run [post]
+func (h *Handler) RunTask(c *gin.Context) {
+	key := c.Param("key")
+	if err := h.broker.RunTaskNow(key); err != nil {
+		h.fail(c, err)
+		return
+	}
+	status, _ := h.broker.GetTask(key)
+	response.Success(c, status, "任务已开始执行")
+}
+
+// UpdateSchedule 修改定时任务调度
+// @Summary      修改定时任务调度
+// @Description  修改任务的 cron 调度并持久化，重启后依然生效
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        key   path  string                 true  "任务 key"
+// @Param        body  body  UpdateScheduleRequest  true  "调度配置"
+// @Success      200  {object}  response.Response{data=task.TaskStatus}  "修改成功"
+// @Failure      400  {object}  response.Response  "调度表达式不合法"
+// @Failure      404  {object}  response.Response  "任务不存在"
+// @Router       /admin/tasks/{key}/schedule [put]
+func (h *Handler) UpdateSchedule(c *gin.Context) {
+	var req UpdateScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+
+	status, err := h.broker.UpdateTaskSchedule(c.Request.Context(), c.Param("key"), req.Schedule)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, status, "任务调度已更新")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, task.ErrTaskNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, task.ErrTaskRunning):
+		response.Fail(c, http.StatusConflict, err.Error())
+	case errors.Is(err, task.ErrInvalidSchedule):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, task.ErrReadOnly):
+		response.Fail(c, http.StatusServiceUnavailable, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
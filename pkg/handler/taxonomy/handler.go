@@ -0,0 +1,57 @@
+/*
+ * @Description: 标签云和分类树公开 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package taxonomy
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/taxonomy"
+)
+
+// Handler 标签云和分类树 handler
+type Handler struct {
+	svc *taxonomy.Service
+}
+
+// NewHandler 创建标签云和分类树 handler
+func NewHandler(svc *taxonomy.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// GetTagCloud 获取标签云
+// @Summary      获取标签云
+// @Description  返回有文章的标签及其权重（1~10），按名称排序
+// @Tags         分类与标签
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]taxonomy.TagCloudItem}  "获取成功"
+// @Router       /public/taxonomy/tag-cloud [get]
+func (h *Handler) GetTagCloud(c *gin.Context) {
+	items, err := h.svc.TagCloud(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, items, "获取标签云成功")
+}
+
+// GetCategoryTree 获取分类树
+// @Summary      获取分类树
+// @Description  按分类名称中的 / 构建层级，返回每个分类的文章数和包含子分类的总数
+// @Tags         分类与标签
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]taxonomy.CategoryNode}  "获取成功"
+// @Router       /public/taxonomy/category-tree [get]
+func (h *Handler) GetCategoryTree(c *gin.Context) {
+	tree, err := h.svc.CategoryTree(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, tree, "获取分类树成功")
+}
@@ -13,24 +13,75 @@
 package theme
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/conditional"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/errorreport"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/themelayout"
+	"github.com/anzhiyu-c/anheyu-app/pkg/ssr"
 	"github.com/gin-gonic/gin"
 )
 
 // Handler 主题管理处理器
 type Handler struct {
-	themeService theme.ThemeService
-	ssrManager   theme.SSRManagerInterface // SSR 主题管理器
-	isProVersion bool                      // 是否为 PRO 版本
-	licenseKey   string                    // PRO 版授权密钥
+	themeService  theme.ThemeService
+	ssrManager    theme.SSRManagerInterface // SSR 主题管理器
+	isProVersion  bool                      // 是否为 PRO 版本
+	licenseKey    string                    // PRO 版授权密钥
+	assetRewriter AssetURLRewriter          // 公开主题配置中的资源地址改写器
+	layouts       LayoutResolver            // 公开主题配置中的菜单和小工具布局
+}
+
+// LayoutConfigKey 公开主题配置中菜单和小工具布局的键，使用双下划线避免与主题配置字段重名
+const LayoutConfigKey = "__layout__"
+
+const (
+	// themeListPageSize 主题列表游标分页的默认每页数量
+	themeListPageSize = 20
+	// themeListMaxPageSize 主题列表游标分页的每页数量上限
+	themeListMaxPageSize = 100
+)
+
+// installedThemeKey 已安装主题按安装时间分页，主题名称在同一用户下唯一
+func installedThemeKey(t *theme.ThemeInfo) response.CursorKey {
+	key := response.CursorKey{ID: t.Name}
+	if t.InstallTime != nil {
+		key.CreatedAt = *t.InstallTime
+	}
+	return key
+}
+
+// marketThemeKey 主题商城按发布时间分页，无法解析的发布时间按零值处理
+func marketThemeKey(t *theme.MarketTheme) response.CursorKey {
+	key := response.CursorKey{ID: t.Name}
+	for _, layout := range []string{time.RFC3339, time.DateTime} {
+		if created, err := time.Parse(layout, t.CreatedAt); err == nil {
+			key.CreatedAt = created
+			break
+		}
+	}
+	return key
+}
+
+// LayoutResolver 解析当前主题的菜单和小工具布局，由主题布局服务实现
+type LayoutResolver interface {
+	ResolveCurrent(ctx context.Context) *themelayout.ResolvedLayout
+}
+
+// AssetURLRewriter 改写主题配置值中的静态资源地址，由主题资源 CDN 服务实现
+type AssetURLRewriter interface {
+	RewriteValue(value interface{}) interface{}
 }
 
 // ThemeHandler 类型别名，简化引用
@@ -57,10 +108,38 @@ type (
 
 	// ThemeUploadResponse 主题上传响应
 	ThemeUploadResponse struct {
-		ThemeName string      `json:"theme_name"`
-		ThemeInfo interface{} `json:"theme_info"`
-		Installed bool        `json:"installed"`
-		Message   string      `json:"message"`
+		ThemeName string                      `json:"theme_name"`
+		ThemeInfo interface{}                 `json:"theme_info"`
+		Installed bool                        `json:"installed"`
+		Message   string                      `json:"message"`
+		SmokeTest *theme.ThemeSmokeTestResult `json:"smoke_test,omitempty"`
+	}
+
+	// ThemeInstallResponse 主题安装响应
+	ThemeInstallResponse struct {
+		ThemeName string                      `json:"theme_name"`
+		SmokeTest *theme.ThemeSmokeTestResult `json:"smoke_test,omitempty"`
+	}
+
+	// ThemeUpdateRequest 主题更新请求
+	ThemeUpdateRequest struct {
+		ThemeName string `json:"theme_name" binding:"required"`
+	}
+
+	// ThemeUpdateResponse 主题更新响应
+	ThemeUpdateResponse struct {
+		*theme.ThemeUpdateResult
+		SmokeTest *theme.ThemeSmokeTestResult `json:"smoke_test,omitempty"`
+	}
+
+	// ThemeSmokeTestRequest 主题冒烟测试请求
+	ThemeSmokeTestRequest struct {
+		ThemeName string `json:"theme_name" binding:"required,min=1,max=100"`
+	}
+
+	// ThemeIntegrityRequest 主题完整性复检请求
+	ThemeIntegrityRequest struct {
+		ThemeName string `json:"theme_name" binding:"required,min=1,max=100"`
 	}
 )
 
@@ -74,6 +153,30 @@ func NewHandler(themeService theme.ThemeService, ssrManager theme.SSRManagerInte
 	}
 }
 
+// SetAssetURLRewriter 设置公开主题配置的资源地址改写器（可选注入）
+func (h *Handler) SetAssetURLRewriter(rewriter AssetURLRewriter) {
+	h.assetRewriter = rewriter
+}
+
+// SetLayoutResolver 设置公开主题配置的布局解析器（可选注入）
+func (h *Handler) SetLayoutResolver(resolver LayoutResolver) {
+	h.layouts = resolver
+}
+
+// publicConfigValues 附加菜单和小工具布局并改写公开主题配置中的资源地址，
+// 后台编辑配置的接口不改写，避免把 CDN 地址保存回配置
+func (h *Handler) publicConfigValues(ctx context.Context, values map[string]interface{}) interface{} {
+	if h.layouts != nil {
+		if layout := h.layouts.ResolveCurrent(ctx); layout != nil {
+			values[LayoutConfigKey] = layout
+		}
+	}
+	if h.assetRewriter == nil {
+		return values
+	}
+	return h.assetRewriter.RewriteValue(values)
+}
+
 // ConfigureForPro 配置为 PRO 版本模式
 // 调用此方法后，GetThemeMarket 会返回包含完整 downloadUrl 的 PRO 主题
 func (h *Handler) ConfigureForPro(licenseKey string) {
@@ -82,12 +185,34 @@ func (h *Handler) ConfigureForPro(licenseKey string) {
 	log.Printf("[Theme Handler] 已配置为 PRO 版本模式，授权密钥已设置")
 }
 
+// errNotLoggedIn 请求中没有登录信息
+var errNotLoggedIn = errors.New("用户未登录")
+
+// themeErrorRules 主题服务错误到错误码的映射
+var themeErrorRules = []response.ErrorRule{
+	{Err: theme.ErrThemeAlreadyInstalled, Status: http.StatusConflict, Code: response.CodeThemeAlreadyInstalled},
+	{Err: theme.ErrThemeNotInstalled, Status: http.StatusNotFound, Code: response.CodeThemeNotInstalled},
+	{Err: theme.ErrThemeStorageQuotaExceeded, Status: http.StatusInsufficientStorage, Code: response.CodeThemeStorageQuotaExceeded},
+	{Err: theme.ErrInsufficientDiskSpace, Status: http.StatusInsufficientStorage, Code: response.CodeInsufficientDiskSpace},
+	{Err: theme.ErrInstallInProgress, Status: http.StatusConflict, Code: response.CodeThemeInstallInProgress},
+	{Err: theme.ErrInstallCanceled, Status: http.StatusConflict, Code: response.CodeThemeInstallCanceled},
+	{Err: theme.ErrInstallNotFound, Status: http.StatusNotFound, Code: response.CodeNotFound},
+	{Err: theme.ErrInstallCommitting, Status: http.StatusConflict, Code: response.CodeConflict},
+	{Err: theme.ErrCanaryFailed, Status: http.StatusBadGateway, Code: response.CodeThemeCanaryFailed},
+	{Err: theme.ErrOperationTimeout, Status: http.StatusGatewayTimeout, Code: response.CodeThemeOperationTimeout},
+	{Err: theme.ErrThemeUpToDate, Status: http.StatusConflict, Code: response.CodeThemeUpToDate},
+	{Err: theme.ErrThemeUpdateUnavailable, Status: http.StatusUnprocessableEntity, Code: response.CodeThemeUpdateUnavailable},
+	{Err: ssr.ErrThemeNotRunning, Status: http.StatusConflict, Code: response.CodeSSRNotRunning},
+	{Err: ssr.ErrThemeAlreadyRunning, Status: http.StatusConflict, Code: response.CodeSSRAlreadyRunning},
+	{Err: ssr.ErrThemeNotInstalled, Status: http.StatusNotFound, Code: response.CodeThemeNotInstalled},
+}
+
 // 辅助函数：统一的用户ID提取和验证
 func (h *Handler) extractUserID(c *gin.Context) (uint, error) {
 	// 从JWT中间件设置的Claims中获取用户信息
 	claimsValue, exists := c.Get("user_claims")
 	if !exists {
-		return 0, errors.New("用户未登录")
+		return 0, errNotLoggedIn
 	}
 
 	// 类型断言为CustomClaims
@@ -108,10 +233,19 @@ func (h *Handler) extractUserID(c *gin.Context) (uint, error) {
 	return userID, nil
 }
 
-// 辅助函数：统一的错误响应处理
+// 辅助函数：用户ID提取失败时的响应
+func (h *Handler) failUserID(c *gin.Context, err error) {
+	if errors.Is(err, errNotLoggedIn) {
+		response.FailCode(c, http.StatusUnauthorized, response.CodeUnauthorized, err.Error())
+		return
+	}
+	response.FailCode(c, http.StatusBadRequest, response.CodeBadRequest, err.Error())
+}
+
+// 辅助函数：统一的错误响应处理，服务层错误能识别时使用对应的错误码，否则使用 statusCode
 func (h *Handler) handleError(c *gin.Context, err error, message string, statusCode int) {
 	log.Printf("[Theme Handler Error] %s: %v", message, err)
-	response.Fail(c, statusCode, message+": "+err.Error())
+	response.FailError(c, err, statusCode, message, themeErrorRules...)
 }
 
 // GetCurrentTheme 获取当前使用的主题
@@ -129,11 +263,7 @@ func (h *Handler) GetCurrentTheme(c *gin.Context) {
 	// 提取用户ID
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
@@ -150,10 +280,13 @@ func (h *Handler) GetCurrentTheme(c *gin.Context) {
 
 // GetInstalledThemes 获取已安装的主题列表
 // @Summary      获取已安装主题列表
-// @Description  获取用户已安装的所有主题
+// @Description  获取用户已安装的所有主题。带 cursor 或 limit 参数时按游标分页返回，format=ndjson 时逐行流式返回
 // @Tags         主题管理
 // @Security     BearerAuth
 // @Produce      json
+// @Param        cursor  query  string  false  "分页游标，传入上一页返回的 next_cursor"
+// @Param        limit   query  int     false  "游标分页每页数量，按安装时间倒序"
+// @Param        format  query  string  false  "传 ndjson 时逐行流式返回"
 // @Success      200  {object}  response.Response{data=[]theme.ThemeInfo}  "获取成功"
 // @Failure      400  {object}  response.Response  "参数错误"
 // @Failure      500  {object}  response.Response  "获取失败"
@@ -162,17 +295,23 @@ func (h *Handler) GetInstalledThemes(c *gin.Context) {
 	// 提取用户ID
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
+		return
+	}
+
+	cur, err := response.ParseCursor(c, themeListPageSize, themeListMaxPageSize)
+	if err != nil {
+		response.FailCode(c, http.StatusBadRequest, response.CodeBadRequest, err.Error())
 		return
 	}
 
 	themes, err := h.themeService.GetInstalledThemes(c.Request.Context(), userID)
 	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, "获取已安装主题失败: "+err.Error())
+		response.FailError(c, err, http.StatusInternalServerError, "获取已安装主题失败", themeErrorRules...)
+		return
+	}
+	if cur.Enabled {
+		response.RespondCursor(c, cur, response.SliceFetcher(themes, installedThemeKey), installedThemeKey, "获取已安装主题成功")
 		return
 	}
 
@@ -195,44 +334,58 @@ func (h *Handler) InstallTheme(c *gin.Context) {
 	// 提取用户ID
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
 	var req theme.ThemeInstallRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.Fail(c, http.StatusBadRequest, "请求参数格式错误: "+err.Error())
+		response.FailBind(c, err, &req)
 		return
 	}
 
 	// 基础参数验证
 	if req.ThemeName == "" {
-		response.Fail(c, http.StatusBadRequest, "主题名称不能为空")
+		response.FailFields(c, "主题名称不能为空", response.RequiredField("theme_name"))
 		return
 	}
 
 	if req.DownloadURL == "" {
-		response.Fail(c, http.StatusBadRequest, "下载URL不能为空")
+		response.FailFields(c, "下载URL不能为空", response.RequiredField("download_url"))
 		return
 	}
 
 	// 主题名称必须以theme-开头
 	if len(req.ThemeName) < 6 || req.ThemeName[:6] != "theme-" {
-		response.Fail(c, http.StatusBadRequest, "主题名称必须以'theme-'开头")
+		response.FailFields(c, "主题名称必须以'theme-'开头", response.FieldError{
+			Field:   "theme_name",
+			Rule:    "prefix",
+			Param:   "theme-",
+			Message: "主题名称必须以'theme-'开头",
+		})
 		return
 	}
 
 	err = h.themeService.InstallTheme(c.Request.Context(), userID, &req)
 	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, "安装主题失败: "+err.Error())
+		if errors.Is(err, theme.ErrMarketSourceNotFound) {
+			response.FailCode(c, http.StatusBadRequest, response.CodeMarketSourceNotFound, err.Error())
+			return
+		}
+		response.FailError(c, err, http.StatusInternalServerError, "安装主题失败", themeErrorRules...)
 		return
 	}
 
-	response.Success(c, nil, "主题安装成功")
+	// 安装完成后执行兼容性冒烟测试，结果随安装响应一并返回（不影响安装结果）
+	smokeTest, err := h.themeService.RunSmokeTest(c.Request.Context(), req.ThemeName)
+	if err != nil {
+		log.Printf("[Theme Handler] 主题 %s 冒烟测试执行失败: %v", req.ThemeName, err)
+	}
+
+	response.Success(c, ThemeInstallResponse{
+		ThemeName: req.ThemeName,
+		SmokeTest: smokeTest,
+	}, "主题安装成功")
 }
 
 // SwitchTheme 切换主题
@@ -251,28 +404,25 @@ func (h *Handler) SwitchTheme(c *gin.Context) {
 	// 提取用户ID
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
 	var req SwitchThemeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.Fail(c, http.StatusBadRequest, "请求参数格式错误: "+err.Error())
+		response.FailBind(c, err, &req)
 		return
 	}
 
 	if req.ThemeName == "" {
-		response.Fail(c, http.StatusBadRequest, "主题名称不能为空")
+		response.FailFields(c, "主题名称不能为空", response.RequiredField("theme_name"))
 		return
 	}
 
 	err = h.themeService.SwitchToTheme(c.Request.Context(), userID, req.ThemeName, h.ssrManager)
 	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, "切换主题失败: "+err.Error())
+		errorreport.CaptureError(err, map[string]string{"component": "theme", "action": "switch", "theme": req.ThemeName})
+		response.FailError(c, err, http.StatusInternalServerError, "切换主题失败", themeErrorRules...)
 		return
 	}
 
@@ -298,17 +448,14 @@ func (h *Handler) SwitchToOfficial(c *gin.Context) {
 	// 提取用户ID
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
 	err = h.themeService.SwitchToOfficial(c.Request.Context(), userID, h.ssrManager)
 	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, "切换到官方主题失败: "+err.Error())
+		errorreport.CaptureError(err, map[string]string{"component": "theme", "action": "switch_official"})
+		response.FailError(c, err, http.StatusInternalServerError, "切换到官方主题失败", themeErrorRules...)
 		return
 	}
 
@@ -320,6 +467,107 @@ func (h *Handler) SwitchToOfficial(c *gin.Context) {
 	response.Success(c, nil, "成功切换到官方主题")
 }
 
+// CancelInstall 取消主题安装
+// @Summary      取消主题安装
+// @Description  取消正在下载或解压的主题安装，已写出的文件会被清理，安装请求返回 THEME_INSTALL_CANCELED。
+// @Description  安装进度通过管理后台事件流的 theme.install 事件推送，进入 committing 阶段后不能再取消
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  CancelInstallRequest  true  "取消安装请求"
+// @Success      200  {object}  response.Response  "已请求取消"
+// @Failure      404  {object}  response.Response  "没有正在进行的安装"
+// @Failure      409  {object}  response.Response  "安装已进入提交阶段"
+// @Router       /theme/install/cancel [post]
+func (h *Handler) CancelInstall(c *gin.Context) {
+	var req CancelInstallRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	if err := h.themeService.CancelInstall(c.Request.Context(), req.ThemeName); err != nil {
+		response.FailError(c, err, http.StatusInternalServerError, "取消安装失败", themeErrorRules...)
+		return
+	}
+
+	response.Success(c, nil, "已请求取消安装")
+}
+
+// CheckThemeUpdates 检查主题更新
+// @Summary      检查主题更新
+// @Description  将全部已安装普通主题的版本与主题商城中的最新版本比较，逐项返回是否有更新；SSR 主题不在检查范围内
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=theme.ThemeBulkResult}  "检查完成"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      502  {object}  response.Response  "获取主题商城数据失败"
+// @Router       /theme/check-updates [get]
+func (h *Handler) CheckThemeUpdates(c *gin.Context) {
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		h.failUserID(c, err)
+		return
+	}
+
+	result, err := h.themeService.CheckThemeUpdates(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err, "检查主题更新失败", http.StatusBadGateway)
+		return
+	}
+
+	response.Success(c, result, "检查更新完成")
+}
+
+// UpdateTheme 更新主题
+// @Summary      更新主题
+// @Description  从主题商城下载最新版本并替换已安装的主题，旧版本先移入备份目录，任一步骤失败都会回滚到旧版本。
+// @Description  更新的是当前使用的主题时同步刷新静态文件并执行页面检查；进度通过管理后台事件流的 theme.install 事件推送，可用取消安装接口取消
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  ThemeUpdateRequest  true  "主题更新请求"
+// @Success      200  {object}  response.Response{data=ThemeUpdateResponse}  "更新成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      404  {object}  response.Response  "主题未安装"
+// @Failure      409  {object}  response.Response  "已是最新版本或正在安装"
+// @Failure      422  {object}  response.Response  "主题商城中没有该主题或没有下载地址"
+// @Failure      502  {object}  response.Response  "更新后页面检查未通过，已回滚"
+// @Router       /theme/update [post]
+func (h *Handler) UpdateTheme(c *gin.Context) {
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		h.failUserID(c, err)
+		return
+	}
+
+	var req ThemeUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	result, err := h.themeService.UpdateTheme(c.Request.Context(), userID, req.ThemeName)
+	if err != nil {
+		h.handleError(c, err, "更新主题失败", http.StatusInternalServerError)
+		return
+	}
+
+	// 与安装一样，更新后执行兼容性冒烟测试，结果随响应返回（不影响更新结果）
+	smokeTest, err := h.themeService.RunSmokeTest(c.Request.Context(), req.ThemeName)
+	if err != nil {
+		log.Printf("[Theme Handler] 主题 %s 冒烟测试执行失败: %v", req.ThemeName, err)
+	}
+
+	response.Success(c, ThemeUpdateResponse{
+		ThemeUpdateResult: result,
+		SmokeTest:         smokeTest,
+	}, "主题更新成功")
+}
+
 // UninstallTheme 卸载主题
 // @Summary      卸载主题
 // @Description  卸载指定的主题（不能卸载当前使用的主题）
@@ -336,28 +584,24 @@ func (h *Handler) UninstallTheme(c *gin.Context) {
 	// 提取用户ID
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
 	var req UninstallThemeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.Fail(c, http.StatusBadRequest, "请求参数格式错误: "+err.Error())
+		response.FailBind(c, err, &req)
 		return
 	}
 
 	if req.ThemeName == "" {
-		response.Fail(c, http.StatusBadRequest, "主题名称不能为空")
+		response.FailFields(c, "主题名称不能为空", response.RequiredField("theme_name"))
 		return
 	}
 
 	err = h.themeService.UninstallTheme(c.Request.Context(), userID, req.ThemeName)
 	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, "卸载主题失败: "+err.Error())
+		response.FailError(c, err, http.StatusInternalServerError, "卸载主题失败", themeErrorRules...)
 		return
 	}
 
@@ -375,12 +619,19 @@ type ThemeMarketListResponse struct {
 // @Description  获取主题商城中的所有可用主题（PRO 版本会返回包含完整 downloadUrl 的 PRO 主题）
 // @Tags         主题商城
 // @Produce      json
+// @Param        cursor  query  string  false  "分页游标，传入上一页返回的 next_cursor"
+// @Param        limit   query  int     false  "游标分页每页数量，按发布时间倒序"
+// @Param        format  query  string  false  "传 ndjson 时逐行流式返回"
 // @Success      200  {object}  response.Response{data=ThemeMarketListResponse}  "获取成功"
 // @Failure      500  {object}  response.Response  "获取失败"
 // @Router       /public/theme/market [get]
 func (h *Handler) GetThemeMarket(c *gin.Context) {
+	cur, err := response.ParseCursor(c, themeListPageSize, themeListMaxPageSize)
+	if err != nil {
+		response.FailCode(c, http.StatusBadRequest, response.CodeBadRequest, err.Error())
+		return
+	}
 	var themes []*theme.MarketTheme
-	var err error
 
 	// 根据版本类型选择不同的 API
 	log.Printf("[Theme Handler] 获取主题商城列表 - isProVersion: %v, hasLicenseKey: %v", h.isProVersion, h.licenseKey != "")
@@ -400,7 +651,12 @@ func (h *Handler) GetThemeMarket(c *gin.Context) {
 	}
 
 	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, "获取主题商城列表失败: "+err.Error())
+		response.FailError(c, err, http.StatusInternalServerError, "获取主题商城列表失败", themeErrorRules...)
+		return
+	}
+
+	if cur.Enabled {
+		response.RespondCursor(c, cur, response.SliceFetcher(themes, marketThemeKey), marketThemeKey, "获取主题商城列表成功")
 		return
 	}
 
@@ -413,6 +669,57 @@ func (h *Handler) GetThemeMarket(c *gin.Context) {
 	response.Success(c, responseData, "获取主题商城列表成功")
 }
 
+// GetMarketSources 获取主题商城来源
+// @Summary      获取主题商城来源
+// @Description  返回启用的主题商城来源，商城列表中每个主题的 source 字段对应来源 ID
+// @Tags         主题商城
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]theme.MarketSourceInfo}  "获取成功"
+// @Router       /theme/market/sources [get]
+func (h *Handler) GetMarketSources(c *gin.Context) {
+	response.Success(c, h.themeService.ListMarketSources(), "获取主题商城来源成功")
+}
+
+// RateMarketThemeRequest 主题评分请求
+type RateMarketThemeRequest struct {
+	Source   string `json:"source"` // 主题所属的商城来源 ID，为空时为官方商城
+	MarketID int    `json:"market_id" binding:"required"`
+	Rating   int    `json:"rating" binding:"required,min=1,max=5"`
+}
+
+// RateMarketTheme 为主题评分
+// @Summary      为主题评分
+// @Description  评分提交到主题所属的商城来源
+// @Tags         主题商城
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  RateMarketThemeRequest  true  "评分请求"
+// @Success      200  {object}  response.Response  "评分成功"
+// @Failure      400  {object}  response.Response  "参数错误或来源不支持评分"
+// @Failure      404  {object}  response.Response  "来源不存在"
+// @Router       /theme/market/rate [post]
+func (h *Handler) RateMarketTheme(c *gin.Context) {
+	var req RateMarketThemeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+	if err := h.themeService.RateMarketTheme(c.Request.Context(), req.Source, req.MarketID, req.Rating); err != nil {
+		switch {
+		case errors.Is(err, theme.ErrMarketSourceNotFound):
+			response.FailCode(c, http.StatusNotFound, response.CodeMarketSourceNotFound, err.Error())
+		case errors.Is(err, theme.ErrMarketRatingUnsupported):
+			response.FailCode(c, http.StatusBadRequest, response.CodeMarketRatingUnsupported, err.Error())
+		default:
+			response.FailCode(c, http.StatusBadGateway, response.CodeUpstreamFailed, err.Error())
+		}
+		return
+	}
+	response.Success(c, nil, "评分成功")
+}
+
 // CheckStaticMode 检查是否处于静态模式
 // @Summary      检查静态模式
 // @Description  检查当前是否处于静态主题模式（是否存在static目录）
@@ -434,6 +741,11 @@ type SwitchThemeRequest struct {
 	ThemeName string `json:"theme_name" binding:"required"`
 }
 
+// CancelInstallRequest 取消主题安装请求结构
+type CancelInstallRequest struct {
+	ThemeName string `json:"theme_name" binding:"required"`
+}
+
 // UninstallThemeRequest 卸载主题请求结构
 type UninstallThemeRequest struct {
 	ThemeName string `json:"theme_name" binding:"required"`
@@ -462,32 +774,38 @@ func (h *Handler) UploadTheme(c *gin.Context) {
 	// 提取用户ID
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
 	// 获取上传的文件
 	file, err := c.FormFile("file")
 	if err != nil {
-		response.Fail(c, http.StatusBadRequest, "获取上传文件失败: "+err.Error())
+		response.FailFields(c, "获取上传文件失败: "+err.Error(), response.RequiredField("file"))
 		return
 	}
 
 	// 验证文件类型
 	if file.Header.Get("Content-Type") != "application/zip" &&
 		!strings.HasSuffix(strings.ToLower(file.Filename), ".zip") {
-		response.Fail(c, http.StatusBadRequest, "仅支持ZIP格式的主题压缩包")
+		response.FailFields(c, "仅支持ZIP格式的主题压缩包", response.FieldError{
+			Field:   "file",
+			Rule:    "ext",
+			Param:   "zip",
+			Message: "仅支持ZIP格式的主题压缩包",
+		})
 		return
 	}
 
 	// 验证文件大小（最大50MB）
 	const maxFileSize = 50 * 1024 * 1024 // 50MB
 	if file.Size > maxFileSize {
-		response.Fail(c, http.StatusBadRequest, "文件大小不能超过50MB")
+		response.FailFields(c, "文件大小不能超过50MB", response.FieldError{
+			Field:   "file",
+			Rule:    "max",
+			Param:   "50MB",
+			Message: "文件大小不能超过50MB",
+		})
 		return
 	}
 
@@ -501,12 +819,19 @@ func (h *Handler) UploadTheme(c *gin.Context) {
 		return
 	}
 
+	// 执行兼容性冒烟测试
+	smokeTest, err := h.themeService.RunSmokeTest(c.Request.Context(), themeInfo.Name)
+	if err != nil {
+		log.Printf("[Theme Handler] 主题 %s 冒烟测试执行失败: %v", themeInfo.Name, err)
+	}
+
 	// 构造响应
 	uploadResponse := ThemeUploadResponse{
 		ThemeName: themeInfo.Name,
 		ThemeInfo: themeInfo,
 		Installed: true,
 		Message:   "主题上传并安装成功",
+		SmokeTest: smokeTest,
 	}
 
 	log.Printf("[Theme Handler] 用户 %d 成功上传主题: %s", userID, themeInfo.Name)
@@ -529,11 +854,7 @@ func (h *Handler) ValidateTheme(c *gin.Context) {
 	// 提取用户ID
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
@@ -570,11 +891,7 @@ func (h *Handler) FixThemeStatus(c *gin.Context) {
 	// 提取用户ID
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
@@ -613,17 +930,13 @@ func (h *Handler) GetThemeSettings(c *gin.Context) {
 	// 验证用户登录
 	_, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
 	themeName := c.Query("theme_name")
 	if themeName == "" {
-		response.Fail(c, http.StatusBadRequest, "主题名称不能为空")
+		response.FailFields(c, "主题名称不能为空", response.RequiredField("theme_name"))
 		return
 	}
 
@@ -651,17 +964,13 @@ func (h *Handler) GetThemeSettings(c *gin.Context) {
 func (h *Handler) GetUserThemeConfig(c *gin.Context) {
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
 	themeName := c.Query("theme_name")
 	if themeName == "" {
-		response.Fail(c, http.StatusBadRequest, "主题名称不能为空")
+		response.FailFields(c, "主题名称不能为空", response.RequiredField("theme_name"))
 		return
 	}
 
@@ -676,36 +985,46 @@ func (h *Handler) GetUserThemeConfig(c *gin.Context) {
 
 // SaveUserThemeConfig 保存用户主题配置
 // @Summary      保存用户主题配置
-// @Description  保存用户对指定主题的配置值
+// @Description  保存用户对指定主题的配置值。配置值不合法时返回 422，error.details.fields 以字段名为键列出所有不合法的字段，
+// @Description  提示文本按 lang 参数或 Accept-Language 生成（支持中文和英文）
 // @Tags         主题配置
 // @Security     BearerAuth
 // @Accept       json
 // @Produce      json
-// @Param        request  body  ThemeConfigRequest  true  "主题配置请求"
+// @Param        request  body   ThemeConfigRequest  true   "主题配置请求"
+// @Param        lang     query  string              false  "校验提示的语言，如 zh-CN、en"
 // @Success      200  {object}  response.Response  "保存成功"
 // @Failure      400  {object}  response.Response  "参数错误"
 // @Failure      401  {object}  response.Response  "未授权"
+// @Failure      422  {object}  response.Response{error=response.ErrorBody{details=theme.ConfigValidationError}}  "配置值校验失败"
 // @Failure      500  {object}  response.Response  "保存失败"
 // @Router       /theme/config [post]
 func (h *Handler) SaveUserThemeConfig(c *gin.Context) {
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
 	var req ThemeConfigRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.Fail(c, http.StatusBadRequest, "请求参数格式错误: "+err.Error())
+		response.FailBind(c, err, &req)
 		return
 	}
 
 	err = h.themeService.SaveUserThemeConfig(c.Request.Context(), userID, req.ThemeName, req.Config)
 	if err != nil {
+		var verr *theme.ConfigValidationError
+		if errors.As(err, &verr) {
+			lang := strings.TrimSpace(c.Query("lang"))
+			if lang == "" {
+				lang = preferredLanguage(c.GetHeader("Accept-Language"))
+			}
+			verr.Localize(lang)
+			apiErr := response.NewError(http.StatusUnprocessableEntity, response.CodeValidationFailed, "配置验证失败: "+verr.Error())
+			response.FailWith(c, apiErr.WithDetails(verr))
+			return
+		}
 		h.handleError(c, err, "保存主题配置失败", http.StatusInternalServerError)
 		return
 	}
@@ -727,11 +1046,7 @@ func (h *Handler) SaveUserThemeConfig(c *gin.Context) {
 func (h *Handler) GetCurrentThemeConfig(c *gin.Context) {
 	userID, err := h.extractUserID(c)
 	if err != nil {
-		status := http.StatusBadRequest
-		if err.Error() == "用户未登录" {
-			status = http.StatusUnauthorized
-		}
-		response.Fail(c, status, err.Error())
+		h.failUserID(c, err)
 		return
 	}
 
@@ -744,11 +1059,49 @@ func (h *Handler) GetCurrentThemeConfig(c *gin.Context) {
 	response.Success(c, config, "获取当前主题配置成功")
 }
 
+// GetPublicThemeLocale 获取当前主题的语言词条（无需登录的公开接口）
+// @Summary      获取当前主题的语言词条
+// @Description  返回当前主题按回退链合并后的语言词条。未指定 lang 时使用 Accept-Language 的首选语言，仍为空时使用主题默认语言
+// @Tags         主题配置
+// @Produce      json
+// @Param        lang  query  string  false  "语言标签，如 zh-CN、en"
+// @Success      200  {object}  response.Response{data=theme.ThemeLocaleResponse}  "获取成功"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /public/theme/locale [get]
+func (h *Handler) GetPublicThemeLocale(c *gin.Context) {
+	lang := strings.TrimSpace(c.Query("lang"))
+	if lang == "" {
+		lang = preferredLanguage(c.GetHeader("Accept-Language"))
+	}
+
+	// 与公开主题配置一致，使用默认管理员的当前主题
+	locale, err := h.themeService.GetCurrentThemeLocale(c.Request.Context(), 1, lang)
+	if err != nil {
+		h.handleError(c, err, "获取主题语言失败", http.StatusInternalServerError)
+		return
+	}
+	response.Success(c, locale, "获取主题语言成功")
+}
+
+// preferredLanguage 从 Accept-Language 中取权重最高的语言标签（按出现顺序，忽略 *）
+func preferredLanguage(acceptLanguage string) string {
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+		if tag != "" && tag != "*" {
+			return tag
+		}
+	}
+	return ""
+}
+
 // GetPublicThemeConfig 获取当前主题配置（无需登录的公开接口）
 // @Summary      获取当前主题配置（公开）
-// @Description  获取当前激活主题的配置值（供前端主题使用，只返回配置值）
+// @Description  获取当前激活主题的配置值（供前端主题使用，只返回配置值）。
+// @Description  指定 mode 时，区分浅色/深色的颜色和图片字段会展开为对应模式的字符串
+// @Description  主题在 theme.json 中声明了菜单位置或小工具区域时，__layout__ 返回解析后的菜单和小工具
 // @Tags         主题配置
 // @Produce      json
+// @Param        mode  query  string  false  "配色模式：light 或 dark"
 // @Success      200  {object}  response.Response{data=map[string]interface{}}  "获取成功"
 // @Failure      500  {object}  response.Response  "获取失败"
 // @Router       /public/theme/config [get]
@@ -763,6 +1116,471 @@ func (h *Handler) GetPublicThemeConfig(c *gin.Context) {
 		return
 	}
 
+	// 指定配色模式时展开分模式字段，供 SSR 主题在服务端输出对应配色
+	if mode := c.Query("mode"); mode != "" {
+		if !theme.IsValidThemeMode(mode) {
+			response.FailFields(c, "mode 只能是 light 或 dark", response.FieldError{
+				Field:   "mode",
+				Rule:    "oneof",
+				Param:   "light dark",
+				Message: "mode 只能是 light 或 dark",
+			})
+			return
+		}
+		values := h.publicConfigValues(c.Request.Context(), theme.ResolveThemeConfigMode(config.Settings, config.Values, mode))
+		// 配置值来自主题配置、菜单和小工具，没有统一的更新时间，按内容计算 ETag
+		if conditional.New("theme-config", mode).AddJSON(values).NotModified(c) {
+			return
+		}
+		response.Success(c, values, "获取主题配置成功")
+		return
+	}
+
 	// 只返回配置值，不返回定义
-	response.Success(c, config.Values, "获取主题配置成功")
+	values := h.publicConfigValues(c.Request.Context(), config.Values)
+	if conditional.New("theme-config").AddJSON(values).NotModified(c) {
+		return
+	}
+	response.Success(c, values, "获取主题配置成功")
+}
+
+// GetSmokeTestResult 获取主题冒烟测试结果
+// @Summary      获取主题冒烟测试结果
+// @Description  获取指定主题最近一次兼容性冒烟测试的结果
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        theme_name  query     string  true  "主题名称"
+// @Success      200  {object}  response.Response{data=theme.ThemeSmokeTestResult}  "获取成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      404  {object}  response.Response  "尚未执行测试"
+// @Router       /theme/smoke-test [get]
+func (h *Handler) GetSmokeTestResult(c *gin.Context) {
+	if _, err := h.extractUserID(c); err != nil {
+		h.failUserID(c, err)
+		return
+	}
+
+	themeName := c.Query("theme_name")
+	if themeName == "" {
+		response.FailFields(c, "主题名称不能为空", response.RequiredField("theme_name"))
+		return
+	}
+
+	result, err := h.themeService.GetSmokeTestResult(c.Request.Context(), themeName)
+	if err != nil {
+		response.FailCode(c, http.StatusNotFound, response.CodeNotFound, err.Error())
+		return
+	}
+
+	response.Success(c, result, "获取冒烟测试结果成功")
+}
+
+// RunSmokeTest 重新执行主题冒烟测试
+// @Summary      执行主题冒烟测试
+// @Description  在沙箱中渲染主题首页和文章页，检查模板错误、缺失资源和未闭合的 script 标签
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  ThemeSmokeTestRequest  true  "冒烟测试请求"
+// @Success      200  {object}  response.Response{data=theme.ThemeSmokeTestResult}  "执行成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      500  {object}  response.Response  "执行失败"
+// @Router       /theme/smoke-test [post]
+func (h *Handler) RunSmokeTest(c *gin.Context) {
+	if _, err := h.extractUserID(c); err != nil {
+		h.failUserID(c, err)
+		return
+	}
+
+	var req ThemeSmokeTestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	result, err := h.themeService.RunSmokeTest(c.Request.Context(), req.ThemeName)
+	if err != nil {
+		h.handleError(c, err, "执行冒烟测试失败", http.StatusInternalServerError)
+		return
+	}
+
+	response.Success(c, result, "冒烟测试执行完成")
+}
+
+// GetStorageReport 获取主题存储占用报告
+// @Summary      获取主题存储占用
+// @Description  统计每个主题的磁盘占用，并列出遗留的 static 备份目录和上传失败残留的临时文件
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=theme.ThemeStorageReport}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /theme/storage [get]
+func (h *Handler) GetStorageReport(c *gin.Context) {
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		h.failUserID(c, err)
+		return
+	}
+
+	report, err := h.themeService.GetStorageReport(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err, "获取主题存储占用失败", http.StatusInternalServerError)
+		return
+	}
+
+	response.Success(c, report, "获取主题存储占用成功")
+}
+
+// ThemeJSONRequest 校验或写入 theme.json 的请求
+type ThemeJSONRequest struct {
+	ThemeName string `json:"theme_name" binding:"required"`
+	Content   string `json:"content" binding:"required"`
+}
+
+// GetThemeJSON 读取 theme.json 原始内容
+// @Summary      读取 theme.json 原始内容
+// @Description  返回主题 theme.json 的原始文本、校验结果和已有备份，JSON 格式损坏时同样返回，用于在后台修复元信息；backup 不为空时读取指定备份
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        theme_name  query     string  true   "主题名称"
+// @Param        backup      query     string  false  "备份名称，如 theme.json.20261015-120000"
+// @Success      200  {object}  response.Response{data=theme.ThemeJSONDocument}  "获取成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      404  {object}  response.Response  "主题未安装"
+// @Router       /theme/metadata [get]
+func (h *Handler) GetThemeJSON(c *gin.Context) {
+	themeName := c.Query("theme_name")
+	if themeName == "" {
+		response.FailFields(c, "主题名称不能为空", response.RequiredField("theme_name"))
+		return
+	}
+
+	doc, err := h.themeService.GetThemeJSON(c.Request.Context(), themeName, c.Query("backup"))
+	if err != nil {
+		h.handleError(c, err, "读取 theme.json 失败", http.StatusBadRequest)
+		return
+	}
+
+	response.Success(c, doc, "读取 theme.json 成功")
+}
+
+// ValidateThemeJSON 校验 theme.json 内容
+// @Summary      校验 theme.json 内容
+// @Description  按上传主题时的规则校验修改后的 theme.json，返回错误、警告以及 JSON 格式错误所在的行列，不写入文件
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  ThemeJSONRequest  true  "主题名称和 theme.json 内容"
+// @Success      200  {object}  response.Response{data=theme.ThemeJSONValidation}  "校验完成"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Router       /theme/metadata/validate [post]
+func (h *Handler) ValidateThemeJSON(c *gin.Context) {
+	var req ThemeJSONRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	response.Success(c, h.themeService.ValidateThemeJSON(c.Request.Context(), req.ThemeName, req.Content), "校验完成")
+}
+
+// SaveThemeJSON 写入 theme.json
+// @Summary      写入 theme.json
+// @Description  校验通过后将原 theme.json 备份到 backup/theme_json/{主题名}/ 并写入新内容；校验未通过时返回 422，details 中为校验结果
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  ThemeJSONRequest  true  "主题名称和 theme.json 内容"
+// @Success      200  {object}  response.Response{data=theme.ThemeJSONValidation}  "写入成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      404  {object}  response.Response  "主题未安装"
+// @Failure      422  {object}  response.Response  "校验未通过"
+// @Failure      500  {object}  response.Response  "写入失败"
+// @Router       /theme/metadata [put]
+func (h *Handler) SaveThemeJSON(c *gin.Context) {
+	var req ThemeJSONRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	validation, err := h.themeService.SaveThemeJSON(c.Request.Context(), req.ThemeName, req.Content)
+	if err != nil {
+		if errors.Is(err, theme.ErrInvalidThemeJSON) {
+			response.FailWith(c, response.NewError(http.StatusUnprocessableEntity, response.CodeValidationFailed, err.Error()).WithDetails(validation))
+			return
+		}
+		h.handleError(c, err, "写入 theme.json 失败", http.StatusInternalServerError)
+		return
+	}
+
+	response.Success(c, validation, "theme.json 已更新")
+}
+
+// VerifyThemeIntegrity 复检已安装主题的完整性
+// @Summary      复检主题完整性
+// @Description  对已安装主题重新执行安装时的校验（目录结构、theme.json、index.html、禁止的文件类型），并与安装时记录的文件清单比对，报告新增、删除和被修改的文件
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  ThemeIntegrityRequest  true  "主题名称"
+// @Success      200  {object}  response.Response{data=theme.ThemeIntegrityReport}  "复检完成"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      404  {object}  response.Response  "主题未安装"
+// @Failure      500  {object}  response.Response  "复检失败"
+// @Router       /theme/integrity/verify [post]
+func (h *Handler) VerifyThemeIntegrity(c *gin.Context) {
+	var req ThemeIntegrityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	report, err := h.themeService.VerifyThemeIntegrity(c.Request.Context(), req.ThemeName)
+	if err != nil {
+		h.handleError(c, err, "主题完整性复检失败", http.StatusInternalServerError)
+		return
+	}
+
+	response.Success(c, report, "主题完整性复检完成")
+}
+
+// RebuildThemeManifest 重建主题文件清单
+// @Summary      重建主题文件清单
+// @Description  确认主题当前文件无误后，以当前文件重建文件清单作为之后复检的基线，返回重建后的复检结果
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  ThemeIntegrityRequest  true  "主题名称"
+// @Success      200  {object}  response.Response{data=theme.ThemeIntegrityReport}  "重建成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      404  {object}  response.Response  "主题未安装"
+// @Failure      500  {object}  response.Response  "重建失败"
+// @Router       /theme/integrity/rebuild [post]
+func (h *Handler) RebuildThemeManifest(c *gin.Context) {
+	var req ThemeIntegrityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	report, err := h.themeService.RebuildThemeManifest(c.Request.Context(), req.ThemeName)
+	if err != nil {
+		h.handleError(c, err, "重建主题文件清单失败", http.StatusInternalServerError)
+		return
+	}
+
+	response.Success(c, report, "主题文件清单已重建")
+}
+
+// CleanupStorage 清理主题遗留文件
+// @Summary      清理主题遗留文件
+// @Description  删除遗留的 static 备份目录和临时文件，dry_run=true 时仅返回将被删除的内容
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  theme.ThemeStorageCleanupRequest  true  "清理请求"
+// @Success      200  {object}  response.Response{data=theme.ThemeStorageCleanupResult}  "清理成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      500  {object}  response.Response  "清理失败"
+// @Router       /theme/storage/cleanup [post]
+func (h *Handler) CleanupStorage(c *gin.Context) {
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		h.failUserID(c, err)
+		return
+	}
+
+	var req theme.ThemeStorageCleanupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	result, err := h.themeService.CleanupStorage(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.handleError(c, err, "清理主题遗留文件失败", http.StatusBadRequest)
+		return
+	}
+
+	message := "清理完成"
+	if result.DryRun {
+		message = "预演完成，未删除任何文件"
+	}
+	response.Success(c, result, message)
+}
+
+// ReconcileThemes 检查主题记录与文件的一致性
+// @Summary      检查主题一致性
+// @Description  报告数据库中存在但主题目录缺失的记录，以及 themes 目录中存在但没有安装记录的主题（普通主题和 SSR 主题）
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=theme.ThemeReconcileReport}  "检查成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      500  {object}  response.Response  "检查失败"
+// @Router       /theme/reconcile [get]
+func (h *Handler) ReconcileThemes(c *gin.Context) {
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		h.failUserID(c, err)
+		return
+	}
+
+	report, err := h.themeService.ReconcileThemes(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err, "检查主题一致性失败", http.StatusInternalServerError)
+		return
+	}
+
+	response.Success(c, report, "检查主题一致性成功")
+}
+
+// FixOrphanTheme 修复不一致的主题
+// @Summary      修复不一致的主题
+// @Description  对有记录无文件的主题重新下载(redownload)或清除记录(purge_record)，对有文件无记录的主题创建记录(adopt)
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  theme.ThemeReconcileRequest  true  "修复请求"
+// @Success      200  {object}  response.Response  "修复成功"
+// @Failure      400  {object}  response.Response  "参数错误或修复失败"
+// @Router       /theme/reconcile/fix [post]
+func (h *Handler) FixOrphanTheme(c *gin.Context) {
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		h.failUserID(c, err)
+		return
+	}
+
+	var req theme.ThemeReconcileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return
+	}
+
+	if err := h.themeService.FixOrphanTheme(c.Request.Context(), userID, &req); err != nil {
+		h.handleError(c, err, "修复主题失败", http.StatusBadRequest)
+		return
+	}
+
+	response.Success(c, nil, "修复主题成功")
+}
+
+// BulkUninstallThemes 批量卸载主题
+// @Summary      批量卸载主题
+// @Description  卸载选中的多个主题，逐项返回结果；当前使用的主题、被其它主题继承的主题和 SSR 主题会卸载失败，不影响其它主题
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  theme.ThemeBulkRequest  true  "主题名称列表"
+// @Success      200  {object}  response.Response{data=theme.ThemeBulkResult}  "执行完成"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Router       /theme/bulk/uninstall [post]
+func (h *Handler) BulkUninstallThemes(c *gin.Context) {
+	userID, req, ok := h.bindBulkRequest(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.themeService.BulkUninstallThemes(c.Request.Context(), userID, req.ThemeNames)
+	if err != nil {
+		h.handleError(c, err, "批量卸载主题失败", http.StatusBadRequest)
+		return
+	}
+
+	response.Success(c, result, "批量卸载完成")
+}
+
+// BulkCheckThemeUpdates 批量检查主题更新
+// @Summary      批量检查主题更新
+// @Description  将选中主题的已安装版本与主题商城中的最新版本比较，逐项返回是否有更新
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request  body  theme.ThemeBulkRequest  true  "主题名称列表"
+// @Success      200  {object}  response.Response{data=theme.ThemeBulkResult}  "检查完成"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      502  {object}  response.Response  "获取主题商城数据失败"
+// @Router       /theme/bulk/check-updates [post]
+func (h *Handler) BulkCheckThemeUpdates(c *gin.Context) {
+	userID, req, ok := h.bindBulkRequest(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.themeService.BulkCheckThemeUpdates(c.Request.Context(), userID, req.ThemeNames)
+	if err != nil {
+		h.handleError(c, err, "批量检查更新失败", http.StatusBadGateway)
+		return
+	}
+
+	response.Success(c, result, "批量检查更新完成")
+}
+
+// BulkExportThemes 批量导出主题
+// @Summary      批量导出主题
+// @Description  将选中的主题打包为一个 ZIP 下载，逐项结果写入压缩包内的 export-result.json；未安装的主题会被跳过，
+// @Description  写入压缩包出错时整个导出失败；没有主题可以导出时返回 422 和逐项结果
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      application/zip
+// @Param        request  body  theme.ThemeBulkRequest  true  "主题名称列表"
+// @Success      200  {file}    file  "主题压缩包"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      422  {object}  response.Response{data=theme.ThemeBulkResult}  "没有主题导出成功"
+// @Router       /theme/bulk/export [post]
+func (h *Handler) BulkExportThemes(c *gin.Context) {
+	userID, req, ok := h.bindBulkRequest(c)
+	if !ok {
+		return
+	}
+
+	zipPath, result, err := h.themeService.BulkExportThemes(c.Request.Context(), userID, req.ThemeNames)
+	if err != nil {
+		h.handleError(c, err, "批量导出主题失败", http.StatusBadRequest)
+		return
+	}
+	if zipPath == "" {
+		response.SuccessWithStatus(c, http.StatusUnprocessableEntity, result, "没有主题导出成功")
+		return
+	}
+	defer os.Remove(zipPath)
+
+	filename := fmt.Sprintf("themes-export-%s.zip", time.Now().Format("20060102-150405"))
+	c.Header("Content-Description", "File Transfer")
+	c.Header("Content-Disposition", "attachment; filename="+filename)
+	c.Header("Content-Transfer-Encoding", "binary")
+	c.Header("Content-Type", "application/zip")
+	c.File(zipPath)
+}
+
+// bindBulkRequest 提取用户ID并绑定批量操作请求，失败时已写入响应
+func (h *Handler) bindBulkRequest(c *gin.Context) (uint, *theme.ThemeBulkRequest, bool) {
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		h.failUserID(c, err)
+		return 0, nil, false
+	}
+
+	var req theme.ThemeBulkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.FailBind(c, err, &req)
+		return 0, nil, false
+	}
+	return userID, &req, true
 }
@@ -0,0 +1,90 @@
+/*
+ * @Description: 主题菜单和小工具布局 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package themelayout
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/themelayout"
+)
+
+// Handler 主题布局 handler
+type Handler struct {
+	svc *themelayout.Service
+}
+
+// NewHandler 创建主题布局 handler
+func NewHandler(svc *themelayout.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// SetLayoutRequest 保存主题布局请求
+type SetLayoutRequest struct {
+	ThemeName string                          `json:"theme_name"` // 为空时使用当前主题
+	Menus     map[string]string               `json:"menus"`      // 菜单位置 -> header_menu 或 nav_menu
+	Widgets   map[string][]themelayout.Widget `json:"widgets"`    // 小工具区域 -> 小工具
+}
+
+// GetLayout 获取主题布局
+// @Summary      获取主题布局
+// @Description  返回主题在 theme.json 中声明的菜单位置、小工具区域以及当前的分配
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        theme_name  query  string  false  "主题名称，为空时使用当前主题"
+// @Success      200  {object}  response.Response{data=themelayout.LayoutDetail}  "获取成功"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /admin/theme/layout [get]
+func (h *Handler) GetLayout(c *gin.Context) {
+	detail, err := h.svc.Get(c.Request.Context(), c.Query("theme_name"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, detail, "获取主题布局成功")
+}
+
+// SetLayout 保存主题布局
+// @Summary      保存主题布局
+// @Description  覆盖主题的菜单和小工具分配，只能使用主题声明的菜单位置和小工具区域
+// @Tags         主题管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  SetLayoutRequest  true  "菜单和小工具分配"
+// @Success      200  {object}  response.Response{data=themelayout.LayoutDetail}  "保存成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Router       /admin/theme/layout [put]
+func (h *Handler) SetLayout(c *gin.Context) {
+	var req SetLayoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+	detail, err := h.svc.Set(c.Request.Context(), req.ThemeName, &themelayout.Layout{Menus: req.Menus, Widgets: req.Widgets})
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, detail, "保存主题布局成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	for _, target := range []error{
+		themelayout.ErrUnknownMenuLocation, themelayout.ErrUnknownWidgetArea, themelayout.ErrInvalidMenuSource,
+		themelayout.ErrInvalidWidgetType, themelayout.ErrInvalidWidgetLimit, themelayout.ErrInvalidWidgetID, themelayout.ErrTooManyWidgets,
+	} {
+		if errors.Is(err, target) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	response.Fail(c, http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,35 @@
+/*
+ * @Description: 内置 HTTPS 证书状态 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package tls
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/autotls"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+)
+
+// Handler 内置 HTTPS handler
+type Handler struct {
+	manager *autotls.Manager
+}
+
+// NewHandler 创建内置 HTTPS handler
+func NewHandler(manager *autotls.Manager) *Handler {
+	return &Handler{manager: manager}
+}
+
+// GetStatus 获取证书状态
+// @Summary      获取内置 HTTPS 证书状态
+// @Description  返回内置 HTTPS 是否启用，以及各域名证书的签发机构、有效期、剩余天数和最近一次申请失败原因
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=autotls.Status}  "获取成功"
+// @Router       /admin/tls/status [get]
+func (h *Handler) GetStatus(c *gin.Context) {
+	response.Success(c, h.manager.Status(c.Request.Context()), "获取证书状态成功")
+}
@@ -0,0 +1,136 @@
+/*
+ * @Description: 回收站 API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package trash
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/media"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/trash"
+)
+
+// Handler 回收站 handler
+type Handler struct {
+	svc *trash.Service
+}
+
+// NewHandler 创建回收站 handler
+func NewHandler(svc *trash.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// ListResponse 回收站列表
+type ListResponse struct {
+	List          []*trash.Item `json:"list"`
+	RetentionDays int           `json:"retention_days"` // 保留天数，0 表示不自动清理
+}
+
+// EmptyResponse 清空回收站结果
+type EmptyResponse struct {
+	Purged int `json:"purged"`
+}
+
+// List 获取回收站内容
+// @Summary      获取回收站内容
+// @Description  列出已删除的文章、页面和已卸载的主题，按删除时间从新到旧排列，并给出预计彻底删除的时间
+// @Tags         回收站
+// @Security     BearerAuth
+// @Produce      json
+// @Param        type  query  string  false  "内容类型" Enums(article, page, theme, media)
+// @Success      200  {object}  response.Response{data=ListResponse}  "获取成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /admin/trash [get]
+func (h *Handler) List(c *gin.Context) {
+	items, err := h.svc.List(c.Request.Context(), c.Query("type"))
+	if err != nil {
+		h.fail(c, "获取回收站内容失败", err)
+		return
+	}
+	response.Success(c, ListResponse{List: items, RetentionDays: h.svc.RetentionDays()}, "获取成功")
+}
+
+// Restore 恢复回收站中的内容
+// @Summary      恢复回收站中的内容
+// @Description  恢复文章时一并恢复其标签和分类；恢复主题时要求当前未安装同名主题，恢复后不会自动启用
+// @Tags         回收站
+// @Security     BearerAuth
+// @Produce      json
+// @Param        type  path  string  true  "内容类型" Enums(article, page, theme, media)
+// @Param        id    path  string  true  "内容ID"
+// @Success      200  {object}  response.Response  "恢复成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      404  {object}  response.Response  "回收站中不存在该内容"
+// @Failure      409  {object}  response.Response  "同名主题已安装"
+// @Failure      500  {object}  response.Response  "恢复失败"
+// @Router       /admin/trash/{type}/{id}/restore [post]
+func (h *Handler) Restore(c *gin.Context) {
+	if err := h.svc.Restore(c.Request.Context(), c.Param("type"), c.Param("id")); err != nil {
+		h.fail(c, "恢复失败", err)
+		return
+	}
+	response.Success(c, nil, "恢复成功")
+}
+
+// Purge 彻底删除回收站中的内容
+// @Summary      彻底删除回收站中的内容
+// @Description  彻底删除后不可恢复，文章的历史版本会一并删除
+// @Tags         回收站
+// @Security     BearerAuth
+// @Produce      json
+// @Param        type  path  string  true  "内容类型" Enums(article, page, theme, media)
+// @Param        id    path  string  true  "内容ID"
+// @Success      200  {object}  response.Response  "删除成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      404  {object}  response.Response  "回收站中不存在该内容"
+// @Failure      500  {object}  response.Response  "删除失败"
+// @Router       /admin/trash/{type}/{id} [delete]
+func (h *Handler) Purge(c *gin.Context) {
+	if err := h.svc.Purge(c.Request.Context(), c.Param("type"), c.Param("id")); err != nil {
+		h.fail(c, "彻底删除失败", err)
+		return
+	}
+	response.Success(c, nil, "已彻底删除")
+}
+
+// Empty 清空回收站
+// @Summary      清空回收站
+// @Description  彻底删除回收站中的全部内容，可按类型清空，不可恢复
+// @Tags         回收站
+// @Security     BearerAuth
+// @Produce      json
+// @Param        type  query  string  false  "内容类型，留空清空全部" Enums(article, page, theme, media)
+// @Success      200  {object}  response.Response{data=EmptyResponse}  "清空成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      500  {object}  response.Response  "部分内容删除失败"
+// @Router       /admin/trash [delete]
+func (h *Handler) Empty(c *gin.Context) {
+	count, err := h.svc.Empty(c.Request.Context(), c.Query("type"))
+	if err != nil {
+		h.fail(c, "清空回收站失败", err)
+		return
+	}
+	response.Success(c, EmptyResponse{Purged: count}, "回收站已清空")
+}
+
+func (h *Handler) fail(c *gin.Context, msg string, err error) {
+	switch {
+	case errors.Is(err, trash.ErrUnknownType):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, constant.ErrNotFound), errors.Is(err, theme.ErrTrashedThemeNotFound), errors.Is(err, media.ErrTrashedFileNotFound):
+		response.Fail(c, http.StatusNotFound, "回收站中不存在该内容")
+	case errors.Is(err, theme.ErrThemeAlreadyInstalled):
+		response.Fail(c, http.StatusConflict, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, msg+": "+err.Error())
+	}
+}
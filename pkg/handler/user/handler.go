@@ -21,6 +21,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	service_auth "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/direct_link"
 	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
@@ -35,15 +36,17 @@ type UserHandler struct {
 	settingSvc    setting.SettingService
 	fileSvc       file_service.FileService
 	directLinkSvc direct_link.Service
+	tokenSvc      service_auth.TokenService
 }
 
 // NewUserHandler 是 UserHandler 的构造函数
-func NewUserHandler(userSvc user.UserService, settingSvc setting.SettingService, fileSvc file_service.FileService, directLinkSvc direct_link.Service) *UserHandler {
+func NewUserHandler(userSvc user.UserService, settingSvc setting.SettingService, fileSvc file_service.FileService, directLinkSvc direct_link.Service, tokenSvc service_auth.TokenService) *UserHandler {
 	return &UserHandler{
 		userSvc:       userSvc,
 		settingSvc:    settingSvc,
 		fileSvc:       fileSvc,
 		directLinkSvc: directLinkSvc,
+		tokenSvc:      tokenSvc,
 	}
 }
 
@@ -215,7 +218,12 @@ func (h *UserHandler) UpdateUserPassword(c *gin.Context) {
 		return
 	}
 
-	// 5. 返回成功响应
+	// 5. 退出其他设备上的登录，保留当前会话
+	if _, err := h.tokenSvc.RevokeUserSessions(c.Request.Context(), internalUserID, claims.SessionID); err != nil {
+		log.Printf("[修改密码] 退出用户 %d 其他设备的登录失败: %v", internalUserID, err)
+	}
+
+	// 6. 返回成功响应
 	response.Success(c, nil, "密码修改成功")
 }
 
@@ -634,7 +642,12 @@ func (h *UserHandler) AdminResetPassword(c *gin.Context) {
 		return
 	}
 
-	// 4. 返回响应
+	// 4. 退出该用户在所有设备上的登录，旧密码登录的会话不再有效
+	if _, err := h.tokenSvc.RevokeUserSessions(c.Request.Context(), userID, ""); err != nil {
+		log.Printf("[重置密码] 退出用户 %d 所有设备的登录失败: %v", userID, err)
+	}
+
+	// 5. 返回响应
 	response.Success(c, nil, "密码重置成功")
 }
 
@@ -679,7 +692,12 @@ func (h *UserHandler) AdminUpdateUserStatus(c *gin.Context) {
 		return
 	}
 
-	// 4. 返回响应
+	// 4. 退出该用户在所有设备上的登录，封禁或停用后已签发的令牌不能继续刷新
+	if _, err := h.tokenSvc.RevokeUserSessions(c.Request.Context(), userID, ""); err != nil {
+		log.Printf("[更新用户状态] 退出用户 %d 所有设备的登录失败: %v", userID, err)
+	}
+
+	// 5. 返回响应
 	response.Success(c, nil, "用户状态更新成功")
 }
 
@@ -0,0 +1,81 @@
+/*
+ * @Description: 站点能力声明，供 SSR 和外部主题检测可用功能
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package version
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/search"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// Capabilities 站点能力声明
+type Capabilities struct {
+	Version         string     `json:"version"`
+	ThemeAPIVersion int        `json:"theme_api_version"`
+	Features        FeatureSet `json:"features"`
+}
+
+// FeatureSet 各子系统的启用状态
+type FeatureSet struct {
+	Search      SearchFeature   `json:"search"`
+	Comments    Feature         `json:"comments"`
+	Reactions   ReactionFeature `json:"reactions"`
+	Newsletter  Feature         `json:"newsletter"`
+	Webmentions Feature         `json:"webmentions"`
+}
+
+// Feature 子系统是否可用
+type Feature struct {
+	Enabled bool `json:"enabled"`
+}
+
+// SearchFeature 搜索子系统，mode 为 redis 或 simple，站点开关关闭时 enabled 为 false
+type SearchFeature struct {
+	Enabled bool   `json:"enabled"`
+	Mode    string `json:"mode,omitempty"`
+}
+
+// ReactionFeature 表态子系统，附带允许的表态列表
+type ReactionFeature struct {
+	Enabled bool     `json:"enabled"`
+	Allowed []string `json:"allowed,omitempty"`
+}
+
+// GetCapabilities 获取站点能力声明
+// @Summary      获取站点能力声明
+// @Description  返回程序版本、主题 API 约定版本以及搜索、评论、表态、订阅、Webmention 等子系统的启用状态，供主题在构建或运行时检测可用功能
+// @Tags         辅助工具
+// @Produce      json
+// @Success      200  {object}  response.Response{data=Capabilities}  "获取成功"
+// @Router       /public/capabilities [get]
+func (h *Handler) GetCapabilities(c *gin.Context) {
+	mode := search.Mode()
+	caps := Capabilities{
+		Version:         version.GetVersion(),
+		ThemeAPIVersion: version.ThemeAPIVersion,
+		Features: FeatureSet{
+			Search: SearchFeature{
+				Enabled: mode != "" && setting.FeatureEnabled(h.settingSvc, setting.FeatureSearch),
+				Mode:    mode,
+			},
+			Comments: Feature{Enabled: setting.FeatureEnabled(h.settingSvc, setting.FeatureComments)},
+			Reactions: ReactionFeature{
+				Enabled: setting.FeatureEnabled(h.settingSvc, setting.FeatureReactions),
+				Allowed: h.reactionSvc.AllowedReactions(),
+			},
+			Newsletter: Feature{Enabled: setting.FeatureEnabled(h.settingSvc, setting.FeatureNewsletter)},
+			// 当前版本尚未实现 Webmention
+			Webmentions: Feature{Enabled: false},
+		},
+	}
+
+	// 能力随配置变化，只允许短时间缓存
+	c.Header("Cache-Control", "public, max-age=60")
+	response.Success(c, caps, "获取站点能力成功")
+}
@@ -11,15 +11,20 @@ import (
 	"net/http"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/reaction"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/gin-gonic/gin"
 )
 
 // Handler 版本信息处理器
-type Handler struct{}
+type Handler struct {
+	settingSvc  setting.SettingService
+	reactionSvc *reaction.Service
+}
 
 // NewHandler 创建版本信息处理器实例
-func NewHandler() *Handler {
-	return &Handler{}
+func NewHandler(settingSvc setting.SettingService, reactionSvc *reaction.Service) *Handler {
+	return &Handler{settingSvc: settingSvc, reactionSvc: reactionSvc}
 }
 
 // GetVersion 获取版本信息
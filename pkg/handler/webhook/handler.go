@@ -0,0 +1,140 @@
+/*
+ * @Description: 内容来源 Webhook API
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package webhook
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/webhook"
+)
+
+// maxPayloadSize Webhook 请求体大小上限
+const maxPayloadSize = 10 << 20
+
+// Handler 内容来源 Webhook handler
+type Handler struct {
+	svc *webhook.Service
+}
+
+// NewHandler 创建内容来源 Webhook handler
+func NewHandler(svc *webhook.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// Receive 接收外部系统推送的内容
+// @Summary      接收内容来源推送
+// @Description  请求体使用来源密钥计算 HMAC-SHA256，签名以 sha256=<hex> 格式放在 X-Hub-Signature-256 或 X-Anheyu-Signature-256 头中；dry_run=true 时只返回处理预览
+// @Tags         内容来源 Webhook
+// @Accept       json
+// @Produce      json
+// @Param        id       path   string  true   "来源 ID"
+// @Param        dry_run  query  bool    false  "只预览不写入"
+// @Success      200  {object}  response.Response{data=webhook.Result}  "处理完成"
+// @Failure      401  {object}  response.Response  "签名校验失败"
+// @Failure      404  {object}  response.Response  "来源不存在"
+// @Router       /webhooks/{id} [post]
+func (h *Handler) Receive(c *gin.Context) {
+	body, err := readBody(c)
+	if err != nil {
+		response.Fail(c, http.StatusRequestEntityTooLarge, err.Error())
+		return
+	}
+	signature := c.GetHeader("X-Hub-Signature-256")
+	if signature == "" {
+		signature = c.GetHeader("X-Anheyu-Signature-256")
+	}
+	dryRun, _ := strconv.ParseBool(c.Query("dry_run"))
+	result, err := h.svc.Receive(c.Request.Context(), c.Param("id"), webhook.Delivery{
+		Event:     c.GetHeader("X-GitHub-Event"),
+		Signature: signature,
+		Body:      body,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, result, "处理完成")
+}
+
+// ListSources 获取内容来源列表
+// @Summary      获取内容来源列表
+// @Tags         内容来源 Webhook
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]webhook.SourceInfo}  "获取成功"
+// @Router       /admin/webhooks [get]
+func (h *Handler) ListSources(c *gin.Context) {
+	response.Success(c, h.svc.Sources(requestBaseURL(c)), "获取内容来源成功")
+}
+
+// Preview 使用示例请求体预览处理结果
+// @Summary      预览内容来源推送
+// @Description  请求体为来源会推送的示例内容（github 来源为 push 事件），不校验签名，不写入任何数据
+// @Tags         内容来源 Webhook
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id     path   string  true   "来源 ID"
+// @Param        event  query  string  false  "github 来源的事件类型，默认 push"
+// @Success      200  {object}  response.Response{data=webhook.Result}  "预览成功"
+// @Failure      404  {object}  response.Response  "来源不存在"
+// @Router       /admin/webhooks/{id}/preview [post]
+func (h *Handler) Preview(c *gin.Context) {
+	body, err := readBody(c)
+	if err != nil {
+		response.Fail(c, http.StatusRequestEntityTooLarge, err.Error())
+		return
+	}
+	result, err := h.svc.Preview(c.Request.Context(), c.Param("id"), webhook.Delivery{
+		Event: c.DefaultQuery("event", "push"),
+		Body:  body,
+	})
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, result, "预览成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, webhook.ErrSourceNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, webhook.ErrInvalidSignature):
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, webhook.ErrInvalidPayload):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func readBody(c *gin.Context) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxPayloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxPayloadSize {
+		return nil, errors.New("请求体超过 10 MB")
+	}
+	return body, nil
+}
+
+// requestBaseURL 未配置站点地址时，根据当前请求推断接收地址
+func requestBaseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host
+}
@@ -2,6 +2,7 @@
 package wechat
 
 import (
+	"errors"
 	"log"
 	"net/http"
 
@@ -13,12 +14,14 @@ import (
 // Handler 微信JS-SDK处理器
 type Handler struct {
 	jssdkService *wechat_service.JSSDKService
+	shareService *wechat_service.ShareService
 }
 
 // NewHandler 创建处理器
-func NewHandler(jssdkService *wechat_service.JSSDKService) *Handler {
+func NewHandler(jssdkService *wechat_service.JSSDKService, shareService *wechat_service.ShareService) *Handler {
 	return &Handler{
 		jssdkService: jssdkService,
+		shareService: shareService,
 	}
 }
 
@@ -67,6 +70,50 @@ func (h *Handler) GetJSSDKConfig(c *gin.Context) {
 	response.Success(c, config, "")
 }
 
+// GetArticleShareConfigRequest 获取文章分享配置请求
+type GetArticleShareConfigRequest struct {
+	Slug string `form:"slug" binding:"required"` // 文章 slug 或 ID
+	URL  string `form:"url"`                     // 需要签名的页面URL，为空时使用文章地址
+}
+
+// GetArticleShareConfig 获取文章分享配置
+// @Summary      获取文章微信分享配置
+// @Description  根据文章 slug 返回签名后的JS-SDK配置以及由文章数据生成的分享标题、描述、封面和链接
+// @Tags         微信分享
+// @Produce      json
+// @Param        slug query string true "文章 slug 或 ID"
+// @Param        url query string false "需要签名的页面URL，默认为文章地址"
+// @Success      200 {object} response.Response{data=wechat_service.ArticleShareConfig} "获取成功"
+// @Failure      400 {object} response.Response "参数错误"
+// @Failure      404 {object} response.Response "文章不存在"
+// @Failure      500 {object} response.Response "获取失败"
+// @Router       /wechat/jssdk/article-share [get]
+func (h *Handler) GetArticleShareConfig(c *gin.Context) {
+	var req GetArticleShareConfigRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误: 缺少slug参数")
+		return
+	}
+
+	if h.shareService == nil || h.jssdkService == nil || !h.jssdkService.IsConfigured() {
+		response.Fail(c, http.StatusServiceUnavailable, "微信分享功能未配置")
+		return
+	}
+
+	config, err := h.shareService.GetArticleShareConfig(c.Request.Context(), req.Slug, req.URL)
+	if err != nil {
+		if errors.Is(err, wechat_service.ErrShareArticleNotFound) {
+			response.Fail(c, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Printf("[微信JS-SDK] 获取文章分享配置失败: slug=%s, err=%v", req.Slug, err)
+		response.Fail(c, http.StatusInternalServerError, "获取文章分享配置失败")
+		return
+	}
+
+	response.Success(c, config, "")
+}
+
 // CheckShareEnabled 检查分享功能是否启用
 // @Summary      检查微信分享功能状态
 // @Description  检查微信分享功能是否已配置并启用
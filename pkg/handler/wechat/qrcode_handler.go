@@ -0,0 +1,70 @@
+// anheyu-app/pkg/handler/wechat/qrcode_handler.go
+package wechat
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	wechat_service "github.com/anzhiyu-c/anheyu-app/pkg/service/wechat"
+	"github.com/gin-gonic/gin"
+)
+
+// QRCodeHandler 文章微信二维码处理器
+type QRCodeHandler struct {
+	qrcodeService *wechat_service.QRCodeService
+	articleRepo   repository.ArticleRepository
+}
+
+// NewQRCodeHandler 创建文章微信二维码处理器
+func NewQRCodeHandler(qrcodeService *wechat_service.QRCodeService, articleRepo repository.ArticleRepository) *QRCodeHandler {
+	return &QRCodeHandler{
+		qrcodeService: qrcodeService,
+		articleRepo:   articleRepo,
+	}
+}
+
+// GetArticleQRCode 获取文章的微信小程序码或公众号二维码
+// @Summary      获取文章微信二维码
+// @Description  生成指向文章的小程序码或公众号二维码图片，供主题渲染“在微信中阅读”组件，生成结果按文章缓存
+// @Tags         微信分享
+// @Produce      image/jpeg
+// @Param        slug query string true "文章 slug 或 ID"
+// @Success      200 {file} binary "二维码图片"
+// @Failure      400 {object} response.Response "参数错误"
+// @Failure      404 {object} response.Response "文章不存在"
+// @Failure      500 {object} response.Response "生成失败"
+// @Router       /public/wechat/qrcode [get]
+func (h *QRCodeHandler) GetArticleQRCode(c *gin.Context) {
+	slug := c.Query("slug")
+	if slug == "" {
+		response.Fail(c, http.StatusBadRequest, "参数错误: 缺少slug参数")
+		return
+	}
+
+	if h.qrcodeService == nil || !h.qrcodeService.IsConfigured() {
+		response.Fail(c, http.StatusServiceUnavailable, "微信二维码功能未配置")
+		return
+	}
+
+	article, err := h.articleRepo.GetBySlugOrID(c.Request.Context(), slug)
+	if err != nil || article == nil {
+		response.Fail(c, http.StatusNotFound, "文章不存在")
+		return
+	}
+
+	img, err := h.qrcodeService.GetArticleQRCode(c.Request.Context(), article.ID)
+	if err != nil {
+		log.Printf("[微信二维码] 生成文章 %s 的二维码失败: %v", article.ID, err)
+		response.Fail(c, http.StatusInternalServerError, "生成二维码失败")
+		return
+	}
+
+	contentType := img.ContentType
+	if contentType == "" {
+		contentType = "image/jpeg"
+	}
+	c.Header("Cache-Control", "public, max-age=86400") // 二维码永久有效，缓存1天
+	c.Data(http.StatusOK, contentType, img.Data)
+}
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/ssr"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -91,12 +92,18 @@ func SSRProxyMiddlewareWithChecker(ssrManager *ssr.Manager, checker CurrentSSRTh
 		// 自定义 Director 保留原始请求信息
 		originalDirector := proxy.Director
 		proxy.Director = func(req *http.Request) {
+			// 非可信代理转发的请求丢弃客户端自带的转发头，ReverseProxy 会重新追加真实对端地址
+			if !util.IsFromTrustedProxy(c) {
+				req.Header.Del("X-Forwarded-For")
+				req.Header.Del("X-Forwarded-Proto")
+			}
 			originalDirector(req)
 			// 保留原始 Host 头（某些 SSR 框架可能需要）
 			req.Host = req.URL.Host
 			// 添加代理标识头
 			req.Header.Set("X-Forwarded-Host", c.Request.Host)
-			req.Header.Set("X-Real-IP", c.ClientIP())
+			req.Header.Set("X-Real-IP", util.GetRealClientIP(c))
+			req.Header.Set("X-Forwarded-Proto", util.GetRequestScheme(c))
 		}
 
 		// 错误处理：当 SSR 进程不可用时返回友好错误
@@ -147,6 +154,11 @@ func ShouldSkipSSRProxy(path string) bool {
 		}
 	}
 
+	// 文章精简阅读页由后端直接渲染
+	if strings.HasPrefix(path, "/posts/") && strings.HasSuffix(path, "/lite") {
+		return true
+	}
+
 	// 前缀匹配的路径
 	// 注意：/static/ 和 /assets/ 不在此列表中，因为它们应该由当前激活的主题控制
 	// 当使用 SSR 主题时，这些路径会被代理到 SSR 进程
@@ -0,0 +1,439 @@
+/*
+ * @Description: 外部插件，以独立进程运行，通过本机 gRPC 与主程序通信
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 每个外部插件位于 data/plugins/<名称>/，由 plugin.json 描述：
+ *   {
+ *     "name": "analytics", "version": "1.0.0", "description": "转发访问统计",
+ *     "command": "./analytics", "args": [],
+ *     "events": ["article:published"],
+ *     "routes": true,
+ *     "settings": {"title": "统计转发", "fields": [{"key": "endpoint", "label": "接收地址", "type": "text"}]}
+ *   }
+ * 插件进程启动时通过环境变量获得监听端口（ANHEYU_PLUGIN_PORT）和当前设置（ANHEYU_PLUGIN_SETTINGS，JSON），
+ * 需要在 127.0.0.1 上提供 pluginpb/plugin.proto 中定义的 Plugin 服务，可以用任何支持 gRPC 的语言实现：
+ *   HandleEvent     订阅的事件，payload 为事件内容的 JSON
+ *   UpdateSettings  设置在后台修改后推送
+ *   ServeHTTP       routes 为 true 时，前台 /plugins/<名称>/ 下的请求转发到这里（去掉前缀）
+ * 插件进程只继承 PATH、HOME 和 TZ，主程序的其他环境变量（数据库密码等）不会传给插件。
+ * 转发请求时去掉 Cookie 和 Authorization 等凭据，插件响应中的 Set-Cookie 也会被丢弃，插件无法读取或设置站点的登录状态。
+ */
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/plugin/pluginpb"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+)
+
+// manifestFileName 外部插件描述文件名
+const manifestFileName = "plugin.json"
+
+// 外部插件进程的超时设置
+const (
+	externalStopTimeout    = 5 * time.Second
+	externalEventTimeout   = 5 * time.Second
+	externalRequestTimeout = 30 * time.Second
+)
+
+// maxProxyBodySize 转发给插件的请求体大小上限，gRPC 默认的单条消息上限为 4MB，留出请求头等字段的空间
+const maxProxyBodySize = 3 << 20
+
+// inheritedEnv 插件进程从主程序继承的环境变量
+var inheritedEnv = []string{"PATH", "HOME", "TZ"}
+
+// strippedRequestHeaders 转发给插件前去掉的请求头，避免插件拿到访客的登录凭据
+var strippedRequestHeaders = []string{"Cookie", "Authorization", "Proxy-Authorization"}
+
+// strippedResponseHeaders 插件响应中丢弃的响应头，插件不能设置站点的 Cookie
+var strippedResponseHeaders = []string{"Set-Cookie"}
+
+// Manifest 外部插件描述文件 plugin.json
+type Manifest struct {
+	Name        string         `json:"name"`
+	Version     string         `json:"version"`
+	Description string         `json:"description"`
+	Command     string         `json:"command"`            // 启动命令，相对路径基于插件目录
+	Args        []string       `json:"args"`               // 启动参数
+	Events      []string       `json:"events"`             // 订阅的事件
+	Routes      bool           `json:"routes"`             // 是否将 /plugins/<名称>/ 下的请求转发给插件
+	Settings    *SettingsPanel `json:"settings,omitempty"` // 后台设置面板
+}
+
+// loadExternalPlugins 扫描插件目录，读取每个子目录中的 plugin.json，描述文件无效的插件跳过
+func loadExternalPlugins(dir string) ([]Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var plugins []Plugin
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		pluginDir := filepath.Join(dir, entry.Name())
+		manifest, err := readManifest(pluginDir)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				log.Printf("[插件] 跳过 %s: %v", pluginDir, err)
+			}
+			continue
+		}
+		if manifest.Name != entry.Name() {
+			log.Printf("[插件] 跳过 %s: plugin.json 中的名称 %q 必须与目录名一致", pluginDir, manifest.Name)
+			continue
+		}
+		plugins = append(plugins, &externalPlugin{dir: pluginDir, manifest: manifest})
+	}
+	return plugins, nil
+}
+
+func readManifest(dir string) (Manifest, error) {
+	var manifest Manifest
+	content, err := os.ReadFile(filepath.Join(dir, manifestFileName))
+	if err != nil {
+		return manifest, err
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return manifest, fmt.Errorf("解析 %s 失败: %w", manifestFileName, err)
+	}
+	if !isValidPluginName(manifest.Name) {
+		return manifest, fmt.Errorf("无效的插件名称 %q", manifest.Name)
+	}
+	if manifest.Command == "" {
+		return manifest, errors.New("未指定启动命令 command")
+	}
+	return manifest, nil
+}
+
+// externalPlugin 将外部进程包装为 Plugin
+type externalPlugin struct {
+	dir      string
+	manifest Manifest
+	host     Host
+
+	lifecycle sync.Mutex // 串行化启动、停止和重启
+	mu        sync.Mutex
+	cmd       *exec.Cmd
+	conn      *grpc.ClientConn
+	client    pluginpb.PluginClient
+	done      chan struct{}
+	exitErr   error
+}
+
+func (p *externalPlugin) Info() Info {
+	return Info{Name: p.manifest.Name, Version: p.manifest.Version, Description: p.manifest.Description}
+}
+
+func (p *externalPlugin) Init(host Host) error {
+	p.host = host
+	if p.manifest.Settings != nil {
+		host.RegisterSettingsPanel(*p.manifest.Settings)
+	}
+	p.lifecycle.Lock()
+	err := p.start()
+	p.lifecycle.Unlock()
+	if err != nil {
+		return err
+	}
+	for _, topic := range p.manifest.Events {
+		host.Subscribe(topic, func(payload any) { p.forwardEvent(topic, payload) })
+	}
+	if p.manifest.Routes {
+		host.RegisterRoutes(RouteRegistrarFunc(func(engine *gin.Engine) {
+			engine.Any("/plugins/"+p.manifest.Name+"/*path", p.proxy)
+		}))
+	}
+	return nil
+}
+
+func (p *externalPlugin) Close() error {
+	p.lifecycle.Lock()
+	defer p.lifecycle.Unlock()
+	p.stop()
+	return nil
+}
+
+// Restart 重启插件进程
+func (p *externalPlugin) Restart() error {
+	p.lifecycle.Lock()
+	defer p.lifecycle.Unlock()
+	p.stop()
+	return p.start()
+}
+
+// Err 插件进程意外退出时返回退出原因
+func (p *externalPlugin) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.cmd == nil {
+		return errors.New("插件进程未运行")
+	}
+	select {
+	case <-p.done:
+		if p.exitErr != nil {
+			return fmt.Errorf("插件进程已退出: %w", p.exitErr)
+		}
+		return errors.New("插件进程已退出")
+	default:
+		return nil
+	}
+}
+
+// SettingsChanged 将新的设置推送给插件进程
+func (p *externalPlugin) SettingsChanged(values map[string]string) {
+	client, ok := p.rpcClient()
+	if !ok {
+		log.Printf("[插件] 向插件 %s 推送设置失败: 插件进程未运行", p.manifest.Name)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), externalEventTimeout)
+	defer cancel()
+	if _, err := client.UpdateSettings(ctx, &pluginpb.Settings{Values: values}); err != nil {
+		log.Printf("[插件] 向插件 %s 推送设置失败: %v", p.manifest.Name, err)
+	}
+}
+
+// start 在空闲端口上启动插件进程，输出写入插件目录下的 plugin.log
+func (p *externalPlugin) start() error {
+	port, err := freePort()
+	if err != nil {
+		return fmt.Errorf("分配端口失败: %w", err)
+	}
+	settings, err := json.Marshal(p.currentSettings())
+	if err != nil {
+		return err
+	}
+
+	command := p.manifest.Command
+	if !filepath.IsAbs(command) && filepath.Base(command) != command {
+		command = filepath.Join(p.dir, command)
+	}
+	conn, err := grpc.NewClient("127.0.0.1:"+strconv.Itoa(port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("创建插件连接失败: %w", err)
+	}
+
+	cmd := exec.Command(command, p.manifest.Args...)
+	cmd.Dir = p.dir
+	cmd.Env = pluginEnv(
+		"ANHEYU_PLUGIN_NAME="+p.manifest.Name,
+		"ANHEYU_PLUGIN_PORT="+strconv.Itoa(port),
+		"ANHEYU_PLUGIN_SETTINGS="+string(settings),
+	)
+	logFile, err := os.OpenFile(filepath.Join(p.dir, "plugin.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err == nil {
+		cmd.Stdout = logFile
+		cmd.Stderr = logFile
+	}
+	if err := cmd.Start(); err != nil {
+		if logFile != nil {
+			logFile.Close()
+		}
+		conn.Close()
+		return fmt.Errorf("启动插件进程失败: %w", err)
+	}
+
+	done := make(chan struct{})
+	p.mu.Lock()
+	p.cmd, p.conn, p.client, p.done, p.exitErr = cmd, conn, pluginpb.NewPluginClient(conn), done, nil
+	p.mu.Unlock()
+
+	go func() {
+		err := cmd.Wait()
+		if logFile != nil {
+			logFile.Close()
+		}
+		p.mu.Lock()
+		if p.cmd == cmd {
+			p.exitErr = err
+		}
+		p.mu.Unlock()
+		close(done)
+		log.Printf("[插件] 插件进程已退出: %s", p.manifest.Name)
+	}()
+
+	log.Printf("[插件] 插件进程已启动: %s，端口: %d", p.manifest.Name, port)
+	return nil
+}
+
+// stop 请求插件进程退出，超时后强制结束
+func (p *externalPlugin) stop() {
+	p.mu.Lock()
+	cmd, conn, done := p.cmd, p.conn, p.done
+	p.cmd, p.conn, p.client = nil, nil, nil
+	p.mu.Unlock()
+	if cmd == nil {
+		return
+	}
+	conn.Close()
+
+	select {
+	case <-done:
+		return
+	default:
+	}
+	// Windows 不支持发送中断信号，直接结束进程
+	if err := cmd.Process.Signal(os.Interrupt); err != nil {
+		cmd.Process.Kill()
+	}
+	select {
+	case <-done:
+	case <-time.After(externalStopTimeout):
+		cmd.Process.Kill()
+		<-done
+	}
+}
+
+func (p *externalPlugin) currentSettings() map[string]string {
+	values := make(map[string]string)
+	if p.manifest.Settings != nil && p.host != nil {
+		for _, field := range p.manifest.Settings.Fields {
+			values[field.Key] = p.host.Setting(field.Key)
+		}
+	}
+	return values
+}
+
+// rpcClient 返回插件进程的 gRPC 客户端，进程未运行时返回 false
+func (p *externalPlugin) rpcClient() (pluginpb.PluginClient, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.cmd == nil {
+		return nil, false
+	}
+	select {
+	case <-p.done:
+		return nil, false
+	default:
+	}
+	return p.client, true
+}
+
+func (p *externalPlugin) forwardEvent(topic string, payload any) {
+	err := func() error {
+		client, ok := p.rpcClient()
+		if !ok {
+			return errors.New("插件进程未运行")
+		}
+		content, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), externalEventTimeout)
+		defer cancel()
+		_, err = client.HandleEvent(ctx, &pluginpb.Event{Topic: topic, Payload: content})
+		return err
+	}()
+	if err != nil {
+		log.Printf("[插件] 向插件 %s 转发事件 %s 失败: %v", p.manifest.Name, topic, err)
+	}
+}
+
+// proxy 将 /plugins/<名称>/ 下的请求转发给插件进程
+func (p *externalPlugin) proxy(c *gin.Context) {
+	client, ok := p.rpcClient()
+	if !ok {
+		c.String(http.StatusServiceUnavailable, "插件 %s 未运行", p.manifest.Name)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxProxyBodySize+1))
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxProxyBodySize {
+		c.Status(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	header := c.Request.Header.Clone()
+	for _, key := range strippedRequestHeaders {
+		header.Del(key)
+	}
+	headers := toHeaderValues(header)
+	headers["X-Forwarded-Prefix"] = &pluginpb.HeaderValues{Values: []string{"/plugins/" + p.manifest.Name}}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), externalRequestTimeout)
+	defer cancel()
+	resp, err := client.ServeHTTP(ctx, &pluginpb.HTTPRequest{
+		Method:     c.Request.Method,
+		Path:       c.Param("path"),
+		RawQuery:   c.Request.URL.RawQuery,
+		Headers:    headers,
+		Body:       body,
+		RemoteAddr: util.GetRealClientIP(c),
+	})
+	if err != nil {
+		log.Printf("[插件] 转发请求到插件 %s 失败: %v", p.manifest.Name, err)
+		c.Status(http.StatusBadGateway)
+		return
+	}
+
+	for key, values := range resp.GetHeaders() {
+		if slices.ContainsFunc(strippedResponseHeaders, func(h string) bool { return strings.EqualFold(h, key) }) {
+			continue
+		}
+		for _, v := range values.GetValues() {
+			c.Writer.Header().Add(key, v)
+		}
+	}
+	status := int(resp.GetStatus())
+	if status == 0 {
+		status = http.StatusOK
+	}
+	c.Status(status)
+	c.Writer.Write(resp.GetBody())
+}
+
+func toHeaderValues(header http.Header) map[string]*pluginpb.HeaderValues {
+	result := make(map[string]*pluginpb.HeaderValues, len(header))
+	for key, values := range header {
+		result[key] = &pluginpb.HeaderValues{Values: values}
+	}
+	return result
+}
+
+// pluginEnv 插件进程的环境变量：只继承 inheritedEnv 中列出的变量，再加上 extra
+func pluginEnv(extra ...string) []string {
+	env := make([]string, 0, len(inheritedEnv)+len(extra))
+	for _, key := range inheritedEnv {
+		if value, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+value)
+		}
+	}
+	return append(env, extra...)
+}
+
+// freePort 获取一个本机空闲端口
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
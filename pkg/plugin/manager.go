@@ -0,0 +1,506 @@
+/*
+ * @Description: 插件管理器，负责插件的加载、启停、状态查询和设置保存
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 启用/停用状态和插件设置保存在 data/plugins/state.json。外部插件会以独立进程运行任意程序，
+ * 默认不启用，需要管理员在后台启用；编译进程序的内置插件默认启用。
+ * 路由和模板函数只能在启动时注册，因此启用或停用插件需要重启后生效；外部插件进程可以单独重启。
+ */
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+)
+
+// DefaultDir 外部插件和插件状态文件所在的目录
+const DefaultDir = "data/plugins"
+
+// stateFileName 插件状态文件名
+const stateFileName = "state.json"
+
+// 插件来源
+const (
+	SourceBuiltin  = "builtin"
+	SourceExternal = "external"
+)
+
+// 插件运行状态
+const (
+	StateRunning  = "running"
+	StateFailed   = "failed"
+	StateDisabled = "disabled"
+	StateStopped  = "stopped"
+)
+
+var (
+	// ErrPluginNotFound 插件不存在
+	ErrPluginNotFound = errors.New("插件不存在")
+	// ErrRestartUnsupported 插件不支持单独重启
+	ErrRestartUnsupported = errors.New("只有外部插件支持单独重启")
+)
+
+var templateFuncNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Status 插件状态，用于后台列表展示
+type Status struct {
+	Info
+	Source         string          `json:"source"`
+	State          string          `json:"state"`
+	Error          string          `json:"error,omitempty"`
+	Enabled        bool            `json:"enabled"`         // 保存的启用状态
+	PendingRestart bool            `json:"pending_restart"` // 启用状态已修改，重启后生效
+	Subscriptions  []string        `json:"subscriptions"`
+	TemplateFuncs  []string        `json:"template_funcs"`
+	RouteCount     int             `json:"route_count"`
+	Panels         []SettingsPanel `json:"panels"`
+}
+
+// pluginState 持久化的插件状态
+type pluginState struct {
+	Enabled  map[string]bool              `json:"enabled"`            // 管理员设置的启用状态，未设置时按插件来源决定
+	Disabled []string                     `json:"disabled,omitempty"` // 旧版本的停用列表，读取时并入 Enabled
+	Settings map[string]map[string]string `json:"settings"`
+}
+
+type entry struct {
+	plugin    Plugin
+	source    string
+	state     string
+	err       string
+	enabledAt bool // 启动时是否启用
+	topics    []string
+	funcs     []string
+	routes    int
+	panels    []SettingsPanel
+}
+
+// healthReporter 可选接口，插件运行期间出错（如外部进程退出）时返回错误
+type healthReporter interface {
+	Err() error
+}
+
+// restarter 可选接口，支持单独重启的插件实现
+type restarter interface {
+	Restart() error
+}
+
+// Frontend 接收插件注册的前台路由和模板函数，由前端路由模块提供
+type Frontend struct {
+	RegisterRoutes       func(r RouteRegistrar)
+	RegisterTemplateFunc func(name string, fn any)
+}
+
+// Manager 插件管理器
+type Manager struct {
+	mu       sync.RWMutex
+	dir      string
+	bus      *event.EventBus
+	frontend Frontend
+	entries  map[string]*entry
+	order    []string
+	state    pluginState
+}
+
+// NewManager 创建插件管理器，dir 为外部插件目录
+func NewManager(bus *event.EventBus, dir string, frontend Frontend) *Manager {
+	return &Manager{
+		dir:      dir,
+		bus:      bus,
+		frontend: frontend,
+		entries:  make(map[string]*entry),
+		state:    pluginState{Enabled: map[string]bool{}, Settings: map[string]map[string]string{}},
+	}
+}
+
+// Start 加载并初始化内置插件和外部插件，应在前端路由配置之前调用，
+// 否则插件注册的路由和模板函数不会生效。单个插件初始化失败不影响其它插件
+func (m *Manager) Start() {
+	if err := m.loadState(); err != nil {
+		log.Printf("[插件] 读取插件状态失败，将使用默认状态: %v", err)
+	}
+
+	plugins := make([]Plugin, 0)
+	sources := make(map[string]string)
+	for _, p := range registeredPlugins() {
+		plugins = append(plugins, p)
+		sources[p.Info().Name] = SourceBuiltin
+	}
+	external, err := loadExternalPlugins(m.dir)
+	if err != nil {
+		log.Printf("[插件] 扫描外部插件失败: %v", err)
+	}
+	for _, p := range external {
+		name := p.Info().Name
+		if _, exists := sources[name]; exists {
+			log.Printf("[插件] 外部插件 %s 与内置插件重名，已忽略", name)
+			continue
+		}
+		plugins = append(plugins, p)
+		sources[name] = SourceExternal
+	}
+
+	for _, p := range plugins {
+		name := p.Info().Name
+		e := &entry{plugin: p, source: sources[name], enabledAt: m.isEnabled(name, sources[name])}
+		m.mu.Lock()
+		m.entries[name] = e
+		m.order = append(m.order, name)
+		m.mu.Unlock()
+
+		if !e.enabledAt {
+			e.state = StateDisabled
+			if e.source == SourceExternal {
+				log.Printf("[插件] 外部插件 %s 未启用，在后台启用并重启后生效", name)
+			}
+			continue
+		}
+		if err := m.initPlugin(name, e); err != nil {
+			log.Printf("[插件] 插件 %s 初始化失败: %v", name, err)
+			m.mu.Lock()
+			e.state, e.err = StateFailed, err.Error()
+			m.mu.Unlock()
+			continue
+		}
+		m.mu.Lock()
+		e.state = StateRunning
+		m.mu.Unlock()
+		log.Printf("[插件] 已启用插件 %s %s（%s）", name, p.Info().Version, e.source)
+	}
+}
+
+// initPlugin 调用插件的 Init，插件 panic 时视为初始化失败
+func (m *Manager) initPlugin(name string, e *entry) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("初始化时发生 panic: %v", r)
+		}
+	}()
+	return e.plugin.Init(&host{m: m, name: name, e: e})
+}
+
+// Stop 关闭所有运行中的插件
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, name := range m.order {
+		e := m.entries[name]
+		if e.state != StateRunning {
+			continue
+		}
+		if err := e.plugin.Close(); err != nil {
+			log.Printf("[插件] 关闭插件 %s 失败: %v", name, err)
+		}
+		e.state = StateStopped
+	}
+}
+
+// List 返回所有插件的状态
+func (m *Manager) List() []Status {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	list := make([]Status, 0, len(m.order))
+	for _, name := range m.order {
+		list = append(list, m.statusUnlocked(name))
+	}
+	return list
+}
+
+func (m *Manager) statusUnlocked(name string) Status {
+	e := m.entries[name]
+	enabled := m.isEnabled(name, e.source)
+	s := Status{
+		Info:           e.plugin.Info(),
+		Source:         e.source,
+		State:          e.state,
+		Error:          e.err,
+		Enabled:        enabled,
+		PendingRestart: enabled != e.enabledAt,
+		Subscriptions:  append([]string{}, e.topics...),
+		TemplateFuncs:  append([]string{}, e.funcs...),
+		RouteCount:     e.routes,
+		Panels:         append([]SettingsPanel{}, e.panels...),
+	}
+	if s.State == StateRunning {
+		if hr, ok := e.plugin.(healthReporter); ok {
+			if err := hr.Err(); err != nil {
+				s.State, s.Error = StateFailed, err.Error()
+			}
+		}
+	}
+	return s
+}
+
+// SetEnabled 保存插件的启用状态，重启后生效
+func (m *Manager) SetEnabled(name string, enabled bool) (Status, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.entries[name]; !ok {
+		return Status{}, ErrPluginNotFound
+	}
+	previous, existed := m.state.Enabled[name]
+	m.state.Enabled[name] = enabled
+	if err := m.saveStateUnlocked(); err != nil {
+		if existed {
+			m.state.Enabled[name] = previous
+		} else {
+			delete(m.state.Enabled, name)
+		}
+		return Status{}, err
+	}
+	return m.statusUnlocked(name), nil
+}
+
+// Settings 返回插件的设置面板和当前设置值（未设置的字段使用默认值）
+func (m *Manager) Settings(name string) ([]SettingsPanel, map[string]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	e, ok := m.entries[name]
+	if !ok {
+		return nil, nil, ErrPluginNotFound
+	}
+	values := make(map[string]string)
+	for _, panel := range e.panels {
+		for _, field := range panel.Fields {
+			values[field.Key] = m.settingUnlocked(name, e, field.Key)
+		}
+	}
+	return append([]SettingsPanel{}, e.panels...), values, nil
+}
+
+// UpdateSettings 保存插件设置，只接受设置面板中声明的字段，保存后通知运行中的插件
+func (m *Manager) UpdateSettings(name string, values map[string]string) error {
+	m.mu.Lock()
+	e, ok := m.entries[name]
+	if !ok {
+		m.mu.Unlock()
+		return ErrPluginNotFound
+	}
+	known := make(map[string]bool)
+	for _, panel := range e.panels {
+		for _, field := range panel.Fields {
+			known[field.Key] = true
+		}
+	}
+	for key := range values {
+		if !known[key] {
+			m.mu.Unlock()
+			return fmt.Errorf("插件 %s 没有设置项 %s", name, key)
+		}
+	}
+
+	previous := m.state.Settings[name]
+	merged := make(map[string]string, len(previous)+len(values))
+	for k, v := range previous {
+		merged[k] = v
+	}
+	for k, v := range values {
+		merged[k] = v
+	}
+	m.state.Settings[name] = merged
+	if err := m.saveStateUnlocked(); err != nil {
+		m.state.Settings[name] = previous
+		m.mu.Unlock()
+		return err
+	}
+	running := e.state == StateRunning
+	current := make(map[string]string)
+	for key := range known {
+		current[key] = m.settingUnlocked(name, e, key)
+	}
+	m.mu.Unlock()
+
+	if listener, ok := e.plugin.(SettingsListener); ok && running {
+		listener.SettingsChanged(current)
+	}
+	return nil
+}
+
+// Restart 重启外部插件的进程
+func (m *Manager) Restart(name string) (Status, error) {
+	m.mu.RLock()
+	e, ok := m.entries[name]
+	m.mu.RUnlock()
+	if !ok {
+		return Status{}, ErrPluginNotFound
+	}
+	r, ok := e.plugin.(restarter)
+	if !ok {
+		return Status{}, ErrRestartUnsupported
+	}
+	m.mu.RLock()
+	disabled := e.state == StateDisabled
+	m.mu.RUnlock()
+	if disabled {
+		return Status{}, fmt.Errorf("插件 %s 未启用", name)
+	}
+	err := r.Restart()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err != nil {
+		e.state, e.err = StateFailed, err.Error()
+		return m.statusUnlocked(name), err
+	}
+	e.state, e.err = StateRunning, ""
+	return m.statusUnlocked(name), nil
+}
+
+// isEnabled 插件是否启用：以管理员的设置为准，未设置时内置插件启用、外部插件停用
+func (m *Manager) isEnabled(name, source string) bool {
+	if enabled, ok := m.state.Enabled[name]; ok {
+		return enabled
+	}
+	return source == SourceBuiltin
+}
+
+// settingUnlocked 读取设置值，未保存时使用面板中声明的默认值
+func (m *Manager) settingUnlocked(name string, e *entry, key string) string {
+	if v, ok := m.state.Settings[name][key]; ok {
+		return v
+	}
+	for _, panel := range e.panels {
+		for _, field := range panel.Fields {
+			if field.Key == key {
+				return field.Default
+			}
+		}
+	}
+	return ""
+}
+
+func (m *Manager) loadState() error {
+	content, err := os.ReadFile(filepath.Join(m.dir, stateFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var state pluginState
+	if err := json.Unmarshal(content, &state); err != nil {
+		return err
+	}
+	if state.Settings == nil {
+		state.Settings = map[string]map[string]string{}
+	}
+	if state.Enabled == nil {
+		state.Enabled = map[string]bool{}
+	}
+	for _, name := range state.Disabled {
+		state.Enabled[name] = false
+	}
+	state.Disabled = nil
+	m.mu.Lock()
+	m.state = state
+	m.mu.Unlock()
+	return nil
+}
+
+// saveStateUnlocked 先写临时文件再重命名，避免写入中断导致状态文件损坏
+func (m *Manager) saveStateUnlocked() error {
+	if err := os.MkdirAll(m.dir, 0755); err != nil {
+		return fmt.Errorf("创建插件目录失败: %w", err)
+	}
+	content, err := json.MarshalIndent(m.state, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(m.dir, stateFileName)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, content, 0600); err != nil {
+		return fmt.Errorf("保存插件状态失败: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("保存插件状态失败: %w", err)
+	}
+	return nil
+}
+
+// host 为单个插件提供扩展点，并记录插件注册了哪些扩展
+type host struct {
+	m    *Manager
+	name string
+	e    *entry
+}
+
+func (h *host) Subscribe(topic string, handler func(payload any)) {
+	h.m.mu.Lock()
+	h.e.topics = append(h.e.topics, topic)
+	h.m.mu.Unlock()
+
+	h.m.bus.Subscribe(event.Topic(topic), func(payload interface{}) {
+		h.m.mu.RLock()
+		running := h.e.state == StateRunning
+		h.m.mu.RUnlock()
+		if !running {
+			return
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("[插件] 插件 %s 处理事件 %s 时发生 panic: %v", h.name, topic, r)
+			}
+		}()
+		handler(payload)
+	})
+}
+
+func (h *host) RegisterRoutes(r RouteRegistrar) {
+	h.m.mu.Lock()
+	h.e.routes++
+	h.m.mu.Unlock()
+	// 路由在 SetupFrontend 时才真正注册，此时插件若已初始化失败则跳过
+	h.m.frontend.RegisterRoutes(RouteRegistrarFunc(func(engine *gin.Engine) {
+		h.m.mu.RLock()
+		running := h.e.state == StateRunning
+		h.m.mu.RUnlock()
+		if running {
+			r.Register(engine)
+		}
+	}))
+}
+
+func (h *host) RegisterTemplateFunc(name string, fn any) error {
+	if !templateFuncNamePattern.MatchString(name) {
+		return fmt.Errorf("无效的模板函数名: %s", name)
+	}
+	if fn == nil || reflect.TypeOf(fn).Kind() != reflect.Func {
+		return fmt.Errorf("模板函数 %s 必须是函数", name)
+	}
+	// 与 text/template 的要求一致：返回一个值，或者一个值加一个 error
+	t := reflect.TypeOf(fn)
+	errorType := reflect.TypeFor[error]()
+	if t.NumOut() != 1 && !(t.NumOut() == 2 && t.Out(1) == errorType) {
+		return fmt.Errorf("模板函数 %s 必须返回一个值，或者一个值和一个 error", name)
+	}
+	h.m.mu.Lock()
+	h.e.funcs = append(h.e.funcs, name)
+	h.m.mu.Unlock()
+	h.m.frontend.RegisterTemplateFunc(name, fn)
+	return nil
+}
+
+func (h *host) RegisterSettingsPanel(panel SettingsPanel) {
+	h.m.mu.Lock()
+	defer h.m.mu.Unlock()
+	h.e.panels = append(h.e.panels, panel)
+}
+
+func (h *host) Setting(key string) string {
+	h.m.mu.RLock()
+	defer h.m.mu.RUnlock()
+	return h.m.settingUnlocked(h.name, h.e, key)
+}
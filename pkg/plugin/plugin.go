@@ -0,0 +1,123 @@
+/*
+ * @Description: 插件扩展点定义与内置插件注册
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 插件有两种来源：
+ *   - 内置插件：随程序一起编译，在 init 中调用 plugin.Register 注册，例如 import _ "example.com/anheyu-analytics"
+ *   - 外部插件：data/plugins/<名称>/plugin.json 描述的独立进程，通过本机 HTTP 接收事件和处理路由，见 external.go
+ * 插件在 Init 中通过 Host 注册事件订阅、前台路由、主题模板函数和后台设置面板。
+ */
+package plugin
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Info 插件的基本信息
+type Info struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Description string `json:"description"`
+}
+
+// Plugin 插件需要实现的接口
+type Plugin interface {
+	// Info 返回插件信息，Name 作为插件的唯一标识
+	Info() Info
+	// Init 在应用启动时调用，插件在这里通过 host 注册扩展点；返回错误时该插件不会被启用
+	Init(host Host) error
+	// Close 在应用退出或插件停止时调用
+	Close() error
+}
+
+// RouteRegistrar 注册前台路由的组件，路由在内置前端路由之后、SPA 回退之前注册
+type RouteRegistrar interface {
+	Register(engine *gin.Engine)
+}
+
+// RouteRegistrarFunc 将普通函数适配为 RouteRegistrar
+type RouteRegistrarFunc func(engine *gin.Engine)
+
+// Register 实现 RouteRegistrar
+func (f RouteRegistrarFunc) Register(engine *gin.Engine) {
+	f(engine)
+}
+
+// SettingsListener 可选接口，插件设置在后台修改后收到通知
+type SettingsListener interface {
+	SettingsChanged(values map[string]string)
+}
+
+// Host 插件可以使用的扩展点
+type Host interface {
+	// Subscribe 订阅事件总线上的事件，如 "article:published"，处理函数在事件总线的 worker 中执行，不应长时间阻塞
+	Subscribe(topic string, handler func(payload any))
+	// RegisterRoutes 注册前台路由，路径建议使用 /plugins/<插件名>/ 前缀，避免与内置路由冲突
+	RegisterRoutes(r RouteRegistrar)
+	// RegisterTemplateFunc 注册主题模板函数，fn 必须是函数；与内置函数同名时忽略
+	RegisterTemplateFunc(name string, fn any) error
+	// RegisterSettingsPanel 注册后台设置面板，设置值由插件管理器保存
+	RegisterSettingsPanel(panel SettingsPanel)
+	// Setting 读取插件的设置值，未设置时返回面板中声明的默认值
+	Setting(key string) string
+}
+
+// SettingsPanel 插件在后台展示的设置面板
+type SettingsPanel struct {
+	Title  string          `json:"title"`
+	Fields []SettingsField `json:"fields"`
+}
+
+// SettingsField 设置面板中的一个字段
+type SettingsField struct {
+	Key         string   `json:"key"`
+	Label       string   `json:"label"`
+	Type        string   `json:"type"` // text、password、textarea、switch、select
+	Default     string   `json:"default,omitempty"`
+	Options     []string `json:"options,omitempty"` // type 为 select 时的可选值
+	Description string   `json:"description,omitempty"`
+}
+
+var builtin struct {
+	mu      sync.Mutex
+	plugins []Plugin
+}
+
+// Register 注册内置插件，应在 init 中调用；名称重复时 panic
+func Register(p Plugin) {
+	builtin.mu.Lock()
+	defer builtin.mu.Unlock()
+	name := p.Info().Name
+	if !isValidPluginName(name) {
+		panic(fmt.Sprintf("plugin: 无效的插件名称 %q", name))
+	}
+	for _, existing := range builtin.plugins {
+		if existing.Info().Name == name {
+			panic(fmt.Sprintf("plugin: 插件 %s 重复注册", name))
+		}
+	}
+	builtin.plugins = append(builtin.plugins, p)
+}
+
+func registeredPlugins() []Plugin {
+	builtin.mu.Lock()
+	defer builtin.mu.Unlock()
+	return append([]Plugin(nil), builtin.plugins...)
+}
+
+// isValidPluginName 插件名用于目录名和路由前缀，只允许小写字母、数字、- 和 _
+func isValidPluginName(name string) bool {
+	if name == "" || len(name) > 64 {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
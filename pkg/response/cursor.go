@@ -0,0 +1,220 @@
+/*
+ * @Description: 列表接口的游标分页与 NDJSON 流式输出
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 请求带 cursor 或 limit 参数时按游标分页返回 {list, next_cursor, has_more, total}，
+ * 下一页把 next_cursor 原样传回即可。游标记录上一页最后一条记录的 (created_at, id)，
+ * 列表按这两列倒序排列，下一页只取排在其后的记录，翻页期间插入或删除记录不会导致跳过或重复。
+ * 请求头 Accept 为 application/x-ndjson 或带 format=ndjson 参数时，从游标位置开始逐页查询，
+ * 每条记录输出一行 JSON，数据量大时无需一次性加载到内存。两者都没有时由调用方沿用原有的 page/pageSize 分页。
+ */
+package response
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MimeNDJSON NDJSON 的内容类型
+const MimeNDJSON = "application/x-ndjson"
+
+// ErrInvalidCursor 游标格式错误
+var ErrInvalidCursor = errors.New("无效的分页游标")
+
+// CursorKey 记录在 (created_at, id) 倒序中的位置
+type CursorKey struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"i"` // 记录的公共 ID
+}
+
+// Cursor 解析后的游标分页参数
+type Cursor struct {
+	After   *CursorKey // 上一页最后一条记录的位置，第一页为 nil
+	Limit   int        // 每页数量，由第一页请求决定，后续游标沿用
+	Enabled bool       // 请求是否使用游标分页或 NDJSON
+	Stream  bool       // 是否以 NDJSON 流式输出全部剩余记录
+}
+
+// CursorPage 游标分页的单页结果
+type CursorPage[T any] struct {
+	List       []T    `json:"list"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+	Total      int64  `json:"total"`
+}
+
+// Fetcher 按 (created_at, id) 倒序查询排在 after 之后的至多 limit 条记录（after 为 nil 时从第一条开始），
+// 返回这些记录和符合条件的记录总数
+type Fetcher[T any] func(after *CursorKey, limit int) ([]T, int64, error)
+
+// KeyFunc 返回记录的游标位置
+type KeyFunc[T any] func(T) CursorKey
+
+// cursorPayload 游标编码前的内容
+type cursorPayload struct {
+	After CursorKey `json:"a"`
+	Limit int       `json:"l"`
+}
+
+// EncodeCursor 将上一页最后一条记录的位置和每页数量编码为不透明的游标字符串
+func EncodeCursor(after CursorKey, limit int) string {
+	data, _ := json.Marshal(cursorPayload{After: after, Limit: limit})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor 解码游标字符串
+func decodeCursor(s string) (cursorPayload, error) {
+	var p cursorPayload
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return p, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, &p); err != nil {
+		return p, ErrInvalidCursor
+	}
+	if p.Limit <= 0 || p.After.ID == "" {
+		return p, ErrInvalidCursor
+	}
+	return p, nil
+}
+
+// WantsNDJSON 判断请求是否要求以 NDJSON 格式返回
+func WantsNDJSON(c *gin.Context) bool {
+	if strings.EqualFold(c.Query("format"), "ndjson") {
+		return true
+	}
+	return strings.Contains(c.GetHeader("Accept"), MimeNDJSON)
+}
+
+// ParseCursor 从查询参数 cursor、limit 和请求格式中解析游标分页参数。
+// limit 缺省时取 defaultLimit，超过 maxLimit 时按 maxLimit 处理；游标中已记录的每页数量优先
+func ParseCursor(c *gin.Context, defaultLimit, maxLimit int) (Cursor, error) {
+	raw, rawLimit := c.Query("cursor"), c.Query("limit")
+	cur := Cursor{Limit: defaultLimit, Stream: WantsNDJSON(c)}
+	cur.Enabled = raw != "" || rawLimit != "" || cur.Stream
+
+	if rawLimit != "" {
+		n, err := strconv.Atoi(rawLimit)
+		if err != nil || n <= 0 {
+			return cur, fmt.Errorf("无效的 limit 参数: %s", rawLimit)
+		}
+		cur.Limit = n
+	}
+	if cur.Limit > maxLimit {
+		cur.Limit = maxLimit
+	}
+	if raw != "" {
+		p, err := decodeCursor(raw)
+		if err != nil {
+			return cur, err
+		}
+		if p.Limit > maxLimit {
+			return cur, ErrInvalidCursor
+		}
+		cur.After, cur.Limit = &p.After, p.Limit
+	}
+	return cur, nil
+}
+
+// NewCursorPage 根据查询到的一页记录和总数构造游标分页结果。
+// 本页记录数达到每页数量时认为还有下一页，因此最后一页可能为空
+func NewCursorPage[T any](cur Cursor, items []T, total int64, key KeyFunc[T]) CursorPage[T] {
+	if items == nil {
+		items = []T{}
+	}
+	page := CursorPage[T]{List: items, Total: total}
+	if len(items) > 0 && len(items) >= cur.Limit {
+		page.HasMore = true
+		page.NextCursor = EncodeCursor(key(items[len(items)-1]), cur.Limit)
+	}
+	return page
+}
+
+// SliceFetcher 为已在内存中的完整列表构造 Fetcher，列表按 (created_at, id) 倒序重新排列后分页
+func SliceFetcher[T any](items []T, key KeyFunc[T]) Fetcher[T] {
+	sorted := append([]T(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return keyAfter(key(sorted[j]), key(sorted[i]))
+	})
+	return func(after *CursorKey, limit int) ([]T, int64, error) {
+		total := int64(len(sorted))
+		start := 0
+		if after != nil {
+			start = sort.Search(len(sorted), func(i int) bool { return keyAfter(key(sorted[i]), *after) })
+		}
+		end := min(start+limit, len(sorted))
+		return sorted[start:end], total, nil
+	}
+}
+
+// keyAfter 判断 a 在 (created_at, id) 倒序中是否排在 b 之后。ID 都是数字时按数值比较
+func keyAfter(a, b CursorKey) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	x, errA := strconv.ParseUint(a.ID, 10, 64)
+	y, errB := strconv.ParseUint(b.ID, 10, 64)
+	if errA == nil && errB == nil {
+		return x < y
+	}
+	return a.ID < b.ID
+}
+
+// RespondCursor 按游标参数返回一页结果，或以 NDJSON 流式输出从游标开始的全部记录
+func RespondCursor[T any](c *gin.Context, cur Cursor, fetch Fetcher[T], key KeyFunc[T], message string) {
+	if cur.Stream {
+		StreamNDJSON(c, cur, fetch, key)
+		return
+	}
+	items, total, err := fetch(cur.After, cur.Limit)
+	if err != nil {
+		Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	Success(c, NewCursorPage(cur, items, total, key), message)
+}
+
+// StreamNDJSON 从游标位置开始逐页查询，每条记录写出一行 JSON 并在每页之后刷新。
+// 第一页查询失败时返回普通的错误响应；开始输出后出错则追加一行 {"error": "..."} 并结束
+func StreamNDJSON[T any](c *gin.Context, cur Cursor, fetch Fetcher[T], key KeyFunc[T]) {
+	items, total, err := fetch(cur.After, cur.Limit)
+	if err != nil {
+		Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Header("Content-Type", MimeNDJSON+"; charset=utf-8")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+	c.Status(http.StatusOK)
+
+	enc := json.NewEncoder(c.Writer)
+	for {
+		for _, item := range items {
+			if err := enc.Encode(item); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+
+		if len(items) == 0 || len(items) < cur.Limit || c.Request.Context().Err() != nil {
+			return
+		}
+		after := key(items[len(items)-1])
+		if items, _, err = fetch(&after, cur.Limit); err != nil {
+			_ = enc.Encode(gin.H{"error": err.Error()})
+			c.Writer.Flush()
+			return
+		}
+	}
+}
@@ -0,0 +1,325 @@
+/*
+ * @Description: 带机器可读错误码的错误响应
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 失败响应在原有的 code/message/data 之外增加 error 字段：
+ *   {"code":409,"message":"...","data":null,"error":{"code":"THEME_ALREADY_INSTALLED","message":"...","details":...,"docs":"..."}}
+ * 前端根据 error.code 分支处理，不再依赖中文提示文本。
+ * 服务层错误通过 ErrorRule 映射到错误码，映射表由各 handler 包按自己调用的服务维护。
+ */
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// ErrorCode 机器可读的错误码，取值稳定，新增不删改
+type ErrorCode string
+
+// 通用错误码
+const (
+	CodeBadRequest       ErrorCode = "BAD_REQUEST"
+	CodeValidationFailed ErrorCode = "VALIDATION_FAILED"
+	CodeUnauthorized     ErrorCode = "UNAUTHORIZED"
+	CodeForbidden        ErrorCode = "FORBIDDEN"
+	CodeNotFound         ErrorCode = "NOT_FOUND"
+	CodeConflict         ErrorCode = "CONFLICT"
+	CodeUpstreamFailed   ErrorCode = "UPSTREAM_FAILED"
+	CodeInternal         ErrorCode = "INTERNAL_ERROR"
+)
+
+// 主题与 SSR 错误码
+const (
+	CodeThemeAlreadyInstalled     ErrorCode = "THEME_ALREADY_INSTALLED"
+	CodeThemeNotInstalled         ErrorCode = "THEME_NOT_INSTALLED"
+	CodeThemeInstallInProgress    ErrorCode = "THEME_INSTALL_IN_PROGRESS"
+	CodeThemeInstallCanceled      ErrorCode = "THEME_INSTALL_CANCELED"
+	CodeThemeCanaryFailed         ErrorCode = "THEME_CANARY_FAILED"
+	CodeThemeOperationTimeout     ErrorCode = "THEME_OPERATION_TIMEOUT"
+	CodeThemeStorageQuotaExceeded ErrorCode = "THEME_STORAGE_QUOTA_EXCEEDED"
+	CodeThemeUpToDate             ErrorCode = "THEME_UP_TO_DATE"
+	CodeThemeUpdateUnavailable    ErrorCode = "THEME_UPDATE_UNAVAILABLE"
+	CodeInsufficientDiskSpace     ErrorCode = "INSUFFICIENT_DISK_SPACE"
+	CodeMarketSourceNotFound      ErrorCode = "MARKET_SOURCE_NOT_FOUND"
+	CodeMarketRatingUnsupported   ErrorCode = "MARKET_RATING_UNSUPPORTED"
+	CodeSSRNotRunning             ErrorCode = "SSR_NOT_RUNNING"
+	CodeSSRAlreadyRunning         ErrorCode = "SSR_ALREADY_RUNNING"
+	CodeSSRBuildDisabled          ErrorCode = "SSR_BUILD_DISABLED"
+)
+
+// ErrorDocsBaseURL 错误码说明文档地址，错误码以锚点形式附加在后面
+var ErrorDocsBaseURL = "https://dev.anheyu.com/api/errors"
+
+// ErrorBody 失败响应中的结构化错误信息
+type ErrorBody struct {
+	Code    ErrorCode   `json:"code"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+	Docs    string      `json:"docs,omitempty"`
+}
+
+// FieldError 参数校验失败的字段
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+// ValidationDetails VALIDATION_FAILED 错误的详细信息
+type ValidationDetails struct {
+	Fields []FieldError `json:"fields"`
+}
+
+// APIError 携带 HTTP 状态码和错误码的错误，可由 handler 直接构造，也可由 ErrorRule 映射得到
+type APIError struct {
+	Status  int
+	Code    ErrorCode
+	Message string
+	Details interface{}
+	Err     error
+}
+
+// NewError 创建 APIError
+func NewError(status int, code ErrorCode, message string) *APIError {
+	return &APIError{Status: status, Code: code, Message: message}
+}
+
+func (e *APIError) Error() string {
+	if e.Err != nil && e.Message == "" {
+		return e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// WithDetails 返回附带详细信息的副本
+func (e *APIError) WithDetails(details interface{}) *APIError {
+	cp := *e
+	cp.Details = details
+	return &cp
+}
+
+// ErrorRule 服务层哨兵错误到状态码和错误码的映射，按 errors.Is 匹配
+type ErrorRule struct {
+	Err    error
+	Status int
+	Code   ErrorCode
+}
+
+// FailError 输出结构化的失败响应。
+// err 为 APIError 时直接使用；能匹配 rules 中某条规则时使用规则的状态码和错误码，提示为错误本身的内容；
+// 都不匹配时使用 status 及其对应的通用错误码，提示为 "message: err"
+func FailError(c *gin.Context, err error, status int, message string, rules ...ErrorRule) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		FailWith(c, apiErr)
+		return
+	}
+	for _, rule := range rules {
+		if errors.Is(err, rule.Err) {
+			writeError(c, rule.Status, rule.Code, err.Error(), nil)
+			return
+		}
+	}
+	if message != "" {
+		message += ": " + err.Error()
+	} else {
+		message = err.Error()
+	}
+	writeError(c, status, codeForStatus(status), message, nil)
+}
+
+// FailWith 输出 APIError 对应的失败响应
+func FailWith(c *gin.Context, e *APIError) {
+	writeError(c, e.Status, e.Code, e.Error(), e.Details)
+}
+
+// FailCode 以指定的状态码和错误码输出失败响应
+func FailCode(c *gin.Context, status int, code ErrorCode, message string) {
+	writeError(c, status, code, message, nil)
+}
+
+// FailFields 手动校验参数失败时输出 VALIDATION_FAILED，并列出出错的字段
+func FailFields(c *gin.Context, message string, fields ...FieldError) {
+	if fields == nil {
+		fields = []FieldError{}
+	}
+	writeError(c, http.StatusBadRequest, CodeValidationFailed, message, ValidationDetails{Fields: fields})
+}
+
+// FailBind 请求体绑定或校验失败时输出 VALIDATION_FAILED。
+// 校验规则不满足时逐个列出字段，字段名按 obj（绑定的请求体）的 json 标签给出；JSON 格式错误时只给出提示
+func FailBind(c *gin.Context, err error, obj interface{}) {
+	var verrs validator.ValidationErrors
+	if errors.As(err, &verrs) {
+		fields := make([]FieldError, 0, len(verrs))
+		names := make([]string, 0, len(verrs))
+		for _, fe := range verrs {
+			f := FieldError{
+				Field: fieldName(reflect.TypeOf(obj), fe),
+				Rule:  fe.Tag(),
+				Param: fe.Param(),
+			}
+			f.Message = fieldMessage(f)
+			fields = append(fields, f)
+			names = append(names, f.Field)
+		}
+		FailFields(c, "请求参数校验失败: "+strings.Join(names, ", "), fields...)
+		return
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		FailFields(c, "请求参数格式错误: "+err.Error(), FieldError{
+			Field:   typeErr.Field,
+			Rule:    "type",
+			Param:   typeErr.Type.String(),
+			Message: fmt.Sprintf("%s 的类型应为 %s", typeErr.Field, typeErr.Type),
+		})
+		return
+	}
+	FailFields(c, "请求参数格式错误: "+err.Error())
+}
+
+// RequiredField 必填字段为空时的 FieldError
+func RequiredField(field string) FieldError {
+	return FieldError{Field: field, Rule: "required", Message: field + " 不能为空"}
+}
+
+func writeError(c *gin.Context, status int, code ErrorCode, message string, details interface{}) {
+	c.JSON(status, Response{
+		Code:    status,
+		Message: message,
+		Data:    nil,
+		Error: &ErrorBody{
+			Code:    code,
+			Message: message,
+			Details: details,
+			Docs:    docsURL(code),
+		},
+	})
+}
+
+// codeForStatus 没有更具体的错误码时，按状态码取通用错误码
+func codeForStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return CodeUpstreamFailed
+	default:
+		return CodeInternal
+	}
+}
+
+func docsURL(code ErrorCode) string {
+	if ErrorDocsBaseURL == "" {
+		return ""
+	}
+	return ErrorDocsBaseURL + "#" + strings.ToLower(strings.ReplaceAll(string(code), "_", "-"))
+}
+
+// fieldName 字段在请求体中的路径。
+// StructNamespace 形如 Request.Items[0].Name，去掉顶层结构体名后按 t 逐级把 Go 字段名换成 json 标签名
+func fieldName(t reflect.Type, fe validator.FieldError) string {
+	segments := strings.Split(fe.StructNamespace(), ".")
+	if len(segments) < 2 {
+		return fe.Field()
+	}
+	path := make([]string, 0, len(segments)-1)
+	for _, seg := range segments[1:] {
+		name, index := seg, ""
+		if i := strings.Index(seg, "["); i >= 0 {
+			name, index = seg[:i], seg[i:]
+		}
+		t = structType(t)
+		if t == nil {
+			path = append(path, seg)
+			continue
+		}
+		f, ok := t.FieldByName(name)
+		if !ok {
+			path = append(path, seg)
+			t = nil
+			continue
+		}
+		t = f.Type
+		for j := 0; j < strings.Count(index, "["); j++ {
+			t = elemType(t)
+		}
+		jsonName := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+		switch {
+		case jsonName == "" && f.Anonymous:
+			// 未命名的嵌入结构体字段在 JSON 中展开到外层
+			continue
+		case jsonName == "" || jsonName == "-":
+			jsonName = f.Name
+		}
+		path = append(path, jsonName+index)
+	}
+	return strings.Join(path, ".")
+}
+
+// structType 去掉指针后为结构体时返回该类型，否则返回 nil
+func structType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	return t
+}
+
+// elemType 切片、数组和 map 的元素类型，按下标访问一级
+func elemType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return t.Elem()
+	}
+	return nil
+}
+
+func fieldMessage(f FieldError) string {
+	switch f.Rule {
+	case "required":
+		return f.Field + " 不能为空"
+	case "min":
+		return fmt.Sprintf("%s 不能小于 %s", f.Field, f.Param)
+	case "max":
+		return fmt.Sprintf("%s 不能大于 %s", f.Field, f.Param)
+	case "oneof":
+		return fmt.Sprintf("%s 只能是 %s 之一", f.Field, f.Param)
+	case "url":
+		return f.Field + " 必须是有效的 URL"
+	default:
+		return fmt.Sprintf("%s 不满足校验规则 %s", f.Field, f.Rule)
+	}
+}
@@ -18,6 +18,7 @@ type Response struct {
 	Code    int         `json:"code"`
 	Message string      `json:"message"`
 	Data    interface{} `json:"data"`
+	Error   *ErrorBody  `json:"error,omitempty"` // 失败时的结构化错误信息，见 error.go
 }
 
 // Success 成功响应
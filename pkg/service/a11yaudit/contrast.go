@@ -0,0 +1,85 @@
+/*
+ * @Description: WCAG 颜色对比度计算
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package a11yaudit
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// rgb 0~255 的颜色分量
+type rgb struct {
+	r, g, b float64
+}
+
+// parseColor 解析 #rgb、#rgba、#rrggbb、#rrggbbaa 和 rgb()/rgba() 格式的颜色，透明度忽略
+func parseColor(value string) (rgb, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if strings.HasPrefix(value, "#") {
+		hex := value[1:]
+		switch len(hex) {
+		case 3, 4:
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		case 6, 8:
+			hex = hex[:6]
+		default:
+			return rgb{}, false
+		}
+		n, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return rgb{}, false
+		}
+		return rgb{float64(n >> 16 & 0xff), float64(n >> 8 & 0xff), float64(n & 0xff)}, true
+	}
+
+	for _, prefix := range []string{"rgba(", "rgb("} {
+		if !strings.HasPrefix(value, prefix) || !strings.HasSuffix(value, ")") {
+			continue
+		}
+		inner := strings.TrimSuffix(strings.TrimPrefix(value, prefix), ")")
+		parts := strings.FieldsFunc(inner, func(r rune) bool { return r == ',' || r == ' ' || r == '/' })
+		if len(parts) < 3 {
+			return rgb{}, false
+		}
+		var channels [3]float64
+		for i := 0; i < 3; i++ {
+			part := parts[i]
+			percent := strings.HasSuffix(part, "%")
+			v, err := strconv.ParseFloat(strings.TrimSuffix(part, "%"), 64)
+			if err != nil {
+				return rgb{}, false
+			}
+			if percent {
+				v = v * 255 / 100
+			}
+			channels[i] = math.Max(0, math.Min(255, v))
+		}
+		return rgb{channels[0], channels[1], channels[2]}, true
+	}
+	return rgb{}, false
+}
+
+// relativeLuminance WCAG 2.x 定义的相对亮度
+func relativeLuminance(c rgb) float64 {
+	linear := func(v float64) float64 {
+		v /= 255
+		if v <= 0.03928 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(c.r) + 0.7152*linear(c.g) + 0.0722*linear(c.b)
+}
+
+// contrastRatio 两种颜色的对比度，范围 1~21
+func contrastRatio(a, b rgb) float64 {
+	la, lb := relativeLuminance(a), relativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
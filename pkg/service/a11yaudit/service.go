@@ -0,0 +1,555 @@
+/*
+ * @Description: 页面无障碍检查
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 与 SEO 检查相同，通过应用自身的路由在进程内渲染指定页面，检查图片缺少 alt、标题层级跳跃、
+ * 表单控件缺少标签等常见问题，并根据主题色和当前主题配置中的颜色计算 WCAG 对比度，
+ * 帮助主题作者和站长发现服务端渲染 HTML 中的基础无障碍问题。
+ */
+package a11yaudit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
+)
+
+// 问题级别
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+)
+
+const (
+	// auditUserAgent 检查请求使用的 User-Agent，包含 bot 关键字，不会计入浏览量和访客统计
+	auditUserAgent = "AnheyuA11yAudit/1.0 (+bot)"
+	// maxPageSize 参与检查的页面最大体积
+	maxPageSize = 5 << 20
+	// maxListedElements 每类问题在报告中列出的元素数量上限
+	maxListedElements = 10
+	// maxElementLength 报告中元素片段的最大长度
+	maxElementLength = 160
+
+	// minTextContrast WCAG AA 正文文字的最低对比度
+	minTextContrast = 4.5
+	// minLargeContrast WCAG AA 大号文字和界面组件的最低对比度
+	minLargeContrast = 3.0
+	// themeConfigUserID 前台页面使用默认管理员的主题配置，与公开主题配置接口一致
+	themeConfigUserID = 1
+)
+
+// 对比度计算时假定的页面配色，与内置主题的默认背景色和正文颜色一致
+const (
+	lightBackground = "#ffffff"
+	lightText       = "#363636"
+	darkBackground  = "#18171d"
+	darkText        = "#f7f7fa"
+)
+
+var (
+	// ErrInvalidRoute 检查的路由不合法
+	ErrInvalidRoute = errors.New("路由必须以 / 开头，且不能是接口或后台地址")
+	// ErrNotReady 应用路由尚未初始化
+	ErrNotReady = errors.New("无障碍检查尚未就绪，请稍后再试")
+)
+
+// ThemeConfigProvider 提供当前主题的配置，由主题服务实现
+type ThemeConfigProvider interface {
+	GetCurrentThemeConfig(ctx context.Context, userID uint) (*theme.ThemeConfigResponse, error)
+}
+
+// Issue 检查发现的问题
+type Issue struct {
+	Level    string   `json:"level"`
+	Code     string   `json:"code"`
+	Message  string   `json:"message"`
+	Elements []string `json:"elements,omitempty"` // 有问题的元素片段，最多列出 maxListedElements 个
+}
+
+// ContrastResult 一组前景色和背景色的对比度
+type ContrastResult struct {
+	Source     string  `json:"source"` // 颜色来源，如 THEME_COLOR、theme.primaryColor
+	Mode       string  `json:"mode"`   // light 或 dark
+	Foreground string  `json:"foreground"`
+	Background string  `json:"background"`
+	Ratio      float64 `json:"ratio"`
+	Passed     bool    `json:"passed"`
+}
+
+// Report 单个页面的检查报告
+type Report struct {
+	Route        string           `json:"route"`
+	StatusCode   int              `json:"status_code"`
+	Lang         string           `json:"lang"`
+	ImageCount   int              `json:"image_count"`
+	HeadingCount int              `json:"heading_count"`
+	Headings     []string         `json:"headings"` // 按出现顺序的标题层级，如 h1、h2
+	ControlCount int              `json:"control_count"`
+	Contrasts    []ContrastResult `json:"contrasts"`
+	Issues       []Issue          `json:"issues"`
+	ErrorCount   int              `json:"error_count"`
+	WarningCount int              `json:"warning_count"`
+	CheckedAt    time.Time        `json:"checked_at"`
+	DurationMs   int64            `json:"duration_ms"`
+}
+
+// Service 无障碍检查服务
+type Service struct {
+	settingSvc setting.SettingService
+	themes     ThemeConfigProvider
+	handler    http.Handler
+}
+
+// NewService 创建无障碍检查服务，需要在 Gin 引擎创建后通过 SetHandler 注入；
+// themes 为 nil 时只检查主题色的对比度
+func NewService(settingSvc setting.SettingService, themes ThemeConfigProvider) *Service {
+	return &Service{settingSvc: settingSvc, themes: themes}
+}
+
+// SetHandler 设置用于渲染页面的 HTTP 处理器（通常为 Gin 引擎）
+func (s *Service) SetHandler(handler http.Handler) {
+	s.handler = handler
+}
+
+// Audit 渲染并检查指定路由
+func (s *Service) Audit(ctx context.Context, route string) (*Report, error) {
+	if s.handler == nil {
+		return nil, ErrNotReady
+	}
+	route = strings.TrimSpace(route)
+	if !isAuditableRoute(route) {
+		return nil, ErrInvalidRoute
+	}
+
+	start := time.Now()
+	status, body, err := s.render(ctx, route)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Route:      route,
+		StatusCode: status,
+		CheckedAt:  start,
+		Headings:   []string{},
+		Contrasts:  []ContrastResult{},
+		Issues:     []Issue{},
+	}
+	if status != http.StatusOK {
+		report.add(LevelError, "status", fmt.Sprintf("页面返回状态码 %d", status), nil)
+	}
+
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("解析页面 HTML 失败: %w", err)
+	}
+	page := extractPage(doc)
+
+	checkLang(report, page)
+	checkImages(report, page)
+	checkHeadings(report, page)
+	checkControls(report, page)
+	s.checkContrast(ctx, report)
+
+	report.DurationMs = time.Since(start).Milliseconds()
+	return report, nil
+}
+
+// render 在进程内请求路由，走与真实访客相同的中间件和渲染流程
+func (s *Service) render(ctx context.Context, route string) (int, []byte, error) {
+	target := route
+	if base := s.siteURL(); base != nil {
+		target = base.Scheme + "://" + base.Host + route
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrInvalidRoute, err)
+	}
+	req.RemoteAddr = "127.0.0.1:0"
+	req.Header.Set("User-Agent", auditUserAgent)
+	req.Header.Set("Accept", "text/html")
+
+	recorder := httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+
+	body, err := io.ReadAll(io.LimitReader(recorder.Result().Body, maxPageSize))
+	if err != nil {
+		return 0, nil, err
+	}
+	return recorder.Code, body, nil
+}
+
+func (s *Service) siteURL() *url.URL {
+	raw := strings.TrimSpace(s.settingSvc.Get(constant.KeySiteURL.String()))
+	if raw == "" {
+		return nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return nil
+	}
+	if u.Scheme == "" {
+		u.Scheme = "https"
+	}
+	return u
+}
+
+func checkLang(report *Report, page *pageInfo) {
+	report.Lang = page.lang
+	if strings.TrimSpace(page.lang) == "" {
+		report.add(LevelWarning, "lang_missing", "<html> 缺少 lang 属性，读屏软件无法确定朗读语言", nil)
+	}
+}
+
+func checkImages(report *Report, page *pageInfo) {
+	report.ImageCount = page.imageCount
+	if len(page.missingAlt) > 0 {
+		report.add(LevelError, "image_alt_missing",
+			fmt.Sprintf("%d 个图片元素缺少 alt 属性，装饰性图片请使用 alt=\"\"", len(page.missingAlt)), page.missingAlt)
+	}
+}
+
+func checkHeadings(report *Report, page *pageInfo) {
+	report.HeadingCount = len(page.headings)
+	h1Count := 0
+	var skipped, empty []string
+	prev := 0
+	for _, h := range page.headings {
+		report.Headings = append(report.Headings, "h"+strconv.Itoa(h.level))
+		if h.level == 1 {
+			h1Count++
+		}
+		// 层级只能逐级加深，如 h2 之后直接出现 h4
+		if prev > 0 && h.level > prev+1 {
+			skipped = append(skipped, fmt.Sprintf("h%d → %s", prev, h.element))
+		}
+		if h.empty {
+			empty = append(empty, h.element)
+		}
+		prev = h.level
+	}
+
+	switch {
+	case h1Count == 0:
+		report.add(LevelWarning, "heading_h1_missing", "页面没有 <h1>，读屏用户无法快速定位页面主标题", nil)
+	case h1Count > 1:
+		report.add(LevelWarning, "heading_h1_multiple", fmt.Sprintf("页面包含 %d 个 <h1>，建议只保留一个", h1Count), nil)
+	}
+	if len(page.headings) > 0 && page.headings[0].level != 1 {
+		report.add(LevelWarning, "heading_first_not_h1", fmt.Sprintf("第一个标题是 <h%d>，建议以 <h1> 开始", page.headings[0].level), nil)
+	}
+	if len(skipped) > 0 {
+		report.add(LevelWarning, "heading_level_skipped", fmt.Sprintf("标题层级跳跃了 %d 次", len(skipped)), skipped)
+	}
+	if len(empty) > 0 {
+		report.add(LevelError, "heading_empty", fmt.Sprintf("%d 个标题没有文字内容", len(empty)), empty)
+	}
+}
+
+func checkControls(report *Report, page *pageInfo) {
+	report.ControlCount = len(page.controls)
+	var missing []string
+	for _, ctl := range page.controls {
+		if ctl.labelled || (ctl.id != "" && page.labelFor[ctl.id]) {
+			continue
+		}
+		missing = append(missing, ctl.element)
+	}
+	if len(missing) > 0 {
+		report.add(LevelError, "form_label_missing",
+			fmt.Sprintf("%d 个表单控件没有关联的 <label>、aria-label 或 aria-labelledby", len(missing)), missing)
+	}
+}
+
+// checkContrast 根据主题色和当前主题配置中的颜色字段计算对比度。
+// 只能得到配置值本身，无法知道主题实际把颜色用在哪里：名称含 bg、background 的字段视为背景色，
+// 与正文颜色比较；其他颜色字段视为文字或链接颜色，与页面背景比较
+func (s *Service) checkContrast(ctx context.Context, report *Report) {
+	// 主题色用作链接颜色，也用作白色文字按钮的背景色，两种情况的对比度相同
+	if color := strings.TrimSpace(s.settingSvc.Get(constant.KeyThemeColor.String())); color != "" {
+		report.compare(constant.KeyThemeColor.String(), theme.ThemeModeLight, color, lightBackground, minTextContrast)
+	}
+
+	if s.themes == nil {
+		return
+	}
+	config, err := s.themes.GetCurrentThemeConfig(ctx, themeConfigUserID)
+	if err != nil || config == nil {
+		return
+	}
+	var fields []theme.ThemeSettingField
+	for _, group := range config.Settings {
+		for _, field := range group.Fields {
+			if field.Type == "color" {
+				fields = append(fields, field)
+			}
+		}
+	}
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+
+	light := theme.ResolveThemeConfigMode(config.Settings, config.Values, theme.ThemeModeLight)
+	dark := theme.ResolveThemeConfigMode(config.Settings, config.Values, theme.ThemeModeDark)
+	for _, field := range fields {
+		source := "theme." + field.Name
+		background := isBackgroundField(field.Name)
+		if color, ok := light[field.Name].(string); ok && color != "" {
+			if background {
+				report.compare(source, theme.ThemeModeLight, lightText, color, minTextContrast)
+			} else {
+				report.compare(source, theme.ThemeModeLight, color, lightBackground, minTextContrast)
+			}
+		}
+		// 不区分模式的颜色在深色模式下通常会被主题覆盖，只检查分模式配置的深色值
+		if !field.Modes {
+			continue
+		}
+		if color, ok := dark[field.Name].(string); ok && color != "" {
+			if background {
+				report.compare(source, theme.ThemeModeDark, darkText, color, minTextContrast)
+			} else {
+				report.compare(source, theme.ThemeModeDark, color, darkBackground, minTextContrast)
+			}
+		}
+	}
+}
+
+// compare 计算一组颜色的对比度，无法解析的颜色跳过
+func (r *Report) compare(source, mode, foreground, background string, minimum float64) {
+	fg, ok := parseColor(foreground)
+	if !ok {
+		return
+	}
+	bg, ok := parseColor(background)
+	if !ok {
+		return
+	}
+	ratio := math.Round(contrastRatio(fg, bg)*100) / 100
+	result := ContrastResult{
+		Source:     source,
+		Mode:       mode,
+		Foreground: foreground,
+		Background: background,
+		Ratio:      ratio,
+		Passed:     ratio >= minimum,
+	}
+	r.Contrasts = append(r.Contrasts, result)
+	if result.Passed {
+		return
+	}
+	modeName := "浅色"
+	if mode == theme.ThemeModeDark {
+		modeName = "深色"
+	}
+	message := fmt.Sprintf("%s 在%s模式下 %s 与 %s 的对比度为 %.2f:1，低于正文文字要求的 %.1f:1", source, modeName, foreground, background, ratio, minimum)
+	if ratio < minLargeContrast {
+		message += fmt.Sprintf("，也低于大号文字和图标要求的 %.0f:1", minLargeContrast)
+	}
+	r.add(LevelWarning, "contrast_low", message, nil)
+}
+
+func (r *Report) add(level, code, message string, elements []string) {
+	if len(elements) > maxListedElements {
+		elements = elements[:maxListedElements]
+	}
+	r.Issues = append(r.Issues, Issue{Level: level, Code: code, Message: message, Elements: elements})
+	if level == LevelError {
+		r.ErrorCount++
+	} else {
+		r.WarningCount++
+	}
+}
+
+// isAuditableRoute 只允许检查前台页面，避免通过该接口请求后台和 API
+func isAuditableRoute(route string) bool {
+	if !strings.HasPrefix(route, "/") || strings.HasPrefix(route, "//") {
+		return false
+	}
+	for _, prefix := range []string{"/api/", "/admin", "/needcache/", "/f/"} {
+		if strings.HasPrefix(route, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+func isBackgroundField(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.Contains(lower, "background") || strings.Contains(lower, "bg")
+}
+
+type headingInfo struct {
+	level   int
+	empty   bool
+	element string
+}
+
+type controlInfo struct {
+	id       string
+	labelled bool
+	element  string
+}
+
+// pageInfo 从 HTML 中提取的无障碍相关信息
+type pageInfo struct {
+	lang       string
+	imageCount int
+	missingAlt []string
+	headings   []headingInfo
+	controls   []controlInfo
+	labelFor   map[string]bool // <label for> 指向的控件 id
+}
+
+func extractPage(doc *html.Node) *pageInfo {
+	page := &pageInfo{labelFor: make(map[string]bool)}
+	var walk func(n *html.Node, inLabel bool)
+	walk = func(n *html.Node, inLabel bool) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "html":
+				page.lang = attr(n, "lang")
+			case "img", "area":
+				if !hidden(n) {
+					page.imageCount++
+					if _, ok := lookupAttr(n, "alt"); !ok && !hasAccessibleName(n) {
+						page.missingAlt = append(page.missingAlt, describe(n))
+					}
+				}
+			case "h1", "h2", "h3", "h4", "h5", "h6":
+				if !hidden(n) {
+					page.headings = append(page.headings, headingInfo{
+						level:   int(n.Data[1] - '0'),
+						empty:   strings.TrimSpace(accessibleText(n)) == "" && !hasAccessibleName(n),
+						element: describe(n),
+					})
+				}
+			case "label":
+				if id := attr(n, "for"); id != "" {
+					page.labelFor[id] = true
+				}
+				inLabel = true
+			case "input", "select", "textarea":
+				if n.Data == "input" {
+					switch strings.ToLower(attr(n, "type")) {
+					case "hidden", "submit", "reset", "button":
+						// 隐藏字段没有界面，按钮的名称来自 value
+					case "image":
+						if !hidden(n) {
+							page.imageCount++
+							if _, ok := lookupAttr(n, "alt"); !ok && !hasAccessibleName(n) {
+								page.missingAlt = append(page.missingAlt, describe(n))
+							}
+						}
+					default:
+						page.addControl(n, inLabel)
+					}
+				} else {
+					page.addControl(n, inLabel)
+				}
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child, inLabel)
+		}
+	}
+	walk(doc, false)
+	return page
+}
+
+func (p *pageInfo) addControl(n *html.Node, inLabel bool) {
+	if hidden(n) {
+		return
+	}
+	p.controls = append(p.controls, controlInfo{
+		id:       attr(n, "id"),
+		labelled: inLabel || hasAccessibleName(n) || strings.TrimSpace(attr(n, "title")) != "",
+		element:  describe(n),
+	})
+}
+
+// hidden 元素对辅助技术不可见，或被声明为装饰性内容
+func hidden(n *html.Node) bool {
+	if attr(n, "aria-hidden") == "true" {
+		return true
+	}
+	if _, ok := lookupAttr(n, "hidden"); ok {
+		return true
+	}
+	switch attr(n, "role") {
+	case "presentation", "none":
+		return true
+	}
+	return false
+}
+
+func hasAccessibleName(n *html.Node) bool {
+	return strings.TrimSpace(attr(n, "aria-label")) != "" || strings.TrimSpace(attr(n, "aria-labelledby")) != ""
+}
+
+// accessibleText 元素内的全部文字，包括子元素中图片的 alt
+func accessibleText(n *html.Node) string {
+	var sb strings.Builder
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		switch {
+		case node.Type == html.TextNode:
+			sb.WriteString(node.Data)
+		case node.Type == html.ElementNode && node.Data == "img":
+			sb.WriteString(attr(node, "alt"))
+		}
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(n)
+	return sb.String()
+}
+
+// describe 返回元素的开始标签，用于在报告中定位问题
+func describe(n *html.Node) string {
+	var sb strings.Builder
+	sb.WriteString("<" + n.Data)
+	for _, a := range n.Attr {
+		if a.Key == "style" {
+			continue
+		}
+		sb.WriteString(" " + a.Key + `="` + a.Val + `"`)
+	}
+	sb.WriteString(">")
+	s := sb.String()
+	if utf8.RuneCountInString(s) > maxElementLength {
+		s = string([]rune(s)[:maxElementLength]) + "…"
+	}
+	return s
+}
+
+func attr(n *html.Node, key string) string {
+	val, _ := lookupAttr(n, key)
+	return val
+}
+
+func lookupAttr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,239 @@
+/*
+ * @Description: 结构化访问日志与慢请求追踪
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 每个请求在上下文中携带一个 Trace，数据库驱动和外部 HTTP 客户端会向其中累加查询次数和调用记录；
+ * 请求结束后由访问日志中间件生成 Entry 写入内存环形缓冲区，超过慢请求阈值的请求会附带追踪详情。
+ */
+package accesslog
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 请求的上游类型
+const (
+	UpstreamAPI    = "api"
+	UpstreamSSR    = "ssr"
+	UpstreamStatic = "static"
+)
+
+// UpstreamContextKey gin 上下文中记录上游类型的键，由 SSR 代理等中间件设置
+const UpstreamContextKey = "access_log_upstream"
+
+// maxTracedExternalCalls 单个请求最多记录的外部调用条数
+const maxTracedExternalCalls = 20
+
+// ExternalCall 一次外部 API 调用
+type ExternalCall struct {
+	Method     string `json:"method"`
+	Host       string `json:"host"`
+	Path       string `json:"path"`
+	StatusCode int    `json:"status_code,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Entry 一条访问日志
+type Entry struct {
+	ID            uint64         `json:"id"`
+	Time          time.Time      `json:"time"`
+	Method        string         `json:"method"`
+	Path          string         `json:"path"`
+	Status        int            `json:"status"`
+	LatencyMs     int64          `json:"latency_ms"`
+	Upstream      string         `json:"upstream"`
+	UserID        uint           `json:"user_id,omitempty"`
+	ClientIP      string         `json:"client_ip"`
+	Slow          bool           `json:"slow"`
+	DBQueries     int64          `json:"db_queries,omitempty"`
+	ExternalCalls []ExternalCall `json:"external_calls,omitempty"`
+}
+
+// Trace 单个请求的追踪信息
+type Trace struct {
+	dbQueries atomic.Int64
+	mu        sync.Mutex
+	calls     []ExternalCall
+}
+
+type traceContextKey struct{}
+
+// WithTrace 返回携带新 Trace 的上下文
+func WithTrace(ctx context.Context) (context.Context, *Trace) {
+	trace := &Trace{}
+	return context.WithValue(ctx, traceContextKey{}, trace), trace
+}
+
+// FromContext 获取上下文中的 Trace，不存在时返回 nil
+func FromContext(ctx context.Context) *Trace {
+	if ctx == nil {
+		return nil
+	}
+	trace, _ := ctx.Value(traceContextKey{}).(*Trace)
+	return trace
+}
+
+// RecordDBQuery 为当前请求累加一次数据库查询
+func RecordDBQuery(ctx context.Context) {
+	if trace := FromContext(ctx); trace != nil {
+		trace.dbQueries.Add(1)
+	}
+}
+
+// RecordExternalCall 记录当前请求发起的一次外部调用
+func RecordExternalCall(ctx context.Context, call ExternalCall) {
+	trace := FromContext(ctx)
+	if trace == nil {
+		return
+	}
+	trace.mu.Lock()
+	defer trace.mu.Unlock()
+	if len(trace.calls) < maxTracedExternalCalls {
+		trace.calls = append(trace.calls, call)
+	}
+}
+
+// DBQueries 返回累计的数据库查询次数
+func (t *Trace) DBQueries() int64 {
+	return t.dbQueries.Load()
+}
+
+// ExternalCalls 返回外部调用记录的副本
+func (t *Trace) ExternalCalls() []ExternalCall {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]ExternalCall(nil), t.calls...)
+}
+
+// Filter 访问日志查询条件
+type Filter struct {
+	SlowOnly   bool
+	Upstream   string
+	PathPrefix string
+	MinStatus  int
+	Limit      int
+}
+
+// Store 内存中的访问日志环形缓冲区
+type Store struct {
+	mu       sync.RWMutex
+	entries  []Entry
+	next     int
+	full     bool
+	nextID   atomic.Uint64
+	capacity int
+}
+
+// NewStore 创建访问日志缓冲区
+func NewStore(capacity int) *Store {
+	if capacity <= 0 {
+		capacity = 1000
+	}
+	return &Store{entries: make([]Entry, capacity), capacity: capacity}
+}
+
+var defaultStore = NewStore(1000)
+
+// DefaultStore 返回全局访问日志缓冲区
+func DefaultStore() *Store {
+	return defaultStore
+}
+
+// Add 写入一条访问日志
+func (s *Store) Add(entry Entry) {
+	entry.ID = s.nextID.Add(1)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[s.next] = entry
+	s.next = (s.next + 1) % s.capacity
+	if s.next == 0 {
+		s.full = true
+	}
+}
+
+// List 按时间倒序返回符合条件的访问日志
+func (s *Store) List(filter Filter) []Entry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	limit := filter.Limit
+	if limit <= 0 || limit > s.capacity {
+		limit = 100
+	}
+
+	count := s.next
+	if s.full {
+		count = s.capacity
+	}
+
+	result := make([]Entry, 0, limit)
+	for i := 0; i < count && len(result) < limit; i++ {
+		idx := (s.next - 1 - i + s.capacity) % s.capacity
+		entry := s.entries[idx]
+		if filter.SlowOnly && !entry.Slow {
+			continue
+		}
+		if filter.Upstream != "" && entry.Upstream != filter.Upstream {
+			continue
+		}
+		if filter.PathPrefix != "" && !strings.HasPrefix(entry.Path, filter.PathPrefix) {
+			continue
+		}
+		if filter.MinStatus > 0 && entry.Status < filter.MinStatus {
+			continue
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+// Clear 清空访问日志
+func (s *Store) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = make([]Entry, s.capacity)
+	s.next = 0
+	s.full = false
+}
+
+// tracingTransport 记录外部 HTTP 调用的 RoundTripper，仅在请求上下文携带 Trace 时记录
+type tracingTransport struct {
+	base http.RoundTripper
+}
+
+// NewTracingTransport 包装 base，使通过它发起的外部调用出现在慢请求追踪中
+func NewTracingTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &tracingTransport{base: base}
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if FromContext(req.Context()) == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	call := ExternalCall{
+		Method:     req.Method,
+		Host:       req.URL.Host,
+		Path:       req.URL.Path,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		call.Error = err.Error()
+	} else {
+		call.StatusCode = resp.StatusCode
+	}
+	RecordExternalCall(req.Context(), call)
+	return resp, err
+}
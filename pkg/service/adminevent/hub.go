@@ -0,0 +1,245 @@
+/*
+ * @Description: 管理后台实时事件中心
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 主题切换进度、SSR 进程状态变化、后台任务运行、新的系统通知等事件统一发布到这里，
+ * 管理后台通过 SSE 或长轮询订阅，不必轮询多个状态接口。
+ * 最近的事件保存在内存中，客户端断线重连时可以凭 Last-Event-ID 补齐错过的事件。
+ */
+package adminevent
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// 事件类型，以点分隔的前缀表示所属模块，订阅时可按前缀过滤
+const (
+	TypeThemeSwitch        = "theme.switch"         // 主题切换进度
+	TypeThemeInstall       = "theme.install"        // 主题安装进度
+	TypeSSRState           = "ssr.state"            // SSR 主题进程状态变化
+	TypeSSRBuild           = "ssr.build"            // SSR 主题源码构建进度和日志
+	TypeTaskStarted        = "task.started"         // 后台任务开始运行
+	TypeTaskFinished       = "task.finished"        // 后台任务运行结束
+	TypeNotificationCreate = "notification.created" // 新的系统通知
+	TypeArticleBulk        = "article.bulk"         // 文章批量操作进度
+)
+
+// 主题切换阶段
+const (
+	StageStarted   = "started"
+	StageStopping  = "stopping" // 正在停止运行中的 SSR 主题
+	StageStarting  = "starting" // 正在启动 SSR 主题
+	StageCompleted = "completed"
+	StageFailed    = "failed"
+)
+
+// 主题安装阶段，开始、完成、失败沿用上面的 StageStarted、StageCompleted、StageFailed
+const (
+	StageDownloading = "downloading"
+	StageExtracting  = "extracting"
+	StageValidating  = "validating"
+	StageCommitting  = "committing" // 写入数据库，此后不能再取消
+	StageCanceled    = "canceled"
+)
+
+// SSR 主题构建阶段，开始、完成、失败沿用 StageStarted、StageCompleted、StageFailed
+const (
+	StageInstallingDeps = "installing_deps" // 安装依赖
+	StageBuilding       = "building"        // 执行 build 脚本
+)
+
+const (
+	// DefaultHistorySize 保留的最近事件数
+	DefaultHistorySize = 200
+	// subscriberBuffer 每个订阅者的缓冲区大小，写满说明客户端跟不上，断开后由客户端凭 Last-Event-ID 重连补齐
+	subscriberBuffer = 64
+)
+
+// Event 管理后台事件
+type Event struct {
+	ID   uint64      `json:"id"`
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+	Time time.Time   `json:"time"`
+}
+
+// ThemeSwitchData 主题切换进度
+type ThemeSwitchData struct {
+	Theme string `json:"theme"`
+	Stage string `json:"stage"`
+	Error string `json:"error,omitempty"`
+}
+
+// ThemeInstallData 主题安装进度，Percent 为当前阶段的完成百分比，总大小未知时为 -1
+type ThemeInstallData struct {
+	Theme   string `json:"theme"`
+	Stage   string `json:"stage"`
+	Percent int    `json:"percent"`
+	Done    int64  `json:"done,omitempty"`  // 当前阶段已处理的字节数
+	Total   int64  `json:"total,omitempty"` // 当前阶段的总字节数
+	Error   string `json:"error,omitempty"`
+}
+
+// SSRStateData SSR 主题进程状态
+type SSRStateData struct {
+	Theme  string `json:"theme"`
+	Status string `json:"status"`
+	Port   int    `json:"port,omitempty"`
+	Reason string `json:"reason,omitempty"` // 状态变化的原因，如进程意外退出
+}
+
+// SSRBuildData SSR 主题构建进度，Lines 为自上一条事件以来新输出的构建日志
+type SSRBuildData struct {
+	Theme string   `json:"theme"`
+	Stage string   `json:"stage"`
+	Lines []string `json:"lines,omitempty"`
+	Error string   `json:"error,omitempty"`
+}
+
+// TaskData 后台任务运行状态
+type TaskData struct {
+	Key        string `json:"key"`
+	Trigger    string `json:"trigger"`
+	Result     string `json:"result,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms,omitempty"`
+}
+
+// ArticleBulkData 文章批量操作进度，Status 为任务状态，处理完每篇文章推送一次
+type ArticleBulkData struct {
+	JobID     string `json:"job_id"`
+	Action    string `json:"action"`
+	Status    string `json:"status"`
+	Total     int    `json:"total"`
+	Processed int    `json:"processed"`
+	Succeeded int    `json:"succeeded"`
+	Failed    int    `json:"failed"`
+}
+
+// Hub 事件中心
+type Hub struct {
+	mu          sync.Mutex
+	nextID      uint64
+	history     []Event
+	historySize int
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	ch     chan Event
+	filter []string
+}
+
+// NewHub 创建事件中心
+func NewHub(historySize int) *Hub {
+	if historySize <= 0 {
+		historySize = DefaultHistorySize
+	}
+	return &Hub{historySize: historySize, subscribers: make(map[*subscriber]struct{})}
+}
+
+var defaultHub = NewHub(DefaultHistorySize)
+
+// Default 返回全局事件中心
+func Default() *Hub {
+	return defaultHub
+}
+
+// Publish 向全局事件中心发布事件
+func Publish(eventType string, data interface{}) {
+	defaultHub.Publish(eventType, data)
+}
+
+// Publish 发布事件，不会阻塞调用方
+func (h *Hub) Publish(eventType string, data interface{}) Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	ev := Event{ID: h.nextID, Type: eventType, Data: data, Time: time.Now()}
+	h.history = append(h.history, ev)
+	if len(h.history) > h.historySize {
+		h.history = h.history[len(h.history)-h.historySize:]
+	}
+
+	for sub := range h.subscribers {
+		if !sub.match(eventType) {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+			// 订阅者跟不上，关闭通道让客户端重连
+			delete(h.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+	return ev
+}
+
+// Subscribe 订阅事件。lastID 大于 0 时先返回其后仍保留在内存中的事件；
+// types 为空时订阅全部事件，否则只订阅类型等于或以其为前缀的事件，如 "ssr"、"task.finished"。
+// 返回的通道在取消订阅或订阅者过慢时关闭
+func (h *Hub) Subscribe(lastID uint64, types []string) ([]Event, <-chan Event, func()) {
+	sub := &subscriber{ch: make(chan Event, subscriberBuffer), filter: types}
+
+	h.mu.Lock()
+	backlog := h.since(lastID, sub)
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			if _, ok := h.subscribers[sub]; ok {
+				delete(h.subscribers, sub)
+				close(sub.ch)
+			}
+		})
+	}
+	return backlog, sub.ch, cancel
+}
+
+// Since 返回 ID 大于 lastID 的事件，供长轮询使用
+func (h *Hub) Since(lastID uint64, types []string) []Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.since(lastID, &subscriber{filter: types})
+}
+
+// LastID 最近一条事件的 ID
+func (h *Hub) LastID() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.nextID
+}
+
+func (h *Hub) since(lastID uint64, sub *subscriber) []Event {
+	if lastID == 0 {
+		return nil
+	}
+	var events []Event
+	for _, ev := range h.history {
+		if ev.ID > lastID && sub.match(ev.Type) {
+			events = append(events, ev)
+		}
+	}
+	return events
+}
+
+func (s *subscriber) match(eventType string) bool {
+	if len(s.filter) == 0 {
+		return true
+	}
+	for _, f := range s.filter {
+		if eventType == f || strings.HasPrefix(eventType, f+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,515 @@
+/*
+ * @Description: 文章批量操作：批量设置分类和标签、批量发布和转为草稿、批量移入回收站、批量重新生成派生数据
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 批量操作作为后台任务执行，提交后立即返回任务信息，管理后台通过任务接口或管理后台事件流（article.bulk）查看进度。
+ * 每篇文章单独处理并记录逐项结果，单篇失败不影响其它文章。同一时间只执行一个批量任务，后提交的任务排队等待，
+ * 排队的任务超过 maxPendingBulkJobs 个时拒绝新的提交。
+ * 任务记录只保存在内存中，保留最近 maxBulkJobHistory 个，服务重启后丢失。
+ */
+package article
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/toc"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/adminevent"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/summary"
+)
+
+// 批量操作类型
+const (
+	BulkActionCategories = "categories" // 批量设置分类
+	BulkActionTags       = "tags"       // 批量设置标签
+	BulkActionPublish    = "publish"    // 批量发布
+	BulkActionUnpublish  = "unpublish"  // 批量转为草稿
+	BulkActionDelete     = "delete"     // 批量移入回收站
+	BulkActionRegenerate = "regenerate" // 批量重新生成派生数据
+)
+
+// 设置分类和标签的方式
+const (
+	BulkModeAdd     = "add"     // 追加到已有的分类或标签
+	BulkModeRemove  = "remove"  // 从已有的分类或标签中移除
+	BulkModeReplace = "replace" // 替换为指定的分类或标签
+)
+
+// 可重新生成的派生数据
+const (
+	BulkTargetSummary     = "summary"      // 摘要，需要开启摘要生成
+	BulkTargetReadingTime = "reading_time" // 字数和阅读时长
+	BulkTargetToc         = "toc"          // 目录和标题锚点
+)
+
+// 批量任务状态
+const (
+	BulkJobPending   = "pending"
+	BulkJobRunning   = "running"
+	BulkJobCompleted = "completed"
+)
+
+const (
+	// MaxBulkArticleCount 单次批量操作的文章数量上限
+	MaxBulkArticleCount = 500
+	// maxBulkJobHistory 内存中保留的批量任务数量
+	maxBulkJobHistory = 20
+	// maxPendingBulkJobs 排队等待执行的批量任务上限，每个排队的任务占用一个等待中的 goroutine
+	maxPendingBulkJobs = 5
+	// bulkItemTimeout 处理单篇文章的超时时间，生成摘要可能需要调用外部服务
+	bulkItemTimeout = 2 * time.Minute
+)
+
+var (
+	// ErrInvalidBulkRequest 批量操作参数错误
+	ErrInvalidBulkRequest = errors.New("批量操作参数错误")
+	// ErrBulkJobNotFound 批量任务不存在或已过期
+	ErrBulkJobNotFound = errors.New("批量任务不存在或已过期")
+	// ErrBulkQueueFull 排队的批量任务过多
+	ErrBulkQueueFull = errors.New("排队中的批量任务过多，请等待当前任务完成后再提交")
+)
+
+// BulkRequest 批量操作请求
+type BulkRequest struct {
+	Action      string   `json:"action" binding:"required,oneof=categories tags publish unpublish delete regenerate"`
+	IDs         []string `json:"ids" binding:"required,min=1"`
+	Mode        string   `json:"mode" binding:"omitempty,oneof=add remove replace"` // 设置分类和标签的方式，默认 add
+	CategoryIDs []string `json:"category_ids"`
+	TagIDs      []string `json:"tag_ids"`
+	Targets     []string `json:"targets"`             // 需要重新生成的派生数据，留空重新生成字数、阅读时长和目录
+	Overwrite   bool     `json:"overwrite,omitempty"` // 重新生成摘要时是否覆盖已有摘要
+}
+
+// BulkItemResult 单篇文章的处理结果，Skipped 表示文章已满足要求无需修改
+type BulkItemResult struct {
+	ID      string `json:"id"`
+	Title   string `json:"title,omitempty"`
+	Success bool   `json:"success"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// BulkJob 批量任务
+type BulkJob struct {
+	ID         string            `json:"id"`
+	Action     string            `json:"action"`
+	Status     string            `json:"status"`
+	Total      int               `json:"total"`
+	Processed  int               `json:"processed"`
+	Succeeded  int               `json:"succeeded"`
+	Failed     int               `json:"failed"`
+	Items      []*BulkItemResult `json:"items"`
+	CreatedAt  time.Time         `json:"created_at"`
+	StartedAt  *time.Time        `json:"started_at,omitempty"`
+	FinishedAt *time.Time        `json:"finished_at,omitempty"`
+}
+
+// bulkJobs 批量任务登记，runMu 保证同一时间只执行一个任务
+type bulkJobs struct {
+	mu    sync.Mutex
+	jobs  []*BulkJob
+	runMu sync.Mutex
+	seed  uint64
+}
+
+// StartBulk 校验请求并登记批量任务，任务在后台执行，返回任务的初始状态
+func (s *serviceImpl) StartBulk(ctx context.Context, req *BulkRequest) (*BulkJob, error) {
+	ids, err := s.normalizeBulkRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &s.bulk
+	b.mu.Lock()
+	pending := 0
+	for _, j := range b.jobs {
+		if j.Status == BulkJobPending {
+			pending++
+		}
+	}
+	if pending >= maxPendingBulkJobs {
+		b.mu.Unlock()
+		return nil, ErrBulkQueueFull
+	}
+	b.seed++
+	job := &BulkJob{
+		ID:        strconv.FormatInt(time.Now().UnixMilli(), 36) + strconv.FormatUint(b.seed, 36),
+		Action:    req.Action,
+		Status:    BulkJobPending,
+		Total:     len(ids),
+		Items:     make([]*BulkItemResult, 0, len(ids)),
+		CreatedAt: time.Now(),
+	}
+	b.jobs = append(b.jobs, job)
+	if len(b.jobs) > maxBulkJobHistory {
+		// 只淘汰已结束的任务
+		kept := b.jobs[:0]
+		excess := len(b.jobs) - maxBulkJobHistory
+		for _, j := range b.jobs {
+			if excess > 0 && j.Status == BulkJobCompleted {
+				excess--
+				continue
+			}
+			kept = append(kept, j)
+		}
+		b.jobs = kept
+	}
+	snapshot := job.snapshot()
+	b.mu.Unlock()
+
+	log.Printf("[文章批量操作] 已提交任务 %s：%s，共 %d 篇文章", job.ID, job.Action, job.Total)
+	go s.runBulkJob(job, req, ids)
+	return snapshot, nil
+}
+
+// GetBulkJob 获取批量任务的状态和逐项结果
+func (s *serviceImpl) GetBulkJob(id string) (*BulkJob, error) {
+	s.bulk.mu.Lock()
+	defer s.bulk.mu.Unlock()
+	for _, job := range s.bulk.jobs {
+		if job.ID == id {
+			return job.snapshot(), nil
+		}
+	}
+	return nil, ErrBulkJobNotFound
+}
+
+// ListBulkJobs 获取最近的批量任务，从新到旧排列，不包含逐项结果
+func (s *serviceImpl) ListBulkJobs() []*BulkJob {
+	s.bulk.mu.Lock()
+	defer s.bulk.mu.Unlock()
+	result := make([]*BulkJob, 0, len(s.bulk.jobs))
+	for i := len(s.bulk.jobs) - 1; i >= 0; i-- {
+		job := *s.bulk.jobs[i]
+		job.Items = nil
+		result = append(result, &job)
+	}
+	return result
+}
+
+// normalizeBulkRequest 去除重复的文章ID，校验分类、标签和重新生成的目标
+func (s *serviceImpl) normalizeBulkRequest(ctx context.Context, req *BulkRequest) ([]string, error) {
+	seen := make(map[string]bool, len(req.IDs))
+	ids := make([]string, 0, len(req.IDs))
+	for _, id := range req.IDs {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: 文章ID列表不能为空", ErrInvalidBulkRequest)
+	}
+	if len(ids) > MaxBulkArticleCount {
+		return nil, fmt.Errorf("%w: 单次最多操作 %d 篇文章", ErrInvalidBulkRequest, MaxBulkArticleCount)
+	}
+
+	switch req.Action {
+	case BulkActionCategories, BulkActionTags:
+		if req.Mode == "" {
+			req.Mode = BulkModeAdd
+		}
+		targetIDs := req.CategoryIDs
+		if req.Action == BulkActionTags {
+			targetIDs = req.TagIDs
+		}
+		if len(targetIDs) == 0 && req.Mode != BulkModeReplace {
+			return nil, fmt.Errorf("%w: 请选择要设置的分类或标签", ErrInvalidBulkRequest)
+		}
+		for _, id := range targetIDs {
+			var err error
+			if req.Action == BulkActionCategories {
+				_, err = s.postCategoryRepo.GetByID(ctx, id)
+			} else {
+				_, err = s.postTagRepo.GetByID(ctx, id)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%w: 分类或标签 %s 不存在", ErrInvalidBulkRequest, id)
+			}
+		}
+	case BulkActionRegenerate:
+		if len(req.Targets) == 0 {
+			req.Targets = []string{BulkTargetReadingTime, BulkTargetToc}
+		}
+		for _, target := range req.Targets {
+			switch target {
+			case BulkTargetReadingTime, BulkTargetToc:
+			case BulkTargetSummary:
+				if !s.summarySvc.Enabled() {
+					return nil, fmt.Errorf("%w: %v", ErrInvalidBulkRequest, summary.ErrDisabled)
+				}
+			default:
+				return nil, fmt.Errorf("%w: 不支持重新生成 %s", ErrInvalidBulkRequest, target)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// runBulkJob 逐篇处理文章，每处理完一篇更新任务状态并推送进度
+func (s *serviceImpl) runBulkJob(job *BulkJob, req *BulkRequest, ids []string) {
+	s.bulk.runMu.Lock()
+	defer s.bulk.runMu.Unlock()
+
+	s.bulk.mu.Lock()
+	now := time.Now()
+	job.Status = BulkJobRunning
+	job.StartedAt = &now
+	s.bulk.mu.Unlock()
+	s.publishBulkProgress(job)
+
+	for _, id := range ids {
+		item := s.runBulkItem(req, id)
+		s.bulk.mu.Lock()
+		job.Items = append(job.Items, item)
+		job.Processed++
+		if item.Success {
+			job.Succeeded++
+		} else {
+			job.Failed++
+		}
+		s.bulk.mu.Unlock()
+		s.publishBulkProgress(job)
+	}
+
+	s.bulk.mu.Lock()
+	now = time.Now()
+	job.Status = BulkJobCompleted
+	job.FinishedAt = &now
+	s.bulk.mu.Unlock()
+	s.publishBulkProgress(job)
+
+	if req.Action == BulkActionRegenerate {
+		s.updateSiteStatsInBackground()
+	}
+	log.Printf("[文章批量操作] 任务 %s 执行完成：成功 %d 篇，失败 %d 篇", job.ID, job.Succeeded, job.Failed)
+}
+
+// runBulkItem 处理单篇文章，出错时记录到结果中
+func (s *serviceImpl) runBulkItem(req *BulkRequest, id string) (item *BulkItemResult) {
+	item = &BulkItemResult{ID: id}
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[文章批量操作] 处理文章 %s 时发生异常: %v", id, r)
+			item.Success = false
+			item.Error = fmt.Sprintf("处理时发生异常: %v", r)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), bulkItemTimeout)
+	defer cancel()
+
+	article, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		item.Error = "文章不存在或已删除"
+		return item
+	}
+	item.Title = article.Title
+
+	changed, err := s.applyBulkAction(ctx, req, article)
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+	item.Success = true
+	item.Skipped = !changed
+	return item
+}
+
+// applyBulkAction 对单篇文章执行批量操作，文章已满足要求时返回 false
+func (s *serviceImpl) applyBulkAction(ctx context.Context, req *BulkRequest, article *model.Article) (bool, error) {
+	switch req.Action {
+	case BulkActionCategories:
+		current := make([]string, len(article.PostCategories))
+		for i, c := range article.PostCategories {
+			current[i] = c.ID
+		}
+		next, changed := mergeBulkIDs(current, req.CategoryIDs, req.Mode)
+		if !changed {
+			return false, nil
+		}
+		_, err := s.Update(ctx, article.ID, &model.UpdateArticleRequest{PostCategoryIDs: next}, "", "")
+		return true, err
+
+	case BulkActionTags:
+		current := make([]string, len(article.PostTags))
+		for i, t := range article.PostTags {
+			current[i] = t.ID
+		}
+		next, changed := mergeBulkIDs(current, req.TagIDs, req.Mode)
+		if !changed {
+			return false, nil
+		}
+		_, err := s.Update(ctx, article.ID, &model.UpdateArticleRequest{PostTagIDs: next}, "", "")
+		return true, err
+
+	case BulkActionPublish, BulkActionUnpublish:
+		status := "PUBLISHED"
+		if req.Action == BulkActionUnpublish {
+			status = "DRAFT"
+		}
+		if article.Status == status {
+			return false, nil
+		}
+		_, err := s.Update(ctx, article.ID, &model.UpdateArticleRequest{Status: &status}, "", "")
+		return true, err
+
+	case BulkActionDelete:
+		return true, s.Delete(ctx, article.ID)
+
+	case BulkActionRegenerate:
+		return s.regenerateDerivedData(ctx, article, req.Targets, req.Overwrite)
+	}
+	return false, fmt.Errorf("%w: 不支持的操作 %s", ErrInvalidBulkRequest, req.Action)
+}
+
+// regenerateDerivedData 根据当前正文重新生成字数、阅读时长、目录和摘要，不修改文章的更新时间
+func (s *serviceImpl) regenerateDerivedData(ctx context.Context, article *model.Article, targets []string, overwrite bool) (bool, error) {
+	var data model.ArticleDerivedData
+	changed := false
+	regenerateSummary := false
+	for _, target := range targets {
+		switch target {
+		case BulkTargetReadingTime:
+			wordCount, readingTime := calculatePostStats(article.ContentMd)
+			if wordCount != article.WordCount || readingTime != article.ReadingTime {
+				data.WordCount = &wordCount
+				data.ReadingTime = &readingTime
+			}
+		case BulkTargetToc:
+			html, tocItems := toc.Extract(article.ContentHTML, s.tocMaxDepth())
+			if html != article.ContentHTML {
+				data.ContentHTML = &html
+			}
+			var oldToc []*model.TocItem
+			if article.ExtraConfig != nil {
+				oldToc = article.ExtraConfig.Toc
+			}
+			if !sameToc(oldToc, tocItems) {
+				data.ExtraConfig = withToc(article.ExtraConfig, tocItems)
+			}
+		case BulkTargetSummary:
+			regenerateSummary = overwrite || len(article.Summaries) == 0
+		}
+	}
+
+	if data.WordCount != nil || data.ContentHTML != nil || data.ExtraConfig != nil {
+		if err := s.repo.UpdateDerivedData(ctx, article.ID, &data); err != nil {
+			return false, fmt.Errorf("保存派生数据失败: %w", err)
+		}
+		changed = true
+		s.invalidateArticleCache(ctx, article.ID, article.Abbrlink)
+	}
+	if regenerateSummary {
+		// 生成摘要时会清除缓存并发布更新事件
+		if _, err := s.GenerateSummary(ctx, article.ID, true); err != nil {
+			return changed, fmt.Errorf("生成摘要失败: %w", err)
+		}
+		return true, nil
+	}
+	if changed {
+		go s.invalidateRelatedCaches(context.Background())
+		s.publishArticleEvent(event.ArticleUpdated, article.ID, article.Abbrlink)
+	}
+	return changed, nil
+}
+
+// mergeBulkIDs 按方式合并已有和指定的ID，保持原有顺序，返回合并结果和是否有变化
+func mergeBulkIDs(current, ids []string, mode string) ([]string, bool) {
+	// 比较前统一解码，避免同一实体不同编码的公共ID被视为不同
+	key := func(id string) string {
+		if dbID, _, err := idgen.DecodePublicID(id); err == nil {
+			return strconv.FormatUint(uint64(dbID), 10)
+		}
+		return id
+	}
+	has := make(map[string]bool, len(current))
+	for _, id := range current {
+		has[key(id)] = true
+	}
+
+	switch mode {
+	case BulkModeReplace:
+		want := make(map[string]bool, len(ids))
+		result := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if k := key(id); !want[k] {
+				want[k] = true
+				result = append(result, id)
+			}
+		}
+		changed := len(want) != len(has)
+		for k := range want {
+			if !has[k] {
+				changed = true
+			}
+		}
+		return result, changed
+	case BulkModeRemove:
+		remove := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			remove[key(id)] = true
+		}
+		result := make([]string, 0, len(current))
+		for _, id := range current {
+			if !remove[key(id)] {
+				result = append(result, id)
+			}
+		}
+		return result, len(result) != len(current)
+	default:
+		result := append([]string{}, current...)
+		for _, id := range ids {
+			if k := key(id); !has[k] {
+				has[k] = true
+				result = append(result, id)
+			}
+		}
+		return result, len(result) != len(current)
+	}
+}
+
+// sameToc 比较两个目录是否相同
+func sameToc(a, b []*model.TocItem) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	x, errX := json.Marshal(a)
+	y, errY := json.Marshal(b)
+	return errX == nil && errY == nil && string(x) == string(y)
+}
+
+func (s *serviceImpl) publishBulkProgress(job *BulkJob) {
+	s.bulk.mu.Lock()
+	data := adminevent.ArticleBulkData{
+		JobID:     job.ID,
+		Action:    job.Action,
+		Status:    job.Status,
+		Total:     job.Total,
+		Processed: job.Processed,
+		Succeeded: job.Succeeded,
+		Failed:    job.Failed,
+	}
+	s.bulk.mu.Unlock()
+	adminevent.Publish(adminevent.TypeArticleBulk, data)
+}
+
+// snapshot 复制任务状态，调用方需持有 bulkJobs.mu
+func (j *BulkJob) snapshot() *BulkJob {
+	c := *j
+	c.Items = append([]*BulkItemResult{}, j.Items...)
+	return &c
+}
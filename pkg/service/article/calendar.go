@@ -0,0 +1,102 @@
+/*
+ * @Description: 内容日历，按天汇总已发布和定时发布的文章
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 后台编辑日历按月份区间查询：已发布文章按发布时间（created_at）归入当天，
+ * 定时文章按计划发布时间（scheduled_at）归入当天，日期按服务器时区划分。
+ */
+package article
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+const (
+	// calendarMonthLayout 日历月份参数格式
+	calendarMonthLayout = "2006-01"
+	// calendarDayLayout 日历日期格式
+	calendarDayLayout = "2006-01-02"
+	// calendarMaxMonths 单次查询最多包含的月份数
+	calendarMaxMonths = 12
+)
+
+// ErrInvalidCalendarRange 日历月份参数不合法
+var ErrInvalidCalendarRange = errors.New("日历月份区间不合法")
+
+// GetCalendar 获取 [startMonth, endMonth] 月份区间（YYYY-MM，含两端）的内容日历，authorID 不为空时只统计该作者的文章
+func (s *serviceImpl) GetCalendar(ctx context.Context, startMonth, endMonth string, authorID *uint) (*model.ArticleCalendarResponse, error) {
+	start, err := time.ParseInLocation(calendarMonthLayout, startMonth, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("%w: 起始月份格式错误，应为 YYYY-MM: %s", ErrInvalidCalendarRange, startMonth)
+	}
+	last, err := time.ParseInLocation(calendarMonthLayout, endMonth, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("%w: 结束月份格式错误，应为 YYYY-MM: %s", ErrInvalidCalendarRange, endMonth)
+	}
+	if last.Before(start) {
+		return nil, fmt.Errorf("%w: 结束月份不能早于起始月份", ErrInvalidCalendarRange)
+	}
+	months := (last.Year()-start.Year())*12 + int(last.Month()-start.Month()) + 1
+	if months > calendarMaxMonths {
+		return nil, fmt.Errorf("%w: 单次最多查询 %d 个月", ErrInvalidCalendarRange, calendarMaxMonths)
+	}
+	end := last.AddDate(0, 1, 0)
+
+	articles, err := s.repo.ListForCalendar(ctx, start, end, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &model.ArticleCalendarResponse{
+		Start: startMonth,
+		End:   endMonth,
+		Days:  []*model.CalendarDay{},
+	}
+	days := make(map[string]*model.CalendarDay)
+	for _, a := range articles {
+		item := &model.CalendarArticle{
+			ID:       a.ID,
+			Title:    a.Title,
+			Status:   a.Status,
+			Time:     a.CreatedAt,
+			Abbrlink: a.Abbrlink,
+			CoverURL: a.CoverURL,
+			OwnerID:  a.OwnerID,
+		}
+		if a.Status == "SCHEDULED" && a.ScheduledAt != nil {
+			item.Time = *a.ScheduledAt
+		}
+		date := item.Time.In(time.Local).Format(calendarDayLayout)
+		day, ok := days[date]
+		if !ok {
+			day = &model.CalendarDay{Date: date, Items: []*model.CalendarArticle{}}
+			days[date] = day
+		}
+		day.Items = append(day.Items, item)
+		if item.Status == "SCHEDULED" {
+			day.ScheduledCount++
+			resp.ScheduledTotal++
+		} else {
+			day.PublishedCount++
+			resp.PublishedTotal++
+		}
+	}
+
+	// 按日期升序输出，同一天内按时间排序
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		day, ok := days[d.Format(calendarDayLayout)]
+		if !ok {
+			continue
+		}
+		sort.SliceStable(day.Items, func(i, j int) bool { return day.Items[i].Time.Before(day.Items[j].Time) })
+		resp.Days = append(resp.Days, day)
+	}
+	return resp, nil
+}
@@ -0,0 +1,202 @@
+/*
+ * @Description: 文章自定义字段
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 自定义字段类似 Front-matter，以键值对保存在文章扩展配置的 custom_fields 中，
+ * 随文章接口和文章页 SSR 数据（initialData.data.custom_fields）提供给主题，用于视频文章、链接文章等自定义布局。
+ * 站点可在 post.custom_fields.schema 中声明字段及其类型（string、number、bool、json），
+ * 声明后只接受已声明的字段并校验类型和必填项，未填写的字段在返回时使用声明的默认值；未声明时接受任意字段。
+ */
+package article
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// 自定义字段类型
+const (
+	CustomFieldString = "string"
+	CustomFieldNumber = "number"
+	CustomFieldBool   = "bool"
+	CustomFieldJSON   = "json"
+)
+
+const (
+	// maxCustomFields 单篇文章最多的自定义字段数量
+	maxCustomFields = 50
+	// maxCustomFieldsSize 单篇文章自定义字段序列化后的最大字节数
+	maxCustomFieldsSize = 32 << 10
+)
+
+// ErrInvalidCustomFields 自定义字段不符合要求
+var ErrInvalidCustomFields = errors.New("自定义字段无效")
+
+// customFieldKeyRegex 字段名限制为合法的标识符，便于主题直接使用
+var customFieldKeyRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,63}$`)
+
+// CustomFieldDef 站点声明的自定义字段
+type CustomFieldDef struct {
+	Key         string      `json:"key"`
+	Type        string      `json:"type"`
+	Label       string      `json:"label,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Required    bool        `json:"required,omitempty"`
+	Default     interface{} `json:"default,omitempty"`
+}
+
+// customFieldSchema 读取站点声明的字段，未配置或格式错误时返回 nil，表示不限制字段
+func (s *serviceImpl) customFieldSchema() []CustomFieldDef {
+	raw := strings.TrimSpace(s.settingSvc.Get(constant.KeyPostCustomFieldSchema.String()))
+	if raw == "" || raw == "[]" {
+		return nil
+	}
+	var defs []CustomFieldDef
+	if err := json.Unmarshal([]byte(raw), &defs); err != nil {
+		log.Printf("[自定义字段] 字段声明格式错误，已忽略: %v", err)
+		return nil
+	}
+	valid := defs[:0]
+	for _, def := range defs {
+		if !customFieldKeyRegex.MatchString(def.Key) || !isCustomFieldType(def.Type) {
+			log.Printf("[自定义字段] 忽略无效的字段声明: key=%q type=%q", def.Key, def.Type)
+			continue
+		}
+		valid = append(valid, def)
+	}
+	return valid
+}
+
+// validateCustomFields 校验并整理自定义字段：值为 null 的字段视为删除，声明了字段时校验类型和必填项
+func (s *serviceImpl) validateCustomFields(fields map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		if value == nil {
+			continue
+		}
+		if !customFieldKeyRegex.MatchString(key) {
+			return nil, fmt.Errorf("%w: 字段名 %q 只能包含字母、数字和下划线，且不能以数字开头", ErrInvalidCustomFields, key)
+		}
+		result[key] = value
+	}
+	if len(result) > maxCustomFields {
+		return nil, fmt.Errorf("%w: 最多 %d 个字段", ErrInvalidCustomFields, maxCustomFields)
+	}
+	if data, err := json.Marshal(result); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCustomFields, err)
+	} else if len(data) > maxCustomFieldsSize {
+		return nil, fmt.Errorf("%w: 内容不能超过 %d KB", ErrInvalidCustomFields, maxCustomFieldsSize>>10)
+	}
+
+	schema := s.customFieldSchema()
+	if schema == nil {
+		for key, value := range result {
+			if customFieldTypeOf(value) == "" {
+				return nil, fmt.Errorf("%w: 字段 %s 的值类型不受支持", ErrInvalidCustomFields, key)
+			}
+		}
+		return result, nil
+	}
+
+	declared := make(map[string]CustomFieldDef, len(schema))
+	for _, def := range schema {
+		declared[def.Key] = def
+		value, ok := result[def.Key]
+		if !ok {
+			if def.Required {
+				return nil, fmt.Errorf("%w: 缺少必填字段 %s", ErrInvalidCustomFields, customFieldName(def))
+			}
+			continue
+		}
+		if !matchCustomFieldType(def.Type, value) {
+			return nil, fmt.Errorf("%w: 字段 %s 应为 %s 类型", ErrInvalidCustomFields, customFieldName(def), def.Type)
+		}
+		if def.Required && def.Type == CustomFieldString && strings.TrimSpace(value.(string)) == "" {
+			return nil, fmt.Errorf("%w: 缺少必填字段 %s", ErrInvalidCustomFields, customFieldName(def))
+		}
+	}
+	var unknown []string
+	for key := range result {
+		if _, ok := declared[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("%w: 未声明的字段 %s", ErrInvalidCustomFields, strings.Join(unknown, ", "))
+	}
+	return result, nil
+}
+
+// resolveCustomFields 返回给主题的自定义字段，未填写的已声明字段使用默认值
+func (s *serviceImpl) resolveCustomFields(config *model.ArticleExtraConfig) map[string]interface{} {
+	var saved map[string]interface{}
+	if config != nil {
+		saved = config.CustomFields
+	}
+	schema := s.customFieldSchema()
+	if len(saved) == 0 && len(schema) == 0 {
+		return nil
+	}
+	result := make(map[string]interface{}, len(saved)+len(schema))
+	for key, value := range saved {
+		result[key] = value
+	}
+	for _, def := range schema {
+		if _, ok := result[def.Key]; !ok && def.Default != nil && matchCustomFieldType(def.Type, def.Default) {
+			result[def.Key] = def.Default
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+func isCustomFieldType(t string) bool {
+	switch t {
+	case CustomFieldString, CustomFieldNumber, CustomFieldBool, CustomFieldJSON:
+		return true
+	}
+	return false
+}
+
+// customFieldTypeOf 推断 JSON 解码后的值的类型
+func customFieldTypeOf(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return CustomFieldString
+	case float64, json.Number:
+		return CustomFieldNumber
+	case bool:
+		return CustomFieldBool
+	case map[string]interface{}, []interface{}:
+		return CustomFieldJSON
+	}
+	return ""
+}
+
+// matchCustomFieldType json 类型可以是任意 JSON 值
+func matchCustomFieldType(t string, value interface{}) bool {
+	actual := customFieldTypeOf(value)
+	if t == CustomFieldJSON {
+		return actual != ""
+	}
+	return actual == t
+}
+
+func customFieldName(def CustomFieldDef) string {
+	if def.Label != "" {
+		return fmt.Sprintf("%s（%s）", def.Label, def.Key)
+	}
+	return def.Key
+}
@@ -0,0 +1,194 @@
+/*
+ * @Description: 文章置顶与首页布局
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 首页布局以 JSON 保存在 home.layout 配置项中，包括精选轮播文章和各区块的顺序，
+ * 通过公开接口和首页 SSR 数据统一提供给主题，主题无需各自实现置顶和精选逻辑。
+ */
+package article
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+const (
+	// homeSectionDefaultLimit 区块未设置数量时的默认文章数
+	homeSectionDefaultLimit = 10
+	// homeSectionMaxLimit 单个区块最多返回的文章数
+	homeSectionMaxLimit = 50
+	// homeFeaturedMaxCount 精选轮播最多包含的文章数
+	homeFeaturedMaxCount = 20
+)
+
+// DefaultHomeLayout 默认首页布局：精选轮播、置顶文章、最新文章
+func DefaultHomeLayout() *model.HomeLayout {
+	return &model.HomeLayout{
+		FeaturedIDs: []string{},
+		Sections: []model.HomeSection{
+			{Type: model.HomeSectionFeatured, Enabled: true, Limit: 6},
+			{Type: model.HomeSectionPinned, Enabled: true, Limit: 5},
+			{Type: model.HomeSectionRecent, Enabled: true, Limit: homeSectionDefaultLimit},
+		},
+	}
+}
+
+// SetPinSort 设置文章置顶权重，0 表示取消置顶
+func (s *serviceImpl) SetPinSort(ctx context.Context, publicID string, pinSort int) (*model.ArticleResponse, error) {
+	if pinSort < 0 {
+		return nil, fmt.Errorf("置顶权重不能为负数")
+	}
+	if err := s.repo.UpdatePinSort(ctx, publicID, pinSort); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.GetByID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateArticleCache(ctx, publicID, updated.Abbrlink)
+	go s.invalidateRelatedCaches(context.Background())
+	return s.ToAPIResponse(updated, false, false), nil
+}
+
+// GetHomeLayout 获取首页布局配置，未配置或配置无效时返回默认布局
+func (s *serviceImpl) GetHomeLayout(ctx context.Context) *model.HomeLayout {
+	raw := s.settingSvc.Get(constant.KeyHomeLayout.String())
+	if raw == "" {
+		return DefaultHomeLayout()
+	}
+	var layout model.HomeLayout
+	if err := json.Unmarshal([]byte(raw), &layout); err != nil {
+		log.Printf("[首页布局] 解析配置失败，使用默认布局: %v", err)
+		return DefaultHomeLayout()
+	}
+	if err := normalizeHomeLayout(&layout); err != nil {
+		log.Printf("[首页布局] 配置无效，使用默认布局: %v", err)
+		return DefaultHomeLayout()
+	}
+	return &layout
+}
+
+// UpdateHomeLayout 校验并保存首页布局配置
+func (s *serviceImpl) UpdateHomeLayout(ctx context.Context, layout *model.HomeLayout) (*model.HomeLayout, error) {
+	if err := normalizeHomeLayout(layout); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(layout)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.settingSvc.UpdateSettings(ctx, map[string]string{constant.KeyHomeLayout.String(): string(data)}); err != nil {
+		return nil, fmt.Errorf("保存首页布局失败: %w", err)
+	}
+	go s.invalidateRelatedCaches(context.Background())
+	return layout, nil
+}
+
+// GetHomePageData 按首页布局获取各启用区块的文章，单个区块失败时返回空列表，不影响其他区块
+func (s *serviceImpl) GetHomePageData(ctx context.Context) (*model.HomePageData, error) {
+	layout := s.GetHomeLayout(ctx)
+	data := &model.HomePageData{Sections: []model.HomeSectionData{}}
+
+	for _, section := range layout.Sections {
+		if !section.Enabled {
+			continue
+		}
+		articles, err := s.homeSectionArticles(ctx, layout, section)
+		if err != nil {
+			log.Printf("[首页布局] 获取区块 %s 的文章失败: %v", section.Type, err)
+			articles = []model.ArticleResponse{}
+		}
+		data.Sections = append(data.Sections, model.HomeSectionData{HomeSection: section, Articles: articles})
+	}
+	return data, nil
+}
+
+func (s *serviceImpl) homeSectionArticles(ctx context.Context, layout *model.HomeLayout, section model.HomeSection) ([]model.ArticleResponse, error) {
+	options := &model.ListPublicArticlesOptions{Page: 1, PageSize: section.Limit}
+	switch section.Type {
+	case model.HomeSectionFeatured:
+		if len(layout.FeaturedIDs) == 0 {
+			return s.listHome(ctx, section.Limit)
+		}
+		return s.featuredArticles(ctx, layout.FeaturedIDs, section.Limit), nil
+	case model.HomeSectionPinned:
+		options.PinnedOnly = true
+	case model.HomeSectionCategory:
+		options.CategoryName = section.Value
+	case model.HomeSectionTag:
+		options.TagName = section.Value
+	}
+
+	result, err := s.ListPublic(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return result.List, nil
+}
+
+// featuredArticles 按配置顺序获取精选文章，已删除或未发布的文章会被跳过
+func (s *serviceImpl) featuredArticles(ctx context.Context, ids []string, limit int) []model.ArticleResponse {
+	list := make([]model.ArticleResponse, 0, len(ids))
+	ownerCache := make(map[uint]string)
+	for _, id := range ids {
+		if len(list) >= limit {
+			break
+		}
+		a, err := s.repo.GetBySlugOrID(ctx, id)
+		if err != nil || a == nil {
+			continue
+		}
+		a.ContentMd = ""
+		a.ViewCount += s.pendingViewCount(ctx, a.ID)
+		resp := s.ToAPIResponse(a, true, false)
+		s.fillOwnerNickname(ctx, resp, ownerCache)
+		list = append(list, *resp)
+	}
+	return list
+}
+
+// normalizeHomeLayout 校验区块类型并补全默认值
+func normalizeHomeLayout(layout *model.HomeLayout) error {
+	if layout.FeaturedIDs == nil {
+		layout.FeaturedIDs = []string{}
+	}
+	if len(layout.FeaturedIDs) > homeFeaturedMaxCount {
+		return fmt.Errorf("精选文章最多 %d 篇", homeFeaturedMaxCount)
+	}
+	if layout.Sections == nil {
+		layout.Sections = []model.HomeSection{}
+	}
+
+	seen := make(map[string]bool)
+	for i := range layout.Sections {
+		section := &layout.Sections[i]
+		switch section.Type {
+		case model.HomeSectionFeatured, model.HomeSectionPinned, model.HomeSectionRecent:
+			if seen[section.Type] {
+				return fmt.Errorf("区块 %s 只能出现一次", section.Type)
+			}
+			seen[section.Type] = true
+		case model.HomeSectionCategory, model.HomeSectionTag:
+			if section.Value == "" {
+				return fmt.Errorf("区块 %s 必须指定名称", section.Type)
+			}
+		default:
+			return fmt.Errorf("不支持的首页区块类型: %s", section.Type)
+		}
+
+		if section.Limit <= 0 {
+			section.Limit = homeSectionDefaultLimit
+		}
+		if section.Limit > homeSectionMaxLimit {
+			section.Limit = homeSectionMaxLimit
+		}
+	}
+	return nil
+}
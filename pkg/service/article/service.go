@@ -17,17 +17,24 @@ import (
 	"unicode"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/app/task"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/permalink"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/toc"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/author"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/cdn"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/direct_link"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/linkarchive"
 	appParser "github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/reaction"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/search"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/subscriber"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/summary"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 )
 
@@ -46,13 +53,21 @@ type Service interface {
 	Get(ctx context.Context, publicID string) (*model.ArticleResponse, error)
 	Update(ctx context.Context, publicID string, req *model.UpdateArticleRequest, ip, referer string) (*model.ArticleResponse, error)
 	Delete(ctx context.Context, publicID string) error
+	ListDeleted(ctx context.Context) ([]*model.Article, error)
+	Restore(ctx context.Context, publicID string) error
+	Purge(ctx context.Context, publicID string) error
 	BatchDelete(ctx context.Context, publicIDs []string) (*BatchDeleteResult, error)
+	StartBulk(ctx context.Context, req *BulkRequest) (*BulkJob, error)
+	GetBulkJob(id string) (*BulkJob, error)
+	ListBulkJobs() []*BulkJob
 	List(ctx context.Context, options *model.ListArticlesOptions) (*model.ArticleListResponse, error)
 	GetPublicBySlugOrID(ctx context.Context, slugOrID string) (*model.ArticleDetailResponse, error)
 	GetBySlugOrIDForPreview(ctx context.Context, slugOrID string) (*model.ArticleDetailResponse, error)
 	ListPublic(ctx context.Context, options *model.ListPublicArticlesOptions) (*model.ArticleListResponse, error)
 	ListHome(ctx context.Context) ([]model.ArticleResponse, error)
 	ListArchives(ctx context.Context) (*model.ArchiveSummaryResponse, error)
+	// GetCalendar 获取月份区间内的内容日历（已发布与定时发布文章的按天统计）
+	GetCalendar(ctx context.Context, startMonth, endMonth string, authorID *uint) (*model.ArticleCalendarResponse, error)
 	GetRandom(ctx context.Context) (*model.ArticleResponse, error)
 	ToAPIResponse(a *model.Article, useAbbrlinkAsID bool, includeHTML bool) *model.ArticleResponse
 	GetPrimaryColorFromURL(ctx context.Context, imageURL string) (string, error)
@@ -70,8 +85,42 @@ type Service interface {
 	// SetHistoryRepo 设置文章历史版本仓储（可选注入，用于文章发布时自动记录历史版本）
 	SetHistoryRepo(historyRepo repository.ArticleHistoryRepository)
 
+	// SetReactionService 设置表态服务（可选注入，用于文章详情返回表态计数）
+	SetReactionService(reactionSvc *reaction.Service)
+
+	// SetLinkArchiveService 设置外链存档服务（可选注入，用于文章详情返回外链存档地址）
+	SetLinkArchiveService(linkArchiveSvc *linkarchive.Service)
+
+	// SetSummaryService 设置摘要生成服务（可选注入，用于按需或保存时自动生成摘要）
+	SetSummaryService(summarySvc *summary.Service)
+
+	// GenerateSummary 为文章生成摘要，save 为 true 时写入文章
+	GenerateSummary(ctx context.Context, publicID string, save bool) (*summary.Result, error)
+
+	// SetSaveListener 设置文章保存监听（可选注入，用于 Git 同步等需要感知文章变更的功能）
+	SetSaveListener(listener SaveListener)
+
+	// SetRedirectRecorder 设置重定向记录（可选注入，文章永久链接变化时自动添加 301 重定向）
+	SetRedirectRecorder(recorder RedirectRecorder)
+
+	// SetEventBus 设置事件总线（可选注入，文章创建、更新、删除后发布事件）
+	SetEventBus(bus *event.EventBus)
+
+	// PermalinkFor 按指定的永久链接策略计算文章的 abbrlink，已被占用或在 taken 中时追加序号
+	PermalinkFor(ctx context.Context, a *model.Article, strategy, pattern string, taken map[string]bool) string
+
 	// GetArticleStatistics 获取文章统计数据（用于前台展示）
 	GetArticleStatistics(ctx context.Context) (*model.ArticleStatistics, error)
+
+	// 文章置顶与首页布局
+	SetPinSort(ctx context.Context, publicID string, pinSort int) (*model.ArticleResponse, error)
+	GetHomeLayout(ctx context.Context) *model.HomeLayout
+	UpdateHomeLayout(ctx context.Context, layout *model.HomeLayout) (*model.HomeLayout, error)
+	GetHomePageData(ctx context.Context) (*model.HomePageData, error)
+
+	// 浏览量统计：按访客去重、排除爬虫
+	RecordView(ctx context.Context, publicID, ip, userAgent string) bool
+	RecordViewBySlugOrID(ctx context.Context, slugOrID, ip, userAgent string) (*model.ArticleViewResult, error)
 }
 
 type serviceImpl struct {
@@ -97,6 +146,29 @@ type serviceImpl struct {
 
 	userRepo    repository.UserRepository
 	historyRepo repository.ArticleHistoryRepository // 文章历史版本仓储
+	reactionSvc *reaction.Service                   // 表态服务
+
+	linkArchiveSvc *linkarchive.Service // 外链存档服务
+	summarySvc     *summary.Service     // 摘要生成服务
+
+	saveListener     SaveListener     // 文章保存监听
+	redirectRecorder RedirectRecorder // 永久链接变化时的重定向记录
+	eventBus         *event.EventBus  // 文章变更事件
+
+	bulk bulkJobs // 批量操作任务
+}
+
+// SaveListener 文章创建、更新或删除成功后收到通知，回调应尽快返回
+type SaveListener interface {
+	ArticleSaved(publicID string)
+	ArticleDeleted(publicID string)
+}
+
+// RedirectRecorder 文章详情页地址变化时记录旧地址到新地址的重定向
+type RedirectRecorder interface {
+	AddPermalinkRedirect(from, to string)
+	// ReleasePath 文章开始使用 path 时删除从该地址出发的重定向，否则访问者会被重定向走
+	ReleasePath(path string)
 }
 
 func NewService(
@@ -144,6 +216,43 @@ func NewService(
 	}
 }
 
+// SetReactionService 设置表态服务（可选注入）
+func (s *serviceImpl) SetReactionService(reactionSvc *reaction.Service) {
+	s.reactionSvc = reactionSvc
+}
+
+// SetLinkArchiveService 设置外链存档服务（可选注入）
+func (s *serviceImpl) SetLinkArchiveService(linkArchiveSvc *linkarchive.Service) {
+	s.linkArchiveSvc = linkArchiveSvc
+}
+
+// SetSaveListener 设置文章保存监听（可选注入）
+func (s *serviceImpl) SetSaveListener(listener SaveListener) {
+	s.saveListener = listener
+}
+
+// SetRedirectRecorder 设置重定向记录（可选注入）
+func (s *serviceImpl) SetRedirectRecorder(recorder RedirectRecorder) {
+	s.redirectRecorder = recorder
+}
+
+// SetEventBus 设置事件总线（可选注入）
+func (s *serviceImpl) SetEventBus(bus *event.EventBus) {
+	s.eventBus = bus
+}
+
+// publishArticleEvent 发布文章变更事件
+func (s *serviceImpl) publishArticleEvent(topic event.Topic, id, abbrlink string) {
+	if s.eventBus == nil {
+		return
+	}
+	slug := abbrlink
+	if slug == "" {
+		slug = id
+	}
+	s.eventBus.Publish(topic, &event.ArticlePayload{ID: id, Slug: slug})
+}
+
 // SetHistoryRepo 设置文章历史版本仓储（可选注入）
 func (s *serviceImpl) SetHistoryRepo(historyRepo repository.ArticleHistoryRepository) {
 	s.historyRepo = historyRepo
@@ -558,6 +667,78 @@ func (s *serviceImpl) validateAbbrlink(ctx context.Context, abbrlink string, exc
 	return nil
 }
 
+// maxPermalinkAttempts 生成的 abbrlink 冲突时最多尝试的序号
+const maxPermalinkAttempts = 100
+
+// articlePermalink 文章详情页地址，未设置 abbrlink 时使用文章 ID
+func articlePermalink(a *model.Article) string {
+	if a.Abbrlink != "" {
+		return "/posts/" + a.Abbrlink
+	}
+	return "/posts/" + a.ID
+}
+
+// permalinkStrategy 读取配置的永久链接策略和自定义模式
+func (s *serviceImpl) permalinkStrategy() (string, string) {
+	strategy := strings.TrimSpace(s.settingSvc.Get(constant.KeyPermalinkStrategy.String()))
+	if !permalink.Valid(strategy) {
+		strategy = permalink.StrategyAbbrlink
+	}
+	return strategy, s.settingSvc.Get(constant.KeyPermalinkPattern.String())
+}
+
+// generateAbbrlink 按永久链接策略生成 abbrlink，与其他文章、页面路径、保留路径或 taken 冲突时追加序号；
+// 无法生成时返回空字符串，由文章 ID 兜底
+func (s *serviceImpl) generateAbbrlink(ctx context.Context, strategy, pattern string, in permalink.Input, excludeDBID uint, taken map[string]bool) string {
+	base := permalink.Build(strategy, pattern, in)
+	if base == "" {
+		return ""
+	}
+	for n := 1; n <= maxPermalinkAttempts; n++ {
+		candidate := permalink.WithSuffix(base, n)
+		if taken[candidate] {
+			continue
+		}
+		if err := s.validateAbbrlink(ctx, candidate, excludeDBID); err == nil {
+			return candidate
+		}
+	}
+	log.Printf("[永久链接] 为 %s 生成永久链接失败，尝试 %d 次后仍有冲突", base, maxPermalinkAttempts)
+	return ""
+}
+
+// firstCategoryName 返回第一个分类的名称，用于永久链接模式中的 {category}
+func (s *serviceImpl) firstCategoryName(ctx context.Context, categoryIDs []string) string {
+	if len(categoryIDs) == 0 {
+		return ""
+	}
+	category, err := s.postCategoryRepo.GetByID(ctx, categoryIDs[0])
+	if err != nil {
+		return ""
+	}
+	return category.Name
+}
+
+// PermalinkFor 按指定的永久链接策略计算文章的 abbrlink，abbrlink 策略下保持不变
+func (s *serviceImpl) PermalinkFor(ctx context.Context, a *model.Article, strategy, pattern string, taken map[string]bool) string {
+	if strategy == permalink.StrategyAbbrlink {
+		return a.Abbrlink
+	}
+	dbID, _, err := idgen.DecodePublicID(a.ID)
+	if err != nil {
+		return a.Abbrlink
+	}
+	var category string
+	if len(a.PostCategories) > 0 {
+		category = a.PostCategories[0].Name
+	}
+	generated := s.generateAbbrlink(ctx, strategy, pattern, permalink.Input{Title: a.Title, Category: category, Date: a.CreatedAt}, dbID, taken)
+	if generated == "" {
+		return a.Abbrlink
+	}
+	return generated
+}
+
 // ToAPIResponse 将领域模型转换为用于API响应的DTO。
 func (s *serviceImpl) ToAPIResponse(a *model.Article, useAbbrlinkAsID bool, includeHTML bool) *model.ArticleResponse {
 	if a == nil {
@@ -631,12 +812,47 @@ func (s *serviceImpl) ToAPIResponse(a *model.Article, useAbbrlinkAsID bool, incl
 		}
 	}
 
+	resp.CustomFields = s.resolveCustomFields(a.ExtraConfig)
+
+	// 解析共同作者资料
+	if a.ExtraConfig != nil && len(a.ExtraConfig.Authors) > 0 {
+		resp.Authors = author.ResolveProfiles(s.settingSvc, a.ExtraConfig.Authors)
+	}
+
 	if includeHTML {
 		resp.ContentHTML = a.ContentHTML
+		if a.ExtraConfig != nil && len(a.ExtraConfig.Toc) > 0 {
+			resp.Toc = a.ExtraConfig.Toc
+		} else {
+			// 功能上线前保存的文章没有目录，即时提取并同步补齐正文中的锚点
+			resp.ContentHTML, resp.Toc = toc.Extract(a.ContentHTML, s.tocMaxDepth())
+		}
 	}
 	return resp
 }
 
+// tocMaxDepth 读取目录提取层数
+func (s *serviceImpl) tocMaxDepth() int {
+	depth, err := strconv.Atoi(s.settingSvc.Get(constant.KeyPostTocMaxDepth.String()))
+	if err != nil || depth <= 0 || depth > 6 {
+		return toc.DefaultMaxDepth
+	}
+	return depth
+}
+
+// withToc 返回带有目录的扩展配置副本，不修改传入的配置
+func withToc(config *model.ArticleExtraConfig, items []*model.TocItem) *model.ArticleExtraConfig {
+	if config == nil && len(items) == 0 {
+		return nil
+	}
+	result := &model.ArticleExtraConfig{}
+	if config != nil {
+		*result = *config
+	}
+	result.Toc = items
+	return result
+}
+
 // 将领域模型转换为简化的 API 响应
 func toSimpleAPIResponse(a *model.Article) *model.SimpleArticleResponse {
 	if a == nil {
@@ -846,26 +1062,8 @@ func (s *serviceImpl) GetPublicBySlugOrID(ctx context.Context, slugOrID string)
 		relatedArticles, relatedErr = s.repo.FindRelatedArticles(ctx, article, 2)
 	}()
 
-	viewCacheKey := s.getArticleViewCacheKey(article.ID)
-	go func() {
-		if _, err := s.cacheSvc.Increment(context.Background(), viewCacheKey); err != nil {
-			log.Printf("[错误] 无法在 Redis 中为文章 %s 增加浏览次数: %v", article.ID, err)
-		}
-	}()
-
-	redisIncrStr, err := s.cacheSvc.Get(ctx, viewCacheKey)
-	if err != nil {
-		log.Printf("[警告] 无法从 Redis 获取文章 %s 的增量浏览量: %v。将只返回数据库中的值。", article.ID, err)
-	}
-
-	var redisIncr int
-	if redisIncrStr != "" {
-		val, convErr := strconv.Atoi(redisIncrStr)
-		if convErr == nil {
-			redisIncr = val
-		}
-	}
-	article.ViewCount += redisIncr
+	// 浏览量由 RecordView 按访客去重后计入，这里只叠加尚未同步到数据库的增量
+	article.ViewCount += s.pendingViewCount(ctx, article.ID)
 
 	wg.Wait()
 
@@ -901,6 +1099,16 @@ func (s *serviceImpl) GetPublicBySlugOrID(ctx context.Context, slugOrID string)
 	// abbrlink 信息仍然通过 Abbrlink 字段返回
 	mainArticleResponse := s.ToAPIResponse(article, false, true)
 	s.fillOwnerNickname(ctx, mainArticleResponse, nil)
+	if s.reactionSvc != nil {
+		if counts, err := s.reactionSvc.Get(ctx, reaction.TargetArticle, article.ID); err != nil {
+			log.Printf("[GetPublicBySlugOrID] 获取文章 %s 的表态计数失败: %v", article.ID, err)
+		} else {
+			mainArticleResponse.Reactions = counts
+		}
+	}
+	if s.linkArchiveSvc != nil {
+		mainArticleResponse.ArchivedLinks = s.linkArchiveSvc.Lookup(ctx, article.ContentHTML)
+	}
 	relatedResponses := make([]*model.SimpleArticleResponse, 0, len(relatedArticles))
 	for _, rel := range relatedArticles {
 		relatedResponses = append(relatedResponses, toSimpleAPIResponse(rel))
@@ -947,9 +1155,38 @@ func (s *serviceImpl) Create(ctx context.Context, req *model.CreateArticleReques
 	if err := s.validateAbbrlink(ctx, req.Abbrlink, 0); err != nil {
 		return nil, err
 	}
+	// 未填写 abbrlink 时按永久链接策略生成
+	abbrlink := req.Abbrlink
+	if strategy, pattern := s.permalinkStrategy(); abbrlink == "" && strategy != permalink.StrategyAbbrlink {
+		publishedAt := time.Now()
+		if req.CustomPublishedAt != nil {
+			if parsed, err := time.Parse(time.RFC3339, *req.CustomPublishedAt); err == nil {
+				publishedAt = parsed
+			}
+		}
+		abbrlink = s.generateAbbrlink(ctx, strategy, pattern, permalink.Input{
+			Title:    req.Title,
+			Category: s.firstCategoryName(ctx, req.PostCategoryIDs),
+			Date:     publishedAt,
+		}, 0, nil)
+	}
 
 	var newArticle *model.Article
 	sanitizedHTML := s.parserSvc.SanitizeHTML(req.ContentHTML)
+	// 提取目录并补齐标题锚点，目录随扩展配置一起保存
+	sanitizedHTML, tocItems := toc.Extract(sanitizedHTML, s.tocMaxDepth())
+	extraConfig := withToc(req.ExtraConfig, tocItems)
+	var customFields map[string]interface{}
+	if extraConfig != nil {
+		customFields = extraConfig.CustomFields
+	}
+	customFields, cfErr := s.validateCustomFields(customFields)
+	if cfErr != nil {
+		return nil, cfErr
+	}
+	if extraConfig != nil {
+		extraConfig.CustomFields = customFields
+	}
 
 	err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
 		wordCount, readingTime := calculatePostStats(req.ContentMd)
@@ -1119,7 +1356,7 @@ func (s *serviceImpl) Create(ctx context.Context, req *model.CreateArticleReques
 			PrimaryColor:         primaryColor,
 			IsPrimaryColorManual: isManual,
 			ShowOnHome:           showOnHome,
-			Abbrlink:             req.Abbrlink,
+			Abbrlink:             abbrlink,
 			Copyright:            copyright,
 			IsReprint:            isReprint,
 			CopyrightAuthor:      req.CopyrightAuthor,
@@ -1129,7 +1366,7 @@ func (s *serviceImpl) Create(ctx context.Context, req *model.CreateArticleReques
 			CustomUpdatedAt:      customUpdatedAt,
 			Keywords:             req.Keywords,
 			ReviewStatus:         req.ReviewStatus, // 审核状态（多人共创功能）
-			ExtraConfig:          req.ExtraConfig,  // 文章扩展配置
+			ExtraConfig:          extraConfig,      // 文章扩展配置（含目录）
 			ScheduledAt:          scheduledAt,      // 定时发布时间
 			// 文档模式相关字段
 			IsDoc:   req.IsDoc,
@@ -1179,16 +1416,28 @@ func (s *serviceImpl) Create(ctx context.Context, req *model.CreateArticleReques
 		}
 	}()
 
-	// 如果文章发布成功，触发订阅通知
+	// 如果文章发布成功，触发订阅通知（订阅功能关闭时不推送）
 	if newArticle.Status == "PUBLISHED" {
-		if err := s.subscriberSvc.NotifyArticlePublished(ctx, newArticle); err != nil {
-			log.Printf("[Create] 触发订阅通知失败: %v", err)
+		if setting.FeatureEnabled(s.settingSvc, setting.FeatureNewsletter) {
+			if err := s.subscriberSvc.NotifyArticlePublished(ctx, newArticle); err != nil {
+				log.Printf("[Create] 触发订阅通知失败: %v", err)
+			}
 		}
 
 		// 创建历史版本记录（仅在发布时记录）
 		s.createArticleHistory(ctx, newArticle, req.OwnerID, "初次发布")
 	}
 
+	if s.redirectRecorder != nil {
+		s.redirectRecorder.ReleasePath(articlePermalink(newArticle))
+	}
+
+	if s.saveListener != nil {
+		s.saveListener.ArticleSaved(newArticle.ID)
+	}
+	s.publishArticleEvent(event.ArticleCreated, newArticle.ID, newArticle.Abbrlink)
+	s.autoSummarize(newArticle)
+
 	resp := s.ToAPIResponse(newArticle, false, false)
 	s.fillOwnerNickname(ctx, resp, nil)
 	return resp, nil
@@ -1218,32 +1467,12 @@ func (s *serviceImpl) getArticleViewCacheKey(publicID string) string {
 
 // GetPublicByID (此方法似乎与 GetPublicBySlugOrID 功能重叠，暂时保留)
 func (s *serviceImpl) GetPublicByID(ctx context.Context, publicID string) (*model.ArticleResponse, error) {
-	viewCacheKey := s.getArticleViewCacheKey(publicID)
-	go func() {
-		if _, err := s.cacheSvc.Increment(context.Background(), viewCacheKey); err != nil {
-			log.Printf("[错误] 无法在 Redis 中为文章 %s 增加浏览次数: %v", publicID, err)
-		}
-	}()
-
 	article, err := s.repo.GetByID(ctx, publicID)
 	if err != nil {
 		return nil, err
 	}
 
-	redisIncrStr, err := s.cacheSvc.Get(ctx, viewCacheKey)
-	if err != nil {
-		log.Printf("[警告] 无法从 Redis 获取文章 %s 的增量浏览量: %v。将只返回数据库中的值。", publicID, err)
-	}
-
-	var redisIncr int
-	if redisIncrStr != "" {
-		val, convErr := strconv.Atoi(redisIncrStr)
-		if convErr == nil {
-			redisIncr = val
-		}
-	}
-
-	article.ViewCount = article.ViewCount + redisIncr
+	article.ViewCount += s.pendingViewCount(ctx, publicID)
 
 	resp := s.ToAPIResponse(article, true, true)
 	s.fillOwnerNickname(ctx, resp, nil)
@@ -1265,7 +1494,7 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 	}
 
 	var updatedArticle *model.Article
-	var oldStatus string
+	var oldStatus, oldPermalink string
 
 	err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
 		oldArticle, err := repos.Article.GetByID(ctx, publicID)
@@ -1273,6 +1502,7 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 			return err
 		}
 		oldStatus = oldArticle.Status
+		oldPermalink = articlePermalink(oldArticle)
 		oldTagIDs := make([]uint, len(oldArticle.PostTags))
 		for i, t := range oldArticle.PostTags {
 			oldTagIDs[i], _, _ = idgen.DecodePublicID(t.ID)
@@ -1313,6 +1543,21 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 			}
 		}
 
+		// 扩展配置中未传自定义字段时沿用已保存的字段，传入时重新校验
+		if req.ExtraConfig != nil {
+			if req.ExtraConfig.CustomFields == nil {
+				if oldArticle.ExtraConfig != nil {
+					req.ExtraConfig.CustomFields = oldArticle.ExtraConfig.CustomFields
+				}
+			} else {
+				customFields, err := s.validateCustomFields(req.ExtraConfig.CustomFields)
+				if err != nil {
+					return err
+				}
+				req.ExtraConfig.CustomFields = customFields
+			}
+		}
+
 		var computedParams model.UpdateArticleComputedParams
 
 		// 如果 Markdown 内容有更新，则重新计算字数和阅读时间
@@ -1323,7 +1568,17 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 		}
 		if req.ContentHTML != nil {
 			sanitizedHTML := s.parserSvc.SanitizeHTML(*req.ContentHTML)
+			sanitizedHTML, tocItems := toc.Extract(sanitizedHTML, s.tocMaxDepth())
 			computedParams.ContentHTML = sanitizedHTML
+			// 正文变化时重新生成目录，未传扩展配置时保留原有配置
+			baseConfig := req.ExtraConfig
+			if baseConfig == nil {
+				baseConfig = oldArticle.ExtraConfig
+			}
+			req.ExtraConfig = withToc(baseConfig, tocItems)
+		} else if req.ExtraConfig != nil && oldArticle.ExtraConfig != nil {
+			// 只更新扩展配置时沿用已保存的目录
+			req.ExtraConfig.Toc = oldArticle.ExtraConfig.Toc
 		}
 
 		isManual := oldArticle.IsPrimaryColorManual
@@ -1525,8 +1780,8 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 		}
 	}()
 
-	// 如果文章状态从非发布变为发布，触发订阅通知
-	if oldStatus != "PUBLISHED" && updatedArticle.Status == "PUBLISHED" {
+	// 如果文章状态从非发布变为发布，触发订阅通知（订阅功能关闭时不推送）
+	if oldStatus != "PUBLISHED" && updatedArticle.Status == "PUBLISHED" && setting.FeatureEnabled(s.settingSvc, setting.FeatureNewsletter) {
 		if err := s.subscriberSvc.NotifyArticlePublished(ctx, updatedArticle); err != nil {
 			log.Printf("[Update] 触发订阅通知失败: %v", err)
 		}
@@ -1541,12 +1796,27 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 		s.createArticleHistory(ctx, updatedArticle, updatedArticle.OwnerID, changeNote)
 	}
 
+	// 详情页地址变化时为旧地址添加 301 重定向（同时删除新地址上的规则），未开启时只删除新地址上的规则
+	if newPermalink := articlePermalink(updatedArticle); newPermalink != oldPermalink && s.redirectRecorder != nil {
+		if s.settingSvc.GetBool(constant.KeyPermalinkAutoRedirect.String()) {
+			s.redirectRecorder.AddPermalinkRedirect(oldPermalink, newPermalink)
+		} else {
+			s.redirectRecorder.ReleasePath(newPermalink)
+		}
+	}
+
+	if s.saveListener != nil {
+		s.saveListener.ArticleSaved(updatedArticle.ID)
+	}
+	s.publishArticleEvent(event.ArticleUpdated, updatedArticle.ID, updatedArticle.Abbrlink)
+	s.autoSummarize(updatedArticle)
+
 	resp := s.ToAPIResponse(updatedArticle, false, false)
 	s.fillOwnerNickname(ctx, resp, nil)
 	return resp, nil
 }
 
-// Delete 处理删除文章的业务逻辑。
+// Delete 处理删除文章的业务逻辑，文章移入回收站，可通过 Restore 恢复。
 func (s *serviceImpl) Delete(ctx context.Context, publicID string) error {
 	err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
 		article, err := repos.Article.GetByID(ctx, publicID)
@@ -1568,15 +1838,7 @@ func (s *serviceImpl) Delete(ctx context.Context, publicID string) error {
 			docSeriesDBID = *article.DocSeriesID
 		}
 
-		// 先删除文章历史版本记录（解决外键约束问题）
-		articleDBID, _, decodeErr := idgen.DecodePublicID(publicID)
-		if decodeErr == nil {
-			if err := repos.ArticleHistory.DeleteByArticle(ctx, articleDBID); err != nil {
-				log.Printf("[警告] 删除文章历史版本记录失败: %v", err)
-				// 不中断删除流程，继续尝试删除文章
-			}
-		}
-
+		// 文章移入回收站，历史版本保留到彻底删除时再清理
 		if err := repos.Article.Delete(ctx, publicID); err != nil {
 			return err
 		}
@@ -1625,6 +1887,11 @@ func (s *serviceImpl) Delete(ctx context.Context, publicID string) error {
 		}
 	}()
 
+	if s.saveListener != nil {
+		s.saveListener.ArticleDeleted(publicID)
+	}
+	s.publishArticleEvent(event.ArticleDeleted, publicID, "")
+
 	return nil
 }
 
@@ -1704,9 +1971,17 @@ func (s *serviceImpl) GetRandom(ctx context.Context) (*model.ArticleResponse, er
 	return resp, nil
 }
 
+// homeArticleLimit 首页推荐文章接口返回的文章数
+const homeArticleLimit = 6
+
 // ListHome 获取首页推荐文章列表。
 func (s *serviceImpl) ListHome(ctx context.Context) ([]model.ArticleResponse, error) {
-	articles, err := s.repo.ListHome(ctx)
+	return s.listHome(ctx, homeArticleLimit)
+}
+
+// listHome 获取最多 limit 篇首页推荐文章
+func (s *serviceImpl) listHome(ctx context.Context, limit int) ([]model.ArticleResponse, error) {
+	articles, err := s.repo.ListHome(ctx, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -1714,6 +1989,7 @@ func (s *serviceImpl) ListHome(ctx context.Context) ([]model.ArticleResponse, er
 	ownerCache := make(map[uint]string)
 	for i, a := range articles {
 		a.ContentMd = ""
+		a.ViewCount += s.pendingViewCount(ctx, a.ID)
 		resp := s.ToAPIResponse(a, true, false)
 		s.fillOwnerNickname(ctx, resp, ownerCache)
 		list[i] = *resp
@@ -1735,6 +2011,7 @@ func (s *serviceImpl) ListPublic(ctx context.Context, options *model.ListPublicA
 		if !options.WithContent {
 			a.ContentMd = ""
 		}
+		a.ViewCount += s.pendingViewCount(ctx, a.ID)
 		resp := s.ToAPIResponse(a, true, false)
 		s.fillOwnerNickname(ctx, resp, ownerCache)
 		list[i] = *resp
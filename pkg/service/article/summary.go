@@ -0,0 +1,93 @@
+/*
+ * @Description: 文章摘要的按需生成与保存时自动生成
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 保存时自动生成只在以下条件同时满足时触发：开启了 summary.on_save、文章不是草稿、
+ * 未填写任何摘要、且文章扩展配置中没有设置 disable_auto_summary。生成在后台进行，不阻塞保存，
+ * 写入摘要后清除文章缓存并发布更新事件，不修改文章的更新时间。
+ */
+package article
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/summary"
+)
+
+// autoSummaryTimeout 保存时自动生成摘要的超时时间
+const autoSummaryTimeout = 2 * time.Minute
+
+// SetSummaryService 设置摘要生成服务（可选注入）
+func (s *serviceImpl) SetSummaryService(summarySvc *summary.Service) {
+	s.summarySvc = summarySvc
+}
+
+// GenerateSummary 为文章生成摘要，save 为 true 时写入文章，否则只返回结果供编辑器填入
+func (s *serviceImpl) GenerateSummary(ctx context.Context, publicID string, save bool) (*summary.Result, error) {
+	if !s.summarySvc.Enabled() {
+		return nil, summary.ErrDisabled
+	}
+	article, err := s.repo.GetByID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.summarySvc.Summarize(ctx, article.Title, article.ContentHTML)
+	if err != nil {
+		return nil, err
+	}
+	if save {
+		if err := s.saveSummary(ctx, article, result.Summary); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// autoSummarize 文章保存后按需在后台生成摘要
+func (s *serviceImpl) autoSummarize(a *model.Article) {
+	if a == nil || !s.summarySvc.OnSave() || a.Status == "DRAFT" || len(a.Summaries) > 0 {
+		return
+	}
+	if a.ExtraConfig != nil && a.ExtraConfig.DisableAutoSummary {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), autoSummaryTimeout)
+		defer cancel()
+
+		result, err := s.summarySvc.Summarize(ctx, a.Title, a.ContentHTML)
+		if err != nil {
+			log.Printf("[自动摘要] 文章 %s 生成摘要失败: %v", a.ID, err)
+			return
+		}
+		// 重新读取，避免覆盖生成期间用户手动填写的摘要
+		latest, err := s.repo.GetByID(ctx, a.ID)
+		if err != nil || len(latest.Summaries) > 0 {
+			return
+		}
+		if err := s.saveSummary(ctx, latest, result.Summary); err != nil {
+			log.Printf("[自动摘要] 文章 %s 保存摘要失败: %v", a.ID, err)
+			return
+		}
+		log.Printf("[自动摘要] 已为文章 %s 生成摘要（%s）", a.ID, result.Provider)
+	}()
+}
+
+// saveSummary 将摘要写入文章并清除缓存
+func (s *serviceImpl) saveSummary(ctx context.Context, a *model.Article, text string) error {
+	if err := s.repo.UpdateSummaries(ctx, a.ID, []string{text}); err != nil {
+		return err
+	}
+	s.invalidateArticleCache(ctx, a.ID, a.Abbrlink)
+	go s.invalidateRelatedCaches(context.Background())
+	if s.saveListener != nil {
+		s.saveListener.ArticleSaved(a.ID)
+	}
+	s.publishArticleEvent(event.ArticleUpdated, a.ID, a.Abbrlink)
+	return nil
+}
@@ -0,0 +1,98 @@
+package article
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// ListDeleted 获取回收站中的文章，删除文章只设置删除时间，由回收站负责恢复或彻底删除
+func (s *serviceImpl) ListDeleted(ctx context.Context) ([]*model.Article, error) {
+	return s.repo.ListDeleted(ctx)
+}
+
+// Restore 从回收站恢复文章，同时恢复因删除而被清理的标签、分类并重新计数
+func (s *serviceImpl) Restore(ctx context.Context, publicID string) error {
+	var restored *model.Article
+	err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		article, err := repos.Article.GetDeletedByID(ctx, publicID)
+		if err != nil {
+			return fmt.Errorf("回收站中不存在文章 %s: %w", publicID, err)
+		}
+		tagIDs := make([]uint, len(article.PostTags))
+		for i, t := range article.PostTags {
+			tagIDs[i], _, _ = idgen.DecodePublicID(t.ID)
+		}
+		categoryIDs := make([]uint, len(article.PostCategories))
+		for i, c := range article.PostCategories {
+			categoryIDs[i], _, _ = idgen.DecodePublicID(c.ID)
+		}
+
+		if err := repos.Article.Restore(ctx, publicID); err != nil {
+			return fmt.Errorf("恢复文章失败: %w", err)
+		}
+		if err := repos.PostTag.Restore(ctx, tagIDs); err != nil {
+			return fmt.Errorf("恢复标签失败: %w", err)
+		}
+		if err := repos.PostTag.UpdateCount(ctx, tagIDs, nil); err != nil {
+			return fmt.Errorf("更新标签计数失败: %w", err)
+		}
+		if err := repos.PostCategory.Restore(ctx, categoryIDs); err != nil {
+			return fmt.Errorf("恢复分类失败: %w", err)
+		}
+		if err := repos.PostCategory.UpdateCount(ctx, categoryIDs, nil); err != nil {
+			return fmt.Errorf("更新分类计数失败: %w", err)
+		}
+		if article.IsDoc && article.DocSeriesID != nil {
+			if err := repos.DocSeries.UpdateDocCount(ctx, *article.DocSeriesID, 1); err != nil {
+				return fmt.Errorf("更新文档系列计数失败: %w", err)
+			}
+		}
+
+		restored, err = repos.Article.GetByID(ctx, publicID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.updateSiteStatsInBackground()
+	go s.invalidateRelatedCaches(context.Background())
+	go func() {
+		if err := s.searchSvc.IndexArticle(context.Background(), restored); err != nil {
+			log.Printf("[警告] 更新搜索索引失败: %v", err)
+		}
+	}()
+
+	if s.saveListener != nil {
+		s.saveListener.ArticleSaved(publicID)
+	}
+	s.publishArticleEvent(event.ArticleUpdated, restored.ID, restored.Abbrlink)
+	return nil
+}
+
+// Purge 彻底删除回收站中的文章及其历史版本，不可恢复
+func (s *serviceImpl) Purge(ctx context.Context, publicID string) error {
+	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		if _, err := repos.Article.GetDeletedByID(ctx, publicID); err != nil {
+			return fmt.Errorf("回收站中不存在文章 %s: %w", publicID, err)
+		}
+		// 先删除历史版本（外键约束）
+		articleDBID, _, err := idgen.DecodePublicID(publicID)
+		if err != nil {
+			return err
+		}
+		if err := repos.ArticleHistory.DeleteByArticle(ctx, articleDBID); err != nil {
+			return fmt.Errorf("删除文章历史版本失败: %w", err)
+		}
+		if err := repos.Article.Purge(ctx, publicID); err != nil {
+			return fmt.Errorf("彻底删除文章失败: %w", err)
+		}
+		return nil
+	})
+}
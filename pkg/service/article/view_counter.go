@@ -0,0 +1,106 @@
+/*
+ * @Description: 文章浏览量统计，按访客去重并排除爬虫
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 浏览量增量先累加在缓存中（anheyu:article:view_count:<文章ID>），由 sync_view_counts 定时任务批量写入数据库；
+ * 同一访客（IP + User-Agent 的哈希）在去重窗口内重复打开或刷新同一篇文章只计一次。
+ * 对外返回的浏览量始终是“数据库值 + 尚未同步的增量”，与同步前后保持一致。
+ * 浏览只在文章接口（详情接口和 POST /view）以及不含脚本的精简阅读页中记录，服务端渲染文章页时不记录，
+ * 避免与页面随后请求文章接口重复计数。
+ */
+package article
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+)
+
+const (
+	// ArticleViewSeenKeyPrefix 访客去重标记的缓存键前缀
+	ArticleViewSeenKeyPrefix = ArticleKeyNamespace + "article:view_seen:"
+	// defaultViewDebounceMinutes 默认去重窗口（分钟）
+	defaultViewDebounceMinutes = 30
+)
+
+// RecordView 记录一次文章浏览，返回本次是否被计入；爬虫和去重窗口内的重复浏览不计入
+func (s *serviceImpl) RecordView(ctx context.Context, publicID, ip, userAgent string) bool {
+	if publicID == "" || util.IsBotUserAgent(userAgent) {
+		return false
+	}
+
+	if window := s.viewDebounceWindow(); window > 0 {
+		seenKey := ArticleViewSeenKeyPrefix + publicID + ":" + visitorHash(ip, userAgent)
+		seen, err := s.cacheSvc.Increment(ctx, seenKey)
+		if err != nil {
+			log.Printf("[浏览量] 写入文章 %s 的访客去重标记失败: %v", publicID, err)
+			return false
+		}
+		if seen > 1 {
+			return false
+		}
+		if err := s.cacheSvc.Expire(ctx, seenKey, window); err != nil {
+			log.Printf("[浏览量] 设置文章 %s 的访客去重标记过期时间失败: %v", publicID, err)
+		}
+	}
+
+	if _, err := s.cacheSvc.Increment(ctx, s.getArticleViewCacheKey(publicID)); err != nil {
+		log.Printf("[错误] 无法在 Redis 中为文章 %s 增加浏览次数: %v", publicID, err)
+		return false
+	}
+	return true
+}
+
+// RecordViewBySlugOrID 按文章 ID 或 abbrlink 记录浏览并返回最新浏览量
+func (s *serviceImpl) RecordViewBySlugOrID(ctx context.Context, slugOrID, ip, userAgent string) (*model.ArticleViewResult, error) {
+	article, err := s.repo.GetBySlugOrID(ctx, slugOrID)
+	if err != nil {
+		return nil, err
+	}
+	counted := s.RecordView(ctx, article.ID, ip, userAgent)
+	return &model.ArticleViewResult{
+		ID:        article.ID,
+		ViewCount: article.ViewCount + s.pendingViewCount(ctx, article.ID),
+		Counted:   counted,
+	}, nil
+}
+
+// pendingViewCount 返回缓存中尚未同步到数据库的浏览量增量
+func (s *serviceImpl) pendingViewCount(ctx context.Context, publicID string) int {
+	raw, err := s.cacheSvc.Get(ctx, s.getArticleViewCacheKey(publicID))
+	if err != nil {
+		log.Printf("[警告] 无法从 Redis 获取文章 %s 的增量浏览量: %v。将只返回数据库中的值。", publicID, err)
+		return 0
+	}
+	if raw == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// viewDebounceWindow 读取去重窗口，配置为 0 时不去重
+func (s *serviceImpl) viewDebounceWindow() time.Duration {
+	minutes, err := strconv.Atoi(s.settingSvc.Get(constant.KeyArticleViewDebounceMinutes.String()))
+	if err != nil || minutes < 0 {
+		minutes = defaultViewDebounceMinutes
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// visitorHash 访客标识，只保存哈希，不在缓存中留下原始 IP
+func visitorHash(ip, userAgent string) string {
+	sum := sha256.Sum256([]byte(ip + "|" + userAgent))
+	return hex.EncodeToString(sum[:12])
+}
@@ -0,0 +1,455 @@
+/*
+ * @Description: 主题静态资源 CDN 分发
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 设置 theme.asset_cdn.url 后，前台页面 HTML（内嵌主题、外部主题和 SSR 主题）和公开主题配置接口中
+ * /static/ 与 /assets/ 开头的资源地址会改写为 CDN 地址，并附带 ?v={内容哈希}，资源内容变化后地址随之变化。
+ *
+ * 未设置 theme.asset_cdn.policy_id 时按回源模式处理：CDN 以本站为源站，直接改写所有已知资源。
+ * 设置后按推送模式处理：切换主题时由同步任务把资源上传到该存储策略的 theme-assets 目录，
+ * CDN 地址应指向该目录；只有已上传且内容一致的资源才会被改写，其余资源仍从本站加载。
+ * 两种模式下 CDN 都需要把查询参数计入缓存键。
+ */
+package assetcdn
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+)
+
+const (
+	// DefaultDir 同步记录的保存目录
+	DefaultDir = "data/asset_cdn"
+	// RemoteDir 推送模式下资源在存储策略中的目录
+	RemoteDir = "theme-assets"
+
+	manifestFileName = "manifest.json"
+	// hashLength 资源地址中使用的哈希长度，与内嵌资源版本号一致
+	hashLength = 10
+	// versionCheckInterval 检查主题资源是否变化的最短间隔，避免每次渲染页面都遍历主题目录
+	versionCheckInterval = 10 * time.Second
+	// syncTimeout 单次同步的超时时间
+	syncTimeout = 30 * time.Minute
+)
+
+// ErrSyncRunning 同步任务正在运行
+var ErrSyncRunning = errors.New("主题资源同步正在进行中")
+
+// assetURLPattern 匹配 HTML 中 src、href、content 属性和 CSS url() 引用的主题资源，已有的查询参数会被替换
+var assetURLPattern = regexp.MustCompile(`((?:src|href|content)=["']|url\(["']?)(/(?:static|assets)/[^"'?#)\s]+)(\?[^"'#)\s]*)?`)
+
+// Source 前台主题静态资源的来源，由 router 包根据当前使用的主题实现
+type Source interface {
+	// Signature 当前资源的签名，切换主题或修改覆盖目录后改变
+	Signature() string
+	// Walk 遍历所有资源，url 为 /static/ 或 /assets/ 开头的访问地址
+	Walk(fn func(url string, open func() (io.ReadCloser, error)) error) error
+}
+
+// SyncResult 一次同步的结果
+type SyncResult struct {
+	Reason     string            `json:"reason"`
+	PolicyID   string            `json:"policy_id"`
+	Total      int               `json:"total"`
+	Uploaded   int               `json:"uploaded"`
+	Skipped    int               `json:"skipped"` // 内容未变化，无需重新上传
+	Failed     map[string]string `json:"failed,omitempty"`
+	Error      string            `json:"error,omitempty"`
+	StartedAt  time.Time         `json:"started_at"`
+	DurationMs int64             `json:"duration_ms"`
+}
+
+// Status 资源 CDN 的当前状态
+type Status struct {
+	Enabled  bool        `json:"enabled"`
+	BaseURL  string      `json:"base_url"`
+	Mode     string      `json:"mode"` // pull 回源模式，push 推送模式
+	Assets   int         `json:"assets"`
+	Synced   int         `json:"synced"` // 推送模式下已上传且内容一致的资源数
+	Running  bool        `json:"running"`
+	LastSync *SyncResult `json:"last_sync,omitempty"`
+}
+
+// syncManifest 已上传到存储策略的资源记录
+type syncManifest struct {
+	PolicyID string            `json:"policy_id"`
+	Files    map[string]string `json:"files"` // 资源地址 -> 内容哈希
+}
+
+// Service 主题静态资源 CDN 服务
+type Service struct {
+	settingSvc setting.SettingService
+	policySvc  volume.IStoragePolicyService
+	providers  map[constant.StoragePolicyType]storage.IStorageProvider
+	source     Source
+	dir        string
+
+	mu        sync.Mutex
+	signature string
+	checkedAt time.Time
+	hashes    map[string]string // 资源地址 -> 内容哈希
+	manifest  *syncManifest
+
+	syncMu   sync.Mutex
+	lastSync *SyncResult
+}
+
+// NewService 创建主题静态资源 CDN 服务
+func NewService(
+	settingSvc setting.SettingService,
+	policySvc volume.IStoragePolicyService,
+	providers map[constant.StoragePolicyType]storage.IStorageProvider,
+	source Source,
+	dir string,
+) *Service {
+	s := &Service{
+		settingSvc: settingSvc,
+		policySvc:  policySvc,
+		providers:  providers,
+		source:     source,
+		dir:        dir,
+		manifest:   &syncManifest{Files: map[string]string{}},
+	}
+	s.loadManifest()
+	return s
+}
+
+// RegisterHandlers 订阅主题切换事件，推送模式下切换主题后同步资源
+func (s *Service) RegisterHandlers(bus *event.EventBus) {
+	bus.Subscribe(event.ThemeSwitched, func(payload interface{}) {
+		s.SyncAsync(fmt.Sprintf("切换主题 %v", payload))
+	})
+}
+
+// Enabled 是否设置了资源 CDN 地址
+func (s *Service) Enabled() bool {
+	return s != nil && s.baseURL() != ""
+}
+
+func (s *Service) baseURL() string {
+	return strings.TrimRight(strings.TrimSpace(s.settingSvc.Get(constant.KeyThemeAssetCDNURL.String())), "/")
+}
+
+func (s *Service) policyID() string {
+	return strings.TrimSpace(s.settingSvc.Get(constant.KeyThemeAssetCDNPolicyID.String()))
+}
+
+// AssetURL 返回资源的 CDN 地址，资源不存在或推送模式下尚未上传时返回 false
+func (s *Service) AssetURL(url string) (string, bool) {
+	base := s.baseURL()
+	if base == "" {
+		return "", false
+	}
+	if i := strings.IndexAny(url, "?#"); i != -1 {
+		url = url[:i]
+	}
+	hashes := s.versions()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	hash, ok := hashes[url]
+	if !ok {
+		return "", false
+	}
+	if policyID := s.policyID(); policyID != "" {
+		if s.manifest.PolicyID != policyID || s.manifest.Files[url] != hash {
+			return "", false
+		}
+	}
+	return base + url + "?v=" + hash, true
+}
+
+// Rewrite 将 HTML 中引用的主题资源地址改写为 CDN 地址
+func (s *Service) Rewrite(document string) string {
+	if !s.Enabled() {
+		return document
+	}
+	return assetURLPattern.ReplaceAllStringFunc(document, func(match string) string {
+		parts := assetURLPattern.FindStringSubmatch(match)
+		if cdnURL, ok := s.AssetURL(parts[2]); ok {
+			return parts[1] + cdnURL
+		}
+		return match
+	})
+}
+
+// RewriteValue 改写主题配置值中的资源地址，递归处理区分浅色/深色模式的对象和数组
+func (s *Service) RewriteValue(value interface{}) interface{} {
+	if !s.Enabled() {
+		return value
+	}
+	switch v := value.(type) {
+	case string:
+		if strings.HasPrefix(v, "/static/") || strings.HasPrefix(v, "/assets/") {
+			if cdnURL, ok := s.AssetURL(v); ok {
+				return cdnURL
+			}
+		}
+		return v
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = s.RewriteValue(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = s.RewriteValue(item)
+		}
+		return result
+	}
+	return value
+}
+
+// versions 返回当前主题资源的内容哈希表，资源签名变化时重新计算
+func (s *Service) versions() map[string]string {
+	s.mu.Lock()
+	if s.hashes != nil && time.Since(s.checkedAt) < versionCheckInterval {
+		hashes := s.hashes
+		s.mu.Unlock()
+		return hashes
+	}
+	s.mu.Unlock()
+
+	signature := s.source.Signature()
+	s.mu.Lock()
+	if s.hashes != nil && s.signature == signature {
+		s.checkedAt = time.Now()
+		hashes := s.hashes
+		s.mu.Unlock()
+		return hashes
+	}
+	s.mu.Unlock()
+
+	hashes := make(map[string]string)
+	err := s.source.Walk(func(url string, open func() (io.ReadCloser, error)) error {
+		hash, err := contentHash(open)
+		if err != nil {
+			log.Printf("[资源CDN] 计算 %s 的哈希失败: %v", url, err)
+			return nil
+		}
+		hashes[url] = hash
+		return nil
+	})
+	if err != nil {
+		log.Printf("[资源CDN] 遍历主题资源失败: %v", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.signature = signature
+	s.checkedAt = time.Now()
+	s.hashes = hashes
+	return hashes
+}
+
+// SyncAsync 推送模式下在后台同步资源
+func (s *Service) SyncAsync(reason string) {
+	if !s.Enabled() || s.policyID() == "" {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
+		defer cancel()
+		if _, err := s.Sync(ctx, reason); err != nil && !errors.Is(err, ErrSyncRunning) {
+			log.Printf("[资源CDN] 同步主题资源失败: %v", err)
+		}
+	}()
+}
+
+// Sync 将当前主题的资源上传到推送模式的存储策略，内容未变化的资源跳过
+func (s *Service) Sync(ctx context.Context, reason string) (*SyncResult, error) {
+	if !s.syncMu.TryLock() {
+		return nil, ErrSyncRunning
+	}
+	defer s.syncMu.Unlock()
+
+	result := &SyncResult{Reason: reason, PolicyID: s.policyID(), StartedAt: time.Now()}
+	defer func() {
+		result.DurationMs = time.Since(result.StartedAt).Milliseconds()
+		s.mu.Lock()
+		s.lastSync = result
+		s.mu.Unlock()
+	}()
+
+	err := s.sync(ctx, result)
+	if err != nil {
+		result.Error = err.Error()
+		return result, err
+	}
+	log.Printf("[资源CDN] 主题资源同步完成（%s）：共 %d 个，上传 %d 个，跳过 %d 个，失败 %d 个",
+		reason, result.Total, result.Uploaded, result.Skipped, len(result.Failed))
+	return result, nil
+}
+
+func (s *Service) sync(ctx context.Context, result *SyncResult) error {
+	if result.PolicyID == "" {
+		return fmt.Errorf("未设置资源 CDN 的存储策略，回源模式无需同步")
+	}
+	policy, err := s.policySvc.GetPolicyByID(ctx, result.PolicyID)
+	if err != nil {
+		return fmt.Errorf("获取存储策略失败: %w", err)
+	}
+	if policy.Type == constant.PolicyTypeLocal {
+		return fmt.Errorf("存储策略 %s 为本机存储，无法作为 CDN 源站", policy.Name)
+	}
+	provider, ok := s.providers[policy.Type]
+	if !ok {
+		return fmt.Errorf("不支持的存储类型: %s", policy.Type)
+	}
+
+	s.mu.Lock()
+	uploaded := make(map[string]string)
+	if s.manifest.PolicyID == result.PolicyID {
+		for url, hash := range s.manifest.Files {
+			uploaded[url] = hash
+		}
+	}
+	s.mu.Unlock()
+
+	err = s.source.Walk(func(url string, open func() (io.ReadCloser, error)) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result.Total++
+		hash, err := contentHash(open)
+		if err != nil {
+			result.fail(url, err)
+			return nil
+		}
+		if uploaded[url] == hash {
+			result.Skipped++
+			return nil
+		}
+		reader, err := open()
+		if err != nil {
+			result.fail(url, err)
+			return nil
+		}
+		_, err = provider.Upload(ctx, reader, policy, path.Join(policy.VirtualPath, RemoteDir, url))
+		reader.Close()
+		if err != nil {
+			result.fail(url, err)
+			return nil
+		}
+		uploaded[url] = hash
+		result.Uploaded++
+		return nil
+	})
+
+	// 已上传的资源即使本次同步中断也要记录，下次同步时跳过
+	s.mu.Lock()
+	s.manifest = &syncManifest{PolicyID: result.PolicyID, Files: uploaded}
+	saveErr := s.saveManifest()
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if saveErr != nil {
+		return fmt.Errorf("保存同步记录失败: %w", saveErr)
+	}
+	return nil
+}
+
+func (r *SyncResult) fail(url string, err error) {
+	if r.Failed == nil {
+		r.Failed = make(map[string]string)
+	}
+	r.Failed[url] = err.Error()
+}
+
+// Status 返回资源 CDN 的当前状态
+func (s *Service) Status() *Status {
+	status := &Status{Enabled: s.Enabled(), BaseURL: s.baseURL(), Mode: "pull"}
+	policyID := s.policyID()
+	if policyID != "" {
+		status.Mode = "push"
+	}
+	hashes := s.versions()
+	status.Assets = len(hashes)
+
+	if s.syncMu.TryLock() {
+		s.syncMu.Unlock()
+	} else {
+		status.Running = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status.LastSync = s.lastSync
+	if policyID != "" && s.manifest.PolicyID == policyID {
+		for url, hash := range hashes {
+			if s.manifest.Files[url] == hash {
+				status.Synced++
+			}
+		}
+	}
+	return status
+}
+
+func (s *Service) loadManifest() {
+	data, err := os.ReadFile(filepath.Join(s.dir, manifestFileName))
+	if err != nil {
+		return
+	}
+	var manifest syncManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		log.Printf("[资源CDN] 读取同步记录失败: %v", err)
+		return
+	}
+	if manifest.Files == nil {
+		manifest.Files = map[string]string{}
+	}
+	s.manifest = &manifest
+}
+
+// saveManifest 保存同步记录，调用方需持有 s.mu
+func (s *Service) saveManifest() error {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(s.manifest)
+	if err != nil {
+		return err
+	}
+	target := filepath.Join(s.dir, manifestFileName)
+	tmp := target + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, target)
+}
+
+func contentHash(open func() (io.ReadCloser, error)) (string, error) {
+	reader, err := open()
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, reader); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil))[:hashLength], nil
+}
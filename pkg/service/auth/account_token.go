@@ -0,0 +1,76 @@
+/*
+ * @Description: 激活和重置密码链接的签名令牌与发送频率限制
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 签名令牌按用途区分，激活链接不能用于重置密码。重置密码的令牌还绑定了当前的密码哈希，
+ * 密码一旦修改，之前发出的重置链接全部失效，做到无状态的一次性使用。
+ * 发送邮件按邮箱限制频率：同一邮箱两次发送至少间隔一分钟，每小时最多若干次。
+ */
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// activationTokenTTL 激活链接的有效期
+	activationTokenTTL = 24 * time.Hour
+	// resetTokenTTL 重置密码链接的有效期
+	resetTokenTTL = time.Hour
+
+	// mailCooldown 同一邮箱两次发送之间的最短间隔
+	mailCooldown = time.Minute
+	// mailHourlyLimit 同一邮箱每小时最多发送的次数
+	mailHourlyLimit = 5
+
+	mailPurposeActivate = "activate"
+	mailPurposeReset    = "reset"
+
+	cacheKeyMailCooldown = "auth:mail:cooldown:"
+	cacheKeyMailCount    = "auth:mail:count:"
+)
+
+// ErrMailTooFrequent 邮件发送过于频繁
+var ErrMailTooFrequent = errors.New("邮件发送过于频繁，请稍后再试")
+
+// activationIdentifier 激活令牌签名的内容
+func activationIdentifier(publicUserID string) string {
+	return mailPurposeActivate + ":" + publicUserID
+}
+
+// resetIdentifier 重置密码令牌签名的内容，包含当前密码哈希的摘要
+func resetIdentifier(publicUserID, passwordHash string) string {
+	sum := sha256.Sum256([]byte(passwordHash))
+	return mailPurposeReset + ":" + publicUserID + ":" + hex.EncodeToString(sum[:8])
+}
+
+// allowMail 检查并记录一次邮件发送，超过频率限制时返回 ErrMailTooFrequent。
+// 不论邮箱是否已注册都会计数，避免通过限制行为判断邮箱是否存在
+func (s *authService) allowMail(ctx context.Context, purpose, email string) error {
+	email = strings.ToLower(strings.TrimSpace(email))
+	cooldownKey := cacheKeyMailCooldown + purpose + ":" + email
+	if val, err := s.cacheSvc.Get(ctx, cooldownKey); err == nil && val != "" {
+		return ErrMailTooFrequent
+	}
+
+	countKey := cacheKeyMailCount + purpose + ":" + email
+	count, err := s.cacheSvc.Increment(ctx, countKey)
+	if err != nil {
+		// 缓存不可用时不阻止发送
+		return nil
+	}
+	if count == 1 {
+		s.cacheSvc.Expire(ctx, countKey, time.Hour)
+	}
+	if count > mailHourlyLimit {
+		return ErrMailTooFrequent
+	}
+	s.cacheSvc.Set(ctx, cooldownKey, "1", mailCooldown)
+	return nil
+}
@@ -10,6 +10,7 @@ package auth
 import (
 	"context"
 	"crypto/md5"
+	"crypto/rand"
 	"database/sql"
 	"encoding/hex"
 	"fmt"
@@ -33,8 +34,12 @@ import (
 type AuthService interface {
 	Login(ctx context.Context, email, password string) (*model.User, error)
 	Register(ctx context.Context, email, nickname, password string) (activationRequired bool, err error)
+	// RegisterVerified 为第三方登录验证过邮箱的用户创建已激活的账号
+	RegisterVerified(ctx context.Context, email, nickname string) (*model.User, error)
 	// ActivateUser 现在接收内部数据库 ID (uint)
 	ActivateUser(ctx context.Context, userID uint, sign string) error
+	// ResendActivation 重新发送激活邮件，邮箱未注册或已激活时静默返回
+	ResendActivation(ctx context.Context, email string) error
 	RequestPasswordReset(ctx context.Context, email string) error
 	// PerformPasswordReset 现在接收内部数据库 ID (uint)
 	PerformPasswordReset(ctx context.Context, userID uint, sign, newPassword string) error
@@ -49,6 +54,7 @@ type authService struct {
 	settingSvc setting.SettingService
 	tokenSvc   TokenService
 	emailSvc   utility.EmailService
+	cacheSvc   utility.CacheService
 	txManager  repository.TransactionManager
 	articleSvc articleSvc.Service
 }
@@ -59,6 +65,7 @@ func NewAuthService(
 	settingSvc setting.SettingService,
 	tokenSvc TokenService,
 	emailSvc utility.EmailService,
+	cacheSvc utility.CacheService,
 	txManager repository.TransactionManager,
 	articleSvc articleSvc.Service,
 ) AuthService {
@@ -67,6 +74,7 @@ func NewAuthService(
 		settingSvc: settingSvc,
 		tokenSvc:   tokenSvc,
 		emailSvc:   emailSvc,
+		cacheSvc:   cacheSvc,
 		txManager:  txManager,
 		articleSvc: articleSvc,
 	}
@@ -164,26 +172,68 @@ func (s *authService) Login(ctx context.Context, email, password string) (*model
 // Register 实现了最终的用户注册逻辑
 // 它会为新用户创建根目录，并在首次注册时初始化系统内置的存储策略及其关联的虚拟目录。
 func (s *authService) Register(ctx context.Context, email, nickname, password string) (bool, error) {
+	activationEnabled := s.settingSvc.Get(constant.KeyEnableUserActivation.String()) == "true"
+	newUser, err := s.createUser(ctx, email, nickname, password, activationEnabled)
+	if err != nil {
+		return false, err
+	}
+
+	// 事务成功后，发送激活邮件
+	if activationEnabled {
+		// 计入发送频率，避免注册后立即重复请求激活邮件
+		s.allowMail(ctx, mailPurposeActivate, newUser.Email)
+		if err := s.sendActivationEmail(newUser); err != nil {
+			return false, fmt.Errorf("用户已创建，但%w", err)
+		}
+	}
+
+	return activationEnabled, nil
+}
+
+// sendActivationEmail 生成激活令牌并发送激活邮件
+func (s *authService) sendActivationEmail(user *model.User) error {
+	publicUserID, err := idgen.GeneratePublicID(user.ID, idgen.EntityTypeUser)
+	if err != nil {
+		return fmt.Errorf("生成激活邮件公共ID失败: %w", err)
+	}
+	sign, err := s.tokenSvc.GenerateSignedToken(activationIdentifier(publicUserID), activationTokenTTL)
+	if err != nil {
+		return fmt.Errorf("生成激活令牌失败: %w", err)
+	}
+	go s.emailSvc.SendActivationEmail(context.Background(), user.Email, user.Nickname, publicUserID, sign)
+	return nil
+}
+
+// RegisterVerified 为已由第三方登录验证过邮箱的用户创建账号，账号直接处于激活状态，不需要密码登录
+func (s *authService) RegisterVerified(ctx context.Context, email, nickname string) (*model.User, error) {
+	randomPassword := make([]byte, 24)
+	if _, err := rand.Read(randomPassword); err != nil {
+		return nil, err
+	}
+	return s.createUser(ctx, email, nickname, hex.EncodeToString(randomPassword), false)
+}
+
+// createUser 在单个事务中创建用户及其根目录，第一个用户会成为管理员并初始化内置存储策略
+func (s *authService) createUser(ctx context.Context, email, nickname, password string, inactive bool) (*model.User, error) {
 	// email转为小写
 	email = strings.ToLower(strings.TrimSpace(email))
 	// nickname去除首尾空格
 	nickname = strings.TrimSpace(nickname)
 
 	if existing, err := s.userRepo.FindByEmail(ctx, email); err != nil {
-		return false, fmt.Errorf("查询邮箱时数据库出错: %w", err)
+		return nil, fmt.Errorf("查询邮箱时数据库出错: %w", err)
 	} else if existing != nil {
-		return false, fmt.Errorf("该邮箱已被注册")
+		return nil, fmt.Errorf("该邮箱已被注册")
 	}
 	userCount, err := s.userRepo.Count(ctx)
 	if err != nil {
-		return false, fmt.Errorf("获取用户总数失败: %w", err)
+		return nil, fmt.Errorf("获取用户总数失败: %w", err)
 	}
 	isFirstUser := userCount == 0
 	assignedUserGroupID := uint(2)
 	if isFirstUser {
 		assignedUserGroupID = 1
 	}
-	activationEnabled := s.settingSvc.Get(constant.KeyEnableUserActivation.String()) == "true"
 	hashedPassword, _ := security.HashPassword(password)
 	// 如果昵称为空，则使用邮箱前缀作为默认昵称
 	if nickname == "" {
@@ -201,7 +251,7 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 		UserGroupID:  assignedUserGroupID,
 		Status:       model.UserStatusActive,
 	}
-	if activationEnabled {
+	if inactive {
 		newUser.Status = model.UserStatusInactive
 	}
 
@@ -365,7 +415,7 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 	})
 
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	// 异步为第一个用户（管理员）创建一篇默认文章
@@ -373,21 +423,7 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 		go s.createDefaultArticle(context.Background())
 	}
 
-	// --- 步骤4：事务成功后，发送激活邮件 ---
-	if activationEnabled {
-		publicUserID, err := idgen.GeneratePublicID(newUser.ID, idgen.EntityTypeUser)
-		if err != nil {
-			return false, fmt.Errorf("用户已创建，但生成激活邮件公共ID失败: %w", err)
-		}
-
-		sign, err := s.tokenSvc.GenerateSignedToken(publicUserID, 24*time.Hour)
-		if err != nil {
-			return false, fmt.Errorf("用户已创建，但生成激活令牌失败: %w", err)
-		}
-		go s.emailSvc.SendActivationEmail(context.Background(), newUser.Email, newUser.Nickname, publicUserID, sign)
-	}
-
-	return activationEnabled, nil
+	return newUser, nil
 }
 
 // ActivateUser 实现了激活用户的业务逻辑
@@ -399,7 +435,7 @@ func (s *authService) ActivateUser(ctx context.Context, userID uint, sign string
 		return fmt.Errorf("无法为激活验证生成公共用户ID: %w", err)
 	}
 
-	if err := s.tokenSvc.VerifySignedToken(publicUserID, sign); err != nil {
+	if err := s.tokenSvc.VerifySignedToken(activationIdentifier(publicUserID), sign); err != nil {
 		return fmt.Errorf("链接无效或已过期: %w", err)
 	}
 
@@ -416,8 +452,29 @@ func (s *authService) ActivateUser(ctx context.Context, userID uint, sign string
 	return s.userRepo.Update(ctx, user)
 }
 
+// ResendActivation 重新发送激活邮件
+func (s *authService) ResendActivation(ctx context.Context, email string) error {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if err := s.allowMail(ctx, mailPurposeActivate, email); err != nil {
+		return err
+	}
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		log.Printf("重新发送激活邮件时查询用户失败: %v", err)
+		return nil // 故意不返回错误，防止邮箱枚举攻击
+	}
+	if user == nil || user.Status != model.UserStatusInactive {
+		return nil
+	}
+	return s.sendActivationEmail(user)
+}
+
 // RequestPasswordReset 实现了请求重置密码的业务逻辑
 func (s *authService) RequestPasswordReset(ctx context.Context, email string) error {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if err := s.allowMail(ctx, mailPurposeReset, email); err != nil {
+		return err
+	}
 	user, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
 		fmt.Printf("请求重置密码时查询用户失败: %v\n", err)
@@ -433,7 +490,8 @@ func (s *authService) RequestPasswordReset(ctx context.Context, email string) er
 		return fmt.Errorf("生成重置密码邮件公共ID失败: %w", err)
 	}
 
-	sign, err := s.tokenSvc.GenerateSignedToken(publicUserID, 1*time.Hour) // 令牌使用公共 ID
+	// 令牌绑定当前密码，重置成功后链接即失效
+	sign, err := s.tokenSvc.GenerateSignedToken(resetIdentifier(publicUserID, user.PasswordHash), resetTokenTTL)
 	if err != nil {
 		return fmt.Errorf("生成重置令牌失败: %w", err)
 	}
@@ -451,9 +509,6 @@ func (s *authService) PerformPasswordReset(ctx context.Context, userID uint, sig
 		return fmt.Errorf("无法为重置密码验证生成公共用户ID: %w", err)
 	}
 
-	if err := s.tokenSvc.VerifySignedToken(publicUserID, sign); err != nil {
-		return fmt.Errorf("链接无效或已过期: %w", err)
-	}
 	// 使用 FindByID 通过内部数据库 ID 查询用户
 	user, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
@@ -462,11 +517,21 @@ func (s *authService) PerformPasswordReset(ctx context.Context, userID uint, sig
 	if user == nil {
 		return fmt.Errorf("用户不存在")
 	}
+	if err := s.tokenSvc.VerifySignedToken(resetIdentifier(publicUserID, user.PasswordHash), sign); err != nil {
+		return fmt.Errorf("链接无效或已过期: %w", err)
+	}
 
 	newHashedPassword, _ := security.HashPassword(newPassword)
 	user.PasswordHash = newHashedPassword
 
-	return s.userRepo.Update(ctx, user)
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+	// 重置密码后退出所有设备上的登录
+	if _, err := s.tokenSvc.RevokeUserSessions(ctx, userID, ""); err != nil {
+		log.Printf("[重置密码] 退出用户 %d 全部设备的登录失败: %v", userID, err)
+	}
+	return nil
 }
 
 // CheckEmailExists 实现了检查邮箱是否存在的业务逻辑
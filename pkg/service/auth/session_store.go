@@ -0,0 +1,343 @@
+/*
+ * @Description: 服务端登录会话，支持刷新令牌轮换、设备列表和远程退出
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 刷新令牌不再是长期有效的 JWT，而是 "会话ID.随机密钥" 形式的不透明字符串，
+ * 服务端只保存密钥的哈希。每次刷新都会签发新的密钥并作废旧密钥；
+ * 已作废的密钥再次出现说明令牌可能已泄露，此时直接吊销整个会话。
+ * 会话保存在数据库中，多实例部署时共享；旧版本的 data/auth_sessions.json 会在启动时导入一次。
+ */
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/authsession"
+)
+
+const (
+	// LegacySessionStorePath 旧版本保存登录会话的文件，启动时导入数据库后重命名
+	LegacySessionStorePath = "data/auth_sessions.json"
+
+	// sessionTTL 未勾选"记住我"时会话的有效期，每次刷新后顺延
+	sessionTTL = 24 * time.Hour
+	// rememberSessionTTL 勾选"记住我"时会话的有效期，每次刷新后顺延
+	rememberSessionTTL = 30 * 24 * time.Hour
+	// rotationGrace 轮换后的短时间内旧令牌再次出现视为多个标签页同时刷新，只拒绝不吊销
+	rotationGrace = 30 * time.Second
+	// maxSessionsPerUser 每个用户保留的会话数，超出时淘汰最久未使用的会话
+	maxSessionsPerUser = 20
+)
+
+var (
+	ErrSessionNotFound = errors.New("会话不存在或已失效")
+	ErrTokenReused     = errors.New("刷新令牌已被使用，会话已吊销，请重新登录")
+	ErrTokenRotated    = errors.New("刷新令牌已轮换，请使用最新的令牌")
+)
+
+// SessionOptions 创建会话时的客户端信息
+type SessionOptions struct {
+	RememberMe bool
+	IP         string
+	Device     string
+}
+
+// Session 一个登录会话，对应一台设备上的一次登录
+type Session struct {
+	ID         string    `json:"id"`
+	UserID     uint      `json:"-"`
+	Device     string    `json:"device"`
+	IP         string    `json:"ip"`
+	RememberMe bool      `json:"remember_me"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"` // 是否为发起请求的会话，仅列表接口填充
+}
+
+// legacySession 旧版本 data/auth_sessions.json 中的会话记录
+type legacySession struct {
+	Session
+	UserID        uint      `json:"user_id"`
+	TokenHash     string    `json:"token_hash"`
+	PrevTokenHash string    `json:"prev_token_hash,omitempty"`
+	RotatedAt     time.Time `json:"rotated_at,omitempty"`
+}
+
+// SessionStore 登录会话存储
+type SessionStore struct {
+	db *ent.Client
+}
+
+// NewSessionStore 创建登录会话存储，legacyPath 不为空时导入旧版本保存在文件中的会话
+func NewSessionStore(db *ent.Client, legacyPath string) *SessionStore {
+	s := &SessionStore{db: db}
+	if legacyPath != "" {
+		if err := s.importLegacy(context.Background(), legacyPath); err != nil {
+			log.Printf("[会话] 导入旧版登录会话失败: %v", err)
+		}
+	}
+	return s
+}
+
+// Create 为用户创建会话，返回会话和对应的刷新令牌
+func (s *SessionStore) Create(ctx context.Context, userID uint, opts SessionOptions) (*Session, string, error) {
+	id, err := randomString(16)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomString(32)
+	if err != nil {
+		return nil, "", err
+	}
+
+	row, err := s.db.AuthSession.Create().
+		SetID(id).
+		SetUserID(userID).
+		SetDevice(opts.Device).
+		SetIP(opts.IP).
+		SetRememberMe(opts.RememberMe).
+		SetTokenHash(hashSecret(secret)).
+		SetExpiresAt(time.Now().Add(sessionLifetime(opts.RememberMe))).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	s.evict(ctx, userID)
+	return toSession(row), id + "." + secret, nil
+}
+
+// Rotate 校验刷新令牌并签发新令牌，旧令牌随即作废
+func (s *SessionStore) Rotate(ctx context.Context, refreshToken, ip string) (*Session, string, error) {
+	id, secret, ok := strings.Cut(refreshToken, ".")
+	if !ok || id == "" || secret == "" {
+		return nil, "", ErrSessionNotFound
+	}
+
+	row, err := s.db.AuthSession.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, "", ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if time.Now().After(row.ExpiresAt) {
+		return nil, "", ErrSessionNotFound
+	}
+	hash := hashSecret(secret)
+	if !hashEqual(hash, row.TokenHash) {
+		if row.PrevTokenHash != "" && hashEqual(hash, row.PrevTokenHash) {
+			if row.RotatedAt != nil && time.Since(*row.RotatedAt) < rotationGrace {
+				return nil, "", ErrTokenRotated
+			}
+			log.Printf("[会话] 会话 %s 的旧刷新令牌被重复使用（IP: %s），已吊销该会话", id, ip)
+			if err := s.db.AuthSession.DeleteOneID(id).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+				return nil, "", err
+			}
+			return nil, "", ErrTokenReused
+		}
+		return nil, "", ErrSessionNotFound
+	}
+
+	newSecret, err := randomString(32)
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now()
+	update := s.db.AuthSession.Update().
+		// 以当前令牌哈希为条件更新，并发的刷新请求只有一个能完成轮换
+		Where(authsession.ID(id), authsession.TokenHash(row.TokenHash)).
+		SetPrevTokenHash(row.TokenHash).
+		SetTokenHash(hashSecret(newSecret)).
+		SetRotatedAt(now).
+		SetLastUsedAt(now).
+		SetExpiresAt(now.Add(sessionLifetime(row.RememberMe)))
+	if ip != "" {
+		update = update.SetIP(ip)
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if n == 0 {
+		return nil, "", ErrTokenRotated
+	}
+
+	sess := toSession(row)
+	sess.LastUsedAt = now
+	sess.ExpiresAt = now.Add(sessionLifetime(row.RememberMe))
+	if ip != "" {
+		sess.IP = ip
+	}
+	return sess, id + "." + newSecret, nil
+}
+
+// Active 会话是否存在且未过期，每个需要登录的请求都会调用，按主键查询
+func (s *SessionStore) Active(ctx context.Context, id string) (bool, error) {
+	return s.db.AuthSession.Query().
+		Where(authsession.ID(id), authsession.ExpiresAtGT(time.Now())).
+		Exist(ctx)
+}
+
+// List 按最近使用时间从新到旧返回用户的有效会话
+func (s *SessionStore) List(ctx context.Context, userID uint) ([]*Session, error) {
+	rows, err := s.db.AuthSession.Query().
+		Where(authsession.UserID(userID), authsession.ExpiresAtGT(time.Now())).
+		Order(ent.Desc(authsession.FieldLastUsedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Session, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toSession(row))
+	}
+	return result, nil
+}
+
+// Revoke 吊销用户的指定会话
+func (s *SessionStore) Revoke(ctx context.Context, userID uint, id string) error {
+	n, err := s.db.AuthSession.Delete().
+		Where(authsession.ID(id), authsession.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrSessionNotFound
+	}
+	return nil
+}
+
+// RevokeAll 吊销用户除 exceptID 以外的全部会话，返回吊销的数量
+func (s *SessionStore) RevokeAll(ctx context.Context, userID uint, exceptID string) (int, error) {
+	del := s.db.AuthSession.Delete().Where(authsession.UserID(userID))
+	if exceptID != "" {
+		del = del.Where(authsession.IDNEQ(exceptID))
+	}
+	return del.Exec(ctx)
+}
+
+// evict 清理过期会话，并在用户会话数超限时淘汰最久未使用的会话
+func (s *SessionStore) evict(ctx context.Context, userID uint) {
+	if _, err := s.db.AuthSession.Delete().Where(authsession.ExpiresAtLT(time.Now())).Exec(ctx); err != nil {
+		log.Printf("[会话] 清理过期会话失败: %v", err)
+	}
+	stale, err := s.db.AuthSession.Query().
+		Where(authsession.UserID(userID)).
+		Order(ent.Desc(authsession.FieldLastUsedAt)).
+		Offset(maxSessionsPerUser).
+		IDs(ctx)
+	if err == nil && len(stale) > 0 {
+		_, err = s.db.AuthSession.Delete().Where(authsession.IDIn(stale...)).Exec(ctx)
+	}
+	if err != nil {
+		log.Printf("[会话] 淘汰用户 %d 的旧会话失败: %v", userID, err)
+	}
+}
+
+// importLegacy 将旧版本 data/auth_sessions.json 中未过期的会话导入数据库，成功后重命名文件避免重复导入
+func (s *SessionStore) importLegacy(ctx context.Context, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var stored []legacySession
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+
+	imported := 0
+	now := time.Now()
+	for _, st := range stored {
+		if now.After(st.ExpiresAt) || st.ID == "" || st.TokenHash == "" {
+			continue
+		}
+		if _, err := s.db.User.Get(ctx, st.UserID); err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		create := s.db.AuthSession.Create().
+			SetID(st.ID).
+			SetUserID(st.UserID).
+			SetDevice(st.Device).
+			SetIP(st.IP).
+			SetRememberMe(st.RememberMe).
+			SetTokenHash(st.TokenHash).
+			SetPrevTokenHash(st.PrevTokenHash).
+			SetCreatedAt(st.CreatedAt).
+			SetLastUsedAt(st.LastUsedAt).
+			SetExpiresAt(st.ExpiresAt)
+		if !st.RotatedAt.IsZero() {
+			create.SetRotatedAt(st.RotatedAt)
+		}
+		if err := create.Exec(ctx); err != nil {
+			if ent.IsConstraintError(err) {
+				continue
+			}
+			return fmt.Errorf("导入会话 %s 失败: %w", st.ID, err)
+		}
+		imported++
+	}
+	if err := os.Rename(path, path+".imported"); err != nil {
+		return err
+	}
+	log.Printf("[会话] 已从 %s 导入 %d 个登录会话", path, imported)
+	return nil
+}
+
+func toSession(row *ent.AuthSession) *Session {
+	return &Session{
+		ID:         row.ID,
+		UserID:     row.UserID,
+		Device:     row.Device,
+		IP:         row.IP,
+		RememberMe: row.RememberMe,
+		CreatedAt:  row.CreatedAt,
+		LastUsedAt: row.LastUsedAt,
+		ExpiresAt:  row.ExpiresAt,
+	}
+}
+
+func sessionLifetime(rememberMe bool) time.Duration {
+	if rememberMe {
+		return rememberSessionTTL
+	}
+	return sessionTTL
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func hashEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
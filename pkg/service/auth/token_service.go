@@ -14,17 +14,24 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
-	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 )
 
 type TokenService interface {
-	GenerateSessionTokens(ctx context.Context, user *model.User) (accessToken, refreshToken string, expiresAt int64, err error)
-	RefreshAccessToken(ctx context.Context, refreshToken string) (accessToken string, expiresAt int64, err error)
+	GenerateSessionTokens(ctx context.Context, user *model.User, opts SessionOptions) (accessToken, refreshToken string, expiresAt int64, err error)
+	// RefreshAccessToken 使用刷新令牌换取新的访问令牌，刷新令牌同时轮换，旧令牌作废
+	RefreshAccessToken(ctx context.Context, refreshToken, ip string) (accessToken, newRefreshToken string, expiresAt int64, err error)
 	GenerateSignedToken(identifier string, duration time.Duration) (string, error)
 	VerifySignedToken(identifier, sign string) error
 	ParseAccessToken(ctx context.Context, accessToken string) (*auth.CustomClaims, error)
+
+	// ListSessions 返回用户的有效登录会话
+	ListSessions(ctx context.Context, userID uint) ([]*Session, error)
+	// RevokeSession 吊销用户的指定会话，该会话的访问令牌和刷新令牌立即失效
+	RevokeSession(ctx context.Context, userID uint, sessionID string) error
+	// RevokeUserSessions 吊销用户除 exceptSessionID 以外的全部会话，返回吊销的数量
+	RevokeUserSessions(ctx context.Context, userID uint, exceptSessionID string) (int, error)
 }
 
 // tokenService 结构体增加了 cacheSvc 依赖
@@ -32,6 +39,7 @@ type tokenService struct {
 	userRepo   repository.UserRepository
 	settingSvc setting.SettingService
 	cacheSvc   utility.CacheService
+	sessions   *SessionStore
 }
 
 // NewTokenService 构造函数
@@ -39,77 +47,89 @@ func NewTokenService(
 	userRepo repository.UserRepository,
 	settingSvc setting.SettingService,
 	cacheSvc utility.CacheService,
+	sessions *SessionStore,
 ) TokenService {
 	return &tokenService{
 		userRepo:   userRepo,
 		settingSvc: settingSvc,
 		cacheSvc:   cacheSvc,
+		sessions:   sessions,
 	}
 }
 
 // --- JWT 会话令牌实现 ---
 
-func (s *tokenService) GenerateSessionTokens(ctx context.Context, user *model.User) (string, string, int64, error) {
+func (s *tokenService) GenerateSessionTokens(ctx context.Context, user *model.User, opts SessionOptions) (string, string, int64, error) {
 	// 动态地从 SettingService 获取密钥
 	jwtSecret := s.settingSvc.Get(constant.KeyJWTSecret.String())
 	if jwtSecret == "" {
 		return "", "", 0, fmt.Errorf("JWT_SECRET 未从数据库加载, 无法生成令牌")
 	}
 
-	// auth.GenerateToken 和 auth.GenerateRefreshToken 现在接收内部 uint ID，并在内部生成公共 ID
-	accessToken, err := auth.GenerateToken(user.ID, []byte(user.UserGroup.Permissions), user.UserGroup.ID, []byte(jwtSecret))
-	if err != nil {
-		return "", "", 0, err
-	}
-	refreshToken, err := auth.GenerateRefreshToken(user.ID, []byte(jwtSecret))
+	// 刷新令牌由服务端会话签发，可随时吊销
+	session, refreshToken, err := s.sessions.Create(ctx, user.ID, opts)
 	if err != nil {
-		return "", "", 0, err
+		return "", "", 0, fmt.Errorf("创建登录会话失败: %w", err)
 	}
 
-	claims, err := auth.ParseToken(accessToken, []byte(jwtSecret))
+	accessToken, expiresAt, err := s.issueAccessToken(user, session.ID, jwtSecret)
 	if err != nil {
 		return "", "", 0, err
 	}
-	expiresAt := claims.ExpiresAt.Time.UnixMilli()
-
 	return accessToken, refreshToken, expiresAt, nil
 }
 
-func (s *tokenService) RefreshAccessToken(ctx context.Context, refreshToken string) (string, int64, error) {
+func (s *tokenService) RefreshAccessToken(ctx context.Context, refreshToken, ip string) (string, string, int64, error) {
 	jwtSecret := s.settingSvc.Get(constant.KeyJWTSecret.String())
 	if jwtSecret == "" {
-		return "", 0, fmt.Errorf("JWT_SECRET 未从数据库加载, 无法刷新令牌")
+		return "", "", 0, fmt.Errorf("JWT_SECRET 未从数据库加载, 无法刷新令牌")
 	}
 
-	claims, err := auth.ParseToken(refreshToken, []byte(jwtSecret))
+	// 1. 校验并轮换刷新令牌
+	session, newRefreshToken, err := s.sessions.Rotate(ctx, refreshToken, ip)
 	if err != nil {
-		return "", 0, fmt.Errorf("无效或过期的刷新令牌: %w", err)
+		return "", "", 0, fmt.Errorf("无效或过期的刷新令牌: %w", err)
 	}
 
-	// 1. claims.UserID 包含公共用户 ID，需要将其解码为内部数据库 ID，并验证类型
-	internalUserID, entityType, err := idgen.DecodePublicID(claims.UserID) // 统一使用 DecodePublicID
-	if err != nil {
-		return "", 0, fmt.Errorf("解码公共用户ID失败: %w", err)
-	}
-	if entityType != idgen.EntityTypeUser {
-		return "", 0, fmt.Errorf("令牌中的用户ID类型不匹配")
+	// 2. 查询用户，被禁用的用户同时吊销其会话
+	user, err := s.userRepo.FindByID(ctx, session.UserID)
+	if err != nil || user == nil || user.Status != model.UserStatusActive {
+		s.sessions.Revoke(ctx, session.UserID, session.ID)
+		return "", "", 0, fmt.Errorf("用户不存在或状态异常")
 	}
 
-	// 2. 使用内部数据库 ID 查询用户
-	user, err := s.userRepo.FindByID(ctx, internalUserID)
-	if err != nil || user == nil || user.Status != model.UserStatusActive {
-		return "", 0, fmt.Errorf("用户不存在或状态异常")
+	// 3. 重新生成 Access Token
+	accessToken, expiresAt, err := s.issueAccessToken(user, session.ID, jwtSecret)
+	if err != nil {
+		return "", "", 0, err
 	}
+	return accessToken, newRefreshToken, expiresAt, nil
+}
 
-	// 3. 重新生成 Access Token，auth.GenerateToken 现在接收内部 uint ID
-	accessToken, err := auth.GenerateToken(user.ID, []byte(user.UserGroup.Permissions), user.UserGroup.ID, []byte(jwtSecret))
+// issueAccessToken 为会话签发访问令牌，返回令牌及其过期时间（毫秒时间戳）
+func (s *tokenService) issueAccessToken(user *model.User, sessionID, jwtSecret string) (string, int64, error) {
+	// auth.GenerateToken 接收内部 uint ID，并在内部生成公共 ID
+	accessToken, err := auth.GenerateToken(user.ID, []byte(user.UserGroup.Permissions), user.UserGroup.ID, sessionID, []byte(jwtSecret))
+	if err != nil {
+		return "", 0, err
+	}
+	claims, err := auth.ParseToken(accessToken, []byte(jwtSecret))
 	if err != nil {
 		return "", 0, err
 	}
+	return accessToken, claims.ExpiresAt.Time.UnixMilli(), nil
+}
+
+func (s *tokenService) ListSessions(ctx context.Context, userID uint) ([]*Session, error) {
+	return s.sessions.List(ctx, userID)
+}
+
+func (s *tokenService) RevokeSession(ctx context.Context, userID uint, sessionID string) error {
+	return s.sessions.Revoke(ctx, userID, sessionID)
+}
 
-	newClaims, _ := auth.ParseToken(accessToken, []byte(jwtSecret))
-	expiresAt := newClaims.ExpiresAt.Time.UnixMilli()
-	return accessToken, expiresAt, nil
+func (s *tokenService) RevokeUserSessions(ctx context.Context, userID uint, exceptSessionID string) (int, error) {
+	return s.sessions.RevokeAll(ctx, userID, exceptSessionID)
 }
 
 // GenerateSignedToken 生成一个新的签名令牌。identifier 预期是公共 ID。
@@ -176,5 +196,20 @@ func (s *tokenService) ParseAccessToken(ctx context.Context, accessToken string)
 		return nil, fmt.Errorf("JWT_SECRET 未配置，无法解析令牌")
 	}
 
-	return auth.ParseToken(accessToken, []byte(jwtSecret))
+	claims, err := auth.ParseToken(accessToken, []byte(jwtSecret))
+	if err != nil {
+		return nil, err
+	}
+	// 会话被吊销（远程退出、修改密码等）后，尚未过期的访问令牌也立即失效
+	if claims.SessionID == "" {
+		return nil, fmt.Errorf("登录会话已失效")
+	}
+	active, err := s.sessions.Active(ctx, claims.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("查询登录会话失败: %w", err)
+	}
+	if !active {
+		return nil, fmt.Errorf("登录会话已失效")
+	}
+	return claims, nil
 }
@@ -0,0 +1,124 @@
+/*
+ * @Description: 文章作者资料服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 作者资料以 JSON 数组保存在 author.profiles 配置项中，文章通过 extra_config.authors
+ * 记录共同作者的 slug，作者页按 slug 查询归档文章。
+ */
+package author
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// ErrAuthorNotFound 作者不存在
+var ErrAuthorNotFound = errors.New("作者不存在")
+
+// slugPattern 作者 slug 只允许小写字母、数字、短横线和下划线
+var slugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// Service 作者资料服务
+type Service struct {
+	settingSvc setting.SettingService
+}
+
+// NewService 创建作者资料服务
+func NewService(settingSvc setting.SettingService) *Service {
+	return &Service{settingSvc: settingSvc}
+}
+
+// List 返回全部作者资料
+func (s *Service) List() []model.AuthorProfile {
+	return LoadProfiles(s.settingSvc)
+}
+
+// Get 根据 slug 获取作者资料
+func (s *Service) Get(slug string) (*model.AuthorProfile, error) {
+	for _, profile := range s.List() {
+		if profile.Slug == slug {
+			return &profile, nil
+		}
+	}
+	return nil, ErrAuthorNotFound
+}
+
+// Save 校验并保存全部作者资料
+func (s *Service) Save(ctx context.Context, profiles []model.AuthorProfile) ([]model.AuthorProfile, error) {
+	seen := make(map[string]bool, len(profiles))
+	for i := range profiles {
+		p := &profiles[i]
+		p.Slug = strings.TrimSpace(p.Slug)
+		p.Name = strings.TrimSpace(p.Name)
+		if !slugPattern.MatchString(p.Slug) {
+			return nil, fmt.Errorf("作者标识 %q 不合法，只能包含小写字母、数字、短横线和下划线", p.Slug)
+		}
+		if p.Name == "" {
+			return nil, fmt.Errorf("作者 %s 的名称不能为空", p.Slug)
+		}
+		if seen[p.Slug] {
+			return nil, fmt.Errorf("作者标识 %s 重复", p.Slug)
+		}
+		seen[p.Slug] = true
+		p.UserID = strings.TrimSpace(p.UserID)
+		if p.UserID != "" {
+			if _, entityType, err := idgen.DecodePublicID(p.UserID); err != nil || entityType != idgen.EntityTypeUser {
+				return nil, fmt.Errorf("作者 %s 关联的用户ID %q 无效", p.Slug, p.UserID)
+			}
+		}
+	}
+
+	data, err := json.Marshal(profiles)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.settingSvc.UpdateSettings(ctx, map[string]string{constant.KeyAuthorProfiles.String(): string(data)}); err != nil {
+		return nil, fmt.Errorf("保存作者资料失败: %w", err)
+	}
+	return profiles, nil
+}
+
+// LoadProfiles 从配置中读取作者资料，配置为空或格式错误时返回空列表
+func LoadProfiles(settingSvc setting.SettingService) []model.AuthorProfile {
+	raw := settingSvc.Get(constant.KeyAuthorProfiles.String())
+	if raw == "" {
+		return []model.AuthorProfile{}
+	}
+	var profiles []model.AuthorProfile
+	if err := json.Unmarshal([]byte(raw), &profiles); err != nil {
+		log.Printf("[作者] 解析作者资料配置失败: %v", err)
+		return []model.AuthorProfile{}
+	}
+	return profiles
+}
+
+// ResolveProfiles 按 slug 顺序返回作者资料，未找到的 slug 会被忽略
+func ResolveProfiles(settingSvc setting.SettingService, slugs []string) []model.AuthorProfile {
+	if len(slugs) == 0 {
+		return nil
+	}
+	profiles := LoadProfiles(settingSvc)
+	bySlug := make(map[string]model.AuthorProfile, len(profiles))
+	for _, p := range profiles {
+		bySlug[p.Slug] = p
+	}
+
+	result := make([]model.AuthorProfile, 0, len(slugs))
+	for _, slug := range slugs {
+		if p, ok := bySlug[slug]; ok {
+			result = append(result, p)
+		}
+	}
+	return result
+}
@@ -0,0 +1,245 @@
+/*
+ * @Description: 评论者头像代理，按邮箱哈希从 Gravatar/Cravatar 拉取头像并缓存到本地
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package avatar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// DefaultCacheDir 头像缓存目录
+	DefaultCacheDir = "data/cache/avatars"
+
+	defaultSize       = 80
+	maxAvatarBytes    = 2 << 20
+	defaultCacheHours = 168
+
+	// maxCacheBytes 本地头像缓存的总大小上限，超出时删除最久未使用的头像
+	maxCacheBytes = 64 << 20
+)
+
+// sizeBuckets 可用的头像尺寸，请求的尺寸向上取整到其中之一，避免每个尺寸各占一份缓存
+var sizeBuckets = []int{40, 80, 160, 320, 512}
+
+var (
+	ErrInvalidHash     = errors.New("无效的头像哈希")
+	ErrProxyDisabled   = errors.New("头像代理未启用")
+	ErrUpstreamFailure = errors.New("获取头像失败")
+
+	// 兼容 Gravatar 的 MD5 与 SHA256 两种邮箱哈希
+	hashRegex = regexp.MustCompile(`^([a-f0-9]{32}|[a-f0-9]{64})$`)
+)
+
+// URL 返回邮箱哈希对应的头像地址：启用代理时指向本站，否则直接指向 Gravatar 服务器
+func URL(settingSvc setting.SettingService, hash string) string {
+	if settingSvc.GetBool(constant.KeyAvatarProxyEnable.String()) {
+		return "/api/avatar/" + hash
+	}
+	return upstreamURL(settingSvc, hash, 0)
+}
+
+func upstreamURL(settingSvc setting.SettingService, hash string, size int) string {
+	base := strings.TrimRight(settingSvc.Get(constant.KeyGravatarURL.String()), "/") + "/avatar/" + hash
+	query := url.Values{}
+	if d := settingSvc.Get(constant.KeyDefaultGravatarType.String()); d != "" {
+		query.Set("d", d)
+	}
+	if size > 0 {
+		query.Set("s", strconv.Itoa(size))
+	}
+	if len(query) == 0 {
+		return base
+	}
+	return base + "?" + query.Encode()
+}
+
+// Service 头像代理服务
+type Service struct {
+	settingSvc setting.SettingService
+	httpClient *http.Client
+	cacheDir   string
+
+	mu         sync.Mutex
+	entries    map[string]*cacheEntry // 缓存文件名到大小和最近使用时间，首次访问时从缓存目录加载
+	totalBytes int64
+}
+
+type cacheEntry struct {
+	size     int64
+	lastUsed time.Time
+}
+
+// NewService 创建头像代理服务
+func NewService(settingSvc setting.SettingService, cacheDir string) *Service {
+	return &Service{
+		settingSvc: settingSvc,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		cacheDir:   cacheDir,
+	}
+}
+
+// NormalizeSize 将请求的尺寸向上取整到可用尺寸，未指定时使用默认尺寸，超出时使用最大尺寸
+func NormalizeSize(size int) int {
+	if size <= 0 {
+		return defaultSize
+	}
+	for _, bucket := range sizeBuckets {
+		if size <= bucket {
+			return bucket
+		}
+	}
+	return sizeBuckets[len(sizeBuckets)-1]
+}
+
+// Get 返回头像图片数据。缓存未过期时直接读取本地文件，上游不可用时回退到过期缓存
+func (s *Service) Get(ctx context.Context, hash string, size int) ([]byte, error) {
+	if !s.settingSvc.GetBool(constant.KeyAvatarProxyEnable.String()) {
+		return nil, ErrProxyDisabled
+	}
+	hash = strings.ToLower(hash)
+	if !hashRegex.MatchString(hash) {
+		return nil, ErrInvalidHash
+	}
+	size = NormalizeSize(size)
+
+	name := fmt.Sprintf("%s_%d", hash, size)
+	cachePath := filepath.Join(s.cacheDir, name)
+	info, statErr := os.Stat(cachePath)
+	if statErr == nil && time.Since(info.ModTime()) < s.cacheTTL() {
+		if data, err := os.ReadFile(cachePath); err == nil {
+			s.touch(name)
+			return data, nil
+		}
+	}
+
+	data, err := s.fetch(ctx, upstreamURL(s.settingSvc, hash, size))
+	if err != nil {
+		if statErr == nil {
+			if stale, readErr := os.ReadFile(cachePath); readErr == nil {
+				s.touch(name)
+				return stale, nil
+			}
+		}
+		return nil, err
+	}
+
+	if err := os.MkdirAll(s.cacheDir, 0755); err == nil {
+		tmp := cachePath + ".tmp"
+		if err := os.WriteFile(tmp, data, 0644); err == nil {
+			if os.Rename(tmp, cachePath) == nil {
+				s.record(name, int64(len(data)))
+			}
+		}
+	}
+	return data, nil
+}
+
+// loadEntriesLocked 首次使用时从缓存目录加载已有的头像，以修改时间作为最近使用时间
+func (s *Service) loadEntriesLocked() {
+	if s.entries != nil {
+		return
+	}
+	s.entries = make(map[string]*cacheEntry)
+	dirEntries, err := os.ReadDir(s.cacheDir)
+	if err != nil {
+		return
+	}
+	for _, de := range dirEntries {
+		if de.IsDir() || strings.HasSuffix(de.Name(), ".tmp") {
+			continue
+		}
+		info, err := de.Info()
+		if err != nil {
+			continue
+		}
+		s.entries[de.Name()] = &cacheEntry{size: info.Size(), lastUsed: info.ModTime()}
+		s.totalBytes += info.Size()
+	}
+}
+
+// touch 更新缓存头像的最近使用时间
+func (s *Service) touch(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loadEntriesLocked()
+	if e, ok := s.entries[name]; ok {
+		e.lastUsed = time.Now()
+	}
+}
+
+// record 记录新写入的头像，缓存总大小超出上限时删除最久未使用的头像
+func (s *Service) record(name string, size int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loadEntriesLocked()
+	if old, ok := s.entries[name]; ok {
+		s.totalBytes -= old.size
+	}
+	s.entries[name] = &cacheEntry{size: size, lastUsed: time.Now()}
+	s.totalBytes += size
+
+	for s.totalBytes > maxCacheBytes && len(s.entries) > 1 {
+		var oldestName string
+		var oldest time.Time
+		for n, e := range s.entries {
+			if n != name && (oldestName == "" || e.lastUsed.Before(oldest)) {
+				oldestName, oldest = n, e.lastUsed
+			}
+		}
+		if err := os.Remove(filepath.Join(s.cacheDir, oldestName)); err != nil && !os.IsNotExist(err) {
+			break
+		}
+		s.totalBytes -= s.entries[oldestName].size
+		delete(s.entries, oldestName)
+	}
+}
+
+func (s *Service) cacheTTL() time.Duration {
+	hours, err := strconv.Atoi(s.settingSvc.Get(constant.KeyAvatarProxyCacheHours.String()))
+	if err != nil || hours <= 0 {
+		hours = defaultCacheHours
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+func (s *Service) fetch(ctx context.Context, avatarURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, avatarURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUpstreamFailure, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: HTTP %d", ErrUpstreamFailure, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxAvatarBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUpstreamFailure, err)
+	}
+	if len(data) > maxAvatarBytes || !strings.HasPrefix(http.DetectContentType(data), "image/") {
+		return nil, fmt.Errorf("%w: 返回内容不是有效的图片", ErrUpstreamFailure)
+	}
+	return data, nil
+}
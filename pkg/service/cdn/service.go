@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/accesslog"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 )
 
@@ -30,6 +31,8 @@ type CDNService interface {
 	PurgeByTags(ctx context.Context, tags []string) error
 	// PurgeArticleCache 清除文章相关的CDN缓存
 	PurgeArticleCache(ctx context.Context, articleID string) error
+	// PurgeAll 按站点根目录清除全部CDN缓存
+	PurgeAll(ctx context.Context) error
 }
 
 type serviceImpl struct {
@@ -42,7 +45,8 @@ func NewService(settingSvc setting.SettingService) CDNService {
 	return &serviceImpl{
 		settingSvc: settingSvc,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: accesslog.NewTracingTransport(nil),
 		},
 	}
 }
@@ -122,6 +126,37 @@ func (s *serviceImpl) PurgeByTags(ctx context.Context, tags []string) error {
 	}
 }
 
+// PurgeAll 按站点根目录清除全部CDN缓存
+func (s *serviceImpl) PurgeAll(ctx context.Context) error {
+	enabled, provider, _, _, _, _, _, _ := s.getConfig()
+	if !enabled {
+		log.Printf("[CDN] 缓存清除功能未启用，跳过全部清除操作")
+		return nil
+	}
+
+	siteURL := strings.TrimRight(s.settingSvc.Get(constant.KeySiteURL.String()), "/")
+	if siteURL == "" {
+		return fmt.Errorf("未配置站点地址，无法按目录清除CDN缓存")
+	}
+	dir := siteURL + "/"
+
+	log.Printf("[CDN] 开始清除站点全部缓存: %s", dir)
+
+	switch strings.ToLower(provider) {
+	case "tencent":
+		return s.purgeTencentPath(ctx, dir)
+	case "edgeone":
+		return s.purgeEdgeOnePrefix(ctx, dir)
+	case "aliyun-esa":
+		return s.purgeAliyunESA(ctx, "directory", []string{dir})
+	case "cdnfly":
+		return s.purgeCDNfly(ctx, "clean_dir", []string{dir})
+	default:
+		log.Printf("[CDN] 不支持的CDN提供商: %s", provider)
+		return nil
+	}
+}
+
 // PurgeArticleCache 清除文章相关的CDN缓存
 func (s *serviceImpl) PurgeArticleCache(ctx context.Context, articleID string) error {
 	enabled, _, _, _, _, _, _, _ := s.getConfig()
@@ -205,6 +240,48 @@ func (s *serviceImpl) purgeTencentCache(ctx context.Context, urls []string) erro
 	return nil
 }
 
+// purgeTencentPath 按目录清除腾讯云CDN缓存
+func (s *serviceImpl) purgeTencentPath(ctx context.Context, dir string) error {
+	_, _, secretID, secretKey, region, domain, _, _ := s.getConfig()
+	if secretID == "" || secretKey == "" || domain == "" {
+		log.Printf("[CDN] 腾讯云CDN配置不完整，跳过缓存清除")
+		return nil
+	}
+
+	params := map[string]interface{}{
+		"Paths":     []string{dir},
+		"FlushType": "flush", // 刷新目录下的全部资源
+	}
+	if err := s.callTencentCloudAPI(ctx, "cdn.tencentcloudapi.com", "cdn", "2018-06-06", "PurgePathCache", region, secretID, secretKey, params); err != nil {
+		return fmt.Errorf("腾讯云CDN目录缓存清除失败: %w", err)
+	}
+
+	log.Printf("[CDN] 腾讯云CDN目录缓存清除成功: %s", dir)
+	return nil
+}
+
+// purgeEdgeOnePrefix 按前缀清除EdgeOne缓存
+func (s *serviceImpl) purgeEdgeOnePrefix(ctx context.Context, prefix string) error {
+	_, _, secretID, secretKey, region, _, zoneID, _ := s.getConfig()
+	if secretID == "" || secretKey == "" || zoneID == "" {
+		log.Printf("[CDN] EdgeOne配置不完整，跳过缓存清除")
+		return nil
+	}
+
+	params := map[string]interface{}{
+		"ZoneId":  zoneID,
+		"Type":    "purge_prefix", // 按前缀清除
+		"Method":  "invalidate",
+		"Targets": []string{prefix},
+	}
+	if err := s.callTencentCloudAPI(ctx, "teo.tencentcloudapi.com", "teo", "2022-09-01", "CreatePurgeTasks", region, secretID, secretKey, params); err != nil {
+		return fmt.Errorf("EdgeOne前缀缓存清除失败: %w", err)
+	}
+
+	log.Printf("[CDN] EdgeOne前缀缓存清除成功: %s", prefix)
+	return nil
+}
+
 // purgeEdgeOneCache 清除EdgeOne缓存
 func (s *serviceImpl) purgeEdgeOneCache(ctx context.Context, urls []string) error {
 	_, _, secretID, secretKey, region, _, zoneID, _ := s.getConfig()
@@ -380,6 +457,11 @@ func (s *serviceImpl) hmacSha256(key []byte, data string) []byte {
 
 // purgeAliyunESACache 清除阿里云ESA缓存
 func (s *serviceImpl) purgeAliyunESACache(ctx context.Context, urls []string) error {
+	return s.purgeAliyunESA(ctx, "file", urls)
+}
+
+// purgeAliyunESA 按指定刷新类型清除阿里云ESA缓存
+func (s *serviceImpl) purgeAliyunESA(ctx context.Context, purgeType string, urls []string) error {
 	_, _, accessKeyID, accessKeySecret, _, _, siteID, _ := s.getConfig()
 	if accessKeyID == "" || accessKeySecret == "" || siteID == "" {
 		log.Printf("[CDN] 阿里云ESA配置不完整，跳过缓存清除")
@@ -395,7 +477,7 @@ func (s *serviceImpl) purgeAliyunESACache(ctx context.Context, urls []string) er
 	// Type: file-文件刷新, directory-目录刷新, hostheader-主机头刷新, ignoreParams-忽略参数刷新, cachetag-标签刷新, prefetch-预热
 	params := map[string]interface{}{
 		"SiteId":  siteID,
-		"Type":    "file",                   // 使用 file 类型进行 URL 文件刷新
+		"Type":    purgeType,                // file 为 URL 文件刷新，directory 为目录刷新
 		"Content": strings.Join(urls, "\n"), // 每个URL一行
 	}
 
@@ -550,6 +632,11 @@ func (s *serviceImpl) base64Encode(data []byte) string {
 
 // purgeCDNflyCache 清除 CDNFLY 缓存
 func (s *serviceImpl) purgeCDNflyCache(ctx context.Context, urls []string) error {
+	return s.purgeCDNfly(ctx, "clean_url", urls)
+}
+
+// purgeCDNfly 按指定任务类型清除CDNFLY缓存，clean_url 刷新 URL，clean_dir 刷新目录
+func (s *serviceImpl) purgeCDNfly(ctx context.Context, jobType string, urls []string) error {
 	_, _, secretID, secretKey, _, _, _, baseURL := s.getConfig()
 	if secretID == "" || secretKey == "" || baseURL == "" {
 		log.Printf("[CDN] CDNFLY配置不完整，跳过缓存清除")
@@ -560,7 +647,7 @@ func (s *serviceImpl) purgeCDNflyCache(ctx context.Context, urls []string) error
 	params := []map[string]interface{}{}
 	for _, url := range urls {
 		param := map[string]interface{}{
-			"type": "clean_url",
+			"type": jobType,
 			"data": map[string]string{
 				"url": url,
 			},
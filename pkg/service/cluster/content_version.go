@@ -0,0 +1,134 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/article"
+	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
+	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
+	entsetting "github.com/anzhiyu-c/anheyu-app/ent/setting"
+)
+
+// ArticleVersion 返回文章相关数据在数据库中的版本，各实例读到的版本一致，用作公开接口 ETag 的列表版本号。
+// 版本由文章、分类、标签的未删除数量、最近更新和删除时间以及配置表的最近更新时间组成，
+// 返回的时间为其中最新的一个
+func ArticleVersion(db *ent.Client) func(ctx context.Context) (string, time.Time, error) {
+	return func(ctx context.Context) (string, time.Time, error) {
+		var (
+			parts  []interface{}
+			latest time.Time
+		)
+		add := func(count int, times ...time.Time) {
+			parts = append(parts, count)
+			for _, t := range times {
+				if t.IsZero() {
+					parts = append(parts, 0)
+					continue
+				}
+				parts = append(parts, t.UnixNano())
+				if t.After(latest) {
+					latest = t
+				}
+			}
+		}
+
+		articles, articleUpdated, articleDeleted, err := articleState(ctx, db)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("查询文章版本失败: %w", err)
+		}
+		add(articles, articleUpdated, articleDeleted)
+
+		categories, categoryUpdated, categoryDeleted, err := categoryState(ctx, db)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("查询分类版本失败: %w", err)
+		}
+		add(categories, categoryUpdated, categoryDeleted)
+
+		tags, tagUpdated, tagDeleted, err := tagState(ctx, db)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("查询标签版本失败: %w", err)
+		}
+		add(tags, tagUpdated, tagDeleted)
+
+		setting, err := db.Setting.Query().
+			Order(ent.Desc(entsetting.FieldUpdatedAt)).
+			Select(entsetting.FieldUpdatedAt).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return "", time.Time{}, fmt.Errorf("查询配置版本失败: %w", err)
+		}
+		if setting != nil {
+			add(0, setting.UpdatedAt)
+		}
+		return fmt.Sprint(parts...), latest, nil
+	}
+}
+
+// articleState 未删除的文章数量、最近一次更新时间和最近一次移入回收站的时间
+func articleState(ctx context.Context, db *ent.Client) (count int, updated, deleted time.Time, err error) {
+	if count, err = db.Article.Query().Where(article.DeletedAtIsNil()).Count(ctx); err != nil {
+		return
+	}
+	u, err := db.Article.Query().Order(ent.Desc(article.FieldUpdatedAt)).Select(article.FieldUpdatedAt).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return
+	}
+	if u != nil {
+		updated = u.UpdatedAt
+	}
+	d, err := db.Article.Query().Where(article.DeletedAtNotNil()).Order(ent.Desc(article.FieldDeletedAt)).Select(article.FieldDeletedAt).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return
+	}
+	if d != nil && d.DeletedAt != nil {
+		deleted = *d.DeletedAt
+	}
+	return count, updated, deleted, nil
+}
+
+// categoryState 未删除的分类数量、最近一次更新时间和最近一次删除时间
+func categoryState(ctx context.Context, db *ent.Client) (count int, updated, deleted time.Time, err error) {
+	if count, err = db.PostCategory.Query().Where(postcategory.DeletedAtIsNil()).Count(ctx); err != nil {
+		return
+	}
+	u, err := db.PostCategory.Query().Order(ent.Desc(postcategory.FieldUpdatedAt)).Select(postcategory.FieldUpdatedAt).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return
+	}
+	if u != nil {
+		updated = u.UpdatedAt
+	}
+	d, err := db.PostCategory.Query().Where(postcategory.DeletedAtNotNil()).Order(ent.Desc(postcategory.FieldDeletedAt)).Select(postcategory.FieldDeletedAt).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return
+	}
+	if d != nil && d.DeletedAt != nil {
+		deleted = *d.DeletedAt
+	}
+	return count, updated, deleted, nil
+}
+
+// tagState 未删除的标签数量、最近一次更新时间和最近一次删除时间
+func tagState(ctx context.Context, db *ent.Client) (count int, updated, deleted time.Time, err error) {
+	if count, err = db.PostTag.Query().Where(posttag.DeletedAtIsNil()).Count(ctx); err != nil {
+		return
+	}
+	u, err := db.PostTag.Query().Order(ent.Desc(posttag.FieldUpdatedAt)).Select(posttag.FieldUpdatedAt).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return
+	}
+	if u != nil {
+		updated = u.UpdatedAt
+	}
+	d, err := db.PostTag.Query().Where(posttag.DeletedAtNotNil()).Order(ent.Desc(posttag.FieldDeletedAt)).Select(posttag.FieldDeletedAt).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return
+	}
+	if d != nil && d.DeletedAt != nil {
+		deleted = *d.DeletedAt
+	}
+	return count, updated, deleted, nil
+}
@@ -0,0 +1,347 @@
+/*
+ * @Description: 多实例部署的缓存失效同步
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 配置和当前主题缓存在各实例的内存中，一个实例保存配置或切换主题后，其他实例在重启前看不到变化。
+ * 同步器在本实例保存配置、切换主题时通知其他实例，其他实例收到后从数据库刷新配置，或在本地重新发布主题切换事件，
+ * 使订阅这些事件的缓存（页面缓存、资源清单、资源 CDN 等）随之失效。
+ * 配置了 Redis 时通过 Redis 发布订阅即时通知；没有 Redis 时可以轮询数据库中配置和主题记录的更新时间作为版本号，
+ * 各实例在一个轮询间隔内收敛。
+ */
+package cluster
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	entsetting "github.com/anzhiyu-c/anheyu-app/ent/setting"
+	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// 同步方式
+const (
+	ModeAuto  = "auto"  // 有可用的 Redis 时使用 redis，否则不同步
+	ModeRedis = "redis" // Redis 发布订阅
+	ModePoll  = "poll"  // 轮询数据库版本
+	ModeOff   = "off"
+)
+
+const (
+	// Channel Redis 发布订阅频道
+	Channel = "anheyu:cluster:invalidate"
+	// DefaultPollInterval poll 方式默认的轮询间隔
+	DefaultPollInterval = 5 * time.Second
+	// refreshDelay 收到配置变更通知后延迟刷新，合并一次保存产生的多条通知
+	refreshDelay = 300 * time.Millisecond
+	// officialTheme 没有当前主题记录时使用的官方主题名称，与 theme.OfficialThemeName 一致
+	officialTheme = "theme-anheyu"
+)
+
+// 消息类型
+const (
+	kindSetting = "setting"
+	kindTheme   = "theme"
+)
+
+// message 实例之间传递的失效通知
+type message struct {
+	Origin string `json:"origin"` // 发送实例，实例忽略自己发送的消息
+	Kind   string `json:"kind"`
+	Key    string `json:"key,omitempty"`   // 变化的配置键
+	Theme  string `json:"theme,omitempty"` // 切换后的主题名称
+}
+
+// Options 同步器配置
+type Options struct {
+	Mode         string
+	PollInterval time.Duration
+	Redis        *redis.Client // 为空或不可用时 auto 方式不同步，redis 方式退回 poll
+	DB           *ent.Client
+	Bus          *event.EventBus
+	Settings     setting.SettingService
+}
+
+// Syncer 多实例缓存失效同步器
+type Syncer struct {
+	mode         string
+	pollInterval time.Duration
+	redis        *redis.Client
+	db           *ent.Client
+	bus          *event.EventBus
+	settings     setting.SettingService
+	instanceID   string
+
+	mu           sync.Mutex
+	themeEchoes  map[string]int // 本实例因远程通知重新发布、尚未被自己的订阅者收到的主题切换事件
+	refreshTimer *time.Timer
+	lastSetting  time.Time // poll 方式下已处理的配置版本
+	lastTheme    string    // poll 方式下已知的当前主题
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewSyncer 创建同步器，根据配置和 Redis 可用性确定同步方式
+func NewSyncer(opts Options) *Syncer {
+	mode := strings.ToLower(strings.TrimSpace(opts.Mode))
+	if mode == "" {
+		mode = ModeAuto
+	}
+	redisOK := opts.Redis != nil && opts.Redis.Ping(context.Background()).Err() == nil
+	switch mode {
+	case ModeAuto:
+		mode = ModeOff
+		if redisOK {
+			mode = ModeRedis
+		}
+	case ModeRedis:
+		if !redisOK {
+			log.Println("[多实例同步] Redis 不可用，改为轮询数据库")
+			mode = ModePoll
+		}
+	case ModePoll, ModeOff:
+	default:
+		log.Printf("[多实例同步] 无法识别的同步方式 %q，不进行同步", opts.Mode)
+		mode = ModeOff
+	}
+
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	return &Syncer{
+		mode:         mode,
+		pollInterval: interval,
+		redis:        opts.Redis,
+		db:           opts.DB,
+		bus:          opts.Bus,
+		settings:     opts.Settings,
+		instanceID:   newInstanceID(),
+		themeEchoes:  make(map[string]int),
+	}
+}
+
+// Mode 实际使用的同步方式
+func (s *Syncer) Mode() string {
+	return s.mode
+}
+
+// Start 订阅本地事件并开始接收其他实例的通知
+func (s *Syncer) Start() {
+	if s.mode == ModeOff {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.bus.Subscribe(event.Topic(setting.TopicSettingUpdated), s.onLocalSetting)
+	s.bus.Subscribe(event.ThemeSwitched, s.onLocalTheme)
+
+	s.wg.Add(1)
+	switch s.mode {
+	case ModeRedis:
+		go s.runRedis(ctx)
+	case ModePoll:
+		s.lastSetting = s.settingVersion(ctx)
+		s.lastTheme = s.currentTheme(ctx)
+		go s.runPoll(ctx)
+	}
+	log.Printf("[多实例同步] 已启用，同步方式: %s，实例: %s", s.mode, s.instanceID)
+}
+
+// Stop 停止接收通知
+func (s *Syncer) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+}
+
+// onLocalSetting 本实例保存了配置，通知其他实例
+func (s *Syncer) onLocalSetting(payload interface{}) {
+	ev, ok := payload.(setting.SettingUpdatedEvent)
+	if !ok || ev.Remote {
+		return
+	}
+	s.publish(message{Kind: kindSetting, Key: ev.Key})
+}
+
+// onLocalTheme 本实例切换了主题，通知其他实例；因远程通知重新发布的事件不再转发
+func (s *Syncer) onLocalTheme(payload interface{}) {
+	themeName, _ := payload.(string)
+	s.mu.Lock()
+	if s.themeEchoes[themeName] > 0 {
+		s.themeEchoes[themeName]--
+		s.mu.Unlock()
+		return
+	}
+	if s.mode == ModePoll {
+		s.lastTheme = themeName
+	}
+	s.mu.Unlock()
+	s.publish(message{Kind: kindTheme, Theme: themeName})
+}
+
+// publish 向其他实例发送通知，poll 方式下其他实例从数据库读取变化，不需要发送
+func (s *Syncer) publish(msg message) {
+	if s.mode != ModeRedis {
+		return
+	}
+	msg.Origin = s.instanceID
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := s.redis.Publish(ctx, Channel, data).Err(); err != nil {
+		log.Printf("[多实例同步] 发送通知失败: %v", err)
+	}
+}
+
+func (s *Syncer) runRedis(ctx context.Context) {
+	defer s.wg.Done()
+	pubsub := s.redis.Subscribe(ctx, Channel)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case raw, ok := <-ch:
+			if !ok {
+				return
+			}
+			var msg message
+			if err := json.Unmarshal([]byte(raw.Payload), &msg); err != nil || msg.Origin == s.instanceID {
+				continue
+			}
+			s.handle(msg)
+		}
+	}
+}
+
+// handle 处理其他实例的通知
+func (s *Syncer) handle(msg message) {
+	switch msg.Kind {
+	case kindSetting:
+		s.scheduleRefresh()
+	case kindTheme:
+		s.republishTheme(msg.Theme)
+	}
+}
+
+// scheduleRefresh 延迟刷新配置，合并短时间内的多条通知
+func (s *Syncer) scheduleRefresh() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.refreshTimer != nil {
+		return
+	}
+	s.refreshTimer = time.AfterFunc(refreshDelay, func() {
+		s.mu.Lock()
+		s.refreshTimer = nil
+		s.mu.Unlock()
+		s.refreshSettings()
+	})
+}
+
+func (s *Syncer) refreshSettings() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := s.settings.Refresh(ctx); err != nil {
+		log.Printf("[多实例同步] 刷新配置失败: %v", err)
+	}
+}
+
+// republishTheme 在本地重新发布主题切换事件，使依赖当前主题的缓存失效
+func (s *Syncer) republishTheme(themeName string) {
+	s.mu.Lock()
+	s.themeEchoes[themeName]++
+	s.mu.Unlock()
+	log.Printf("[多实例同步] 其他实例已切换到主题 %s，刷新本实例的主题缓存", themeName)
+	s.bus.Publish(event.ThemeSwitched, themeName)
+}
+
+func (s *Syncer) runPoll(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.poll(ctx)
+		}
+	}
+}
+
+// poll 以配置表和主题记录的最新更新时间作为版本号，版本变化时刷新
+func (s *Syncer) poll(ctx context.Context) {
+	if version := s.settingVersion(ctx); version.After(s.lastSetting) {
+		s.lastSetting = version
+		s.refreshSettings()
+	}
+
+	current := s.currentTheme(ctx)
+	if current == "" {
+		return
+	}
+	s.mu.Lock()
+	changed := current != s.lastTheme
+	s.lastTheme = current
+	s.mu.Unlock()
+	if changed {
+		s.republishTheme(current)
+	}
+}
+
+// settingVersion 配置表中最近一次修改的时间
+func (s *Syncer) settingVersion(ctx context.Context) time.Time {
+	latest, err := s.db.Setting.Query().
+		Order(ent.Desc(entsetting.FieldUpdatedAt)).
+		Select(entsetting.FieldUpdatedAt).
+		First(ctx)
+	if err != nil {
+		return time.Time{}
+	}
+	return latest.UpdatedAt
+}
+
+// currentTheme 数据库中记录的当前主题，查询失败时返回空字符串
+func (s *Syncer) currentTheme(ctx context.Context) string {
+	current, err := s.db.UserInstalledTheme.Query().
+		Where(userinstalledtheme.IsCurrent(true)).
+		Order(ent.Desc(userinstalledtheme.FieldUpdatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return officialTheme
+	}
+	if err != nil {
+		return ""
+	}
+	return current.ThemeName
+}
+
+func newInstanceID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return time.Now().Format("150405.000000000")
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,95 @@
+/*
+ * @Description: 评论者身份徽章与头像地址
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package comment
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/avatar"
+)
+
+// 评论徽章类型
+const (
+	BadgeTypeOwner    = "owner"    // 博主
+	BadgeTypeVerified = "verified" // 登录用户发表的评论
+	BadgeTypeCustom   = "custom"   // 按邮箱配置的自定义徽章
+)
+
+// customBadge 自定义徽章配置，email 也可以填写邮箱的 MD5，避免在配置中保存明文邮箱
+type customBadge struct {
+	Email string `json:"email"`
+	Label string `json:"label"`
+	Color string `json:"color"`
+}
+
+// customBadges 读取自定义徽章配置，键为邮箱 MD5
+func (s *Service) customBadges() map[string]customBadge {
+	raw := s.settingSvc.Get(constant.KeyCommentCustomBadges.String())
+	if raw == "" || raw == "[]" {
+		return nil
+	}
+	var list []customBadge
+	if err := json.Unmarshal([]byte(raw), &list); err != nil {
+		return nil
+	}
+	result := make(map[string]customBadge, len(list))
+	for _, b := range list {
+		email := strings.ToLower(strings.TrimSpace(b.Email))
+		if email == "" || b.Label == "" {
+			continue
+		}
+		if strings.Contains(email, "@") {
+			email = emailHash(email)
+		}
+		result[email] = b
+	}
+	return result
+}
+
+// commentBadges 计算评论者徽章，匿名评论不显示任何徽章
+func (s *Service) commentBadges(c *model.Comment, emailMD5 string) []dto.Badge {
+	badges := []dto.Badge{}
+	if c.IsAnonymous {
+		return badges
+	}
+
+	if c.IsAdminAuthor {
+		label := s.settingSvc.Get(constant.KeyCommentMasterTag.String())
+		if label == "" {
+			label = "博主"
+		}
+		badges = append(badges, dto.Badge{Type: BadgeTypeOwner, Label: label})
+	} else if c.UserID != nil {
+		if label := s.settingSvc.Get(constant.KeyCommentVerifiedBadgeLabel.String()); label != "" {
+			badges = append(badges, dto.Badge{Type: BadgeTypeVerified, Label: label})
+		}
+	}
+
+	if b, ok := s.customBadges()[emailMD5]; ok && emailMD5 != "" {
+		badges = append(badges, dto.Badge{Type: BadgeTypeCustom, Label: b.Label, Color: b.Color})
+	}
+	return badges
+}
+
+// commentAvatar 返回评论者头像地址：优先使用关联用户上传的头像，其次按邮箱哈希生成
+func (s *Service) commentAvatar(c *model.Comment, emailMD5 string) string {
+	if !c.IsAnonymous && c.User != nil && c.User.Avatar != "" {
+		if strings.HasPrefix(c.User.Avatar, "http://") || strings.HasPrefix(c.User.Avatar, "https://") {
+			return c.User.Avatar
+		}
+	}
+	return avatar.URL(s.settingSvc, emailMD5)
+}
+
+func emailHash(email string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(strings.ToLower(email))))
+}
@@ -540,7 +540,7 @@ func (s *Service) importSingleComment(ctx context.Context, commentData ExportCom
 		Status:         status,
 		IsAdminComment: commentData.IsAdminComment,
 		IsAnonymous:    commentData.IsAnonymous,
-		IPAddress:      commentData.IPAddress,
+		IPAddress:      s.storedIP(commentData.IPAddress),
 		IPLocation:     commentData.IPLocation,
 		UserAgent:      userAgentPtr,
 		CreatedAt:      createdAtPtr,
@@ -0,0 +1,438 @@
+/*
+ * @Description: 从 Twikoo、Waline、Disqus 迁移评论，转换为本站导出格式后复用导入流程
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package comment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// 评论导入来源
+const (
+	ImportSourceAuto   = "auto"
+	ImportSourceAnheyu = "anheyu"
+	ImportSourceTwikoo = "twikoo"
+	ImportSourceWaline = "waline"
+	ImportSourceDisqus = "disqus"
+)
+
+// ErrUnknownImportSource 无法识别导入文件的来源
+var ErrUnknownImportSource = errors.New("无法识别导入文件的格式，请指定来源：anheyu、twikoo、waline 或 disqus")
+
+// ImportCommentsFromSource 按来源解析导入文件并导入评论，source 为空或 auto 时自动识别
+func (s *Service) ImportCommentsFromSource(ctx context.Context, source string, data []byte, req *ImportCommentRequest) (*ImportCommentResult, error) {
+	if source == "" || source == ImportSourceAuto {
+		source = detectImportSource(data)
+	}
+
+	var (
+		exportData *ExportCommentData
+		err        error
+	)
+	switch source {
+	case ImportSourceAnheyu:
+		return s.ImportCommentsFromJSON(ctx, data, req)
+	case ImportSourceTwikoo:
+		exportData, err = convertTwikoo(data)
+	case ImportSourceWaline:
+		exportData, err = convertWaline(data)
+	case ImportSourceDisqus:
+		exportData, err = convertDisqus(data)
+	default:
+		return nil, ErrUnknownImportSource
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 第三方系统只提供原文或 HTML，导入前补齐渲染后的内容
+	for i := range exportData.Comments {
+		item := &exportData.Comments[i]
+		if item.ContentHTML == "" {
+			if html, err := s.parserSvc.ToHTML(ctx, item.Content); err == nil {
+				item.ContentHTML = html
+			}
+		}
+	}
+
+	log.Printf("[导入评论] 识别为 %s 格式，共 %d 条评论", source, len(exportData.Comments))
+	req.Data = *exportData
+	return s.ImportComments(ctx, req)
+}
+
+// detectImportSource 根据文件内容推断来源
+func detectImportSource(data []byte) string {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return ""
+	}
+	if trimmed[0] == '<' {
+		if bytes.Contains(trimmed[:min(len(trimmed), 1024)], []byte("disqus")) {
+			return ImportSourceDisqus
+		}
+		return ""
+	}
+
+	var probe struct {
+		Type     string          `json:"type"`
+		Version  any             `json:"version"`
+		Comments json.RawMessage `json:"comments"`
+		Data     json.RawMessage `json:"data"`
+	}
+	if trimmed[0] == '{' && json.Unmarshal(trimmed, &probe) == nil {
+		switch {
+		case probe.Type == "waline" || len(probe.Data) > 0:
+			return ImportSourceWaline
+		case len(probe.Comments) > 0:
+			return ImportSourceAnheyu
+		}
+	}
+
+	// Twikoo 导出为评论数组，或每行一条评论的 JSON Lines
+	if bytes.Contains(trimmed, []byte(`"nick"`)) && bytes.Contains(trimmed, []byte(`"comment"`)) {
+		return ImportSourceTwikoo
+	}
+	return ""
+}
+
+// flexString 兼容字符串和数字两种形式的 ID
+type flexString string
+
+func (f *flexString) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err == nil {
+		*f = flexString(str)
+		return nil
+	}
+	var num json.Number
+	if err := json.Unmarshal(b, &num); err == nil {
+		*f = flexString(num.String())
+		return nil
+	}
+	*f = ""
+	return nil
+}
+
+// flexTime 兼容毫秒时间戳、时间字符串以及 LeanCloud 的 {"iso": "..."} 日期对象
+type flexTime time.Time
+
+func (f *flexTime) UnmarshalJSON(b []byte) error {
+	var ms int64
+	if err := json.Unmarshal(b, &ms); err == nil {
+		*f = flexTime(time.UnixMilli(ms))
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		var obj struct {
+			ISO string `json:"iso"`
+		}
+		if err := json.Unmarshal(b, &obj); err != nil {
+			return nil
+		}
+		str = obj.ISO
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02T15:04:05"} {
+		if t, err := time.Parse(layout, str); err == nil {
+			*f = flexTime(t)
+			return nil
+		}
+	}
+	return nil
+}
+
+// flexBool 兼容布尔值和 0/1
+type flexBool bool
+
+func (f *flexBool) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	*f = flexBool(s == "true" || (s != "" && s != "0" && s != "false" && s != "null"))
+	return nil
+}
+
+// twikooComment Twikoo 导出的评论
+type twikooComment struct {
+	ID      flexString `json:"_id"`
+	Nick    string     `json:"nick"`
+	Mail    string     `json:"mail"`
+	Link    string     `json:"link"`
+	UA      string     `json:"ua"`
+	IP      string     `json:"ip"`
+	URL     string     `json:"url"`
+	Comment string     `json:"comment"`
+	PID     flexString `json:"pid"`
+	IsSpam  flexBool   `json:"isSpam"`
+	Master  flexBool   `json:"master"`
+	Top     flexBool   `json:"top"`
+	Ups     []string   `json:"ups"`
+	Created flexTime   `json:"created"`
+	Updated flexTime   `json:"updated"`
+}
+
+func convertTwikoo(data []byte) (*ExportCommentData, error) {
+	var list []twikooComment
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &list); err != nil {
+			return nil, fmt.Errorf("解析 Twikoo 数据失败: %w", err)
+		}
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(trimmed))
+		for {
+			var item twikooComment
+			if err := decoder.Decode(&item); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, fmt.Errorf("解析 Twikoo 数据失败: %w", err)
+			}
+			list = append(list, item)
+		}
+	}
+
+	items := make([]ExportCommentItem, 0, len(list))
+	for _, c := range list {
+		if c.IsSpam || c.ID == "" {
+			continue
+		}
+		item := ExportCommentItem{
+			ID:             string(c.ID),
+			CreatedAt:      time.Time(c.Created),
+			UpdatedAt:      time.Time(c.Updated),
+			Content:        c.Comment,
+			TargetPath:     targetPathFromURL(c.URL),
+			Nickname:       c.Nick,
+			Email:          c.Mail,
+			Website:        c.Link,
+			IPAddress:      c.IP,
+			UserAgent:      c.UA,
+			ParentID:       string(c.PID),
+			ReplyToID:      string(c.PID),
+			Status:         int(model.StatusPublished),
+			IsAdminComment: bool(c.Master),
+			LikeCount:      len(c.Ups),
+		}
+		if c.Top {
+			pinnedAt := item.CreatedAt.Format(time.RFC3339)
+			item.PinnedAt = &pinnedAt
+		}
+		items = append(items, item)
+	}
+	return newMigrationData(ImportSourceTwikoo, items), nil
+}
+
+// walineComment Waline 导出的评论
+type walineComment struct {
+	ObjectID   flexString `json:"objectId"`
+	ID         flexString `json:"id"`
+	Nick       string     `json:"nick"`
+	Mail       string     `json:"mail"`
+	Link       string     `json:"link"`
+	UA         string     `json:"ua"`
+	IP         string     `json:"ip"`
+	URL        string     `json:"url"`
+	Comment    string     `json:"comment"`
+	PID        flexString `json:"pid"`
+	Status     string     `json:"status"`
+	Like       int        `json:"like"`
+	Sticky     flexBool   `json:"sticky"`
+	UserID     flexString `json:"user_id"`
+	InsertedAt flexTime   `json:"insertedAt"`
+	CreatedAt  flexTime   `json:"createdAt"`
+	UpdatedAt  flexTime   `json:"updatedAt"`
+}
+
+// walineUser Waline 导出的用户，用于识别管理员评论
+type walineUser struct {
+	ObjectID flexString `json:"objectId"`
+	ID       flexString `json:"id"`
+	Type     string     `json:"type"`
+}
+
+func convertWaline(data []byte) (*ExportCommentData, error) {
+	var export struct {
+		Data struct {
+			Comment []walineComment `json:"Comment"`
+			Users   []walineUser    `json:"Users"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("解析 Waline 数据失败: %w", err)
+	}
+
+	admins := make(map[flexString]bool)
+	for _, u := range export.Data.Users {
+		if u.Type == "administrator" {
+			admins[firstNonEmpty(u.ObjectID, u.ID)] = true
+		}
+	}
+
+	items := make([]ExportCommentItem, 0, len(export.Data.Comment))
+	for _, c := range export.Data.Comment {
+		id := firstNonEmpty(c.ObjectID, c.ID)
+		if c.Status == "spam" || id == "" {
+			continue
+		}
+		status := model.StatusPublished
+		if c.Status == "waiting" {
+			status = model.StatusPending
+		}
+		createdAt := time.Time(c.InsertedAt)
+		if createdAt.IsZero() {
+			createdAt = time.Time(c.CreatedAt)
+		}
+		item := ExportCommentItem{
+			ID:             string(id),
+			CreatedAt:      createdAt,
+			UpdatedAt:      time.Time(c.UpdatedAt),
+			Content:        c.Comment,
+			TargetPath:     targetPathFromURL(c.URL),
+			Nickname:       c.Nick,
+			Email:          c.Mail,
+			Website:        c.Link,
+			IPAddress:      c.IP,
+			UserAgent:      c.UA,
+			ParentID:       string(c.PID),
+			ReplyToID:      string(c.PID),
+			Status:         int(status),
+			IsAdminComment: c.UserID != "" && admins[c.UserID],
+			LikeCount:      c.Like,
+		}
+		if c.Sticky {
+			pinnedAt := createdAt.Format(time.RFC3339)
+			item.PinnedAt = &pinnedAt
+		}
+		items = append(items, item)
+	}
+	return newMigrationData(ImportSourceWaline, items), nil
+}
+
+type disqusRef struct {
+	ID string `xml:"http://disqus.com/disqus-internals id,attr"`
+}
+
+type disqusThread struct {
+	ID    string `xml:"http://disqus.com/disqus-internals id,attr"`
+	Link  string `xml:"link"`
+	Title string `xml:"title"`
+}
+
+type disqusPost struct {
+	ID        string    `xml:"http://disqus.com/disqus-internals id,attr"`
+	Message   string    `xml:"message"`
+	CreatedAt string    `xml:"createdAt"`
+	IsDeleted bool      `xml:"isDeleted"`
+	IsSpam    bool      `xml:"isSpam"`
+	IPAddress string    `xml:"ipAddress"`
+	Thread    disqusRef `xml:"thread"`
+	Parent    disqusRef `xml:"parent"`
+	Author    struct {
+		Name  string `xml:"name"`
+		Email string `xml:"email"`
+	} `xml:"author"`
+}
+
+func convertDisqus(data []byte) (*ExportCommentData, error) {
+	var export struct {
+		Threads []disqusThread `xml:"thread"`
+		Posts   []disqusPost   `xml:"post"`
+	}
+	if err := xml.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("解析 Disqus 数据失败: %w", err)
+	}
+
+	threads := make(map[string]disqusThread, len(export.Threads))
+	for _, t := range export.Threads {
+		threads[t.ID] = t
+	}
+
+	items := make([]ExportCommentItem, 0, len(export.Posts))
+	for _, p := range export.Posts {
+		if p.IsDeleted || p.IsSpam || p.ID == "" {
+			continue
+		}
+		thread, ok := threads[p.Thread.ID]
+		if !ok {
+			continue
+		}
+		createdAt, _ := time.Parse(time.RFC3339, strings.TrimSpace(p.CreatedAt))
+		nickname := strings.TrimSpace(p.Author.Name)
+		if nickname == "" {
+			nickname = "匿名"
+		}
+		items = append(items, ExportCommentItem{
+			ID:          p.ID,
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt,
+			Content:     strings.TrimSpace(p.Message),
+			TargetPath:  targetPathFromURL(thread.Link),
+			TargetTitle: strings.TrimSpace(thread.Title),
+			Nickname:    nickname,
+			Email:       strings.TrimSpace(p.Author.Email),
+			IPAddress:   strings.TrimSpace(p.IPAddress),
+			ParentID:    p.Parent.ID,
+			ReplyToID:   p.Parent.ID,
+			Status:      int(model.StatusPublished),
+		})
+	}
+	return newMigrationData(ImportSourceDisqus, items), nil
+}
+
+// newMigrationData 按时间排序并组装导出格式，保证回复目标先于回复导入
+func newMigrationData(source string, items []ExportCommentItem) *ExportCommentData {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].CreatedAt.Before(items[j].CreatedAt)
+	})
+	for i := range items {
+		if items[i].UpdatedAt.IsZero() {
+			items[i].UpdatedAt = items[i].CreatedAt
+		}
+	}
+	return &ExportCommentData{
+		Version:  "1.0",
+		ExportAt: time.Now(),
+		Comments: items,
+		Meta: map[string]interface{}{
+			"total_comments": len(items),
+			"export_by":      source,
+		},
+	}
+}
+
+// targetPathFromURL 将完整地址转换为站内路径，已是路径时原样返回
+func targetPathFromURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if u, err := url.Parse(raw); err == nil && u.Host != "" {
+		raw = u.Path
+	}
+	if raw == "" {
+		return "/"
+	}
+	if !strings.HasPrefix(raw, "/") {
+		raw = "/" + raw
+	}
+	return raw
+}
+
+func firstNonEmpty(values ...flexString) flexString {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,208 @@
+/*
+ * @Description: 评论属地显示策略与 IP 匿名化
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package comment
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+)
+
+// 评论属地显示粒度
+const (
+	LocationGranularityCountry  = "country"
+	LocationGranularityProvince = "province"
+	LocationGranularityCity     = "city"
+	LocationGranularityOff      = "off"
+)
+
+// ipAnonymizeBatchSize 批量处理历史评论时每批处理的评论数
+const ipAnonymizeBatchSize = 200
+
+// ErrIPAnonymizeRunning 匿名化任务已在运行
+var ErrIPAnonymizeRunning = errors.New("IP 匿名化任务正在运行中")
+
+// IPAnonymizeStatus 历史评论 IP 匿名化任务状态
+type IPAnonymizeStatus struct {
+	Running          bool       `json:"running"`
+	Processed        int        `json:"processed"`
+	Updated          int        `json:"updated"`           // IP 或属地有变化的评论数
+	LocationsUpdated int        `json:"locations_updated"` // 按当前属地显示粒度处理了属地的评论数
+	StartedAt        *time.Time `json:"started_at,omitempty"`
+	FinishedAt       *time.Time `json:"finished_at,omitempty"`
+	Error            string     `json:"error,omitempty"`
+}
+
+// locationGranularity 读取属地显示粒度，非法值按 city 处理
+func (s *Service) locationGranularity() string {
+	switch g := s.settingSvc.Get(constant.KeyCommentLocationGranularity.String()); g {
+	case LocationGranularityCountry, LocationGranularityProvince, LocationGranularityOff:
+		return g
+	default:
+		return LocationGranularityCity
+	}
+}
+
+// resolveIPLocation 按显示粒度查询评论者属地，必须在 IP 匿名化之前调用以保证查询精度
+func (s *Service) resolveIPLocation(ip, referer string) string {
+	granularity := s.locationGranularity()
+	if granularity == LocationGranularityOff {
+		return ""
+	}
+	if ip == "" || s.geoService == nil {
+		return "未知"
+	}
+
+	if granularity == LocationGranularityCity {
+		location, err := s.geoService.Lookup(ip, referer)
+		if err != nil {
+			return "未知"
+		}
+		return location
+	}
+
+	result, err := s.geoService.LookupFull(ip, referer)
+	if err != nil || result == nil {
+		return "未知"
+	}
+	location := result.Country
+	if granularity == LocationGranularityProvince && result.Province != "" {
+		location = result.Province
+	}
+	if location == "" {
+		return "未知"
+	}
+	return location
+}
+
+// scrubLocation 按当前显示粒度处理历史评论已存储的属地：off 时清空，province 时只保留省份，
+// country 时用存储的 IP 重新查询国家（IP 已匿名化时国家通常仍然准确），查询失败时记为未知
+func (s *Service) scrubLocation(granularity, ip, location string) string {
+	switch granularity {
+	case LocationGranularityOff:
+		return ""
+	case LocationGranularityProvince:
+		if idx := strings.Index(location, " "); idx > 0 {
+			return location[:idx]
+		}
+	case LocationGranularityCountry:
+		if location == "" || location == "未知" {
+			return location
+		}
+		if ip == "" || s.geoService == nil {
+			return "未知"
+		}
+		result, err := s.geoService.LookupFull(ip, "")
+		if err != nil || result == nil || result.Country == "" {
+			return "未知"
+		}
+		return result.Country
+	}
+	return location
+}
+
+// displayLocation 按显示粒度处理已存储的属地，粒度调低后历史评论同样生效
+// 存储格式为 "省 市"，province 粒度只保留第一段；country 粒度无法从旧数据还原国家，保持原样
+func (s *Service) displayLocation(location string) (string, bool) {
+	switch s.locationGranularity() {
+	case LocationGranularityOff:
+		return "", false
+	case LocationGranularityProvince:
+		if idx := strings.Index(location, " "); idx > 0 {
+			return location[:idx], true
+		}
+	}
+	return location, true
+}
+
+// storedIP 返回写入数据库的 IP，开启匿名化时截断末段
+func (s *Service) storedIP(ip string) string {
+	if ip != "" && s.settingSvc.GetBool(constant.KeyCommentIPAnonymize.String()) {
+		return util.AnonymizeIP(ip)
+	}
+	return ip
+}
+
+// StartIPAnonymization 在后台对所有历史评论的 IP 做匿名化处理，并按当前属地显示粒度处理已存储的属地。
+// 匿名化和属地粒度设置只作用于之后的新评论，已存储的数据需要通过该任务一次性处理
+func (s *Service) StartIPAnonymization() error {
+	s.anonymizeMu.Lock()
+	defer s.anonymizeMu.Unlock()
+	if s.anonymizeStatus.Running {
+		return ErrIPAnonymizeRunning
+	}
+
+	now := time.Now()
+	s.anonymizeStatus = IPAnonymizeStatus{Running: true, StartedAt: &now}
+	go s.runIPAnonymization()
+	return nil
+}
+
+// IPAnonymizationStatus 返回匿名化任务的当前状态
+func (s *Service) IPAnonymizationStatus() IPAnonymizeStatus {
+	s.anonymizeMu.Lock()
+	defer s.anonymizeMu.Unlock()
+	return s.anonymizeStatus
+}
+
+func (s *Service) runIPAnonymization() {
+	ctx := context.Background()
+	var afterID uint
+	var processed, updated, locationsUpdated int
+	var runErr error
+	granularity := s.locationGranularity()
+
+	for {
+		records, err := s.repo.ListIPAddresses(ctx, afterID, ipAnonymizeBatchSize)
+		if err != nil {
+			runErr = err
+			break
+		}
+		for _, record := range records {
+			afterID = record.ID
+			processed++
+			// 属地在匿名化之前处理，重新查询时使用完整 IP
+			location := s.scrubLocation(granularity, record.IPAddress, record.IPLocation)
+			anonymized := util.AnonymizeIP(record.IPAddress)
+			if anonymized == record.IPAddress && location == record.IPLocation {
+				continue
+			}
+			if err := s.repo.UpdateIPAddress(ctx, record.ID, anonymized, location); err != nil {
+				log.Printf("[评论IP匿名化] 更新评论 %d 失败: %v", record.ID, err)
+				continue
+			}
+			updated++
+			if location != record.IPLocation {
+				locationsUpdated++
+			}
+		}
+
+		s.anonymizeMu.Lock()
+		s.anonymizeStatus.Processed, s.anonymizeStatus.Updated = processed, updated
+		s.anonymizeStatus.LocationsUpdated = locationsUpdated
+		s.anonymizeMu.Unlock()
+
+		if len(records) < ipAnonymizeBatchSize {
+			break
+		}
+	}
+
+	now := time.Now()
+	s.anonymizeMu.Lock()
+	s.anonymizeStatus.Running = false
+	s.anonymizeStatus.FinishedAt = &now
+	if runErr != nil {
+		s.anonymizeStatus.Error = runErr.Error()
+	}
+	s.anonymizeMu.Unlock()
+
+	log.Printf("[评论IP匿名化] 完成，共检查 %d 条评论，更新 %d 条，其中处理属地 %d 条", processed, updated, locationsUpdated)
+}
@@ -16,10 +16,12 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/app/task"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
@@ -77,6 +79,10 @@ type Service struct {
 	pushooSvc                 utility.PushooService
 	notificationSvc           notification.Service
 	inAppNotificationCallback InAppNotificationCallback // PRO版可注入的站内通知回调
+	eventBus                  *event.EventBus           // 评论变化时发布事件，页面缓存据此失效
+
+	anonymizeMu     sync.Mutex
+	anonymizeStatus IPAnonymizeStatus
 }
 
 // NewService 创建一个新的评论服务实例。
@@ -113,6 +119,19 @@ func (s *Service) SetInAppNotificationCallback(callback InAppNotificationCallbac
 	s.inAppNotificationCallback = callback
 }
 
+// SetEventBus 设置事件总线（可选注入，评论发布、审核、修改或删除后发布评论事件）
+func (s *Service) SetEventBus(bus *event.EventBus) {
+	s.eventBus = bus
+}
+
+// publishCommentEvent 发布评论事件，未注入事件总线时忽略
+func (s *Service) publishCommentEvent(topic event.Topic, targetPath string) {
+	if s.eventBus == nil || targetPath == "" {
+		return
+	}
+	s.eventBus.Publish(topic, &event.CommentPayload{TargetPath: targetPath})
+}
+
 // UploadImage 负责处理评论图片的上传业务逻辑。
 func (s *Service) UploadImage(ctx context.Context, viewerID uint, originalFilename string, fileReader io.Reader) (*model.FileItem, error) {
 	newFileName := uuid.New().String() + filepath.Ext(originalFilename)
@@ -273,13 +292,7 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 	if req.Email != nil {
 		emailMD5 = fmt.Sprintf("%x", md5.Sum([]byte(strings.ToLower(*req.Email))))
 	}
-	ipLocation := "未知"
-	if ip != "" && s.geoService != nil {
-		location, err := s.geoService.Lookup(ip, referer)
-		if err == nil {
-			ipLocation = location
-		}
-	}
+	ipLocation := s.resolveIPLocation(ip, referer)
 	status := model.StatusPublished
 	forbiddenWords := s.settingSvc.Get(constant.KeyCommentForbiddenWords.String())
 	if forbiddenWords != "" {
@@ -377,7 +390,7 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 		Content:        req.Content,
 		ContentHTML:    safeHTML,
 		UserAgent:      &ua,
-		IPAddress:      ip,
+		IPAddress:      s.storedIP(ip),
 		IPLocation:     ipLocation,
 		Status:         int(status),
 		IsAdminComment: isAdmin,
@@ -390,6 +403,7 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 	}
 
 	if newComment.IsPublished() {
+		s.publishCommentEvent(event.CommentCreated, newComment.TargetPath)
 		log.Printf("[DEBUG] 评论已发布，开始处理通知逻辑，评论ID: %d", newComment.ID)
 
 		// 发送邮件通知
@@ -996,9 +1010,11 @@ func (s *Service) toResponseDTO(ctx context.Context, c *model.Comment, parent *m
 		EmailMD5:       emailMD5,
 		QQNumber:       qqNumber,  // QQ号（如果是QQ邮箱）
 		AvatarURL:      avatarURL, // 添加用户自定义头像URL
+		Avatar:         s.commentAvatar(c, emailMD5),
 		Website:        c.Author.Website,
 		ContentHTML:    renderedContentHTML,
 		IsAdminComment: c.IsAdminAuthor,
+		Badges:         s.commentBadges(c, emailMD5),
 		IsAnonymous:    c.IsAnonymous, // 匿名评论标识
 		TargetPath:     c.TargetPath,
 		TargetTitle:    c.TargetTitle,
@@ -1014,8 +1030,9 @@ func (s *Service) toResponseDTO(ctx context.Context, c *model.Comment, parent *m
 		resp.UserAgent = &ua
 	}
 	if showRegion {
-		loc := c.Author.Location
-		resp.IPLocation = loc
+		if loc, ok := s.displayLocation(c.Author.Location); ok {
+			resp.IPLocation = loc
+		}
 	}
 
 	if isAdminView {
@@ -1118,6 +1135,14 @@ func (s *Service) AdminList(ctx context.Context, req *dto.AdminListRequest) (*dt
 		Content:    req.Content,
 		TargetPath: req.TargetPath,
 		Status:     req.Status,
+		Keyset:     repository.KeysetQuery{Enabled: req.Keyset, After: req.After},
+	}
+	if req.Keyset && req.After != nil {
+		dbID, entityType, err := idgen.DecodePublicID(req.AfterID)
+		if err != nil || entityType != idgen.EntityTypeComment {
+			return nil, fmt.Errorf("无效的评论ID '%s'", req.AfterID)
+		}
+		params.Keyset.AfterID = dbID
 	}
 	comments, total, err := s.repo.FindWithConditions(ctx, params)
 	if err != nil {
@@ -1155,7 +1180,23 @@ func (s *Service) Delete(ctx context.Context, ids []string) (int, error) {
 	if len(dbIDs) == 0 {
 		return 0, errors.New("未提供任何有效的评论ID")
 	}
-	return s.repo.DeleteByIDs(ctx, dbIDs)
+	// 删除前记录评论所在页面，删除后逐个发布事件
+	targets, err := s.repo.FindManyByIDs(ctx, dbIDs)
+	if err != nil {
+		log.Printf("警告：查询待删除评论所在页面失败: %v", err)
+	}
+	deleted, err := s.repo.DeleteByIDs(ctx, dbIDs)
+	if err != nil {
+		return deleted, err
+	}
+	paths := make(map[string]bool, len(targets))
+	for _, c := range targets {
+		if !paths[c.TargetPath] {
+			paths[c.TargetPath] = true
+			s.publishCommentEvent(event.CommentDeleted, c.TargetPath)
+		}
+	}
+	return deleted, nil
 }
 
 // UpdateStatus 更新评论的状态。
@@ -1172,6 +1213,7 @@ func (s *Service) UpdateStatus(ctx context.Context, publicID string, status int)
 	if err != nil {
 		return nil, fmt.Errorf("更新评论状态失败: %w", err)
 	}
+	s.publishCommentEvent(event.CommentUpdated, updatedComment.TargetPath)
 	return s.toResponseDTO(ctx, updatedComment, nil, nil, true), nil
 }
 
@@ -1190,6 +1232,7 @@ func (s *Service) SetPin(ctx context.Context, publicID string, isPinned bool) (*
 	if err != nil {
 		return nil, fmt.Errorf("设置评论置顶状态失败: %w", err)
 	}
+	s.publishCommentEvent(event.CommentUpdated, updatedComment.TargetPath)
 	return s.toResponseDTO(ctx, updatedComment, nil, nil, true), nil
 }
 
@@ -1216,6 +1259,7 @@ func (s *Service) UpdateContent(ctx context.Context, publicID string, newContent
 	if err != nil {
 		return nil, fmt.Errorf("更新评论内容失败: %w", err)
 	}
+	s.publishCommentEvent(event.CommentUpdated, updatedComment.TargetPath)
 
 	return s.toResponseDTO(ctx, updatedComment, nil, nil, true), nil
 }
@@ -0,0 +1,248 @@
+/*
+ * @Description: 后台仪表盘汇总服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 将后台首页需要的文章数、待审评论、今日访客、主题与 SSR 状态、主题更新和系统通知
+ * 合并为一次查询，各部分并发获取，结果短时间缓存，减少后台首页的请求数和加载时间。
+ */
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/statistics"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+	"github.com/anzhiyu-c/anheyu-app/pkg/ssr"
+)
+
+const (
+	// CacheKeySummary 仪表盘汇总缓存键前缀，按用户区分
+	CacheKeySummary = "dashboard:summary:"
+	// CacheExpireSummary 仪表盘汇总缓存时间
+	CacheExpireSummary = time.Minute
+	// recentNoticeLimit 返回的最近系统通知条数
+	recentNoticeLimit = 5
+)
+
+// ArticleSummary 文章数量统计
+type ArticleSummary struct {
+	Total     int `json:"total"`
+	Published int `json:"published"`
+	Draft     int `json:"draft"`
+	Scheduled int `json:"scheduled"`
+}
+
+// CommentSummary 评论统计
+type CommentSummary struct {
+	Pending int64 `json:"pending"` // 待审核评论数
+}
+
+// ThemeUpdate 可更新的主题
+type ThemeUpdate struct {
+	Name             string `json:"name"`
+	InstalledVersion string `json:"installed_version"`
+	LatestVersion    string `json:"latest_version"`
+}
+
+// ThemeSummary 主题状态
+type ThemeSummary struct {
+	Current        string         `json:"current"`
+	CurrentVersion string         `json:"current_version,omitempty"`
+	SSRRunning     *ssr.ThemeInfo `json:"ssr_running,omitempty"`
+	PendingUpdates []ThemeUpdate  `json:"pending_updates"`
+}
+
+// NotificationSummary 系统通知
+type NotificationSummary struct {
+	Unread int                         `json:"unread"`
+	Recent []notification.SystemNotice `json:"recent"`
+}
+
+// Summary 仪表盘汇总数据
+type Summary struct {
+	Articles      ArticleSummary           `json:"articles"`
+	Comments      CommentSummary           `json:"comments"`
+	Visitors      *model.VisitorStatistics `json:"visitors"`
+	Theme         ThemeSummary             `json:"theme"`
+	Notifications NotificationSummary      `json:"notifications"`
+	Warnings      []string                 `json:"warnings,omitempty"` // 获取失败的部分，其余数据仍正常返回
+	GeneratedAt   time.Time                `json:"generated_at"`
+}
+
+// Service 仪表盘服务接口
+type Service interface {
+	// GetSummary 获取仪表盘汇总数据，refresh 为 true 时跳过缓存
+	GetSummary(ctx context.Context, userID uint, refresh bool) (*Summary, error)
+}
+
+type service struct {
+	articleRepo repository.ArticleRepository
+	commentRepo repository.CommentRepository
+	statService statistics.VisitorStatService
+	themeSvc    theme.ThemeService
+	ssrManager  *ssr.Manager
+	cacheSvc    utility.CacheService
+}
+
+// NewService 创建仪表盘服务
+func NewService(
+	articleRepo repository.ArticleRepository,
+	commentRepo repository.CommentRepository,
+	statService statistics.VisitorStatService,
+	themeSvc theme.ThemeService,
+	ssrManager *ssr.Manager,
+	cacheSvc utility.CacheService,
+) Service {
+	return &service{
+		articleRepo: articleRepo,
+		commentRepo: commentRepo,
+		statService: statService,
+		themeSvc:    themeSvc,
+		ssrManager:  ssrManager,
+		cacheSvc:    cacheSvc,
+	}
+}
+
+// GetSummary 获取仪表盘汇总数据
+func (s *service) GetSummary(ctx context.Context, userID uint, refresh bool) (*Summary, error) {
+	cacheKey := fmt.Sprintf("%s%d", CacheKeySummary, userID)
+	if !refresh && s.cacheSvc != nil {
+		if cached, err := s.cacheSvc.Get(ctx, cacheKey); err == nil && cached != "" {
+			var summary Summary
+			if err := json.Unmarshal([]byte(cached), &summary); err == nil {
+				return &summary, nil
+			}
+		}
+	}
+
+	summary := &Summary{GeneratedAt: time.Now()}
+	var mu sync.Mutex
+	warn := func(part string, err error) {
+		log.Printf("[仪表盘] 获取%s失败: %v", part, err)
+		mu.Lock()
+		summary.Warnings = append(summary.Warnings, part)
+		mu.Unlock()
+	}
+
+	var wg sync.WaitGroup
+	run := func(fn func()) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn()
+		}()
+	}
+
+	run(func() {
+		articles, err := s.articleSummary(ctx)
+		if err != nil {
+			warn("文章统计", err)
+			return
+		}
+		summary.Articles = *articles
+	})
+	run(func() {
+		pending := int(model.StatusPending)
+		_, total, err := s.commentRepo.FindWithConditions(ctx, repository.AdminListParams{Page: 1, PageSize: 1, Status: &pending})
+		if err != nil {
+			warn("待审评论", err)
+			return
+		}
+		summary.Comments.Pending = total
+	})
+	run(func() {
+		stats, err := s.statService.GetBasicStatistics(ctx)
+		if err != nil {
+			warn("访客统计", err)
+			return
+		}
+		summary.Visitors = stats
+	})
+	run(func() {
+		themeSummary, err := s.themeSummary(ctx, userID)
+		if err != nil {
+			warn("主题状态", err)
+		}
+		summary.Theme = themeSummary
+	})
+
+	center := notification.DefaultNoticeCenter()
+	summary.Notifications.Unread = center.UnreadCount()
+	recent := center.List("", false)
+	if len(recent) > recentNoticeLimit {
+		recent = recent[:recentNoticeLimit]
+	}
+	summary.Notifications.Recent = recent
+
+	wg.Wait()
+
+	// 部分失败时不缓存，避免把不完整的数据保留一分钟
+	if s.cacheSvc != nil && len(summary.Warnings) == 0 {
+		if data, err := json.Marshal(summary); err == nil {
+			s.cacheSvc.Set(ctx, cacheKey, string(data), CacheExpireSummary)
+		}
+	}
+	return summary, nil
+}
+
+// articleSummary 按状态统计文章数量
+func (s *service) articleSummary(ctx context.Context) (*ArticleSummary, error) {
+	count := func(status string) (int, error) {
+		_, total, err := s.articleRepo.List(ctx, &model.ListArticlesOptions{Page: 1, PageSize: 1, Status: status})
+		return total, err
+	}
+
+	summary := &ArticleSummary{}
+	var err error
+	if summary.Total, err = count(""); err != nil {
+		return nil, err
+	}
+	if summary.Published, err = count("PUBLISHED"); err != nil {
+		return nil, err
+	}
+	if summary.Draft, err = count("DRAFT"); err != nil {
+		return nil, err
+	}
+	if summary.Scheduled, err = count("SCHEDULED"); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// themeSummary 当前主题、运行中的 SSR 主题和可更新的主题
+func (s *service) themeSummary(ctx context.Context, userID uint) (ThemeSummary, error) {
+	summary := ThemeSummary{PendingUpdates: []ThemeUpdate{}}
+	if s.ssrManager != nil {
+		summary.SSRRunning = s.ssrManager.GetRunningTheme()
+	}
+
+	if current, err := s.themeSvc.GetCurrentTheme(ctx, userID); err == nil && current != nil {
+		summary.Current = current.Name
+		summary.CurrentVersion = current.InstalledVersion
+	}
+
+	installed, err := s.themeSvc.GetInstalledThemes(ctx, userID)
+	if err != nil {
+		return summary, err
+	}
+	for _, t := range installed {
+		if t.InstalledVersion != "" && t.Version != "" && t.Version != t.InstalledVersion {
+			summary.PendingUpdates = append(summary.PendingUpdates, ThemeUpdate{
+				Name:             t.Name,
+				InstalledVersion: t.InstalledVersion,
+				LatestVersion:    t.Version,
+			})
+		}
+	}
+	return summary, nil
+}
@@ -0,0 +1,86 @@
+/*
+ * @Description: 演示模式，供主题作者部署在线预览站点
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 在 conf.ini 的 [Demo] 中设置 Enable = true（或环境变量 ANHEYU_DEMO_ENABLE=true）开启。
+ * 开启后修改数据的接口返回 403，页面底部显示演示横幅；启动时保存数据库快照到 data/demo，
+ * 计划任务按调度（默认每小时）把数据库恢复到快照，清除浏览量、访问统计等仍允许写入的数据。
+ * 删除 data/demo 后重启即可用当前数据重新生成快照。
+ */
+package demo
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/instancebackup"
+)
+
+// DefaultDir 数据库快照的保存目录
+const DefaultDir = "data/demo"
+
+// BannerHTML 演示站点页面底部显示的横幅
+const BannerHTML = `<div id="anheyu-demo-banner" style="position:fixed;left:0;right:0;bottom:0;z-index:99999;padding:8px 16px;background:#425aef;color:#fff;font:14px/1.5 sans-serif;text-align:center;box-shadow:0 -2px 8px rgba(0,0,0,.15)">当前为演示站点，数据修改不会保存，并会定期重置</div>`
+
+// Service 演示模式服务
+type Service struct {
+	cfg       *config.Config
+	backupSvc *instancebackup.Service
+	dir       string
+
+	mu       sync.Mutex
+	snapshot string
+}
+
+// NewService 创建演示模式服务
+func NewService(cfg *config.Config, backupSvc *instancebackup.Service, dir string) *Service {
+	return &Service{cfg: cfg, backupSvc: backupSvc, dir: dir}
+}
+
+// Enabled 是否开启了演示模式
+func (s *Service) Enabled() bool {
+	return s.cfg.GetBool(config.KeyDemoEnable)
+}
+
+// Prepare 查找已有的数据库快照，不存在时用当前数据生成
+func (s *Service) Prepare(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, name := range []string{"sqlite.db", "postgres.sql", "mysql.sql"} {
+		p := filepath.Join(s.dir, name)
+		if _, err := os.Stat(p); err == nil {
+			s.snapshot = p
+			log.Printf("[演示模式] 使用已有的数据库快照 %s", p)
+			return nil
+		}
+	}
+	p, err := s.backupSvc.SnapshotDatabase(ctx, s.dir)
+	if err != nil {
+		return fmt.Errorf("生成演示数据快照失败: %w", err)
+	}
+	s.snapshot = p
+	log.Printf("[演示模式] 已生成数据库快照 %s", p)
+	return nil
+}
+
+// Reset 将数据库恢复到快照
+func (s *Service) Reset(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.snapshot == "" {
+		return fmt.Errorf("演示数据快照尚未生成")
+	}
+	if err := s.backupSvc.ResetDatabase(ctx, s.snapshot); err != nil {
+		return fmt.Errorf("重置演示数据失败: %w", err)
+	}
+	log.Printf("[演示模式] 已将数据库恢复到快照")
+	return nil
+}
@@ -0,0 +1,103 @@
+package diagnostics
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultLogLines 内存中保留的最近日志行数
+const defaultLogLines = 2000
+
+// logRing 保留最近若干行日志的环形缓冲区，实现 io.Writer
+type logRing struct {
+	mu      sync.Mutex
+	lines   []string
+	next    int
+	full    bool
+	partial []byte // 尚未遇到换行的不完整行
+}
+
+func newLogRing(capacity int) *logRing {
+	return &logRing{lines: make([]string, capacity)}
+}
+
+func (r *logRing) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data := append(r.partial, p...)
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		r.add(string(data[:i]))
+		data = data[i+1:]
+	}
+	r.partial = append([]byte(nil), data...)
+	return len(p), nil
+}
+
+func (r *logRing) add(line string) {
+	r.lines[r.next] = line
+	r.next = (r.next + 1) % len(r.lines)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Lines 按时间顺序返回保留的日志行
+func (r *logRing) Lines() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.full {
+		return append([]string(nil), r.lines[:r.next]...)
+	}
+	result := make([]string, 0, len(r.lines))
+	result = append(result, r.lines[r.next:]...)
+	return append(result, r.lines[:r.next]...)
+}
+
+var (
+	recentLogs  = newLogRing(defaultLogLines)
+	captureOnce sync.Once
+	startedAt   = time.Now()
+)
+
+// CaptureLogs 在输出到标准错误的同时把标准库 log 的输出保留在内存中，供诊断包使用，应在启动早期调用
+func CaptureLogs() {
+	captureOnce.Do(func() {
+		log.SetOutput(io.MultiWriter(os.Stderr, recentLogs))
+	})
+}
+
+// LogWriter 包装结构化日志（slog）的输出，使其同样保留在内存中
+func LogWriter(w io.Writer) io.Writer {
+	return io.MultiWriter(w, recentLogs)
+}
+
+// errorLogKeywords 判断日志行是否为错误或警告的关键字（小写）
+var errorLogKeywords = []string{"错误", "失败", "警告", "panic", "error", "level=warn", "fatal"}
+
+// errorLines 日志中最近的至多 limit 条错误或警告
+func errorLines(lines []string, limit int) []string {
+	var result []string
+	for i := len(lines) - 1; i >= 0 && len(result) < limit; i-- {
+		lower := strings.ToLower(lines[i])
+		for _, keyword := range errorLogKeywords {
+			if strings.Contains(lower, keyword) {
+				result = append(result, lines[i])
+				break
+			}
+		}
+	}
+	// 恢复为时间顺序
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
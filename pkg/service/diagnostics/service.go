@@ -0,0 +1,272 @@
+/*
+ * @Description: 诊断包生成服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 用户反馈问题时，管理员可以在后台下载一个诊断包（zip）附在反馈中，包含：
+ *   version.json  程序版本、构建信息、运行环境和出站身份
+ *   config.json   部署配置（conf.ini）和后台配置，密码、密钥、令牌等敏感值已替换为 ******
+ *   logs.txt      最近的运行日志
+ *   themes.json   当前主题、静态模式、已安装主题和 SSR 主题状态
+ *   disk.json     data、themes、static、backup 目录占用和磁盘可用空间
+ *   errors.json   最近的 5xx 请求、外部接口失败和日志中的错误与警告
+ * 日志、请求路径和配置值中的 URL 凭据、查询参数凭据和 Authorization 凭据同样会被去除，访客 IP 做匿名化处理。
+ */
+package diagnostics
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/configdef"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/accesslog"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
+	"github.com/anzhiyu-c/anheyu-app/pkg/ssr"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+)
+
+const (
+	// recentErrorLimit 诊断包中最近 5xx 请求和错误日志的条数
+	recentErrorLimit = 100
+	// dataDir 站点数据目录
+	dataDir = "data"
+)
+
+// VersionInfo 版本与运行环境
+type VersionInfo struct {
+	Build       version.BuildInfo   `json:"build"`
+	Identity    httpclient.Identity `json:"identity"`
+	OS          string              `json:"os"`
+	Arch        string              `json:"arch"`
+	NumCPU      int                 `json:"num_cpu"`
+	Goroutines  int                 `json:"goroutines"`
+	HeapAllocMB uint64              `json:"heap_alloc_mb"`
+	SysMB       uint64              `json:"sys_mb"`
+	Uptime      string              `json:"uptime"`
+	GeneratedAt time.Time           `json:"generated_at"`
+}
+
+// ConfigSummary 脱敏后的配置
+type ConfigSummary struct {
+	Deployment map[string]string `json:"deployment"` // conf.ini 与环境变量
+	Settings   map[string]string `json:"settings"`   // 后台配置
+}
+
+// ThemeStatus 主题与 SSR 状态
+type ThemeStatus struct {
+	Current          string          `json:"current"`
+	CurrentVersion   string          `json:"current_version,omitempty"`
+	StaticModeActive bool            `json:"static_mode_active"`
+	SSRInstalled     []ssr.ThemeInfo `json:"ssr_installed"`
+	SSRRunning       []string        `json:"ssr_running"`
+	Warnings         []string        `json:"warnings,omitempty"`
+}
+
+// DiskUsage 磁盘占用
+type DiskUsage struct {
+	Directories map[string]int64          `json:"directories"` // 目录 -> 占用字节数
+	Themes      *theme.ThemeStorageReport `json:"themes,omitempty"`
+	Warnings    []string                  `json:"warnings,omitempty"`
+}
+
+// RecentErrors 最近的错误
+type RecentErrors struct {
+	Requests  []accesslog.Entry        `json:"requests"`  // 最近的 5xx 请求
+	Upstreams []httpclient.HostMetrics `json:"upstreams"` // 有失败记录的外部接口
+	Logs      []string                 `json:"logs"`      // 日志中最近的错误与警告
+}
+
+// Service 诊断包服务
+type Service struct {
+	cfg        *config.Config
+	settingSvc setting.SettingService
+	themeSvc   theme.ThemeService
+	ssrManager *ssr.Manager
+	accessLogs *accesslog.Store
+}
+
+// NewService 创建诊断包服务
+func NewService(cfg *config.Config, settingSvc setting.SettingService, themeSvc theme.ThemeService, ssrManager *ssr.Manager, accessLogs *accesslog.Store) *Service {
+	return &Service{
+		cfg:        cfg,
+		settingSvc: settingSvc,
+		themeSvc:   themeSvc,
+		ssrManager: ssrManager,
+		accessLogs: accessLogs,
+	}
+}
+
+// WriteBundle 生成诊断包写入 w，userID 为下载诊断包的管理员，用于读取其当前主题
+func (s *Service) WriteBundle(ctx context.Context, userID uint, w io.Writer) error {
+	logs := recentLogs.Lines()
+	for i, line := range logs {
+		logs[i] = redactText(line)
+	}
+
+	zw := zip.NewWriter(w)
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"version.json", s.versionInfo()},
+		{"config.json", s.configSummary()},
+		{"themes.json", s.themeStatus(ctx, userID)},
+		{"disk.json", s.diskUsage(ctx, userID)},
+		{"errors.json", s.recentErrors(logs)},
+	}
+	for _, f := range files {
+		data, err := json.MarshalIndent(f.data, "", "  ")
+		if err != nil {
+			return fmt.Errorf("生成 %s 失败: %w", f.name, err)
+		}
+		if err := writeZipFile(zw, f.name, data); err != nil {
+			return err
+		}
+	}
+	if err := writeZipFile(zw, "logs.txt", []byte(strings.Join(logs, "\n")+"\n")); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func writeZipFile(zw *zip.Writer, name string, data []byte) error {
+	fw, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return fmt.Errorf("写入 %s 失败: %w", name, err)
+	}
+	if _, err := fw.Write(data); err != nil {
+		return fmt.Errorf("写入 %s 失败: %w", name, err)
+	}
+	return nil
+}
+
+func (s *Service) versionInfo() VersionInfo {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	return VersionInfo{
+		Build:       version.GetBuildInfo(),
+		Identity:    httpclient.CurrentIdentity(),
+		OS:          runtime.GOOS,
+		Arch:        runtime.GOARCH,
+		NumCPU:      runtime.NumCPU(),
+		Goroutines:  runtime.NumGoroutine(),
+		HeapAllocMB: mem.HeapAlloc >> 20,
+		SysMB:       mem.Sys >> 20,
+		Uptime:      time.Since(startedAt).Round(time.Second).String(),
+		GeneratedAt: time.Now(),
+	}
+}
+
+func (s *Service) configSummary() ConfigSummary {
+	summary := ConfigSummary{Deployment: map[string]string{}, Settings: map[string]string{}}
+	if s.cfg != nil {
+		for _, key := range config.Keys() {
+			summary.Deployment[key] = redactValue(key, s.cfg.GetString(key))
+		}
+	}
+	for _, def := range configdef.AllSettings {
+		key := def.Key.String()
+		summary.Settings[key] = redactValue(key, s.settingSvc.Get(key))
+	}
+	return summary
+}
+
+func (s *Service) themeStatus(ctx context.Context, userID uint) ThemeStatus {
+	status := ThemeStatus{
+		StaticModeActive: s.themeSvc.IsStaticModeActive(),
+		SSRInstalled:     []ssr.ThemeInfo{},
+		SSRRunning:       []string{},
+	}
+	if current, err := s.themeSvc.GetCurrentTheme(ctx, userID); err == nil && current != nil {
+		status.Current = current.Name
+		status.CurrentVersion = current.InstalledVersion
+	} else if err != nil {
+		status.Warnings = append(status.Warnings, "获取当前主题失败: "+err.Error())
+	}
+	if s.ssrManager != nil {
+		if installed, err := s.ssrManager.ListInstalled(); err == nil {
+			status.SSRInstalled = installed
+		} else {
+			status.Warnings = append(status.Warnings, "获取 SSR 主题失败: "+err.Error())
+		}
+		status.SSRRunning = s.ssrManager.ListRunning()
+	}
+	return status
+}
+
+func (s *Service) diskUsage(ctx context.Context, userID uint) DiskUsage {
+	usage := DiskUsage{Directories: map[string]int64{}}
+	for _, dir := range []string{dataDir, theme.ThemesDirName, theme.StaticDirName, theme.BackupDirName} {
+		usage.Directories[dir] = dirSize(dir)
+	}
+	report, err := s.themeSvc.GetStorageReport(ctx, userID)
+	if err != nil {
+		usage.Warnings = append(usage.Warnings, "获取主题存储报告失败: "+err.Error())
+	}
+	usage.Themes = report
+	return usage
+}
+
+func (s *Service) recentErrors(logs []string) RecentErrors {
+	result := RecentErrors{
+		Requests:  []accesslog.Entry{},
+		Upstreams: []httpclient.HostMetrics{},
+		Logs:      errorLines(logs, recentErrorLimit),
+	}
+	if s.accessLogs != nil {
+		for _, entry := range s.accessLogs.List(accesslog.Filter{MinStatus: 500, Limit: recentErrorLimit}) {
+			entry.Path = redactText(entry.Path)
+			entry.ClientIP = util.AnonymizeIP(entry.ClientIP)
+			// 外部调用列表与访问日志共用底层数组，复制后再脱敏
+			calls := make([]accesslog.ExternalCall, len(entry.ExternalCalls))
+			for i, call := range entry.ExternalCalls {
+				call.Path = redactText(call.Path)
+				call.Error = redactText(call.Error)
+				calls[i] = call
+			}
+			entry.ExternalCalls = calls
+			result.Requests = append(result.Requests, entry)
+		}
+	}
+	for _, m := range httpclient.Metrics() {
+		if m.Failures > 0 || m.Rejected > 0 {
+			m.LastError = redactText(m.LastError)
+			result.Upstreams = append(result.Upstreams, m)
+		}
+	}
+	return result
+}
+
+// dirSize 目录占用的字节数，目录不存在时为 0
+func dirSize(root string) int64 {
+	var size int64
+	filepath.WalkDir(root, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		return nil
+	})
+	return size
+}
+
+// BundleFilename 诊断包的下载文件名
+func BundleFilename() string {
+	return "anheyu-diagnostics-" + time.Now().Format("20060102-150405") + ".zip"
+}
@@ -0,0 +1,299 @@
+/*
+ * @Description: 错误上报服务（兼容 Sentry 协议）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 通过 Sentry 的 store 接口上报 panic 和已处理的错误，兼容 Sentry、GlitchTip 等实现。
+ * DSN 从后台配置读取，修改后立即生效；未配置 DSN 时上报调用直接忽略。
+ */
+package errorreport
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// 事件级别
+const (
+	LevelError   = "error"
+	LevelFatal   = "fatal"
+	LevelWarning = "warning"
+)
+
+// queueSize 待发送事件队列长度，队列满时丢弃新事件，避免错误风暴拖垮服务
+const queueSize = 100
+
+// Event 一条待上报的错误事件
+type Event struct {
+	Level   string
+	Message string
+	Err     error
+	Tags    map[string]string
+	Extra   map[string]interface{}
+	Request *http.Request
+	// Stack 为 panic 时采集的调用栈，为空时在上报处采集
+	Stack []uintptr
+}
+
+// Reporter 错误上报器
+type Reporter struct {
+	settingSvc setting.SettingService
+	release    string
+	serverName string
+	httpClient *http.Client
+	queue      chan map[string]interface{}
+}
+
+var (
+	defaultReporter   *Reporter
+	defaultReporterMu sync.RWMutex
+)
+
+// Init 初始化全局错误上报器，应在应用启动时调用一次
+func Init(settingSvc setting.SettingService, release string) *Reporter {
+	hostname, _ := os.Hostname()
+	r := &Reporter{
+		settingSvc: settingSvc,
+		release:    release,
+		serverName: hostname,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		queue:      make(chan map[string]interface{}, queueSize),
+	}
+	go r.worker()
+
+	defaultReporterMu.Lock()
+	defaultReporter = r
+	defaultReporterMu.Unlock()
+	return r
+}
+
+// Default 返回全局错误上报器，未初始化时返回 nil
+func Default() *Reporter {
+	defaultReporterMu.RLock()
+	defer defaultReporterMu.RUnlock()
+	return defaultReporter
+}
+
+// CaptureError 使用全局上报器上报一个已处理的错误
+// tags 用于标记错误来源，如 {"component": "theme", "action": "switch"}
+func CaptureError(err error, tags map[string]string) {
+	if err == nil {
+		return
+	}
+	if r := Default(); r != nil {
+		r.Capture(Event{Level: LevelError, Err: err, Tags: tags})
+	}
+}
+
+// CapturePanic 使用全局上报器上报 panic
+func CapturePanic(recovered interface{}, req *http.Request, stack []uintptr) {
+	if r := Default(); r != nil {
+		r.Capture(Event{
+			Level:   LevelFatal,
+			Message: fmt.Sprintf("panic: %v", recovered),
+			Tags:    map[string]string{"mechanism": "recover"},
+			Request: req,
+			Stack:   stack,
+		})
+	}
+}
+
+// dsnInfo 解析后的 DSN
+type dsnInfo struct {
+	storeURL  string
+	publicKey string
+}
+
+// parseDSN 解析 Sentry DSN: {scheme}://{public_key}@{host}/{project_id}
+func parseDSN(dsn string) (*dsnInfo, error) {
+	u, err := url.Parse(strings.TrimSpace(dsn))
+	if err != nil {
+		return nil, fmt.Errorf("无效的 DSN: %w", err)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return nil, fmt.Errorf("DSN 缺少公钥")
+	}
+	path := strings.Trim(u.Path, "/")
+	idx := strings.LastIndex(path, "/")
+	projectID := path[idx+1:]
+	if projectID == "" {
+		return nil, fmt.Errorf("DSN 缺少项目 ID")
+	}
+	prefix := ""
+	if idx > 0 {
+		prefix = "/" + path[:idx]
+	}
+	return &dsnInfo{
+		storeURL:  fmt.Sprintf("%s://%s%s/api/%s/store/", u.Scheme, u.Host, prefix, projectID),
+		publicKey: u.User.Username(),
+	}, nil
+}
+
+// Enabled 是否已配置 DSN
+func (r *Reporter) Enabled() bool {
+	return strings.TrimSpace(r.settingSvc.Get(constant.KeyErrorReportDSN.String())) != ""
+}
+
+// Capture 构建事件并放入发送队列
+func (r *Reporter) Capture(event Event) {
+	if !r.Enabled() {
+		return
+	}
+
+	payload := r.buildPayload(event)
+	select {
+	case r.queue <- payload:
+	default:
+		log.Printf("[错误上报] 发送队列已满，丢弃事件: %s", payload["message"])
+	}
+}
+
+// buildPayload 按 Sentry 事件格式组装数据
+func (r *Reporter) buildPayload(event Event) map[string]interface{} {
+	message := event.Message
+	excType := "error"
+	if event.Err != nil {
+		if message == "" {
+			message = event.Err.Error()
+		}
+		excType = fmt.Sprintf("%T", event.Err)
+	}
+	if event.Level == LevelFatal {
+		excType = "panic"
+	}
+
+	stack := event.Stack
+	if len(stack) == 0 {
+		stack = make([]uintptr, 32)
+		// 跳过 runtime.Callers、buildPayload、Capture 以及全局包装函数
+		stack = stack[:runtime.Callers(4, stack)]
+	}
+
+	tags := map[string]string{}
+	for k, v := range event.Tags {
+		tags[k] = v
+	}
+
+	payload := map[string]interface{}{
+		"event_id":    newEventID(),
+		"timestamp":   time.Now().UTC().Format(time.RFC3339),
+		"level":       event.Level,
+		"platform":    "go",
+		"logger":      "anheyu-app",
+		"release":     r.release,
+		"environment": r.environment(),
+		"server_name": r.serverName,
+		"message":     message,
+		"tags":        tags,
+		"exception": map[string]interface{}{
+			"values": []map[string]interface{}{{
+				"type":       excType,
+				"value":      message,
+				"stacktrace": map[string]interface{}{"frames": buildFrames(stack)},
+			}},
+		},
+	}
+	if len(event.Extra) > 0 {
+		payload["extra"] = event.Extra
+	}
+	if event.Request != nil {
+		payload["request"] = map[string]interface{}{
+			"url":          event.Request.URL.String(),
+			"method":       event.Request.Method,
+			"query_string": event.Request.URL.RawQuery,
+		}
+	}
+	return payload
+}
+
+func (r *Reporter) environment() string {
+	if env := strings.TrimSpace(r.settingSvc.Get(constant.KeyErrorReportEnvironment.String())); env != "" {
+		return env
+	}
+	return "production"
+}
+
+// worker 后台发送事件
+func (r *Reporter) worker() {
+	for payload := range r.queue {
+		if err := r.send(payload); err != nil {
+			log.Printf("[错误上报] 发送失败: %v", err)
+		}
+	}
+}
+
+func (r *Reporter) send(payload map[string]interface{}) error {
+	dsn, err := parseDSN(r.settingSvc.Get(constant.KeyErrorReportDSN.String()))
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("序列化事件失败: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, dsn.storeURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("创建请求失败: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf(
+		"Sentry sentry_version=7, sentry_client=anheyu-app/%s, sentry_timestamp=%d, sentry_key=%s",
+		r.release, time.Now().Unix(), dsn.publicKey))
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("服务端返回状态码 %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// buildFrames 将调用栈转换为 Sentry 帧格式（最内层帧在最后）
+func buildFrames(stack []uintptr) []map[string]interface{} {
+	frames := runtime.CallersFrames(stack)
+	var result []map[string]interface{}
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" {
+			result = append(result, map[string]interface{}{
+				"function": frame.Function,
+				"abs_path": frame.File,
+				"filename": frame.File,
+				"lineno":   frame.Line,
+				"in_app":   strings.Contains(frame.Function, "anheyu-app"),
+			})
+		}
+		if !more {
+			break
+		}
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
+
+func newEventID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,44 @@
+/*
+ * @Description: ICO 文件编码
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package favicon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+)
+
+// encodeICO 生成包含多个尺寸的 favicon.ico，每个尺寸以 PNG 格式内嵌（Windows Vista 起的浏览器均支持）
+func encodeICO(src image.Image, sizes []int) ([]byte, error) {
+	images := make([][]byte, 0, len(sizes))
+	for _, size := range sizes {
+		data, err := encodePNG(renderIcon(src, iconSpec{size: size}, color.NRGBA{}))
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, data)
+	}
+
+	var buf bytes.Buffer
+	// ICONDIR：保留字段、类型（1 表示图标）、图像数量
+	binary.Write(&buf, binary.LittleEndian, [3]uint16{0, 1, uint16(len(images))})
+	offset := 6 + 16*len(images)
+	for i, data := range images {
+		// ICONDIRENTRY 中宽高为 0 表示 256
+		dim := uint8(sizes[i] % 256)
+		binary.Write(&buf, binary.LittleEndian, struct {
+			Width, Height, Colors, Reserved uint8
+			Planes, BitCount                uint16
+			Size, Offset                    uint32
+		}{dim, dim, 0, 0, 1, 32, uint32(len(data)), uint32(offset)})
+		offset += len(data)
+	}
+	for _, data := range images {
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,311 @@
+/*
+ * @Description: 由一张 Logo 生成全套站点图标和 Web App Manifest
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 管理员上传一张图片后，生成 favicon.ico、各尺寸 PNG 图标、apple-touch-icon 和可遮罩（maskable）图标，
+ * 通过文章图片存储策略保存并创建直链，同时更新 ICON_URL 和两个尺寸的 LOGO 配置。
+ * 前台页面据此注入 link 标签；manifest 由本站的 /site.webmanifest 动态输出，
+ * 站点名称和主题色修改后无需重新生成，且 start_url 与页面同源。
+ */
+package favicon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "image/gif"
+	_ "image/jpeg"
+
+	"github.com/disintegration/imaging"
+	_ "golang.org/x/image/bmp"
+	_ "golang.org/x/image/webp"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/direct_link"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// ManifestPath Web App Manifest 的地址
+const ManifestPath = "/site.webmanifest"
+
+const (
+	// MaxSourceSize 上传图片的大小上限
+	MaxSourceSize = 10 << 20
+	// MinSourceSize 上传图片最短边的像素下限，更小的图片放大到 512 后会明显模糊
+	MinSourceSize = 192
+	// maskableSafeZone 可遮罩图标的安全区域占比，系统可能裁掉安全区域以外的部分
+	maskableSafeZone = 0.8
+)
+
+var (
+	ErrSourceTooLarge = fmt.Errorf("图片不能超过 %dMB", MaxSourceSize>>20)
+	ErrSourceTooSmall = fmt.Errorf("图片的宽和高都不能小于 %d 像素，建议使用 512x512 以上的正方形图片", MinSourceSize)
+	ErrSourceInvalid  = errors.New("无法识别的图片格式，请上传 PNG、JPEG、WebP、GIF 或 BMP 图片")
+)
+
+// icoSizes favicon.ico 中包含的尺寸
+var icoSizes = []int{16, 32, 48}
+
+// Icon 生成的单个图标
+type Icon struct {
+	Name    string `json:"name"`              // 文件名，如 favicon-32x32.png
+	URL     string `json:"url"`               // 直链地址
+	Size    int    `json:"size"`              // 边长（像素），favicon.ico 为其中最大的尺寸
+	Type    string `json:"type"`              // MIME 类型
+	Rel     string `json:"rel,omitempty"`     // 页面中 link 标签的 rel，不在页面中引用时为空
+	Purpose string `json:"purpose,omitempty"` // manifest 中的 purpose，不在 manifest 中引用时为空
+}
+
+// IconSet 一次生成的全部图标
+type IconSet struct {
+	Icons       []Icon    `json:"icons"`
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// iconSpec 需要生成的图标
+type iconSpec struct {
+	name    string
+	size    int
+	rel     string
+	purpose string
+	flatten bool // 铺上背景色，iOS 会把透明区域显示为黑色
+	padded  bool // 缩小到安全区域内
+}
+
+var iconSpecs = []iconSpec{
+	{name: "favicon-16x16.png", size: 16, rel: "icon"},
+	{name: "favicon-32x32.png", size: 32, rel: "icon"},
+	{name: "apple-touch-icon.png", size: 180, rel: "apple-touch-icon", flatten: true},
+	{name: "android-chrome-192x192.png", size: 192, purpose: "any"},
+	{name: "android-chrome-512x512.png", size: 512, purpose: "any"},
+	{name: "maskable-512x512.png", size: 512, purpose: "maskable", flatten: true, padded: true},
+}
+
+// Service 站点图标服务
+type Service struct {
+	settingSvc    setting.SettingService
+	fileSvc       file.FileService
+	directLinkSvc direct_link.Service
+}
+
+// NewService 创建站点图标服务
+func NewService(settingSvc setting.SettingService, fileSvc file.FileService, directLinkSvc direct_link.Service) *Service {
+	return &Service{settingSvc: settingSvc, fileSvc: fileSvc, directLinkSvc: directLinkSvc}
+}
+
+// Generate 由上传的图片生成全套图标，上传到存储后更新图标相关配置
+func (s *Service) Generate(ctx context.Context, ownerID, userGroupID uint, r io.Reader) (*IconSet, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxSourceSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("读取图片失败: %w", err)
+	}
+	if len(data) > MaxSourceSize {
+		return nil, ErrSourceTooLarge
+	}
+	src, err := imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, ErrSourceInvalid
+	}
+	if b := src.Bounds(); b.Dx() < MinSourceSize || b.Dy() < MinSourceSize {
+		return nil, ErrSourceTooSmall
+	}
+	background := parseHexColor(s.settingSvc.Get(constant.KeySiteIconBackground.String()), color.NRGBA{255, 255, 255, 255})
+
+	// 同一批图标使用相同的时间戳前缀，避免与已上传的旧图标重名
+	prefix := strconv.FormatInt(time.Now().UnixNano(), 10) + "-"
+	set := &IconSet{GeneratedAt: time.Now()}
+	for _, spec := range iconSpecs {
+		encoded, err := encodePNG(renderIcon(src, spec, background))
+		if err != nil {
+			return nil, err
+		}
+		url, err := s.upload(ctx, ownerID, userGroupID, prefix+spec.name, encoded)
+		if err != nil {
+			return nil, err
+		}
+		set.Icons = append(set.Icons, Icon{Name: spec.name, URL: url, Size: spec.size, Type: "image/png", Rel: spec.rel, Purpose: spec.purpose})
+	}
+
+	ico, err := encodeICO(src, icoSizes)
+	if err != nil {
+		return nil, err
+	}
+	icoURL, err := s.upload(ctx, ownerID, userGroupID, prefix+"favicon.ico", ico)
+	if err != nil {
+		return nil, err
+	}
+	set.Icons = append(set.Icons, Icon{Name: "favicon.ico", URL: icoURL, Size: icoSizes[len(icoSizes)-1], Type: "image/x-icon"})
+
+	raw, err := json.Marshal(set)
+	if err != nil {
+		return nil, err
+	}
+	updates := map[string]string{
+		constant.KeySiteIconSet.String(): string(raw),
+		constant.KeyIconURL.String():     icoURL,
+	}
+	if icon := set.find("android-chrome-192x192.png"); icon != nil {
+		updates[constant.KeyLogoURL192.String()] = icon.URL
+	}
+	if icon := set.find("android-chrome-512x512.png"); icon != nil {
+		updates[constant.KeyLogoURL512.String()] = icon.URL
+	}
+	if err := s.settingSvc.UpdateSettings(ctx, updates); err != nil {
+		return nil, fmt.Errorf("保存图标配置失败: %w", err)
+	}
+	log.Printf("[站点图标] 已生成 %d 个图标", len(set.Icons))
+	return set, nil
+}
+
+// upload 通过文章图片存储策略上传图标并返回直链
+func (s *Service) upload(ctx context.Context, ownerID, userGroupID uint, name string, data []byte) (string, error) {
+	item, err := s.fileSvc.UploadFileByPolicyFlagWithGroup(ctx, ownerID, userGroupID, bytes.NewReader(data), constant.PolicyFlagArticleImage, name)
+	if err != nil {
+		return "", fmt.Errorf("上传 %s 失败: %w", name, err)
+	}
+	fileID, _, err := idgen.DecodePublicID(item.ID)
+	if err != nil {
+		return "", fmt.Errorf("无效的文件ID: %w", err)
+	}
+	links, err := s.directLinkSvc.GetOrCreateDirectLinks(ctx, userGroupID, []uint{fileID})
+	if err != nil {
+		return "", fmt.Errorf("为 %s 创建直链失败: %w", name, err)
+	}
+	link, ok := links[fileID]
+	if !ok || link.URL == "" {
+		return "", fmt.Errorf("获取 %s 的直链失败", name)
+	}
+	return link.URL, nil
+}
+
+// Current 返回当前使用的图标，尚未生成时返回 nil
+func Current(settingSvc setting.SettingService) *IconSet {
+	raw := settingSvc.Get(constant.KeySiteIconSet.String())
+	if raw == "" {
+		return nil
+	}
+	var set IconSet
+	if err := json.Unmarshal([]byte(raw), &set); err != nil || len(set.Icons) == 0 {
+		return nil
+	}
+	return &set
+}
+
+func (set *IconSet) find(name string) *Icon {
+	for i := range set.Icons {
+		if set.Icons[i].Name == name {
+			return &set.Icons[i]
+		}
+	}
+	return nil
+}
+
+// HeadHTML 返回需要注入到页面 head 中的图标和 manifest 标签，尚未生成图标时返回空字符串
+func HeadHTML(settingSvc setting.SettingService) string {
+	set := Current(settingSvc)
+	if set == nil {
+		return ""
+	}
+	var buf strings.Builder
+	for _, icon := range set.Icons {
+		if icon.Rel == "" {
+			continue
+		}
+		fmt.Fprintf(&buf, "<link rel=\"%s\" type=\"%s\" sizes=\"%dx%d\" href=\"%s\">\n", icon.Rel, icon.Type, icon.Size, icon.Size, html.EscapeString(icon.URL))
+	}
+	fmt.Fprintf(&buf, "<link rel=\"manifest\" href=\"%s\">\n", ManifestPath)
+	return buf.String()
+}
+
+// Manifest 根据当前的站点配置和图标生成 Web App Manifest，尚未生成图标时返回 nil
+func Manifest(settingSvc setting.SettingService) map[string]interface{} {
+	set := Current(settingSvc)
+	if set == nil {
+		return nil
+	}
+	icons := make([]map[string]string, 0, len(set.Icons))
+	for _, icon := range set.Icons {
+		if icon.Purpose == "" {
+			continue
+		}
+		icons = append(icons, map[string]string{
+			"src":     icon.URL,
+			"sizes":   fmt.Sprintf("%dx%d", icon.Size, icon.Size),
+			"type":    icon.Type,
+			"purpose": icon.Purpose,
+		})
+	}
+	name := settingSvc.Get(constant.KeyAppName.String())
+	manifest := map[string]interface{}{
+		"name":             name,
+		"short_name":       name,
+		"description":      settingSvc.Get(constant.KeySiteDescription.String()),
+		"start_url":        "/",
+		"scope":            "/",
+		"display":          "standalone",
+		"background_color": settingSvc.Get(constant.KeySiteIconBackground.String()),
+		"icons":            icons,
+	}
+	if themeColor := settingSvc.Get(constant.KeyThemeColor.String()); themeColor != "" {
+		manifest["theme_color"] = themeColor
+	}
+	return manifest
+}
+
+// renderIcon 将图片缩放为指定尺寸的正方形图标，非正方形的图片居中并在两侧留白
+func renderIcon(src image.Image, spec iconSpec, background color.NRGBA) *image.NRGBA {
+	inner := spec.size
+	if spec.padded {
+		inner = int(float64(spec.size) * maskableSafeZone)
+	}
+	fitted := imaging.Fit(src, inner, inner, imaging.Lanczos)
+
+	var canvas *image.NRGBA
+	if spec.flatten {
+		canvas = imaging.New(spec.size, spec.size, background)
+	} else {
+		canvas = imaging.New(spec.size, spec.size, color.NRGBA{})
+	}
+	offset := image.Pt((spec.size-fitted.Bounds().Dx())/2, (spec.size-fitted.Bounds().Dy())/2)
+	draw.Draw(canvas, fitted.Bounds().Add(offset), fitted, fitted.Bounds().Min, draw.Over)
+	return canvas
+}
+
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := imaging.Encode(&buf, img, imaging.PNG); err != nil {
+		return nil, fmt.Errorf("编码图标失败: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// parseHexColor 解析 #rgb 或 #rrggbb 格式的颜色，无法解析时返回 fallback
+func parseHexColor(value string, fallback color.NRGBA) color.NRGBA {
+	hex := strings.TrimPrefix(strings.TrimSpace(value), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return fallback
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return fallback
+	}
+	return color.NRGBA{R: uint8(n >> 16), G: uint8(n >> 8), B: uint8(n), A: 255}
+}
@@ -0,0 +1,326 @@
+/*
+ * @Description: 文章和页面与 Markdown 文件之间的转换
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package gitsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/frontmatter"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// 同步的内容类型
+const (
+	KindArticle = "article"
+	KindPage    = "page"
+)
+
+// dateLayout 导出到 frontmatter 的时间格式
+const dateLayout = "2006-01-02 15:04:05"
+
+// keyMap frontmatter 字段名映射，键为内置字段名，值为文件中使用的字段名
+type keyMap map[string]string
+
+func (m keyMap) key(name string) string {
+	if k := m[name]; k != "" {
+		return k
+	}
+	return name
+}
+
+// siteItem 站内的一篇文章或一个页面
+type siteItem struct {
+	Kind    string
+	ID      string
+	Key     string // 与文件匹配的标识：文章为 abbrlink，页面为路径
+	Title   string
+	Content []byte // 导出的 Markdown 文件内容
+}
+
+func exportArticle(m keyMap, a *model.Article) ([]byte, error) {
+	tags := make([]string, 0, len(a.PostTags))
+	for _, t := range a.PostTags {
+		tags = append(tags, t.Name)
+	}
+	categories := make([]string, 0, len(a.PostCategories))
+	for _, c := range a.PostCategories {
+		categories = append(categories, c.Name)
+	}
+	var summary string
+	if len(a.Summaries) > 0 {
+		summary = a.Summaries[0]
+	}
+	return frontmatter.Join([]frontmatter.Field{
+		{Key: m.key("title"), Value: a.Title},
+		{Key: m.key("abbrlink"), Value: a.Abbrlink},
+		{Key: m.key("date"), Value: a.CreatedAt.In(time.Local).Format(dateLayout)},
+		{Key: m.key("status"), Value: a.Status},
+		{Key: m.key("tags"), Value: tags},
+		{Key: m.key("categories"), Value: categories},
+		{Key: m.key("cover"), Value: a.CoverURL},
+		{Key: m.key("keywords"), Value: a.Keywords},
+		{Key: m.key("description"), Value: summary},
+	}, a.ContentMd)
+}
+
+func exportPage(p *model.Page) ([]byte, error) {
+	return frontmatter.Join([]frontmatter.Field{
+		{Key: "title", Value: p.Title},
+		{Key: "path", Value: p.Path},
+		{Key: "description", Value: p.Description},
+		{Key: "published", Value: p.IsPublished},
+		{Key: "comment", Value: p.ShowComment},
+		{Key: "sort", Value: p.Sort},
+	}, p.MarkdownContent)
+}
+
+// fileKey 从文件内容中取出与站内内容匹配的标识
+func fileKey(kind string, m keyMap, filePath string, data []byte) string {
+	meta, _, err := frontmatter.Split(data)
+	if err != nil {
+		return ""
+	}
+	if kind == KindPage {
+		return pagePath(meta, filePath)
+	}
+	return articleSlug(m, meta, filePath)
+}
+
+func articleSlug(m keyMap, meta frontmatter.Meta, filePath string) string {
+	if slug := strings.TrimSpace(meta.String(m.key("abbrlink"))); slug != "" {
+		return slug
+	}
+	return strings.Join(strings.Fields(strings.TrimSuffix(path.Base(filePath), path.Ext(filePath))), "-")
+}
+
+func pagePath(meta frontmatter.Meta, filePath string) string {
+	if p := strings.TrimSpace(meta.String("path")); p != "" {
+		return p
+	}
+	return "/" + strings.TrimSuffix(path.Base(filePath), path.Ext(filePath))
+}
+
+// applyArticle 用文件内容创建或更新文章，id 为空时创建，返回文章公共 ID
+func (s *Service) applyArticle(ctx context.Context, m keyMap, tax *taxonomy, id, filePath string, data []byte) (string, error) {
+	meta, body, err := frontmatter.Split(data)
+	if err != nil {
+		return "", err
+	}
+	title := strings.TrimSpace(meta.String(m.key("title")))
+	if title == "" {
+		return "", errors.New("缺少标题")
+	}
+	slug := articleSlug(m, meta, filePath)
+	status := normalizeStatus(meta.String(m.key("status")))
+	tagIDs, err := tax.tagIDs(ctx, meta.List(m.key("tags")))
+	if err != nil {
+		return "", fmt.Errorf("处理标签失败: %w", err)
+	}
+	categoryIDs, err := tax.categoryIDs(ctx, meta.List(m.key("categories")))
+	if err != nil {
+		return "", fmt.Errorf("处理分类失败: %w", err)
+	}
+	html, err := s.parserSvc.ToHTML(ctx, body)
+	if err != nil {
+		return "", fmt.Errorf("渲染 Markdown 失败: %w", err)
+	}
+	cover := meta.String(m.key("cover"))
+	keywords := meta.String(m.key("keywords"))
+	var summaries []string
+	if summary := meta.String(m.key("description")); summary != "" {
+		summaries = []string{summary}
+	}
+	var publishedAt *string
+	if date := meta.Time(m.key("date")); date != nil {
+		v := date.Format(time.RFC3339)
+		publishedAt = &v
+	}
+
+	if id != "" {
+		req := &model.UpdateArticleRequest{
+			Title:             &title,
+			ContentMd:         &body,
+			ContentHTML:       &html,
+			Abbrlink:          &slug,
+			CoverURL:          &cover,
+			Keywords:          &keywords,
+			Summaries:         summaries,
+			PostTagIDs:        tagIDs,
+			PostCategoryIDs:   categoryIDs,
+			CustomPublishedAt: publishedAt,
+		}
+		if summaries == nil {
+			req.Summaries = []string{}
+		}
+		if status != "" {
+			req.Status = &status
+		}
+		if _, err := s.articleSvc.Update(ctx, id, req, "", ""); err != nil {
+			return "", err
+		}
+		return id, nil
+	}
+
+	if status == "" {
+		status = "DRAFT"
+	}
+	created, err := s.articleSvc.Create(ctx, &model.CreateArticleRequest{
+		Title:             title,
+		ContentMd:         body,
+		ContentHTML:       html,
+		Status:            status,
+		Abbrlink:          slug,
+		CoverURL:          cover,
+		Keywords:          keywords,
+		Summaries:         summaries,
+		PostTagIDs:        tagIDs,
+		PostCategoryIDs:   categoryIDs,
+		CustomPublishedAt: publishedAt,
+		OwnerID:           defaultOwnerID,
+	}, "", "")
+	if err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+// applyPage 用文件内容创建或更新页面，id 为空时创建，返回页面 ID
+func (s *Service) applyPage(ctx context.Context, id, filePath string, data []byte) (string, error) {
+	meta, body, err := frontmatter.Split(data)
+	if err != nil {
+		return "", err
+	}
+	title := strings.TrimSpace(meta.String("title"))
+	if title == "" {
+		return "", errors.New("缺少标题")
+	}
+	pathValue := pagePath(meta, filePath)
+	html, err := s.parserSvc.ToHTML(ctx, body)
+	if err != nil {
+		return "", fmt.Errorf("渲染 Markdown 失败: %w", err)
+	}
+	description := meta.String("description")
+	published := true
+	if b := meta.Bool("published"); b != nil {
+		published = *b
+	}
+	comment := false
+	if b := meta.Bool("comment"); b != nil {
+		comment = *b
+	}
+	sort := 0
+	if n := meta.Int("sort"); n != nil {
+		sort = *n
+	}
+
+	if id != "" {
+		if _, err := s.pageSvc.Update(ctx, id, &model.UpdatePageOptions{
+			Title:           &title,
+			Path:            &pathValue,
+			Content:         &html,
+			MarkdownContent: &body,
+			Description:     &description,
+			IsPublished:     &published,
+			ShowComment:     &comment,
+			Sort:            &sort,
+		}); err != nil {
+			return "", err
+		}
+		return id, nil
+	}
+	page, err := s.pageSvc.Create(ctx, &model.CreatePageOptions{
+		Title:           title,
+		Path:            pathValue,
+		Content:         html,
+		MarkdownContent: body,
+		Description:     description,
+		IsPublished:     published,
+		ShowComment:     comment,
+		Sort:            sort,
+	})
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatUint(uint64(page.ID), 10), nil
+}
+
+func normalizeStatus(status string) string {
+	switch s := strings.ToUpper(strings.TrimSpace(status)); s {
+	case "DRAFT", "PUBLISHED", "ARCHIVED":
+		return s
+	default:
+		return ""
+	}
+}
+
+// taxonomy 按名称解析标签和分类，不存在时自动创建，一次同步内缓存查询结果
+type taxonomy struct {
+	postTagRepo      repository.PostTagRepository
+	postCategoryRepo repository.PostCategoryRepository
+	tags             map[string]string
+	categories       map[string]string
+}
+
+func (t *taxonomy) tagIDs(ctx context.Context, names []string) ([]string, error) {
+	if t.tags == nil {
+		tags, err := t.postTagRepo.List(ctx, &model.ListPostTagsOptions{})
+		if err != nil {
+			return nil, err
+		}
+		t.tags = make(map[string]string, len(tags))
+		for _, tag := range tags {
+			t.tags[tag.Name] = tag.ID
+		}
+	}
+	ids := []string{}
+	for _, name := range names {
+		id, ok := t.tags[name]
+		if !ok {
+			tag, err := t.postTagRepo.Create(ctx, &model.CreatePostTagRequest{Name: name})
+			if err != nil {
+				return nil, fmt.Errorf("创建标签 %s 失败: %w", name, err)
+			}
+			id = tag.ID
+			t.tags[name] = id
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (t *taxonomy) categoryIDs(ctx context.Context, names []string) ([]string, error) {
+	if t.categories == nil {
+		categories, err := t.postCategoryRepo.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t.categories = make(map[string]string, len(categories))
+		for _, category := range categories {
+			t.categories[category.Name] = category.ID
+		}
+	}
+	ids := []string{}
+	for _, name := range names {
+		id, ok := t.categories[name]
+		if !ok {
+			category, err := t.postCategoryRepo.Create(ctx, &model.CreatePostCategoryRequest{Name: name})
+			if err != nil {
+				return nil, fmt.Errorf("创建分类 %s 失败: %w", name, err)
+			}
+			id = category.ID
+			t.categories[name] = id
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,218 @@
+/*
+ * @Description: Git 内容同步使用的 git 命令封装
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package gitsync
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// gitTimeout 单条 git 命令的超时时间
+const gitTimeout = 2 * time.Minute
+
+// ErrInvalidRepoURL 仓库地址不是允许的格式
+var ErrInvalidRepoURL = errors.New("仓库地址只支持 https://、ssh://、user@host:path 和服务器上的绝对路径")
+
+var (
+	// scpURLPattern scp 风格的 SSH 地址，如 git@github.com:owner/repo.git
+	scpURLPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+@[A-Za-z0-9][A-Za-z0-9.-]*:[^:]+$`)
+	// remoteHelperPattern <transport>::<address> 形式的远程辅助程序地址，如 ext::sh -c ...
+	remoteHelperPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]*::`)
+)
+
+// validateRepoURL 校验仓库地址，拒绝以 - 开头会被 git 当作参数的值和 ext:: 等远程辅助程序地址
+func validateRepoURL(raw string) error {
+	if raw == "" || strings.HasPrefix(raw, "-") || remoteHelperPattern.MatchString(raw) {
+		return ErrInvalidRepoURL
+	}
+	switch {
+	case strings.HasPrefix(raw, "https://"), strings.HasPrefix(raw, "ssh://"):
+		u, err := url.Parse(raw)
+		if err != nil || u.Host == "" || strings.HasPrefix(u.Host, "-") {
+			return ErrInvalidRepoURL
+		}
+		return nil
+	case scpURLPattern.MatchString(raw):
+		return nil
+	case filepath.IsAbs(raw):
+		return nil
+	}
+	return ErrInvalidRepoURL
+}
+
+// repo 工作区中的 git 仓库
+type repo struct {
+	dir    string
+	url    string
+	branch string
+	token  string
+}
+
+// run 在工作区中执行 git 命令
+func (r *repo) run(ctx context.Context, args ...string) (string, error) {
+	return r.runEnv(ctx, nil, args...)
+}
+
+// runEnv 附加环境变量执行 git 命令，令牌通过环境变量注入，不会出现在进程参数中
+func (r *repo) runEnv(ctx context.Context, env []string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, gitTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = r.dir
+	// 只允许与 validateRepoURL 一致的传输协议，子模块或重定向也不能使用 ext 等协议
+	cmd.Env = append(append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GIT_ALLOW_PROTOCOL=https:ssh:file"), env...)
+	if r.token != "" {
+		auth := base64.StdEncoding.EncodeToString([]byte("oauth2:" + r.token))
+		cmd.Env = append(cmd.Env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+auth,
+		)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return "", fmt.Errorf("git %s: %s", args[0], msg)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// ensure 工作区不存在时克隆仓库，并切换到同步分支
+func (r *repo) ensure(ctx context.Context) error {
+	if _, err := os.Stat(filepath.Join(r.dir, ".git")); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(r.dir), 0755); err != nil {
+			return err
+		}
+		parent := &repo{dir: filepath.Dir(r.dir), token: r.token}
+		if err := validateRepoURL(r.url); err != nil {
+			return err
+		}
+		if _, err := parent.run(ctx, "clone", "--no-checkout", "--", r.url, filepath.Base(r.dir)); err != nil {
+			return err
+		}
+		return r.checkout(ctx)
+	}
+	if current, _ := r.run(ctx, "symbolic-ref", "--short", "-q", "HEAD"); current != r.branch {
+		return r.checkout(ctx)
+	}
+	return nil
+}
+
+// checkout 切换到同步分支；远程没有该分支时从当前提交创建，空仓库则等待首次提交
+func (r *repo) checkout(ctx context.Context) error {
+	if r.hasRemoteBranch(ctx) {
+		_, err := r.run(ctx, "checkout", "-B", r.branch, "origin/"+r.branch)
+		return err
+	}
+	if _, err := r.run(ctx, "rev-parse", "--verify", "--quiet", "HEAD"); err != nil {
+		_, err := r.run(ctx, "symbolic-ref", "HEAD", "refs/heads/"+r.branch)
+		return err
+	}
+	_, err := r.run(ctx, "checkout", "-B", r.branch)
+	return err
+}
+
+func (r *repo) hasRemoteBranch(ctx context.Context) bool {
+	_, err := r.run(ctx, "rev-parse", "--verify", "--quiet", "refs/remotes/origin/"+r.branch)
+	return err == nil
+}
+
+// pull 拉取远程分支并把本地未推送的提交变基到最新
+func (r *repo) pull(ctx context.Context) error {
+	if _, err := r.run(ctx, "fetch", "--prune", "origin"); err != nil {
+		return err
+	}
+	if !r.hasRemoteBranch(ctx) {
+		return nil
+	}
+	if _, err := r.run(ctx, "rebase", "--autostash", "origin/"+r.branch); err != nil {
+		r.run(ctx, "rebase", "--abort")
+		return fmt.Errorf("本地提交无法变基到远程分支，请检查仓库历史: %w", err)
+	}
+	return nil
+}
+
+// commit 提交工作区中指定目录的全部变更，没有变更时返回空字符串
+func (r *repo) commit(ctx context.Context, message, committer string, dirs ...string) (string, error) {
+	args := append([]string{"add", "-A", "--"}, dirs...)
+	if _, err := r.run(ctx, args...); err != nil {
+		return "", err
+	}
+	if _, err := r.run(ctx, "diff", "--cached", "--quiet"); err == nil {
+		return "", nil
+	}
+	name, email := parseCommitter(committer)
+	env := []string{
+		"GIT_AUTHOR_NAME=" + name, "GIT_AUTHOR_EMAIL=" + email,
+		"GIT_COMMITTER_NAME=" + name, "GIT_COMMITTER_EMAIL=" + email,
+	}
+	if _, err := r.runEnv(ctx, env, "commit", "-q", "-m", message); err != nil {
+		return "", err
+	}
+	return r.run(ctx, "rev-parse", "HEAD")
+}
+
+// push 推送当前分支，返回是否有提交被推送
+func (r *repo) push(ctx context.Context) (bool, error) {
+	if r.hasRemoteBranch(ctx) {
+		ahead, err := r.run(ctx, "rev-list", "--count", "origin/"+r.branch+"..HEAD")
+		if err != nil {
+			return false, err
+		}
+		if ahead == "0" {
+			return false, nil
+		}
+	} else if _, err := r.run(ctx, "rev-parse", "--verify", "--quiet", "HEAD"); err != nil {
+		return false, nil
+	}
+	if _, err := r.run(ctx, "push", "origin", "HEAD:refs/heads/"+r.branch); err != nil {
+		return false, err
+	}
+	_, err := r.run(ctx, "fetch", "origin")
+	return true, err
+}
+
+// parseCommitter 解析 名称 <邮箱> 格式的提交作者
+func parseCommitter(committer string) (string, string) {
+	name, email := "anheyu", "anheyu@localhost"
+	committer = strings.TrimSpace(committer)
+	if i := strings.Index(committer, "<"); i >= 0 && strings.HasSuffix(committer, ">") {
+		if n := strings.TrimSpace(committer[:i]); n != "" {
+			name = n
+		}
+		if e := strings.TrimSpace(committer[i+1 : len(committer)-1]); e != "" {
+			email = e
+		}
+	} else if committer != "" {
+		name = committer
+	}
+	return name, email
+}
+
+// maskURL 去掉仓库地址中的账号密码，用于展示
+func maskURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.User == nil {
+		return raw
+	}
+	u.User = nil
+	return u.String()
+}
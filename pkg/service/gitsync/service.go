@@ -0,0 +1,928 @@
+/*
+ * @Description: Git 内容同步，文章和页面与 Git 仓库中的 Markdown 文件双向同步
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 仓库克隆在 data/gitsync/repo，同步状态保存在 data/gitsync/state.json。
+ * 每个已同步的文件记录上次同步时文件内容和站内导出内容的哈希，据此判断哪一侧发生了变化：
+ *   - 只有仓库变化：用文件内容更新站内（文件删除则删除站内内容）
+ *   - 只有站内变化：导出为文件并提交推送（站内删除则删除文件）
+ *   - 两侧都变化且内容不一致：记为冲突，由管理员选择保留哪一侧
+ * 同步由定时任务触发，保存文章或页面后也会延迟触发一次。
+ */
+package gitsync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	page_service "github.com/anzhiyu-c/anheyu-app/pkg/service/page"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// DefaultDir Git 同步的工作目录
+	DefaultDir = "data/gitsync"
+
+	// saveSyncDelay 保存后延迟同步，合并短时间内的多次保存
+	saveSyncDelay = 10 * time.Second
+	// syncTimeout 一次同步的超时时间
+	syncTimeout = 10 * time.Minute
+	// listPageSize 分页读取站内内容的每页数量
+	listPageSize = 100
+
+	// defaultOwnerID 从仓库新建的文章归属初始管理员
+	defaultOwnerID = 1
+
+	// 冲突的保留方式
+	KeepSite = "site"
+	KeepGit  = "git"
+)
+
+var (
+	ErrDisabled         = errors.New("Git 同步未启用或未配置仓库地址")
+	ErrSyncing          = errors.New("正在同步中，请稍后再试")
+	ErrConflictNotFound = errors.New("冲突不存在或已解决")
+	ErrInvalidKeep      = errors.New("keep 只能是 site 或 git")
+	ErrUnsafePath       = errors.New("仓库中的路径是符号链接或指向仓库之外")
+)
+
+// Conflict 两侧都修改过的内容
+type Conflict struct {
+	Path       string    `json:"path"`
+	Kind       string    `json:"kind"` // article 或 page
+	ID         string    `json:"id,omitempty"`
+	Title      string    `json:"title,omitempty"`
+	Reason     string    `json:"reason"`
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// Report 一次同步的结果
+type Report struct {
+	Imported     int      `json:"imported"`      // 从仓库创建或更新的站内内容
+	Exported     int      `json:"exported"`      // 导出到仓库的站内内容
+	DeletedSite  int      `json:"deleted_site"`  // 因仓库删除文件而删除的站内内容
+	DeletedFiles int      `json:"deleted_files"` // 因站内删除而删除的文件
+	Conflicts    int      `json:"conflicts"`     // 冲突数量
+	Commit       string   `json:"commit,omitempty"`
+	Pushed       bool     `json:"pushed"`
+	Errors       []string `json:"errors,omitempty"`
+}
+
+// Status 同步状态
+type Status struct {
+	Enabled    bool       `json:"enabled"`
+	RepoURL    string     `json:"repo_url"`
+	Branch     string     `json:"branch"`
+	PushOnSave bool       `json:"push_on_save"`
+	Syncing    bool       `json:"syncing"`
+	Tracked    int        `json:"tracked"` // 已同步的文件数
+	LastSyncAt *time.Time `json:"last_sync_at,omitempty"`
+	LastCommit string     `json:"last_commit,omitempty"`
+	LastError  string     `json:"last_error,omitempty"`
+	LastReport *Report    `json:"last_report,omitempty"`
+	Conflicts  []Conflict `json:"conflicts"`
+}
+
+// fileEntry 已同步文件上次同步时两侧内容的哈希
+type fileEntry struct {
+	Kind     string `json:"kind"`
+	ID       string `json:"id"`
+	FileHash string `json:"file_hash"`
+	SiteHash string `json:"site_hash"`
+}
+
+type syncState struct {
+	RepoURL    string                `json:"repo_url"`
+	Branch     string                `json:"branch"`
+	Files      map[string]*fileEntry `json:"files"`
+	Conflicts  []Conflict            `json:"conflicts"`
+	LastSyncAt *time.Time            `json:"last_sync_at,omitempty"`
+	LastCommit string                `json:"last_commit,omitempty"`
+	LastError  string                `json:"last_error,omitempty"`
+	LastReport *Report               `json:"last_report,omitempty"`
+}
+
+// config 同步配置
+type config struct {
+	repoURL    string
+	branch     string
+	token      string
+	postsDir   string
+	pagesDir   string
+	pushOnSave bool
+	mapping    keyMap
+	committer  string
+}
+
+// Service Git 内容同步服务
+type Service struct {
+	settingSvc       setting.SettingService
+	articleSvc       article_service.Service
+	articleRepo      repository.ArticleRepository
+	pageSvc          page_service.Service
+	postTagRepo      repository.PostTagRepository
+	postCategoryRepo repository.PostCategoryRepository
+	parserSvc        *parser.Service
+	dir              string
+
+	syncMu  sync.Mutex // 串行化同步和冲突处理
+	mu      sync.Mutex // 保护 state、syncing 和 timer
+	state   syncState
+	syncing bool
+	timer   *time.Timer
+}
+
+// NewService 创建 Git 内容同步服务，dir 为工作目录
+func NewService(
+	settingSvc setting.SettingService,
+	articleSvc article_service.Service,
+	articleRepo repository.ArticleRepository,
+	pageSvc page_service.Service,
+	postTagRepo repository.PostTagRepository,
+	postCategoryRepo repository.PostCategoryRepository,
+	parserSvc *parser.Service,
+	dir string,
+) *Service {
+	s := &Service{
+		settingSvc:       settingSvc,
+		articleSvc:       articleSvc,
+		articleRepo:      articleRepo,
+		pageSvc:          pageSvc,
+		postTagRepo:      postTagRepo,
+		postCategoryRepo: postCategoryRepo,
+		parserSvc:        parserSvc,
+		dir:              dir,
+		state:            syncState{Files: map[string]*fileEntry{}},
+	}
+	s.loadState()
+	return s
+}
+
+// Enabled 是否启用 Git 同步
+func (s *Service) Enabled() bool {
+	return s.settingSvc.GetBool(constant.KeyGitSyncEnable.String()) &&
+		strings.TrimSpace(s.settingSvc.Get(constant.KeyGitSyncRepoURL.String())) != ""
+}
+
+func (s *Service) config() config {
+	cfg := config{
+		repoURL:    strings.TrimSpace(s.settingSvc.Get(constant.KeyGitSyncRepoURL.String())),
+		branch:     strings.TrimSpace(s.settingSvc.Get(constant.KeyGitSyncBranch.String())),
+		token:      strings.TrimSpace(s.settingSvc.Get(constant.KeyGitSyncToken.String())),
+		postsDir:   cleanDir(s.settingSvc.Get(constant.KeyGitSyncPostsDir.String())),
+		pagesDir:   cleanDir(s.settingSvc.Get(constant.KeyGitSyncPagesDir.String())),
+		pushOnSave: s.settingSvc.GetBool(constant.KeyGitSyncPushOnSave.String()),
+		mapping:    keyMap{},
+		committer:  s.settingSvc.Get(constant.KeyGitSyncCommitter.String()),
+	}
+	if cfg.branch == "" {
+		cfg.branch = "main"
+	}
+	if cfg.postsDir == "" {
+		cfg.postsDir = "posts"
+	}
+	if raw := s.settingSvc.Get(constant.KeyGitSyncMapping.String()); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &cfg.mapping); err != nil {
+			log.Printf("[Git同步] 解析字段映射失败，使用默认字段名: %v", err)
+			cfg.mapping = keyMap{}
+		}
+	}
+	return cfg
+}
+
+// cleanDir 规范化仓库内的目录，禁止跳出仓库
+func cleanDir(dir string) string {
+	dir = strings.Trim(path.Clean("/"+strings.TrimSpace(filepath.ToSlash(dir))), "/")
+	if dir == "." {
+		return ""
+	}
+	return dir
+}
+
+func (s *Service) repoDir() string   { return filepath.Join(s.dir, "repo") }
+func (s *Service) statePath() string { return filepath.Join(s.dir, "state.json") }
+
+// Status 返回同步状态
+func (s *Service) Status() Status {
+	cfg := s.config()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conflicts := append([]Conflict{}, s.state.Conflicts...)
+	return Status{
+		Enabled:    s.Enabled(),
+		RepoURL:    maskURL(cfg.repoURL),
+		Branch:     cfg.branch,
+		PushOnSave: cfg.pushOnSave,
+		Syncing:    s.syncing,
+		Tracked:    len(s.state.Files),
+		LastSyncAt: s.state.LastSyncAt,
+		LastCommit: s.state.LastCommit,
+		LastError:  s.state.LastError,
+		LastReport: s.state.LastReport,
+		Conflicts:  conflicts,
+	}
+}
+
+// Sync 执行一次双向同步
+func (s *Service) Sync(ctx context.Context) (*Report, error) {
+	if !s.Enabled() {
+		return nil, ErrDisabled
+	}
+	if !s.syncMu.TryLock() {
+		return nil, ErrSyncing
+	}
+	defer s.syncMu.Unlock()
+
+	s.mu.Lock()
+	s.syncing = true
+	s.mu.Unlock()
+
+	report, err := s.sync(ctx, s.config())
+
+	now := time.Now()
+	s.mu.Lock()
+	s.syncing = false
+	s.state.LastSyncAt = &now
+	s.state.LastReport = report
+	s.state.LastError = ""
+	if err != nil {
+		s.state.LastError = err.Error()
+	}
+	s.mu.Unlock()
+	s.saveState()
+
+	if err != nil {
+		log.Printf("[Git同步] 同步失败: %v", err)
+		return nil, err
+	}
+	log.Printf("[Git同步] 同步完成 - 导入: %d, 导出: %d, 删除站内: %d, 删除文件: %d, 冲突: %d",
+		report.Imported, report.Exported, report.DeletedSite, report.DeletedFiles, report.Conflicts)
+	return report, nil
+}
+
+// RunScheduled 定时任务入口，未启用时跳过
+func (s *Service) RunScheduled(ctx context.Context) error {
+	if !s.Enabled() {
+		return nil
+	}
+	_, err := s.Sync(ctx)
+	if errors.Is(err, ErrSyncing) {
+		return nil
+	}
+	return err
+}
+
+func (s *Service) openRepo(ctx context.Context, cfg config) (*repo, error) {
+	if err := validateRepoURL(cfg.repoURL); err != nil {
+		return nil, err
+	}
+	r := &repo{dir: s.repoDir(), url: cfg.repoURL, branch: cfg.branch, token: cfg.token}
+
+	// 仓库地址或分支变更后重新克隆，并清空同步记录
+	s.mu.Lock()
+	changed := s.state.RepoURL != cfg.repoURL || s.state.Branch != cfg.branch
+	if changed {
+		s.state = syncState{RepoURL: cfg.repoURL, Branch: cfg.branch, Files: map[string]*fileEntry{}}
+	}
+	s.mu.Unlock()
+	if changed {
+		if err := os.RemoveAll(r.dir); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.ensure(ctx); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *Service) sync(ctx context.Context, cfg config) (*Report, error) {
+	report := &Report{}
+	r, err := s.openRepo(ctx, cfg)
+	if err != nil {
+		return report, err
+	}
+	if err := r.pull(ctx); err != nil {
+		return report, err
+	}
+
+	files, err := s.readFiles(cfg)
+	if err != nil {
+		return report, err
+	}
+	site, err := s.readSite(ctx, cfg)
+	if err != nil {
+		return report, err
+	}
+
+	s.mu.Lock()
+	entries := make(map[string]*fileEntry, len(s.state.Files))
+	for p, e := range s.state.Files {
+		copied := *e
+		entries[p] = &copied
+	}
+	s.mu.Unlock()
+
+	tax := &taxonomy{postTagRepo: s.postTagRepo, postCategoryRepo: s.postCategoryRepo}
+	var conflicts []Conflict
+	handledFiles := make(map[string]bool)
+	handledSite := make(map[string]bool)
+	addConflict := func(filePath, kind, id, title, reason string) {
+		conflicts = append(conflicts, Conflict{Path: filePath, Kind: kind, ID: id, Title: title, Reason: reason, DetectedAt: time.Now()})
+	}
+	fail := func(filePath string, err error) {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", filePath, err))
+	}
+
+	// 已同步的文件
+	paths := make([]string, 0, len(entries))
+	for p := range entries {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, filePath := range paths {
+		entry := entries[filePath]
+		siteKey := entry.Kind + ":" + entry.ID
+		handledFiles[filePath] = true
+		handledSite[siteKey] = true
+
+		data, fileOK := files[filePath]
+		item, siteOK := site[siteKey]
+		gitChanged := !fileOK || hash(data) != entry.FileHash
+		siteChanged := !siteOK || hash(item.Content) != entry.SiteHash
+
+		switch {
+		case !gitChanged && !siteChanged:
+		case gitChanged && !siteChanged:
+			if !fileOK {
+				if err := s.deleteSiteItem(ctx, entry.Kind, entry.ID); err != nil {
+					fail(filePath, err)
+					continue
+				}
+				delete(entries, filePath)
+				report.DeletedSite++
+				continue
+			}
+			if err := s.importFile(ctx, cfg, tax, entries, filePath, entry.Kind, entry.ID, data); err != nil {
+				fail(filePath, err)
+				continue
+			}
+			report.Imported++
+		case !gitChanged && siteChanged:
+			if !siteOK {
+				full, err := repoPath(r.dir, filePath)
+				if err == nil {
+					err = os.Remove(full)
+				}
+				if err != nil && !os.IsNotExist(err) {
+					fail(filePath, err)
+					continue
+				}
+				delete(entries, filePath)
+				report.DeletedFiles++
+				continue
+			}
+			if err := s.writeFile(r, entries, filePath, item); err != nil {
+				fail(filePath, err)
+				continue
+			}
+			report.Exported++
+		default:
+			switch {
+			case !fileOK && !siteOK:
+				delete(entries, filePath)
+			case fileOK && siteOK && string(data) == string(item.Content):
+				entries[filePath] = &fileEntry{Kind: entry.Kind, ID: entry.ID, FileHash: hash(data), SiteHash: hash(item.Content)}
+			case !fileOK:
+				addConflict(filePath, entry.Kind, entry.ID, item.Title, "仓库中删除了文件，但站内内容也有修改")
+			case !siteOK:
+				addConflict(filePath, entry.Kind, entry.ID, "", "站内删除了内容，但仓库中的文件也有修改")
+			default:
+				addConflict(filePath, entry.Kind, entry.ID, item.Title, "站内和仓库中的内容都有修改")
+			}
+		}
+	}
+
+	// 仓库中新增的文件：按 abbrlink 或页面路径匹配站内内容
+	byKey := make(map[string]*siteItem)
+	for key, item := range site {
+		if !handledSite[key] && item.Key != "" {
+			byKey[item.Kind+":"+item.Key] = item
+		}
+	}
+	newFiles := make([]string, 0)
+	for p := range files {
+		if !handledFiles[p] {
+			newFiles = append(newFiles, p)
+		}
+	}
+	sort.Strings(newFiles)
+	for _, filePath := range newFiles {
+		data := files[filePath]
+		kind := cfg.kindOf(filePath)
+		if item, ok := byKey[kind+":"+fileKey(kind, cfg.mapping, filePath, data)]; ok {
+			handledSite[item.Kind+":"+item.ID] = true
+			if string(data) == string(item.Content) {
+				entries[filePath] = &fileEntry{Kind: kind, ID: item.ID, FileHash: hash(data), SiteHash: hash(item.Content)}
+			} else {
+				addConflict(filePath, kind, item.ID, item.Title, "首次同步时站内和仓库中的内容不一致")
+			}
+			continue
+		}
+		if err := s.importFile(ctx, cfg, tax, entries, filePath, kind, "", data); err != nil {
+			fail(filePath, err)
+			continue
+		}
+		report.Imported++
+	}
+
+	// 站内新增的内容：导出为文件
+	siteKeys := make([]string, 0)
+	for key := range site {
+		if !handledSite[key] {
+			siteKeys = append(siteKeys, key)
+		}
+	}
+	sort.Strings(siteKeys)
+	for _, key := range siteKeys {
+		item := site[key]
+		filePath := s.newFilePath(cfg, item, files, entries)
+		if err := s.writeFile(r, entries, filePath, item); err != nil {
+			fail(filePath, err)
+			continue
+		}
+		report.Exported++
+	}
+
+	report.Conflicts = len(conflicts)
+	s.mu.Lock()
+	s.state.Files = entries
+	s.state.Conflicts = conflicts
+	s.mu.Unlock()
+
+	if err := s.commitAndPush(ctx, r, cfg, report); err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
+func (s *Service) commitAndPush(ctx context.Context, r *repo, cfg config, report *Report) error {
+	dirs := []string{cfg.postsDir}
+	if cfg.pagesDir != "" {
+		dirs = append(dirs, cfg.pagesDir)
+	}
+	message := fmt.Sprintf("同步站内内容：导出 %d 篇，删除 %d 篇", report.Exported, report.DeletedFiles)
+	commit, err := r.commit(ctx, message, cfg.committer, dirs...)
+	if err != nil {
+		return err
+	}
+	if commit != "" {
+		report.Commit = commit
+		s.mu.Lock()
+		s.state.LastCommit = commit
+		s.mu.Unlock()
+	}
+	// 推送失败时本地提交保留，下次同步时变基后重试
+	pushed, err := r.push(ctx)
+	if err != nil {
+		return fmt.Errorf("推送失败: %w", err)
+	}
+	report.Pushed = pushed
+	return nil
+}
+
+// kindOf 根据文件所在目录判断内容类型
+func (c config) kindOf(filePath string) string {
+	if c.pagesDir != "" && strings.HasPrefix(filePath, c.pagesDir+"/") {
+		return KindPage
+	}
+	return KindArticle
+}
+
+// readFiles 读取仓库中文章和页面目录下的 Markdown 文件，键为仓库内的相对路径
+func (s *Service) readFiles(cfg config) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	dirs := []string{cfg.postsDir}
+	if cfg.pagesDir != "" && cfg.pagesDir != cfg.postsDir {
+		dirs = append(dirs, cfg.pagesDir)
+	}
+	root := s.repoDir()
+	for _, dir := range dirs {
+		base, err := repoPath(root, dir)
+		if err != nil {
+			return nil, fmt.Errorf("读取仓库文件失败: %w", err)
+		}
+		err = filepath.WalkDir(base, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			// 仓库内容来自外部，符号链接可能指向服务器上的任意文件，一律跳过
+			if d.Type()&fs.ModeSymlink != 0 {
+				log.Printf("[Git 同步] 跳过符号链接: %s", p)
+				return nil
+			}
+			if d.IsDir() || !isMarkdownFile(p) {
+				return nil
+			}
+			rel, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+			full, err := repoPath(root, filepath.ToSlash(rel))
+			if err != nil {
+				log.Printf("[Git 同步] 跳过 %s: %v", p, err)
+				return nil
+			}
+			data, err := os.ReadFile(full)
+			if err != nil {
+				return err
+			}
+			files[filepath.ToSlash(rel)] = data
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("读取仓库文件失败: %w", err)
+		}
+	}
+	return files, nil
+}
+
+// repoPath 将仓库内的相对路径转换为本地路径，并逐级检查：路径不能离开仓库目录，
+// 已存在的每一级都不能是符号链接，避免仓库中的链接让同步读写服务器上的其他文件。不存在的部分由调用方创建
+func repoPath(root, rel string) (string, error) {
+	full := filepath.Join(root, filepath.FromSlash(rel))
+	within, err := filepath.Rel(root, full)
+	if err != nil || within == ".." || strings.HasPrefix(within, ".."+string(filepath.Separator)) || filepath.IsAbs(within) {
+		return "", fmt.Errorf("%w: %s", ErrUnsafePath, rel)
+	}
+	current := root
+	for _, part := range strings.Split(within, string(filepath.Separator)) {
+		if part == "." {
+			continue
+		}
+		current = filepath.Join(current, part)
+		info, err := os.Lstat(current)
+		if os.IsNotExist(err) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("%w: %s", ErrUnsafePath, rel)
+		}
+	}
+	return full, nil
+}
+
+func isMarkdownFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".md", ".markdown":
+		return true
+	default:
+		return false
+	}
+}
+
+// readSite 读取全部文章和页面并导出为 Markdown，键为 类型:ID
+func (s *Service) readSite(ctx context.Context, cfg config) (map[string]*siteItem, error) {
+	items := make(map[string]*siteItem)
+	for page := 1; ; page++ {
+		articles, total, err := s.articleRepo.List(ctx, &model.ListArticlesOptions{Page: page, PageSize: listPageSize, WithContent: true})
+		if err != nil {
+			return nil, fmt.Errorf("读取文章失败: %w", err)
+		}
+		for _, a := range articles {
+			content, err := exportArticle(cfg.mapping, a)
+			if err != nil {
+				return nil, err
+			}
+			items[KindArticle+":"+a.ID] = &siteItem{Kind: KindArticle, ID: a.ID, Key: a.Abbrlink, Title: a.Title, Content: content}
+		}
+		if len(articles) == 0 || page*listPageSize >= total {
+			break
+		}
+	}
+	if cfg.pagesDir == "" {
+		return items, nil
+	}
+	for page := 1; ; page++ {
+		pages, total, err := s.pageSvc.List(ctx, &model.ListPagesOptions{Page: page, PageSize: listPageSize})
+		if err != nil {
+			return nil, fmt.Errorf("读取页面失败: %w", err)
+		}
+		for _, p := range pages {
+			content, err := exportPage(p)
+			if err != nil {
+				return nil, err
+			}
+			id := strconv.FormatUint(uint64(p.ID), 10)
+			items[KindPage+":"+id] = &siteItem{Kind: KindPage, ID: id, Key: p.Path, Title: p.Title, Content: content}
+		}
+		if len(pages) == 0 || page*listPageSize >= total {
+			break
+		}
+	}
+	return items, nil
+}
+
+// loadSiteItem 重新读取单个站内内容并导出
+func (s *Service) loadSiteItem(ctx context.Context, cfg config, kind, id string) (*siteItem, error) {
+	if kind == KindPage {
+		p, err := s.pageSvc.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		content, err := exportPage(p)
+		if err != nil {
+			return nil, err
+		}
+		return &siteItem{Kind: KindPage, ID: id, Key: p.Path, Title: p.Title, Content: content}, nil
+	}
+	a, err := s.articleRepo.GetBySlugOrIDForPreview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	content, err := exportArticle(cfg.mapping, a)
+	if err != nil {
+		return nil, err
+	}
+	return &siteItem{Kind: KindArticle, ID: a.ID, Key: a.Abbrlink, Title: a.Title, Content: content}, nil
+}
+
+// importFile 用文件内容创建或更新站内内容，并记录两侧的哈希
+func (s *Service) importFile(ctx context.Context, cfg config, tax *taxonomy, entries map[string]*fileEntry, filePath, kind, id string, data []byte) error {
+	var err error
+	if kind == KindPage {
+		id, err = s.applyPage(ctx, id, filePath, data)
+	} else {
+		id, err = s.applyArticle(ctx, cfg.mapping, tax, id, filePath, data)
+	}
+	if err != nil {
+		return err
+	}
+	item, err := s.loadSiteItem(ctx, cfg, kind, id)
+	if err != nil {
+		return err
+	}
+	entries[filePath] = &fileEntry{Kind: kind, ID: id, FileHash: hash(data), SiteHash: hash(item.Content)}
+	return nil
+}
+
+// writeFile 将站内内容写入仓库文件，并记录两侧的哈希
+func (s *Service) writeFile(r *repo, entries map[string]*fileEntry, filePath string, item *siteItem) error {
+	full, err := repoPath(r.dir, filePath)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(full, item.Content, 0644); err != nil {
+		return err
+	}
+	sum := hash(item.Content)
+	entries[filePath] = &fileEntry{Kind: item.Kind, ID: item.ID, FileHash: sum, SiteHash: sum}
+	return nil
+}
+
+func (s *Service) deleteSiteItem(ctx context.Context, kind, id string) error {
+	if kind == KindPage {
+		return s.pageSvc.Delete(ctx, id)
+	}
+	return s.articleSvc.Delete(ctx, id)
+}
+
+// newFilePath 为站内新增的内容分配文件名：文章使用 abbrlink（没有时使用 ID），页面使用路径
+func (s *Service) newFilePath(cfg config, item *siteItem, files map[string][]byte, entries map[string]*fileEntry) string {
+	dir := cfg.postsDir
+	name := item.Key
+	if item.Kind == KindPage {
+		dir = cfg.pagesDir
+		name = strings.ReplaceAll(strings.Trim(item.Key, "/"), "/", "-")
+	}
+	name = sanitizeFileName(name)
+	if name == "" {
+		name = item.ID
+	}
+	candidate := dir + "/" + name + ".md"
+	if _, taken := files[candidate]; taken {
+		candidate = dir + "/" + name + "-" + item.ID + ".md"
+	} else if _, taken := entries[candidate]; taken {
+		candidate = dir + "/" + name + "-" + item.ID + ".md"
+	}
+	return candidate
+}
+
+func sanitizeFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', ' ', '\t', '\n', '\r':
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(name))
+}
+
+// ResolveConflict 按管理员的选择解决冲突：site 保留站内内容，git 保留仓库文件
+func (s *Service) ResolveConflict(ctx context.Context, filePath, keep string) (*Report, error) {
+	if keep != KeepSite && keep != KeepGit {
+		return nil, ErrInvalidKeep
+	}
+	if !s.Enabled() {
+		return nil, ErrDisabled
+	}
+	if !s.syncMu.TryLock() {
+		return nil, ErrSyncing
+	}
+	defer s.syncMu.Unlock()
+
+	s.mu.Lock()
+	var conflict *Conflict
+	for i := range s.state.Conflicts {
+		if s.state.Conflicts[i].Path == filePath {
+			c := s.state.Conflicts[i]
+			conflict = &c
+			break
+		}
+	}
+	entries := make(map[string]*fileEntry, len(s.state.Files))
+	for p, e := range s.state.Files {
+		copied := *e
+		entries[p] = &copied
+	}
+	s.mu.Unlock()
+	if conflict == nil {
+		return nil, ErrConflictNotFound
+	}
+
+	cfg := s.config()
+	r, err := s.openRepo(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{}
+	full, err := repoPath(r.dir, filePath)
+	if err != nil {
+		return nil, err
+	}
+	data, readErr := os.ReadFile(full)
+	fileOK := readErr == nil
+
+	var item *siteItem
+	if conflict.ID != "" {
+		if loaded, err := s.loadSiteItem(ctx, cfg, conflict.Kind, conflict.ID); err == nil {
+			item = loaded
+		}
+	}
+
+	switch {
+	case keep == KeepSite && item != nil:
+		if err := s.writeFile(r, entries, filePath, item); err != nil {
+			return nil, err
+		}
+		report.Exported++
+	case keep == KeepSite:
+		if err := os.Remove(full); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		delete(entries, filePath)
+		report.DeletedFiles++
+	case fileOK:
+		id := ""
+		if item != nil {
+			id = item.ID
+		}
+		tax := &taxonomy{postTagRepo: s.postTagRepo, postCategoryRepo: s.postCategoryRepo}
+		if err := s.importFile(ctx, cfg, tax, entries, filePath, conflict.Kind, id, data); err != nil {
+			return nil, err
+		}
+		report.Imported++
+	default:
+		if item != nil {
+			if err := s.deleteSiteItem(ctx, conflict.Kind, item.ID); err != nil {
+				return nil, err
+			}
+			report.DeletedSite++
+		}
+		delete(entries, filePath)
+	}
+
+	s.mu.Lock()
+	s.state.Files = entries
+	remaining := s.state.Conflicts[:0]
+	for _, c := range s.state.Conflicts {
+		if c.Path != filePath {
+			remaining = append(remaining, c)
+		}
+	}
+	s.state.Conflicts = remaining
+	report.Conflicts = len(remaining)
+	s.mu.Unlock()
+
+	err = s.commitAndPush(ctx, r, cfg, report)
+	s.saveState()
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// ArticleSaved 实现 article.SaveListener
+func (s *Service) ArticleSaved(publicID string) { s.scheduleSync() }
+
+// ArticleDeleted 实现 article.SaveListener
+func (s *Service) ArticleDeleted(publicID string) { s.scheduleSync() }
+
+// PageSaved 实现 page.SaveListener
+func (s *Service) PageSaved(id string) { s.scheduleSync() }
+
+// PageDeleted 实现 page.SaveListener
+func (s *Service) PageDeleted(id string) { s.scheduleSync() }
+
+// scheduleSync 保存后延迟同步，同步本身导入内容触发的保存只会得到一次没有变化的同步
+func (s *Service) scheduleSync() {
+	if !s.Enabled() || !s.settingSvc.GetBool(constant.KeyGitSyncPushOnSave.String()) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.timer != nil {
+		s.timer.Reset(saveSyncDelay)
+		return
+	}
+	s.timer = time.AfterFunc(saveSyncDelay, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
+		defer cancel()
+		if _, err := s.Sync(ctx); errors.Is(err, ErrSyncing) {
+			// 正在同步，稍后再试一次以包含本次保存
+			s.scheduleSync()
+		}
+	})
+}
+
+func (s *Service) loadState() {
+	data, err := os.ReadFile(s.statePath())
+	if err != nil {
+		return
+	}
+	var state syncState
+	if err := json.Unmarshal(data, &state); err != nil {
+		log.Printf("[Git同步] 读取同步状态失败: %v", err)
+		return
+	}
+	if state.Files == nil {
+		state.Files = map[string]*fileEntry{}
+	}
+	s.state = state
+}
+
+func (s *Service) saveState() {
+	s.mu.Lock()
+	data, err := json.MarshalIndent(s.state, "", "  ")
+	s.mu.Unlock()
+	if err != nil {
+		log.Printf("[Git同步] 保存同步状态失败: %v", err)
+		return
+	}
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		log.Printf("[Git同步] 保存同步状态失败: %v", err)
+		return
+	}
+	tmp := s.statePath() + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		log.Printf("[Git同步] 保存同步状态失败: %v", err)
+		return
+	}
+	if err := os.Rename(tmp, s.statePath()); err != nil {
+		log.Printf("[Git同步] 保存同步状态失败: %v", err)
+	}
+}
+
+func hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
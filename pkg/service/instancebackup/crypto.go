@@ -0,0 +1,202 @@
+/*
+ * @Description: 整站备份文件的分块加密
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 文件格式：magic(8) | salt(16) | nonce 前缀(4) | 若干分块
+ * 每个分块为 4 字节大端密文长度 + AES-256-GCM 密文，nonce 为前缀 + 8 字节分块序号，
+ * 附加数据标记是否为最后一块，用于发现被截断的备份文件。
+ */
+package instancebackup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	encryptChunkSize = 1 << 20
+	saltSize         = 16
+	noncePrefixSize  = 4
+)
+
+var encryptMagic = []byte("AHBKENC1")
+
+// isEncrypted 根据文件头判断备份文件是否已加密
+func isEncrypted(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	head := make([]byte, len(encryptMagic))
+	if _, err := io.ReadFull(f, head); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return false, nil
+		}
+		return false, err
+	}
+	return bytes.Equal(head, encryptMagic), nil
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, fmt.Errorf("派生加密密钥失败: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(prefix []byte, index uint64) []byte {
+	nonce := make([]byte, noncePrefixSize+8)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint64(nonce[noncePrefixSize:], index)
+	return nonce
+}
+
+func chunkAAD(final bool) []byte {
+	if final {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// encryptFile 使用口令加密 src 并写入 dst
+func encryptFile(src, dst, passphrase string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	header := make([]byte, saltSize+noncePrefixSize)
+	if _, err := rand.Read(header); err != nil {
+		return err
+	}
+	salt, prefix := header[:saltSize], header[saltSize:]
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(out)
+	w.Write(encryptMagic)
+	w.Write(header)
+
+	reader := bufio.NewReaderSize(in, encryptChunkSize)
+	buf := make([]byte, encryptChunkSize)
+	lenBuf := make([]byte, 4)
+	for index := uint64(0); ; index++ {
+		n, readErr := io.ReadFull(reader, buf)
+		if readErr != nil && !errors.Is(readErr, io.EOF) && !errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return readErr
+		}
+		final := readErr != nil
+		if !final {
+			// 恰好读满时预读一个字节，确认后面是否还有数据
+			if _, peekErr := reader.Peek(1); peekErr == io.EOF {
+				final = true
+			}
+		}
+
+		sealed := gcm.Seal(nil, chunkNonce(prefix, index), buf[:n], chunkAAD(final))
+		binary.BigEndian.PutUint32(lenBuf, uint32(len(sealed)))
+		w.Write(lenBuf)
+		if _, err := w.Write(sealed); err != nil {
+			return err
+		}
+		if final {
+			break
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+// decryptFile 使用口令解密 src 并写入 dst，口令错误或文件被篡改、截断时返回 ErrDecryptFailed
+func decryptFile(src, dst, passphrase string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	reader := bufio.NewReader(in)
+
+	header := make([]byte, len(encryptMagic)+saltSize+noncePrefixSize)
+	if _, err := io.ReadFull(reader, header); err != nil || !bytes.Equal(header[:len(encryptMagic)], encryptMagic) {
+		return ErrInvalidBackup
+	}
+	salt := header[len(encryptMagic) : len(encryptMagic)+saltSize]
+	prefix := header[len(encryptMagic)+saltSize:]
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return err
+	}
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	w := bufio.NewWriter(out)
+
+	lenBuf := make([]byte, 4)
+	maxSealed := encryptChunkSize + gcm.Overhead()
+	for index := uint64(0); ; index++ {
+		if _, err := io.ReadFull(reader, lenBuf); err != nil {
+			// 没有读到最后一块就结束，说明文件被截断
+			return ErrDecryptFailed
+		}
+		size := int(binary.BigEndian.Uint32(lenBuf))
+		if size > maxSealed {
+			return ErrDecryptFailed
+		}
+		sealed := make([]byte, size)
+		if _, err := io.ReadFull(reader, sealed); err != nil {
+			return ErrDecryptFailed
+		}
+
+		plain, err := gcm.Open(nil, chunkNonce(prefix, index), sealed, chunkAAD(false))
+		final := false
+		if err != nil {
+			plain, err = gcm.Open(nil, chunkNonce(prefix, index), sealed, chunkAAD(true))
+			if err != nil {
+				return ErrDecryptFailed
+			}
+			final = true
+		}
+		if _, err := w.Write(plain); err != nil {
+			return err
+		}
+		if final {
+			break
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return out.Close()
+}
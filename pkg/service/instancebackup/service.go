@@ -0,0 +1,821 @@
+/*
+ * @Description: 整站备份服务，备份数据库、data 目录和本机上传文件，并支持校验后恢复
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 备份文件为 tar.gz 归档（加密后追加 .enc 后缀），结构如下：
+ *   database/<sqlite.db|postgres.sql|mysql.sql>  数据库副本或导出的 SQL
+ *   data/...                                    data 目录下的配置文件、本机上传文件等
+ *   manifest.json                               备份清单，记录数据库类型和每个文件的 SHA-256
+ */
+package instancebackup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/persistence/database"
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+)
+
+const (
+	// DefaultBackupDir 整站备份的本地保存目录
+	DefaultBackupDir = "data/backups"
+
+	dataDir         = "data"
+	uploadsDir      = "data/storage"
+	tempDir         = "data/temp"
+	manifestName    = "manifest.json"
+	manifestVersion = 1
+	filePrefix      = "instance-"
+	archiveExt      = ".tar.gz"
+	encryptedExt    = ".enc"
+)
+
+var (
+	ErrBackupRunning       = errors.New("已有备份或恢复任务正在进行")
+	ErrBackupNotFound      = errors.New("备份文件不存在")
+	ErrInvalidBackup       = errors.New("备份文件格式无效")
+	ErrPassphraseRequired  = errors.New("备份文件已加密，需要提供口令")
+	ErrDecryptFailed       = errors.New("解密失败，口令错误或备份文件已损坏")
+	ErrChecksumMismatch    = errors.New("备份文件校验失败")
+	ErrDatabaseTypeChanged = errors.New("备份的数据库类型与当前配置不一致")
+)
+
+// Manifest 备份清单
+type Manifest struct {
+	Version        int               `json:"version"`
+	AppVersion     string            `json:"app_version"`
+	DBType         string            `json:"db_type"`
+	CreatedAt      time.Time         `json:"created_at"`
+	Description    string            `json:"description"`
+	IncludeUploads bool              `json:"include_uploads"`
+	Files          map[string]string `json:"files"` // 归档内路径 -> SHA-256
+}
+
+// BackupInfo 备份文件信息
+type BackupInfo struct {
+	Filename   string    `json:"filename"`
+	Size       int64     `json:"size"`
+	CreatedAt  time.Time `json:"created_at"`
+	Encrypted  bool      `json:"encrypted"`
+	RemotePath string    `json:"remote_path,omitempty"` // 推送到存储策略后的路径，仅创建时返回
+}
+
+// Service 整站备份服务
+type Service struct {
+	cfg        *config.Config
+	db         *sql.DB
+	settingSvc setting.SettingService
+	policySvc  volume.IStoragePolicyService
+	providers  map[constant.StoragePolicyType]storage.IStorageProvider
+	appVersion string
+	dir        string
+	mu         sync.Mutex
+}
+
+// NewService 创建整站备份服务
+func NewService(
+	cfg *config.Config,
+	db *sql.DB,
+	settingSvc setting.SettingService,
+	policySvc volume.IStoragePolicyService,
+	providers map[constant.StoragePolicyType]storage.IStorageProvider,
+	appVersion string,
+	dir string,
+) *Service {
+	return &Service{
+		cfg:        cfg,
+		db:         db,
+		settingSvc: settingSvc,
+		policySvc:  policySvc,
+		providers:  providers,
+		appVersion: appVersion,
+		dir:        dir,
+	}
+}
+
+// Enabled 是否启用定时备份
+func (s *Service) Enabled() bool {
+	return s.settingSvc.GetBool(constant.KeyInstanceBackupEnable.String())
+}
+
+// Create 创建一份整站备份，配置了口令时加密，配置了存储策略时推送到远端，最后按保留数量清理旧备份
+func (s *Service) Create(ctx context.Context, description string) (*BackupInfo, error) {
+	if !s.mu.TryLock() {
+		return nil, ErrBackupRunning
+	}
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return nil, fmt.Errorf("创建备份目录失败: %w", err)
+	}
+	workDir, err := os.MkdirTemp(s.dir, ".work-*")
+	if err != nil {
+		return nil, fmt.Errorf("创建临时目录失败: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	dbFile, dbEntry, err := s.dumpDatabase(ctx, workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	includeUploads := s.settingSvc.GetBool(constant.KeyInstanceBackupIncludeUploads.String())
+	manifest := &Manifest{
+		Version:        manifestVersion,
+		AppVersion:     s.appVersion,
+		DBType:         s.dbType(),
+		CreatedAt:      time.Now(),
+		Description:    description,
+		IncludeUploads: includeUploads,
+		Files:          make(map[string]string),
+	}
+
+	archivePath := filepath.Join(workDir, "backup"+archiveExt)
+	if err := s.writeArchive(archivePath, dbFile, dbEntry, manifest); err != nil {
+		return nil, err
+	}
+
+	filename := filePrefix + manifest.CreatedAt.Format("20060102-150405") + archiveExt
+	finalSrc := archivePath
+	if passphrase := s.settingSvc.Get(constant.KeyInstanceBackupPassphrase.String()); passphrase != "" {
+		filename += encryptedExt
+		finalSrc = archivePath + encryptedExt
+		if err := encryptFile(archivePath, finalSrc, passphrase); err != nil {
+			return nil, fmt.Errorf("加密备份文件失败: %w", err)
+		}
+	}
+
+	target := filepath.Join(s.dir, filename)
+	if err := os.Rename(finalSrc, target); err != nil {
+		return nil, fmt.Errorf("保存备份文件失败: %w", err)
+	}
+	info, err := s.stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[整站备份] 已创建备份 %s（%d 字节）", filename, info.Size)
+
+	if policyID := s.settingSvc.Get(constant.KeyInstanceBackupPolicyID.String()); policyID != "" {
+		remotePath, err := s.push(ctx, policyID, target)
+		if err != nil {
+			// 推送失败不影响本地备份
+			log.Printf("[整站备份] 推送备份 %s 到存储策略失败: %v", filename, err)
+		} else {
+			info.RemotePath = remotePath
+		}
+	}
+
+	if keep, _ := strconv.Atoi(s.settingSvc.Get(constant.KeyInstanceBackupKeep.String())); keep > 0 {
+		s.cleanup(keep)
+	}
+	return info, nil
+}
+
+// List 列出本地备份，按创建时间从新到旧排序
+func (s *Service) List() ([]*BackupInfo, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*BackupInfo{}, nil
+		}
+		return nil, err
+	}
+
+	backups := make([]*BackupInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !isBackupName(entry.Name()) {
+			continue
+		}
+		if info, err := s.stat(entry.Name()); err == nil {
+			backups = append(backups, info)
+		}
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Filename > backups[j].Filename
+	})
+	return backups, nil
+}
+
+// Delete 删除本地备份
+func (s *Service) Delete(filename string) error {
+	p, err := s.Path(filename)
+	if err != nil {
+		return err
+	}
+	return os.Remove(p)
+}
+
+// Path 返回本地备份文件的完整路径，文件名不合法或不存在时返回 ErrBackupNotFound
+func (s *Service) Path(filename string) (string, error) {
+	if filepath.Base(filename) != filename || !isBackupName(filename) {
+		return "", ErrBackupNotFound
+	}
+	p := filepath.Join(s.dir, filename)
+	if _, err := os.Stat(p); err != nil {
+		return "", ErrBackupNotFound
+	}
+	return p, nil
+}
+
+// Validate 校验备份文件：解密、检查清单、逐个核对文件摘要并确认数据库类型与当前配置一致
+func (s *Service) Validate(ctx context.Context, file, passphrase string) (*Manifest, error) {
+	if err := os.MkdirAll(tempDir, 0755); err != nil {
+		return nil, err
+	}
+	workDir, err := os.MkdirTemp(tempDir, "validate-*")
+	if err != nil {
+		return nil, fmt.Errorf("创建临时目录失败: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	_, manifest, err := s.open(ctx, file, passphrase, workDir)
+	return manifest, err
+}
+
+// Restore 校验通过后恢复数据库和 data 目录。
+// 恢复会覆盖当前数据，应在停止服务后通过命令行执行，完成后重新启动服务。
+func (s *Service) Restore(ctx context.Context, file, passphrase string) (*Manifest, error) {
+	if !s.mu.TryLock() {
+		return nil, ErrBackupRunning
+	}
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(tempDir, 0755); err != nil {
+		return nil, err
+	}
+	workDir, err := os.MkdirTemp(tempDir, "restore-*")
+	if err != nil {
+		return nil, fmt.Errorf("创建临时目录失败: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	archivePath, manifest, err := s.open(ctx, file, passphrase, workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	dbFile, err := s.extract(archivePath, workDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.restoreDatabase(ctx, dbFile); err != nil {
+		return nil, err
+	}
+	log.Printf("[整站备份] 已从 %s 恢复数据（备份时间 %s）", filepath.Base(file), manifest.CreatedAt.Format(time.DateTime))
+	return manifest, nil
+}
+
+// SnapshotDatabase 将当前数据库导出到 dir，返回导出文件的路径。
+// 与 ResetDatabase 配合使用，例如演示模式启动时保存的重置基线
+func (s *Service) SnapshotDatabase(ctx context.Context, dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	workDir, err := os.MkdirTemp(dir, "snapshot-*")
+	if err != nil {
+		return "", fmt.Errorf("创建临时目录失败: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	dumped, _, err := s.dumpDatabase(ctx, workDir)
+	if err != nil {
+		return "", err
+	}
+	target := filepath.Join(dir, filepath.Base(dumped))
+	if err := os.Rename(dumped, target); err != nil {
+		return "", fmt.Errorf("保存数据库快照失败: %w", err)
+	}
+	return target, nil
+}
+
+// ResetDatabase 用 SnapshotDatabase 导出的文件覆盖当前数据库，服务运行中也可以执行。
+// SQLite 不能在连接打开时替换文件，改为在同一连接中附加快照后逐表复制；其他数据库直接导入 SQL。
+// 快照之后新建的数据表（例如升级后迁移创建的表）不在快照中，重置时清空
+func (s *Service) ResetDatabase(ctx context.Context, file string) error {
+	if s.dbType() != "sqlite" {
+		return s.resetSQLDatabase(ctx, file)
+	}
+
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// 外键检查只能在事务之外切换
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+		return err
+	}
+	defer conn.ExecContext(context.Background(), "PRAGMA foreign_keys = ON")
+	if _, err := conn.ExecContext(ctx, "ATTACH DATABASE ? AS snapshot", file); err != nil {
+		return fmt.Errorf("打开数据库快照失败: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "DETACH DATABASE snapshot")
+
+	tables, err := queryTables(ctx, conn, "SELECT name FROM snapshot.sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'")
+	if err != nil {
+		return fmt.Errorf("读取数据库快照失败: %w", err)
+	}
+	current, err := queryTables(ctx, conn, "SELECT name FROM main.sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'")
+	if err != nil {
+		return fmt.Errorf("读取数据表失败: %w", err)
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		quoted := quoteIdent(table, `"`)
+		if _, err := tx.ExecContext(ctx, "DELETE FROM main."+quoted); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("清空数据表 %s 失败: %w", table, err)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO main."+quoted+" SELECT * FROM snapshot."+quoted); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("恢复数据表 %s 失败: %w", table, err)
+		}
+	}
+	for _, table := range tablesNotIn(current, tables) {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM main."+quoteIdent(table, `"`)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("清空数据表 %s 失败: %w", table, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// createTablePattern 匹配 pg_dump、mysqldump 导出的建表语句中的表名
+var createTablePattern = regexp.MustCompile("(?m)^CREATE TABLE (?:IF NOT EXISTS )?(?:[\\w\"`]+\\.)?[\"`]?([^\\s\"`(]+)")
+
+// resetSQLDatabase 导入 PostgreSQL、MySQL 的 SQL 快照。导出时带有删表语句，快照中的表会整体重建，
+// 快照中没有的表导入后逐个清空
+func (s *Service) resetSQLDatabase(ctx context.Context, file string) error {
+	dump, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("读取数据库快照失败: %w", err)
+	}
+	var tables []string
+	for _, m := range createTablePattern.FindAllSubmatch(dump, -1) {
+		tables = append(tables, string(m[1]))
+	}
+
+	if err := s.restoreDatabase(ctx, file); err != nil {
+		return err
+	}
+
+	query, quote := "SELECT table_name FROM information_schema.tables WHERE table_schema = current_schema() AND table_type = 'BASE TABLE'", `"`
+	if s.dbType() == "mysql" {
+		query, quote = "SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE'", "`"
+	}
+	current, err := queryTables(ctx, s.db, query)
+	if err != nil {
+		return fmt.Errorf("读取数据表失败: %w", err)
+	}
+	for _, table := range tablesNotIn(current, tables) {
+		if _, err := s.db.ExecContext(ctx, "DELETE FROM "+quoteIdent(table, quote)); err != nil {
+			return fmt.Errorf("清空数据表 %s 失败: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// queryTables 执行返回单列表名的查询
+func queryTables(ctx context.Context, q interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}, query string) ([]string, error) {
+	rows, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tables = append(tables, name)
+	}
+	return tables, rows.Err()
+}
+
+// tablesNotIn 返回 current 中不在 snapshot 里的表
+func tablesNotIn(current, snapshot []string) []string {
+	known := make(map[string]bool, len(snapshot))
+	for _, table := range snapshot {
+		known[table] = true
+	}
+	var extra []string
+	for _, table := range current {
+		if !known[table] {
+			extra = append(extra, table)
+		}
+	}
+	return extra
+}
+
+func quoteIdent(name, quote string) string {
+	return quote + strings.ReplaceAll(name, quote, quote+quote) + quote
+}
+
+// cleanup 只保留最新的 keep 个本地备份
+func (s *Service) cleanup(keep int) {
+	backups, err := s.List()
+	if err != nil || len(backups) <= keep {
+		return
+	}
+	for _, b := range backups[keep:] {
+		if err := os.Remove(filepath.Join(s.dir, b.Filename)); err != nil {
+			log.Printf("[整站备份] 删除旧备份 %s 失败: %v", b.Filename, err)
+		}
+	}
+}
+
+func (s *Service) stat(filename string) (*BackupInfo, error) {
+	fi, err := os.Stat(filepath.Join(s.dir, filename))
+	if err != nil {
+		return nil, err
+	}
+	return &BackupInfo{
+		Filename:  filename,
+		Size:      fi.Size(),
+		CreatedAt: fi.ModTime(),
+		Encrypted: strings.HasSuffix(filename, encryptedExt),
+	}, nil
+}
+
+func isBackupName(name string) bool {
+	return strings.HasPrefix(name, filePrefix) &&
+		(strings.HasSuffix(name, archiveExt) || strings.HasSuffix(name, archiveExt+encryptedExt))
+}
+
+// dbType 返回归一化后的数据库类型
+func (s *Service) dbType() string {
+	return database.NormalizeDBType(s.cfg.GetString(config.KeyDBType))
+}
+
+func (s *Service) sqlitePath() string {
+	name := s.cfg.GetString(config.KeyDBName)
+	if name == "" {
+		name = "anheyu_app.db"
+	}
+	return filepath.Join(dataDir, name)
+}
+
+// dumpDatabase 导出数据库到 workDir，返回本地文件路径和归档内路径
+func (s *Service) dumpDatabase(ctx context.Context, workDir string) (string, string, error) {
+	out, err := database.DumpDatabase(ctx, s.db, s.cfg, workDir)
+	if err != nil {
+		return "", "", err
+	}
+	return out, "database/" + filepath.Base(out), nil
+}
+
+// restoreDatabase 将导出的数据库文件导入当前数据库
+func (s *Service) restoreDatabase(ctx context.Context, dbFile string) error {
+	switch s.dbType() {
+	case "sqlite":
+		target := s.sqlitePath()
+		staged := target + ".restore"
+		if err := copyFile(dbFile, staged); err != nil {
+			return fmt.Errorf("写入 SQLite 数据库失败: %w", err)
+		}
+		// 旧的 WAL 文件与新数据库不匹配，必须一并移除
+		os.Remove(target + "-wal")
+		os.Remove(target + "-shm")
+		if err := os.Rename(staged, target); err != nil {
+			return fmt.Errorf("替换 SQLite 数据库失败: %w", err)
+		}
+		return nil
+	case "postgres":
+		cmd := exec.CommandContext(ctx, "psql", "-v", "ON_ERROR_STOP=1", "-q",
+			"-h", s.cfg.GetString(config.KeyDBHost), "-p", s.cfg.GetString(config.KeyDBPort),
+			"-U", s.cfg.GetString(config.KeyDBUser), "-d", s.cfg.GetString(config.KeyDBName), "-f", dbFile)
+		cmd.Env = append(os.Environ(), "PGPASSWORD="+s.cfg.GetString(config.KeyDBPassword))
+		return database.RunClientTool(cmd)
+	case "mysql":
+		in, err := os.Open(dbFile)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		cmd := exec.CommandContext(ctx, "mysql",
+			"-h", s.cfg.GetString(config.KeyDBHost), "-P", s.cfg.GetString(config.KeyDBPort),
+			"-u", s.cfg.GetString(config.KeyDBUser), s.cfg.GetString(config.KeyDBName))
+		cmd.Env = append(os.Environ(), "MYSQL_PWD="+s.cfg.GetString(config.KeyDBPassword))
+		cmd.Stdin = in
+		return database.RunClientTool(cmd)
+	default:
+		return fmt.Errorf("不支持恢复的数据库类型: %s", s.dbType())
+	}
+}
+
+// writeArchive 写入归档，同时计算每个文件的摘要，清单放在归档末尾
+func (s *Service) writeArchive(archivePath, dbFile, dbEntry string, manifest *Manifest) error {
+	out, err := os.Create(archivePath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+
+	if err := addFile(tw, dbFile, dbEntry, manifest); err != nil {
+		return err
+	}
+
+	sqlitePath := filepath.Clean(s.sqlitePath())
+	skipDirs := map[string]bool{
+		filepath.Clean(s.dir):   true,
+		filepath.Clean(tempDir): true,
+	}
+	if !manifest.IncludeUploads {
+		skipDirs[filepath.Clean(uploadsDir)] = true
+	}
+
+	err = filepath.WalkDir(dataDir, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if skipDirs[filepath.Clean(p)] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		// 数据库已通过 dumpDatabase 导出，直接复制正在使用的文件可能得到不一致的副本
+		if strings.HasPrefix(filepath.Clean(p), sqlitePath) || !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dataDir, p)
+		if err != nil {
+			return err
+		}
+		return addFile(tw, p, path.Join("data", filepath.ToSlash(rel)), manifest)
+	})
+	if err != nil {
+		return fmt.Errorf("打包 data 目录失败: %w", err)
+	}
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: manifestName, Mode: 0644, Size: int64(len(manifestData)), ModTime: manifest.CreatedAt}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(manifestData); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+func addFile(tw *tar.Writer, src, name string, manifest *Manifest) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: fi.Size(), ModTime: fi.ModTime()}); err != nil {
+		return err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tw, h), f); err != nil {
+		return fmt.Errorf("写入 %s 失败: %w", name, err)
+	}
+	manifest.Files[name] = hex.EncodeToString(h.Sum(nil))
+	return nil
+}
+
+// open 解密（如需要）并校验备份，返回可直接读取的 tar.gz 路径
+func (s *Service) open(ctx context.Context, file, passphrase, workDir string) (string, *Manifest, error) {
+	encrypted, err := isEncrypted(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil, ErrBackupNotFound
+		}
+		return "", nil, err
+	}
+
+	archivePath := file
+	if encrypted {
+		if passphrase == "" {
+			passphrase = s.settingSvc.Get(constant.KeyInstanceBackupPassphrase.String())
+		}
+		if passphrase == "" {
+			return "", nil, ErrPassphraseRequired
+		}
+		archivePath = filepath.Join(workDir, "backup"+archiveExt)
+		if err := decryptFile(file, archivePath, passphrase); err != nil {
+			return "", nil, err
+		}
+	}
+
+	manifest, err := s.verify(ctx, archivePath)
+	if err != nil {
+		return "", nil, err
+	}
+	return archivePath, manifest, nil
+}
+
+// verify 逐个核对归档内文件的摘要
+func (s *Service) verify(ctx context.Context, archivePath string) (*Manifest, error) {
+	sums := make(map[string]string)
+	var manifest *Manifest
+
+	err := walkArchive(archivePath, func(hdr *tar.Header, r io.Reader) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if hdr.Name == manifestName {
+			manifest = &Manifest{}
+			if err := json.NewDecoder(r).Decode(manifest); err != nil {
+				return ErrInvalidBackup
+			}
+			return nil
+		}
+		h := sha256.New()
+		if _, err := io.Copy(h, r); err != nil {
+			return err
+		}
+		sums[hdr.Name] = hex.EncodeToString(h.Sum(nil))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil || manifest.Version != manifestVersion {
+		return nil, ErrInvalidBackup
+	}
+
+	hasDatabase := false
+	for name, sum := range manifest.Files {
+		if sums[name] != sum {
+			return nil, fmt.Errorf("%w: %s", ErrChecksumMismatch, name)
+		}
+		hasDatabase = hasDatabase || strings.HasPrefix(name, "database/")
+	}
+	if len(sums) != len(manifest.Files) || !hasDatabase {
+		return nil, ErrInvalidBackup
+	}
+	if manifest.DBType != s.dbType() {
+		return nil, fmt.Errorf("%w（备份: %s，当前: %s）", ErrDatabaseTypeChanged, manifest.DBType, s.dbType())
+	}
+	return manifest, nil
+}
+
+// extract 将 data 目录下的文件写回原位置，数据库文件解压到 workDir 并返回其路径
+func (s *Service) extract(archivePath, workDir string) (string, error) {
+	var dbFile string
+	err := walkArchive(archivePath, func(hdr *tar.Header, r io.Reader) error {
+		name := path.Clean(hdr.Name)
+		if hdr.Typeflag != tar.TypeReg || name == manifestName {
+			return nil
+		}
+		if strings.HasPrefix(name, "database/") {
+			dbFile = filepath.Join(workDir, path.Base(name))
+			return writeFile(dbFile, r)
+		}
+		rel, ok := strings.CutPrefix(name, "data/")
+		if !ok || rel == "" || strings.HasPrefix(rel, "../") || path.IsAbs(rel) {
+			return fmt.Errorf("%w: 非法路径 %s", ErrInvalidBackup, hdr.Name)
+		}
+		return writeFile(filepath.Join(dataDir, filepath.FromSlash(rel)), r)
+	})
+	if err != nil {
+		return "", fmt.Errorf("恢复文件失败: %w", err)
+	}
+	if dbFile == "" {
+		return "", ErrInvalidBackup
+	}
+	return dbFile, nil
+}
+
+func walkArchive(archivePath string, fn func(hdr *tar.Header, r io.Reader) error) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return ErrInvalidBackup
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidBackup, err)
+		}
+		if err := fn(hdr, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// writeFile 先写临时文件再重命名，避免中途失败留下不完整的文件
+func writeFile(target string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	tmp := target + ".restore"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		out.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, target)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// push 将备份文件上传到指定存储策略的 /backups 目录下
+func (s *Service) push(ctx context.Context, policyID, file string) (string, error) {
+	policy, err := s.policySvc.GetPolicyByID(ctx, policyID)
+	if err != nil {
+		return "", fmt.Errorf("获取存储策略失败: %w", err)
+	}
+	if policy.Type == constant.PolicyTypeLocal {
+		return "", fmt.Errorf("存储策略 %s 为本机存储，备份已保存在本地，无需推送", policy.Name)
+	}
+	provider, ok := s.providers[policy.Type]
+	if !ok {
+		return "", fmt.Errorf("不支持的存储类型: %s", policy.Type)
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	virtualPath := path.Join(policy.VirtualPath, "backups", filepath.Base(file))
+	if _, err := provider.Upload(ctx, f, policy, virtualPath); err != nil {
+		return "", err
+	}
+	return virtualPath, nil
+}
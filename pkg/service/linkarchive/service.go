@@ -0,0 +1,536 @@
+/*
+ * @Description: 外链存档服务，将文章中的外部链接提交到 Wayback Machine 或自定义存档服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 定时任务扫描上次运行后有变更的已发布文章，提取正文中的外部链接并逐个提交存档，
+ * 存档结果保存在数据库的 link_archives 表中，文章详情接口据此返回每个链接的存档地址，
+ * 主题可以在原链接失效时提供“查看存档”入口。旧版本的 data/link_archives.json 会在启动时导入一次。
+ */
+package linkarchive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/article"
+	"github.com/anzhiyu-c/anheyu-app/ent/linkarchive"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// LegacyStorePath 旧版本保存存档记录的文件，启动时导入数据库后重命名
+	LegacyStorePath = "data/link_archives.json"
+
+	// 存档服务类型
+	ProviderWayback = "wayback"
+	ProviderCustom  = "custom"
+
+	waybackSaveURL      = "https://web.archive.org/save/"
+	waybackAvailableURL = "https://archive.org/wayback/available?url="
+
+	defaultBatchSize = 20
+	maxAttempts      = 5
+	// submitInterval 两次提交之间的间隔，Wayback Machine 对匿名提交有频率限制
+	submitInterval = 5 * time.Second
+	scanPageSize   = 50
+	// maxURLLength 超过该长度的链接不存档，与 link_archives.url 字段长度一致
+	maxURLLength = 500
+)
+
+// ErrRunning 存档任务正在运行
+var ErrRunning = errors.New("外链存档任务正在运行中")
+
+var hrefRegex = regexp.MustCompile(`(?i)<a\s[^>]*?href\s*=\s*["'](https?://[^"'#\s]+)`)
+
+// waybackSnapshotRegex Wayback Machine 的快照地址：/web/<14 位时间戳>[可选标记]/<原链接>
+var waybackSnapshotRegex = regexp.MustCompile(`^https://web\.archive\.org/web/\d{14}[a-z_]*/https?://`)
+
+// Record 单个链接的存档记录
+type Record struct {
+	URL         string     `json:"url"`
+	ArchivedURL string     `json:"archived_url,omitempty"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	Attempts    int        `json:"attempts"`
+	LastAttempt *time.Time `json:"last_attempt,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// RunResult 一次存档任务的结果
+type RunResult struct {
+	Articles  int `json:"articles"`  // 扫描到有变更的文章数
+	Submitted int `json:"submitted"` // 提交存档的链接数
+	Archived  int `json:"archived"`  // 成功存档的链接数
+	Pending   int `json:"pending"`   // 仍待存档的链接数
+}
+
+// Service 外链存档服务
+type Service struct {
+	db         *ent.Client
+	settingSvc setting.SettingService
+	httpClient *http.Client
+
+	running sync.Mutex
+}
+
+// NewService 创建外链存档服务，legacyPath 不为空时导入旧版本保存在文件中的存档记录
+func NewService(db *ent.Client, settingSvc setting.SettingService, legacyPath string) *Service {
+	s := &Service{
+		db:         db,
+		settingSvc: settingSvc,
+		httpClient: httpclient.New(httpclient.Options{
+			Name:     "link_archive",
+			Timeout:  2 * time.Minute,
+			Identify: true,
+		}),
+	}
+	if legacyPath != "" {
+		if err := s.importLegacy(context.Background(), legacyPath); err != nil {
+			log.Printf("[外链存档] 导入旧版存档记录失败: %v", err)
+		}
+	}
+	return s
+}
+
+// Enabled 是否启用外链存档
+func (s *Service) Enabled() bool {
+	return s.settingSvc.GetBool(constant.KeyLinkArchiveEnable.String())
+}
+
+// Lookup 返回正文中已存档链接的存档地址，键为原链接
+func (s *Service) Lookup(ctx context.Context, contentHTML string) map[string]string {
+	links := ExtractLinks(contentHTML, nil)
+	if len(links) == 0 {
+		return nil
+	}
+	rows, err := s.db.LinkArchive.Query().
+		Where(linkarchive.URLIn(links...), linkarchive.ArchivedURLNEQ("")).
+		Select(linkarchive.FieldURL, linkarchive.FieldArchivedURL).
+		All(ctx)
+	if err != nil {
+		log.Printf("[外链存档] 查询存档地址失败: %v", err)
+		return nil
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(rows))
+	for _, row := range rows {
+		result[row.URL] = row.ArchivedURL
+	}
+	return result
+}
+
+// Records 返回全部存档记录，供后台查看
+func (s *Service) Records(ctx context.Context) ([]*Record, error) {
+	rows, err := s.db.LinkArchive.Query().Order(ent.Asc(linkarchive.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Record, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toRecord(row))
+	}
+	return result, nil
+}
+
+// Run 扫描有变更的文章收集外链，并提交一批待存档的链接
+func (s *Service) Run(ctx context.Context) (*RunResult, error) {
+	if !s.running.TryLock() {
+		return nil, ErrRunning
+	}
+	defer s.running.Unlock()
+
+	result := &RunResult{}
+	articles, err := s.collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Articles = articles
+
+	batchSize, err := strconv.Atoi(s.settingSvc.Get(constant.KeyLinkArchiveBatchSize.String()))
+	if err != nil || batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	due, err := s.due(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("读取待存档链接失败: %w", err)
+	}
+	for _, r := range due {
+		if result.Submitted >= batchSize {
+			break
+		}
+		if result.Submitted > 0 {
+			select {
+			case <-ctx.Done():
+				return result, ctx.Err()
+			case <-time.After(submitInterval):
+			}
+		}
+		result.Submitted++
+
+		archivedURL, archiveErr := s.archive(ctx, r.URL)
+		now := time.Now()
+		update := s.db.LinkArchive.UpdateOneID(r.ID).AddAttempts(1).SetLastAttempt(now)
+		if archiveErr != nil {
+			update.SetError(truncate(archiveErr.Error(), 500))
+			log.Printf("[外链存档] 存档 %s 失败: %v", r.URL, archiveErr)
+		} else {
+			update.SetArchivedURL(archivedURL).SetArchivedAt(now).SetError("")
+			result.Archived++
+		}
+		if err := update.Exec(ctx); err != nil {
+			log.Printf("[外链存档] 保存 %s 的存档结果失败: %v", r.URL, err)
+		}
+	}
+
+	if pending, err := s.due(ctx, time.Now().Add(24*time.Hour)); err == nil {
+		result.Pending = len(pending)
+	}
+	log.Printf("[外链存档] 完成：扫描文章 %d 篇，提交 %d 个，成功 %d 个，待存档 %d 个",
+		result.Articles, result.Submitted, result.Archived, result.Pending)
+	return result, nil
+}
+
+// collect 扫描上次扫描后更新过的已发布文章，把新出现的外链加入待存档列表。
+// 全部扫描完成后才推进扫描时间，中途失败时下次从上一个扫描时间重新开始
+func (s *Service) collect(ctx context.Context) (int, error) {
+	startedAt := time.Now()
+	query := s.db.Article.Query().Where(
+		article.StatusEQ(article.StatusPUBLISHED),
+		article.DeletedAtIsNil(),
+		article.IsTakedownEQ(false),
+		article.Or(
+			article.ReviewStatusEQ(article.ReviewStatusAPPROVED),
+			article.ReviewStatusEQ(article.ReviewStatusNONE),
+		),
+	)
+	if since, err := time.Parse(time.RFC3339Nano, s.settingSvc.Get(constant.KeyLinkArchiveScannedAt.String())); err == nil {
+		query = query.Where(article.UpdatedAtGT(since))
+	}
+
+	excluded := s.excludedHosts()
+	changed := 0
+	var lastID uint
+	for {
+		articles, err := query.Clone().
+			Where(article.IDGT(lastID)).
+			Order(ent.Asc(article.FieldID)).
+			Limit(scanPageSize).
+			Select(article.FieldID, article.FieldContentHTML).
+			All(ctx)
+		if err != nil {
+			return changed, fmt.Errorf("读取文章列表失败: %w", err)
+		}
+
+		var builders []*ent.LinkArchiveCreate
+		for _, a := range articles {
+			lastID = a.ID
+			changed++
+			for _, link := range ExtractLinks(a.ContentHTML, excluded) {
+				if len(link) <= maxURLLength {
+					builders = append(builders, s.db.LinkArchive.Create().SetURL(link))
+				}
+			}
+		}
+		if err := s.insertLinks(ctx, builders); err != nil {
+			return changed, fmt.Errorf("保存待存档链接失败: %w", err)
+		}
+
+		if len(articles) < scanPageSize {
+			break
+		}
+	}
+
+	err := s.settingSvc.UpdateSettings(ctx, map[string]string{
+		constant.KeyLinkArchiveScannedAt.String(): startedAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		log.Printf("[外链存档] 保存扫描时间失败: %v", err)
+	}
+	return changed, nil
+}
+
+// insertLinks 批量写入链接，已存在的链接保持原有存档状态
+func (s *Service) insertLinks(ctx context.Context, builders []*ent.LinkArchiveCreate) error {
+	for len(builders) > 0 {
+		n := min(len(builders), 100)
+		err := s.db.LinkArchive.CreateBulk(builders[:n]...).
+			OnConflictColumns(linkarchive.FieldURL).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		builders = builders[n:]
+	}
+	return nil
+}
+
+// due 返回到期需要提交的链接：未存档、未超过重试次数，且距上次失败已超过退避时间
+func (s *Service) due(ctx context.Context, now time.Time) ([]*ent.LinkArchive, error) {
+	rows, err := s.db.LinkArchive.Query().
+		Where(
+			linkarchive.Or(linkarchive.ArchivedURLIsNil(), linkarchive.ArchivedURLEQ("")),
+			linkarchive.AttemptsLT(maxAttempts),
+		).
+		Order(ent.Asc(linkarchive.FieldID)).
+		Select(linkarchive.FieldURL, linkarchive.FieldAttempts, linkarchive.FieldLastAttempt).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := rows[:0]
+	for _, r := range rows {
+		if r.LastAttempt != nil && now.Sub(*r.LastAttempt) < time.Duration(r.Attempts)*6*time.Hour {
+			continue
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// excludedHosts 本站域名、存档服务自身以及配置中排除的域名不需要存档
+func (s *Service) excludedHosts() []string {
+	hosts := []string{"web.archive.org", "archive.org"}
+	if u, err := url.Parse(s.settingSvc.Get(constant.KeySiteURL.String())); err == nil && u.Host != "" {
+		hosts = append(hosts, strings.ToLower(u.Hostname()))
+	}
+	for _, h := range strings.Split(s.settingSvc.Get(constant.KeyLinkArchiveExclude.String()), ",") {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
+
+// ExtractLinks 提取 HTML 中的外部链接并去重，excluded 中的域名及其子域名会被跳过
+func ExtractLinks(contentHTML string, excluded []string) []string {
+	seen := make(map[string]bool)
+	var links []string
+	for _, m := range hrefRegex.FindAllStringSubmatch(contentHTML, -1) {
+		link := html.UnescapeString(m[1])
+		u, err := url.Parse(link)
+		if err != nil || u.Host == "" {
+			continue
+		}
+		host := strings.ToLower(u.Hostname())
+		skip := false
+		for _, ex := range excluded {
+			if host == ex || strings.HasSuffix(host, "."+ex) {
+				skip = true
+				break
+			}
+		}
+		if skip || seen[link] {
+			continue
+		}
+		seen[link] = true
+		links = append(links, link)
+	}
+	return links
+}
+
+// archive 提交单个链接并返回存档地址，只接受存档服务返回的快照地址
+func (s *Service) archive(ctx context.Context, link string) (string, error) {
+	if s.settingSvc.Get(constant.KeyLinkArchiveProvider.String()) == ProviderCustom {
+		endpoint := s.settingSvc.Get(constant.KeyLinkArchiveEndpoint.String())
+		if !strings.Contains(endpoint, "{url}") {
+			return "", errors.New("自定义存档服务地址需要包含 {url} 占位符")
+		}
+		archivedURL, err := s.submit(ctx, strings.ReplaceAll(endpoint, "{url}", url.QueryEscape(link)))
+		if err != nil {
+			return "", err
+		}
+		if !isCustomSnapshot(endpoint, link, archivedURL) {
+			return "", fmt.Errorf("存档服务返回的不是快照地址: %s", truncate(archivedURL, 200))
+		}
+		return archivedURL, nil
+	}
+
+	archivedURL, err := s.submit(ctx, waybackSaveURL+link)
+	if err == nil && !waybackSnapshotRegex.MatchString(archivedURL) {
+		err = fmt.Errorf("Wayback Machine 返回的不是快照地址: %s", truncate(archivedURL, 200))
+	}
+	if err == nil {
+		return archivedURL, nil
+	}
+	// 提交失败时（例如触发频率限制）尝试使用已有的最近快照
+	if snapshot := s.waybackSnapshot(ctx, link); waybackSnapshotRegex.MatchString(snapshot) {
+		return snapshot, nil
+	}
+	return "", err
+}
+
+// isSnapshot 按当前的存档服务检查 archivedURL 是否为 link 的快照地址
+func (s *Service) isSnapshot(link, archivedURL string) bool {
+	if waybackSnapshotRegex.MatchString(archivedURL) {
+		return true
+	}
+	if s.settingSvc.Get(constant.KeyLinkArchiveProvider.String()) != ProviderCustom {
+		return false
+	}
+	return isCustomSnapshot(s.settingSvc.Get(constant.KeyLinkArchiveEndpoint.String()), link, archivedURL)
+}
+
+// isCustomSnapshot 自定义存档服务返回的地址必须是存档服务自身（或其子域名）上的页面，
+// 且不能是提交地址本身或原链接，避免把错误页、跳回原站的地址当作存档
+func isCustomSnapshot(endpoint, link, archivedURL string) bool {
+	u, err := url.Parse(archivedURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return false
+	}
+	if archivedURL == link || strings.Contains(archivedURL, "{url}") {
+		return false
+	}
+	e, err := url.Parse(strings.ReplaceAll(endpoint, "{url}", ""))
+	if err != nil || e.Hostname() == "" {
+		return false
+	}
+	host, archiveHost := strings.ToLower(u.Hostname()), strings.ToLower(e.Hostname())
+	if host != archiveHost && !strings.HasSuffix(host, "."+archiveHost) {
+		return false
+	}
+	return u.Path != "" && u.Path != "/" && u.Path != e.Path
+}
+
+// submit 请求存档地址，按 Content-Location、Location 或跳转后的最终地址确定存档结果
+func (s *Service) submit(ctx context.Context, submitURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, submitURL, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+
+	if resp.StatusCode >= 400 {
+		return "", fmt.Errorf("存档服务返回 HTTP %d", resp.StatusCode)
+	}
+	for _, candidate := range []string{resp.Header.Get("Content-Location"), resp.Header.Get("Location")} {
+		if candidate == "" {
+			continue
+		}
+		if ref, err := resp.Request.URL.Parse(candidate); err == nil {
+			return ref.String(), nil
+		}
+	}
+	if final := resp.Request.URL.String(); final != submitURL {
+		return final, nil
+	}
+	return "", errors.New("存档服务未返回存档地址")
+}
+
+// waybackSnapshot 查询 Wayback Machine 中已有的最近快照
+func (s *Service) waybackSnapshot(ctx context.Context, link string) string {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, waybackAvailableURL+url.QueryEscape(link), nil)
+	if err != nil {
+		return ""
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return ""
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		ArchivedSnapshots struct {
+			Closest struct {
+				Available bool   `json:"available"`
+				URL       string `json:"url"`
+			} `json:"closest"`
+		} `json:"archived_snapshots"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return ""
+	}
+	if !body.ArchivedSnapshots.Closest.Available {
+		return ""
+	}
+	return body.ArchivedSnapshots.Closest.URL
+}
+
+// importLegacy 将旧版本 data/link_archives.json 中的存档记录导入数据库，成功后重命名文件避免重复导入。
+// 旧文件中的文章扫描时间不再使用，导入后第一次任务会重新扫描全部文章，已有链接保持原状态
+func (s *Service) importLegacy(ctx context.Context, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var stored struct {
+		Links map[string]*Record `json:"links"`
+	}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+
+	builders := make([]*ent.LinkArchiveCreate, 0, len(stored.Links))
+	for link, r := range stored.Links {
+		if r == nil || link == "" || len(link) > maxURLLength {
+			continue
+		}
+		b := s.db.LinkArchive.Create().
+			SetURL(link).
+			SetAttempts(r.Attempts).
+			SetNillableLastAttempt(r.LastAttempt).
+			SetError(truncate(r.Error, 500))
+		// 旧版本未校验存档地址，只保留确认是快照的地址，其余的重新提交
+		if r.ArchivedURL != "" && s.isSnapshot(link, r.ArchivedURL) {
+			b.SetArchivedURL(r.ArchivedURL).SetNillableArchivedAt(r.ArchivedAt)
+		} else if r.ArchivedURL != "" {
+			b.SetAttempts(0).SetError("")
+		}
+		builders = append(builders, b)
+	}
+	if err := s.insertLinks(ctx, builders); err != nil {
+		return err
+	}
+	log.Printf("[外链存档] 已从 %s 导入 %d 条存档记录", path, len(builders))
+	return os.Rename(path, path+".imported")
+}
+
+func toRecord(row *ent.LinkArchive) *Record {
+	return &Record{
+		URL:         row.URL,
+		ArchivedURL: row.ArchivedURL,
+		ArchivedAt:  row.ArchivedAt,
+		Attempts:    row.Attempts,
+		LastAttempt: row.LastAttempt,
+		Error:       row.Error,
+	}
+}
+
+// truncate 按字节截断字符串，不截断多字节字符
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,543 @@
+/*
+ * @Description: 第三方字体与静态资源本地化
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 将主题 HTML 中引用的 Google Fonts、jsDelivr 等 CDN 资源下载到本地，改写为 /needcache/resource/ 下的地址，
+ * 改善这些 CDN 访问较慢地区的加载速度。首次遇到的资源在后台下载，下载完成前仍使用原地址；
+ * CSS 中通过 url() 引用的字体等资源会一并本地化。已缓存的资源由定时任务定期刷新。
+ * 只本地化样式、脚本、字体和位图图片，内容类型由扩展名决定而不沿用上游返回的类型；资源总数和总体积都有上限。
+ */
+package localizer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// DefaultCacheDir 本地化资源的默认存储目录
+	DefaultCacheDir = "data/localized"
+	// URLPrefix 本地化资源的访问路径前缀
+	URLPrefix = "/needcache/resource/"
+
+	indexFileName = "index.json"
+	// maxResourceSize 单个资源的最大体积
+	maxResourceSize = 20 << 20
+	// maxTotalSize 所有本地化资源的总体积上限
+	maxTotalSize = 200 << 20
+	// maxResources 记录的资源数量上限（包括下载失败的资源）
+	maxResources = 1000
+	// queueSize 等待下载的资源队列长度，队列满时本次请求不再排队，下次遇到时重试
+	queueSize = 256
+	// browserUserAgent Google Fonts 按 User-Agent 返回字体格式，使用现代浏览器的 UA 获取 woff2
+	browserUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0 Safari/537.36"
+)
+
+var (
+	// ErrResourceNotFound 本地化资源不存在
+	ErrResourceNotFound = errors.New("资源不存在")
+	// ErrResourceType 资源类型不在允许本地化的范围内
+	ErrResourceType = errors.New("不允许本地化的资源类型")
+	// ErrQuotaExceeded 本地化资源的数量或总体积已达上限
+	ErrQuotaExceeded = errors.New("本地化资源已达数量或体积上限")
+)
+
+// resourceTypes 允许本地化的扩展名及对应的内容类型。SVG、HTML 等可执行脚本的类型不在其中
+var resourceTypes = map[string]string{
+	"css":   "text/css; charset=utf-8",
+	"js":    "text/javascript; charset=utf-8",
+	"woff2": "font/woff2",
+	"woff":  "font/woff",
+	"ttf":   "font/ttf",
+	"otf":   "font/otf",
+	"eot":   "application/vnd.ms-fontobject",
+	"png":   "image/png",
+	"jpg":   "image/jpeg",
+	"jpeg":  "image/jpeg",
+	"gif":   "image/gif",
+	"webp":  "image/webp",
+	"avif":  "image/avif",
+}
+
+var (
+	// fileNamePattern 本地文件名为 URL 哈希加扩展名
+	fileNamePattern = regexp.MustCompile(`^[a-f0-9]{32}\.[a-z0-9]{1,8}$`)
+	// cssURLPattern 匹配 CSS 中的 url(...) 引用
+	cssURLPattern = regexp.MustCompile(`url\(\s*['"]?([^'")]+?)['"]?\s*\)`)
+)
+
+var downloadClient = httpclient.New(httpclient.Options{
+	Name:       "resource-localizer",
+	Timeout:    60 * time.Second,
+	MaxRetries: 2,
+})
+
+// Entry 单个本地化资源
+type Entry struct {
+	URL         string     `json:"url"`
+	File        string     `json:"file,omitempty"`
+	ContentType string     `json:"content_type,omitempty"`
+	Size        int64      `json:"size"`
+	FetchedAt   *time.Time `json:"fetched_at,omitempty"`
+	LastError   string     `json:"last_error,omitempty"`
+	LastErrorAt *time.Time `json:"last_error_at,omitempty"`
+}
+
+// RefreshResult 刷新结果
+type RefreshResult struct {
+	Total   int `json:"total"`
+	Updated int `json:"updated"`
+	Failed  int `json:"failed"`
+}
+
+// Service 资源本地化服务
+type Service struct {
+	settingSvc setting.SettingService
+	dir        string
+
+	mu      sync.RWMutex
+	entries map[string]*Entry // 原始 URL -> 资源
+	byFile  map[string]*Entry // 本地文件名 -> 资源
+	pending map[string]bool
+	queue   chan string
+
+	patternMu    sync.Mutex
+	patternHosts string
+	pattern      *regexp.Regexp
+}
+
+// NewService 创建资源本地化服务并启动后台下载协程，dir 为空时使用 DefaultCacheDir
+func NewService(settingSvc setting.SettingService, dir string) *Service {
+	if dir == "" {
+		dir = DefaultCacheDir
+	}
+	s := &Service{
+		settingSvc: settingSvc,
+		dir:        dir,
+		entries:    make(map[string]*Entry),
+		byFile:     make(map[string]*Entry),
+		pending:    make(map[string]bool),
+		queue:      make(chan string, queueSize),
+	}
+	s.loadIndex()
+	go s.worker()
+	return s
+}
+
+// Enabled 是否启用资源本地化
+func (s *Service) Enabled() bool {
+	return s != nil && s.settingSvc.GetBool(constant.KeyResourceLocalizeEnable.String())
+}
+
+// Rewrite 将 HTML 中可本地化的资源地址替换为本地地址，尚未下载的资源加入下载队列并保留原地址
+func (s *Service) Rewrite(document string) string {
+	if !s.Enabled() {
+		return document
+	}
+	pattern := s.hostPattern()
+	if pattern == nil {
+		return document
+	}
+	return pattern.ReplaceAllStringFunc(document, func(match string) string {
+		rawURL := normalizeURL(html.UnescapeString(match))
+		if local, ok := s.localPath(rawURL); ok {
+			return local
+		}
+		s.enqueue(rawURL)
+		return match
+	})
+}
+
+// Open 打开本地化资源文件，返回文件和按扩展名确定的内容类型
+func (s *Service) Open(name string) (*os.File, string, error) {
+	if !fileNamePattern.MatchString(name) {
+		return nil, "", ErrResourceNotFound
+	}
+	contentType, ok := resourceTypes[strings.TrimPrefix(path.Ext(name), ".")]
+	if !ok {
+		return nil, "", ErrResourceNotFound
+	}
+	s.mu.RLock()
+	_, known := s.byFile[name]
+	s.mu.RUnlock()
+	if !known {
+		return nil, "", ErrResourceNotFound
+	}
+	f, err := os.Open(filepath.Join(s.dir, name))
+	if err != nil {
+		return nil, "", ErrResourceNotFound
+	}
+	return f, contentType, nil
+}
+
+// List 返回所有已记录的资源，按 URL 排序
+func (s *Service) List() []Entry {
+	s.mu.RLock()
+	result := make([]Entry, 0, len(s.entries))
+	for _, entry := range s.entries {
+		result = append(result, *entry)
+	}
+	s.mu.RUnlock()
+	sort.Slice(result, func(i, j int) bool { return result[i].URL < result[j].URL })
+	return result
+}
+
+// Refresh 重新下载所有已记录的资源，下载失败时保留原有的本地副本
+func (s *Service) Refresh(ctx context.Context) RefreshResult {
+	var result RefreshResult
+	for _, entry := range s.List() {
+		if ctx.Err() != nil {
+			break
+		}
+		result.Total++
+		if err := s.fetch(ctx, entry.URL); err != nil {
+			result.Failed++
+			continue
+		}
+		result.Updated++
+	}
+	log.Printf("[资源本地化] 刷新完成，共 %d 个资源，更新 %d 个，失败 %d 个", result.Total, result.Updated, result.Failed)
+	return result
+}
+
+// Clear 删除所有本地化资源，之后遇到的资源会重新下载
+func (s *Service) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.RemoveAll(s.dir); err != nil {
+		return fmt.Errorf("删除本地化资源失败: %w", err)
+	}
+	s.entries = make(map[string]*Entry)
+	s.byFile = make(map[string]*Entry)
+	return nil
+}
+
+func (s *Service) localPath(rawURL string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if entry, ok := s.entries[rawURL]; ok && entry.File != "" {
+		return URLPrefix + entry.File, true
+	}
+	return "", false
+}
+
+func (s *Service) enqueue(rawURL string) {
+	s.mu.Lock()
+	if s.pending[rawURL] {
+		s.mu.Unlock()
+		return
+	}
+	// 最近下载失败的资源等到定时刷新时再重试，避免每次请求都重复下载
+	if entry, ok := s.entries[rawURL]; ok && entry.LastErrorAt != nil && time.Since(*entry.LastErrorAt) < time.Hour {
+		s.mu.Unlock()
+		return
+	}
+	// 数量达到上限后不再记录新资源，已有资源仍可刷新
+	if _, ok := s.entries[rawURL]; !ok && len(s.entries) >= maxResources {
+		s.mu.Unlock()
+		return
+	}
+	s.pending[rawURL] = true
+	s.mu.Unlock()
+
+	select {
+	case s.queue <- rawURL:
+	default:
+		s.mu.Lock()
+		delete(s.pending, rawURL)
+		s.mu.Unlock()
+	}
+}
+
+func (s *Service) worker() {
+	for rawURL := range s.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		_ = s.fetch(ctx, rawURL)
+		cancel()
+
+		s.mu.Lock()
+		delete(s.pending, rawURL)
+		s.mu.Unlock()
+	}
+}
+
+// fetch 下载资源并写入本地，CSS 中引用的允许本地化的资源会一并下载
+func (s *Service) fetch(ctx context.Context, rawURL string) error {
+	data, ext, err := download(ctx, rawURL)
+	if err == nil && ext == "css" {
+		data = []byte(s.localizeCSS(ctx, rawURL, string(data)))
+	}
+	if err == nil {
+		err = s.store(rawURL, data, ext)
+	}
+	if err != nil {
+		log.Printf("[资源本地化] 下载 %s 失败: %v", rawURL, err)
+		s.recordError(rawURL, err)
+		return err
+	}
+	return nil
+}
+
+// localizeCSS 改写 CSS 中的 url() 引用：相对地址先按原 CSS 地址解析为绝对地址，允许本地化的资源下载到本地。
+// 引用的 CSS 文件不再递归下载，避免循环引用
+func (s *Service) localizeCSS(ctx context.Context, baseURL, css string) string {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return css
+	}
+	pattern := s.hostPattern()
+	return cssURLPattern.ReplaceAllStringFunc(css, func(match string) string {
+		ref := strings.TrimSpace(cssURLPattern.FindStringSubmatch(match)[1])
+		if ref == "" || strings.HasPrefix(ref, "data:") || strings.HasPrefix(ref, "#") {
+			return match
+		}
+		resolved, err := base.Parse(ref)
+		if err != nil {
+			return match
+		}
+		abs := resolved.String()
+		if pattern != nil && pattern.MatchString(abs) && urlExt(abs) != "css" {
+			if local, ok := s.localPath(abs); ok {
+				return "url(" + local + ")"
+			}
+			if err := s.fetch(ctx, abs); err == nil {
+				if local, ok := s.localPath(abs); ok {
+					return "url(" + local + ")"
+				}
+			}
+		}
+		return "url(" + abs + ")"
+	})
+}
+
+// store 写入资源文件并更新索引，超过数量或总体积上限时拒绝
+func (s *Service) store(rawURL string, data []byte, ext string) error {
+	name := fileName(rawURL, ext)
+	if err := s.checkQuota(rawURL, int64(len(data))); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	tmp := filepath.Join(s.dir, name+".tmp")
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(s.dir, name)); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	now := time.Now()
+	s.mu.Lock()
+	entry := &Entry{URL: rawURL, File: name, ContentType: resourceTypes[ext], Size: int64(len(data)), FetchedAt: &now}
+	s.entries[rawURL] = entry
+	s.byFile[name] = entry
+	s.mu.Unlock()
+	return s.saveIndex()
+}
+
+// checkQuota 检查保存 rawURL 的新内容后是否超过数量或总体积上限，替换已有资源时扣除其原体积
+func (s *Service) checkQuota(rawURL string, size int64) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var total int64
+	files := 0
+	for u, entry := range s.entries {
+		if entry.File == "" || u == rawURL {
+			continue
+		}
+		total += entry.Size
+		files++
+	}
+	if files >= maxResources || total+size > maxTotalSize {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+func (s *Service) recordError(rawURL string, err error) {
+	now := time.Now()
+	s.mu.Lock()
+	entry, ok := s.entries[rawURL]
+	if !ok {
+		entry = &Entry{URL: rawURL}
+		s.entries[rawURL] = entry
+	}
+	entry.LastError = err.Error()
+	entry.LastErrorAt = &now
+	s.mu.Unlock()
+	if saveErr := s.saveIndex(); saveErr != nil {
+		log.Printf("[资源本地化] 保存资源索引失败: %v", saveErr)
+	}
+}
+
+func (s *Service) loadIndex() {
+	data, err := os.ReadFile(filepath.Join(s.dir, indexFileName))
+	if err != nil {
+		return
+	}
+	var entries []*Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		log.Printf("[资源本地化] 解析资源索引失败: %v", err)
+		return
+	}
+	for _, entry := range entries {
+		// 丢弃旧版本保存的、不在允许范围内的资源
+		if _, ok := resourceTypes[strings.TrimPrefix(path.Ext(entry.File), ".")]; entry.File != "" && !ok {
+			os.Remove(filepath.Join(s.dir, entry.File))
+			continue
+		}
+		s.entries[entry.URL] = entry
+		if entry.File != "" {
+			s.byFile[entry.File] = entry
+		}
+	}
+}
+
+func (s *Service) saveIndex() error {
+	entries := s.List()
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	tmp := filepath.Join(s.dir, indexFileName+".tmp")
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(s.dir, indexFileName))
+}
+
+// hostPattern 根据配置的域名生成匹配资源地址的正则，配置未变化时复用
+func (s *Service) hostPattern() *regexp.Regexp {
+	hosts := s.settingSvc.Get(constant.KeyResourceLocalizeHosts.String())
+	s.patternMu.Lock()
+	defer s.patternMu.Unlock()
+	if hosts == s.patternHosts {
+		return s.pattern
+	}
+
+	var quoted []string
+	for _, host := range strings.Split(hosts, ",") {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			quoted = append(quoted, regexp.QuoteMeta(host))
+		}
+	}
+	s.patternHosts = hosts
+	s.pattern = nil
+	if len(quoted) > 0 {
+		s.pattern = regexp.MustCompile(`(?:https?:)?//(?:` + strings.Join(quoted, "|") + `)/[^\s"'<>()\\]+`)
+	}
+	return s.pattern
+}
+
+// download 下载资源，返回内容和本地扩展名。类型不在允许范围内时不读取响应内容
+func download(ctx context.Context, rawURL string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("User-Agent", browserUserAgent)
+	resp, err := downloadClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	ext, ok := resourceExt(rawURL, resp.Header.Get("Content-Type"))
+	if !ok {
+		return nil, "", ErrResourceType
+	}
+	if resp.ContentLength > maxResourceSize {
+		return nil, "", fmt.Errorf("资源超过 %d MB", maxResourceSize>>20)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResourceSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > maxResourceSize {
+		return nil, "", fmt.Errorf("资源超过 %d MB", maxResourceSize>>20)
+	}
+	return data, ext, nil
+}
+
+// resourceExt 确定资源的本地扩展名：优先取 URL 中的扩展名，URL 没有扩展名时（例如 Google Fonts 的 css2 接口）
+// 按上游内容类型推断。两者都不在允许范围内时 ok 为 false
+func resourceExt(rawURL, upstreamType string) (string, bool) {
+	if ext := urlExt(rawURL); ext != "" {
+		_, ok := resourceTypes[ext]
+		return ext, ok
+	}
+	mediaType, _, err := mime.ParseMediaType(upstreamType)
+	if err != nil {
+		return "", false
+	}
+	for ext, contentType := range resourceTypes {
+		if t, _, _ := mime.ParseMediaType(contentType); t == mediaType {
+			if ext == "jpeg" {
+				ext = "jpg"
+			}
+			return ext, true
+		}
+	}
+	switch mediaType {
+	case "application/javascript", "application/x-javascript":
+		return "js", true
+	case "application/font-woff2":
+		return "woff2", true
+	case "application/font-woff":
+		return "woff", true
+	}
+	return "", false
+}
+
+// normalizeURL 协议相对地址统一补全为 https
+func normalizeURL(rawURL string) string {
+	if strings.HasPrefix(rawURL, "//") {
+		return "https:" + rawURL
+	}
+	return rawURL
+}
+
+// urlExt 返回 URL 路径的小写扩展名（不含点）
+func urlExt(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(path.Ext(u.Path), "."))
+}
+
+// fileName 本地文件名由 URL 的哈希和扩展名组成
+func fileName(rawURL, ext string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return hex.EncodeToString(sum[:16]) + "." + ext
+}
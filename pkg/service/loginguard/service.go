@@ -0,0 +1,405 @@
+/*
+ * @Description: 登录安全服务：登录失败锁定、验证码升级、新设备登录提醒和认证事件记录
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 失败次数和锁定状态按账号（邮箱）和 IP 分别保存在缓存中；
+ * 认证事件保存在内存环形缓冲区，已知设备保存在数据库中，多实例部署时共享。
+ * 旧版本的 data/login_devices.json 会在启动时导入一次。
+ */
+package loginguard
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/logindevice"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	// LegacyDeviceStorePath 旧版本保存已知设备的文件，启动时导入数据库后重命名
+	LegacyDeviceStorePath = "data/login_devices.json"
+
+	maxEvents         = 500
+	maxDevicesPerUser = 20
+
+	cacheKeyFailAccount = "login:fail:account:"
+	cacheKeyFailIP      = "login:fail:ip:"
+	cacheKeyLockAccount = "login:lock:account:"
+	cacheKeyLockIP      = "login:lock:ip:"
+)
+
+// 认证事件类型
+const (
+	EventLoginSuccess = "login_success"
+	EventLoginFailed  = "login_failed"
+	EventLocked       = "locked"
+	EventBlocked      = "blocked"
+	EventNewDevice    = "new_device"
+)
+
+// LockedError 账号或 IP 处于锁定状态
+type LockedError struct {
+	Until time.Time
+}
+
+func (e *LockedError) Error() string {
+	minutes := int(time.Until(e.Until).Minutes()) + 1
+	return fmt.Sprintf("登录失败次数过多，请 %d 分钟后再试", minutes)
+}
+
+// Event 一条认证事件
+type Event struct {
+	ID       uint64    `json:"id"`
+	Time     time.Time `json:"time"`
+	Type     string    `json:"type"`
+	Email    string    `json:"email"`
+	IP       string    `json:"ip"`
+	Location string    `json:"location,omitempty"`
+	Device   string    `json:"device,omitempty"`
+	Reason   string    `json:"reason,omitempty"`
+}
+
+// legacyDevice 旧版本 data/login_devices.json 中的设备记录
+type legacyDevice struct {
+	Fingerprint string    `json:"fingerprint"`
+	Device      string    `json:"device"`
+	Location    string    `json:"location"`
+	LastSeen    time.Time `json:"last_seen"`
+}
+
+// Service 登录安全服务
+type Service struct {
+	db         *ent.Client
+	settingSvc setting.SettingService
+	cacheSvc   utility.CacheService
+	geoSvc     utility.GeoIPService
+	emailSvc   utility.EmailService
+
+	eventsMu sync.Mutex
+	events   []Event
+	nextID   uint64
+}
+
+// NewService 创建登录安全服务，geoSvc 为 nil 时设备指纹不包含地区，legacyPath 不为空时导入旧版本保存在文件中的已知设备
+func NewService(db *ent.Client, settingSvc setting.SettingService, cacheSvc utility.CacheService, geoSvc utility.GeoIPService, emailSvc utility.EmailService, legacyPath string) *Service {
+	s := &Service{
+		db:         db,
+		settingSvc: settingSvc,
+		cacheSvc:   cacheSvc,
+		geoSvc:     geoSvc,
+		emailSvc:   emailSvc,
+	}
+	if legacyPath != "" {
+		if err := s.importLegacyDevices(context.Background(), legacyPath); err != nil {
+			log.Printf("[登录安全] 导入旧版已知设备失败: %v", err)
+		}
+	}
+	return s
+}
+
+func (s *Service) intSetting(key constant.SettingKey, def int) int {
+	if v, err := strconv.Atoi(s.settingSvc.Get(key.String())); err == nil && v >= 0 {
+		return v
+	}
+	return def
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// Check 检查账号和 IP 是否处于锁定状态，锁定时返回 *LockedError
+func (s *Service) Check(ctx context.Context, email, ip string) error {
+	if !s.settingSvc.GetBool(constant.KeyLoginLockEnable.String()) {
+		return nil
+	}
+	for _, key := range []string{cacheKeyLockAccount + normalizeEmail(email), cacheKeyLockIP + ip} {
+		val, err := s.cacheSvc.Get(ctx, key)
+		if err != nil || val == "" {
+			continue
+		}
+		if until, err := strconv.ParseInt(val, 10, 64); err == nil && time.Now().Unix() < until {
+			s.record(Event{Type: EventBlocked, Email: email, IP: ip, Reason: "锁定期间尝试登录"})
+			return &LockedError{Until: time.Unix(until, 0)}
+		}
+	}
+	return nil
+}
+
+// CaptchaRequired 账号或 IP 的失败次数达到阈值后要求额外的图形验证码
+func (s *Service) CaptchaRequired(ctx context.Context, email, ip string) bool {
+	threshold := s.intSetting(constant.KeyLoginCaptchaAfter, 3)
+	if threshold == 0 {
+		return false
+	}
+	return s.failures(ctx, cacheKeyFailAccount+normalizeEmail(email)) >= threshold ||
+		s.failures(ctx, cacheKeyFailIP+ip) >= threshold
+}
+
+func (s *Service) failures(ctx context.Context, key string) int {
+	val, _ := s.cacheSvc.Get(ctx, key)
+	n, _ := strconv.Atoi(val)
+	return n
+}
+
+// RecordFailure 记录一次登录失败，达到阈值时锁定账号或 IP 并返回 *LockedError
+func (s *Service) RecordFailure(ctx context.Context, email, ip, userAgent, reason string) error {
+	email = normalizeEmail(email)
+	s.record(Event{Type: EventLoginFailed, Email: email, IP: ip, Device: DescribeDevice(userAgent), Reason: reason})
+	if !s.settingSvc.GetBool(constant.KeyLoginLockEnable.String()) {
+		return nil
+	}
+
+	window := time.Duration(s.intSetting(constant.KeyLoginLockWindow, 15)) * time.Minute
+	duration := time.Duration(s.intSetting(constant.KeyLoginLockDuration, 15)) * time.Minute
+
+	targets := []struct {
+		failKey, lockKey string
+		max              int
+		label            string
+	}{
+		{cacheKeyFailAccount + email, cacheKeyLockAccount + email, s.intSetting(constant.KeyLoginLockAccountMax, 5), "账号"},
+		{cacheKeyFailIP + ip, cacheKeyLockIP + ip, s.intSetting(constant.KeyLoginLockIPMax, 20), "IP"},
+	}
+	var locked error
+	for _, t := range targets {
+		count, err := s.cacheSvc.Increment(ctx, t.failKey)
+		if err != nil {
+			log.Printf("[登录安全] 记录登录失败次数失败: %v", err)
+			continue
+		}
+		if count == 1 {
+			s.cacheSvc.Expire(ctx, t.failKey, window)
+		}
+		if t.max > 0 && int(count) >= t.max {
+			until := time.Now().Add(duration)
+			s.cacheSvc.Set(ctx, t.lockKey, strconv.FormatInt(until.Unix(), 10), duration)
+			s.cacheSvc.Delete(ctx, t.failKey)
+			s.record(Event{Type: EventLocked, Email: email, IP: ip, Reason: fmt.Sprintf("%s连续失败 %d 次，锁定至 %s", t.label, count, until.Format("15:04:05"))})
+			locked = &LockedError{Until: until}
+		}
+	}
+	return locked
+}
+
+// RecordSuccess 记录登录成功并清除该账号的失败次数，新设备登录时按配置发送提醒邮件。
+// IP 的失败次数不清除，避免攻击者用一个有效账号重置计数。
+func (s *Service) RecordSuccess(ctx context.Context, user *model.User, ip, userAgent, referer string) {
+	s.cacheSvc.Delete(ctx, cacheKeyFailAccount+normalizeEmail(user.Email))
+
+	device := DescribeDevice(userAgent)
+	location := ""
+	if s.geoSvc != nil && ip != "" {
+		location, _ = s.geoSvc.Lookup(ip, referer)
+	}
+	s.record(Event{Type: EventLoginSuccess, Email: user.Email, IP: ip, Location: location, Device: device})
+
+	isNew, hasHistory, err := s.rememberDevice(ctx, user.ID, device, location)
+	if err != nil {
+		log.Printf("[登录安全] 保存已知设备失败: %v", err)
+		return
+	}
+	if !isNew || !hasHistory {
+		// 首次登录没有可比较的历史设备，不发送提醒
+		return
+	}
+	s.record(Event{Type: EventNewDevice, Email: user.Email, IP: ip, Location: location, Device: device})
+	if s.emailSvc == nil || !s.settingSvc.GetBool(constant.KeyLoginNotifyNewDevice.String()) {
+		return
+	}
+	go func() {
+		if err := s.emailSvc.SendNewDeviceLoginEmail(context.Background(), user.Email, user.Nickname, device, location, ip, time.Now()); err != nil {
+			log.Printf("[登录安全] 发送新设备登录提醒失败: %v", err)
+		}
+	}()
+}
+
+// Unlock 解除账号或 IP 的锁定并清除失败次数
+func (s *Service) Unlock(ctx context.Context, email, ip string) error {
+	var keys []string
+	if email = normalizeEmail(email); email != "" {
+		keys = append(keys, cacheKeyLockAccount+email, cacheKeyFailAccount+email)
+	}
+	if ip != "" {
+		keys = append(keys, cacheKeyLockIP+ip, cacheKeyFailIP+ip)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return s.cacheSvc.Delete(ctx, keys...)
+}
+
+// Events 按时间从新到旧返回最近的认证事件，eventType 为空时返回全部类型
+func (s *Service) Events(eventType string, limit int) []Event {
+	s.eventsMu.Lock()
+	defer s.eventsMu.Unlock()
+
+	result := make([]Event, 0, limit)
+	for i := len(s.events) - 1; i >= 0 && len(result) < limit; i-- {
+		if eventType == "" || s.events[i].Type == eventType {
+			result = append(result, s.events[i])
+		}
+	}
+	return result
+}
+
+func (s *Service) record(e Event) {
+	s.eventsMu.Lock()
+	defer s.eventsMu.Unlock()
+
+	s.nextID++
+	e.ID = s.nextID
+	e.Time = time.Now()
+	s.events = append(s.events, e)
+	if len(s.events) > maxEvents {
+		s.events = s.events[len(s.events)-maxEvents:]
+	}
+}
+
+// rememberDevice 记录用户的登录设备，返回是否为新设备以及该用户此前是否有设备记录
+func (s *Service) rememberDevice(ctx context.Context, userID uint, device, location string) (isNew, hasHistory bool, err error) {
+	sum := sha256.Sum256([]byte(device + "|" + location))
+	fingerprint := hex.EncodeToString(sum[:8])
+
+	n, err := s.db.LoginDevice.Update().
+		Where(logindevice.UserID(userID), logindevice.Fingerprint(fingerprint)).
+		SetLastSeen(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, false, err
+	}
+	if n > 0 {
+		return false, true, nil
+	}
+
+	hasHistory, err = s.db.LoginDevice.Query().Where(logindevice.UserID(userID)).Exist(ctx)
+	if err != nil {
+		return false, false, err
+	}
+	err = s.db.LoginDevice.Create().
+		SetUserID(userID).
+		SetFingerprint(fingerprint).
+		SetDevice(device).
+		SetLocation(location).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// 同一设备的并发登录已经写入了记录
+		return false, true, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+
+	// 只保留最近登录的设备
+	stale, err := s.db.LoginDevice.Query().
+		Where(logindevice.UserID(userID)).
+		Order(ent.Desc(logindevice.FieldLastSeen)).
+		Offset(maxDevicesPerUser).
+		IDs(ctx)
+	if err == nil && len(stale) > 0 {
+		_, err = s.db.LoginDevice.Delete().Where(logindevice.IDIn(stale...)).Exec(ctx)
+	}
+	if err != nil {
+		log.Printf("[登录安全] 清理用户 %d 的旧设备记录失败: %v", userID, err)
+	}
+	return true, hasHistory, nil
+}
+
+// importLegacyDevices 将旧版本 data/login_devices.json 中的设备导入数据库，成功后重命名文件避免重复导入
+func (s *Service) importLegacyDevices(ctx context.Context, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var legacy map[uint][]legacyDevice
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+
+	imported := 0
+	for userID, devices := range legacy {
+		if len(devices) == 0 {
+			continue
+		}
+		if _, err := s.db.User.Get(ctx, userID); err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		builders := make([]*ent.LoginDeviceCreate, 0, len(devices))
+		for _, d := range devices {
+			builders = append(builders, s.db.LoginDevice.Create().
+				SetUserID(userID).
+				SetFingerprint(d.Fingerprint).
+				SetDevice(d.Device).
+				SetLocation(d.Location).
+				SetLastSeen(d.LastSeen))
+		}
+		err = s.db.LoginDevice.CreateBulk(builders...).
+			OnConflict(sql.ConflictColumns(logindevice.FieldUserID, logindevice.FieldFingerprint)).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		imported += len(builders)
+	}
+	if err := os.Rename(path, path+".imported"); err != nil {
+		return err
+	}
+	log.Printf("[登录安全] 已从 %s 导入 %d 条已知设备", path, imported)
+	return nil
+}
+
+// describeDevice 从 User-Agent 中提取浏览器和操作系统
+func DescribeDevice(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+
+	browser := "其他浏览器"
+	switch {
+	case strings.Contains(ua, "edg/"):
+		browser = "Edge"
+	case strings.Contains(ua, "firefox"):
+		browser = "Firefox"
+	case strings.Contains(ua, "chrome"):
+		browser = "Chrome"
+	case strings.Contains(ua, "safari"):
+		browser = "Safari"
+	}
+
+	system := "其他系统"
+	switch {
+	case strings.Contains(ua, "android"):
+		system = "Android"
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"):
+		system = "iOS"
+	case strings.Contains(ua, "windows"):
+		system = "Windows"
+	case strings.Contains(ua, "mac os"):
+		system = "macOS"
+	case strings.Contains(ua, "linux"):
+		system = "Linux"
+	}
+	return browser + " / " + system
+}
@@ -0,0 +1,156 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	netmail "net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+)
+
+const (
+	sendGridEndpoint  = "https://api.sendgrid.com/v3/mail/send"
+	mailgunEndpoint   = "https://api.mailgun.net/v3"
+	mailgunEUEndpoint = "https://api.eu.mailgun.net/v3"
+	resendEndpoint    = "https://api.resend.com/emails"
+	// maxErrorBodySize 错误响应最多读取的字节数
+	maxErrorBodySize = 4 << 10
+)
+
+// 发送请求不是幂等的，由队列负责重试，客户端本身不重试
+var apiHTTPClient = httpclient.New(httpclient.Options{
+	Name:    "mail",
+	Timeout: 30 * time.Second,
+})
+
+// sendGridProvider SendGrid Web API v3
+type sendGridProvider struct {
+	apiKey string
+}
+
+func (p *sendGridProvider) Name() string { return ProviderSendGrid }
+
+func (p *sendGridProvider) Send(ctx context.Context, env *Envelope) error {
+	if p.apiKey == "" {
+		return fmt.Errorf("未配置 SendGrid API Key")
+	}
+	type address struct {
+		Email string `json:"email"`
+		Name  string `json:"name,omitempty"`
+	}
+	type content struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	}
+	payload := map[string]interface{}{
+		"personalizations": []map[string]interface{}{{"to": []address{{Email: env.To}}}},
+		"from":             address{Email: env.FromEmail, Name: env.FromName},
+		"subject":          env.Subject,
+		"content":          []content{{"text/plain", env.Text}, {"text/html", env.HTML}},
+	}
+	if env.ReplyTo != "" {
+		payload["reply_to"] = address{Email: env.ReplyTo}
+	}
+	return postJSON(ctx, ProviderSendGrid, sendGridEndpoint, p.apiKey, payload)
+}
+
+// mailgunProvider Mailgun Messages API
+type mailgunProvider struct {
+	apiKey string
+	domain string
+	region string
+}
+
+func (p *mailgunProvider) Name() string { return ProviderMailgun }
+
+func (p *mailgunProvider) Send(ctx context.Context, env *Envelope) error {
+	if p.apiKey == "" || p.domain == "" {
+		return fmt.Errorf("未配置 Mailgun API Key 或发信域名")
+	}
+	endpoint := mailgunEndpoint
+	if p.region == "eu" {
+		endpoint = mailgunEUEndpoint
+	}
+	form := url.Values{}
+	form.Set("from", formatAddress(env.FromName, env.FromEmail))
+	form.Set("to", env.To)
+	form.Set("subject", env.Subject)
+	form.Set("text", env.Text)
+	form.Set("html", env.HTML)
+	if env.ReplyTo != "" {
+		form.Set("h:Reply-To", env.ReplyTo)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/"+url.PathEscape(p.domain)+"/messages", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth("api", p.apiKey)
+	return doRequest(ProviderMailgun, req)
+}
+
+// resendProvider Resend API
+type resendProvider struct {
+	apiKey string
+}
+
+func (p *resendProvider) Name() string { return ProviderResend }
+
+func (p *resendProvider) Send(ctx context.Context, env *Envelope) error {
+	if p.apiKey == "" {
+		return fmt.Errorf("未配置 Resend API Key")
+	}
+	payload := map[string]interface{}{
+		"from":    formatAddress(env.FromName, env.FromEmail),
+		"to":      []string{env.To},
+		"subject": env.Subject,
+		"html":    env.HTML,
+		"text":    env.Text,
+	}
+	if env.ReplyTo != "" {
+		payload["reply_to"] = env.ReplyTo
+	}
+	return postJSON(ctx, ProviderResend, resendEndpoint, p.apiKey, payload)
+}
+
+func postJSON(ctx context.Context, provider, endpoint, apiKey string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	return doRequest(provider, req)
+}
+
+// doRequest 发送请求，2xx 视为成功，否则返回包含响应内容的错误
+func doRequest(provider string, req *http.Request) error {
+	resp, err := apiHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("请求 %s 接口失败: %w", provider, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	return fmt.Errorf("%s 接口返回 HTTP %d: %s", provider, resp.StatusCode, strings.TrimSpace(string(msg)))
+}
+
+// formatAddress 生成 "名称 <地址>" 形式的发件人，名称中的特殊字符按 RFC 5322 处理
+func formatAddress(name, email string) string {
+	return (&netmail.Address{Name: name, Address: email}).String()
+}
@@ -0,0 +1,104 @@
+package mail
+
+import (
+	"bytes"
+	"html"
+	"html/template"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/parser"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// defaultBrandColor 未配置主题色时外框使用的颜色
+const defaultBrandColor = "#425AEF"
+
+var (
+	// fullDocumentPattern 正文已是完整的 HTML 文档时不套用外框
+	fullDocumentPattern = regexp.MustCompile(`(?i)<(!doctype|html|body)[\s>]`)
+	// colorPattern 主题色只接受十六进制颜色，避免写入样式的内容被注入
+	colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{3,8}$`)
+	// textBreakPattern 块级元素结束处换行
+	textBreakPattern = regexp.MustCompile(`(?i)</(p|h[1-6]|li|div|blockquote|tr|table)>|<br\s*/?>`)
+	// textLinkPattern 纯文本版本中保留链接地址
+	textLinkPattern    = regexp.MustCompile(`(?is)<a\s[^>]*href\s*=\s*["']([^"']+)["'][^>]*>(.*?)</a>`)
+	textSpacePattern   = regexp.MustCompile(`[ \t\r\f\v]+`)
+	textNewlinePattern = regexp.MustCompile(`\s*\n\s*(\n\s*)+`)
+)
+
+// layoutTemplate 邮件统一外框，使用表格布局和内联样式以兼容各邮件客户端
+var layoutTemplate = template.Must(template.New("layout").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="UTF-8"><meta name="viewport" content="width=device-width, initial-scale=1.0"><title>{{.Subject}}</title></head>
+<body style="margin:0;padding:0;background:#f4f5f7;">
+<table role="presentation" width="100%" cellpadding="0" cellspacing="0" style="background:#f4f5f7;padding:24px 0;">
+<tr><td align="center">
+<table role="presentation" width="600" cellpadding="0" cellspacing="0" style="max-width:600px;width:100%;background:#ffffff;border-radius:8px;overflow:hidden;">
+<tr><td style="background:{{.Color}};padding:20px 30px;">
+<a href="{{.SiteURL}}" style="text-decoration:none;color:#ffffff;font-size:20px;font-weight:bold;">{{if .LogoURL}}<img src="{{.LogoURL}}" alt="" height="32" style="height:32px;vertical-align:middle;margin-right:10px;border:0;">{{end}}<span style="vertical-align:middle;">{{.SiteName}}</span></a>
+</td></tr>
+<tr><td style="padding:30px;font-size:14px;line-height:1.8;color:#333333;">{{.Body}}</td></tr>
+<tr><td style="background:#f8f9fa;padding:16px 30px;text-align:center;font-size:12px;color:#999999;">
+<p style="margin:4px 0;">本邮件由 <a href="{{.SiteURL}}" style="color:#999999;">{{.SiteName}}</a> 自动发送，请勿直接回复</p>
+<p style="margin:4px 0;">© {{.Year}} {{.SiteName}}</p>
+</td></tr>
+</table>
+</td></tr>
+</table>
+</body>
+</html>`))
+
+// applyLayout 为正文套用带站点名称、Logo 和主题色的外框，正文已是完整 HTML 文档时原样返回
+func (s *Service) applyLayout(subject, body string) string {
+	if fullDocumentPattern.MatchString(body) {
+		return body
+	}
+	color := strings.TrimSpace(s.settingSvc.Get(constant.KeyThemeColor.String()))
+	if !colorPattern.MatchString(color) {
+		color = defaultBrandColor
+	}
+	logoURL := strings.TrimSpace(s.settingSvc.Get(constant.KeyLogoURL192.String()))
+	if logoURL == "" {
+		logoURL = strings.TrimSpace(s.settingSvc.Get(constant.KeyLogoURL.String()))
+	}
+	// 邮件客户端无法加载相对地址的图片
+	if !strings.HasPrefix(logoURL, "http://") && !strings.HasPrefix(logoURL, "https://") {
+		logoURL = ""
+	}
+
+	var buf bytes.Buffer
+	err := layoutTemplate.Execute(&buf, map[string]interface{}{
+		"Subject":  subject,
+		"SiteName": s.settingSvc.Get(constant.KeyAppName.String()),
+		"SiteURL":  strings.TrimRight(s.settingSvc.Get(constant.KeySiteURL.String()), "/"),
+		"LogoURL":  logoURL,
+		"Color":    template.CSS(color),
+		"Body":     template.HTML(body),
+		"Year":     time.Now().Year(),
+	})
+	if err != nil {
+		log.Printf("[邮件] 套用邮件外框失败，使用原始正文: %v", err)
+		return body
+	}
+	return buf.String()
+}
+
+// htmlToText 生成邮件的纯文本版本，链接以 "文字 (地址)" 形式保留
+func htmlToText(body string) string {
+	text := textLinkPattern.ReplaceAllStringFunc(body, func(m string) string {
+		sub := textLinkPattern.FindStringSubmatch(m)
+		label := strings.TrimSpace(parser.StripHTML(sub[2]))
+		if label == "" || html.UnescapeString(label) == sub[1] {
+			return sub[1]
+		}
+		return label + " (" + sub[1] + ")"
+	})
+	text = textBreakPattern.ReplaceAllString(text, "\n")
+	text = html.UnescapeString(parser.StripHTML(text))
+	text = textSpacePattern.ReplaceAllString(text, " ")
+	text = textNewlinePattern.ReplaceAllString(text, "\n\n")
+	return strings.TrimSpace(text)
+}
@@ -0,0 +1,281 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// 队列中邮件的状态
+const (
+	StatusPending = "pending"
+	StatusFailed  = "failed"
+)
+
+const (
+	// queuePollInterval 后台协程检查到期邮件的间隔
+	queuePollInterval = 30 * time.Second
+	// maxFailedItems 保留的发送失败记录数，超出时删除最早的记录
+	maxFailedItems = 200
+)
+
+// retryDelays 第 n 次发送失败后的重试间隔，超出部分使用最后一项
+var retryDelays = []time.Duration{time.Minute, 5 * time.Minute, 30 * time.Minute, 2 * time.Hour, 6 * time.Hour}
+
+// QueueItem 队列中的一封邮件
+type QueueItem struct {
+	ID            string    `json:"id"`
+	Message       Message   `json:"message"`
+	Status        string    `json:"status"`
+	Attempts      int       `json:"attempts"`
+	LastError     string    `json:"last_error,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+}
+
+// QueueStatus 发送队列概况，Items 中不包含邮件正文
+type QueueStatus struct {
+	Provider string       `json:"provider"`
+	Pending  int          `json:"pending"`
+	Failed   int          `json:"failed"`
+	Items    []*QueueItem `json:"items"`
+}
+
+// queue 持久化的邮件发送队列，保存在 data/mail_queue.json，重启后继续发送
+type queue struct {
+	svc  *Service
+	path string
+	wake chan struct{}
+
+	mu    sync.Mutex
+	items []*QueueItem
+}
+
+func newQueue(path string, svc *Service) *queue {
+	q := &queue{svc: svc, path: path, wake: make(chan struct{}, 1)}
+	q.load()
+	return q
+}
+
+func (q *queue) push(msg *Message) error {
+	id, err := randomToken(8)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	q.mu.Lock()
+	q.items = append(q.items, &QueueItem{
+		ID:            id,
+		Message:       *msg,
+		Status:        StatusPending,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	})
+	err = q.saveLocked()
+	q.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("保存邮件队列失败: %w", err)
+	}
+	q.notify()
+	return nil
+}
+
+func (q *queue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// worker 依次发送到期的邮件
+func (q *queue) worker() {
+	ticker := time.NewTicker(queuePollInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			item := q.nextDue()
+			if item == nil {
+				break
+			}
+			q.deliver(item)
+		}
+		select {
+		case <-ticker.C:
+		case <-q.wake:
+		}
+	}
+}
+
+// nextDue 返回最早到期的待发送邮件
+func (q *queue) nextDue() *QueueItem {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := time.Now()
+	for _, item := range q.items {
+		if item.Status == StatusPending && !item.NextAttemptAt.After(now) {
+			copied := *item
+			return &copied
+		}
+	}
+	return nil
+}
+
+func (q *queue) deliver(item *QueueItem) {
+	err := q.svc.Send(context.Background(), &item.Message)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	idx := q.indexLocked(item.ID)
+	if idx < 0 {
+		return
+	}
+	current := q.items[idx]
+	if err == nil {
+		q.items = append(q.items[:idx], q.items[idx+1:]...)
+		log.Printf("[邮件队列] 邮件「%s」已发送到 %s", item.Message.Subject, item.Message.To)
+	} else {
+		current.Attempts++
+		current.LastError = err.Error()
+		if current.Attempts >= q.svc.maxAttempts() {
+			current.Status = StatusFailed
+			log.Printf("[邮件队列] 邮件「%s」发送到 %s 失败 %d 次，不再重试: %v", item.Message.Subject, item.Message.To, current.Attempts, err)
+		} else {
+			delay := retryDelays[min(current.Attempts, len(retryDelays))-1]
+			current.NextAttemptAt = time.Now().Add(delay)
+			log.Printf("[邮件队列] 邮件「%s」发送到 %s 失败，%s 后重试: %v", item.Message.Subject, item.Message.To, delay, err)
+		}
+		q.trimFailedLocked()
+	}
+	if err := q.saveLocked(); err != nil {
+		log.Printf("[邮件队列] 保存邮件队列失败: %v", err)
+	}
+}
+
+// status 返回队列概况，按创建时间从新到旧排列
+func (q *queue) status() *QueueStatus {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	st := &QueueStatus{Items: make([]*QueueItem, 0, len(q.items))}
+	for _, item := range q.items {
+		if item.Status == StatusFailed {
+			st.Failed++
+		} else {
+			st.Pending++
+		}
+		copied := *item
+		copied.Message.HTML = ""
+		st.Items = append(st.Items, &copied)
+	}
+	sort.SliceStable(st.Items, func(i, j int) bool { return st.Items[i].CreatedAt.After(st.Items[j].CreatedAt) })
+	return st
+}
+
+// retry 将发送失败的邮件重新加入发送，id 为空时重试全部，返回重试的数量
+func (q *queue) retry(id string) (int, error) {
+	q.mu.Lock()
+	count := 0
+	now := time.Now()
+	for _, item := range q.items {
+		if item.Status != StatusFailed || (id != "" && item.ID != id) {
+			continue
+		}
+		item.Status = StatusPending
+		item.Attempts = 0
+		item.NextAttemptAt = now
+		count++
+	}
+	var err error
+	if count > 0 {
+		err = q.saveLocked()
+	}
+	q.mu.Unlock()
+	if count > 0 {
+		q.notify()
+	}
+	return count, err
+}
+
+// remove 删除队列中的邮件，返回是否存在
+func (q *queue) remove(id string) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	idx := q.indexLocked(id)
+	if idx < 0 {
+		return false, nil
+	}
+	q.items = append(q.items[:idx], q.items[idx+1:]...)
+	return true, q.saveLocked()
+}
+
+func (q *queue) indexLocked(id string) int {
+	for i, item := range q.items {
+		if item.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// trimFailedLocked 只保留最近 maxFailedItems 条发送失败的记录
+func (q *queue) trimFailedLocked() {
+	failed := 0
+	for i := len(q.items) - 1; i >= 0; i-- {
+		if q.items[i].Status != StatusFailed {
+			continue
+		}
+		failed++
+		if failed > maxFailedItems {
+			q.items = append(q.items[:i], q.items[i+1:]...)
+		}
+	}
+}
+
+func (q *queue) load() {
+	data, err := os.ReadFile(q.path)
+	if err != nil {
+		return
+	}
+	if err := json.Unmarshal(data, &q.items); err != nil {
+		log.Printf("[邮件队列] 读取邮件队列失败: %v", err)
+		q.items = nil
+	}
+}
+
+// saveLocked 保存队列，文件中包含收件人和正文，仅所有者可读
+func (q *queue) saveLocked() error {
+	data, err := json.Marshal(q.items)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(q.path), 0755); err != nil {
+		return err
+	}
+	tmp := q.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, q.path)
+}
+
+// QueueStatus 获取发送队列概况
+func (s *Service) QueueStatus() *QueueStatus {
+	st := s.queue.status()
+	st.Provider = s.ProviderName()
+	return st
+}
+
+// RetryFailed 重新发送失败的邮件，id 为空时重试全部
+func (s *Service) RetryFailed(id string) (int, error) {
+	return s.queue.retry(id)
+}
+
+// RemoveQueued 从队列中删除邮件
+func (s *Service) RemoveQueued(id string) (bool, error) {
+	return s.queue.remove(id)
+}
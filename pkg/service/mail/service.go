@@ -0,0 +1,217 @@
+/*
+ * @Description: 邮件发送服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 站点所有邮件都经由本服务发出，发送方式由 mail.provider 决定：
+ *   smtp      使用 SMTP_* 配置的 SMTP 服务器（默认）；
+ *   sendgrid  SendGrid Web API；
+ *   mailgun   Mailgun HTTP API，需要配置发信域名和区域；
+ *   resend    Resend API。
+ * 发件人名称、地址和回信地址各方式共用 SMTP_SENDER_NAME、SMTP_SENDER_EMAIL、SMTP_REPLY_TO_EMAIL。
+ * 正文不是完整 HTML 文档时套用带站点标识的统一外框（见 layout.go），并附带纯文本版本。
+ * 验证码、测试邮件等需要立即知道结果的邮件同步发送；通知类邮件写入持久化队列，
+ * 失败后按退避间隔重试（见 queue.go）。
+ */
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// 邮件发送方式
+const (
+	ProviderSMTP     = "smtp"
+	ProviderSendGrid = "sendgrid"
+	ProviderMailgun  = "mailgun"
+	ProviderResend   = "resend"
+)
+
+const (
+	// DefaultQueuePath 邮件发送队列的保存路径
+	DefaultQueuePath = "data/mail_queue.json"
+	// defaultMaxAttempts 队列中邮件的默认最大发送次数
+	defaultMaxAttempts = 5
+	// sendTimeout 单封邮件的发送超时
+	sendTimeout = 60 * time.Second
+)
+
+var (
+	// ErrUnknownProvider 未知的邮件发送方式
+	ErrUnknownProvider = errors.New("未知的邮件发送方式")
+	// ErrInvalidRecipient 收件人地址为空或包含换行
+	ErrInvalidRecipient = errors.New("收件人地址无效")
+)
+
+// Message 待发送的邮件
+type Message struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	HTML    string `json:"html"`
+	// NoLayout 正文已自带完整的版式，不再套用统一外框
+	NoLayout bool `json:"no_layout,omitempty"`
+}
+
+// Envelope 交给发送方式的完整邮件
+type Envelope struct {
+	FromName  string
+	FromEmail string
+	ReplyTo   string
+	To        string
+	Subject   string
+	HTML      string
+	Text      string
+}
+
+// Provider 邮件发送方式
+type Provider interface {
+	Name() string
+	Send(ctx context.Context, env *Envelope) error
+}
+
+// SendResult 同步发送的结果
+type SendResult struct {
+	Provider string `json:"provider"`
+	Duration int64  `json:"duration_ms"`
+}
+
+// Service 邮件发送服务
+type Service struct {
+	settingSvc setting.SettingService
+	queue      *queue
+}
+
+// NewService 创建邮件发送服务，加载未发送完的队列并启动后台发送协程；queuePath 为空时使用 DefaultQueuePath
+func NewService(settingSvc setting.SettingService, queuePath string) *Service {
+	if queuePath == "" {
+		queuePath = DefaultQueuePath
+	}
+	s := &Service{settingSvc: settingSvc}
+	s.queue = newQueue(queuePath, s)
+	go s.queue.worker()
+	return s
+}
+
+// ProviderName 当前配置的邮件发送方式
+func (s *Service) ProviderName() string {
+	p := strings.ToLower(strings.TrimSpace(s.settingSvc.Get(constant.KeyMailProvider.String())))
+	if p == "" {
+		return ProviderSMTP
+	}
+	return p
+}
+
+// Send 立即发送邮件
+func (s *Service) Send(ctx context.Context, msg *Message) error {
+	_, err := s.SendWith(ctx, "", msg)
+	return err
+}
+
+// SendWith 使用指定的发送方式立即发送邮件，provider 为空时使用当前配置，用于切换前测试
+func (s *Service) SendWith(ctx context.Context, provider string, msg *Message) (*SendResult, error) {
+	if provider == "" {
+		provider = s.ProviderName()
+	}
+	p, err := s.provider(provider)
+	if err != nil {
+		return nil, err
+	}
+	env, err := s.envelope(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	start := time.Now()
+	if err := p.Send(ctx, env); err != nil {
+		return nil, fmt.Errorf("[%s] %w", p.Name(), err)
+	}
+	return &SendResult{Provider: p.Name(), Duration: time.Since(start).Milliseconds()}, nil
+}
+
+// Enqueue 将邮件写入发送队列，由后台协程发送，失败后自动重试
+func (s *Service) Enqueue(msg *Message) error {
+	if err := checkRecipient(msg.To); err != nil {
+		return err
+	}
+	return s.queue.push(msg)
+}
+
+// provider 按名称创建发送方式，每次发送时读取最新配置
+func (s *Service) provider(name string) (Provider, error) {
+	get := func(key constant.SettingKey) string {
+		return strings.TrimSpace(s.settingSvc.Get(key.String()))
+	}
+	switch name {
+	case ProviderSMTP:
+		return &smtpProvider{
+			host:     get(constant.KeySmtpHost),
+			port:     get(constant.KeySmtpPort),
+			username: get(constant.KeySmtpUsername),
+			password: s.settingSvc.Get(constant.KeySmtpPassword.String()),
+			forceSSL: s.settingSvc.GetBool(constant.KeySmtpForceSSL.String()),
+		}, nil
+	case ProviderSendGrid:
+		return &sendGridProvider{apiKey: get(constant.KeyMailAPIKey)}, nil
+	case ProviderMailgun:
+		return &mailgunProvider{
+			apiKey: get(constant.KeyMailAPIKey),
+			domain: get(constant.KeyMailMailgunDomain),
+			region: strings.ToLower(get(constant.KeyMailMailgunRegion)),
+		}, nil
+	case ProviderResend:
+		return &resendProvider{apiKey: get(constant.KeyMailAPIKey)}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, name)
+	}
+}
+
+// envelope 补全发件人信息，套用统一外框并生成纯文本版本
+func (s *Service) envelope(msg *Message) (*Envelope, error) {
+	if err := checkRecipient(msg.To); err != nil {
+		return nil, err
+	}
+	fromEmail := strings.TrimSpace(s.settingSvc.Get(constant.KeySmtpSenderEmail.String()))
+	if fromEmail == "" {
+		return nil, fmt.Errorf("未配置发件人邮箱地址 (SMTP_SENDER_EMAIL)")
+	}
+	body := msg.HTML
+	if !msg.NoLayout && s.settingSvc.GetBool(constant.KeyMailBrandLayout.String()) {
+		body = s.applyLayout(msg.Subject, body)
+	}
+	return &Envelope{
+		FromName:  strings.TrimSpace(s.settingSvc.Get(constant.KeySmtpSenderName.String())),
+		FromEmail: fromEmail,
+		ReplyTo:   strings.TrimSpace(s.settingSvc.Get(constant.KeySmtpReplyToEmail.String())),
+		To:        strings.TrimSpace(msg.To),
+		Subject:   strings.Join(strings.Fields(msg.Subject), " "),
+		HTML:      body,
+		Text:      htmlToText(msg.HTML),
+	}, nil
+}
+
+// maxAttempts 队列中邮件的最大发送次数
+func (s *Service) maxAttempts() int {
+	v, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyMailMaxAttempts.String())))
+	if err != nil || v <= 0 {
+		return defaultMaxAttempts
+	}
+	return v
+}
+
+func checkRecipient(to string) error {
+	to = strings.TrimSpace(to)
+	if to == "" || strings.ContainsAny(to, "\r\n") || !strings.Contains(to, "@") {
+		return fmt.Errorf("%w: %q", ErrInvalidRecipient, to)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// smtpDialTimeout SMTP 连接超时
+const smtpDialTimeout = 15 * time.Second
+
+// smtpProvider 通过 SMTP 服务器发送，465 端口等直接 SSL 连接需要开启 SMTP_FORCE_SSL，否则尝试 STARTTLS
+type smtpProvider struct {
+	host     string
+	port     string
+	username string
+	password string
+	forceSSL bool
+}
+
+func (p *smtpProvider) Name() string { return ProviderSMTP }
+
+func (p *smtpProvider) Send(ctx context.Context, env *Envelope) error {
+	if p.host == "" {
+		return fmt.Errorf("未配置 SMTP 服务器地址")
+	}
+	if _, err := strconv.Atoi(p.port); err != nil {
+		return fmt.Errorf("SMTP端口配置无效 '%s'", p.port)
+	}
+	message, err := buildMIMEMessage(env)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(p.host, p.port)
+	dialer := &net.Dialer{Timeout: smtpDialTimeout}
+	var conn net.Conn
+	if p.forceSSL {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: p.tlsConfig()}).DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return fmt.Errorf("TLS拨号失败 (请检查端口是否正确，SSL通常使用465端口): %w", err)
+		}
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return fmt.Errorf("连接 SMTP 服务器失败: %w", err)
+		}
+	}
+	// 整个会话受 ctx 的超时约束
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, p.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("创建SMTP客户端失败: %w", err)
+	}
+	defer c.Close()
+
+	if !p.forceSSL {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(p.tlsConfig()); err != nil {
+				return fmt.Errorf("STARTTLS 失败: %w", err)
+			}
+		}
+	}
+	if p.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", p.username, p.password, p.host)); err != nil {
+			return fmt.Errorf("SMTP认证失败: %w", err)
+		}
+	}
+	if err := c.Mail(env.FromEmail); err != nil {
+		return fmt.Errorf("设置发件人失败: %w", err)
+	}
+	if err := c.Rcpt(env.To); err != nil {
+		return fmt.Errorf("设置收件人 %s 失败: %w", env.To, err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("获取数据写入器失败: %w", err)
+	}
+	if _, err := w.Write(message); err != nil {
+		return fmt.Errorf("写入邮件内容失败: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("关闭写入器失败: %w", err)
+	}
+	if err := c.Quit(); err != nil {
+		log.Printf("警告: SMTP Quit 执行失败: %v。这通常不影响邮件发送。", err)
+	}
+	return nil
+}
+
+// tlsConfig 与此前的实现保持一致，不校验证书，兼容使用自签名证书的自建邮件服务器
+func (p *smtpProvider) tlsConfig() *tls.Config {
+	return &tls.Config{
+		ServerName:         p.host,
+		InsecureSkipVerify: true,
+		MinVersion:         tls.VersionTLS12,
+	}
+}
+
+// buildMIMEMessage 生成 multipart/alternative 邮件，包含纯文本和 HTML 两个版本
+func buildMIMEMessage(env *Envelope) ([]byte, error) {
+	boundary, err := randomToken(12)
+	if err != nil {
+		return nil, err
+	}
+	messageID, err := randomToken(16)
+	if err != nil {
+		return nil, err
+	}
+	domain := "localhost"
+	if i := strings.LastIndex(env.FromEmail, "@"); i >= 0 {
+		domain = env.FromEmail[i+1:]
+	}
+
+	var buf bytes.Buffer
+	header := func(k, v string) { fmt.Fprintf(&buf, "%s: %s\r\n", k, v) }
+	header("From", (&netmail.Address{Name: env.FromName, Address: env.FromEmail}).String())
+	header("To", (&netmail.Address{Address: env.To}).String())
+	if env.ReplyTo != "" {
+		header("Reply-To", (&netmail.Address{Address: env.ReplyTo}).String())
+	}
+	header("Subject", mime.BEncoding.Encode("UTF-8", env.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", fmt.Sprintf("<%s@%s>", messageID, domain))
+	header("MIME-Version", "1.0")
+	header("Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", boundary))
+	buf.WriteString("\r\n")
+
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain", env.Text},
+		{"text/html", env.HTML},
+	} {
+		fmt.Fprintf(&buf, "--%s\r\n", boundary)
+		fmt.Fprintf(&buf, "Content-Type: %s; charset=UTF-8\r\n", part.contentType)
+		buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+		qp := quotedprintable.NewWriter(&buf)
+		if _, err := qp.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\r\n")
+	}
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+	return buf.Bytes(), nil
+}
+
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,126 @@
+/*
+ * @Description: 按内容哈希查找重复的媒体文件
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// metaKeyContentHash 文件内容 SHA-256 的元数据键，值为 "{实体ID}:{哈希}"，文件内容更新后实体ID变化即自动失效
+const metaKeyContentHash = "media_sha256"
+
+// DuplicateGroup 一组内容完全相同的文件
+type DuplicateGroup struct {
+	Hash       string  `json:"hash"`
+	Size       int64   `json:"size"`
+	Files      []*Item `json:"files"`
+	WastedSize int64   `json:"wasted_size"` // 保留一份后可释放的空间
+}
+
+// Duplicates 查找内容重复的文件。
+// 只有大小相同的文件才需要计算哈希，计算结果缓存在文件元数据中；
+// 多个文件共用同一个物理实体（复制产生）时不占用额外空间，不视为重复。
+func (s *Service) Duplicates(ctx context.Context) ([]*DuplicateGroup, error) {
+	bySize := make(map[int64][]*model.File)
+	err := s.eachFile(ctx, &repository.MediaListOptions{}, func(f *model.File) error {
+		if f.Size > 0 && f.PrimaryEntity != nil {
+			bySize[f.Size] = append(bySize[f.Size], f)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := s.usageIndex(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*DuplicateGroup
+	for size, files := range bySize {
+		if len(distinctEntities(files)) < 2 {
+			continue
+		}
+		byHash := make(map[string][]*model.File)
+		for _, f := range files {
+			hash, err := s.contentHash(ctx, f)
+			if err != nil {
+				if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+					return nil, err
+				}
+				log.Printf("[媒体库] 计算文件 %s (ID: %d) 的哈希失败: %v", f.Name, f.ID, err)
+				continue
+			}
+			byHash[hash] = append(byHash[hash], f)
+		}
+		for hash, same := range byHash {
+			entities := distinctEntities(same)
+			if len(entities) < 2 {
+				continue
+			}
+			group := &DuplicateGroup{Hash: hash, Size: size, WastedSize: size * int64(len(entities)-1)}
+			for _, f := range same {
+				group.Files = append(group.Files, toItem(f, usage))
+			}
+			groups = append(groups, group)
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].WastedSize != groups[j].WastedSize {
+			return groups[i].WastedSize > groups[j].WastedSize
+		}
+		return groups[i].Hash < groups[j].Hash
+	})
+	return groups, nil
+}
+
+// contentHash 返回文件内容的 SHA-256，优先使用元数据中的缓存
+func (s *Service) contentHash(ctx context.Context, f *model.File) (string, error) {
+	entityID := strconv.FormatUint(uint64(f.PrimaryEntity.ID), 10)
+	if cached, err := s.metadataSvc.Get(ctx, f.ID, metaKeyContentHash); err == nil {
+		if id, hash, ok := strings.Cut(cached, ":"); ok && id == entityID {
+			return hash, nil
+		}
+	}
+
+	reader, err := s.openContent(ctx, f)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, reader); err != nil {
+		return "", fmt.Errorf("读取文件内容失败: %w", err)
+	}
+	hash := hex.EncodeToString(h.Sum(nil))
+	if err := s.metadataSvc.Set(ctx, f.ID, metaKeyContentHash, entityID+":"+hash); err != nil {
+		log.Printf("[媒体库] 缓存文件 %d 的哈希失败: %v", f.ID, err)
+	}
+	return hash, nil
+}
+
+func distinctEntities(files []*model.File) map[uint]bool {
+	entities := make(map[uint]bool, len(files))
+	for _, f := range files {
+		entities[f.PrimaryEntity.ID] = true
+	}
+	return entities
+}
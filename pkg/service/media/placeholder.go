@@ -0,0 +1,217 @@
+/*
+ * @Description: 文章图片的低质量占位图（LQIP）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 文章保存后，为正文、封面和顶部图中通过直链引用的图片生成最长边 16 像素的缩略图，
+ * 以 data URI 形式保存在文件元数据中。服务端渲染文章时用它代替透明占位图作为懒加载图片的初始 src，
+ * 浏览器放大后呈现模糊的预览，原图加载完成前页面不再是一片空白。
+ */
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"io"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+
+	"github.com/disintegration/imaging"
+	_ "golang.org/x/image/bmp"
+	_ "golang.org/x/image/webp"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+const (
+	// metaKeyPlaceholder 占位图的元数据键，值为 "{实体ID}:{data URI}"，文件内容更新后实体ID变化即自动失效
+	metaKeyPlaceholder = "media_lqip"
+	// placeholderSize 占位图最长边的像素数
+	placeholderSize = 16
+	// placeholderMaxFileSize 超过该大小的图片不生成占位图，避免解码占用过多内存
+	placeholderMaxFileSize = 20 << 20
+	// placeholderTimeout 单篇文章生成占位图的超时时间
+	placeholderTimeout = 2 * time.Minute
+)
+
+// placeholderExts 可以解码并生成占位图的图片格式
+var placeholderExts = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".gif":  true,
+	".webp": true,
+	".bmp":  true,
+}
+
+// RegisterHandlers 订阅文章保存事件，为文章引用的图片生成占位图
+func (s *Service) RegisterHandlers(bus *event.EventBus) {
+	bus.Subscribe(event.ArticleCreated, s.onArticleSaved)
+	bus.Subscribe(event.ArticleUpdated, s.onArticleSaved)
+}
+
+func (s *Service) onArticleSaved(payload interface{}) {
+	p, ok := payload.(*event.ArticlePayload)
+	if !ok || p.ID == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), placeholderTimeout)
+	defer cancel()
+
+	article, err := s.articleRepo.GetByID(ctx, p.ID)
+	if err != nil || article == nil {
+		return
+	}
+	generated := 0
+	seen := make(map[string]bool)
+	for _, text := range []string{article.ContentHTML, article.ContentMd, article.CoverURL, article.TopImgURL} {
+		for _, m := range directLinkRegex.FindAllStringSubmatch(text, -1) {
+			linkID := m[1]
+			if seen[linkID] {
+				continue
+			}
+			seen[linkID] = true
+			created, err := s.ensurePlaceholder(ctx, linkID)
+			if err != nil {
+				log.Printf("[媒体库] 为直链 %s 生成占位图失败: %v", linkID, err)
+				continue
+			}
+			if created {
+				generated++
+			}
+		}
+	}
+	if generated > 0 {
+		log.Printf("[媒体库] 已为文章 %s 的 %d 张图片生成占位图", p.ID, generated)
+	}
+}
+
+// ensurePlaceholder 为直链指向的图片生成占位图，已有且未失效时跳过，返回是否新生成
+func (s *Service) ensurePlaceholder(ctx context.Context, linkID string) (bool, error) {
+	link, err := s.directLinkRepo.FindByPublicID(ctx, linkID)
+	if err != nil || link == nil {
+		return false, nil
+	}
+	f, err := s.fileRepo.FindByID(ctx, link.FileID)
+	if err != nil || f == nil || f.PrimaryEntity == nil {
+		return false, nil
+	}
+	if !placeholderExts[strings.ToLower(filepath.Ext(f.Name))] || f.Size > placeholderMaxFileSize {
+		return false, nil
+	}
+
+	entityID := strconv.FormatUint(uint64(f.PrimaryEntity.ID), 10)
+	if cached, err := s.metadataSvc.Get(ctx, f.ID, metaKeyPlaceholder); err == nil {
+		if id, uri, ok := strings.Cut(cached, ":"); ok && id == entityID {
+			s.cachePlaceholder(linkID, uri)
+			return false, nil
+		}
+	}
+
+	reader, err := s.openContent(ctx, f)
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+	uri, err := encodePlaceholder(reader)
+	if err != nil {
+		return false, err
+	}
+	if err := s.metadataSvc.Set(ctx, f.ID, metaKeyPlaceholder, entityID+":"+uri); err != nil {
+		return false, fmt.Errorf("保存占位图失败: %w", err)
+	}
+	s.cachePlaceholder(linkID, uri)
+	return true, nil
+}
+
+// ImagePlaceholder 返回图片地址对应的占位图 data URI，不是本站直链或尚未生成时返回空字符串。
+// 结果缓存在内存中，渲染文章时不会重复查询数据库
+func (s *Service) ImagePlaceholder(src string) string {
+	m := directLinkRegex.FindStringSubmatch(src)
+	if m == nil {
+		return ""
+	}
+	linkID := m[1]
+
+	s.placeholderMu.RLock()
+	uri, ok := s.placeholders[linkID]
+	s.placeholderMu.RUnlock()
+	if ok {
+		return uri
+	}
+
+	ctx := context.Background()
+	if link, err := s.directLinkRepo.FindByPublicID(ctx, linkID); err == nil && link != nil {
+		if cached, err := s.metadataSvc.Get(ctx, link.FileID, metaKeyPlaceholder); err == nil {
+			_, uri, _ = strings.Cut(cached, ":")
+		}
+	}
+	s.cachePlaceholder(linkID, uri)
+	return uri
+}
+
+func (s *Service) cachePlaceholder(linkID, uri string) {
+	s.placeholderMu.Lock()
+	defer s.placeholderMu.Unlock()
+	if s.placeholders == nil {
+		s.placeholders = make(map[string]string)
+	}
+	s.placeholders[linkID] = uri
+}
+
+// openContent 打开文件主实体的内容
+func (s *Service) openContent(ctx context.Context, f *model.File) (io.ReadCloser, error) {
+	policy, err := s.policySvc.GetPolicyByDatabaseID(ctx, f.PrimaryEntity.PolicyID)
+	if err != nil {
+		return nil, fmt.Errorf("找不到存储策略: %w", err)
+	}
+	provider, err := s.fileSvc.GetProviderForPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := provider.Get(ctx, policy, f.PrimaryEntity.Source.String)
+	if err != nil {
+		return nil, fmt.Errorf("读取文件内容失败: %w", err)
+	}
+	return reader, nil
+}
+
+// encodePlaceholder 将图片缩小为占位图，不透明的图片编码为 JPEG，带透明通道的编码为 PNG
+func encodePlaceholder(r io.Reader) (string, error) {
+	img, err := imaging.Decode(r, imaging.AutoOrientation(true))
+	if err != nil {
+		return "", fmt.Errorf("解码图片失败: %w", err)
+	}
+	small := imaging.Fit(img, placeholderSize, placeholderSize, imaging.Linear)
+
+	var buf bytes.Buffer
+	mime := "image/jpeg"
+	if opaque(small) {
+		err = imaging.Encode(&buf, small, imaging.JPEG, imaging.JPEGQuality(60))
+	} else {
+		mime = "image/png"
+		err = imaging.Encode(&buf, small, imaging.PNG, imaging.PNGCompressionLevel(png.BestCompression))
+	}
+	if err != nil {
+		return "", fmt.Errorf("编码占位图失败: %w", err)
+	}
+	return "data:" + mime + ";base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func opaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return true
+}
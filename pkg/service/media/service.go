@@ -0,0 +1,444 @@
+/*
+ * @Description: 媒体库服务，跨目录管理上传的文件：检索、查重、引用统计、清理未使用文件与重新生成缩略图
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 文章、页面、相册和站点配置中通过直链（/api/f/{直链ID}/{文件名}）引用的文件会被计入引用。
+ * 清理只针对文章图片存储策略（article_image）下的文件，网盘中的其它文件、评论图片和用户头像不在清理范围内；
+ * 清理出的文件移入回收站（软删除），可以恢复，由回收站彻底删除。
+ */
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/file_info"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/thumbnail"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+)
+
+const (
+	// DefaultPageSize 列表默认每页数量
+	DefaultPageSize = 20
+	// MaxPageSize 列表每页数量上限
+	MaxPageSize  = 100
+	scanPageSize = 200
+
+	// usageTTL 引用索引的缓存时间，列表接口频繁翻页时不必每次重新扫描全部内容
+	usageTTL = 5 * time.Minute
+	// defaultMinAgeHours 清理时默认跳过最近 24 小时内上传的文件，避免误删尚未保存进文章的图片
+	defaultMinAgeHours = 24
+	// maxRegenerate 单次重新生成缩略图的文件数上限
+	maxRegenerate = 500
+)
+
+var (
+	// ErrCleanupRunning 清理任务正在运行
+	ErrCleanupRunning = errors.New("媒体清理任务正在运行中")
+	// ErrTrashedFileNotFound 回收站中不存在该文件
+	ErrTrashedFileNotFound = errors.New("回收站中不存在该文件")
+)
+
+// ThumbnailDispatcher 派发缩略图生成任务，由任务调度器实现
+type ThumbnailDispatcher interface {
+	DispatchThumbnailGeneration(fileID uint)
+}
+
+// Item 媒体库中的单个文件
+type Item struct {
+	ID         string      `json:"id"` // 文件公共ID
+	Name       string      `json:"name"`
+	Size       int64       `json:"size"`
+	MimeType   string      `json:"mime_type,omitempty"`
+	OwnerID    uint        `json:"owner_id"`
+	PolicyID   uint        `json:"policy_id"`
+	CreatedAt  time.Time   `json:"created_at"`
+	References []Reference `json:"references"`
+}
+
+// ListOptions 媒体库列表查询条件
+type ListOptions struct {
+	Page     int    `form:"page"`
+	PageSize int    `form:"pageSize"`
+	Keyword  string `form:"keyword"`
+	Mime     string `form:"mime"` // MIME 类型前缀，例如 image/
+
+	// 游标分页：Keyset 为 true 时忽略页码，只返回排在 (After, AfterID) 之后的文件，After 为 nil 时从第一条开始
+	Keyset  bool       `form:"-"`
+	After   *time.Time `form:"-"`
+	AfterID string     `form:"-"` // 文件公共ID
+}
+
+// ListResult 媒体库列表结果
+type ListResult struct {
+	List     []*Item `json:"list"`
+	Total    int64   `json:"total"`
+	Page     int     `json:"page"`
+	PageSize int     `json:"pageSize"`
+}
+
+// CleanupRequest 清理未使用文件的请求
+type CleanupRequest struct {
+	DryRun      *bool    `json:"dry_run"`       // 默认只预览不删除
+	MinAgeHours *int     `json:"min_age_hours"` // 只清理上传超过该时长的文件，默认 24
+	FileIDs     []string `json:"file_ids"`      // 只在这些文件中清理，为空表示全部
+}
+
+// CleanupResult 清理结果
+type CleanupResult struct {
+	DryRun    bool              `json:"dry_run"`
+	Files     []*Item           `json:"files"`            // 未被引用的候选文件
+	TotalSize int64             `json:"total_size"`       // 候选文件总大小
+	Deleted   int               `json:"deleted"`          // 移入回收站的文件数
+	Failed    map[string]string `json:"failed,omitempty"` // 移入回收站失败的文件公共ID及原因
+}
+
+// Service 媒体库服务
+type Service struct {
+	fileRepo       repository.FileRepository
+	directLinkRepo repository.DirectLinkRepository
+	articleRepo    repository.ArticleRepository
+	pageRepo       repository.PageRepository
+	albumRepo      repository.AlbumRepository
+	metadataSvc    *file_info.MetadataService
+	policySvc      volume.IStoragePolicyService
+	fileSvc        file.FileService
+	thumbnailSvc   *thumbnail.ThumbnailService
+	dispatcher     ThumbnailDispatcher
+	settingSvc     setting.SettingService
+
+	usageMu   sync.Mutex
+	usage     map[uint][]Reference
+	usageAt   time.Time
+	cleanupMu sync.Mutex
+
+	placeholderMu sync.RWMutex
+	placeholders  map[string]string // 直链公共ID -> 占位图 data URI，空字符串表示没有占位图
+}
+
+// NewService 创建媒体库服务
+func NewService(
+	fileRepo repository.FileRepository,
+	directLinkRepo repository.DirectLinkRepository,
+	articleRepo repository.ArticleRepository,
+	pageRepo repository.PageRepository,
+	albumRepo repository.AlbumRepository,
+	metadataSvc *file_info.MetadataService,
+	policySvc volume.IStoragePolicyService,
+	fileSvc file.FileService,
+	thumbnailSvc *thumbnail.ThumbnailService,
+	dispatcher ThumbnailDispatcher,
+	settingSvc setting.SettingService,
+) *Service {
+	return &Service{
+		fileRepo:       fileRepo,
+		directLinkRepo: directLinkRepo,
+		articleRepo:    articleRepo,
+		pageRepo:       pageRepo,
+		albumRepo:      albumRepo,
+		metadataSvc:    metadataSvc,
+		policySvc:      policySvc,
+		fileSvc:        fileSvc,
+		thumbnailSvc:   thumbnailSvc,
+		dispatcher:     dispatcher,
+		settingSvc:     settingSvc,
+	}
+}
+
+// List 分页检索媒体文件，并附带每个文件的引用情况
+func (s *Service) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = DefaultPageSize
+	}
+	if opts.PageSize > MaxPageSize {
+		opts.PageSize = MaxPageSize
+	}
+
+	keyset := repository.KeysetQuery{Enabled: opts.Keyset, After: opts.After}
+	if opts.Keyset && opts.After != nil {
+		fileID, err := decodeFileID(opts.AfterID)
+		if err != nil {
+			return nil, err
+		}
+		keyset.AfterID = fileID
+	}
+
+	files, total, err := s.fileRepo.ListMediaFiles(ctx, &repository.MediaListOptions{
+		PageQuery:  repository.PageQuery{Page: opts.Page, PageSize: opts.PageSize},
+		Keyword:    opts.Keyword,
+		MimePrefix: opts.Mime,
+		Keyset:     keyset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("查询媒体文件失败: %w", err)
+	}
+	usage, err := s.usageIndex(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*Item, 0, len(files))
+	for _, f := range files {
+		items = append(items, toItem(f, usage))
+	}
+	return &ListResult{List: items, Total: total, Page: opts.Page, PageSize: opts.PageSize}, nil
+}
+
+// Usage 返回单个文件被引用的位置，总是重新扫描以保证结果最新
+func (s *Service) Usage(ctx context.Context, publicID string) ([]Reference, error) {
+	fileID, err := decodeFileID(publicID)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := s.usageIndex(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	refs := usage[fileID]
+	if refs == nil {
+		refs = []Reference{}
+	}
+	return refs, nil
+}
+
+// Cleanup 查找文章图片存储策略下未被引用的文件，非预览模式下将其移入回收站
+func (s *Service) Cleanup(ctx context.Context, req CleanupRequest) (*CleanupResult, error) {
+	if !s.cleanupMu.TryLock() {
+		return nil, ErrCleanupRunning
+	}
+	defer s.cleanupMu.Unlock()
+
+	dryRun := req.DryRun == nil || *req.DryRun
+	minAge := defaultMinAgeHours
+	if req.MinAgeHours != nil && *req.MinAgeHours >= 0 {
+		minAge = *req.MinAgeHours
+	}
+	cutoff := time.Now().Add(-time.Duration(minAge) * time.Hour)
+
+	var only map[uint]bool
+	if len(req.FileIDs) > 0 {
+		only = make(map[uint]bool, len(req.FileIDs))
+		for _, publicID := range req.FileIDs {
+			fileID, err := decodeFileID(publicID)
+			if err != nil {
+				return nil, err
+			}
+			only[fileID] = true
+		}
+	}
+
+	policyID, err := s.articleImagePolicyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := s.usageIndex(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CleanupResult{DryRun: dryRun, Files: []*Item{}}
+	var candidates []*model.File
+	err = s.eachFile(ctx, &repository.MediaListOptions{PolicyID: policyID}, func(f *model.File) error {
+		if len(usage[f.ID]) > 0 || f.CreatedAt.After(cutoff) || (only != nil && !only[f.ID]) {
+			return nil
+		}
+		candidates = append(candidates, f)
+		result.Files = append(result.Files, toItem(f, nil))
+		result.TotalSize += f.Size
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	for i, f := range candidates {
+		publicID := result.Files[i].ID
+		if err := s.fileRepo.SoftDelete(ctx, f.ID); err != nil {
+			if result.Failed == nil {
+				result.Failed = make(map[string]string)
+			}
+			result.Failed[publicID] = err.Error()
+			log.Printf("[媒体库] 将未使用文件 %s (ID: %d) 移入回收站失败: %v", f.Name, f.ID, err)
+			continue
+		}
+		result.Deleted++
+	}
+	s.invalidateUsage()
+	log.Printf("[媒体库] 清理未使用文件完成：候选 %d 个，移入回收站 %d 个，共 %d 字节", len(candidates), result.Deleted, result.TotalSize)
+	return result, nil
+}
+
+// ListTrashed 列出回收站中的媒体文件，即被清理移入回收站的文章图片
+func (s *Service) ListTrashed(ctx context.Context) ([]*model.File, error) {
+	policyID, err := s.articleImagePolicyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var files []*model.File
+	err = s.eachFile(ctx, &repository.MediaListOptions{PolicyID: policyID, Deleted: true}, func(f *model.File) error {
+		files = append(files, f)
+		return nil
+	})
+	return files, err
+}
+
+// RestoreTrashed 从回收站恢复媒体文件
+func (s *Service) RestoreTrashed(ctx context.Context, publicID string) error {
+	f, err := s.findTrashed(ctx, publicID)
+	if err != nil {
+		return err
+	}
+	if err := s.fileRepo.Restore(ctx, f.ID); err != nil {
+		return fmt.Errorf("恢复文件失败: %w", err)
+	}
+	s.invalidateUsage()
+	return nil
+}
+
+// PurgeTrashed 彻底删除回收站中的媒体文件及其存储内容，不可恢复
+func (s *Service) PurgeTrashed(ctx context.Context, publicID string) error {
+	f, err := s.findTrashed(ctx, publicID)
+	if err != nil {
+		return err
+	}
+	return s.fileSvc.DeleteItems(ctx, f.OwnerID, []string{publicID})
+}
+
+// findTrashed 查找已移入回收站的文件，未删除的文件不能经回收站恢复或彻底删除
+func (s *Service) findTrashed(ctx context.Context, publicID string) (*model.File, error) {
+	fileID, err := decodeFileID(publicID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := s.fileRepo.FindByIDUnscoped(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil, ErrTrashedFileNotFound
+		}
+		return nil, err
+	}
+	if f.DeletedAt == nil || f.Type != model.FileTypeFile {
+		return nil, ErrTrashedFileNotFound
+	}
+	return f, nil
+}
+
+// articleImagePolicyID 文章图片存储策略的数据库ID，清理只在该策略下进行
+func (s *Service) articleImagePolicyID(ctx context.Context) (uint, error) {
+	policy, err := s.fileSvc.GetPolicyByFlag(ctx, constant.PolicyFlagArticleImage)
+	if err != nil {
+		return 0, fmt.Errorf("查询文章图片存储策略失败: %w", err)
+	}
+	if policy == nil || policy.ID == 0 {
+		return 0, errors.New("未找到文章图片存储策略")
+	}
+	return policy.ID, nil
+}
+
+// Regenerate 重置文件的缩略图状态并在后台重新生成，返回已派发的文件数
+func (s *Service) Regenerate(ctx context.Context, publicIDs []string) (int, error) {
+	if len(publicIDs) == 0 {
+		return 0, errors.New("请选择需要重新生成的文件")
+	}
+	if len(publicIDs) > maxRegenerate {
+		return 0, fmt.Errorf("单次最多重新生成 %d 个文件", maxRegenerate)
+	}
+	fileIDs := make([]uint, 0, len(publicIDs))
+	for _, publicID := range publicIDs {
+		fileID, err := decodeFileID(publicID)
+		if err != nil {
+			return 0, err
+		}
+		fileIDs = append(fileIDs, fileID)
+	}
+	files, err := s.fileRepo.FindBatchByIDs(ctx, fileIDs)
+	if err != nil {
+		return 0, fmt.Errorf("查询文件失败: %w", err)
+	}
+	fileIDs = fileIDs[:0]
+	for _, f := range files {
+		if f.Type == model.FileTypeFile {
+			fileIDs = append(fileIDs, f.ID)
+		}
+	}
+	if len(fileIDs) == 0 {
+		return 0, nil
+	}
+
+	if err := s.thumbnailSvc.ResetThumbnailMetadataForFiles(ctx, fileIDs); err != nil {
+		return 0, err
+	}
+	go func() {
+		for _, id := range fileIDs {
+			s.dispatcher.DispatchThumbnailGeneration(id)
+		}
+	}()
+	return len(fileIDs), nil
+}
+
+// eachFile 按 base 中的条件分页遍历媒体文件
+func (s *Service) eachFile(ctx context.Context, base *repository.MediaListOptions, fn func(f *model.File) error) error {
+	for page := 1; ; page++ {
+		opts := *base
+		opts.PageQuery = repository.PageQuery{Page: page, PageSize: scanPageSize}
+		files, _, err := s.fileRepo.ListMediaFiles(ctx, &opts)
+		if err != nil {
+			return fmt.Errorf("查询媒体文件失败: %w", err)
+		}
+		for _, f := range files {
+			if err := fn(f); err != nil {
+				return err
+			}
+		}
+		if len(files) < scanPageSize {
+			return nil
+		}
+	}
+}
+
+func toItem(f *model.File, usage map[uint][]Reference) *Item {
+	publicID, _ := idgen.GeneratePublicID(f.ID, idgen.EntityTypeFile)
+	item := &Item{
+		ID:         publicID,
+		Name:       f.Name,
+		Size:       f.Size,
+		OwnerID:    f.OwnerID,
+		CreatedAt:  f.CreatedAt,
+		References: usage[f.ID],
+	}
+	if item.References == nil {
+		item.References = []Reference{}
+	}
+	if f.PrimaryEntity != nil {
+		item.PolicyID = f.PrimaryEntity.PolicyID
+		if f.PrimaryEntity.MimeType.Valid {
+			item.MimeType = f.PrimaryEntity.MimeType.String
+		}
+	}
+	return item
+}
+
+func decodeFileID(publicID string) (uint, error) {
+	fileID, entityType, err := idgen.DecodePublicID(publicID)
+	if err != nil || entityType != idgen.EntityTypeFile {
+		return 0, fmt.Errorf("无效的文件ID '%s'", publicID)
+	}
+	return fileID, nil
+}
@@ -0,0 +1,168 @@
+/*
+ * @Description: 媒体文件引用索引，扫描文章、页面、相册和站点配置中的直链
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package media
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/configdef"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// 引用来源类型
+const (
+	RefTypeArticle = "article"
+	RefTypePage    = "page"
+	RefTypeAlbum   = "album"
+	RefTypeSetting = "setting"
+)
+
+// directLinkRegex 匹配直链地址中的直链公共ID，兼容带域名和不带域名的写法
+var directLinkRegex = regexp.MustCompile(`/api/f/([A-Za-z0-9_-]+)/`)
+
+// Reference 文件被引用的位置
+type Reference struct {
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// usageScanner 一次扫描内的状态，缓存直链到文件的映射，避免重复查询
+type usageScanner struct {
+	ctx            context.Context
+	directLinkRepo repository.DirectLinkRepository
+	links          map[string]uint
+	index          map[uint][]Reference
+}
+
+func (sc *usageScanner) add(ref Reference, texts ...string) {
+	seen := make(map[uint]bool)
+	for _, text := range texts {
+		for _, m := range directLinkRegex.FindAllStringSubmatch(text, -1) {
+			fileID := sc.resolve(m[1])
+			if fileID == 0 || seen[fileID] {
+				continue
+			}
+			seen[fileID] = true
+			sc.index[fileID] = append(sc.index[fileID], ref)
+		}
+	}
+}
+
+func (sc *usageScanner) resolve(publicID string) uint {
+	if fileID, ok := sc.links[publicID]; ok {
+		return fileID
+	}
+	var fileID uint
+	link, err := sc.directLinkRepo.FindByPublicID(sc.ctx, publicID)
+	if err == nil && link != nil {
+		fileID = link.FileID
+	}
+	sc.links[publicID] = fileID
+	return fileID
+}
+
+// usageIndex 返回文件ID到引用位置的索引，force 为 true 时忽略缓存重新扫描
+func (s *Service) usageIndex(ctx context.Context, force bool) (map[uint][]Reference, error) {
+	s.usageMu.Lock()
+	defer s.usageMu.Unlock()
+
+	if !force && s.usage != nil && time.Since(s.usageAt) < usageTTL {
+		return s.usage, nil
+	}
+
+	sc := &usageScanner{
+		ctx:            ctx,
+		directLinkRepo: s.directLinkRepo,
+		links:          make(map[string]uint),
+		index:          make(map[uint][]Reference),
+	}
+	if err := s.scanArticles(sc); err != nil {
+		return nil, err
+	}
+	if err := s.scanPages(sc); err != nil {
+		return nil, err
+	}
+	if err := s.scanAlbums(sc); err != nil {
+		return nil, err
+	}
+	for _, def := range configdef.AllSettings {
+		key := def.Key.String()
+		sc.add(Reference{Type: RefTypeSetting, ID: key, Title: def.Comment}, s.settingSvc.Get(key))
+	}
+
+	s.usage = sc.index
+	s.usageAt = time.Now()
+	log.Printf("[媒体库] 引用索引已重建，共 %d 个文件被引用", len(sc.index))
+	return s.usage, nil
+}
+
+func (s *Service) invalidateUsage() {
+	s.usageMu.Lock()
+	s.usage = nil
+	s.usageMu.Unlock()
+}
+
+// scanArticles 扫描所有文章（包括草稿），草稿中引用的图片同样不能被清理
+func (s *Service) scanArticles(sc *usageScanner) error {
+	for page := 1; ; page++ {
+		articles, _, err := s.articleRepo.List(sc.ctx, &model.ListArticlesOptions{
+			Page:        page,
+			PageSize:    scanPageSize,
+			WithContent: true,
+		})
+		if err != nil {
+			return fmt.Errorf("读取文章列表失败: %w", err)
+		}
+		for _, a := range articles {
+			sc.add(Reference{Type: RefTypeArticle, ID: a.ID, Title: a.Title},
+				a.ContentMd, a.ContentHTML, a.CoverURL, a.TopImgURL)
+		}
+		if len(articles) < scanPageSize {
+			return nil
+		}
+	}
+}
+
+func (s *Service) scanPages(sc *usageScanner) error {
+	for page := 1; ; page++ {
+		pages, _, err := s.pageRepo.List(sc.ctx, &model.ListPagesOptions{Page: page, PageSize: scanPageSize})
+		if err != nil {
+			return fmt.Errorf("读取页面列表失败: %w", err)
+		}
+		for _, p := range pages {
+			sc.add(Reference{Type: RefTypePage, ID: strconv.FormatUint(uint64(p.ID), 10), Title: p.Title},
+				p.Content, p.MarkdownContent)
+		}
+		if len(pages) < scanPageSize {
+			return nil
+		}
+	}
+}
+
+func (s *Service) scanAlbums(sc *usageScanner) error {
+	for page := 1; ; page++ {
+		result, err := s.albumRepo.FindListByOptions(sc.ctx, repository.AlbumQueryOptions{
+			PageQuery: repository.PageQuery{Page: page, PageSize: scanPageSize},
+		})
+		if err != nil {
+			return fmt.Errorf("读取相册列表失败: %w", err)
+		}
+		for _, a := range result.Items {
+			sc.add(Reference{Type: RefTypeAlbum, ID: strconv.FormatUint(uint64(a.ID), 10), Title: a.ImageUrl},
+				a.ImageUrl, a.BigImageUrl, a.DownloadUrl)
+		}
+		if len(result.Items) < scanPageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,163 @@
+/*
+ * @Description: 系统通知中心（面向管理员的运行时告警，如模板渲染错误）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package notification
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/adminevent"
+)
+
+// 系统通知级别
+const (
+	NoticeLevelInfo    = "info"
+	NoticeLevelWarning = "warning"
+	NoticeLevelError   = "error"
+)
+
+// 系统通知分类
+const (
+	NoticeCategoryTemplateRender = "template_render"
+	NoticeCategoryThemeCanary    = "theme_canary" // 主题切换后页面检查未通过并已回滚
+)
+
+// SystemNotice 系统通知
+type SystemNotice struct {
+	ID        uint64    `json:"id"`
+	Level     string    `json:"level"`
+	Category  string    `json:"category"`
+	Title     string    `json:"title"`
+	Message   string    `json:"message"`
+	Source    string    `json:"source,omitempty"` // 出错的文件或模块
+	Line      int       `json:"line,omitempty"`   // 出错行号（如可解析）
+	Count     int       `json:"count"`            // 相同通知的累计次数
+	Read      bool      `json:"read"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NoticeCenter 内存中的系统通知中心，超过容量时丢弃最旧的通知
+type NoticeCenter struct {
+	mu       sync.RWMutex
+	notices  []*SystemNotice
+	capacity int
+	nextID   atomic.Uint64
+}
+
+// NewNoticeCenter 创建系统通知中心
+func NewNoticeCenter(capacity int) *NoticeCenter {
+	if capacity <= 0 {
+		capacity = 200
+	}
+	return &NoticeCenter{capacity: capacity}
+}
+
+var defaultNoticeCenter = NewNoticeCenter(200)
+
+// DefaultNoticeCenter 返回全局系统通知中心
+func DefaultNoticeCenter() *NoticeCenter {
+	return defaultNoticeCenter
+}
+
+// Push 推送一条系统通知
+// 如果存在相同分类、来源和内容的未读通知，则只累加次数，避免同一错误在每次请求时刷屏。
+// 返回的是加锁期间复制的通知内容，不会与之后的推送产生数据竞争
+func (nc *NoticeCenter) Push(notice SystemNotice) SystemNotice {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	now := time.Now()
+	for _, n := range nc.notices {
+		if !n.Read && n.Category == notice.Category && n.Source == notice.Source && n.Message == notice.Message {
+			n.Count++
+			n.UpdatedAt = now
+			return *n
+		}
+	}
+
+	if notice.Level == "" {
+		notice.Level = NoticeLevelInfo
+	}
+	notice.ID = nc.nextID.Add(1)
+	notice.Count = 1
+	notice.Read = false
+	notice.CreatedAt = now
+	notice.UpdatedAt = now
+
+	stored := notice
+	nc.notices = append(nc.notices, &stored)
+	if len(nc.notices) > nc.capacity {
+		nc.notices = nc.notices[len(nc.notices)-nc.capacity:]
+	}
+	adminevent.Publish(adminevent.TypeNotificationCreate, stored)
+	return stored
+}
+
+// List 按时间倒序列出通知，category 为空表示全部分类
+func (nc *NoticeCenter) List(category string, unreadOnly bool) []SystemNotice {
+	nc.mu.RLock()
+	defer nc.mu.RUnlock()
+
+	result := make([]SystemNotice, 0, len(nc.notices))
+	for i := len(nc.notices) - 1; i >= 0; i-- {
+		n := nc.notices[i]
+		if category != "" && n.Category != category {
+			continue
+		}
+		if unreadOnly && n.Read {
+			continue
+		}
+		result = append(result, *n)
+	}
+	return result
+}
+
+// Latest 返回指定分类最近一条未读通知
+func (nc *NoticeCenter) Latest(category string) (SystemNotice, bool) {
+	list := nc.List(category, true)
+	if len(list) == 0 {
+		return SystemNotice{}, false
+	}
+	return list[0], true
+}
+
+// UnreadCount 返回未读通知数量
+func (nc *NoticeCenter) UnreadCount() int {
+	nc.mu.RLock()
+	defer nc.mu.RUnlock()
+
+	count := 0
+	for _, n := range nc.notices {
+		if !n.Read {
+			count++
+		}
+	}
+	return count
+}
+
+// MarkRead 标记通知为已读，id 为 0 时标记全部
+func (nc *NoticeCenter) MarkRead(id uint64) bool {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	found := false
+	for _, n := range nc.notices {
+		if id == 0 || n.ID == id {
+			n.Read = true
+			found = true
+		}
+	}
+	return found
+}
+
+// Clear 清空所有通知
+func (nc *NoticeCenter) Clear() {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	nc.notices = nil
+}
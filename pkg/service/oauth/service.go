@@ -0,0 +1,654 @@
+/*
+ * @Description: 第三方登录服务，支持 GitHub、Google 和自定义 OIDC 提供方
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 登录流程：前端跳转到 authorize 接口 → 提供方授权 → 回调接口换取身份 →
+ * 生成一次性登录码并跳回前端 → 前端用登录码换取与密码登录相同的 JWT。
+ * 第三方账号与本站账号的绑定关系保存在数据库中，旧版本的 data/oauth_links.json 会在启动时导入一次。
+ */
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/oauthlink"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	// LegacyLinkStorePath 旧版本保存账号绑定关系的文件，启动时导入数据库后重命名
+	LegacyLinkStorePath = "data/oauth_links.json"
+
+	// 提供方类型
+	TypeGitHub = "github"
+	TypeGoogle = "google"
+	TypeOIDC   = "oidc"
+
+	// 授权用途
+	ModeLogin = "login"
+	ModeLink  = "link"
+
+	googleIssuer = "https://accounts.google.com"
+
+	stateTTL     = 10 * time.Minute
+	loginCodeTTL = 2 * time.Minute
+
+	cacheKeyState     = "oauth:state:"
+	cacheKeyLoginCode = "oauth:code:"
+)
+
+var (
+	ErrProviderNotFound = errors.New("第三方登录方式不存在或未启用")
+	ErrInvalidState     = errors.New("授权已过期或无效，请重新登录")
+	ErrNotLinked        = errors.New("该第三方账号尚未绑定本站账号，请先使用密码登录后在个人中心绑定")
+	ErrAlreadyLinked    = errors.New("该第三方账号已绑定其他账号")
+	ErrAccountDisabled  = errors.New("账号未激活或已被封禁")
+	ErrInvalidLoginCode = errors.New("登录码无效或已过期")
+)
+
+// ProviderConfig 第三方登录提供方配置，保存在 oauth.providers 配置项中
+type ProviderConfig struct {
+	ID           string   `json:"id"`   // 唯一标识，用于回调地址 /api/auth/oauth/{id}/callback
+	Type         string   `json:"type"` // github、google 或 oidc
+	Name         string   `json:"name"` // 登录按钮上显示的名称
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	Issuer       string   `json:"issuer,omitempty"` // OIDC 的 issuer 地址，google 可不填
+	Scopes       []string `json:"scopes,omitempty"`
+	Enabled      bool     `json:"enabled"`
+}
+
+// ProviderInfo 前端展示的提供方信息
+type ProviderInfo struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// Identity 第三方返回的身份信息
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Avatar        string
+}
+
+// Link 第三方账号与本站账号的绑定关系
+type Link struct {
+	Provider string    `json:"provider"`
+	Subject  string    `json:"subject"`
+	UserID   uint      `json:"user_id"`
+	Email    string    `json:"email,omitempty"`
+	Name     string    `json:"name,omitempty"`
+	LinkedAt time.Time `json:"linked_at"`
+}
+
+// CallbackResult 回调处理结果
+type CallbackResult struct {
+	Mode      string
+	Redirect  string
+	LoginCode string // 登录模式下用于换取令牌的一次性登录码
+}
+
+// authState 授权过程中保存在缓存中的状态
+type authState struct {
+	Provider string `json:"provider"`
+	Mode     string `json:"mode"`
+	UserID   uint   `json:"user_id,omitempty"`
+	Redirect string `json:"redirect"`
+	Verifier string `json:"verifier"`
+}
+
+// oidcEndpoints OIDC 发现文档中用到的地址
+type oidcEndpoints struct {
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// Service 第三方登录服务
+type Service struct {
+	db         *ent.Client
+	settingSvc setting.SettingService
+	cacheSvc   utility.CacheService
+	userRepo   repository.UserRepository
+	authSvc    auth.AuthService
+	httpClient *http.Client
+
+	discoveryMu sync.Mutex
+	discovery   map[string]*oidcEndpoints
+}
+
+// NewService 创建第三方登录服务，legacyPath 不为空时导入旧版本保存在文件中的绑定关系
+func NewService(db *ent.Client, settingSvc setting.SettingService, cacheSvc utility.CacheService, userRepo repository.UserRepository, authSvc auth.AuthService, legacyPath string) *Service {
+	s := &Service{
+		db:         db,
+		settingSvc: settingSvc,
+		cacheSvc:   cacheSvc,
+		userRepo:   userRepo,
+		authSvc:    authSvc,
+		httpClient: &http.Client{Timeout: 15 * time.Second},
+		discovery:  make(map[string]*oidcEndpoints),
+	}
+	if legacyPath != "" {
+		if err := s.importLegacyLinks(context.Background(), legacyPath); err != nil {
+			log.Printf("[第三方登录] 导入旧版绑定关系失败: %v", err)
+		}
+	}
+	return s
+}
+
+func (s *Service) providers() []ProviderConfig {
+	var list []ProviderConfig
+	raw := s.settingSvc.Get(constant.KeyOAuthProviders.String())
+	if raw == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(raw), &list); err != nil {
+		log.Printf("[第三方登录] 解析提供方配置失败: %v", err)
+		return nil
+	}
+	return list
+}
+
+func (s *Service) provider(id string) (*ProviderConfig, error) {
+	for _, p := range s.providers() {
+		if p.ID == id && p.Enabled && p.ClientID != "" {
+			return &p, nil
+		}
+	}
+	return nil, ErrProviderNotFound
+}
+
+// Providers 返回已启用的提供方
+func (s *Service) Providers() []ProviderInfo {
+	result := []ProviderInfo{}
+	for _, p := range s.providers() {
+		if !p.Enabled || p.ClientID == "" {
+			continue
+		}
+		name := p.Name
+		if name == "" {
+			name = p.ID
+		}
+		result = append(result, ProviderInfo{ID: p.ID, Type: p.Type, Name: name})
+	}
+	return result
+}
+
+// callbackURL 提供方回调地址，需要在提供方后台登记
+func (s *Service) callbackURL(providerID, baseURL string) string {
+	if siteURL := strings.TrimRight(s.settingSvc.Get(constant.KeySiteURL.String()), "/"); strings.HasPrefix(siteURL, "http") {
+		baseURL = siteURL
+	}
+	return strings.TrimRight(baseURL, "/") + "/api/auth/oauth/" + providerID + "/callback"
+}
+
+func (s *Service) oauthConfig(ctx context.Context, p *ProviderConfig, baseURL string) (*oauth2.Config, *oidcEndpoints, error) {
+	cfg := &oauth2.Config{
+		ClientID:     p.ClientID,
+		ClientSecret: p.ClientSecret,
+		RedirectURL:  s.callbackURL(p.ID, baseURL),
+		Scopes:       p.Scopes,
+	}
+
+	if p.Type == TypeGitHub {
+		cfg.Endpoint = github.Endpoint
+		if len(cfg.Scopes) == 0 {
+			cfg.Scopes = []string{"read:user", "user:email"}
+		}
+		return cfg, nil, nil
+	}
+
+	issuer := p.Issuer
+	if p.Type == TypeGoogle && issuer == "" {
+		issuer = googleIssuer
+	}
+	if issuer == "" {
+		return nil, nil, fmt.Errorf("提供方 %s 未配置 issuer", p.ID)
+	}
+	endpoints, err := s.discover(ctx, issuer)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg.Endpoint = oauth2.Endpoint{AuthURL: endpoints.AuthorizationEndpoint, TokenURL: endpoints.TokenEndpoint}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+	return cfg, endpoints, nil
+}
+
+// discover 读取 OIDC 发现文档，结果缓存在内存中
+func (s *Service) discover(ctx context.Context, issuer string) (*oidcEndpoints, error) {
+	issuer = strings.TrimRight(issuer, "/")
+	s.discoveryMu.Lock()
+	cached := s.discovery[issuer]
+	s.discoveryMu.Unlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	var endpoints oidcEndpoints
+	if err := s.getJSON(ctx, issuer+"/.well-known/openid-configuration", "", &endpoints); err != nil {
+		return nil, fmt.Errorf("获取 OIDC 配置失败: %w", err)
+	}
+	if endpoints.AuthorizationEndpoint == "" || endpoints.TokenEndpoint == "" || endpoints.UserinfoEndpoint == "" {
+		return nil, fmt.Errorf("OIDC 配置缺少必要的地址")
+	}
+
+	s.discoveryMu.Lock()
+	s.discovery[issuer] = &endpoints
+	s.discoveryMu.Unlock()
+	return &endpoints, nil
+}
+
+// AuthorizeURL 生成跳转到提供方的授权地址。mode 为 link 时 userID 为当前登录用户
+func (s *Service) AuthorizeURL(ctx context.Context, providerID, mode string, userID uint, redirect, baseURL string) (string, error) {
+	p, err := s.provider(providerID)
+	if err != nil {
+		return "", err
+	}
+	cfg, _, err := s.oauthConfig(ctx, p, baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	state := randomToken()
+	st := authState{
+		Provider: providerID,
+		Mode:     mode,
+		UserID:   userID,
+		Redirect: safeRedirect(redirect),
+		Verifier: oauth2.GenerateVerifier(),
+	}
+	data, _ := json.Marshal(st)
+	if err := s.cacheSvc.Set(ctx, cacheKeyState+state, string(data), stateTTL); err != nil {
+		return "", err
+	}
+	return cfg.AuthCodeURL(state, oauth2.S256ChallengeOption(st.Verifier)), nil
+}
+
+// Callback 处理提供方回调：登录模式生成一次性登录码，绑定模式保存绑定关系
+func (s *Service) Callback(ctx context.Context, providerID, code, state, baseURL string) (*CallbackResult, error) {
+	if state == "" {
+		return nil, ErrInvalidState
+	}
+	// state 只能使用一次，取出与删除必须是原子的，否则并发的回调请求可以重复使用
+	raw, err := s.cacheSvc.GetDel(ctx, cacheKeyState+state)
+	if err != nil {
+		return nil, err
+	}
+	if raw == "" {
+		return nil, ErrInvalidState
+	}
+
+	var st authState
+	if err := json.Unmarshal([]byte(raw), &st); err != nil || st.Provider != providerID {
+		return nil, ErrInvalidState
+	}
+	result := &CallbackResult{Mode: st.Mode, Redirect: st.Redirect}
+
+	p, err := s.provider(providerID)
+	if err != nil {
+		return result, err
+	}
+	cfg, endpoints, err := s.oauthConfig(ctx, p, baseURL)
+	if err != nil {
+		return result, err
+	}
+	token, err := cfg.Exchange(context.WithValue(ctx, oauth2.HTTPClient, s.httpClient), code, oauth2.VerifierOption(st.Verifier))
+	if err != nil {
+		return result, fmt.Errorf("换取访问令牌失败: %w", err)
+	}
+
+	var identity *Identity
+	if p.Type == TypeGitHub {
+		identity, err = s.githubIdentity(ctx, token.AccessToken)
+	} else {
+		identity, err = s.oidcIdentity(ctx, endpoints.UserinfoEndpoint, token.AccessToken)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if st.Mode == ModeLink {
+		return result, s.link(ctx, providerID, st.UserID, identity)
+	}
+
+	user, err := s.resolveUser(ctx, providerID, identity)
+	if err != nil {
+		return result, err
+	}
+	loginCode := randomToken()
+	if err := s.cacheSvc.Set(ctx, cacheKeyLoginCode+loginCode, strconv.FormatUint(uint64(user.ID), 10), loginCodeTTL); err != nil {
+		return result, err
+	}
+	result.LoginCode = loginCode
+	return result, nil
+}
+
+// resolveUser 依次按绑定关系、已验证邮箱查找本站账号，允许注册时为新身份创建账号
+func (s *Service) resolveUser(ctx context.Context, providerID string, identity *Identity) (*model.User, error) {
+	link, err := s.db.OAuthLink.Query().
+		Where(oauthlink.Provider(providerID), oauthlink.Subject(identity.Subject)).
+		Only(ctx)
+	if err == nil {
+		return s.activeUser(ctx, link.UserID)
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("查询绑定关系失败: %w", err)
+	}
+	if !identity.EmailVerified || identity.Email == "" {
+		return nil, ErrNotLinked
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, strings.ToLower(identity.Email))
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		if !s.settingSvc.GetBool(constant.KeyOAuthAllowRegister.String()) {
+			return nil, ErrNotLinked
+		}
+		name := identity.Name
+		if name == "" {
+			name = strings.Split(identity.Email, "@")[0]
+		}
+		if user, err = s.authSvc.RegisterVerified(ctx, identity.Email, name); err != nil {
+			return nil, err
+		}
+		log.Printf("[第三方登录] 通过 %s 为 %s 创建了新账号", providerID, identity.Email)
+	}
+	if err := s.link(ctx, providerID, user.ID, identity); err != nil {
+		return nil, err
+	}
+	return s.activeUser(ctx, user.ID)
+}
+
+func (s *Service) activeUser(ctx context.Context, userID uint) (*model.User, error) {
+	user, err := s.authSvc.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.Status != model.UserStatusActive {
+		return nil, ErrAccountDisabled
+	}
+	return user, nil
+}
+
+// ExchangeLoginCode 用一次性登录码换取用户，登录码使用后立即失效
+func (s *Service) ExchangeLoginCode(ctx context.Context, code string) (*model.User, error) {
+	if code == "" {
+		return nil, ErrInvalidLoginCode
+	}
+	raw, err := s.cacheSvc.GetDel(ctx, cacheKeyLoginCode+code)
+	if err != nil {
+		return nil, err
+	}
+	if raw == "" {
+		return nil, ErrInvalidLoginCode
+	}
+
+	userID, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidLoginCode
+	}
+	user, err := s.activeUser(ctx, uint(userID))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	user.LastLoginAt = &now
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		log.Printf("[第三方登录] 更新用户最后登录时间失败: %v", err)
+	}
+	return user, nil
+}
+
+// Links 返回用户绑定的第三方账号
+func (s *Service) Links(ctx context.Context, userID uint) ([]Link, error) {
+	rows, err := s.db.OAuthLink.Query().
+		Where(oauthlink.UserID(userID)).
+		Order(ent.Asc(oauthlink.FieldLinkedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询绑定关系失败: %w", err)
+	}
+	result := make([]Link, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, Link{
+			Provider: row.Provider,
+			Subject:  row.Subject,
+			UserID:   row.UserID,
+			Email:    row.Email,
+			Name:     row.Name,
+			LinkedAt: row.LinkedAt,
+		})
+	}
+	return result, nil
+}
+
+// Unlink 解除用户与指定提供方的绑定
+func (s *Service) Unlink(ctx context.Context, userID uint, providerID string) error {
+	_, err := s.db.OAuthLink.Delete().
+		Where(oauthlink.UserID(userID), oauthlink.Provider(providerID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("解除绑定失败: %w", err)
+	}
+	return nil
+}
+
+// link 保存绑定关系，同一提供方下每个本站账号只保留一个绑定
+func (s *Service) link(ctx context.Context, providerID string, userID uint, identity *Identity) error {
+	if userID == 0 {
+		return ErrInvalidState
+	}
+	existing, err := s.db.OAuthLink.Query().
+		Where(oauthlink.Provider(providerID), oauthlink.Subject(identity.Subject)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("查询绑定关系失败: %w", err)
+	}
+	if existing != nil && existing.UserID != userID {
+		return ErrAlreadyLinked
+	}
+
+	// 唯一索引 (provider, user_id) 冲突时替换为新的第三方账号
+	err = s.db.OAuthLink.Create().
+		SetProvider(providerID).
+		SetSubject(identity.Subject).
+		SetUserID(userID).
+		SetEmail(identity.Email).
+		SetName(identity.Name).
+		OnConflict(sql.ConflictColumns(oauthlink.FieldProvider, oauthlink.FieldUserID)).
+		UpdateSubject().
+		UpdateEmail().
+		UpdateName().
+		UpdateLinkedAt().
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// 并发请求已将该第三方账号绑定到其他账号
+		return ErrAlreadyLinked
+	}
+	if err != nil {
+		return fmt.Errorf("保存绑定关系失败: %w", err)
+	}
+	return nil
+}
+
+// importLegacyLinks 将旧版本 data/oauth_links.json 中的绑定关系导入数据库，成功后重命名文件避免重复导入
+func (s *Service) importLegacyLinks(ctx context.Context, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var links []Link
+	if err := json.Unmarshal(data, &links); err != nil {
+		return err
+	}
+
+	builders := make([]*ent.OAuthLinkCreate, 0, len(links))
+	for _, l := range links {
+		if _, err := s.db.User.Get(ctx, l.UserID); err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		create := s.db.OAuthLink.Create().
+			SetProvider(l.Provider).
+			SetSubject(l.Subject).
+			SetUserID(l.UserID).
+			SetEmail(l.Email).
+			SetName(l.Name)
+		if !l.LinkedAt.IsZero() {
+			create.SetLinkedAt(l.LinkedAt)
+		}
+		builders = append(builders, create)
+	}
+	if len(builders) > 0 {
+		// 两个唯一索引任一冲突都跳过该条记录
+		if err := s.db.OAuthLink.CreateBulk(builders...).OnConflict().DoNothing().Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(path, path+".imported"); err != nil {
+		return err
+	}
+	log.Printf("[第三方登录] 已从 %s 导入 %d 条绑定关系", path, len(builders))
+	return nil
+}
+
+func (s *Service) githubIdentity(ctx context.Context, accessToken string) (*Identity, error) {
+	var profile struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := s.getJSON(ctx, "https://api.github.com/user", accessToken, &profile); err != nil {
+		return nil, fmt.Errorf("获取 GitHub 用户信息失败: %w", err)
+	}
+	identity := &Identity{
+		Subject: strconv.FormatInt(profile.ID, 10),
+		Name:    profile.Name,
+		Avatar:  profile.AvatarURL,
+	}
+	if identity.Name == "" {
+		identity.Name = profile.Login
+	}
+
+	// 公开资料中的邮箱未必经过验证，以邮箱列表中的主邮箱为准
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := s.getJSON(ctx, "https://api.github.com/user/emails", accessToken, &emails); err == nil {
+		for _, e := range emails {
+			if e.Primary {
+				identity.Email = e.Email
+				identity.EmailVerified = e.Verified
+				break
+			}
+		}
+	}
+	return identity, nil
+}
+
+func (s *Service) oidcIdentity(ctx context.Context, userinfoURL, accessToken string) (*Identity, error) {
+	var info struct {
+		Sub               string `json:"sub"`
+		Email             string `json:"email"`
+		EmailVerified     any    `json:"email_verified"`
+		Name              string `json:"name"`
+		PreferredUsername string `json:"preferred_username"`
+		Picture           string `json:"picture"`
+	}
+	if err := s.getJSON(ctx, userinfoURL, accessToken, &info); err != nil {
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	if info.Sub == "" {
+		return nil, fmt.Errorf("提供方未返回用户标识")
+	}
+	identity := &Identity{
+		Subject: info.Sub,
+		Email:   info.Email,
+		Name:    info.Name,
+		Avatar:  info.Picture,
+	}
+	if identity.Name == "" {
+		identity.Name = info.PreferredUsername
+	}
+	// 部分提供方将 email_verified 返回为字符串
+	switch v := info.EmailVerified.(type) {
+	case bool:
+		identity.EmailVerified = v
+	case string:
+		identity.EmailVerified = v == "true"
+	}
+	return identity, nil
+}
+
+func (s *Service) getJSON(ctx context.Context, url, accessToken string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(out)
+}
+
+// safeRedirect 只允许站内相对路径，防止被用作开放重定向
+func safeRedirect(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.HasPrefix(redirect, "/\\") {
+		return "/"
+	}
+	return redirect
+}
+
+func randomToken() string {
+	b := make([]byte, 24)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -3,6 +3,7 @@ package page
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
@@ -26,16 +27,35 @@ type Service interface {
 	// Update 更新页面
 	Update(ctx context.Context, id string, options *model.UpdatePageOptions) (*model.Page, error)
 
-	// Delete 删除页面
+	// Delete 删除页面（移入回收站）
 	Delete(ctx context.Context, id string) error
 
+	// ListDeleted 获取回收站中的页面
+	ListDeleted(ctx context.Context) ([]*model.Page, error)
+
+	// Restore 从回收站恢复页面
+	Restore(ctx context.Context, id string) error
+
+	// Purge 彻底删除回收站中的页面
+	Purge(ctx context.Context, id string) error
+
 	// InitializeDefaultPages 初始化默认页面
 	InitializeDefaultPages(ctx context.Context) error
+
+	// SetSaveListener 设置页面保存监听（可选注入，用于 Git 同步）
+	SetSaveListener(listener SaveListener)
+}
+
+// SaveListener 页面创建、更新或删除成功后收到通知，回调应尽快返回
+type SaveListener interface {
+	PageSaved(id string)
+	PageDeleted(id string)
 }
 
 // service 页面服务实现
 type service struct {
-	pageRepo repository.PageRepository
+	pageRepo     repository.PageRepository
+	saveListener SaveListener
 }
 
 // NewService 创建页面服务
@@ -45,6 +65,11 @@ func NewService(pageRepo repository.PageRepository) Service {
 	}
 }
 
+// SetSaveListener 设置页面保存监听
+func (s *service) SetSaveListener(listener SaveListener) {
+	s.saveListener = listener
+}
+
 // Create 创建页面
 func (s *service) Create(ctx context.Context, options *model.CreatePageOptions) (*model.Page, error) {
 	// 验证路径格式
@@ -58,7 +83,7 @@ func (s *service) Create(ctx context.Context, options *model.CreatePageOptions)
 		return nil, fmt.Errorf("检查路径是否存在失败: %w", err)
 	}
 	if exists {
-		return nil, fmt.Errorf("路径 %s 已存在", options.Path)
+		return nil, fmt.Errorf("路径 %s 已存在（回收站中的页面同样占用路径）", options.Path)
 	}
 
 	// 创建页面
@@ -67,6 +92,10 @@ func (s *service) Create(ctx context.Context, options *model.CreatePageOptions)
 		return nil, fmt.Errorf("创建页面失败: %w", err)
 	}
 
+	if s.saveListener != nil {
+		s.saveListener.PageSaved(strconv.FormatUint(uint64(page.ID), 10))
+	}
+
 	return page, nil
 }
 
@@ -116,7 +145,7 @@ func (s *service) Update(ctx context.Context, id string, options *model.UpdatePa
 			return nil, fmt.Errorf("检查路径是否存在失败: %w", err)
 		}
 		if exists {
-			return nil, fmt.Errorf("路径 %s 已存在", *options.Path)
+			return nil, fmt.Errorf("路径 %s 已存在（回收站中的页面同样占用路径）", *options.Path)
 		}
 	}
 
@@ -126,6 +155,10 @@ func (s *service) Update(ctx context.Context, id string, options *model.UpdatePa
 		return nil, fmt.Errorf("更新页面失败: %w", err)
 	}
 
+	if s.saveListener != nil {
+		s.saveListener.PageSaved(id)
+	}
+
 	return page, nil
 }
 
@@ -136,9 +169,36 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("删除页面失败: %w", err)
 	}
 
+	if s.saveListener != nil {
+		s.saveListener.PageDeleted(id)
+	}
+
+	return nil
+}
+
+// ListDeleted 获取回收站中的页面
+func (s *service) ListDeleted(ctx context.Context) ([]*model.Page, error) {
+	return s.pageRepo.ListDeleted(ctx)
+}
+
+// Restore 从回收站恢复页面
+func (s *service) Restore(ctx context.Context, id string) error {
+	if err := s.pageRepo.Restore(ctx, id); err != nil {
+		return err
+	}
+
+	if s.saveListener != nil {
+		s.saveListener.PageSaved(id)
+	}
+
 	return nil
 }
 
+// Purge 彻底删除回收站中的页面
+func (s *service) Purge(ctx context.Context, id string) error {
+	return s.pageRepo.Purge(ctx, id)
+}
+
 // InitializeDefaultPages 初始化默认页面
 func (s *service) InitializeDefaultPages(ctx context.Context) error {
 	defaultPages := []*model.CreatePageOptions{
@@ -503,9 +563,9 @@ Cookies 提供许多功能。例如，他们可以帮助我记住你喜欢深色
 	}
 
 	for _, pageOptions := range defaultPages {
-		// 检查页面是否已存在
-		existingPage, err := s.pageRepo.GetByPath(ctx, pageOptions.Path)
-		if err == nil && existingPage != nil {
+		// 检查页面是否已存在（包括回收站中的页面，已删除的默认页面不再重新创建）
+		exists, err := s.pageRepo.ExistsByPath(ctx, pageOptions.Path, "")
+		if err == nil && exists {
 			// 页面已存在，跳过
 			continue
 		}
@@ -0,0 +1,289 @@
+/*
+ * @Description: 自定义页面的服务端数据绑定
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 每个自定义页面可以声明若干数据绑定，如「分类 X 下最新的 N 篇文章」或标签云。
+ * 服务端渲染页面时解析绑定，结果随 initialData.bindings 注入页面，
+ * 站点搭建者不用新建主题就能做出带动态内容的落地页。绑定保存在 page 表的 data_bindings 字段，随页面一起删除；
+ * 旧版本的 data/page_bindings.json 会在启动时导入一次。
+ */
+package pagedata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	ent_page "github.com/anzhiyu-c/anheyu-app/ent/page"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/page"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/taxonomy"
+)
+
+// LegacyStorePath 旧版本保存数据绑定的文件，启动时导入数据库后重命名
+const LegacyStorePath = "data/page_bindings.json"
+
+// 绑定类型
+const (
+	TypePosts        = "posts"         // 文章列表，可按分类、标签、作者过滤
+	TypeTagCloud     = "tag_cloud"     // 标签云
+	TypeCategoryTree = "category_tree" // 分类树
+)
+
+const (
+	// defaultLimit 文章列表默认返回的数量
+	defaultLimit = 10
+	// maxLimit 文章列表最多返回的数量
+	maxLimit = 50
+	// maxBindings 单个页面最多的绑定数
+	maxBindings = 20
+)
+
+var (
+	ErrPageNotFound   = errors.New("页面不存在")
+	ErrInvalidName    = errors.New("绑定名称只能包含字母、数字和下划线，且不能以数字开头")
+	ErrDuplicateName  = errors.New("绑定名称重复")
+	ErrInvalidType    = errors.New("绑定类型只能是 posts、tag_cloud 或 category_tree")
+	ErrInvalidLimit   = fmt.Errorf("文章数量必须在 1~%d 之间", maxLimit)
+	ErrTooManyBinding = fmt.Errorf("单个页面最多 %d 个数据绑定", maxBindings)
+)
+
+// bindingNameRegex 绑定名称即 initialData.bindings 中的键，限制为合法的标识符便于主题直接使用
+var bindingNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,63}$`)
+
+// Binding 页面数据绑定
+type Binding = model.PageDataBinding
+
+// Service 页面数据绑定服务
+type Service struct {
+	db         *ent.Client
+	pageSvc    page.Service
+	articleSvc article_service.Service
+	taxonomy   *taxonomy.Service
+}
+
+// NewService 创建页面数据绑定服务，legacyPath 不为空时导入旧版本保存在文件中的绑定
+func NewService(db *ent.Client, legacyPath string, pageSvc page.Service, articleSvc article_service.Service, taxonomySvc *taxonomy.Service) *Service {
+	s := &Service{
+		db:         db,
+		pageSvc:    pageSvc,
+		articleSvc: articleSvc,
+		taxonomy:   taxonomySvc,
+	}
+	if legacyPath != "" {
+		if err := s.importLegacy(context.Background(), legacyPath); err != nil {
+			log.Printf("[页面数据] 导入旧版数据绑定失败: %v", err)
+		}
+	}
+	return s
+}
+
+// Get 返回页面的数据绑定
+func (s *Service) Get(ctx context.Context, pageID string) ([]Binding, error) {
+	pageData, err := s.pageSvc.GetByID(ctx, pageID)
+	if err != nil {
+		return nil, ErrPageNotFound
+	}
+	items, err := s.load(ctx, pageData.ID)
+	if err != nil {
+		return nil, err
+	}
+	if items == nil {
+		return []Binding{}, nil
+	}
+	return items, nil
+}
+
+// Set 校验并保存页面的数据绑定，传入空列表时清除
+func (s *Service) Set(ctx context.Context, pageID string, items []Binding) ([]Binding, error) {
+	pageData, err := s.pageSvc.GetByID(ctx, pageID)
+	if err != nil {
+		return nil, ErrPageNotFound
+	}
+	normalized, err := normalize(items)
+	if err != nil {
+		return nil, err
+	}
+
+	update := s.db.Page.UpdateOneID(pageData.ID)
+	if len(normalized) == 0 {
+		update.ClearDataBindings()
+	} else {
+		update.SetDataBindings(normalized)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("保存数据绑定失败: %w", err)
+	}
+	return normalized, nil
+}
+
+// normalize 校验绑定并补全默认值
+func normalize(items []Binding) ([]Binding, error) {
+	if len(items) > maxBindings {
+		return nil, ErrTooManyBinding
+	}
+	result := make([]Binding, 0, len(items))
+	names := make(map[string]bool, len(items))
+	for _, b := range items {
+		b.Name = strings.TrimSpace(b.Name)
+		if !bindingNameRegex.MatchString(b.Name) {
+			return nil, ErrInvalidName
+		}
+		if names[b.Name] {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateName, b.Name)
+		}
+		names[b.Name] = true
+
+		switch b.Type {
+		case TypePosts:
+			if b.Limit == 0 {
+				b.Limit = defaultLimit
+			}
+			if b.Limit < 1 || b.Limit > maxLimit {
+				return nil, ErrInvalidLimit
+			}
+			b.Category = strings.TrimSpace(b.Category)
+			b.Tag = strings.TrimSpace(b.Tag)
+			b.Author = strings.TrimSpace(b.Author)
+		case TypeTagCloud, TypeCategoryTree:
+			// 标签云和分类树不需要过滤条件
+			b = Binding{Name: b.Name, Type: b.Type}
+		default:
+			return nil, ErrInvalidType
+		}
+		result = append(result, b)
+	}
+	return result, nil
+}
+
+// ResolvePath 解析已发布的自定义页面的数据绑定，页面不存在、未发布或没有绑定时 ok 为 false。
+// 单个绑定解析失败时记录日志并返回 null，不影响其他绑定
+func (s *Service) ResolvePath(ctx context.Context, path string) (map[string]interface{}, bool) {
+	pageData := s.findPage(ctx, path)
+	if pageData == nil || !pageData.IsPublished {
+		return nil, false
+	}
+	items, err := s.load(ctx, pageData.ID)
+	if err != nil {
+		log.Printf("[页面数据] 读取页面 %s 的数据绑定失败: %v", pageData.Path, err)
+		return nil, false
+	}
+	if len(items) == 0 {
+		return nil, false
+	}
+
+	result := make(map[string]interface{}, len(items))
+	for _, b := range items {
+		value, err := s.resolve(ctx, b)
+		if err != nil {
+			log.Printf("[页面数据] 页面 %s 的数据绑定 %s 解析失败: %v", pageData.Path, b.Name, err)
+		}
+		result[b.Name] = value
+	}
+	return result, true
+}
+
+// findPage 按路径查找页面，兼容末尾斜杠的差异
+func (s *Service) findPage(ctx context.Context, path string) *model.Page {
+	candidates := []string{path}
+	if len(path) > 1 && strings.HasSuffix(path, "/") {
+		candidates = append(candidates, strings.TrimSuffix(path, "/"))
+	} else if !strings.HasSuffix(path, "/") {
+		candidates = append(candidates, path+"/")
+	}
+	for _, p := range candidates {
+		if pageData, err := s.pageSvc.GetByPath(ctx, p); err == nil && pageData != nil {
+			return pageData
+		}
+	}
+	return nil
+}
+
+func (s *Service) resolve(ctx context.Context, b Binding) (interface{}, error) {
+	switch b.Type {
+	case TypePosts:
+		list, err := s.articleSvc.ListPublic(ctx, &model.ListPublicArticlesOptions{
+			Page:         1,
+			PageSize:     b.Limit,
+			CategoryName: b.Category,
+			TagName:      b.Tag,
+			Author:       b.Author,
+			PinnedOnly:   b.PinnedOnly,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return list.List, nil
+	case TypeTagCloud:
+		return s.taxonomy.TagCloud(ctx)
+	case TypeCategoryTree:
+		return s.taxonomy.CategoryTree(ctx)
+	}
+	return nil, ErrInvalidType
+}
+
+// load 读取页面的数据绑定
+func (s *Service) load(ctx context.Context, pageID uint) ([]Binding, error) {
+	row, err := s.db.Page.Query().
+		Where(ent_page.ID(pageID)).
+		Select(ent_page.FieldDataBindings).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPageNotFound
+		}
+		return nil, err
+	}
+	return row.DataBindings, nil
+}
+
+// importLegacy 将旧版本 data/page_bindings.json 中的绑定写入对应页面，成功后重命名文件避免重复导入。
+// 页面已不存在或绑定不合法的条目会被跳过
+func (s *Service) importLegacy(ctx context.Context, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var stored map[string][]Binding
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+
+	imported := 0
+	for key, items := range stored {
+		id, err := strconv.ParseUint(key, 10, 32)
+		if err != nil {
+			continue
+		}
+		normalized, err := normalize(items)
+		if err != nil {
+			log.Printf("[页面数据] 跳过页面 %s 的旧版数据绑定: %v", key, err)
+			continue
+		}
+		if len(normalized) == 0 {
+			continue
+		}
+		err = s.db.Page.UpdateOneID(uint(id)).SetDataBindings(normalized).Exec(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		imported++
+	}
+	log.Printf("[页面数据] 已从 %s 导入 %d 个页面的数据绑定", path, imported)
+	return os.Rename(path, path+".imported")
+}
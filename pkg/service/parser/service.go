@@ -16,6 +16,7 @@ import (
 	"golang.org/x/net/html"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/perfprofile"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 
@@ -39,9 +40,8 @@ type EmojiPack struct {
 }
 
 // 缓存配置常量
+// 缓存容量由性能档位决定：常规模式最多缓存 500 条解析结果，低配模式 100 条
 const (
-	// 缓存容量：最多缓存 500 条解析结果
-	cacheCapacity = 500
 	// 缓存 TTL：30 分钟
 	cacheTTL = 30 * time.Minute
 )
@@ -160,6 +160,7 @@ func NewService(settingSvc setting.SettingService, bus *event.EventBus) *Service
 
 	policy.AllowAttrs("id").OnElements("div", "h1", "h2", "h3", "h4", "h5", "h6", "button", "a", "img", "span", "code", "pre", "table", "thead", "tbody", "tr", "th", "td", "font", "details", "summary", "svg", "blockquote", "video", "iframe")
 
+	cacheCapacity := perfprofile.Current().ParserCacheSize
 	svc := &Service{
 		settingSvc:    settingSvc,
 		mdParser:      mdParser,
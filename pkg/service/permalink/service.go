@@ -0,0 +1,226 @@
+/*
+ * @Description: 文章永久链接管理，预览和切换永久链接策略，检测重复的永久链接
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 切换策略时为已有文章重新生成 abbrlink，旧地址通过重定向管理自动添加 301，
+ * 新文章按配置的策略在创建时生成 abbrlink。
+ */
+package permalink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/permalink"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// listPageSize 分页读取文章的每页数量
+const listPageSize = 100
+
+// ErrInvalidStrategy 不支持的永久链接策略
+var ErrInvalidStrategy = errors.New("永久链接策略只能是 abbrlink、date、title 或 pattern")
+
+// Config 永久链接配置
+type Config struct {
+	Strategy     string `json:"strategy"`
+	Pattern      string `json:"pattern"`
+	AutoRedirect bool   `json:"auto_redirect"`
+}
+
+// Change 一篇文章的永久链接变化
+type Change struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	From  string `json:"from"` // 原详情页地址
+	To    string `json:"to"`   // 新详情页地址
+	Error string `json:"error,omitempty"`
+}
+
+// Collision 多篇文章使用了相同的永久链接，或永久链接与其他文章的 ID 相同
+type Collision struct {
+	Slug     string   `json:"slug"`
+	Reason   string   `json:"reason"`
+	Articles []string `json:"articles"` // 文章 ID
+}
+
+// Status 永久链接配置和冲突检测结果
+type Status struct {
+	Config     Config      `json:"config"`
+	Collisions []Collision `json:"collisions"`
+}
+
+// ApplyResult 切换策略的结果
+type ApplyResult struct {
+	Config  Config   `json:"config"`
+	Changed int      `json:"changed"`
+	Failed  int      `json:"failed"`
+	Changes []Change `json:"changes"`
+}
+
+// Service 永久链接管理服务
+type Service struct {
+	settingSvc  setting.SettingService
+	articleSvc  article_service.Service
+	articleRepo repository.ArticleRepository
+}
+
+// NewService 创建永久链接管理服务
+func NewService(settingSvc setting.SettingService, articleSvc article_service.Service, articleRepo repository.ArticleRepository) *Service {
+	return &Service{settingSvc: settingSvc, articleSvc: articleSvc, articleRepo: articleRepo}
+}
+
+func (s *Service) config() Config {
+	cfg := Config{
+		Strategy:     s.settingSvc.Get(constant.KeyPermalinkStrategy.String()),
+		Pattern:      s.settingSvc.Get(constant.KeyPermalinkPattern.String()),
+		AutoRedirect: s.settingSvc.GetBool(constant.KeyPermalinkAutoRedirect.String()),
+	}
+	if !permalink.Valid(cfg.Strategy) {
+		cfg.Strategy = permalink.StrategyAbbrlink
+	}
+	if cfg.Pattern == "" {
+		cfg.Pattern = permalink.DefaultPattern
+	}
+	return cfg
+}
+
+// Status 返回当前配置和永久链接冲突
+func (s *Service) Status(ctx context.Context) (*Status, error) {
+	articles, err := s.allArticles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Status{Config: s.config(), Collisions: detectCollisions(articles)}, nil
+}
+
+// Preview 预览按指定策略重新生成永久链接后会变化的文章
+func (s *Service) Preview(ctx context.Context, strategy, pattern string) ([]Change, error) {
+	if !permalink.Valid(strategy) {
+		return nil, ErrInvalidStrategy
+	}
+	articles, err := s.allArticles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.plan(ctx, articles, strategy, pattern), nil
+}
+
+// Apply 切换永久链接策略：保存配置并为已有文章重新生成 abbrlink，
+// 开启自动重定向时旧地址会添加 301 重定向
+func (s *Service) Apply(ctx context.Context, strategy, pattern string) (*ApplyResult, error) {
+	if !permalink.Valid(strategy) {
+		return nil, ErrInvalidStrategy
+	}
+	if err := s.settingSvc.UpdateSettings(ctx, map[string]string{
+		constant.KeyPermalinkStrategy.String(): strategy,
+		constant.KeyPermalinkPattern.String():  pattern,
+	}); err != nil {
+		return nil, fmt.Errorf("保存永久链接配置失败: %w", err)
+	}
+	articles, err := s.allArticles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ApplyResult{Config: s.config(), Changes: []Change{}}
+	for _, change := range s.plan(ctx, articles, strategy, pattern) {
+		abbrlink := strings.TrimPrefix(change.To, "/posts/")
+		if _, err := s.articleSvc.Update(ctx, change.ID, &model.UpdateArticleRequest{Abbrlink: &abbrlink}, "", ""); err != nil {
+			change.Error = err.Error()
+			result.Failed++
+		} else {
+			result.Changed++
+		}
+		result.Changes = append(result.Changes, change)
+	}
+	return result, nil
+}
+
+// plan 计算每篇文章的新永久链接，只返回有变化的文章；同一批次内分配过的链接不会重复使用
+func (s *Service) plan(ctx context.Context, articles []*model.Article, strategy, pattern string) []Change {
+	changes := []Change{}
+	taken := make(map[string]bool, len(articles))
+	for _, a := range articles {
+		abbrlink := s.articleSvc.PermalinkFor(ctx, a, strategy, pattern, taken)
+		if abbrlink != "" {
+			taken[abbrlink] = true
+		}
+		if abbrlink == a.Abbrlink {
+			continue
+		}
+		changes = append(changes, Change{
+			ID:    a.ID,
+			Title: a.Title,
+			From:  detailPath(a.ID, a.Abbrlink),
+			To:    detailPath(a.ID, abbrlink),
+		})
+	}
+	return changes
+}
+
+func detailPath(id, abbrlink string) string {
+	if abbrlink == "" {
+		return "/posts/" + id
+	}
+	return "/posts/" + abbrlink
+}
+
+// detectCollisions 找出重复的永久链接（不区分大小写），以及与其他文章 ID 相同、会遮挡该文章的永久链接
+func detectCollisions(articles []*model.Article) []Collision {
+	ids := make(map[string]bool, len(articles))
+	bySlug := make(map[string][]*model.Article)
+	for _, a := range articles {
+		ids[a.ID] = true
+		if a.Abbrlink != "" {
+			key := strings.ToLower(a.Abbrlink)
+			bySlug[key] = append(bySlug[key], a)
+		}
+	}
+
+	collisions := []Collision{}
+	for _, a := range articles {
+		if a.Abbrlink == "" {
+			continue
+		}
+		group := bySlug[strings.ToLower(a.Abbrlink)]
+		if len(group) > 1 && group[0] == a {
+			articleIDs := make([]string, 0, len(group))
+			for _, g := range group {
+				articleIDs = append(articleIDs, g.ID)
+			}
+			collisions = append(collisions, Collision{Slug: a.Abbrlink, Reason: "多篇文章使用了相同的永久链接", Articles: articleIDs})
+		}
+		if a.Abbrlink != a.ID && ids[a.Abbrlink] {
+			collisions = append(collisions, Collision{Slug: a.Abbrlink, Reason: "永久链接与另一篇文章的 ID 相同", Articles: []string{a.ID, a.Abbrlink}})
+		}
+	}
+	return collisions
+}
+
+// allArticles 分页读取全部文章，按创建顺序排列
+func (s *Service) allArticles(ctx context.Context) ([]*model.Article, error) {
+	var articles []*model.Article
+	for page := 1; ; page++ {
+		list, total, err := s.articleRepo.List(ctx, &model.ListArticlesOptions{Page: page, PageSize: listPageSize})
+		if err != nil {
+			return nil, fmt.Errorf("读取文章失败: %w", err)
+		}
+		articles = append(articles, list...)
+		if len(list) == 0 || page*listPageSize >= total {
+			break
+		}
+	}
+	// 列表按创建时间倒序返回，较早的文章优先分配不带序号的链接
+	for i, j := 0, len(articles)-1; i < j; i, j = i+1, j-1 {
+		articles[i], articles[j] = articles[j], articles[i]
+	}
+	return articles, nil
+}
@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
@@ -20,11 +21,19 @@ import (
 type Service struct {
 	repo        repository.PostCategoryRepository
 	articleRepo repository.ArticleRepository
+	eventBus    *event.EventBus
 }
 
 // NewService 是 PostCategory Service 的构造函数。
-func NewService(repo repository.PostCategoryRepository, articleRepo repository.ArticleRepository) *Service {
-	return &Service{repo: repo, articleRepo: articleRepo}
+func NewService(repo repository.PostCategoryRepository, articleRepo repository.ArticleRepository, eventBus *event.EventBus) *Service {
+	return &Service{repo: repo, articleRepo: articleRepo, eventBus: eventBus}
+}
+
+// publishUpdated 发布分类变更事件
+func (s *Service) publishUpdated() {
+	if s.eventBus != nil {
+		s.eventBus.Publish(event.CategoryUpdated, nil)
+	}
 }
 
 // toAPIResponse 是一个私有的辅助函数，将领域模型转换为用于API响应的DTO。
@@ -59,6 +68,7 @@ func (s *Service) Create(ctx context.Context, req *model.CreatePostCategoryReque
 	if err != nil {
 		return nil, err
 	}
+	s.publishUpdated()
 	return s.toAPIResponse(newCategory), nil
 }
 
@@ -98,10 +108,15 @@ func (s *Service) Update(ctx context.Context, publicID string, req *model.Update
 	if err != nil {
 		return nil, err
 	}
+	s.publishUpdated()
 	return s.toAPIResponse(updatedCategory), nil
 }
 
 // Delete 处理删除分类的业务逻辑。
 func (s *Service) Delete(ctx context.Context, publicID string) error {
-	return s.repo.Delete(ctx, publicID)
+	if err := s.repo.Delete(ctx, publicID); err != nil {
+		return err
+	}
+	s.publishUpdated()
+	return nil
 }
@@ -11,18 +11,27 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 )
 
 // Service 封装了文章标签的业务逻辑。
 type Service struct {
-	repo repository.PostTagRepository
+	repo     repository.PostTagRepository
+	eventBus *event.EventBus
 }
 
 // NewService 是 PostTag Service 的构造函数。
-func NewService(repo repository.PostTagRepository) *Service {
-	return &Service{repo: repo}
+func NewService(repo repository.PostTagRepository, eventBus *event.EventBus) *Service {
+	return &Service{repo: repo, eventBus: eventBus}
+}
+
+// publishUpdated 发布标签变更事件
+func (s *Service) publishUpdated() {
+	if s.eventBus != nil {
+		s.eventBus.Publish(event.TagUpdated, nil)
+	}
 }
 
 // toAPIResponse 是一个私有的辅助函数，将领域模型转换为用于API响应的DTO。
@@ -54,6 +63,7 @@ func (s *Service) Create(ctx context.Context, req *model.CreatePostTagRequest) (
 	if err != nil {
 		return nil, err
 	}
+	s.publishUpdated()
 	return s.toAPIResponse(newTag), nil
 }
 
@@ -78,10 +88,15 @@ func (s *Service) Update(ctx context.Context, publicID string, req *model.Update
 	if err != nil {
 		return nil, err
 	}
+	s.publishUpdated()
 	return s.toAPIResponse(updatedTag), nil
 }
 
 // Delete 处理删除标签的业务逻辑。
 func (s *Service) Delete(ctx context.Context, publicID string) error {
-	return s.repo.Delete(ctx, publicID)
+	if err := s.repo.Delete(ctx, publicID); err != nil {
+		return err
+	}
+	s.publishUpdated()
+	return nil
 }
@@ -0,0 +1,362 @@
+/*
+ * @Description: 个人数据导出与匿名化服务，用于处理访客或用户的隐私请求
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 以评论者邮箱或用户账号为线索，汇总评论、订阅和账号信息。
+ * 点赞和表态只记录计数，访问记录只关联匿名访客 ID 和 IP，都无法可靠地对应到个人，因此不在导出和匿名化范围内。
+ * 按评论 IP 匹配访问记录会把共用同一出口 IP 的其他访客一并导出或清除，所以不这样做。
+ */
+package privacy
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	entcomment "github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
+	entuser "github.com/anzhiyu-c/anheyu-app/ent/user"
+	"github.com/anzhiyu-c/anheyu-app/ent/usernotificationconfig"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// anonymousNickname 匿名化后评论显示的昵称
+const anonymousNickname = "匿名用户"
+
+var (
+	ErrEmptySubject   = errors.New("请提供邮箱或用户ID")
+	ErrInvalidUserID  = errors.New("用户ID不合法")
+	ErrSubjectMissing = errors.New("未找到与该邮箱或用户相关的数据")
+)
+
+// Subject 隐私请求的主体，邮箱和用户ID至少提供一个
+type Subject struct {
+	Email  string `json:"email" form:"email"`
+	UserID string `json:"user_id" form:"user_id"` // 用户公共ID
+}
+
+// Account 用户账号信息
+type Account struct {
+	ID          string     `json:"id"`
+	Username    string     `json:"username"`
+	Nickname    string     `json:"nickname"`
+	Email       string     `json:"email"`
+	Website     string     `json:"website"`
+	Avatar      string     `json:"avatar"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+}
+
+// Comment 评论记录，包含存储的 IP 和归属地
+type Comment struct {
+	ID          string     `json:"id"`
+	TargetPath  string     `json:"target_path"`
+	TargetTitle string     `json:"target_title,omitempty"`
+	Nickname    string     `json:"nickname"`
+	Email       string     `json:"email,omitempty"`
+	Website     string     `json:"website,omitempty"`
+	Content     string     `json:"content"`
+	IPAddress   string     `json:"ip_address"`
+	IPLocation  string     `json:"ip_location,omitempty"`
+	UserAgent   string     `json:"user_agent,omitempty"`
+	LikeCount   int        `json:"like_count"`
+	Status      int        `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// Subscription 邮件订阅记录
+type Subscription struct {
+	Email     string    `json:"email"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NotificationSetting 用户的通知偏好
+type NotificationSetting struct {
+	IsEnabled         bool     `json:"is_enabled"`
+	EnabledChannels   []string `json:"enabled_channels"`
+	NotificationEmail string   `json:"notification_email,omitempty"`
+}
+
+// visitorLogNotice 导出结果中关于访问记录的说明
+const visitorLogNotice = "访问记录只关联匿名访客 ID 和 IP，无法可靠地对应到个人，不包含在导出中，也不会被匿名化"
+
+// Export 个人数据导出结果
+type Export struct {
+	Subject       Subject               `json:"subject"`
+	GeneratedAt   time.Time             `json:"generated_at"`
+	Account       *Account              `json:"account,omitempty"`
+	Notifications []NotificationSetting `json:"notifications,omitempty"`
+	Comments      []Comment             `json:"comments"`
+	Subscriptions []Subscription        `json:"subscriptions"`
+	Notes         []string              `json:"notes"` // 未包含在导出中的数据及原因
+}
+
+// AnonymizeResult 匿名化结果
+type AnonymizeResult struct {
+	Comments      int `json:"comments"`
+	Subscriptions int `json:"subscriptions"`
+}
+
+// Service 隐私请求服务
+type Service struct {
+	db *ent.Client
+}
+
+// NewService 创建隐私请求服务
+func NewService(db *ent.Client) *Service {
+	return &Service{db: db}
+}
+
+// resolved 解析后的请求主体
+type resolved struct {
+	email  string
+	userID uint
+	user   *ent.User
+}
+
+func (s *Service) resolve(ctx context.Context, subject Subject) (*resolved, error) {
+	r := &resolved{email: strings.ToLower(strings.TrimSpace(subject.Email))}
+	if subject.UserID != "" {
+		id, entityType, err := idgen.DecodePublicID(subject.UserID)
+		if err != nil || entityType != idgen.EntityTypeUser {
+			return nil, ErrInvalidUserID
+		}
+		r.userID = id
+	}
+	if r.email == "" && r.userID == 0 {
+		return nil, ErrEmptySubject
+	}
+
+	var userPred predicate.User
+	if r.userID != 0 {
+		userPred = entuser.IDEQ(r.userID)
+	} else {
+		userPred = entuser.EmailEqualFold(r.email)
+	}
+	u, err := s.db.User.Query().Where(userPred).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if u != nil {
+		r.user = u
+		r.userID = u.ID
+		if r.email == "" {
+			r.email = strings.ToLower(u.Email)
+		}
+	}
+	return r, nil
+}
+
+func (r *resolved) commentPredicate() predicate.Comment {
+	var preds []predicate.Comment
+	if r.email != "" {
+		preds = append(preds, entcomment.EmailEqualFold(r.email))
+	}
+	if r.userID != 0 {
+		preds = append(preds, entcomment.UserIDEQ(r.userID))
+	}
+	return entcomment.Or(preds...)
+}
+
+// Export 汇总与邮箱或用户账号相关的全部数据，已软删除的评论也会包含在内
+func (s *Service) Export(ctx context.Context, subject Subject) (*Export, error) {
+	r, err := s.resolve(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Export{
+		Subject:       subject,
+		GeneratedAt:   time.Now(),
+		Comments:      []Comment{},
+		Subscriptions: []Subscription{},
+		Notes:         []string{visitorLogNotice},
+	}
+
+	if r.user != nil {
+		userPublicID, _ := idgen.GeneratePublicID(r.user.ID, idgen.EntityTypeUser)
+		result.Account = &Account{
+			ID:          userPublicID,
+			Username:    r.user.Username,
+			Nickname:    r.user.Nickname,
+			Email:       r.user.Email,
+			Website:     r.user.Website,
+			Avatar:      r.user.Avatar,
+			CreatedAt:   r.user.CreatedAt,
+			LastLoginAt: r.user.LastLoginAt,
+		}
+		configs, err := s.db.UserNotificationConfig.Query().
+			Where(usernotificationconfig.UserIDEQ(r.user.ID)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询通知设置失败: %w", err)
+		}
+		for _, cfg := range configs {
+			result.Notifications = append(result.Notifications, NotificationSetting{
+				IsEnabled:         cfg.IsEnabled,
+				EnabledChannels:   cfg.EnabledChannels,
+				NotificationEmail: cfg.NotificationEmail,
+			})
+		}
+	}
+
+	comments, err := s.db.Comment.Query().
+		Where(r.commentPredicate()).
+		Order(ent.Asc(entcomment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询评论失败: %w", err)
+	}
+	for _, c := range comments {
+		publicID, _ := idgen.GeneratePublicID(c.ID, idgen.EntityTypeComment)
+		result.Comments = append(result.Comments, Comment{
+			ID:          publicID,
+			TargetPath:  c.TargetPath,
+			TargetTitle: deref(c.TargetTitle),
+			Nickname:    c.Nickname,
+			Email:       deref(c.Email),
+			Website:     deref(c.Website),
+			Content:     c.Content,
+			IPAddress:   c.IPAddress,
+			IPLocation:  deref(c.IPLocation),
+			UserAgent:   deref(c.UserAgent),
+			LikeCount:   c.LikeCount,
+			Status:      c.Status,
+			CreatedAt:   c.CreatedAt,
+			DeletedAt:   c.DeletedAt,
+		})
+	}
+
+	if r.email != "" {
+		subs, err := s.db.Subscriber.Query().Where(subscriber.EmailEqualFold(r.email)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询订阅记录失败: %w", err)
+		}
+		for _, sub := range subs {
+			result.Subscriptions = append(result.Subscriptions, Subscription{
+				Email:     sub.Email,
+				IsActive:  sub.IsActive,
+				CreatedAt: sub.CreatedAt,
+			})
+		}
+	}
+
+	if result.Account == nil && len(result.Comments) == 0 && len(result.Subscriptions) == 0 {
+		return nil, ErrSubjectMissing
+	}
+	return result, nil
+}
+
+// ExportZip 将导出结果按类别打包为 ZIP
+func (s *Service) ExportZip(ctx context.Context, subject Subject) ([]byte, error) {
+	data, err := s.Export(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]interface{}{
+		"comments.json":      data.Comments,
+		"subscriptions.json": data.Subscriptions,
+		"export.json":        data,
+	}
+	if data.Account != nil {
+		files["account.json"] = data.Account
+		files["notifications.json"] = data.Notifications
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, v := range files {
+		content, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: data.GeneratedAt})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Anonymize 清除评论中的邮箱、网站、IP、归属地和 User-Agent 并解除与账号的关联，
+// 删除订阅记录。评论内容和账号本身保留，需要时在对应的管理页面处理；访问记录不与个人关联，不做处理。
+func (s *Service) Anonymize(ctx context.Context, subject Subject) (*AnonymizeResult, error) {
+	r, err := s.resolve(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.db.Comment.Query().Where(r.commentPredicate()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询评论失败: %w", err)
+	}
+	ids := make([]uint, 0, len(comments))
+	for _, c := range comments {
+		ids = append(ids, c.ID)
+	}
+
+	result := &AnonymizeResult{}
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if len(ids) > 0 {
+		emptyMD5 := md5.Sum(nil)
+		result.Comments, err = tx.Comment.Update().
+			Where(entcomment.IDIn(ids...)).
+			SetNickname(anonymousNickname).
+			ClearEmail().
+			SetEmailMd5(hex.EncodeToString(emptyMD5[:])).
+			ClearWebsite().
+			ClearUserID().
+			SetIPAddress("").
+			ClearIPLocation().
+			ClearUserAgent().
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("匿名化评论失败: %w", err)
+		}
+	}
+
+	if r.email != "" {
+		result.Subscriptions, err = tx.Subscriber.Delete().Where(subscriber.EmailEqualFold(r.email)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("删除订阅记录失败: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	log.Printf("[隐私请求] 已匿名化 %d 条评论、%d 条订阅", result.Comments, result.Subscriptions)
+	return result, nil
+}
+
+func deref(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
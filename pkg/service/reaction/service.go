@@ -0,0 +1,385 @@
+/*
+ * @Description: 文章与即刻的表态（点赞、表情回应）服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 访客无需登录即可表态，同一访客（IP + User-Agent 的哈希）对同一目标的同一表情只计一次。
+ * 去重标记保存在缓存中，通过原子自增抢占，并发的重复请求只有一个能计数。
+ * 表态计数保存在数据库中，多实例部署时共享。旧版本的 data/reactions.json 会在启动时导入一次。
+ */
+package reaction
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/reactioncount"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// 表态目标类型
+const (
+	TargetArticle = "article"
+	TargetEssay   = "essay"
+)
+
+const (
+	// LegacyStorePath 旧版本保存表态计数的文件，启动时导入数据库后重命名
+	LegacyStorePath = "data/reactions.json"
+	// DefaultReactions 默认允许的表态
+	DefaultReactions = "like,👍,❤️,😂,😮,😢,🎉"
+	// seenKeyPrefix 访客表态标记的缓存键前缀
+	seenKeyPrefix = "anheyu:reaction:seen:"
+	// seenTTL 访客表态标记保留时间
+	seenTTL = 365 * 24 * time.Hour
+)
+
+var (
+	// ErrInvalidTarget 表态目标不合法
+	ErrInvalidTarget = errors.New("表态目标不合法")
+	// ErrReactionNotAllowed 表态不在允许列表中
+	ErrReactionNotAllowed = errors.New("不支持该表态")
+)
+
+// targetIDPattern 目标 ID 只允许字母、数字、短横线和下划线
+var targetIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// Counts 各表态的数量
+type Counts map[string]int
+
+// Result 表态操作结果
+type Result struct {
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	Reaction   string `json:"reaction"`
+	Changed    bool   `json:"changed"` // 本次操作是否改变了计数，重复表态或撤销不存在的表态为 false
+	Counts     Counts `json:"counts"`
+}
+
+// TargetCounts 管理后台展示的目标表态统计
+type TargetCounts struct {
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	Total      int    `json:"total"`
+	Counts     Counts `json:"counts"`
+}
+
+// Resolver 将前端传入的目标 ID 规范化（如文章 abbrlink 转为公共 ID），目标不存在时返回错误
+type Resolver func(ctx context.Context, id string) (string, error)
+
+// Service 表态服务
+type Service struct {
+	db         *ent.Client
+	settingSvc setting.SettingService
+	cacheSvc   utility.CacheService
+	resolvers  map[string]Resolver
+}
+
+// NewService 创建表态服务，legacyPath 不为空时导入旧版本保存在文件中的计数
+func NewService(db *ent.Client, settingSvc setting.SettingService, cacheSvc utility.CacheService, legacyPath string) *Service {
+	s := &Service{
+		db:         db,
+		settingSvc: settingSvc,
+		cacheSvc:   cacheSvc,
+		resolvers:  make(map[string]Resolver),
+	}
+	if legacyPath != "" {
+		if err := s.importLegacy(context.Background(), legacyPath); err != nil {
+			log.Printf("[表态] 导入旧版表态数据失败: %v", err)
+		}
+	}
+	return s
+}
+
+// SetResolver 设置目标类型的 ID 解析函数
+func (s *Service) SetResolver(targetType string, resolver Resolver) {
+	s.resolvers[targetType] = resolver
+}
+
+// AllowedReactions 返回允许的表态列表
+func (s *Service) AllowedReactions() []string {
+	raw := s.settingSvc.Get(constant.KeyReactionAllowed.String())
+	if strings.TrimSpace(raw) == "" {
+		raw = DefaultReactions
+	}
+	var reactions []string
+	for _, r := range strings.Split(raw, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			reactions = append(reactions, r)
+		}
+	}
+	return reactions
+}
+
+// Get 返回目标的表态计数，允许的表态即使为 0 也会返回
+func (s *Service) Get(ctx context.Context, targetType, targetID string) (Counts, error) {
+	rows, err := s.db.ReactionCount.Query().
+		Where(
+			reactioncount.TargetType(targetType),
+			reactioncount.TargetID(targetID),
+			reactioncount.CountGT(0),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询表态计数失败: %w", err)
+	}
+	result := make(Counts)
+	for _, r := range s.AllowedReactions() {
+		result[r] = 0
+	}
+	for _, row := range rows {
+		result[row.Reaction] = int(row.Count)
+	}
+	return result, nil
+}
+
+// Resolve 校验目标并返回规范化后的 ID
+func (s *Service) Resolve(ctx context.Context, targetType, targetID string) (string, error) {
+	if targetType != TargetArticle && targetType != TargetEssay {
+		return "", ErrInvalidTarget
+	}
+	if !targetIDPattern.MatchString(targetID) {
+		return "", ErrInvalidTarget
+	}
+	if resolver, ok := s.resolvers[targetType]; ok {
+		resolved, err := resolver(ctx, targetID)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrInvalidTarget, err)
+		}
+		return resolved, nil
+	}
+	return targetID, nil
+}
+
+// React 访客表态，同一访客对同一目标的同一表态只计一次
+func (s *Service) React(ctx context.Context, targetType, targetID, reaction, ip, userAgent string) (*Result, error) {
+	return s.apply(ctx, targetType, targetID, reaction, ip, userAgent, true)
+}
+
+// Unreact 撤销访客自己的表态
+func (s *Service) Unreact(ctx context.Context, targetType, targetID, reaction, ip, userAgent string) (*Result, error) {
+	return s.apply(ctx, targetType, targetID, reaction, ip, userAgent, false)
+}
+
+func (s *Service) apply(ctx context.Context, targetType, targetID, reaction, ip, userAgent string, add bool) (*Result, error) {
+	targetID, err := s.Resolve(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.isAllowed(reaction) {
+		return nil, ErrReactionNotAllowed
+	}
+
+	seenKey := seenKeyPrefix + targetKey(targetType, targetID) + ":" + reaction + ":" + visitorHash(ip, userAgent)
+	changed := false
+	if add {
+		// 自增结果为 1 的请求抢到了标记，并发的重复请求拿到的都大于 1
+		n, err := s.cacheSvc.Increment(ctx, seenKey)
+		if err != nil {
+			return nil, fmt.Errorf("保存表态记录失败: %w", err)
+		}
+		if n == 1 {
+			if err := s.cacheSvc.Expire(ctx, seenKey, seenTTL); err != nil {
+				log.Printf("[表态] 设置表态记录过期时间失败: %v", err)
+			}
+			if err := s.increase(ctx, targetType, targetID, reaction); err != nil {
+				s.cacheSvc.Delete(ctx, seenKey)
+				return nil, err
+			}
+			changed = true
+		}
+	} else {
+		// 取出并删除是原子的，并发的撤销请求只有一个能拿到标记
+		seen, err := s.cacheSvc.GetAndDeleteMany(ctx, []string{seenKey})
+		if err != nil {
+			return nil, fmt.Errorf("删除表态记录失败: %w", err)
+		}
+		if _, ok := seen[seenKey]; ok {
+			if changed, err = s.decrease(ctx, targetType, targetID, reaction); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	counts, err := s.Get(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reaction:   reaction,
+		Changed:    changed,
+		Counts:     counts,
+	}, nil
+}
+
+// List 返回某类目标的表态统计，按总数倒序，targetType 为空时返回全部
+func (s *Service) List(ctx context.Context, targetType string) ([]TargetCounts, error) {
+	query := s.db.ReactionCount.Query().Where(reactioncount.CountGT(0))
+	if targetType != "" {
+		query = query.Where(reactioncount.TargetType(targetType))
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询表态统计失败: %w", err)
+	}
+
+	index := make(map[string]int)
+	result := make([]TargetCounts, 0)
+	for _, row := range rows {
+		key := targetKey(row.TargetType, row.TargetID)
+		i, ok := index[key]
+		if !ok {
+			i = len(result)
+			index[key] = i
+			result = append(result, TargetCounts{TargetType: row.TargetType, TargetID: row.TargetID, Counts: make(Counts)})
+		}
+		result[i].Counts[row.Reaction] = int(row.Count)
+		result[i].Total += int(row.Count)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Total != result[j].Total {
+			return result[i].Total > result[j].Total
+		}
+		return result[i].TargetID < result[j].TargetID
+	})
+	return result, nil
+}
+
+// Reset 清零目标的表态计数，reaction 为空时清零该目标的全部表态。
+// 访客的去重标记不会清除，已表态过的访客不能再次刷回计数。
+func (s *Service) Reset(ctx context.Context, targetType, targetID, reaction string) error {
+	del := s.db.ReactionCount.Delete().
+		Where(
+			reactioncount.TargetType(targetType),
+			reactioncount.TargetID(targetID),
+		)
+	if reaction != "" {
+		del = del.Where(reactioncount.Reaction(reaction))
+	}
+	if _, err := del.Exec(ctx); err != nil {
+		return fmt.Errorf("重置表态计数失败: %w", err)
+	}
+	log.Printf("[表态] 已重置 %s 的表态计数（表态: %q）", targetKey(targetType, targetID), reaction)
+	return nil
+}
+
+// increase 计数加一，行不存在时插入，由数据库保证并发安全
+func (s *Service) increase(ctx context.Context, targetType, targetID, reaction string) error {
+	err := s.db.ReactionCount.Create().
+		SetTargetType(targetType).
+		SetTargetID(targetID).
+		SetReaction(reaction).
+		SetCount(1).
+		OnConflict(
+			sql.ConflictColumns(reactioncount.FieldTargetType, reactioncount.FieldTargetID, reactioncount.FieldReaction),
+		).
+		Update(func(u *ent.ReactionCountUpsert) {
+			u.AddCount(1)
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("保存表态计数失败: %w", err)
+	}
+	return nil
+}
+
+// decrease 计数减一，计数已被管理员重置为 0 时不做修改并返回 false
+func (s *Service) decrease(ctx context.Context, targetType, targetID, reaction string) (bool, error) {
+	n, err := s.db.ReactionCount.Update().
+		Where(
+			reactioncount.TargetType(targetType),
+			reactioncount.TargetID(targetID),
+			reactioncount.Reaction(reaction),
+			reactioncount.CountGT(0),
+		).
+		AddCount(-1).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("保存表态计数失败: %w", err)
+	}
+	return n > 0, nil
+}
+
+// importLegacy 将旧版本 data/reactions.json 中的计数导入数据库，成功后重命名文件避免重复导入
+func (s *Service) importLegacy(ctx context.Context, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var legacy map[string]Counts
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+
+	builders := make([]*ent.ReactionCountCreate, 0)
+	for key, counts := range legacy {
+		t, id, _ := strings.Cut(key, ":")
+		for r, n := range counts {
+			if n <= 0 {
+				continue
+			}
+			builders = append(builders, s.db.ReactionCount.Create().
+				SetTargetType(t).
+				SetTargetID(id).
+				SetReaction(r).
+				SetCount(int64(n)))
+		}
+	}
+	if len(builders) > 0 {
+		err := s.db.ReactionCount.CreateBulk(builders...).
+			OnConflict(
+				sql.ConflictColumns(reactioncount.FieldTargetType, reactioncount.FieldTargetID, reactioncount.FieldReaction),
+			).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(path, path+".imported"); err != nil {
+		return err
+	}
+	log.Printf("[表态] 已从 %s 导入 %d 条表态计数", path, len(builders))
+	return nil
+}
+
+func (s *Service) isAllowed(reaction string) bool {
+	for _, r := range s.AllowedReactions() {
+		if r == reaction {
+			return true
+		}
+	}
+	return false
+}
+
+func targetKey(targetType, targetID string) string {
+	return targetType + ":" + targetID
+}
+
+// visitorHash 访客标识，只保存哈希，不在缓存中留下原始 IP
+func visitorHash(ip, userAgent string) string {
+	sum := sha256.Sum256([]byte(ip + "|" + userAgent))
+	return hex.EncodeToString(sum[:12])
+}
@@ -0,0 +1,321 @@
+/*
+ * @Description: 重定向管理，保存旧地址到新地址的重定向规则
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 规则保存在数据库的 redirect_rules 表中，旧版本的 data/redirects.json 会在启动时导入一次。
+ * 文章永久链接变化时自动添加 301 规则，添加规则时会把指向旧地址的规则改为直接指向新地址，
+ * 避免出现重定向链和循环；新文章使用了某条规则的来源地址时删除该规则，否则新文章会被重定向走。
+ * 每个请求都要查找规则，因此在内存中缓存一份，修改后立即刷新，多实例部署时其他实例最多 cacheTTL 后生效。
+ */
+package redirect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/redirectrule"
+)
+
+const (
+	// LegacyStorePath 旧版本保存重定向规则的文件，启动时导入数据库后重命名
+	LegacyStorePath = "data/redirects.json"
+	// cacheTTL 内存中规则缓存的有效期
+	cacheTTL = 30 * time.Second
+)
+
+// 规则来源
+const (
+	SourceManual    = "manual"    // 管理员添加
+	SourcePermalink = "permalink" // 文章永久链接变化时自动添加
+)
+
+var (
+	ErrInvalidPath = errors.New("地址必须是以 / 开头的站内路径")
+	ErrInvalidCode = errors.New("状态码只能是 301、302、307 或 308")
+	ErrSamePath    = errors.New("来源地址和目标地址不能相同")
+	ErrNotFound    = errors.New("重定向规则不存在")
+)
+
+// Rule 重定向规则
+type Rule struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Code      int       `json:"code"`
+	Source    string    `json:"source"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Service 重定向管理服务
+type Service struct {
+	db *ent.Client
+
+	mu       sync.RWMutex
+	rules    map[string]Rule // 来源地址 -> 规则
+	loadedAt time.Time
+}
+
+// NewService 创建重定向管理服务，legacyPath 不为空时导入旧版本保存在文件中的规则
+func NewService(db *ent.Client, legacyPath string) *Service {
+	s := &Service{db: db}
+	ctx := context.Background()
+	if legacyPath != "" {
+		if err := s.importLegacy(ctx, legacyPath); err != nil {
+			log.Printf("[重定向] 导入旧版重定向规则失败: %v", err)
+		}
+	}
+	if err := s.reload(ctx); err != nil {
+		log.Printf("[重定向] 读取重定向规则失败: %v", err)
+	}
+	return s
+}
+
+// normalizePath 规范化站内路径：去掉查询参数和末尾的斜杠
+func normalizePath(p string) (string, error) {
+	p = strings.TrimSpace(p)
+	if i := strings.IndexAny(p, "?#"); i >= 0 {
+		p = p[:i]
+	}
+	if !strings.HasPrefix(p, "/") || strings.HasPrefix(p, "//") {
+		return "", ErrInvalidPath
+	}
+	if len(p) > 1 {
+		p = strings.TrimRight(p, "/")
+	}
+	return p, nil
+}
+
+func validCode(code int) bool {
+	switch code {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	default:
+		return false
+	}
+}
+
+// List 返回所有规则，按来源地址排序
+func (s *Service) List(ctx context.Context) ([]Rule, error) {
+	rows, err := s.db.RedirectRule.Query().Order(ent.Asc(redirectrule.FieldFromPath)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]Rule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, toRule(row))
+	}
+	return rules, nil
+}
+
+// Add 添加或覆盖规则
+func (s *Service) Add(ctx context.Context, from, to string, code int, source string) (*Rule, error) {
+	from, err := normalizePath(from)
+	if err != nil {
+		return nil, err
+	}
+	to, err = normalizePath(to)
+	if err != nil {
+		return nil, err
+	}
+	if from == to {
+		return nil, ErrSamePath
+	}
+	if code == 0 {
+		code = http.StatusMovedPermanently
+	}
+	if !validCode(code) {
+		return nil, ErrInvalidCode
+	}
+	if source == "" {
+		source = SourceManual
+	}
+
+	var row *ent.RedirectRule
+	err = s.withTx(ctx, func(tx *ent.Tx) error {
+		// 目标地址重新启用，原来从目标地址出发的规则失效；来源地址的旧规则被新规则覆盖
+		if _, err := tx.RedirectRule.Delete().Where(redirectrule.FromPathIn(to, from)).Exec(ctx); err != nil {
+			return err
+		}
+		// 原来指向来源地址的规则改为直接指向新地址，来源恰好是新地址的已在上一步删除
+		if _, err := tx.RedirectRule.Update().Where(redirectrule.ToPath(from)).SetToPath(to).Save(ctx); err != nil {
+			return err
+		}
+		row, err = tx.RedirectRule.Create().
+			SetFromPath(from).
+			SetToPath(to).
+			SetCode(code).
+			SetSource(source).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("保存重定向规则失败: %w", err)
+	}
+	s.refresh(ctx)
+	rule := toRule(row)
+	return &rule, nil
+}
+
+// Delete 删除规则
+func (s *Service) Delete(ctx context.Context, from string) error {
+	from, err := normalizePath(from)
+	if err != nil {
+		return err
+	}
+	n, err := s.db.RedirectRule.Delete().Where(redirectrule.FromPath(from)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	s.refresh(ctx)
+	return nil
+}
+
+// Lookup 查找请求路径对应的规则
+func (s *Service) Lookup(path string) (string, int, bool) {
+	path, err := normalizePath(path)
+	if err != nil {
+		return "", 0, false
+	}
+	s.mu.RLock()
+	stale := time.Since(s.loadedAt) > cacheTTL
+	r, ok := s.rules[path]
+	s.mu.RUnlock()
+	if stale {
+		s.refresh(context.Background())
+		s.mu.RLock()
+		r, ok = s.rules[path]
+		s.mu.RUnlock()
+	}
+	if !ok {
+		return "", 0, false
+	}
+	return r.To, r.Code, true
+}
+
+// AddPermalinkRedirect 实现 article.RedirectRecorder，文章永久链接变化时添加 301 规则
+func (s *Service) AddPermalinkRedirect(from, to string) {
+	if _, err := s.Add(context.Background(), from, to, http.StatusMovedPermanently, SourcePermalink); err != nil {
+		log.Printf("[重定向] 添加永久链接重定向 %s -> %s 失败: %v", from, to, err)
+		return
+	}
+	log.Printf("[重定向] 文章永久链接变化，已添加 301 重定向: %s -> %s", from, to)
+}
+
+// ReleasePath 实现 article.RedirectRecorder，文章开始使用 path 时删除从该地址出发的规则
+func (s *Service) ReleasePath(path string) {
+	ctx := context.Background()
+	if err := s.Delete(ctx, path); err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			log.Printf("[重定向] 删除地址 %s 上的重定向失败: %v", path, err)
+		}
+		return
+	}
+	log.Printf("[重定向] 地址 %s 已被新文章使用，已删除该地址上的重定向", path)
+}
+
+// refresh 重新读取规则缓存，失败时保留旧缓存并在下个请求重试
+func (s *Service) refresh(ctx context.Context) {
+	if err := s.reload(ctx); err != nil {
+		log.Printf("[重定向] 刷新重定向规则失败: %v", err)
+	}
+}
+
+func (s *Service) reload(ctx context.Context) error {
+	rows, err := s.db.RedirectRule.Query().All(ctx)
+	if err != nil {
+		s.mu.Lock()
+		s.loadedAt = time.Now()
+		s.mu.Unlock()
+		return err
+	}
+	rules := make(map[string]Rule, len(rows))
+	for _, row := range rows {
+		rules[row.FromPath] = toRule(row)
+	}
+	s.mu.Lock()
+	s.rules = rules
+	s.loadedAt = time.Now()
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *Service) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// importLegacy 将旧版本 data/redirects.json 中的规则导入数据库，成功后重命名文件避免重复导入
+func (s *Service) importLegacy(ctx context.Context, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var stored []Rule
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+
+	builders := make([]*ent.RedirectRuleCreate, 0, len(stored))
+	seen := make(map[string]bool, len(stored))
+	for _, r := range stored {
+		from, err := normalizePath(r.From)
+		if err != nil || seen[from] {
+			continue
+		}
+		to, err := normalizePath(r.To)
+		if err != nil || from == to {
+			continue
+		}
+		code := r.Code
+		if !validCode(code) {
+			code = http.StatusMovedPermanently
+		}
+		source := r.Source
+		if source == "" {
+			source = SourceManual
+		}
+		seen[from] = true
+		b := s.db.RedirectRule.Create().SetFromPath(from).SetToPath(to).SetCode(code).SetSource(source)
+		if !r.CreatedAt.IsZero() {
+			b.SetCreatedAt(r.CreatedAt)
+		}
+		builders = append(builders, b)
+	}
+	if len(builders) > 0 {
+		err := s.db.RedirectRule.CreateBulk(builders...).
+			OnConflictColumns(redirectrule.FieldFromPath).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	log.Printf("[重定向] 已从 %s 导入 %d 条重定向规则", path, len(builders))
+	return os.Rename(path, path+".imported")
+}
+
+func toRule(row *ent.RedirectRule) Rule {
+	return Rule{From: row.FromPath, To: row.ToPath, Code: row.Code, Source: row.Source, CreatedAt: row.CreatedAt}
+}
@@ -116,6 +116,18 @@ func (s *SearchService) clearAllIndexes(ctx context.Context) error {
 	return nil
 }
 
+// Mode 返回当前使用的搜索模式：redis、simple，未初始化时为空
+func Mode() string {
+	switch AppSearcher.(type) {
+	case *RedisSearcher:
+		return "redis"
+	case *SimpleSearcher:
+		return "simple"
+	default:
+		return ""
+	}
+}
+
 // InitializeSearchEngine 初始化搜索引擎（支持自动降级）
 func InitializeSearchEngine(settingSvc setting.SettingService) error {
 	// 尝试使用 Redis 搜索模式
@@ -0,0 +1,210 @@
+package selfupdate
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// ReleasePublicKey 项目发布签名使用的 Ed25519 公钥（Base64），正式构建时通过 ldflags 注入。
+// 配置了 Update.PublicKey 时以配置为准（自建发布源使用自己的密钥签名）
+var ReleasePublicKey = ""
+
+// Asset 发布附带的文件
+type Asset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+	Size int64  `json:"size"`
+}
+
+// Release 发布信息，字段与 GitHub Releases API 一致
+type Release struct {
+	TagName     string    `json:"tag_name"`
+	Name        string    `json:"name"`
+	Body        string    `json:"body"`
+	Prerelease  bool      `json:"prerelease"`
+	PublishedAt time.Time `json:"published_at"`
+	HTMLURL     string    `json:"html_url"`
+	Assets      []Asset   `json:"assets"`
+}
+
+// fetchRelease 从发布信息地址获取最新发布
+func fetchRelease(ctx context.Context, client *http.Client, feedURL string) (*Release, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feedURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("获取发布信息失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("获取发布信息失败: HTTP %d", resp.StatusCode)
+	}
+	var release Release
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 4<<20)).Decode(&release); err != nil {
+		return nil, fmt.Errorf("解析发布信息失败: %w", err)
+	}
+	if release.TagName == "" {
+		return nil, fmt.Errorf("发布信息缺少版本号")
+	}
+	return &release, nil
+}
+
+// archiveName 当前平台的发布包文件名，与发布流程的命名规则一致
+func archiveName(version string) string {
+	ext := ".tar.gz"
+	if runtime.GOOS == "windows" {
+		ext = ".zip"
+	}
+	return fmt.Sprintf("%s_%s_%s_%s%s", projectName, strings.TrimPrefix(version, "v"), runtime.GOOS, runtime.GOARCH, ext)
+}
+
+// checksumsName 校验文件的文件名
+func checksumsName(version string) string {
+	return fmt.Sprintf("%s_%s_checksums.txt", projectName, strings.TrimPrefix(version, "v"))
+}
+
+func (r *Release) asset(name string) *Asset {
+	for i := range r.Assets {
+		if r.Assets[i].Name == name {
+			return &r.Assets[i]
+		}
+	}
+	return nil
+}
+
+// parseChecksums 解析 sha256sum 格式的校验文件，返回文件名到哈希的映射
+func parseChecksums(data []byte) map[string]string {
+	sums := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 {
+			sums[strings.TrimPrefix(fields[1], "*")] = strings.ToLower(fields[0])
+		}
+	}
+	return sums
+}
+
+// verifySignature 使用 Ed25519 公钥校验校验文件的签名，签名为 Base64 编码
+func verifySignature(publicKey string, data, signature []byte) error {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("签名公钥不是有效的 Ed25519 公钥")
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil {
+		return fmt.Errorf("签名格式无效: %w", err)
+	}
+	if !ed25519.Verify(ed25519.PublicKey(key), data, sig) {
+		return fmt.Errorf("校验文件签名不匹配")
+	}
+	return nil
+}
+
+// fileSHA256 计算文件的 SHA-256
+func fileSHA256(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// extractBinary 从发布包中取出程序文件写入 dst
+func extractBinary(archive, dst string) error {
+	name := projectName
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	if strings.HasSuffix(archive, ".zip") {
+		return extractFromZip(archive, name, dst)
+	}
+	return extractFromTarGz(archive, name, dst)
+}
+
+func extractFromTarGz(archive, name, dst string) error {
+	f, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("解压发布包失败: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return fmt.Errorf("发布包中没有找到 %s", name)
+		}
+		if err != nil {
+			return fmt.Errorf("读取发布包失败: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeReg && path.Base(hdr.Name) == name {
+			return writeExecutable(tr, dst)
+		}
+	}
+}
+
+func extractFromZip(archive, name, dst string) error {
+	zr, err := zip.OpenReader(archive)
+	if err != nil {
+		return fmt.Errorf("解压发布包失败: %w", err)
+	}
+	defer zr.Close()
+	for _, f := range zr.File {
+		if path.Base(f.Name) != name || f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+		return writeExecutable(rc, dst)
+	}
+	return fmt.Errorf("发布包中没有找到 %s", name)
+}
+
+func writeExecutable(r io.Reader, dst string) error {
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	if err != nil {
+		return fmt.Errorf("写入新程序失败: %w", err)
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		out.Close()
+		return fmt.Errorf("写入新程序失败: %w", err)
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
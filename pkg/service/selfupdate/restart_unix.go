@@ -0,0 +1,14 @@
+//go:build !windows
+
+package selfupdate
+
+import (
+	"os"
+	"syscall"
+)
+
+// restart 用 binary 替换当前进程，进程 ID 保持不变，systemd 等进程管理器不会察觉重启。
+// 监听的套接字带有 close-on-exec 标志，新程序可以重新绑定同一端口。env 为新程序的环境变量
+func restart(binary string, env []string) error {
+	return syscall.Exec(binary, os.Args, env)
+}
@@ -0,0 +1,22 @@
+//go:build windows
+
+package selfupdate
+
+import (
+	"os"
+	"os/exec"
+)
+
+// restart Windows 不支持替换当前进程，启动新进程后退出当前进程。env 为新程序的环境变量
+func restart(binary string, env []string) error {
+	cmd := exec.Command(binary, os.Args[1:]...)
+	cmd.Env = env
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	os.Exit(0)
+	return nil
+}
@@ -0,0 +1,404 @@
+/*
+ * @Description: 程序自更新：检查新版本、下载校验、原地替换、重启和失败回滚
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 流程：
+ *   1. 从发布信息地址获取最新发布，找到当前平台的发布包和校验文件；
+ *   2. 用内置的项目公钥（或 Update.PublicKey）校验校验文件的 Ed25519 签名，再按校验文件校验发布包的 SHA-256。
+ *      没有签名或没有可用公钥时拒绝更新，除非管理员显式设置 Update.AllowUnsigned；
+ *   3. 解压出新程序放在当前程序旁边，保留旧程序备份后原子替换，然后重新执行自身；
+ *   4. 新进程启动后做健康检查，通过后标记更新成功；检查失败或新版本反复启动失败时恢复旧程序，
+ *      并以允许降级的方式重启旧程序，使其能够启动新版本迁移过的数据库。
+ * 容器部署应通过更新镜像升级，不支持自更新。
+ */
+package selfupdate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+)
+
+const (
+	// DefaultStateDir 下载文件和更新状态的保存目录
+	DefaultStateDir = "data/update"
+	// DefaultFeedURL 默认的发布信息地址
+	DefaultFeedURL = "https://api.github.com/repos/anzhiyu-c/anheyu-app/releases/latest"
+
+	projectName = "anheyu-app"
+
+	// healthDelay 新版本启动后等待多久开始健康检查
+	healthDelay = 15 * time.Second
+	// healthAttempts 健康检查的次数，全部失败时回滚
+	healthAttempts = 3
+	// healthInterval 两次健康检查之间的间隔
+	healthInterval = 10 * time.Second
+)
+
+var (
+	ErrUnsupported   = errors.New("容器部署请通过更新镜像升级，不支持程序自更新")
+	ErrUpdateRunning = errors.New("已有更新正在进行")
+	ErrUpToDate      = errors.New("当前已是最新版本")
+	ErrNoRollback    = errors.New("没有可回滚的旧版本程序")
+)
+
+var (
+	feedClient = httpclient.New(httpclient.Options{
+		Name:       "selfupdate",
+		Timeout:    15 * time.Second,
+		MaxRetries: 2,
+	})
+	downloadClient = httpclient.New(httpclient.Options{
+		Name:       "selfupdate-download",
+		Service:    "selfupdate",
+		Timeout:    10 * time.Minute,
+		MaxRetries: 1,
+	})
+)
+
+// CheckResult 检查更新的结果
+type CheckResult struct {
+	CurrentVersion string    `json:"current_version"`
+	LatestVersion  string    `json:"latest_version"`
+	HasUpdate      bool      `json:"has_update"`
+	ReleaseName    string    `json:"release_name"`
+	ReleaseNotes   string    `json:"release_notes"`
+	ReleaseURL     string    `json:"release_url"`
+	PublishedAt    time.Time `json:"published_at"`
+	Asset          string    `json:"asset,omitempty"` // 当前平台的发布包，为空表示没有适用于当前平台的发布包
+	AssetSize      int64     `json:"asset_size,omitempty"`
+	Signed         bool      `json:"signed"` // 发布是否附带校验文件签名
+	Supported      bool      `json:"supported"`
+}
+
+// HealthCheck 新版本启动后的健康检查
+type HealthCheck func(ctx context.Context) error
+
+// Service 程序自更新服务
+type Service struct {
+	cfg *config.Config
+	dir string
+
+	running       sync.Mutex
+	stateMu       sync.Mutex
+	beforeRestart func()
+}
+
+// NewService 创建程序自更新服务
+func NewService(cfg *config.Config, dir string) *Service {
+	return &Service{cfg: cfg, dir: dir}
+}
+
+// SetBeforeRestart 设置重启前执行的清理函数，例如停止后台任务、保存内存中的数据
+func (s *Service) SetBeforeRestart(fn func()) {
+	s.beforeRestart = fn
+}
+
+// Supported 当前部署方式是否支持自更新
+func (s *Service) Supported() bool {
+	_, err := os.Stat("/.dockerenv")
+	return err != nil
+}
+
+// Status 返回最近一次更新的状态
+func (s *Service) Status() State {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	return loadState(s.dir)
+}
+
+func (s *Service) setState(update func(st *State)) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	st := loadState(s.dir)
+	update(&st)
+	if err := saveState(s.dir, st); err != nil {
+		log.Printf("[自更新] 保存更新状态失败: %v", err)
+	}
+}
+
+func (s *Service) feedURL() string {
+	if u := s.cfg.GetString(config.KeyUpdateFeedURL); u != "" {
+		return u
+	}
+	return DefaultFeedURL
+}
+
+// Check 检查是否有新版本
+func (s *Service) Check(ctx context.Context) (*CheckResult, error) {
+	release, err := fetchRelease(ctx, feedClient, s.feedURL())
+	if err != nil {
+		return nil, err
+	}
+	current := version.GetVersion()
+	result := &CheckResult{
+		CurrentVersion: current,
+		LatestVersion:  release.TagName,
+		HasUpdate:      version.Newer(release.TagName, current),
+		ReleaseName:    release.Name,
+		ReleaseNotes:   release.Body,
+		ReleaseURL:     release.HTMLURL,
+		PublishedAt:    release.PublishedAt,
+		Signed:         release.asset(checksumsName(release.TagName)+".sig") != nil,
+		Supported:      s.Supported(),
+	}
+	if a := release.asset(archiveName(release.TagName)); a != nil {
+		result.Asset = a.Name
+		result.AssetSize = a.Size
+	}
+	return result, nil
+}
+
+// Apply 在后台下载并安装最新版本，完成后自动重启。进度通过 Status 查询
+func (s *Service) Apply(ctx context.Context) error {
+	if !s.Supported() {
+		return ErrUnsupported
+	}
+	if !s.running.TryLock() {
+		return ErrUpdateRunning
+	}
+
+	release, err := fetchRelease(ctx, feedClient, s.feedURL())
+	if err != nil {
+		s.running.Unlock()
+		return err
+	}
+	current := version.GetVersion()
+	if !version.Newer(release.TagName, current) {
+		s.running.Unlock()
+		return ErrUpToDate
+	}
+
+	s.setState(func(st *State) {
+		*st = State{Status: StatusDownloading, FromVersion: current, ToVersion: release.TagName}
+	})
+	go func() {
+		defer s.running.Unlock()
+		if err := s.install(context.Background(), release); err != nil {
+			log.Printf("[自更新] 更新到 %s 失败: %v", release.TagName, err)
+			s.setState(func(st *State) {
+				st.Status = StatusFailed
+				st.Error = err.Error()
+			})
+		}
+	}()
+	return nil
+}
+
+// install 下载、校验并替换程序，成功时不会返回（进程被新程序替换）
+func (s *Service) install(ctx context.Context, release *Release) error {
+	archive := release.asset(archiveName(release.TagName))
+	if archive == nil {
+		return fmt.Errorf("该版本没有适用于 %s 的发布包", archiveName(release.TagName))
+	}
+	sumsAsset := release.asset(checksumsName(release.TagName))
+	if sumsAsset == nil {
+		return fmt.Errorf("该版本缺少校验文件，拒绝更新")
+	}
+
+	// 1. 校验文件及其签名
+	sums, err := s.download(ctx, sumsAsset.URL)
+	if err != nil {
+		return fmt.Errorf("下载校验文件失败: %w", err)
+	}
+	if err := s.verifyChecksums(ctx, release, sumsAsset.Name, sums); err != nil {
+		return err
+	}
+	expected := parseChecksums(sums)[archive.Name]
+	if expected == "" {
+		return fmt.Errorf("校验文件中没有 %s", archive.Name)
+	}
+
+	// 2. 下载发布包，下载过程中校验 SHA-256
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	archivePath := filepath.Join(s.dir, archive.Name)
+	f, err := os.Create(archivePath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(archivePath)
+	err = httpclient.DownloadToFile(ctx, downloadClient, archive.URL, expected, f)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("下载发布包失败: %w", err)
+	}
+	if sum, err := fileSHA256(archivePath); err != nil || sum != expected {
+		return fmt.Errorf("发布包校验失败")
+	}
+
+	// 3. 解压新程序到当前程序旁边，保证替换时在同一文件系统
+	s.setState(func(st *State) { st.Status = StatusInstalling })
+	binary, err := executablePath()
+	if err != nil {
+		return err
+	}
+	staged := binary + ".new"
+	defer os.Remove(staged)
+	if err := extractBinary(archivePath, staged); err != nil {
+		return err
+	}
+
+	// 4. 备份旧程序并替换
+	backup := binary + ".old"
+	if err := swapBinary(binary, staged, backup); err != nil {
+		return err
+	}
+	s.setState(func(st *State) {
+		st.Status = StatusPendingHealth
+		st.Binary = binary
+		st.BackupPath = backup
+		st.BootAttempts = 0
+		st.Error = ""
+	})
+	log.Printf("[自更新] 已安装 %s，正在重启", release.TagName)
+
+	// 5. 重启到新程序
+	if s.beforeRestart != nil {
+		s.beforeRestart()
+	}
+	if err := restart(binary, os.Environ()); err != nil {
+		// 新程序无法执行，立即恢复旧程序
+		st := s.Status()
+		if rbErr := rollback(s.dir, st, "重启到新版本失败: "+err.Error()); rbErr != nil {
+			log.Printf("[自更新] 回滚失败: %v", rbErr)
+		}
+		return fmt.Errorf("重启失败: %w", err)
+	}
+	return nil
+}
+
+// publicKey 校验发布签名使用的公钥，配置优先于构建时内置的项目公钥
+func (s *Service) publicKey() string {
+	if key := s.cfg.GetString(config.KeyUpdatePublicKey); key != "" {
+		return key
+	}
+	return ReleasePublicKey
+}
+
+// verifyChecksums 校验校验文件的签名。缺少签名或公钥时拒绝更新，只有显式允许未签名更新时才跳过
+func (s *Service) verifyChecksums(ctx context.Context, release *Release, sumsName string, sums []byte) error {
+	publicKey := s.publicKey()
+	sigAsset := release.asset(sumsName + ".sig")
+	if publicKey == "" || sigAsset == nil {
+		if !s.cfg.GetBool(config.KeyUpdateAllowUnsigned) {
+			if publicKey == "" {
+				return fmt.Errorf("当前程序没有内置签名公钥，无法校验更新包，拒绝更新（确认信任发布源时可设置 %s = true）", config.KeyUpdateAllowUnsigned)
+			}
+			return fmt.Errorf("该版本没有签名文件，拒绝更新（确认信任发布源时可设置 %s = true）", config.KeyUpdateAllowUnsigned)
+		}
+		log.Printf("[自更新] ⚠️ 已按 %s 配置安装未经签名校验的 %s", config.KeyUpdateAllowUnsigned, release.TagName)
+		return nil
+	}
+	sig, err := s.download(ctx, sigAsset.URL)
+	if err != nil {
+		return fmt.Errorf("下载签名文件失败: %w", err)
+	}
+	return verifySignature(publicKey, sums, sig)
+}
+
+// download 下载小文件（校验文件、签名）到内存
+func (s *Service) download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := feedClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+// Rollback 手动恢复更新前的旧版本程序并重启
+func (s *Service) Rollback() error {
+	if !s.running.TryLock() {
+		return ErrUpdateRunning
+	}
+	defer s.running.Unlock()
+
+	st := s.Status()
+	if st.BackupPath == "" || (st.Status != StatusSucceeded && st.Status != StatusPendingHealth) {
+		return ErrNoRollback
+	}
+	s.stateMu.Lock()
+	err := rollback(s.dir, st, "手动回滚")
+	s.stateMu.Unlock()
+	if err != nil {
+		return err
+	}
+	log.Printf("[自更新] 已手动回滚到 %s，正在重启", st.FromVersion)
+	go func() {
+		// 留出时间返回响应
+		time.Sleep(time.Second)
+		if s.beforeRestart != nil {
+			s.beforeRestart()
+		}
+		if err := restart(st.Binary, rollbackEnv()); err != nil {
+			log.Printf("[自更新] 回滚后重启失败，请手动重启: %v", err)
+		}
+	}()
+	return nil
+}
+
+// WatchHealth 新版本启动后在后台执行健康检查，全部失败时回滚并重启
+func (s *Service) WatchHealth(check HealthCheck) {
+	if s.Status().Status != StatusPendingHealth {
+		return
+	}
+	go func() {
+		time.Sleep(healthDelay)
+		var err error
+		for i := 0; i < healthAttempts; i++ {
+			ctx, cancel := context.WithTimeout(context.Background(), healthInterval)
+			err = check(ctx)
+			cancel()
+			if err == nil {
+				s.setState(func(st *State) {
+					st.Status = StatusSucceeded
+					st.BootAttempts = 0
+				})
+				log.Printf("[自更新] 新版本 %s 已通过健康检查", version.GetVersion())
+				return
+			}
+			log.Printf("[自更新] 健康检查失败（%d/%d）: %v", i+1, healthAttempts, err)
+			time.Sleep(healthInterval)
+		}
+
+		if s.beforeRestart != nil {
+			s.beforeRestart()
+		}
+		s.stateMu.Lock()
+		defer s.stateMu.Unlock()
+		rollbackAndRestart(s.dir, loadState(s.dir), "健康检查失败: "+err.Error())
+	}()
+}
+
+// executablePath 返回当前程序的真实路径
+func executablePath() (string, error) {
+	p, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("获取程序路径失败: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(p); err == nil {
+		p = resolved
+	}
+	return p, nil
+}
@@ -0,0 +1,191 @@
+package selfupdate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+)
+
+// 更新状态
+const (
+	StatusIdle          = "idle"
+	StatusDownloading   = "downloading"
+	StatusInstalling    = "installing"
+	StatusPendingHealth = "pending_health" // 已替换程序并重启，等待新版本通过健康检查
+	StatusSucceeded     = "succeeded"
+	StatusFailed        = "failed"
+	StatusRolledBack    = "rolled_back"
+)
+
+// maxBootAttempts 新版本在通过健康检查前最多启动的次数，超过后视为无法启动并回滚
+const maxBootAttempts = 3
+
+// State 更新进度，保存在 data/update/state.json，重启后由新进程继续跟踪
+type State struct {
+	Status       string    `json:"status"`
+	FromVersion  string    `json:"from_version,omitempty"`
+	ToVersion    string    `json:"to_version,omitempty"`
+	Binary       string    `json:"binary,omitempty"`        // 被替换的程序路径
+	BackupPath   string    `json:"backup_path,omitempty"`   // 旧版本程序的备份路径
+	BootAttempts int       `json:"boot_attempts,omitempty"` // 新版本已启动的次数
+	Error        string    `json:"error,omitempty"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func statePath(dir string) string {
+	return filepath.Join(dir, "state.json")
+}
+
+func loadState(dir string) State {
+	data, err := os.ReadFile(statePath(dir))
+	if err != nil {
+		return State{Status: StatusIdle}
+	}
+	var st State
+	if err := json.Unmarshal(data, &st); err != nil || st.Status == "" {
+		return State{Status: StatusIdle}
+	}
+	return st
+}
+
+func saveState(dir string, st State) error {
+	st.UpdatedAt = time.Now()
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	tmp := statePath(dir) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, statePath(dir))
+}
+
+// RecoverOnBoot 在程序启动的最早阶段调用。
+// 上一次更新尚未通过健康检查时记录启动次数，新版本反复启动失败（例如初始化时崩溃）
+// 超过 maxBootAttempts 次后恢复旧版本程序并重新执行
+func RecoverOnBoot(dir string) {
+	st := loadState(dir)
+	if st.Status != StatusPendingHealth {
+		return
+	}
+	st.BootAttempts++
+	if st.BootAttempts <= maxBootAttempts {
+		if err := saveState(dir, st); err != nil {
+			log.Printf("[自更新] 保存更新状态失败: %v", err)
+		}
+		return
+	}
+
+	log.Printf("[自更新] %s 已连续启动 %d 次仍未通过健康检查", st.ToVersion, st.BootAttempts-1)
+	rollbackAndRestart(dir, st, "新版本多次启动失败")
+}
+
+// AbortPendingUpdate 新版本初始化失败时调用：如果正处于更新后的健康检查阶段，立即回滚到旧版本并重启，
+// 不必等进程管理器反复拉起。没有待确认的更新时直接返回
+func AbortPendingUpdate(dir string, cause error) {
+	st := loadState(dir)
+	if st.Status != StatusPendingHealth {
+		return
+	}
+	log.Printf("[自更新] %s 启动失败: %v", st.ToVersion, cause)
+	rollbackAndRestart(dir, st, "新版本启动失败: "+cause.Error())
+}
+
+func rollbackAndRestart(dir string, st State, reason string) {
+	if err := rollback(dir, st, reason); err != nil {
+		log.Printf("[自更新] 回滚失败: %v", err)
+		return
+	}
+	log.Printf("[自更新] 已回滚到 %s，正在重启", st.FromVersion)
+	if err := restart(st.Binary, rollbackEnv()); err != nil {
+		log.Printf("[自更新] 回滚后重启失败，请手动重启: %v", err)
+	}
+}
+
+// rollbackEnv 回滚后启动旧程序使用的环境变量。新版本可能已经迁移表结构并记录了更高的程序版本，
+// 旧程序需要允许降级启动，按自身的表定义迁移回去（迁移前会先导出数据库快照）
+func rollbackEnv() []string {
+	return append(os.Environ(), config.EnvName(config.KeyDBAllowDowngrade)+"=true")
+}
+
+// rollback 用备份恢复旧版本程序并记录回滚原因
+func rollback(dir string, st State, reason string) error {
+	if st.BackupPath == "" || st.Binary == "" {
+		return fmt.Errorf("没有可用于回滚的旧版本程序")
+	}
+	if _, err := os.Stat(st.BackupPath); err != nil {
+		return fmt.Errorf("旧版本程序不存在: %w", err)
+	}
+	if err := swapBinary(st.Binary, st.BackupPath, ""); err != nil {
+		return err
+	}
+	st.Status = StatusRolledBack
+	st.Error = reason
+	st.BackupPath = ""
+	return saveState(dir, st)
+}
+
+// swapBinary 用 src 替换 target。backup 不为空时先将当前程序保存到 backup。
+// 非 Windows 系统上通过一次 rename 原子替换，运行中的进程不受影响；
+// Windows 不能覆盖正在运行的程序，只能先将其改名再放入新程序
+func swapBinary(target, src, backup string) error {
+	if runtime.GOOS == "windows" {
+		moved := backup
+		if moved == "" {
+			moved = target + ".replaced"
+		}
+		if err := os.Rename(target, moved); err != nil {
+			return fmt.Errorf("移走当前程序失败: %w", err)
+		}
+		if err := os.Rename(src, target); err != nil {
+			os.Rename(moved, target)
+			return fmt.Errorf("放置新程序失败: %w", err)
+		}
+		return nil
+	}
+
+	if backup != "" {
+		os.Remove(backup)
+		if err := os.Link(target, backup); err != nil {
+			if err := copyFile(target, backup); err != nil {
+				return fmt.Errorf("备份当前程序失败: %w", err)
+			}
+		}
+	}
+	if err := os.Rename(src, target); err != nil {
+		return fmt.Errorf("替换程序失败: %w", err)
+	}
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
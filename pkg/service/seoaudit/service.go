@@ -0,0 +1,433 @@
+/*
+ * @Description: 页面 SEO 检查
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 通过应用自身的路由（包括 SSR 代理、外部主题和内嵌主题的渲染流程）在进程内渲染指定页面，
+ * 检查标题、描述、og:image、canonical、h1 数量和图片 alt 覆盖率等常见问题，
+ * 无需借助外部爬虫即可发现主题和内容的 SEO 问题。
+ */
+package seoaudit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// 问题级别
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+)
+
+const (
+	// auditUserAgent 检查请求使用的 User-Agent，包含 bot 关键字，不会计入浏览量和访客统计
+	auditUserAgent = "AnheyuSEOAudit/1.0 (+bot)"
+	// maxPageSize 参与检查的页面最大体积
+	maxPageSize = 5 << 20
+	// maxListedImages 报告中列出的缺少 alt 的图片数量上限
+	maxListedImages = 10
+
+	titleMinLength       = 10
+	titleMaxLength       = 60
+	descriptionMinLength = 50
+	descriptionMaxLength = 160
+)
+
+var (
+	// ErrInvalidRoute 检查的路由不合法
+	ErrInvalidRoute = errors.New("路由必须以 / 开头，且不能是接口或后台地址")
+	// ErrNotReady 应用路由尚未初始化
+	ErrNotReady = errors.New("SEO 检查尚未就绪，请稍后再试")
+)
+
+// Issue 检查发现的问题
+type Issue struct {
+	Level   string `json:"level"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Report 单个页面的检查报告
+type Report struct {
+	Route            string    `json:"route"`
+	StatusCode       int       `json:"status_code"`
+	Title            string    `json:"title"`
+	Description      string    `json:"description"`
+	OgImage          string    `json:"og_image"`
+	Canonical        string    `json:"canonical"`
+	CanonicalStatus  int       `json:"canonical_status,omitempty"` // canonical 指向本站时，渲染该地址得到的状态码
+	H1Count          int       `json:"h1_count"`
+	ImageCount       int       `json:"image_count"`
+	ImagesWithAlt    int       `json:"images_with_alt"`
+	AltCoverage      float64   `json:"alt_coverage"` // 0~100
+	ImagesMissingAlt []string  `json:"images_missing_alt,omitempty"`
+	Issues           []Issue   `json:"issues"`
+	ErrorCount       int       `json:"error_count"`
+	WarningCount     int       `json:"warning_count"`
+	CheckedAt        time.Time `json:"checked_at"`
+	DurationMs       int64     `json:"duration_ms"`
+}
+
+// Service SEO 检查服务
+type Service struct {
+	settingSvc setting.SettingService
+	handler    http.Handler
+}
+
+// NewService 创建 SEO 检查服务，需要在 Gin 引擎创建后通过 SetHandler 注入
+func NewService(settingSvc setting.SettingService) *Service {
+	return &Service{settingSvc: settingSvc}
+}
+
+// SetHandler 设置用于渲染页面的 HTTP 处理器（通常为 Gin 引擎）
+func (s *Service) SetHandler(handler http.Handler) {
+	s.handler = handler
+}
+
+// Audit 渲染并检查指定路由
+func (s *Service) Audit(ctx context.Context, route string) (*Report, error) {
+	if s.handler == nil {
+		return nil, ErrNotReady
+	}
+	route = strings.TrimSpace(route)
+	if !isAuditableRoute(route) {
+		return nil, ErrInvalidRoute
+	}
+
+	start := time.Now()
+	status, body, err := s.render(ctx, route)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Route:      route,
+		StatusCode: status,
+		CheckedAt:  start,
+		Issues:     []Issue{},
+	}
+	if status != http.StatusOK {
+		report.add(LevelError, "status", fmt.Sprintf("页面返回状态码 %d", status))
+	}
+
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("解析页面 HTML 失败: %w", err)
+	}
+	page := extractPage(doc)
+
+	s.checkTitle(ctx, report, page)
+	checkDescription(report, page)
+	checkOgImage(report, page)
+	s.checkCanonical(ctx, report, page)
+	checkHeadings(report, page)
+	checkImages(report, page)
+
+	report.DurationMs = time.Since(start).Milliseconds()
+	return report, nil
+}
+
+// render 在进程内请求路由，走与真实访客相同的中间件和渲染流程
+func (s *Service) render(ctx context.Context, route string) (int, []byte, error) {
+	target := route
+	if base := s.siteURL(); base != nil {
+		target = base.Scheme + "://" + base.Host + route
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrInvalidRoute, err)
+	}
+	req.RemoteAddr = "127.0.0.1:0"
+	req.Header.Set("User-Agent", auditUserAgent)
+	req.Header.Set("Accept", "text/html")
+
+	recorder := httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+
+	body, err := io.ReadAll(io.LimitReader(recorder.Result().Body, maxPageSize))
+	if err != nil {
+		return 0, nil, err
+	}
+	return recorder.Code, body, nil
+}
+
+func (s *Service) siteURL() *url.URL {
+	raw := strings.TrimSpace(s.settingSvc.Get(constant.KeySiteURL.String()))
+	if raw == "" {
+		return nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return nil
+	}
+	if u.Scheme == "" {
+		u.Scheme = "https"
+	}
+	return u
+}
+
+func (s *Service) checkTitle(ctx context.Context, report *Report, page *pageInfo) {
+	switch len(page.titles) {
+	case 0:
+		report.add(LevelError, "title_missing", "缺少 <title> 标签")
+		return
+	case 1:
+	default:
+		report.add(LevelError, "title_duplicate", fmt.Sprintf("页面包含 %d 个 <title> 标签", len(page.titles)))
+	}
+
+	report.Title = page.titles[0]
+	length := utf8.RuneCountInString(report.Title)
+	switch {
+	case length == 0:
+		report.add(LevelError, "title_empty", "<title> 内容为空")
+		return
+	case length < titleMinLength:
+		report.add(LevelWarning, "title_short", fmt.Sprintf("标题只有 %d 个字符，建议不少于 %d 个", length, titleMinLength))
+	case length > titleMaxLength:
+		report.add(LevelWarning, "title_long", fmt.Sprintf("标题有 %d 个字符，超过 %d 个时搜索结果中会被截断", length, titleMaxLength))
+	}
+
+	// 非首页使用与首页相同的标题，通常是主题没有为该页面生成独立标题
+	if strings.TrimSuffix(report.Route, "/") != "" {
+		if status, body, err := s.render(ctx, "/"); err == nil && status == http.StatusOK {
+			if doc, err := html.Parse(bytes.NewReader(body)); err == nil {
+				if home := extractPage(doc); len(home.titles) > 0 && home.titles[0] == report.Title {
+					report.add(LevelWarning, "title_same_as_home", "标题与首页相同，建议为每个页面设置独立标题")
+				}
+			}
+		}
+	}
+}
+
+func checkDescription(report *Report, page *pageInfo) {
+	descriptions := page.meta["description"]
+	if len(descriptions) == 0 {
+		report.add(LevelError, "description_missing", "缺少 meta description")
+		return
+	}
+	if len(descriptions) > 1 {
+		report.add(LevelWarning, "description_duplicate", fmt.Sprintf("页面包含 %d 个 meta description", len(descriptions)))
+	}
+	report.Description = descriptions[0]
+	length := utf8.RuneCountInString(report.Description)
+	switch {
+	case length == 0:
+		report.add(LevelError, "description_empty", "meta description 内容为空")
+	case length < descriptionMinLength:
+		report.add(LevelWarning, "description_short", fmt.Sprintf("描述只有 %d 个字符，建议在 %d~%d 个之间", length, descriptionMinLength, descriptionMaxLength))
+	case length > descriptionMaxLength:
+		report.add(LevelWarning, "description_long", fmt.Sprintf("描述有 %d 个字符，超过 %d 个时搜索结果中会被截断", length, descriptionMaxLength))
+	}
+}
+
+func checkOgImage(report *Report, page *pageInfo) {
+	images := page.meta["og:image"]
+	if len(images) == 0 || strings.TrimSpace(images[0]) == "" {
+		report.add(LevelWarning, "og_image_missing", "缺少 og:image，分享到社交平台时不会显示预览图")
+		return
+	}
+	report.OgImage = images[0]
+	if u, err := url.Parse(report.OgImage); err != nil || !u.IsAbs() {
+		report.add(LevelWarning, "og_image_relative", "og:image 应使用完整的绝对地址")
+	}
+}
+
+func (s *Service) checkCanonical(ctx context.Context, report *Report, page *pageInfo) {
+	switch len(page.canonicals) {
+	case 0:
+		report.add(LevelWarning, "canonical_missing", "缺少 canonical 链接")
+		return
+	case 1:
+	default:
+		report.add(LevelError, "canonical_duplicate", fmt.Sprintf("页面包含 %d 个 canonical 链接", len(page.canonicals)))
+	}
+
+	report.Canonical = page.canonicals[0]
+	canonical, err := url.Parse(report.Canonical)
+	if err != nil || report.Canonical == "" {
+		report.add(LevelError, "canonical_invalid", "canonical 链接不是合法的 URL")
+		return
+	}
+	if !canonical.IsAbs() {
+		report.add(LevelWarning, "canonical_relative", "canonical 应使用完整的绝对地址")
+	}
+
+	site := s.siteURL()
+	if canonical.IsAbs() && site != nil && !strings.EqualFold(canonical.Host, site.Host) {
+		report.add(LevelWarning, "canonical_external", fmt.Sprintf("canonical 指向其他域名 %s，与站点地址 %s 不一致", canonical.Host, site.Host))
+		return
+	}
+
+	path := canonical.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if !isAuditableRoute(path) {
+		return
+	}
+	status, _, err := s.render(ctx, path)
+	if err != nil {
+		return
+	}
+	report.CanonicalStatus = status
+	if status != http.StatusOK {
+		report.add(LevelError, "canonical_broken", fmt.Sprintf("canonical 指向的页面 %s 返回状态码 %d", path, status))
+	}
+}
+
+func checkHeadings(report *Report, page *pageInfo) {
+	report.H1Count = page.h1Count
+	switch {
+	case page.h1Count == 0:
+		report.add(LevelWarning, "h1_missing", "服务端渲染的 HTML 中没有 <h1>，搜索引擎可能无法识别页面主题")
+	case page.h1Count > 1:
+		report.add(LevelWarning, "h1_multiple", fmt.Sprintf("页面包含 %d 个 <h1>，建议只保留一个", page.h1Count))
+	}
+}
+
+func checkImages(report *Report, page *pageInfo) {
+	report.ImageCount = len(page.images)
+	var missing []string
+	for _, img := range page.images {
+		if img.hasAlt {
+			report.ImagesWithAlt++
+		} else {
+			missing = append(missing, img.src)
+		}
+	}
+	if report.ImageCount == 0 {
+		report.AltCoverage = 100
+		return
+	}
+	report.AltCoverage = float64(report.ImagesWithAlt) * 100 / float64(report.ImageCount)
+	if len(missing) > 0 {
+		report.add(LevelWarning, "image_alt_missing", fmt.Sprintf("%d/%d 张图片缺少 alt 描述", len(missing), report.ImageCount))
+		if len(missing) > maxListedImages {
+			missing = missing[:maxListedImages]
+		}
+		report.ImagesMissingAlt = missing
+	}
+}
+
+func (r *Report) add(level, code, message string) {
+	r.Issues = append(r.Issues, Issue{Level: level, Code: code, Message: message})
+	if level == LevelError {
+		r.ErrorCount++
+	} else {
+		r.WarningCount++
+	}
+}
+
+// isAuditableRoute 只允许检查前台页面，避免通过该接口请求后台和 API
+func isAuditableRoute(route string) bool {
+	if !strings.HasPrefix(route, "/") || strings.HasPrefix(route, "//") {
+		return false
+	}
+	for _, prefix := range []string{"/api/", "/admin", "/needcache/", "/f/"} {
+		if strings.HasPrefix(route, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+type imageInfo struct {
+	src    string
+	hasAlt bool
+}
+
+// pageInfo 从 HTML 中提取的 SEO 相关信息
+type pageInfo struct {
+	titles     []string
+	meta       map[string][]string // name 或 property -> content
+	canonicals []string
+	h1Count    int
+	images     []imageInfo
+}
+
+func extractPage(doc *html.Node) *pageInfo {
+	page := &pageInfo{meta: make(map[string][]string)}
+	var walk func(n *html.Node, inSVG bool)
+	walk = func(n *html.Node, inSVG bool) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "svg":
+				// svg 内的 <title> 是图形标题，不是页面标题
+				inSVG = true
+			case "title":
+				if !inSVG {
+					page.titles = append(page.titles, strings.TrimSpace(textContent(n)))
+				}
+			case "meta":
+				key := strings.ToLower(attr(n, "name"))
+				if key == "" {
+					key = strings.ToLower(attr(n, "property"))
+				}
+				if key != "" {
+					page.meta[key] = append(page.meta[key], strings.TrimSpace(attr(n, "content")))
+				}
+			case "link":
+				for _, rel := range strings.Fields(strings.ToLower(attr(n, "rel"))) {
+					if rel == "canonical" {
+						page.canonicals = append(page.canonicals, strings.TrimSpace(attr(n, "href")))
+					}
+				}
+			case "h1":
+				page.h1Count++
+			case "img":
+				src := attr(n, "src")
+				if src == "" {
+					src = attr(n, "data-src")
+				}
+				// alt="" 表示装饰性图片，同样视为已处理
+				_, hasAlt := lookupAttr(n, "alt")
+				page.images = append(page.images, imageInfo{src: src, hasAlt: hasAlt || attr(n, "role") == "presentation"})
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child, inSVG)
+		}
+	}
+	walk(doc, false)
+	return page
+}
+
+func attr(n *html.Node, key string) string {
+	val, _ := lookupAttr(n, key)
+	return val
+}
+
+func lookupAttr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+func textContent(n *html.Node) string {
+	var sb strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.TextNode {
+			sb.WriteString(child.Data)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,40 @@
+package setting
+
+import "github.com/anzhiyu-c/anheyu-app/pkg/constant"
+
+// Feature 可由站点开关整体关闭的功能
+type Feature string
+
+// 站点功能
+const (
+	FeatureComments   Feature = "comments"
+	FeatureSearch     Feature = "search"
+	FeatureReactions  Feature = "reactions"
+	FeatureNewsletter Feature = "newsletter"
+)
+
+// featureKeys 功能对应的开关配置，评论和订阅沿用已有的开关，主题中原有的判断保持可用
+var featureKeys = map[Feature]constant.SettingKey{
+	FeatureComments:   constant.KeyCommentEnable,
+	FeatureSearch:     constant.KeyFeatureSearch,
+	FeatureReactions:  constant.KeyFeatureReactions,
+	FeatureNewsletter: constant.KeyPostSubscribeEnable,
+}
+
+// FeatureEnabled 功能是否开启，未登记的功能视为开启
+func FeatureEnabled(svc SettingService, f Feature) bool {
+	key, ok := featureKeys[f]
+	if !ok {
+		return true
+	}
+	return svc.GetBool(key.String())
+}
+
+// Features 返回所有功能的开关状态，供主题和站点配置接口使用
+func Features(svc SettingService) map[Feature]bool {
+	result := make(map[Feature]bool, len(featureKeys))
+	for f := range featureKeys {
+		result[f] = FeatureEnabled(svc, f)
+	}
+	return result
+}
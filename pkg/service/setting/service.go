@@ -19,8 +19,9 @@ const TopicSettingUpdated = "setting:updated"
 
 // SettingUpdatedEvent 定义了配置更新事件的数据结构
 type SettingUpdatedEvent struct {
-	Key   string
-	Value string
+	Key    string
+	Value  string
+	Remote bool // 由其他实例保存，经跨实例同步刷新到本实例
 }
 
 // SettingService 定义了配置服务的接口
@@ -31,8 +32,9 @@ type SettingService interface {
 	GetByKeys(keys []string) map[string]interface{}
 	GetSiteConfig() map[string]interface{}
 	UpdateSettings(ctx context.Context, settingsToUpdate map[string]string) error
-	RegisterPublicSettings(keys []string) // 动态注册公开配置
-	IsPublicSetting(key string) bool      // 检查配置是否为公开配置
+	RegisterPublicSettings(keys []string)          // 动态注册公开配置
+	IsPublicSetting(key string) bool               // 检查配置是否为公开配置
+	Refresh(ctx context.Context) ([]string, error) // 重新从数据库读取配置，返回发生变化的配置键（多实例同步）
 }
 
 // settingService 是 SettingService 接口的实现
@@ -111,6 +113,36 @@ func (s *settingService) UpdateSettings(ctx context.Context, settingsToUpdate ma
 	return nil
 }
 
+// Refresh 重新从数据库读取配置并更新缓存，为发生变化的配置项发布带 Remote 标记的变更事件。
+// 多实例部署时其他实例保存了配置后调用，订阅变更事件的缓存随之失效
+func (s *settingService) Refresh(ctx context.Context) ([]string, error) {
+	dbSettings, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []SettingUpdatedEvent
+	s.mu.Lock()
+	for _, dbSetting := range dbSettings {
+		if old, ok := s.cache[dbSetting.ConfigKey]; ok && old == dbSetting.Value {
+			continue
+		}
+		s.cache[dbSetting.ConfigKey] = dbSetting.Value
+		changed = append(changed, SettingUpdatedEvent{Key: dbSetting.ConfigKey, Value: dbSetting.Value, Remote: true})
+	}
+	s.mu.Unlock()
+
+	keys := make([]string, 0, len(changed))
+	for _, ev := range changed {
+		s.eventBus.Publish(event.Topic(TopicSettingUpdated), ev)
+		keys = append(keys, ev.Key)
+	}
+	if len(keys) > 0 {
+		log.Printf("已从数据库刷新 %d 个其他实例修改的站点配置项。", len(keys))
+	}
+	return keys, nil
+}
+
 // Get 根据键获取配置值
 func (s *settingService) Get(key string) string {
 	s.mu.RLock()
@@ -0,0 +1,152 @@
+/*
+ * @Description: 搜索引擎站点验证与 well-known 文件
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 根据配置在前台页面 head 中输出各搜索引擎的站点验证 meta 标签，
+ * 并提供 /.well-known/security.txt、IndexNow 密钥文件以及自定义的验证文件，
+ * 站长无需再手动把这些内容写进自定义头部 HTML 或上传到静态目录。
+ */
+package siteverify
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"log"
+	"mime"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// SecurityTxtPath security.txt 的固定路径（RFC 9116）
+const SecurityTxtPath = "/.well-known/security.txt"
+
+// wellKnownPrefix 允许自定义文件使用的 well-known 目录
+const wellKnownPrefix = "/.well-known/"
+
+// verifyMetas 配置项与对应的验证 meta 名称，按输出顺序排列
+var verifyMetas = []struct {
+	key  constant.SettingKey
+	name string
+}{
+	{constant.KeySiteVerifyGoogle, "google-site-verification"},
+	{constant.KeySiteVerifyBing, "msvalidate.01"},
+	{constant.KeySiteVerifyBaidu, "baidu-site-verification"},
+	{constant.KeySiteVerifyYandex, "yandex-verification"},
+}
+
+var (
+	// metaContentRegex 从粘贴的整个 meta 标签中提取 content 属性
+	metaContentRegex = regexp.MustCompile(`(?i)content\s*=\s*["']([^"']*)["']`)
+	// indexNowKeyRegex IndexNow 规定密钥为 8~128 位的字母、数字或短横线
+	indexNowKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9-]{8,128}$`)
+)
+
+// 自定义文件配置的解析结果，配置未变化时复用
+var (
+	filesMu     sync.Mutex
+	filesRaw    string
+	filesParsed map[string]string
+)
+
+// MetaHTML 返回需要注入到页面 head 中的站点验证 meta 标签，未配置时返回空字符串
+func MetaHTML(settingSvc setting.SettingService) string {
+	var buf strings.Builder
+	for _, item := range verifyMetas {
+		code := verificationCode(settingSvc.Get(item.key.String()))
+		if code == "" {
+			continue
+		}
+		fmt.Fprintf(&buf, "<meta name=\"%s\" content=\"%s\">\n", item.name, html.EscapeString(code))
+	}
+	return buf.String()
+}
+
+// verificationCode 取出验证码，兼容直接粘贴搜索引擎给出的整个 meta 标签
+func verificationCode(value string) string {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "<") {
+		if m := metaContentRegex.FindStringSubmatch(value); m != nil {
+			return strings.TrimSpace(m[1])
+		}
+		return ""
+	}
+	return value
+}
+
+// Lookup 返回请求路径对应的验证文件内容和 Content-Type，路径没有对应文件时 ok 为 false
+func Lookup(settingSvc setting.SettingService, reqPath string) (content, contentType string, ok bool) {
+	if !ValidFilePath(reqPath) {
+		return "", "", false
+	}
+	if reqPath == SecurityTxtPath {
+		if text := strings.TrimSpace(settingSvc.Get(constant.KeySiteVerifySecurityTxt.String())); text != "" {
+			return text + "\n", "text/plain; charset=utf-8", true
+		}
+	}
+	if key := strings.TrimSpace(settingSvc.Get(constant.KeySiteVerifyIndexNowKey.String())); key != "" && indexNowKeyRegex.MatchString(key) {
+		if reqPath == "/"+key+".txt" {
+			return key, "text/plain; charset=utf-8", true
+		}
+	}
+	if text, found := loadFiles(settingSvc)[reqPath]; found {
+		return text, fileContentType(reqPath), true
+	}
+	return "", "", false
+}
+
+// ValidFilePath 判断路径能否作为验证文件：根目录下的单个文件或 /.well-known/ 下的文件，不允许 .. 等跳转
+func ValidFilePath(p string) bool {
+	if !strings.HasPrefix(p, "/") || p == "/" || strings.HasSuffix(p, "/") || path.Clean(p) != p {
+		return false
+	}
+	if rest, isWellKnown := strings.CutPrefix(p, wellKnownPrefix); isWellKnown {
+		return rest != ""
+	}
+	return !strings.Contains(p[1:], "/")
+}
+
+// loadFiles 解析自定义验证文件配置，忽略不合法的路径
+func loadFiles(settingSvc setting.SettingService) map[string]string {
+	raw := strings.TrimSpace(settingSvc.Get(constant.KeySiteVerifyFiles.String()))
+
+	filesMu.Lock()
+	defer filesMu.Unlock()
+	if filesParsed != nil && raw == filesRaw {
+		return filesParsed
+	}
+	files := make(map[string]string)
+	if raw != "" {
+		var items map[string]string
+		if err := json.Unmarshal([]byte(raw), &items); err != nil {
+			log.Printf("[站点验证] 解析验证文件配置失败: %v", err)
+		}
+		for p, text := range items {
+			p = strings.TrimSpace(p)
+			if !strings.HasPrefix(p, "/") {
+				p = "/" + p
+			}
+			if !ValidFilePath(p) {
+				log.Printf("[站点验证] 忽略不合法的验证文件路径: %s", p)
+				continue
+			}
+			files[p] = text
+		}
+	}
+	filesRaw, filesParsed = raw, files
+	return files
+}
+
+// fileContentType 按扩展名推断 Content-Type，未知扩展名按纯文本返回
+func fileContentType(p string) string {
+	if ct := mime.TypeByExtension(path.Ext(p)); ct != "" {
+		return ct
+	}
+	return "text/plain; charset=utf-8"
+}
@@ -0,0 +1,427 @@
+/*
+ * @Description: 自定义 CSS/JS 代码片段管理
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 在 CUSTOM_HEADER_HTML 之外提供多个具名代码片段，每个片段可单独启停、限定生效路由、
+ * 选择注入位置（head 或 body 末尾），每次修改都会保留历史版本，可随时回滚。
+ * 片段保存在 snippet.items 配置项中，历史版本保存在 snippet.history 中。
+ */
+package snippet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// 代码片段类型
+const (
+	TypeCSS = "css"
+	TypeJS  = "js"
+)
+
+// 代码片段注入位置
+const (
+	PositionHead    = "head"
+	PositionBodyEnd = "body_end"
+)
+
+const (
+	// maxHistoryVersions 每个片段保留的历史版本数
+	maxHistoryVersions = 20
+	// maxSnippetSize 单个片段内容的最大长度
+	maxSnippetSize = 256 * 1024
+)
+
+var (
+	// ErrSnippetNotFound 代码片段不存在
+	ErrSnippetNotFound = errors.New("代码片段不存在")
+	// ErrVersionNotFound 历史版本不存在
+	ErrVersionNotFound = errors.New("历史版本不存在")
+	// ErrInvalidSnippet 代码片段内容不合法
+	ErrInvalidSnippet = errors.New("代码片段不合法")
+)
+
+// Snippet 代码片段
+type Snippet struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Content   string    `json:"content"`
+	Enabled   bool      `json:"enabled"`
+	Position  string    `json:"position"`
+	Routes    []string  `json:"routes"` // 生效路由，以 * 结尾表示前缀匹配，为空表示全站
+	Sort      int       `json:"sort"`   // 同一位置内按 sort 升序注入
+	Version   int       `json:"version"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SnippetRequest 创建或修改代码片段的请求
+type SnippetRequest struct {
+	Name     string   `json:"name" binding:"required"`
+	Type     string   `json:"type" binding:"required"`
+	Content  string   `json:"content"`
+	Enabled  *bool    `json:"enabled"`
+	Position string   `json:"position"`
+	Routes   []string `json:"routes"`
+	Sort     int      `json:"sort"`
+}
+
+// Service 代码片段服务
+type Service struct {
+	settingSvc setting.SettingService
+	mu         sync.Mutex
+}
+
+// NewService 创建代码片段服务
+func NewService(settingSvc setting.SettingService) *Service {
+	return &Service{settingSvc: settingSvc}
+}
+
+// List 返回全部代码片段，按排序号排列
+func (s *Service) List() []Snippet {
+	return LoadSnippets(s.settingSvc)
+}
+
+// Get 获取单个代码片段
+func (s *Service) Get(id string) (*Snippet, error) {
+	for _, item := range s.List() {
+		if item.ID == id {
+			return &item, nil
+		}
+	}
+	return nil, ErrSnippetNotFound
+}
+
+// Create 创建代码片段
+func (s *Service) Create(ctx context.Context, req *SnippetRequest) (*Snippet, error) {
+	item := Snippet{ID: uuid.NewString(), Enabled: true}
+	if err := applyRequest(&item, req); err != nil {
+		return nil, err
+	}
+	item.Version = 1
+	item.UpdatedAt = time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.List()
+	items = append(items, item)
+	if err := s.saveSnippets(ctx, items); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// Update 修改代码片段，修改前的内容存入历史版本
+func (s *Service) Update(ctx context.Context, id string, req *SnippetRequest) (*Snippet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.List()
+	idx := indexOf(items, id)
+	if idx < 0 {
+		return nil, ErrSnippetNotFound
+	}
+
+	updated := items[idx]
+	if err := applyRequest(&updated, req); err != nil {
+		return nil, err
+	}
+	return s.replace(ctx, items, idx, updated)
+}
+
+// SetEnabled 启用或停用代码片段，只修改开关时不产生历史版本
+func (s *Service) SetEnabled(ctx context.Context, id string, enabled bool) (*Snippet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.List()
+	idx := indexOf(items, id)
+	if idx < 0 {
+		return nil, ErrSnippetNotFound
+	}
+	items[idx].Enabled = enabled
+	items[idx].UpdatedAt = time.Now()
+	if err := s.saveSnippets(ctx, items); err != nil {
+		return nil, err
+	}
+	return &items[idx], nil
+}
+
+// Delete 删除代码片段及其历史版本
+func (s *Service) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.List()
+	idx := indexOf(items, id)
+	if idx < 0 {
+		return ErrSnippetNotFound
+	}
+	items = append(items[:idx], items[idx+1:]...)
+	if err := s.saveSnippets(ctx, items); err != nil {
+		return err
+	}
+
+	history := s.loadHistory()
+	if _, ok := history[id]; ok {
+		delete(history, id)
+		if err := s.saveHistory(ctx, history); err != nil {
+			log.Printf("[代码片段] 删除片段 %s 的历史版本失败: %v", id, err)
+		}
+	}
+	return nil
+}
+
+// History 返回代码片段的历史版本，版本号从新到旧
+func (s *Service) History(id string) ([]Snippet, error) {
+	if _, err := s.Get(id); err != nil {
+		return nil, err
+	}
+	versions := s.loadHistory()[id]
+	result := make([]Snippet, len(versions))
+	for i, v := range versions {
+		result[len(versions)-1-i] = v
+	}
+	return result, nil
+}
+
+// Rollback 将代码片段恢复到指定历史版本，恢复本身也会生成一个新版本
+func (s *Service) Rollback(ctx context.Context, id string, version int) (*Snippet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.List()
+	idx := indexOf(items, id)
+	if idx < 0 {
+		return nil, ErrSnippetNotFound
+	}
+
+	var target *Snippet
+	for _, v := range s.loadHistory()[id] {
+		if v.Version == version {
+			target = &v
+			break
+		}
+	}
+	if target == nil {
+		return nil, ErrVersionNotFound
+	}
+
+	restored := *target
+	restored.Enabled = items[idx].Enabled
+	return s.replace(ctx, items, idx, restored)
+}
+
+// replace 用新内容替换片段，旧内容写入历史版本；调用方需持有锁
+func (s *Service) replace(ctx context.Context, items []Snippet, idx int, updated Snippet) (*Snippet, error) {
+	previous := items[idx]
+	updated.ID = previous.ID
+	updated.Version = previous.Version + 1
+	updated.UpdatedAt = time.Now()
+	items[idx] = updated
+
+	history := s.loadHistory()
+	versions := append(history[previous.ID], previous)
+	if len(versions) > maxHistoryVersions {
+		versions = versions[len(versions)-maxHistoryVersions:]
+	}
+	history[previous.ID] = versions
+	if err := s.saveHistory(ctx, history); err != nil {
+		return nil, err
+	}
+	if err := s.saveSnippets(ctx, items); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (s *Service) saveSnippets(ctx context.Context, items []Snippet) error {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	if err := s.settingSvc.UpdateSettings(ctx, map[string]string{constant.KeySnippets.String(): string(data)}); err != nil {
+		return fmt.Errorf("保存代码片段失败: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) loadHistory() map[string][]Snippet {
+	history := make(map[string][]Snippet)
+	raw := s.settingSvc.Get(constant.KeySnippetHistory.String())
+	if raw == "" {
+		return history
+	}
+	if err := json.Unmarshal([]byte(raw), &history); err != nil {
+		log.Printf("[代码片段] 解析历史版本失败: %v", err)
+		return make(map[string][]Snippet)
+	}
+	return history
+}
+
+func (s *Service) saveHistory(ctx context.Context, history map[string][]Snippet) error {
+	data, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+	if err := s.settingSvc.UpdateSettings(ctx, map[string]string{constant.KeySnippetHistory.String(): string(data)}); err != nil {
+		return fmt.Errorf("保存代码片段历史版本失败: %w", err)
+	}
+	return nil
+}
+
+// applyRequest 校验请求并写入片段
+func applyRequest(item *Snippet, req *SnippetRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return fmt.Errorf("%w: 名称不能为空", ErrInvalidSnippet)
+	}
+	if req.Type != TypeCSS && req.Type != TypeJS {
+		return fmt.Errorf("%w: 不支持的类型 %s", ErrInvalidSnippet, req.Type)
+	}
+	position := req.Position
+	if position == "" {
+		position = PositionHead
+	}
+	if position != PositionHead && position != PositionBodyEnd {
+		return fmt.Errorf("%w: 不支持的注入位置 %s", ErrInvalidSnippet, req.Position)
+	}
+	if len(req.Content) > maxSnippetSize {
+		return fmt.Errorf("%w: 内容不能超过 %d KB", ErrInvalidSnippet, maxSnippetSize/1024)
+	}
+
+	routes := make([]string, 0, len(req.Routes))
+	for _, route := range req.Routes {
+		route = strings.TrimSpace(route)
+		if route == "" {
+			continue
+		}
+		if !strings.HasPrefix(route, "/") {
+			return fmt.Errorf("%w: 路由 %q 必须以 / 开头", ErrInvalidSnippet, route)
+		}
+		if strings.Contains(strings.TrimSuffix(route, "*"), "*") {
+			return fmt.Errorf("%w: 路由 %q 只支持在末尾使用 *", ErrInvalidSnippet, route)
+		}
+		routes = append(routes, route)
+	}
+
+	item.Name = name
+	item.Type = req.Type
+	item.Content = req.Content
+	item.Position = position
+	item.Routes = routes
+	item.Sort = req.Sort
+	if req.Enabled != nil {
+		item.Enabled = *req.Enabled
+	}
+	return nil
+}
+
+func indexOf(items []Snippet, id string) int {
+	for i, item := range items {
+		if item.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// LoadSnippets 从配置中读取代码片段，配置为空或格式错误时返回空列表
+func LoadSnippets(settingSvc setting.SettingService) []Snippet {
+	raw := settingSvc.Get(constant.KeySnippets.String())
+	if raw == "" {
+		return []Snippet{}
+	}
+	var items []Snippet
+	if err := json.Unmarshal([]byte(raw), &items); err != nil {
+		log.Printf("[代码片段] 解析代码片段配置失败: %v", err)
+		return []Snippet{}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Sort < items[j].Sort })
+	return items
+}
+
+// MatchRoute 判断片段是否在指定路径生效
+func (item *Snippet) MatchRoute(path string) bool {
+	if len(item.Routes) == 0 {
+		return true
+	}
+	for _, route := range item.Routes {
+		if prefix, ok := strings.CutSuffix(route, "*"); ok {
+			// /posts/* 同时匹配 /posts 本身
+			if strings.HasPrefix(path, prefix) || path == strings.TrimSuffix(prefix, "/") {
+				return true
+			}
+			continue
+		}
+		if path == route || path == strings.TrimSuffix(route, "/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Render 返回指定路径需要注入到 head 和 body 末尾的 HTML
+func Render(settingSvc setting.SettingService, path string) (head, bodyEnd string) {
+	var headBuf, bodyBuf strings.Builder
+	for _, item := range LoadSnippets(settingSvc) {
+		if !item.Enabled || strings.TrimSpace(item.Content) == "" || !item.MatchRoute(path) {
+			continue
+		}
+		buf := &headBuf
+		if item.Position == PositionBodyEnd {
+			buf = &bodyBuf
+		}
+		buf.WriteString(item.tag())
+	}
+	return headBuf.String(), bodyBuf.String()
+}
+
+// tag 将片段包装为 style 或 script 标签
+func (item *Snippet) tag() string {
+	name := html.EscapeString(item.Name)
+	if item.Type == TypeCSS {
+		return fmt.Sprintf("<style data-snippet=\"%s\">\n%s\n</style>\n", name, item.Content)
+	}
+	return fmt.Sprintf("<script data-snippet=\"%s\">\n%s\n</script>\n", name, item.Content)
+}
+
+// InjectHTML 将片段插入完整的 HTML 文档，head 片段放在 </head> 前，body 片段放在 </body> 前
+func InjectHTML(document, head, bodyEnd string) string {
+	if head != "" {
+		if idx := lastIndexFold(document, "</head>"); idx >= 0 {
+			document = document[:idx] + head + document[idx:]
+		}
+	}
+	if bodyEnd != "" {
+		if idx := lastIndexFold(document, "</body>"); idx >= 0 {
+			document = document[:idx] + bodyEnd + document[idx:]
+		} else {
+			document += bodyEnd
+		}
+	}
+	return document
+}
+
+// lastIndexFold 查找小写或大写形式的结束标签
+func lastIndexFold(s, tag string) int {
+	if idx := strings.LastIndex(s, tag); idx >= 0 {
+		return idx
+	}
+	return strings.LastIndex(s, strings.ToUpper(tag))
+}
@@ -0,0 +1,175 @@
+/*
+ * @Description: SQLite 在线备份，不停止服务生成数据库的一致快照
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 备份通过 SQLite 在线备份接口完成，得到的是单个可直接替换使用的 .db 文件，
+ * 保存在 data/backups/sqlite 下，只保留最近的若干份。仅在使用 SQLite 时可用。
+ */
+package sqlitebackup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/persistence/database"
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+)
+
+const (
+	// DefaultBackupDir SQLite 在线备份的本地保存目录
+	DefaultBackupDir = "data/backups/sqlite"
+
+	filePrefix = "sqlite-"
+	fileExt    = ".db"
+	// keepBackups 保留的备份数量
+	keepBackups = 10
+)
+
+var (
+	ErrNotSQLite      = errors.New("当前数据库不是 SQLite，无法使用在线备份")
+	ErrBackupRunning  = errors.New("已有 SQLite 备份正在进行")
+	ErrBackupNotFound = errors.New("备份文件不存在")
+)
+
+// BackupInfo 备份文件信息
+type BackupInfo struct {
+	Filename   string    `json:"filename"`
+	Size       int64     `json:"size"`
+	CreatedAt  time.Time `json:"created_at"`
+	DurationMs int64     `json:"duration_ms,omitempty"` // 备份耗时，仅创建时返回
+}
+
+// Service SQLite 在线备份服务
+type Service struct {
+	cfg *config.Config
+	db  *sql.DB
+	dir string
+	mu  sync.Mutex
+}
+
+// NewService 创建 SQLite 在线备份服务
+func NewService(cfg *config.Config, db *sql.DB, dir string) *Service {
+	return &Service{cfg: cfg, db: db, dir: dir}
+}
+
+// Available 当前是否使用 SQLite
+func (s *Service) Available() bool {
+	return database.NormalizeDBType(s.cfg.GetString(config.KeyDBType)) == "sqlite"
+}
+
+// Create 生成一份数据库快照，先写入临时文件，完成后再改名，避免列表中出现不完整的备份
+func (s *Service) Create(ctx context.Context) (*BackupInfo, error) {
+	if !s.Available() {
+		return nil, ErrNotSQLite
+	}
+	if !s.mu.TryLock() {
+		return nil, ErrBackupRunning
+	}
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return nil, fmt.Errorf("创建备份目录失败: %w", err)
+	}
+	filename := filePrefix + time.Now().Format("20060102-150405") + fileExt
+	target := filepath.Join(s.dir, filename)
+	staged := target + ".tmp"
+	defer os.Remove(staged)
+
+	start := time.Now()
+	if err := database.BackupSQLite(ctx, s.db, staged); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(staged, target); err != nil {
+		return nil, fmt.Errorf("保存备份文件失败: %w", err)
+	}
+	info, err := s.stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	info.DurationMs = time.Since(start).Milliseconds()
+	log.Printf("[SQLite 备份] 已生成 %s（%d 字节，耗时 %dms）", filename, info.Size, info.DurationMs)
+
+	s.cleanup(keepBackups)
+	return info, nil
+}
+
+// List 列出本地备份，按创建时间从新到旧排序
+func (s *Service) List() ([]*BackupInfo, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*BackupInfo{}, nil
+		}
+		return nil, err
+	}
+
+	backups := make([]*BackupInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !isBackupName(entry.Name()) {
+			continue
+		}
+		if info, err := s.stat(entry.Name()); err == nil {
+			backups = append(backups, info)
+		}
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Filename > backups[j].Filename
+	})
+	return backups, nil
+}
+
+// Path 返回备份文件的完整路径，文件名不合法或不存在时返回 ErrBackupNotFound
+func (s *Service) Path(filename string) (string, error) {
+	if filepath.Base(filename) != filename || !isBackupName(filename) {
+		return "", ErrBackupNotFound
+	}
+	p := filepath.Join(s.dir, filename)
+	if _, err := os.Stat(p); err != nil {
+		return "", ErrBackupNotFound
+	}
+	return p, nil
+}
+
+// Delete 删除备份
+func (s *Service) Delete(filename string) error {
+	p, err := s.Path(filename)
+	if err != nil {
+		return err
+	}
+	return os.Remove(p)
+}
+
+// cleanup 只保留最新的 keep 个备份
+func (s *Service) cleanup(keep int) {
+	backups, err := s.List()
+	if err != nil || len(backups) <= keep {
+		return
+	}
+	for _, b := range backups[keep:] {
+		if err := os.Remove(filepath.Join(s.dir, b.Filename)); err != nil {
+			log.Printf("[SQLite 备份] 删除旧备份 %s 失败: %v", b.Filename, err)
+		}
+	}
+}
+
+func (s *Service) stat(filename string) (*BackupInfo, error) {
+	fi, err := os.Stat(filepath.Join(s.dir, filename))
+	if err != nil {
+		return nil, err
+	}
+	return &BackupInfo{Filename: filename, Size: fi.Size(), CreatedAt: fi.ModTime()}, nil
+}
+
+func isBackupName(name string) bool {
+	return strings.HasPrefix(name, filePrefix) && strings.HasSuffix(name, fileExt)
+}
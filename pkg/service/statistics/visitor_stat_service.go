@@ -22,6 +22,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	"github.com/gin-gonic/gin"
 )
@@ -78,6 +79,9 @@ type VisitorStatService interface {
 
 	// 获取访客访问日志（时间范围）
 	GetVisitorLogs(ctx context.Context, startDate, endDate time.Time) ([]*ent.VisitorLog, error)
+
+	// 清理过期的 User-Agent 缓存和请求去重记录，由定时任务周期调用
+	CleanupExpiredCaches()
 }
 
 type visitorStatService struct {
@@ -130,9 +134,6 @@ func NewVisitorStatService(
 	// 启动worker池处理访问任务
 	go svc.startWorkerPool()
 
-	// 启动定期清理缓存的任务
-	go svc.cleanupCaches()
-
 	return svc, nil
 }
 
@@ -326,34 +327,29 @@ func (s *visitorStatService) processVisitTask(task *visitTask) {
 	}
 }
 
-// cleanupCaches 定期清理过期缓存
-func (s *visitorStatService) cleanupCaches() {
-	ticker := time.NewTicker(30 * time.Minute) // 每30分钟清理一次
-	defer ticker.Stop()
-
-	for range ticker.C {
-		now := time.Now()
+// CleanupExpiredCaches 清理过期缓存
+func (s *visitorStatService) CleanupExpiredCaches() {
+	now := time.Now()
 
-		// 清理User-Agent缓存
-		s.userAgentCache.Range(func(key, value interface{}) bool {
-			if cache, ok := value.(*userAgentCache); ok {
-				if now.Sub(cache.timestamp) > UACacheExpire {
-					s.userAgentCache.Delete(key)
-				}
+	// 清理User-Agent缓存
+	s.userAgentCache.Range(func(key, value interface{}) bool {
+		if cache, ok := value.(*userAgentCache); ok {
+			if now.Sub(cache.timestamp) > UACacheExpire {
+				s.userAgentCache.Delete(key)
 			}
-			return true
-		})
+		}
+		return true
+	})
 
-		// 清理请求去重Map
-		s.requestDedup.Range(func(key, value interface{}) bool {
-			if timestamp, ok := value.(time.Time); ok {
-				if now.Sub(timestamp) > DedupExpire {
-					s.requestDedup.Delete(key)
-				}
+	// 清理请求去重Map
+	s.requestDedup.Range(func(key, value interface{}) bool {
+		if timestamp, ok := value.(time.Time); ok {
+			if now.Sub(timestamp) > DedupExpire {
+				s.requestDedup.Delete(key)
 			}
-			return true
-		})
-	}
+		}
+		return true
+	})
 }
 
 // parseUserAgentCached 解析User-Agent（带缓存）
@@ -1027,26 +1023,9 @@ func (s *visitorStatService) updateDatabaseStats(ctx context.Context, stats *mod
 	return nil
 }
 
-// 获取客户端真实IP
+// 获取客户端真实IP，仅信任来自可信代理的代理头
 func (s *visitorStatService) getClientIP(c *gin.Context) string {
-	// 检查代理头
-	if ip := c.GetHeader("X-Forwarded-For"); ip != "" {
-		// X-Forwarded-For 可能包含多个IP，取第一个
-		if ips := strings.Split(ip, ","); len(ips) > 0 {
-			return strings.TrimSpace(ips[0])
-		}
-	}
-
-	if ip := c.GetHeader("X-Real-IP"); ip != "" {
-		return ip
-	}
-
-	if ip := c.GetHeader("X-Original-Forwarded-For"); ip != "" {
-		return ip
-	}
-
-	// 返回默认IP
-	return c.ClientIP()
+	return util.GetRealClientIP(c)
 }
 
 // 生成访客ID
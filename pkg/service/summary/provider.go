@@ -0,0 +1,198 @@
+package summary
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+)
+
+const (
+	defaultOpenAIEndpoint = "https://api.openai.com/v1"
+	defaultOpenAIModel    = "gpt-4o-mini"
+	// defaultLocalEndpoint Ollama 默认提供的 OpenAI 兼容接口
+	defaultLocalEndpoint = "http://127.0.0.1:11434/v1"
+	// maxResponseSize 模型响应的最大字节数
+	maxResponseSize = 1 << 20
+)
+
+// 模型接口较慢，不重试以免重复计费
+var chatHTTPClient = httpclient.New(httpclient.Options{
+	Name:     "summary",
+	Timeout:  90 * time.Second,
+	Identify: true,
+})
+
+// chatClient OpenAI 兼容的 /chat/completions 接口
+type chatClient struct {
+	endpoint string
+	apiKey   string
+	model    string
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Temperature float64       `json:"temperature"`
+	MaxTokens   int           `json:"max_tokens"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (c *chatClient) summarize(ctx context.Context, title, text string, maxLength int) (string, error) {
+	if c.model == "" {
+		return "", fmt.Errorf("未配置摘要模型名称")
+	}
+	payload, err := json.Marshal(chatRequest{
+		Model: c.model,
+		Messages: []chatMessage{
+			{Role: "system", Content: fmt.Sprintf("你是博客编辑，请为用户提供的文章写一段摘要，用于搜索引擎描述和文章列表简介。要求：使用文章的语言；不超过 %d 个字；只输出摘要正文，不要标题、引号或“本文”之类的开头。", maxLength)},
+			{Role: "user", Content: "标题：" + title + "\n\n正文：\n" + text},
+		},
+		Temperature: 0.3,
+		// 中文约 1 字 1~2 个 token，留出余量
+		MaxTokens: maxLength*2 + 64,
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(c.endpoint, "/")+"/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("摘要接口地址无效: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := chatHTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("请求摘要接口失败: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return "", fmt.Errorf("读取摘要接口响应失败: %w", err)
+	}
+
+	var result chatResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("摘要接口返回 HTTP %d", resp.StatusCode)
+		}
+		return "", fmt.Errorf("解析摘要接口响应失败: %w", err)
+	}
+	if result.Error != nil && result.Error.Message != "" {
+		return "", fmt.Errorf("摘要接口返回错误 (HTTP %d): %s", resp.StatusCode, result.Error.Message)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("摘要接口返回 HTTP %d", resp.StatusCode)
+	}
+	if len(result.Choices) == 0 {
+		return "", fmt.Errorf("摘要接口未返回内容")
+	}
+	summary := strings.TrimSpace(strings.Trim(strings.TrimSpace(result.Choices[0].Message.Content), "\"“”"))
+	if summary == "" {
+		return "", fmt.Errorf("摘要接口返回了空摘要")
+	}
+	return summary, nil
+}
+
+const (
+	// minSentenceLength 抽取式摘要跳过短于该字数的句子（多为图片说明）
+	minSentenceLength = 8
+	// headingMaxLength 不以标点结尾且短于该字数的行视为标题，不计入抽取式摘要
+	headingMaxLength = 30
+)
+
+// Extract 抽取式摘要：按顺序选取正文开头的完整句子，直到接近 maxLength；
+// 第一句就超出长度时截断并加省略号
+func Extract(text string, maxLength int) string {
+	var b strings.Builder
+	length := 0
+	for _, sentence := range splitSentences(text) {
+		n := utf8.RuneCountInString(sentence)
+		if n < minSentenceLength {
+			continue
+		}
+		if length+n > maxLength {
+			if length == 0 {
+				return truncateRunes(sentence, maxLength-1) + "…"
+			}
+			break
+		}
+		b.WriteString(sentence)
+		length += n
+	}
+	if b.Len() == 0 {
+		text = strings.ReplaceAll(text, "\n", " ")
+		if utf8.RuneCountInString(text) > maxLength {
+			return truncateRunes(text, maxLength-1) + "…"
+		}
+		return text
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// splitSentences 按中英文句末标点和换行切分句子，标点保留在句尾
+func splitSentences(text string) []string {
+	var sentences []string
+	var current strings.Builder
+	runes := []rune(text)
+	for i, r := range runes {
+		if r == '\n' {
+			s := strings.TrimSpace(current.String())
+			if s != "" && (utf8.RuneCountInString(s) >= headingMaxLength || strings.ContainsRune("。！？；!?;.…", runes[i-1])) {
+				sentences = append(sentences, s+sentenceGap(runes[i-1]))
+			}
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+		end := false
+		switch r {
+		case '。', '！', '？', '；', '!', '?', ';':
+			end = true
+		case '.':
+			// 英文句号后需跟空白，避免切开小数和网址
+			end = i+1 == len(runes) || runes[i+1] == ' '
+		}
+		if end {
+			if s := strings.TrimSpace(current.String()); s != "" {
+				sentences = append(sentences, s+sentenceGap(r))
+			}
+			current.Reset()
+		}
+	}
+	if s := strings.TrimSpace(current.String()); s != "" {
+		sentences = append(sentences, s)
+	}
+	return sentences
+}
+
+// sentenceGap 英文句子之间保留空格
+func sentenceGap(r rune) string {
+	if r < utf8.RuneSelf {
+		return " "
+	}
+	return ""
+}
@@ -0,0 +1,209 @@
+/*
+ * @Description: 文章摘要自动生成服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 文章摘要用作 SEO 描述和列表简介，未填写时可由本服务生成，生成方式由 summary.provider 决定：
+ *   openai      调用 OpenAI 兼容的 /chat/completions 接口；
+ *   local       调用本地模型（Ollama、LM Studio 等提供的 OpenAI 兼容接口），无需 API Key；
+ *   extractive  从正文开头抽取完整句子，不调用任何外部服务。
+ * 调用模型前正文按 summary.max_input_chars 截断，每小时调用次数受 summary.hourly_limit 限制；
+ * 调用失败或超出限制时，如开启了 summary.fallback，改用抽取式摘要。
+ */
+package summary
+
+import (
+	"context"
+	"errors"
+	"html"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/parser"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// 摘要生成方式
+const (
+	ProviderNone       = "none"
+	ProviderOpenAI     = "openai"
+	ProviderLocal      = "local"
+	ProviderExtractive = "extractive"
+)
+
+const (
+	defaultMaxLength     = 150
+	defaultMaxInputChars = 6000
+	defaultHourlyLimit   = 30
+)
+
+var (
+	// ErrDisabled 未启用摘要生成
+	ErrDisabled = errors.New("未启用自动摘要，请先在设置中选择摘要生成方式")
+	// ErrEmptyContent 正文为空，无法生成摘要
+	ErrEmptyContent = errors.New("文章正文为空，无法生成摘要")
+	// ErrRateLimited 超出每小时调用次数限制
+	ErrRateLimited = errors.New("已达到每小时摘要生成次数上限，请稍后再试")
+)
+
+var (
+	// codeBlockPattern 代码块不参与摘要
+	codeBlockPattern = regexp.MustCompile(`(?is)<pre[^>]*>.*?</pre>`)
+	// blockEndPattern 块级元素结束处换行，避免标题与段落首句连在一起
+	blockEndPattern = regexp.MustCompile(`(?i)</(p|h[1-6]|li|div|blockquote|tr|figcaption)>|<br\s*/?>`)
+	spacePattern    = regexp.MustCompile(`[ \t\r\f\v]+`)
+	newlinePattern  = regexp.MustCompile(`\s*\n\s*`)
+)
+
+// Result 摘要生成结果
+type Result struct {
+	Summary  string `json:"summary"`
+	Provider string `json:"provider"` // 实际使用的生成方式
+	Fallback bool   `json:"fallback"` // 是否因模型调用失败或超出限制改用了抽取式摘要
+	Reason   string `json:"reason,omitempty"`
+}
+
+// Service 摘要生成服务
+type Service struct {
+	settingSvc setting.SettingService
+
+	mu    sync.Mutex
+	calls []time.Time // 最近一小时内调用模型的时间
+}
+
+// NewService 创建摘要生成服务
+func NewService(settingSvc setting.SettingService) *Service {
+	return &Service{settingSvc: settingSvc}
+}
+
+// Provider 当前配置的摘要生成方式，未知的值视为关闭
+func (s *Service) Provider() string {
+	switch p := strings.ToLower(strings.TrimSpace(s.settingSvc.Get(constant.KeySummaryProvider.String()))); p {
+	case ProviderOpenAI, ProviderLocal, ProviderExtractive:
+		return p
+	default:
+		return ProviderNone
+	}
+}
+
+// Enabled 是否启用了摘要生成
+func (s *Service) Enabled() bool {
+	return s != nil && s.Provider() != ProviderNone
+}
+
+// OnSave 保存未填写摘要的文章时是否自动生成
+func (s *Service) OnSave() bool {
+	return s.Enabled() && s.settingSvc.GetBool(constant.KeySummaryOnSave.String())
+}
+
+// Summarize 根据标题和正文 HTML 生成摘要
+func (s *Service) Summarize(ctx context.Context, title, contentHTML string) (*Result, error) {
+	provider := s.Provider()
+	if provider == ProviderNone {
+		return nil, ErrDisabled
+	}
+	text := PlainText(contentHTML)
+	if text == "" {
+		return nil, ErrEmptyContent
+	}
+	maxLength := s.intSetting(constant.KeySummaryMaxLength, defaultMaxLength)
+	if maxLength == 0 {
+		maxLength = defaultMaxLength
+	}
+
+	if provider == ProviderExtractive {
+		return &Result{Summary: Extract(text, maxLength), Provider: ProviderExtractive}, nil
+	}
+
+	var summary string
+	err := s.acquire()
+	if err == nil {
+		input := truncateRunes(text, s.intSetting(constant.KeySummaryMaxInputChars, defaultMaxInputChars))
+		summary, err = s.chatClient(provider).summarize(ctx, title, input, maxLength)
+	}
+	if err == nil {
+		return &Result{Summary: truncateRunes(summary, maxLength), Provider: provider}, nil
+	}
+	if !s.settingSvc.GetBool(constant.KeySummaryFallback.String()) {
+		return nil, err
+	}
+	log.Printf("[自动摘要] 调用 %s 失败，改用抽取式摘要: %v", provider, err)
+	return &Result{
+		Summary:  Extract(text, maxLength),
+		Provider: ProviderExtractive,
+		Fallback: true,
+		Reason:   err.Error(),
+	}, nil
+}
+
+// acquire 占用一次模型调用额度，超出每小时次数限制时返回 ErrRateLimited
+func (s *Service) acquire() error {
+	limit := s.intSetting(constant.KeySummaryHourlyLimit, defaultHourlyLimit)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	recent := s.calls[:0]
+	for _, t := range s.calls {
+		if now.Sub(t) < time.Hour {
+			recent = append(recent, t)
+		}
+	}
+	s.calls = recent
+	if limit > 0 && len(s.calls) >= limit {
+		return ErrRateLimited
+	}
+	s.calls = append(s.calls, now)
+	return nil
+}
+
+func (s *Service) chatClient(provider string) *chatClient {
+	endpoint := strings.TrimSpace(s.settingSvc.Get(constant.KeySummaryEndpoint.String()))
+	model := strings.TrimSpace(s.settingSvc.Get(constant.KeySummaryModel.String()))
+	if endpoint == "" {
+		if provider == ProviderLocal {
+			endpoint = defaultLocalEndpoint
+		} else {
+			endpoint = defaultOpenAIEndpoint
+		}
+	}
+	if model == "" && provider == ProviderOpenAI {
+		model = defaultOpenAIModel
+	}
+	return &chatClient{
+		endpoint: endpoint,
+		apiKey:   strings.TrimSpace(s.settingSvc.Get(constant.KeySummaryAPIKey.String())),
+		model:    model,
+	}
+}
+
+func (s *Service) intSetting(key constant.SettingKey, def int) int {
+	v, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(key.String())))
+	if err != nil || v < 0 {
+		return def
+	}
+	return v
+}
+
+// PlainText 将正文 HTML 转为纯文本，去除代码块，块级元素之间以换行分隔
+func PlainText(contentHTML string) string {
+	text := codeBlockPattern.ReplaceAllString(contentHTML, "\n")
+	text = blockEndPattern.ReplaceAllString(text, "\n")
+	text = html.UnescapeString(parser.StripHTML(text))
+	text = spacePattern.ReplaceAllString(text, " ")
+	return strings.TrimSpace(newlinePattern.ReplaceAllString(text, "\n"))
+}
+
+// truncateRunes 按字符数截断，limit 为 0 时不截断
+func truncateRunes(s string, limit int) string {
+	runes := []rune(s)
+	if limit <= 0 || len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit])
+}
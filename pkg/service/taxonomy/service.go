@@ -0,0 +1,247 @@
+/*
+ * @Description: 标签云和分类树，供主题和服务端渲染直接使用，无需在客户端拉取全部文章后统计
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 结果缓存在内存中，文章、分类、标签变更事件到达时失效；事件总线之外的变更（如 Git 同步导入）
+ * 由缓存有效期兜底。分类没有父级字段，层级按名称中的 / 拆分，例如“技术/Go”是“技术”的子分类。
+ */
+package taxonomy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// cacheTTL 缓存有效期，事件总线之外的变更最多延迟这么久生效
+const cacheTTL = 10 * time.Minute
+
+// 标签云权重范围
+const (
+	MinWeight = 1
+	MaxWeight = 10
+)
+
+// pathSeparator 分类名称中表示层级的分隔符
+const pathSeparator = "/"
+
+// TagCloudItem 标签云中的一个标签
+type TagCloudItem struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Count  int    `json:"count"`
+	Weight int    `json:"weight"` // 1~10，按文章数的对数缩放
+}
+
+// CategoryNode 分类树节点
+type CategoryNode struct {
+	ID          string          `json:"id,omitempty"` // 名称中的上级分类不存在时为空
+	Name        string          `json:"name"`         // 当前层级的名称
+	Path        string          `json:"path"`         // 完整分类名称
+	Description string          `json:"description,omitempty"`
+	Count       int             `json:"count"` // 直接属于该分类的文章数
+	Total       int             `json:"total"` // 包含所有子分类的文章数之和
+	IsSeries    bool            `json:"is_series"`
+	SortOrder   int             `json:"sort_order"`
+	Children    []*CategoryNode `json:"children,omitempty"`
+}
+
+// Service 标签云和分类树服务
+type Service struct {
+	tagRepo      repository.PostTagRepository
+	categoryRepo repository.PostCategoryRepository
+
+	mu           sync.Mutex
+	version      int // 每次失效递增，避免失效前开始的查询写回旧数据
+	tagCloud     []TagCloudItem
+	tagCloudAt   time.Time
+	categoryTree []*CategoryNode
+	categoryAt   time.Time
+}
+
+// NewService 创建标签云和分类树服务
+func NewService(tagRepo repository.PostTagRepository, categoryRepo repository.PostCategoryRepository) *Service {
+	return &Service{tagRepo: tagRepo, categoryRepo: categoryRepo}
+}
+
+// RegisterHandlers 订阅文章、分类和标签变更事件，收到事件时清空缓存
+func (s *Service) RegisterHandlers(bus *event.EventBus) {
+	for _, topic := range []event.Topic{
+		event.ArticleCreated,
+		event.ArticleUpdated,
+		event.ArticleDeleted,
+		event.ArticlePublished,
+		event.CategoryUpdated,
+		event.TagUpdated,
+	} {
+		bus.Subscribe(topic, func(interface{}) { s.Invalidate() })
+	}
+}
+
+// Invalidate 清空缓存
+func (s *Service) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.version++
+	s.tagCloud = nil
+	s.categoryTree = nil
+}
+
+// TagCloud 返回有文章的标签及其权重，按名称排序
+func (s *Service) TagCloud(ctx context.Context) ([]TagCloudItem, error) {
+	s.mu.Lock()
+	if s.tagCloud != nil && time.Since(s.tagCloudAt) < cacheTTL {
+		items := s.tagCloud
+		s.mu.Unlock()
+		return items, nil
+	}
+	version := s.version
+	s.mu.Unlock()
+
+	tags, err := s.tagRepo.List(ctx, &model.ListPostTagsOptions{SortBy: model.SortByName})
+	if err != nil {
+		return nil, fmt.Errorf("获取标签失败: %w", err)
+	}
+	items := buildTagCloud(tags)
+
+	s.mu.Lock()
+	if s.version == version {
+		s.tagCloud = items
+		s.tagCloudAt = time.Now()
+	}
+	s.mu.Unlock()
+	return items, nil
+}
+
+// CategoryTree 返回分类树，同级按分类的排序值排列
+func (s *Service) CategoryTree(ctx context.Context) ([]*CategoryNode, error) {
+	s.mu.Lock()
+	if s.categoryTree != nil && time.Since(s.categoryAt) < cacheTTL {
+		tree := s.categoryTree
+		s.mu.Unlock()
+		return tree, nil
+	}
+	version := s.version
+	s.mu.Unlock()
+
+	categories, err := s.categoryRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取分类失败: %w", err)
+	}
+	tree := buildCategoryTree(categories)
+
+	s.mu.Lock()
+	if s.version == version {
+		s.categoryTree = tree
+		s.categoryAt = time.Now()
+	}
+	s.mu.Unlock()
+	return tree, nil
+}
+
+// buildTagCloud 去掉没有文章的标签，按文章数的对数把权重映射到 1~10
+func buildTagCloud(tags []*model.PostTag) []TagCloudItem {
+	items := make([]TagCloudItem, 0, len(tags))
+	minCount, maxCount := 0, 0
+	for _, t := range tags {
+		if t.Count <= 0 {
+			continue
+		}
+		if minCount == 0 || t.Count < minCount {
+			minCount = t.Count
+		}
+		if t.Count > maxCount {
+			maxCount = t.Count
+		}
+		items = append(items, TagCloudItem{ID: t.ID, Name: t.Name, Count: t.Count})
+	}
+
+	spread := math.Log(float64(maxCount)) - math.Log(float64(minCount))
+	for i := range items {
+		if spread == 0 {
+			items[i].Weight = MinWeight
+			continue
+		}
+		ratio := (math.Log(float64(items[i].Count)) - math.Log(float64(minCount))) / spread
+		items[i].Weight = MinWeight + int(math.Round(ratio*float64(MaxWeight-MinWeight)))
+	}
+	return items
+}
+
+// buildCategoryTree 按名称中的 / 构建层级；上级分类不存在时生成只有名称的节点。
+// 分类列表已按排序值排列，同级节点保持首次出现的顺序
+func buildCategoryTree(categories []*model.PostCategory) []*CategoryNode {
+	roots := []*CategoryNode{}
+	nodes := make(map[string]*CategoryNode)
+
+	var ensure func(path string) *CategoryNode
+	ensure = func(path string) *CategoryNode {
+		if n, ok := nodes[path]; ok {
+			return n
+		}
+		parentPath, name := "", path
+		if i := strings.LastIndex(path, pathSeparator); i >= 0 {
+			parentPath, name = path[:i], path[i+1:]
+		}
+		n := &CategoryNode{Name: name, Path: path}
+		nodes[path] = n
+		if parentPath == "" {
+			roots = append(roots, n)
+		} else {
+			parent := ensure(parentPath)
+			parent.Children = append(parent.Children, n)
+		}
+		return n
+	}
+
+	for _, c := range categories {
+		path := normalizePath(c.Name)
+		if path == "" {
+			continue
+		}
+		n := ensure(path)
+		if n.ID != "" {
+			// 规范化后重名的分类合并计数
+			n.Count += c.Count
+			continue
+		}
+		n.ID = c.ID
+		n.Description = c.Description
+		n.Count = c.Count
+		n.IsSeries = c.IsSeries
+		n.SortOrder = c.SortOrder
+	}
+
+	for _, n := range roots {
+		sumTotals(n)
+	}
+	return roots
+}
+
+// normalizePath 去掉各级名称两侧的空白和空的层级
+func normalizePath(name string) string {
+	parts := strings.Split(name, pathSeparator)
+	kept := parts[:0]
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, pathSeparator)
+}
+
+func sumTotals(n *CategoryNode) int {
+	n.Total = n.Count
+	for _, child := range n.Children {
+		n.Total += sumTotals(child)
+	}
+	return n.Total
+}
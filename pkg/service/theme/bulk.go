@@ -0,0 +1,386 @@
+/*
+ * @Description: 已安装主题的批量操作：批量卸载、批量检查更新、批量导出
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 每个主题单独处理并返回逐项结果，单个主题失败不影响其它主题。
+ */
+package theme
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
+)
+
+// MaxBulkThemeCount 单次批量操作的主题数量上限
+const MaxBulkThemeCount = 50
+
+// ThemeBulkRequest 批量操作请求
+type ThemeBulkRequest struct {
+	ThemeNames []string `json:"theme_names" binding:"required,min=1"`
+}
+
+// ThemeBulkItemResult 单个主题的批量操作结果
+type ThemeBulkItemResult struct {
+	ThemeName string `json:"theme_name"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+
+	// 以下字段仅用于检查更新
+	InstalledVersion string `json:"installed_version,omitempty"`
+	LatestVersion    string `json:"latest_version,omitempty"`
+	HasUpdate        bool   `json:"has_update,omitempty"`
+	DownloadURL      string `json:"download_url,omitempty"`
+
+	// 以下字段仅用于导出
+	SizeBytes int64 `json:"size_bytes,omitempty"`
+	FileCount int   `json:"file_count,omitempty"`
+}
+
+// ThemeBulkResult 批量操作结果
+type ThemeBulkResult struct {
+	Items     []*ThemeBulkItemResult `json:"items"`
+	Succeeded int                    `json:"succeeded"`
+	Failed    int                    `json:"failed"`
+}
+
+func (r *ThemeBulkResult) add(item *ThemeBulkItemResult) {
+	r.Items = append(r.Items, item)
+	if item.Success {
+		r.Succeeded++
+	} else {
+		r.Failed++
+	}
+}
+
+// normalizeBulkThemeNames 去除空白和重复的主题名称，并检查数量上限
+func normalizeBulkThemeNames(names []string) ([]string, error) {
+	seen := make(map[string]bool, len(names))
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		result = append(result, name)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("主题名称列表不能为空")
+	}
+	if len(result) > MaxBulkThemeCount {
+		return nil, fmt.Errorf("单次最多操作 %d 个主题", MaxBulkThemeCount)
+	}
+	return result, nil
+}
+
+// BulkUninstallThemes 批量卸载主题。
+// 子主题先于父主题卸载，同一批次中同时选中父主题和子主题时父主题也能卸载成功。
+func (s *themeService) BulkUninstallThemes(ctx context.Context, userID uint, names []string) (*ThemeBulkResult, error) {
+	names, err := normalizeBulkThemeNames(names)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ThemeBulkResult{Items: make([]*ThemeBulkItemResult, 0, len(names))}
+	for _, name := range orderChildThemesFirst(names) {
+		item := &ThemeBulkItemResult{ThemeName: name}
+		if err := ctx.Err(); err != nil {
+			item.Error = err.Error()
+			result.add(item)
+			continue
+		}
+
+		installed, err := s.db.UserInstalledTheme.
+			Query().
+			Where(
+				userinstalledtheme.UserID(userID),
+				userinstalledtheme.ThemeName(name),
+			).
+			First(ctx)
+		switch {
+		case err != nil:
+			item.Error = fmt.Sprintf("主题 %s 未安装", name)
+		case installed.DeployType == userinstalledtheme.DeployTypeSsr:
+			item.Error = "SSR 主题请在 SSR 主题管理中卸载"
+		default:
+			if err := s.UninstallTheme(ctx, userID, name); err != nil {
+				item.Error = err.Error()
+			} else {
+				item.Success = true
+			}
+		}
+		result.add(item)
+	}
+
+	log.Printf("[主题批量操作] 用户 %d 批量卸载主题：成功 %d 个，失败 %d 个", userID, result.Succeeded, result.Failed)
+	return result, nil
+}
+
+// orderChildThemesFirst 调整卸载顺序，使继承了同批次中其它主题的子主题排在前面
+func orderChildThemesFirst(names []string) []string {
+	pending := make(map[string]string, len(names))
+	for _, name := range names {
+		_, parent, _ := readThemeExtends(filepath.Join(ThemesDirName, name))
+		pending[name] = parent
+	}
+
+	ordered := make([]string, 0, len(names))
+	for len(ordered) < len(names) {
+		progressed := false
+		for _, name := range names {
+			if _, ok := pending[name]; !ok {
+				continue
+			}
+			// 仍有未处理的子主题继承该主题时，推迟处理
+			hasPendingChild := false
+			for child, parent := range pending {
+				if child != name && parent == name {
+					hasPendingChild = true
+					break
+				}
+			}
+			if hasPendingChild {
+				continue
+			}
+			ordered = append(ordered, name)
+			delete(pending, name)
+			progressed = true
+		}
+		if !progressed {
+			// 存在循环继承，剩余主题按原顺序处理，由卸载时的依赖检查报告错误
+			for _, name := range names {
+				if _, ok := pending[name]; ok {
+					ordered = append(ordered, name)
+					delete(pending, name)
+				}
+			}
+		}
+	}
+	return ordered
+}
+
+// BulkCheckThemeUpdates 批量检查主题更新，将已安装版本与主题商城中的最新版本比较
+func (s *themeService) BulkCheckThemeUpdates(ctx context.Context, userID uint, names []string) (*ThemeBulkResult, error) {
+	names, err := normalizeBulkThemeNames(names)
+	if err != nil {
+		return nil, err
+	}
+	return s.checkThemeUpdates(ctx, userID, names)
+}
+
+// checkThemeUpdates 逐个比较主题的已安装版本与主题商城中的最新版本
+func (s *themeService) checkThemeUpdates(ctx context.Context, userID uint, names []string) (*ThemeBulkResult, error) {
+	marketThemes, err := s.GetThemeMarketList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取主题商城数据失败: %w", err)
+	}
+	market := make(map[string]*MarketTheme, len(marketThemes))
+	for _, t := range marketThemes {
+		market[t.Name] = t
+	}
+
+	installedThemes, err := s.db.UserInstalledTheme.
+		Query().
+		Where(
+			userinstalledtheme.UserID(userID),
+			userinstalledtheme.ThemeNameIn(names...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询已安装主题失败: %w", err)
+	}
+	installed := make(map[string]*ent.UserInstalledTheme, len(installedThemes))
+	for _, t := range installedThemes {
+		installed[t.ThemeName] = t
+	}
+
+	result := &ThemeBulkResult{Items: make([]*ThemeBulkItemResult, 0, len(names))}
+	for _, name := range names {
+		item := &ThemeBulkItemResult{ThemeName: name}
+		t, ok := installed[name]
+		if !ok {
+			item.Error = fmt.Sprintf("主题 %s 未安装", name)
+			result.add(item)
+			continue
+		}
+		installedVersion := s.installedThemeVersion(t)
+		item.InstalledVersion = installedVersion
+
+		marketTheme := market[name]
+		if marketTheme == nil {
+			item.Error = "主题商城中没有该主题，无法检查更新"
+			result.add(item)
+			continue
+		}
+		item.Success = true
+		item.LatestVersion = marketTheme.Version
+		item.HasUpdate = version.Newer(marketTheme.Version, installedVersion)
+		if item.HasUpdate {
+			item.DownloadURL = marketTheme.DownloadURL
+		}
+		result.add(item)
+	}
+	return result, nil
+}
+
+// BulkExportThemes 将选中的主题目录打包为临时目录中的一个 ZIP 文件并返回其路径，调用方负责删除。
+// 每个主题位于以主题名命名的目录中，逐项结果同时写入压缩包内的 export-result.json。
+// 未安装或目录不存在的主题记为失败并跳过；写入压缩包时出错会中止整个导出，避免压缩包中留下不完整的主题。
+// 没有任何主题可以导出时不生成压缩包，路径为空。
+func (s *themeService) BulkExportThemes(ctx context.Context, userID uint, names []string) (string, *ThemeBulkResult, error) {
+	names, err := normalizeBulkThemeNames(names)
+	if err != nil {
+		return "", nil, err
+	}
+
+	installedThemes, err := s.db.UserInstalledTheme.
+		Query().
+		Where(
+			userinstalledtheme.UserID(userID),
+			userinstalledtheme.ThemeNameIn(names...),
+		).
+		All(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("查询已安装主题失败: %w", err)
+	}
+	installed := make(map[string]bool, len(installedThemes))
+	for _, t := range installedThemes {
+		installed[t.ThemeName] = true
+	}
+
+	// 先检查每个主题能否导出，压缩包只写入通过检查的主题
+	items := make([]*ThemeBulkItemResult, 0, len(names))
+	var exportable []*ThemeBulkItemResult
+	for _, name := range names {
+		item := &ThemeBulkItemResult{ThemeName: name}
+		items = append(items, item)
+		switch {
+		case !installed[name]:
+			item.Error = fmt.Sprintf("主题 %s 未安装", name)
+		case !filepath.IsLocal(name) || strings.ContainsAny(name, `/\`):
+			item.Error = "无效的主题名称"
+		default:
+			if info, err := os.Stat(filepath.Join(ThemesDirName, name)); err != nil || !info.IsDir() {
+				item.Error = "主题目录不存在"
+				break
+			}
+			exportable = append(exportable, item)
+		}
+	}
+	result := &ThemeBulkResult{Items: make([]*ThemeBulkItemResult, 0, len(names))}
+	if len(exportable) == 0 {
+		for _, item := range items {
+			result.add(item)
+		}
+		return "", result, nil
+	}
+
+	out, err := os.CreateTemp("", "themes_export_*.zip")
+	if err != nil {
+		return "", nil, fmt.Errorf("创建临时文件失败: %w", err)
+	}
+	if err := writeThemesZip(ctx, out, exportable, items, result); err != nil {
+		out.Close()
+		os.Remove(out.Name())
+		return "", nil, err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(out.Name())
+		return "", nil, fmt.Errorf("写入 ZIP 文件失败: %w", err)
+	}
+
+	log.Printf("[主题批量操作] 用户 %d 批量导出主题：成功 %d 个，失败 %d 个", userID, result.Succeeded, result.Failed)
+	return out.Name(), result, nil
+}
+
+// writeThemesZip 将 exportable 中的主题依次写入 ZIP，任一主题出错时立即返回错误；
+// 全部写入后按请求顺序汇总 items 的结果并追加 export-result.json
+func writeThemesZip(ctx context.Context, out io.Writer, exportable, items []*ThemeBulkItemResult, result *ThemeBulkResult) error {
+	zipWriter := zip.NewWriter(out)
+	for _, item := range exportable {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := addThemeDirToZip(zipWriter, filepath.Join(ThemesDirName, item.ThemeName), item.ThemeName, item); err != nil {
+			return fmt.Errorf("导出主题 %s 失败: %w", item.ThemeName, err)
+		}
+		item.Success = true
+	}
+	for _, item := range items {
+		result.add(item)
+	}
+
+	resultJSON, err := json.MarshalIndent(struct {
+		ExportedAt time.Time `json:"exported_at"`
+		*ThemeBulkResult
+	}{time.Now(), result}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("序列化导出结果失败: %w", err)
+	}
+	w, err := zipWriter.Create("export-result.json")
+	if err != nil {
+		return fmt.Errorf("创建 ZIP 文件失败: %w", err)
+	}
+	if _, err := w.Write(resultJSON); err != nil {
+		return fmt.Errorf("写入导出结果失败: %w", err)
+	}
+	if err := zipWriter.Close(); err != nil {
+		return fmt.Errorf("关闭 ZIP 文件失败: %w", err)
+	}
+	return nil
+}
+
+// addThemeDirToZip 将主题目录写入 ZIP 的 prefix/ 目录下，跳过符号链接
+func addThemeDirToZip(zw *zip.Writer, themeDir, prefix string, item *ThemeBulkItemResult) error {
+	return filepath.WalkDir(themeDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(themeDir, p)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = prefix + "/" + filepath.ToSlash(rel)
+		header.Method = zip.Deflate
+		w, err := zw.CreateHeader(header)
+		if err != nil {
+			return fmt.Errorf("创建 ZIP 文件失败: %w", err)
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return fmt.Errorf("读取 %s 失败: %w", rel, err)
+		}
+		defer f.Close()
+		if _, err := io.Copy(w, f); err != nil {
+			return fmt.Errorf("写入 %s 失败: %w", rel, err)
+		}
+		item.SizeBytes += info.Size()
+		item.FileCount++
+		return nil
+	})
+}
@@ -0,0 +1,267 @@
+/*
+ * @Description: 主题切换后的页面探活与自动回滚
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 切换到外部主题或 SSR 主题后，经由应用自身的路由请求首页和最新一篇文章。
+ * 在超时时间内页面一直返回 5xx 或空白内容时，恢复切换前的 static 目录、当前主题记录和 SSR 进程，
+ * 并推送系统通知，避免整站在切换后静默不可用。SSR 主题启动后需要时间就绪，检查会在超时时间内重试。
+ */
+package theme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/article"
+	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
+)
+
+// ErrCanaryFailed 切换后的页面检查未通过，已回滚到切换前的主题
+var ErrCanaryFailed = errors.New("切换后页面检查未通过，已回滚")
+
+const (
+	defaultCanaryTimeout = 30 * time.Second
+	// canaryRetryInterval 页面检查失败后的重试间隔
+	canaryRetryInterval = time.Second
+)
+
+// CanaryConfig 切换后页面检查配置
+type CanaryConfig struct {
+	Enabled bool
+	Timeout time.Duration // 每个页面检查的最长等待时间
+}
+
+// CanaryConfigProvider 返回当前的页面检查配置
+type CanaryConfigProvider func() CanaryConfig
+
+var (
+	canaryConfigMu       sync.RWMutex
+	canaryConfigProvider CanaryConfigProvider
+)
+
+// SetCanaryConfigProvider 设置页面检查配置来源，未设置时使用默认配置（开启，超时 30 秒）
+func SetCanaryConfigProvider(provider CanaryConfigProvider) {
+	canaryConfigMu.Lock()
+	canaryConfigProvider = provider
+	canaryConfigMu.Unlock()
+}
+
+// NewSettingCanaryConfigProvider 基于后台配置 theme.canary.enable / theme.canary.timeout 的页面检查配置来源
+func NewSettingCanaryConfigProvider(settings settingGetter) CanaryConfigProvider {
+	return func() CanaryConfig {
+		cfg := CanaryConfig{
+			Enabled: settings.Get(constant.KeyThemeCanaryEnable.String()) != "false",
+			Timeout: defaultCanaryTimeout,
+		}
+		if n, err := strconv.Atoi(strings.TrimSpace(settings.Get(constant.KeyThemeCanaryTimeout.String()))); err == nil && n > 0 {
+			cfg.Timeout = time.Duration(n) * time.Second
+		}
+		return cfg
+	}
+}
+
+func currentCanaryConfig() CanaryConfig {
+	canaryConfigMu.RLock()
+	provider := canaryConfigProvider
+	canaryConfigMu.RUnlock()
+	if provider == nil {
+		return CanaryConfig{Enabled: true, Timeout: defaultCanaryTimeout}
+	}
+	return provider()
+}
+
+// SetCanaryHandler 设置处理页面检查请求的 HTTP 处理器（通常是 gin 引擎），未设置时不做检查
+func (s *themeService) SetCanaryHandler(handler http.Handler) {
+	s.canaryHandler = handler
+}
+
+// switchSnapshot 切换前的主题状态，页面检查未通过时据此回滚
+type switchSnapshot struct {
+	currentID   uint     // 切换前的当前主题记录，0 表示官方主题
+	currentName string   // 切换前的当前主题名称
+	runningSSR  []string // 切换前运行中的 SSR 主题
+
+	staticChanged bool   // 切换过程中是否改写了 static 目录
+	staticBackup  string // 切换前 static 目录的备份，为空表示切换前不是静态模式
+}
+
+// snapshotSwitch 记录切换前的当前主题和运行中的 SSR 主题
+func (s *themeService) snapshotSwitch(ctx context.Context, userID uint, ssrManager SSRManagerInterface) *switchSnapshot {
+	snap := &switchSnapshot{currentName: OfficialThemeName}
+	current, err := s.db.UserInstalledTheme.Query().
+		Where(
+			userinstalledtheme.UserID(userID),
+			userinstalledtheme.IsCurrent(true),
+		).
+		First(ctx)
+	if err == nil {
+		snap.currentID = current.ID
+		snap.currentName = current.ThemeName
+	}
+	if ssrManager != nil {
+		snap.runningSSR = ssrManager.ListRunning()
+	}
+	return snap
+}
+
+// runCanary 依次检查首页和最新一篇文章，每个页面在超时时间内重试，全部可用时返回 nil
+func (s *themeService) runCanary(ctx context.Context) error {
+	cfg := currentCanaryConfig()
+	if s.canaryHandler == nil || !cfg.Enabled {
+		return nil
+	}
+	// 管理员关闭页面不应中断检查，否则会把取消误判为页面不可用
+	ctx = context.WithoutCancel(ctx)
+
+	for _, path := range s.canaryPaths(ctx) {
+		pageCtx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+		err := s.probeUntilReady(pageCtx, path)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("%s %w", path, err)
+		}
+	}
+	return nil
+}
+
+// canaryPaths 检查的页面：首页和最新发布的一篇文章（没有文章时只检查首页）
+func (s *themeService) canaryPaths(ctx context.Context) []string {
+	paths := []string{"/"}
+	latest, err := s.db.Article.Query().
+		Where(article.StatusEQ(article.StatusPUBLISHED)).
+		Order(ent.Desc(article.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		return paths
+	}
+	var slug string
+	if latest.Abbrlink != nil && *latest.Abbrlink != "" {
+		slug = *latest.Abbrlink
+	} else if slug, err = idgen.GeneratePublicID(latest.ID, idgen.EntityTypeArticle); err != nil {
+		return paths
+	}
+	return append(paths, "/posts/"+slug)
+}
+
+func (s *themeService) probeUntilReady(ctx context.Context, path string) error {
+	for {
+		err := s.probe(ctx, path)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(canaryRetryInterval):
+		}
+	}
+}
+
+// probe 请求一次页面，5xx 或空白内容视为不可用
+func (s *themeService) probe(ctx context.Context, path string) error {
+	req := httptest.NewRequest(http.MethodGet, path, nil).WithContext(ctx)
+	req.Header.Set("Accept", "text/html")
+	req.Header.Set("User-Agent", "anheyu-theme-canary")
+	rec := httptest.NewRecorder()
+	s.canaryHandler.ServeHTTP(rec, req)
+
+	if rec.Code >= http.StatusInternalServerError {
+		return fmt.Errorf("返回 HTTP %d", rec.Code)
+	}
+	if strings.TrimSpace(rec.Body.String()) == "" {
+		return fmt.Errorf("返回空白页面（HTTP %d）", rec.Code)
+	}
+	return nil
+}
+
+// rollbackSwitch 页面检查未通过时恢复切换前的状态并推送系统通知
+func (s *themeService) rollbackSwitch(ctx context.Context, userID uint, themeName string, snap *switchSnapshot, ssrManager SSRManagerInterface, cause error) {
+	ctx = context.WithoutCancel(ctx)
+	log.Printf("[主题切换] 切换到 %s 后页面检查未通过（%v），回滚到 %s", themeName, cause, snap.currentName)
+
+	// 1. 恢复 static 目录
+	switch {
+	case !snap.staticChanged:
+	case snap.staticBackup != "":
+		if err := s.restoreFromBackup(snap.staticBackup, StaticDirName); err != nil {
+			log.Printf("[主题切换] 回滚时恢复 static 目录失败: %v", err)
+		}
+	default:
+		// 切换前不是静态模式，清空 static 目录回到官方主题
+		if err := s.safeRemoveStaticDir(); err != nil {
+			log.Printf("[主题切换] 回滚时清空 static 目录失败: %v", err)
+		}
+	}
+
+	// 2. 恢复当前主题记录
+	if err := s.restoreCurrentTheme(ctx, userID, snap.currentID); err != nil {
+		log.Printf("[主题切换] 回滚时恢复当前主题记录失败: %v", err)
+	}
+
+	// 3. 停止新启动的 SSR 主题，重新启动切换前运行中的 SSR 主题
+	if ssrManager != nil {
+		wasRunning := make(map[string]bool, len(snap.runningSSR))
+		for _, name := range snap.runningSSR {
+			wasRunning[name] = true
+		}
+		for _, name := range ssrManager.ListRunning() {
+			if !wasRunning[name] {
+				if err := ssrManager.Stop(name); err != nil {
+					log.Printf("[主题切换] 回滚时停止 SSR 主题 %s 失败: %v", name, err)
+				}
+			}
+		}
+		for _, name := range snap.runningSSR {
+			if ssrManager.IsRunning(name) {
+				continue
+			}
+			if err := ssrManager.Start(name, 3000); err != nil {
+				log.Printf("[主题切换] 回滚时启动 SSR 主题 %s 失败: %v", name, err)
+			}
+		}
+	}
+
+	s.publishThemeSwitched(snap.currentName)
+	notification.DefaultNoticeCenter().Push(notification.SystemNotice{
+		Level:    notification.NoticeLevelError,
+		Category: notification.NoticeCategoryThemeCanary,
+		Title:    "主题切换后页面不可用，已自动回滚",
+		Message:  fmt.Sprintf("切换到主题 %s 后 %v，已恢复为 %s", themeName, cause, snap.currentName),
+		Source:   themeName,
+	})
+}
+
+// restoreCurrentTheme 将当前主题记录恢复为 currentID，0 表示官方主题（没有当前主题记录）
+func (s *themeService) restoreCurrentTheme(ctx context.Context, userID uint, currentID uint) error {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.UserInstalledTheme.Update().
+		Where(userinstalledtheme.UserID(userID)).
+		SetIsCurrent(false).
+		Save(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if currentID != 0 {
+		if _, err := tx.UserInstalledTheme.UpdateOneID(currentID).SetIsCurrent(true).Save(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
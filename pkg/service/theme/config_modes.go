@@ -0,0 +1,104 @@
+/*
+ * @Description: 主题配置的浅色/深色模式取值
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * color 和 image 类型的配置字段在 theme.json 中声明 "modes": true 后，
+ * 值可以是 {"light": "...", "dark": "..."} 对象，也可以是两种模式共用的字符串。
+ * 公开配置接口指定 mode 时按模式展开为字符串，SSR 主题可在服务端直接输出对应配色，避免闪烁。
+ */
+package theme
+
+import (
+	"regexp"
+	"strings"
+)
+
+// 主题配色模式
+const (
+	ThemeModeLight = "light"
+	ThemeModeDark  = "dark"
+)
+
+// colorValuePattern 支持的颜色格式：#rgb、#rrggbb、#rrggbbaa、rgb()/rgba()/hsl()/hsla()、CSS 变量
+var colorValuePattern = regexp.MustCompile(`^(#([0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})|(rgb|rgba|hsl|hsla)\([^()]*\)|var\(--[A-Za-z0-9_-]+\)|transparent)$`)
+
+// IsValidThemeMode 是否为支持的配色模式
+func IsValidThemeMode(mode string) bool {
+	return mode == ThemeModeLight || mode == ThemeModeDark
+}
+
+// fieldSupportsModes 只有颜色和图片字段支持分模式取值
+func fieldSupportsModes(field ThemeSettingField) bool {
+	return field.Type == "color" || field.Type == "image"
+}
+
+// validateModeFieldValue 校验分模式字段的值：字符串或只包含 light/dark 的对象
+func (s *themeService) validateModeFieldValue(field ThemeSettingField, value interface{}) *ConfigFieldError {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return s.validateModeVariant(field, v)
+	case map[string]interface{}:
+		for mode, variant := range v {
+			if !IsValidThemeMode(mode) {
+				return &ConfigFieldError{Code: ConfigErrInvalidMode, Params: map[string]interface{}{"mode": mode}}
+			}
+			str, ok := variant.(string)
+			if !ok {
+				return &ConfigFieldError{Code: ConfigErrType, Mode: mode, Params: map[string]interface{}{"expected": "string"}}
+			}
+			if fe := s.validateModeVariant(field, str); fe != nil {
+				fe.Mode = mode
+				return fe
+			}
+		}
+		if field.Required {
+			if light, _ := v[ThemeModeLight].(string); light == "" {
+				return &ConfigFieldError{Code: ConfigErrRequired, Mode: ThemeModeLight}
+			}
+		}
+		return nil
+	default:
+		return &ConfigFieldError{Code: ConfigErrType, Params: map[string]interface{}{"expected": "mode_value"}}
+	}
+}
+
+// validateModeVariant 校验单个模式的取值，空字符串表示沿用另一模式
+func (s *themeService) validateModeVariant(field ThemeSettingField, value string) *ConfigFieldError {
+	if value == "" {
+		return nil
+	}
+	if field.Type == "color" && !colorValuePattern.MatchString(strings.TrimSpace(value)) {
+		return &ConfigFieldError{Code: ConfigErrColor, Params: map[string]interface{}{"value": value}}
+	}
+	return s.validateFieldValue(field, value)
+}
+
+// ResolveThemeConfigMode 按配色模式展开配置值：分模式字段取对应模式的值，深色模式未设置时沿用浅色模式
+func ResolveThemeConfigMode(settings []ThemeSettingGroup, values map[string]interface{}, mode string) map[string]interface{} {
+	modeFields := make(map[string]bool)
+	for _, group := range settings {
+		for _, field := range group.Fields {
+			if field.Modes && fieldSupportsModes(field) {
+				modeFields[field.Name] = true
+			}
+		}
+	}
+
+	result := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		variants, ok := value.(map[string]interface{})
+		if !modeFields[key] || !ok {
+			result[key] = value
+			continue
+		}
+		resolved, _ := variants[mode].(string)
+		if resolved == "" {
+			resolved, _ = variants[ThemeModeLight].(string)
+		}
+		result[key] = resolved
+	}
+	return result
+}
@@ -0,0 +1,147 @@
+/*
+ * @Description: 主题配置校验错误
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 保存主题配置时收集所有不合法的字段，而不是遇到第一个就返回，后台表单可以一次标出全部错误。
+ * 每个字段的错误带有稳定的错误码和参数，提示文本按请求语言生成，目前支持中文和英文；
+ * 主题在 validation.message 中自定义的提示原样返回。
+ */
+package theme
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 配置字段校验错误码
+const (
+	ConfigErrRequired    = "required"     // 必填项为空
+	ConfigErrMinLength   = "min_length"   // 长度小于 minLength
+	ConfigErrMaxLength   = "max_length"   // 长度大于 maxLength
+	ConfigErrPattern     = "pattern"      // 不匹配 pattern
+	ConfigErrBadPattern  = "bad_pattern"  // 主题声明的 pattern 本身无效
+	ConfigErrMin         = "min"          // 数值小于 min
+	ConfigErrMax         = "max"          // 数值大于 max
+	ConfigErrColor       = "color"        // 颜色格式不正确
+	ConfigErrInvalidMode = "invalid_mode" // 分模式字段包含 light/dark 以外的模式
+	ConfigErrType        = "type"         // 值的类型不正确
+)
+
+// ConfigFieldError 单个配置字段的校验错误
+type ConfigFieldError struct {
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Params  map[string]interface{} `json:"params,omitempty"`
+	Mode    string                 `json:"mode,omitempty"` // 分模式字段中出错的模式
+
+	custom bool // Message 由主题定义，不随语言变化
+}
+
+// ConfigValidationError 主题配置校验失败，Fields 以字段名为键列出所有不合法的字段
+type ConfigValidationError struct {
+	Fields map[string]*ConfigFieldError `json:"fields"`
+}
+
+func (e *ConfigValidationError) Error() string {
+	names := make([]string, 0, len(e.Fields))
+	for name := range e.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, e.Fields[name].Message)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (e *ConfigValidationError) add(field ThemeSettingField, fe *ConfigFieldError) {
+	if e.Fields == nil {
+		e.Fields = make(map[string]*ConfigFieldError)
+	}
+	if fe.Params == nil {
+		fe.Params = make(map[string]interface{})
+	}
+	fe.Params["label"] = fieldLabel(field)
+	if !fe.custom {
+		fe.Message = configErrorMessage("zh", fe)
+	}
+	e.Fields[field.Name] = fe
+}
+
+// Localize 按语言重新生成提示文本，不支持的语言使用中文
+func (e *ConfigValidationError) Localize(lang string) {
+	for _, fe := range e.Fields {
+		if !fe.custom {
+			fe.Message = configErrorMessage(lang, fe)
+		}
+	}
+}
+
+// configErrorMessages 各语言的提示模板，{name} 替换为 Params 中的同名参数
+var configErrorMessages = map[string]map[string]string{
+	"zh": {
+		ConfigErrRequired:    "字段 {label} 为必填项",
+		ConfigErrMinLength:   "字段 {label} 长度不能小于 {min}",
+		ConfigErrMaxLength:   "字段 {label} 长度不能大于 {max}",
+		ConfigErrPattern:     "字段 {label} 格式不正确",
+		ConfigErrBadPattern:  "字段 {label} 的正则表达式无效",
+		ConfigErrMin:         "字段 {label} 的值不能小于 {min}",
+		ConfigErrMax:         "字段 {label} 的值不能大于 {max}",
+		ConfigErrColor:       "字段 {label} 颜色格式不正确: {value}",
+		ConfigErrInvalidMode: "字段 {label} 只支持 light 和 dark 两种模式，发现 {mode}",
+		ConfigErrType:        "字段 {label} 的值类型不正确，应为{expected}",
+	},
+	"en": {
+		ConfigErrRequired:    "{label} is required",
+		ConfigErrMinLength:   "{label} must be at least {min} characters",
+		ConfigErrMaxLength:   "{label} must be at most {max} characters",
+		ConfigErrPattern:     "{label} has an invalid format",
+		ConfigErrBadPattern:  "{label} has an invalid validation pattern",
+		ConfigErrMin:         "{label} must be at least {min}",
+		ConfigErrMax:         "{label} must be at most {max}",
+		ConfigErrColor:       "{label} is not a valid color: {value}",
+		ConfigErrInvalidMode: "{label} only supports light and dark modes, got {mode}",
+		ConfigErrType:        "{label} must be {expected}",
+	},
+}
+
+// configTypeNames 类型名称，用于 type 错误的 expected 参数
+var configTypeNames = map[string]map[string]string{
+	"zh": {"string": "字符串", "mode_value": "字符串或包含 light/dark 的对象"},
+	"en": {"string": "a string", "mode_value": "a string or an object with light/dark values"},
+}
+
+func configErrorMessage(lang string, fe *ConfigFieldError) string {
+	lang = strings.ToLower(strings.SplitN(lang, "-", 2)[0])
+	templates, ok := configErrorMessages[lang]
+	if !ok {
+		lang, templates = "zh", configErrorMessages["zh"]
+	}
+	msg := templates[fe.Code]
+	if msg == "" {
+		msg = configErrorMessages["zh"][ConfigErrType]
+	}
+	for key, val := range fe.Params {
+		s := fmt.Sprint(val)
+		if key == "expected" {
+			if name, ok := configTypeNames[lang][s]; ok {
+				s = name
+			}
+		}
+		msg = strings.ReplaceAll(msg, "{"+key+"}", s)
+	}
+	if fe.Mode != "" {
+		msg = fe.Mode + ": " + msg
+	}
+	return msg
+}
+
+func fieldLabel(field ThemeSettingField) string {
+	if field.Label != "" {
+		return field.Label
+	}
+	return field.Name
+}
@@ -0,0 +1,164 @@
+/*
+ * @Description: 主题操作前的磁盘空间预检查与存储配额
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 下载、解压和备份前按预估大小（Content-Length、压缩包解压后大小、源目录大小）检查磁盘可用空间，
+ * 并对 themes/ 和 backup/ 目录的合计占用应用可配置的配额，避免写到一半磁盘写满导致主题残缺。
+ */
+package theme
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+var (
+	// ErrInsufficientDiskSpace 磁盘可用空间不足
+	ErrInsufficientDiskSpace = errors.New("磁盘空间不足")
+	// ErrThemeStorageQuotaExceeded 超出主题存储配额
+	ErrThemeStorageQuotaExceeded = errors.New("超出主题存储配额")
+
+	// errDiskSpaceUnsupported 当前平台无法获取磁盘可用空间，跳过检查
+	errDiskSpaceUnsupported = errors.New("当前平台不支持获取磁盘可用空间")
+)
+
+// defaultMinFreeBytes 未配置时磁盘至少保留的可用空间
+const defaultMinFreeBytes = 100 << 20
+
+// StorageLimits 主题存储限制
+type StorageLimits struct {
+	QuotaBytes   int64 // themes 和 backup 目录合计占用上限，0 表示不限制
+	MinFreeBytes int64 // 操作完成后磁盘至少保留的可用空间
+}
+
+// StorageLimitsProvider 返回当前的主题存储限制
+type StorageLimitsProvider func() StorageLimits
+
+var (
+	storageLimitsMu       sync.RWMutex
+	storageLimitsProvider StorageLimitsProvider
+)
+
+// SetStorageLimitsProvider 设置主题存储限制来源，未设置时不限制配额，并保留默认的可用空间
+func SetStorageLimitsProvider(provider StorageLimitsProvider) {
+	storageLimitsMu.Lock()
+	storageLimitsProvider = provider
+	storageLimitsMu.Unlock()
+}
+
+// settingGetter 读取配置项的最小接口
+type settingGetter interface {
+	Get(key string) string
+}
+
+// NewSettingStorageLimitsProvider 基于后台配置 theme.storage.quota_mb / theme.storage.min_free_mb 的存储限制来源
+func NewSettingStorageLimitsProvider(settings settingGetter) StorageLimitsProvider {
+	readMB := func(key constant.SettingKey, fallback int64) int64 {
+		n, err := strconv.ParseInt(strings.TrimSpace(settings.Get(key.String())), 10, 64)
+		if err != nil || n < 0 {
+			return fallback
+		}
+		return n << 20
+	}
+	return func() StorageLimits {
+		return StorageLimits{
+			QuotaBytes:   readMB(constant.KeyThemeStorageQuotaMB, 0),
+			MinFreeBytes: readMB(constant.KeyThemeStorageMinFreeMB, defaultMinFreeBytes),
+		}
+	}
+}
+
+func currentStorageLimits() StorageLimits {
+	storageLimitsMu.RLock()
+	provider := storageLimitsProvider
+	storageLimitsMu.RUnlock()
+	if provider == nil {
+		return StorageLimits{MinFreeBytes: defaultMinFreeBytes}
+	}
+	return provider()
+}
+
+// ensureSpaceFor 检查向 dest 写入 required 字节前的磁盘可用空间；
+// dest 位于 themes 或 backup 目录中时同时检查存储配额
+func ensureSpaceFor(dest string, required int64) error {
+	if required <= 0 {
+		return nil
+	}
+	limits := currentStorageLimits()
+
+	free, err := availableDiskSpace(existingParent(dest))
+	switch {
+	case errors.Is(err, errDiskSpaceUnsupported):
+	case err != nil:
+		log.Printf("[主题存储] 获取 %s 所在磁盘的可用空间失败，跳过检查: %v", dest, err)
+	case free < uint64(required+limits.MinFreeBytes):
+		return fmt.Errorf("%w：写入 %s 需要约 %s，可用 %s（需保留 %s）",
+			ErrInsufficientDiskSpace, dest, formatBytes(required), formatBytes(int64(free)), formatBytes(limits.MinFreeBytes))
+	}
+
+	if limits.QuotaBytes > 0 && isQuotaManagedPath(dest) {
+		themesSize, _ := dirUsage(ThemesDirName)
+		backupSize, _ := dirUsage(BackupDirName)
+		if used := themesSize + backupSize; used+required > limits.QuotaBytes {
+			return fmt.Errorf("%w：themes 和 backup 目录已占用 %s，本次需要约 %s，配额 %s，请先卸载不用的主题或清理备份",
+				ErrThemeStorageQuotaExceeded, formatBytes(used), formatBytes(required), formatBytes(limits.QuotaBytes))
+		}
+	}
+	return nil
+}
+
+// isQuotaManagedPath 判断路径是否位于受配额限制的 themes 或 backup 目录中
+func isQuotaManagedPath(p string) bool {
+	for _, dir := range []string{ThemesDirName, BackupDirName} {
+		if rel, err := filepath.Rel(dir, p); err == nil && filepath.IsLocal(rel) {
+			return true
+		}
+	}
+	return false
+}
+
+// existingParent 返回路径自身或最近的已存在的上级目录，用于查询尚未创建的目录所在的磁盘
+func existingParent(p string) string {
+	for {
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+		parent := filepath.Dir(p)
+		if parent == p {
+			return p
+		}
+		p = parent
+	}
+}
+
+// zipUncompressedSize 计算压缩包解压后的总大小
+func zipUncompressedSize(r *zip.Reader) int64 {
+	var total uint64
+	for _, f := range r.File {
+		total += f.UncompressedSize64
+	}
+	return int64(total)
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,8 @@
+//go:build !linux && !darwin
+
+package theme
+
+// availableDiskSpace 当前平台不支持获取可用空间，调用方跳过磁盘空间检查
+func availableDiskSpace(path string) (uint64, error) {
+	return 0, errDiskSpaceUnsupported
+}
@@ -0,0 +1,14 @@
+//go:build linux || darwin
+
+package theme
+
+import "syscall"
+
+// availableDiskSpace 返回 path 所在文件系统中非特权用户可用的字节数
+func availableDiskSpace(path string) (uint64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, err
+	}
+	return uint64(st.Bavail) * uint64(st.Bsize), nil
+}
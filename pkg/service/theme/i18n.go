@@ -0,0 +1,217 @@
+/*
+ * @Description: 主题多语言（locales/{lang}.json）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 主题可以在 locales 目录下提供 {lang}.json 语言文件，值为字符串或嵌套对象。
+ * 请求某个语言时按回退链合并：主题默认语言 < 语言主标签 < 完整语言标签，越具体的语言优先级越高，
+ * 缺失的词条由回退语言补齐。语言文件启动时加载到内存，主题目录变化时重新加载（见 locale_cache.go）。
+ */
+package theme
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	// LocalesDirName 主题语言文件目录
+	LocalesDirName = "locales"
+	// DefaultThemeLocale 主题未声明默认语言时使用的回退语言
+	DefaultThemeLocale = "zh-CN"
+	// maxLocaleFileSize 单个语言文件的大小上限
+	maxLocaleFileSize = 1 << 20
+)
+
+// localeNamePattern 语言标签格式，如 zh、zh-CN、zh-Hant-TW
+var localeNamePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// ThemeLocaleResponse 合并后的主题语言词条
+type ThemeLocaleResponse struct {
+	ThemeName string                 `json:"theme_name"`
+	Locale    string                 `json:"locale"`    // 请求的语言
+	Chain     []string               `json:"chain"`     // 实际参与合并的语言，优先级从低到高
+	Available []string               `json:"available"` // 主题提供的全部语言
+	Messages  map[string]interface{} `json:"messages"`
+}
+
+// ListThemeLocales 列出主题提供的语言
+func (s *themeService) ListThemeLocales(ctx context.Context, themeName string) ([]string, error) {
+	t := s.locales.get(themeName)
+	if t.err != nil {
+		return nil, t.err
+	}
+	return append([]string{}, t.available...), nil
+}
+
+// GetThemeLocale 读取主题的单个语言文件
+func (s *themeService) GetThemeLocale(ctx context.Context, themeName, locale string) (map[string]interface{}, error) {
+	if !localeNamePattern.MatchString(locale) {
+		return nil, fmt.Errorf("语言标签格式错误: %s", locale)
+	}
+	t := s.locales.get(themeName)
+	if err, ok := t.invalid[locale]; ok {
+		return nil, err
+	}
+	messages, ok := t.messages[locale]
+	if !ok {
+		return nil, fmt.Errorf("语言文件 %s 不存在: %w", locale, os.ErrNotExist)
+	}
+	// 返回副本，缓存的词条不会被调用方修改
+	copied := make(map[string]interface{}, len(messages))
+	mergeLocaleMessages(copied, messages)
+	return copied, nil
+}
+
+// GetCurrentThemeLocale 返回当前主题按回退链合并后的语言词条，locale 为空时使用主题默认语言
+func (s *themeService) GetCurrentThemeLocale(ctx context.Context, userID uint, locale string) (*ThemeLocaleResponse, error) {
+	themeName, ok := s.GetCurrentSSRThemeName(ctx, userID)
+	if !ok {
+		current, err := s.GetCurrentTheme(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("获取当前主题失败: %w", err)
+		}
+		themeName = current.Name
+		if current.IsOfficial || s.isOfficialTheme(themeName) {
+			return &ThemeLocaleResponse{ThemeName: themeName, Locale: locale, Chain: []string{}, Available: []string{}, Messages: map[string]interface{}{}}, nil
+		}
+	}
+
+	available, err := s.ListThemeLocales(ctx, themeName)
+	if err != nil {
+		return nil, fmt.Errorf("读取主题语言列表失败: %w", err)
+	}
+
+	defaultLocale := s.locales.get(themeName).defaultLocale
+	if locale == "" {
+		locale = defaultLocale
+	}
+
+	resp := &ThemeLocaleResponse{
+		ThemeName: themeName,
+		Locale:    locale,
+		Chain:     []string{},
+		Available: available,
+		Messages:  map[string]interface{}{},
+	}
+	for _, candidate := range localeFallbackChain(locale, defaultLocale, available) {
+		messages, err := s.GetThemeLocale(ctx, themeName, candidate)
+		if err != nil {
+			continue
+		}
+		mergeLocaleMessages(resp.Messages, messages)
+		resp.Chain = append(resp.Chain, candidate)
+	}
+	return resp, nil
+}
+
+// validateLocaleFile 校验主题包中的语言文件，返回错误信息
+func validateLocaleFile(file *zip.File, normalizedName string) []string {
+	name := path.Base(normalizedName)
+	locale := strings.TrimSuffix(name, ".json")
+	if path.Dir(normalizedName) != LocalesDirName || !strings.HasSuffix(name, ".json") {
+		return []string{fmt.Sprintf("语言文件 %s 必须是 locales 目录下的 .json 文件", normalizedName)}
+	}
+	if !localeNamePattern.MatchString(locale) {
+		return []string{fmt.Sprintf("语言文件 %s 的文件名不是合法的语言标签（如 zh-CN、en）", normalizedName)}
+	}
+	if file.UncompressedSize64 > maxLocaleFileSize {
+		return []string{fmt.Sprintf("语言文件 %s 超过 1MB", normalizedName)}
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return []string{fmt.Sprintf("读取语言文件 %s 失败: %v", normalizedName, err)}
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(io.LimitReader(reader, maxLocaleFileSize+1))
+	if err != nil {
+		return []string{fmt.Sprintf("读取语言文件 %s 失败: %v", normalizedName, err)}
+	}
+	if _, err := parseLocaleMessages(data); err != nil {
+		return []string{fmt.Sprintf("语言文件 %s 无效: %v", normalizedName, err)}
+	}
+	return nil
+}
+
+// parseLocaleMessages 解析语言文件，值只能是字符串或嵌套对象
+func parseLocaleMessages(data []byte) (map[string]interface{}, error) {
+	var messages map[string]interface{}
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil, fmt.Errorf("JSON格式错误: %w", err)
+	}
+	if messages == nil {
+		return nil, fmt.Errorf("语言文件必须是 JSON 对象")
+	}
+	if err := checkLocaleValues(messages, ""); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func checkLocaleValues(messages map[string]interface{}, prefix string) error {
+	for key, value := range messages {
+		switch v := value.(type) {
+		case string:
+		case map[string]interface{}:
+			if err := checkLocaleValues(v, prefix+key+"."); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("词条 %s%s 的值必须是字符串或对象", prefix, key)
+		}
+	}
+	return nil
+}
+
+// mergeLocaleMessages 将 src 深度合并到 dst，src 中的词条覆盖 dst
+func mergeLocaleMessages(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeLocaleMessages(dstMap, srcMap)
+			continue
+		}
+		if srcIsMap {
+			copied := make(map[string]interface{}, len(srcMap))
+			mergeLocaleMessages(copied, srcMap)
+			dst[key] = copied
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// localeFallbackChain 生成回退链（优先级从低到高），只保留主题实际提供的语言。
+// 例如请求 zh-Hant-TW、默认 zh-CN 时为：zh-CN < zh < zh-Hant < zh-Hant-TW。
+// 语言标签按大小写不敏感匹配主题提供的文件名。
+func localeFallbackChain(locale, defaultLocale string, available []string) []string {
+	byLower := make(map[string]string, len(available))
+	for _, name := range available {
+		byLower[strings.ToLower(name)] = name
+	}
+
+	var chain []string
+	seen := make(map[string]bool)
+	add := func(tag string) {
+		if name, ok := byLower[strings.ToLower(tag)]; ok && !seen[name] {
+			seen[name] = true
+			chain = append(chain, name)
+		}
+	}
+
+	add(defaultLocale)
+	parts := strings.Split(locale, "-")
+	for i := 1; i <= len(parts); i++ {
+		add(strings.Join(parts[:i], "-"))
+	}
+	return chain
+}
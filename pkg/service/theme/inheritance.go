@@ -0,0 +1,152 @@
+/*
+ * @Description: 主题继承，子主题通过 theme.json 的 extends 字段声明父主题
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 子主题只需包含修改过的文件，缺少的静态资源和模板由前台路由依次回退到 themes/<父主题>/ 中查找。
+ * 安装、切换时校验父主题已安装且继承链中没有循环；被其它主题继承的主题不能卸载。
+ */
+package theme
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
+)
+
+// MaxThemeInheritanceDepth 继承链的最大层数（不含子主题本身）
+const MaxThemeInheritanceDepth = 5
+
+// readThemeExtends 读取主题目录中 theme.json 的主题名称和声明的父主题，没有 theme.json 或未声明时返回空字符串
+func readThemeExtends(themeDir string) (name string, extends string, err error) {
+	content, err := os.ReadFile(filepath.Join(themeDir, "theme.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", "", nil
+		}
+		return "", "", fmt.Errorf("读取 theme.json 失败: %w", err)
+	}
+	var meta struct {
+		Name    string `json:"name"`
+		Extends string `json:"extends"`
+	}
+	if err := json.Unmarshal(content, &meta); err != nil {
+		return "", "", fmt.Errorf("解析 theme.json 失败: %w", err)
+	}
+	return meta.Name, strings.TrimSpace(meta.Extends), nil
+}
+
+// resolveParentChain 从 parent 开始沿 extends 向上解析继承链，返回由近到远的父主题名称。
+// child 为子主题名称，用于检测循环继承；父主题必须已安装在 themes 目录中。
+func resolveParentChain(child, parent string) ([]string, error) {
+	var chain []string
+	seen := map[string]bool{child: true}
+	for parent != "" {
+		if seen[parent] {
+			return nil, fmt.Errorf("主题继承存在循环: %s -> %s", strings.Join(append([]string{child}, chain...), " -> "), parent)
+		}
+		if len(chain) >= MaxThemeInheritanceDepth {
+			return nil, fmt.Errorf("主题继承层数超过 %d 层", MaxThemeInheritanceDepth)
+		}
+		if parent == OfficialThemeName {
+			return nil, fmt.Errorf("不支持继承内置的官方主题 %s", OfficialThemeName)
+		}
+		if !filepath.IsLocal(parent) || strings.ContainsAny(parent, `/\`) {
+			return nil, fmt.Errorf("无效的父主题名称: %s", parent)
+		}
+		parentDir := filepath.Join(ThemesDirName, parent)
+		if _, err := os.Stat(filepath.Join(parentDir, "theme.json")); err != nil {
+			return nil, fmt.Errorf("父主题 %s 未安装，请先安装父主题", parent)
+		}
+
+		seen[parent] = true
+		chain = append(chain, parent)
+		_, next, err := readThemeExtends(parentDir)
+		if err != nil {
+			return nil, fmt.Errorf("父主题 %s: %w", parent, err)
+		}
+		parent = next
+	}
+	return chain, nil
+}
+
+// validateThemeInheritance 校验主题目录声明的继承关系，返回由近到远的父主题目录
+func (s *themeService) validateThemeInheritance(themeDir string) ([]string, error) {
+	name, parent, err := readThemeExtends(themeDir)
+	if err != nil || parent == "" {
+		return nil, err
+	}
+	chain, err := resolveParentChain(name, parent)
+	if err != nil {
+		return nil, err
+	}
+	dirs := make([]string, len(chain))
+	for i, name := range chain {
+		dirs[i] = filepath.Join(ThemesDirName, name)
+	}
+	return dirs, nil
+}
+
+// resolveInheritedFile 在主题目录及其父主题目录中依次查找文件，找不到时返回空字符串
+func resolveInheritedFile(themeDir string, parentDirs []string, rel string) string {
+	for _, dir := range append([]string{themeDir}, parentDirs...) {
+		p := filepath.Join(dir, rel)
+		if info, err := os.Stat(p); err == nil && !info.IsDir() {
+			return p
+		}
+	}
+	return ""
+}
+
+// resolveStaticIndex 返回当前外部主题实际使用的 index.html，子主题未提供时使用父主题的
+func resolveStaticIndex() string {
+	indexPath := filepath.Join(StaticDirName, "index.html")
+	if _, err := os.Stat(indexPath); err == nil {
+		return indexPath
+	}
+	name, parent, err := readThemeExtends(StaticDirName)
+	if err != nil || parent == "" {
+		return indexPath
+	}
+	chain, err := resolveParentChain(name, parent)
+	if err != nil {
+		return indexPath
+	}
+	for _, name := range chain {
+		p := filepath.Join(ThemesDirName, name, "index.html")
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+	return indexPath
+}
+
+// ensureNoDependents 检查是否有其它已安装的主题继承了该主题
+func (s *themeService) ensureNoDependents(ctx context.Context, userID uint, themeName string) error {
+	installed, err := s.db.UserInstalledTheme.
+		Query().
+		Where(userinstalledtheme.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("查询已安装主题失败: %w", err)
+	}
+	var dependents []string
+	for _, t := range installed {
+		if t.ThemeName == themeName {
+			continue
+		}
+		_, parent, err := readThemeExtends(filepath.Join(ThemesDirName, t.ThemeName))
+		if err == nil && parent == themeName {
+			dependents = append(dependents, t.ThemeName)
+		}
+	}
+	if len(dependents) > 0 {
+		return fmt.Errorf("主题 %s 被 %s 继承，请先卸载这些子主题", themeName, strings.Join(dependents, "、"))
+	}
+	return nil
+}
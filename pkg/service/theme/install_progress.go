@@ -0,0 +1,191 @@
+/*
+ * @Description: 主题安装进度与取消
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 安装过程按下载、解压、校验、写入数据库几个阶段向管理后台事件流推送进度，下载和解压附带百分比。
+ * 正在进行的安装登记在内存中，可以按主题名取消：下载和解压随 context 中止，已写出的主题目录会被清理。
+ * 进入写入数据库阶段后不再允许取消，避免出现有记录没有文件或有文件没有记录的状态。
+ */
+package theme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/adminevent"
+)
+
+var (
+	// ErrInstallCanceled 安装被管理员取消
+	ErrInstallCanceled = errors.New("安装已取消")
+	// ErrInstallInProgress 同一主题已有安装正在进行
+	ErrInstallInProgress = errors.New("正在安装中")
+	// ErrInstallNotFound 没有正在进行的安装
+	ErrInstallNotFound = errors.New("没有正在进行的安装")
+	// ErrInstallCommitting 安装已进入写入数据库阶段，不能再取消
+	ErrInstallCommitting = errors.New("安装已进入提交阶段，无法取消")
+)
+
+// installProgressStep 总大小未知时每处理这么多字节推送一次进度
+const installProgressStep = 1 << 20
+
+// installTask 一次正在进行的主题安装
+type installTask struct {
+	theme  string
+	cancel context.CancelFunc
+
+	mu         sync.Mutex
+	stage      string
+	canceled   bool
+	committing bool
+	lastReport int64 // 上次推送时的百分比，总大小未知时为已处理字节数
+}
+
+type installTaskKey struct{}
+
+var (
+	activeInstallsMu sync.Mutex
+	activeInstalls   = make(map[string]*installTask)
+)
+
+// beginInstall 登记一次安装并推送开始事件，返回可取消的 context，安装进度从中读取。
+// 调用方必须在安装结束时调用 finish：
+//
+//	ctx, task, err := beginInstall(ctx, themeName)
+//	if err != nil {
+//		return err
+//	}
+//	defer task.finish(&err)
+func beginInstall(ctx context.Context, themeName string) (context.Context, *installTask, error) {
+	activeInstallsMu.Lock()
+	defer activeInstallsMu.Unlock()
+	if _, ok := activeInstalls[themeName]; ok {
+		return nil, nil, fmt.Errorf("主题 %s %w", themeName, ErrInstallInProgress)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	task := &installTask{theme: themeName, cancel: cancel}
+	activeInstalls[themeName] = task
+	task.publish(adminevent.ThemeInstallData{Stage: adminevent.StageStarted})
+	return context.WithValue(ctx, installTaskKey{}, task), task, nil
+}
+
+// cancelInstall 取消正在进行的安装
+func cancelInstall(themeName string) error {
+	activeInstallsMu.Lock()
+	task, ok := activeInstalls[themeName]
+	activeInstallsMu.Unlock()
+	if !ok {
+		return fmt.Errorf("主题 %s %w", themeName, ErrInstallNotFound)
+	}
+
+	task.mu.Lock()
+	defer task.mu.Unlock()
+	if task.committing {
+		return fmt.Errorf("主题 %s %w", themeName, ErrInstallCommitting)
+	}
+	task.canceled = true
+	task.cancel()
+	return nil
+}
+
+// installTaskFrom 取出 context 中的安装任务，不在安装流程中（如一致性修复重新下载）时返回 nil，
+// installTask 的方法都可以在 nil 上调用
+func installTaskFrom(ctx context.Context) *installTask {
+	task, _ := ctx.Value(installTaskKey{}).(*installTask)
+	return task
+}
+
+// finish 注销安装并推送结果；安装是被取消的，将 err 替换为 ErrInstallCanceled
+func (t *installTask) finish(err *error) {
+	activeInstallsMu.Lock()
+	delete(activeInstalls, t.theme)
+	activeInstallsMu.Unlock()
+
+	t.mu.Lock()
+	canceled := t.canceled
+	t.mu.Unlock()
+	t.cancel()
+
+	switch {
+	case *err == nil:
+		t.publish(adminevent.ThemeInstallData{Stage: adminevent.StageCompleted, Percent: 100})
+	case canceled:
+		*err = fmt.Errorf("主题 %s %w", t.theme, ErrInstallCanceled)
+		t.publish(adminevent.ThemeInstallData{Stage: adminevent.StageCanceled})
+	default:
+		t.publish(adminevent.ThemeInstallData{Stage: adminevent.StageFailed, Error: (*err).Error()})
+	}
+}
+
+// setStage 进入新阶段
+func (t *installTask) setStage(stage string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	t.stage = stage
+	t.lastReport = -1
+	t.mu.Unlock()
+	t.publish(adminevent.ThemeInstallData{Stage: stage})
+}
+
+// progress 推送当前阶段的进度，百分比每变化 1% 推送一次，总大小未知时每处理 1MB 推送一次
+func (t *installTask) progress(done, total int64) {
+	if t == nil {
+		return
+	}
+	percent := -1
+	mark := done / installProgressStep
+	if total > 0 {
+		percent = int(done * 100 / total)
+		if percent > 100 {
+			percent = 100
+		}
+		mark = int64(percent)
+	}
+
+	t.mu.Lock()
+	if mark == t.lastReport {
+		t.mu.Unlock()
+		return
+	}
+	t.lastReport = mark
+	stage := t.stage
+	t.mu.Unlock()
+
+	data := adminevent.ThemeInstallData{Stage: stage, Percent: percent, Done: done}
+	if total > 0 {
+		data.Total = total
+	}
+	t.publish(data)
+}
+
+// beginCommit 进入写入数据库阶段，此后取消请求会被拒绝；已被取消时返回错误
+func (t *installTask) beginCommit(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	if t.canceled {
+		t.mu.Unlock()
+		return ErrInstallCanceled
+	}
+	if err := ctx.Err(); err != nil {
+		t.mu.Unlock()
+		return err
+	}
+	t.committing = true
+	t.stage = adminevent.StageCommitting
+	t.mu.Unlock()
+	t.publish(adminevent.ThemeInstallData{Stage: adminevent.StageCommitting})
+	return nil
+}
+
+func (t *installTask) publish(data adminevent.ThemeInstallData) {
+	data.Theme = t.theme
+	adminevent.Publish(adminevent.TypeThemeInstall, data)
+}
@@ -0,0 +1,307 @@
+/*
+ * @Description: 已安装主题的完整性复检
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 主题安装/上传校验通过后，记录主题目录下每个文件的大小和 SHA-256 作为文件清单。
+ * 复检时重新执行安装时的校验（目录结构、theme.json、index.html、禁止的文件类型），
+ * 并与文件清单比对，报告安装后新增、删除和被修改的文件，用于发现手动改动或共享主机上的篡改。
+ * 确认改动无误后可以重建文件清单，以当前文件作为新的基线。
+ */
+package theme
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// themeManifestDir 主题文件清单的存储目录
+// 与冒烟测试结果一样不放在主题目录内，清单本身不会被当作主题文件比对，也不会被复制到 static 目录
+const themeManifestDir = ".manifests"
+
+// ThemeManifestFile 文件清单中的单个文件
+type ThemeManifestFile struct {
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// ThemeManifest 主题文件清单，键为相对主题目录的路径（使用 / 分隔）
+type ThemeManifest struct {
+	ThemeName string                       `json:"theme_name"`
+	Version   string                       `json:"version"`
+	CreatedAt time.Time                    `json:"created_at"`
+	Files     map[string]ThemeManifestFile `json:"files"`
+}
+
+// ThemeIntegrityReport 主题完整性复检结果
+type ThemeIntegrityReport struct {
+	ThemeName         string     `json:"theme_name"`
+	Passed            bool       `json:"passed"`                        // 校验全部通过且没有文件变更
+	Errors            []string   `json:"errors"`                        // 安装校验流程发现的问题
+	Warnings          []string   `json:"warnings"`                      // 不影响使用的问题
+	ForbiddenFiles    []string   `json:"forbidden_files"`               // 禁止的文件类型
+	HasManifest       bool       `json:"has_manifest"`                  // 是否有安装时记录的文件清单
+	ManifestCreatedAt *time.Time `json:"manifest_created_at,omitempty"` // 文件清单的记录时间
+	ManifestVersion   string     `json:"manifest_version,omitempty"`    // 记录清单时的主题版本
+	Added             []string   `json:"added"`                         // 安装后新增的文件
+	Removed           []string   `json:"removed"`                       // 安装后删除的文件
+	Modified          []string   `json:"modified"`                      // 内容发生变化的文件
+	FileCount         int        `json:"file_count"`
+	CheckedAt         time.Time  `json:"checked_at"`
+	Duration          int64      `json:"duration_ms"`
+}
+
+// VerifyThemeIntegrity 对已安装主题重新执行安装校验，并与安装时的文件清单比对
+func (s *themeService) VerifyThemeIntegrity(ctx context.Context, themeName string) (*ThemeIntegrityReport, error) {
+	themeDir, err := s.integrityThemeDir(themeName)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	report := &ThemeIntegrityReport{
+		ThemeName:      themeName,
+		Errors:         []string{},
+		Warnings:       []string{},
+		ForbiddenFiles: []string{},
+		Added:          []string{},
+		Removed:        []string{},
+		Modified:       []string{},
+		CheckedAt:      start,
+	}
+
+	// 1. 目录结构和 index.html
+	if err := s.validateThemeFiles(themeDir); err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	} else if err := s.validateExtractedTheme(themeDir, nil); err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	}
+
+	// 2. theme.json，与上传和 theme.json 编辑器使用同一套校验
+	if content, err := os.ReadFile(themeJSONPath(themeName)); err == nil {
+		validation := s.ValidateThemeJSON(ctx, themeName, string(content))
+		report.Errors = append(report.Errors, validation.Errors...)
+		report.Warnings = append(report.Warnings, validation.Warnings...)
+	} else if !os.IsNotExist(err) {
+		report.Errors = append(report.Errors, fmt.Sprintf("读取 theme.json 失败: %v", err))
+	}
+
+	// 3. 禁止的文件类型和当前文件哈希
+	files, err := hashThemeFiles(ctx, themeDir)
+	if err != nil {
+		return nil, fmt.Errorf("读取主题文件失败: %w", err)
+	}
+	report.FileCount = len(files)
+	for _, rel := range sortedManifestPaths(files) {
+		if err := s.validateFileType(rel); err != nil {
+			report.ForbiddenFiles = append(report.ForbiddenFiles, rel)
+		}
+	}
+	if len(report.ForbiddenFiles) > 0 {
+		report.Errors = append(report.Errors, fmt.Sprintf("主题目录中包含 %d 个禁止的文件类型", len(report.ForbiddenFiles)))
+	}
+
+	// 4. 与安装时的文件清单比对
+	manifest, err := loadThemeManifest(themeName)
+	switch {
+	case err == nil:
+		report.HasManifest = true
+		report.ManifestCreatedAt = &manifest.CreatedAt
+		report.ManifestVersion = manifest.Version
+		for _, rel := range sortedManifestPaths(files) {
+			old, ok := manifest.Files[rel]
+			if !ok {
+				report.Added = append(report.Added, rel)
+			} else if old != files[rel] {
+				report.Modified = append(report.Modified, rel)
+			}
+		}
+		for _, rel := range sortedManifestPaths(manifest.Files) {
+			if _, ok := files[rel]; !ok {
+				report.Removed = append(report.Removed, rel)
+			}
+		}
+	case os.IsNotExist(err):
+		report.Warnings = append(report.Warnings, "没有安装时记录的文件清单（主题可能安装于该功能上线之前），无法比对文件变更，可重建文件清单作为基线")
+	default:
+		report.Warnings = append(report.Warnings, fmt.Sprintf("文件清单损坏，无法比对文件变更: %v", err))
+	}
+
+	drift := len(report.Added) + len(report.Removed) + len(report.Modified)
+	report.Passed = len(report.Errors) == 0 && drift == 0
+	report.Duration = time.Since(start).Milliseconds()
+	log.Printf("主题 %s 完整性复检完成: passed=%v, 错误=%d, 新增=%d, 删除=%d, 修改=%d",
+		themeName, report.Passed, len(report.Errors), len(report.Added), len(report.Removed), len(report.Modified))
+	return report, nil
+}
+
+// RebuildThemeManifest 以主题当前文件重建文件清单，返回重建后的复检结果
+func (s *themeService) RebuildThemeManifest(ctx context.Context, themeName string) (*ThemeIntegrityReport, error) {
+	if _, err := s.integrityThemeDir(themeName); err != nil {
+		return nil, err
+	}
+	if err := s.recordThemeManifest(ctx, themeName); err != nil {
+		return nil, err
+	}
+	log.Printf("主题 %s 的文件清单已重建", themeName)
+	return s.VerifyThemeIntegrity(ctx, themeName)
+}
+
+// integrityThemeDir 检查主题名称并返回主题目录，官方主题内置于程序中，不需要复检
+func (s *themeService) integrityThemeDir(themeName string) (string, error) {
+	if err := validateThemeName(themeName); err != nil {
+		return "", err
+	}
+	if s.isOfficialTheme(themeName) {
+		return "", fmt.Errorf("官方主题内置于程序中，无需完整性校验")
+	}
+	themeDir := filepath.Join(ThemesDirName, themeName)
+	if info, err := os.Stat(themeDir); err != nil || !info.IsDir() {
+		return "", fmt.Errorf("%w: %s", ErrThemeNotInstalled, themeName)
+	}
+	return themeDir, nil
+}
+
+// recordThemeManifest 记录主题当前文件的清单，安装/上传校验通过后调用
+func (s *themeService) recordThemeManifest(ctx context.Context, themeName string) error {
+	themeDir := filepath.Join(ThemesDirName, themeName)
+	files, err := hashThemeFiles(ctx, themeDir)
+	if err != nil {
+		return fmt.Errorf("计算主题文件哈希失败: %w", err)
+	}
+	manifest := ThemeManifest{
+		ThemeName: themeName,
+		CreatedAt: time.Now(),
+		Files:     files,
+	}
+	if metadata, err := s.loadThemeMetadataFromDisk(themeName); err == nil {
+		manifest.Version = metadata.Version
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(ThemesDirName, themeManifestDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("创建文件清单目录失败: %w", err)
+	}
+	if err := writeFileAtomic(themeManifestPath(themeName), data); err != nil {
+		return fmt.Errorf("保存文件清单失败: %w", err)
+	}
+	return nil
+}
+
+// updateThemeManifestFile 通过后台修改主题文件后同步更新清单中该文件的记录，没有清单时不处理
+func updateThemeManifestFile(themeName, rel string) {
+	manifest, err := loadThemeManifest(themeName)
+	if err != nil {
+		return
+	}
+	entry, err := hashFile(filepath.Join(ThemesDirName, themeName, filepath.FromSlash(rel)))
+	if err != nil {
+		log.Printf("更新主题 %s 文件清单失败: %v", themeName, err)
+		return
+	}
+	manifest.Files[rel] = entry
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := writeFileAtomic(themeManifestPath(themeName), data); err != nil {
+		log.Printf("更新主题 %s 文件清单失败: %v", themeName, err)
+	}
+}
+
+// removeThemeManifest 卸载主题时删除文件清单
+func removeThemeManifest(themeName string) {
+	if err := os.Remove(themeManifestPath(themeName)); err != nil && !os.IsNotExist(err) {
+		log.Printf("删除主题 %s 文件清单失败: %v", themeName, err)
+	}
+}
+
+func themeManifestPath(themeName string) string {
+	return filepath.Join(ThemesDirName, themeManifestDir, themeName+".json")
+}
+
+func loadThemeManifest(themeName string) (*ThemeManifest, error) {
+	data, err := os.ReadFile(themeManifestPath(themeName))
+	if err != nil {
+		return nil, err
+	}
+	var manifest ThemeManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Files == nil {
+		manifest.Files = map[string]ThemeManifestFile{}
+	}
+	return &manifest, nil
+}
+
+// hashThemeFiles 计算主题目录下所有文件的大小和 SHA-256，符号链接不跟随，按其指向的路径计算哈希
+func hashThemeFiles(ctx context.Context, themeDir string) (map[string]ThemeManifestFile, error) {
+	files := make(map[string]ThemeManifestFile)
+	err := filepath.WalkDir(themeDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(themeDir, path)
+		if err != nil {
+			return err
+		}
+		var entry ThemeManifestFile
+		if d.Type()&fs.ModeSymlink != 0 {
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			sum := sha256.Sum256([]byte("symlink:" + target))
+			entry = ThemeManifestFile{SHA256: hex.EncodeToString(sum[:])}
+		} else if entry, err = hashFile(path); err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = entry
+		return nil
+	})
+	return files, err
+}
+
+func hashFile(path string) (ThemeManifestFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return ThemeManifestFile{}, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return ThemeManifestFile{}, err
+	}
+	return ThemeManifestFile{Size: n, SHA256: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+func sortedManifestPaths(files map[string]ThemeManifestFile) []string {
+	paths := make([]string, 0, len(files))
+	for rel := range files {
+		paths = append(paths, rel)
+	}
+	sort.Strings(paths)
+	return paths
+}
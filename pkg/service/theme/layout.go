@@ -0,0 +1,100 @@
+/*
+ * @Description: 主题声明的菜单位置和小工具区域
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 主题在 theme.json 中通过 menu_locations 和 widget_areas 声明可放置菜单和小工具的位置，
+ * 后台据此为各位置分配菜单和小工具，主题本身不必为每个站点硬编码导航和侧边栏内容。
+ */
+package theme
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// layoutNameRegex 菜单位置和小工具区域的名称
+var layoutNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,63}$`)
+
+// ThemeMenuLocation 主题声明的菜单位置
+type ThemeMenuLocation struct {
+	Name        string `json:"name"`                  // 位置标识（如 header、footer）
+	Label       string `json:"label"`                 // 显示名称
+	Description string `json:"description,omitempty"` // 说明
+}
+
+// ThemeWidgetArea 主题声明的小工具区域
+type ThemeWidgetArea struct {
+	Name        string `json:"name"`                  // 区域标识（如 sidebar、footer）
+	Label       string `json:"label"`                 // 显示名称
+	Description string `json:"description,omitempty"` // 说明
+	MaxWidgets  int    `json:"max_widgets,omitempty"` // 最多放置的小工具数量，0 表示不限制
+}
+
+// ThemeLayoutAreas 主题声明的全部菜单位置和小工具区域
+type ThemeLayoutAreas struct {
+	MenuLocations []ThemeMenuLocation `json:"menu_locations"`
+	WidgetAreas   []ThemeWidgetArea   `json:"widget_areas"`
+}
+
+// GetThemeLayoutAreas 读取主题 theme.json 声明的菜单位置和小工具区域，官方主题没有可声明的区域
+func (s *themeService) GetThemeLayoutAreas(ctx context.Context, themeName string) (*ThemeLayoutAreas, error) {
+	areas := &ThemeLayoutAreas{MenuLocations: []ThemeMenuLocation{}, WidgetAreas: []ThemeWidgetArea{}}
+	if s.isOfficialTheme(themeName) {
+		return areas, nil
+	}
+	metadata, err := s.loadThemeMetadataFromDisk(themeName)
+	if err != nil {
+		return nil, fmt.Errorf("读取主题元数据失败: %w", err)
+	}
+	if metadata.MenuLocations != nil {
+		areas.MenuLocations = metadata.MenuLocations
+	}
+	if metadata.WidgetAreas != nil {
+		areas.WidgetAreas = metadata.WidgetAreas
+	}
+	return areas, nil
+}
+
+// GetCurrentThemeName 返回当前生效的主题名称，SSR 主题优先
+func (s *themeService) GetCurrentThemeName(ctx context.Context, userID uint) (string, error) {
+	if name, ok := s.GetCurrentSSRThemeName(ctx, userID); ok {
+		return name, nil
+	}
+	current, err := s.GetCurrentTheme(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if current.IsOfficial {
+		return OfficialThemeName, nil
+	}
+	return current.Name, nil
+}
+
+// validateLayoutAreas 校验 theme.json 中菜单位置和小工具区域的声明，返回错误信息
+func validateLayoutAreas(metadata *ThemeMetadata) []string {
+	var errs []string
+	seen := make(map[string]bool)
+	for _, loc := range metadata.MenuLocations {
+		if !layoutNameRegex.MatchString(loc.Name) {
+			errs = append(errs, fmt.Sprintf("theme.json 中菜单位置名称 %q 无效，只能包含小写字母、数字、下划线和短横线", loc.Name))
+		} else if seen[loc.Name] {
+			errs = append(errs, fmt.Sprintf("theme.json 中菜单位置 %s 重复声明", loc.Name))
+		}
+		seen[loc.Name] = true
+	}
+	seen = make(map[string]bool)
+	for _, area := range metadata.WidgetAreas {
+		if !layoutNameRegex.MatchString(area.Name) {
+			errs = append(errs, fmt.Sprintf("theme.json 中小工具区域名称 %q 无效，只能包含小写字母、数字、下划线和短横线", area.Name))
+		} else if seen[area.Name] {
+			errs = append(errs, fmt.Sprintf("theme.json 中小工具区域 %s 重复声明", area.Name))
+		}
+		if area.MaxWidgets < 0 {
+			errs = append(errs, fmt.Sprintf("theme.json 中小工具区域 %s 的 max_widgets 不能为负数", area.Name))
+		}
+		seen[area.Name] = true
+	}
+	return errs
+}
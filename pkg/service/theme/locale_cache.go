@@ -0,0 +1,189 @@
+package theme
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// themeLocales 一个主题的全部语言文件
+type themeLocales struct {
+	available     []string
+	messages      map[string]map[string]interface{}
+	invalid       map[string]error // 无法读取或格式错误的语言文件
+	defaultLocale string
+	err           error // 读取 locales 目录失败（目录不存在时为 nil）
+}
+
+// localeCache 主题语言文件的内存缓存。启动时加载全部主题的语言文件，
+// 之后监听主题目录，主题被安装、更新、上传或手动修改时重新加载该主题
+type localeCache struct {
+	root string
+	load func(themeName string) *themeLocales
+
+	mu         sync.RWMutex
+	themes     map[string]*themeLocales
+	generation map[string]int // 每次失效递增，避免失效前开始的加载写回旧数据
+	watcher    *fsnotify.Watcher
+}
+
+func newLocaleCache(root string, load func(themeName string) *themeLocales) *localeCache {
+	return &localeCache{
+		root:       root,
+		load:       load,
+		themes:     make(map[string]*themeLocales),
+		generation: make(map[string]int),
+	}
+}
+
+// start 开始监听主题目录并加载已安装主题的语言文件；监听不可用时缓存不生效，每次都从磁盘读取
+func (c *localeCache) start() {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Printf("[主题语言] 创建文件监听失败，语言文件将每次从磁盘读取: %v", err)
+		return
+	}
+	if err := watcher.Add(c.root); err != nil {
+		log.Printf("[主题语言] 监听主题目录失败，语言文件将每次从磁盘读取: %v", err)
+		watcher.Close()
+		return
+	}
+	c.watcher = watcher
+
+	entries, _ := os.ReadDir(c.root)
+	for _, entry := range entries {
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			c.watchTheme(entry.Name())
+			c.get(entry.Name())
+		}
+	}
+	go c.watch()
+}
+
+// watchTheme 监听主题目录（theme.json）和 locales 目录，目录不存在时忽略
+func (c *localeCache) watchTheme(themeName string) {
+	themeDir := filepath.Join(c.root, themeName)
+	_ = c.watcher.Add(themeDir)
+	_ = c.watcher.Add(filepath.Join(themeDir, LocalesDirName))
+}
+
+func (c *localeCache) watch() {
+	for {
+		select {
+		case ev, ok := <-c.watcher.Events:
+			if !ok {
+				return
+			}
+			rel, err := filepath.Rel(c.root, ev.Name)
+			if err != nil {
+				continue
+			}
+			parts := strings.Split(filepath.ToSlash(rel), "/")
+			themeName := parts[0]
+			if themeName == "." || strings.HasPrefix(themeName, ".") {
+				continue
+			}
+			// 新建或替换的主题目录、新建的 locales 目录需要重新加入监听
+			if ev.Has(fsnotify.Create) && len(parts) <= 2 {
+				c.watchTheme(themeName)
+			}
+			c.invalidate(themeName)
+		case err, ok := <-c.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("[主题语言] 文件监听出错: %v", err)
+		}
+	}
+}
+
+// get 返回主题的语言文件，未缓存时从磁盘加载
+func (c *localeCache) get(themeName string) *themeLocales {
+	if c.watcher == nil {
+		return c.load(themeName)
+	}
+
+	c.mu.RLock()
+	cached, ok := c.themes[themeName]
+	generation := c.generation[themeName]
+	c.mu.RUnlock()
+	if ok {
+		return cached
+	}
+
+	loaded := c.load(themeName)
+	c.mu.Lock()
+	if c.generation[themeName] == generation {
+		c.themes[themeName] = loaded
+	}
+	c.mu.Unlock()
+	return loaded
+}
+
+func (c *localeCache) invalidate(themeName string) {
+	c.mu.Lock()
+	delete(c.themes, themeName)
+	c.generation[themeName]++
+	c.mu.Unlock()
+}
+
+// readThemeLocales 从磁盘读取主题的全部语言文件和默认语言
+func (s *themeService) readThemeLocales(themeName string) *themeLocales {
+	t := &themeLocales{
+		available:     []string{},
+		messages:      make(map[string]map[string]interface{}),
+		invalid:       make(map[string]error),
+		defaultLocale: DefaultThemeLocale,
+	}
+	if metadata, err := s.loadThemeMetadataFromDisk(themeName); err == nil && metadata.DefaultLocale != "" {
+		t.defaultLocale = metadata.DefaultLocale
+	}
+
+	localesDir := filepath.Join(ThemesDirName, themeName, LocalesDirName)
+	entries, err := os.ReadDir(localesDir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			t.err = err
+		}
+		return t
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		locale := strings.TrimSuffix(name, ".json")
+		if !localeNamePattern.MatchString(locale) {
+			continue
+		}
+		t.available = append(t.available, locale)
+		if messages, err := readLocaleFile(filepath.Join(localesDir, name)); err != nil {
+			t.invalid[locale] = err
+		} else {
+			t.messages[locale] = messages
+		}
+	}
+	sort.Strings(t.available)
+	return t
+}
+
+func readLocaleFile(localePath string) (map[string]interface{}, error) {
+	info, err := os.Stat(localePath)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxLocaleFileSize {
+		return nil, fmt.Errorf("语言文件 %s 超过 1MB", filepath.Base(localePath))
+	}
+	data, err := os.ReadFile(localePath)
+	if err != nil {
+		return nil, err
+	}
+	return parseLocaleMessages(data)
+}
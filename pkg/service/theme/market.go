@@ -0,0 +1,392 @@
+/*
+ * @Description: 主题商城多来源配置，合并官方商城和自建主题源的列表，并把下载和评分请求路由到主题所属的来源
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package theme
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// OfficialMarketSourceID 官方主题商城的来源 ID
+const OfficialMarketSourceID = "official"
+
+var (
+	// ErrMarketSourceNotFound 主题来源不存在或未启用
+	ErrMarketSourceNotFound = errors.New("主题来源不存在或未启用")
+	// ErrMarketRatingUnsupported 主题来源不支持评分
+	ErrMarketRatingUnsupported = errors.New("该主题来源不支持评分")
+
+	// errMarketEndpointMissing 来源没有提供对应的接口
+	errMarketEndpointMissing = errors.New("来源未提供该接口")
+)
+
+// MarketSource 主题商城来源
+type MarketSource struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	URL     string `json:"url"`               // 主题列表接口，返回 {"list":[...]} 或 {"code":0,"data":{"list":[...]}}
+	Enabled *bool  `json:"enabled,omitempty"` // 未填写时视为启用
+	Token   string `json:"token,omitempty"`   // 私有主题源的访问令牌，以 Bearer 方式发送
+
+	// 下载统计和评分接口，{id} 替换为主题在该来源中的 ID；未填写时使用 列表接口/{id}/download 和 列表接口/{id}/rating
+	DownloadAPI string `json:"download_api,omitempty"`
+	RatingAPI   string `json:"rating_api,omitempty"`
+}
+
+// MarketSourceInfo 主题来源信息（不包含令牌）
+type MarketSourceInfo struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	Official bool   `json:"official"`
+	HasToken bool   `json:"has_token"`
+}
+
+func (src MarketSource) enabled() bool {
+	return src.Enabled == nil || *src.Enabled
+}
+
+func (src MarketSource) api(template, suffix string, marketID int) string {
+	if template == "" {
+		template = strings.TrimRight(src.URL, "/") + suffix
+	}
+	return strings.ReplaceAll(template, "{id}", strconv.Itoa(marketID))
+}
+
+func defaultOfficialMarketSource() MarketSource {
+	return MarketSource{ID: OfficialMarketSourceID, Name: "官方商城", URL: ThemeMarketAPI}
+}
+
+// MarketSourcesProvider 返回配置的主题商城来源
+type MarketSourcesProvider func() []MarketSource
+
+var (
+	marketSourcesMu       sync.RWMutex
+	marketSourcesProvider MarketSourcesProvider
+)
+
+// SetMarketSourcesProvider 设置主题商城来源，未设置时只使用官方商城
+func SetMarketSourcesProvider(provider MarketSourcesProvider) {
+	marketSourcesMu.Lock()
+	marketSourcesProvider = provider
+	marketSourcesMu.Unlock()
+}
+
+// NewSettingMarketSourcesProvider 基于后台配置 theme.market_sources 的主题商城来源
+func NewSettingMarketSourcesProvider(settings settingGetter) MarketSourcesProvider {
+	return func() []MarketSource {
+		raw := strings.TrimSpace(settings.Get(constant.KeyThemeMarketSources.String()))
+		if raw == "" {
+			return nil
+		}
+		var sources []MarketSource
+		if err := json.Unmarshal([]byte(raw), &sources); err != nil {
+			log.Printf("[主题商城] 解析主题来源配置失败，只使用官方商城: %v", err)
+			return nil
+		}
+		return sources
+	}
+}
+
+// currentMarketSources 返回启用的主题来源，官方商城未配置时排在第一位；
+// 配置中的 official 项可以替换官方商城地址（如镜像）或将其禁用
+func currentMarketSources() []MarketSource {
+	marketSourcesMu.RLock()
+	provider := marketSourcesProvider
+	marketSourcesMu.RUnlock()
+
+	var configured []MarketSource
+	if provider != nil {
+		configured = provider()
+	}
+
+	hasOfficial := false
+	for _, src := range configured {
+		if strings.TrimSpace(src.ID) == OfficialMarketSourceID {
+			hasOfficial = true
+			break
+		}
+	}
+	if !hasOfficial {
+		configured = append([]MarketSource{defaultOfficialMarketSource()}, configured...)
+	}
+
+	seen := make(map[string]bool, len(configured))
+	sources := make([]MarketSource, 0, len(configured))
+	for _, src := range configured {
+		src.ID = strings.TrimSpace(src.ID)
+		src.URL = strings.TrimSpace(src.URL)
+		if src.ID == OfficialMarketSourceID && src.URL == "" {
+			src.URL = ThemeMarketAPI
+		}
+		if src.ID == "" || src.URL == "" || seen[src.ID] || !src.enabled() {
+			continue
+		}
+		seen[src.ID] = true
+		if src.Name == "" {
+			src.Name = src.ID
+		}
+		sources = append(sources, src)
+	}
+	return sources
+}
+
+func findMarketSource(id string) (MarketSource, bool) {
+	if id == "" {
+		id = OfficialMarketSourceID
+	}
+	for _, src := range currentMarketSources() {
+		if src.ID == id {
+			return src, true
+		}
+	}
+	return MarketSource{}, false
+}
+
+// ListMarketSources 获取启用的主题商城来源
+func (s *themeService) ListMarketSources() []MarketSourceInfo {
+	sources := currentMarketSources()
+	result := make([]MarketSourceInfo, 0, len(sources))
+	for _, src := range sources {
+		result = append(result, MarketSourceInfo{
+			ID:       src.ID,
+			Name:     src.Name,
+			URL:      src.URL,
+			Official: src.ID == OfficialMarketSourceID,
+			HasToken: src.Token != "",
+		})
+	}
+	return result
+}
+
+// fetchMarketSources 并发获取多个来源的主题列表，按来源顺序合并，同名主题保留排在前面的来源
+func fetchMarketSources(ctx context.Context, sources []MarketSource, fetched map[string][]*MarketTheme) []*MarketTheme {
+	lists := make([][]*MarketTheme, len(sources))
+	var wg sync.WaitGroup
+	for i, src := range sources {
+		if list, ok := fetched[src.ID]; ok {
+			lists[i] = labelMarketThemes(src, list)
+			continue
+		}
+		wg.Add(1)
+		go func(i int, src MarketSource) {
+			defer wg.Done()
+			list, err := fetchMarketSource(ctx, src)
+			if err != nil {
+				// 单个来源不可用时跳过，确保其他来源的主题仍可浏览
+				log.Printf("[主题商城] 获取来源 %s 的主题列表失败: %v", src.ID, err)
+				return
+			}
+			lists[i] = list
+		}(i, src)
+	}
+	wg.Wait()
+	return mergeMarketThemes(lists)
+}
+
+func mergeMarketThemes(lists [][]*MarketTheme) []*MarketTheme {
+	merged := []*MarketTheme{}
+	seen := make(map[string]string)
+	for _, list := range lists {
+		for _, t := range list {
+			if t == nil || t.Name == "" {
+				continue
+			}
+			if source, dup := seen[t.Name]; dup {
+				log.Printf("[主题商城] 主题 %s 同时出现在来源 %s 和 %s 中，使用 %s 的版本", t.Name, source, t.Source, source)
+				continue
+			}
+			seen[t.Name] = t.Source
+			merged = append(merged, t)
+		}
+	}
+	return merged
+}
+
+// fetchMarketSource 获取单个来源的主题列表
+func fetchMarketSource(ctx context.Context, src MarketSource) ([]*MarketTheme, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if src.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+src.Token)
+	}
+
+	resp, err := themeMarketClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("返回错误状态码: %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("读取响应失败: %w", err)
+	}
+	list, err := parseMarketList(body)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[主题商城] 从来源 %s 获取到 %d 个主题", src.ID, len(list))
+	return labelMarketThemes(src, list), nil
+}
+
+// parseMarketList 解析主题列表，支持直接格式 {"list":[...]} 和包装格式 {"code":0,"data":{"list":[...]}}
+func parseMarketList(body []byte) ([]*MarketTheme, error) {
+	var resp struct {
+		Code    int            `json:"code"`
+		Message string         `json:"message"`
+		List    []*MarketTheme `json:"list"`
+		Data    struct {
+			List []*MarketTheme `json:"list"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("解析响应失败: %w", err)
+	}
+	if resp.List != nil {
+		return resp.List, nil
+	}
+	// 官网 API 成功时返回 code: 0
+	if resp.Code != 0 && resp.Code != 200 {
+		return nil, fmt.Errorf("返回错误码: %d, 消息: %s", resp.Code, resp.Message)
+	}
+	if resp.Data.List == nil {
+		return []*MarketTheme{}, nil
+	}
+	return resp.Data.List, nil
+}
+
+// labelMarketThemes 标记主题所属的来源，并把相对地址解析为来源下的绝对地址
+func labelMarketThemes(src MarketSource, list []*MarketTheme) []*MarketTheme {
+	base, _ := url.Parse(src.URL)
+	resolve := func(ref string) string {
+		if ref == "" || base == nil {
+			return ref
+		}
+		u, err := base.Parse(ref)
+		if err != nil {
+			return ref
+		}
+		return u.String()
+	}
+	for _, t := range list {
+		if t == nil {
+			continue
+		}
+		t.Source = src.ID
+		t.SourceName = src.Name
+		t.DownloadURL = resolve(t.DownloadURL)
+		t.PreviewURL = resolve(t.PreviewURL)
+	}
+	return list
+}
+
+// resolveMarketDownload 从主题所属来源的列表中取出下载地址和校验和，找不到时沿用请求中的地址
+func resolveMarketDownload(ctx context.Context, req *ThemeInstallRequest) error {
+	if req.Source == "" || req.MarketID <= 0 {
+		return nil
+	}
+	src, ok := findMarketSource(req.Source)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrMarketSourceNotFound, req.Source)
+	}
+	list, err := fetchMarketSource(ctx, src)
+	if err != nil {
+		log.Printf("[主题商城] 获取来源 %s 的主题列表失败，使用请求中的下载地址: %v", src.ID, err)
+		return nil
+	}
+	for _, t := range list {
+		if t.ID == req.MarketID && t.Name == req.ThemeName {
+			if t.DownloadURL != "" {
+				req.DownloadURL = t.DownloadURL
+			}
+			if req.Checksum == "" {
+				req.Checksum = t.Checksum
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// reportMarketDownload 向主题所属来源上报一次下载，失败不影响安装
+func reportMarketDownload(ctx context.Context, req *ThemeInstallRequest) {
+	if req.MarketID <= 0 {
+		return
+	}
+	src, ok := findMarketSource(req.Source)
+	if !ok {
+		return
+	}
+	if err := postMarketSource(ctx, src, src.api(src.DownloadAPI, "/{id}/download", req.MarketID), map[string]any{
+		"name":    req.ThemeName,
+		"version": req.Version,
+	}); err != nil {
+		log.Printf("[主题商城] 向来源 %s 上报主题 %s 的下载失败: %v", src.ID, req.ThemeName, err)
+	}
+}
+
+// RateMarketTheme 为主题评分，评分提交到主题所属的来源
+func (s *themeService) RateMarketTheme(ctx context.Context, source string, marketID int, rating int) error {
+	if marketID <= 0 || rating < 1 || rating > 5 {
+		return errors.New("主题 ID 无效或评分不在 1-5 之间")
+	}
+	src, ok := findMarketSource(source)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrMarketSourceNotFound, source)
+	}
+	endpoint := src.api(src.RatingAPI, "/{id}/rating", marketID)
+	if err := postMarketSource(ctx, src, endpoint, map[string]any{"rating": rating}); err != nil {
+		if errors.Is(err, errMarketEndpointMissing) {
+			return ErrMarketRatingUnsupported
+		}
+		return fmt.Errorf("提交评分到来源 %s 失败: %w", src.ID, err)
+	}
+	return nil
+}
+
+func postMarketSource(ctx context.Context, src MarketSource, endpoint string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("创建请求失败: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if src.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+src.Token)
+	}
+	resp, err := themeMarketClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed:
+		return errMarketEndpointMissing
+	case resp.StatusCode >= 300:
+		return fmt.Errorf("返回错误状态码: %d", resp.StatusCode)
+	}
+	return nil
+}
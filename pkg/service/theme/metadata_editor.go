@@ -0,0 +1,312 @@
+/*
+ * @Description: theme.json 修复编辑器
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * theme.json 损坏（JSON 格式错误或缺少必需字段）时主题配置、布局区域等接口会一直失败，
+ * 管理员可以在后台读取原始内容、校验修改后的内容，再安全地写回：
+ *   - 写回前必须通过与上传主题相同的元信息校验，且 name 必须与主题目录名一致；
+ *   - 原文件先备份到 backup/theme_json/{主题名}/，每个主题保留最近 maxThemeJSONBackups 份；
+ *   - 先写临时文件再重命名，写入过程中断不会留下半个文件；
+ *   - 主题正在以静态模式使用时，static/theme.json 同步更新。
+ */
+package theme
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// themeJSONBackupDir theme.json 备份目录，位于 backup/ 下
+	themeJSONBackupDir = "theme_json"
+	// maxThemeJSONBackups 每个主题保留的 theme.json 备份数
+	maxThemeJSONBackups = 10
+	// maxThemeJSONSize theme.json 的大小上限
+	maxThemeJSONSize = 1 << 20
+	// themeJSONBackupTimeLayout 备份文件名中的时间格式
+	themeJSONBackupTimeLayout = "20060102-150405"
+)
+
+// ErrInvalidThemeJSON 提交的 theme.json 未通过校验
+var ErrInvalidThemeJSON = errors.New("theme.json 校验未通过")
+
+// ThemeJSONDocument theme.json 原始内容
+type ThemeJSONDocument struct {
+	ThemeName  string               `json:"theme_name"`
+	Content    string               `json:"content"`
+	ModifiedAt time.Time            `json:"modified_at"`
+	Validation *ThemeJSONValidation `json:"validation"` // 当前内容的校验结果
+	Backups    []ThemeJSONBackup    `json:"backups"`    // 已有备份，按时间倒序
+}
+
+// ThemeJSONBackup theme.json 备份
+type ThemeJSONBackup struct {
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ThemeJSONValidation theme.json 校验结果
+type ThemeJSONValidation struct {
+	Valid    bool           `json:"valid"`
+	Errors   []string       `json:"errors"`
+	Warnings []string       `json:"warnings"`
+	Line     int            `json:"line,omitempty"`   // JSON 格式错误所在行
+	Column   int            `json:"column,omitempty"` // JSON 格式错误所在列
+	Metadata *ThemeMetadata `json:"metadata,omitempty"`
+}
+
+// GetThemeJSON 读取主题 theme.json 的原始内容，backup 不为空时读取指定的备份；内容无法解析时同样返回
+func (s *themeService) GetThemeJSON(ctx context.Context, themeName, backup string) (*ThemeJSONDocument, error) {
+	if err := s.checkEditableTheme(themeName); err != nil {
+		return nil, err
+	}
+	path := themeJSONPath(themeName)
+	if backup != "" {
+		if !isThemeJSONBackupName(backup) {
+			return nil, fmt.Errorf("非法的备份名称: %s", backup)
+		}
+		path = filepath.Join(themeJSONBackupPath(themeName), backup)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%s 不存在", filepath.ToSlash(path))
+		}
+		return nil, err
+	}
+	if info.Size() > maxThemeJSONSize {
+		return nil, fmt.Errorf("theme.json 超过 1MB，请检查文件是否正确")
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取 theme.json 失败: %w", err)
+	}
+	return &ThemeJSONDocument{
+		ThemeName:  themeName,
+		Content:    string(content),
+		ModifiedAt: info.ModTime(),
+		Validation: s.ValidateThemeJSON(ctx, themeName, string(content)),
+		Backups:    listThemeJSONBackups(themeName),
+	}, nil
+}
+
+// ValidateThemeJSON 按上传主题时的规则校验 theme.json 内容，不写入文件
+func (s *themeService) ValidateThemeJSON(ctx context.Context, themeName, content string) *ThemeJSONValidation {
+	result := &ThemeJSONValidation{Errors: []string{}, Warnings: []string{}}
+	if len(content) > maxThemeJSONSize {
+		result.Errors = append(result.Errors, "theme.json 不能超过 1MB")
+		return result
+	}
+
+	var metadata ThemeMetadata
+	if err := json.Unmarshal([]byte(content), &metadata); err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &syntaxErr):
+			result.Line, result.Column = offsetPosition(content, syntaxErr.Offset)
+			result.Errors = append(result.Errors, fmt.Sprintf("JSON 格式错误（第 %d 行第 %d 列）: %v", result.Line, result.Column, err))
+		case errors.As(err, &typeErr):
+			result.Line, result.Column = offsetPosition(content, typeErr.Offset)
+			result.Errors = append(result.Errors, fmt.Sprintf("字段 %s 的类型应为 %s（第 %d 行第 %d 列）", typeErr.Field, typeErr.Type, result.Line, result.Column))
+		default:
+			result.Errors = append(result.Errors, fmt.Sprintf("JSON 格式错误: %v", err))
+		}
+		return result
+	}
+	result.Metadata = &metadata
+
+	result.Errors = append(result.Errors, s.validateThemeMetadata(&metadata)...)
+	if metadata.Name != "" && metadata.Name != themeName {
+		result.Errors = append(result.Errors, fmt.Sprintf("name 字段 %s 必须与主题目录名 %s 一致", metadata.Name, themeName))
+	}
+	if metadata.Extends != "" {
+		if _, err := resolveParentChain(metadata.Name, metadata.Extends); err != nil {
+			result.Errors = append(result.Errors, err.Error())
+		}
+	}
+	if metadata.DefaultLocale != "" {
+		if _, err := os.Stat(filepath.Join(ThemesDirName, themeName, LocalesDirName, metadata.DefaultLocale+".json")); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("theme.json 声明的默认语言 %s 缺少 locales/%s.json", metadata.DefaultLocale, metadata.DefaultLocale))
+		}
+	}
+	result.Errors = append(result.Errors, validateLayoutAreas(&metadata)...)
+
+	// 未知字段只提示，主题可能使用了较新版本的字段
+	decoder := json.NewDecoder(strings.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&ThemeMetadata{}); err != nil && strings.Contains(err.Error(), "unknown field") {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("包含未识别的字段: %v", err))
+	}
+
+	result.Valid = len(result.Errors) == 0
+	return result
+}
+
+// SaveThemeJSON 校验通过后备份原文件并写入新的 theme.json
+func (s *themeService) SaveThemeJSON(ctx context.Context, themeName, content string) (*ThemeJSONValidation, error) {
+	if err := s.checkEditableTheme(themeName); err != nil {
+		return nil, err
+	}
+	validation := s.ValidateThemeJSON(ctx, themeName, content)
+	if !validation.Valid {
+		return validation, fmt.Errorf("%w: %s", ErrInvalidThemeJSON, strings.Join(validation.Errors, "；"))
+	}
+
+	path := themeJSONPath(themeName)
+	old, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("读取 theme.json 失败: %w", err)
+	}
+	if err == nil {
+		if bytes.Equal(old, []byte(content)) {
+			return validation, nil
+		}
+		if err := backupThemeJSON(themeName, old); err != nil {
+			return nil, err
+		}
+	}
+	if err := writeFileAtomic(path, []byte(content)); err != nil {
+		return nil, fmt.Errorf("写入 theme.json 失败: %w", err)
+	}
+
+	// 静态模式下正在使用该主题时同步更新 static/theme.json（原文件损坏时按内容判断）
+	staticPath := filepath.Join(StaticDirName, "theme.json")
+	if staticContent, err := os.ReadFile(staticPath); err == nil {
+		var staticMeta struct {
+			Name string `json:"name"`
+		}
+		if bytes.Equal(staticContent, old) || (json.Unmarshal(staticContent, &staticMeta) == nil && staticMeta.Name == themeName) {
+			if err := writeFileAtomic(staticPath, []byte(content)); err != nil {
+				log.Printf("[theme.json 编辑] 同步 static/theme.json 失败: %v", err)
+			}
+		}
+	}
+	// 后台编辑属于预期内的修改，同步到文件清单，完整性复检时不报告为变更
+	updateThemeManifestFile(themeName, "theme.json")
+	log.Printf("[theme.json 编辑] 已更新主题 %s 的 theme.json", themeName)
+	return validation, nil
+}
+
+// checkEditableTheme 主题必须是已安装在 themes/ 下的外部主题
+func (s *themeService) checkEditableTheme(themeName string) error {
+	if err := validateThemeName(themeName); err != nil {
+		return err
+	}
+	if s.isOfficialTheme(themeName) {
+		return fmt.Errorf("官方主题没有 theme.json")
+	}
+	if info, err := os.Stat(filepath.Join(ThemesDirName, themeName)); err != nil || !info.IsDir() {
+		return fmt.Errorf("%w: %s", ErrThemeNotInstalled, themeName)
+	}
+	return nil
+}
+
+func themeJSONPath(themeName string) string {
+	return filepath.Join(ThemesDirName, themeName, "theme.json")
+}
+
+func themeJSONBackupPath(themeName string) string {
+	return filepath.Join(BackupDirName, themeJSONBackupDir, themeName)
+}
+
+// isThemeJSONBackupName 备份名称形如 theme.json.20261015-120000
+func isThemeJSONBackupName(name string) bool {
+	stamp, ok := strings.CutPrefix(name, "theme.json.")
+	if !ok {
+		return false
+	}
+	_, err := time.Parse(themeJSONBackupTimeLayout, stamp)
+	return err == nil
+}
+
+// backupThemeJSON 备份 theme.json 原内容，并删除超出数量的旧备份
+func backupThemeJSON(themeName string, content []byte) error {
+	dir := themeJSONBackupPath(themeName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("创建 theme.json 备份目录失败: %w", err)
+	}
+	name := "theme.json." + time.Now().Format(themeJSONBackupTimeLayout)
+	if err := os.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+		return fmt.Errorf("备份 theme.json 失败: %w", err)
+	}
+	backups := listThemeJSONBackups(themeName)
+	for i := maxThemeJSONBackups; i < len(backups); i++ {
+		if err := os.Remove(filepath.Join(dir, backups[i].Name)); err != nil {
+			log.Printf("[theme.json 编辑] 删除旧备份 %s 失败: %v", backups[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// listThemeJSONBackups 列出主题的 theme.json 备份，按时间倒序
+func listThemeJSONBackups(themeName string) []ThemeJSONBackup {
+	backups := []ThemeJSONBackup{}
+	entries, err := os.ReadDir(themeJSONBackupPath(themeName))
+	if err != nil {
+		return backups
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !isThemeJSONBackupName(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		createdAt, _ := time.ParseInLocation(themeJSONBackupTimeLayout, strings.TrimPrefix(entry.Name(), "theme.json."), time.Local)
+		backups = append(backups, ThemeJSONBackup{Name: entry.Name(), Size: info.Size(), CreatedAt: createdAt})
+	}
+	// 时间格式可按字典序排序
+	sort.Slice(backups, func(i, j int) bool { return backups[i].Name > backups[j].Name })
+	return backups
+}
+
+// writeFileAtomic 先写入同目录下的临时文件再重命名
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".theme-json-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// offsetPosition 将字节偏移换算为行号和列号（从 1 开始）
+func offsetPosition(content string, offset int64) (int, int) {
+	if offset > int64(len(content)) {
+		offset = int64(len(content))
+	}
+	before := content[:offset]
+	line := strings.Count(before, "\n") + 1
+	column := len([]rune(before[strings.LastIndex(before, "\n")+1:]))
+	if column == 0 {
+		column = 1
+	}
+	return line, column
+}
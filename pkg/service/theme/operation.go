@@ -0,0 +1,99 @@
+/*
+ * @Description: 主题操作超时
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 安装、上传和切换主题在请求的 context 上再加一个可配置的超时，下载、解压和复制文件的循环随之中止，
+ * 管理员关闭页面或网络卡住时不会让操作一直占用磁盘和安装名额。超时后返回 ErrOperationTimeout。
+ */
+package theme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// ErrOperationTimeout 主题操作超过了配置的最长时间
+var ErrOperationTimeout = errors.New("主题操作超时")
+
+const (
+	defaultInstallTimeout = 10 * time.Minute
+	defaultSwitchTimeout  = 2 * time.Minute
+)
+
+// OperationTimeouts 主题操作超时配置，0 表示不限制
+type OperationTimeouts struct {
+	Install time.Duration // 安装、上传主题（SSR 主题不含构建）
+	Switch  time.Duration // 切换主题（不含切换后的页面检查）
+}
+
+// OperationTimeoutsProvider 返回当前的主题操作超时配置
+type OperationTimeoutsProvider func() OperationTimeouts
+
+var (
+	operationTimeoutsMu       sync.RWMutex
+	operationTimeoutsProvider OperationTimeoutsProvider
+)
+
+// SetOperationTimeoutsProvider 设置主题操作超时配置来源，未设置时安装 10 分钟、切换 2 分钟
+func SetOperationTimeoutsProvider(provider OperationTimeoutsProvider) {
+	operationTimeoutsMu.Lock()
+	operationTimeoutsProvider = provider
+	operationTimeoutsMu.Unlock()
+}
+
+// NewSettingOperationTimeoutsProvider 基于后台配置 theme.operation.install_timeout / theme.operation.switch_timeout 的超时配置来源
+func NewSettingOperationTimeoutsProvider(settings settingGetter) OperationTimeoutsProvider {
+	return func() OperationTimeouts {
+		return OperationTimeouts{
+			Install: settingSeconds(settings, constant.KeyThemeInstallTimeout, defaultInstallTimeout),
+			Switch:  settingSeconds(settings, constant.KeyThemeSwitchTimeout, defaultSwitchTimeout),
+		}
+	}
+}
+
+// settingSeconds 读取以秒为单位的配置，未配置或格式错误时返回默认值
+func settingSeconds(settings settingGetter, key constant.SettingKey, fallback time.Duration) time.Duration {
+	n, err := strconv.Atoi(strings.TrimSpace(settings.Get(key.String())))
+	if err != nil || n < 0 {
+		return fallback
+	}
+	return time.Duration(n) * time.Second
+}
+
+func currentOperationTimeouts() OperationTimeouts {
+	operationTimeoutsMu.RLock()
+	provider := operationTimeoutsProvider
+	operationTimeoutsMu.RUnlock()
+	if provider == nil {
+		return OperationTimeouts{Install: defaultInstallTimeout, Switch: defaultSwitchTimeout}
+	}
+	return provider()
+}
+
+// withOperationTimeout 为主题操作加上超时，timeout 为 0 时只返回可取消的 context
+func withOperationTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// wrapTimeout 操作因超时中止时，将错误替换为 ErrOperationTimeout，用法：
+//
+//	ctx, cancel := withOperationTimeout(ctx, currentOperationTimeouts().Install)
+//	defer cancel()
+//	defer wrapTimeout(ctx, "安装主题 "+name, &err)
+func wrapTimeout(ctx context.Context, operation string, err *error) {
+	if *err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return
+	}
+	*err = fmt.Errorf("%s %w: %v", operation, ErrOperationTimeout, *err)
+}
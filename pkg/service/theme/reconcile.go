@@ -0,0 +1,277 @@
+/*
+ * @Description: 主题数据库记录与文件系统一致性检查
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * GetInstalledThemes 只读取数据库，手动删除主题目录后列表中仍会显示该主题；
+ * SyncSSRThemesFromFileSystem 也只覆盖 SSR 主题。这里统一检查普通主题和 SSR 主题，
+ * 报告"有记录无文件"和"有文件无记录"两类孤儿，并提供重新下载、收录、清除记录三种修复操作。
+ */
+package theme
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
+)
+
+// 孤儿主题修复操作
+const (
+	ReconcileActionRedownload  = "redownload"   // 有记录无文件：从主题商城重新下载
+	ReconcileActionAdopt       = "adopt"        // 有文件无记录：为文件创建数据库记录
+	ReconcileActionPurgeRecord = "purge_record" // 有记录无文件：删除数据库记录
+)
+
+// OrphanThemeRecord 数据库中存在但文件缺失的主题
+type OrphanThemeRecord struct {
+	ThemeName        string `json:"theme_name"`
+	DeployType       string `json:"deploy_type"`
+	InstalledVersion string `json:"installed_version"`
+	IsCurrent        bool   `json:"is_current"`
+	MarketID         int    `json:"market_id,omitempty"`
+	CanRedownload    bool   `json:"can_redownload"`
+	Reason           string `json:"reason"`
+}
+
+// OrphanThemeFiles 文件系统中存在但没有数据库记录的主题
+type OrphanThemeFiles struct {
+	ThemeName  string `json:"theme_name"`
+	Path       string `json:"path"`
+	DeployType string `json:"deploy_type"`
+	Version    string `json:"version,omitempty"`
+	CanAdopt   bool   `json:"can_adopt"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// ThemeReconcileReport 一致性检查报告
+type ThemeReconcileReport struct {
+	MissingFiles   []OrphanThemeRecord `json:"missing_files"`   // 有记录无文件
+	MissingRecords []OrphanThemeFiles  `json:"missing_records"` // 有文件无记录
+	CheckedAt      time.Time           `json:"checked_at"`
+}
+
+// ThemeReconcileRequest 修复请求
+type ThemeReconcileRequest struct {
+	ThemeName string `json:"theme_name" binding:"required"`
+	Action    string `json:"action" binding:"required,oneof=redownload adopt purge_record"`
+}
+
+// ReconcileThemes 检查数据库主题记录与 themes 目录的一致性
+func (s *themeService) ReconcileThemes(ctx context.Context, userID uint) (*ThemeReconcileReport, error) {
+	report := &ThemeReconcileReport{
+		MissingFiles:   []OrphanThemeRecord{},
+		MissingRecords: []OrphanThemeFiles{},
+		CheckedAt:      time.Now(),
+	}
+
+	records, err := s.db.UserInstalledTheme.
+		Query().
+		Where(userinstalledtheme.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询已安装主题失败: %w", err)
+	}
+
+	var marketMap map[string]*MarketTheme
+	recordMap := make(map[string]*ent.UserInstalledTheme, len(records))
+	for _, record := range records {
+		recordMap[record.ThemeName] = record
+		if record.ThemeName == OfficialThemeName {
+			continue
+		}
+
+		deployType := record.DeployType
+		if _, reason := detectThemeFiles(record.ThemeName, deployType); reason != "" {
+			if marketMap == nil {
+				marketMap = s.marketThemeMap(ctx)
+			}
+			market := marketMap[record.ThemeName]
+			report.MissingFiles = append(report.MissingFiles, OrphanThemeRecord{
+				ThemeName:        record.ThemeName,
+				DeployType:       string(deployType),
+				InstalledVersion: record.InstalledVersion,
+				IsCurrent:        record.IsCurrent,
+				MarketID:         record.ThemeMarketID,
+				CanRedownload:    deployType == userinstalledtheme.DeployTypeStandard && market != nil && market.DownloadURL != "",
+				Reason:           reason,
+			})
+		}
+	}
+
+	entries, err := os.ReadDir(ThemesDirName)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("读取主题目录失败: %w", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || entry.Name() == OfficialThemeName {
+			continue
+		}
+		if _, ok := recordMap[entry.Name()]; ok {
+			continue
+		}
+
+		orphan := OrphanThemeFiles{
+			ThemeName: entry.Name(),
+			Path:      filepath.Join(ThemesDirName, entry.Name()),
+		}
+		deployType, reason := classifyThemeDir(entry.Name())
+		if reason != "" {
+			orphan.Reason = reason
+		} else {
+			orphan.DeployType = string(deployType)
+			orphan.CanAdopt = true
+			if metadata, err := s.loadThemeMetadataFromDisk(entry.Name()); err == nil {
+				orphan.Version = metadata.Version
+			}
+		}
+		report.MissingRecords = append(report.MissingRecords, orphan)
+	}
+
+	return report, nil
+}
+
+// FixOrphanTheme 执行一致性修复操作
+func (s *themeService) FixOrphanTheme(ctx context.Context, userID uint, req *ThemeReconcileRequest) error {
+	if err := validateThemeName(req.ThemeName); err != nil {
+		return err
+	}
+	if s.isOfficialTheme(req.ThemeName) {
+		return fmt.Errorf("官方主题无需修复")
+	}
+
+	record, err := s.db.UserInstalledTheme.
+		Query().
+		Where(
+			userinstalledtheme.UserID(userID),
+			userinstalledtheme.ThemeName(req.ThemeName),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("查询主题记录失败: %w", err)
+	}
+
+	switch req.Action {
+	case ReconcileActionRedownload:
+		if record == nil {
+			return fmt.Errorf("主题 %s 没有安装记录，无法重新下载", req.ThemeName)
+		}
+		if record.DeployType == userinstalledtheme.DeployTypeSsr {
+			return fmt.Errorf("SSR 主题请通过 SSR 主题管理重新安装")
+		}
+		market := s.marketThemeMap(ctx)[req.ThemeName]
+		if market == nil || market.DownloadURL == "" {
+			return fmt.Errorf("主题商城中找不到主题 %s 的下载地址", req.ThemeName)
+		}
+
+		themeDir := filepath.Join(ThemesDirName, req.ThemeName)
+		os.RemoveAll(themeDir)
+		if err := s.downloadAndExtractTheme(ctx, market.DownloadURL, market.Checksum, themeDir); err != nil {
+			return fmt.Errorf("重新下载主题失败: %w", err)
+		}
+		if err := s.validateThemeFiles(themeDir); err != nil {
+			os.RemoveAll(themeDir)
+			return fmt.Errorf("主题文件验证失败: %w", err)
+		}
+		if _, err := record.Update().
+			SetInstalledVersion(market.Version).
+			SetInstallTime(time.Now()).
+			Save(ctx); err != nil {
+			return fmt.Errorf("更新主题记录失败: %w", err)
+		}
+		if err := s.recordThemeManifest(ctx, req.ThemeName); err != nil {
+			log.Printf("[主题一致性修复] 记录主题 %s 文件清单失败: %v", req.ThemeName, err)
+		}
+		log.Printf("[主题一致性修复] 已重新下载主题 %s (版本 %s)", req.ThemeName, market.Version)
+
+	case ReconcileActionAdopt:
+		if record != nil {
+			return fmt.Errorf("主题 %s 已有安装记录", req.ThemeName)
+		}
+		deployType, reason := classifyThemeDir(req.ThemeName)
+		if reason != "" {
+			return fmt.Errorf("无法收录主题 %s: %s", req.ThemeName, reason)
+		}
+
+		create := s.db.UserInstalledTheme.
+			Create().
+			SetUserID(userID).
+			SetThemeName(req.ThemeName).
+			SetDeployType(deployType).
+			SetInstallTime(time.Now()).
+			SetUserThemeConfig(map[string]interface{}{})
+		if metadata, err := s.loadThemeMetadataFromDisk(req.ThemeName); err == nil && metadata.Version != "" {
+			create = create.SetInstalledVersion(metadata.Version)
+		}
+		if _, err := create.Save(ctx); err != nil {
+			return fmt.Errorf("创建主题记录失败: %w", err)
+		}
+		log.Printf("[主题一致性修复] 已收录主题目录 %s (%s)", req.ThemeName, deployType)
+
+	case ReconcileActionPurgeRecord:
+		if record == nil {
+			return fmt.Errorf("主题 %s 没有安装记录", req.ThemeName)
+		}
+		if _, reason := detectThemeFiles(req.ThemeName, record.DeployType); reason == "" {
+			return fmt.Errorf("主题 %s 的文件完整，请使用卸载功能", req.ThemeName)
+		}
+		if err := s.db.UserInstalledTheme.DeleteOneID(record.ID).Exec(ctx); err != nil {
+			return fmt.Errorf("删除主题记录失败: %w", err)
+		}
+		log.Printf("[主题一致性修复] 已清除主题 %s 的孤立记录", req.ThemeName)
+
+	default:
+		return fmt.Errorf("未知的修复操作: %s", req.Action)
+	}
+
+	return nil
+}
+
+// marketThemeMap 获取主题商城数据并按名称索引，获取失败时返回空映射
+func (s *themeService) marketThemeMap(ctx context.Context) map[string]*MarketTheme {
+	result := make(map[string]*MarketTheme)
+	themes, err := s.GetThemeMarketList(ctx)
+	if err != nil {
+		log.Printf("[主题一致性检查] 获取主题商城数据失败: %v", err)
+		return result
+	}
+	for _, t := range themes {
+		result[t.Name] = t
+	}
+	return result
+}
+
+// detectThemeFiles 检查数据库记录对应的主题文件是否完整，返回缺失原因
+func detectThemeFiles(themeName string, deployType userinstalledtheme.DeployType) (string, string) {
+	themeDir := filepath.Join(ThemesDirName, themeName)
+	if info, err := os.Stat(themeDir); err != nil || !info.IsDir() {
+		return themeDir, "主题目录不存在"
+	}
+
+	required := "index.html"
+	if deployType == userinstalledtheme.DeployTypeSsr {
+		required = "server.js"
+	}
+	if _, err := os.Stat(filepath.Join(themeDir, required)); os.IsNotExist(err) {
+		return themeDir, fmt.Sprintf("缺少 %s 文件", required)
+	}
+	return themeDir, ""
+}
+
+// classifyThemeDir 根据目录内容判断主题类型（server.js 为 SSR，index.html 为普通主题）
+func classifyThemeDir(themeName string) (userinstalledtheme.DeployType, string) {
+	themeDir := filepath.Join(ThemesDirName, themeName)
+	if _, err := os.Stat(filepath.Join(themeDir, "server.js")); err == nil {
+		return userinstalledtheme.DeployTypeSsr, ""
+	}
+	if _, err := os.Stat(filepath.Join(themeDir, "index.html")); err == nil {
+		return userinstalledtheme.DeployTypeStandard, ""
+	}
+	return "", "目录中既没有 index.html 也没有 server.js，不是有效的主题"
+}
@@ -16,6 +16,7 @@ import (
 	"archive/zip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -29,7 +30,10 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/adminevent"
 )
 
 const (
@@ -51,6 +55,30 @@ const (
 	DeployTypeSSR      = "ssr"      // SSR 主题
 )
 
+var (
+	// themeMarketClient 主题商城接口客户端，商城不可用时熔断，避免后台主题页长时间卡住
+	themeMarketClient = httpclient.New(httpclient.Options{
+		Name:       "theme-market",
+		Service:    "theme",
+		Timeout:    10 * time.Second,
+		MaxRetries: 2,
+		Identify:   true,
+	})
+	// themeDownloadClient 主题包下载客户端，压缩包较大，使用更长的超时
+	themeDownloadClient = httpclient.New(httpclient.Options{
+		Name:       "theme-download",
+		Service:    "theme",
+		Timeout:    5 * time.Minute,
+		MaxRetries: 2,
+		Identify:   true,
+	})
+
+	// ErrThemeAlreadyInstalled 主题已经安装，包装时在后面附上主题名称，如 "主题已经安装: xxx"
+	ErrThemeAlreadyInstalled = errors.New("主题已经安装")
+	// ErrThemeNotInstalled 主题未安装，包装时在后面附上主题名称，如 "主题未安装: xxx"
+	ErrThemeNotInstalled = errors.New("主题未安装")
+)
+
 // SSRManagerInterface SSR 主题管理器接口
 // 用于解耦 ThemeService 和 SSR Manager
 type SSRManagerInterface interface {
@@ -77,6 +105,7 @@ type ThemeInfo struct {
 	InstructionURL string   `json:"instructionUrl"`
 	Price          int      `json:"price"`
 	DownloadURL    string   `json:"downloadUrl"`
+	Checksum       string   `json:"checksum"` // 主题包 SHA-256
 	Tags           []string `json:"tags"`
 	PreviewURL     string   `json:"previewUrl"`
 	DemoURL        string   `json:"demoUrl"`
@@ -102,6 +131,8 @@ type ThemeInstallRequest struct {
 	ThemeName   string `json:"theme_name"`
 	DownloadURL string `json:"download_url"`
 	Version     string `json:"version,omitempty"`
+	Checksum    string `json:"checksum,omitempty"` // 主题包 SHA-256，提供时校验下载内容，并允许使用下载镜像
+	Source      string `json:"source,omitempty"`   // 主题所属的商城来源 ID，提供时从该来源获取下载地址并上报下载
 }
 
 // MarketTheme 主题商城主题信息（外部API格式）
@@ -116,6 +147,7 @@ type MarketTheme struct {
 	InstructionURL string   `json:"instructionUrl"`
 	Price          int      `json:"price"`
 	DownloadURL    string   `json:"downloadUrl"`
+	Checksum       string   `json:"checksum"` // 主题包 SHA-256
 	Tags           []string `json:"tags"`
 	PreviewURL     string   `json:"previewUrl"`
 	DemoURL        string   `json:"demoUrl"`
@@ -126,6 +158,8 @@ type MarketTheme struct {
 	IsActive       bool     `json:"isActive"`
 	CreatedAt      string   `json:"createdAt"`
 	UpdatedAt      string   `json:"updatedAt"`
+	Source         string   `json:"source"`     // 主题所属的商城来源 ID
+	SourceName     string   `json:"sourceName"` // 主题所属的商城来源名称
 }
 
 // ThemeMetadata 主题元信息（theme.json格式）
@@ -148,6 +182,14 @@ type ThemeMetadata struct {
 	Features    []string          `json:"features"`
 	// 主题配置定义（类似 Halo 的 settings.yaml）
 	Settings []ThemeSettingGroup `json:"settings,omitempty"`
+	// 默认语言，对应 locales/{default_locale}.json，未声明时为 zh-CN
+	DefaultLocale string `json:"default_locale,omitempty"`
+	// 父主题名称，子主题中缺少的文件和模板从父主题回退查找
+	Extends string `json:"extends,omitempty"`
+	// 主题提供的菜单位置，后台可为每个位置分配菜单
+	MenuLocations []ThemeMenuLocation `json:"menu_locations,omitempty"`
+	// 主题提供的小工具区域，后台可向每个区域放置小工具
+	WidgetAreas []ThemeWidgetArea `json:"widget_areas,omitempty"`
 }
 
 // ThemeSettingGroup 主题配置分组
@@ -169,6 +211,7 @@ type ThemeSettingField struct {
 	Options     []ThemeSettingOption  `json:"options,omitempty"`     // 选项（用于 select、radio 类型）
 	Validation  *ThemeFieldValidation `json:"validation,omitempty"`  // 验证规则
 	Condition   *ThemeFieldCondition  `json:"condition,omitempty"`   // 显示条件（依赖其他字段）
+	Modes       bool                  `json:"modes,omitempty"`       // 是否区分浅色/深色模式（仅 color、image 类型）
 }
 
 // ThemeSettingOption 配置字段选项
@@ -223,6 +266,15 @@ type ThemeService interface {
 	// 安装主题（简化流程）
 	InstallTheme(ctx context.Context, userID uint, req *ThemeInstallRequest) error
 
+	// 取消正在进行的主题安装（下载或解压阶段）
+	CancelInstall(ctx context.Context, themeName string) error
+
+	// 检查全部已安装普通主题的更新，返回逐项结果
+	CheckThemeUpdates(ctx context.Context, userID uint) (*ThemeBulkResult, error)
+
+	// 从主题商城更新主题（下载、备份、替换，失败时回滚到旧版本）
+	UpdateTheme(ctx context.Context, userID uint, themeName string) (*ThemeUpdateResult, error)
+
 	// 切换到指定主题（可能是普通主题或官方主题）
 	// ssrManager: 用于切换到普通/官方主题时停止 SSR 进程
 	SwitchToTheme(ctx context.Context, userID uint, themeName string, ssrManager SSRManagerInterface) error
@@ -230,9 +282,18 @@ type ThemeService interface {
 	// 切换到官方主题（需要停止所有 SSR 主题）
 	SwitchToOfficial(ctx context.Context, userID uint, ssrManager SSRManagerInterface) error
 
-	// 卸载主题
+	// 卸载主题（主题文件移入回收站）
 	UninstallTheme(ctx context.Context, userID uint, themeName string) error
 
+	// 获取回收站中的主题
+	ListTrashedThemes() ([]*TrashedTheme, error)
+
+	// 从回收站恢复主题
+	RestoreTrashedTheme(ctx context.Context, id string) error
+
+	// 彻底删除回收站中的主题
+	PurgeTrashedTheme(id string) error
+
 	// 检查是否使用静态模式（是否存在static目录）
 	IsStaticModeActive() bool
 
@@ -242,6 +303,12 @@ type ThemeService interface {
 	// 获取 PRO 版本主题商城列表（包含完整的 PRO 主题下载链接）
 	GetThemeMarketListForPro(ctx context.Context, licenseKey string) ([]*MarketTheme, error)
 
+	// 获取启用的主题商城来源
+	ListMarketSources() []MarketSourceInfo
+
+	// 为主题评分（提交到主题所属的来源）
+	RateMarketTheme(ctx context.Context, source string, marketID int, rating int) error
+
 	// 上传主题压缩包
 	UploadTheme(ctx context.Context, userID uint, file *multipart.FileHeader, forceUpdate ...bool) (*ThemeInfo, error)
 
@@ -288,6 +355,85 @@ type ThemeService interface {
 
 	// 获取当前激活主题的配置（供前端主题使用的公开接口）
 	GetCurrentThemeConfig(ctx context.Context, userID uint) (*ThemeConfigResponse, error)
+
+	// ===== theme.json 修复 =====
+
+	// 读取 theme.json 原始内容（内容损坏时同样返回），backup 不为空时读取指定的备份
+	GetThemeJSON(ctx context.Context, themeName, backup string) (*ThemeJSONDocument, error)
+
+	// 校验 theme.json 内容，不写入文件
+	ValidateThemeJSON(ctx context.Context, themeName, content string) *ThemeJSONValidation
+
+	// 校验通过后备份原文件并写入新的 theme.json
+	SaveThemeJSON(ctx context.Context, themeName, content string) (*ThemeJSONValidation, error)
+
+	// ===== 主题完整性复检 =====
+
+	// 对已安装主题重新执行安装校验，并与安装时记录的文件清单比对，报告文件变更
+	VerifyThemeIntegrity(ctx context.Context, themeName string) (*ThemeIntegrityReport, error)
+
+	// 以当前文件重建主题的文件清单，作为之后复检的基线
+	RebuildThemeManifest(ctx context.Context, themeName string) (*ThemeIntegrityReport, error)
+
+	// ===== 主题布局区域 =====
+
+	// 获取主题声明的菜单位置和小工具区域（从 theme.json 读取）
+	GetThemeLayoutAreas(ctx context.Context, themeName string) (*ThemeLayoutAreas, error)
+
+	// 获取当前生效的主题名称（SSR 主题优先，官方主题返回 OfficialThemeName）
+	GetCurrentThemeName(ctx context.Context, userID uint) (string, error)
+
+	// ===== 主题多语言 =====
+
+	// 列出主题提供的语言（locales/{lang}.json）
+	ListThemeLocales(ctx context.Context, themeName string) ([]string, error)
+
+	// 读取主题的单个语言文件
+	GetThemeLocale(ctx context.Context, themeName, locale string) (map[string]interface{}, error)
+
+	// 获取当前主题按回退链合并后的语言词条
+	GetCurrentThemeLocale(ctx context.Context, userID uint, locale string) (*ThemeLocaleResponse, error)
+
+	// ===== 主题兼容性检查 =====
+
+	// 对已安装主题执行冒烟测试（渲染首页和文章页模板，检查资源引用）
+	RunSmokeTest(ctx context.Context, themeName string) (*ThemeSmokeTestResult, error)
+
+	// 获取最近一次冒烟测试结果
+	GetSmokeTestResult(ctx context.Context, themeName string) (*ThemeSmokeTestResult, error)
+
+	// ===== 主题存储管理 =====
+
+	// 统计主题目录磁盘占用，列出遗留备份和临时文件
+	GetStorageReport(ctx context.Context, userID uint) (*ThemeStorageReport, error)
+
+	// 清理遗留备份和临时文件（支持预演）
+	CleanupStorage(ctx context.Context, userID uint, req *ThemeStorageCleanupRequest) (*ThemeStorageCleanupResult, error)
+
+	// ===== 主题一致性检查 =====
+
+	// 检查数据库记录与主题目录是否一致（包括普通主题和 SSR 主题）
+	ReconcileThemes(ctx context.Context, userID uint) (*ThemeReconcileReport, error)
+
+	// 修复不一致的主题：重新下载、收录目录或清除记录
+	FixOrphanTheme(ctx context.Context, userID uint, req *ThemeReconcileRequest) error
+
+	// ===== 批量操作 =====
+
+	// 批量卸载主题，返回逐项结果
+	BulkUninstallThemes(ctx context.Context, userID uint, names []string) (*ThemeBulkResult, error)
+
+	// 批量检查主题更新，返回逐项结果
+	BulkCheckThemeUpdates(ctx context.Context, userID uint, names []string) (*ThemeBulkResult, error)
+
+	// 批量导出主题为临时 ZIP 文件并返回路径，调用方负责删除；没有主题可以导出时路径为空
+	BulkExportThemes(ctx context.Context, userID uint, names []string) (string, *ThemeBulkResult, error)
+
+	// 设置事件总线（可选注入，切换主题后发布事件）
+	SetEventBus(bus *event.EventBus)
+
+	// 设置处理切换后页面检查请求的 HTTP 处理器（可选注入，未设置时不检查）
+	SetCanaryHandler(handler http.Handler)
 }
 
 // ThemeConfigResponse 主题配置响应
@@ -301,82 +447,61 @@ type ThemeConfigResponse struct {
 type themeService struct {
 	db       *ent.Client
 	userRepo repository.UserRepository
+	eventBus *event.EventBus
+	locales  *localeCache // 主题语言文件缓存，见 locale_cache.go
+
+	canaryHandler http.Handler // 切换后页面检查经由的处理器，见 canary.go
 }
 
-// NewThemeService 创建主题服务实例
+// NewThemeService 创建主题服务实例，同时加载已安装主题的语言文件并监听其变化
 func NewThemeService(db *ent.Client, userRepo repository.UserRepository) ThemeService {
-	return &themeService{
+	s := &themeService{
 		db:       db,
 		userRepo: userRepo,
 	}
+	s.locales = newLocaleCache(ThemesDirName, s.readThemeLocales)
+	s.locales.start()
+	return s
 }
 
-// GetThemeMarketList 获取主题商城列表（从外部API获取）
-func (s *themeService) GetThemeMarketList(ctx context.Context) ([]*MarketTheme, error) {
-	// 创建HTTP客户端请求
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-
-	req, err := http.NewRequestWithContext(ctx, "GET", ThemeMarketAPI, nil)
-	if err != nil {
-		return nil, fmt.Errorf("创建请求失败: %w", err)
-	}
-
-	// 设置请求头
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", "Anheyu-App/1.0")
-
-	// 发送请求
-	resp, err := client.Do(req)
-	if err != nil {
-		// 如果外部API调用失败，返回空列表而不是错误，确保系统仍可用
-		log.Printf("调用主题商城API失败: %v，返回空列表", err)
-		return []*MarketTheme{}, nil
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		log.Printf("主题商城API返回错误状态码: %d，返回空列表", resp.StatusCode)
-		return []*MarketTheme{}, nil
-	}
-
-	// 读取响应体
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		log.Printf("读取API响应失败: %v，返回空列表", err)
-		return []*MarketTheme{}, nil
-	}
-
-	// 定义API响应结构
-	type APIResponse struct {
-		Code    int    `json:"code"`
-		Message string `json:"message"`
-		Data    struct {
-			List  []*MarketTheme `json:"list"`
-			Total int            `json:"total"`
-		} `json:"data"`
-	}
+// SetEventBus 设置事件总线（可选注入）
+func (s *themeService) SetEventBus(bus *event.EventBus) {
+	s.eventBus = bus
+}
 
-	var apiResp APIResponse
-	if err := json.Unmarshal(body, &apiResp); err != nil {
-		log.Printf("解析API响应失败: %v，返回空列表", err)
-		return []*MarketTheme{}, nil
+// trackSwitch 向管理后台推送主题切换开始，返回的函数在切换结束时推送完成或失败，用法：
+//
+//	defer trackSwitch(themeName)(&err)
+func trackSwitch(themeName string) func(err *error) {
+	switchProgress(themeName, adminevent.StageStarted)
+	return func(err *error) {
+		data := adminevent.ThemeSwitchData{Theme: themeName, Stage: adminevent.StageCompleted}
+		if *err != nil {
+			data.Stage = adminevent.StageFailed
+			data.Error = (*err).Error()
+		}
+		adminevent.Publish(adminevent.TypeThemeSwitch, data)
 	}
+}
 
-	// 检查API响应码（官网 API 成功时返回 code: 0）
-	if apiResp.Code != 0 && apiResp.Code != 200 {
-		log.Printf("API返回错误码: %d, 消息: %s，返回空列表", apiResp.Code, apiResp.Message)
-		return []*MarketTheme{}, nil
-	}
+// switchProgress 向管理后台推送主题切换进度
+func switchProgress(themeName, stage string) {
+	adminevent.Publish(adminevent.TypeThemeSwitch, adminevent.ThemeSwitchData{Theme: themeName, Stage: stage})
+}
 
-	// 返回主题列表
-	if apiResp.Data.List == nil {
-		return []*MarketTheme{}, nil
+// publishThemeSwitched 发布主题切换事件
+func (s *themeService) publishThemeSwitched(themeName string) {
+	if s.eventBus != nil {
+		s.eventBus.Publish(event.ThemeSwitched, themeName)
 	}
+}
 
-	log.Printf("成功从主题商城API获取到 %d 个主题", len(apiResp.Data.List))
-	return apiResp.Data.List, nil
+// GetThemeMarketList 获取主题商城列表（合并所有启用的主题来源）
+func (s *themeService) GetThemeMarketList(ctx context.Context) ([]*MarketTheme, error) {
+	// 来源不可用时跳过，全部不可用时返回空列表而不是错误，确保系统仍可用
+	themes := fetchMarketSources(ctx, currentMarketSources(), nil)
+	log.Printf("成功从主题商城获取到 %d 个主题", len(themes))
+	return themes, nil
 }
 
 // PRO 版本主题商城 API 地址
@@ -386,9 +511,7 @@ const ThemeMarketProAPI = "https://anheyuofficialwebsiteapi.anheyu.com/api/v1/th
 // licenseKey 参数用于授权密钥验证
 func (s *themeService) GetThemeMarketListForPro(ctx context.Context, licenseKey string) ([]*MarketTheme, error) {
 	// 创建HTTP客户端请求
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
+	client := themeMarketClient
 
 	req, err := http.NewRequestWithContext(ctx, "GET", ThemeMarketProAPI, nil)
 	if err != nil {
@@ -442,7 +565,7 @@ func (s *themeService) GetThemeMarketListForPro(ctx context.Context, licenseKey
 	var directResp DirectResponse
 	if err := json.Unmarshal(body, &directResp); err == nil && directResp.List != nil {
 		log.Printf("成功从 PRO 主题商城API获取到 %d 个主题（直接格式，包含完整下载链接）", len(directResp.List))
-		return directResp.List, nil
+		return s.mergeProMarketList(ctx, directResp.List), nil
 	}
 
 	// 尝试解析包装格式
@@ -469,11 +592,21 @@ func (s *themeService) GetThemeMarketListForPro(ctx context.Context, licenseKey
 
 	// 返回主题列表
 	if wrappedResp.Data.List == nil {
-		return []*MarketTheme{}, nil
+		return s.mergeProMarketList(ctx, []*MarketTheme{}), nil
 	}
 
 	log.Printf("成功从 PRO 主题商城API获取到 %d 个主题（包装格式，包含完整下载链接）", len(wrappedResp.Data.List))
-	return wrappedResp.Data.List, nil
+	return s.mergeProMarketList(ctx, wrappedResp.Data.List), nil
+}
+
+// mergeProMarketList 用 PRO 接口的列表作为官方来源的列表，再与其他主题来源合并
+func (s *themeService) mergeProMarketList(ctx context.Context, proList []*MarketTheme) []*MarketTheme {
+	sources := currentMarketSources()
+	if _, ok := findMarketSource(OfficialMarketSourceID); !ok {
+		// 配置中禁用了官方商城时，PRO 主题仍然排在最前面
+		sources = append([]MarketSource{defaultOfficialMarketSource()}, sources...)
+	}
+	return fetchMarketSources(ctx, sources, map[string][]*MarketTheme{OfficialMarketSourceID: proList})
 }
 
 // GetCurrentTheme 获取当前使用的主题
@@ -654,6 +787,7 @@ func (s *themeService) GetInstalledThemes(ctx context.Context, userID uint) ([]*
 			themeInfo.InstructionURL = marketTheme.InstructionURL
 			themeInfo.Price = marketTheme.Price
 			themeInfo.DownloadURL = marketTheme.DownloadURL
+			themeInfo.Checksum = marketTheme.Checksum
 			themeInfo.Tags = marketTheme.Tags
 			themeInfo.PreviewURL = marketTheme.PreviewURL
 			themeInfo.DemoURL = marketTheme.DemoURL
@@ -822,7 +956,17 @@ func (s *themeService) GetInstalledThemes(ctx context.Context, userID uint) ([]*
 }
 
 // InstallTheme 安装主题（简化流程）
-func (s *themeService) InstallTheme(ctx context.Context, userID uint, req *ThemeInstallRequest) error {
+// 安装过程向管理后台事件流推送进度，可通过 CancelInstall 取消，超过 theme.operation.install_timeout 时中止
+func (s *themeService) InstallTheme(ctx context.Context, userID uint, req *ThemeInstallRequest) (err error) {
+	ctx, task, err := beginInstall(ctx, req.ThemeName)
+	if err != nil {
+		return err
+	}
+	defer task.finish(&err)
+	ctx, cancel := withOperationTimeout(ctx, currentOperationTimeouts().Install)
+	defer cancel()
+	defer wrapTimeout(ctx, "安装主题 "+req.ThemeName, &err)
+
 	// 1. 检查主题是否已经安装
 	exists, err := s.db.UserInstalledTheme.
 		Query().
@@ -837,23 +981,38 @@ func (s *themeService) InstallTheme(ctx context.Context, userID uint, req *Theme
 	}
 
 	if exists {
-		return fmt.Errorf("主题 %s 已经安装", req.ThemeName)
+		return fmt.Errorf("%w: %s", ErrThemeAlreadyInstalled, req.ThemeName)
 	}
 
-	// 2. 下载并解压主题文件
+	// 2. 下载并解压主题文件，下载地址以主题所属来源的列表为准
+	if err := resolveMarketDownload(ctx, req); err != nil {
+		return err
+	}
 	themeDir := filepath.Join(ThemesDirName, req.ThemeName)
-	if err := s.downloadAndExtractTheme(req.DownloadURL, themeDir); err != nil {
+	_, statErr := os.Stat(themeDir)
+	createdDir := os.IsNotExist(statErr)
+	if err := s.downloadAndExtractTheme(ctx, req.DownloadURL, req.Checksum, themeDir); err != nil {
+		// 清理解压了一半的目录（安装前已存在的目录不动）
+		if createdDir {
+			os.RemoveAll(themeDir)
+		}
 		return fmt.Errorf("下载主题失败: %w", err)
 	}
 
 	// 3. 验证主题文件完整性
+	task.setStage(adminevent.StageValidating)
 	if err := s.validateThemeFiles(themeDir); err != nil {
 		// 清理已下载的文件
 		os.RemoveAll(themeDir)
 		return fmt.Errorf("主题文件验证失败: %w", err)
 	}
 
-	// 4. 在数据库中记录主题信息（只存储必要的本地信息）
+	// 4. 在数据库中记录主题信息（只存储必要的本地信息），此后不能再取消，也不受超时影响
+	if err := task.beginCommit(ctx); err != nil {
+		os.RemoveAll(themeDir)
+		return err
+	}
+	ctx = context.WithoutCancel(ctx)
 	createBuilder := s.db.UserInstalledTheme.
 		Create().
 		SetUserID(userID).
@@ -875,10 +1034,23 @@ func (s *themeService) InstallTheme(ctx context.Context, userID uint, req *Theme
 		return fmt.Errorf("保存主题信息失败: %w", err)
 	}
 
+	if err := s.recordThemeManifest(ctx, req.ThemeName); err != nil {
+		log.Printf("警告：记录主题 %s 文件清单失败: %v", req.ThemeName, err)
+	}
+	reportMarketDownload(ctx, req)
 	log.Printf("主题 %s 安装成功", req.ThemeName)
 	return nil
 }
 
+// CancelInstall 取消正在进行的主题安装，安装流程随后清理已写出的文件并返回 ErrInstallCanceled
+func (s *themeService) CancelInstall(ctx context.Context, themeName string) error {
+	if err := cancelInstall(themeName); err != nil {
+		return err
+	}
+	log.Printf("主题 %s 的安装已请求取消", themeName)
+	return nil
+}
+
 // combineThemeInfo 组合本地数据和外部API数据
 func (s *themeService) combineThemeInfo(ctx context.Context, localTheme *ent.UserInstalledTheme, marketTheme *MarketTheme) (*ThemeInfo, error) {
 	themeInfo := &ThemeInfo{
@@ -904,6 +1076,7 @@ func (s *themeService) combineThemeInfo(ctx context.Context, localTheme *ent.Use
 		themeInfo.InstructionURL = marketTheme.InstructionURL
 		themeInfo.Price = marketTheme.Price
 		themeInfo.DownloadURL = marketTheme.DownloadURL
+		themeInfo.Checksum = marketTheme.Checksum
 		themeInfo.PreviewURL = marketTheme.PreviewURL
 		themeInfo.DemoURL = marketTheme.DemoURL
 		themeInfo.DownloadCount = marketTheme.DownloadCount
@@ -947,12 +1120,16 @@ func (s *themeService) isOfficialTheme(themeName string) bool {
 }
 
 // SwitchToTheme 切换到指定主题
-func (s *themeService) SwitchToTheme(ctx context.Context, userID uint, themeName string, ssrManager SSRManagerInterface) error {
+func (s *themeService) SwitchToTheme(ctx context.Context, userID uint, themeName string, ssrManager SSRManagerInterface) (err error) {
 	// 检查是否是官方主题
 	if s.isOfficialTheme(themeName) {
 		log.Printf("用户 %d 请求切换到官方主题: %s", userID, themeName)
 		return s.SwitchToOfficial(ctx, userID, ssrManager)
 	}
+	defer trackSwitch(themeName)(&err)
+	ctx, cancel := withOperationTimeout(ctx, currentOperationTimeouts().Switch)
+	defer cancel()
+	defer wrapTimeout(ctx, "切换到主题 "+themeName, &err)
 
 	// 1. 检查主题是否已安装
 	theme, err := s.db.UserInstalledTheme.
@@ -965,7 +1142,7 @@ func (s *themeService) SwitchToTheme(ctx context.Context, userID uint, themeName
 
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return fmt.Errorf("主题 %s 未安装", themeName)
+			return fmt.Errorf("%w: %s", ErrThemeNotInstalled, themeName)
 		}
 		return fmt.Errorf("查询主题失败: %w", err)
 	}
@@ -975,21 +1152,27 @@ func (s *themeService) SwitchToTheme(ctx context.Context, userID uint, themeName
 	if err := s.validateThemeFiles(themeDir); err != nil {
 		return fmt.Errorf("主题文件不完整: %w", err)
 	}
+	snap := s.snapshotSwitch(ctx, userID, ssrManager)
 
 	// 3. 备份当前static目录（如果存在）
 	backupPath := ""
 	if s.IsStaticModeActive() {
 		backupPath = filepath.Join(BackupDirName, fmt.Sprintf("static_backup_%d", time.Now().Unix()))
-		if err := s.backupDirectory(StaticDirName, backupPath); err != nil {
+		if err := s.backupDirectory(ctx, StaticDirName, backupPath); err != nil {
+			os.RemoveAll(backupPath)
 			return fmt.Errorf("备份静态文件失败: %w", err)
 		}
 	}
+	snap.staticChanged = true
+	snap.staticBackup = backupPath
 
 	// 4. 复制主题文件到static目录
-	if err := s.copyThemeToStatic(themeDir); err != nil {
-		// 如果失败，恢复备份
+	if err := s.copyThemeToStatic(ctx, themeDir); err != nil {
+		// 如果失败，恢复备份；切换前不是静态模式时清掉复制了一半的文件
 		if backupPath != "" {
 			s.restoreFromBackup(backupPath, StaticDirName)
+		} else {
+			s.safeRemoveStaticDir()
 		}
 		return fmt.Errorf("复制主题文件失败: %w", err)
 	}
@@ -1051,10 +1234,21 @@ func (s *themeService) SwitchToTheme(ctx context.Context, userID uint, themeName
 		log.Printf("警告：用户 %d 在主题切换后有 %d 个当前主题，状态异常", userID, currentThemesCount)
 	}
 
-	// 7. 清理备份文件
+	s.publishThemeSwitched(themeName)
+
+	// 7. 检查首页和文章页，不可用时回滚到切换前的主题
+	canaryErr := s.runCanary(ctx)
+	if canaryErr != nil {
+		s.rollbackSwitch(ctx, userID, themeName, snap, ssrManager, canaryErr)
+	}
+
+	// 8. 清理备份文件
 	if backupPath != "" {
 		os.RemoveAll(backupPath)
 	}
+	if canaryErr != nil {
+		return fmt.Errorf("主题 %s %w: %v", themeName, ErrCanaryFailed, canaryErr)
+	}
 
 	log.Printf("成功切换到主题 %s", themeName)
 	return nil
@@ -1063,10 +1257,15 @@ func (s *themeService) SwitchToTheme(ctx context.Context, userID uint, themeName
 // SwitchToOfficial 切换到官方主题
 // 重要：先更新数据库状态，再停止 SSR 进程
 // 这样即使停止进程失败，代理中间件也不会再代理请求（因为数据库状态已经更新了）
-func (s *themeService) SwitchToOfficial(ctx context.Context, userID uint, ssrManager SSRManagerInterface) error {
+func (s *themeService) SwitchToOfficial(ctx context.Context, userID uint, ssrManager SSRManagerInterface) (err error) {
+	defer trackSwitch(OfficialThemeName)(&err)
+	ctx, cancel := withOperationTimeout(ctx, currentOperationTimeouts().Switch)
+	defer cancel()
+	defer wrapTimeout(ctx, "切换到官方主题", &err)
+
 	// 1. 首先更新数据库记录（让代理中间件立即停止代理到 SSR）
 	// 这是最关键的一步，必须首先执行
-	_, err := s.db.UserInstalledTheme.
+	_, err = s.db.UserInstalledTheme.
 		Update().
 		Where(userinstalledtheme.UserID(userID)).
 		SetIsCurrent(false).
@@ -1081,7 +1280,7 @@ func (s *themeService) SwitchToOfficial(ctx context.Context, userID uint, ssrMan
 	backupPath := ""
 	if s.IsStaticModeActive() {
 		backupPath = filepath.Join(BackupDirName, fmt.Sprintf("static_backup_%d", time.Now().Unix()))
-		if err := s.backupDirectory(StaticDirName, backupPath); err != nil {
+		if err := s.backupDirectory(ctx, StaticDirName, backupPath); err != nil {
 			log.Printf("[切换到官方主题] 警告：备份静态文件失败: %v", err)
 			// 不阻塞，继续执行
 		}
@@ -1097,6 +1296,9 @@ func (s *themeService) SwitchToOfficial(ctx context.Context, userID uint, ssrMan
 	// 即使这一步失败，前面数据库状态已经更新，代理中间件也不会再代理到 SSR
 	if ssrManager != nil {
 		runningThemes := ssrManager.ListRunning()
+		if len(runningThemes) > 0 {
+			switchProgress(OfficialThemeName, adminevent.StageStopping)
+		}
 		for _, themeName := range runningThemes {
 			log.Printf("[切换到官方主题] 停止 SSR 主题: %s", themeName)
 			if err := ssrManager.Stop(themeName); err != nil {
@@ -1127,6 +1329,7 @@ func (s *themeService) SwitchToOfficial(ctx context.Context, userID uint, ssrMan
 	}
 
 	log.Printf("成功切换到官方主题")
+	s.publishThemeSwitched(OfficialThemeName)
 	return nil
 }
 
@@ -1147,7 +1350,7 @@ func (s *themeService) UninstallTheme(ctx context.Context, userID uint, themeNam
 
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return fmt.Errorf("主题 %s 未安装", themeName)
+			return fmt.Errorf("%w: %s", ErrThemeNotInstalled, themeName)
 		}
 		return fmt.Errorf("查询主题失败: %w", err)
 	}
@@ -1181,20 +1384,26 @@ func (s *themeService) UninstallTheme(ctx context.Context, userID uint, themeNam
 	if isReallyCurrentTheme {
 		return fmt.Errorf("不能卸载当前使用的主题，请先切换到其他主题")
 	}
+	if err := s.ensureNoDependents(ctx, userID, themeName); err != nil {
+		return err
+	}
 
-	// 3. 删除主题文件
-	themeDir := filepath.Join(ThemesDirName, themeName)
-	if err := os.RemoveAll(themeDir); err != nil {
-		log.Printf("警告：删除主题文件夹失败: %v", err)
-		// 继续执行，不因为文件删除失败而中断
+	// 3. 主题文件移入回收站，保留期内可以恢复
+	if err := moveThemeToTrash(theme); err != nil {
+		log.Printf("警告：主题 %s 移入回收站失败，直接删除: %v", themeName, err)
+		if err := os.RemoveAll(filepath.Join(ThemesDirName, themeName)); err != nil {
+			log.Printf("警告：删除主题文件夹失败: %v", err)
+			// 继续执行，不因为文件删除失败而中断
+		}
+		removeThemeManifest(themeName)
 	}
 
 	// 4. 删除数据库记录
-	if err := s.db.UserInstalledTheme.DeleteOneID(theme.ID).Exec(ctx); err != nil {
+	if err := s.deleteInstalledThemeRecord(ctx, theme.ID); err != nil {
 		return fmt.Errorf("删除主题记录失败: %w", err)
 	}
 
-	log.Printf("主题 %s 卸载成功", themeName)
+	log.Printf("主题 %s 已卸载并移入回收站", themeName)
 	return nil
 }
 
@@ -1205,8 +1414,8 @@ func (s *themeService) IsStaticModeActive() bool {
 		return false
 	}
 
-	// 检查 index.html 是否存在
-	indexPath := filepath.Join(StaticDirName, "index.html")
+	// 检查 index.html 是否存在（子主题可以使用父主题的 index.html）
+	indexPath := resolveStaticIndex()
 	if _, err := os.Stat(indexPath); os.IsNotExist(err) {
 		return false
 	}
@@ -1247,7 +1456,7 @@ func (s *themeService) IsStaticModeActive() bool {
 }
 
 // downloadAndExtractTheme 下载并解压主题
-func (s *themeService) downloadAndExtractTheme(downloadURL, themeDir string) error {
+func (s *themeService) downloadAndExtractTheme(ctx context.Context, downloadURL, checksum, themeDir string) error {
 	// 创建临时文件
 	tempFile, err := os.CreateTemp("", "theme_*.zip")
 	if err != nil {
@@ -1256,44 +1465,47 @@ func (s *themeService) downloadAndExtractTheme(downloadURL, themeDir string) err
 	defer os.Remove(tempFile.Name())
 	defer tempFile.Close()
 
-	// 下载文件
-	resp, err := http.Get(downloadURL)
-	if err != nil {
+	// 下载文件（按配置优先使用下载镜像，提供校验和时校验内容），服务器返回大小时先检查临时目录的可用空间
+	// 在安装流程中时推送下载和解压进度
+	task := installTaskFrom(ctx)
+	task.setStage(adminevent.StageDownloading)
+	checkSize := func(contentLength int64) error { return ensureSpaceFor(tempFile.Name(), contentLength) }
+	if err := httpclient.DownloadToFileWithProgress(ctx, themeDownloadClient, downloadURL, checksum, tempFile, checkSize, task.progress); err != nil {
 		return fmt.Errorf("下载失败: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("下载失败，状态码: %d", resp.StatusCode)
-	}
-
-	// 复制到临时文件
-	_, err = io.Copy(tempFile, resp.Body)
-	if err != nil {
-		return fmt.Errorf("保存下载文件失败: %w", err)
-	}
 
 	// 解压到主题目录
-	return s.extractZip(tempFile.Name(), themeDir)
+	return s.extractZip(ctx, tempFile.Name(), themeDir)
 }
 
 // extractZip 解压zip文件
-func (s *themeService) extractZip(zipPath, destDir string) error {
+// ctx 取消时在下一个文件前停止，已解压的文件由调用方清理
+func (s *themeService) extractZip(ctx context.Context, zipPath, destDir string) error {
 	reader, err := zip.OpenReader(zipPath)
 	if err != nil {
 		return err
 	}
 	defer reader.Close()
 
+	// 按解压后的总大小检查磁盘空间和存储配额，避免解压到一半磁盘写满
+	totalSize := zipUncompressedSize(&reader.Reader)
+	if err := ensureSpaceFor(destDir, totalSize); err != nil {
+		return err
+	}
+	task := installTaskFrom(ctx)
+	task.setStage(adminevent.StageExtracting)
+	var extracted int64
+
 	// 检测是否有根目录前缀
 	var rootPrefix string
 	for _, file := range reader.File {
-		if strings.Contains(file.Name, "/") {
-			parts := strings.Split(file.Name, "/")
+		name := normalizeArchivePath(file.Name)
+		if strings.Contains(name, "/") {
+			parts := strings.Split(name, "/")
 			if len(parts) > 1 {
 				// 检查是否有 theme.json 或 index.html 在这个子目录中
 				potentialPrefix := parts[0] + "/"
-				if strings.HasSuffix(file.Name, "theme.json") || strings.HasSuffix(file.Name, "index.html") {
+				if strings.HasSuffix(name, "theme.json") || strings.HasSuffix(name, "index.html") {
 					rootPrefix = potentialPrefix
 					log.Printf("解压时检测到主题文件在子目录中: %s", rootPrefix)
 					break
@@ -1306,15 +1518,19 @@ func (s *themeService) extractZip(zipPath, destDir string) error {
 	os.MkdirAll(destDir, 0755)
 
 	for _, file := range reader.File {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		name := normalizeArchivePath(file.Name)
 		// 防止路径遍历攻击
-		if strings.Contains(file.Name, "..") {
+		if strings.Contains(name, "..") {
 			continue
 		}
 
 		// 处理子目录前缀
-		targetPath := file.Name
-		if rootPrefix != "" && strings.HasPrefix(file.Name, rootPrefix) {
-			targetPath = strings.TrimPrefix(file.Name, rootPrefix)
+		targetPath := name
+		if rootPrefix != "" && strings.HasPrefix(name, rootPrefix) {
+			targetPath = strings.TrimPrefix(name, rootPrefix)
 		}
 
 		// 如果去除前缀后路径为空，跳过
@@ -1322,13 +1538,13 @@ func (s *themeService) extractZip(zipPath, destDir string) error {
 			continue
 		}
 
-		path := filepath.Join(destDir, targetPath)
-
-		// 确保目标路径在目标目录内（再次防止路径遍历）
-		if !strings.HasPrefix(path, destDir) {
-			log.Printf("跳过不安全的路径: %s", path)
+		// 确保目标路径在目标目录内（再次防止路径遍历，包括绝对路径和 Windows 盘符）
+		targetPath = filepath.FromSlash(targetPath)
+		if !filepath.IsLocal(targetPath) {
+			log.Printf("跳过不安全的路径: %s", file.Name)
 			continue
 		}
+		path := filepath.Join(destDir, targetPath)
 
 		if file.FileInfo().IsDir() {
 			os.MkdirAll(path, file.FileInfo().Mode())
@@ -1351,10 +1567,12 @@ func (s *themeService) extractZip(zipPath, destDir string) error {
 		}
 		defer targetFile.Close()
 
-		_, err = io.Copy(targetFile, fileReader)
+		n, err := io.Copy(targetFile, fileReader)
 		if err != nil {
 			return err
 		}
+		extracted += n
+		task.progress(extracted, totalSize)
 
 		log.Printf("解压文件: %s -> %s", file.Name, targetPath)
 	}
@@ -1362,13 +1580,26 @@ func (s *themeService) extractZip(zipPath, destDir string) error {
 	return nil
 }
 
+// normalizeArchivePath 统一压缩包内的路径分隔符，Windows 上打包的压缩包可能使用反斜杠
+func normalizeArchivePath(name string) string {
+	return strings.ReplaceAll(name, "\\", "/")
+}
+
 // validateThemeFiles 验证主题文件完整性
+// 子主题可以不包含 index.html 和 static 目录，由父主题提供
 func (s *themeService) validateThemeFiles(themeDir string) error {
+	parentDirs, err := s.validateThemeInheritance(themeDir)
+	if err != nil {
+		return err
+	}
+
 	// 检查index.html是否存在
-	indexPath := filepath.Join(themeDir, "index.html")
-	if _, err := os.Stat(indexPath); os.IsNotExist(err) {
+	if resolveInheritedFile(themeDir, parentDirs, "index.html") == "" {
 		return fmt.Errorf("缺少必需的 index.html 文件")
 	}
+	if len(parentDirs) > 0 {
+		return nil
+	}
 
 	// 检查static目录是否存在
 	staticPath := filepath.Join(themeDir, "static")
@@ -1380,9 +1611,13 @@ func (s *themeService) validateThemeFiles(themeDir string) error {
 }
 
 // backupDirectory 备份目录
-func (s *themeService) backupDirectory(srcDir, backupDir string) error {
+func (s *themeService) backupDirectory(ctx context.Context, srcDir, backupDir string) error {
+	size, _ := dirUsage(srcDir)
+	if err := ensureSpaceFor(backupDir, size); err != nil {
+		return err
+	}
 	os.MkdirAll(filepath.Dir(backupDir), 0755)
-	return s.copyDirectory(srcDir, backupDir)
+	return s.copyDirectory(ctx, srcDir, backupDir)
 }
 
 // restoreFromBackup 从备份恢复
@@ -1401,12 +1636,12 @@ func (s *themeService) restoreFromBackup(backupDir, destDir string) error {
 		os.RemoveAll(destDir)
 	}
 
-	// 从备份恢复
-	return s.copyDirectory(backupDir, destDir)
+	// 从备份恢复，恢复是失败后的清理，不随操作取消或超时中止
+	return s.copyDirectory(context.Background(), backupDir, destDir)
 }
 
 // copyThemeToStatic 复制主题文件到static目录
-func (s *themeService) copyThemeToStatic(themeDir string) error {
+func (s *themeService) copyThemeToStatic(ctx context.Context, themeDir string) error {
 	// 先安全清空static目录
 	if err := s.safeRemoveStaticDir(); err != nil {
 		log.Printf("警告：清空static目录失败，继续尝试复制: %v", err)
@@ -1419,15 +1654,18 @@ func (s *themeService) copyThemeToStatic(themeDir string) error {
 	}
 
 	// 复制整个主题目录内容到static
-	return s.copyDirectory(themeDir, StaticDirName)
+	return s.copyDirectory(ctx, themeDir, StaticDirName)
 }
 
-// copyDirectory 复制目录
-func (s *themeService) copyDirectory(srcDir, destDir string) error {
+// copyDirectory 复制目录，每个文件复制前检查 ctx 是否已取消
+func (s *themeService) copyDirectory(ctx context.Context, srcDir, destDir string) error {
 	return filepath.Walk(srcDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 
 		relPath, err := filepath.Rel(srcDir, path)
 		if err != nil {
@@ -1465,8 +1703,12 @@ func (s *themeService) copyFile(srcPath, destPath string) error {
 	return err
 }
 
-// UploadTheme 上传主题压缩包
-func (s *themeService) UploadTheme(ctx context.Context, userID uint, file *multipart.FileHeader, forceUpdate ...bool) (*ThemeInfo, error) {
+// UploadTheme 上传主题压缩包，超过 theme.operation.install_timeout 时中止
+func (s *themeService) UploadTheme(ctx context.Context, userID uint, file *multipart.FileHeader, forceUpdate ...bool) (_ *ThemeInfo, err error) {
+	ctx, cancel := withOperationTimeout(ctx, currentOperationTimeouts().Install)
+	defer cancel()
+	defer wrapTimeout(ctx, "上传主题 "+file.Filename, &err)
+
 	// 解析可选的 forceUpdate 参数
 	isForceUpdate := len(forceUpdate) > 0 && forceUpdate[0]
 	// 1. 验证主题压缩包
@@ -1499,8 +1741,8 @@ func (s *themeService) UploadTheme(ctx context.Context, userID uint, file *multi
 		if !isForceUpdate {
 			// 如果没有强制更新标志，说明前端没有经过版本比较流程，直接返回错误
 			// 正常流程应该是前端先调用 ValidateThemePackage，发现重复后进行版本比较和用户确认
-			return nil, fmt.Errorf("主题 %s 已经安装，请使用版本更新功能。当前版本: %s",
-				metadata.Name, existingInstallation.InstalledVersion)
+			return nil, fmt.Errorf("%w: %s，请使用版本更新功能。当前版本: %s",
+				ErrThemeAlreadyInstalled, metadata.Name, existingInstallation.InstalledVersion)
 		}
 		isUpdate = true
 		log.Printf("强制更新主题 %s: %s -> %s", metadata.Name, existingInstallation.InstalledVersion, metadata.Version)
@@ -1517,7 +1759,13 @@ func (s *themeService) UploadTheme(ctx context.Context, userID uint, file *multi
 
 	// 4. 解压主题到目标目录
 	themeDir := filepath.Join(ThemesDirName, metadata.Name)
-	if err := s.extractZip(tempFile, themeDir); err != nil {
+	_, statErr := os.Stat(themeDir)
+	createdDir := os.IsNotExist(statErr)
+	if err := s.extractZip(ctx, tempFile, themeDir); err != nil {
+		// 清理解压了一半的目录（更新已有主题时目录已存在，不动）
+		if createdDir {
+			os.RemoveAll(themeDir)
+		}
 		return nil, fmt.Errorf("解压主题失败: %w", err)
 	}
 
@@ -1562,6 +1810,10 @@ func (s *themeService) UploadTheme(ctx context.Context, userID uint, file *multi
 		}
 	}
 
+	if err := s.recordThemeManifest(ctx, metadata.Name); err != nil {
+		log.Printf("警告：记录主题 %s 文件清单失败: %v", metadata.Name, err)
+	}
+
 	// 7. 构造返回的主题信息
 	authorName := s.extractAuthorName(metadata.Author)
 	previewURL := s.extractFirstScreenshot(metadata.Screenshots)
@@ -1656,6 +1908,7 @@ func (s *themeService) ValidateThemePackage(ctx context.Context, userID uint, fi
 	var themeJsonFile *zip.File
 	var indexHtmlFile *zip.File
 	hasStaticDir := false
+	localeNames := make(map[string]bool)
 	var rootPrefix string // 检测是否有根目录前缀
 
 	// 第一遍扫描：检测压缩包结构
@@ -1669,12 +1922,13 @@ func (s *themeService) ValidateThemePackage(ctx context.Context, userID uint, fi
 		result.FileList = append(result.FileList, file.Name)
 
 		// 检测是否所有文件都在同一个子目录中
-		if strings.Contains(file.Name, "/") && rootPrefix == "" {
-			parts := strings.Split(file.Name, "/")
+		name := normalizeArchivePath(file.Name)
+		if strings.Contains(name, "/") && rootPrefix == "" {
+			parts := strings.Split(name, "/")
 			if len(parts) > 1 {
 				// 检查是否有 theme.json 或 index.html 在这个子目录中
 				potentialPrefix := parts[0] + "/"
-				if strings.HasSuffix(file.Name, "theme.json") || strings.HasSuffix(file.Name, "index.html") {
+				if strings.HasSuffix(name, "theme.json") || strings.HasSuffix(name, "index.html") {
 					rootPrefix = potentialPrefix
 					log.Printf("检测到主题文件在子目录中: %s", rootPrefix)
 				}
@@ -1689,9 +1943,9 @@ func (s *themeService) ValidateThemePackage(ctx context.Context, userID uint, fi
 		}
 
 		// 移除根目录前缀进行匹配
-		normalizedName := file.Name
-		if rootPrefix != "" && strings.HasPrefix(file.Name, rootPrefix) {
-			normalizedName = strings.TrimPrefix(file.Name, rootPrefix)
+		normalizedName := normalizeArchivePath(file.Name)
+		if rootPrefix != "" && strings.HasPrefix(normalizedName, rootPrefix) {
+			normalizedName = strings.TrimPrefix(normalizedName, rootPrefix)
 		}
 
 		// 检查必需文件
@@ -1702,6 +1956,9 @@ func (s *themeService) ValidateThemePackage(ctx context.Context, userID uint, fi
 			indexHtmlFile = file
 		case strings.HasPrefix(normalizedName, "static/"):
 			hasStaticDir = true
+		case strings.HasPrefix(normalizedName, LocalesDirName+"/") && !file.FileInfo().IsDir():
+			result.Errors = append(result.Errors, validateLocaleFile(file, normalizedName)...)
+			localeNames[strings.TrimSuffix(strings.TrimPrefix(normalizedName, LocalesDirName+"/"), ".json")] = true
 		}
 
 		// 验证文件类型安全性
@@ -1715,14 +1972,6 @@ func (s *themeService) ValidateThemePackage(ctx context.Context, userID uint, fi
 		result.Errors = append(result.Errors, "缺少必需的 theme.json 文件")
 	}
 
-	if indexHtmlFile == nil {
-		result.Errors = append(result.Errors, "缺少必需的 index.html 文件")
-	}
-
-	if !hasStaticDir {
-		result.Warnings = append(result.Warnings, "建议包含 static/ 目录用于存放静态资源")
-	}
-
 	// 6. 验证theme.json内容
 	if themeJsonFile != nil {
 		metadata, err := s.parseThemeJson(themeJsonFile)
@@ -1735,9 +1984,32 @@ func (s *themeService) ValidateThemePackage(ctx context.Context, userID uint, fi
 			if validationErrors := s.validateThemeMetadata(metadata); len(validationErrors) > 0 {
 				result.Errors = append(result.Errors, validationErrors...)
 			}
+			// 声明了父主题时父主题必须已安装，且继承链中不能有循环
+			if metadata.Extends != "" {
+				if _, err := resolveParentChain(metadata.Name, metadata.Extends); err != nil {
+					result.Errors = append(result.Errors, err.Error())
+				}
+			}
+			// 声明了默认语言时必须提供对应的语言文件
+			if metadata.DefaultLocale != "" && !localeNames[metadata.DefaultLocale] {
+				result.Errors = append(result.Errors, fmt.Sprintf("theme.json 声明的默认语言 %s 缺少 locales/%s.json", metadata.DefaultLocale, metadata.DefaultLocale))
+			} else if metadata.DefaultLocale == "" && len(localeNames) > 0 && !localeNames[DefaultThemeLocale] {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("提供了语言文件但没有 locales/%s.json，建议在 theme.json 中声明 default_locale 作为回退语言", DefaultThemeLocale))
+			}
+			result.Errors = append(result.Errors, validateLayoutAreas(metadata)...)
 		}
 	}
 
+	// 子主题可以不包含 index.html 和 static 目录，由父主题提供
+	isChildTheme := result.Metadata != nil && result.Metadata.Extends != ""
+	if indexHtmlFile == nil && !isChildTheme {
+		result.Errors = append(result.Errors, "缺少必需的 index.html 文件")
+	}
+
+	if !hasStaticDir && !isChildTheme {
+		result.Warnings = append(result.Warnings, "建议包含 static/ 目录用于存放静态资源")
+	}
+
 	// 7. 检查是否存在重复主题
 	if result.Metadata != nil {
 		log.Printf("[ValidateTheme] 检查主题 %s 是否已被用户 %d 安装", result.Metadata.Name, userID)
@@ -1792,6 +2064,10 @@ func (s *themeService) saveUploadedFile(file *multipart.FileHeader) (string, err
 	}
 	defer src.Close()
 
+	if err := ensureSpaceFor(os.TempDir(), file.Size); err != nil {
+		return "", err
+	}
+
 	// 创建临时文件
 	tempFile, err := os.CreateTemp("", "theme_upload_*.zip")
 	if err != nil {
@@ -1850,6 +2126,10 @@ func (s *themeService) validateThemeMetadata(metadata *ThemeMetadata) []string {
 		}
 	}
 
+	if metadata.Extends != "" && metadata.Extends == metadata.Name {
+		errors = append(errors, "extends字段不能指向主题自身")
+	}
+
 	if metadata.DisplayName == "" {
 		errors = append(errors, "displayName字段不能为空")
 	}
@@ -1890,6 +2170,15 @@ func (s *themeService) validateThemeMetadata(metadata *ThemeMetadata) []string {
 		}
 	}
 
+	// 验证分模式配置只用于颜色和图片字段
+	for _, group := range metadata.Settings {
+		for _, field := range group.Fields {
+			if field.Modes && !fieldSupportsModes(field) {
+				errors = append(errors, fmt.Sprintf("配置项 %s 的类型为 %s，只有 color 和 image 类型支持 modes", field.Name, field.Type))
+			}
+		}
+	}
+
 	return errors
 }
 
@@ -1942,9 +2231,13 @@ func (s *themeService) validateExtractedTheme(themeDir string, metadata *ThemeMe
 		return fmt.Errorf("解压后缺少 theme.json 文件")
 	}
 
-	// 检查index.html文件
-	indexPath := filepath.Join(themeDir, "index.html")
-	if _, err := os.Stat(indexPath); os.IsNotExist(err) {
+	// 检查index.html文件，子主题可以使用父主题的 index.html
+	parentDirs, err := s.validateThemeInheritance(themeDir)
+	if err != nil {
+		return err
+	}
+	indexPath := resolveInheritedFile(themeDir, parentDirs, "index.html")
+	if indexPath == "" {
 		return fmt.Errorf("解压后缺少 index.html 文件")
 	}
 
@@ -2226,7 +2519,7 @@ func (s *themeService) GetUserThemeConfig(ctx context.Context, userID uint, them
 
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("主题 %s 未安装", themeName)
+			return nil, fmt.Errorf("%w: %s", ErrThemeNotInstalled, themeName)
 		}
 		return nil, fmt.Errorf("查询主题失败: %w", err)
 	}
@@ -2249,7 +2542,7 @@ func (s *themeService) SaveUserThemeConfig(ctx context.Context, userID uint, the
 
 	// 验证配置值
 	if err := s.validateThemeConfig(settings, config); err != nil {
-		return fmt.Errorf("配置验证失败: %w", err)
+		return err
 	}
 
 	// 更新数据库
@@ -2311,7 +2604,7 @@ func (s *themeService) GetCurrentThemeConfig(ctx context.Context, userID uint) (
 	}, nil
 }
 
-// validateThemeConfig 验证主题配置值
+// validateThemeConfig 验证主题配置值，不合法的字段全部收集到 ConfigValidationError 中返回
 func (s *themeService) validateThemeConfig(settings []ThemeSettingGroup, config map[string]interface{}) error {
 	// 构建字段定义映射
 	fieldDefs := make(map[string]ThemeSettingField)
@@ -2321,6 +2614,8 @@ func (s *themeService) validateThemeConfig(settings []ThemeSettingGroup, config
 		}
 	}
 
+	verr := &ConfigValidationError{}
+
 	// 验证每个配置项
 	for key, value := range config {
 		fieldDef, exists := fieldDefs[key]
@@ -2332,12 +2627,19 @@ func (s *themeService) validateThemeConfig(settings []ThemeSettingGroup, config
 
 		// 验证必填字段
 		if fieldDef.Required && (value == nil || value == "") {
-			return fmt.Errorf("字段 %s 为必填项", fieldDef.Label)
+			verr.add(fieldDef, &ConfigFieldError{Code: ConfigErrRequired})
+			continue
 		}
 
-		// 验证字段类型
-		if err := s.validateFieldValue(fieldDef, value); err != nil {
-			return fmt.Errorf("字段 %s 验证失败: %w", fieldDef.Label, err)
+		// 验证字段类型，分模式字段分别校验浅色和深色的值
+		var fe *ConfigFieldError
+		if fieldDef.Modes && fieldSupportsModes(fieldDef) {
+			fe = s.validateModeFieldValue(fieldDef, value)
+		} else {
+			fe = s.validateFieldValue(fieldDef, value)
+		}
+		if fe != nil {
+			verr.add(fieldDef, fe)
 		}
 	}
 
@@ -2346,17 +2648,20 @@ func (s *themeService) validateThemeConfig(settings []ThemeSettingGroup, config
 		for _, field := range group.Fields {
 			if field.Required {
 				if _, exists := config[field.Name]; !exists {
-					return fmt.Errorf("字段 %s 为必填项", field.Label)
+					verr.add(field, &ConfigFieldError{Code: ConfigErrRequired})
 				}
 			}
 		}
 	}
 
+	if len(verr.Fields) > 0 {
+		return verr
+	}
 	return nil
 }
 
 // validateFieldValue 验证单个字段值
-func (s *themeService) validateFieldValue(field ThemeSettingField, value interface{}) error {
+func (s *themeService) validateFieldValue(field ThemeSettingField, value interface{}) *ConfigFieldError {
 	if value == nil {
 		return nil
 	}
@@ -2369,21 +2674,22 @@ func (s *themeService) validateFieldValue(field ThemeSettingField, value interfa
 	// 字符串类型验证
 	if strVal, ok := value.(string); ok {
 		if validation.MinLength != nil && len(strVal) < *validation.MinLength {
-			return fmt.Errorf("长度不能小于 %d", *validation.MinLength)
+			return &ConfigFieldError{Code: ConfigErrMinLength, Params: map[string]interface{}{"min": *validation.MinLength}}
 		}
 		if validation.MaxLength != nil && len(strVal) > *validation.MaxLength {
-			return fmt.Errorf("长度不能大于 %d", *validation.MaxLength)
+			return &ConfigFieldError{Code: ConfigErrMaxLength, Params: map[string]interface{}{"max": *validation.MaxLength}}
 		}
 		if validation.Pattern != "" {
 			matched, err := regexp.MatchString(validation.Pattern, strVal)
 			if err != nil {
-				return fmt.Errorf("正则表达式无效")
+				return &ConfigFieldError{Code: ConfigErrBadPattern}
 			}
 			if !matched {
+				fe := &ConfigFieldError{Code: ConfigErrPattern, Params: map[string]interface{}{"pattern": validation.Pattern}}
 				if validation.Message != "" {
-					return fmt.Errorf("%s", validation.Message)
+					fe.Message, fe.custom = validation.Message, true
 				}
-				return fmt.Errorf("格式不正确")
+				return fe
 			}
 		}
 	}
@@ -2391,10 +2697,10 @@ func (s *themeService) validateFieldValue(field ThemeSettingField, value interfa
 	// 数字类型验证
 	if numVal, ok := value.(float64); ok {
 		if validation.Min != nil && numVal < *validation.Min {
-			return fmt.Errorf("值不能小于 %v", *validation.Min)
+			return &ConfigFieldError{Code: ConfigErrMin, Params: map[string]interface{}{"min": *validation.Min}}
 		}
 		if validation.Max != nil && numVal > *validation.Max {
-			return fmt.Errorf("值不能大于 %v", *validation.Max)
+			return &ConfigFieldError{Code: ConfigErrMax, Params: map[string]interface{}{"max": *validation.Max}}
 		}
 	}
 
@@ -2519,7 +2825,12 @@ func (s *themeService) UninstallSSRTheme(ctx context.Context, userID uint, theme
 }
 
 // SwitchToSSRTheme 切换到 SSR 主题
-func (s *themeService) SwitchToSSRTheme(ctx context.Context, userID uint, themeName string, ssrManager SSRManagerInterface) error {
+func (s *themeService) SwitchToSSRTheme(ctx context.Context, userID uint, themeName string, ssrManager SSRManagerInterface) (err error) {
+	defer trackSwitch(themeName)(&err)
+	ctx, cancel := withOperationTimeout(ctx, currentOperationTimeouts().Switch)
+	defer cancel()
+	defer wrapTimeout(ctx, "切换到 SSR 主题 "+themeName, &err)
+
 	// #region agent log
 	debugLog := func(msg string, data map[string]interface{}) {
 		f, _ := os.OpenFile("/Users/anzhiyu/Project/2025/anheyu-work/.cursor/debug.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
@@ -2549,7 +2860,7 @@ func (s *themeService) SwitchToSSRTheme(ctx context.Context, userID uint, themeN
 			// #region agent log
 			debugLog("主题未安装", map[string]interface{}{"error": "not found"})
 			// #endregion
-			return fmt.Errorf("SSR 主题 %s 未安装", themeName)
+			return fmt.Errorf("SSR %w: %s", ErrThemeNotInstalled, themeName)
 		}
 		return fmt.Errorf("查询 SSR 主题失败: %w", err)
 	}
@@ -2559,6 +2870,7 @@ func (s *themeService) SwitchToSSRTheme(ctx context.Context, userID uint, themeN
 	// #endregion
 
 	// 2. 停止其他运行中的 SSR 主题
+	snap := s.snapshotSwitch(ctx, userID, ssrManager)
 	if ssrManager != nil {
 		runningThemes := ssrManager.ListRunning()
 		// #region agent log
@@ -2566,6 +2878,7 @@ func (s *themeService) SwitchToSSRTheme(ctx context.Context, userID uint, themeN
 		// #endregion
 		for _, name := range runningThemes {
 			if name != themeName {
+				switchProgress(themeName, adminevent.StageStopping)
 				if err := ssrManager.Stop(name); err != nil {
 					log.Printf("[SSR主题] 停止主题 %s 失败: %v", name, err)
 				}
@@ -2621,6 +2934,7 @@ func (s *themeService) SwitchToSSRTheme(ctx context.Context, userID uint, themeN
 			// #region agent log
 			debugLog("启动SSR主题", map[string]interface{}{"themeName": themeName})
 			// #endregion
+			switchProgress(themeName, adminevent.StageStarting)
 			if err := ssrManager.Start(themeName, 3000); err != nil {
 				tx.Rollback()
 				return fmt.Errorf("启动 SSR 主题失败: %w", err)
@@ -2644,6 +2958,14 @@ func (s *themeService) SwitchToSSRTheme(ctx context.Context, userID uint, themeN
 	debugLog("切换SSR主题完成", map[string]interface{}{"themeName": themeName, "success": true})
 	// #endregion
 
+	s.publishThemeSwitched(themeName)
+
+	// 6. 检查首页和文章页（在超时时间内等待 SSR 就绪），不可用时回滚到切换前的主题
+	if err := s.runCanary(ctx); err != nil {
+		s.rollbackSwitch(ctx, userID, themeName, snap, ssrManager, err)
+		return fmt.Errorf("SSR 主题 %s %w: %v", themeName, ErrCanaryFailed, err)
+	}
+
 	log.Printf("[SSR主题] 切换到主题成功: %s", themeName)
 	return nil
 }
@@ -2801,3 +3123,14 @@ func (s *themeService) GetSSRThemeCurrentStatus(ctx context.Context, userID uint
 
 	return result, nil
 }
+
+// validateThemeName 校验主题名称，防止通过名称进行路径遍历
+func validateThemeName(themeName string) error {
+	if themeName == "" {
+		return fmt.Errorf("主题名称不能为空")
+	}
+	if strings.Contains(themeName, "..") || strings.ContainsAny(themeName, `/\`) {
+		return fmt.Errorf("非法的主题名称: %s", themeName)
+	}
+	return nil
+}
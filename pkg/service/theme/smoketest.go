@@ -0,0 +1,363 @@
+/*
+ * @Description: 主题兼容性冒烟测试
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 主题安装/上传完成后，在沙箱中渲染首页和文章详情页模板，
+ * 检查模板语法错误、缺失的静态资源以及未闭合的 script 标签。
+ */
+package theme
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// smokeTestResultDir 冒烟测试结果的存储目录
+// 结果不放在主题目录内，避免切换主题时被复制到 static 目录并对外暴露
+const smokeTestResultDir = ".smoke-tests"
+
+// ThemeSmokeTestPage 单个页面的冒烟测试结果
+type ThemeSmokeTestPage struct {
+	Page          string   `json:"page"`                     // 模板文件，如 index.html
+	Route         string   `json:"route"`                    // 模拟请求的路由
+	IsTemplate    bool     `json:"is_template"`              // 是否为 Go 模板
+	TemplateError string   `json:"template_error,omitempty"` // 模板解析/渲染错误
+	MissingAssets []string `json:"missing_assets,omitempty"` // 引用但不存在的本地资源
+	ScriptIssues  []string `json:"script_issues,omitempty"`  // script 标签问题
+	Passed        bool     `json:"passed"`
+}
+
+// ThemeSmokeTestResult 主题冒烟测试结果
+type ThemeSmokeTestResult struct {
+	ThemeName string               `json:"theme_name"`
+	Passed    bool                 `json:"passed"`
+	Skipped   bool                 `json:"skipped,omitempty"` // SSR 主题等不适用的情况
+	Message   string               `json:"message,omitempty"`
+	Pages     []ThemeSmokeTestPage `json:"pages"`
+	CheckedAt time.Time            `json:"checked_at"`
+	Duration  int64                `json:"duration_ms"`
+}
+
+var (
+	smokeGoTemplateVarPattern  = regexp.MustCompile(`\{\{\s*\.`)
+	smokeGoTemplateCtrlPattern = regexp.MustCompile(`\{\{\s*(if|range|template|define|block|with|end|else)\b`)
+	smokeAssetRefPattern       = regexp.MustCompile(`(?i)<(?:script|link|img|source)\b[^>]*?\s(?:src|href)\s*=\s*["']([^"']+)["']`)
+	smokeScriptOpenPattern     = regexp.MustCompile(`(?i)<script\b`)
+	smokeScriptClosePattern    = regexp.MustCompile(`(?i)</script\s*>`)
+)
+
+// RunSmokeTest 对已安装的主题执行冒烟测试，并保存测试结果
+func (s *themeService) RunSmokeTest(ctx context.Context, themeName string) (*ThemeSmokeTestResult, error) {
+	if err := validateThemeName(themeName); err != nil {
+		return nil, err
+	}
+
+	themeDir := filepath.Join(ThemesDirName, themeName)
+	if _, err := os.Stat(themeDir); os.IsNotExist(err) {
+		return nil, fmt.Errorf("主题 %s 不存在", themeName)
+	}
+
+	start := time.Now()
+	result := &ThemeSmokeTestResult{
+		ThemeName: themeName,
+		Passed:    true,
+		CheckedAt: start,
+	}
+
+	indexPath := filepath.Join(themeDir, "index.html")
+	if _, err := os.Stat(indexPath); os.IsNotExist(err) {
+		// SSR 主题没有 index.html，由独立进程渲染，不在此处检查
+		result.Skipped = true
+		result.Message = "主题不包含 index.html，跳过冒烟测试"
+	} else {
+		pages := []struct {
+			file  string
+			route string
+		}{
+			{"index.html", "/"},
+			{filepath.Join("posts", "__template__.html"), "/posts/smoke-test"},
+		}
+
+		for _, p := range pages {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			fullPath := filepath.Join(themeDir, p.file)
+			if _, err := os.Stat(fullPath); os.IsNotExist(err) {
+				continue
+			}
+			page := s.smokeTestPage(themeDir, p.file, p.route)
+			if !page.Passed {
+				result.Passed = false
+			}
+			result.Pages = append(result.Pages, page)
+		}
+	}
+
+	result.Duration = time.Since(start).Milliseconds()
+	if result.Message == "" {
+		if result.Passed {
+			result.Message = "冒烟测试通过"
+		} else {
+			result.Message = "冒烟测试发现问题，请检查各页面详情"
+		}
+	}
+
+	if data, err := json.MarshalIndent(result, "", "  "); err == nil {
+		resultDir := filepath.Join(ThemesDirName, smokeTestResultDir)
+		if err := os.MkdirAll(resultDir, 0755); err != nil {
+			log.Printf("创建冒烟测试结果目录失败: %v", err)
+		} else if err := os.WriteFile(filepath.Join(resultDir, themeName+".json"), data, 0644); err != nil {
+			log.Printf("保存主题 %s 冒烟测试结果失败: %v", themeName, err)
+		}
+	}
+
+	log.Printf("主题 %s 冒烟测试完成: passed=%v, 页面数=%d, 耗时=%dms", themeName, result.Passed, len(result.Pages), result.Duration)
+	return result, nil
+}
+
+// GetSmokeTestResult 读取最近一次冒烟测试结果
+func (s *themeService) GetSmokeTestResult(ctx context.Context, themeName string) (*ThemeSmokeTestResult, error) {
+	if err := validateThemeName(themeName); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(ThemesDirName, smokeTestResultDir, themeName+".json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("主题 %s 尚未执行冒烟测试", themeName)
+		}
+		return nil, fmt.Errorf("读取冒烟测试结果失败: %w", err)
+	}
+
+	var result ThemeSmokeTestResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("解析冒烟测试结果失败: %w", err)
+	}
+	return &result, nil
+}
+
+// smokeTestPage 在沙箱中渲染单个页面并检查资源引用
+func (s *themeService) smokeTestPage(themeDir, file, route string) ThemeSmokeTestPage {
+	page := ThemeSmokeTestPage{Page: filepath.ToSlash(file), Route: route, Passed: true}
+
+	content, err := os.ReadFile(filepath.Join(themeDir, file))
+	if err != nil {
+		page.TemplateError = fmt.Sprintf("读取文件失败: %v", err)
+		page.Passed = false
+		return page
+	}
+
+	html := string(content)
+	page.IsTemplate = smokeGoTemplateVarPattern.MatchString(html) || smokeGoTemplateCtrlPattern.MatchString(html)
+
+	if page.IsTemplate {
+		rendered, err := renderSmokeTemplate(file, html, route)
+		if err != nil {
+			page.TemplateError = err.Error()
+			page.Passed = false
+		} else {
+			html = rendered
+		}
+	}
+
+	page.ScriptIssues = checkScriptTags(html)
+	page.MissingAssets = findMissingAssets(themeDir, filepath.Dir(file), html)
+	if len(page.ScriptIssues) > 0 || len(page.MissingAssets) > 0 {
+		page.Passed = false
+	}
+	return page
+}
+
+// TemplateFuncMapProvider 返回前台渲染主题模板时使用的函数
+type TemplateFuncMapProvider func() template.FuncMap
+
+var (
+	templateFuncsMu       sync.RWMutex
+	templateFuncsProvider TemplateFuncMapProvider
+)
+
+// SetTemplateFuncMapProvider 设置冒烟测试使用的模板函数来源，应与前台渲染使用同一来源，
+// 否则使用了 asset 等函数或插件函数的主题会在冒烟测试中解析失败
+func SetTemplateFuncMapProvider(provider TemplateFuncMapProvider) {
+	templateFuncsMu.Lock()
+	templateFuncsProvider = provider
+	templateFuncsMu.Unlock()
+}
+
+// smokeTemplateFuncs 返回冒烟测试使用的模板函数，未设置来源时只提供 json
+func smokeTemplateFuncs() template.FuncMap {
+	templateFuncsMu.RLock()
+	provider := templateFuncsProvider
+	templateFuncsMu.RUnlock()
+	if provider != nil {
+		return provider()
+	}
+	return template.FuncMap{
+		"json": func(v interface{}) template.JS {
+			a, _ := json.Marshal(v)
+			return template.JS(a)
+		},
+	}
+}
+
+// renderSmokeTemplate 使用模拟数据渲染模板，并捕获模板执行期间的 panic
+func renderSmokeTemplate(name, content, route string) (out string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("模板渲染时发生 panic: %v", r)
+		}
+	}()
+
+	tmpl, err := template.New(filepath.Base(name)).Funcs(smokeTemplateFuncs()).Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("模板解析失败: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, smokeTestTemplateData(route)); err != nil {
+		return "", fmt.Errorf("模板渲染失败: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// smokeTestTemplateData 构造与前台渲染管线字段一致的模拟数据
+func smokeTestTemplateData(route string) map[string]interface{} {
+	now := time.Now()
+	data := map[string]interface{}{
+		"pageTitle":            "Smoke Test - 安和鱼",
+		"pageDescription":      "主题冒烟测试页面",
+		"keywords":             "smoke,test",
+		"author":               "安知鱼",
+		"themeColor":           "#f7f9fe",
+		"favicon":              "/favicon.ico",
+		"initialData":          nil,
+		"ogType":               "website",
+		"ogUrl":                "https://example.com" + route,
+		"ogTitle":              "Smoke Test",
+		"ogDescription":        "主题冒烟测试页面",
+		"ogImage":              "",
+		"ogSiteName":           "安和鱼",
+		"ogLocale":             "zh_CN",
+		"articlePublishedTime": nil,
+		"articleModifiedTime":  nil,
+		"articleAuthor":        nil,
+		"articleTags":          nil,
+		"breadcrumbList":       []map[string]interface{}{},
+		"socialMediaLinks":     []string{},
+		"customHeaderHTML":     template.HTML(""),
+		"customFooterHTML":     template.HTML(""),
+		"currentYear":          now.Year(),
+	}
+
+	if strings.HasPrefix(route, "/posts/") {
+		tags := []string{"示例标签"}
+		data["ogType"] = "article"
+		data["initialData"] = map[string]interface{}{
+			"data":          map[string]interface{}{"title": "示例文章"},
+			"__timestamp__": now.UnixMilli(),
+		}
+		data["articlePublishedTime"] = now
+		data["articleModifiedTime"] = now
+		data["articleAuthor"] = "安知鱼"
+		data["articleTags"] = tags
+		data["articleCover"] = ""
+		data["articleContent"] = template.HTML("<h2>示例标题</h2><p>示例内容</p>")
+		data["articleReadingTime"] = 1
+		data["articleViewCount"] = 0
+		data["articleWordCount"] = 10
+		data["articleTagsList"] = tags
+		data["articlePrimaryColor"] = "#f7f9fe"
+		data["articleCategory"] = "示例分类"
+		data["prevArticle"] = map[string]interface{}{"slug": "prev", "title": "上一篇"}
+		data["nextArticle"] = map[string]interface{}{"slug": "next", "title": "下一篇"}
+	}
+	return data
+}
+
+// checkScriptTags 检查 script 标签是否成对出现
+func checkScriptTags(html string) []string {
+	var issues []string
+	opens := len(smokeScriptOpenPattern.FindAllStringIndex(html, -1))
+	closes := len(smokeScriptClosePattern.FindAllStringIndex(html, -1))
+	if opens != closes {
+		issues = append(issues, fmt.Sprintf("script 标签未正确闭合：<script> %d 个，</script> %d 个", opens, closes))
+	}
+	return issues
+}
+
+// findMissingAssets 查找页面中引用但在主题目录中不存在的本地资源
+// pageDir 为页面所在的子目录，用于解析相对路径引用
+func findMissingAssets(themeDir, pageDir, html string) []string {
+	var missing []string
+	seen := make(map[string]bool)
+
+	for _, match := range smokeAssetRefPattern.FindAllStringSubmatch(html, -1) {
+		ref := strings.TrimSpace(match[1])
+		if ref == "" || seen[ref] {
+			continue
+		}
+		seen[ref] = true
+
+		localPath, ok := resolveThemeAssetPath(themeDir, pageDir, ref)
+		if !ok {
+			continue
+		}
+		if _, err := os.Stat(localPath); os.IsNotExist(err) {
+			missing = append(missing, ref)
+		}
+	}
+	return missing
+}
+
+// resolveThemeAssetPath 将页面中的资源 URL 映射为主题目录中的文件路径
+// 外部链接、数据 URI、模板占位符及由后端动态提供的路径不做检查
+func resolveThemeAssetPath(themeDir, pageDir, ref string) (string, bool) {
+	lower := strings.ToLower(ref)
+	if strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") ||
+		strings.HasPrefix(lower, "//") || strings.HasPrefix(lower, "data:") ||
+		strings.HasPrefix(lower, "#") || strings.HasPrefix(lower, "mailto:") ||
+		strings.Contains(ref, "{{") {
+		return "", false
+	}
+
+	u, err := url.Parse(ref)
+	if err != nil || u.Path == "" {
+		return "", false
+	}
+	p := u.Path
+
+	// 后端动态提供的路径
+	for _, prefix := range []string{"/api/", "/f/", "/needcache/", "/admin-static/", "/admin-assets/"} {
+		if strings.HasPrefix(p, prefix) {
+			return "", false
+		}
+	}
+	switch p {
+	case "/rss.xml", "/feed.xml", "/atom.xml", "/sitemap.xml", "/robots.txt", "/sw.js", "/asset-manifest.json":
+		return "", false
+	}
+
+	var clean string
+	if strings.HasPrefix(p, "/") {
+		clean = filepath.Clean(filepath.FromSlash(strings.TrimPrefix(p, "/")))
+	} else {
+		clean = filepath.Clean(filepath.Join(pageDir, filepath.FromSlash(p)))
+	}
+	if strings.HasPrefix(clean, "..") {
+		return "", false
+	}
+	return filepath.Join(themeDir, clean), true
+}
@@ -0,0 +1,273 @@
+/*
+ * @Description: 主题目录磁盘占用统计与清理
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 统计 themes/、static/ 的磁盘占用，找出遗留的 backup/static_backup_*、backup/theme_backup_* 备份目录
+ * 以及上传/下载失败后残留在系统临时目录中的主题压缩包，并提供支持预演（dry-run）的清理操作。
+ */
+package theme
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
+)
+
+// staleTempFileAge 临时文件超过该时长才视为残留，避免误删正在进行中的上传
+const staleTempFileAge = time.Hour
+
+// 主题相关临时文件的名称前缀（见 downloadAndExtractTheme、saveUploadedFile、ThemeInstallWithTransaction）
+var themeTempFilePrefixes = []string{"theme_upload_", "theme_install_", "theme_"}
+
+// ThemeDiskUsage 单个主题的磁盘占用
+type ThemeDiskUsage struct {
+	Name      string `json:"name"`
+	Path      string `json:"path"`
+	SizeBytes int64  `json:"size_bytes"`
+	FileCount int    `json:"file_count"`
+	Installed bool   `json:"installed"` // 数据库中是否存在安装记录
+	IsCurrent bool   `json:"is_current"`
+}
+
+// ThemeStorageEntry 可清理的文件或目录
+type ThemeStorageEntry struct {
+	Path      string    `json:"path"`
+	SizeBytes int64     `json:"size_bytes"`
+	ModTime   time.Time `json:"mod_time"`
+	Kind      string    `json:"kind"` // backup 或 temp
+}
+
+// ThemeStorageReport 主题存储占用报告
+type ThemeStorageReport struct {
+	Themes          []ThemeDiskUsage    `json:"themes"`
+	ThemesSizeBytes int64               `json:"themes_size_bytes"`
+	StaticSizeBytes int64               `json:"static_size_bytes"`
+	Backups         []ThemeStorageEntry `json:"backups"`
+	TempFiles       []ThemeStorageEntry `json:"temp_files"`
+	ReclaimableSize int64               `json:"reclaimable_size_bytes"`
+	DiskFreeBytes   int64               `json:"disk_free_bytes,omitempty"` // themes 目录所在磁盘的可用空间，无法获取时为 0
+	GeneratedAt     time.Time           `json:"generated_at"`
+}
+
+// ThemeStorageCleanupRequest 清理请求
+type ThemeStorageCleanupRequest struct {
+	DryRun            bool `json:"dry_run"`             // 仅预演，不实际删除
+	Backups           bool `json:"backups"`             // 清理 static 备份目录
+	TempFiles         bool `json:"temp_files"`          // 清理残留的临时文件
+	KeepLatestBackups int  `json:"keep_latest_backups"` // 保留最近的 N 个备份
+}
+
+// ThemeStorageCleanupResult 清理结果
+type ThemeStorageCleanupResult struct {
+	DryRun     bool                `json:"dry_run"`
+	Removed    []ThemeStorageEntry `json:"removed"`
+	FreedBytes int64               `json:"freed_bytes"`
+	Errors     []string            `json:"errors,omitempty"`
+}
+
+// GetStorageReport 生成主题存储占用报告
+func (s *themeService) GetStorageReport(ctx context.Context, userID uint) (*ThemeStorageReport, error) {
+	report := &ThemeStorageReport{GeneratedAt: time.Now()}
+
+	installed, err := s.db.UserInstalledTheme.
+		Query().
+		Where(userinstalledtheme.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询已安装主题失败: %w", err)
+	}
+	installedMap := make(map[string]bool, len(installed))
+	currentMap := make(map[string]bool, len(installed))
+	for _, t := range installed {
+		installedMap[t.ThemeName] = true
+		currentMap[t.ThemeName] = t.IsCurrent
+	}
+
+	entries, err := os.ReadDir(ThemesDirName)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("读取主题目录失败: %w", err)
+	}
+	for _, entry := range entries {
+		// 跳过隐藏目录（如冒烟测试结果目录）
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		themePath := filepath.Join(ThemesDirName, entry.Name())
+		size, count := dirUsage(themePath)
+		report.Themes = append(report.Themes, ThemeDiskUsage{
+			Name:      entry.Name(),
+			Path:      themePath,
+			SizeBytes: size,
+			FileCount: count,
+			Installed: installedMap[entry.Name()],
+			IsCurrent: currentMap[entry.Name()],
+		})
+		report.ThemesSizeBytes += size
+	}
+	sort.Slice(report.Themes, func(i, j int) bool { return report.Themes[i].SizeBytes > report.Themes[j].SizeBytes })
+
+	report.StaticSizeBytes, _ = dirUsage(StaticDirName)
+	if free, err := availableDiskSpace(existingParent(ThemesDirName)); err == nil {
+		report.DiskFreeBytes = int64(free)
+	}
+	report.Backups = findStaticBackups()
+	report.TempFiles = findStaleThemeTempFiles()
+
+	for _, b := range report.Backups {
+		report.ReclaimableSize += b.SizeBytes
+	}
+	for _, t := range report.TempFiles {
+		report.ReclaimableSize += t.SizeBytes
+	}
+
+	return report, nil
+}
+
+// CleanupStorage 清理遗留备份与临时文件，DryRun 为 true 时只返回将被删除的内容
+func (s *themeService) CleanupStorage(ctx context.Context, userID uint, req *ThemeStorageCleanupRequest) (*ThemeStorageCleanupResult, error) {
+	if !req.Backups && !req.TempFiles {
+		return nil, fmt.Errorf("请至少选择一种清理项")
+	}
+
+	result := &ThemeStorageCleanupResult{DryRun: req.DryRun}
+	var candidates []ThemeStorageEntry
+
+	if req.Backups {
+		backups := findStaticBackups() // 已按时间倒序
+		keep := req.KeepLatestBackups
+		if keep < 0 {
+			keep = 0
+		}
+		if keep < len(backups) {
+			candidates = append(candidates, backups[keep:]...)
+		}
+	}
+	if req.TempFiles {
+		candidates = append(candidates, findStaleThemeTempFiles()...)
+	}
+
+	for _, entry := range candidates {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if !req.DryRun {
+			if err := os.RemoveAll(entry.Path); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("删除 %s 失败: %v", entry.Path, err))
+				continue
+			}
+		}
+		result.Removed = append(result.Removed, entry)
+		result.FreedBytes += entry.SizeBytes
+	}
+
+	if req.DryRun {
+		log.Printf("[主题存储清理] 预演：将删除 %d 项，可释放 %d 字节", len(result.Removed), result.FreedBytes)
+	} else {
+		log.Printf("[主题存储清理] 用户 %d 删除了 %d 项，释放 %d 字节", userID, len(result.Removed), result.FreedBytes)
+	}
+	return result, nil
+}
+
+// findStaticBackups 查找 backup/static_backup_* 和更新主题留下的 backup/theme_backup_* 目录，按修改时间倒序
+// 主题切换和更新成功后会删除备份，因此这里存在的备份均为失败或中断后的遗留
+func findStaticBackups() []ThemeStorageEntry {
+	var backups []ThemeStorageEntry
+	entries, err := os.ReadDir(BackupDirName)
+	if err != nil {
+		return backups
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), "static_backup_") && !strings.HasPrefix(entry.Name(), "theme_backup_") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		path := filepath.Join(BackupDirName, entry.Name())
+		size, _ := dirUsage(path)
+		backups = append(backups, ThemeStorageEntry{
+			Path:      path,
+			SizeBytes: size,
+			ModTime:   info.ModTime(),
+			Kind:      "backup",
+		})
+	}
+	sort.Slice(backups, func(i, j int) bool { return backups[i].ModTime.After(backups[j].ModTime) })
+	return backups
+}
+
+// findStaleThemeTempFiles 查找系统临时目录中残留的主题压缩包和解压目录
+func findStaleThemeTempFiles() []ThemeStorageEntry {
+	var files []ThemeStorageEntry
+	tempDir := os.TempDir()
+	entries, err := os.ReadDir(tempDir)
+	if err != nil {
+		return files
+	}
+
+	cutoff := time.Now().Add(-staleTempFileAge)
+	for _, entry := range entries {
+		if !hasThemeTempPrefix(entry.Name()) {
+			continue
+		}
+		// 压缩包必须是 .zip 文件，解压目录必须是 theme_install_ 前缀的目录
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), "theme_install_") {
+			continue
+		}
+		if !entry.IsDir() && !strings.HasSuffix(entry.Name(), ".zip") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+
+		path := filepath.Join(tempDir, entry.Name())
+		size := info.Size()
+		if entry.IsDir() {
+			size, _ = dirUsage(path)
+		}
+		files = append(files, ThemeStorageEntry{
+			Path:      path,
+			SizeBytes: size,
+			ModTime:   info.ModTime(),
+			Kind:      "temp",
+		})
+	}
+	return files
+}
+
+func hasThemeTempPrefix(name string) bool {
+	for _, prefix := range themeTempFilePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// dirUsage 统计目录的总大小和文件数量
+func dirUsage(root string) (int64, int) {
+	var size int64
+	var count int
+	_ = filepath.Walk(root, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if !info.IsDir() {
+			size += info.Size()
+			count++
+		}
+		return nil
+	})
+	return size, count
+}
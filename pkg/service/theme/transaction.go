@@ -205,12 +205,12 @@ func (s *themeService) ThemeInstallWithTransaction(ctx context.Context, userID u
 		}
 
 		if exists {
-			return fmt.Errorf("主题 %s 已经安装", req.ThemeName)
+			return fmt.Errorf("%w: %s", ErrThemeAlreadyInstalled, req.ThemeName)
 		}
 
 		// 下载并解压主题文件
 		tempDir := filepath.Join(os.TempDir(), "theme_install_"+req.ThemeName)
-		if err := s.downloadAndExtractTheme(req.DownloadURL, tempDir); err != nil {
+		if err := s.downloadAndExtractTheme(ctx, req.DownloadURL, req.Checksum, tempDir); err != nil {
 			return fmt.Errorf("下载主题失败: %w", err)
 		}
 
@@ -256,6 +256,13 @@ func (s *themeService) ThemeInstallWithTransaction(ctx context.Context, userID u
 			return fmt.Errorf("准备文件操作失败: %w", err)
 		}
 
+		// 复制前检查磁盘空间和存储配额
+		size, _ := dirUsage(tempDir)
+		if err := ensureSpaceFor(themeDir, size); err != nil {
+			os.RemoveAll(tempDir) // 清理临时文件
+			return err
+		}
+
 		// 执行文件操作
 		if err := fm.Execute(); err != nil {
 			os.RemoveAll(tempDir) // 清理临时文件
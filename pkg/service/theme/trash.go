@@ -0,0 +1,203 @@
+package theme
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/schema/mixin"
+	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
+)
+
+const (
+	// themeTrashDir 卸载的主题移入 themes/.trash/<id>/，files 为主题文件，entry.json 记录安装信息
+	themeTrashDir       = ".trash"
+	themeTrashFilesDir  = "files"
+	themeTrashEntryFile = "entry.json"
+	themeTrashManifest  = "manifest.json"
+)
+
+// ErrTrashedThemeNotFound 回收站中不存在指定的主题
+var ErrTrashedThemeNotFound = errors.New("回收站中不存在该主题")
+
+// TrashedTheme 回收站中的主题，保存卸载前的安装记录以便原样恢复
+type TrashedTheme struct {
+	ID               string                 `json:"id"`
+	ThemeName        string                 `json:"theme_name"`
+	UserID           uint                   `json:"user_id"`
+	ThemeMarketID    int                    `json:"theme_market_id,omitempty"`
+	InstalledVersion string                 `json:"installed_version,omitempty"`
+	DeployType       string                 `json:"deploy_type"`
+	InstallTime      time.Time              `json:"install_time"`
+	UserThemeConfig  map[string]interface{} `json:"user_theme_config,omitempty"`
+	DeletedAt        time.Time              `json:"deleted_at"`
+	Size             int64                  `json:"size"`
+}
+
+func themeTrashPath(elem ...string) string {
+	return filepath.Join(append([]string{ThemesDirName, themeTrashDir}, elem...)...)
+}
+
+// moveThemeToTrash 将主题文件和文件清单移入回收站
+func moveThemeToTrash(theme *ent.UserInstalledTheme) error {
+	now := time.Now()
+	entry := &TrashedTheme{
+		ID:               fmt.Sprintf("%s-%d", theme.ThemeName, now.UnixNano()),
+		ThemeName:        theme.ThemeName,
+		UserID:           theme.UserID,
+		ThemeMarketID:    theme.ThemeMarketID,
+		InstalledVersion: theme.InstalledVersion,
+		DeployType:       string(theme.DeployType),
+		InstallTime:      theme.InstallTime,
+		UserThemeConfig:  theme.UserThemeConfig,
+		DeletedAt:        now,
+	}
+	dir := themeTrashPath(entry.ID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("创建回收站目录失败: %w", err)
+	}
+	themeDir := filepath.Join(ThemesDirName, theme.ThemeName)
+	if err := os.Rename(themeDir, filepath.Join(dir, themeTrashFilesDir)); err != nil && !os.IsNotExist(err) {
+		os.RemoveAll(dir)
+		return fmt.Errorf("移动主题文件失败: %w", err)
+	}
+	if err := os.Rename(themeManifestPath(theme.ThemeName), filepath.Join(dir, themeTrashManifest)); err != nil && !os.IsNotExist(err) {
+		log.Printf("警告：移动主题 %s 文件清单失败: %v", theme.ThemeName, err)
+	}
+	entry.Size, _ = dirUsage(filepath.Join(dir, themeTrashFilesDir))
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, themeTrashEntryFile), data, 0644)
+}
+
+// ListTrashedThemes 获取回收站中的主题，按卸载时间从新到旧排列
+func (s *themeService) ListTrashedThemes() ([]*TrashedTheme, error) {
+	entries, err := os.ReadDir(themeTrashPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*TrashedTheme{}, nil
+		}
+		return nil, fmt.Errorf("读取主题回收站失败: %w", err)
+	}
+	result := make([]*TrashedTheme, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		entry, err := loadTrashedTheme(e.Name())
+		if err != nil {
+			log.Printf("警告：读取回收站主题 %s 失败: %v", e.Name(), err)
+			continue
+		}
+		result = append(result, entry)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].DeletedAt.After(result[j].DeletedAt) })
+	return result, nil
+}
+
+// RestoreTrashedTheme 从回收站恢复主题文件和安装记录，恢复后不会自动启用
+func (s *themeService) RestoreTrashedTheme(ctx context.Context, id string) error {
+	entry, err := loadTrashedTheme(id)
+	if err != nil {
+		return err
+	}
+	themeDir := filepath.Join(ThemesDirName, entry.ThemeName)
+	if _, err := os.Stat(themeDir); err == nil {
+		return fmt.Errorf("%w: %s，请先卸载后再恢复", ErrThemeAlreadyInstalled, entry.ThemeName)
+	}
+	exists, err := s.db.UserInstalledTheme.Query().
+		Where(
+			userinstalledtheme.UserID(entry.UserID),
+			userinstalledtheme.ThemeName(entry.ThemeName),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("检查主题是否存在失败: %w", err)
+	}
+	if exists {
+		return fmt.Errorf("%w: %s，请先卸载后再恢复", ErrThemeAlreadyInstalled, entry.ThemeName)
+	}
+
+	dir := themeTrashPath(entry.ID)
+	if err := os.Rename(filepath.Join(dir, themeTrashFilesDir), themeDir); err != nil {
+		return fmt.Errorf("恢复主题文件失败: %w", err)
+	}
+	createBuilder := s.db.UserInstalledTheme.
+		Create().
+		SetUserID(entry.UserID).
+		SetThemeName(entry.ThemeName).
+		SetInstallTime(entry.InstallTime).
+		SetDeployType(userinstalledtheme.DeployType(entry.DeployType))
+	if entry.ThemeMarketID > 0 {
+		createBuilder = createBuilder.SetThemeMarketID(entry.ThemeMarketID)
+	}
+	if entry.InstalledVersion != "" {
+		createBuilder = createBuilder.SetInstalledVersion(entry.InstalledVersion)
+	}
+	if entry.UserThemeConfig != nil {
+		createBuilder = createBuilder.SetUserThemeConfig(entry.UserThemeConfig)
+	}
+	if _, err := createBuilder.Save(ctx); err != nil {
+		// 记录保存失败时把文件放回回收站
+		if mvErr := os.Rename(themeDir, filepath.Join(dir, themeTrashFilesDir)); mvErr != nil {
+			log.Printf("警告：主题 %s 文件放回回收站失败: %v", entry.ThemeName, mvErr)
+		}
+		return fmt.Errorf("保存主题信息失败: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(themeManifestPath(entry.ThemeName)), 0755); err == nil {
+		if err := os.Rename(filepath.Join(dir, themeTrashManifest), themeManifestPath(entry.ThemeName)); err != nil && !os.IsNotExist(err) {
+			log.Printf("警告：恢复主题 %s 文件清单失败: %v", entry.ThemeName, err)
+		}
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		log.Printf("警告：清理回收站目录 %s 失败: %v", dir, err)
+	}
+	log.Printf("主题 %s 已从回收站恢复", entry.ThemeName)
+	return nil
+}
+
+// PurgeTrashedTheme 彻底删除回收站中的主题文件
+func (s *themeService) PurgeTrashedTheme(id string) error {
+	if _, err := loadTrashedTheme(id); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(themeTrashPath(id)); err != nil {
+		return fmt.Errorf("删除回收站主题失败: %w", err)
+	}
+	return nil
+}
+
+// deleteInstalledThemeRecord 卸载时删除安装记录，安装信息已保存在回收站中，
+// 跳过软删除以免占用 (user_id, theme_name) 唯一索引导致无法重新安装
+func (s *themeService) deleteInstalledThemeRecord(ctx context.Context, id uint) error {
+	return s.db.UserInstalledTheme.DeleteOneID(id).Exec(mixin.SkipSoftDelete(ctx))
+}
+
+func loadTrashedTheme(id string) (*TrashedTheme, error) {
+	if id == "" || id != filepath.Base(id) || id == "." || id == ".." {
+		return nil, ErrTrashedThemeNotFound
+	}
+	data, err := os.ReadFile(themeTrashPath(id, themeTrashEntryFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrTrashedThemeNotFound
+		}
+		return nil, err
+	}
+	var entry TrashedTheme
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("回收站主题信息损坏: %w", err)
+	}
+	entry.ID = id
+	return &entry, nil
+}
@@ -0,0 +1,276 @@
+/*
+ * @Description: 从主题商城检查并更新已安装的主题
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 更新流程：下载新版本到 themes/ 下的临时目录并校验，再将旧版本目录移入 backup/，把新版本移到原位置。
+ * 更新的是当前使用的主题时同步刷新 static 目录并执行切换后的页面检查。
+ * 任一步骤失败都会恢复旧版本目录、static 目录和数据库中的版本号，成功后删除备份。
+ * 更新复用安装的进度推送和取消机制，进入替换阶段后不能再取消。
+ */
+package theme
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/adminevent"
+)
+
+var (
+	// ErrThemeUpToDate 已安装版本不低于主题商城中的最新版本
+	ErrThemeUpToDate = errors.New("已是最新版本")
+	// ErrThemeUpdateUnavailable 主题商城中没有该主题或没有提供下载地址，无法在线更新
+	ErrThemeUpdateUnavailable = errors.New("无法从主题商城更新")
+)
+
+// ThemeUpdateResult 主题更新结果
+type ThemeUpdateResult struct {
+	ThemeName   string `json:"theme_name"`
+	FromVersion string `json:"from_version"`
+	ToVersion   string `json:"to_version"`
+	IsCurrent   bool   `json:"is_current"` // 是否为当前使用的主题，为 true 时 static 目录已同步更新
+}
+
+// CheckThemeUpdates 检查全部已安装的普通主题是否有更新，SSR 主题不在检查范围内
+func (s *themeService) CheckThemeUpdates(ctx context.Context, userID uint) (*ThemeBulkResult, error) {
+	names, err := s.db.UserInstalledTheme.
+		Query().
+		Where(
+			userinstalledtheme.UserID(userID),
+			userinstalledtheme.DeployTypeNEQ(userinstalledtheme.DeployTypeSsr),
+		).
+		Order(ent.Asc(userinstalledtheme.FieldThemeName)).
+		Select(userinstalledtheme.FieldThemeName).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询已安装主题失败: %w", err)
+	}
+	if len(names) == 0 {
+		return &ThemeBulkResult{Items: []*ThemeBulkItemResult{}}, nil
+	}
+	return s.checkThemeUpdates(ctx, userID, names)
+}
+
+// UpdateTheme 将已安装的普通主题更新到主题商城中的最新版本，失败时回滚到旧版本
+func (s *themeService) UpdateTheme(ctx context.Context, userID uint, themeName string) (_ *ThemeUpdateResult, err error) {
+	if err := validateThemeName(themeName); err != nil {
+		return nil, err
+	}
+	ctx, task, err := beginInstall(ctx, themeName)
+	if err != nil {
+		return nil, err
+	}
+	defer task.finish(&err)
+	ctx, cancel := withOperationTimeout(ctx, currentOperationTimeouts().Install)
+	defer cancel()
+	defer wrapTimeout(ctx, "更新主题 "+themeName, &err)
+
+	// 1. 检查主题是否已安装，并与主题商城中的版本比较
+	installed, err := s.db.UserInstalledTheme.
+		Query().
+		Where(
+			userinstalledtheme.UserID(userID),
+			userinstalledtheme.ThemeName(themeName),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrThemeNotInstalled, themeName)
+		}
+		return nil, fmt.Errorf("查询主题失败: %w", err)
+	}
+	if installed.DeployType == userinstalledtheme.DeployTypeSsr {
+		return nil, fmt.Errorf("SSR 主题请在 SSR 主题管理中更新")
+	}
+	fromVersion := s.installedThemeVersion(installed)
+
+	marketThemes, err := s.GetThemeMarketList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取主题商城数据失败: %w", err)
+	}
+	var marketTheme *MarketTheme
+	for _, t := range marketThemes {
+		if t.Name == themeName {
+			marketTheme = t
+			break
+		}
+	}
+	if marketTheme == nil {
+		return nil, fmt.Errorf("主题 %s %w：主题商城中没有该主题", themeName, ErrThemeUpdateUnavailable)
+	}
+	if !version.Newer(marketTheme.Version, fromVersion) {
+		return nil, fmt.Errorf("主题 %s %w（%s）", themeName, ErrThemeUpToDate, fromVersion)
+	}
+
+	req := &ThemeInstallRequest{
+		MarketID:    marketTheme.ID,
+		ThemeName:   themeName,
+		DownloadURL: marketTheme.DownloadURL,
+		Version:     marketTheme.Version,
+		Checksum:    marketTheme.Checksum,
+		Source:      marketTheme.Source,
+	}
+	if err := resolveMarketDownload(ctx, req); err != nil {
+		return nil, err
+	}
+	if req.DownloadURL == "" {
+		return nil, fmt.Errorf("主题 %s %w：主题商城未提供下载地址", themeName, ErrThemeUpdateUnavailable)
+	}
+	log.Printf("开始更新主题 %s: %s -> %s", themeName, fromVersion, req.Version)
+
+	// 2. 下载新版本到临时目录并校验，旧版本在此之前不受影响
+	now := time.Now()
+	stagingDir := filepath.Join(ThemesDirName, fmt.Sprintf(".update_%s_%d", themeName, now.Unix()))
+	defer os.RemoveAll(stagingDir)
+	if err := s.downloadAndExtractTheme(ctx, req.DownloadURL, req.Checksum, stagingDir); err != nil {
+		return nil, fmt.Errorf("下载主题失败: %w", err)
+	}
+	task.setStage(adminevent.StageValidating)
+	if err := s.validateThemeFiles(stagingDir); err != nil {
+		return nil, fmt.Errorf("主题文件验证失败: %w", err)
+	}
+	if err := checkUpdatePackageName(stagingDir, themeName); err != nil {
+		return nil, err
+	}
+
+	// 3. 替换主题目录，此后不能再取消，也不受超时影响
+	if err := task.beginCommit(ctx); err != nil {
+		return nil, err
+	}
+	ctx = context.WithoutCancel(ctx)
+
+	themeDir := filepath.Join(ThemesDirName, themeName)
+	backupDir := filepath.Join(BackupDirName, fmt.Sprintf("theme_backup_%s_%d", themeName, now.Unix()))
+	if err := os.MkdirAll(BackupDirName, 0755); err != nil {
+		return nil, fmt.Errorf("创建备份目录失败: %w", err)
+	}
+	if err := os.Rename(themeDir, backupDir); err != nil {
+		return nil, fmt.Errorf("备份旧版本失败: %w", err)
+	}
+	if err := os.Rename(stagingDir, themeDir); err != nil {
+		if rerr := os.Rename(backupDir, themeDir); rerr != nil {
+			log.Printf("严重：更新主题 %s 失败后恢复旧版本失败，旧版本保留在 %s: %v", themeName, backupDir, rerr)
+		}
+		return nil, fmt.Errorf("替换主题目录失败: %w", err)
+	}
+
+	isCurrent := installed.IsCurrent && s.IsStaticModeActive()
+	staticBackup := ""
+	staticChanged := false
+	versionSaved := false
+	rollback := func(cause error) {
+		log.Printf("更新主题 %s 失败，回滚到 %s: %v", themeName, fromVersion, cause)
+		if versionSaved {
+			if _, err := installed.Update().SetInstalledVersion(installed.InstalledVersion).Save(ctx); err != nil {
+				log.Printf("回滚主题 %s 的版本记录失败: %v", themeName, err)
+			}
+		}
+		if err := os.RemoveAll(themeDir); err != nil {
+			log.Printf("回滚时删除新版本目录失败: %v", err)
+		}
+		if err := os.Rename(backupDir, themeDir); err != nil {
+			log.Printf("严重：回滚主题 %s 失败，旧版本保留在 %s: %v", themeName, backupDir, err)
+		}
+		if staticChanged {
+			if err := s.restoreFromBackup(staticBackup, StaticDirName); err != nil {
+				log.Printf("回滚时恢复 static 目录失败，备份保留在 %s: %v", staticBackup, err)
+				return
+			}
+			s.publishThemeSwitched(themeName)
+		}
+		if staticBackup != "" {
+			os.RemoveAll(staticBackup)
+		}
+	}
+
+	// 4. 更新数据库中的版本号
+	update := installed.Update().SetInstalledVersion(req.Version)
+	if req.MarketID > 0 {
+		update = update.SetThemeMarketID(req.MarketID)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		rollback(err)
+		return nil, fmt.Errorf("更新主题信息失败: %w", err)
+	}
+	versionSaved = true
+
+	// 5. 当前使用的主题需要同步 static 目录，并检查首页和文章页
+	if isCurrent {
+		staticBackup = filepath.Join(BackupDirName, fmt.Sprintf("static_backup_%d", now.Unix()))
+		if err := s.backupDirectory(ctx, StaticDirName, staticBackup); err != nil {
+			os.RemoveAll(staticBackup)
+			staticBackup = ""
+			rollback(err)
+			return nil, fmt.Errorf("备份静态文件失败: %w", err)
+		}
+		staticChanged = true
+		if err := s.copyThemeToStatic(ctx, themeDir); err != nil {
+			rollback(err)
+			return nil, fmt.Errorf("复制主题文件失败: %w", err)
+		}
+		s.publishThemeSwitched(themeName)
+
+		if err := s.runCanary(ctx); err != nil {
+			rollback(err)
+			return nil, fmt.Errorf("主题 %s %w: %v", themeName, ErrCanaryFailed, err)
+		}
+		os.RemoveAll(staticBackup)
+	}
+
+	// 6. 清理备份并记录新的文件清单
+	if err := os.RemoveAll(backupDir); err != nil {
+		log.Printf("警告：删除主题 %s 的旧版本备份失败: %v", themeName, err)
+	}
+	if err := s.recordThemeManifest(ctx, themeName); err != nil {
+		log.Printf("警告：记录主题 %s 文件清单失败: %v", themeName, err)
+	}
+	reportMarketDownload(ctx, req)
+
+	log.Printf("主题 %s 更新成功: %s -> %s", themeName, fromVersion, req.Version)
+	return &ThemeUpdateResult{
+		ThemeName:   themeName,
+		FromVersion: fromVersion,
+		ToVersion:   req.Version,
+		IsCurrent:   isCurrent,
+	}, nil
+}
+
+// installedThemeVersion 返回已安装版本，本地上传的主题没有记录版本时使用 theme.json 中的版本
+func (s *themeService) installedThemeVersion(installed *ent.UserInstalledTheme) string {
+	if installed.InstalledVersion != "" {
+		return installed.InstalledVersion
+	}
+	if metadata, err := s.loadThemeMetadataFromDisk(installed.ThemeName); err == nil {
+		return metadata.Version
+	}
+	return ""
+}
+
+// checkUpdatePackageName 新版本包含 theme.json 时，其中的主题名称必须与要更新的主题一致
+func checkUpdatePackageName(dir, themeName string) error {
+	content, err := os.ReadFile(filepath.Join(dir, "theme.json"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("读取 theme.json 失败: %w", err)
+	}
+	var metadata ThemeMetadata
+	if err := json.Unmarshal(content, &metadata); err != nil {
+		return fmt.Errorf("解析 theme.json 失败: %w", err)
+	}
+	if metadata.Name != "" && metadata.Name != themeName {
+		return fmt.Errorf("新版本的主题名称 %s 与已安装的主题 %s 不一致", metadata.Name, themeName)
+	}
+	return nil
+}
@@ -0,0 +1,413 @@
+/*
+ * @Description: 主题菜单位置和小工具区域的分配
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 主题在 theme.json 中声明菜单位置和小工具区域，管理员为菜单位置指定已有的菜单（顶部菜单或导航菜单），
+ * 并向小工具区域添加最新文章、标签云、自定义 HTML 等小工具。分配按主题保存在 data/theme_layouts.json，
+ * 切换主题后各主题的分配互不影响。解析后的结构随公开主题配置返回，供 SSR 主题直接渲染。
+ */
+package themelayout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/taxonomy"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
+)
+
+// DefaultStorePath 布局分配的保存位置
+const DefaultStorePath = "data/theme_layouts.json"
+
+// 菜单来源，对应已有的菜单配置
+const (
+	MenuSourceHeader = "header_menu" // 顶部菜单 header.menu
+	MenuSourceNav    = "nav_menu"    // 导航栏下拉菜单 header.nav.menu
+)
+
+// 小工具类型
+const (
+	WidgetRecentPosts = "recent_posts" // 最新文章
+	WidgetTagCloud    = "tag_cloud"    // 标签云
+	WidgetCustomHTML  = "custom_html"  // 自定义 HTML
+)
+
+const (
+	// defaultWidgetLimit 最新文章和标签云默认展示的数量
+	defaultWidgetLimit = 5
+	// maxWidgetLimit 最新文章和标签云最多展示的数量
+	maxWidgetLimit = 50
+	// adminUserID 公开接口使用的默认管理员，与公开主题配置一致
+	adminUserID = 1
+)
+
+var (
+	ErrUnknownMenuLocation = errors.New("主题没有声明该菜单位置")
+	ErrUnknownWidgetArea   = errors.New("主题没有声明该小工具区域")
+	ErrInvalidMenuSource   = errors.New("菜单只能是 header_menu 或 nav_menu")
+	ErrInvalidWidgetType   = errors.New("小工具类型只能是 recent_posts、tag_cloud 或 custom_html")
+	ErrInvalidWidgetLimit  = fmt.Errorf("小工具展示数量必须在 1~%d 之间", maxWidgetLimit)
+	ErrInvalidWidgetID     = errors.New("小工具 ID 只能包含字母、数字、下划线和短横线，且不能重复")
+	ErrTooManyWidgets      = errors.New("小工具数量超过区域允许的上限")
+)
+
+// widgetIDRegex 小工具 ID，主题可以用它作为 DOM id 或缓存键
+var widgetIDRegex = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// Widget 小工具实例
+type Widget struct {
+	ID       string `json:"id"`                 // 区域内唯一，为空时自动生成
+	Type     string `json:"type"`               // recent_posts、tag_cloud 或 custom_html
+	Title    string `json:"title,omitempty"`    // 标题
+	Limit    int    `json:"limit,omitempty"`    // 最新文章、标签云展示的数量
+	Category string `json:"category,omitempty"` // 最新文章只取该分类
+	HTML     string `json:"html,omitempty"`     // 自定义 HTML 的内容
+}
+
+// Layout 一个主题的菜单和小工具分配
+type Layout struct {
+	Menus   map[string]string   `json:"menus"`   // 菜单位置 -> 菜单来源
+	Widgets map[string][]Widget `json:"widgets"` // 小工具区域 -> 小工具
+}
+
+// LayoutDetail 后台编辑布局时需要的主题声明和当前分配
+type LayoutDetail struct {
+	ThemeName string `json:"theme_name"`
+	*theme.ThemeLayoutAreas
+	Layout *Layout `json:"layout"`
+}
+
+// ResolvedWidget 解析后的小工具，Data 为最新文章列表、标签云或 HTML 字符串
+type ResolvedWidget struct {
+	ID    string      `json:"id"`
+	Type  string      `json:"type"`
+	Title string      `json:"title"`
+	Data  interface{} `json:"data"`
+}
+
+// ResolvedLayout 公开主题配置中返回的布局结构
+type ResolvedLayout struct {
+	Menus   map[string]interface{}      `json:"menus"`
+	Widgets map[string][]ResolvedWidget `json:"widgets"`
+}
+
+// Service 主题布局服务
+type Service struct {
+	mu         sync.RWMutex
+	layouts    map[string]*Layout
+	storePath  string
+	themeSvc   theme.ThemeService
+	settingSvc setting.SettingService
+	articleSvc article_service.Service
+	taxonomy   *taxonomy.Service
+}
+
+// NewService 创建主题布局服务
+func NewService(storePath string, themeSvc theme.ThemeService, settingSvc setting.SettingService, articleSvc article_service.Service, taxonomySvc *taxonomy.Service) *Service {
+	s := &Service{
+		layouts:    map[string]*Layout{},
+		storePath:  storePath,
+		themeSvc:   themeSvc,
+		settingSvc: settingSvc,
+		articleSvc: articleSvc,
+		taxonomy:   taxonomySvc,
+	}
+	s.load()
+	return s
+}
+
+// themeNameOrCurrent 未指定主题时使用当前主题
+func (s *Service) themeNameOrCurrent(ctx context.Context, themeName string) (string, error) {
+	if themeName = strings.TrimSpace(themeName); themeName != "" {
+		return themeName, nil
+	}
+	name, err := s.themeSvc.GetCurrentThemeName(ctx, adminUserID)
+	if err != nil {
+		return "", fmt.Errorf("获取当前主题失败: %w", err)
+	}
+	return name, nil
+}
+
+// Get 返回主题声明的区域和当前分配，themeName 为空时使用当前主题
+func (s *Service) Get(ctx context.Context, themeName string) (*LayoutDetail, error) {
+	themeName, err := s.themeNameOrCurrent(ctx, themeName)
+	if err != nil {
+		return nil, err
+	}
+	areas, err := s.themeSvc.GetThemeLayoutAreas(ctx, themeName)
+	if err != nil {
+		return nil, err
+	}
+	return &LayoutDetail{ThemeName: themeName, ThemeLayoutAreas: areas, Layout: s.layoutOf(themeName)}, nil
+}
+
+// Set 校验并保存主题的布局分配，themeName 为空时使用当前主题
+func (s *Service) Set(ctx context.Context, themeName string, layout *Layout) (*LayoutDetail, error) {
+	themeName, err := s.themeNameOrCurrent(ctx, themeName)
+	if err != nil {
+		return nil, err
+	}
+	areas, err := s.themeSvc.GetThemeLayoutAreas(ctx, themeName)
+	if err != nil {
+		return nil, err
+	}
+	normalized, err := normalize(areas, layout)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.layouts[themeName] = normalized
+	err = s.save()
+	s.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("保存主题布局失败: %w", err)
+	}
+	return &LayoutDetail{ThemeName: themeName, ThemeLayoutAreas: areas, Layout: normalized}, nil
+}
+
+// layoutOf 返回主题布局分配的副本，没有分配时返回空布局
+func (s *Service) layoutOf(themeName string) *Layout {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := &Layout{Menus: map[string]string{}, Widgets: map[string][]Widget{}}
+	if layout, ok := s.layouts[themeName]; ok {
+		for k, v := range layout.Menus {
+			result.Menus[k] = v
+		}
+		for k, v := range layout.Widgets {
+			result.Widgets[k] = append([]Widget(nil), v...)
+		}
+	}
+	return result
+}
+
+// normalize 按主题声明校验布局分配，补全小工具的默认值和 ID
+func normalize(areas *theme.ThemeLayoutAreas, layout *Layout) (*Layout, error) {
+	result := &Layout{Menus: map[string]string{}, Widgets: map[string][]Widget{}}
+	if layout == nil {
+		return result, nil
+	}
+
+	locations := make(map[string]bool, len(areas.MenuLocations))
+	for _, loc := range areas.MenuLocations {
+		locations[loc.Name] = true
+	}
+	for loc, source := range layout.Menus {
+		if !locations[loc] {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownMenuLocation, loc)
+		}
+		switch source {
+		case "":
+			continue
+		case MenuSourceHeader, MenuSourceNav:
+			result.Menus[loc] = source
+		default:
+			return nil, ErrInvalidMenuSource
+		}
+	}
+
+	widgetAreas := make(map[string]theme.ThemeWidgetArea, len(areas.WidgetAreas))
+	for _, area := range areas.WidgetAreas {
+		widgetAreas[area.Name] = area
+	}
+	for name, widgets := range layout.Widgets {
+		area, ok := widgetAreas[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownWidgetArea, name)
+		}
+		if area.MaxWidgets > 0 && len(widgets) > area.MaxWidgets {
+			return nil, fmt.Errorf("%w: %s 最多 %d 个", ErrTooManyWidgets, name, area.MaxWidgets)
+		}
+		if len(widgets) == 0 {
+			continue
+		}
+		normalized, err := normalizeWidgets(widgets)
+		if err != nil {
+			return nil, err
+		}
+		result.Widgets[name] = normalized
+	}
+	return result, nil
+}
+
+func normalizeWidgets(widgets []Widget) ([]Widget, error) {
+	result := make([]Widget, 0, len(widgets))
+	ids := make(map[string]bool, len(widgets))
+	for _, w := range widgets {
+		if w.ID != "" {
+			if !widgetIDRegex.MatchString(w.ID) || ids[w.ID] {
+				return nil, ErrInvalidWidgetID
+			}
+			ids[w.ID] = true
+		}
+	}
+	for i, w := range widgets {
+		w.Title = strings.TrimSpace(w.Title)
+		switch w.Type {
+		case WidgetRecentPosts, WidgetTagCloud:
+			if w.Limit == 0 {
+				w.Limit = defaultWidgetLimit
+			}
+			if w.Limit < 1 || w.Limit > maxWidgetLimit {
+				return nil, ErrInvalidWidgetLimit
+			}
+			w.HTML = ""
+			w.Category = strings.TrimSpace(w.Category)
+			if w.Type == WidgetTagCloud {
+				w.Category = ""
+			}
+		case WidgetCustomHTML:
+			w.Limit, w.Category = 0, ""
+		default:
+			return nil, ErrInvalidWidgetType
+		}
+		if w.ID == "" {
+			// 自动生成的 ID 由类型和序号组成，避开已使用的 ID
+			for n := i + 1; ; n++ {
+				id := fmt.Sprintf("%s-%d", w.Type, n)
+				if !ids[id] {
+					w.ID = id
+					break
+				}
+			}
+			ids[w.ID] = true
+		}
+		result = append(result, w)
+	}
+	return result, nil
+}
+
+// ResolveCurrent 解析当前主题的布局，主题没有声明任何菜单位置和小工具区域时返回 nil。
+// 单个菜单或小工具解析失败时记录日志并返回 null，不影响其他位置
+func (s *Service) ResolveCurrent(ctx context.Context) *ResolvedLayout {
+	themeName, err := s.themeSvc.GetCurrentThemeName(ctx, adminUserID)
+	if err != nil {
+		return nil
+	}
+	areas, err := s.themeSvc.GetThemeLayoutAreas(ctx, themeName)
+	if err != nil || (len(areas.MenuLocations) == 0 && len(areas.WidgetAreas) == 0) {
+		return nil
+	}
+	layout := s.layoutOf(themeName)
+
+	resolved := &ResolvedLayout{Menus: map[string]interface{}{}, Widgets: map[string][]ResolvedWidget{}}
+	for _, loc := range areas.MenuLocations {
+		resolved.Menus[loc.Name] = s.resolveMenu(layout.Menus[loc.Name])
+	}
+	for _, area := range areas.WidgetAreas {
+		widgets := make([]ResolvedWidget, 0, len(layout.Widgets[area.Name]))
+		for _, w := range layout.Widgets[area.Name] {
+			data, err := s.resolveWidget(ctx, w)
+			if err != nil {
+				log.Printf("[主题布局] 小工具 %s/%s 解析失败: %v", area.Name, w.ID, err)
+			}
+			widgets = append(widgets, ResolvedWidget{ID: w.ID, Type: w.Type, Title: w.Title, Data: data})
+		}
+		resolved.Widgets[area.Name] = widgets
+	}
+	return resolved
+}
+
+// resolveMenu 读取菜单来源对应的菜单配置，未分配时返回 nil
+func (s *Service) resolveMenu(source string) interface{} {
+	var key constant.SettingKey
+	switch source {
+	case MenuSourceHeader:
+		key = constant.KeyHeaderMenu
+	case MenuSourceNav:
+		key = constant.KeyHeaderNavMenu
+	default:
+		return nil
+	}
+	var menu interface{}
+	if err := json.Unmarshal([]byte(s.settingSvc.Get(key.String())), &menu); err != nil {
+		log.Printf("[主题布局] 解析菜单 %s 失败: %v", source, err)
+		return nil
+	}
+	return menu
+}
+
+func (s *Service) resolveWidget(ctx context.Context, w Widget) (interface{}, error) {
+	switch w.Type {
+	case WidgetRecentPosts:
+		list, err := s.articleSvc.ListPublic(ctx, &model.ListPublicArticlesOptions{
+			Page:         1,
+			PageSize:     w.Limit,
+			CategoryName: w.Category,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return list.List, nil
+	case WidgetTagCloud:
+		tags, err := s.taxonomy.TagCloud(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return topTags(tags, w.Limit), nil
+	case WidgetCustomHTML:
+		return w.HTML, nil
+	}
+	return nil, ErrInvalidWidgetType
+}
+
+// topTags 保留文章数最多的 limit 个标签，仍按名称排序
+func topTags(tags []taxonomy.TagCloudItem, limit int) []taxonomy.TagCloudItem {
+	if len(tags) <= limit {
+		return tags
+	}
+	sorted := append([]taxonomy.TagCloudItem(nil), tags...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Count > sorted[j].Count })
+	keep := make(map[string]bool, limit)
+	for _, t := range sorted[:limit] {
+		keep[t.ID] = true
+	}
+	result := make([]taxonomy.TagCloudItem, 0, limit)
+	for _, t := range tags {
+		if keep[t.ID] {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func (s *Service) load() {
+	data, err := os.ReadFile(s.storePath)
+	if err != nil {
+		return
+	}
+	if err := json.Unmarshal(data, &s.layouts); err != nil {
+		log.Printf("[主题布局] 读取主题布局失败: %v", err)
+		s.layouts = map[string]*Layout{}
+	}
+}
+
+func (s *Service) save() error {
+	data, err := json.MarshalIndent(s.layouts, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.storePath), 0755); err != nil {
+		return err
+	}
+	tmp := s.storePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.storePath)
+}
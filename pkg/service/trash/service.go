@@ -0,0 +1,229 @@
+/*
+ * @Description: 回收站服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 删除文章、自定义页面和卸载主题时不再立即清除数据：文章和页面只设置删除时间，
+ * 卸载的主题文件移入 themes/.trash，媒体库清理出的未使用文件只设置删除时间。回收站汇总这四类内容，支持恢复和彻底删除，
+ * 超过 trash.retention_days 天的内容由定时任务彻底删除，设置为 0 时不自动清理。
+ */
+package trash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/media"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/page"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
+)
+
+// 回收站中的内容类型
+const (
+	TypeArticle = "article"
+	TypePage    = "page"
+	TypeTheme   = "theme"
+	TypeMedia   = "media"
+)
+
+// defaultRetentionDays 未配置保留天数时的默认值
+const defaultRetentionDays = 30
+
+// ErrUnknownType 未知的回收站内容类型
+var ErrUnknownType = errors.New("未知的回收站内容类型")
+
+// Item 回收站中的一项内容
+type Item struct {
+	Type      string     `json:"type"`
+	ID        string     `json:"id"`
+	Title     string     `json:"title"`
+	Extra     string     `json:"extra,omitempty"` // 文章的永久链接、页面路径或主题版本
+	DeletedAt time.Time  `json:"deleted_at"`
+	PurgeAt   *time.Time `json:"purge_at,omitempty"` // 预计彻底删除的时间，不自动清理时为空
+	Size      int64      `json:"size,omitempty"`     // 主题或媒体文件大小（字节）
+}
+
+// Service 回收站服务
+type Service struct {
+	settingSvc setting.SettingService
+	articleSvc article.Service
+	pageSvc    page.Service
+	themeSvc   theme.ThemeService
+	mediaSvc   *media.Service
+}
+
+// NewService 创建回收站服务
+func NewService(settingSvc setting.SettingService, articleSvc article.Service, pageSvc page.Service, themeSvc theme.ThemeService, mediaSvc *media.Service) *Service {
+	return &Service{
+		settingSvc: settingSvc,
+		articleSvc: articleSvc,
+		pageSvc:    pageSvc,
+		themeSvc:   themeSvc,
+		mediaSvc:   mediaSvc,
+	}
+}
+
+// RetentionDays 回收站内容的保留天数，0 表示不自动清理
+func (s *Service) RetentionDays() int {
+	days, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyTrashRetentionDays.String())))
+	if err != nil || days < 0 {
+		return defaultRetentionDays
+	}
+	return days
+}
+
+// List 获取回收站中的内容，typ 为空时返回全部类型，按删除时间从新到旧排列
+func (s *Service) List(ctx context.Context, typ string) ([]*Item, error) {
+	if typ != "" && !validType(typ) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownType, typ)
+	}
+	items := make([]*Item, 0)
+	if typ == "" || typ == TypeArticle {
+		articles, err := s.articleSvc.ListDeleted(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range articles {
+			if a.DeletedAt == nil {
+				continue
+			}
+			items = append(items, &Item{Type: TypeArticle, ID: a.ID, Title: a.Title, Extra: a.Abbrlink, DeletedAt: *a.DeletedAt})
+		}
+	}
+	if typ == "" || typ == TypePage {
+		pages, err := s.pageSvc.ListDeleted(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range pages {
+			if p.DeletedAt == nil {
+				continue
+			}
+			items = append(items, &Item{Type: TypePage, ID: strconv.FormatUint(uint64(p.ID), 10), Title: p.Title, Extra: p.Path, DeletedAt: *p.DeletedAt})
+		}
+	}
+	if typ == "" || typ == TypeTheme {
+		themes, err := s.themeSvc.ListTrashedThemes()
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range themes {
+			items = append(items, &Item{Type: TypeTheme, ID: t.ID, Title: t.ThemeName, Extra: t.InstalledVersion, DeletedAt: t.DeletedAt, Size: t.Size})
+		}
+	}
+	if typ == "" || typ == TypeMedia {
+		files, err := s.mediaSvc.ListTrashed(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			if f.DeletedAt == nil {
+				continue
+			}
+			publicID, err := idgen.GeneratePublicID(f.ID, idgen.EntityTypeFile)
+			if err != nil {
+				continue
+			}
+			items = append(items, &Item{Type: TypeMedia, ID: publicID, Title: f.Name, DeletedAt: *f.DeletedAt, Size: f.Size})
+		}
+	}
+
+	if days := s.RetentionDays(); days > 0 {
+		for _, item := range items {
+			purgeAt := item.DeletedAt.AddDate(0, 0, days)
+			item.PurgeAt = &purgeAt
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].DeletedAt.After(items[j].DeletedAt) })
+	return items, nil
+}
+
+// Restore 恢复回收站中的内容
+func (s *Service) Restore(ctx context.Context, typ, id string) error {
+	switch typ {
+	case TypeArticle:
+		return s.articleSvc.Restore(ctx, id)
+	case TypePage:
+		return s.pageSvc.Restore(ctx, id)
+	case TypeTheme:
+		return s.themeSvc.RestoreTrashedTheme(ctx, id)
+	case TypeMedia:
+		return s.mediaSvc.RestoreTrashed(ctx, id)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, typ)
+	}
+}
+
+// Purge 彻底删除回收站中的内容，不可恢复
+func (s *Service) Purge(ctx context.Context, typ, id string) error {
+	switch typ {
+	case TypeArticle:
+		return s.articleSvc.Purge(ctx, id)
+	case TypePage:
+		return s.pageSvc.Purge(ctx, id)
+	case TypeTheme:
+		return s.themeSvc.PurgeTrashedTheme(id)
+	case TypeMedia:
+		return s.mediaSvc.PurgeTrashed(ctx, id)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, typ)
+	}
+}
+
+// Empty 清空回收站，typ 为空时清空全部类型，返回彻底删除的数量
+func (s *Service) Empty(ctx context.Context, typ string) (int, error) {
+	return s.purgeWhere(ctx, typ, func(*Item) bool { return true })
+}
+
+// PurgeExpired 彻底删除超过保留天数的内容，由定时任务调用
+func (s *Service) PurgeExpired(ctx context.Context) (int, error) {
+	if s.RetentionDays() == 0 {
+		return 0, nil
+	}
+	now := time.Now()
+	count, err := s.purgeWhere(ctx, "", func(item *Item) bool {
+		return item.PurgeAt != nil && !item.PurgeAt.After(now)
+	})
+	if count > 0 {
+		log.Printf("[回收站] 已彻底删除 %d 项超过保留期的内容", count)
+	}
+	return count, err
+}
+
+// purgeWhere 彻底删除满足条件的内容，单项失败时记录日志并继续，返回第一个错误
+func (s *Service) purgeWhere(ctx context.Context, typ string, match func(*Item) bool) (int, error) {
+	items, err := s.List(ctx, typ)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	var firstErr error
+	for _, item := range items {
+		if !match(item) {
+			continue
+		}
+		if err := s.Purge(ctx, item.Type, item.ID); err != nil {
+			log.Printf("[回收站] 彻底删除 %s %s 失败: %v", item.Type, item.ID, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		count++
+	}
+	return count, firstErr
+}
+
+func validType(typ string) bool {
+	return typ == TypeArticle || typ == TypePage || typ == TypeTheme || typ == TypeMedia
+}
@@ -21,6 +21,8 @@ type CacheService interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
 	Get(ctx context.Context, key string) (string, error)
 	Delete(ctx context.Context, key ...string) error
+	// GetDel 原子地获取并删除一个键，键不存在时返回空字符串，用于一次性凭据
+	GetDel(ctx context.Context, key string) (string, error)
 	// Increment 原子地增加一个键的值
 	Increment(ctx context.Context, key string) (int64, error)
 	// Expire 设置键的过期时间
@@ -72,6 +74,21 @@ func (s *redisCacheService) Get(ctx context.Context, key string) (string, error)
 	return val, err
 }
 
+// GetDel 在事务管道（MULTI/EXEC）中获取并删除键，并发请求只有一个能拿到值
+func (s *redisCacheService) GetDel(ctx context.Context, key string) (string, error) {
+	pipe := s.client.TxPipeline()
+	get := pipe.Get(ctx, key)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return "", err
+	}
+	val, err := get.Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return val, err
+}
+
 // Delete 实现了删除缓存的方法
 func (s *redisCacheService) Delete(ctx context.Context, key ...string) error {
 	return s.client.Del(ctx, key...).Err()
@@ -132,7 +149,8 @@ func (s *redisCacheService) GetAndDeleteMany(ctx context.Context, keys []string)
 		return nil, nil
 	}
 
-	pipe := s.client.Pipeline()
+	// 使用事务管道（MULTI/EXEC），避免 GET 与 DEL 之间写入的增量被一并删除
+	pipe := s.client.TxPipeline()
 	cmds := make(map[string]*redis.StringCmd)
 
 	// 1. 在 pipeline 中为每个 key 添加一个 GET 命令
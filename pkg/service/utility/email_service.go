@@ -5,18 +5,16 @@ import (
 	"bytes"
 	"context"
 	"crypto/md5"
-	"crypto/tls"
+	"errors"
 	"fmt"
 	"html/template"
 	"log"
-	"net"
-	"net/smtp"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/mail"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
 	parser_service "github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
@@ -37,6 +35,8 @@ type EmailService interface {
 	SendVerificationEmail(ctx context.Context, toEmail, code string) error
 	// SendArticlePushEmail 发送文章更新推送邮件
 	SendArticlePushEmail(ctx context.Context, toEmail, unsubscribeToken string, article *model.Article) error
+	// SendNewDeviceLoginEmail 发送新设备登录提醒邮件
+	SendNewDeviceLoginEmail(ctx context.Context, toEmail, nickname, device, location, ip string, loginAt time.Time) error
 }
 
 // emailService 是 EmailService 接口的实现
@@ -44,14 +44,16 @@ type emailService struct {
 	settingSvc      setting.SettingService
 	notificationSvc notification.Service
 	parserSvc       *parser_service.Service
+	mailSvc         *mail.Service
 }
 
 // NewEmailService 是 emailService 的构造函数
-func NewEmailService(settingSvc setting.SettingService, notificationSvc notification.Service, parserSvc *parser_service.Service) EmailService {
+func NewEmailService(settingSvc setting.SettingService, notificationSvc notification.Service, parserSvc *parser_service.Service, mailSvc *mail.Service) EmailService {
 	return &emailService{
 		settingSvc:      settingSvc,
 		notificationSvc: notificationSvc,
 		parserSvc:       parserSvc,
+		mailSvc:         mailSvc,
 	}
 }
 
@@ -75,6 +77,29 @@ func (s *emailService) SendTestEmail(ctx context.Context, toEmail string) error
 	return s.send(toEmail, subject, body)
 }
 
+// SendNewDeviceLoginEmail 发送新设备登录提醒邮件
+func (s *emailService) SendNewDeviceLoginEmail(ctx context.Context, toEmail, nickname, device, location, ip string, loginAt time.Time) error {
+	appName := s.settingSvc.Get(constant.KeyAppName.String())
+	if location == "" {
+		location = "未知"
+	}
+
+	subject := fmt.Sprintf("【%s】您的账号在新设备上登录", appName)
+	body := fmt.Sprintf(`<p>%s，您好！</p>
+	<p>您的账号刚刚在一台新设备上登录了 %s：</p>
+	<ul>
+		<li>时间：%s</li>
+		<li>设备：%s</li>
+		<li>位置：%s</li>
+		<li>IP：%s</li>
+	</ul>
+	<p>如果这是您本人的操作，请忽略此邮件；如果不是，请立即修改密码。</p>`,
+		template.HTMLEscapeString(nickname), template.HTMLEscapeString(appName), loginAt.Format("2006-01-02 15:04:05"),
+		template.HTMLEscapeString(device), template.HTMLEscapeString(location), template.HTMLEscapeString(ip))
+
+	return s.send(toEmail, subject, body)
+}
+
 // SendLinkApplicationNotification 发送友链申请邮件通知给站长
 func (s *emailService) SendLinkApplicationNotification(ctx context.Context, link *model.LinkDTO) error {
 	if link == nil {
@@ -158,13 +183,8 @@ func (s *emailService) SendLinkApplicationNotification(ctx context.Context, link
 		return fmt.Errorf("渲染友链申请邮件正文失败: %w", err)
 	}
 
-	go func() {
-		if err := s.send(adminEmail, subject, body); err != nil {
-			log.Printf("[ERROR] 发送友链申请通知邮件失败: %v", err)
-		} else {
-			log.Printf("[INFO] 友链申请通知邮件已发送到: %s", adminEmail)
-		}
-	}()
+	// 写入发送队列，失败后自动重试
+	s.enqueue(adminEmail, subject, body)
 
 	return nil
 }
@@ -280,7 +300,7 @@ func (s *emailService) SendCommentNotification(newComment *model.Comment, parent
 
 		subject, _ := renderTemplate(adminSubjectTpl, data)
 		body, _ := renderTemplate(adminBodyTpl, data)
-		go func() { _ = s.send(primaryAdminEmail, subject, body) }()
+		s.enqueue(primaryAdminEmail, subject, body)
 		log.Printf("[DEBUG] 博主通知邮件已分发")
 	} else {
 		log.Printf("[DEBUG] 跳过博主通知: primaryAdminEmail=%s, shouldSendEmail=%t, isAdminComment=%t",
@@ -364,7 +384,7 @@ func (s *emailService) SendCommentNotification(newComment *model.Comment, parent
 
 		subject, _ := renderTemplate(replySubjectTpl, data)
 		body, _ := renderTemplate(replyBodyTpl, data)
-		go func() { _ = s.send(parentEmail, subject, body) }()
+		s.enqueue(parentEmail, subject, body)
 		log.Printf("[DEBUG] 回复通知邮件已分发到: %s", parentEmail)
 	}
 }
@@ -399,7 +419,7 @@ func (s *emailService) SendActivationEmail(ctx context.Context, toEmail, nicknam
 		return fmt.Errorf("渲染激活邮件正文失败: %w", err)
 	}
 
-	go func() { _ = s.send(toEmail, subject, body) }()
+	s.enqueue(toEmail, subject, body)
 	return nil
 }
 
@@ -433,7 +453,7 @@ func (s *emailService) SendForgotPasswordEmail(ctx context.Context, toEmail, nic
 		return fmt.Errorf("渲染重置密码邮件正文失败: %w", err)
 	}
 
-	go func() { _ = s.send(toEmail, subject, body) }()
+	s.enqueue(toEmail, subject, body)
 	return nil
 }
 
@@ -557,14 +577,8 @@ func (s *emailService) SendLinkReviewNotification(ctx context.Context, link *mod
 		return fmt.Errorf("渲染友链审核邮件正文失败: %w", err)
 	}
 
-	// 异步发送邮件
-	go func() {
-		if err := s.send(link.Email, subject, body); err != nil {
-			log.Printf("[ERROR] 发送友链审核邮件失败: %v", err)
-		} else {
-			log.Printf("[INFO] 友链审核邮件已发送到: %s", link.Email)
-		}
-	}()
+	// 写入发送队列，失败后自动重试
+	s.enqueue(link.Email, subject, body)
 
 	return nil
 }
@@ -604,29 +618,19 @@ func (s *emailService) SendVerificationEmail(ctx context.Context, toEmail, code
 	</div>
 </div>`, siteURL, appName, code, appName)
 
-	// 创建30秒超时的context
+	// 订阅者在等待验证码，同步发送并限制 30 秒；正文自带版式，不套用统一外框
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
-
-	// 在独立goroutine中发送邮件，使用channel接收结果
-	errChan := make(chan error, 1)
-	go func() {
-		errChan <- s.send(toEmail, subject, body)
-	}()
-
-	// 等待发送完成或超时
-	select {
-	case err := <-errChan:
-		if err != nil {
-			log.Printf("[ERROR] 发送订阅验证码邮件失败: %v", err)
-			return fmt.Errorf("发送验证码邮件失败: %w", err)
+	if err := s.mailSvc.Send(ctx, &mail.Message{To: toEmail, Subject: subject, HTML: body, NoLayout: true}); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			log.Printf("[ERROR] 发送订阅验证码邮件超时 (30s): %s", toEmail)
+			return fmt.Errorf("发送验证码邮件超时，请稍后重试")
 		}
-		log.Printf("[INFO] 订阅验证码邮件已发送到: %s", toEmail)
-		return nil
-	case <-ctx.Done():
-		log.Printf("[ERROR] 发送订阅验证码邮件超时 (30s): %s", toEmail)
-		return fmt.Errorf("发送验证码邮件超时，请稍后重试")
+		log.Printf("[ERROR] 发送订阅验证码邮件失败: %v", err)
+		return fmt.Errorf("发送验证码邮件失败: %w", err)
 	}
+	log.Printf("[INFO] 订阅验证码邮件已发送到: %s", toEmail)
+	return nil
 }
 
 // SendArticlePushEmail 发送文章更新推送邮件
@@ -730,122 +734,22 @@ func (s *emailService) SendArticlePushEmail(ctx context.Context, toEmail, unsubs
 		return fmt.Errorf("渲染文章推送邮件正文失败: %w", err)
 	}
 
-	// 异步发送
-	go func() {
-		if err := s.send(toEmail, subject, body); err != nil {
-			log.Printf("[ERROR] 发送文章推送邮件失败 (Email: %s): %v", toEmail, err)
-		} else {
-			log.Printf("[INFO] 文章推送邮件已发送到: %s", toEmail)
-		}
-	}()
+	// 写入发送队列，失败后自动重试
+	s.enqueue(toEmail, subject, body)
 
 	return nil
 }
 
-// send 是一个底层的、私有的邮件发送函数
+// send 立即发送邮件，用于需要知道发送结果的场景
 func (s *emailService) send(to, subject, body string) error {
-	host := s.settingSvc.Get(constant.KeySmtpHost.String())
-	portStr := s.settingSvc.Get(constant.KeySmtpPort.String())
-	username := s.settingSvc.Get(constant.KeySmtpUsername.String())
-	password := s.settingSvc.Get(constant.KeySmtpPassword.String())
-	senderName := s.settingSvc.Get(constant.KeySmtpSenderName.String())
-	senderEmail := s.settingSvc.Get(constant.KeySmtpSenderEmail.String())
-	replyToEmail := s.settingSvc.Get(constant.KeySmtpReplyToEmail.String())
-	forceSSL := s.settingSvc.GetBool(constant.KeySmtpForceSSL.String())
-
-	// 验证端口配置是否为数字
-	if _, err := strconv.Atoi(portStr); err != nil {
-		msg := fmt.Sprintf("SMTP端口配置无效 '%s'", portStr)
-		log.Printf("错误: %s: %v", msg, err)
-		return fmt.Errorf("%s: %w", msg, err)
-	}
-
-	headers := make(map[string]string)
-	headers["From"] = fmt.Sprintf("%s <%s>", senderName, senderEmail)
-	headers["To"] = to
-	headers["Subject"] = subject
-	headers["Content-Type"] = "text/html; charset=UTF-8"
-	if replyToEmail != "" {
-		headers["Reply-To"] = replyToEmail
-	}
-
-	var messageBuilder strings.Builder
-	for k, v := range headers {
-		messageBuilder.WriteString(fmt.Sprintf("%s: %s\r\n", k, v))
-	}
-	messageBuilder.WriteString("\r\n")
-	messageBuilder.WriteString(body)
-	message := []byte(messageBuilder.String())
-
-	auth := smtp.PlainAuth("", username, password, host)
-	addr := net.JoinHostPort(host, portStr)
-
-	if forceSSL {
-		if err := sendMailSSL(addr, auth, senderEmail, []string{to}, message); err != nil {
-			log.Printf("错误: [SSL] 发送邮件到 %s 失败: %v", to, err)
-			return err
-		}
-	} else {
-		// 使用带超时的拨号（15秒超时）
-		conn, err := net.DialTimeout("tcp", addr, 15*time.Second)
-		if err != nil {
-			log.Printf("错误: [STARTTLS] Dialing failed: %v", err)
-			return err
-		}
-
-		c, err := smtp.NewClient(conn, host)
-		if err != nil {
-			conn.Close()
-			log.Printf("错误: [STARTTLS] 创建SMTP客户端失败: %v", err)
-			return err
-		}
-		defer c.Close()
-
-		if ok, _ := c.Extension("STARTTLS"); ok {
-			tlsConfig := &tls.Config{
-				ServerName:         host,
-				InsecureSkipVerify: true,
-			}
-			if err = c.StartTLS(tlsConfig); err != nil {
-				log.Printf("错误: [STARTTLS] c.StartTLS failed: %v", err)
-				return err
-			}
-		}
-
-		if auth != nil {
-			if err = c.Auth(auth); err != nil {
-				log.Printf("错误: [STARTTLS] c.Auth failed: %v", err)
-				return err
-			}
-		}
-
-		if err = c.Mail(senderEmail); err != nil {
-			return err
-		}
-		if err = c.Rcpt(to); err != nil {
-			return err
-		}
-
-		w, err := c.Data()
-		if err != nil {
-			return err
-		}
-		_, err = w.Write(message)
-		if err != nil {
-			return err
-		}
-		err = w.Close()
-		if err != nil {
-			return err
-		}
-
-		if err := c.Quit(); err != nil {
-			log.Printf("警告: [STARTTLS] SMTP c.Quit() 执行失败: %v。这通常不影响邮件发送。", err)
-		}
+	return s.mailSvc.Send(context.Background(), &mail.Message{To: to, Subject: subject, HTML: body})
+}
 
-		return nil
+// enqueue 将通知类邮件写入发送队列，由邮件服务在后台发送并在失败后重试
+func (s *emailService) enqueue(to, subject, body string) {
+	if err := s.mailSvc.Enqueue(&mail.Message{To: to, Subject: subject, HTML: body}); err != nil {
+		log.Printf("[ERROR] 邮件「%s」加入发送队列失败: %v", subject, err)
 	}
-	return nil
 }
 
 // renderTemplate 是一个渲染 Go 模板的辅助函数
@@ -860,59 +764,3 @@ func renderTemplate(tplStr string, data interface{}) (string, error) {
 	}
 	return buf.String(), nil
 }
-
-// sendMailSSL 是用于处理直接SSL连接的辅助函数
-func sendMailSSL(addr string, auth smtp.Auth, from string, to []string, message []byte) error {
-	host, port, _ := net.SplitHostPort(addr)
-	tlsConfig := &tls.Config{
-		InsecureSkipVerify: true,
-		ServerName:         host,
-		MinVersion:         tls.VersionTLS12, // 最低支持TLS 1.2
-	}
-
-	// 设置15秒超时
-	dialer := &net.Dialer{
-		Timeout: 15 * time.Second,
-	}
-
-	log.Printf("[邮件发送] 尝试通过SSL连接到 %s:%s", host, port)
-	conn, err := tls.DialWithDialer(dialer, "tcp", addr, tlsConfig)
-	if err != nil {
-		return fmt.Errorf("TLS拨号失败 (请检查端口是否正确，SSL通常使用465端口): %w", err)
-	}
-	defer conn.Close()
-	client, err := smtp.NewClient(conn, host)
-	if err != nil {
-		return fmt.Errorf("创建SMTP客户端失败: %w", err)
-	}
-	defer client.Close()
-	if auth != nil {
-		if err = client.Auth(auth); err != nil {
-			return fmt.Errorf("SMTP认证失败: %w", err)
-		}
-	}
-	if err = client.Mail(from); err != nil {
-		return fmt.Errorf("设置发件人失败: %w", err)
-	}
-	for _, recipient := range to {
-		if err = client.Rcpt(recipient); err != nil {
-			return fmt.Errorf("设置收件人 %s 失败: %w", recipient, err)
-		}
-	}
-	w, err := client.Data()
-	if err != nil {
-		return fmt.Errorf("获取数据写入器失败: %w", err)
-	}
-	_, err = w.Write(message)
-	if err != nil {
-		return fmt.Errorf("写入邮件内容失败: %w", err)
-	}
-	err = w.Close()
-	if err != nil {
-		return fmt.Errorf("关闭写入器失败: %w", err)
-	}
-	if err := client.Quit(); err != nil {
-		log.Printf("警告: SMTP client.Quit() 执行失败: %v。这通常不影响邮件发送。", err)
-	}
-	return nil
-}
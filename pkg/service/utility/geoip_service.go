@@ -16,6 +16,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 )
@@ -106,9 +107,13 @@ type smartGeoIPService struct {
 func NewGeoIPService(settingSvc setting.SettingService) (GeoIPService, error) {
 	return &smartGeoIPService{
 		settingSvc: settingSvc,
-		httpClient: &http.Client{
-			Timeout: 5 * time.Second, // 为 API 请求设置5秒超时
-		},
+		// 为 API 请求设置5秒超时，上游持续失败时熔断，避免拖慢评论等请求
+		httpClient: httpclient.New(httpclient.Options{
+			Name:       "geoip",
+			Timeout:    5 * time.Second,
+			MaxRetries: 1,
+			Identify:   true,
+		}),
 	}, nil
 }
 
@@ -69,6 +69,13 @@ func (s *memoryCacheService) cleanupExpired() {
 	}
 }
 
+// SetCleanupInterval 修改清理过期数据的间隔，不大于 0 时忽略
+func (s *memoryCacheService) SetCleanupInterval(d time.Duration) {
+	if d > 0 {
+		s.ticker.Reset(d)
+	}
+}
+
 // Stop 停止清理任务
 func (s *memoryCacheService) Stop() {
 	s.ticker.Stop()
@@ -111,6 +118,19 @@ func (s *memoryCacheService) Get(ctx context.Context, key string) (string, error
 	return item.value, nil
 }
 
+// GetDel 获取并删除缓存
+func (s *memoryCacheService) GetDel(ctx context.Context, key string) (string, error) {
+	value, ok := s.data.LoadAndDelete(key)
+	if !ok {
+		return "", nil
+	}
+	item, ok := value.(*cacheItem)
+	if !ok || item.isExpired() {
+		return "", nil
+	}
+	return item.value, nil
+}
+
 // Delete 删除缓存
 func (s *memoryCacheService) Delete(ctx context.Context, keys ...string) error {
 	for _, key := range keys {
@@ -0,0 +1,188 @@
+/*
+ * @Description: GitHub 仓库 push 事件解析与 Markdown 文件拉取
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+)
+
+const (
+	// maxFilesPerPush 单次推送最多处理的文件数
+	maxFilesPerPush = 200
+	// maxMarkdownSize 单个 Markdown 文件的大小上限
+	maxMarkdownSize = 5 << 20
+)
+
+// githubPushEvent push 事件中用到的字段
+type githubPushEvent struct {
+	Ref        string `json:"ref"`
+	After      string `json:"after"`
+	Deleted    bool   `json:"deleted"`
+	Repository struct {
+		FullName      string `json:"full_name"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"repository"`
+	Commits []struct {
+		Added    []string `json:"added"`
+		Modified []string `json:"modified"`
+		Removed  []string `json:"removed"`
+	} `json:"commits"`
+}
+
+// githubEntries 解析 push 事件并拉取变更的 Markdown 文件，返回待处理的文章和已跳过的文件
+func (s *Service) githubEntries(ctx context.Context, src *SourceConfig, d Delivery) ([]entry, []Action, error) {
+	if d.Event != "" && d.Event != "push" {
+		// ping 等其他事件直接确认
+		log.Printf("[内容Webhook] 来源 %s 忽略 GitHub 事件: %s", src.ID, d.Event)
+		return nil, nil, nil
+	}
+	var event githubPushEvent
+	if err := json.Unmarshal(d.Body, &event); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	repo := event.Repository.FullName
+	if repo == "" || event.After == "" {
+		return nil, nil, fmt.Errorf("%w: 缺少 repository.full_name 或 after", ErrInvalidPayload)
+	}
+	if src.Repository != "" && !strings.EqualFold(src.Repository, repo) {
+		return nil, nil, fmt.Errorf("%w: 仓库 %s 与来源配置不一致", ErrInvalidPayload, repo)
+	}
+	branch := src.Branch
+	if branch == "" {
+		branch = event.Repository.DefaultBranch
+	}
+	if event.Deleted || event.Ref != "refs/heads/"+branch {
+		log.Printf("[内容Webhook] 来源 %s 忽略非 %s 分支的推送: %s", src.ID, branch, event.Ref)
+		return nil, nil, nil
+	}
+
+	// 合并多个提交的变更，以最后一次操作为准
+	changed := make(map[string]bool)
+	var order []string
+	for _, commit := range event.Commits {
+		for _, files := range [][]string{commit.Added, commit.Modified} {
+			for _, f := range files {
+				if _, seen := changed[f]; !seen {
+					order = append(order, f)
+				}
+				changed[f] = true
+			}
+		}
+		for _, f := range commit.Removed {
+			if _, seen := changed[f]; !seen {
+				order = append(order, f)
+			}
+			changed[f] = false
+		}
+	}
+
+	var entries []entry
+	var skipped []Action
+	for _, f := range order {
+		if !isMarkdownFile(f) || !strings.HasPrefix(f, src.PathPrefix) {
+			continue
+		}
+		if !changed[f] {
+			skipped = append(skipped, Action{Path: f, Op: OpSkip, Message: "文件已删除，站内文章保留"})
+			continue
+		}
+		if len(entries) >= maxFilesPerPush {
+			skipped = append(skipped, Action{Path: f, Op: OpSkip, Message: fmt.Sprintf("单次推送最多处理 %d 个文件", maxFilesPerPush)})
+			continue
+		}
+		data, err := s.fetcher.fetch(ctx, repo, event.After, f, src.Token)
+		if err != nil {
+			skipped = append(skipped, Action{Path: f, Op: OpError, Message: err.Error()})
+			continue
+		}
+		e, err := markdownEntry(src.Mapping, f, data)
+		if err != nil {
+			skipped = append(skipped, Action{Path: f, Op: OpError, Message: err.Error()})
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries, skipped, nil
+}
+
+func isMarkdownFile(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".md", ".markdown":
+		return true
+	default:
+		return false
+	}
+}
+
+// githubFetcher 拉取仓库中指定提交的文件内容
+type githubFetcher struct {
+	client  *http.Client
+	apiBase string
+	rawBase string
+}
+
+func newGitHubFetcher() *githubFetcher {
+	return &githubFetcher{
+		client: httpclient.New(httpclient.Options{
+			Name:       "content_webhook",
+			Timeout:    30 * time.Second,
+			MaxRetries: 2,
+		}),
+		apiBase: "https://api.github.com",
+		rawBase: "https://raw.githubusercontent.com",
+	}
+}
+
+// fetch 配置了令牌时通过 API 拉取（支持私有仓库），否则直接读取 raw 地址
+func (f *githubFetcher) fetch(ctx context.Context, repo, ref, filePath, token string) ([]byte, error) {
+	escaped := make([]string, 0)
+	for _, seg := range strings.Split(filePath, "/") {
+		escaped = append(escaped, url.PathEscape(seg))
+	}
+	escapedPath := strings.Join(escaped, "/")
+
+	var target string
+	if token != "" {
+		target = fmt.Sprintf("%s/repos/%s/contents/%s?ref=%s", f.apiBase, repo, escapedPath, url.QueryEscape(ref))
+	} else {
+		target = fmt.Sprintf("%s/%s/%s/%s", f.rawBase, repo, url.PathEscape(ref), escapedPath)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Accept", "application/vnd.github.raw")
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("拉取文件失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("拉取文件失败，状态码: %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxMarkdownSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("读取文件失败: %w", err)
+	}
+	if len(data) > maxMarkdownSize {
+		return nil, fmt.Errorf("文件超过 %d MB", maxMarkdownSize>>20)
+	}
+	return data, nil
+}
@@ -0,0 +1,175 @@
+/*
+ * @Description: 内容来源的字段映射、frontmatter 解析与标签分类解析
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ */
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/frontmatter"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+func pick(key, fallback string) string {
+	if key != "" {
+		return key
+	}
+	return fallback
+}
+
+// mapEntry 按字段映射从元数据中取出文章字段
+func mapEntry(m FieldMapping, meta frontmatter.Meta, content string) entry {
+	return entry{
+		Slug:       strings.TrimSpace(meta.String(pick(m.Slug, "abbrlink"))),
+		Title:      strings.TrimSpace(meta.String(pick(m.Title, "title"))),
+		Content:    content,
+		Tags:       meta.List(pick(m.Tags, "tags")),
+		Categories: meta.List(pick(m.Categories, "categories")),
+		Cover:      meta.String(pick(m.Cover, "cover")),
+		Status:     meta.String(pick(m.Status, "status")),
+		Keywords:   meta.String(pick(m.Keywords, "keywords")),
+		Summary:    meta.String(pick(m.Summary, "description")),
+		Date:       meta.Time(pick(m.Date, "date")),
+		Updated:    meta.Time(pick(m.Updated, "updated")),
+	}
+}
+
+// markdownEntry 解析带 frontmatter 的 Markdown 文件，未指定 abbrlink 时使用文件名
+func markdownEntry(m FieldMapping, filePath string, data []byte) (entry, error) {
+	meta, body, err := frontmatter.Split(data)
+	if err != nil {
+		return entry{Path: filePath}, err
+	}
+	e := mapEntry(m, meta, body)
+	e.Path = filePath
+	if e.Slug == "" {
+		e.Slug = strings.Join(strings.Fields(strings.TrimSuffix(path.Base(filePath), path.Ext(filePath))), "-")
+	}
+	return e, nil
+}
+
+// jsonEntries 解析通用 JSON 推送：单个对象、对象数组或 {"items": [...]}
+func jsonEntries(src *SourceConfig, body []byte) ([]entry, error) {
+	var items []map[string]any
+	trimmed := bytes.TrimSpace(body)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("[")):
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+		}
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		var obj map[string]any
+		if err := json.Unmarshal(trimmed, &obj); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+		}
+		if list, ok := obj["items"].([]any); ok {
+			for _, item := range list {
+				m, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("%w: items 中的元素必须是对象", ErrInvalidPayload)
+				}
+				items = append(items, m)
+			}
+		} else {
+			items = []map[string]any{obj}
+		}
+	default:
+		return nil, ErrInvalidPayload
+	}
+
+	entries := make([]entry, 0, len(items))
+	for _, item := range items {
+		entries = append(entries, mapEntry(src.Mapping, frontmatter.Meta(item), frontmatter.Meta(item).String(pick(src.Mapping.Content, "content"))))
+	}
+	return entries, nil
+}
+
+// taxonomy 按名称解析标签和分类，一次推送内缓存查询结果
+type taxonomy struct {
+	postTagRepo      repository.PostTagRepository
+	postCategoryRepo repository.PostCategoryRepository
+	tags             map[string]string
+	categories       map[string]string
+}
+
+func newTaxonomy(postTagRepo repository.PostTagRepository, postCategoryRepo repository.PostCategoryRepository) *taxonomy {
+	return &taxonomy{postTagRepo: postTagRepo, postCategoryRepo: postCategoryRepo}
+}
+
+// tagIDs 返回标签的公共 ID 和被忽略的标签名；预览时需要创建的标签不会真正创建
+func (t *taxonomy) tagIDs(ctx context.Context, names []string, create, dryRun bool) ([]string, []string, error) {
+	if t.tags == nil {
+		tags, err := t.postTagRepo.List(ctx, &model.ListPostTagsOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		t.tags = make(map[string]string, len(tags))
+		for _, tag := range tags {
+			t.tags[tag.Name] = tag.ID
+		}
+	}
+	return resolveNames(names, t.tags, create, dryRun, func(name string) (string, error) {
+		tag, err := t.postTagRepo.Create(ctx, &model.CreatePostTagRequest{Name: name})
+		if err != nil {
+			return "", err
+		}
+		return tag.ID, nil
+	})
+}
+
+// categoryIDs 返回分类的公共 ID 和被忽略的分类名；预览时需要创建的分类不会真正创建
+func (t *taxonomy) categoryIDs(ctx context.Context, names []string, create, dryRun bool) ([]string, []string, error) {
+	if t.categories == nil {
+		categories, err := t.postCategoryRepo.List(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		t.categories = make(map[string]string, len(categories))
+		for _, category := range categories {
+			t.categories[category.Name] = category.ID
+		}
+	}
+	return resolveNames(names, t.categories, create, dryRun, func(name string) (string, error) {
+		category, err := t.postCategoryRepo.Create(ctx, &model.CreatePostCategoryRequest{Name: name})
+		if err != nil {
+			return "", err
+		}
+		return category.ID, nil
+	})
+}
+
+func resolveNames(names []string, known map[string]string, create, dryRun bool, createFn func(name string) (string, error)) ([]string, []string, error) {
+	if names == nil {
+		return nil, nil, nil
+	}
+	ids := []string{}
+	var missing []string
+	for _, name := range names {
+		if id, ok := known[name]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		if !create {
+			missing = append(missing, name)
+			continue
+		}
+		if dryRun {
+			continue
+		}
+		id, err := createFn(name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("创建 %s 失败: %w", name, err)
+		}
+		known[name] = id
+		ids = append(ids, id)
+	}
+	return ids, missing, nil
+}
@@ -0,0 +1,458 @@
+/*
+ * @Description: 内容来源 Webhook，接收外部系统推送的内容并创建或更新文章
+ * @Author: 安知鱼
+ * @Date: 2026-10-15
+ *
+ * 来源配置保存在 webhook.sources 配置项中，每个来源对应一个接收地址 /api/webhooks/{id}：
+ *   - github：仓库 push 事件，拉取新增和修改的 Markdown 文件（带 YAML frontmatter）
+ *   - json：通用 JSON 推送，适用于 Notion 导出等流水线，请求体为单个对象、对象数组或 {"items": [...]}
+ * 请求体使用来源密钥计算 HMAC-SHA256，签名放在 X-Hub-Signature-256 或 X-Anheyu-Signature-256 头中，
+ * 格式为 sha256=<hex>。文章按 abbrlink 匹配，已存在则更新，否则创建。
+ */
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// 来源类型
+	TypeGitHub = "github"
+	TypeJSON   = "json"
+
+	// 处理结果
+	OpCreate = "create"
+	OpUpdate = "update"
+	OpSkip   = "skip"
+	OpError  = "error"
+
+	// defaultOwnerID 未配置 owner_id 时文章归属初始管理员
+	defaultOwnerID = 1
+)
+
+var (
+	ErrSourceNotFound   = errors.New("Webhook 来源不存在或未启用")
+	ErrInvalidSignature = errors.New("Webhook 签名校验失败")
+	ErrInvalidPayload   = errors.New("Webhook 请求体格式无效")
+)
+
+// FieldMapping 字段映射，值为来源中的字段名（Markdown frontmatter 的键或 JSON 字段名），留空使用括号中的默认值
+type FieldMapping struct {
+	Title      string `json:"title,omitempty"`      // title
+	Slug       string `json:"slug,omitempty"`       // abbrlink，github 来源缺省时使用文件名
+	Content    string `json:"content,omitempty"`    // content，仅 json 来源使用，值为 Markdown
+	Tags       string `json:"tags,omitempty"`       // tags
+	Categories string `json:"categories,omitempty"` // categories
+	Cover      string `json:"cover,omitempty"`      // cover
+	Status     string `json:"status,omitempty"`     // status
+	Date       string `json:"date,omitempty"`       // date
+	Updated    string `json:"updated,omitempty"`    // updated
+	Keywords   string `json:"keywords,omitempty"`   // keywords
+	Summary    string `json:"summary,omitempty"`    // description
+}
+
+// SourceConfig 内容来源配置，保存在 webhook.sources 配置项中
+type SourceConfig struct {
+	ID               string       `json:"id"`   // 唯一标识，用于接收地址 /api/webhooks/{id}
+	Type             string       `json:"type"` // github 或 json
+	Name             string       `json:"name"`
+	Secret           string       `json:"secret"` // HMAC 签名密钥
+	Enabled          bool         `json:"enabled"`
+	OwnerID          uint         `json:"owner_id,omitempty"`       // 新建文章的作者，默认 1
+	DefaultStatus    string       `json:"default_status,omitempty"` // 来源未指定状态时新建文章的状态，默认 DRAFT
+	CreateTags       bool         `json:"create_tags"`              // 是否自动创建不存在的标签
+	CreateCategories bool         `json:"create_categories"`        // 是否自动创建不存在的分类
+	Mapping          FieldMapping `json:"mapping"`
+
+	// github 来源
+	Repository string `json:"repository,omitempty"`  // owner/name，填写后只接受该仓库的推送
+	Branch     string `json:"branch,omitempty"`      // 只处理该分支，默认仓库的默认分支
+	PathPrefix string `json:"path_prefix,omitempty"` // 只处理该目录下的文件，如 posts/
+	Token      string `json:"token,omitempty"`       // 私有仓库的访问令牌
+}
+
+// SourceInfo 后台展示的来源信息，不包含密钥
+type SourceInfo struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	Enabled    bool   `json:"enabled"`
+	Endpoint   string `json:"endpoint"`
+	HasSecret  bool   `json:"has_secret"`
+	Repository string `json:"repository,omitempty"`
+	Branch     string `json:"branch,omitempty"`
+	PathPrefix string `json:"path_prefix,omitempty"`
+}
+
+// Action 单篇内容的处理结果
+type Action struct {
+	Path       string   `json:"path,omitempty"` // github 来源的文件路径
+	Slug       string   `json:"slug"`
+	Title      string   `json:"title"`
+	Op         string   `json:"op"` // create、update、skip、error
+	ArticleID  string   `json:"article_id,omitempty"`
+	Status     string   `json:"status,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+	Message    string   `json:"message,omitempty"`
+}
+
+// Result 一次推送的处理结果
+type Result struct {
+	Source  string   `json:"source"`
+	DryRun  bool     `json:"dry_run"`
+	Created int      `json:"created"`
+	Updated int      `json:"updated"`
+	Skipped int      `json:"skipped"`
+	Failed  int      `json:"failed"`
+	Actions []Action `json:"actions"`
+}
+
+func (r *Result) add(a Action) {
+	switch a.Op {
+	case OpCreate:
+		r.Created++
+	case OpUpdate:
+		r.Updated++
+	case OpSkip:
+		r.Skipped++
+	case OpError:
+		r.Failed++
+	}
+	r.Actions = append(r.Actions, a)
+}
+
+// Delivery 一次 Webhook 推送
+type Delivery struct {
+	Event     string // github 来源的 X-GitHub-Event 头
+	Signature string // 签名头的值，管理员预览时为空
+	Body      []byte
+	DryRun    bool
+}
+
+// entry 经过字段映射后的文章内容
+type entry struct {
+	Path       string
+	Slug       string
+	Title      string
+	Content    string
+	Tags       []string
+	Categories []string
+	Cover      string
+	Status     string
+	Keywords   string
+	Summary    string
+	Date       *time.Time
+	Updated    *time.Time
+}
+
+// Service 内容来源 Webhook 服务
+type Service struct {
+	settingSvc       setting.SettingService
+	articleSvc       article_service.Service
+	articleRepo      repository.ArticleRepository
+	postTagRepo      repository.PostTagRepository
+	postCategoryRepo repository.PostCategoryRepository
+	parserSvc        *parser.Service
+	fetcher          *githubFetcher
+}
+
+// NewService 创建内容来源 Webhook 服务
+func NewService(
+	settingSvc setting.SettingService,
+	articleSvc article_service.Service,
+	articleRepo repository.ArticleRepository,
+	postTagRepo repository.PostTagRepository,
+	postCategoryRepo repository.PostCategoryRepository,
+	parserSvc *parser.Service,
+) *Service {
+	return &Service{
+		settingSvc:       settingSvc,
+		articleSvc:       articleSvc,
+		articleRepo:      articleRepo,
+		postTagRepo:      postTagRepo,
+		postCategoryRepo: postCategoryRepo,
+		parserSvc:        parserSvc,
+		fetcher:          newGitHubFetcher(),
+	}
+}
+
+func (s *Service) sources() []SourceConfig {
+	var list []SourceConfig
+	raw := s.settingSvc.Get(constant.KeyWebhookSources.String())
+	if raw == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(raw), &list); err != nil {
+		log.Printf("[内容Webhook] 解析来源配置失败: %v", err)
+		return nil
+	}
+	return list
+}
+
+func (s *Service) source(id string) (*SourceConfig, error) {
+	for _, src := range s.sources() {
+		if src.ID == id && src.Enabled && (src.Type == TypeGitHub || src.Type == TypeJSON) {
+			return &src, nil
+		}
+	}
+	return nil, ErrSourceNotFound
+}
+
+// Sources 返回全部来源配置（不含密钥），baseURL 用于拼接接收地址
+func (s *Service) Sources(baseURL string) []SourceInfo {
+	if siteURL := strings.TrimRight(s.settingSvc.Get(constant.KeySiteURL.String()), "/"); strings.HasPrefix(siteURL, "http") {
+		baseURL = siteURL
+	}
+	result := []SourceInfo{}
+	for _, src := range s.sources() {
+		result = append(result, SourceInfo{
+			ID:         src.ID,
+			Type:       src.Type,
+			Name:       src.Name,
+			Enabled:    src.Enabled,
+			Endpoint:   strings.TrimRight(baseURL, "/") + "/api/webhooks/" + src.ID,
+			HasSecret:  src.Secret != "",
+			Repository: src.Repository,
+			Branch:     src.Branch,
+			PathPrefix: src.PathPrefix,
+		})
+	}
+	return result
+}
+
+// Receive 处理外部系统的推送，校验签名后按来源类型解析内容
+func (s *Service) Receive(ctx context.Context, sourceID string, d Delivery) (*Result, error) {
+	src, err := s.source(sourceID)
+	if err != nil {
+		return nil, err
+	}
+	if !verifySignature(src.Secret, d.Body, d.Signature) {
+		return nil, ErrInvalidSignature
+	}
+	return s.process(ctx, src, d)
+}
+
+// Preview 管理员使用示例请求体预览处理结果，不校验签名也不写入
+func (s *Service) Preview(ctx context.Context, sourceID string, d Delivery) (*Result, error) {
+	src, err := s.source(sourceID)
+	if err != nil {
+		return nil, err
+	}
+	d.DryRun = true
+	return s.process(ctx, src, d)
+}
+
+func (s *Service) process(ctx context.Context, src *SourceConfig, d Delivery) (*Result, error) {
+	result := &Result{Source: src.ID, DryRun: d.DryRun, Actions: []Action{}}
+
+	var entries []entry
+	switch src.Type {
+	case TypeGitHub:
+		list, skipped, err := s.githubEntries(ctx, src, d)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range skipped {
+			result.add(a)
+		}
+		entries = list
+	case TypeJSON:
+		list, err := jsonEntries(src, d.Body)
+		if err != nil {
+			return nil, err
+		}
+		entries = list
+	}
+
+	tax := newTaxonomy(s.postTagRepo, s.postCategoryRepo)
+	for _, e := range entries {
+		result.add(s.apply(ctx, src, tax, e, d.DryRun))
+	}
+	log.Printf("[内容Webhook] 来源 %s 处理完成（预览: %v）- 新建: %d, 更新: %d, 跳过: %d, 失败: %d",
+		src.ID, d.DryRun, result.Created, result.Updated, result.Skipped, result.Failed)
+	return result, nil
+}
+
+// apply 按 abbrlink 查找文章，存在则更新，否则创建；预览时只计算处理结果
+func (s *Service) apply(ctx context.Context, src *SourceConfig, tax *taxonomy, e entry, dryRun bool) Action {
+	action := Action{Path: e.Path, Slug: e.Slug, Title: e.Title, Tags: e.Tags, Categories: e.Categories}
+	fail := func(format string, args ...any) Action {
+		action.Op = OpError
+		action.Message = fmt.Sprintf(format, args...)
+		return action
+	}
+	if e.Slug == "" {
+		return fail("缺少 abbrlink，无法匹配文章")
+	}
+	if e.Title == "" {
+		return fail("缺少标题")
+	}
+
+	existing, err := s.articleRepo.GetBySlugOrIDForPreview(ctx, e.Slug)
+	if err != nil && !ent.IsNotFound(err) {
+		return fail("查询文章失败: %v", err)
+	}
+	if existing != nil && existing.Abbrlink != e.Slug {
+		// slug 恰好能解码为其他文章的公共 ID，不能当作同一篇文章
+		existing = nil
+	}
+
+	status := normalizeStatus(e.Status)
+	if existing == nil && status == "" {
+		status = normalizeStatus(src.DefaultStatus)
+		if status == "" {
+			status = "DRAFT"
+		}
+	}
+	action.Status = status
+
+	tagIDs, missingTags, err := tax.tagIDs(ctx, e.Tags, src.CreateTags, dryRun)
+	if err != nil {
+		return fail("处理标签失败: %v", err)
+	}
+	categoryIDs, missingCategories, err := tax.categoryIDs(ctx, e.Categories, src.CreateCategories, dryRun)
+	if err != nil {
+		return fail("处理分类失败: %v", err)
+	}
+	var notes []string
+	if len(missingTags) > 0 {
+		notes = append(notes, "忽略不存在的标签: "+strings.Join(missingTags, ", "))
+	}
+	if len(missingCategories) > 0 {
+		notes = append(notes, "忽略不存在的分类: "+strings.Join(missingCategories, ", "))
+	}
+	action.Message = strings.Join(notes, "；")
+
+	if existing != nil {
+		action.Op = OpUpdate
+		action.ArticleID = existing.ID
+		if existing.Title == e.Title && existing.ContentMd == e.Content && (status == "" || status == existing.Status) {
+			action.Op = OpSkip
+			action.Message = "内容未变化"
+			return action
+		}
+	} else {
+		action.Op = OpCreate
+	}
+	if dryRun {
+		return action
+	}
+
+	html, err := s.parserSvc.ToHTML(ctx, e.Content)
+	if err != nil {
+		return fail("渲染 Markdown 失败: %v", err)
+	}
+
+	if existing != nil {
+		req := &model.UpdateArticleRequest{
+			Title:       &e.Title,
+			ContentMd:   &e.Content,
+			ContentHTML: &html,
+		}
+		if status != "" {
+			req.Status = &status
+		}
+		if e.Tags != nil {
+			req.PostTagIDs = tagIDs
+		}
+		if e.Categories != nil {
+			req.PostCategoryIDs = categoryIDs
+		}
+		if e.Cover != "" {
+			req.CoverURL = &e.Cover
+		}
+		if e.Keywords != "" {
+			req.Keywords = &e.Keywords
+		}
+		if e.Summary != "" {
+			req.Summaries = []string{e.Summary}
+		}
+		if e.Updated != nil {
+			updated := e.Updated.Format(time.RFC3339)
+			req.CustomUpdatedAt = &updated
+		}
+		if _, err := s.articleSvc.Update(ctx, existing.ID, req, "", ""); err != nil {
+			return fail("更新文章失败: %v", err)
+		}
+		return action
+	}
+
+	ownerID := src.OwnerID
+	if ownerID == 0 {
+		ownerID = defaultOwnerID
+	}
+	req := &model.CreateArticleRequest{
+		Title:           e.Title,
+		ContentMd:       e.Content,
+		ContentHTML:     html,
+		Status:          status,
+		PostTagIDs:      tagIDs,
+		PostCategoryIDs: categoryIDs,
+		CoverURL:        e.Cover,
+		Abbrlink:        e.Slug,
+		Keywords:        e.Keywords,
+		OwnerID:         ownerID,
+	}
+	if e.Summary != "" {
+		req.Summaries = []string{e.Summary}
+	}
+	if e.Date != nil {
+		published := e.Date.Format(time.RFC3339)
+		req.CustomPublishedAt = &published
+	}
+	if e.Updated != nil {
+		updated := e.Updated.Format(time.RFC3339)
+		req.CustomUpdatedAt = &updated
+	}
+	created, err := s.articleSvc.Create(ctx, req, "", "")
+	if err != nil {
+		return fail("创建文章失败: %v", err)
+	}
+	action.ArticleID = created.ID
+	return action
+}
+
+// verifySignature 校验 sha256=<hex> 格式的 HMAC-SHA256 签名，未配置密钥的来源拒绝所有请求
+func verifySignature(secret string, body []byte, signature string) bool {
+	if secret == "" {
+		return false
+	}
+	hexSig, ok := strings.CutPrefix(strings.TrimSpace(signature), "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(hexSig)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+func normalizeStatus(status string) string {
+	switch s := strings.ToUpper(strings.TrimSpace(status)); s {
+	case "DRAFT", "PUBLISHED", "ARCHIVED":
+		return s
+	default:
+		return ""
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
 	"github.com/google/uuid"
 )
 
@@ -24,6 +25,7 @@ type JSSDKService struct {
 	ticketExpireAt time.Time
 	tokenMu        sync.RWMutex
 	ticketMu       sync.RWMutex
+	httpClient     *http.Client
 }
 
 // AccessTokenResponse 获取access_token响应
@@ -63,6 +65,11 @@ func NewJSSDKService(appID, appSecret string) *JSSDKService {
 	return &JSSDKService{
 		appID:     appID,
 		appSecret: appSecret,
+		httpClient: httpclient.New(httpclient.Options{
+			Name:       "wechat",
+			Timeout:    10 * time.Second,
+			MaxRetries: 2,
+		}),
 	}
 }
 
@@ -93,7 +100,7 @@ func (s *JSSDKService) GetAccessToken(ctx context.Context) (string, error) {
 		return "", fmt.Errorf("创建请求失败: %w", err)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := s.httpClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("请求失败: %w", err)
 	}
@@ -152,7 +159,7 @@ func (s *JSSDKService) GetJSAPITicket(ctx context.Context) (string, error) {
 		return "", fmt.Errorf("创建请求失败: %w", err)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := s.httpClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("请求失败: %w", err)
 	}
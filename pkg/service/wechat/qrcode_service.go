@@ -0,0 +1,231 @@
+// anheyu-app/pkg/service/wechat/qrcode_service.go
+package wechat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 二维码类型
+const (
+	QRCodeTypeMiniProgram = "miniprogram"
+	QRCodeTypeOfficial    = "official"
+)
+
+// maxCachedQRCodes 内存中最多缓存的二维码数量
+const maxCachedQRCodes = 500
+
+// QRCodeImage 二维码图片
+type QRCodeImage struct {
+	Data        []byte
+	ContentType string
+	CreatedAt   time.Time
+}
+
+// QRCodeService 文章二维码生成服务
+//   - miniprogram: 调用 wxa/getwxacodeunlimit 生成小程序码，scene 为文章 ID，由小程序页面解析后打开文章；
+//   - official: 调用 cgi-bin/qrcode/create 生成公众号永久二维码，scene_str 为 article_<文章ID>。
+//
+// 两种码都永久有效，生成后按文章缓存在内存中，避免重复消耗微信接口调用额度。
+type QRCodeService struct {
+	codeType    string
+	page        string
+	tokenSource *JSSDKService // 复用 JSSDKService 的 access_token 获取与缓存
+	httpClient  *http.Client
+
+	mu    sync.Mutex
+	cache map[string]*QRCodeImage
+}
+
+// NewQRCodeService 创建二维码生成服务
+// codeType 为 miniprogram 时 tokenSource 应使用小程序的 AppID/AppSecret，page 为小程序中打开文章的页面路径
+func NewQRCodeService(codeType, page string, tokenSource *JSSDKService) *QRCodeService {
+	if codeType != QRCodeTypeOfficial {
+		codeType = QRCodeTypeMiniProgram
+	}
+	return &QRCodeService{
+		codeType:    codeType,
+		page:        strings.TrimPrefix(page, "/"),
+		tokenSource: tokenSource,
+		httpClient:  tokenSource.httpClient,
+		cache:       make(map[string]*QRCodeImage),
+	}
+}
+
+// IsConfigured 检查是否已配置
+func (s *QRCodeService) IsConfigured() bool {
+	return s.tokenSource != nil && s.tokenSource.IsConfigured()
+}
+
+// GetArticleQRCode 获取文章二维码，命中缓存时直接返回
+func (s *QRCodeService) GetArticleQRCode(ctx context.Context, articleID string) (*QRCodeImage, error) {
+	s.mu.Lock()
+	if img, ok := s.cache[articleID]; ok {
+		s.mu.Unlock()
+		return img, nil
+	}
+	s.mu.Unlock()
+
+	var img *QRCodeImage
+	var err error
+	if s.codeType == QRCodeTypeOfficial {
+		img, err = s.createOfficialQRCode(ctx, articleID)
+	} else {
+		img, err = s.createMiniProgramCode(ctx, articleID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	if len(s.cache) >= maxCachedQRCodes {
+		s.evictOldestLocked()
+	}
+	s.cache[articleID] = img
+	s.mu.Unlock()
+	return img, nil
+}
+
+// InvalidateArticle 清除文章的二维码缓存
+func (s *QRCodeService) InvalidateArticle(articleID string) {
+	s.mu.Lock()
+	delete(s.cache, articleID)
+	s.mu.Unlock()
+}
+
+func (s *QRCodeService) evictOldestLocked() {
+	var oldestKey string
+	var oldest time.Time
+	for key, img := range s.cache {
+		if oldestKey == "" || img.CreatedAt.Before(oldest) {
+			oldestKey, oldest = key, img.CreatedAt
+		}
+	}
+	delete(s.cache, oldestKey)
+}
+
+// createMiniProgramCode 生成小程序码（不限数量接口）
+func (s *QRCodeService) createMiniProgramCode(ctx context.Context, articleID string) (*QRCodeImage, error) {
+	if len(articleID) > 32 {
+		return nil, fmt.Errorf("文章ID超过小程序码 scene 长度限制")
+	}
+
+	accessToken, err := s.tokenSource.GetAccessToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取access_token失败: %w", err)
+	}
+
+	payload := map[string]interface{}{
+		"scene":      articleID,
+		"check_path": false,
+		"width":      430,
+	}
+	if s.page != "" {
+		payload["page"] = s.page
+	}
+
+	apiURL := "https://api.weixin.qq.com/wxa/getwxacodeunlimit?access_token=" + url.QueryEscape(accessToken)
+	data, contentType, err := s.postJSON(ctx, apiURL, payload)
+	if err != nil {
+		return nil, err
+	}
+	// 失败时微信返回 JSON 错误信息，成功时返回图片
+	if strings.HasPrefix(contentType, "application/json") || strings.HasPrefix(contentType, "text/plain") {
+		return nil, parseWechatError("生成小程序码失败", data)
+	}
+	return &QRCodeImage{Data: data, ContentType: contentType, CreatedAt: time.Now()}, nil
+}
+
+// createOfficialQRCode 生成公众号永久二维码
+func (s *QRCodeService) createOfficialQRCode(ctx context.Context, articleID string) (*QRCodeImage, error) {
+	accessToken, err := s.tokenSource.GetAccessToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取access_token失败: %w", err)
+	}
+
+	payload := map[string]interface{}{
+		"action_name": "QR_LIMIT_STR_SCENE",
+		"action_info": map[string]interface{}{
+			"scene": map[string]string{"scene_str": "article_" + articleID},
+		},
+	}
+	apiURL := "https://api.weixin.qq.com/cgi-bin/qrcode/create?access_token=" + url.QueryEscape(accessToken)
+	data, _, err := s.postJSON(ctx, apiURL, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Ticket  string `json:"ticket"`
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("解析响应失败: %w", err)
+	}
+	if result.ErrCode != 0 || result.Ticket == "" {
+		return nil, fmt.Errorf("生成公众号二维码失败(code=%d): %s", result.ErrCode, result.ErrMsg)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		"https://mp.weixin.qq.com/cgi-bin/showqrcode?ticket="+url.QueryEscape(result.Ticket), nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %w", err)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("下载二维码失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("下载二维码失败，状态码: %d", resp.StatusCode)
+	}
+	image, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("读取二维码失败: %w", err)
+	}
+	return &QRCodeImage{Data: image, ContentType: resp.Header.Get("Content-Type"), CreatedAt: time.Now()}, nil
+}
+
+func (s *QRCodeService) postJSON(ctx context.Context, apiURL string, payload interface{}) ([]byte, string, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, "", fmt.Errorf("序列化请求失败: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, "", fmt.Errorf("创建请求失败: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("请求失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("读取响应失败: %w", err)
+	}
+	return data, resp.Header.Get("Content-Type"), nil
+}
+
+func parseWechatError(prefix string, data []byte) error {
+	var result struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("%s: 无法解析响应", prefix)
+	}
+	return fmt.Errorf("%s(code=%d): %s", prefix, result.ErrCode, result.ErrMsg)
+}
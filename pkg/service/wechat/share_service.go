@@ -0,0 +1,122 @@
+// anheyu-app/pkg/service/wechat/share_service.go
+package wechat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// maxShareDescLength 分享描述的最大字符数，微信分享卡片只展示前几十个字
+const maxShareDescLength = 120
+
+// ErrShareArticleNotFound 分享的文章不存在或未发布
+var ErrShareArticleNotFound = errors.New("文章不存在")
+
+// ArticleShareConfig 文章分享配置（JS-SDK 签名 + 分享内容）
+type ArticleShareConfig struct {
+	JSSDK *JSSDKConfig `json:"jssdk"`
+	Share *ShareConfig `json:"share"`
+}
+
+// ShareService 文章分享配置服务
+type ShareService struct {
+	jssdkService *JSSDKService
+	articleRepo  repository.ArticleRepository
+	settingSvc   setting.SettingService
+}
+
+// NewShareService 创建文章分享配置服务
+func NewShareService(jssdkService *JSSDKService, articleRepo repository.ArticleRepository, settingSvc setting.SettingService) *ShareService {
+	return &ShareService{
+		jssdkService: jssdkService,
+		articleRepo:  articleRepo,
+		settingSvc:   settingSvc,
+	}
+}
+
+// GetArticleShareConfig 根据文章 slug 返回签名后的 JS-SDK 配置和分享内容
+// pageURL 为需要签名的当前页面地址，为空时使用文章的规范地址
+func (s *ShareService) GetArticleShareConfig(ctx context.Context, slug, pageURL string) (*ArticleShareConfig, error) {
+	article, err := s.articleRepo.GetBySlugOrID(ctx, slug)
+	if err != nil || article == nil {
+		return nil, ErrShareArticleNotFound
+	}
+
+	share := s.BuildShareConfig(article)
+	if pageURL == "" {
+		pageURL = share.Link
+	}
+
+	config, err := s.jssdkService.GetJSSDKConfig(ctx, pageURL)
+	if err != nil {
+		return nil, fmt.Errorf("获取JS-SDK配置失败: %w", err)
+	}
+
+	return &ArticleShareConfig{JSSDK: config, Share: share}, nil
+}
+
+// BuildShareConfig 根据文章和站点配置构建分享内容
+// 描述优先使用文章摘要，其次是站点描述；图片依次回退到顶部图、默认封面和站点 Logo
+func (s *ShareService) BuildShareConfig(article *model.Article) *ShareConfig {
+	siteURL := strings.TrimRight(s.settingSvc.Get(constant.KeySiteURL.String()), "/")
+
+	articleSlug := article.Abbrlink
+	if articleSlug == "" {
+		articleSlug = article.ID
+	}
+
+	desc := ""
+	for _, summary := range article.Summaries {
+		if summary = strings.TrimSpace(summary); summary != "" {
+			desc = summary
+			break
+		}
+	}
+	if desc == "" {
+		desc = s.settingSvc.Get(constant.KeySiteDescription.String())
+	}
+	if runes := []rune(desc); len(runes) > maxShareDescLength {
+		desc = string(runes[:maxShareDescLength]) + "..."
+	}
+
+	imgURL := firstNonEmpty(
+		article.CoverURL,
+		article.TopImgURL,
+		s.settingSvc.Get(constant.KeyPostDefaultCover.String()),
+		s.settingSvc.Get(constant.KeyLogoURL512.String()),
+	)
+
+	return &ShareConfig{
+		Title:  article.Title,
+		Desc:   desc,
+		Link:   fmt.Sprintf("%s/posts/%s", siteURL, articleSlug),
+		ImgURL: absoluteURL(siteURL, imgURL),
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// absoluteURL 微信分享图标必须是完整地址，相对路径补全站点地址
+func absoluteURL(siteURL, raw string) string {
+	if raw == "" || strings.HasPrefix(raw, "http://") || strings.HasPrefix(raw, "https://") {
+		return raw
+	}
+	if strings.HasPrefix(raw, "//") {
+		return "https:" + raw
+	}
+	return siteURL + "/" + strings.TrimPrefix(raw, "/")
+}
@@ -0,0 +1,362 @@
+/*
+ * SSR 主题源码构建
+ * 部分 SSR 主题只发布源码（package.json 声明了 build 脚本、没有 server.js），需要在本机安装依赖并构建。
+ * 构建在 themes 目录下的单独工作目录中进行，只传入必要的环境变量，并限制总时长和 Node.js 堆内存；
+ * 这些限制不是沙箱：依赖安装脚本和 build 脚本以与主程序相同的用户和权限运行，可以读写主程序能访问的所有文件，
+ * 因此只应构建可信来源的主题。
+ * 构建日志写入主题目录的 build.log，同时推送到管理后台事件流。
+ * 是否允许构建由后台配置 ssr.build.enable 控制，默认关闭。
+ */
+package ssr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/adminevent"
+)
+
+// 构建相关错误
+var (
+	ErrBuildDisabled      = errors.New("theme package requires a build step, but ssr.build.enable is off")
+	ErrBuildOutputMissing = errors.New("build finished but server.js was not found")
+)
+
+const (
+	defaultBuildTimeout     = 15 * time.Minute
+	defaultBuildMaxMemoryMB = 2048
+
+	// buildLogFlushInterval 构建日志推送到事件流的间隔，日志按批推送，避免挤掉事件中心里的其它事件
+	buildLogFlushInterval = time.Second
+	// buildLogBatchLines 积攒到这么多行时立即推送
+	buildLogBatchLines = 50
+	// buildLogMaxLine 推送的单行日志最大长度，完整内容以 build.log 为准
+	buildLogMaxLine = 1000
+)
+
+// buildEnvPassthrough 传给构建进程的环境变量，其余（如数据库密码、密钥）不传入
+var buildEnvPassthrough = []string{
+	"PATH", "HOME", "USERPROFILE", "APPDATA", "LOCALAPPDATA", "SystemRoot", "ComSpec", "PATHEXT",
+	"TMPDIR", "TEMP", "TMP", "LANG",
+	"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy",
+	"npm_config_registry", "NPM_CONFIG_REGISTRY",
+}
+
+// BuildConfig SSR 主题构建配置
+type BuildConfig struct {
+	Enabled     bool
+	Timeout     time.Duration // 安装依赖和构建的总时长上限
+	MaxMemoryMB int           // Node.js 堆内存上限
+}
+
+// BuildConfigProvider 返回当前的构建配置
+type BuildConfigProvider func() BuildConfig
+
+var (
+	buildConfigMu       sync.RWMutex
+	buildConfigProvider BuildConfigProvider
+)
+
+// SetBuildConfigProvider 设置构建配置来源，未设置时不允许构建
+func SetBuildConfigProvider(provider BuildConfigProvider) {
+	buildConfigMu.Lock()
+	buildConfigProvider = provider
+	buildConfigMu.Unlock()
+}
+
+// settingGetter 读取配置项的最小接口
+type settingGetter interface {
+	Get(key string) string
+}
+
+// NewSettingBuildConfigProvider 基于后台配置 ssr.build.* 的构建配置来源
+func NewSettingBuildConfigProvider(settings settingGetter) BuildConfigProvider {
+	readInt := func(key constant.SettingKey, fallback int) int {
+		n, err := strconv.Atoi(strings.TrimSpace(settings.Get(key.String())))
+		if err != nil || n <= 0 {
+			return fallback
+		}
+		return n
+	}
+	return func() BuildConfig {
+		return BuildConfig{
+			Enabled:     settings.Get(constant.KeySSRBuildEnable.String()) == "true",
+			Timeout:     time.Duration(readInt(constant.KeySSRBuildTimeout, int(defaultBuildTimeout/time.Second))) * time.Second,
+			MaxMemoryMB: readInt(constant.KeySSRBuildMaxMemoryMB, defaultBuildMaxMemoryMB),
+		}
+	}
+}
+
+func currentBuildConfig() BuildConfig {
+	buildConfigMu.RLock()
+	provider := buildConfigProvider
+	buildConfigMu.RUnlock()
+	if provider == nil {
+		return BuildConfig{Timeout: defaultBuildTimeout, MaxMemoryMB: defaultBuildMaxMemoryMB}
+	}
+	return provider()
+}
+
+// needsBuild 目录中没有 server.js、但 package.json 声明了 build 脚本时需要构建
+func needsBuild(dir string) bool {
+	if fileExists(filepath.Join(dir, "server.js")) {
+		return false
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return false
+	}
+	var pkg struct {
+		Scripts map[string]string `json:"scripts"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return false
+	}
+	return strings.TrimSpace(pkg.Scripts["build"]) != ""
+}
+
+// buildTheme 在 workDir 中安装依赖并执行 build 脚本，成功后把可运行的产物移动到 destDir
+func buildTheme(ctx context.Context, themeName, workDir, destDir, logPath string) (err error) {
+	cfg := currentBuildConfig()
+	if !cfg.Enabled {
+		return ErrBuildDisabled
+	}
+
+	blog, err := newBuildLog(themeName, logPath)
+	if err != nil {
+		return fmt.Errorf("create build log failed: %w", err)
+	}
+	defer func() { blog.close(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	pm, installArgs := packageManager(workDir)
+	if err := runBuildStep(ctx, cfg, workDir, blog, adminevent.StageInstallingDeps, pm, installArgs...); err != nil {
+		return err
+	}
+	if err := runBuildStep(ctx, cfg, workDir, blog, adminevent.StageBuilding, pm, "run", "build"); err != nil {
+		return err
+	}
+	return assembleBuildOutput(workDir, destDir)
+}
+
+// packageManager 按锁文件选择包管理器和安装依赖的参数，有锁文件时严格按锁文件安装
+func packageManager(dir string) (string, []string) {
+	switch {
+	case fileExists(filepath.Join(dir, "pnpm-lock.yaml")):
+		return "pnpm", []string{"install", "--frozen-lockfile"}
+	case fileExists(filepath.Join(dir, "yarn.lock")):
+		return "yarn", []string{"install", "--frozen-lockfile"}
+	case fileExists(filepath.Join(dir, "package-lock.json")):
+		return "npm", []string{"ci"}
+	default:
+		return "npm", []string{"install"}
+	}
+}
+
+// runBuildStep 执行一个构建命令，超时或取消时结束整个进程组
+func runBuildStep(ctx context.Context, cfg BuildConfig, dir string, blog *buildLog, stage, name string, args ...string) error {
+	blog.setStage(stage)
+	fmt.Fprintf(blog, "$ %s %s\n", name, strings.Join(args, " "))
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.Env = buildEnv(cfg)
+	cmd.Stdout = blog
+	cmd.Stderr = blog
+	configureProcess(cmd)
+	cmd.Cancel = func() error { return killProcess(cmd.Process) }
+	cmd.WaitDelay = stopTimeout
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start %s failed: %w", name, err)
+	}
+	lowerPriority(cmd.Process)
+	if err := cmd.Wait(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%s %s timed out after %s", name, strings.Join(args, " "), cfg.Timeout)
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("%s %s failed: %w", name, strings.Join(args, " "), err)
+	}
+	return nil
+}
+
+func buildEnv(cfg BuildConfig) []string {
+	var env []string
+	for _, key := range buildEnvPassthrough {
+		if v, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+v)
+		}
+	}
+	return append(env,
+		"CI=1",
+		"NEXT_TELEMETRY_DISABLED=1",
+		fmt.Sprintf("NODE_OPTIONS=--max-old-space-size=%d", cfg.MaxMemoryMB),
+	)
+}
+
+// assembleBuildOutput 找到构建产物中的 server.js 并移动到 destDir。
+// Next.js standalone 模式的产物在 .next/standalone，静态资源和 public 目录需要另外放进去
+func assembleBuildOutput(workDir, destDir string) error {
+	standalone := filepath.Join(workDir, ".next", "standalone")
+	if fileExists(filepath.Join(standalone, "server.js")) {
+		moves := map[string]string{
+			filepath.Join(workDir, ".next", "static"): filepath.Join(standalone, ".next", "static"),
+			filepath.Join(workDir, "public"):          filepath.Join(standalone, "public"),
+			filepath.Join(workDir, "version.txt"):     filepath.Join(standalone, "version.txt"),
+		}
+		for src, dst := range moves {
+			if !fileExists(src) || fileExists(dst) {
+				continue
+			}
+			if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+				return err
+			}
+			if err := os.Rename(src, dst); err != nil {
+				return fmt.Errorf("move %s failed: %w", filepath.Base(src), err)
+			}
+		}
+		return os.Rename(standalone, destDir)
+	}
+	if fileExists(filepath.Join(workDir, "server.js")) {
+		return os.Rename(workDir, destDir)
+	}
+	return ErrBuildOutputMissing
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// buildLog 构建日志，写入文件的同时按行分批推送到管理后台事件流
+type buildLog struct {
+	theme string
+	file  *os.File
+
+	mu      sync.Mutex
+	stage   string
+	partial []byte
+	pending []string
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newBuildLog(themeName, path string) (*buildLog, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	l := &buildLog{
+		theme: themeName,
+		file:  f,
+		stage: adminevent.StageStarted,
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+	l.publish(adminevent.SSRBuildData{Stage: adminevent.StageStarted})
+	go l.flushLoop()
+	return l, nil
+}
+
+func (l *buildLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.file.Write(p)
+	l.partial = append(l.partial, p...)
+	for {
+		i := bytes.IndexByte(l.partial, '\n')
+		if i < 0 {
+			break
+		}
+		l.addLine(string(l.partial[:i]))
+		l.partial = l.partial[i+1:]
+	}
+	if len(l.pending) >= buildLogBatchLines {
+		l.flushLocked()
+	}
+	return len(p), nil
+}
+
+func (l *buildLog) addLine(line string) {
+	line = strings.TrimRight(line, "\r")
+	if len(line) > buildLogMaxLine {
+		line = strings.ToValidUTF8(line[:buildLogMaxLine], "") + "…"
+	}
+	l.pending = append(l.pending, line)
+}
+
+// setStage 推送完上一阶段的日志后进入新阶段
+func (l *buildLog) setStage(stage string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.flushLocked()
+	l.stage = stage
+	l.publish(adminevent.SSRBuildData{Stage: stage})
+}
+
+func (l *buildLog) flushLoop() {
+	defer close(l.done)
+	ticker := time.NewTicker(buildLogFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			l.mu.Lock()
+			l.flushLocked()
+			l.mu.Unlock()
+		}
+	}
+}
+
+func (l *buildLog) flushLocked() {
+	if len(l.pending) == 0 {
+		return
+	}
+	l.publish(adminevent.SSRBuildData{Stage: l.stage, Lines: l.pending})
+	l.pending = nil
+}
+
+// close 推送剩余日志和构建结果
+func (l *buildLog) close(err error) {
+	close(l.stop)
+	<-l.done
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.partial) > 0 {
+		l.addLine(string(l.partial))
+		l.partial = nil
+	}
+	l.flushLocked()
+	if err != nil {
+		fmt.Fprintf(l.file, "\n构建失败: %v\n", err)
+		l.publish(adminevent.SSRBuildData{Stage: adminevent.StageFailed, Error: err.Error()})
+	} else {
+		l.publish(adminevent.SSRBuildData{Stage: adminevent.StageCompleted})
+	}
+	l.file.Close()
+}
+
+func (l *buildLog) publish(data adminevent.SSRBuildData) {
+	data.Theme = l.theme
+	adminevent.Publish(adminevent.TypeSSRBuild, data)
+}
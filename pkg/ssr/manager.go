@@ -17,12 +17,62 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
-	"syscall"
 	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/adminevent"
+)
+
+// downloadClient SSR 主题包下载客户端
+var downloadClient = httpclient.New(httpclient.Options{
+	Name:       "ssr-theme-download",
+	Service:    "ssr",
+	Timeout:    5 * time.Minute,
+	MaxRetries: 2,
+	Identify:   true,
+})
+
+// defaultInstallTimeout 下载和解压主题包的默认时长上限
+const defaultInstallTimeout = 10 * time.Minute
+
+var (
+	installTimeoutMu       sync.RWMutex
+	installTimeoutProvider func() time.Duration
 )
 
+// SetInstallTimeoutProvider 设置下载和解压主题包的时长上限来源，返回 0 表示不限制；未设置时为 10 分钟。
+// 构建时长由 ssr.build.timeout 单独限制，不计入其中
+func SetInstallTimeoutProvider(provider func() time.Duration) {
+	installTimeoutMu.Lock()
+	installTimeoutProvider = provider
+	installTimeoutMu.Unlock()
+}
+
+// NewSettingInstallTimeoutProvider 基于后台配置 theme.operation.install_timeout 的时长上限来源，与普通主题安装共用
+func NewSettingInstallTimeoutProvider(settings settingGetter) func() time.Duration {
+	return func() time.Duration {
+		n, err := strconv.Atoi(strings.TrimSpace(settings.Get(constant.KeyThemeInstallTimeout.String())))
+		if err != nil || n < 0 {
+			return defaultInstallTimeout
+		}
+		return time.Duration(n) * time.Second
+	}
+}
+
+func currentInstallTimeout() time.Duration {
+	installTimeoutMu.RLock()
+	provider := installTimeoutProvider
+	installTimeoutMu.RUnlock()
+	if provider == nil {
+		return defaultInstallTimeout
+	}
+	return provider()
+}
+
 // ThemeStatus SSR 主题状态
 type ThemeStatus string
 
@@ -43,19 +93,43 @@ type ThemeInfo struct {
 	StartedAt   *time.Time  `json:"startedAt,omitempty"`
 }
 
+// SSR 主题管理错误
+var (
+	ErrThemeAlreadyInstalled = errors.New("theme already installed")
+	ErrThemeAlreadyRunning   = errors.New("theme already running")
+	ErrThemeNotInstalled     = errors.New("theme not installed or server.js not found")
+	ErrThemeNotRunning       = errors.New("theme not running")
+	ErrThemeInstalling       = errors.New("theme is being installed")
+)
+
+// publishState 向管理后台推送主题进程状态变化
+func publishState(themeName string, status ThemeStatus, port int, reason string) {
+	adminevent.Publish(adminevent.TypeSSRState, adminevent.SSRStateData{
+		Theme:  themeName,
+		Status: string(status),
+		Port:   port,
+		Reason: reason,
+	})
+}
+
+// stopTimeout 等待主题进程优雅退出的时间，超时后强制结束
+const stopTimeout = 5 * time.Second
+
 // runningTheme 运行中的主题信息
 type runningTheme struct {
 	cmd       *exec.Cmd
 	port      int
 	startedAt time.Time
+	done      chan struct{} // 进程退出后关闭
 }
 
 // Manager SSR 主题管理器
 type Manager struct {
-	themesDir string                   // 主题存储目录
-	processes map[string]*runningTheme // 运行中的主题进程
-	mu        sync.RWMutex
-	basePort  int // SSR 主题基础端口
+	themesDir  string                   // 主题存储目录
+	processes  map[string]*runningTheme // 运行中的主题进程
+	installing map[string]struct{}      // 正在安装的主题，安装（尤其是构建）耗时较长，期间不持有 mu
+	mu         sync.RWMutex
+	basePort   int // SSR 主题基础端口
 }
 
 // NewManager 创建 SSR 主题管理器
@@ -64,11 +138,13 @@ func NewManager(themesDir string) *Manager {
 	if err := os.MkdirAll(themesDir, 0755); err != nil {
 		log.Printf("[SSR] 创建主题目录失败: %s, 错误: %v", themesDir, err)
 	}
+	cleanupStaleInstalls(themesDir)
 
 	return &Manager{
-		themesDir: themesDir,
-		processes: make(map[string]*runningTheme),
-		basePort:  3000,
+		themesDir:  themesDir,
+		processes:  make(map[string]*runningTheme),
+		installing: make(map[string]struct{}),
+		basePort:   3000,
 	}
 }
 
@@ -77,48 +153,107 @@ func (m *Manager) GetThemesDir() string {
 	return m.themesDir
 }
 
-// Install 下载并安装 SSR 主题
-func (m *Manager) Install(ctx context.Context, themeName, downloadURL string) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+// staleInstallAge 超过该时长的解压目录和主题包临时文件视为上次进程异常退出时遗留
+const staleInstallAge = 24 * time.Hour
+
+// cleanupStaleInstalls 清理进程在安装过程中异常退出时遗留的解压目录和主题包临时文件。
+// 正常安装结束时（无论成功或失败）Install 会自行删除它们
+func cleanupStaleInstalls(themesDir string) {
+	staging, _ := filepath.Glob(filepath.Join(themesDir, ".install-*"))
+	downloads, _ := filepath.Glob(filepath.Join(os.TempDir(), "ssr_theme_*"))
+	for _, path := range append(staging, downloads...) {
+		// 其他实例可能正在使用相同目录安装，只清理足够旧的文件
+		info, err := os.Stat(path)
+		if err != nil || time.Since(info.ModTime()) < staleInstallAge {
+			continue
+		}
+		if err := os.RemoveAll(path); err != nil {
+			log.Printf("[SSR] 清理遗留的安装文件失败: %s, 错误: %v", path, err)
+		}
+	}
+}
 
+// Install 下载并安装 SSR 主题
+// checksum 为主题包的 SHA-256，提供时校验下载内容，并允许使用配置的下载镜像
+// 主题包只包含源码时，在开启 ssr.build.enable 的情况下先构建再安装（见 build.go）
+func (m *Manager) Install(ctx context.Context, themeName, downloadURL, checksum string) error {
 	themePath := filepath.Join(m.themesDir, themeName)
 
+	m.mu.Lock()
 	// 检查是否已安装
 	if _, err := os.Stat(themePath); err == nil {
-		return errors.New("theme already installed")
+		m.mu.Unlock()
+		return ErrThemeAlreadyInstalled
+	}
+	if _, ok := m.installing[themeName]; ok {
+		m.mu.Unlock()
+		return ErrThemeInstalling
 	}
+	m.installing[themeName] = struct{}{}
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		delete(m.installing, themeName)
+		m.mu.Unlock()
+	}()
 
 	// 下载主题包
 	log.Printf("[SSR] 正在下载主题: %s, URL: %s", themeName, downloadURL)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", downloadURL, nil)
+	tempFile, err := os.CreateTemp("", "ssr_theme_*.tar.gz")
 	if err != nil {
-		return fmt.Errorf("create request failed: %w", err)
+		return fmt.Errorf("create temp file failed: %w", err)
+	}
+	// 成功和失败时都删除临时文件，先关闭再删除（Windows 上不能删除打开的文件）
+	defer func() {
+		tempFile.Close()
+		os.RemoveAll(tempFile.Name())
+	}()
+
+	// 下载和解压受安装时长上限约束，构建另有 ssr.build.timeout
+	fetchCtx := ctx
+	if timeout := currentInstallTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		fetchCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	timedOut := func(err error) error {
+		if errors.Is(fetchCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			return fmt.Errorf("timed out after %s: %w", currentInstallTimeout(), err)
+		}
+		return err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("download failed: %w", err)
+	if err := httpclient.DownloadToFile(fetchCtx, downloadClient, downloadURL, checksum, tempFile); err != nil {
+		return fmt.Errorf("download failed: %w", timedOut(err))
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("download failed with status: %d", resp.StatusCode)
+	// 先解压到临时目录，准备好可运行的文件后再放到主题目录，失败时不留下残缺的主题
+	stagingDir := filepath.Join(m.themesDir, ".install-"+themeName)
+	os.RemoveAll(stagingDir)
+	defer os.RemoveAll(stagingDir)
+	if err := m.extractTarGz(fetchCtx, tempFile, stagingDir); err != nil {
+		return fmt.Errorf("extract failed: %w", timedOut(err))
 	}
 
-	// 解压到主题目录
-	if err := m.extractTarGz(resp.Body, themePath); err != nil {
-		os.RemoveAll(themePath) // 清理失败的安装
-		return fmt.Errorf("extract failed: %w", err)
+	if needsBuild(stagingDir) {
+		log.Printf("[SSR] 主题 %s 只包含源码，开始构建", themeName)
+		logPath := filepath.Join(m.themesDir, ".build-"+themeName+".log")
+		if err := buildTheme(ctx, themeName, stagingDir, themePath, logPath); err != nil {
+			// 构建日志保留在 themes 目录下便于排查
+			return fmt.Errorf("build failed: %w", err)
+		}
+		os.Rename(logPath, filepath.Join(themePath, "build.log"))
+	} else if err := os.Rename(stagingDir, themePath); err != nil {
+		return fmt.Errorf("move theme failed: %w", err)
 	}
 
 	log.Printf("[SSR] 主题安装成功: %s", themeName)
 	return nil
 }
 
-// extractTarGz 解压 tar.gz 文件
-func (m *Manager) extractTarGz(r io.Reader, destDir string) error {
+// extractTarGz 解压 tar.gz 文件，每个条目解压前检查 ctx 是否已取消
+func (m *Manager) extractTarGz(ctx context.Context, r io.Reader, destDir string) error {
 	gzr, err := gzip.NewReader(r)
 	if err != nil {
 		return err
@@ -128,6 +263,9 @@ func (m *Manager) extractTarGz(r io.Reader, destDir string) error {
 	tr := tar.NewReader(gzr)
 
 	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		header, err := tr.Next()
 		if err == io.EOF {
 			break
@@ -137,7 +275,8 @@ func (m *Manager) extractTarGz(r io.Reader, destDir string) error {
 		}
 
 		// 处理路径，移除顶层目录（如果存在）
-		name := header.Name
+		// Windows 上打包的压缩包可能使用反斜杠作为分隔符，统一转换后再处理
+		name := strings.ReplaceAll(header.Name, "\\", "/")
 		// 去除可能的顶层目录前缀（如 "theme-nova/"）
 		parts := strings.SplitN(name, "/", 2)
 		if len(parts) == 2 {
@@ -150,12 +289,12 @@ func (m *Manager) extractTarGz(r io.Reader, destDir string) error {
 			continue
 		}
 
-		target := filepath.Join(destDir, name)
-
-		// 安全检查：防止路径遍历攻击
-		if !strings.HasPrefix(filepath.Clean(target), filepath.Clean(destDir)) {
-			return fmt.Errorf("invalid file path: %s", name)
+		// 安全检查：防止路径遍历攻击（包括绝对路径和 Windows 盘符、保留设备名）
+		name = filepath.FromSlash(name)
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("invalid file path: %s", header.Name)
 		}
+		target := filepath.Join(destDir, name)
 
 		switch header.Typeflag {
 		case tar.TypeDir:
@@ -187,8 +326,7 @@ func (m *Manager) Uninstall(themeName string) error {
 
 	// 先停止运行中的进程
 	if rt, exists := m.processes[themeName]; exists && rt.cmd.Process != nil {
-		rt.cmd.Process.Signal(syscall.SIGTERM)
-		rt.cmd.Wait()
+		stopProcess(themeName, rt)
 		delete(m.processes, themeName)
 	}
 
@@ -208,7 +346,7 @@ func (m *Manager) Start(themeName string, port int) error {
 
 	// 检查是否已在运行
 	if rt, exists := m.processes[themeName]; exists && rt.cmd.Process != nil {
-		return errors.New("theme already running")
+		return ErrThemeAlreadyRunning
 	}
 
 	themePath := filepath.Join(m.themesDir, themeName)
@@ -216,13 +354,14 @@ func (m *Manager) Start(themeName string, port int) error {
 
 	// 检查主题是否已安装
 	if _, err := os.Stat(serverJS); os.IsNotExist(err) {
-		return errors.New("theme not installed or server.js not found")
+		return ErrThemeNotInstalled
 	}
 
 	// 启动 Node.js 进程
 	// 注意：使用相对路径 server.js 而不是绝对路径，因为 Next.js 对工作目录有特殊要求
 	cmd := exec.Command("node", "server.js")
 	cmd.Dir = themePath
+	configureProcess(cmd)
 	cmd.Env = append(os.Environ(),
 		fmt.Sprintf("PORT=%d", port),
 		"API_URL=http://localhost:8091",
@@ -245,19 +384,32 @@ func (m *Manager) Start(themeName string, port int) error {
 	}
 
 	now := time.Now()
-	m.processes[themeName] = &runningTheme{
+	rt := &runningTheme{
 		cmd:       cmd,
 		port:      port,
 		startedAt: now,
+		done:      make(chan struct{}),
 	}
+	m.processes[themeName] = rt
 
-	// 后台监控进程
+	// 后台监控进程，进程退出后先通知等待方，再移除记录（停止时调用方持有锁，不能在此之前加锁）
 	go func() {
 		cmd.Wait()
+		if logFile != nil {
+			logFile.Close()
+		}
+		close(rt.done)
 		m.mu.Lock()
-		delete(m.processes, themeName)
+		// 记录仍在说明不是通过 Stop 停止的，进程意外退出
+		unexpected := m.processes[themeName] == rt
+		if unexpected {
+			delete(m.processes, themeName)
+		}
 		m.mu.Unlock()
 		log.Printf("[SSR] 主题进程已退出: %s", themeName)
+		if unexpected {
+			publishState(themeName, StatusError, 0, "进程意外退出")
+		}
 	}()
 
 	// 等待 SSR 主题就绪（健康检查）
@@ -265,6 +417,7 @@ func (m *Manager) Start(themeName string, port int) error {
 	go m.waitForReady(themeName, port)
 
 	log.Printf("[SSR] 主题启动成功: %s, 端口: %d", themeName, port)
+	publishState(themeName, StatusRunning, port, "已启动，等待就绪")
 	return nil
 }
 
@@ -280,6 +433,7 @@ func (m *Manager) waitForReady(themeName string, port int) {
 		elapsed := time.Since(startTime)
 		if elapsed >= maxTimeout {
 			log.Printf("[SSR] ⚠️ 主题健康检查超时: %s（已等待 %.1f 秒）", themeName, elapsed.Seconds())
+			publishState(themeName, StatusError, port, "健康检查超时")
 			return
 		}
 
@@ -299,6 +453,7 @@ func (m *Manager) waitForReady(themeName string, port int) {
 		if err == nil {
 			resp.Body.Close()
 			log.Printf("[SSR] 主题 HTTP 服务已就绪: %s (等待了 %.1f 秒)", themeName, time.Since(startTime).Seconds())
+			publishState(themeName, StatusRunning, port, "已就绪")
 			return
 		}
 
@@ -313,28 +468,13 @@ func (m *Manager) Stop(themeName string) error {
 
 	rt, exists := m.processes[themeName]
 	if !exists || rt.cmd.Process == nil {
-		return errors.New("theme not running")
-	}
-
-	// 优雅关闭
-	if err := rt.cmd.Process.Signal(syscall.SIGTERM); err != nil {
-		rt.cmd.Process.Kill()
-	}
-
-	// 等待进程结束（超时 5 秒）
-	done := make(chan error, 1)
-	go func() {
-		done <- rt.cmd.Wait()
-	}()
-
-	select {
-	case <-done:
-	case <-time.After(5 * time.Second):
-		rt.cmd.Process.Kill()
+		return ErrThemeNotRunning
 	}
 
+	stopProcess(themeName, rt)
 	delete(m.processes, themeName)
 	log.Printf("[SSR] 主题停止成功: %s", themeName)
+	publishState(themeName, StatusInstalled, 0, "已停止")
 	return nil
 }
 
@@ -404,7 +544,8 @@ func (m *Manager) ListInstalled() ([]ThemeInfo, error) {
 
 	var themes []ThemeInfo
 	for _, entry := range entries {
-		if entry.IsDir() {
+		// 以 . 开头的是安装和构建用的临时目录
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
 			// 检查是否有 server.js 文件（验证是 SSR 主题）
 			serverJS := filepath.Join(m.themesDir, entry.Name(), "server.js")
 			if _, err := os.Stat(serverJS); err == nil {
@@ -478,9 +619,9 @@ func (m *Manager) StopAll() error {
 
 	for name, rt := range m.processes {
 		if rt.cmd.Process != nil {
-			rt.cmd.Process.Signal(syscall.SIGTERM)
-			rt.cmd.Wait()
+			stopProcess(name, rt)
 			log.Printf("[SSR] 主题停止成功: %s", name)
+			publishState(name, StatusInstalled, 0, "已停止")
 		}
 	}
 	m.processes = make(map[string]*runningTheme)
@@ -509,3 +650,28 @@ func (m *Manager) ListRunning() []string {
 	}
 	return running
 }
+
+// stopProcess 请求主题进程优雅退出，超时后强制结束进程树
+// Unix 上发送 SIGTERM，Windows 上使用 taskkill（见 process_unix.go / process_windows.go）
+func stopProcess(themeName string, rt *runningTheme) {
+	if err := terminateProcess(rt.cmd.Process); err != nil {
+		log.Printf("[SSR] 请求主题进程退出失败，强制结束: %s, 错误: %v", themeName, err)
+		killProcess(rt.cmd.Process)
+	}
+
+	select {
+	case <-rt.done:
+		return
+	case <-time.After(stopTimeout):
+	}
+
+	log.Printf("[SSR] 主题进程 %s 未在 %s 内退出，强制结束", themeName, stopTimeout)
+	if err := killProcess(rt.cmd.Process); err != nil {
+		log.Printf("[SSR] 强制结束主题进程失败: %s, 错误: %v", themeName, err)
+	}
+	select {
+	case <-rt.done:
+	case <-time.After(stopTimeout):
+		log.Printf("[SSR] 主题进程 %s 仍未退出", themeName)
+	}
+}
@@ -0,0 +1,85 @@
+package ssr
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestHelperProcess 不是真正的测试，作为被停止的子进程使用
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("SSR_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	time.Sleep(time.Minute)
+	os.Exit(0)
+}
+
+func TestStopProcess(t *testing.T) {
+	cmd := exec.Command(os.Args[0], "-test.run=TestHelperProcess")
+	cmd.Env = append(os.Environ(), "SSR_WANT_HELPER_PROCESS=1")
+	configureProcess(cmd)
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("启动子进程失败: %v", err)
+	}
+
+	rt := &runningTheme{cmd: cmd, done: make(chan struct{})}
+	go func() {
+		cmd.Wait()
+		close(rt.done)
+	}()
+
+	start := time.Now()
+	stopProcess("helper", rt)
+	select {
+	case <-rt.done:
+	default:
+		t.Fatal("stopProcess 返回后子进程仍在运行")
+	}
+	if elapsed := time.Since(start); elapsed > 2*stopTimeout {
+		t.Fatalf("停止子进程耗时过长: %s", elapsed)
+	}
+}
+
+func TestExtractTarGzNormalizesPaths(t *testing.T) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	write := func(name, content string) {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		tw.Write([]byte(content))
+	}
+	write("theme-nova/server.js", "server")
+	write(`theme-nova\public\app.js`, "app")
+	tw.Close()
+	gw.Close()
+
+	dest := t.TempDir()
+	m := &Manager{}
+	if err := m.extractTarGz(context.Background(), bytes.NewReader(buf.Bytes()), dest); err != nil {
+		t.Fatalf("解压失败: %v", err)
+	}
+	for _, rel := range []string{"server.js", filepath.Join("public", "app.js")} {
+		if _, err := os.Stat(filepath.Join(dest, rel)); err != nil {
+			t.Errorf("缺少文件 %s: %v", rel, err)
+		}
+	}
+
+	buf.Reset()
+	gw = gzip.NewWriter(&buf)
+	tw = tar.NewWriter(gw)
+	write("theme-nova/../../evil.js", "evil")
+	tw.Close()
+	gw.Close()
+	if err := m.extractTarGz(context.Background(), bytes.NewReader(buf.Bytes()), t.TempDir()); err == nil {
+		t.Fatal("包含路径遍历的压缩包应当解压失败")
+	}
+}
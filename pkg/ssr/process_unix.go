@@ -0,0 +1,36 @@
+//go:build !windows
+
+package ssr
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// configureProcess 让 Node.js 进程成为新进程组的组长，停止时可以连同它派生的子进程一起结束
+func configureProcess(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// terminateProcess 向进程组发送 SIGTERM，请求优雅退出
+func terminateProcess(p *os.Process) error {
+	if err := syscall.Kill(-p.Pid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return p.Signal(syscall.SIGTERM)
+	}
+	return nil
+}
+
+// lowerPriority 降低构建进程的调度优先级，避免构建占满 CPU 影响站点响应
+func lowerPriority(p *os.Process) {
+	syscall.Setpriority(syscall.PRIO_PROCESS, p.Pid, 10)
+}
+
+// killProcess 强制结束整个进程组
+func killProcess(p *os.Process) error {
+	if err := syscall.Kill(-p.Pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return p.Kill()
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+//go:build windows
+
+package ssr
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+// configureProcess 在新的进程组中启动 Node.js 进程，避免控制台的 Ctrl+C 直接传递给主题进程
+func configureProcess(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// terminateProcess Windows 不支持 SIGTERM，使用 taskkill /T 请求结束进程树
+func terminateProcess(p *os.Process) error {
+	return taskkill(p.Pid, false)
+}
+
+// lowerPriority Windows 上不调整构建进程的优先级
+func lowerPriority(p *os.Process) {}
+
+// killProcess 使用 taskkill /T /F 强制结束进程树，taskkill 不可用时退回只结束主进程
+func killProcess(p *os.Process) error {
+	if err := taskkill(p.Pid, true); err != nil {
+		return p.Kill()
+	}
+	return nil
+}
+
+func taskkill(pid int, force bool) error {
+	args := []string{"/T", "/PID", strconv.Itoa(pid)}
+	if force {
+		args = append([]string{"/F"}, args...)
+	}
+	if out, err := exec.Command("taskkill", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("taskkill 失败: %w: %s", err, out)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+// pkg/util/bot.go
+package util
+
+import "strings"
+
+// botUserAgentKeywords 常见爬虫、监控和命令行工具的 User-Agent 关键字（小写）
+var botUserAgentKeywords = []string{
+	"bot", "spider", "crawl", "slurp", "bingpreview", "mediapartners",
+	"facebookexternalhit", "embedly", "quora link preview", "whatsapp", "telegram",
+	"headlesschrome", "phantomjs", "lighthouse", "pingdom", "uptime", "monitor",
+	"curl/", "wget/", "python-requests", "python-urllib", "go-http-client", "okhttp", "java/", "axios/", "node-fetch",
+}
+
+// IsBotUserAgent 判断 User-Agent 是否来自爬虫或自动化工具，空 User-Agent 同样视为非真实访客
+func IsBotUserAgent(userAgent string) bool {
+	ua := strings.ToLower(strings.TrimSpace(userAgent))
+	if ua == "" {
+		return true
+	}
+	for _, keyword := range botUserAgentKeywords {
+		if strings.Contains(ua, keyword) {
+			return true
+		}
+	}
+	return false
+}
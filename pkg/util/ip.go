@@ -11,15 +11,24 @@ import (
 // GetRealClientIP 获取客户端真实IP地址
 // 优先级：X-Forwarded-For > X-Real-IP > X-Original-Forwarded-For > CF-Connecting-IP > EO-Connecting-IP > Ali-CDN-Real-IP > 其他 > RemoteAddr
 // 支持的 CDN: Cloudflare, 腾讯云 EdgeOne, 阿里云 CDN/ESA 等
+// 直连对端不是可信代理时不读取任何代理头，直接返回对端地址，防止客户端伪造 IP
 func GetRealClientIP(c *gin.Context) string {
+	if !IsFromTrustedProxy(c) {
+		return c.RemoteIP()
+	}
+
 	// 1. 检查 X-Forwarded-For 头部（最常用的代理头部）
 	if xff := c.GetHeader("X-Forwarded-For"); xff != "" {
 		// X-Forwarded-For 可能包含多个IP，格式：client, proxy1, proxy2
-		// 取第一个IP（客户端真实IP）
-		if ips := strings.Split(xff, ","); len(ips) > 0 {
-			clientIP := strings.TrimSpace(ips[0])
+		// 从右往左跳过可信代理，第一个非可信地址即客户端真实IP；客户端自行添加的左侧内容不会被采信
+		ips := strings.Split(xff, ",")
+		for i := len(ips) - 1; i >= 0; i-- {
+			clientIP := strings.TrimSpace(ips[i])
 			// 验证IP格式
-			if ip := net.ParseIP(clientIP); ip != nil {
+			if ip := net.ParseIP(clientIP); ip == nil {
+				break
+			}
+			if i == 0 || !IsTrustedProxy(clientIP) {
 				return clientIP
 			}
 		}
@@ -102,6 +111,19 @@ func IsValidIP(ip string) bool {
 	return net.ParseIP(ip) != nil
 }
 
+// AnonymizeIP 对IP地址做匿名化处理：IPv4 将最后一段置零，IPv6 仅保留前 48 位
+// 无法解析的地址原样返回
+func AnonymizeIP(ip string) string {
+	parsedIP := net.ParseIP(strings.TrimSpace(ip))
+	if parsedIP == nil {
+		return ip
+	}
+	if v4 := parsedIP.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return parsedIP.Mask(net.CIDRMask(48, 128)).String()
+}
+
 // IsPrivateIP 检查是否为私有IP地址
 func IsPrivateIP(ip string) bool {
 	parsedIP := net.ParseIP(ip)
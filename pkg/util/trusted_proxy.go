@@ -0,0 +1,104 @@
+// pkg/util/trusted_proxy.go
+package util
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultTrustedProxies 未配置可信代理时使用的默认值：本机回环和私有网段，
+// 适用于 Nginx 同机部署或 Docker 网络内反向代理的常见场景
+var DefaultTrustedProxies = []string{"127.0.0.1", "::1", "10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+
+var trustedProxyNets atomic.Pointer[[]*net.IPNet]
+
+func init() {
+	nets, _ := parseProxyCIDRs(DefaultTrustedProxies)
+	trustedProxyNets.Store(&nets)
+}
+
+// ParseTrustedProxies 解析逗号或换行分隔的可信代理配置，为空时返回默认值
+func ParseTrustedProxies(raw string) []string {
+	var proxies []string
+	for _, item := range strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == '\n' || r == ' ' }) {
+		if item = strings.TrimSpace(item); item != "" {
+			proxies = append(proxies, item)
+		}
+	}
+	if len(proxies) == 0 {
+		return DefaultTrustedProxies
+	}
+	return proxies
+}
+
+// SetTrustedProxies 设置可信代理列表，支持单个 IP 和 CIDR
+// 只有来自可信代理的请求才会读取 X-Forwarded-For、X-Real-IP、X-Forwarded-Proto 等代理头
+func SetTrustedProxies(proxies []string) error {
+	nets, err := parseProxyCIDRs(proxies)
+	if err != nil {
+		return err
+	}
+	trustedProxyNets.Store(&nets)
+	return nil
+}
+
+// IsTrustedProxy 判断 IP 是否属于可信代理
+func IsTrustedProxy(ip string) bool {
+	parsedIP := net.ParseIP(strings.TrimSpace(ip))
+	if parsedIP == nil {
+		return false
+	}
+	for _, ipNet := range *trustedProxyNets.Load() {
+		if ipNet.Contains(parsedIP) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFromTrustedProxy 判断请求的直连对端是否为可信代理
+func IsFromTrustedProxy(c *gin.Context) bool {
+	return IsTrustedProxy(c.RemoteIP())
+}
+
+// GetRequestScheme 获取请求协议 (http 或 https)
+// 仅在请求来自可信代理时采用 X-Forwarded-Proto，避免客户端伪造协议
+func GetRequestScheme(c *gin.Context) string {
+	if IsFromTrustedProxy(c) {
+		if proto := strings.ToLower(strings.TrimSpace(c.GetHeader("X-Forwarded-Proto"))); proto == "http" || proto == "https" {
+			return proto
+		}
+	}
+	if c.Request.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
+
+func parseProxyCIDRs(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("无效的可信代理地址: %s", proxy)
+			}
+			if ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("无效的可信代理地址: %s", proxy)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}